@@ -11,11 +11,14 @@ import (
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
 	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	dbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/libs/log"
 	tmos "github.com/cometbft/cometbft/libs/os"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	_ "github.com/cosmos/cosmos-sdk/client/docs/statik" //nolint:nolintlint,used_for_swagger_ui_docs
@@ -200,6 +203,7 @@ var (
 		liquidstaketypes.ModuleName:                   {authtypes.Minter, authtypes.Burner},
 		liquidstakeibctypes.DepositModuleAccount:      nil,
 		liquidstakeibctypes.UndelegationModuleAccount: {authtypes.Burner},
+		wasmtypes.ModuleName:                          {authtypes.Burner},
 	}
 
 	receiveAllowedMAcc = map[string]bool{
@@ -258,12 +262,14 @@ type PstakeApp struct {
 	LiquidStakeIBCKeeper  liquidstakeibckeeper.Keeper
 	LiquidStakeKeeper     liquidstakekeeper.Keeper
 	RatesyncKeeper        *ratesynckeeper.Keeper
+	WasmKeeper            wasmkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper           capabilitykeeper.ScopedKeeper
 	ScopedTransferKeeper      capabilitykeeper.ScopedKeeper
 	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper
 	ScopedICAControllerKeeper capabilitykeeper.ScopedKeeper
+	ScopedWasmKeeper          capabilitykeeper.ScopedKeeper
 
 	// the module manager
 	mm *module.Manager
@@ -311,9 +317,9 @@ func NewpStakeApp(
 		capabilitytypes.StoreKey, feegrant.StoreKey, authzkeeper.StoreKey, icahosttypes.StoreKey,
 		icacontrollertypes.StoreKey, epochstypes.StoreKey, interchainquerytypes.StoreKey,
 		ibcfeetypes.StoreKey, liquidstakeibctypes.StoreKey, liquidstaketypes.StoreKey, consensusparamtypes.StoreKey,
-		ratesynctypes.StoreKey,
+		ratesynctypes.StoreKey, wasmtypes.StoreKey,
 	)
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, liquidstaketypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	app := &PstakeApp{
@@ -344,6 +350,7 @@ func NewpStakeApp(
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
 	scopedICAControllerKeeper := app.CapabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
+	scopedWasmKeeper := app.CapabilityKeeper.ScopeToModule(wasmtypes.ModuleName)
 	app.CapabilityKeeper.Seal()
 
 	// add keepers
@@ -430,11 +437,14 @@ func NewpStakeApp(
 	app.LiquidStakeKeeper = liquidstakekeeper.NewKeeper(
 		appCodec,
 		keys[liquidstaketypes.StoreKey],
+		tkeys[liquidstaketypes.TStoreKey],
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.StakingKeeper,
 		app.DistrKeeper,
 		app.SlashingKeeper,
+		app.EpochsKeeper,
+		app.AuthzKeeper,
 		app.MsgServiceRouter(),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -489,6 +499,35 @@ func NewpStakeApp(
 
 	// icaModule := ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper)
 
+	// register the proposal types, the gov keeper is needed by the liquidstakeibc keeper
+
+	// Register the proposal types
+	// Deprecated: Avoid adding new handlers, instead use the new proposal flow
+	// by granting the governance module the right to execute the message.
+	// See: https://github.com/cosmos/cosmos-sdk/blob/release/v0.46.x/x/gov/spec/01_concepts.md#proposal-messages
+	govRouter := govv1beta1.NewRouter()
+	govRouter.AddRoute(govtypes.RouterKey, govv1beta1.ProposalHandler).
+		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper))
+	govConfig := govtypes.DefaultConfig()
+	/*
+		Example of setting gov params:
+		govConfig.MaxMetadataLen = 10000
+	*/
+	govKeeper := govkeeper.NewKeeper(
+		appCodec, keys[govtypes.StoreKey], app.AccountKeeper, app.BankKeeper,
+		app.StakingKeeper, app.MsgServiceRouter(), govConfig, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// Set legacy router for backwards compatibility with gov v1beta1
+	govKeeper.SetLegacyRouter(govRouter)
+
+	app.GovKeeper = *govKeeper.SetHooks(
+		govtypes.NewMultiGovHooks(
+		// register the governance hooks
+		),
+	)
+
 	app.InterchainQueryKeeper = interchainquerykeeper.NewKeeper(appCodec, keys[interchainquerytypes.StoreKey], app.IBCKeeper)
 	interchainQueryModule := interchainquery.NewAppModule(appCodec, app.InterchainQueryKeeper)
 
@@ -502,17 +541,49 @@ func NewpStakeApp(
 		app.IBCKeeper, // TODO: Move to module interface
 		app.TransferKeeper,
 		&app.InterchainQueryKeeper,
+		&app.GovKeeper,
+		app.DistrKeeper,
 		app.GetSubspace(liquidstakeibctypes.ModuleName),
 		app.MsgServiceRouter(),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	wasmConfig, err := wasm.ReadWasmConfig(appOpts)
+	if err != nil {
+		panic(fmt.Sprintf("error while reading wasm config: %s", err))
+	}
+	app.WasmKeeper = wasmkeeper.NewKeeper(
+		appCodec,
+		keys[wasmtypes.StoreKey],
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.StakingKeeper,
+		distrkeeper.NewQuerier(app.DistrKeeper),
+		app.IBCFeeKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		scopedWasmKeeper,
+		app.TransferKeeper,
+		app.MsgServiceRouter(),
+		app.GRPCQueryRouter(),
+		homePath,
+		wasmConfig,
+		WasmCapabilities,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// the callback contract notified about the received host chain transfers is executed through the wasm keeper
+	app.LiquidStakeIBCKeeper = *app.LiquidStakeIBCKeeper.SetContractKeeper(
+		wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper))
+
 	app.RatesyncKeeper = ratesynckeeper.NewKeeper(appCodec, keys[ratesynctypes.StoreKey],
 		app.EpochsKeeper, app.LiquidStakeKeeper, app.ICAControllerKeeper, app.IBCKeeper,
 		app.MsgServiceRouter(), authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	app.LiquidStakeIBCKeeper = *app.LiquidStakeIBCKeeper.SetHooks(liquidstakeibctypes.NewMultiLiquidStakeIBCHooks(
 		app.RatesyncKeeper.LiquidStakeIBCHooks()))
+	app.LiquidStakeIBCKeeper = *app.LiquidStakeIBCKeeper.SetLocalhostQuerier(
+		liquidstakeibckeeper.NewLocalhostQuerier(keys, app.GRPCQueryRouter()))
 
 	_ = app.InterchainQueryKeeper.SetCallbackHandler(liquidstakeibctypes.ModuleName, app.LiquidStakeIBCKeeper.CallbackHandler())
 
@@ -542,39 +613,11 @@ func NewpStakeApp(
 		AddRoute(icahosttypes.SubModuleName, icaHostStack).
 		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
 		AddRoute(liquidstakeibctypes.ModuleName, icaControllerStack).
-		AddRoute(ratesynctypes.ModuleName, icaControllerStack)
+		AddRoute(ratesynctypes.ModuleName, icaControllerStack).
+		AddRoute(wasmtypes.ModuleName, wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper, app.IBCFeeKeeper))
 
 	app.IBCKeeper.SetRouter(ibcRouter)
 
-	// register the proposal types
-
-	// Register the proposal types
-	// Deprecated: Avoid adding new handlers, instead use the new proposal flow
-	// by granting the governance module the right to execute the message.
-	// See: https://github.com/cosmos/cosmos-sdk/blob/release/v0.46.x/x/gov/spec/01_concepts.md#proposal-messages
-	govRouter := govv1beta1.NewRouter()
-	govRouter.AddRoute(govtypes.RouterKey, govv1beta1.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
-		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper))
-	govConfig := govtypes.DefaultConfig()
-	/*
-		Example of setting gov params:
-		govConfig.MaxMetadataLen = 10000
-	*/
-	govKeeper := govkeeper.NewKeeper(
-		appCodec, keys[govtypes.StoreKey], app.AccountKeeper, app.BankKeeper,
-		app.StakingKeeper, app.MsgServiceRouter(), govConfig, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-	)
-
-	// Set legacy router for backwards compatibility with gov v1beta1
-	govKeeper.SetLegacyRouter(govRouter)
-
-	app.GovKeeper = *govKeeper.SetHooks(
-		govtypes.NewMultiGovHooks(
-		// register the governance hooks
-		),
-	)
-
 	// create evidence keeper with router
 	evidenceKeeper := evidencekeeper.NewKeeper(
 		appCodec,
@@ -589,6 +632,7 @@ func NewpStakeApp(
 		epochstypes.NewMultiEpochHooks(
 			app.LiquidStakeIBCKeeper.NewEpochHooks(),
 			app.RatesyncKeeper.EpochHooks(),
+			app.LiquidStakeKeeper.EpochHooks(),
 		),
 	)
 
@@ -629,6 +673,15 @@ func NewpStakeApp(
 		liquidstake.NewAppModule(app.LiquidStakeKeeper),
 		ratesync.NewAppModule(appCodec, *app.RatesyncKeeper, app.AccountKeeper, app.BankKeeper),
 		consensus.NewAppModule(appCodec, app.ConsensusParamsKeeper),
+		wasm.NewAppModule(
+			appCodec,
+			&app.WasmKeeper,
+			app.StakingKeeper,
+			app.AccountKeeper,
+			app.BankKeeper,
+			app.MsgServiceRouter(),
+			app.GetSubspace(wasmtypes.ModuleName),
+		),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -665,6 +718,7 @@ func NewpStakeApp(
 		liquidstaketypes.ModuleName,
 		ratesynctypes.ModuleName,
 		consensusparamtypes.ModuleName,
+		wasmtypes.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName,
@@ -694,6 +748,7 @@ func NewpStakeApp(
 		liquidstaketypes.ModuleName,
 		ratesynctypes.ModuleName,
 		consensusparamtypes.ModuleName,
+		wasmtypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -730,6 +785,7 @@ func NewpStakeApp(
 		liquidstaketypes.ModuleName,
 		ratesynctypes.ModuleName,
 		consensusparamtypes.ModuleName,
+		wasmtypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(app.CrisisKeeper)
@@ -796,16 +852,31 @@ func NewpStakeApp(
 
 	app.RegisterUpgradeHandler()
 
+	// the wasm code is not part of the state, it is shipped with the state sync snapshots
+	if manager := app.SnapshotManager(); manager != nil {
+		err = manager.RegisterExtensions(wasmkeeper.NewWasmSnapshotter(app.CommitMultiStore(), &app.WasmKeeper))
+		if err != nil {
+			panic(fmt.Errorf("failed to register snapshot extension: %s", err))
+		}
+	}
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(fmt.Sprintf("failed to load latest version: %s", err))
 		}
+
+		// the pinned contracts are kept in the wasm vm memory cache
+		ctx := app.BaseApp.NewUncachedContext(true, tmproto.Header{})
+		if err := app.WasmKeeper.InitializePinnedCodes(ctx); err != nil {
+			tmos.Exit(fmt.Sprintf("failed to initialize pinned codes: %s", err))
+		}
 	}
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
 	app.ScopedICAHostKeeper = scopedICAHostKeeper
 	app.ScopedICAControllerKeeper = scopedICAControllerKeeper
+	app.ScopedWasmKeeper = scopedWasmKeeper
 
 	return app
 }
@@ -983,6 +1054,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(ibcexported.ModuleName)
 	paramsKeeper.Subspace(icacontrollertypes.SubModuleName)
 	paramsKeeper.Subspace(icahosttypes.SubModuleName)
+	paramsKeeper.Subspace(wasmtypes.ModuleName)
 
 	return paramsKeeper
 }
@@ -1019,6 +1091,12 @@ func (app *PstakeApp) RegisterUpgradeHandler() {
 	app.UpgradeKeeper.SetUpgradeHandler(
 		UpgradeName,
 		func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			// the store migrations run first, so the host chains are valid before they are written again
+			versionMap, err := app.mm.RunMigrations(ctx, app.configurator, fromVM)
+			if err != nil {
+				return nil, err
+			}
+
 			for _, hc := range app.LiquidStakeIBCKeeper.GetAllHostChains(ctx) {
 				switch hc.ChainId {
 				case "cosmoshub-4":
@@ -1026,47 +1104,59 @@ func (app *PstakeApp) RegisterUpgradeHandler() {
 					lowerLimit, _ := sdk.NewDecFromStr("0.80")
 					hc.Params.UpperCValueLimit = upperLimit
 					hc.Params.LowerCValueLimit = lowerLimit
-					app.LiquidStakeIBCKeeper.SetHostChain(ctx, hc)
+					if err := app.LiquidStakeIBCKeeper.SetHostChain(ctx, hc); err != nil {
+						return nil, err
+					}
 
 				case "osmosis-1":
 					upperLimit, _ := sdk.NewDecFromStr("1.01")
 					lowerLimit, _ := sdk.NewDecFromStr("0.97")
 					hc.Params.UpperCValueLimit = upperLimit
 					hc.Params.LowerCValueLimit = lowerLimit
-					app.LiquidStakeIBCKeeper.SetHostChain(ctx, hc)
+					if err := app.LiquidStakeIBCKeeper.SetHostChain(ctx, hc); err != nil {
+						return nil, err
+					}
 
 				case "theta-testnet-001":
 					upperLimit, _ := sdk.NewDecFromStr("1.01")
 					lowerLimit, _ := sdk.NewDecFromStr("0.9")
 					hc.Params.UpperCValueLimit = upperLimit
 					hc.Params.LowerCValueLimit = lowerLimit
-					app.LiquidStakeIBCKeeper.SetHostChain(ctx, hc)
+					if err := app.LiquidStakeIBCKeeper.SetHostChain(ctx, hc); err != nil {
+						return nil, err
+					}
 
 				case "osmo-test-5":
 					upperLimit, _ := sdk.NewDecFromStr("1.01")
 					lowerLimit, _ := sdk.NewDecFromStr("0.95")
 					hc.Params.UpperCValueLimit = upperLimit
 					hc.Params.LowerCValueLimit = lowerLimit
-					app.LiquidStakeIBCKeeper.SetHostChain(ctx, hc)
+					if err := app.LiquidStakeIBCKeeper.SetHostChain(ctx, hc); err != nil {
+						return nil, err
+					}
 
 				case "dydx-test-4":
 					upperLimit, _ := sdk.NewDecFromStr("1.01")
 					lowerLimit, _ := sdk.NewDecFromStr("0.95")
 					hc.Params.UpperCValueLimit = upperLimit
 					hc.Params.LowerCValueLimit = lowerLimit
-					app.LiquidStakeIBCKeeper.SetHostChain(ctx, hc)
+					if err := app.LiquidStakeIBCKeeper.SetHostChain(ctx, hc); err != nil {
+						return nil, err
+					}
 
 				case "gaia-1":
 					upperLimit, _ := sdk.NewDecFromStr("1.01")
 					lowerLimit, _ := sdk.NewDecFromStr("0.95")
 					hc.Params.UpperCValueLimit = upperLimit
 					hc.Params.LowerCValueLimit = lowerLimit
-					app.LiquidStakeIBCKeeper.SetHostChain(ctx, hc)
+					if err := app.LiquidStakeIBCKeeper.SetHostChain(ctx, hc); err != nil {
+						return nil, err
+					}
 
 				}
 			}
 
-			return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+			return versionMap, nil
 		},
 	)
 
@@ -1077,7 +1167,7 @@ func (app *PstakeApp) RegisterUpgradeHandler() {
 
 	if upgradeInfo.Name == UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
 		storeUpgrades := store.StoreUpgrades{
-			Added:   []string{},
+			Added:   []string{wasmtypes.StoreKey},
 			Deleted: []string{},
 		}
 
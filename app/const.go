@@ -3,4 +3,7 @@ package app
 const (
 	appName     = "pStake"
 	UpgradeName = "v2.9.0"
+
+	// WasmCapabilities are the capabilities the wasm contracts can require
+	WasmCapabilities = "iterator,staking,stargate,cosmwasm_1_1,cosmwasm_1_2"
 )
@@ -121,7 +121,7 @@ func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
 }
 
 // Setup initializes a new SimApp. A Nop logger is set in SimApp.
-func Setup(t *testing.T, isCheckTx bool, invCheckPeriod uint) *app.PstakeApp {
+func Setup(t testing.TB, isCheckTx bool, invCheckPeriod uint) *app.PstakeApp {
 	t.Helper()
 
 	privVal := mock.NewPV()
@@ -145,7 +145,7 @@ func Setup(t *testing.T, isCheckTx bool, invCheckPeriod uint) *app.PstakeApp {
 	return app
 }
 
-func genesisStateWithValSet(t *testing.T,
+func genesisStateWithValSet(t testing.TB,
 	app *app.PstakeApp, genesisState app.GenesisState,
 	valSet *tmtypes.ValidatorSet, genAccs []authtypes.GenesisAccount,
 	balances ...banktypes.Balance,
@@ -214,7 +214,7 @@ func genesisStateWithValSet(t *testing.T,
 // that also act as delegators. For simplicity, each validator is bonded with a delegation
 // of one consensus engine unit in the default token of the simapp from first genesis
 // account. A Nop logger is set in SimApp.
-func SetupWithGenesisValSet(t *testing.T, valSet *tmtypes.ValidatorSet, genAccs []authtypes.GenesisAccount, balances ...banktypes.Balance) *app.PstakeApp {
+func SetupWithGenesisValSet(t testing.TB, valSet *tmtypes.ValidatorSet, genAccs []authtypes.GenesisAccount, balances ...banktypes.Balance) *app.PstakeApp {
 	t.Helper()
 
 	app, genesisState := setup(true, 5)
@@ -0,0 +1,267 @@
+package interchaintest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/interchaintest/v7/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v7/ibc"
+	"github.com/strangelove-ventures/interchaintest/v7/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// GaiaLSMVersion is the first gaia release shipping the liquid staking module.
+	GaiaLSMVersion = "v14.1.0"
+	GaiaHostDenom  = "uatom"
+
+	// LiquidStakeIBCDayEpoch replaces the "day" epoch driving the liquidstakeibc workflows, so that deposits
+	// are transferred and delegated within the test timeout.
+	LiquidStakeIBCDayEpoch = "60s"
+
+	// liquidStakeIBCAdminMnemonic derives the liquidstakeibc admin, which is set in genesis and funded once
+	// the chain is started.
+	liquidStakeIBCAdminMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art"
+
+	// maxPollBlocks bounds the amount of blocks waited for an asynchronous workflow to complete.
+	maxPollBlocks = 100
+)
+
+var (
+	liquidStakeIBCGenesisOverridesKV = append(append([]cosmos.GenesisKV{}, defaultGenesisOverridesKV...),
+		cosmos.GenesisKV{
+			Key:   "app_state.epochs.epochs.0.duration",
+			Value: LiquidStakeIBCDayEpoch,
+		},
+		cosmos.GenesisKV{
+			Key:   "app_state.liquidstakeibc.params.admin_address",
+			Value: liquidStakeIBCAdminAddress(),
+		},
+	)
+
+	pstakeLSMConfig = func() ibc.ChainConfig {
+		config := pstakeConfig
+		config.ModifyGenesis = cosmos.ModifyGenesis(liquidStakeIBCGenesisOverridesKV)
+		return config
+	}()
+
+	// gaiaLSMGenesisOverridesKV allows the ICA messages sent by liquidstakeibc and makes downtime slashing
+	// happen within a few blocks.
+	gaiaLSMGenesisOverridesKV = []cosmos.GenesisKV{
+		{
+			Key: "app_state.interchainaccounts.host_genesis_state.params.allow_messages",
+			Value: []string{
+				"/cosmos.bank.v1beta1.MsgSend",
+				"/cosmos.staking.v1beta1.MsgDelegate",
+				"/cosmos.staking.v1beta1.MsgUndelegate",
+				"/cosmos.staking.v1beta1.MsgBeginRedelegate",
+				"/cosmos.staking.v1beta1.MsgRedeemTokensForShares",
+				"/cosmos.distribution.v1beta1.MsgSetWithdrawAddress",
+				"/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward",
+				"/ibc.applications.transfer.v1.MsgTransfer",
+			},
+		},
+		{
+			Key:   "app_state.slashing.params.signed_blocks_window",
+			Value: "10",
+		},
+		{
+			Key:   "app_state.slashing.params.min_signed_per_window",
+			Value: "0.500000000000000000",
+		},
+		{
+			Key:   "app_state.slashing.params.downtime_jail_duration",
+			Value: "10s",
+		},
+		{
+			Key:   "app_state.slashing.params.slash_fraction_downtime",
+			Value: "0.100000000000000000",
+		},
+	}
+
+	gaiaLSMConfig = ibc.ChainConfig{
+		ModifyGenesis: cosmos.ModifyGenesis(gaiaLSMGenesisOverridesKV),
+	}
+)
+
+// liquidStakeIBCAdminAddress returns the persistence address of the liquidstakeibc admin mnemonic.
+func liquidStakeIBCAdminAddress() string {
+	derivedPriv, err := hd.Secp256k1.Derive()(
+		liquidStakeIBCAdminMnemonic,
+		"",
+		hd.CreateHDPath(PersistenceCoinType, 0, 0).String(),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	address, err := sdk.Bech32ifyAddressBytes(
+		pstakeConfig.Bech32Prefix,
+		hd.Secp256k1.Generate()(derivedPriv).PubKey().Address(),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return address
+}
+
+// hostChain mirrors the fields of the liquidstakeibc host chain the tests assert on.
+type hostChain struct {
+	ChainID           string  `json:"chain_id"`
+	CValue            sdk.Dec `json:"c_value"`
+	DelegationAccount struct {
+		Address string `json:"address"`
+	} `json:"delegation_account"`
+	RewardsAccount struct {
+		Address string `json:"address"`
+	} `json:"rewards_account"`
+	Validators []struct {
+		OperatorAddress string  `json:"operator_address"`
+		DelegatedAmount sdk.Int `json:"delegated_amount"`
+	} `json:"validators"`
+}
+
+// delegatedAmount returns the amount liquidstakeibc accounts as delegated to a host chain validator.
+func (hc hostChain) delegatedAmount(operatorAddress string) sdk.Int {
+	for _, validator := range hc.Validators {
+		if validator.OperatorAddress == operatorAddress {
+			return validator.DelegatedAmount
+		}
+	}
+
+	return sdk.ZeroInt()
+}
+
+// lsmDeposit mirrors the fields of a liquidstakeibc LSM deposit the tests assert on.
+type lsmDeposit struct {
+	Denom    string  `json:"denom"`
+	IBCDenom string  `json:"ibc_denom"`
+	Shares   sdk.Dec `json:"shares"`
+	State    string  `json:"state"`
+}
+
+// getFullNode returns the node the queries and transactions of chain go through, the first full node if there is one
+// or else the first validator.
+func getFullNode(chain *cosmos.CosmosChain) *cosmos.ChainNode {
+	if len(chain.FullNodes) > 0 {
+		return chain.FullNodes[0]
+	}
+
+	return chain.Validators[0]
+}
+
+// queryHostChain returns the liquidstakeibc host chain registered for chainID.
+func queryHostChain(t *testing.T, ctx context.Context, chain *cosmos.CosmosChain, chainID string) hostChain {
+	stdout, _, err := getFullNode(chain).ExecQuery(ctx, "liquidstakeibc", "host-chain", chainID)
+	require.NoError(t, err)
+
+	var resp struct {
+		HostChain hostChain `json:"host_chain"`
+	}
+	require.NoError(t, json.Unmarshal(stdout, &resp))
+
+	return resp.HostChain
+}
+
+// queryLSMDeposits returns the liquidstakeibc LSM deposits pending for chainID.
+func queryLSMDeposits(t *testing.T, ctx context.Context, chain *cosmos.CosmosChain, chainID string) []lsmDeposit {
+	stdout, _, err := getFullNode(chain).ExecQuery(ctx, "liquidstakeibc", "lsm-deposits", chainID)
+	require.NoError(t, err)
+
+	var resp struct {
+		Deposits []lsmDeposit `json:"deposits"`
+	}
+	require.NoError(t, json.Unmarshal(stdout, &resp))
+
+	return resp.Deposits
+}
+
+// queryBalances returns all the balances held by address, including the tokenized share denoms which are not
+// part of the bank denom metadata.
+func queryBalances(t *testing.T, ctx context.Context, chain *cosmos.CosmosChain, address string) sdk.Coins {
+	stdout, _, err := getFullNode(chain).ExecQuery(ctx, "bank", "balances", address)
+	require.NoError(t, err)
+
+	var resp struct {
+		Balances sdk.Coins `json:"balances"`
+	}
+	require.NoError(t, json.Unmarshal(stdout, &resp))
+
+	return resp.Balances
+}
+
+// registerHostChain registers and activates the counterparty of connectionID and channelID as a liquidstakeibc
+// host chain with LSM enabled, delegating to validators at equal weights.
+func registerHostChain(
+	t *testing.T,
+	ctx context.Context,
+	chain *cosmos.CosmosChain,
+	admin ibc.Wallet,
+	hostChainID, connectionID, channelID string,
+	validators []string,
+) hostChain {
+	node := getFullNode(chain)
+	_, err := node.ExecTx(ctx, admin.KeyName(),
+		"liquidstakeibc", "register-host-chain",
+		connectionID, channelID, "transfer",
+		"0.01", "0.05", "0", "0.03",
+		GaiaHostDenom, "1", "4", "20",
+		"--gas", "auto",
+	)
+	require.NoError(t, err)
+
+	// the interchain accounts are set once the relayer finishes their channel handshakes
+	var hc hostChain
+	waitForCondition(t, ctx, chain, "interchain accounts registered", func() bool {
+		hc = queryHostChain(t, ctx, chain, hostChainID)
+		return hc.DelegationAccount.Address != "" && hc.RewardsAccount.Address != ""
+	})
+
+	type kvUpdate struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	}
+	updates := []kvUpdate{
+		{Key: "flags", Value: `{"lsm": true}`},
+		{Key: "set_withdraw_address", Value: ""},
+	}
+	weight := sdk.OneDec().QuoInt64(int64(len(validators)))
+	for _, validator := range validators {
+		updates = append(updates, kvUpdate{
+			Key: "add_validator",
+			Value: fmt.Sprintf(
+				`{"operator_address": %q, "status": "BOND_STATUS_BONDED", "weight": %q, "delegated_amount": "0", "exchange_rate": "1", "unbonding_epoch": 0, "delegable": true}`,
+				validator,
+				weight,
+			),
+		})
+	}
+	updates = append(updates, kvUpdate{Key: "active", Value: "true"})
+
+	updatesJSON, err := json.Marshal(updates)
+	require.NoError(t, err)
+	_, err = node.ExecTx(ctx, admin.KeyName(),
+		"liquidstakeibc", "update-host-chain", hostChainID, string(updatesJSON),
+		"--gas", "auto",
+	)
+	require.NoError(t, err)
+
+	return queryHostChain(t, ctx, chain, hostChainID)
+}
+
+// waitForCondition waits block by block on chain until condition holds, failing the test after maxPollBlocks.
+func waitForCondition(t *testing.T, ctx context.Context, chain *cosmos.CosmosChain, description string, condition func() bool) {
+	for i := 0; i < maxPollBlocks; i++ {
+		if condition() {
+			return
+		}
+		require.NoError(t, testutil.WaitForBlocks(ctx, 1, chain))
+	}
+
+	t.Fatalf("%s: condition not met after %d blocks", description, maxPollBlocks)
+}
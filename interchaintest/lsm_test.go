@@ -0,0 +1,280 @@
+package interchaintest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/strangelove-ventures/interchaintest/v7"
+	"github.com/strangelove-ventures/interchaintest/v7/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v7/ibc"
+	interchaintestrelayer "github.com/strangelove-ventures/interchaintest/v7/relayer"
+	"github.com/strangelove-ventures/interchaintest/v7/testreporter"
+	"github.com/strangelove-ventures/interchaintest/v7/testutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+// TestPersistenceGaiaLSM spins up a Persistence and an LSM enabled Gaia network, registers Gaia as a liquidstakeibc
+// host chain and liquid stakes tokenized delegations through MsgLiquidStakeLSM. It covers a regular LSM deposit,
+// an instant redemption, and a validator being slashed while its tokenized shares are in transit to the host chain.
+func TestPersistenceGaiaLSM(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	t.Parallel()
+
+	// Gaia runs four validators so that the chain keeps producing blocks while one of them is down
+	pstakeVals, gaiaVals := 1, 4
+	numFullNodes := 1
+
+	cf := interchaintest.NewBuiltinChainFactory(zaptest.NewLogger(t), []*interchaintest.ChainSpec{
+		{
+			Name:          "pstake",
+			ChainConfig:   pstakeLSMConfig,
+			NumValidators: &pstakeVals,
+			NumFullNodes:  &numFullNodes,
+		},
+		{
+			Name:          "gaia",
+			Version:       GaiaLSMVersion,
+			ChainConfig:   gaiaLSMConfig,
+			NumValidators: &gaiaVals,
+			NumFullNodes:  &numFullNodes,
+		},
+	})
+
+	const (
+		path         = "ibc-path"
+		walletAmount = int64(100_000_000)
+		lsmAmount    = int64(1_000_000)
+	)
+
+	// Get chains from the chain factory
+	chains, err := cf.Chains(t.Name())
+	require.NoError(t, err)
+
+	client, network := interchaintest.DockerSetup(t)
+
+	persistenceChain, gaiaChain := chains[0].(*cosmos.CosmosChain), chains[1].(*cosmos.CosmosChain)
+
+	relayerType, relayerName := ibc.CosmosRly, "relay"
+
+	rf := interchaintest.NewBuiltinRelayerFactory(
+		relayerType,
+		zaptest.NewLogger(t),
+		interchaintestrelayer.CustomDockerImage(IBCRelayerImage, IBCRelayerVersion, "100:1000"),
+		interchaintestrelayer.StartupFlags("--processor", "events", "--block-history", "100"),
+	)
+
+	r := rf.Build(t, client, network)
+
+	ic := interchaintest.NewInterchain().
+		AddChain(persistenceChain).
+		AddChain(gaiaChain).
+		AddRelayer(r, relayerName).
+		AddLink(interchaintest.InterchainLink{
+			Chain1:  persistenceChain,
+			Chain2:  gaiaChain,
+			Relayer: r,
+			Path:    path,
+		})
+
+	ctx := context.Background()
+
+	rep := testreporter.NewNopReporter()
+	eRep := rep.RelayerExecReporter(t)
+
+	require.NoError(t, ic.Build(ctx, eRep, interchaintest.InterchainBuildOptions{
+		TestName:          t.Name(),
+		Client:            client,
+		NetworkID:         network,
+		BlockDatabaseFile: interchaintest.DefaultBlockDatabaseFilepath(),
+		SkipPathCreation:  false,
+	}))
+	t.Cleanup(func() {
+		_ = ic.Close()
+	})
+
+	users := interchaintest.GetAndFundTestUsers(t, ctx, t.Name(), walletAmount, persistenceChain, gaiaChain)
+	persistenceUser, gaiaUser := users[0], users[1]
+	admin, err := interchaintest.GetAndFundTestUserWithMnemonic(ctx, "admin", liquidStakeIBCAdminMnemonic, genesisWalletAmount, persistenceChain)
+	require.NoError(t, err)
+	require.Equal(t, liquidStakeIBCAdminAddress(), admin.FormattedAddress())
+
+	require.NoError(t, r.StartRelayer(ctx, eRep, path))
+	t.Cleanup(
+		func() {
+			err := r.StopRelayer(ctx, eRep)
+			if err != nil {
+				t.Logf("an error occurred while stopping the relayer: %s", err)
+			}
+		},
+	)
+
+	require.NoError(t, testutil.WaitForBlocks(ctx, 5, persistenceChain, gaiaChain))
+
+	channel, err := ibc.GetTransferChannel(ctx, r, eRep, persistenceChain.Config().ChainID, gaiaChain.Config().ChainID)
+	require.NoError(t, err)
+
+	validators := make([]string, 0, len(gaiaChain.Validators))
+	for _, node := range gaiaChain.Validators {
+		validator, err := node.KeyBech32(ctx, "validator", "val")
+		require.NoError(t, err)
+		validators = append(validators, validator)
+	}
+
+	hc := registerHostChain(
+		t, ctx, persistenceChain, admin,
+		gaiaChain.Config().ChainID, channel.ConnectionHops[0], channel.ChannelID,
+		validators,
+	)
+	mintDenom := "stk/" + GaiaHostDenom
+
+	// tokenizeAndTransfer delegates amount to validator on Gaia, tokenizes the delegation and transfers the share
+	// tokens to the Persistence user, returning their IBC denom.
+	tokenizeAndTransfer := func(t *testing.T, validator string, amount int64) string {
+		gaiaNode := getFullNode(gaiaChain)
+		coin := fmt.Sprintf("%d%s", amount, GaiaHostDenom)
+
+		_, err := gaiaNode.ExecTx(ctx, gaiaUser.KeyName(), "staking", "delegate", validator, coin)
+		require.NoError(t, err)
+		_, err = gaiaNode.ExecTx(ctx, gaiaUser.KeyName(),
+			"staking", "tokenize-share", validator, coin, gaiaUser.FormattedAddress(),
+			"--gas", "auto",
+		)
+		require.NoError(t, err)
+
+		var shareDenom string
+		for _, balance := range queryBalances(t, ctx, gaiaChain, gaiaUser.FormattedAddress()) {
+			if strings.HasPrefix(balance.Denom, validator+"/") {
+				shareDenom = balance.Denom
+			}
+		}
+		require.NotEmpty(t, shareDenom, "no tokenized shares of %s", validator)
+
+		_, err = gaiaChain.SendIBCTransfer(ctx, channel.Counterparty.ChannelID, gaiaUser.KeyName(), ibc.WalletAmount{
+			Address: persistenceUser.FormattedAddress(),
+			Denom:   shareDenom,
+			Amount:  amount,
+		}, ibc.TransferOptions{})
+		require.NoError(t, err)
+
+		ibcDenom := transfertypes.ParseDenomTrace(
+			transfertypes.GetPrefixedDenom(channel.PortID, channel.ChannelID, shareDenom),
+		).IBCDenom()
+		waitForCondition(t, ctx, persistenceChain, "tokenized shares transferred", func() bool {
+			balance, err := persistenceChain.GetBalance(ctx, persistenceUser.FormattedAddress(), ibcDenom)
+			require.NoError(t, err)
+			return balance == amount
+		})
+
+		return ibcDenom
+	}
+
+	liquidStakeLSM := func(t *testing.T, ibcDenom string, amount int64) {
+		_, err := getFullNode(persistenceChain).ExecTx(ctx, persistenceUser.KeyName(),
+			"liquidstakeibc", "liquid-stake-lsm", fmt.Sprintf("%d%s", amount, ibcDenom),
+			"--gas", "auto",
+		)
+		require.NoError(t, err)
+	}
+
+	t.Run("liquid stake lsm", func(t *testing.T) {
+		ibcDenom := tokenizeAndTransfer(t, validators[0], lsmAmount)
+		liquidStakeLSM(t, ibcDenom, lsmAmount)
+
+		// the deposit fee is kept from the minted tokens, the exchange rate and c value being both one
+		minted, err := persistenceChain.GetBalance(ctx, persistenceUser.FormattedAddress(), mintDenom)
+		require.NoError(t, err)
+		require.Equal(t, lsmAmount-lsmAmount/100, minted)
+
+		// the shares are sent back to the delegation account on the next epoch and redeemed into a delegation
+		waitForCondition(t, ctx, persistenceChain, "lsm deposit redeemed", func() bool {
+			hc = queryHostChain(t, ctx, persistenceChain, hc.ChainID)
+			return len(queryLSMDeposits(t, ctx, persistenceChain, hc.ChainID)) == 0 &&
+				hc.delegatedAmount(validators[0]).Equal(sdk.NewInt(lsmAmount))
+		})
+	})
+
+	t.Run("redeem", func(t *testing.T) {
+		const stakeAmount = int64(10_000_000)
+		_, err := gaiaChain.SendIBCTransfer(ctx, channel.Counterparty.ChannelID, gaiaUser.KeyName(), ibc.WalletAmount{
+			Address: persistenceUser.FormattedAddress(),
+			Denom:   GaiaHostDenom,
+			Amount:  stakeAmount,
+		}, ibc.TransferOptions{})
+		require.NoError(t, err)
+
+		hostDenom := transfertypes.ParseDenomTrace(
+			transfertypes.GetPrefixedDenom(channel.PortID, channel.ChannelID, GaiaHostDenom),
+		).IBCDenom()
+		waitForCondition(t, ctx, persistenceChain, "host tokens transferred", func() bool {
+			balance, err := persistenceChain.GetBalance(ctx, persistenceUser.FormattedAddress(), hostDenom)
+			require.NoError(t, err)
+			return balance == stakeAmount
+		})
+
+		node := getFullNode(persistenceChain)
+		_, err = node.ExecTx(ctx, persistenceUser.KeyName(),
+			"liquidstakeibc", "liquid-stake", fmt.Sprintf("%d%s", stakeAmount, hostDenom),
+		)
+		require.NoError(t, err)
+
+		// redeem from the deposits before the next epoch moves them to the host chain
+		redeemAmount := sdk.NewInt(stakeAmount / 10)
+		hc = queryHostChain(t, ctx, persistenceChain, hc.ChainID)
+		_, err = node.ExecTx(ctx, persistenceUser.KeyName(),
+			"liquidstakeibc", "redeem", sdk.NewCoin(mintDenom, redeemAmount).String(),
+		)
+		require.NoError(t, err)
+
+		fee := sdk.MustNewDecFromStr("0.03").MulInt(redeemAmount).TruncateInt()
+		expected := sdk.NewDecFromInt(redeemAmount.Sub(fee)).Quo(hc.CValue).TruncateInt()
+		balance, err := persistenceChain.GetBalance(ctx, persistenceUser.FormattedAddress(), hostDenom)
+		require.NoError(t, err)
+		require.Equal(t, expected.Int64(), balance)
+	})
+
+	t.Run("slash during transfer", func(t *testing.T) {
+		slashed := validators[len(validators)-1]
+		ibcDenom := tokenizeAndTransfer(t, slashed, lsmAmount)
+
+		// hold the shares transfer on Persistence until the validator is slashed on Gaia
+		require.NoError(t, r.StopRelayer(ctx, eRep))
+		liquidStakeLSM(t, ibcDenom, lsmAmount)
+		waitForCondition(t, ctx, persistenceChain, "lsm deposit sent", func() bool {
+			deposits := queryLSMDeposits(t, ctx, persistenceChain, hc.ChainID)
+			return len(deposits) == 1 && deposits[0].State == "DEPOSIT_SENT"
+		})
+
+		validatorNode := gaiaChain.Validators[len(gaiaChain.Validators)-1]
+		require.NoError(t, validatorNode.StopContainer(ctx))
+		waitForCondition(t, ctx, gaiaChain, "validator jailed", func() bool {
+			stdout, _, err := getFullNode(gaiaChain).ExecQuery(ctx, "staking", "validator", slashed)
+			require.NoError(t, err)
+
+			var validator struct {
+				Jailed bool `json:"jailed"`
+			}
+			require.NoError(t, json.Unmarshal(stdout, &validator))
+			return validator.Jailed
+		})
+		require.NoError(t, validatorNode.StartContainer(ctx))
+		require.NoError(t, r.StartRelayer(ctx, eRep, path))
+
+		// the shares are redeemed at the slashed exchange rate, so less than the deposit ends up delegated
+		waitForCondition(t, ctx, persistenceChain, "slashed lsm deposit redeemed", func() bool {
+			return len(queryLSMDeposits(t, ctx, persistenceChain, hc.ChainID)) == 0
+		})
+		hc = queryHostChain(t, ctx, persistenceChain, hc.ChainID)
+		delegated := hc.delegatedAmount(slashed)
+		require.True(t, delegated.IsPositive())
+		require.True(t, delegated.LT(sdk.NewInt(lsmAmount)), "expected slashed delegation, got %s", delegated)
+	})
+}
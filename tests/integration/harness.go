@@ -0,0 +1,663 @@
+// Package integration spins up a Persistence app next to a mock host chain using the ibc-go testing
+// framework, so cross-module liquid staking flows can be exercised end to end: epochs are driven by
+// moving the coordinator clock, packets are relayed in both directions and interchain queries are
+// answered with real proofs from the host chain state.
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/cosmos/ibc-go/v7/testing/simapp"
+	icqtypes "github.com/persistenceOne/persistence-sdk/v2/x/interchainquery/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/app"
+	"github.com/persistenceOne/pstake-native/v2/app/helpers"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+const (
+	// HostDenom is the staking denom of the mock host chain.
+	HostDenom = "uatom"
+	// HostUnbondingTime is the unbonding period set on the mock host chain. It is kept well below the
+	// light client trusting period so unbondings can mature without the clients expiring.
+	HostUnbondingTime = 3 * 24 * time.Hour
+	// UnbondingFactor is the unbonding factor the host chain is registered with.
+	UnbondingFactor = 4
+	// AutocompoundFactor is the autocompound factor the host chain is registered with.
+	AutocompoundFactor = 20
+
+	// maxRelayRounds bounds the number of packet and query relaying rounds, a flow that keeps
+	// producing packets after this many rounds is considered stuck.
+	maxRelayRounds = 50
+)
+
+// Harness wires a Persistence chain to a mock host chain with a transfer channel, a registered
+// liquidstakeibc host chain and its delegation and rewards interchain accounts.
+type Harness struct {
+	t *testing.T
+
+	Coordinator *ibctesting.Coordinator
+	Persistence *ibctesting.TestChain
+	Host        *ibctesting.TestChain
+
+	App     *app.PstakeApp
+	HostApp *simapp.SimApp
+
+	TransferPath   *ibctesting.Path
+	DelegationPath *ibctesting.Path
+	RewardsPath    *ibctesting.Path
+
+	recorder    *packetRecorder
+	hostPackets []channeltypes.Packet
+}
+
+// NewHarness sets up both chains, opens the transfer channel, registers the host chain through
+// MsgRegisterHostChain, completes the interchain account handshakes and activates the host chain
+// with its validator set split in equal weights.
+func NewHarness(t *testing.T) *Harness {
+	t.Helper()
+
+	h := &Harness{t: t, recorder: &packetRecorder{}}
+	h.Coordinator = ibctesting.NewCoordinator(t, 0)
+
+	ibctesting.DefaultTestingAppInit = helpers.SetupTestingApp
+	sdk.DefaultBondDenom = "uxprt"
+	h.Persistence = ibctesting.NewTestChain(t, h.Coordinator, ibctesting.GetChainID(1))
+	ibctesting.DefaultTestingAppInit = ibctesting.SetupTestingApp
+	sdk.DefaultBondDenom = HostDenom
+	h.Host = ibctesting.NewTestChain(t, h.Coordinator, ibctesting.GetChainID(2))
+	h.Coordinator.Chains = map[string]*ibctesting.TestChain{
+		h.Persistence.ChainID: h.Persistence,
+		h.Host.ChainID:        h.Host,
+	}
+
+	h.App = h.Persistence.App.(*app.PstakeApp)
+	h.HostApp = h.Host.GetSimApp()
+	h.App.SetStreamingService(h.recorder)
+
+	h.resetEpochs()
+	h.setHostUnbondingTime()
+
+	h.TransferPath = newTransferPath(h.Persistence, h.Host)
+	clientConfig := h.TransferPath.EndpointA.ClientConfig.(*ibctesting.TendermintConfig)
+	clientConfig.UnbondingPeriod = HostUnbondingTime
+	clientConfig.TrustingPeriod = HostUnbondingTime * 2 / 3
+	h.Coordinator.Setup(h.TransferPath)
+
+	// validators without outstanding rewards are stored with empty values, which the proofs of the withdraw address
+	// query can't be verified against
+	h.AllocateHostRewards(math.NewInt(1000))
+
+	h.registerHostChain()
+	h.activateHostChain()
+
+	return h
+}
+
+// Ctx returns the current Persistence context.
+func (h *Harness) Ctx() sdk.Context {
+	return h.Persistence.GetContext()
+}
+
+// HostCtx returns the current host chain context.
+func (h *Harness) HostCtx() sdk.Context {
+	return h.Host.GetContext()
+}
+
+// HostChain returns the registered host chain as stored on Persistence.
+func (h *Harness) HostChain() *types.HostChain {
+	hc, found := h.App.LiquidStakeIBCKeeper.GetHostChain(h.Ctx(), h.Host.ChainID)
+	require.True(h.t, found, "host chain %s not registered", h.Host.ChainID)
+
+	return hc
+}
+
+// Deliver sends the messages to Persistence signed by its sender account, records the packets
+// the transaction emitted and relays everything until both chains are idle.
+func (h *Harness) Deliver(msgs ...sdk.Msg) *sdk.Result {
+	h.t.Helper()
+
+	res, err := h.deliver(msgs...)
+	require.NoError(h.t, err)
+	h.Relay()
+
+	return res
+}
+
+// DeliverHost sends the messages to the host chain signed by its sender account.
+func (h *Harness) DeliverHost(msgs ...sdk.Msg) *sdk.Result {
+	h.t.Helper()
+
+	res, err := h.Host.SendMsgs(msgs...)
+	require.NoError(h.t, err)
+
+	return res
+}
+
+// TransferToPersistence sends host tokens from the host sender account to the Persistence sender
+// account over the transfer channel.
+func (h *Harness) TransferToPersistence(coin sdk.Coin) {
+	h.t.Helper()
+
+	msg := ibctransfertypes.NewMsgTransfer(
+		h.TransferPath.EndpointB.ChannelConfig.PortID,
+		h.TransferPath.EndpointB.ChannelID,
+		coin,
+		h.Host.SenderAccount.GetAddress().String(),
+		h.Persistence.SenderAccount.GetAddress().String(),
+		clienttypes.ZeroHeight(),
+		uint64(h.Coordinator.CurrentTime.Add(types.IBCTimeoutTimestamp).UnixNano()),
+		"",
+	)
+	res := h.DeliverHost(msg)
+
+	packets, err := parsePackets(res.Events)
+	require.NoError(h.t, err)
+	h.hostPackets = append(h.hostPackets, packets...)
+	h.Relay()
+}
+
+// NextBlock commits a block on both chains and relays everything produced by it.
+func (h *Harness) NextBlock() {
+	h.Coordinator.CommitBlock(h.Persistence, h.Host)
+	h.Relay()
+}
+
+// AdvanceEpoch moves the clock to the end of the current epoch with the given identifier, commits
+// the block that triggers it and relays every packet and query the epoch hooks produced.
+func (h *Harness) AdvanceEpoch(identifier string) {
+	h.t.Helper()
+
+	h.Relay()
+
+	info := h.App.EpochsKeeper.GetEpochInfo(h.Ctx(), identifier)
+	require.NotEmpty(h.t, info.Identifier, "epoch %s not found", identifier)
+	// epochs only end on blocks strictly after their end time
+	epochEnd := info.CurrentEpochStartTime.Add(info.Duration)
+	if !h.Coordinator.CurrentTime.After(epochEnd) {
+		h.Coordinator.IncrementTimeBy(epochEnd.Sub(h.Coordinator.CurrentTime) + ibctesting.TimeIncrement)
+	}
+
+	h.NextBlock()
+	h.updateClients()
+	require.Equal(
+		h.t, info.CurrentEpoch+1,
+		h.App.EpochsKeeper.GetEpochInfo(h.Ctx(), identifier).CurrentEpoch,
+		"epoch %s did not advance", identifier,
+	)
+}
+
+// AdvanceEpochs advances the epoch with the given identifier n times.
+func (h *Harness) AdvanceEpochs(identifier string, n int) {
+	for i := 0; i < n; i++ {
+		h.AdvanceEpoch(identifier)
+	}
+}
+
+// Relay relays pending packets in both directions and answers pending interchain queries until
+// neither chain has anything left to relay.
+func (h *Harness) Relay() {
+	h.t.Helper()
+
+	for round := 0; round < maxRelayRounds; round++ {
+		relayed := h.relayPackets()
+		answered := h.relayQueries()
+		if !relayed && !answered {
+			return
+		}
+	}
+
+	h.t.Fatalf("relaying did not settle after %d rounds", maxRelayRounds)
+}
+
+// AllocateHostRewards distributes the amount as staking rewards across the host validators. The
+// ibctesting chains commit blocks without votes, so the host distribution module never allocates
+// block rewards on its own.
+func (h *Harness) AllocateHostRewards(amount math.Int) {
+	h.t.Helper()
+
+	ctx := h.HostCtx()
+	validators := h.HostApp.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	share := amount.QuoRaw(int64(len(validators)))
+	total := sdk.NewCoins(sdk.NewCoin(HostDenom, share.MulRaw(int64(len(validators)))))
+	require.NoError(h.t, h.HostApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, total))
+	require.NoError(h.t, h.HostApp.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, distrtypes.ModuleName, total))
+	for _, validator := range validators {
+		h.HostApp.DistrKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoins(sdk.NewDecCoin(HostDenom, share)))
+	}
+	h.Coordinator.CommitBlock(h.Host)
+}
+
+// HostValidators returns the operator addresses of the host chain validator set.
+func (h *Harness) HostValidators() []string {
+	validators := make([]string, 0, len(h.Host.Vals.Validators))
+	for _, validator := range h.Host.Vals.Validators {
+		validators = append(validators, sdk.MustBech32ifyAddressBytes(app.Bech32PrefixValAddr, validator.Address))
+	}
+
+	return validators
+}
+
+// HostDelegation returns the amount of host tokens the delegation account has bonded to a validator.
+func (h *Harness) HostDelegation(validator string) math.Int {
+	delegator, err := sdk.AccAddressFromBech32(h.HostChain().DelegationAccount.Address)
+	require.NoError(h.t, err)
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	require.NoError(h.t, err)
+
+	ctx := h.HostCtx()
+	delegation, found := h.HostApp.StakingKeeper.GetDelegation(ctx, delegator, valAddr)
+	if !found {
+		return math.ZeroInt()
+	}
+	val, found := h.HostApp.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(h.t, found)
+
+	return val.TokensFromShares(delegation.Shares).TruncateInt()
+}
+
+// updateClients keeps both light clients within their trusting period across clock jumps.
+func (h *Harness) updateClients() {
+	require.NoError(h.t, h.TransferPath.EndpointA.UpdateClient())
+	require.NoError(h.t, h.TransferPath.EndpointB.UpdateClient())
+}
+
+func (h *Harness) deliver(msgs ...sdk.Msg) (*sdk.Result, error) {
+	res, err := h.Persistence.SendMsgs(msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	packets, err := parsePackets(res.Events)
+	if err != nil {
+		return nil, err
+	}
+	h.recorder.pending = append(h.recorder.pending, packets...)
+
+	return res, nil
+}
+
+// resetEpochs restarts every epoch at the current block time, so the first epochs don't fire
+// back to back from the genesis start time.
+func (h *Harness) resetEpochs() {
+	ctx := h.Ctx()
+	for _, epoch := range h.App.EpochsKeeper.AllEpochInfos(ctx) {
+		epoch.StartTime = ctx.BlockTime()
+		epoch.CurrentEpoch = 1
+		epoch.CurrentEpochStartTime = ctx.BlockTime()
+		epoch.CurrentEpochStartHeight = ctx.BlockHeight()
+		h.App.EpochsKeeper.DeleteEpochInfo(ctx, epoch.Identifier)
+		require.NoError(h.t, h.App.EpochsKeeper.AddEpochInfo(ctx, epoch))
+	}
+	h.Coordinator.CommitBlock(h.Persistence)
+}
+
+func (h *Harness) setHostUnbondingTime() {
+	ctx := h.HostCtx()
+	params := h.HostApp.StakingKeeper.GetParams(ctx)
+	params.UnbondingTime = HostUnbondingTime
+	require.NoError(h.t, h.HostApp.StakingKeeper.SetParams(ctx, params))
+	h.Coordinator.CommitBlock(h.Host)
+}
+
+// registerHostChain registers the host chain on Persistence through the admin account and opens
+// the delegation and rewards interchain account channels.
+func (h *Harness) registerHostChain() {
+	ctx := h.Ctx()
+	params := h.App.LiquidStakeIBCKeeper.GetParams(ctx)
+	params.AdminAddress = h.Persistence.SenderAccount.GetAddress().String()
+	h.App.LiquidStakeIBCKeeper.SetParams(ctx, params)
+	h.Coordinator.CommitBlock(h.Persistence)
+
+	channelSequence := h.App.IBCKeeper.ChannelKeeper.GetNextChannelSequence(h.Ctx())
+	_, err := h.deliver(types.NewMsgRegisterHostChain(
+		h.TransferPath.EndpointA.ConnectionID,
+		h.TransferPath.EndpointA.ChannelID,
+		h.TransferPath.EndpointA.ChannelConfig.PortID,
+		"0.01",
+		"0.02",
+		"0.03",
+		"0.03",
+		HostDenom,
+		math.NewInt(5),
+		UnbondingFactor,
+		params.AdminAddress,
+		AutocompoundFactor,
+	))
+	require.NoError(h.t, err)
+
+	h.DelegationPath = h.newICAPath(types.DefaultDelegateAccountPortOwner(h.Host.ChainID), channelSequence)
+	h.RewardsPath = h.newICAPath(types.DefaultRewardsAccountPortOwner(h.Host.ChainID), channelSequence+1)
+	for _, path := range []*ibctesting.Path{h.DelegationPath, h.RewardsPath} {
+		require.NoError(h.t, path.EndpointB.ChanOpenTry())
+		require.NoError(h.t, path.EndpointA.ChanOpenAck())
+		require.NoError(h.t, path.EndpointB.ChanOpenConfirm())
+	}
+
+	// the acks issue the interchain account balance queries
+	h.Relay()
+}
+
+// activateHostChain adds every host validator with an equal weight, refreshes them through an
+// interchain query, sets the delegation account withdraw address and activates the host chain.
+func (h *Harness) activateHostChain() {
+	hc := h.HostChain()
+	validators := h.HostValidators()
+	weight := sdk.OneDec().QuoInt64(int64(len(validators)))
+
+	updates := make([]*types.KVUpdate, 0)
+	for _, validator := range validators {
+		value, err := json.Marshal(&types.Validator{
+			OperatorAddress: validator,
+			Status:          stakingtypes.Bonded.String(),
+			Weight:          weight,
+			DelegatedAmount: math.ZeroInt(),
+			ExchangeRate:    sdk.OneDec(),
+			Delegable:       true,
+		})
+		require.NoError(h.t, err)
+		updates = append(updates, &types.KVUpdate{Key: types.KeyAddValidator, Value: string(value)})
+	}
+	for _, validator := range validators {
+		updates = append(updates, &types.KVUpdate{Key: types.KeyValidatorUpdate, Value: validator})
+	}
+	updates = append(updates,
+		&types.KVUpdate{Key: types.KeySetWithdrawAddress, Value: ""},
+		&types.KVUpdate{Key: types.KeyActive, Value: "true"},
+	)
+
+	h.Deliver(types.NewMsgUpdateHostChain(hc.ChainId, h.Persistence.SenderAccount.GetAddress().String(), updates))
+}
+
+func (h *Harness) newICAPath(owner string, channelSequence uint64) *ibctesting.Path {
+	portID, err := icatypes.NewControllerPortID(owner)
+	require.NoError(h.t, err)
+
+	path := ibctesting.NewPath(h.Persistence, h.Host)
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.Order = channeltypes.ORDERED
+		endpoint.ChannelConfig.PortID = icatypes.HostPortID
+	}
+	path.EndpointA.ClientID = h.TransferPath.EndpointA.ClientID
+	path.EndpointB.ClientID = h.TransferPath.EndpointB.ClientID
+	path.EndpointA.ConnectionID = h.TransferPath.EndpointA.ConnectionID
+	path.EndpointB.ConnectionID = h.TransferPath.EndpointB.ConnectionID
+	path.EndpointA.ChannelConfig.PortID = portID
+	path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+	path.EndpointA.ChannelConfig.Version = path.EndpointA.GetChannel().Version
+	path.EndpointB.ChannelConfig.Version = path.EndpointA.ChannelConfig.Version
+
+	return path
+}
+
+func newTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = ibctransfertypes.Version
+	path.EndpointB.ChannelConfig.Version = ibctransfertypes.Version
+
+	return path
+}
+
+// pathForPacket returns the path whose endpoint on the sending chain matches the packet source.
+func (h *Harness) pathForPacket(packet channeltypes.Packet, fromPersistence bool) *ibctesting.Path {
+	for _, path := range []*ibctesting.Path{h.TransferPath, h.DelegationPath, h.RewardsPath} {
+		if path == nil {
+			continue
+		}
+
+		source := path.EndpointB
+		if fromPersistence {
+			source = path.EndpointA
+		}
+		if source.ChannelConfig.PortID == packet.SourcePort && source.ChannelID == packet.SourceChannel {
+			return path
+		}
+	}
+
+	h.t.Fatalf("no path for packet %s/%s", packet.SourcePort, packet.SourceChannel)
+
+	return nil
+}
+
+// relayPackets relays every pending packet in sequence order, reporting whether any was relayed.
+func (h *Harness) relayPackets() bool {
+	relayed := false
+
+	// packets sent from the begin blocker of the open block need it committed before relaying
+	if len(h.recorder.beginBlock) > 0 {
+		h.Coordinator.CommitBlock(h.Persistence)
+	}
+
+	for len(h.recorder.pending) > 0 || len(h.hostPackets) > 0 {
+		packets := h.recorder.pending
+		h.recorder.pending = nil
+		sortPackets(packets)
+		for _, packet := range packets {
+			relayed = h.relayPacket(packet, h.pathForPacket(packet, true), true) || relayed
+		}
+
+		packets = h.hostPackets
+		h.hostPackets = nil
+		sortPackets(packets)
+		for _, packet := range packets {
+			relayed = h.relayPacket(packet, h.pathForPacket(packet, false), false) || relayed
+		}
+	}
+
+	return relayed
+}
+
+// relayPacket receives the packet on the counterparty and acknowledges it back on the source
+// chain, recording any packets either side sends while processing it.
+func (h *Harness) relayPacket(packet channeltypes.Packet, path *ibctesting.Path, fromPersistence bool) bool {
+	source, destination := path.EndpointB, path.EndpointA
+	if fromPersistence {
+		source, destination = path.EndpointA, path.EndpointB
+	}
+
+	// skip packets whose commitment is gone, they were either relayed or never committed
+	commitment := source.Chain.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(
+		source.Chain.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence,
+	)
+	if len(commitment) == 0 {
+		return false
+	}
+
+	require.NoError(h.t, destination.UpdateClient())
+	res, err := destination.RecvPacketWithResult(packet)
+	require.NoError(h.t, err)
+
+	packets, err := parsePackets(res.Events)
+	require.NoError(h.t, err)
+	if fromPersistence {
+		h.hostPackets = append(h.hostPackets, packets...)
+	} else {
+		h.recorder.pending = append(h.recorder.pending, packets...)
+	}
+
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	require.NoError(h.t, err)
+
+	proof, proofHeight := destination.QueryProof(
+		host.PacketAcknowledgementKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence),
+	)
+	ackMsg := channeltypes.NewMsgAcknowledgement(
+		packet, ack, proof, proofHeight, source.Chain.SenderAccount.GetAddress().String(),
+	)
+	if fromPersistence {
+		_, err = h.deliver(ackMsg)
+	} else {
+		_, err = source.Chain.SendMsgs(ackMsg)
+	}
+	require.NoError(h.t, err)
+
+	return true
+}
+
+// relayQueries answers the pending interchain queries with proofs from the latest committed host
+// chain state, reporting whether any query was answered.
+func (h *Harness) relayQueries() bool {
+	queries := h.App.InterchainQueryKeeper.AllQueries(h.Ctx())
+	if len(queries) == 0 {
+		return false
+	}
+
+	// make sure Persistence has the consensus state the proofs are verified against
+	h.Coordinator.CommitBlock(h.Host)
+	require.NoError(h.t, h.TransferPath.EndpointA.UpdateClient())
+
+	answered := false
+	for _, query := range queries {
+		if query.ChainId != h.Host.ChainID {
+			continue
+		}
+
+		res := h.Host.App.Query(abci.RequestQuery{
+			Path:   query.QueryType,
+			Data:   query.Request,
+			Height: h.Host.App.LastBlockHeight() - 1,
+			Prove:  true,
+		})
+		require.True(h.t, res.IsOK(), "host query %s failed: %s", query.Id, res.Log)
+
+		_, err := h.deliver(&icqtypes.MsgSubmitQueryResponse{
+			ChainId:     query.ChainId,
+			QueryId:     query.Id,
+			Result:      res.Value,
+			ProofOps:    res.ProofOps,
+			Height:      res.Height,
+			FromAddress: h.Persistence.SenderAccount.GetAddress().String(),
+		})
+		require.NoError(h.t, err, "could not submit query %s response", query.Id)
+		answered = true
+	}
+
+	return answered
+}
+
+func sortPackets(packets []channeltypes.Packet) {
+	sort.SliceStable(packets, func(i, j int) bool {
+		return packets[i].Sequence < packets[j].Sequence
+	})
+}
+
+// packetRecorder is a streaming service that keeps the packets sent from the Persistence begin and
+// end blockers. The ibctesting chains re-run BeginBlock on the same block state whenever the clock
+// moves, so the packets of every run are held until the block is committed.
+type packetRecorder struct {
+	beginBlock []channeltypes.Packet
+	pending    []channeltypes.Packet
+}
+
+var _ baseapp.StreamingService = &packetRecorder{}
+
+func (r *packetRecorder) Stream(_ *sync.WaitGroup) error { return nil }
+
+func (r *packetRecorder) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+func (r *packetRecorder) Close() error { return nil }
+
+func (r *packetRecorder) ListenBeginBlock(_ context.Context, _ abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	packets, err := parsePackets(res.Events)
+	r.beginBlock = append(r.beginBlock, packets...)
+
+	return err
+}
+
+func (r *packetRecorder) ListenEndBlock(_ context.Context, _ abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	packets, err := parsePackets(res.Events)
+	if err != nil {
+		return err
+	}
+
+	r.pending = append(r.pending, r.beginBlock...)
+	r.pending = append(r.pending, packets...)
+	r.beginBlock = nil
+
+	return nil
+}
+
+func (r *packetRecorder) ListenDeliverTx(_ context.Context, _ abci.RequestDeliverTx, _ abci.ResponseDeliverTx) error {
+	return nil
+}
+
+func (r *packetRecorder) ListenCommit(_ context.Context, _ abci.ResponseCommit) error {
+	return nil
+}
+
+// parsePackets returns every packet sent in the events.
+func parsePackets(events []abci.Event) ([]channeltypes.Packet, error) {
+	packets := make([]channeltypes.Packet, 0)
+	for _, event := range events {
+		if event.Type != channeltypes.EventTypeSendPacket {
+			continue
+		}
+
+		packet := channeltypes.Packet{}
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case channeltypes.AttributeKeyData: //nolint:staticcheck // DEPRECATED
+				packet.Data = []byte(attr.Value)
+			case channeltypes.AttributeKeySequence:
+				sequence, err := strconv.ParseUint(attr.Value, 10, 64)
+				if err != nil {
+					return nil, err
+				}
+				packet.Sequence = sequence
+			case channeltypes.AttributeKeySrcPort:
+				packet.SourcePort = attr.Value
+			case channeltypes.AttributeKeySrcChannel:
+				packet.SourceChannel = attr.Value
+			case channeltypes.AttributeKeyDstPort:
+				packet.DestinationPort = attr.Value
+			case channeltypes.AttributeKeyDstChannel:
+				packet.DestinationChannel = attr.Value
+			case channeltypes.AttributeKeyTimeoutHeight:
+				height, err := clienttypes.ParseHeight(attr.Value)
+				if err != nil {
+					return nil, err
+				}
+				packet.TimeoutHeight = height
+			case channeltypes.AttributeKeyTimeoutTimestamp:
+				timestamp, err := strconv.ParseUint(attr.Value, 10, 64)
+				if err != nil {
+					return nil, err
+				}
+				packet.TimeoutTimestamp = timestamp
+			}
+		}
+
+		if packet.SourcePort == "" || packet.SourceChannel == "" {
+			return nil, fmt.Errorf("malformed %s event", event.Type)
+		}
+		packets = append(packets, packet)
+	}
+
+	return packets, nil
+}
@@ -0,0 +1,103 @@
+package integration_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/tests/integration"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func TestHarnessSetup(t *testing.T) {
+	h := integration.NewHarness(t)
+
+	hc := h.HostChain()
+	require.True(t, hc.Active)
+	require.NotEmpty(t, hc.DelegationAccount.Address)
+	require.NotEmpty(t, hc.RewardsAccount.Address)
+	require.Equal(t, types.ICAAccount_ICA_CHANNEL_CREATED, hc.DelegationAccount.ChannelState)
+	require.Equal(t, types.ICAAccount_ICA_CHANNEL_CREATED, hc.RewardsAccount.ChannelState)
+	require.Len(t, hc.Validators, len(h.HostValidators()))
+	for _, validator := range hc.Validators {
+		require.True(t, validator.Delegable)
+	}
+	require.Empty(t, h.App.InterchainQueryKeeper.AllQueries(h.Ctx()))
+
+	h.TransferToPersistence(sdk.NewInt64Coin(integration.HostDenom, 1_000_000))
+	balance := h.App.BankKeeper.GetBalance(h.Ctx(), h.Persistence.SenderAccount.GetAddress(), hc.IBCDenom())
+	require.Equal(t, math.NewInt(1_000_000), balance.Amount)
+}
+
+func TestStakeDelegateRewardsUnstakeClaim(t *testing.T) {
+	h := integration.NewHarness(t)
+	hc := h.HostChain()
+	user := h.Persistence.SenderAccount.GetAddress()
+	stakeAmount := math.NewInt(10_000_000)
+	h.TransferToPersistence(sdk.NewCoin(integration.HostDenom, stakeAmount))
+
+	// stake
+	h.Deliver(types.NewMsgLiquidStake(sdk.NewCoin(hc.IBCDenom(), stakeAmount), user))
+	fee := hc.Params.DepositFee.MulInt(stakeAmount).TruncateInt()
+	minted := h.App.BankKeeper.GetBalance(h.Ctx(), user, hc.MintDenom())
+	require.Equal(t, stakeAmount.Sub(fee), minted.Amount)
+
+	// delegate: the deposit is transferred to the delegation account at the end of the epoch and
+	// delegated on the following block
+	h.AdvanceEpoch(types.DelegationEpoch)
+
+	hc = h.HostChain()
+	delegated := math.ZeroInt()
+	for _, validator := range hc.Validators {
+		require.Equal(t, h.HostDelegation(validator.OperatorAddress), validator.DelegatedAmount)
+		delegated = delegated.Add(validator.DelegatedAmount)
+	}
+	require.Equal(t, stakeAmount, delegated)
+	require.Equal(t, sdk.OneDec(), hc.CValue)
+
+	// rewards: the next epoch withdraws the delegation rewards into the rewards account, which are
+	// transferred back to Persistence and delegated again, raising the value of the minted tokens
+	h.AllocateHostRewards(math.NewInt(10_000))
+	h.AdvanceEpoch(types.RewardsEpochIdentifier)
+	h.AdvanceEpoch(types.DelegationEpoch)
+
+	hc = h.HostChain()
+	delegated = hc.GetHostChainTotalDelegations()
+	require.True(t, delegated.GT(stakeAmount), "rewards were not restaked")
+	h.App.LiquidStakeIBCKeeper.UpdateCValues(h.Ctx())
+	hc = h.HostChain()
+	require.True(t, hc.CValue.LT(sdk.OneDec()), "c value %s did not decrease", hc.CValue)
+
+	// unstake
+	h.Deliver(types.NewMsgLiquidUnstake(minted, user))
+	epoch := h.App.EpochsKeeper.GetEpochInfo(h.Ctx(), types.UndelegationEpoch).CurrentEpoch
+	unbondingEpoch := types.CurrentUnbondingEpoch(hc.UnbondingFactor, epoch)
+	userUnbonding, found := h.App.LiquidStakeIBCKeeper.GetUserUnbonding(h.Ctx(), hc.ChainId, user.String(), unbondingEpoch)
+	require.True(t, found)
+	require.True(t, userUnbonding.UnbondAmount.Amount.IsPositive())
+	h.Deliver(types.NewMsgSetAutoClaim(user, hc.ChainId, unbondingEpoch, true))
+
+	for h.App.EpochsKeeper.GetEpochInfo(h.Ctx(), types.UndelegationEpoch).CurrentEpoch <= unbondingEpoch {
+		h.AdvanceEpoch(types.UndelegationEpoch)
+	}
+	unbonding, found := h.App.LiquidStakeIBCKeeper.GetUnbonding(h.Ctx(), hc.ChainId, unbondingEpoch)
+	require.True(t, found)
+	require.Equal(t, types.Unbonding_UNBONDING_MATURING, unbonding.State)
+	require.True(t, h.HostChain().GetHostChainTotalDelegations().LT(delegated), "delegations were not undelegated")
+
+	// claim: once the host unbonding matures the tokens are transferred back and claimed for the opted in user
+	balanceBefore := h.App.BankKeeper.GetBalance(h.Ctx(), user, hc.IBCDenom())
+	for h.Ctx().BlockTime().Before(unbonding.MatureTime) {
+		h.AdvanceEpoch(types.UndelegationEpoch)
+	}
+	h.NextBlock()
+
+	_, found = h.App.LiquidStakeIBCKeeper.GetUnbonding(h.Ctx(), hc.ChainId, unbondingEpoch)
+	require.False(t, found)
+	_, found = h.App.LiquidStakeIBCKeeper.GetUserUnbonding(h.Ctx(), hc.ChainId, user.String(), unbondingEpoch)
+	require.False(t, found)
+	balance := h.App.BankKeeper.GetBalance(h.Ctx(), user, hc.IBCDenom())
+	require.Equal(t, userUnbonding.UnbondAmount.Amount, balance.Amount.Sub(balanceBefore.Amount))
+}
@@ -10,10 +10,25 @@ import (
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstake/types"
 )
 
-// BeginBlocker updates liquid validator set changes for the current block
+// BeginBlocker updates liquid validator set changes for the current block and releases the vested stkXPRT
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
+	// start the block without a cached net amount state, so the rewards and slashes of the previous begin blockers count
+	k.InvalidateNetAmountStateCache(ctx)
+
 	// return value of UpdateLiquidValidatorSet is useful only in testing
 	_ = k.UpdateLiquidValidatorSet(ctx)
+
+	k.ReleaseVestingLocks(ctx)
+}
+
+// EndBlocker executes the due StakeToLP schedules
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	// unbondings matured by the staking end blocker are not reflected by the net amount state cache
+	k.InvalidateNetAmountStateCache(ctx)
+
+	k.ExecuteStakeToLPSchedules(ctx)
 }
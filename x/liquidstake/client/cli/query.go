@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -25,8 +26,14 @@ func GetQueryCmd() *cobra.Command {
 
 	liquidValidatorQueryCmd.AddCommand(
 		GetCmdQueryParams(),
+		GetCmdQueryParamsDiff(),
 		GetCmdQueryLiquidValidators(),
 		GetCmdQueryStates(),
+		GetCmdQueryProxyDelegations(),
+		GetCmdQueryWhitelistRotation(),
+		GetCmdQueryStakeToLPSchedules(),
+		GetCmdQueryRedelegationFailures(),
+		GetCmdQueryVestingLock(),
 	)
 
 	return liquidValidatorQueryCmd
@@ -71,6 +78,46 @@ $ %s query %s params
 	return cmd
 }
 
+// GetCmdQueryParamsDiff implements the params diff query command.
+func GetCmdQueryParamsDiff() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params-diff",
+		Args:  cobra.NoArgs,
+		Short: "Query the liquidstake parameters which differ from their defaults",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the liquidstake parameters alongside the default parameters and the list of
+parameters whose value differs from the default one.
+
+Example:
+$ %s query %s params-diff
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ParamsDiff(
+				cmd.Context(),
+				&types.QueryParamsDiffRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryLiquidValidators implements the query liquidValidators command.
 func GetCmdQueryLiquidValidators() *cobra.Command {
 	cmd := &cobra.Command{
@@ -153,3 +200,208 @@ $ %s query %s states
 
 	return cmd
 }
+
+// GetCmdQueryProxyDelegations implements the query proxy delegations command.
+func GetCmdQueryProxyDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proxy-delegations",
+		Args:  cobra.NoArgs,
+		Short: "Query the proxy account delegations to the whitelisted validators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the proxy account delegation shares, tokens, pending rewards and deviation from the target weight for every whitelisted validator.
+
+Example:
+$ %s query %s proxy-delegations
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProxyDelegations(
+				cmd.Context(),
+				&types.QueryProxyDelegationsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryWhitelistRotation implements the query whitelist rotation command.
+func GetCmdQueryWhitelistRotation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "whitelist-rotation",
+		Args:  cobra.NoArgs,
+		Short: "Query the scheduled whitelist rotation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the scheduled whitelist rotation, if any.
+
+Example:
+$ %s query %s whitelist-rotation
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.WhitelistRotation(
+				cmd.Context(),
+				&types.QueryWhitelistRotationRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryStakeToLPSchedules implements the stake to LP schedules query command.
+func GetCmdQueryStakeToLPSchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stake-to-lp-schedules [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the stake to LP schedules of a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the recurring stake to LP executions scheduled by a delegator.
+
+Example:
+$ %s query %s stake-to-lp-schedules persistence1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StakeToLPSchedules(
+				cmd.Context(),
+				&types.QueryStakeToLPSchedulesRequest{DelegatorAddress: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRedelegationFailures implements the redelegation failures query command.
+func GetCmdQueryRedelegationFailures() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redelegation-failures [epoch]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the rebalancing redelegations which failed during an epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the source validator, destination validator, amount and error of each rebalancing redelegation which failed during the given %s epoch.
+
+Example:
+$ %s query %s redelegation-failures 12
+`,
+				types.RedelegationFailureEpoch, version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epoch, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RedelegationFailures(
+				cmd.Context(),
+				&types.QueryRedelegationFailuresRequest{Epoch: epoch},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryVestingLock implements the vesting lock query command.
+func GetCmdQueryVestingLock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting-lock [vesting-account-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the stkXPRT held for the locked coins a vesting account liquid staked",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the locked coins a vesting account liquid staked and the stkXPRT held for them until they vest.
+
+Example:
+$ %s query %s vesting-lock persistence1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VestingLock(
+				cmd.Context(),
+				&types.QueryVestingLockRequest{Address: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
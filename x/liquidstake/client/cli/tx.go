@@ -7,8 +7,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -19,6 +22,11 @@ import (
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstake/types"
 )
 
+const (
+	FlagMinOut   = "min-out"
+	FlagDeadline = "deadline"
+)
+
 // GetTxCmd returns a root CLI command handler for all x/liquidstake transaction commands.
 func GetTxCmd() *cobra.Command {
 	liquidstakeTxCmd := &cobra.Command{
@@ -35,6 +43,10 @@ func GetTxCmd() *cobra.Command {
 		NewStakeToLPCmd(),
 		NewLiquidUnstakeCmd(),
 		NewUpdateParamsCmd(),
+		NewScheduleWhitelistRotationCmd(),
+		NewScheduleStakeToLPCmd(),
+		NewCancelStakeToLPScheduleCmd(),
+		NewRepairProxyWithdrawAddressCmd(),
 	)
 
 	return liquidstakeTxCmd
@@ -51,8 +63,10 @@ func NewLiquidStakeCmd() *cobra.Command {
 			
 Example:
 $ %s tx %s liquid-stake 1000uxprt --from mykey
+$ %s tx %s liquid-stake 1000uxprt --min-out 990 --deadline 2024-01-01T00:00:00Z --from mykey
 `,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -69,11 +83,17 @@ $ %s tx %s liquid-stake 1000uxprt --from mykey
 			}
 
 			msg := types.NewMsgLiquidStake(liquidStaker, stakingCoin)
+			msg.MinStkOut, msg.Deadline, err = parseMinOutAndDeadline(cmd)
+			if err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagMinOut, "", "Minimum amount of stkXPRT to be minted")
+	cmd.Flags().String(FlagDeadline, "", "RFC3339 block time after which the liquid stake fails")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -147,8 +167,10 @@ func NewLiquidUnstakeCmd() *cobra.Command {
 			
 Example:
 $ %s tx %s liquid-unstake 500stk/uxprt --from mykey
+$ %s tx %s liquid-unstake 500stk/uxprt --min-out 505 --deadline 2024-01-01T00:00:00Z --from mykey
 `,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -165,11 +187,17 @@ $ %s tx %s liquid-unstake 500stk/uxprt --from mykey
 			}
 
 			msg := types.NewMsgLiquidUnstake(liquidStaker, unstakingCoin)
+			msg.MinOut, msg.Deadline, err = parseMinOutAndDeadline(cmd)
+			if err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagMinOut, "", "Minimum amount of XPRT to be unbonded")
+	cmd.Flags().String(FlagDeadline, "", "RFC3339 block time after which the liquid unstake fails")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -235,3 +263,224 @@ Example params.json
 
 	return cmd
 }
+
+func NewScheduleWhitelistRotationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-whitelist-rotation [whitelisted-validators.json] [start-epoch] [transition-epochs]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Schedule a whitelist rotation of liquidstake module.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`schedule-whitelist-rotation whitelisted-validators-file start-epoch transition-epochs.
+
+The target weights move from the current whitelist to the new one in equal steps at the end of each
+epoch, starting with start-epoch and finishing after transition-epochs epochs.
+
+Example:
+$ %s tx %s schedule-whitelist-rotation ~/whitelisted_validators.json 120 5 --from mykey
+
+Example whitelisted_validators.json
+[
+  {
+    "validator_address": "persistencevaloper1hcqg5wj9t42zawqkqucs7la85ffyv08lmnhye9",
+    "target_weight": "10"
+  }
+]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var whitelistedValidators []types.WhitelistedValidator
+
+			whitelistInFile, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			err = json.Unmarshal(whitelistInFile, &whitelistedValidators)
+			if err != nil {
+				return err
+			}
+
+			startEpoch, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			transitionEpochs, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			authority := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgScheduleWhitelistRotation(authority, whitelistedValidators, startEpoch, transitionEpochs)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewScheduleStakeToLPCmd implements the recurring stake to LP command handler.
+func NewScheduleStakeToLPCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "schedule-stake-to-lp [validator-addr] [staked_amount] [liquid_amount] [interval]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Schedule a recurring stake to LP executed by the module",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Schedule a stake to LP executed by the module on your behalf every interval.
+The %s module account must be granted an authorization for %s first, e.g. with "%s tx authz grant".
+
+Example:
+$ %s tx %s schedule-stake-to-lp %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 1000uxprt 0uxprt 24h --from mykey
+`,
+				types.ModuleName, sdk.MsgTypeURL(&types.MsgStakeToLP{}), version.AppName,
+				version.AppName, types.ModuleName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			stakedCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			liquidCoin, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			interval, err := time.ParseDuration(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgScheduleStakeToLP(clientCtx.GetFromAddress(), valAddr, stakedCoin, liquidCoin, interval)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCancelStakeToLPScheduleCmd implements the stake to LP schedule cancellation command handler.
+func NewCancelStakeToLPScheduleCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "cancel-stake-to-lp-schedule [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a recurring stake to LP",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel the recurring stake to LP scheduled for the validator.
+
+Example:
+$ %s tx %s cancel-stake-to-lp-schedule %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`,
+				version.AppName, types.ModuleName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelStakeToLPSchedule(clientCtx.GetFromAddress(), valAddr)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRepairProxyWithdrawAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "repair-proxy-withdraw-address",
+		Args:  cobra.NoArgs,
+		Short: "Reset the distribution withdraw address of the proxy account to the proxy account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Reset the distribution withdraw address of the proxy account, so that its staking rewards are autocompounded again.
+
+Example:
+$ %s tx %s repair-proxy-withdraw-address --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRepairProxyWithdrawAddress(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseMinOutAndDeadline reads the optional min-out and deadline flags.
+func parseMinOutAndDeadline(cmd *cobra.Command) (minOut math.Int, deadline *time.Time, err error) {
+	minOutStr, err := cmd.Flags().GetString(FlagMinOut)
+	if err != nil {
+		return minOut, nil, err
+	}
+	if minOutStr != "" {
+		var ok bool
+		minOut, ok = math.NewIntFromString(minOutStr)
+		if !ok {
+			return minOut, nil, fmt.Errorf("invalid %s: %s", FlagMinOut, minOutStr)
+		}
+	}
+
+	deadlineStr, err := cmd.Flags().GetString(FlagDeadline)
+	if err != nil {
+		return minOut, nil, err
+	}
+	if deadlineStr != "" {
+		t, err := time.Parse(time.RFC3339, deadlineStr)
+		if err != nil {
+			return minOut, nil, fmt.Errorf("invalid %s: %w", FlagDeadline, err)
+		}
+		deadline = &t
+	}
+
+	return minOut, deadline, nil
+}
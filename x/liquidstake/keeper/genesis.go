@@ -18,6 +18,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	if genState.Params.WhitelistedValidators == nil || len(genState.Params.WhitelistedValidators) == 0 {
 		genState.Params.WhitelistedValidators = []types.WhitelistedValidator{}
 	}
+	if genState.Params.AutocompoundFeeTiers == nil {
+		genState.Params.AutocompoundFeeTiers = []types.AutocompoundFeeTier{}
+	}
 
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
@@ -27,6 +30,26 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		k.SetLiquidValidator(ctx, lv)
 	}
 
+	if genState.WhitelistRotation != nil {
+		k.SetWhitelistRotation(ctx, *genState.WhitelistRotation)
+	}
+
+	for _, schedule := range genState.StakeToLPSchedules {
+		k.SetStakeToLPSchedule(ctx, schedule)
+	}
+
+	for i, failure := range genState.RedelegationFailures {
+		k.SetRedelegationFailure(ctx, failure, i)
+	}
+
+	for _, lock := range genState.VestingLocks {
+		k.SetVestingLock(ctx, lock)
+	}
+
+	if err := k.ValidateProxyWithdrawAddress(ctx); err != nil {
+		panic(err)
+	}
+
 	moduleAcc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	if moduleAcc == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
@@ -41,7 +64,18 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	if params.WhitelistedValidators == nil || len(params.WhitelistedValidators) == 0 {
 		params.WhitelistedValidators = []types.WhitelistedValidator{}
 	}
+	if params.AutocompoundFeeTiers == nil {
+		params.AutocompoundFeeTiers = []types.AutocompoundFeeTier{}
+	}
 
 	liquidValidators := k.GetAllLiquidValidators(ctx)
-	return types.NewGenesisState(params, liquidValidators)
+	genState := types.NewGenesisState(params, liquidValidators)
+	if rotation, found := k.GetWhitelistRotation(ctx); found {
+		genState.WhitelistRotation = &rotation
+	}
+	genState.StakeToLPSchedules = k.GetAllStakeToLPSchedules(ctx)
+	genState.RedelegationFailures = k.GetAllRedelegationFailures(ctx)
+	genState.VestingLocks = k.GetAllVestingLocks(ctx)
+
+	return genState
 }
@@ -14,6 +14,15 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 	s.Require().Equal(genState, *got)
 }
 
+func (s *KeeperTestSuite) TestInitGenesisAlteredProxyWithdrawAddress() {
+	genState := *types.DefaultGenesisState()
+	s.app.DistrKeeper.SetDelegatorWithdrawAddr(s.ctx, types.LiquidStakeProxyAcc, s.delAddrs[0])
+	s.Require().Panics(func() {
+		s.keeper.InitGenesis(s.ctx, genState)
+	})
+	s.app.DistrKeeper.SetDelegatorWithdrawAddr(s.ctx, types.LiquidStakeProxyAcc, types.LiquidStakeProxyAcc)
+}
+
 func (s *KeeperTestSuite) TestImportExportGenesis() {
 	k, ctx := s.keeper, s.ctx
 	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
@@ -26,6 +26,24 @@ func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// ParamsDiff queries the parameters of the liquidstake module which deviate from the default ones.
+func (k Querier) ParamsDiff(c context.Context, req *types.QueryParamsDiffRequest) (*types.QueryParamsDiffResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	params := k.GetParams(ctx)
+	defaultParams := types.DefaultParams()
+	diff, err := params.Diff(defaultParams)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryParamsDiffResponse{Params: params, DefaultParams: defaultParams, Diff: diff}, nil
+}
+
 // LiquidValidators queries all liquid validators.
 func (k Querier) LiquidValidators(c context.Context, req *types.QueryLiquidValidatorsRequest) (*types.QueryLiquidValidatorsResponse, error) {
 	if req == nil {
@@ -47,3 +65,82 @@ func (k Querier) States(c context.Context, req *types.QueryStatesRequest) (*type
 
 	return &types.QueryStatesResponse{NetAmountState: k.GetNetAmountState(ctx)}, nil
 }
+
+// ProxyDelegations queries the proxy account delegations to the whitelisted validators.
+func (k Querier) ProxyDelegations(c context.Context, req *types.QueryProxyDelegationsRequest) (*types.QueryProxyDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryProxyDelegationsResponse{ProxyDelegations: k.GetProxyDelegations(ctx, types.LiquidStakeProxyAcc)}, nil
+}
+
+// WhitelistRotation queries the scheduled whitelist rotation.
+func (k Querier) WhitelistRotation(c context.Context, req *types.QueryWhitelistRotationRequest) (*types.QueryWhitelistRotationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rotation, found := k.GetWhitelistRotation(ctx)
+	if !found {
+		return &types.QueryWhitelistRotationResponse{}, nil
+	}
+
+	return &types.QueryWhitelistRotationResponse{WhitelistRotation: &rotation}, nil
+}
+
+// StakeToLPSchedules queries the StakeToLP schedules of a delegator.
+func (k Querier) StakeToLPSchedules(c context.Context, req *types.QueryStakeToLPSchedulesRequest) (*types.QueryStakeToLPSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	delegator, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryStakeToLPSchedulesResponse{Schedules: k.GetStakeToLPSchedulesByDelegator(ctx, delegator)}, nil
+}
+
+// RedelegationFailures queries the rebalancing redelegations which failed during an epoch.
+func (k Querier) RedelegationFailures(c context.Context, req *types.QueryRedelegationFailuresRequest) (*types.QueryRedelegationFailuresResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryRedelegationFailuresResponse{RedelegationFailures: k.GetRedelegationFailuresByEpoch(ctx, req.Epoch)}, nil
+}
+
+// VestingLock queries the stkXPRT held for the locked coins a vesting account liquid staked.
+func (k Querier) VestingLock(c context.Context, req *types.QueryVestingLockRequest) (*types.QueryVestingLockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	vestingAcc, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	lock, found := k.GetVestingLock(ctx, vestingAcc)
+	if !found {
+		lock = types.VestingLock{
+			Address:      req.Address,
+			LockedAmount: sdk.ZeroInt(),
+			StkAmount:    sdk.ZeroInt(),
+		}
+	}
+
+	return &types.QueryVestingLockResponse{VestingLock: lock}, nil
+}
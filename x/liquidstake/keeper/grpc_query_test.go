@@ -16,6 +16,29 @@ func (s *KeeperTestSuite) TestGRPCParams() {
 	s.Require().Equal(s.keeper.GetParams(s.ctx), resp.Params)
 }
 
+func (s *KeeperTestSuite) TestGRPCParamsDiff() {
+	_, err := s.querier.ParamsDiff(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Error(err)
+
+	params := types.DefaultParams()
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	resp, err := s.querier.ParamsDiff(sdk.WrapSDKContext(s.ctx), &types.QueryParamsDiffRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams(), resp.DefaultParams)
+	s.Require().Empty(resp.Diff)
+
+	params.AutocompoundFeeRate = math.LegacyMustNewDecFromStr("0.1")
+	params.LsmDisabled = true
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	resp, err = s.querier.ParamsDiff(sdk.WrapSDKContext(s.ctx), &types.QueryParamsDiffRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(s.keeper.GetParams(s.ctx), resp.Params)
+	s.Require().Equal([]types.ParamDiff{
+		{Key: "autocompound_fee_rate", Value: `"0.100000000000000000"`, DefaultValue: `"0.050000000000000000"`},
+		{Key: "lsm_disabled", Value: "true", DefaultValue: ""},
+	}, resp.Diff)
+}
+
 func (s *KeeperTestSuite) TestGRPCQueries() {
 	_, valOpers, _ := s.CreateValidators([]int64{1000000, 2000000, 3000000})
 	params := s.keeper.GetParams(s.ctx)
@@ -61,3 +84,48 @@ func (s *KeeperTestSuite) TestGRPCQueries() {
 	s.Require().Equal(respParams.Params.WhitelistedValidators[1].ValidatorAddress, valOpers[1].String())
 	s.Require().Equal(respParams.Params.WhitelistedValidators[2].ValidatorAddress, valOpers[2].String())
 }
+
+func (s *KeeperTestSuite) TestGRPCProxyDelegations() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 2000000, 3000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: math.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: math.NewInt(1)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: math.NewInt(2)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], math.NewInt(1_000_000)))
+	s.advanceHeight(10, false)
+
+	resp, err := s.querier.ProxyDelegations(sdk.WrapSDKContext(s.ctx), &types.QueryProxyDelegationsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(resp.ProxyDelegations, 3)
+	s.Require().Equal(valOpers[2].String(), resp.ProxyDelegations[2].ValidatorAddress)
+	s.Require().Equal(math.NewInt(2), resp.ProxyDelegations[2].TargetWeight)
+	s.Require().Equal(math.NewInt(500_000), resp.ProxyDelegations[2].Tokens)
+
+	totalTokens := sdk.ZeroInt()
+	for _, pd := range resp.ProxyDelegations {
+		s.Require().True(pd.Shares.IsPositive())
+		s.Require().True(pd.PendingRewards.AmountOf(sdk.DefaultBondDenom).IsPositive())
+		s.Require().True(pd.WeightDeviation.IsZero())
+		totalTokens = totalTokens.Add(pd.Tokens)
+	}
+	s.Require().Equal(s.keeper.GetNetAmountState(s.ctx).TotalLiquidTokens, totalTokens)
+
+	// raising the first validator weight leaves it under-delegated until the next rebalancing
+	params.WhitelistedValidators[0].TargetWeight = math.NewInt(4)
+	s.keeper.SetParams(s.ctx, params)
+
+	resp, err = s.querier.ProxyDelegations(sdk.WrapSDKContext(s.ctx), &types.QueryProxyDelegationsRequest{})
+	s.Require().NoError(err)
+	s.Require().True(resp.ProxyDelegations[0].WeightDeviation.IsNegative())
+	s.Require().True(resp.ProxyDelegations[1].WeightDeviation.IsPositive())
+	s.Require().True(resp.ProxyDelegations[2].WeightDeviation.IsPositive())
+
+	resp, err = s.querier.ProxyDelegations(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Nil(resp)
+	s.Require().ErrorIs(err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstypes "github.com/persistenceOne/persistence-sdk/v2/x/epochs/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstake/types"
+)
+
+// EpochHooks wrapper struct
+type EpochHooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// EpochHooks returns the liquidstake epoch hooks
+func (k Keeper) EpochHooks() EpochHooks {
+	return EpochHooks{k}
+}
+
+func (h EpochHooks) BeforeEpochStart(_ sdk.Context, _ string, _ int64) error {
+	return nil
+}
+
+func (h EpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	if epochIdentifier == types.WhitelistRotationEpoch {
+		h.k.ApplyWhitelistRotationStep(ctx, epochNumber)
+	}
+	if epochIdentifier == types.RedelegationFailureEpoch {
+		h.k.PruneRedelegationFailures(ctx, epochNumber-types.RedelegationFailureRetentionEpochs+1)
+	}
+	return nil
+}
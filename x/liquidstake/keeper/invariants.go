@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstake/types"
+)
+
+// RegisterInvariants registers the liquidstake module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "proxy-withdraw-address", ProxyWithdrawAddress(k))
+}
+
+// ProxyWithdrawAddress checks that the proxy account withdraws its staking rewards to itself
+func ProxyWithdrawAddress(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		err := k.ValidateProxyWithdrawAddress(ctx)
+		return sdk.FormatInvariant(
+			types.ModuleName, "proxy-withdraw-address",
+			fmt.Sprintf("proxy withdraw address altered: %v", err),
+		), err != nil
+	}
+}
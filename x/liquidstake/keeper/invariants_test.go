@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstake/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstake/types"
+)
+
+func (s *KeeperTestSuite) TestProxyWithdrawAddress() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: math.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: math.NewInt(1)},
+	}
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], math.NewInt(100_000_000)))
+
+	invariant := keeper.ProxyWithdrawAddress(s.keeper)
+	_, broken := invariant(s.ctx)
+	s.Require().False(broken)
+	s.Require().NoError(s.keeper.ValidateProxyWithdrawAddress(s.ctx))
+
+	// redirect the proxy rewards elsewhere
+	s.app.DistrKeeper.SetDelegatorWithdrawAddr(s.ctx, types.LiquidStakeProxyAcc, s.delAddrs[1])
+	_, broken = invariant(s.ctx)
+	s.Require().True(broken)
+	s.Require().ErrorIs(s.keeper.ValidateProxyWithdrawAddress(s.ctx), types.ErrInvalidProxyWithdrawAddress)
+
+	// autocompounding is skipped, the rewards stay on the delegations
+	s.advanceHeight(100, false)
+	balanceBefore := s.app.BankKeeper.GetAllBalances(s.ctx, s.delAddrs[1])
+	totalRewards, _, _ := s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakeProxyAcc)
+	s.Require().True(totalRewards.IsPositive())
+	s.keeper.AutocompoundStakingRewards(s.ctx, types.GetWhitelistedValsMap(params.WhitelistedValidators))
+	totalRewardsAfter, _, _ := s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakeProxyAcc)
+	s.Require().Equal(totalRewards, totalRewardsAfter)
+	s.Require().Equal(balanceBefore, s.app.BankKeeper.GetAllBalances(s.ctx, s.delAddrs[1]))
+
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	_, err := msgServer.RepairProxyWithdrawAddress(
+		sdk.WrapSDKContext(s.ctx),
+		types.NewMsgRepairProxyWithdrawAddress(s.delAddrs[0]),
+	)
+	s.Require().ErrorIs(err, sdkerrors.ErrorInvalidSigner)
+
+	_, err = msgServer.RepairProxyWithdrawAddress(
+		sdk.WrapSDKContext(s.ctx),
+		types.NewMsgRepairProxyWithdrawAddress(authtypes.NewModuleAddress(govtypes.ModuleName)),
+	)
+	s.Require().NoError(err)
+	_, broken = invariant(s.ctx)
+	s.Require().False(broken)
+	s.Require().Equal(types.LiquidStakeProxyAcc, s.app.DistrKeeper.GetDelegatorWithdrawAddr(s.ctx, types.LiquidStakeProxyAcc))
+}
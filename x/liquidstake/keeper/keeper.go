@@ -14,14 +14,17 @@ import (
 
 // Keeper of the liquidstake store
 type Keeper struct {
-	cdc      codec.BinaryCodec
-	storeKey storetypes.StoreKey
+	cdc       codec.BinaryCodec
+	storeKey  storetypes.StoreKey
+	tStoreKey storetypes.StoreKey
 
 	accountKeeper  types.AccountKeeper
 	bankKeeper     types.BankKeeper
 	stakingKeeper  types.StakingKeeper
 	distrKeeper    types.DistrKeeper
 	slashingKeeper types.SlashingKeeper
+	epochsKeeper   types.EpochsKeeper
+	authzKeeper    types.AuthzKeeper
 
 	router    *baseapp.MsgServiceRouter
 	authority string
@@ -31,11 +34,14 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
+	tStoreKey storetypes.StoreKey,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	stakingKeeper types.StakingKeeper,
 	distrKeeper types.DistrKeeper,
 	slashingKeeper types.SlashingKeeper,
+	epochsKeeper types.EpochsKeeper,
+	authzKeeper types.AuthzKeeper,
 	router *baseapp.MsgServiceRouter,
 	authority string,
 ) Keeper {
@@ -47,11 +53,14 @@ func NewKeeper(
 	return Keeper{
 		cdc:            cdc,
 		storeKey:       storeKey,
+		tStoreKey:      tStoreKey,
 		accountKeeper:  accountKeeper,
 		bankKeeper:     bankKeeper,
 		stakingKeeper:  stakingKeeper,
 		distrKeeper:    distrKeeper,
 		slashingKeeper: slashingKeeper,
+		epochsKeeper:   epochsKeeper,
+		authzKeeper:    authzKeeper,
 		router:         router,
 		authority:      authority,
 	}
@@ -304,6 +304,8 @@ func (s *KeeperTestSuite) advanceHeight(height int, withBeginBlock bool) {
 	for i := 0; i < height; i++ {
 		s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).
 			WithBlockTime(s.ctx.BlockTime().Add(BlockTime))
+		// mimic the transient store reset on commit
+		s.keeper.InvalidateNetAmountStateCache(s.ctx)
 
 		mint.BeginBlocker(s.ctx, s.app.MintKeeper, minttypes.DefaultInflationCalculationFn)
 		feeCollectorBalance := s.app.BankKeeper.GetAllBalances(
@@ -9,6 +9,7 @@ import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstake/types"
@@ -21,7 +22,27 @@ func (k Keeper) LiquidBondDenom(ctx sdk.Context) string {
 // GetNetAmountState calculates the sum of bondedDenom balance, total delegation tokens(slash applied LiquidTokens), total remaining reward of types.LiquidStakeProxyAcc
 // During liquid unstaking, stkxprt immediately burns and the unbonding queue belongs to the requester, so the liquid staker's unbonding values are excluded on netAmount
 // It is used only for calculation and query and is not stored in kv.
+//
+// The delegation, reward and unbonding sums are cached in the transient store until the end of the block or until
+// the proxy account delegations are changed, see InvalidateNetAmountStateCache. The stkXPRT supply and the proxy
+// account balance are cheap to read and can be changed by other modules, so they are always read from the store.
 func (k Keeper) GetNetAmountState(ctx sdk.Context) (nas types.NetAmountState) {
+	nas, found := k.getCachedNetAmountState(ctx)
+	if !found {
+		nas = k.calcNetAmountStateDelegations(ctx)
+		k.setCachedNetAmountState(ctx, nas)
+	}
+
+	nas.StkxprtTotalSupply = k.bankKeeper.GetSupply(ctx, k.LiquidBondDenom(ctx)).Amount
+	nas.ProxyAccBalance = k.GetProxyAccBalance(ctx, types.LiquidStakeProxyAcc).Amount
+	nas.NetAmount = nas.CalcNetAmount()
+	nas.MintRate = nas.CalcMintRate()
+	return
+}
+
+// calcNetAmountStateDelegations sums the delegation shares, liquid tokens, remaining rewards and unbonding balances
+// of the proxy account.
+func (k Keeper) calcNetAmountStateDelegations(ctx sdk.Context) types.NetAmountState {
 	totalRemainingRewards, totalDelShares, totalLiquidTokens := k.CheckDelegationStates(ctx, types.LiquidStakeProxyAcc)
 
 	totalUnbondingBalance := sdk.ZeroInt()
@@ -33,35 +54,95 @@ func (k Keeper) GetNetAmountState(ctx sdk.Context) (nas types.NetAmountState) {
 		}
 	}
 
-	nas = types.NetAmountState{
-		StkxprtTotalSupply:    k.bankKeeper.GetSupply(ctx, k.LiquidBondDenom(ctx)).Amount,
+	return types.NetAmountState{
+		MintRate:              sdk.ZeroDec(),
+		StkxprtTotalSupply:    sdk.ZeroInt(),
+		NetAmount:             sdk.ZeroDec(),
 		TotalDelShares:        totalDelShares,
 		TotalLiquidTokens:     totalLiquidTokens,
 		TotalRemainingRewards: totalRemainingRewards,
 		TotalUnbondingBalance: totalUnbondingBalance,
-		ProxyAccBalance:       k.GetProxyAccBalance(ctx, types.LiquidStakeProxyAcc).Amount,
+		ProxyAccBalance:       sdk.ZeroInt(),
+	}
+}
+
+func (k Keeper) getCachedNetAmountState(ctx sdk.Context) (nas types.NetAmountState, found bool) {
+	bz := ctx.TransientStore(k.tStoreKey).Get(types.NetAmountStateCacheKey)
+	if bz == nil {
+		return nas, false
 	}
+	k.cdc.MustUnmarshal(bz, &nas)
+	return nas, true
+}
 
-	nas.NetAmount = nas.CalcNetAmount()
-	nas.MintRate = nas.CalcMintRate()
-	return
+func (k Keeper) setCachedNetAmountState(ctx sdk.Context, nas types.NetAmountState) {
+	ctx.TransientStore(k.tStoreKey).Set(types.NetAmountStateCacheKey, k.cdc.MustMarshal(&nas))
+}
+
+// InvalidateNetAmountStateCache drops the cached net amount state, it must be called whenever the delegations,
+// unbondings or rewards of the proxy account are changed.
+func (k Keeper) InvalidateNetAmountStateCache(ctx sdk.Context) {
+	ctx.TransientStore(k.tStoreKey).Delete(types.NetAmountStateCacheKey)
+}
+
+// ValidateVestingLiquidStake checks that a vesting account only liquid stakes coins that are no longer locked, for the
+// liquid stakes whose stkXPRT leaves the account right away, such as StakeToLP. Locked coins are rejected with an
+// explicit error instead of the bank module insufficient funds one, MsgLiquidStake holds their stkXPRT instead.
+func (k Keeper) ValidateVestingLiquidStake(ctx sdk.Context, liquidStaker sdk.AccAddress, stakingCoin sdk.Coin) error {
+	acc := k.accountKeeper.GetAccount(ctx, liquidStaker)
+	vestingAcc, ok := acc.(vestingexported.VestingAccount)
+	if !ok {
+		return nil
+	}
+
+	spendable := k.bankKeeper.SpendableCoins(ctx, liquidStaker).AmountOf(stakingCoin.Denom)
+	if stakingCoin.Amount.LTE(spendable) {
+		return nil
+	}
+
+	return errors.Wrapf(
+		types.ErrLockedVestingCoins,
+		"vesting account %s can liquid stake up to %s%s, %s%s are locked until they vest",
+		liquidStaker,
+		spendable,
+		stakingCoin.Denom,
+		vestingAcc.LockedCoins(ctx.BlockTime()).AmountOf(stakingCoin.Denom),
+		stakingCoin.Denom,
+	)
 }
 
 // LiquidStake mints stkXPRT worth of staking coin value according to NetAmount and performs LiquidDelegate.
 func (k Keeper) LiquidStake(
 	ctx sdk.Context, proxyAcc, liquidStaker sdk.AccAddress, stakingCoin sdk.Coin,
 ) (newShares math.LegacyDec, stkXPRTMintAmount math.Int, err error) {
+	newShares, stkXPRTMintAmount, _, err = k.liquidStake(ctx, proxyAcc, liquidStaker, stakingCoin, sdk.ZeroInt())
+	return newShares, stkXPRTMintAmount, err
+}
+
+// VestingLiquidStake liquid stakes like LiquidStake, but also accepts the locked coins of a vesting account. The locked
+// coins are moved like a delegation, which the vesting account tracks, and the stkXPRT minted for them is held by the
+// module account until they vest, see ReleaseVestingLocks. It returns the held stkXPRT amount.
+func (k Keeper) VestingLiquidStake(
+	ctx sdk.Context, proxyAcc, liquidStaker sdk.AccAddress, stakingCoin sdk.Coin,
+) (newShares math.LegacyDec, stkXPRTMintAmount, lockedStkXPRTAmount math.Int, err error) {
+	lockedAmount := k.GetVestingLockedAmount(ctx, liquidStaker, stakingCoin)
+	return k.liquidStake(ctx, proxyAcc, liquidStaker, stakingCoin, lockedAmount)
+}
+
+func (k Keeper) liquidStake(
+	ctx sdk.Context, proxyAcc, liquidStaker sdk.AccAddress, stakingCoin sdk.Coin, lockedAmount math.Int,
+) (newShares math.LegacyDec, stkXPRTMintAmount, lockedStkXPRTAmount math.Int, err error) {
 	params := k.GetParams(ctx)
 
 	// check minimum liquid stake amount
 	if stakingCoin.Amount.LT(params.MinLiquidStakeAmount) {
-		return sdk.ZeroDec(), sdk.ZeroInt(), types.ErrLessThanMinLiquidStakeAmount
+		return sdk.ZeroDec(), sdk.ZeroInt(), sdk.ZeroInt(), types.ErrLessThanMinLiquidStakeAmount
 	}
 
 	// check bond denomination
 	bondDenom := k.stakingKeeper.BondDenom(ctx)
 	if stakingCoin.Denom != bondDenom {
-		return sdk.ZeroDec(), sdk.ZeroInt(), errors.Wrapf(
+		return sdk.ZeroDec(), sdk.ZeroInt(), sdk.ZeroInt(), errors.Wrapf(
 			types.ErrInvalidBondDenom, "invalid coin denomination: got %s, expected %s", stakingCoin.Denom, bondDenom,
 		)
 	}
@@ -69,16 +150,30 @@ func (k Keeper) LiquidStake(
 	whitelistedValsMap := types.GetWhitelistedValsMap(params.WhitelistedValidators)
 	activeVals := k.GetActiveLiquidValidators(ctx, whitelistedValsMap)
 	if activeVals.Len() == 0 || !activeVals.TotalWeight(whitelistedValsMap).IsPositive() {
-		return sdk.ZeroDec(), sdk.ZeroInt(), types.ErrActiveLiquidValidatorsNotExists
+		return sdk.ZeroDec(), sdk.ZeroInt(), sdk.ZeroInt(), types.ErrActiveLiquidValidatorsNotExists
 	}
 
 	// NetAmount must be calculated before send
 	nas := k.GetNetAmountState(ctx)
 
 	// send staking coin to liquid stake proxy account to proxy delegation, need sufficient spendable balances
-	err = k.bankKeeper.SendCoins(ctx, liquidStaker, proxyAcc, sdk.NewCoins(stakingCoin))
-	if err != nil {
-		return sdk.ZeroDec(), sdk.ZeroInt(), err
+	// for all but the locked coins, which are moved like a delegation so the vesting account keeps tracking them
+	if freeAmount := stakingCoin.Amount.Sub(lockedAmount); freeAmount.IsPositive() {
+		err = k.bankKeeper.SendCoins(ctx, liquidStaker, proxyAcc, sdk.NewCoins(sdk.NewCoin(stakingCoin.Denom, freeAmount)))
+		if err != nil {
+			return sdk.ZeroDec(), sdk.ZeroInt(), sdk.ZeroInt(), err
+		}
+	}
+	if lockedAmount.IsPositive() {
+		lockedCoins := sdk.NewCoins(sdk.NewCoin(stakingCoin.Denom, lockedAmount))
+		err = k.bankKeeper.DelegateCoins(ctx, liquidStaker, k.accountKeeper.GetModuleAddress(types.ModuleName), lockedCoins)
+		if err != nil {
+			return sdk.ZeroDec(), sdk.ZeroInt(), sdk.ZeroInt(), err
+		}
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, proxyAcc, lockedCoins)
+		if err != nil {
+			return sdk.ZeroDec(), sdk.ZeroInt(), sdk.ZeroInt(), err
+		}
 	}
 
 	// mint stkxprt, MintAmount = TotalSupply * StakeAmount/NetAmount
@@ -89,22 +184,29 @@ func (k Keeper) LiquidStake(
 	}
 
 	if !stkXPRTMintAmount.IsPositive() {
-		return sdk.ZeroDec(), sdk.ZeroInt(), types.ErrTooSmallLiquidStakeAmount
+		return sdk.ZeroDec(), sdk.ZeroInt(), sdk.ZeroInt(), types.ErrTooSmallLiquidStakeAmount
 	}
 
-	// mint on module acc and send
-	mintCoin := sdk.NewCoins(sdk.NewCoin(liquidBondDenom, stkXPRTMintAmount))
-	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, mintCoin)
+	// mint on module acc and send, the share minted for the locked coins is held on the module acc until they vest
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(liquidBondDenom, stkXPRTMintAmount)))
 	if err != nil {
-		return sdk.ZeroDec(), stkXPRTMintAmount, err
+		return sdk.ZeroDec(), stkXPRTMintAmount, sdk.ZeroInt(), err
 	}
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, liquidStaker, mintCoin)
-	if err != nil {
-		return sdk.ZeroDec(), stkXPRTMintAmount, err
+	lockedStkXPRTAmount = sdk.ZeroInt()
+	if lockedAmount.IsPositive() {
+		lockedStkXPRTAmount = sdk.NewDecFromInt(stkXPRTMintAmount).MulInt(lockedAmount).QuoInt(stakingCoin.Amount).Ceil().TruncateInt()
+		k.lockVestingStkXPRT(ctx, liquidStaker, lockedAmount, lockedStkXPRTAmount)
+	}
+	if sendAmount := stkXPRTMintAmount.Sub(lockedStkXPRTAmount); sendAmount.IsPositive() {
+		sendCoin := sdk.NewCoins(sdk.NewCoin(liquidBondDenom, sendAmount))
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, liquidStaker, sendCoin)
+		if err != nil {
+			return sdk.ZeroDec(), stkXPRTMintAmount, lockedStkXPRTAmount, err
+		}
 	}
 
 	newShares, err = k.LiquidDelegate(ctx, proxyAcc, activeVals, stakingCoin.Amount, whitelistedValsMap)
-	return newShares, stkXPRTMintAmount, err
+	return newShares, stkXPRTMintAmount, lockedStkXPRTAmount, err
 }
 
 // LockOnLP sends tokens to a CW contract (Superfluid LP) with time locking.
@@ -283,6 +385,7 @@ func (k Keeper) LSMDelegate(
 
 	// obtained newShares from LSM
 	newShares = lsmRedeemResp.Amount.Amount.ToLegacyDec()
+	k.InvalidateNetAmountStateCache(ctx)
 
 	// mint stkxprt, MintAmount = TotalSupply * StakeAmount/NetAmount
 	liquidBondDenom := k.LiquidBondDenom(ctx)
@@ -326,6 +429,7 @@ func (k Keeper) LiquidDelegate(ctx sdk.Context, proxyAcc sdk.AccAddress, activeV
 		return sdk.ZeroDec(), types.ErrInvalidActiveLiquidValidators
 	}
 	weightedAmt[0] = weightedAmt[0].Add(crumb)
+	defer k.InvalidateNetAmountStateCache(ctx)
 	for i, val := range activeVals {
 		if !weightedAmt[i].IsPositive() {
 			continue
@@ -472,6 +576,7 @@ func (k Keeper) LiquidUnbond(
 	if err != nil {
 		return time.Time{}, sdk.ZeroInt(), stakingtypes.UnbondingDelegation{}, err
 	}
+	k.InvalidateNetAmountStateCache(ctx)
 
 	// transfer the validator tokens to the not bonded pool
 	if validator.IsBonded() {
@@ -519,6 +624,15 @@ func (k Keeper) CheckDelegationStates(ctx sdk.Context, proxyAcc sdk.AccAddress)
 	return totalRewards, totalDelShares, totalLiquidTokens
 }
 
+// ValidateProxyWithdrawAddress checks that the staking rewards of the proxy account are withdrawn to the proxy account itself.
+func (k Keeper) ValidateProxyWithdrawAddress(ctx sdk.Context) error {
+	withdrawAddr := k.distrKeeper.GetDelegatorWithdrawAddr(ctx, types.LiquidStakeProxyAcc)
+	if !withdrawAddr.Equals(types.LiquidStakeProxyAcc) {
+		return errors.Wrapf(types.ErrInvalidProxyWithdrawAddress, "expected %s, got %s", types.LiquidStakeProxyAcc, withdrawAddr)
+	}
+	return nil
+}
+
 func (k Keeper) WithdrawLiquidRewards(ctx sdk.Context, proxyAcc sdk.AccAddress) math.Int {
 	totalRewards := sdk.ZeroInt()
 	bondDenom := k.stakingKeeper.BondDenom(ctx)
@@ -534,6 +648,7 @@ func (k Keeper) WithdrawLiquidRewards(ctx sdk.Context, proxyAcc sdk.AccAddress)
 			return false
 		},
 	)
+	k.InvalidateNetAmountStateCache(ctx)
 	return totalRewards
 }
 
@@ -610,6 +725,61 @@ func (k Keeper) GetAllLiquidValidatorStates(ctx sdk.Context) (liquidValidatorSta
 	return
 }
 
+// GetProxyDelegations returns the proxy account delegation to each whitelisted validator with its pending rewards
+// and the difference between the validator share of the delegated tokens and its share of the target weight.
+func (k Keeper) GetProxyDelegations(ctx sdk.Context, proxyAcc sdk.AccAddress) []types.ProxyDelegation {
+	params := k.GetParams(ctx)
+	weightMap, totalWeight := k.GetWeightMap(ctx, k.GetAllLiquidValidators(ctx), params.WhitelistedValsMap())
+	_, _, totalLiquidTokens := k.CheckDelegationStates(ctx, proxyAcc)
+
+	// Cache ctx for calculate rewards
+	cachedCtx, _ := ctx.CacheContext()
+	proxyDelegations := make([]types.ProxyDelegation, 0, len(params.WhitelistedValidators))
+	for _, wv := range params.WhitelistedValidators {
+		proxyDelegation := types.ProxyDelegation{
+			ValidatorAddress: wv.ValidatorAddress,
+			TargetWeight:     sdk.ZeroInt(),
+			Shares:           sdk.ZeroDec(),
+			Tokens:           sdk.ZeroInt(),
+			PendingRewards:   sdk.DecCoins{},
+			WeightDeviation:  sdk.ZeroDec(),
+		}
+		if weight, ok := weightMap[wv.ValidatorAddress]; ok {
+			proxyDelegation.TargetWeight = weight
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(wv.ValidatorAddress)
+		if err != nil {
+			continue
+		}
+
+		val, found := k.stakingKeeper.GetValidator(cachedCtx, valAddr)
+		if found {
+			del, found := k.stakingKeeper.GetDelegation(cachedCtx, proxyAcc, valAddr)
+			if found && del.GetShares().IsPositive() {
+				endingPeriod := k.distrKeeper.IncrementValidatorPeriod(cachedCtx, val)
+				proxyDelegation.PendingRewards = k.distrKeeper.CalculateDelegationRewards(cachedCtx, val, del, endingPeriod)
+				proxyDelegation.Shares = del.GetShares()
+				proxyDelegation.Tokens = val.TokensFromSharesTruncated(del.GetShares()).TruncateInt()
+			}
+		}
+
+		currentFraction := sdk.ZeroDec()
+		if totalLiquidTokens.IsPositive() {
+			currentFraction = sdk.NewDecFromInt(proxyDelegation.Tokens).QuoInt(totalLiquidTokens)
+		}
+		targetFraction := sdk.ZeroDec()
+		if totalWeight.IsPositive() {
+			targetFraction = sdk.NewDecFromInt(proxyDelegation.TargetWeight).QuoInt(totalWeight)
+		}
+		proxyDelegation.WeightDeviation = currentFraction.Sub(targetFraction)
+
+		proxyDelegations = append(proxyDelegations, proxyDelegation)
+	}
+
+	return proxyDelegations
+}
+
 func (k Keeper) GetLiquidValidatorState(ctx sdk.Context, addr sdk.ValAddress) (liquidValidatorState types.LiquidValidatorState, found bool) {
 	lv, found := k.GetLiquidValidator(ctx, addr)
 	if !found {
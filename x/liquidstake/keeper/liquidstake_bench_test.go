@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	chain "github.com/persistenceOne/pstake-native/v2/app"
+	testhelpers "github.com/persistenceOne/pstake-native/v2/app/helpers"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstake/types"
+)
+
+// setupBenchmark returns an app with numVals whitelisted validators the proxy account delegates to.
+func setupBenchmark(b *testing.B, numVals int) (*chain.PstakeApp, sdk.Context, []sdk.AccAddress) {
+	b.Helper()
+
+	app := testhelpers.Setup(b, false, 5)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).
+		WithBlockHeight(100).
+		WithBlockTime(testhelpers.ParseTime("2022-03-01T00:00:00Z"))
+	stakingParams := stakingtypes.DefaultParams()
+	stakingParams.MaxValidators = uint32(numVals + 1)
+	require.NoError(b, app.StakingKeeper.SetParams(ctx, stakingParams))
+
+	addrs := testhelpers.AddTestAddrsIncremental(app, ctx, numVals, math.NewInt(1_000_000_000))
+	valAddrs := testhelpers.ConvertAddrsToValAddrs(addrs)
+	pks := testhelpers.CreateTestPubKeys(numVals)
+	params := app.LiquidStakeKeeper.GetParams(ctx)
+	for i := range valAddrs {
+		val, err := stakingtypes.NewValidator(valAddrs[i], pks[i], stakingtypes.Description{})
+		require.NoError(b, err)
+		app.StakingKeeper.SetValidator(ctx, val)
+		require.NoError(b, app.StakingKeeper.SetValidatorByConsAddr(ctx, val))
+		app.StakingKeeper.SetNewValidatorByPowerIndex(ctx, val)
+		require.NoError(b, app.StakingKeeper.Hooks().AfterValidatorCreated(ctx, val.GetOperator()))
+		_, err = app.StakingKeeper.Delegate(ctx, addrs[i], math.NewInt(1_000_000), stakingtypes.Unbonded, val, true)
+		require.NoError(b, err)
+
+		params.WhitelistedValidators = append(params.WhitelistedValidators, types.WhitelistedValidator{
+			ValidatorAddress: valAddrs[i].String(),
+			TargetWeight:     math.NewInt(1),
+		})
+	}
+	_, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(b, err)
+	require.NoError(b, app.LiquidStakeKeeper.SetParams(ctx, params))
+	app.LiquidStakeKeeper.UpdateLiquidValidatorSet(ctx)
+
+	delegators := testhelpers.AddTestAddrs(app, ctx, 10, math.NewInt(1_000_000_000))
+	_, _, err = app.LiquidStakeKeeper.LiquidStake(ctx, types.LiquidStakeProxyAcc, delegators[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000_000))
+	require.NoError(b, err)
+
+	return app, ctx, delegators
+}
+
+func BenchmarkGetNetAmountState(b *testing.B) {
+	for _, numVals := range []int{10, 50} {
+		b.Run(fmt.Sprintf("uncached/validators=%d", numVals), func(b *testing.B) {
+			app, ctx, _ := setupBenchmark(b, numVals)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				app.LiquidStakeKeeper.InvalidateNetAmountStateCache(ctx)
+				app.LiquidStakeKeeper.GetNetAmountState(ctx)
+			}
+		})
+
+		b.Run(fmt.Sprintf("cached/validators=%d", numVals), func(b *testing.B) {
+			app, ctx, _ := setupBenchmark(b, numVals)
+			app.LiquidStakeKeeper.GetNetAmountState(ctx)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				app.LiquidStakeKeeper.GetNetAmountState(ctx)
+			}
+		})
+	}
+}
+
+func BenchmarkLiquidStakeGas(b *testing.B) {
+	for _, numVals := range []int{10, 50} {
+		b.Run(fmt.Sprintf("validators=%d", numVals), func(b *testing.B) {
+			app, ctx, delegators := setupBenchmark(b, numVals)
+			stakingCoin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)
+			gasConsumed := uint64(0)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				cachedCtx, _ := ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).CacheContext()
+				_, _, err := app.LiquidStakeKeeper.LiquidStake(cachedCtx, types.LiquidStakeProxyAcc, delegators[1], stakingCoin)
+				require.NoError(b, err)
+				gasConsumed += cachedCtx.GasMeter().GasConsumed()
+			}
+			b.ReportMetric(float64(gasConsumed)/float64(b.N), "gas/op")
+		})
+	}
+}
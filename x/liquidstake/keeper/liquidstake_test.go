@@ -6,8 +6,10 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	testhelpers "github.com/persistenceOne/pstake-native/v2/app/helpers"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstake/keeper"
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstake/types"
 )
 
@@ -309,6 +311,94 @@ func (s *KeeperTestSuite) TestLiquidStakeFromVestingAccount() {
 	s.Require().EqualValues(nas.TotalLiquidTokens, spendableCoins.AmountOf(sdk.DefaultBondDenom))
 }
 
+func (s *KeeperTestSuite) TestValidateVestingLiquidStake() {
+	from := s.delAddrs[1]
+	vestingAmt := s.app.BankKeeper.GetAllBalances(s.ctx, from)
+	vestingStartTime := s.ctx.BlockTime().Add(1 * time.Hour)
+	vestingEndTime := s.ctx.BlockTime().Add(2 * time.Hour)
+
+	vestingAcc := sdk.AccAddress("vesting_account_____")
+	s.createContinuousVestingAccount(from, vestingAcc, vestingAmt, vestingStartTime, vestingEndTime)
+	stakingAmt := vestingAmt.AmountOf(sdk.DefaultBondDenom)
+
+	// regular accounts are left to the bank module balance checks
+	err := s.keeper.ValidateVestingLiquidStake(s.ctx, s.delAddrs[2], sdk.NewCoin(sdk.DefaultBondDenom, stakingAmt))
+	s.Require().NoError(err)
+
+	// everything is still locked
+	err = s.keeper.ValidateVestingLiquidStake(s.ctx, vestingAcc, sdk.NewCoin(sdk.DefaultBondDenom, stakingAmt))
+	s.Require().ErrorIs(err, types.ErrLockedVestingCoins)
+
+	// the vested part can be liquid staked, anything above it can't
+	s.ctx = s.ctx.WithBlockTime(vestingStartTime.Add(30 * time.Minute))
+	spendable := s.app.BankKeeper.SpendableCoins(s.ctx, vestingAcc).AmountOf(sdk.DefaultBondDenom)
+	s.Require().True(spendable.IsPositive())
+
+	err = s.keeper.ValidateVestingLiquidStake(s.ctx, vestingAcc, sdk.NewCoin(sdk.DefaultBondDenom, spendable))
+	s.Require().NoError(err)
+	err = s.keeper.ValidateVestingLiquidStake(s.ctx, vestingAcc, sdk.NewCoin(sdk.DefaultBondDenom, spendable.AddRaw(1)))
+	s.Require().ErrorIs(err, types.ErrLockedVestingCoins)
+}
+
+func (s *KeeperTestSuite) TestVestingLiquidStake() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 2000000, 3000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: math.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: math.NewInt(1)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: math.NewInt(1)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	from := s.delAddrs[0]
+	vestingAmt := s.app.BankKeeper.GetAllBalances(s.ctx, from)
+	vestingStartTime := s.ctx.BlockTime().Add(1 * time.Hour)
+	vestingEndTime := s.ctx.BlockTime().Add(2 * time.Hour)
+
+	vestingAcc := sdk.AccAddress("vesting_account_____")
+	s.createContinuousVestingAccount(from, vestingAcc, vestingAmt, vestingStartTime, vestingEndTime)
+	stakingAmt := vestingAmt.AmountOf(sdk.DefaultBondDenom)
+
+	// the locked coins are liquid staked, the stkXPRT minted for them is held
+	_, stkXPRTMintAmount, lockedStkXPRTAmount, err := s.keeper.VestingLiquidStake(
+		s.ctx, types.LiquidStakeProxyAcc, vestingAcc, sdk.NewCoin(sdk.DefaultBondDenom, stakingAmt),
+	)
+	s.Require().NoError(err)
+	s.Require().True(stkXPRTMintAmount.IsPositive())
+	s.Require().Equal(stkXPRTMintAmount, lockedStkXPRTAmount)
+	s.Require().True(s.app.BankKeeper.GetBalance(s.ctx, vestingAcc, params.LiquidBondDenom).IsZero())
+	s.Require().True(s.app.BankKeeper.GetBalance(s.ctx, vestingAcc, sdk.DefaultBondDenom).IsZero())
+	s.Require().Equal(stakingAmt, s.keeper.GetNetAmountState(s.ctx).TotalLiquidTokens)
+
+	lock, found := s.keeper.GetVestingLock(s.ctx, vestingAcc)
+	s.Require().True(found)
+	s.Require().Equal(stakingAmt, lock.LockedAmount)
+	s.Require().Equal(stkXPRTMintAmount, lock.StkAmount)
+	s.Require().Equal([]types.VestingLock{lock}, s.keeper.ExportGenesis(s.ctx).VestingLocks)
+
+	// nothing is released before the vesting starts
+	s.keeper.ReleaseVestingLocks(s.ctx)
+	s.Require().True(s.app.BankKeeper.GetBalance(s.ctx, vestingAcc, params.LiquidBondDenom).IsZero())
+
+	// the vested half is released
+	s.ctx = s.ctx.WithBlockTime(vestingStartTime.Add(30 * time.Minute))
+	s.keeper.ReleaseVestingLocks(s.ctx)
+	released := s.app.BankKeeper.GetBalance(s.ctx, vestingAcc, params.LiquidBondDenom).Amount
+	s.Require().Equal(stkXPRTMintAmount.QuoRaw(2), released)
+	lock, found = s.keeper.GetVestingLock(s.ctx, vestingAcc)
+	s.Require().True(found)
+	s.Require().Equal(stakingAmt.QuoRaw(2), lock.LockedAmount)
+	s.Require().Equal(stkXPRTMintAmount.Sub(released), lock.StkAmount)
+
+	// everything is released once vested
+	s.ctx = s.ctx.WithBlockTime(vestingEndTime)
+	s.keeper.ReleaseVestingLocks(s.ctx)
+	s.Require().Equal(stkXPRTMintAmount, s.app.BankKeeper.GetBalance(s.ctx, vestingAcc, params.LiquidBondDenom).Amount)
+	_, found = s.keeper.GetVestingLock(s.ctx, vestingAcc)
+	s.Require().False(found)
+}
+
 func (s *KeeperTestSuite) TestLiquidStakeEdgeCases() {
 	_, valOpers, _ := s.CreateValidators([]int64{1000000, 2000000, 3000000})
 	params := s.keeper.GetParams(s.ctx)
@@ -410,3 +500,91 @@ func (s *KeeperTestSuite) TestLiquidUnstakeEdgeCases() {
 	s.Require().EqualValues(ubdTime, time.Time{})
 	s.Require().Len(ubds, 0)
 }
+
+func (s *KeeperTestSuite) TestLiquidStakeMinOutAndDeadline() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 2000000, 3000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: math.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: math.NewInt(10)},
+	}
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	stakingCoin := sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(5000000))
+	past := s.ctx.BlockTime().Add(-time.Second)
+	future := s.ctx.BlockTime().Add(time.Minute)
+
+	// fail, past deadline
+	stakeMsg := types.NewMsgLiquidStake(s.delAddrs[0], stakingCoin)
+	stakeMsg.Deadline = &past
+	_, err := msgServer.LiquidStake(sdk.WrapSDKContext(s.ctx), stakeMsg)
+	s.Require().ErrorIs(err, types.ErrDeadlineExceeded)
+
+	// fail, the first liquid stake mints 1:1
+	stakeMsg.Deadline = &future
+	stakeMsg.MinStkOut = stakingCoin.Amount.AddRaw(1)
+	cachedCtx, _ := s.ctx.CacheContext()
+	_, err = msgServer.LiquidStake(sdk.WrapSDKContext(cachedCtx), stakeMsg)
+	s.Require().ErrorIs(err, types.ErrMinOutNotMet)
+
+	stakeMsg.MinStkOut = stakingCoin.Amount
+	_, err = msgServer.LiquidStake(sdk.WrapSDKContext(s.ctx), stakeMsg)
+	s.Require().NoError(err)
+
+	unstakingCoin := sdk.NewCoin(params.LiquidBondDenom, math.NewInt(1000000))
+	unstakeMsg := types.NewMsgLiquidUnstake(s.delAddrs[0], unstakingCoin)
+	unstakeMsg.Deadline = &past
+	_, err = msgServer.LiquidUnstake(sdk.WrapSDKContext(s.ctx), unstakeMsg)
+	s.Require().ErrorIs(err, types.ErrDeadlineExceeded)
+
+	unstakeMsg.Deadline = &future
+	unstakeMsg.MinOut = unstakingCoin.Amount.AddRaw(1)
+	cachedCtx, _ = s.ctx.CacheContext()
+	_, err = msgServer.LiquidUnstake(sdk.WrapSDKContext(cachedCtx), unstakeMsg)
+	s.Require().ErrorIs(err, types.ErrMinOutNotMet)
+
+	unstakeMsg.MinOut = unstakingCoin.Amount
+	_, err = msgServer.LiquidUnstake(sdk.WrapSDKContext(s.ctx), unstakeMsg)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestNetAmountStateCache() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: math.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: math.NewInt(1)},
+	}
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], math.NewInt(1_000_000)))
+	nas := s.keeper.GetNetAmountState(s.ctx)
+	s.Require().Equal(math.NewInt(1_000_000), nas.TotalLiquidTokens)
+
+	// liquid staking drops the cached delegations
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], math.NewInt(500_000)))
+	nas = s.keeper.GetNetAmountState(s.ctx)
+	s.Require().Equal(math.NewInt(1_500_000), nas.TotalLiquidTokens)
+
+	// the proxy account balance is never cached
+	bondDenom := s.app.StakingKeeper.BondDenom(s.ctx)
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, s.delAddrs[1], types.LiquidStakeProxyAcc, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))))
+	nas = s.keeper.GetNetAmountState(s.ctx)
+	s.Require().Equal(math.NewInt(1000), nas.ProxyAccBalance)
+	s.Require().Equal(math.LegacyNewDec(1_501_000), nas.NetAmount)
+
+	// delegations changed outside of the keeper are picked up once the cache is dropped
+	validator, _ := s.app.StakingKeeper.GetValidator(s.ctx, valOpers[0])
+	_, err := s.app.StakingKeeper.Delegate(s.ctx, types.LiquidStakeProxyAcc, math.NewInt(1000), stakingtypes.Unbonded, validator, true)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(1_500_000), s.keeper.GetNetAmountState(s.ctx).TotalLiquidTokens)
+	s.keeper.InvalidateNetAmountStateCache(s.ctx)
+	s.Require().Equal(math.NewInt(1_501_000), s.keeper.GetNetAmountState(s.ctx).TotalLiquidTokens)
+
+	// liquid unstaking drops the cached delegations
+	s.Require().NoError(s.liquidUnstaking(s.delAddrs[0], math.NewInt(500_000), false))
+	s.Require().True(s.keeper.GetNetAmountState(s.ctx).TotalLiquidTokens.LT(math.NewInt(1_001_000)))
+}
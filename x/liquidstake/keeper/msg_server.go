@@ -7,6 +7,8 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 	"time"
 
 	"cosmossdk.io/errors"
@@ -20,6 +22,14 @@ type msgServer struct {
 	Keeper
 }
 
+// validateDeadline rejects a message whose deadline is before the block time.
+func validateDeadline(ctx sdk.Context, deadline *time.Time) error {
+	if deadline != nil && ctx.BlockTime().After(*deadline) {
+		return errors.Wrapf(types.ErrDeadlineExceeded, "deadline %s, block time %s", deadline.Format(time.RFC3339), ctx.BlockTime().Format(time.RFC3339))
+	}
+	return nil
+}
+
 // NewMsgServerImpl returns an implementation of the liquidstake MsgServer interface
 // for the provided Keeper.
 func NewMsgServerImpl(keeper Keeper) types.MsgServer {
@@ -31,11 +41,19 @@ var _ types.MsgServer = msgServer{}
 func (k msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake) (*types.MsgLiquidStakeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	newShares, stkXPRTMintAmount, err := k.Keeper.LiquidStake(ctx, types.LiquidStakeProxyAcc, msg.GetDelegator(), msg.Amount)
+	if err := validateDeadline(ctx, msg.Deadline); err != nil {
+		return nil, err
+	}
+
+	newShares, stkXPRTMintAmount, lockedStkXPRTAmount, err := k.Keeper.VestingLiquidStake(ctx, types.LiquidStakeProxyAcc, msg.GetDelegator(), msg.Amount)
 	if err != nil {
 		return nil, err
 	}
 
+	if !msg.MinStkOut.IsNil() && stkXPRTMintAmount.LT(msg.MinStkOut) {
+		return nil, errors.Wrapf(types.ErrMinOutNotMet, "minted %s, expected at least %s", stkXPRTMintAmount, msg.MinStkOut)
+	}
+
 	liquidBondDenom := k.LiquidBondDenom(ctx)
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -48,6 +66,7 @@ func (k msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake)
 			sdk.NewAttribute(types.AttributeKeyLiquidAmount, msg.Amount.String()),
 			sdk.NewAttribute(types.AttributeKeyNewShares, newShares.String()),
 			sdk.NewAttribute(types.AttributeKeyStkXPRTMintedAmount, sdk.Coin{Denom: liquidBondDenom, Amount: stkXPRTMintAmount}.String()),
+			sdk.NewAttribute(types.AttributeKeyLockedStkXPRTAmount, sdk.Coin{Denom: liquidBondDenom, Amount: lockedStkXPRTAmount}.String()),
 		),
 	})
 	return &types.MsgLiquidStakeResponse{}, nil
@@ -88,6 +107,10 @@ func (k msgServer) StakeToLP(goCtx context.Context, msg *types.MsgStakeToLP) (*t
 	})
 
 	if msg.LiquidAmount.Amount.IsPositive() {
+		if err := k.ValidateVestingLiquidStake(ctx, msg.GetDelegator(), msg.LiquidAmount); err != nil {
+			return nil, err
+		}
+
 		newShares, stkXPRTMintAmount, err := k.Keeper.LiquidStake(ctx, types.LiquidStakeProxyAcc, msg.GetDelegator(), msg.LiquidAmount)
 		if err != nil {
 			return nil, err
@@ -124,11 +147,19 @@ func (k msgServer) StakeToLP(goCtx context.Context, msg *types.MsgStakeToLP) (*t
 func (k msgServer) LiquidUnstake(goCtx context.Context, msg *types.MsgLiquidUnstake) (*types.MsgLiquidUnstakeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := validateDeadline(ctx, msg.Deadline); err != nil {
+		return nil, err
+	}
+
 	completionTime, unbondingAmount, _, unbondedAmount, err := k.Keeper.LiquidUnstake(ctx, types.LiquidStakeProxyAcc, msg.GetDelegator(), msg.Amount)
 	if err != nil {
 		return nil, err
 	}
 
+	if outAmount := unbondingAmount.Add(unbondedAmount); !msg.MinOut.IsNil() && outAmount.LT(msg.MinOut) {
+		return nil, errors.Wrapf(types.ErrMinOutNotMet, "unstaked %s, expected at least %s", outAmount, msg.MinOut)
+	}
+
 	bondDenom := k.stakingKeeper.BondDenom(ctx)
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -175,3 +206,171 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (k msgServer) ScheduleWhitelistRotation(
+	goCtx context.Context,
+	msg *types.MsgScheduleWhitelistRotation,
+) (*types.MsgScheduleWhitelistRotationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(sdkerrors.ErrorInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	currentEpoch := k.epochsKeeper.GetEpochInfo(ctx, types.WhitelistRotationEpoch).CurrentEpoch
+	if msg.StartEpoch < currentEpoch {
+		return nil, errors.Wrapf(
+			types.ErrInvalidWhitelistRotation,
+			"start epoch %d is before the current epoch %d",
+			msg.StartEpoch,
+			currentEpoch,
+		)
+	}
+
+	if rotation, found := k.GetWhitelistRotation(ctx); found && rotation.Started {
+		return nil, errors.Wrapf(
+			types.ErrInvalidWhitelistRotation,
+			"rotation started at epoch %d is still in progress",
+			rotation.StartEpoch,
+		)
+	}
+
+	k.SetWhitelistRotation(ctx, types.WhitelistRotation{
+		WhitelistedValidators: msg.WhitelistedValidators,
+		StartEpoch:            msg.StartEpoch,
+		TransitionEpochs:      msg.TransitionEpochs,
+	})
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeMsgScheduleWhitelistRotation,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyStartEpoch, strconv.FormatInt(msg.StartEpoch, 10)),
+			sdk.NewAttribute(types.AttributeKeyTransitionEpochs, strconv.FormatInt(msg.TransitionEpochs, 10)),
+			sdk.NewAttribute(types.AttributeKeyWhitelistedValidators, fmt.Sprint(msg.WhitelistedValidators)),
+		),
+	})
+
+	return &types.MsgScheduleWhitelistRotationResponse{}, nil
+}
+
+// ScheduleStakeToLP defines a method for registering a recurring StakeToLP executed through an authz grant
+func (k msgServer) ScheduleStakeToLP(
+	goCtx context.Context,
+	msg *types.MsgScheduleStakeToLP,
+) (*types.MsgScheduleStakeToLPResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegator := sdk.MustAccAddressFromBech32(msg.DelegatorAddress)
+	if !k.HasStakeToLPGrant(ctx, delegator) {
+		return nil, errors.Wrapf(
+			types.ErrStakeToLPGrantNotFound,
+			"%s must grant %s an authorization for %s",
+			msg.DelegatorAddress,
+			k.accountKeeper.GetModuleAddress(types.ModuleName),
+			sdk.MsgTypeURL(&types.MsgStakeToLP{}),
+		)
+	}
+
+	schedule := types.StakeToLPSchedule{
+		DelegatorAddress:  msg.DelegatorAddress,
+		ValidatorAddress:  msg.ValidatorAddress,
+		StakedAmount:      msg.StakedAmount,
+		LiquidAmount:      msg.LiquidAmount,
+		Interval:          msg.Interval,
+		NextExecutionTime: ctx.BlockTime().Add(msg.Interval),
+	}
+	k.SetStakeToLPSchedule(ctx, schedule)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+		sdk.NewEvent(
+			types.EventTypeMsgScheduleStakeToLP,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyStakedAmount, msg.StakedAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyLiquidAmount, msg.LiquidAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyInterval, msg.Interval.String()),
+			sdk.NewAttribute(types.AttributeKeyNextExecutionTime, schedule.NextExecutionTime.Format(time.RFC3339)),
+		),
+	})
+
+	return &types.MsgScheduleStakeToLPResponse{NextExecutionTime: schedule.NextExecutionTime}, nil
+}
+
+// CancelStakeToLPSchedule defines a method for removing a recurring StakeToLP
+func (k msgServer) CancelStakeToLPSchedule(
+	goCtx context.Context,
+	msg *types.MsgCancelStakeToLPSchedule,
+) (*types.MsgCancelStakeToLPScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, found := k.GetStakeToLPSchedule(ctx, sdk.MustAccAddressFromBech32(msg.DelegatorAddress), valAddr)
+	if !found {
+		return nil, errors.Wrapf(
+			types.ErrStakeToLPScheduleNotFound,
+			"delegator %s, validator %s",
+			msg.DelegatorAddress,
+			msg.ValidatorAddress,
+		)
+	}
+	k.DeleteStakeToLPSchedule(ctx, schedule)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+		sdk.NewEvent(
+			types.EventTypeMsgCancelStakeToLPSchedule,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		),
+	})
+
+	return &types.MsgCancelStakeToLPScheduleResponse{}, nil
+}
+
+// RepairProxyWithdrawAddress restores the proxy account as its own distribution withdraw address
+func (k msgServer) RepairProxyWithdrawAddress(
+	goCtx context.Context,
+	msg *types.MsgRepairProxyWithdrawAddress,
+) (*types.MsgRepairProxyWithdrawAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(sdkerrors.ErrorInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	previousWithdrawAddr := k.distrKeeper.GetDelegatorWithdrawAddr(ctx, types.LiquidStakeProxyAcc)
+	k.distrKeeper.SetDelegatorWithdrawAddr(ctx, types.LiquidStakeProxyAcc, types.LiquidStakeProxyAcc)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeMsgRepairProxyWithdrawAddress,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyPreviousWithdrawAddress, previousWithdrawAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, types.LiquidStakeProxyAcc.String()),
+		),
+	})
+
+	return &types.MsgRepairProxyWithdrawAddressResponse{}, nil
+}
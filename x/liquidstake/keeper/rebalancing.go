@@ -44,6 +44,7 @@ func (k Keeper) TryRedelegation(ctx sdk.Context, re types.Redelegation) (complet
 	if err != nil {
 		return time.Time{}, err
 	}
+	k.InvalidateNetAmountStateCache(cachedCtx)
 	writeCache()
 	return completionTime, nil
 }
@@ -123,6 +124,7 @@ func (k Keeper) Rebalance(
 
 	if failCount > 0 {
 		logger.Error("rebalancing failed due to redelegation hopping", "redelegations", redelegations)
+		k.RecordRedelegationFailures(ctx, redelegations)
 	}
 
 	if len(redelegations) != 0 {
@@ -170,41 +172,39 @@ func (k Keeper) UpdateLiquidValidatorSet(ctx sdk.Context) (redelegations []types
 		}
 	}
 
+	// with the manual policy, tokens on inactive liquid validators are left in place, so they are kept out of rebalancing
+	rebalancingVals := liquidValidators
+	if params.InactiveValidatorPolicy == types.InactiveValidatorPolicyManual {
+		rebalancingVals = types.LiquidValidators{}
+		for _, lv := range liquidValidators {
+			if k.IsActiveLiquidValidator(ctx, lv, whitelistedValsMap) {
+				rebalancingVals = append(rebalancingVals, lv)
+			}
+		}
+	}
+
 	// rebalancing based updated liquid validators status with threshold, try by cachedCtx
 	// tombstone status also handled on Rebalance
 	redelegations = k.Rebalance(
 		ctx,
 		types.LiquidStakeProxyAcc,
-		liquidValidators,
+		rebalancingVals,
 		whitelistedValsMap,
 		types.RebalancingTrigger,
 	)
 
-	// unbond all delShares to proxyAcc if delShares exist on inactive liquid validators
+	// handle remaining delShares on inactive liquid validators according to the inactive validator policy
 	for _, lv := range liquidValidators {
 		if !k.IsActiveLiquidValidator(ctx, lv, whitelistedValsMap) {
-			delShares := lv.GetDelShares(ctx, k.stakingKeeper)
-			if delShares.IsPositive() {
-				cachedCtx, writeCache := ctx.CacheContext()
-				completionTime, returnAmount, _, err := k.LiquidUnbond(cachedCtx, types.LiquidStakeProxyAcc, types.LiquidStakeProxyAcc, lv.GetOperator(), delShares, false)
-				if err != nil {
-					logger.Error("liquid unbonding of inactive liquid validator failed", "error", err)
-					continue
+			if lv.GetDelShares(ctx, k.stakingKeeper).IsPositive() {
+				switch params.InactiveValidatorPolicy {
+				case types.InactiveValidatorPolicyRedelegate:
+					k.redelegateInactiveLiquidTokens(ctx, lv, liquidValidators, whitelistedValsMap)
+				case types.InactiveValidatorPolicyManual:
+					k.holdInactiveLiquidTokens(ctx, lv)
+				default:
+					k.unbondInactiveLiquidTokens(ctx, lv)
 				}
-				writeCache()
-				unbondingAmount := sdk.Coin{Denom: k.stakingKeeper.BondDenom(ctx), Amount: returnAmount}.String()
-				ctx.EventManager().EmitEvents(sdk.Events{
-					sdk.NewEvent(
-						types.EventTypeUnbondInactiveLiquidTokens,
-						sdk.NewAttribute(types.AttributeKeyLiquidValidator, lv.OperatorAddress),
-						sdk.NewAttribute(types.AttributeKeyUnbondingAmount, unbondingAmount),
-						sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
-					),
-				})
-				logger.Info(types.EventTypeUnbondInactiveLiquidTokens,
-					types.AttributeKeyLiquidValidator, lv.OperatorAddress,
-					types.AttributeKeyUnbondingAmount, unbondingAmount,
-					types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339))
 			}
 			_, found := k.stakingKeeper.GetDelegation(ctx, types.LiquidStakeProxyAcc, lv.GetOperator())
 			if !found {
@@ -225,6 +225,107 @@ func (k Keeper) UpdateLiquidValidatorSet(ctx sdk.Context) (redelegations []types
 	return redelegations
 }
 
+// unbondInactiveLiquidTokens unbonds all delShares of the proxy account on the inactive liquid validator.
+func (k Keeper) unbondInactiveLiquidTokens(ctx sdk.Context, lv types.LiquidValidator) {
+	logger := k.Logger(ctx)
+	delShares := lv.GetDelShares(ctx, k.stakingKeeper)
+	cachedCtx, writeCache := ctx.CacheContext()
+	completionTime, returnAmount, _, err := k.LiquidUnbond(cachedCtx, types.LiquidStakeProxyAcc, types.LiquidStakeProxyAcc, lv.GetOperator(), delShares, false)
+	if err != nil {
+		logger.Error("liquid unbonding of inactive liquid validator failed", "error", err)
+		return
+	}
+	writeCache()
+	unbondingAmount := sdk.Coin{Denom: k.stakingKeeper.BondDenom(ctx), Amount: returnAmount}.String()
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnbondInactiveLiquidTokens,
+			sdk.NewAttribute(types.AttributeKeyLiquidValidator, lv.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyUnbondingAmount, unbondingAmount),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	})
+	logger.Info(types.EventTypeUnbondInactiveLiquidTokens,
+		types.AttributeKeyLiquidValidator, lv.OperatorAddress,
+		types.AttributeKeyUnbondingAmount, unbondingAmount,
+		types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339))
+}
+
+// redelegateInactiveLiquidTokens redelegates all delShares of the proxy account on the inactive liquid validator to the
+// active liquid validator which is the furthest below its weighted target. A failed redelegation is retried on the next block.
+func (k Keeper) redelegateInactiveLiquidTokens(
+	ctx sdk.Context,
+	lv types.LiquidValidator,
+	liquidVals types.LiquidValidators,
+	whitelistedValsMap types.WhitelistedValsMap,
+) {
+	logger := k.Logger(ctx)
+	totalLiquidTokens, liquidTokenMap := liquidVals.TotalLiquidTokens(ctx, k.stakingKeeper, false)
+	weightMap, totalWeight := k.GetWeightMap(ctx, liquidVals, whitelistedValsMap)
+	if !totalWeight.IsPositive() {
+		logger.Error("redelegation of inactive liquid validator skipped, no active liquid validators",
+			types.AttributeKeyLiquidValidator, lv.OperatorAddress)
+		return
+	}
+
+	var dstVal types.LiquidValidator
+	maxGap := math.Int{}
+	for _, val := range liquidVals {
+		if !weightMap[val.OperatorAddress].IsPositive() {
+			continue
+		}
+		target := totalLiquidTokens.Mul(weightMap[val.OperatorAddress]).Quo(totalWeight)
+		gap := target.Sub(liquidTokenMap[val.OperatorAddress])
+		if maxGap.IsNil() || gap.GT(maxGap) {
+			dstVal, maxGap = val, gap
+		}
+	}
+
+	redelegation := types.Redelegation{
+		Delegator:    types.LiquidStakeProxyAcc,
+		SrcValidator: lv,
+		DstValidator: dstVal,
+		Amount:       liquidTokenMap[lv.OperatorAddress],
+		Last:         true,
+	}
+	completionTime, err := k.TryRedelegation(ctx, redelegation)
+	if err != nil {
+		logger.Error("redelegation of inactive liquid validator failed", "error", err)
+		return
+	}
+
+	redelegationAmount := sdk.Coin{Denom: k.stakingKeeper.BondDenom(ctx), Amount: redelegation.Amount}.String()
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedelegateInactiveLiquidTokens,
+			sdk.NewAttribute(types.AttributeKeyLiquidValidator, lv.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDstLiquidValidator, dstVal.OperatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, redelegationAmount),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	})
+	logger.Info(types.EventTypeRedelegateInactiveLiquidTokens,
+		types.AttributeKeyLiquidValidator, lv.OperatorAddress,
+		types.AttributeKeyDstLiquidValidator, dstVal.OperatorAddress,
+		sdk.AttributeKeyAmount, redelegationAmount,
+		types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339))
+}
+
+// holdInactiveLiquidTokens leaves the delegation on the inactive liquid validator untouched, only reporting the held amount.
+func (k Keeper) holdInactiveLiquidTokens(ctx sdk.Context, lv types.LiquidValidator) {
+	heldAmount := sdk.Coin{Denom: k.stakingKeeper.BondDenom(ctx), Amount: lv.GetLiquidTokens(ctx, k.stakingKeeper, false)}.String()
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeHoldInactiveLiquidTokens,
+			sdk.NewAttribute(types.AttributeKeyLiquidValidator, lv.OperatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, heldAmount),
+		),
+	})
+	k.Logger(ctx).Debug(types.EventTypeHoldInactiveLiquidTokens,
+		types.AttributeKeyLiquidValidator, lv.OperatorAddress,
+		sdk.AttributeKeyAmount, heldAmount)
+}
+
 // AutocompoundStakingRewards withdraws staking rewards and re-stakes when over threshold.
 func (k Keeper) AutocompoundStakingRewards(ctx sdk.Context, whitelistedValsMap types.WhitelistedValsMap) {
 	totalRemainingRewards, _, totalLiquidTokens := k.CheckDelegationStates(ctx, types.LiquidStakeProxyAcc)
@@ -238,25 +339,49 @@ func (k Keeper) AutocompoundStakingRewards(ctx sdk.Context, whitelistedValsMap t
 		return
 	}
 
+	// rewards would be sent elsewhere instead of the proxy account, keep them on the delegations until repaired
+	if err := k.ValidateProxyWithdrawAddress(ctx); err != nil {
+		k.Logger(ctx).Error("skipping autocompound", "error", err)
+		return
+	}
+
 	// Withdraw rewards of LiquidStakeProxyAcc and re-staking
 	k.WithdrawLiquidRewards(ctx, types.LiquidStakeProxyAcc)
 
 	// prepare to re-staking with proxyAccBalance
 	proxyAccBalance = k.GetProxyAccBalance(ctx, types.LiquidStakeProxyAcc)
 
-	// move autocompounding fee from the balance to fee account
+	// move autocompounding fee from the balance to the community pool and fee account
 	params := k.GetParams(ctx)
 	autocompoundFee := sdk.NewCoin(proxyAccBalance.Denom, math.ZeroInt())
+	communityPoolFee := sdk.NewCoin(proxyAccBalance.Denom, math.ZeroInt())
+	feeAccountFee := sdk.NewCoin(proxyAccBalance.Denom, math.ZeroInt())
+
+	autocompoundFeeRate := params.EffectiveAutocompoundFeeRate(totalLiquidTokens)
+
+	if !autocompoundFeeRate.IsZero() {
+		autocompoundFee = sdk.NewCoin(proxyAccBalance.Denom, autocompoundFeeRate.MulInt(proxyAccBalance.Amount).TruncateInt())
+		communityPoolFee, feeAccountFee = splitAutocompoundFee(autocompoundFee, params.AutocompoundFeeCommunityPoolFraction)
+
+		// both transfers are reverted if either fails, leaving the rewards to the next autocompounding
+		cachedCtx, writeCache := ctx.CacheContext()
+		if communityPoolFee.IsPositive() {
+			err := k.distrKeeper.FundCommunityPool(cachedCtx, sdk.NewCoins(communityPoolFee), types.LiquidStakeProxyAcc)
+			if err != nil {
+				k.Logger(ctx).Error("re-staking failed upon community pool funding", "error", err)
+				return
+			}
+		}
 
-	if !params.AutocompoundFeeRate.IsZero() {
-		autocompoundFee = sdk.NewCoin(proxyAccBalance.Denom, params.AutocompoundFeeRate.MulInt(proxyAccBalance.Amount).TruncateInt())
-		feeAccountAddr := sdk.MustAccAddressFromBech32(params.FeeAccountAddress)
-
-		err := k.bankKeeper.SendCoins(ctx, types.LiquidStakeProxyAcc, feeAccountAddr, sdk.NewCoins(autocompoundFee))
-		if err != nil {
-			k.Logger(ctx).Error("re-staking failed upon fee collection", "error", err)
-			return
+		if feeAccountFee.IsPositive() {
+			feeAccountAddr := sdk.MustAccAddressFromBech32(params.FeeAccountAddress)
+			err := k.bankKeeper.SendCoins(cachedCtx, types.LiquidStakeProxyAcc, feeAccountAddr, sdk.NewCoins(feeAccountFee))
+			if err != nil {
+				k.Logger(ctx).Error("re-staking failed upon fee collection", "error", err)
+				return
+			}
 		}
+		writeCache()
 
 		// reset proxyAccBalance
 		proxyAccBalance = k.GetProxyAccBalance(ctx, types.LiquidStakeProxyAcc)
@@ -284,10 +409,24 @@ func (k Keeper) AutocompoundStakingRewards(ctx sdk.Context, whitelistedValsMap t
 			sdk.NewAttribute(types.AttributeKeyDelegator, types.LiquidStakeProxyAcc.String()),
 			sdk.NewAttribute(sdk.AttributeKeyAmount, proxyAccBalance.String()),
 			sdk.NewAttribute(types.AttributeKeyPstakeAutocompoundFee, autocompoundFee.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeAccountFee, feeAccountFee.String()),
+			sdk.NewAttribute(types.AttributeKeyCommunityPoolFee, communityPoolFee.String()),
 		),
 	})
 	logger.Info(types.EventTypeAutocompound,
 		types.AttributeKeyDelegator, types.LiquidStakeProxyAcc.String(),
 		sdk.AttributeKeyAmount, proxyAccBalance.String(),
-		types.AttributeKeyPstakeAutocompoundFee, autocompoundFee.String())
+		types.AttributeKeyPstakeAutocompoundFee, autocompoundFee.String(),
+		types.AttributeKeyFeeAccountFee, feeAccountFee.String(),
+		types.AttributeKeyCommunityPoolFee, communityPoolFee.String())
+}
+
+// splitAutocompoundFee returns the part of the fee funding the community pool and the remainder for the fee account.
+// A fraction left unset by params predating it is treated as zero.
+func splitAutocompoundFee(fee sdk.Coin, communityPoolFraction sdk.Dec) (communityPoolFee, feeAccountFee sdk.Coin) {
+	communityPoolFee = sdk.NewCoin(fee.Denom, math.ZeroInt())
+	if !communityPoolFraction.IsNil() {
+		communityPoolFee.Amount = communityPoolFraction.MulInt(fee.Amount).TruncateInt()
+	}
+	return communityPoolFee, fee.Sub(communityPoolFee)
 }
@@ -512,6 +512,94 @@ func (s *KeeperTestSuite) TestAutocompoundStakingRewards() {
 	s.EqualValues(autocompoundFee.TruncateInt(), feeAccountBalance.Amount)
 }
 
+func (s *KeeperTestSuite) TestAutocompoundStakingRewardsFeeTiers() {
+	_, valOpers, _ := s.CreateValidators([]int64{2000000, 2000000, 2000000})
+	params := s.keeper.GetParams(s.ctx)
+
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: math.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: math.NewInt(10)},
+	}
+	params.AutocompoundFeeTiers = []types.AutocompoundFeeTier{
+		{Threshold: math.NewInt(50000000), FeeRate: math.LegacyMustNewDecFromStr("0.01")},
+	}
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	stakingAmt := math.NewInt(100000000)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], stakingAmt))
+
+	// allocate rewards
+	s.advanceHeight(100, false)
+	totalRewards, _, _ := s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakeProxyAcc)
+	s.NotEqualValues(totalRewards, sdk.ZeroDec())
+
+	whitelistedValsMap := types.GetWhitelistedValsMap(params.WhitelistedValidators)
+	s.keeper.AutocompoundStakingRewards(s.ctx, whitelistedValsMap)
+
+	// half of the staked amount is charged 5%, the other half 1%
+	autocompoundFee := math.LegacyMustNewDecFromStr("0.03").Mul(totalRewards)
+
+	stakingParams := s.app.StakingKeeper.GetParams(s.ctx)
+	feeAccountBalance := s.app.BankKeeper.GetBalance(
+		s.ctx,
+		sdk.MustAccAddressFromBech32(params.FeeAccountAddress),
+		stakingParams.BondDenom,
+	)
+	s.EqualValues(autocompoundFee.TruncateInt(), feeAccountBalance.Amount)
+}
+
+func (s *KeeperTestSuite) TestAutocompoundStakingRewardsCommunityPool() {
+	_, valOpers, _ := s.CreateValidators([]int64{2000000, 2000000, 2000000})
+	params := s.keeper.GetParams(s.ctx)
+
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: math.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: math.NewInt(10)},
+	}
+	params.AutocompoundFeeCommunityPoolFraction = math.LegacyMustNewDecFromStr("0.4")
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	stakingAmt := math.NewInt(100000000)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], stakingAmt))
+
+	// allocate rewards
+	s.advanceHeight(100, false)
+	totalRewards, _, _ := s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakeProxyAcc)
+	s.NotEqualValues(totalRewards, sdk.ZeroDec())
+
+	bondDenom := s.app.StakingKeeper.BondDenom(s.ctx)
+	communityPoolBefore := s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx).AmountOf(bondDenom)
+
+	whitelistedValsMap := types.GetWhitelistedValsMap(params.WhitelistedValidators)
+	s.keeper.AutocompoundStakingRewards(s.ctx, whitelistedValsMap)
+
+	autocompoundFee := params.AutocompoundFeeRate.Mul(totalRewards).TruncateInt()
+	communityPoolFee := params.AutocompoundFeeCommunityPoolFraction.MulInt(autocompoundFee).TruncateInt()
+	s.Require().True(communityPoolFee.IsPositive())
+
+	feeAccountBalance := s.app.BankKeeper.GetBalance(s.ctx, sdk.MustAccAddressFromBech32(params.FeeAccountAddress), bondDenom)
+	s.Require().Equal(autocompoundFee.Sub(communityPoolFee), feeAccountBalance.Amount)
+
+	// the withdrawal also leaves the decimal remainder of the rewards to the community pool
+	communityPoolAfter := s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx).AmountOf(bondDenom)
+	s.Require().Equal(communityPoolFee, communityPoolAfter.Sub(communityPoolBefore).TruncateInt())
+
+	var autocompoundEvent sdk.Event
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeAutocompound {
+			autocompoundEvent = event
+		}
+	}
+	communityPoolAttr, ok := autocompoundEvent.GetAttribute(types.AttributeKeyCommunityPoolFee)
+	s.Require().True(ok)
+	s.Require().Equal(sdk.NewCoin(bondDenom, communityPoolFee).String(), communityPoolAttr.Value)
+	feeAccountAttr, ok := autocompoundEvent.GetAttribute(types.AttributeKeyFeeAccountFee)
+	s.Require().True(ok)
+	s.Require().Equal(feeAccountBalance.String(), feeAccountAttr.Value)
+}
+
 func (s *KeeperTestSuite) TestRemoveAllLiquidValidator() {
 	_, valOpers, _ := s.CreateValidators([]int64{2000000, 2000000, 2000000})
 	params := s.keeper.GetParams(s.ctx)
@@ -568,3 +656,102 @@ func (s *KeeperTestSuite) TestRemoveAllLiquidValidator() {
 
 	s.EqualValues(autocompoundFee.TruncateInt(), feeAccountBalance.Amount)
 }
+
+func (s *KeeperTestSuite) TestInactiveValidatorPolicyRedelegate() {
+	_, valOpers, _ := s.CreateValidators([]int64{2000000, 2000000, 2000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.InactiveValidatorPolicy = types.InactiveValidatorPolicyRedelegate
+
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: math.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: math.NewInt(10)},
+	}
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	stakingAmt := math.NewInt(100000000)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], stakingAmt))
+
+	// val2 receives redelegations when whitelisted
+	params.WhitelistedValidators = append(params.WhitelistedValidators,
+		types.WhitelistedValidator{ValidatorAddress: valOpers[2].String(), TargetWeight: math.NewInt(10)})
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	val2, found := s.keeper.GetLiquidValidator(s.ctx, valOpers[2])
+	s.Require().True(found)
+	s.Require().True(val2.GetLiquidTokens(s.ctx, s.app.StakingKeeper, false).IsPositive())
+
+	// delisting val2 can't redelegate its receiving redelegation, the tokens stay without unbonding
+	params.WhitelistedValidators = params.WhitelistedValidators[:2]
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	_, found = s.app.StakingKeeper.GetUnbondingDelegation(s.ctx, types.LiquidStakeProxyAcc, valOpers[2])
+	s.Require().False(found)
+	_, found = s.app.StakingKeeper.GetDelegation(s.ctx, types.LiquidStakeProxyAcc, valOpers[2])
+	s.Require().True(found)
+	for _, event := range s.ctx.EventManager().Events() {
+		s.Require().NotEqual(types.EventTypeUnbondInactiveLiquidTokens, event.Type)
+	}
+
+	// once the redelegations complete, the inactive tokens are redelegated and val2 is removed
+	s.completeRedelegationUnbonding()
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	_, found = s.app.StakingKeeper.GetUnbondingDelegation(s.ctx, types.LiquidStakeProxyAcc, valOpers[2])
+	s.Require().False(found)
+	_, found = s.app.StakingKeeper.GetDelegation(s.ctx, types.LiquidStakeProxyAcc, valOpers[2])
+	s.Require().False(found)
+	s.Require().Len(s.keeper.GetAllLiquidValidators(s.ctx), 2)
+
+	totalLiquidTokens, _ := s.keeper.GetAllLiquidValidators(s.ctx).TotalLiquidTokens(s.ctx, s.app.StakingKeeper, false)
+	s.Require().EqualValues(stakingAmt, totalLiquidTokens)
+}
+
+func (s *KeeperTestSuite) TestInactiveValidatorPolicyManual() {
+	_, valOpers, _ := s.CreateValidators([]int64{2000000, 2000000, 2000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.InactiveValidatorPolicy = types.InactiveValidatorPolicyManual
+
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: math.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: math.NewInt(10)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: math.NewInt(10)},
+	}
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	stakingAmt := math.NewInt(90000000)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], stakingAmt))
+	val2, found := s.keeper.GetLiquidValidator(s.ctx, valOpers[2])
+	s.Require().True(found)
+	val2Tokens := val2.GetLiquidTokens(s.ctx, s.app.StakingKeeper, false)
+	s.Require().EqualValues(math.NewInt(30000000), val2Tokens)
+
+	// delisted val2 keeps its delegation and is excluded from rebalancing
+	params.WhitelistedValidators = params.WhitelistedValidators[:2]
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	reds := s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().Len(reds, 0)
+	s.Require().Len(s.keeper.GetAllLiquidValidators(s.ctx), 3)
+	s.Require().EqualValues(val2Tokens, val2.GetLiquidTokens(s.ctx, s.app.StakingKeeper, false))
+	_, found = s.app.StakingKeeper.GetUnbondingDelegation(s.ctx, types.LiquidStakeProxyAcc, valOpers[2])
+	s.Require().False(found)
+
+	held := false
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeHoldInactiveLiquidTokens {
+			held = true
+		}
+	}
+	s.Require().True(held)
+
+	// switching back to the unbond policy releases the held tokens
+	params.InactiveValidatorPolicy = types.InactiveValidatorPolicyUnbond
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	_, found = s.app.StakingKeeper.GetDelegation(s.ctx, types.LiquidStakeProxyAcc, valOpers[2])
+	s.Require().False(found)
+	s.Require().Len(s.keeper.GetAllLiquidValidators(s.ctx), 2)
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstake/types"
+)
+
+// SetRedelegationFailure records the redelegation failure at index of the rebalancing redelegations
+func (k Keeper) SetRedelegationFailure(ctx sdk.Context, failure types.RedelegationFailure, index int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&failure)
+	store.Set(types.GetRedelegationFailureKey(failure.Epoch, failure.Height, index), bz)
+}
+
+// GetRedelegationFailuresByEpoch returns the redelegation failures recorded during the epoch
+func (k Keeper) GetRedelegationFailuresByEpoch(ctx sdk.Context, epoch int64) []types.RedelegationFailure {
+	return k.getRedelegationFailures(ctx, types.GetRedelegationFailuresByEpochKey(epoch))
+}
+
+// GetAllRedelegationFailures returns all the recorded redelegation failures
+func (k Keeper) GetAllRedelegationFailures(ctx sdk.Context) []types.RedelegationFailure {
+	return k.getRedelegationFailures(ctx, types.RedelegationFailureKey)
+}
+
+func (k Keeper) getRedelegationFailures(ctx sdk.Context, prefix []byte) []types.RedelegationFailure {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	failures := []types.RedelegationFailure{}
+	for ; iterator.Valid(); iterator.Next() {
+		var failure types.RedelegationFailure
+		k.cdc.MustUnmarshal(iterator.Value(), &failure)
+		failures = append(failures, failure)
+	}
+	return failures
+}
+
+// RecordRedelegationFailures persists the failed redelegations of a rebalancing, under the current epoch
+func (k Keeper) RecordRedelegationFailures(ctx sdk.Context, redelegations []types.Redelegation) {
+	epoch := k.epochsKeeper.GetEpochInfo(ctx, types.RedelegationFailureEpoch).CurrentEpoch
+	for i, re := range redelegations {
+		if re.Error != nil {
+			k.SetRedelegationFailure(ctx, types.NewRedelegationFailure(epoch, ctx.BlockHeight(), re), i)
+		}
+	}
+}
+
+// PruneRedelegationFailures removes the redelegation failures recorded before the epoch
+func (k Keeper) PruneRedelegationFailures(ctx sdk.Context, beforeEpoch int64) {
+	if beforeEpoch <= 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.RedelegationFailureKey, types.GetRedelegationFailuresByEpochKey(beforeEpoch))
+	defer iterator.Close()
+
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
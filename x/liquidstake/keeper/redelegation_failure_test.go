@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"errors"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstake/types"
+)
+
+func (s *KeeperTestSuite) TestRedelegationFailures() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	epoch := s.app.EpochsKeeper.GetEpochInfo(s.ctx, types.RedelegationFailureEpoch).CurrentEpoch
+
+	redelegations := []types.Redelegation{
+		{
+			SrcValidator: types.LiquidValidator{OperatorAddress: valOpers[0].String()},
+			DstValidator: types.LiquidValidator{OperatorAddress: valOpers[1].String()},
+			Amount:       math.NewInt(1000),
+		},
+		{
+			SrcValidator: types.LiquidValidator{OperatorAddress: valOpers[0].String()},
+			DstValidator: types.LiquidValidator{OperatorAddress: valOpers[2].String()},
+			Amount:       math.NewInt(2000),
+			Error:        stakingtypes.ErrTransitiveRedelegation,
+		},
+	}
+	s.keeper.RecordRedelegationFailures(s.ctx, redelegations)
+
+	// only the failed redelegation is recorded
+	failures := s.keeper.GetRedelegationFailuresByEpoch(s.ctx, epoch)
+	s.Require().Len(failures, 1)
+	s.Require().Equal(types.RedelegationFailure{
+		Epoch:               epoch,
+		Height:              s.ctx.BlockHeight(),
+		SrcValidatorAddress: valOpers[0].String(),
+		DstValidatorAddress: valOpers[2].String(),
+		Amount:              math.NewInt(2000),
+		Error:               stakingtypes.ErrTransitiveRedelegation.Error(),
+	}, failures[0])
+	s.Require().NoError(failures[0].Validate())
+	s.Require().Empty(s.keeper.GetRedelegationFailuresByEpoch(s.ctx, epoch+1))
+
+	resp, err := s.querier.RedelegationFailures(sdk.WrapSDKContext(s.ctx), &types.QueryRedelegationFailuresRequest{Epoch: epoch})
+	s.Require().NoError(err)
+	s.Require().Equal(failures, resp.RedelegationFailures)
+
+	resp, err = s.querier.RedelegationFailures(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Nil(resp)
+	s.Require().ErrorIs(err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	// a later failure in the next epoch is kept apart
+	redelegations[0].Error = errors.New("redelegation failed")
+	s.keeper.SetRedelegationFailure(s.ctx, types.NewRedelegationFailure(epoch+1, s.ctx.BlockHeight(), redelegations[0]), 0)
+	s.Require().Len(s.keeper.GetAllRedelegationFailures(s.ctx), 2)
+
+	// records are kept for the retention period only
+	hooks := s.keeper.EpochHooks()
+	s.Require().NoError(hooks.AfterEpochEnd(s.ctx, types.RedelegationFailureEpoch, epoch+types.RedelegationFailureRetentionEpochs-1))
+	s.Require().Len(s.keeper.GetAllRedelegationFailures(s.ctx), 2)
+	s.Require().NoError(hooks.AfterEpochEnd(s.ctx, types.RedelegationFailureEpoch, epoch+types.RedelegationFailureRetentionEpochs))
+	s.Require().Empty(s.keeper.GetRedelegationFailuresByEpoch(s.ctx, epoch))
+	s.Require().Len(s.keeper.GetRedelegationFailuresByEpoch(s.ctx, epoch+1), 1)
+}
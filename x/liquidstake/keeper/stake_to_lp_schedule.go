@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstake/types"
+)
+
+// GetStakeToLPSchedule returns the StakeToLP schedule of the delegator for the validator
+func (k Keeper) GetStakeToLPSchedule(
+	ctx sdk.Context,
+	delegator sdk.AccAddress,
+	validator sdk.ValAddress,
+) (schedule types.StakeToLPSchedule, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetStakeToLPScheduleKey(delegator, validator))
+	if bz == nil {
+		return schedule, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &schedule)
+	return schedule, true
+}
+
+// SetStakeToLPSchedule sets the StakeToLP schedule
+func (k Keeper) SetStakeToLPSchedule(ctx sdk.Context, schedule types.StakeToLPSchedule) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&schedule)
+	store.Set(types.GetStakeToLPScheduleKey(schedule.GetDelegator(), schedule.GetValidator()), bz)
+}
+
+// DeleteStakeToLPSchedule removes the StakeToLP schedule
+func (k Keeper) DeleteStakeToLPSchedule(ctx sdk.Context, schedule types.StakeToLPSchedule) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetStakeToLPScheduleKey(schedule.GetDelegator(), schedule.GetValidator()))
+}
+
+// GetStakeToLPSchedulesByDelegator returns the StakeToLP schedules of the delegator
+func (k Keeper) GetStakeToLPSchedulesByDelegator(ctx sdk.Context, delegator sdk.AccAddress) []types.StakeToLPSchedule {
+	return k.getStakeToLPSchedules(ctx, types.GetStakeToLPSchedulesByDelegatorKey(delegator))
+}
+
+// GetAllStakeToLPSchedules returns all the StakeToLP schedules
+func (k Keeper) GetAllStakeToLPSchedules(ctx sdk.Context) []types.StakeToLPSchedule {
+	return k.getStakeToLPSchedules(ctx, types.StakeToLPScheduleKey)
+}
+
+func (k Keeper) getStakeToLPSchedules(ctx sdk.Context, prefix []byte) []types.StakeToLPSchedule {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	schedules := []types.StakeToLPSchedule{}
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.StakeToLPSchedule
+		k.cdc.MustUnmarshal(iterator.Value(), &schedule)
+		schedules = append(schedules, schedule)
+	}
+	return schedules
+}
+
+// HasStakeToLPGrant returns whether the delegator granted the module account an authorization for MsgStakeToLP.
+func (k Keeper) HasStakeToLPGrant(ctx sdk.Context, delegator sdk.AccAddress) bool {
+	authorization, _ := k.authzKeeper.GetAuthorization(
+		ctx,
+		k.accountKeeper.GetModuleAddress(types.ModuleName),
+		delegator,
+		sdk.MsgTypeURL(&types.MsgStakeToLP{}),
+	)
+	return authorization != nil
+}
+
+// ExecuteStakeToLPSchedules executes the due StakeToLP schedules through the authz grants given to the module account.
+// A failed execution is retried at the next interval, while schedules whose grant was revoked or expired are removed.
+func (k Keeper) ExecuteStakeToLPSchedules(ctx sdk.Context) {
+	logger := k.Logger(ctx)
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+
+	for _, schedule := range k.GetAllStakeToLPSchedules(ctx) {
+		if !schedule.IsDue(ctx.BlockTime()) {
+			continue
+		}
+
+		msg := schedule.StakeToLPMsg()
+		if !k.HasStakeToLPGrant(ctx, msg.GetDelegator()) {
+			k.DeleteStakeToLPSchedule(ctx, schedule)
+			ctx.EventManager().EmitEvents(sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeRemoveStakeToLPSchedule,
+					sdk.NewAttribute(types.AttributeKeyDelegator, schedule.DelegatorAddress),
+					sdk.NewAttribute(types.AttributeKeyValidator, schedule.ValidatorAddress),
+				),
+			})
+			logger.Info(types.EventTypeRemoveStakeToLPSchedule,
+				types.AttributeKeyDelegator, schedule.DelegatorAddress,
+				types.AttributeKeyValidator, schedule.ValidatorAddress)
+			continue
+		}
+
+		schedule.NextExecutionTime = ctx.BlockTime().Add(schedule.Interval)
+		k.SetStakeToLPSchedule(ctx, schedule)
+
+		cachedCtx, writeCache := ctx.CacheContext()
+		if _, err := k.authzKeeper.DispatchActions(cachedCtx, moduleAddr, []sdk.Msg{msg}); err != nil {
+			ctx.EventManager().EmitEvents(sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeStakeToLPScheduleFailed,
+					sdk.NewAttribute(types.AttributeKeyDelegator, schedule.DelegatorAddress),
+					sdk.NewAttribute(types.AttributeKeyValidator, schedule.ValidatorAddress),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+					sdk.NewAttribute(types.AttributeKeyNextExecutionTime, schedule.NextExecutionTime.Format(time.RFC3339)),
+				),
+			})
+			logger.Error("scheduled stake to LP failed",
+				types.AttributeKeyDelegator, schedule.DelegatorAddress,
+				types.AttributeKeyValidator, schedule.ValidatorAddress,
+				"error", err)
+			continue
+		}
+		writeCache()
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeExecuteStakeToLPSchedule,
+				sdk.NewAttribute(types.AttributeKeyDelegator, schedule.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeyValidator, schedule.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyStakedAmount, schedule.StakedAmount.String()),
+				sdk.NewAttribute(types.AttributeKeyLiquidAmount, schedule.LiquidAmount.String()),
+				sdk.NewAttribute(types.AttributeKeyNextExecutionTime, schedule.NextExecutionTime.Format(time.RFC3339)),
+			),
+		})
+		logger.Info(types.EventTypeExecuteStakeToLPSchedule,
+			types.AttributeKeyDelegator, schedule.DelegatorAddress,
+			types.AttributeKeyValidator, schedule.ValidatorAddress,
+			types.AttributeKeyNextExecutionTime, schedule.NextExecutionTime.Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstake/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstake/types"
+)
+
+func (s *KeeperTestSuite) TestStakeToLPSchedule() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: math.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: math.NewInt(1)},
+	}
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	delegator := s.delAddrs[0]
+	moduleAddr := s.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	bondDenom := s.app.StakingKeeper.BondDenom(s.ctx)
+	msg := types.NewMsgScheduleStakeToLP(
+		delegator,
+		valOpers[0],
+		sdk.NewInt64Coin(bondDenom, 1_000_000),
+		sdk.NewInt64Coin(bondDenom, 0),
+		24*time.Hour,
+	)
+
+	// the module must be granted an authorization first
+	_, err := msgServer.ScheduleStakeToLP(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().ErrorIs(err, types.ErrStakeToLPGrantNotFound)
+
+	msgType := sdk.MsgTypeURL(&types.MsgStakeToLP{})
+	s.Require().NoError(s.app.AuthzKeeper.SaveGrant(s.ctx, moduleAddr, delegator, authz.NewGenericAuthorization(msgType), nil))
+	res, err := msgServer.ScheduleStakeToLP(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+	s.Require().Equal(s.ctx.BlockTime().Add(24*time.Hour), res.NextExecutionTime)
+
+	querier := keeper.Querier{Keeper: s.keeper}
+	queryRes, err := querier.StakeToLPSchedules(sdk.WrapSDKContext(s.ctx), &types.QueryStakeToLPSchedulesRequest{
+		DelegatorAddress: delegator.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(queryRes.Schedules, 1)
+	s.Require().Equal(valOpers[0].String(), queryRes.Schedules[0].ValidatorAddress)
+
+	// nothing is executed before the interval elapses
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	s.keeper.ExecuteStakeToLPSchedules(s.ctx)
+	s.Require().Empty(s.ctx.EventManager().Events())
+
+	// a failed execution is reverted and postponed to the next interval
+	s.ctx = s.ctx.WithBlockTime(res.NextExecutionTime).WithEventManager(sdk.NewEventManager())
+	s.keeper.ExecuteStakeToLPSchedules(s.ctx)
+	events := s.ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Equal(types.EventTypeStakeToLPScheduleFailed, events[0].Type)
+	schedule, found := s.keeper.GetStakeToLPSchedule(s.ctx, delegator, valOpers[0])
+	s.Require().True(found)
+	s.Require().Equal(res.NextExecutionTime.Add(24*time.Hour), schedule.NextExecutionTime)
+
+	// the schedule is dropped once the grant is revoked
+	s.Require().NoError(s.app.AuthzKeeper.DeleteGrant(s.ctx, moduleAddr, delegator, msgType))
+	s.ctx = s.ctx.WithBlockTime(schedule.NextExecutionTime).WithEventManager(sdk.NewEventManager())
+	s.keeper.ExecuteStakeToLPSchedules(s.ctx)
+	events = s.ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Equal(types.EventTypeRemoveStakeToLPSchedule, events[0].Type)
+	s.Require().Empty(s.keeper.GetStakeToLPSchedulesByDelegator(s.ctx, delegator))
+
+	// cancelling removes the schedule
+	s.Require().NoError(s.app.AuthzKeeper.SaveGrant(s.ctx, moduleAddr, delegator, authz.NewGenericAuthorization(msgType), nil))
+	_, err = msgServer.ScheduleStakeToLP(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+	cancelMsg := types.NewMsgCancelStakeToLPSchedule(delegator, valOpers[1])
+	_, err = msgServer.CancelStakeToLPSchedule(sdk.WrapSDKContext(s.ctx), cancelMsg)
+	s.Require().ErrorIs(err, types.ErrStakeToLPScheduleNotFound)
+	cancelMsg = types.NewMsgCancelStakeToLPSchedule(delegator, valOpers[0])
+	_, err = msgServer.CancelStakeToLPSchedule(sdk.WrapSDKContext(s.ctx), cancelMsg)
+	s.Require().NoError(err)
+	s.Require().Empty(s.keeper.GetAllStakeToLPSchedules(s.ctx))
+}
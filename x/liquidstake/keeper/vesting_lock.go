@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstake/types"
+)
+
+// SetVestingLock stores the vesting lock of a vesting account
+func (k Keeper) SetVestingLock(ctx sdk.Context, lock types.VestingLock) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&lock)
+	store.Set(types.GetVestingLockKey(lock.GetAddress()), bz)
+}
+
+// GetVestingLock returns the vesting lock of a vesting account
+func (k Keeper) GetVestingLock(ctx sdk.Context, vestingAcc sdk.AccAddress) (lock types.VestingLock, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetVestingLockKey(vestingAcc))
+	if bz == nil {
+		return lock, false
+	}
+	k.cdc.MustUnmarshal(bz, &lock)
+	return lock, true
+}
+
+// DeleteVestingLock removes the vesting lock of a vesting account
+func (k Keeper) DeleteVestingLock(ctx sdk.Context, vestingAcc sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetVestingLockKey(vestingAcc))
+}
+
+// GetAllVestingLocks returns all the vesting locks
+func (k Keeper) GetAllVestingLocks(ctx sdk.Context) []types.VestingLock {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VestingLockKey)
+	defer iterator.Close()
+
+	locks := []types.VestingLock{}
+	for ; iterator.Valid(); iterator.Next() {
+		var lock types.VestingLock
+		k.cdc.MustUnmarshal(iterator.Value(), &lock)
+		locks = append(locks, lock)
+	}
+	return locks
+}
+
+// GetVestingLockedAmount returns the part of a liquid stake which is paid with the locked coins of a vesting account,
+// zero for the other accounts.
+func (k Keeper) GetVestingLockedAmount(ctx sdk.Context, liquidStaker sdk.AccAddress, stakingCoin sdk.Coin) math.Int {
+	if _, ok := k.accountKeeper.GetAccount(ctx, liquidStaker).(vestingexported.VestingAccount); !ok {
+		return sdk.ZeroInt()
+	}
+
+	spendable := k.bankKeeper.SpendableCoins(ctx, liquidStaker).AmountOf(stakingCoin.Denom)
+	if stakingCoin.Amount.LTE(spendable) {
+		return sdk.ZeroInt()
+	}
+	return stakingCoin.Amount.Sub(spendable)
+}
+
+// lockVestingStkXPRT keeps the stkXPRT minted for the locked coins of a vesting account in the module account
+func (k Keeper) lockVestingStkXPRT(ctx sdk.Context, vestingAcc sdk.AccAddress, lockedAmount, stkAmount math.Int) {
+	lock, found := k.GetVestingLock(ctx, vestingAcc)
+	if !found {
+		lock = types.VestingLock{
+			Address:      vestingAcc.String(),
+			LockedAmount: sdk.ZeroInt(),
+			StkAmount:    sdk.ZeroInt(),
+		}
+	}
+
+	lock.LockedAmount = lock.LockedAmount.Add(lockedAmount)
+	lock.StkAmount = lock.StkAmount.Add(stkAmount)
+	k.SetVestingLock(ctx, lock)
+}
+
+// ReleaseVestingLocks sends the vesting accounts the stkXPRT of their liquid staked coins which have vested since.
+// The coins still vesting are counted as liquid staked first, so the stkXPRT is only released once the account
+// has no more vesting coins than it has liquid staked.
+func (k Keeper) ReleaseVestingLocks(ctx sdk.Context) {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	liquidBondDenom := k.LiquidBondDenom(ctx)
+
+	for _, lock := range k.GetAllVestingLocks(ctx) {
+		vestingAcc := lock.GetAddress()
+
+		stillLocked := sdk.ZeroInt()
+		if acc, ok := k.accountKeeper.GetAccount(ctx, vestingAcc).(vestingexported.VestingAccount); ok {
+			stillLocked = math.MinInt(lock.LockedAmount, acc.GetVestingCoins(ctx.BlockTime()).AmountOf(bondDenom))
+		}
+		if stillLocked.Equal(lock.LockedAmount) {
+			continue
+		}
+
+		// the stkXPRT kept for the coins still vesting is rounded up, so no more than the vested share is released
+		stillLockedStk := sdk.NewDecFromInt(lock.StkAmount).MulInt(stillLocked).QuoInt(lock.LockedAmount).Ceil().TruncateInt()
+		released := sdk.NewCoin(liquidBondDenom, lock.StkAmount.Sub(stillLockedStk))
+		if released.IsPositive() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, vestingAcc, sdk.NewCoins(released)); err != nil {
+				k.Logger(ctx).Error("failed to release the vesting lock", "vesting_account", lock.Address, "error", err)
+				continue
+			}
+		}
+
+		if stillLocked.IsZero() {
+			k.DeleteVestingLock(ctx, vestingAcc)
+		} else {
+			lock.LockedAmount = stillLocked
+			lock.StkAmount = stillLockedStk
+			k.SetVestingLock(ctx, lock)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeReleaseVestingLock,
+				sdk.NewAttribute(types.AttributeKeyVestingAccount, lock.Address),
+				sdk.NewAttribute(types.AttributeKeyReleasedStkXPRTAmount, released.String()),
+				sdk.NewAttribute(types.AttributeKeyLockedStkXPRTAmount, sdk.NewCoin(liquidBondDenom, stillLockedStk).String()),
+			),
+		)
+	}
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstake/types"
+)
+
+// GetWhitelistRotation returns the scheduled whitelist rotation
+func (k Keeper) GetWhitelistRotation(ctx sdk.Context) (rotation types.WhitelistRotation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.WhitelistRotationKey)
+	if bz == nil {
+		return rotation, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &rotation)
+	return rotation, true
+}
+
+// SetWhitelistRotation sets the scheduled whitelist rotation
+func (k Keeper) SetWhitelistRotation(ctx sdk.Context, rotation types.WhitelistRotation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&rotation)
+	store.Set(types.WhitelistRotationKey, bz)
+}
+
+// DeleteWhitelistRotation removes the scheduled whitelist rotation
+func (k Keeper) DeleteWhitelistRotation(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.WhitelistRotationKey)
+}
+
+// ApplyWhitelistRotationStep updates the whitelisted validators with the rotation step of the ended epoch.
+// The previous whitelist is saved on the first step, and the rotation is removed once the last step is applied.
+// The new weights are picked up by the next UpdateLiquidValidatorSet, which rebalances towards them.
+func (k Keeper) ApplyWhitelistRotationStep(ctx sdk.Context, epochNumber int64) {
+	rotation, found := k.GetWhitelistRotation(ctx)
+	if !found {
+		return
+	}
+
+	step := rotation.GetStep(epochNumber)
+	if step == 0 {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	if !rotation.Started {
+		rotation.PreviousWhitelistedValidators = params.WhitelistedValidators
+		rotation.Started = true
+	}
+
+	params.WhitelistedValidators = rotation.GetStepWhitelistedValidators(step)
+	if err := k.SetParams(ctx, params); err != nil {
+		k.Logger(ctx).Error("failed to apply whitelist rotation step, dropping the rotation", "step", step, "error", err)
+		k.DeleteWhitelistRotation(ctx)
+		return
+	}
+
+	if step >= rotation.TransitionEpochs {
+		k.DeleteWhitelistRotation(ctx)
+	} else {
+		k.SetWhitelistRotation(ctx, rotation)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWhitelistRotationStep,
+			sdk.NewAttribute(types.AttributeKeyRotationStep, strconv.FormatInt(step, 10)),
+			sdk.NewAttribute(types.AttributeKeyTransitionEpochs, strconv.FormatInt(rotation.TransitionEpochs, 10)),
+		),
+	})
+	k.Logger(ctx).Info(types.EventTypeWhitelistRotationStep,
+		types.AttributeKeyRotationStep, step,
+		types.AttributeKeyTransitionEpochs, rotation.TransitionEpochs)
+}
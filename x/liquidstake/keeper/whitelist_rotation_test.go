@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstake/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstake/types"
+)
+
+func (s *KeeperTestSuite) TestWhitelistRotation() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: math.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: math.NewInt(1)},
+	}
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], math.NewInt(1_000_000)))
+
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	startEpoch := s.app.EpochsKeeper.GetEpochInfo(s.ctx, types.WhitelistRotationEpoch).CurrentEpoch + 1
+	newWhitelist := []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: math.NewInt(1)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: math.NewInt(1)},
+	}
+
+	_, err := msgServer.ScheduleWhitelistRotation(sdk.WrapSDKContext(s.ctx), &types.MsgScheduleWhitelistRotation{
+		Authority:             s.delAddrs[0].String(),
+		WhitelistedValidators: newWhitelist,
+		StartEpoch:            startEpoch,
+		TransitionEpochs:      2,
+	})
+	s.Require().ErrorIs(err, sdkerrors.ErrorInvalidSigner)
+
+	_, err = msgServer.ScheduleWhitelistRotation(sdk.WrapSDKContext(s.ctx), &types.MsgScheduleWhitelistRotation{
+		Authority:             authority,
+		WhitelistedValidators: newWhitelist,
+		StartEpoch:            startEpoch - 2,
+		TransitionEpochs:      2,
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidWhitelistRotation)
+
+	_, err = msgServer.ScheduleWhitelistRotation(sdk.WrapSDKContext(s.ctx), &types.MsgScheduleWhitelistRotation{
+		Authority:             authority,
+		WhitelistedValidators: newWhitelist,
+		StartEpoch:            startEpoch,
+		TransitionEpochs:      2,
+	})
+	s.Require().NoError(err)
+
+	// nothing changes before the start epoch ends
+	hooks := s.keeper.EpochHooks()
+	s.Require().NoError(hooks.AfterEpochEnd(s.ctx, types.WhitelistRotationEpoch, startEpoch-1))
+	s.Require().Equal(params.WhitelistedValidators, s.keeper.GetParams(s.ctx).WhitelistedValidators)
+
+	// first step keeps the removed validator with a lower weight
+	s.Require().NoError(hooks.AfterEpochEnd(s.ctx, types.WhitelistRotationEpoch, startEpoch))
+	s.Require().Equal([]types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: math.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: math.NewInt(2)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: math.NewInt(1)},
+	}, s.keeper.GetParams(s.ctx).WhitelistedValidators)
+
+	redelegations := s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NotEmpty(redelegations)
+	s.Require().Equal(0, s.redelegationsErrorCount(redelegations))
+	lv, found := s.keeper.GetLiquidValidator(s.ctx, valOpers[0])
+	s.Require().True(found)
+	s.Require().Equal(math.NewInt(250_000), lv.GetLiquidTokens(s.ctx, s.app.StakingKeeper, false))
+
+	// a started rotation can't be replaced
+	_, err = msgServer.ScheduleWhitelistRotation(sdk.WrapSDKContext(s.ctx), &types.MsgScheduleWhitelistRotation{
+		Authority:             authority,
+		WhitelistedValidators: params.WhitelistedValidators,
+		StartEpoch:            startEpoch + 5,
+		TransitionEpochs:      1,
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidWhitelistRotation)
+
+	// last step applies the new whitelist and removes the rotation
+	s.Require().NoError(hooks.AfterEpochEnd(s.ctx, types.WhitelistRotationEpoch, startEpoch+1))
+	s.Require().Equal(newWhitelist, s.keeper.GetParams(s.ctx).WhitelistedValidators)
+	_, found = s.keeper.GetWhitelistRotation(s.ctx)
+	s.Require().False(found)
+
+	resp, err := s.querier.WhitelistRotation(sdk.WrapSDKContext(s.ctx), &types.QueryWhitelistRotationRequest{})
+	s.Require().NoError(err)
+	s.Require().Nil(resp.WhitelistRotation)
+}
@@ -14,6 +14,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgStakeToLP{}, "liquidstake/MsgStakeToLP", nil)
 	cdc.RegisterConcrete(&MsgLiquidUnstake{}, "liquidstake/MsgLiquidUnstake", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "liquidstake/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgScheduleWhitelistRotation{}, "liquidstake/MsgScheduleWhitelistRotation", nil)
+	cdc.RegisterConcrete(&MsgScheduleStakeToLP{}, "liquidstake/MsgScheduleStakeToLP", nil)
+	cdc.RegisterConcrete(&MsgCancelStakeToLPSchedule{}, "liquidstake/MsgCancelStakeToLPSchedule", nil)
+	cdc.RegisterConcrete(&MsgRepairProxyWithdrawAddress{}, "liquidstake/MsgRepairProxyWithdrawAddress", nil)
 }
 
 // RegisterInterfaces registers the x/liquidstake interfaces types with the interface registry.
@@ -24,6 +28,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgStakeToLP{},
 		&MsgLiquidUnstake{},
 		&MsgUpdateParams{},
+		&MsgScheduleWhitelistRotation{},
+		&MsgScheduleStakeToLP{},
+		&MsgCancelStakeToLPSchedule{},
+		&MsgRepairProxyWithdrawAddress{},
 	)
 }
 
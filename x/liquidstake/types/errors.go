@@ -21,4 +21,13 @@ var (
 	ErrLSMTokenizeFailed               = errors.Register(ModuleName, 16, "LSM tokenization failed")
 	ErrLSMRedeemFailed                 = errors.Register(ModuleName, 17, "LSM redemption failed")
 	ErrLPContract                      = errors.Register(ModuleName, 18, "CW contract execution failed")
+	ErrLockedVestingCoins              = errors.Register(ModuleName, 19, "vesting account coins are still locked")
+	ErrInvalidWhitelistRotation        = errors.Register(ModuleName, 20, "invalid whitelist rotation")
+	ErrInvalidStakeToLPSchedule        = errors.Register(ModuleName, 21, "invalid stake to LP schedule")
+	ErrStakeToLPGrantNotFound          = errors.Register(ModuleName, 22, "stake to LP authorization not granted to the module")
+	ErrStakeToLPScheduleNotFound       = errors.Register(ModuleName, 23, "stake to LP schedule not found")
+	ErrInvalidProxyWithdrawAddress     = errors.Register(ModuleName, 24, "proxy account withdraw address is not the proxy account")
+	ErrDeadlineExceeded                = errors.Register(ModuleName, 25, "message deadline exceeded")
+	ErrMinOutNotMet                    = errors.Register(ModuleName, 26, "output amount is less than the minimum")
+	ErrInvalidVestingLock              = errors.Register(ModuleName, 27, "invalid vesting lock")
 )
@@ -2,15 +2,26 @@ package types
 
 // Event types for the liquidstake module.
 const (
-	EventTypeMsgLiquidStake             = MsgTypeLiquidStake
-	EventTypeMsgLiquidUnstake           = MsgTypeLiquidUnstake
-	EventTypeMsgStakeToLP               = MsgTypeStakeToLP
-	EventTypeMsgUpdateParams            = MsgTypeUpdateParams
-	EventTypeAddLiquidValidator         = "add_liquid_validator"
-	EventTypeRemoveLiquidValidator      = "remove_liquid_validator"
-	EventTypeBeginRebalancing           = "begin_rebalancing"
-	EventTypeAutocompound               = "autocompound"
-	EventTypeUnbondInactiveLiquidTokens = "unbond_inactive_liquid_tokens"
+	EventTypeMsgLiquidStake                 = MsgTypeLiquidStake
+	EventTypeMsgLiquidUnstake               = MsgTypeLiquidUnstake
+	EventTypeMsgStakeToLP                   = MsgTypeStakeToLP
+	EventTypeMsgUpdateParams                = MsgTypeUpdateParams
+	EventTypeMsgScheduleWhitelistRotation   = MsgTypeScheduleWhitelistRotation
+	EventTypeWhitelistRotationStep          = "whitelist_rotation_step"
+	EventTypeAddLiquidValidator             = "add_liquid_validator"
+	EventTypeRemoveLiquidValidator          = "remove_liquid_validator"
+	EventTypeBeginRebalancing               = "begin_rebalancing"
+	EventTypeAutocompound                   = "autocompound"
+	EventTypeUnbondInactiveLiquidTokens     = "unbond_inactive_liquid_tokens"
+	EventTypeRedelegateInactiveLiquidTokens = "redelegate_inactive_liquid_tokens"
+	EventTypeHoldInactiveLiquidTokens       = "hold_inactive_liquid_tokens"
+	EventTypeMsgScheduleStakeToLP           = MsgTypeScheduleStakeToLP
+	EventTypeMsgCancelStakeToLPSchedule     = MsgTypeCancelStakeToLPSchedule
+	EventTypeExecuteStakeToLPSchedule       = "execute_stake_to_lp_schedule"
+	EventTypeStakeToLPScheduleFailed        = "stake_to_lp_schedule_failed"
+	EventTypeRemoveStakeToLPSchedule        = "remove_stake_to_lp_schedule"
+	EventTypeMsgRepairProxyWithdrawAddress  = MsgTypeRepairProxyWithdrawAddress
+	EventTypeReleaseVestingLock             = "release_vesting_lock"
 
 	AttributeKeyDelegator             = "delegator"
 	AttributeKeyNewShares             = "new_shares"
@@ -19,14 +30,34 @@ const (
 	AttributeKeyUnbondingAmount       = "unbonding_amount"
 	AttributeKeyUnbondedAmount        = "unbonded_amount"
 	AttributeKeyLiquidValidator       = "liquid_validator"
+	AttributeKeyDstLiquidValidator    = "dst_liquid_validator"
 	AttributeKeyRedelegationCount     = "redelegation_count"
 	AttributeKeyRedelegationFailCount = "redelegation_fail_count"
 	AttributeKeyLiquidAmount          = "liquid_amount"
 	AttributeKeyStakedAmount          = "staked_amount"
 	AttributeKeyPstakeAutocompoundFee = "pstake_autocompound_fee"
+	AttributeKeyFeeAccountFee         = "fee_account_fee"
+	AttributeKeyCommunityPoolFee      = "community_pool_fee"
 
 	AttributeKeyAuthority     = "authority"
 	AttributeKeyUpdatedParams = "updated_params"
 
+	AttributeKeyStartEpoch            = "start_epoch"
+	AttributeKeyTransitionEpochs      = "transition_epochs"
+	AttributeKeyRotationStep          = "rotation_step"
+	AttributeKeyWhitelistedValidators = "whitelisted_validators"
+
+	AttributeKeyValidator         = "validator"
+	AttributeKeyInterval          = "interval"
+	AttributeKeyNextExecutionTime = "next_execution_time"
+	AttributeKeyError             = "error"
+
+	AttributeKeyWithdrawAddress         = "withdraw_address"
+	AttributeKeyPreviousWithdrawAddress = "previous_withdraw_address"
+
+	AttributeKeyVestingAccount        = "vesting_account"
+	AttributeKeyLockedStkXPRTAmount   = "locked_stkxprt_amount"
+	AttributeKeyReleasedStkXPRTAmount = "released_stkxprt_amount"
+
 	AttributeValueCategory = ModuleName
 )
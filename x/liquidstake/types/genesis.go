@@ -8,8 +8,11 @@ import (
 // NewGenesisState returns new GenesisState instance.
 func NewGenesisState(params Params, liquidValidators []LiquidValidator) *GenesisState {
 	return &GenesisState{
-		Params:           params,
-		LiquidValidators: liquidValidators,
+		Params:               params,
+		LiquidValidators:     liquidValidators,
+		StakeToLPSchedules:   []StakeToLPSchedule{},
+		RedelegationFailures: []RedelegationFailure{},
+		VestingLocks:         []VestingLock{},
 	}
 }
 
@@ -33,5 +36,30 @@ func ValidateGenesis(data GenesisState) error {
 				"invalid liquid validator %s: %v", lv, err)
 		}
 	}
+	if data.WhitelistRotation != nil {
+		if err := data.WhitelistRotation.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, schedule := range data.StakeToLPSchedules {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, failure := range data.RedelegationFailures {
+		if err := failure.Validate(); err != nil {
+			return err
+		}
+	}
+	vestingLocks := make(map[string]bool)
+	for _, lock := range data.VestingLocks {
+		if err := lock.Validate(); err != nil {
+			return err
+		}
+		if vestingLocks[lock.Address] {
+			return errors.Wrapf(ErrInvalidVestingLock, "duplicate vesting lock for %s", lock.Address)
+		}
+		vestingLocks[lock.Address] = true
+	}
 	return nil
 }
@@ -28,6 +28,13 @@ type GenesisState struct {
 	// params defines all the parameters for the liquidstake module
 	Params           Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	LiquidValidators []LiquidValidator `protobuf:"bytes,2,rep,name=liquid_validators,json=liquidValidators,proto3" json:"liquid_validators"`
+	// whitelist_rotation is the scheduled whitelist rotation, if any
+	WhitelistRotation    *WhitelistRotation    `protobuf:"bytes,3,opt,name=whitelist_rotation,json=whitelistRotation,proto3" json:"whitelist_rotation,omitempty"`
+	StakeToLPSchedules   []StakeToLPSchedule   `protobuf:"bytes,4,rep,name=stake_to_lp_schedules,json=stakeToLpSchedules,proto3" json:"stake_to_lp_schedules"`
+	RedelegationFailures []RedelegationFailure `protobuf:"bytes,5,rep,name=redelegation_failures,json=redelegationFailures,proto3" json:"redelegation_failures"`
+	// vesting_locks is the stkXPRT held for the vesting accounts until their
+	// liquid staked coins vest
+	VestingLocks []VestingLock `protobuf:"bytes,6,rep,name=vesting_locks,json=vestingLocks,proto3" json:"vesting_locks"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -72,25 +79,35 @@ func init() {
 }
 
 var fileDescriptor_bbc03e56b740bb6c = []byte{
-	// 279 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x28, 0x28, 0x2e, 0x49,
-	0xcc, 0x4e, 0xd5, 0xcf, 0xc9, 0x2c, 0x2c, 0xcd, 0x4c, 0x81, 0xb0, 0xcb, 0x0c, 0x93, 0x52, 0x4b,
-	0x12, 0x0d, 0xf5, 0xd3, 0x53, 0xf3, 0x52, 0x8b, 0x33, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2,
-	0x85, 0xa4, 0x20, 0x2a, 0xf5, 0x90, 0x54, 0xea, 0x41, 0x55, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7,
-	0x83, 0x95, 0xe9, 0x83, 0x58, 0x10, 0x1d, 0x52, 0x3a, 0x78, 0xcc, 0x46, 0x36, 0x05, 0xac, 0x5a,
-	0x69, 0x17, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0xc6, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x07, 0x2e,
-	0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x25, 0x3d,
-	0xdc, 0x2e, 0xd0, 0x0b, 0x00, 0xab, 0x74, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0x4f,
-	0x28, 0x8e, 0x4b, 0x10, 0xa2, 0x36, 0xbe, 0x2c, 0x31, 0x27, 0x33, 0x25, 0xb1, 0x24, 0xbf, 0xa8,
-	0x58, 0x82, 0x49, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x1b, 0x9f, 0x61, 0x3e, 0x60, 0xb1, 0x30, 0x98,
-	0x1e, 0xa8, 0xa9, 0x02, 0x39, 0xa8, 0xc2, 0xc5, 0x56, 0x1c, 0x1d, 0x0b, 0xe4, 0x19, 0x5e, 0x2c,
-	0x90, 0x67, 0x70, 0x8a, 0x59, 0xf1, 0x48, 0x8e, 0xf1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4,
-	0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f,
-	0xe5, 0x18, 0xa2, 0xec, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x0b,
-	0x52, 0x8b, 0x8a, 0x33, 0x8b, 0x4b, 0x52, 0xf3, 0x92, 0x53, 0xfd, 0xf3, 0x52, 0xf5, 0x21, 0xae,
-	0xd0, 0xcd, 0x4b, 0x2c, 0xc9, 0x2c, 0x4b, 0xd5, 0x2f, 0x33, 0xd2, 0xaf, 0x40, 0x09, 0xae, 0x92,
-	0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x08, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x54,
-	0xe3, 0xaa, 0x70, 0xad, 0x01, 0x00, 0x00,
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x6d, 0x12, 0x22, 0xe4, 0x16, 0x89, 0xae, 0x5a, 0xc9, 0xca, 0xc1, 0x89, 0x7a, 0x21,
+	0x12, 0xd4, 0x56, 0xc3, 0x8d, 0x03, 0x42, 0x39, 0xc0, 0x25, 0x12, 0x95, 0x83, 0x8a, 0x84, 0x2a,
+	0xac, 0x8d, 0x33, 0x38, 0x4b, 0xb6, 0x5e, 0xe3, 0x19, 0xbb, 0x20, 0xf1, 0x00, 0x1c, 0x79, 0x84,
+	0x1e, 0x79, 0x94, 0x1e, 0x7b, 0x42, 0x9c, 0x2a, 0xe4, 0x5c, 0x78, 0x0c, 0x94, 0xf5, 0x16, 0x52,
+	0x22, 0xcc, 0x6d, 0xfd, 0xef, 0xff, 0xfd, 0xff, 0x78, 0x35, 0xce, 0x20, 0x43, 0xe2, 0x0b, 0x08,
+	0xa4, 0x78, 0x5f, 0x88, 0x59, 0x7d, 0x2e, 0x0f, 0xa7, 0x40, 0xfc, 0x30, 0x48, 0x20, 0x05, 0x14,
+	0xe8, 0x67, 0xb9, 0x22, 0xc5, 0xba, 0xb5, 0xd3, 0x5f, 0x73, 0xfa, 0xc6, 0xd9, 0xdd, 0x4d, 0x54,
+	0xa2, 0xb4, 0x2d, 0x58, 0x9d, 0x6a, 0xa2, 0xfb, 0xb0, 0x21, 0x7b, 0x3d, 0x45, 0xbb, 0xf7, 0xbf,
+	0xb5, 0x9d, 0xed, 0xe7, 0x75, 0xe3, 0x84, 0x38, 0x01, 0x7b, 0xea, 0x74, 0x32, 0x9e, 0xf3, 0x53,
+	0x74, 0xed, 0xbe, 0x3d, 0xd8, 0x1a, 0xee, 0xfb, 0xff, 0x9e, 0xc0, 0x3f, 0xd2, 0xce, 0x51, 0xfb,
+	0xe2, 0xaa, 0x67, 0x85, 0x86, 0x63, 0x6f, 0x9c, 0x9d, 0xda, 0x1b, 0x95, 0x5c, 0x8a, 0x19, 0x27,
+	0x95, 0xa3, 0x7b, 0xab, 0xdf, 0x1a, 0x6c, 0x0d, 0x1f, 0x34, 0x85, 0x8d, 0xb5, 0x76, 0x7c, 0xcd,
+	0x98, 0xd4, 0x7b, 0xf2, 0xa6, 0x8c, 0xec, 0xc4, 0x61, 0x67, 0x73, 0x41, 0x20, 0x05, 0x52, 0x94,
+	0x2b, 0xe2, 0x24, 0x54, 0xea, 0xb6, 0xf4, 0xb4, 0x07, 0x4d, 0x05, 0xaf, 0xae, 0xa9, 0xd0, 0x40,
+	0xe1, 0xce, 0xd9, 0xdf, 0x12, 0xfb, 0xe4, 0xec, 0x69, 0x2a, 0x22, 0x15, 0xc9, 0x2c, 0xc2, 0x78,
+	0x0e, 0xb3, 0x42, 0x02, 0xba, 0xed, 0x7e, 0xeb, 0x7f, 0x05, 0x93, 0xd5, 0xd7, 0x4b, 0x35, 0x3e,
+	0x9a, 0x18, 0x6a, 0xd4, 0x5d, 0xfd, 0x43, 0x75, 0xd5, 0x63, 0x1b, 0x57, 0x18, 0x32, 0x34, 0x5a,
+	0xf6, 0x5b, 0x63, 0xef, 0x9c, 0xbd, 0x1c, 0x66, 0x20, 0x21, 0xd1, 0xd3, 0x44, 0x6f, 0xb9, 0x90,
+	0x45, 0x0e, 0xe8, 0xde, 0xd6, 0xed, 0x41, 0x53, 0x7b, 0xb8, 0x06, 0x3e, 0xab, 0x39, 0xf3, 0x86,
+	0xbb, 0xf9, 0xe6, 0x15, 0xb2, 0xd0, 0xb9, 0x5b, 0x02, 0x92, 0x48, 0x93, 0x48, 0xaa, 0x78, 0x81,
+	0x6e, 0x47, 0x77, 0xdc, 0x6f, 0xea, 0x38, 0xae, 0x81, 0xb1, 0x8a, 0x17, 0x26, 0x7b, 0xbb, 0xfc,
+	0x23, 0xe1, 0xe3, 0x3b, 0x9f, 0xcf, 0x7b, 0xd6, 0xcf, 0xf3, 0x9e, 0x35, 0x3a, 0xf9, 0x5a, 0x79,
+	0xf6, 0x45, 0xe5, 0xd9, 0x97, 0x95, 0x67, 0xff, 0xa8, 0x3c, 0xfb, 0xcb, 0xd2, 0xb3, 0x2e, 0x97,
+	0x9e, 0xf5, 0x7d, 0xe9, 0x59, 0xaf, 0x9f, 0x24, 0x82, 0xe6, 0xc5, 0xd4, 0x8f, 0xd5, 0x69, 0x90,
+	0x41, 0x8e, 0x02, 0x09, 0xd2, 0x18, 0x5e, 0xa4, 0x10, 0xd4, 0xed, 0x07, 0x29, 0x27, 0x51, 0x42,
+	0x50, 0x0e, 0x83, 0x0f, 0x37, 0x56, 0x99, 0x3e, 0x66, 0x80, 0xd3, 0x8e, 0xde, 0xde, 0x47, 0xbf,
+	0x06, 0x00, 0xe4, 0x62, 0x20, 0xbc, 0x49, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -113,6 +130,60 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VestingLocks) > 0 {
+		for iNdEx := len(m.VestingLocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RedelegationFailures) > 0 {
+		for iNdEx := len(m.RedelegationFailures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedelegationFailures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.StakeToLPSchedules) > 0 {
+		for iNdEx := len(m.StakeToLPSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeToLPSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.WhitelistRotation != nil {
+		{
+			size, err := m.WhitelistRotation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.LiquidValidators) > 0 {
 		for iNdEx := len(m.LiquidValidators) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -165,6 +236,28 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.WhitelistRotation != nil {
+		l = m.WhitelistRotation.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.StakeToLPSchedules) > 0 {
+		for _, e := range m.StakeToLPSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RedelegationFailures) > 0 {
+		for _, e := range m.RedelegationFailures {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VestingLocks) > 0 {
+		for _, e := range m.VestingLocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -270,6 +363,144 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistRotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WhitelistRotation == nil {
+				m.WhitelistRotation = &WhitelistRotation{}
+			}
+			if err := m.WhitelistRotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeToLPSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeToLPSchedules = append(m.StakeToLPSchedules, StakeToLPSchedule{})
+			if err := m.StakeToLPSchedules[len(m.StakeToLPSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationFailures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedelegationFailures = append(m.RedelegationFailures, RedelegationFailure{})
+			if err := m.RedelegationFailures[len(m.RedelegationFailures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingLocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingLocks = append(m.VestingLocks, VestingLock{})
+			if err := m.VestingLocks[len(m.VestingLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
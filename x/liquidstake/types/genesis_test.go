@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstake/types"
@@ -49,6 +50,31 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			"unstake fee rate must not be nil",
 		},
+		{
+			"negative redelegation failure epoch",
+			func(genState *types.GenesisState) {
+				genState.RedelegationFailures = []types.RedelegationFailure{
+					{
+						Epoch:  -1,
+						Amount: math.NewInt(1),
+					},
+				}
+			},
+			"redelegation failure epoch and height must not be negative: -1, 0",
+		},
+		{
+			"zero vesting lock locked amount",
+			func(genState *types.GenesisState) {
+				genState.VestingLocks = []types.VestingLock{
+					{
+						Address:      sdk.AccAddress("vesting_account_____").String(),
+						LockedAmount: math.ZeroInt(),
+						StkAmount:    math.NewInt(1),
+					},
+				}
+			},
+			"locked amount must be positive: 0: invalid vesting lock",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			genState := types.DefaultGenesisState()
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -16,8 +18,23 @@ const (
 	// To avoid collision with liquidstakeibc we make it xprtliquidstake
 	StoreKey = "xprt" + ModuleName
 
+	// TStoreKey is the transient store key for the liquidstake module, holding the per block caches
+	TStoreKey = "transient_" + StoreKey
+
 	// QuerierRoute is the querier route for the liquidstake module
 	QuerierRoute = ModuleName
+
+	// WhitelistRotationEpoch is the epoch identifier scheduled whitelist rotations advance on
+	WhitelistRotationEpoch = "day"
+
+	// MinStakeToLPScheduleInterval is the shortest interval a StakeToLP schedule can be executed at
+	MinStakeToLPScheduleInterval = time.Hour
+
+	// RedelegationFailureEpoch is the epoch identifier redelegation failures are recorded by
+	RedelegationFailureEpoch = "day"
+
+	// RedelegationFailureRetentionEpochs is the number of epochs redelegation failures are kept for
+	RedelegationFailureRetentionEpochs = 7
 )
 
 var (
@@ -25,6 +42,21 @@ var (
 
 	// LiquidValidatorsKey defines prefix for each key to a liquid validator
 	LiquidValidatorsKey = []byte{0x02}
+
+	// WhitelistRotationKey defines the key for the scheduled whitelist rotation
+	WhitelistRotationKey = []byte{0x03}
+
+	// StakeToLPScheduleKey defines prefix for each key to a StakeToLP schedule
+	StakeToLPScheduleKey = []byte{0x04}
+
+	// RedelegationFailureKey defines prefix for each key to a redelegation failure
+	RedelegationFailureKey = []byte{0x05}
+
+	// VestingLockKey defines prefix for each key to a vesting lock
+	VestingLockKey = []byte{0x06}
+
+	// NetAmountStateCacheKey defines the transient store key for the delegation part of the net amount state
+	NetAmountStateCacheKey = []byte{0x01}
 )
 
 // GetLiquidValidatorKey creates the key for the liquid validator with address
@@ -33,3 +65,35 @@ func GetLiquidValidatorKey(operatorAddr sdk.ValAddress) []byte {
 	tmp := append([]byte{}, LiquidValidatorsKey...)
 	return append(tmp, address.MustLengthPrefix(operatorAddr)...)
 }
+
+// GetStakeToLPSchedulesByDelegatorKey creates the key prefix for the StakeToLP schedules of the delegator
+func GetStakeToLPSchedulesByDelegatorKey(delegator sdk.AccAddress) []byte {
+	tmp := append([]byte{}, StakeToLPScheduleKey...)
+	return append(tmp, address.MustLengthPrefix(delegator)...)
+}
+
+// GetStakeToLPScheduleKey creates the key for the StakeToLP schedule of the delegator for the validator
+// VALUE: liquidstake/StakeToLPSchedule
+func GetStakeToLPScheduleKey(delegator sdk.AccAddress, validator sdk.ValAddress) []byte {
+	return append(GetStakeToLPSchedulesByDelegatorKey(delegator), address.MustLengthPrefix(validator)...)
+}
+
+// GetRedelegationFailuresByEpochKey creates the key prefix for the redelegation failures of the epoch
+func GetRedelegationFailuresByEpochKey(epoch int64) []byte {
+	tmp := append([]byte{}, RedelegationFailureKey...)
+	return append(tmp, sdk.Uint64ToBigEndian(uint64(epoch))...)
+}
+
+// GetRedelegationFailureKey creates the key for the redelegation failure at index of the rebalancing at height
+// VALUE: liquidstake/RedelegationFailure
+func GetRedelegationFailureKey(epoch, height int64, index int) []byte {
+	tmp := append(GetRedelegationFailuresByEpochKey(epoch), sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(tmp, sdk.Uint64ToBigEndian(uint64(index))...)
+}
+
+// GetVestingLockKey creates the key for the vesting lock of the vesting account
+// VALUE: liquidstake/VestingLock
+func GetVestingLockKey(vestingAcc sdk.AccAddress) []byte {
+	tmp := append([]byte{}, VestingLockKey...)
+	return append(tmp, address.MustLengthPrefix(vestingAcc)...)
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"time"
+
+	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -25,6 +28,73 @@ func GetWhitelistedValsMap(whitelistedValidators []WhitelistedValidator) Whiteli
 	return whitelistedValsMap
 }
 
+// Validate validates WhitelistRotation.
+func (r WhitelistRotation) Validate() error {
+	if err := validateWhitelistedValidators(r.WhitelistedValidators); err != nil {
+		return errors.Wrap(ErrInvalidWhitelistRotation, err.Error())
+	}
+	if err := validateWhitelistedValidators(r.PreviousWhitelistedValidators); err != nil {
+		return errors.Wrap(ErrInvalidWhitelistRotation, err.Error())
+	}
+	if r.StartEpoch < 0 {
+		return errors.Wrapf(ErrInvalidWhitelistRotation, "start epoch must not be negative: %d", r.StartEpoch)
+	}
+	if r.TransitionEpochs < 1 {
+		return errors.Wrapf(ErrInvalidWhitelistRotation, "transition epochs must be positive: %d", r.TransitionEpochs)
+	}
+	return nil
+}
+
+// GetStep returns the rotation step applied at the end of the given epoch, zero before the start epoch.
+func (r WhitelistRotation) GetStep(epochNumber int64) int64 {
+	if epochNumber < r.StartEpoch {
+		return 0
+	}
+	return epochNumber - r.StartEpoch + 1
+}
+
+// GetStepWhitelistedValidators returns the whitelist for a rotation step. Target weights are interpolated
+// between the previous and the new whitelist, validators leaving the whitelist keep a decreasing weight
+// until the last step so their delegations are moved out by rebalancing instead of being unbonded at once.
+func (r WhitelistRotation) GetStepWhitelistedValidators(step int64) []WhitelistedValidator {
+	if step >= r.TransitionEpochs {
+		return r.WhitelistedValidators
+	}
+
+	remaining := math.NewInt(r.TransitionEpochs - step)
+	elapsed := math.NewInt(step)
+	newValsMap := GetWhitelistedValsMap(r.WhitelistedValidators)
+	previousValsMap := GetWhitelistedValsMap(r.PreviousWhitelistedValidators)
+
+	whitelistedValidators := make([]WhitelistedValidator, 0, len(r.PreviousWhitelistedValidators)+len(r.WhitelistedValidators))
+	for _, wv := range r.PreviousWhitelistedValidators {
+		weight := wv.TargetWeight.Mul(remaining)
+		if nv, ok := newValsMap[wv.ValidatorAddress]; ok {
+			weight = weight.Add(nv.TargetWeight.Mul(elapsed))
+		}
+		if weight.IsPositive() {
+			whitelistedValidators = append(whitelistedValidators, WhitelistedValidator{
+				ValidatorAddress: wv.ValidatorAddress,
+				TargetWeight:     weight,
+			})
+		}
+	}
+	for _, wv := range r.WhitelistedValidators {
+		if previousValsMap.IsListed(wv.ValidatorAddress) {
+			continue
+		}
+		weight := wv.TargetWeight.Mul(elapsed)
+		if weight.IsPositive() {
+			whitelistedValidators = append(whitelistedValidators, WhitelistedValidator{
+				ValidatorAddress: wv.ValidatorAddress,
+				TargetWeight:     weight,
+			})
+		}
+	}
+
+	return whitelistedValidators
+}
+
 // Validate validates LiquidValidator.
 func (v LiquidValidator) Validate() error {
 	_, valErr := sdk.ValAddressFromBech32(v.OperatorAddress)
@@ -213,3 +283,62 @@ func UnmarshalLiquidValidator(cdc codec.BinaryCodec, value []byte) (val LiquidVa
 	err = cdc.Unmarshal(value, &val)
 	return val, err
 }
+
+// Validate validates the StakeToLP schedule.
+func (s StakeToLPSchedule) Validate() error {
+	if err := s.StakeToLPMsg().ValidateBasic(); err != nil {
+		return errors.Wrap(ErrInvalidStakeToLPSchedule, err.Error())
+	}
+	if s.Interval < MinStakeToLPScheduleInterval {
+		return errors.Wrapf(ErrInvalidStakeToLPSchedule, "interval must be at least %s: %s", MinStakeToLPScheduleInterval, s.Interval)
+	}
+	return nil
+}
+
+// StakeToLPMsg returns the MsgStakeToLP executed on behalf of the delegator.
+func (s StakeToLPSchedule) StakeToLPMsg() *MsgStakeToLP {
+	return &MsgStakeToLP{
+		DelegatorAddress: s.DelegatorAddress,
+		ValidatorAddress: s.ValidatorAddress,
+		StakedAmount:     s.StakedAmount,
+		LiquidAmount:     s.LiquidAmount,
+	}
+}
+
+// GetDelegator returns the delegator address of the schedule.
+func (s StakeToLPSchedule) GetDelegator() sdk.AccAddress {
+	return s.StakeToLPMsg().GetDelegator()
+}
+
+// GetValidator returns the validator address of the schedule.
+func (s StakeToLPSchedule) GetValidator() sdk.ValAddress {
+	return s.StakeToLPMsg().GetValidator()
+}
+
+// IsDue returns whether the schedule is to be executed at the given block time.
+func (s StakeToLPSchedule) IsDue(blockTime time.Time) bool {
+	return !blockTime.Before(s.NextExecutionTime)
+}
+
+// Validate validates the vesting lock.
+func (l VestingLock) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(l.Address); err != nil {
+		return errors.Wrapf(ErrInvalidVestingLock, "invalid address %s: %s", l.Address, err)
+	}
+	if l.LockedAmount.IsNil() || !l.LockedAmount.IsPositive() {
+		return errors.Wrapf(ErrInvalidVestingLock, "locked amount must be positive: %s", l.LockedAmount)
+	}
+	if l.StkAmount.IsNil() || l.StkAmount.IsNegative() {
+		return errors.Wrapf(ErrInvalidVestingLock, "stk amount must not be negative: %s", l.StkAmount)
+	}
+	return nil
+}
+
+// GetAddress returns the vesting account address of the lock.
+func (l VestingLock) GetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(l.Address)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
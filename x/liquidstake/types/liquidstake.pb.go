@@ -4,20 +4,27 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +32,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InactiveValidatorPolicy enumerates how delegations of inactive liquid
+// validators are handled.
+type InactiveValidatorPolicy int32
+
+const (
+	// INACTIVE_VALIDATOR_POLICY_UNBOND unbonds the remaining delegation to the
+	// proxy account.
+	InactiveValidatorPolicyUnbond InactiveValidatorPolicy = 0
+	// INACTIVE_VALIDATOR_POLICY_REDELEGATE redelegates the remaining delegation
+	// to the active liquid validator furthest below its target.
+	InactiveValidatorPolicyRedelegate InactiveValidatorPolicy = 1
+	// INACTIVE_VALIDATOR_POLICY_MANUAL keeps the delegation untouched, excluded
+	// from rebalancing, until the policy or the whitelist is changed.
+	InactiveValidatorPolicyManual InactiveValidatorPolicy = 2
+)
+
+var InactiveValidatorPolicy_name = map[int32]string{
+	0: "INACTIVE_VALIDATOR_POLICY_UNBOND",
+	1: "INACTIVE_VALIDATOR_POLICY_REDELEGATE",
+	2: "INACTIVE_VALIDATOR_POLICY_MANUAL",
+}
+
+var InactiveValidatorPolicy_value = map[string]int32{
+	"INACTIVE_VALIDATOR_POLICY_UNBOND":     0,
+	"INACTIVE_VALIDATOR_POLICY_REDELEGATE": 1,
+	"INACTIVE_VALIDATOR_POLICY_MANUAL":     2,
+}
+
+func (x InactiveValidatorPolicy) String() string {
+	return proto.EnumName(InactiveValidatorPolicy_name, int32(x))
+}
+
+func (InactiveValidatorPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8f87e6d47a5a3bba, []int{0}
+}
+
 // ValidatorStatus enumerates the status of a liquid validator.
 type ValidatorStatus int32
 
@@ -54,7 +97,7 @@ func (x ValidatorStatus) String() string {
 }
 
 func (ValidatorStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_8f87e6d47a5a3bba, []int{0}
+	return fileDescriptor_8f87e6d47a5a3bba, []int{1}
 }
 
 // Params defines the set of params for the liquidstake module.
@@ -85,6 +128,16 @@ type Params struct {
 	// rewards. The fee is taken in favour of the fee account (see
 	// FeeAccountAddress).
 	AutocompoundFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=autocompound_fee_rate,json=autocompoundFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"autocompound_fee_rate"`
+	// InactiveValidatorPolicy specifies how the proxy account delegations left on
+	// validators that are no longer active liquid validators are handled.
+	InactiveValidatorPolicy InactiveValidatorPolicy `protobuf:"varint,9,opt,name=inactive_validator_policy,json=inactiveValidatorPolicy,proto3,enum=pstake.liquidstake.v1beta1.InactiveValidatorPolicy" json:"inactive_validator_policy,omitempty"`
+	// AutocompoundFeeTiers overrides AutocompoundFeeRate for the portion of the
+	// total staked amount above each tier threshold, ordered by threshold.
+	AutocompoundFeeTiers []AutocompoundFeeTier `protobuf:"bytes,10,rep,name=autocompound_fee_tiers,json=autocompoundFeeTiers,proto3" json:"autocompound_fee_tiers"`
+	// AutocompoundFeeCommunityPoolFraction specifies the fraction of the
+	// autocompound fee funding the community pool, the remainder is sent to the
+	// fee account.
+	AutocompoundFeeCommunityPoolFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=autocompound_fee_community_pool_fraction,json=autocompoundFeeCommunityPoolFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"autocompound_fee_community_pool_fraction"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -119,6 +172,47 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// AutocompoundFeeTier applies the fee rate to the autocompounded rewards earned
+// by the portion of the total staked amount above the threshold, up to the
+// next tier threshold.
+type AutocompoundFeeTier struct {
+	Threshold cosmossdk_io_math.Int                  `protobuf:"bytes,1,opt,name=threshold,proto3,customtype=cosmossdk.io/math.Int" json:"threshold"`
+	FeeRate   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=fee_rate,json=feeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_rate"`
+}
+
+func (m *AutocompoundFeeTier) Reset()         { *m = AutocompoundFeeTier{} }
+func (m *AutocompoundFeeTier) String() string { return proto.CompactTextString(m) }
+func (*AutocompoundFeeTier) ProtoMessage()    {}
+func (*AutocompoundFeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f87e6d47a5a3bba, []int{1}
+}
+func (m *AutocompoundFeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutocompoundFeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutocompoundFeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutocompoundFeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutocompoundFeeTier.Merge(m, src)
+}
+func (m *AutocompoundFeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutocompoundFeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutocompoundFeeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutocompoundFeeTier proto.InternalMessageInfo
+
 // WhitelistedValidator consists of the validator operator address and the
 // target weight, which is a value for calculating the real weight to be derived
 // according to the active status. In the case of inactive, it is calculated as
@@ -137,7 +231,7 @@ func (m *WhitelistedValidator) Reset()         { *m = WhitelistedValidator{} }
 func (m *WhitelistedValidator) String() string { return proto.CompactTextString(m) }
 func (*WhitelistedValidator) ProtoMessage()    {}
 func (*WhitelistedValidator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f87e6d47a5a3bba, []int{1}
+	return fileDescriptor_8f87e6d47a5a3bba, []int{2}
 }
 func (m *WhitelistedValidator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,7 +273,7 @@ func (m *LiquidValidator) Reset()         { *m = LiquidValidator{} }
 func (m *LiquidValidator) String() string { return proto.CompactTextString(m) }
 func (*LiquidValidator) ProtoMessage()    {}
 func (*LiquidValidator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f87e6d47a5a3bba, []int{2}
+	return fileDescriptor_8f87e6d47a5a3bba, []int{3}
 }
 func (m *LiquidValidator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -229,7 +323,7 @@ func (m *LiquidValidatorState) Reset()         { *m = LiquidValidatorState{} }
 func (m *LiquidValidatorState) String() string { return proto.CompactTextString(m) }
 func (*LiquidValidatorState) ProtoMessage()    {}
 func (*LiquidValidatorState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f87e6d47a5a3bba, []int{3}
+	return fileDescriptor_8f87e6d47a5a3bba, []int{4}
 }
 func (m *LiquidValidatorState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -258,6 +352,110 @@ func (m *LiquidValidatorState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LiquidValidatorState proto.InternalMessageInfo
 
+// WhitelistRotation is a whitelist change scheduled for a future epoch. The
+// target weights are moved from the previous whitelist to the new one in equal
+// steps over transition_epochs epochs, so rebalancing is spread over them.
+type WhitelistRotation struct {
+	// whitelisted_validators is the whitelist once the rotation is completed
+	WhitelistedValidators []WhitelistedValidator `protobuf:"bytes,1,rep,name=whitelisted_validators,json=whitelistedValidators,proto3" json:"whitelisted_validators"`
+	// start_epoch is the epoch number at whose end the first step is applied
+	StartEpoch int64 `protobuf:"varint,2,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// transition_epochs is the number of epochs the rotation is spread over
+	TransitionEpochs int64 `protobuf:"varint,3,opt,name=transition_epochs,json=transitionEpochs,proto3" json:"transition_epochs,omitempty"`
+	// previous_whitelisted_validators is the whitelist when the rotation started
+	PreviousWhitelistedValidators []WhitelistedValidator `protobuf:"bytes,4,rep,name=previous_whitelisted_validators,json=previousWhitelistedValidators,proto3" json:"previous_whitelisted_validators"`
+	// started is set once the first step is applied
+	Started bool `protobuf:"varint,5,opt,name=started,proto3" json:"started,omitempty"`
+}
+
+func (m *WhitelistRotation) Reset()         { *m = WhitelistRotation{} }
+func (m *WhitelistRotation) String() string { return proto.CompactTextString(m) }
+func (*WhitelistRotation) ProtoMessage()    {}
+func (*WhitelistRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f87e6d47a5a3bba, []int{5}
+}
+func (m *WhitelistRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WhitelistRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WhitelistRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WhitelistRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WhitelistRotation.Merge(m, src)
+}
+func (m *WhitelistRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *WhitelistRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_WhitelistRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WhitelistRotation proto.InternalMessageInfo
+
+// ProxyDelegation is the delegation of the liquid stake proxy account to a
+// whitelisted validator, compared against the validator target weight. It is
+// used only for queries and is not stored in kv.
+type ProxyDelegation struct {
+	// validator_address defines the address of the whitelisted validator's
+	// operator; bech encoded in JSON.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// target_weight is the whitelisted weight of the validator, zero when the
+	// validator is not an active liquid validator
+	TargetWeight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=target_weight,json=targetWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"target_weight"`
+	// shares define the delegation shares of the proxy account
+	Shares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
+	// tokens define the token amount worth of the delegation shares (slashing
+	// applied amount)
+	Tokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=tokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokens"`
+	// pending_rewards are the rewards of the delegation not withdrawn yet
+	PendingRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,5,rep,name=pending_rewards,json=pendingRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"pending_rewards"`
+	// weight_deviation is the fraction of the proxy account delegated tokens
+	// held by the validator minus its fraction of the total target weight
+	WeightDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=weight_deviation,json=weightDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight_deviation"`
+}
+
+func (m *ProxyDelegation) Reset()         { *m = ProxyDelegation{} }
+func (m *ProxyDelegation) String() string { return proto.CompactTextString(m) }
+func (*ProxyDelegation) ProtoMessage()    {}
+func (*ProxyDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f87e6d47a5a3bba, []int{6}
+}
+func (m *ProxyDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProxyDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProxyDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProxyDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyDelegation.Merge(m, src)
+}
+func (m *ProxyDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProxyDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyDelegation proto.InternalMessageInfo
+
 // NetAmountState is type for net amount raw data and mint rate, This is a value
 // that depends on the several module state every time, so it is used only for
 // calculation and query and is not stored in kv.
@@ -288,7 +486,7 @@ func (m *NetAmountState) Reset()         { *m = NetAmountState{} }
 func (m *NetAmountState) String() string { return proto.CompactTextString(m) }
 func (*NetAmountState) ProtoMessage()    {}
 func (*NetAmountState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f87e6d47a5a3bba, []int{4}
+	return fileDescriptor_8f87e6d47a5a3bba, []int{7}
 }
 func (m *NetAmountState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -317,13 +515,158 @@ func (m *NetAmountState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NetAmountState proto.InternalMessageInfo
 
+// StakeToLPSchedule is a recurring StakeToLP executed by the module on behalf
+// of the delegator, through the authz grant given to the module account.
+type StakeToLPSchedule struct {
+	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string     `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	StakedAmount     types.Coin `protobuf:"bytes,3,opt,name=staked_amount,json=stakedAmount,proto3" json:"staked_amount"`
+	LiquidAmount     types.Coin `protobuf:"bytes,4,opt,name=liquid_amount,json=liquidAmount,proto3" json:"liquid_amount"`
+	// interval is the time between two executions
+	Interval time.Duration `protobuf:"bytes,5,opt,name=interval,proto3,stdduration" json:"interval"`
+	// next_execution_time is the block time from which the next execution is due
+	NextExecutionTime time.Time `protobuf:"bytes,6,opt,name=next_execution_time,json=nextExecutionTime,proto3,stdtime" json:"next_execution_time"`
+}
+
+func (m *StakeToLPSchedule) Reset()         { *m = StakeToLPSchedule{} }
+func (m *StakeToLPSchedule) String() string { return proto.CompactTextString(m) }
+func (*StakeToLPSchedule) ProtoMessage()    {}
+func (*StakeToLPSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f87e6d47a5a3bba, []int{8}
+}
+func (m *StakeToLPSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakeToLPSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakeToLPSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakeToLPSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeToLPSchedule.Merge(m, src)
+}
+func (m *StakeToLPSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakeToLPSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeToLPSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeToLPSchedule proto.InternalMessageInfo
+
+// RedelegationFailure records a rebalancing redelegation of the proxy account
+// which failed, with the reason of the failure.
+type RedelegationFailure struct {
+	// epoch is the day epoch number the redelegation was attempted in
+	Epoch int64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// height is the block height the redelegation was attempted at
+	Height              int64                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	SrcValidatorAddress string                `protobuf:"bytes,3,opt,name=src_validator_address,json=srcValidatorAddress,proto3" json:"src_validator_address,omitempty"`
+	DstValidatorAddress string                `protobuf:"bytes,4,opt,name=dst_validator_address,json=dstValidatorAddress,proto3" json:"dst_validator_address,omitempty"`
+	Amount              cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// error is the reason the redelegation failed
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *RedelegationFailure) Reset()         { *m = RedelegationFailure{} }
+func (m *RedelegationFailure) String() string { return proto.CompactTextString(m) }
+func (*RedelegationFailure) ProtoMessage()    {}
+func (*RedelegationFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f87e6d47a5a3bba, []int{9}
+}
+func (m *RedelegationFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegationFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedelegationFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationFailure.Merge(m, src)
+}
+func (m *RedelegationFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegationFailure proto.InternalMessageInfo
+
+// VestingLock is the stkXPRT minted for the locked coins a vesting account
+// liquid staked. The stkXPRT is held by the module account and released to the
+// vesting account as its coins vest.
+type VestingLock struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// locked_amount is the amount of locked coins liquid staked which have not
+	// vested yet
+	LockedAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=locked_amount,json=lockedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"locked_amount"`
+	// stk_amount is the stkXPRT amount held for the locked coins
+	StkAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=stk_amount,json=stkAmount,proto3,customtype=cosmossdk.io/math.Int" json:"stk_amount"`
+}
+
+func (m *VestingLock) Reset()         { *m = VestingLock{} }
+func (m *VestingLock) String() string { return proto.CompactTextString(m) }
+func (*VestingLock) ProtoMessage()    {}
+func (*VestingLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f87e6d47a5a3bba, []int{10}
+}
+func (m *VestingLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingLock.Merge(m, src)
+}
+func (m *VestingLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingLock proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("pstake.liquidstake.v1beta1.InactiveValidatorPolicy", InactiveValidatorPolicy_name, InactiveValidatorPolicy_value)
 	proto.RegisterEnum("pstake.liquidstake.v1beta1.ValidatorStatus", ValidatorStatus_name, ValidatorStatus_value)
 	proto.RegisterType((*Params)(nil), "pstake.liquidstake.v1beta1.Params")
+	proto.RegisterType((*AutocompoundFeeTier)(nil), "pstake.liquidstake.v1beta1.AutocompoundFeeTier")
 	proto.RegisterType((*WhitelistedValidator)(nil), "pstake.liquidstake.v1beta1.WhitelistedValidator")
 	proto.RegisterType((*LiquidValidator)(nil), "pstake.liquidstake.v1beta1.LiquidValidator")
 	proto.RegisterType((*LiquidValidatorState)(nil), "pstake.liquidstake.v1beta1.LiquidValidatorState")
+	proto.RegisterType((*WhitelistRotation)(nil), "pstake.liquidstake.v1beta1.WhitelistRotation")
+	proto.RegisterType((*ProxyDelegation)(nil), "pstake.liquidstake.v1beta1.ProxyDelegation")
 	proto.RegisterType((*NetAmountState)(nil), "pstake.liquidstake.v1beta1.NetAmountState")
+	proto.RegisterType((*StakeToLPSchedule)(nil), "pstake.liquidstake.v1beta1.StakeToLPSchedule")
+	proto.RegisterType((*RedelegationFailure)(nil), "pstake.liquidstake.v1beta1.RedelegationFailure")
+	proto.RegisterType((*VestingLock)(nil), "pstake.liquidstake.v1beta1.VestingLock")
 }
 
 func init() {
@@ -331,68 +674,117 @@ func init() {
 }
 
 var fileDescriptor_8f87e6d47a5a3bba = []byte{
-	// 965 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0xbd, 0xf9, 0x61, 0x9c, 0x69, 0x88, 0xed, 0x8d, 0xd3, 0x38, 0x06, 0x39, 0x26, 0x07,
-	0x14, 0x15, 0x62, 0xd3, 0x20, 0x71, 0xc8, 0x01, 0x61, 0xc7, 0x89, 0xb0, 0x48, 0xd3, 0x68, 0xed,
-	0xa4, 0x55, 0x0f, 0x4c, 0xc7, 0xbb, 0x2f, 0xce, 0xca, 0xbb, 0x33, 0xcb, 0xce, 0x6c, 0x9c, 0xfc,
-	0x07, 0x55, 0x0e, 0x88, 0x13, 0xe2, 0x12, 0xa9, 0x12, 0xff, 0x02, 0x07, 0xfe, 0x84, 0x5e, 0x90,
-	0x2a, 0x4e, 0x88, 0x43, 0x85, 0x92, 0x0b, 0x7f, 0x05, 0x42, 0x33, 0xb3, 0x76, 0x5c, 0xb7, 0x50,
-	0xd9, 0xed, 0x29, 0xce, 0xcc, 0x7e, 0x3f, 0xef, 0xcd, 0xfb, 0xce, 0x7b, 0xbb, 0xe8, 0xd3, 0x80,
-	0x0b, 0xd2, 0x85, 0x8a, 0xe7, 0x7e, 0x17, 0xb9, 0x8e, 0xfe, 0x7d, 0x7a, 0xb7, 0x0d, 0x82, 0xdc,
-	0x1d, 0x5e, 0x2b, 0x07, 0x21, 0x13, 0xcc, 0x2c, 0xe8, 0xa7, 0xcb, 0xc3, 0x3b, 0xf1, 0xd3, 0x85,
-	0x5c, 0x87, 0x75, 0x98, 0x7a, 0xac, 0x22, 0x7f, 0x69, 0x45, 0x61, 0xc5, 0x66, 0xdc, 0x67, 0x1c,
-	0xeb, 0x0d, 0xfd, 0x8f, 0xde, 0x5a, 0xfb, 0x7e, 0x16, 0x25, 0x0f, 0x48, 0x48, 0x7c, 0x6e, 0xde,
-	0x41, 0x59, 0x8d, 0xc4, 0x6d, 0x46, 0x1d, 0xec, 0x00, 0x65, 0x7e, 0xde, 0x28, 0x19, 0xeb, 0x73,
-	0x56, 0x5a, 0x6f, 0xd4, 0x18, 0x75, 0xea, 0x72, 0xd9, 0xf4, 0xd1, 0xed, 0xde, 0x89, 0x2b, 0xc0,
-	0x73, 0xb9, 0x00, 0x07, 0x9f, 0x12, 0xcf, 0x75, 0x88, 0x60, 0x21, 0xcf, 0x4f, 0x95, 0xa6, 0xd7,
-	0x6f, 0x6d, 0x7e, 0x56, 0xfe, 0xef, 0x24, 0xcb, 0x0f, 0x6e, 0x94, 0x47, 0x7d, 0x61, 0x6d, 0xe6,
-	0xd9, 0x8b, 0xd5, 0x84, 0xb5, 0xd4, 0x7b, 0xcd, 0x1e, 0x37, 0x1f, 0xa2, 0x4c, 0x44, 0x15, 0x04,
-	0x1f, 0x03, 0xe0, 0x90, 0x08, 0xc8, 0x4f, 0xcb, 0xcc, 0x6a, 0x65, 0x29, 0xfb, 0xf3, 0xc5, 0xea,
-	0xc7, 0x1d, 0x57, 0x9c, 0x44, 0xed, 0xb2, 0xcd, 0xfc, 0xf8, 0x80, 0xf1, 0x9f, 0x0d, 0xee, 0x74,
-	0x2b, 0xe2, 0x3c, 0x00, 0x5e, 0xae, 0x83, 0x6d, 0x2d, 0xc4, 0x9c, 0x5d, 0x00, 0x8b, 0x08, 0x30,
-	0x3f, 0x42, 0xf3, 0x1e, 0xf7, 0xb1, 0xe3, 0x72, 0xd2, 0xf6, 0xc0, 0xc9, 0xcf, 0x94, 0x8c, 0xf5,
-	0x94, 0x75, 0xcb, 0xe3, 0x7e, 0x3d, 0x5e, 0x32, 0x01, 0x2d, 0xfb, 0x2e, 0xc5, 0x71, 0x6d, 0x74,
-	0x16, 0xc4, 0x67, 0x11, 0x15, 0xf9, 0xd9, 0xb1, 0x73, 0x68, 0x50, 0x61, 0xe5, 0x7c, 0x97, 0xee,
-	0x29, 0x5a, 0x53, 0xc2, 0xaa, 0x8a, 0x65, 0xde, 0x43, 0xb7, 0xed, 0x1e, 0xf6, 0x98, 0xdd, 0x05,
-	0x07, 0x07, 0x8c, 0x79, 0x98, 0x38, 0x4e, 0x08, 0x9c, 0xe7, 0x93, 0x2a, 0x4a, 0xfe, 0xf7, 0x5f,
-	0x36, 0x72, 0xb1, 0x77, 0x55, 0xbd, 0xd3, 0x14, 0xa1, 0x4b, 0x3b, 0xd6, 0xa2, 0xdd, 0xdb, 0x53,
-	0xb2, 0x03, 0xc6, 0xbc, 0x78, 0xcb, 0xfc, 0x1a, 0x2d, 0xca, 0x52, 0x11, 0xdb, 0x96, 0xf4, 0x01,
-	0xeb, 0xbd, 0x37, 0xb0, 0xb2, 0xc7, 0x00, 0x55, 0xad, 0xe9, 0x93, 0xda, 0x68, 0x89, 0x44, 0x82,
-	0xd9, 0xcc, 0x0f, 0x58, 0x44, 0x9d, 0x1b, 0x07, 0x52, 0x13, 0x39, 0xb0, 0x38, 0x0c, 0x8b, 0x6d,
-	0xd8, 0x4a, 0x3d, 0x79, 0xba, 0x9a, 0xf8, 0xe9, 0xe9, 0x6a, 0x62, 0xed, 0x57, 0x03, 0xe5, 0x5e,
-	0x77, 0x41, 0xcc, 0x1d, 0x94, 0x1d, 0x5c, 0xb3, 0xc1, 0x71, 0x8c, 0x37, 0x1c, 0x27, 0x33, 0x90,
-	0xf4, 0x4f, 0xd3, 0x44, 0xef, 0x0b, 0x12, 0x76, 0x40, 0xe0, 0x1e, 0xb8, 0x9d, 0x13, 0x91, 0x9f,
-	0x9a, 0xc8, 0xc3, 0x79, 0x0d, 0x79, 0xa0, 0x18, 0x5b, 0x33, 0x32, 0xfd, 0xb5, 0xc7, 0x28, 0xad,
-	0x6d, 0xbd, 0x49, 0x7a, 0x1b, 0x65, 0x58, 0x00, 0xe1, 0x58, 0x39, 0xa7, 0xfb, 0x8a, 0x78, 0x59,
-	0x17, 0xe7, 0x6f, 0x19, 0xe1, 0xc7, 0x69, 0x94, 0x1b, 0x09, 0xd1, 0x14, 0xf2, 0x1a, 0xbf, 0x8b,
-	0x38, 0xe6, 0x2e, 0x4a, 0xbe, 0x55, 0x4d, 0x62, 0xb5, 0xb9, 0x8d, 0x92, 0x5c, 0x10, 0x11, 0x71,
-	0xd5, 0xa3, 0x0b, 0x9b, 0x9f, 0xfc, 0xdf, 0x30, 0x78, 0xe9, 0x20, 0x11, 0xb7, 0x62, 0xa9, 0x79,
-	0x0f, 0x21, 0x07, 0x3c, 0xcc, 0x4f, 0x48, 0x08, 0x5c, 0xb5, 0xe5, 0xf8, 0x57, 0x6d, 0xce, 0x01,
-	0xaf, 0xa9, 0x00, 0xd2, 0xf6, 0xb8, 0x81, 0x05, 0xeb, 0x02, 0xe5, 0x13, 0xb6, 0xee, 0xbc, 0x86,
-	0xb4, 0x14, 0x63, 0xc8, 0x98, 0x7f, 0x66, 0xd1, 0xc2, 0x3e, 0x08, 0xdd, 0xca, 0xda, 0x92, 0x6f,
-	0xd0, 0x9c, 0xef, 0x52, 0xa1, 0x5b, 0xc5, 0x98, 0x28, 0xff, 0x94, 0x04, 0xa8, 0x31, 0xf5, 0x18,
-	0xe5, 0xb8, 0xe8, 0x9e, 0x05, 0xa1, 0xc0, 0x82, 0x09, 0xe2, 0x61, 0x1e, 0x05, 0x81, 0x77, 0x3e,
-	0xa1, 0x51, 0x66, 0xcc, 0x6a, 0x49, 0x54, 0x53, 0x91, 0x64, 0xbd, 0x29, 0x88, 0xfe, 0x60, 0x9b,
-	0x6c, 0xb8, 0xce, 0xd1, 0x7e, 0x09, 0xe4, 0xc4, 0xd6, 0x89, 0xbe, 0xb5, 0x89, 0x0b, 0x8a, 0x53,
-	0x1f, 0x38, 0xf9, 0x2d, 0x5a, 0xd4, 0xe4, 0x77, 0xe1, 0x67, 0x56, 0xa1, 0xf6, 0x86, 0x4c, 0x35,
-	0x8f, 0xd1, 0xb2, 0xe6, 0x87, 0xe0, 0x13, 0x97, 0xba, 0xb4, 0x83, 0x43, 0xe8, 0x91, 0xd0, 0xe9,
-	0x0f, 0xe2, 0x71, 0x0f, 0xb0, 0xa4, 0x70, 0x56, 0x9f, 0x66, 0x69, 0xd8, 0x4d, 0x9c, 0x88, 0xca,
-	0xf7, 0xad, 0x8c, 0xd3, 0x26, 0x1e, 0xa1, 0x36, 0xc4, 0x43, 0x7a, 0xdc, 0xb3, 0xe8, 0x38, 0x87,
-	0x7d, 0x5a, 0x4d, 0xc3, 0xcc, 0x47, 0x28, 0x1b, 0x84, 0xec, 0xec, 0x5c, 0xbe, 0x0a, 0x06, 0x11,
-	0x52, 0x13, 0x45, 0x48, 0x2b, 0x50, 0xd5, 0xb6, 0x63, 0xb6, 0x6a, 0x00, 0x43, 0x36, 0xc0, 0x9d,
-	0xdf, 0x0c, 0x94, 0x1e, 0x69, 0x65, 0xf3, 0x2b, 0xf4, 0xe1, 0x51, 0x75, 0xaf, 0x51, 0xaf, 0xb6,
-	0xee, 0x5b, 0xb8, 0xd9, 0xaa, 0xb6, 0x0e, 0x9b, 0xf8, 0x70, 0xbf, 0x79, 0xb0, 0xb3, 0xdd, 0xd8,
-	0x6d, 0xec, 0xd4, 0x33, 0x89, 0x42, 0xf1, 0xe2, 0xb2, 0x54, 0x18, 0x91, 0x1d, 0x52, 0x1e, 0x80,
-	0xed, 0x1e, 0xbb, 0xe0, 0x98, 0x5f, 0xa0, 0xe5, 0x57, 0x08, 0xd5, 0xed, 0x56, 0xe3, 0x68, 0x27,
-	0x63, 0x14, 0x56, 0x2e, 0x2e, 0x4b, 0x4b, 0x23, 0xe2, 0xaa, 0x2d, 0xdc, 0x53, 0x30, 0xb7, 0xd0,
-	0xca, 0x2b, 0xba, 0xc6, 0x7e, 0xac, 0x9c, 0x2a, 0x7c, 0x70, 0x71, 0x59, 0x5a, 0x1e, 0x51, 0x36,
-	0x28, 0x51, 0xda, 0xc2, 0xcc, 0x93, 0x9f, 0x8b, 0x89, 0xda, 0xc3, 0x67, 0x57, 0x45, 0xe3, 0xf9,
-	0x55, 0xd1, 0xf8, 0xeb, 0xaa, 0x68, 0xfc, 0x70, 0x5d, 0x4c, 0x3c, 0xbf, 0x2e, 0x26, 0xfe, 0xb8,
-	0x2e, 0x26, 0x1e, 0x7d, 0x39, 0x54, 0xac, 0x00, 0x42, 0x2e, 0x5f, 0x53, 0xd4, 0x86, 0xfb, 0x14,
-	0x2a, 0x7a, 0xcc, 0x6d, 0x50, 0x22, 0x41, 0x95, 0xd3, 0xcd, 0xca, 0xd9, 0x4b, 0x9f, 0x74, 0xaa,
-	0x90, 0xed, 0xa4, 0xfa, 0xf0, 0xfa, 0xfc, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf0, 0xf7, 0xf1,
-	0x53, 0xf5, 0x09, 0x00, 0x00,
+	// 1753 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xbd, 0x6f, 0x23, 0xc7,
+	0x15, 0xd7, 0x52, 0x3c, 0x89, 0x1a, 0xc9, 0x12, 0x39, 0x92, 0x4e, 0x14, 0x63, 0x93, 0xbc, 0x83,
+	0x13, 0x08, 0x77, 0x39, 0x32, 0xd6, 0x01, 0x29, 0xae, 0x48, 0x42, 0x8a, 0x94, 0xc3, 0x84, 0x27,
+	0x09, 0x4b, 0x4a, 0x67, 0xbb, 0xc8, 0x7a, 0xb8, 0x3b, 0x22, 0x07, 0xdc, 0xdd, 0xd9, 0xec, 0xcc,
+	0xea, 0x23, 0x45, 0x8a, 0x14, 0x81, 0xa1, 0xca, 0x55, 0xe0, 0x46, 0x81, 0x81, 0x74, 0xe9, 0x02,
+	0xb8, 0x48, 0x99, 0xd2, 0x08, 0x10, 0xc0, 0x70, 0x15, 0xa4, 0xb0, 0x83, 0x3b, 0x04, 0xc8, 0x1f,
+	0x90, 0xb4, 0x41, 0x30, 0x1f, 0x4b, 0x52, 0x9f, 0x77, 0xe4, 0x39, 0x40, 0x2a, 0x71, 0x76, 0xe6,
+	0xfd, 0xde, 0xd7, 0x6f, 0xde, 0x9b, 0x27, 0xf0, 0xdd, 0x80, 0x71, 0xd4, 0xc7, 0x65, 0x97, 0xfc,
+	0x3c, 0x22, 0x8e, 0xfa, 0x7d, 0xf4, 0x4e, 0x07, 0x73, 0xf4, 0xce, 0xe8, 0xb7, 0x52, 0x10, 0x52,
+	0x4e, 0x61, 0x4e, 0x9d, 0x2e, 0x8d, 0xee, 0xe8, 0xd3, 0xb9, 0x95, 0x2e, 0xed, 0x52, 0x79, 0xac,
+	0x2c, 0x7e, 0x29, 0x89, 0xdc, 0xba, 0x4d, 0x99, 0x47, 0x99, 0xa5, 0x36, 0xd4, 0x42, 0x6f, 0xe5,
+	0xd5, 0xaa, 0xdc, 0x41, 0x6c, 0xa8, 0xd3, 0xa6, 0xc4, 0x8f, 0xf7, 0xbb, 0x94, 0x76, 0x5d, 0x5c,
+	0x96, 0xab, 0x4e, 0x74, 0x58, 0x76, 0xa2, 0x10, 0x71, 0x42, 0xe3, 0xfd, 0xc2, 0xe5, 0x7d, 0x4e,
+	0x3c, 0xcc, 0x38, 0xf2, 0x02, 0x75, 0xe0, 0xfe, 0xbf, 0x67, 0xc1, 0xcc, 0x1e, 0x0a, 0x91, 0xc7,
+	0xe0, 0x03, 0x90, 0x51, 0x36, 0x5b, 0x1d, 0xea, 0x3b, 0x96, 0x83, 0x7d, 0xea, 0x65, 0x8d, 0xa2,
+	0xb1, 0x31, 0x67, 0x2e, 0xa9, 0x8d, 0x2a, 0xf5, 0x9d, 0x9a, 0xf8, 0x0c, 0x3d, 0x70, 0xf7, 0xb8,
+	0x47, 0x38, 0x76, 0x09, 0xe3, 0xd8, 0xb1, 0x8e, 0x90, 0x4b, 0x1c, 0xc4, 0x69, 0xc8, 0xb2, 0x89,
+	0xe2, 0xf4, 0xc6, 0xfc, 0xe6, 0xf7, 0x4a, 0x37, 0x47, 0xa1, 0xf4, 0x6c, 0x28, 0x79, 0x10, 0x0b,
+	0x56, 0x93, 0x9f, 0x7f, 0x55, 0x98, 0x32, 0x57, 0x8f, 0xaf, 0xd9, 0x63, 0xf0, 0x3d, 0x90, 0x8e,
+	0x7c, 0x09, 0x62, 0x1d, 0x62, 0x6c, 0x85, 0x88, 0xe3, 0xec, 0xb4, 0xb0, 0xac, 0x5a, 0x12, 0x62,
+	0x7f, 0xfb, 0xaa, 0xf0, 0x9d, 0x2e, 0xe1, 0xbd, 0xa8, 0x53, 0xb2, 0xa9, 0xa7, 0x23, 0xa8, 0xff,
+	0x3c, 0x62, 0x4e, 0xbf, 0xcc, 0x4f, 0x03, 0xcc, 0x4a, 0x35, 0x6c, 0x9b, 0x8b, 0x1a, 0x67, 0x1b,
+	0x63, 0x13, 0x71, 0x0c, 0xef, 0x81, 0x05, 0x97, 0x79, 0x96, 0x43, 0x18, 0xea, 0xb8, 0xd8, 0xc9,
+	0x26, 0x8b, 0xc6, 0x46, 0xca, 0x9c, 0x77, 0x99, 0x57, 0xd3, 0x9f, 0x20, 0x06, 0x6b, 0x1e, 0xf1,
+	0x2d, 0x1d, 0x1b, 0x65, 0x05, 0xf2, 0x68, 0xe4, 0xf3, 0xec, 0x9d, 0xb1, 0x6d, 0x68, 0xf8, 0xdc,
+	0x5c, 0xf1, 0x88, 0xdf, 0x94, 0x68, 0x2d, 0x01, 0x56, 0x91, 0x58, 0xf0, 0x29, 0xb8, 0x6b, 0x1f,
+	0x5b, 0x2e, 0xb5, 0xfb, 0xd8, 0xb1, 0x02, 0x4a, 0x5d, 0x0b, 0x39, 0x4e, 0x88, 0x19, 0xcb, 0xce,
+	0x48, 0x2d, 0xd9, 0x2f, 0x3f, 0x7b, 0xb4, 0xa2, 0xc9, 0x51, 0x51, 0x3b, 0x2d, 0x1e, 0x12, 0xbf,
+	0x6b, 0x2e, 0xdb, 0xc7, 0x4d, 0x29, 0xb6, 0x47, 0xa9, 0xab, 0xb7, 0xe0, 0x8f, 0xc1, 0xb2, 0x08,
+	0x15, 0xb2, 0x6d, 0x81, 0x3e, 0xc0, 0x9a, 0x7d, 0x09, 0x56, 0xe6, 0x10, 0xe3, 0x8a, 0x92, 0x89,
+	0x91, 0x3a, 0x60, 0x15, 0x45, 0x9c, 0xda, 0xd4, 0x0b, 0x68, 0xe4, 0x3b, 0xc3, 0x0c, 0xa4, 0x26,
+	0xca, 0xc0, 0xf2, 0x28, 0x58, 0x9c, 0x06, 0x0a, 0xd6, 0x89, 0x8f, 0x6c, 0x4e, 0x8e, 0xf0, 0x90,
+	0x4c, 0x56, 0x40, 0x5d, 0x62, 0x9f, 0x66, 0xe7, 0x8a, 0xc6, 0xc6, 0xe2, 0xe6, 0xe3, 0xdb, 0x28,
+	0xd5, 0xd0, 0xc2, 0x03, 0xce, 0xec, 0x49, 0x51, 0x73, 0x8d, 0x5c, 0xbf, 0x01, 0xfb, 0xe0, 0xee,
+	0x15, 0xa7, 0x38, 0xc1, 0x21, 0xcb, 0x02, 0x49, 0xe0, 0xf2, 0x6d, 0xda, 0x2a, 0x17, 0x3d, 0x68,
+	0x13, 0x1c, 0xf3, 0x77, 0x05, 0x5d, 0xdd, 0x62, 0xf0, 0xd7, 0x06, 0xd8, 0xb8, 0xa2, 0xcd, 0xa6,
+	0x9e, 0x17, 0xf9, 0x84, 0x9f, 0xaa, 0x64, 0x1f, 0x86, 0xc2, 0x50, 0xea, 0x67, 0xe7, 0x27, 0x8a,
+	0xea, 0xdb, 0x97, 0x14, 0x6f, 0xc5, 0xe8, 0x82, 0x13, 0xdb, 0x1a, 0xfb, 0x49, 0xea, 0xa3, 0x4f,
+	0x0b, 0x53, 0x9f, 0x7c, 0x5a, 0x98, 0xba, 0xff, 0x07, 0x03, 0x2c, 0x5f, 0xe3, 0x06, 0x6c, 0x80,
+	0x39, 0xde, 0x0b, 0x31, 0xeb, 0x51, 0xd7, 0x51, 0x97, 0xbf, 0xfa, 0x50, 0x9b, 0xb2, 0xaa, 0x14,
+	0x33, 0xa7, 0x5f, 0x22, 0xb4, 0xec, 0x21, 0xde, 0x13, 0x6c, 0xfe, 0xf2, 0xb3, 0x47, 0x40, 0x6d,
+	0x88, 0x95, 0x39, 0x94, 0x86, 0x0d, 0x90, 0x1a, 0x50, 0x25, 0x31, 0x91, 0x53, 0xb3, 0x87, 0x8a,
+	0x1e, 0x4f, 0x92, 0xc2, 0xee, 0xfb, 0x7f, 0x34, 0xc0, 0xca, 0x75, 0xb5, 0x03, 0xd6, 0x41, 0x66,
+	0x48, 0x9a, 0x98, 0xe9, 0xc6, 0x4b, 0x98, 0x9e, 0x1e, 0x88, 0xc4, 0x44, 0x6f, 0x81, 0x37, 0x38,
+	0x0a, 0xbb, 0x98, 0x5b, 0xc7, 0x98, 0x74, 0x7b, 0x7c, 0x02, 0xab, 0x45, 0x08, 0x16, 0x14, 0xc8,
+	0x33, 0x89, 0xa1, 0x4d, 0xff, 0x10, 0x2c, 0xa9, 0x1b, 0x3f, 0x34, 0x7a, 0x0b, 0xa4, 0x69, 0x80,
+	0xc3, 0xb1, 0x6c, 0x5e, 0x8a, 0x25, 0xf4, 0x67, 0x95, 0xd0, 0x7f, 0x0a, 0x0d, 0xbf, 0x99, 0x06,
+	0x2b, 0x97, 0x54, 0xb4, 0xb8, 0xb8, 0x5a, 0xdf, 0x84, 0x1e, 0xb8, 0x0d, 0x66, 0x5e, 0x2b, 0x26,
+	0x5a, 0x1a, 0x6e, 0x81, 0x19, 0xc6, 0x11, 0x8f, 0x98, 0x2c, 0xdf, 0x8b, 0x9b, 0x0f, 0x6f, 0xbb,
+	0x66, 0x17, 0x1c, 0x89, 0x98, 0xa9, 0x45, 0xe1, 0x53, 0x00, 0x1c, 0xec, 0x5a, 0xac, 0x87, 0x42,
+	0xcc, 0xb2, 0xc9, 0xb1, 0x0d, 0x12, 0xd4, 0x9a, 0x73, 0xb0, 0xdb, 0x92, 0x00, 0x22, 0xed, 0xba,
+	0xb6, 0x73, 0xda, 0xc7, 0x3e, 0x9b, 0xb0, 0xaa, 0x2f, 0x28, 0x90, 0xb6, 0xc4, 0x18, 0x49, 0xcc,
+	0xbf, 0x12, 0x20, 0x33, 0x60, 0xad, 0x49, 0xb9, 0x6c, 0xcf, 0xb7, 0x34, 0x50, 0xe3, 0x7f, 0xd1,
+	0x40, 0x0b, 0x60, 0x9e, 0x71, 0x14, 0x72, 0x0b, 0x07, 0xd4, 0xee, 0xc9, 0x24, 0x4e, 0x9b, 0x40,
+	0x7e, 0xaa, 0x8b, 0x2f, 0xf0, 0x21, 0xc8, 0xf0, 0x10, 0xf9, 0x8c, 0x08, 0xeb, 0xd4, 0x29, 0x95,
+	0xa3, 0x69, 0x33, 0x3d, 0xdc, 0x90, 0x67, 0x19, 0xfc, 0x25, 0x28, 0x04, 0x21, 0x3e, 0x22, 0x34,
+	0x62, 0xd6, 0x0d, 0x5e, 0x24, 0x5f, 0xcb, 0x8b, 0xb7, 0x62, 0xf8, 0x67, 0xd7, 0x7a, 0x93, 0x05,
+	0xb3, 0xd2, 0x74, 0xec, 0xc8, 0x5c, 0xa5, 0xcc, 0x78, 0x39, 0x12, 0xf6, 0xdf, 0x26, 0xc1, 0xd2,
+	0x5e, 0x48, 0x4f, 0x4e, 0x6b, 0xd8, 0xc5, 0x5d, 0x15, 0xf4, 0xff, 0xe3, 0x3a, 0x21, 0x6e, 0x98,
+	0x26, 0xf4, 0x64, 0x0f, 0x1b, 0x2d, 0x2d, 0x70, 0x34, 0x8d, 0x93, 0x93, 0xdd, 0x54, 0x25, 0x0d,
+	0x7f, 0x01, 0x96, 0x02, 0xec, 0x3b, 0xc4, 0xef, 0x5a, 0x21, 0x3e, 0x46, 0xa1, 0x23, 0xee, 0x85,
+	0xc8, 0xe9, 0x9b, 0x25, 0x1d, 0x26, 0xf1, 0x26, 0x1d, 0x24, 0xb3, 0x86, 0xed, 0x2d, 0x4a, 0xfc,
+	0xea, 0x63, 0xa1, 0xee, 0xf7, 0x5f, 0x17, 0x1e, 0xbe, 0x9a, 0xd9, 0x42, 0x86, 0x99, 0x8b, 0x5a,
+	0x93, 0xa9, 0x14, 0xc1, 0xf7, 0x41, 0x5a, 0x45, 0xd6, 0x72, 0xf0, 0x11, 0x91, 0xb9, 0xcb, 0xce,
+	0x8c, 0xed, 0x8d, 0x88, 0xca, 0x92, 0xc2, 0xa9, 0xc5, 0x30, 0x23, 0x04, 0xf9, 0xcf, 0x1d, 0xb0,
+	0xb8, 0x83, 0xb9, 0x7a, 0x7d, 0xa9, 0x52, 0xf9, 0x53, 0x30, 0xe7, 0x11, 0x9f, 0xab, 0x96, 0x65,
+	0x4c, 0xa4, 0x30, 0x25, 0x00, 0xe4, 0x93, 0xe6, 0x43, 0xb0, 0xc2, 0x78, 0xff, 0x24, 0x08, 0xb9,
+	0xc5, 0x29, 0x47, 0xae, 0xc5, 0xa2, 0x20, 0x70, 0x4f, 0x27, 0x24, 0x0b, 0xd4, 0x58, 0x6d, 0x01,
+	0xd5, 0x92, 0x48, 0xa2, 0x0e, 0xfa, 0x98, 0xc7, 0x6f, 0xd1, 0xc9, 0x68, 0x33, 0xe7, 0xc7, 0x21,
+	0x10, 0x8f, 0x6c, 0x65, 0xe8, 0x6b, 0x17, 0xd7, 0x45, 0x89, 0x53, 0x1b, 0x54, 0xd8, 0x9f, 0x81,
+	0x65, 0x85, 0xfc, 0x4d, 0xd4, 0xd9, 0x8c, 0x84, 0x6a, 0x8e, 0x14, 0x5b, 0x78, 0x08, 0xd6, 0x14,
+	0x7e, 0x88, 0x3d, 0x44, 0xfc, 0x51, 0xce, 0x4e, 0x46, 0x9b, 0x55, 0x09, 0x67, 0xc6, 0x68, 0x31,
+	0x2f, 0x07, 0x7a, 0x22, 0x5f, 0x8c, 0x48, 0x42, 0x4f, 0x07, 0xb9, 0xc8, 0xb7, 0x71, 0x76, 0x76,
+	0x6c, 0x3d, 0xc2, 0x17, 0xa5, 0x67, 0x3f, 0x46, 0xab, 0x2a, 0x30, 0xf8, 0x01, 0xc8, 0x04, 0xa2,
+	0x74, 0x89, 0xd7, 0xfb, 0x40, 0x43, 0x6a, 0x22, 0x0d, 0x4b, 0x12, 0xa8, 0x62, 0xdb, 0x1a, 0x5b,
+	0x5e, 0x00, 0x43, 0x5e, 0x80, 0x3f, 0x4f, 0x83, 0x8c, 0x1c, 0x40, 0xda, 0xb4, 0xb9, 0xd7, 0xb2,
+	0x7b, 0xd8, 0x89, 0x5c, 0x2c, 0x6a, 0xa4, 0xa3, 0x2a, 0xe6, 0x38, 0x35, 0x72, 0x20, 0xa2, 0xbf,
+	0x5f, 0x5f, 0x6a, 0x13, 0x63, 0x97, 0xda, 0x1a, 0x78, 0x43, 0x36, 0x0d, 0x67, 0x94, 0xe5, 0xf3,
+	0x9b, 0xeb, 0xd7, 0xd6, 0x20, 0x59, 0x80, 0x54, 0x03, 0x59, 0x50, 0x52, 0x9a, 0xd9, 0xb5, 0x41,
+	0x87, 0xd7, 0x28, 0xc9, 0x57, 0x44, 0x51, 0x52, 0x1a, 0xe5, 0x87, 0x20, 0x45, 0x7c, 0x8e, 0xc3,
+	0x23, 0xe4, 0x66, 0xef, 0x68, 0x00, 0x35, 0x5e, 0x97, 0xe2, 0xf1, 0xba, 0x54, 0xd3, 0xe3, 0x77,
+	0x35, 0x25, 0x00, 0x3e, 0xf9, 0xba, 0x60, 0x98, 0x03, 0x21, 0xd8, 0x06, 0xcb, 0x3e, 0x3e, 0xe1,
+	0x16, 0x3e, 0xc1, 0x76, 0x24, 0xfb, 0xac, 0x98, 0xc6, 0x25, 0x45, 0xe7, 0x37, 0x73, 0x57, 0xb0,
+	0xda, 0xf1, 0xa8, 0xae, 0xc0, 0x3e, 0x16, 0x60, 0x19, 0x01, 0x50, 0x8f, 0xe5, 0xc5, 0x09, 0xfd,
+	0xc0, 0xfc, 0x53, 0x02, 0x2c, 0x9b, 0xd8, 0x19, 0xf4, 0xba, 0x6d, 0x44, 0xdc, 0x28, 0xc4, 0x70,
+	0x05, 0xdc, 0x51, 0x2d, 0xdf, 0x90, 0xbd, 0x5c, 0x2d, 0xe0, 0x5d, 0x30, 0xd3, 0x1b, 0xb6, 0xae,
+	0x69, 0x53, 0xaf, 0x60, 0x13, 0xac, 0xb2, 0xd0, 0xb6, 0xae, 0x66, 0x6e, 0xfa, 0x65, 0x23, 0x28,
+	0x0b, 0xed, 0x83, 0xcb, 0xc9, 0x6b, 0x82, 0x55, 0x87, 0xf1, 0x6b, 0xd0, 0x92, 0x2f, 0x43, 0x73,
+	0x18, 0xbf, 0x82, 0xb6, 0x05, 0x66, 0x2e, 0x4c, 0xdd, 0x63, 0x8d, 0x25, 0x5a, 0x54, 0x86, 0x23,
+	0x0c, 0x69, 0xa8, 0xea, 0x82, 0xa9, 0x16, 0x3a, 0x84, 0xff, 0x30, 0xc0, 0xfc, 0x01, 0x66, 0x9c,
+	0xf8, 0x5d, 0x31, 0x4e, 0xc3, 0x4d, 0x30, 0xfb, 0xaa, 0xfc, 0x8f, 0x0f, 0xc2, 0x3d, 0xf0, 0x86,
+	0x9e, 0xe0, 0xb5, 0xad, 0x89, 0xf1, 0x6d, 0x5d, 0x50, 0x08, 0x9a, 0x75, 0x3f, 0x01, 0x80, 0xf1,
+	0xfe, 0xc5, 0x22, 0x3f, 0xde, 0x44, 0xc6, 0x78, 0x5f, 0x61, 0x29, 0x3f, 0x1f, 0xfc, 0x2a, 0x01,
+	0xd6, 0x6e, 0x98, 0x97, 0xe1, 0xbb, 0xa0, 0xd8, 0xd8, 0xa9, 0x6c, 0xb5, 0x1b, 0x07, 0x75, 0xeb,
+	0xa0, 0xd2, 0x6c, 0xd4, 0x2a, 0xed, 0x5d, 0xd3, 0xda, 0xdb, 0x6d, 0x36, 0xb6, 0xde, 0xb7, 0xf6,
+	0x77, 0xaa, 0xbb, 0x3b, 0xb5, 0xf4, 0x54, 0xee, 0xde, 0xd9, 0x79, 0xf1, 0xad, 0x1b, 0x20, 0x54,
+	0x31, 0x83, 0xbb, 0xe0, 0xed, 0x9b, 0x81, 0xcc, 0x7a, 0xad, 0xde, 0xac, 0xbf, 0x5b, 0x69, 0xd7,
+	0xd3, 0x46, 0xee, 0xdb, 0x67, 0xe7, 0xc5, 0x7b, 0x37, 0xcd, 0xef, 0x31, 0xa3, 0xf1, 0xed, 0x96,
+	0x3d, 0xad, 0xec, 0xec, 0x57, 0x9a, 0xe9, 0xc4, 0xad, 0x96, 0x3d, 0x45, 0x7e, 0x84, 0xdc, 0x5c,
+	0xf2, 0xa3, 0xdf, 0xe5, 0xa7, 0x1e, 0xfc, 0xc5, 0x00, 0x4b, 0x97, 0xe6, 0x0b, 0xf8, 0x23, 0xf0,
+	0xe6, 0x10, 0xb9, 0xd5, 0xae, 0xb4, 0xf7, 0x5b, 0xd6, 0xfe, 0x4e, 0x6b, 0xaf, 0xbe, 0xd5, 0xd8,
+	0x6e, 0xd4, 0x85, 0xe3, 0xf9, 0xb3, 0xf3, 0x62, 0xee, 0x92, 0xd8, 0xbe, 0xcf, 0x02, 0x6c, 0x93,
+	0x43, 0x82, 0x1d, 0xf8, 0x7d, 0xb0, 0x76, 0x05, 0x41, 0xd9, 0x9c, 0x36, 0x72, 0xeb, 0x67, 0xe7,
+	0xc5, 0xd5, 0x4b, 0xc2, 0x15, 0x69, 0x28, 0x7c, 0x02, 0xd6, 0xaf, 0xc8, 0xc5, 0xde, 0xa6, 0x13,
+	0xb9, 0x6f, 0x9d, 0x9d, 0x17, 0xd7, 0x2e, 0x49, 0xc6, 0x4e, 0x2a, 0x7f, 0xaa, 0xef, 0x7d, 0xfe,
+	0x3c, 0x6f, 0x7c, 0xf1, 0x3c, 0x6f, 0xfc, 0xfd, 0x79, 0xde, 0xf8, 0xf8, 0x45, 0x7e, 0xea, 0x8b,
+	0x17, 0xf9, 0xa9, 0xbf, 0xbe, 0xc8, 0x4f, 0x7d, 0xf0, 0x83, 0x91, 0x4e, 0x11, 0xe0, 0x90, 0x11,
+	0xc6, 0xb1, 0x6f, 0xe3, 0x5d, 0x1f, 0x97, 0xd5, 0xe3, 0xfc, 0x91, 0x8f, 0x04, 0x50, 0xf9, 0x68,
+	0xb3, 0x7c, 0x72, 0xe1, 0x7f, 0x9c, 0xb2, 0x8b, 0x74, 0x66, 0x64, 0x41, 0x7a, 0xfc, 0xdf, 0x01,
+	0x00, 0xe6, 0xd9, 0x6f, 0xb1, 0x06, 0x15, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -415,6 +807,35 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.AutocompoundFeeCommunityPoolFraction.Size()
+		i -= size
+		if _, err := m.AutocompoundFeeCommunityPoolFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.AutocompoundFeeTiers) > 0 {
+		for iNdEx := len(m.AutocompoundFeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutocompoundFeeTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstake(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.InactiveValidatorPolicy != 0 {
+		i = encodeVarintLiquidstake(dAtA, i, uint64(m.InactiveValidatorPolicy))
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size := m.AutocompoundFeeRate.Size()
 		i -= size
@@ -493,6 +914,49 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AutocompoundFeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutocompoundFeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutocompoundFeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeRate.Size()
+		i -= size
+		if _, err := m.FeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *WhitelistedValidator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -628,7 +1092,7 @@ func (m *LiquidValidatorState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *NetAmountState) Marshal() (dAtA []byte, err error) {
+func (m *WhitelistRotation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -638,53 +1102,208 @@ func (m *NetAmountState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NetAmountState) MarshalTo(dAtA []byte) (int, error) {
+func (m *WhitelistRotation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NetAmountState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WhitelistRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.ProxyAccBalance.Size()
-		i -= size
-		if _, err := m.ProxyAccBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Started {
+		i--
+		if m.Started {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i = encodeVarintLiquidstake(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x28
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.TotalUnbondingBalance.Size()
-		i -= size
-		if _, err := m.TotalUnbondingBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.PreviousWhitelistedValidators) > 0 {
+		for iNdEx := len(m.PreviousWhitelistedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousWhitelistedValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstake(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
-		i = encodeVarintLiquidstake(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.TotalRemainingRewards.Size()
-		i -= size
-		if _, err := m.TotalRemainingRewards.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstake(dAtA, i, uint64(size))
+	if m.TransitionEpochs != 0 {
+		i = encodeVarintLiquidstake(dAtA, i, uint64(m.TransitionEpochs))
+		i--
+		dAtA[i] = 0x18
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.TotalLiquidTokens.Size()
-		i -= size
-		if _, err := m.TotalLiquidTokens.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstake(dAtA, i, uint64(size))
+	if m.StartEpoch != 0 {
+		i = encodeVarintLiquidstake(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.WhitelistedValidators) > 0 {
+		for iNdEx := len(m.WhitelistedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WhitelistedValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstake(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProxyDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProxyDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProxyDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.WeightDeviation.Size()
+		i -= size
+		if _, err := m.WeightDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.PendingRewards) > 0 {
+		for iNdEx := len(m.PendingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstake(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TargetWeight.Size()
+		i -= size
+		if _, err := m.TargetWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstake(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NetAmountState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetAmountState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetAmountState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ProxyAccBalance.Size()
+		i -= size
+		if _, err := m.ProxyAccBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TotalUnbondingBalance.Size()
+		i -= size
+		if _, err := m.TotalUnbondingBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TotalRemainingRewards.Size()
+		i -= size
+		if _, err := m.TotalRemainingRewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TotalLiquidTokens.Size()
+		i -= size
+		if _, err := m.TotalLiquidTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstake(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x2a
@@ -731,79 +1350,290 @@ func (m *NetAmountState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintLiquidstake(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLiquidstake(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *StakeToLPSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *StakeToLPSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakeToLPSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.LiquidBondDenom)
-	if l > 0 {
-		n += 1 + l + sovLiquidstake(uint64(l))
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextExecutionTime):])
+	if err1 != nil {
+		return 0, err1
 	}
-	if len(m.WhitelistedValidators) > 0 {
-		for _, e := range m.WhitelistedValidators {
-			l = e.Size()
-			n += 1 + l + sovLiquidstake(uint64(l))
+	i -= n1
+	i = encodeVarintLiquidstake(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintLiquidstake(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.LiquidAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintLiquidstake(dAtA, i, uint64(size))
 	}
-	l = m.UnstakeFeeRate.Size()
-	n += 1 + l + sovLiquidstake(uint64(l))
-	if m.LsmDisabled {
-		n += 2
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.StakedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstake(dAtA, i, uint64(size))
 	}
-	l = m.MinLiquidStakeAmount.Size()
-	n += 1 + l + sovLiquidstake(uint64(l))
-	l = len(m.CwLockedPoolAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidstake(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstake(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.FeeAccountAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidstake(uint64(l))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLiquidstake(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.AutocompoundFeeRate.Size()
-	n += 1 + l + sovLiquidstake(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *WhitelistedValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidstake(uint64(l))
+func (m *RedelegationFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.TargetWeight.Size()
-	n += 1 + l + sovLiquidstake(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LiquidValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RedelegationFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidstake(uint64(l))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintLiquidstake(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DstValidatorAddress) > 0 {
+		i -= len(m.DstValidatorAddress)
+		copy(dAtA[i:], m.DstValidatorAddress)
+		i = encodeVarintLiquidstake(dAtA, i, uint64(len(m.DstValidatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SrcValidatorAddress) > 0 {
+		i -= len(m.SrcValidatorAddress)
+		copy(dAtA[i:], m.SrcValidatorAddress)
+		i = encodeVarintLiquidstake(dAtA, i, uint64(len(m.SrcValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintLiquidstake(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintLiquidstake(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.StkAmount.Size()
+		i -= size
+		if _, err := m.StkAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LockedAmount.Size()
+		i -= size
+		if _, err := m.LockedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLiquidstake(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiquidstake(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiquidstake(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LiquidBondDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstake(uint64(l))
+	}
+	if len(m.WhitelistedValidators) > 0 {
+		for _, e := range m.WhitelistedValidators {
+			l = e.Size()
+			n += 1 + l + sovLiquidstake(uint64(l))
+		}
+	}
+	l = m.UnstakeFeeRate.Size()
+	n += 1 + l + sovLiquidstake(uint64(l))
+	if m.LsmDisabled {
+		n += 2
+	}
+	l = m.MinLiquidStakeAmount.Size()
+	n += 1 + l + sovLiquidstake(uint64(l))
+	l = len(m.CwLockedPoolAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstake(uint64(l))
+	}
+	l = len(m.FeeAccountAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstake(uint64(l))
+	}
+	l = m.AutocompoundFeeRate.Size()
+	n += 1 + l + sovLiquidstake(uint64(l))
+	if m.InactiveValidatorPolicy != 0 {
+		n += 1 + sovLiquidstake(uint64(m.InactiveValidatorPolicy))
+	}
+	if len(m.AutocompoundFeeTiers) > 0 {
+		for _, e := range m.AutocompoundFeeTiers {
+			l = e.Size()
+			n += 1 + l + sovLiquidstake(uint64(l))
+		}
+	}
+	l = m.AutocompoundFeeCommunityPoolFraction.Size()
+	n += 1 + l + sovLiquidstake(uint64(l))
+	return n
+}
+
+func (m *AutocompoundFeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Threshold.Size()
+	n += 1 + l + sovLiquidstake(uint64(l))
+	l = m.FeeRate.Size()
+	n += 1 + l + sovLiquidstake(uint64(l))
+	return n
+}
+
+func (m *WhitelistedValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstake(uint64(l))
+	}
+	l = m.TargetWeight.Size()
+	n += 1 + l + sovLiquidstake(uint64(l))
+	return n
+}
+
+func (m *LiquidValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstake(uint64(l))
+	}
+	return n
 }
 
 func (m *LiquidValidatorState) Size() (n int) {
@@ -828,6 +1658,63 @@ func (m *LiquidValidatorState) Size() (n int) {
 	return n
 }
 
+func (m *WhitelistRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WhitelistedValidators) > 0 {
+		for _, e := range m.WhitelistedValidators {
+			l = e.Size()
+			n += 1 + l + sovLiquidstake(uint64(l))
+		}
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovLiquidstake(uint64(m.StartEpoch))
+	}
+	if m.TransitionEpochs != 0 {
+		n += 1 + sovLiquidstake(uint64(m.TransitionEpochs))
+	}
+	if len(m.PreviousWhitelistedValidators) > 0 {
+		for _, e := range m.PreviousWhitelistedValidators {
+			l = e.Size()
+			n += 1 + l + sovLiquidstake(uint64(l))
+		}
+	}
+	if m.Started {
+		n += 2
+	}
+	return n
+}
+
+func (m *ProxyDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstake(uint64(l))
+	}
+	l = m.TargetWeight.Size()
+	n += 1 + l + sovLiquidstake(uint64(l))
+	l = m.Shares.Size()
+	n += 1 + l + sovLiquidstake(uint64(l))
+	l = m.Tokens.Size()
+	n += 1 + l + sovLiquidstake(uint64(l))
+	if len(m.PendingRewards) > 0 {
+		for _, e := range m.PendingRewards {
+			l = e.Size()
+			n += 1 + l + sovLiquidstake(uint64(l))
+		}
+	}
+	l = m.WeightDeviation.Size()
+	n += 1 + l + sovLiquidstake(uint64(l))
+	return n
+}
+
 func (m *NetAmountState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -853,6 +1740,77 @@ func (m *NetAmountState) Size() (n int) {
 	return n
 }
 
+func (m *StakeToLPSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstake(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstake(uint64(l))
+	}
+	l = m.StakedAmount.Size()
+	n += 1 + l + sovLiquidstake(uint64(l))
+	l = m.LiquidAmount.Size()
+	n += 1 + l + sovLiquidstake(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovLiquidstake(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextExecutionTime)
+	n += 1 + l + sovLiquidstake(uint64(l))
+	return n
+}
+
+func (m *RedelegationFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovLiquidstake(uint64(m.Epoch))
+	}
+	if m.Height != 0 {
+		n += 1 + sovLiquidstake(uint64(m.Height))
+	}
+	l = len(m.SrcValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstake(uint64(l))
+	}
+	l = len(m.DstValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstake(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovLiquidstake(uint64(l))
+	}
+	return n
+}
+
+func (m *VestingLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovLiquidstake(uint64(l))
+	}
+	l = m.LockedAmount.Size()
+	n += 1 + l + sovLiquidstake(uint64(l))
+	l = m.StkAmount.Size()
+	n += 1 + l + sovLiquidstake(uint64(l))
+	return n
+}
+
 func sovLiquidstake(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -869,28 +1827,1178 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowLiquidstake
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidBondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidBondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedValidators = append(m.WhitelistedValidators, WhitelistedValidator{})
+			if err := m.WhitelistedValidators[len(m.WhitelistedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnstakeFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LsmDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LsmDisabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidStakeAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinLiquidStakeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CwLockedPoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CwLockedPoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeAccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutocompoundFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutocompoundFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveValidatorPolicy", wireType)
+			}
+			m.InactiveValidatorPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InactiveValidatorPolicy |= InactiveValidatorPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutocompoundFeeTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutocompoundFeeTiers = append(m.AutocompoundFeeTiers, AutocompoundFeeTier{})
+			if err := m.AutocompoundFeeTiers[len(m.AutocompoundFeeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutocompoundFeeCommunityPoolFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutocompoundFeeCommunityPoolFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutocompoundFeeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutocompoundFeeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutocompoundFeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WhitelistedValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WhitelistedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidValidatorState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidValidatorState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ValidatorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WhitelistRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WhitelistRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WhitelistRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedValidators = append(m.WhitelistedValidators, WhitelistedValidator{})
+			if err := m.WhitelistedValidators[len(m.WhitelistedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransitionEpochs", wireType)
+			}
+			m.TransitionEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransitionEpochs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousWhitelistedValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousWhitelistedValidators = append(m.PreviousWhitelistedValidators, WhitelistedValidator{})
+			if err := m.PreviousWhitelistedValidators[len(m.PreviousWhitelistedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Started = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProxyDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProxyDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProxyDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TargetWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidBondDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -918,11 +3026,47 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidBondDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -949,14 +3093,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WhitelistedValidators = append(m.WhitelistedValidators, WhitelistedValidator{})
-			if err := m.WhitelistedValidators[len(m.WhitelistedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingRewards = append(m.PendingRewards, types.DecCoin{})
+			if err := m.PendingRewards[len(m.PendingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightDeviation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -984,15 +3128,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnstakeFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WeightDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LsmDisabled", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstake(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetAmountState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetAmountState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetAmountState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRate", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstake
@@ -1002,15 +3196,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.LsmDisabled = bool(v != 0)
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidStakeAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StkxprtTotalSupply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1038,13 +3246,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinLiquidStakeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StkxprtTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CwLockedPoolAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1072,11 +3280,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CwLockedPoolAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeAccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelShares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1104,11 +3314,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeAccountAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalDelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutocompoundFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1136,63 +3348,47 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AutocompoundFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalLiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLiquidstake(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRemainingRewards", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLiquidstake
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstake
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLiquidstake
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WhitelistedValidator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WhitelistedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			}
+			if err := m.TotalRemainingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnbondingBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1220,11 +3416,13 @@ func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalUnbondingBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyAccBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1252,7 +3450,7 @@ func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TargetWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProxyAccBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1277,7 +3475,7 @@ func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
+func (m *StakeToLPSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1300,15 +3498,15 @@ func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: StakeToLPSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StakeToLPSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1336,61 +3534,11 @@ func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLiquidstake(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLiquidstake
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLiquidstake
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidValidatorState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidValidatorState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1418,13 +3566,13 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstake
@@ -1434,31 +3582,30 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstake
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstake
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidAmount", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstake
@@ -1468,16 +3615,30 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ValidatorStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstake
@@ -1487,31 +3648,30 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstake
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstake
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExecutionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstake
@@ -1521,23 +3681,22 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstake
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstake
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1562,7 +3721,7 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetAmountState) Unmarshal(dAtA []byte) error {
+func (m *RedelegationFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1585,17 +3744,17 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetAmountState: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationFailure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetAmountState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintRate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var stringLen uint64
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstake
@@ -1605,29 +3764,33 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Epoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidstake
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstake
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			if err := m.MintRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StkxprtTotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1655,13 +3818,11 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StkxprtTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SrcValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1689,13 +3850,11 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DstValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1723,13 +3882,13 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalDelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1757,13 +3916,61 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalLiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstake(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalRemainingRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1791,13 +3998,11 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalRemainingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnbondingBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1825,13 +4030,13 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalUnbondingBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LockedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyAccBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StkAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1859,7 +4064,7 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProxyAccBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StkAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -134,6 +134,52 @@ func TestNativeToStkXPRTTo(t *testing.T) {
 	}
 }
 
+func TestWhitelistRotationSteps(t *testing.T) {
+	valAddrs := []string{
+		sdk.ValAddress("validator_1_________").String(),
+		sdk.ValAddress("validator_2_________").String(),
+		sdk.ValAddress("validator_3_________").String(),
+	}
+	rotation := types.WhitelistRotation{
+		WhitelistedValidators: []types.WhitelistedValidator{
+			{ValidatorAddress: valAddrs[1], TargetWeight: math.NewInt(2)},
+			{ValidatorAddress: valAddrs[2], TargetWeight: math.NewInt(2)},
+		},
+		PreviousWhitelistedValidators: []types.WhitelistedValidator{
+			{ValidatorAddress: valAddrs[0], TargetWeight: math.NewInt(1)},
+			{ValidatorAddress: valAddrs[1], TargetWeight: math.NewInt(1)},
+		},
+		StartEpoch:       10,
+		TransitionEpochs: 4,
+		Started:          true,
+	}
+	require.NoError(t, rotation.Validate())
+
+	require.Equal(t, int64(0), rotation.GetStep(9))
+	require.Equal(t, int64(1), rotation.GetStep(10))
+	require.Equal(t, int64(4), rotation.GetStep(13))
+
+	// removed validator weight goes down, added validator weight goes up
+	require.Equal(t, []types.WhitelistedValidator{
+		{ValidatorAddress: valAddrs[0], TargetWeight: math.NewInt(3)},
+		{ValidatorAddress: valAddrs[1], TargetWeight: math.NewInt(5)},
+		{ValidatorAddress: valAddrs[2], TargetWeight: math.NewInt(2)},
+	}, rotation.GetStepWhitelistedValidators(1))
+	require.Equal(t, []types.WhitelistedValidator{
+		{ValidatorAddress: valAddrs[0], TargetWeight: math.NewInt(1)},
+		{ValidatorAddress: valAddrs[1], TargetWeight: math.NewInt(7)},
+		{ValidatorAddress: valAddrs[2], TargetWeight: math.NewInt(6)},
+	}, rotation.GetStepWhitelistedValidators(3))
+	require.Equal(t, rotation.WhitelistedValidators, rotation.GetStepWhitelistedValidators(4))
+
+	rotation.TransitionEpochs = 0
+	require.ErrorIs(t, rotation.Validate(), types.ErrInvalidWhitelistRotation)
+
+	rotation.TransitionEpochs = 4
+	rotation.WhitelistedValidators = whitelistedValidators
+	require.ErrorIs(t, rotation.Validate(), types.ErrInvalidWhitelistRotation)
+}
+
 func TestActiveCondition(t *testing.T) {
 	testCases := []struct {
 		validator      stakingtypes.Validator
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -10,6 +12,10 @@ var (
 	_ sdk.Msg = (*MsgLiquidStake)(nil)
 	_ sdk.Msg = (*MsgLiquidUnstake)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgScheduleWhitelistRotation)(nil)
+	_ sdk.Msg = (*MsgScheduleStakeToLP)(nil)
+	_ sdk.Msg = (*MsgCancelStakeToLPSchedule)(nil)
+	_ sdk.Msg = (*MsgRepairProxyWithdrawAddress)(nil)
 )
 
 // Message types for the liquidstake module
@@ -18,6 +24,11 @@ const (
 	MsgTypeLiquidUnstake = "liquid_unstake"
 	MsgTypeStakeToLP     = "stake_to_lp"
 	MsgTypeUpdateParams  = "update_params"
+
+	MsgTypeScheduleWhitelistRotation  = "schedule_whitelist_rotation"
+	MsgTypeScheduleStakeToLP          = "schedule_stake_to_lp"
+	MsgTypeCancelStakeToLPSchedule    = "cancel_stake_to_lp_schedule"
+	MsgTypeRepairProxyWithdrawAddress = "repair_proxy_withdraw_address"
 )
 
 // NewMsgLiquidStake creates a new MsgLiquidStake.
@@ -45,6 +56,9 @@ func (m *MsgLiquidStake) ValidateBasic() error {
 	if err := m.Amount.Validate(); err != nil {
 		return err
 	}
+	if !m.MinStkOut.IsNil() && m.MinStkOut.IsNegative() {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "min stk out must not be negative: %s", m.MinStkOut)
+	}
 	return nil
 }
 
@@ -159,6 +173,9 @@ func (m *MsgLiquidUnstake) ValidateBasic() error {
 	if err := m.Amount.Validate(); err != nil {
 		return err
 	}
+	if !m.MinOut.IsNil() && m.MinOut.IsNegative() {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "min out must not be negative: %s", m.MinOut)
+	}
 	return nil
 }
 
@@ -224,3 +241,162 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewMsgScheduleWhitelistRotation creates a new MsgScheduleWhitelistRotation.
+func NewMsgScheduleWhitelistRotation(
+	authority sdk.AccAddress,
+	whitelistedValidators []WhitelistedValidator,
+	startEpoch int64,
+	transitionEpochs int64,
+) *MsgScheduleWhitelistRotation {
+	return &MsgScheduleWhitelistRotation{
+		Authority:             authority.String(),
+		WhitelistedValidators: whitelistedValidators,
+		StartEpoch:            startEpoch,
+		TransitionEpochs:      transitionEpochs,
+	}
+}
+
+func (m *MsgScheduleWhitelistRotation) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgScheduleWhitelistRotation) Type() string {
+	return MsgTypeScheduleWhitelistRotation
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgScheduleWhitelistRotation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgScheduleWhitelistRotation) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgScheduleWhitelistRotation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address %q: %v", m.Authority, err)
+	}
+
+	rotation := WhitelistRotation{
+		WhitelistedValidators: m.WhitelistedValidators,
+		StartEpoch:            m.StartEpoch,
+		TransitionEpochs:      m.TransitionEpochs,
+	}
+	return rotation.Validate()
+}
+
+// NewMsgScheduleStakeToLP creates a new MsgScheduleStakeToLP.
+func NewMsgScheduleStakeToLP(
+	liquidStaker sdk.AccAddress,
+	validator sdk.ValAddress,
+	stakedAmount,
+	liquidAmount sdk.Coin,
+	interval time.Duration,
+) *MsgScheduleStakeToLP {
+	return &MsgScheduleStakeToLP{
+		DelegatorAddress: liquidStaker.String(),
+		ValidatorAddress: validator.String(),
+		StakedAmount:     stakedAmount,
+		LiquidAmount:     liquidAmount,
+		Interval:         interval,
+	}
+}
+
+func (m *MsgScheduleStakeToLP) Route() string { return RouterKey }
+
+func (m *MsgScheduleStakeToLP) Type() string { return MsgTypeScheduleStakeToLP }
+
+func (m *MsgScheduleStakeToLP) ValidateBasic() error {
+	schedule := StakeToLPSchedule{
+		DelegatorAddress: m.DelegatorAddress,
+		ValidatorAddress: m.ValidatorAddress,
+		StakedAmount:     m.StakedAmount,
+		LiquidAmount:     m.LiquidAmount,
+		Interval:         m.Interval,
+	}
+	return schedule.Validate()
+}
+
+func (m *MsgScheduleStakeToLP) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m *MsgScheduleStakeToLP) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgCancelStakeToLPSchedule creates a new MsgCancelStakeToLPSchedule.
+func NewMsgCancelStakeToLPSchedule(liquidStaker sdk.AccAddress, validator sdk.ValAddress) *MsgCancelStakeToLPSchedule {
+	return &MsgCancelStakeToLPSchedule{
+		DelegatorAddress: liquidStaker.String(),
+		ValidatorAddress: validator.String(),
+	}
+}
+
+func (m *MsgCancelStakeToLPSchedule) Route() string { return RouterKey }
+
+func (m *MsgCancelStakeToLPSchedule) Type() string { return MsgTypeCancelStakeToLPSchedule }
+
+func (m *MsgCancelStakeToLPSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.DelegatorAddress); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address %q: %v", m.DelegatorAddress, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(m.ValidatorAddress); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address %q: %v", m.ValidatorAddress, err)
+	}
+	return nil
+}
+
+func (m *MsgCancelStakeToLPSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m *MsgCancelStakeToLPSchedule) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRepairProxyWithdrawAddress creates a new MsgRepairProxyWithdrawAddress.
+func NewMsgRepairProxyWithdrawAddress(authority sdk.AccAddress) *MsgRepairProxyWithdrawAddress {
+	return &MsgRepairProxyWithdrawAddress{
+		Authority: authority.String(),
+	}
+}
+
+func (m *MsgRepairProxyWithdrawAddress) Route() string { return RouterKey }
+
+func (m *MsgRepairProxyWithdrawAddress) Type() string { return MsgTypeRepairProxyWithdrawAddress }
+
+func (m *MsgRepairProxyWithdrawAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address %q: %v", m.Authority, err)
+	}
+	return nil
+}
+
+func (m *MsgRepairProxyWithdrawAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m *MsgRepairProxyWithdrawAddress) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/cometbft/cometbft/crypto"
@@ -31,6 +32,10 @@ func TestMsgLiquidStake(t *testing.T) {
 			"staking amount must not be zero: invalid request",
 			types.NewMsgLiquidStake(delegatorAddr, sdk.NewCoin("token", math.NewInt(0))),
 		},
+		{
+			"min stk out must not be negative: -1: invalid request",
+			&types.MsgLiquidStake{DelegatorAddress: delegatorAddr.String(), Amount: stakingCoin, MinStkOut: math.NewInt(-1)},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -71,6 +76,10 @@ func TestMsgLiquidUnstake(t *testing.T) {
 			"unstaking amount must not be zero: invalid request",
 			types.NewMsgLiquidUnstake(delegatorAddr, sdk.NewCoin("btoken", math.NewInt(0))),
 		},
+		{
+			"min out must not be negative: -1: invalid request",
+			&types.MsgLiquidUnstake{DelegatorAddress: delegatorAddr.String(), Amount: stakingCoin, MinOut: math.NewInt(-1)},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -90,3 +99,104 @@ func TestMsgLiquidUnstake(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgScheduleWhitelistRotation(t *testing.T) {
+	authority := sdk.AccAddress(crypto.AddressHash([]byte("authority")))
+	whitelist := []types.WhitelistedValidator{
+		{
+			ValidatorAddress: sdk.ValAddress(crypto.AddressHash([]byte("validator"))).String(),
+			TargetWeight:     math.NewInt(10),
+		},
+	}
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgScheduleWhitelistRotation
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgScheduleWhitelistRotation(authority, whitelist, 10, 5),
+		},
+		{
+			"invalid authority address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgScheduleWhitelistRotation(sdk.AccAddress{}, whitelist, 10, 5),
+		},
+		{
+			"transition epochs must be positive: 0: invalid whitelist rotation",
+			types.NewMsgScheduleWhitelistRotation(authority, whitelist, 10, 0),
+		},
+		{
+			"start epoch must not be negative: -1: invalid whitelist rotation",
+			types.NewMsgScheduleWhitelistRotation(authority, whitelist, -1, 5),
+		},
+		{
+			"liquidstake validator target weight must be positive: 0: invalid whitelist rotation",
+			types.NewMsgScheduleWhitelistRotation(authority, []types.WhitelistedValidator{
+				{ValidatorAddress: whitelist[0].ValidatorAddress, TargetWeight: math.ZeroInt()},
+			}, 10, 5),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgScheduleWhitelistRotation{}, tc.msg)
+		require.Equal(t, types.MsgTypeScheduleWhitelistRotation, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, authority, signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgScheduleStakeToLP(t *testing.T) {
+	delegatorAddr := sdk.AccAddress(crypto.AddressHash([]byte("delegatorAddr")))
+	validatorAddr := sdk.ValAddress(crypto.AddressHash([]byte("validatorAddr")))
+	stakedCoin := sdk.NewCoin("uxprt", math.NewInt(1))
+	liquidCoin := sdk.NewCoin("uxprt", math.NewInt(0))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgScheduleStakeToLP
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgScheduleStakeToLP(delegatorAddr, validatorAddr, stakedCoin, liquidCoin, time.Hour),
+		},
+		{
+			"invalid delegator address \"\": empty address string is not allowed: invalid address: invalid stake to LP schedule",
+			types.NewMsgScheduleStakeToLP(sdk.AccAddress{}, validatorAddr, stakedCoin, liquidCoin, time.Hour),
+		},
+		{
+			"staking amount must not be zero: invalid request: invalid stake to LP schedule",
+			types.NewMsgScheduleStakeToLP(delegatorAddr, validatorAddr, sdk.NewCoin("uxprt", math.NewInt(0)), liquidCoin, time.Hour),
+		},
+		{
+			"interval must be at least 1h0m0s: 59m0s: invalid stake to LP schedule",
+			types.NewMsgScheduleStakeToLP(delegatorAddr, validatorAddr, stakedCoin, liquidCoin, 59*time.Minute),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgScheduleStakeToLP{}, tc.msg)
+		require.Equal(t, types.MsgTypeScheduleStakeToLP, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, delegatorAddr, signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"cosmossdk.io/math"
@@ -20,11 +21,20 @@ var (
 	// DefaultAutocompoundFeeRate is the default fee rate for auto redelegating the stake rewards.
 	DefaultAutocompoundFeeRate = sdk.MustNewDecFromStr("0.05")
 
+	// DefaultAutocompoundFeeCommunityPoolFraction is the default fraction of the autocompound fee funding the community pool.
+	DefaultAutocompoundFeeCommunityPoolFraction = sdk.ZeroDec()
+
 	// DefaultMinLiquidStakeAmount is the default minimum liquid stake amount.
 	DefaultMinLiquidStakeAmount = math.NewInt(1000)
 
 	// Const variables
 
+	// MaxWhitelistedValidators is the maximum amount of whitelisted validators.
+	MaxWhitelistedValidators = 100
+
+	// MaxAutocompoundFeeTiers is the maximum amount of autocompound fee tiers.
+	MaxAutocompoundFeeTiers = 10
+
 	// RebalancingTrigger if the maximum difference and needed each redelegation amount exceeds it, asset rebalacing will be executed.
 	RebalancingTrigger = math.LegacyNewDecWithPrec(1, 3) // "0.001000000000000000"
 
@@ -42,12 +52,16 @@ var (
 // DefaultParams returns the default liquidstake module parameters.
 func DefaultParams() Params {
 	return Params{
-		WhitelistedValidators: []WhitelistedValidator{},
-		LiquidBondDenom:       DefaultLiquidBondDenom,
-		UnstakeFeeRate:        DefaultUnstakeFeeRate,
-		MinLiquidStakeAmount:  DefaultMinLiquidStakeAmount,
-		FeeAccountAddress:     DummyFeeAccountAcc.String(),
-		AutocompoundFeeRate:   DefaultAutocompoundFeeRate,
+		WhitelistedValidators:   []WhitelistedValidator{},
+		LiquidBondDenom:         DefaultLiquidBondDenom,
+		UnstakeFeeRate:          DefaultUnstakeFeeRate,
+		MinLiquidStakeAmount:    DefaultMinLiquidStakeAmount,
+		FeeAccountAddress:       DummyFeeAccountAcc.String(),
+		AutocompoundFeeRate:     DefaultAutocompoundFeeRate,
+		InactiveValidatorPolicy: InactiveValidatorPolicyUnbond,
+		AutocompoundFeeTiers:    []AutocompoundFeeTier{},
+
+		AutocompoundFeeCommunityPoolFraction: DefaultAutocompoundFeeCommunityPoolFraction,
 	}
 }
 
@@ -57,10 +71,87 @@ func (p Params) String() string {
 	return string(out)
 }
 
+// Diff returns the parameters whose json encoded value differs from the one in other, sorted by key.
+func (p Params) Diff(other Params) ([]ParamDiff, error) {
+	values, err := paramsJSONValues(p)
+	if err != nil {
+		return nil, err
+	}
+	otherValues, err := paramsJSONValues(other)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	for key := range otherValues {
+		if _, ok := values[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	diff := make([]ParamDiff, 0)
+	for _, key := range keys {
+		if values[key] != otherValues[key] {
+			diff = append(diff, ParamDiff{Key: key, Value: values[key], DefaultValue: otherValues[key]})
+		}
+	}
+
+	return diff, nil
+}
+
+// paramsJSONValues returns the json encoded value of each of the parameters, by json name. Unset parameters and
+// empty lists are omitted, as nil and empty lists are not distinguished once stored.
+func paramsJSONValues(p Params) (map[string]string, error) {
+	bz, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string, len(raw))
+	for key, value := range raw {
+		if v := string(value); v != "null" && v != "[]" {
+			values[key] = v
+		}
+	}
+
+	return values, nil
+}
+
 func (p Params) WhitelistedValsMap() WhitelistedValsMap {
 	return GetWhitelistedValsMap(p.WhitelistedValidators)
 }
 
+// EffectiveAutocompoundFeeRate returns the autocompound fee rate averaged over the tiers by the portion of
+// totalStaked each of them covers. The amount below the first tier threshold is charged AutocompoundFeeRate.
+func (p Params) EffectiveAutocompoundFeeRate(totalStaked math.Int) sdk.Dec {
+	if len(p.AutocompoundFeeTiers) == 0 || !totalStaked.IsPositive() {
+		return p.AutocompoundFeeRate
+	}
+
+	lowerBound := math.ZeroInt()
+	rate := p.AutocompoundFeeRate
+	weightedFee := sdk.ZeroDec()
+	for _, tier := range p.AutocompoundFeeTiers {
+		if !totalStaked.GT(tier.Threshold) {
+			break
+		}
+		weightedFee = weightedFee.Add(rate.MulInt(tier.Threshold.Sub(lowerBound)))
+		lowerBound, rate = tier.Threshold, tier.FeeRate
+	}
+	weightedFee = weightedFee.Add(rate.MulInt(totalStaked.Sub(lowerBound)))
+
+	return weightedFee.QuoInt(totalStaked)
+}
+
 // Validate validates parameters.
 func (p Params) Validate() error {
 	for _, v := range []struct {
@@ -73,6 +164,10 @@ func (p Params) Validate() error {
 		{p.MinLiquidStakeAmount, validateMinLiquidStakeAmount},
 		{p.AutocompoundFeeRate, validateAutocompoundFeeRate},
 		{p.FeeAccountAddress, validateFeeAccountAddress},
+		{p.CwLockedPoolAddress, validateCwLockedPoolAddress},
+		{p.InactiveValidatorPolicy, validateInactiveValidatorPolicy},
+		{p.AutocompoundFeeTiers, validateAutocompoundFeeTiers},
+		{p.AutocompoundFeeCommunityPoolFraction, validateAutocompoundFeeCommunityPoolFraction},
 	} {
 		if err := v.validator(v.value); err != nil {
 			return err
@@ -103,6 +198,10 @@ func validateWhitelistedValidators(i interface{}) error {
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	if len(wvs) > MaxWhitelistedValidators {
+		return fmt.Errorf("too many liquidstake validators: %d, maximum %d", len(wvs), MaxWhitelistedValidators)
+	}
+
 	valsMap := map[string]struct{}{}
 	for _, wv := range wvs {
 		_, valErr := sdk.ValAddressFromBech32(wv.ValidatorAddress)
@@ -185,6 +284,27 @@ func validateAutocompoundFeeRate(i interface{}) error {
 	return nil
 }
 
+func validateAutocompoundFeeCommunityPoolFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("autocompound fee community pool fraction must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("autocompound fee community pool fraction must not be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("autocompound fee community pool fraction too large: %s", v)
+	}
+
+	return nil
+}
+
 func validateFeeAccountAddress(i interface{}) error {
 	v, ok := i.(string)
 	if !ok {
@@ -196,3 +316,61 @@ func validateFeeAccountAddress(i interface{}) error {
 	}
 	return nil
 }
+
+func validateCwLockedPoolAddress(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// the locked pool contract is optional
+	if v == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("cannot convert cw locked pool address to bech32, invalid address: %s, err: %v", v, err)
+	}
+	return nil
+}
+
+func validateInactiveValidatorPolicy(i interface{}) error {
+	v, ok := i.(InactiveValidatorPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := InactiveValidatorPolicy_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid inactive validator policy: %d", v)
+	}
+
+	return nil
+}
+
+func validateAutocompoundFeeTiers(i interface{}) error {
+	tiers, ok := i.([]AutocompoundFeeTier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(tiers) > MaxAutocompoundFeeTiers {
+		return fmt.Errorf("too many autocompound fee tiers: %d, maximum %d", len(tiers), MaxAutocompoundFeeTiers)
+	}
+
+	prevThreshold := math.ZeroInt()
+	for _, tier := range tiers {
+		if tier.Threshold.IsNil() {
+			return fmt.Errorf("autocompound fee tier threshold must not be nil")
+		}
+
+		if !tier.Threshold.GT(prevThreshold) {
+			return fmt.Errorf("autocompound fee tier thresholds must be positive and increasing: %s", tier.Threshold)
+		}
+		prevThreshold = tier.Threshold
+
+		if err := validateAutocompoundFeeRate(tier.FeeRate); err != nil {
+			return fmt.Errorf("invalid autocompound fee tier %s: %w", tier.Threshold, err)
+		}
+	}
+	return nil
+}
@@ -19,7 +19,9 @@ func TestParams(t *testing.T) {
 "unstake_fee_rate": "0.000000000000000000",
 "min_liquid_stake_amount": "1000",
 "fee_account_address": "persistence1f0lfxf7d4sxe7y4h8k9zp9d5f6avppsrv9uy8r",
-"autocompound_fee_rate": "0.050000000000000000"
+"autocompound_fee_rate": "0.050000000000000000",
+"autocompound_fee_tiers": [],
+"autocompound_fee_community_pool_fraction": "0.000000000000000000"
 }`
 	require.Equal(t, paramsStr, params.String())
 
@@ -40,7 +42,9 @@ func TestParams(t *testing.T) {
 "unstake_fee_rate": "0.000000000000000000",
 "min_liquid_stake_amount": "1000",
 "fee_account_address": "persistence1f0lfxf7d4sxe7y4h8k9zp9d5f6avppsrv9uy8r",
-"autocompound_fee_rate": "0.050000000000000000"
+"autocompound_fee_rate": "0.050000000000000000",
+"autocompound_fee_tiers": [],
+"autocompound_fee_community_pool_fraction": "0.000000000000000000"
 }`
 	require.Equal(t, paramsStr, params.String())
 }
@@ -154,6 +158,13 @@ func TestValidateWhitelistedValidators(t *testing.T) {
 			},
 			"liquidstake validator target weight must be positive: 0",
 		},
+		{
+			"too many whitelisted validators",
+			func(params *types.Params) {
+				params.WhitelistedValidators = make([]types.WhitelistedValidator, types.MaxWhitelistedValidators+1)
+			},
+			"too many liquidstake validators: 101, maximum 100",
+		},
 		{
 			"nil unstake fee rate",
 			func(params *types.Params) {
@@ -189,6 +200,133 @@ func TestValidateWhitelistedValidators(t *testing.T) {
 			},
 			"min liquid stake amount must not be negative: -1",
 		},
+		{
+			"max unstake fee rate",
+			func(params *types.Params) {
+				params.UnstakeFeeRate = sdk.OneDec()
+			},
+			"",
+		},
+		{
+			"nil autocompound fee rate",
+			func(params *types.Params) {
+				params.AutocompoundFeeRate = sdk.Dec{}
+			},
+			"autocompound fee rate must not be nil",
+		},
+		{
+			"negative autocompound fee rate",
+			func(params *types.Params) {
+				params.AutocompoundFeeRate = math.LegacyMustNewDecFromStr("-0.01")
+			},
+			"autocompound fee rate must not be negative: -0.010000000000000000",
+		},
+		{
+			"too large autocompound fee rate",
+			func(params *types.Params) {
+				params.AutocompoundFeeRate = math.LegacyMustNewDecFromStr("1.01")
+			},
+			"autocompound fee rate too large: 1.010000000000000000",
+		},
+		{
+			"invalid fee account address",
+			func(params *types.Params) {
+				params.FeeAccountAddress = "invalidaddr"
+			},
+			"cannot convert fee account address to bech32, invalid address: invalidaddr, err: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			"valid cw locked pool address",
+			func(params *types.Params) {
+				params.CwLockedPoolAddress = types.DummyFeeAccountAcc.String()
+			},
+			"",
+		},
+		{
+			"invalid cw locked pool address",
+			func(params *types.Params) {
+				params.CwLockedPoolAddress = "invalidaddr"
+			},
+			"cannot convert cw locked pool address to bech32, invalid address: invalidaddr, err: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			"invalid inactive validator policy",
+			func(params *types.Params) {
+				params.InactiveValidatorPolicy = types.InactiveValidatorPolicy(3)
+			},
+			"invalid inactive validator policy: 3",
+		},
+		{
+			"valid autocompound fee tiers",
+			func(params *types.Params) {
+				params.AutocompoundFeeTiers = []types.AutocompoundFeeTier{
+					{Threshold: math.NewInt(1000), FeeRate: math.LegacyMustNewDecFromStr("0.04")},
+					{Threshold: math.NewInt(2000), FeeRate: math.LegacyMustNewDecFromStr("0.02")},
+				}
+			},
+			"",
+		},
+		{
+			"zero autocompound fee tier threshold",
+			func(params *types.Params) {
+				params.AutocompoundFeeTiers = []types.AutocompoundFeeTier{
+					{Threshold: math.ZeroInt(), FeeRate: math.LegacyMustNewDecFromStr("0.04")},
+				}
+			},
+			"autocompound fee tier thresholds must be positive and increasing: 0",
+		},
+		{
+			"unordered autocompound fee tiers",
+			func(params *types.Params) {
+				params.AutocompoundFeeTiers = []types.AutocompoundFeeTier{
+					{Threshold: math.NewInt(2000), FeeRate: math.LegacyMustNewDecFromStr("0.04")},
+					{Threshold: math.NewInt(1000), FeeRate: math.LegacyMustNewDecFromStr("0.02")},
+				}
+			},
+			"autocompound fee tier thresholds must be positive and increasing: 1000",
+		},
+		{
+			"too large autocompound fee tier rate",
+			func(params *types.Params) {
+				params.AutocompoundFeeTiers = []types.AutocompoundFeeTier{
+					{Threshold: math.NewInt(1000), FeeRate: math.LegacyMustNewDecFromStr("1.1")},
+				}
+			},
+			"invalid autocompound fee tier 1000: autocompound fee rate too large: 1.100000000000000000",
+		},
+		{
+			"too many autocompound fee tiers",
+			func(params *types.Params) {
+				for i := 1; i <= types.MaxAutocompoundFeeTiers+1; i++ {
+					params.AutocompoundFeeTiers = append(params.AutocompoundFeeTiers, types.AutocompoundFeeTier{
+						Threshold: math.NewInt(int64(i) * 1000),
+						FeeRate:   math.LegacyMustNewDecFromStr("0.01"),
+					})
+				}
+			},
+			"too many autocompound fee tiers: 11, maximum 10",
+		},
+		{
+			"nil autocompound fee community pool fraction",
+			func(params *types.Params) {
+				params.AutocompoundFeeCommunityPoolFraction = sdk.Dec{}
+			},
+			"autocompound fee community pool fraction must not be nil",
+		},
+		{
+			"negative autocompound fee community pool fraction",
+			func(params *types.Params) {
+				params.AutocompoundFeeCommunityPoolFraction = math.LegacyMustNewDecFromStr("-0.1")
+			},
+			"autocompound fee community pool fraction must not be negative: -0.100000000000000000",
+		},
+		{
+			"too large autocompound fee community pool fraction",
+			func(params *types.Params) {
+				params.AutocompoundFeeCommunityPoolFraction = math.LegacyMustNewDecFromStr("1.1")
+			},
+			"autocompound fee community pool fraction too large: 1.100000000000000000",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			params := types.DefaultParams()
@@ -202,3 +340,53 @@ func TestValidateWhitelistedValidators(t *testing.T) {
 		})
 	}
 }
+
+func TestParamsDiff(t *testing.T) {
+	params := types.DefaultParams()
+	diff, err := params.Diff(types.DefaultParams())
+	require.NoError(t, err)
+	require.Empty(t, diff)
+
+	params.MinLiquidStakeAmount = math.NewInt(5000)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{
+			ValidatorAddress: "persistencevaloper19rz0gtqf88vwk6dwz522ajpqpv5swunqm9z90m",
+			TargetWeight:     math.NewInt(10),
+		},
+	}
+	diff, err = params.Diff(types.DefaultParams())
+	require.NoError(t, err)
+	require.Equal(t, []types.ParamDiff{
+		{Key: "min_liquid_stake_amount", Value: `"5000"`, DefaultValue: `"1000"`},
+		{
+			Key:          "whitelisted_validators",
+			Value:        `[{"validator_address":"persistencevaloper19rz0gtqf88vwk6dwz522ajpqpv5swunqm9z90m","target_weight":"10"}]`,
+			DefaultValue: "",
+		},
+	}, diff)
+}
+
+func TestEffectiveAutocompoundFeeRate(t *testing.T) {
+	params := types.DefaultParams()
+	params.AutocompoundFeeRate = math.LegacyMustNewDecFromStr("0.1")
+	require.Equal(t, params.AutocompoundFeeRate, params.EffectiveAutocompoundFeeRate(math.NewInt(5000)))
+
+	params.AutocompoundFeeTiers = []types.AutocompoundFeeTier{
+		{Threshold: math.NewInt(1000), FeeRate: math.LegacyMustNewDecFromStr("0.05")},
+		{Threshold: math.NewInt(3000), FeeRate: math.LegacyMustNewDecFromStr("0.02")},
+	}
+	for _, tc := range []struct {
+		totalStaked math.Int
+		expected    sdk.Dec
+	}{
+		{math.ZeroInt(), math.LegacyMustNewDecFromStr("0.1")},
+		{math.NewInt(500), math.LegacyMustNewDecFromStr("0.1")},
+		{math.NewInt(1000), math.LegacyMustNewDecFromStr("0.1")},
+		// 1000 * 0.1 + 1000 * 0.05
+		{math.NewInt(2000), math.LegacyMustNewDecFromStr("0.075")},
+		// 1000 * 0.1 + 2000 * 0.05 + 1000 * 0.02
+		{math.NewInt(4000), math.LegacyMustNewDecFromStr("0.055")},
+	} {
+		require.Equal(t, tc.expected, params.EffectiveAutocompoundFeeRate(tc.totalStaked), tc.totalStaked.String())
+	}
+}
@@ -111,6 +111,171 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryParamsDiffRequest is the request type for the Query/ParamsDiff RPC
+// method.
+type QueryParamsDiffRequest struct {
+}
+
+func (m *QueryParamsDiffRequest) Reset()         { *m = QueryParamsDiffRequest{} }
+func (m *QueryParamsDiffRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsDiffRequest) ProtoMessage()    {}
+func (*QueryParamsDiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1badba19848dd753, []int{2}
+}
+func (m *QueryParamsDiffRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsDiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsDiffRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsDiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsDiffRequest.Merge(m, src)
+}
+func (m *QueryParamsDiffRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsDiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsDiffRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsDiffRequest proto.InternalMessageInfo
+
+// QueryParamsDiffResponse is the response type for the Query/ParamsDiff RPC
+// method.
+type QueryParamsDiffResponse struct {
+	Params        Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	DefaultParams Params `protobuf:"bytes,2,opt,name=default_params,json=defaultParams,proto3" json:"default_params"`
+	// diff lists the parameters whose value differs from the default one.
+	Diff []ParamDiff `protobuf:"bytes,3,rep,name=diff,proto3" json:"diff"`
+}
+
+func (m *QueryParamsDiffResponse) Reset()         { *m = QueryParamsDiffResponse{} }
+func (m *QueryParamsDiffResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsDiffResponse) ProtoMessage()    {}
+func (*QueryParamsDiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1badba19848dd753, []int{3}
+}
+func (m *QueryParamsDiffResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsDiffResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsDiffResponse.Merge(m, src)
+}
+func (m *QueryParamsDiffResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsDiffResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsDiffResponse proto.InternalMessageInfo
+
+func (m *QueryParamsDiffResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *QueryParamsDiffResponse) GetDefaultParams() Params {
+	if m != nil {
+		return m.DefaultParams
+	}
+	return Params{}
+}
+
+func (m *QueryParamsDiffResponse) GetDiff() []ParamDiff {
+	if m != nil {
+		return m.Diff
+	}
+	return nil
+}
+
+// ParamDiff is a parameter whose current value differs from its default.
+type ParamDiff struct {
+	// key is the json name of the parameter.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the json encoded current value of the parameter.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// default_value is the json encoded default value of the parameter.
+	DefaultValue string `protobuf:"bytes,3,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+}
+
+func (m *ParamDiff) Reset()         { *m = ParamDiff{} }
+func (m *ParamDiff) String() string { return proto.CompactTextString(m) }
+func (*ParamDiff) ProtoMessage()    {}
+func (*ParamDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1badba19848dd753, []int{4}
+}
+func (m *ParamDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamDiff.Merge(m, src)
+}
+func (m *ParamDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamDiff proto.InternalMessageInfo
+
+func (m *ParamDiff) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ParamDiff) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *ParamDiff) GetDefaultValue() string {
+	if m != nil {
+		return m.DefaultValue
+	}
+	return ""
+}
+
 // QueryLiquidValidatorsRequest is the request type for the
 // Query/LiquidValidators RPC method.
 type QueryLiquidValidatorsRequest struct {
@@ -120,7 +285,7 @@ func (m *QueryLiquidValidatorsRequest) Reset()         { *m = QueryLiquidValidat
 func (m *QueryLiquidValidatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLiquidValidatorsRequest) ProtoMessage()    {}
 func (*QueryLiquidValidatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1badba19848dd753, []int{2}
+	return fileDescriptor_1badba19848dd753, []int{5}
 }
 func (m *QueryLiquidValidatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -159,7 +324,7 @@ func (m *QueryLiquidValidatorsResponse) Reset()         { *m = QueryLiquidValida
 func (m *QueryLiquidValidatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLiquidValidatorsResponse) ProtoMessage()    {}
 func (*QueryLiquidValidatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1badba19848dd753, []int{3}
+	return fileDescriptor_1badba19848dd753, []int{6}
 }
 func (m *QueryLiquidValidatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -203,7 +368,7 @@ func (m *QueryStatesRequest) Reset()         { *m = QueryStatesRequest{} }
 func (m *QueryStatesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryStatesRequest) ProtoMessage()    {}
 func (*QueryStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1badba19848dd753, []int{4}
+	return fileDescriptor_1badba19848dd753, []int{7}
 }
 func (m *QueryStatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -241,7 +406,7 @@ func (m *QueryStatesResponse) Reset()         { *m = QueryStatesResponse{} }
 func (m *QueryStatesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryStatesResponse) ProtoMessage()    {}
 func (*QueryStatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1badba19848dd753, []int{5}
+	return fileDescriptor_1badba19848dd753, []int{8}
 }
 func (m *QueryStatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,466 +442,2822 @@ func (m *QueryStatesResponse) GetNetAmountState() NetAmountState {
 	return NetAmountState{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "pstake.liquidstake.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "pstake.liquidstake.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryLiquidValidatorsRequest)(nil), "pstake.liquidstake.v1beta1.QueryLiquidValidatorsRequest")
-	proto.RegisterType((*QueryLiquidValidatorsResponse)(nil), "pstake.liquidstake.v1beta1.QueryLiquidValidatorsResponse")
-	proto.RegisterType((*QueryStatesRequest)(nil), "pstake.liquidstake.v1beta1.QueryStatesRequest")
-	proto.RegisterType((*QueryStatesResponse)(nil), "pstake.liquidstake.v1beta1.QueryStatesResponse")
+// QueryProxyDelegationsRequest is the request type for the
+// Query/ProxyDelegations RPC method.
+type QueryProxyDelegationsRequest struct {
 }
 
-func init() {
-	proto.RegisterFile("pstake/liquidstake/v1beta1/query.proto", fileDescriptor_1badba19848dd753)
+func (m *QueryProxyDelegationsRequest) Reset()         { *m = QueryProxyDelegationsRequest{} }
+func (m *QueryProxyDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyDelegationsRequest) ProtoMessage()    {}
+func (*QueryProxyDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1badba19848dd753, []int{9}
 }
-
-var fileDescriptor_1badba19848dd753 = []byte{
-	// 463 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x8a, 0x13, 0x41,
-	0x10, 0x4e, 0xbb, 0x9a, 0x43, 0x2f, 0x48, 0x6c, 0xf7, 0xb0, 0x0c, 0xeb, 0xb8, 0x0c, 0xb2, 0x84,
-	0x45, 0xbb, 0xdd, 0x78, 0xd1, 0x8b, 0xe8, 0x9e, 0xc5, 0x9f, 0x15, 0x54, 0xf6, 0x12, 0x3a, 0xd9,
-	0x62, 0x6c, 0x4c, 0xba, 0x27, 0xd3, 0x35, 0xc1, 0xbd, 0x8a, 0x0f, 0x20, 0x88, 0xcf, 0xe2, 0x2b,
-	0xec, 0x71, 0xc1, 0x8b, 0x27, 0x91, 0xc4, 0x17, 0xf0, 0x0d, 0x24, 0xdd, 0xed, 0x98, 0x89, 0x64,
-	0x1c, 0xbc, 0x15, 0xd5, 0x5f, 0xd5, 0xf7, 0xcd, 0x57, 0xdf, 0xd0, 0xbd, 0xcc, 0xa2, 0x7c, 0x03,
-	0x62, 0xa4, 0x26, 0x85, 0x3a, 0xf1, 0xf5, 0xf4, 0x60, 0x00, 0x28, 0x0f, 0xc4, 0xa4, 0x80, 0xfc,
-	0x94, 0x67, 0xb9, 0x41, 0xc3, 0x22, 0x8f, 0xe3, 0x4b, 0x38, 0x1e, 0x70, 0xd1, 0x4e, 0x6a, 0x4c,
-	0x3a, 0x02, 0x21, 0x33, 0x25, 0xa4, 0xd6, 0x06, 0x25, 0x2a, 0xa3, 0xad, 0x9f, 0x8c, 0x6e, 0xd6,
-	0x30, 0x2c, 0x6f, 0xf3, 0xe8, 0xad, 0xd4, 0xa4, 0xc6, 0x95, 0x62, 0x51, 0xf9, 0x6e, 0xb2, 0x45,
-	0xd9, 0xb3, 0x85, 0x98, 0xa7, 0x32, 0x97, 0x63, 0x7b, 0x04, 0x93, 0x02, 0x2c, 0x26, 0x2f, 0xe9,
-	0xd5, 0x4a, 0xd7, 0x66, 0x46, 0x5b, 0x60, 0x0f, 0x68, 0x3b, 0x73, 0x9d, 0x6d, 0xb2, 0x4b, 0xba,
-	0x9b, 0xbd, 0x84, 0xaf, 0xd7, 0xce, 0xfd, 0xec, 0xe1, 0xc5, 0xb3, 0x6f, 0xd7, 0x5b, 0x47, 0x61,
-	0x2e, 0x89, 0xe9, 0x8e, 0x5b, 0xfc, 0xc8, 0x0d, 0xbc, 0x90, 0x23, 0x75, 0x22, 0xd1, 0xe4, 0x25,
-	0xf1, 0x7b, 0x42, 0xaf, 0xad, 0x01, 0x04, 0x0d, 0x43, 0x7a, 0xc5, 0xb3, 0xf5, 0xa7, 0xe5, 0xe3,
-	0x36, 0xd9, 0xdd, 0xe8, 0x6e, 0xf6, 0x6e, 0xd7, 0xc9, 0x59, 0x59, 0xf8, 0x1c, 0x25, 0x42, 0x10,
-	0xd7, 0x19, 0xad, 0x90, 0x95, 0xae, 0x38, 0x54, 0x29, 0x6e, 0x12, 0x5c, 0xf9, 0xdd, 0x0d, 0x8a,
-	0x8e, 0x69, 0x47, 0x03, 0xf6, 0xe5, 0xd8, 0x14, 0x1a, 0xfb, 0x76, 0xf1, 0x18, 0xfc, 0xd9, 0xaf,
-	0x13, 0xf4, 0x18, 0xf0, 0xa1, 0x1b, 0x59, 0x96, 0x72, 0x59, 0x57, 0xba, 0xbd, 0x9f, 0x1b, 0xf4,
-	0x92, 0xe3, 0x64, 0x9f, 0x08, 0x6d, 0x7b, 0x4b, 0x19, 0xaf, 0x5b, 0xfb, 0xf7, 0x35, 0x23, 0xd1,
-	0x18, 0xef, 0xbf, 0x28, 0xd9, 0x7f, 0xf7, 0xe5, 0xc7, 0xc7, 0x0b, 0x37, 0x58, 0x22, 0x6a, 0x12,
-	0xe6, 0x2f, 0xca, 0x3e, 0x13, 0xda, 0x59, 0x3d, 0x16, 0xbb, 0xfb, 0x4f, 0xc6, 0x35, 0x01, 0x88,
-	0xee, 0xfd, 0xc7, 0x64, 0x50, 0xcd, 0x9d, 0xea, 0x2e, 0xdb, 0xab, 0x53, 0xfd, 0x27, 0x34, 0xce,
-	0x51, 0x7f, 0xca, 0x06, 0x8e, 0x56, 0x92, 0xd0, 0xc0, 0xd1, 0x6a, 0x46, 0x9a, 0x39, 0xea, 0xa2,
-	0x63, 0x0f, 0x5f, 0x9d, 0xcd, 0x62, 0x72, 0x3e, 0x8b, 0xc9, 0xf7, 0x59, 0x4c, 0x3e, 0xcc, 0xe3,
-	0xd6, 0xf9, 0x3c, 0x6e, 0x7d, 0x9d, 0xc7, 0xad, 0xe3, 0xfb, 0xa9, 0xc2, 0xd7, 0xc5, 0x80, 0x0f,
-	0xcd, 0x58, 0x64, 0x90, 0x5b, 0x65, 0x11, 0xf4, 0x10, 0x9e, 0x68, 0x08, 0x6b, 0x6f, 0x69, 0x89,
-	0x6a, 0x0a, 0x62, 0xda, 0x13, 0x6f, 0x2b, 0x14, 0x78, 0x9a, 0x81, 0x1d, 0xb4, 0xdd, 0x3f, 0x7f,
-	0xe7, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd6, 0x2b, 0x8a, 0x4b, 0x9b, 0x04, 0x00, 0x00,
+func (m *QueryProxyDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyDelegationsRequest.Merge(m, src)
+}
+func (m *QueryProxyDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyDelegationsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryProxyDelegationsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns parameters of the liquidstake module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// LiquidValidators returns liquid validators with states of the liquidstake
-	// module.
-	LiquidValidators(ctx context.Context, in *QueryLiquidValidatorsRequest, opts ...grpc.CallOption) (*QueryLiquidValidatorsResponse, error)
-	// States returns states of the liquidstake module.
-	States(ctx context.Context, in *QueryStatesRequest, opts ...grpc.CallOption) (*QueryStatesResponse, error)
+// QueryProxyDelegationsResponse is the response type for the
+// Query/ProxyDelegations RPC method.
+type QueryProxyDelegationsResponse struct {
+	ProxyDelegations []ProxyDelegation `protobuf:"bytes,1,rep,name=proxy_delegations,json=proxyDelegations,proto3" json:"proxy_delegations"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryProxyDelegationsResponse) Reset()         { *m = QueryProxyDelegationsResponse{} }
+func (m *QueryProxyDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyDelegationsResponse) ProtoMessage()    {}
+func (*QueryProxyDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1badba19848dd753, []int{10}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryProxyDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstake.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryProxyDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) LiquidValidators(ctx context.Context, in *QueryLiquidValidatorsRequest, opts ...grpc.CallOption) (*QueryLiquidValidatorsResponse, error) {
-	out := new(QueryLiquidValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstake.v1beta1.Query/LiquidValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryProxyDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyDelegationsResponse.Merge(m, src)
+}
+func (m *QueryProxyDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyDelegationsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) States(ctx context.Context, in *QueryStatesRequest, opts ...grpc.CallOption) (*QueryStatesResponse, error) {
-	out := new(QueryStatesResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstake.v1beta1.Query/States", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProxyDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryProxyDelegationsResponse) GetProxyDelegations() []ProxyDelegation {
+	if m != nil {
+		return m.ProxyDelegations
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns parameters of the liquidstake module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// LiquidValidators returns liquid validators with states of the liquidstake
-	// module.
-	LiquidValidators(context.Context, *QueryLiquidValidatorsRequest) (*QueryLiquidValidatorsResponse, error)
-	// States returns states of the liquidstake module.
-	States(context.Context, *QueryStatesRequest) (*QueryStatesResponse, error)
+// QueryWhitelistRotationRequest is the request type for the
+// Query/WhitelistRotation RPC method.
+type QueryWhitelistRotationRequest struct {
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryWhitelistRotationRequest) Reset()         { *m = QueryWhitelistRotationRequest{} }
+func (m *QueryWhitelistRotationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWhitelistRotationRequest) ProtoMessage()    {}
+func (*QueryWhitelistRotationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1badba19848dd753, []int{11}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryWhitelistRotationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) LiquidValidators(ctx context.Context, req *QueryLiquidValidatorsRequest) (*QueryLiquidValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidValidators not implemented")
+func (m *QueryWhitelistRotationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWhitelistRotationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) States(ctx context.Context, req *QueryStatesRequest) (*QueryStatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method States not implemented")
+func (m *QueryWhitelistRotationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWhitelistRotationRequest.Merge(m, src)
+}
+func (m *QueryWhitelistRotationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWhitelistRotationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWhitelistRotationRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryWhitelistRotationRequest proto.InternalMessageInfo
+
+// QueryWhitelistRotationResponse is the response type for the
+// Query/WhitelistRotation RPC method.
+type QueryWhitelistRotationResponse struct {
+	WhitelistRotation *WhitelistRotation `protobuf:"bytes,1,opt,name=whitelist_rotation,json=whitelistRotation,proto3" json:"whitelist_rotation,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstake.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryWhitelistRotationResponse) Reset()         { *m = QueryWhitelistRotationResponse{} }
+func (m *QueryWhitelistRotationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWhitelistRotationResponse) ProtoMessage()    {}
+func (*QueryWhitelistRotationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1badba19848dd753, []int{12}
+}
+func (m *QueryWhitelistRotationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWhitelistRotationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWhitelistRotationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryWhitelistRotationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWhitelistRotationResponse.Merge(m, src)
+}
+func (m *QueryWhitelistRotationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWhitelistRotationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWhitelistRotationResponse.DiscardUnknown(m)
 }
 
-func _Query_LiquidValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLiquidValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryWhitelistRotationResponse proto.InternalMessageInfo
+
+func (m *QueryWhitelistRotationResponse) GetWhitelistRotation() *WhitelistRotation {
+	if m != nil {
+		return m.WhitelistRotation
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstake.v1beta1.Query/LiquidValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidValidators(ctx, req.(*QueryLiquidValidatorsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_States_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStatesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).States(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstake.v1beta1.Query/States",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).States(ctx, req.(*QueryStatesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryStakeToLPSchedulesRequest is the request type for the
+// Query/StakeToLPSchedules RPC method.
+type QueryStakeToLPSchedulesRequest struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pstake.liquidstake.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "LiquidValidators",
-			Handler:    _Query_LiquidValidators_Handler,
-		},
-		{
-			MethodName: "States",
-			Handler:    _Query_States_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pstake/liquidstake/v1beta1/query.proto",
+func (m *QueryStakeToLPSchedulesRequest) Reset()         { *m = QueryStakeToLPSchedulesRequest{} }
+func (m *QueryStakeToLPSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakeToLPSchedulesRequest) ProtoMessage()    {}
+func (*QueryStakeToLPSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1badba19848dd753, []int{13}
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryStakeToLPSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakeToLPSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakeToLPSchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryStakeToLPSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakeToLPSchedulesRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryStakeToLPSchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakeToLPSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakeToLPSchedulesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStakeToLPSchedulesRequest proto.InternalMessageInfo
+
+func (m *QueryStakeToLPSchedulesRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryStakeToLPSchedulesResponse is the response type for the
+// Query/StakeToLPSchedules RPC method.
+type QueryStakeToLPSchedulesResponse struct {
+	Schedules []StakeToLPSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryStakeToLPSchedulesResponse) Reset()         { *m = QueryStakeToLPSchedulesResponse{} }
+func (m *QueryStakeToLPSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakeToLPSchedulesResponse) ProtoMessage()    {}
+func (*QueryStakeToLPSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1badba19848dd753, []int{14}
+}
+func (m *QueryStakeToLPSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakeToLPSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakeToLPSchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryStakeToLPSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakeToLPSchedulesResponse.Merge(m, src)
+}
+func (m *QueryStakeToLPSchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakeToLPSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakeToLPSchedulesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLiquidValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStakeToLPSchedulesResponse proto.InternalMessageInfo
+
+func (m *QueryStakeToLPSchedulesResponse) GetSchedules() []StakeToLPSchedule {
+	if m != nil {
+		return m.Schedules
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLiquidValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRedelegationFailuresRequest is the request type for the
+// Query/RedelegationFailures RPC method.
+type QueryRedelegationFailuresRequest struct {
+	Epoch int64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
 }
 
-func (m *QueryLiquidValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryRedelegationFailuresRequest) Reset()         { *m = QueryRedelegationFailuresRequest{} }
+func (m *QueryRedelegationFailuresRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedelegationFailuresRequest) ProtoMessage()    {}
+func (*QueryRedelegationFailuresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1badba19848dd753, []int{15}
+}
+func (m *QueryRedelegationFailuresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedelegationFailuresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedelegationFailuresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRedelegationFailuresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedelegationFailuresRequest.Merge(m, src)
+}
+func (m *QueryRedelegationFailuresRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedelegationFailuresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedelegationFailuresRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLiquidValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRedelegationFailuresRequest proto.InternalMessageInfo
+
+func (m *QueryRedelegationFailuresRequest) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryLiquidValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRedelegationFailuresResponse is the response type for the
+// Query/RedelegationFailures RPC method.
+type QueryRedelegationFailuresResponse struct {
+	RedelegationFailures []RedelegationFailure `protobuf:"bytes,1,rep,name=redelegation_failures,json=redelegationFailures,proto3" json:"redelegation_failures"`
 }
 
-func (m *QueryLiquidValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LiquidValidators) > 0 {
-		for iNdEx := len(m.LiquidValidators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LiquidValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryRedelegationFailuresResponse) Reset()         { *m = QueryRedelegationFailuresResponse{} }
+func (m *QueryRedelegationFailuresResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedelegationFailuresResponse) ProtoMessage()    {}
+func (*QueryRedelegationFailuresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1badba19848dd753, []int{16}
+}
+func (m *QueryRedelegationFailuresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedelegationFailuresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedelegationFailuresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRedelegationFailuresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedelegationFailuresResponse.Merge(m, src)
+}
+func (m *QueryRedelegationFailuresResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedelegationFailuresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedelegationFailuresResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStatesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRedelegationFailuresResponse proto.InternalMessageInfo
+
+func (m *QueryRedelegationFailuresResponse) GetRedelegationFailures() []RedelegationFailure {
+	if m != nil {
+		return m.RedelegationFailures
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryStatesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVestingLockRequest is the request type for the Query/VestingLock RPC
+// method.
+type QueryVestingLockRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryVestingLockRequest) Reset()         { *m = QueryVestingLockRequest{} }
+func (m *QueryVestingLockRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingLockRequest) ProtoMessage()    {}
+func (*QueryVestingLockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1badba19848dd753, []int{17}
+}
+func (m *QueryVestingLockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingLockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingLockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingLockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingLockRequest.Merge(m, src)
+}
+func (m *QueryVestingLockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingLockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingLockRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVestingLockRequest proto.InternalMessageInfo
+
+func (m *QueryVestingLockRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryStatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVestingLockResponse is the response type for the Query/VestingLock RPC
+// method.
+type QueryVestingLockResponse struct {
+	VestingLock VestingLock `protobuf:"bytes,1,opt,name=vesting_lock,json=vestingLock,proto3" json:"vesting_lock"`
 }
 
-func (m *QueryStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.NetAmountState.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryVestingLockResponse) Reset()         { *m = QueryVestingLockResponse{} }
+func (m *QueryVestingLockResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingLockResponse) ProtoMessage()    {}
+func (*QueryVestingLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1badba19848dd753, []int{18}
+}
+func (m *QueryVestingLockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingLockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryVestingLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingLockResponse.Merge(m, src)
+}
+func (m *QueryVestingLockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingLockResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryVestingLockResponse proto.InternalMessageInfo
+
+func (m *QueryVestingLockResponse) GetVestingLock() VestingLock {
+	if m != nil {
+		return m.VestingLock
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return VestingLock{}
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "pstake.liquidstake.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "pstake.liquidstake.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryParamsDiffRequest)(nil), "pstake.liquidstake.v1beta1.QueryParamsDiffRequest")
+	proto.RegisterType((*QueryParamsDiffResponse)(nil), "pstake.liquidstake.v1beta1.QueryParamsDiffResponse")
+	proto.RegisterType((*ParamDiff)(nil), "pstake.liquidstake.v1beta1.ParamDiff")
+	proto.RegisterType((*QueryLiquidValidatorsRequest)(nil), "pstake.liquidstake.v1beta1.QueryLiquidValidatorsRequest")
+	proto.RegisterType((*QueryLiquidValidatorsResponse)(nil), "pstake.liquidstake.v1beta1.QueryLiquidValidatorsResponse")
+	proto.RegisterType((*QueryStatesRequest)(nil), "pstake.liquidstake.v1beta1.QueryStatesRequest")
+	proto.RegisterType((*QueryStatesResponse)(nil), "pstake.liquidstake.v1beta1.QueryStatesResponse")
+	proto.RegisterType((*QueryProxyDelegationsRequest)(nil), "pstake.liquidstake.v1beta1.QueryProxyDelegationsRequest")
+	proto.RegisterType((*QueryProxyDelegationsResponse)(nil), "pstake.liquidstake.v1beta1.QueryProxyDelegationsResponse")
+	proto.RegisterType((*QueryWhitelistRotationRequest)(nil), "pstake.liquidstake.v1beta1.QueryWhitelistRotationRequest")
+	proto.RegisterType((*QueryWhitelistRotationResponse)(nil), "pstake.liquidstake.v1beta1.QueryWhitelistRotationResponse")
+	proto.RegisterType((*QueryStakeToLPSchedulesRequest)(nil), "pstake.liquidstake.v1beta1.QueryStakeToLPSchedulesRequest")
+	proto.RegisterType((*QueryStakeToLPSchedulesResponse)(nil), "pstake.liquidstake.v1beta1.QueryStakeToLPSchedulesResponse")
+	proto.RegisterType((*QueryRedelegationFailuresRequest)(nil), "pstake.liquidstake.v1beta1.QueryRedelegationFailuresRequest")
+	proto.RegisterType((*QueryRedelegationFailuresResponse)(nil), "pstake.liquidstake.v1beta1.QueryRedelegationFailuresResponse")
+	proto.RegisterType((*QueryVestingLockRequest)(nil), "pstake.liquidstake.v1beta1.QueryVestingLockRequest")
+	proto.RegisterType((*QueryVestingLockResponse)(nil), "pstake.liquidstake.v1beta1.QueryVestingLockResponse")
+}
+
+func init() {
+	proto.RegisterFile("pstake/liquidstake/v1beta1/query.proto", fileDescriptor_1badba19848dd753)
+}
+
+var fileDescriptor_1badba19848dd753 = []byte{
+	// 1032 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x5f, 0x6f, 0xdb, 0x54,
+	0x14, 0xaf, 0x97, 0xb5, 0xa8, 0xa7, 0xdb, 0x94, 0xdc, 0x05, 0x88, 0xac, 0x2d, 0x2d, 0x06, 0xb6,
+	0xb2, 0xd1, 0x78, 0x4b, 0x07, 0x5a, 0x3b, 0xfe, 0x75, 0x1a, 0xf0, 0xd2, 0xb1, 0x2e, 0x45, 0xdd,
+	0x34, 0x21, 0x2c, 0x37, 0xb9, 0x49, 0x4d, 0x5c, 0x5f, 0xd7, 0xbe, 0xce, 0x56, 0x55, 0x03, 0x09,
+	0xf1, 0x3e, 0x24, 0xc4, 0x57, 0xe0, 0x13, 0x20, 0xf1, 0xc6, 0x03, 0x4f, 0x7b, 0xac, 0x84, 0x84,
+	0x78, 0x40, 0x08, 0xb5, 0xbc, 0xf2, 0x1d, 0x90, 0xaf, 0x8f, 0x1d, 0x27, 0x4e, 0x6e, 0xd2, 0x68,
+	0x6f, 0xd7, 0xe7, 0xef, 0xef, 0x77, 0xee, 0xcd, 0xf9, 0x29, 0x70, 0xc9, 0xf5, 0xb9, 0xd9, 0xa6,
+	0xba, 0x6d, 0xed, 0x05, 0x56, 0x23, 0x3a, 0x77, 0xae, 0x6f, 0x53, 0x6e, 0x5e, 0xd7, 0xf7, 0x02,
+	0xea, 0xed, 0x57, 0x5c, 0x8f, 0x71, 0x46, 0xd4, 0x28, 0xae, 0x92, 0x8a, 0xab, 0x60, 0x9c, 0x7a,
+	0xa1, 0xc5, 0x58, 0xcb, 0xa6, 0xba, 0xe9, 0x5a, 0xba, 0xe9, 0x38, 0x8c, 0x9b, 0xdc, 0x62, 0x8e,
+	0x1f, 0x65, 0xaa, 0x6f, 0x4b, 0x3a, 0xa4, 0xab, 0x45, 0xd1, 0xc5, 0x16, 0x6b, 0x31, 0x71, 0xd4,
+	0xc3, 0x53, 0x64, 0xd5, 0x8a, 0x40, 0xee, 0x87, 0x60, 0x36, 0x4c, 0xcf, 0xdc, 0xf5, 0x6b, 0x74,
+	0x2f, 0xa0, 0x3e, 0xd7, 0x1e, 0xc0, 0xf9, 0x1e, 0xab, 0xef, 0x32, 0xc7, 0xa7, 0xe4, 0x23, 0x98,
+	0x71, 0x85, 0xa5, 0xa4, 0x2c, 0x28, 0x8b, 0x73, 0x55, 0xad, 0x32, 0x1c, 0x7b, 0x25, 0xca, 0xbd,
+	0x7d, 0xfa, 0xf9, 0xdf, 0xf3, 0x53, 0x35, 0xcc, 0xd3, 0x4a, 0xf0, 0x4a, 0xaa, 0xf0, 0x1d, 0xab,
+	0xd9, 0x8c, 0x5b, 0xfe, 0xa7, 0xc0, 0xab, 0x19, 0xd7, 0x8b, 0xea, 0x4b, 0xee, 0xc1, 0xb9, 0x06,
+	0x6d, 0x9a, 0x81, 0xcd, 0x0d, 0xac, 0x74, 0xea, 0x84, 0x95, 0xce, 0x62, 0x7e, 0x64, 0x24, 0x1f,
+	0xc2, 0xe9, 0x86, 0xd5, 0x6c, 0x96, 0x72, 0x0b, 0xb9, 0xc5, 0xb9, 0xea, 0x9b, 0x23, 0xcb, 0x84,
+	0x7c, 0xb0, 0x92, 0x48, 0xd4, 0x1e, 0xc2, 0x6c, 0xe2, 0x20, 0x79, 0xc8, 0xb5, 0xe9, 0xbe, 0x60,
+	0x37, 0x5b, 0x0b, 0x8f, 0xa4, 0x08, 0xd3, 0x1d, 0xd3, 0x0e, 0xa8, 0xc0, 0x39, 0x5b, 0x8b, 0x3e,
+	0xc8, 0xeb, 0x10, 0xc3, 0x30, 0x22, 0x6f, 0x4e, 0x78, 0xcf, 0xa0, 0x71, 0x2b, 0xb4, 0x69, 0x65,
+	0xb8, 0x20, 0x06, 0xb9, 0x2e, 0xb0, 0x6c, 0x99, 0xb6, 0xd5, 0x30, 0x39, 0xf3, 0x92, 0xcb, 0xfd,
+	0x4e, 0x81, 0x8b, 0x43, 0x02, 0x70, 0xde, 0x75, 0x28, 0x44, 0x44, 0x8c, 0x4e, 0xe2, 0x2c, 0x29,
+	0x82, 0xe9, 0x35, 0x19, 0xd3, 0xbe, 0x82, 0x9b, 0xdc, 0xe4, 0x14, 0x49, 0xe7, 0xed, 0xbe, 0x66,
+	0xc9, 0xcb, 0x13, 0x51, 0x09, 0xb8, 0x3d, 0x38, 0xdf, 0x63, 0x45, 0x44, 0x8f, 0x20, 0xef, 0x50,
+	0x6e, 0x98, 0xbb, 0x2c, 0x70, 0xb8, 0xe1, 0x87, 0x4e, 0x7c, 0x0b, 0x57, 0x64, 0x80, 0x3e, 0xa3,
+	0x7c, 0x4d, 0xa4, 0xa4, 0xa1, 0x9c, 0x73, 0x7a, 0xac, 0xc9, 0xbc, 0x36, 0x3c, 0xf6, 0x64, 0xff,
+	0x0e, 0xb5, 0x69, 0x2b, 0xfa, 0x95, 0xc5, 0x90, 0xbe, 0x81, 0x8b, 0x43, 0xfc, 0x08, 0xee, 0x4b,
+	0x28, 0xb8, 0xa1, 0xcf, 0x68, 0x74, 0x9d, 0x38, 0xae, 0xab, 0xd2, 0x87, 0xd1, 0x5b, 0x30, 0x9e,
+	0x94, 0xdb, 0xd7, 0x47, 0x9b, 0x47, 0x00, 0x0f, 0x76, 0x2c, 0x4e, 0x6d, 0xcb, 0xe7, 0x35, 0x5c,
+	0x04, 0x31, 0xc2, 0xaf, 0xa1, 0x3c, 0x2c, 0x00, 0x21, 0x7e, 0x01, 0xe4, 0x71, 0xec, 0x34, 0x3c,
+	0xf4, 0xe2, 0x04, 0x97, 0x64, 0x18, 0xb3, 0x25, 0x0b, 0x8f, 0xfb, 0x4d, 0xda, 0x5d, 0xec, 0xbf,
+	0x19, 0xa6, 0x7e, 0xce, 0xd6, 0x37, 0x36, 0xeb, 0x3b, 0xb4, 0x11, 0xd8, 0xc9, 0xb5, 0x92, 0xab,
+	0x50, 0xc0, 0xe1, 0x30, 0xcf, 0x30, 0x1b, 0x0d, 0x8f, 0xfa, 0x3e, 0x3e, 0xf7, 0x7c, 0xe2, 0x58,
+	0x8b, 0xec, 0x1a, 0x87, 0xf9, 0xa1, 0xe5, 0x90, 0xcf, 0x7d, 0x98, 0xf5, 0x63, 0x23, 0x8e, 0x5a,
+	0x4a, 0x23, 0x53, 0x0a, 0x87, 0xdd, 0xad, 0xa2, 0xdd, 0x84, 0x05, 0xd1, 0xb5, 0x46, 0xbb, 0xd7,
+	0xf8, 0x89, 0x69, 0xd9, 0x81, 0xd7, 0xa5, 0x51, 0x84, 0x69, 0xea, 0xb2, 0xfa, 0x8e, 0x80, 0x9e,
+	0xab, 0x45, 0x1f, 0xda, 0x33, 0x05, 0x5e, 0x93, 0xa4, 0x22, 0xe4, 0xaf, 0xe0, 0x65, 0x2f, 0xe5,
+	0x37, 0x9a, 0x18, 0x80, 0xf0, 0x75, 0x19, 0xfc, 0x01, 0x85, 0x91, 0x40, 0xd1, 0x1b, 0xd0, 0x53,
+	0x5b, 0xc6, 0x5d, 0xba, 0x45, 0x7d, 0x6e, 0x39, 0xad, 0x75, 0x56, 0x6f, 0xc7, 0x14, 0x4a, 0xf0,
+	0x52, 0xef, 0xfc, 0xe3, 0x4f, 0xcd, 0x86, 0x52, 0x36, 0x09, 0xc1, 0x6f, 0xc0, 0x99, 0x4e, 0x64,
+	0x36, 0x6c, 0x56, 0x6f, 0xe3, 0xcb, 0xb9, 0x2c, 0xc3, 0x9c, 0x2a, 0x83, 0x58, 0xe7, 0x3a, 0x5d,
+	0x53, 0xf5, 0xd9, 0x59, 0x98, 0x16, 0xed, 0xc8, 0x8f, 0x0a, 0xcc, 0xe0, 0x56, 0xad, 0xc8, 0x0a,
+	0x66, 0x75, 0x4a, 0xd5, 0xc7, 0x8e, 0x8f, 0x78, 0x68, 0x57, 0xbe, 0xfd, 0xfd, 0xdf, 0x1f, 0x4e,
+	0xbd, 0x41, 0x34, 0x5d, 0xa2, 0x9d, 0xa8, 0x19, 0x3f, 0x29, 0x00, 0x5d, 0x31, 0x22, 0xd5, 0x31,
+	0x7b, 0xa5, 0x44, 0x4d, 0x5d, 0x3e, 0x51, 0x0e, 0x62, 0xd4, 0x05, 0xc6, 0xb7, 0xc8, 0xe5, 0xd1,
+	0x18, 0x8d, 0x50, 0x4a, 0xc8, 0x2f, 0x0a, 0xe4, 0xfb, 0x77, 0x39, 0xb9, 0x39, 0xb2, 0xf5, 0x10,
+	0x7d, 0x50, 0x57, 0x26, 0xc8, 0x44, 0xe8, 0x15, 0x01, 0x7d, 0x91, 0x5c, 0x92, 0x41, 0xef, 0x6a,
+	0x8a, 0xb8, 0xfa, 0x68, 0xd3, 0x8f, 0x71, 0xf5, 0x3d, 0x42, 0xa1, 0xea, 0x63, 0xc7, 0x9f, 0xe4,
+	0xea, 0xfd, 0x08, 0xcc, 0xaf, 0x0a, 0xe4, 0xfb, 0xd7, 0xfd, 0x18, 0x13, 0x1d, 0xa2, 0x20, 0xea,
+	0xca, 0x04, 0x99, 0x88, 0xfa, 0x1d, 0x81, 0x5a, 0x27, 0x4b, 0xd2, 0xc7, 0xd0, 0xaf, 0x3e, 0xe4,
+	0x37, 0x05, 0x0a, 0x99, 0xd5, 0x4d, 0x46, 0xe3, 0x18, 0x26, 0x31, 0xea, 0xea, 0x24, 0xa9, 0xc8,
+	0xe1, 0x5d, 0xc1, 0xe1, 0x1a, 0xa9, 0xc8, 0x38, 0x64, 0xe5, 0x89, 0xfc, 0xa5, 0x00, 0xc9, 0x6a,
+	0x00, 0x59, 0x1d, 0xe7, 0xe6, 0x07, 0xeb, 0x90, 0x7a, 0x6b, 0xa2, 0x5c, 0xe4, 0x71, 0x57, 0xf0,
+	0xf8, 0x94, 0x7c, 0x3c, 0xe2, 0x05, 0xb5, 0xa9, 0xc1, 0x99, 0x61, 0xbb, 0x46, 0x22, 0x2e, 0xfa,
+	0x41, 0x46, 0xfd, 0x9e, 0x92, 0x3f, 0x14, 0x28, 0x0e, 0x52, 0x0c, 0xf2, 0xde, 0x48, 0x90, 0x12,
+	0x8d, 0x52, 0xdf, 0x9f, 0x30, 0x1b, 0x49, 0xae, 0x09, 0x92, 0xb7, 0xc8, 0x8a, 0x8c, 0xe4, 0x40,
+	0x21, 0xd3, 0x0f, 0x84, 0x1c, 0x3e, 0x25, 0x3f, 0x2b, 0x30, 0x97, 0xda, 0xfe, 0x64, 0xf4, 0x16,
+	0xcc, 0xea, 0x94, 0x7a, 0xe3, 0x64, 0x49, 0x88, 0x7e, 0x55, 0xa0, 0xbf, 0x41, 0xaa, 0xd2, 0x05,
+	0x94, 0x52, 0x32, 0xfd, 0x20, 0xbe, 0x8f, 0xdb, 0x0f, 0x9f, 0x1f, 0x95, 0x95, 0xc3, 0xa3, 0xb2,
+	0xf2, 0xcf, 0x51, 0x59, 0xf9, 0xfe, 0xb8, 0x3c, 0x75, 0x78, 0x5c, 0x9e, 0xfa, 0xf3, 0xb8, 0x3c,
+	0xf5, 0xe8, 0x83, 0x96, 0xc5, 0x77, 0x82, 0xed, 0x4a, 0x9d, 0xed, 0xea, 0x2e, 0xf5, 0x7c, 0xcb,
+	0xe7, 0xd4, 0xa9, 0xd3, 0x7b, 0x0e, 0xc5, 0x36, 0x4b, 0x8e, 0xc9, 0xad, 0x0e, 0xd5, 0x3b, 0x55,
+	0xfd, 0x49, 0x4f, 0x4b, 0xbe, 0xef, 0x52, 0x7f, 0x7b, 0x46, 0xfc, 0xd7, 0x5a, 0xfe, 0x7f, 0x00,
+	0x60, 0xb3, 0x53, 0xe3, 0x13, 0x0e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns parameters of the liquidstake module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ParamsDiff returns the parameters of the liquidstake module alongside the
+	// default parameters and the parameters which deviate from them.
+	ParamsDiff(ctx context.Context, in *QueryParamsDiffRequest, opts ...grpc.CallOption) (*QueryParamsDiffResponse, error)
+	// LiquidValidators returns liquid validators with states of the liquidstake
+	// module.
+	LiquidValidators(ctx context.Context, in *QueryLiquidValidatorsRequest, opts ...grpc.CallOption) (*QueryLiquidValidatorsResponse, error)
+	// States returns states of the liquidstake module.
+	States(ctx context.Context, in *QueryStatesRequest, opts ...grpc.CallOption) (*QueryStatesResponse, error)
+	// ProxyDelegations returns the proxy account delegations to the whitelisted
+	// validators with their rewards and deviation from the target weights.
+	ProxyDelegations(ctx context.Context, in *QueryProxyDelegationsRequest, opts ...grpc.CallOption) (*QueryProxyDelegationsResponse, error)
+	// WhitelistRotation returns the scheduled whitelist rotation.
+	WhitelistRotation(ctx context.Context, in *QueryWhitelistRotationRequest, opts ...grpc.CallOption) (*QueryWhitelistRotationResponse, error)
+	// StakeToLPSchedules returns the StakeToLP schedules of a delegator.
+	StakeToLPSchedules(ctx context.Context, in *QueryStakeToLPSchedulesRequest, opts ...grpc.CallOption) (*QueryStakeToLPSchedulesResponse, error)
+	// RedelegationFailures returns the rebalancing redelegations which failed
+	// during an epoch.
+	RedelegationFailures(ctx context.Context, in *QueryRedelegationFailuresRequest, opts ...grpc.CallOption) (*QueryRedelegationFailuresResponse, error)
+	// VestingLock returns the stkXPRT held for a vesting account until its
+	// liquid staked coins vest.
+	VestingLock(ctx context.Context, in *QueryVestingLockRequest, opts ...grpc.CallOption) (*QueryVestingLockResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstake.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ParamsDiff(ctx context.Context, in *QueryParamsDiffRequest, opts ...grpc.CallOption) (*QueryParamsDiffResponse, error) {
+	out := new(QueryParamsDiffResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstake.v1beta1.Query/ParamsDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryLiquidValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) LiquidValidators(ctx context.Context, in *QueryLiquidValidatorsRequest, opts ...grpc.CallOption) (*QueryLiquidValidatorsResponse, error) {
+	out := new(QueryLiquidValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstake.v1beta1.Query/LiquidValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) States(ctx context.Context, in *QueryStatesRequest, opts ...grpc.CallOption) (*QueryStatesResponse, error) {
+	out := new(QueryStatesResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstake.v1beta1.Query/States", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProxyDelegations(ctx context.Context, in *QueryProxyDelegationsRequest, opts ...grpc.CallOption) (*QueryProxyDelegationsResponse, error) {
+	out := new(QueryProxyDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstake.v1beta1.Query/ProxyDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WhitelistRotation(ctx context.Context, in *QueryWhitelistRotationRequest, opts ...grpc.CallOption) (*QueryWhitelistRotationResponse, error) {
+	out := new(QueryWhitelistRotationResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstake.v1beta1.Query/WhitelistRotation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StakeToLPSchedules(ctx context.Context, in *QueryStakeToLPSchedulesRequest, opts ...grpc.CallOption) (*QueryStakeToLPSchedulesResponse, error) {
+	out := new(QueryStakeToLPSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstake.v1beta1.Query/StakeToLPSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RedelegationFailures(ctx context.Context, in *QueryRedelegationFailuresRequest, opts ...grpc.CallOption) (*QueryRedelegationFailuresResponse, error) {
+	out := new(QueryRedelegationFailuresResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstake.v1beta1.Query/RedelegationFailures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingLock(ctx context.Context, in *QueryVestingLockRequest, opts ...grpc.CallOption) (*QueryVestingLockResponse, error) {
+	out := new(QueryVestingLockResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstake.v1beta1.Query/VestingLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns parameters of the liquidstake module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ParamsDiff returns the parameters of the liquidstake module alongside the
+	// default parameters and the parameters which deviate from them.
+	ParamsDiff(context.Context, *QueryParamsDiffRequest) (*QueryParamsDiffResponse, error)
+	// LiquidValidators returns liquid validators with states of the liquidstake
+	// module.
+	LiquidValidators(context.Context, *QueryLiquidValidatorsRequest) (*QueryLiquidValidatorsResponse, error)
+	// States returns states of the liquidstake module.
+	States(context.Context, *QueryStatesRequest) (*QueryStatesResponse, error)
+	// ProxyDelegations returns the proxy account delegations to the whitelisted
+	// validators with their rewards and deviation from the target weights.
+	ProxyDelegations(context.Context, *QueryProxyDelegationsRequest) (*QueryProxyDelegationsResponse, error)
+	// WhitelistRotation returns the scheduled whitelist rotation.
+	WhitelistRotation(context.Context, *QueryWhitelistRotationRequest) (*QueryWhitelistRotationResponse, error)
+	// StakeToLPSchedules returns the StakeToLP schedules of a delegator.
+	StakeToLPSchedules(context.Context, *QueryStakeToLPSchedulesRequest) (*QueryStakeToLPSchedulesResponse, error)
+	// RedelegationFailures returns the rebalancing redelegations which failed
+	// during an epoch.
+	RedelegationFailures(context.Context, *QueryRedelegationFailuresRequest) (*QueryRedelegationFailuresResponse, error)
+	// VestingLock returns the stkXPRT held for a vesting account until its
+	// liquid staked coins vest.
+	VestingLock(context.Context, *QueryVestingLockRequest) (*QueryVestingLockResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ParamsDiff(ctx context.Context, req *QueryParamsDiffRequest) (*QueryParamsDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsDiff not implemented")
+}
+func (*UnimplementedQueryServer) LiquidValidators(ctx context.Context, req *QueryLiquidValidatorsRequest) (*QueryLiquidValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidValidators not implemented")
+}
+func (*UnimplementedQueryServer) States(ctx context.Context, req *QueryStatesRequest) (*QueryStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method States not implemented")
+}
+func (*UnimplementedQueryServer) ProxyDelegations(ctx context.Context, req *QueryProxyDelegationsRequest) (*QueryProxyDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyDelegations not implemented")
+}
+func (*UnimplementedQueryServer) WhitelistRotation(ctx context.Context, req *QueryWhitelistRotationRequest) (*QueryWhitelistRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhitelistRotation not implemented")
+}
+func (*UnimplementedQueryServer) StakeToLPSchedules(ctx context.Context, req *QueryStakeToLPSchedulesRequest) (*QueryStakeToLPSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakeToLPSchedules not implemented")
+}
+func (*UnimplementedQueryServer) RedelegationFailures(ctx context.Context, req *QueryRedelegationFailuresRequest) (*QueryRedelegationFailuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegationFailures not implemented")
+}
+func (*UnimplementedQueryServer) VestingLock(ctx context.Context, req *QueryVestingLockRequest) (*QueryVestingLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingLock not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstake.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParamsDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstake.v1beta1.Query/ParamsDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsDiff(ctx, req.(*QueryParamsDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstake.v1beta1.Query/LiquidValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidValidators(ctx, req.(*QueryLiquidValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_States_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).States(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstake.v1beta1.Query/States",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).States(ctx, req.(*QueryStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProxyDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProxyDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProxyDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstake.v1beta1.Query/ProxyDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProxyDelegations(ctx, req.(*QueryProxyDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WhitelistRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWhitelistRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WhitelistRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstake.v1beta1.Query/WhitelistRotation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WhitelistRotation(ctx, req.(*QueryWhitelistRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StakeToLPSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakeToLPSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StakeToLPSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstake.v1beta1.Query/StakeToLPSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakeToLPSchedules(ctx, req.(*QueryStakeToLPSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedelegationFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedelegationFailuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedelegationFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstake.v1beta1.Query/RedelegationFailures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedelegationFailures(ctx, req.(*QueryRedelegationFailuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstake.v1beta1.Query/VestingLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingLock(ctx, req.(*QueryVestingLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pstake.liquidstake.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ParamsDiff",
+			Handler:    _Query_ParamsDiff_Handler,
+		},
+		{
+			MethodName: "LiquidValidators",
+			Handler:    _Query_LiquidValidators_Handler,
+		},
+		{
+			MethodName: "States",
+			Handler:    _Query_States_Handler,
+		},
+		{
+			MethodName: "ProxyDelegations",
+			Handler:    _Query_ProxyDelegations_Handler,
+		},
+		{
+			MethodName: "WhitelistRotation",
+			Handler:    _Query_WhitelistRotation_Handler,
+		},
+		{
+			MethodName: "StakeToLPSchedules",
+			Handler:    _Query_StakeToLPSchedules_Handler,
+		},
+		{
+			MethodName: "RedelegationFailures",
+			Handler:    _Query_RedelegationFailures_Handler,
+		},
+		{
+			MethodName: "VestingLock",
+			Handler:    _Query_VestingLock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pstake/liquidstake/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsDiffRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsDiffRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsDiffRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsDiffResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsDiffResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Diff) > 0 {
+		for iNdEx := len(m.Diff) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Diff[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.DefaultParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DefaultValue) > 0 {
+		i -= len(m.DefaultValue)
+		copy(dAtA[i:], m.DefaultValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DefaultValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LiquidValidators) > 0 {
+		for iNdEx := len(m.LiquidValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NetAmountState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProxyDelegations) > 0 {
+		for iNdEx := len(m.ProxyDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProxyDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWhitelistRotationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWhitelistRotationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWhitelistRotationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWhitelistRotationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWhitelistRotationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWhitelistRotationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WhitelistRotation != nil {
+		{
+			size, err := m.WhitelistRotation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakeToLPSchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakeToLPSchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakeToLPSchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakeToLPSchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakeToLPSchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakeToLPSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedelegationFailuresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedelegationFailuresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedelegationFailuresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedelegationFailuresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedelegationFailuresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedelegationFailuresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RedelegationFailures) > 0 {
+		for iNdEx := len(m.RedelegationFailures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedelegationFailures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingLockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingLockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingLockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VestingLock.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsDiffRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsDiffResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DefaultParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Diff) > 0 {
+		for _, e := range m.Diff {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParamDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DefaultValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLiquidValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidValidators) > 0 {
+		for _, e := range m.LiquidValidators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetAmountState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProxyDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProxyDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProxyDelegations) > 0 {
+		for _, e := range m.ProxyDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryWhitelistRotationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryWhitelistRotationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WhitelistRotation != nil {
+		l = m.WhitelistRotation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakeToLPSchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakeToLPSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRedelegationFailuresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *QueryRedelegationFailuresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RedelegationFailures) > 0 {
+		for _, e := range m.RedelegationFailures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVestingLockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VestingLock.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsDiffRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsDiffRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsDiffRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsDiffResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsDiffResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Diff = append(m.Diff, ParamDiff{})
+			if err := m.Diff[len(m.Diff)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidValidators = append(m.LiquidValidators, LiquidValidatorState{})
+			if err := m.LiquidValidators[len(m.LiquidValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmountState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetAmountState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyDelegations = append(m.ProxyDelegations, ProxyDelegation{})
+			if err := m.ProxyDelegations[len(m.ProxyDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryLiquidValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.LiquidValidators) > 0 {
-		for _, e := range m.LiquidValidators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryWhitelistRotationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWhitelistRotationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWhitelistRotationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *QueryStatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *QueryStatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryWhitelistRotationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWhitelistRotationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWhitelistRotationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistRotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WhitelistRotation == nil {
+				m.WhitelistRotation = &WhitelistRotation{}
+			}
+			if err := m.WhitelistRotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.NetAmountState.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStakeToLPSchedulesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -759,12 +3280,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakeToLPSchedulesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakeToLPSchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -786,7 +3339,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStakeToLPSchedulesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -809,15 +3362,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakeToLPSchedulesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakeToLPSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -844,7 +3397,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Schedules = append(m.Schedules, StakeToLPSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -869,7 +3423,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRedelegationFailuresRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -892,12 +3446,31 @@ func (m *QueryLiquidValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedelegationFailuresRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedelegationFailuresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -919,7 +3492,7 @@ func (m *QueryLiquidValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRedelegationFailuresResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -942,15 +3515,15 @@ func (m *QueryLiquidValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedelegationFailuresResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedelegationFailuresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationFailures", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -977,8 +3550,8 @@ func (m *QueryLiquidValidatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidValidators = append(m.LiquidValidators, LiquidValidatorState{})
-			if err := m.LiquidValidators[len(m.LiquidValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RedelegationFailures = append(m.RedelegationFailures, RedelegationFailure{})
+			if err := m.RedelegationFailures[len(m.RedelegationFailures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1003,7 +3576,7 @@ func (m *QueryLiquidValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVestingLockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1026,12 +3599,44 @@ func (m *QueryStatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVestingLockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVestingLockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1053,7 +3658,7 @@ func (m *QueryStatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVestingLockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1076,15 +3681,15 @@ func (m *QueryStatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVestingLockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVestingLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAmountState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingLock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1111,7 +3716,7 @@ func (m *QueryStatesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetAmountState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VestingLock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
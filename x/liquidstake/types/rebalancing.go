@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -14,6 +16,35 @@ type Redelegation struct {
 	Error        error
 }
 
+// NewRedelegationFailure returns the record of the failed redelegation.
+func NewRedelegationFailure(epoch, height int64, re Redelegation) RedelegationFailure {
+	return RedelegationFailure{
+		Epoch:               epoch,
+		Height:              height,
+		SrcValidatorAddress: re.SrcValidator.OperatorAddress,
+		DstValidatorAddress: re.DstValidator.OperatorAddress,
+		Amount:              re.Amount,
+		Error:               re.Error.Error(),
+	}
+}
+
+// Validate validates the redelegation failure record.
+func (f RedelegationFailure) Validate() error {
+	if f.Epoch < 0 || f.Height < 0 {
+		return fmt.Errorf("redelegation failure epoch and height must not be negative: %d, %d", f.Epoch, f.Height)
+	}
+	if _, err := sdk.ValAddressFromBech32(f.SrcValidatorAddress); err != nil {
+		return fmt.Errorf("invalid redelegation failure source validator %s: %w", f.SrcValidatorAddress, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(f.DstValidatorAddress); err != nil {
+		return fmt.Errorf("invalid redelegation failure destination validator %s: %w", f.DstValidatorAddress, err)
+	}
+	if f.Amount.IsNil() || f.Amount.IsNegative() {
+		return fmt.Errorf("redelegation failure amount must not be negative: %s", f.Amount)
+	}
+	return nil
+}
+
 // DivideByWeight divide the input value by the ratio of the param weight of the liquid validator and return it with crumb
 // which is may occur while dividing according to the weight of active liquid validators by decimal error.
 func DivideByWeight(avs ActiveLiquidValidators, input math.Int, whitelistedValsMap WhitelistedValsMap) (outputs []math.Int, crumb math.Int) {
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -16,6 +17,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -40,6 +42,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type MsgLiquidStake struct {
 	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 	Amount           types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// min_stk_out is the minimum amount of stkXPRT to be minted, the message
+	// fails otherwise
+	MinStkOut cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=min_stk_out,json=minStkOut,proto3,customtype=cosmossdk.io/math.Int" json:"min_stk_out"`
+	// deadline is the block time after which the message fails, if set
+	Deadline *time.Time `protobuf:"bytes,4,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty"`
 }
 
 func (m *MsgLiquidStake) Reset()         { *m = MsgLiquidStake{} }
@@ -196,6 +203,11 @@ var xxx_messageInfo_MsgStakeToLPResponse proto.InternalMessageInfo
 type MsgLiquidUnstake struct {
 	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 	Amount           types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// min_out is the minimum amount of XPRT to be unbonded or returned, the
+	// message fails otherwise
+	MinOut cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=min_out,json=minOut,proto3,customtype=cosmossdk.io/math.Int" json:"min_out"`
+	// deadline is the block time after which the message fails, if set
+	Deadline *time.Time `protobuf:"bytes,4,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty"`
 }
 
 func (m *MsgLiquidUnstake) Reset()         { *m = MsgLiquidUnstake{} }
@@ -356,6 +368,339 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgScheduleWhitelistRotation schedules the whitelisted validators to be
+// replaced gradually, starting at a future epoch. It replaces any rotation
+// that has not started yet.
+type MsgScheduleWhitelistRotation struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// whitelisted_validators is the whitelist once the rotation is completed
+	WhitelistedValidators []WhitelistedValidator `protobuf:"bytes,2,rep,name=whitelisted_validators,json=whitelistedValidators,proto3" json:"whitelisted_validators"`
+	// start_epoch is the epoch number at whose end the first step is applied
+	StartEpoch int64 `protobuf:"varint,3,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// transition_epochs is the number of epochs the rotation is spread over
+	TransitionEpochs int64 `protobuf:"varint,4,opt,name=transition_epochs,json=transitionEpochs,proto3" json:"transition_epochs,omitempty"`
+}
+
+func (m *MsgScheduleWhitelistRotation) Reset()         { *m = MsgScheduleWhitelistRotation{} }
+func (m *MsgScheduleWhitelistRotation) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleWhitelistRotation) ProtoMessage()    {}
+func (*MsgScheduleWhitelistRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d90501ae6d9f0009, []int{8}
+}
+func (m *MsgScheduleWhitelistRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleWhitelistRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleWhitelistRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleWhitelistRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleWhitelistRotation.Merge(m, src)
+}
+func (m *MsgScheduleWhitelistRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleWhitelistRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleWhitelistRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleWhitelistRotation proto.InternalMessageInfo
+
+// MsgScheduleWhitelistRotationResponse defines the response structure for
+// executing a MsgScheduleWhitelistRotation message.
+type MsgScheduleWhitelistRotationResponse struct {
+}
+
+func (m *MsgScheduleWhitelistRotationResponse) Reset()         { *m = MsgScheduleWhitelistRotationResponse{} }
+func (m *MsgScheduleWhitelistRotationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleWhitelistRotationResponse) ProtoMessage()    {}
+func (*MsgScheduleWhitelistRotationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d90501ae6d9f0009, []int{9}
+}
+func (m *MsgScheduleWhitelistRotationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleWhitelistRotationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleWhitelistRotationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleWhitelistRotationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleWhitelistRotationResponse.Merge(m, src)
+}
+func (m *MsgScheduleWhitelistRotationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleWhitelistRotationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleWhitelistRotationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleWhitelistRotationResponse proto.InternalMessageInfo
+
+// MsgScheduleStakeToLP registers a StakeToLP of the delegator to be executed
+// every interval by the module. The delegator must have granted the module
+// account an authorization for MsgStakeToLP.
+type MsgScheduleStakeToLP struct {
+	DelegatorAddress string        `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string        `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	StakedAmount     types.Coin    `protobuf:"bytes,3,opt,name=staked_amount,json=stakedAmount,proto3" json:"staked_amount"`
+	LiquidAmount     types.Coin    `protobuf:"bytes,4,opt,name=liquid_amount,json=liquidAmount,proto3" json:"liquid_amount"`
+	Interval         time.Duration `protobuf:"bytes,5,opt,name=interval,proto3,stdduration" json:"interval"`
+}
+
+func (m *MsgScheduleStakeToLP) Reset()         { *m = MsgScheduleStakeToLP{} }
+func (m *MsgScheduleStakeToLP) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleStakeToLP) ProtoMessage()    {}
+func (*MsgScheduleStakeToLP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d90501ae6d9f0009, []int{10}
+}
+func (m *MsgScheduleStakeToLP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleStakeToLP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleStakeToLP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleStakeToLP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleStakeToLP.Merge(m, src)
+}
+func (m *MsgScheduleStakeToLP) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleStakeToLP) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleStakeToLP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleStakeToLP proto.InternalMessageInfo
+
+// MsgScheduleStakeToLPResponse defines the MsgScheduleStakeToLP response type.
+type MsgScheduleStakeToLPResponse struct {
+	NextExecutionTime time.Time `protobuf:"bytes,1,opt,name=next_execution_time,json=nextExecutionTime,proto3,stdtime" json:"next_execution_time"`
+}
+
+func (m *MsgScheduleStakeToLPResponse) Reset()         { *m = MsgScheduleStakeToLPResponse{} }
+func (m *MsgScheduleStakeToLPResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleStakeToLPResponse) ProtoMessage()    {}
+func (*MsgScheduleStakeToLPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d90501ae6d9f0009, []int{11}
+}
+func (m *MsgScheduleStakeToLPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleStakeToLPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleStakeToLPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleStakeToLPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleStakeToLPResponse.Merge(m, src)
+}
+func (m *MsgScheduleStakeToLPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleStakeToLPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleStakeToLPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleStakeToLPResponse proto.InternalMessageInfo
+
+func (m *MsgScheduleStakeToLPResponse) GetNextExecutionTime() time.Time {
+	if m != nil {
+		return m.NextExecutionTime
+	}
+	return time.Time{}
+}
+
+// MsgCancelStakeToLPSchedule removes the StakeToLP schedule of the delegator
+// for the validator.
+type MsgCancelStakeToLPSchedule struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *MsgCancelStakeToLPSchedule) Reset()         { *m = MsgCancelStakeToLPSchedule{} }
+func (m *MsgCancelStakeToLPSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelStakeToLPSchedule) ProtoMessage()    {}
+func (*MsgCancelStakeToLPSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d90501ae6d9f0009, []int{12}
+}
+func (m *MsgCancelStakeToLPSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelStakeToLPSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelStakeToLPSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelStakeToLPSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelStakeToLPSchedule.Merge(m, src)
+}
+func (m *MsgCancelStakeToLPSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelStakeToLPSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelStakeToLPSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelStakeToLPSchedule proto.InternalMessageInfo
+
+// MsgCancelStakeToLPScheduleResponse defines the MsgCancelStakeToLPSchedule
+// response type.
+type MsgCancelStakeToLPScheduleResponse struct {
+}
+
+func (m *MsgCancelStakeToLPScheduleResponse) Reset()         { *m = MsgCancelStakeToLPScheduleResponse{} }
+func (m *MsgCancelStakeToLPScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelStakeToLPScheduleResponse) ProtoMessage()    {}
+func (*MsgCancelStakeToLPScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d90501ae6d9f0009, []int{13}
+}
+func (m *MsgCancelStakeToLPScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelStakeToLPScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelStakeToLPScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelStakeToLPScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelStakeToLPScheduleResponse.Merge(m, src)
+}
+func (m *MsgCancelStakeToLPScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelStakeToLPScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelStakeToLPScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelStakeToLPScheduleResponse proto.InternalMessageInfo
+
+// MsgRepairProxyWithdrawAddress resets the distribution withdraw address of the
+// proxy account to the proxy account, so that the staking rewards are
+// autocompounded again.
+type MsgRepairProxyWithdrawAddress struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgRepairProxyWithdrawAddress) Reset()         { *m = MsgRepairProxyWithdrawAddress{} }
+func (m *MsgRepairProxyWithdrawAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgRepairProxyWithdrawAddress) ProtoMessage()    {}
+func (*MsgRepairProxyWithdrawAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d90501ae6d9f0009, []int{14}
+}
+func (m *MsgRepairProxyWithdrawAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRepairProxyWithdrawAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRepairProxyWithdrawAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRepairProxyWithdrawAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRepairProxyWithdrawAddress.Merge(m, src)
+}
+func (m *MsgRepairProxyWithdrawAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRepairProxyWithdrawAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRepairProxyWithdrawAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRepairProxyWithdrawAddress proto.InternalMessageInfo
+
+// MsgRepairProxyWithdrawAddressResponse defines the response structure for
+// executing a MsgRepairProxyWithdrawAddress message.
+type MsgRepairProxyWithdrawAddressResponse struct {
+}
+
+func (m *MsgRepairProxyWithdrawAddressResponse) Reset()         { *m = MsgRepairProxyWithdrawAddressResponse{} }
+func (m *MsgRepairProxyWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRepairProxyWithdrawAddressResponse) ProtoMessage()    {}
+func (*MsgRepairProxyWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d90501ae6d9f0009, []int{15}
+}
+func (m *MsgRepairProxyWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRepairProxyWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRepairProxyWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRepairProxyWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRepairProxyWithdrawAddressResponse.Merge(m, src)
+}
+func (m *MsgRepairProxyWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRepairProxyWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRepairProxyWithdrawAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRepairProxyWithdrawAddressResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgLiquidStake)(nil), "pstake.liquidstake.v1beta1.MsgLiquidStake")
 	proto.RegisterType((*MsgLiquidStakeResponse)(nil), "pstake.liquidstake.v1beta1.MsgLiquidStakeResponse")
@@ -365,6 +710,14 @@ func init() {
 	proto.RegisterType((*MsgLiquidUnstakeResponse)(nil), "pstake.liquidstake.v1beta1.MsgLiquidUnstakeResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "pstake.liquidstake.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "pstake.liquidstake.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgScheduleWhitelistRotation)(nil), "pstake.liquidstake.v1beta1.MsgScheduleWhitelistRotation")
+	proto.RegisterType((*MsgScheduleWhitelistRotationResponse)(nil), "pstake.liquidstake.v1beta1.MsgScheduleWhitelistRotationResponse")
+	proto.RegisterType((*MsgScheduleStakeToLP)(nil), "pstake.liquidstake.v1beta1.MsgScheduleStakeToLP")
+	proto.RegisterType((*MsgScheduleStakeToLPResponse)(nil), "pstake.liquidstake.v1beta1.MsgScheduleStakeToLPResponse")
+	proto.RegisterType((*MsgCancelStakeToLPSchedule)(nil), "pstake.liquidstake.v1beta1.MsgCancelStakeToLPSchedule")
+	proto.RegisterType((*MsgCancelStakeToLPScheduleResponse)(nil), "pstake.liquidstake.v1beta1.MsgCancelStakeToLPScheduleResponse")
+	proto.RegisterType((*MsgRepairProxyWithdrawAddress)(nil), "pstake.liquidstake.v1beta1.MsgRepairProxyWithdrawAddress")
+	proto.RegisterType((*MsgRepairProxyWithdrawAddressResponse)(nil), "pstake.liquidstake.v1beta1.MsgRepairProxyWithdrawAddressResponse")
 }
 
 func init() {
@@ -372,48 +725,74 @@ func init() {
 }
 
 var fileDescriptor_d90501ae6d9f0009 = []byte{
-	// 648 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0x3d, 0x6f, 0xd3, 0x4e,
-	0x18, 0xb7, 0xdb, 0xaa, 0xfa, 0xf7, 0xfa, 0x6e, 0x55, 0x6d, 0xe2, 0xc1, 0xa9, 0xfc, 0x5f, 0xa2,
-	0xd2, 0xda, 0x24, 0x45, 0x20, 0x75, 0x40, 0x24, 0xd0, 0xad, 0x11, 0x55, 0xda, 0x4a, 0x88, 0x25,
-	0xba, 0xc4, 0xc7, 0xf5, 0x44, 0xec, 0x33, 0xbe, 0x4b, 0xd4, 0xae, 0x4c, 0x8c, 0xfd, 0x08, 0x95,
-	0xf8, 0x02, 0x15, 0xe2, 0x23, 0x30, 0x64, 0xac, 0x98, 0x98, 0x00, 0x25, 0x03, 0x7c, 0x0c, 0x64,
-	0xdf, 0xd9, 0xb1, 0x8b, 0x68, 0x5c, 0x89, 0x85, 0x29, 0x3e, 0xff, 0x5e, 0x9e, 0xe7, 0x7e, 0xbe,
-	0xe7, 0x02, 0xfe, 0xf7, 0x19, 0x87, 0xaf, 0x91, 0xdd, 0x25, 0x6f, 0x7a, 0xc4, 0x11, 0xcf, 0xfd,
-	0x4a, 0x1b, 0x71, 0x58, 0xb1, 0xf9, 0x99, 0xe5, 0x07, 0x94, 0x53, 0x4d, 0x17, 0x24, 0x2b, 0x45,
-	0xb2, 0x24, 0x49, 0x5f, 0xc3, 0x14, 0xd3, 0x88, 0x66, 0x87, 0x4f, 0x42, 0xa1, 0x17, 0x3b, 0x94,
-	0xb9, 0x94, 0xb5, 0x04, 0x20, 0x16, 0x12, 0x32, 0xc4, 0xca, 0x6e, 0x43, 0x36, 0x2e, 0xd5, 0xa1,
-	0xc4, 0x93, 0xf8, 0x86, 0xc4, 0x5d, 0x86, 0xed, 0x7e, 0x25, 0xfc, 0x91, 0x40, 0x09, 0x53, 0x8a,
-	0xbb, 0xc8, 0x8e, 0x56, 0xed, 0xde, 0x2b, 0x9b, 0x13, 0x17, 0x31, 0x0e, 0x5d, 0x5f, 0x12, 0xb6,
-	0x6f, 0xd9, 0x4b, 0xba, 0xf5, 0x88, 0x6d, 0x5e, 0xa9, 0x60, 0xa9, 0xc1, 0xf0, 0x41, 0x04, 0x1c,
-	0x85, 0x80, 0xb6, 0x0f, 0x56, 0x1d, 0xd4, 0x45, 0x18, 0x72, 0x1a, 0xb4, 0xa0, 0xe3, 0x04, 0x88,
-	0xb1, 0x82, 0xba, 0xa9, 0x96, 0xe7, 0xea, 0x85, 0xcf, 0x1f, 0x77, 0xd6, 0xe4, 0x3e, 0x6a, 0x02,
-	0x39, 0xe2, 0x01, 0xf1, 0x70, 0x73, 0x25, 0x91, 0xc8, 0xf7, 0xda, 0x23, 0x30, 0x0b, 0x5d, 0xda,
-	0xf3, 0x78, 0x61, 0x6a, 0x53, 0x2d, 0xcf, 0x57, 0x8b, 0x96, 0x14, 0x86, 0x5b, 0x8e, 0x83, 0xb3,
-	0x9e, 0x52, 0xe2, 0xd5, 0x67, 0x06, 0x5f, 0x4b, 0x4a, 0x53, 0xd2, 0xf7, 0x8c, 0x77, 0x97, 0x25,
-	0xe5, 0xe7, 0x65, 0x49, 0x79, 0xfb, 0xe3, 0x6a, 0xeb, 0xf7, 0x56, 0xcc, 0x02, 0x58, 0xcf, 0x76,
-	0xdc, 0x44, 0xcc, 0xa7, 0x1e, 0x43, 0xe6, 0x60, 0x0a, 0x2c, 0x34, 0x18, 0x8e, 0x5e, 0x1e, 0xd3,
-	0x83, 0xc3, 0xbf, 0xb5, 0x95, 0x7d, 0xb0, 0xda, 0x87, 0x5d, 0xe2, 0x64, 0x6c, 0xa6, 0x26, 0xd9,
-	0x24, 0x92, 0xd8, 0xe6, 0x19, 0x58, 0x8c, 0xa2, 0x77, 0x5a, 0x32, 0x98, 0xe9, 0x7c, 0xc1, 0x2c,
-	0x08, 0x55, 0x2d, 0x12, 0x85, 0x2e, 0xe2, 0x33, 0xc6, 0x2e, 0x33, 0x39, 0x5d, 0x84, 0xaa, 0x96,
-	0x2f, 0xe4, 0x75, 0xb0, 0x96, 0x4e, 0x32, 0x89, 0xf8, 0x83, 0x0a, 0x56, 0x92, 0xf4, 0x4f, 0x3c,
-	0xf6, 0x4f, 0x9c, 0x18, 0x02, 0x0a, 0x37, 0x7b, 0x8e, 0x37, 0xa4, 0x35, 0xc0, 0x72, 0x87, 0xba,
-	0x7e, 0x17, 0x71, 0x42, 0xbd, 0x56, 0x38, 0x4c, 0x51, 0xe7, 0xf3, 0x55, 0xdd, 0x12, 0x93, 0x66,
-	0xc5, 0x93, 0x66, 0x1d, 0xc7, 0x93, 0x56, 0xff, 0x2f, 0x2c, 0x7f, 0xf1, 0xad, 0xa4, 0x36, 0x97,
-	0xc6, 0xe2, 0x10, 0x36, 0xdf, 0xab, 0x60, 0xb9, 0xc1, 0xf0, 0x89, 0xef, 0x40, 0x8e, 0x0e, 0x61,
-	0x00, 0x5d, 0xa6, 0x3d, 0x04, 0x73, 0xb0, 0xc7, 0x4f, 0x69, 0x40, 0xf8, 0xf9, 0xc4, 0x58, 0xc6,
-	0x54, 0xed, 0x09, 0x98, 0xf5, 0x23, 0x07, 0x99, 0x87, 0x69, 0xfd, 0xf9, 0x06, 0xb2, 0x44, 0xad,
-	0x38, 0x18, 0xa1, 0xdb, 0x5b, 0x4f, 0x07, 0x33, 0x76, 0x36, 0x8b, 0x60, 0xe3, 0x46, 0x93, 0x71,
-	0x1e, 0xd5, 0x4f, 0xd3, 0x60, 0xba, 0xc1, 0xb0, 0xe6, 0x82, 0xf9, 0xf4, 0xa5, 0xb0, 0x75, 0x5b,
-	0xed, 0xec, 0x38, 0xea, 0xd5, 0xfc, 0xdc, 0xe4, 0x33, 0x30, 0xb0, 0x98, 0x3d, 0x53, 0xdb, 0xb9,
-	0x4c, 0x24, 0x5b, 0x7f, 0x70, 0x17, 0x76, 0x52, 0x14, 0x83, 0xb9, 0xf1, 0x5d, 0x51, 0x9e, 0x60,
-	0x91, 0x30, 0xf5, 0xfb, 0x79, 0x99, 0x49, 0x21, 0x1f, 0x2c, 0x64, 0x4e, 0xc4, 0xbd, 0x09, 0x0e,
-	0x69, 0xb2, 0xbe, 0x7b, 0x07, 0x72, 0x5c, 0xb1, 0xfe, 0x62, 0x30, 0x34, 0xd4, 0xeb, 0xa1, 0xa1,
-	0x7e, 0x1f, 0x1a, 0xea, 0xc5, 0xc8, 0x50, 0xae, 0x47, 0x86, 0xf2, 0x65, 0x64, 0x28, 0x2f, 0x1f,
-	0x63, 0xc2, 0x4f, 0x7b, 0x6d, 0xab, 0x43, 0x5d, 0xdb, 0x47, 0x01, 0x23, 0x8c, 0x23, 0xaf, 0x83,
-	0x9e, 0x7b, 0xc8, 0x16, 0x75, 0x76, 0x3c, 0xc8, 0x49, 0x1f, 0xd9, 0xfd, 0xaa, 0x7d, 0x96, 0xf9,
-	0x17, 0xe1, 0xe7, 0x3e, 0x62, 0xed, 0xd9, 0x68, 0x1e, 0x76, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff,
-	0x69, 0xe1, 0x65, 0x0f, 0x34, 0x07, 0x00, 0x00,
+	// 1071 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0x21, 0x24, 0xcf, 0x49, 0x1b, 0x2f, 0x69, 0xe2, 0xac, 0x8a, 0x5d, 0x99, 0x02,
+	0x51, 0xd3, 0xec, 0x26, 0x2e, 0x2a, 0xa5, 0x42, 0xa5, 0x49, 0x93, 0x43, 0x45, 0xad, 0x46, 0x4e,
+	0x4a, 0x10, 0x17, 0x6b, 0xec, 0x1d, 0xd6, 0xa3, 0xec, 0xee, 0x2c, 0x3b, 0xb3, 0x8e, 0x73, 0x42,
+	0xe2, 0xc4, 0x31, 0x3d, 0x81, 0x38, 0x55, 0xe2, 0xc8, 0x85, 0x43, 0xc5, 0x85, 0x7f, 0x20, 0xe2,
+	0x54, 0xf5, 0x84, 0x38, 0x14, 0x94, 0x1c, 0xe0, 0xcf, 0x40, 0x3b, 0xfb, 0xc3, 0x76, 0x5a, 0x7b,
+	0x1d, 0x0b, 0xa4, 0x1c, 0x7a, 0x8a, 0x77, 0xdf, 0xf7, 0x7d, 0xf3, 0xde, 0xf7, 0xde, 0xbe, 0xdd,
+	0xc0, 0x3b, 0x0e, 0xe3, 0x68, 0x0f, 0x6b, 0x26, 0xf9, 0xca, 0x23, 0x7a, 0xf0, 0xbb, 0xb5, 0x5a,
+	0xc7, 0x1c, 0xad, 0x6a, 0xbc, 0xad, 0x3a, 0x2e, 0xe5, 0x54, 0x56, 0x02, 0x90, 0xda, 0x05, 0x52,
+	0x43, 0x90, 0x32, 0x6b, 0x50, 0x83, 0x0a, 0x98, 0xe6, 0xff, 0x0a, 0x18, 0xca, 0x42, 0x83, 0x32,
+	0x8b, 0xb2, 0x5a, 0x10, 0x08, 0x2e, 0xc2, 0x50, 0x21, 0xb8, 0xd2, 0xea, 0x88, 0x75, 0x8e, 0x6a,
+	0x50, 0x62, 0x87, 0xf1, 0xf9, 0x30, 0x6e, 0x31, 0x43, 0x6b, 0xad, 0xfa, 0x7f, 0x22, 0xa2, 0x41,
+	0xa9, 0x61, 0x62, 0x4d, 0x5c, 0xd5, 0xbd, 0x2f, 0x35, 0xdd, 0x73, 0x11, 0x27, 0x34, 0x22, 0x16,
+	0x4f, 0xc7, 0x39, 0xb1, 0x30, 0xe3, 0xc8, 0x72, 0x42, 0xc0, 0xf5, 0x01, 0xb5, 0x76, 0x97, 0x26,
+	0xd0, 0xa5, 0x5f, 0xd3, 0x70, 0xa1, 0xc2, 0x8c, 0x07, 0x22, 0xb0, 0xed, 0x07, 0xe4, 0x4d, 0xc8,
+	0xe9, 0xd8, 0xc4, 0x06, 0xe2, 0xd4, 0xad, 0x21, 0x5d, 0x77, 0x31, 0x63, 0x79, 0xe9, 0x8a, 0xb4,
+	0x38, 0xb9, 0x9e, 0x7f, 0xfe, 0x74, 0x79, 0x36, 0xac, 0x73, 0x2d, 0x88, 0x6c, 0x73, 0x97, 0xd8,
+	0x46, 0x75, 0x26, 0xa6, 0x84, 0xf7, 0xe5, 0x0f, 0x61, 0x1c, 0x59, 0xd4, 0xb3, 0x79, 0x3e, 0x7d,
+	0x45, 0x5a, 0xcc, 0x96, 0x17, 0xd4, 0x90, 0xe8, 0x5b, 0x12, 0x19, 0xab, 0xde, 0xa3, 0xc4, 0x5e,
+	0x1f, 0x3b, 0x7a, 0x51, 0x4c, 0x55, 0x43, 0xb8, 0xfc, 0x29, 0x64, 0x2d, 0x62, 0xd7, 0x18, 0xdf,
+	0xab, 0x51, 0x8f, 0xe7, 0x33, 0xe2, 0xe4, 0x25, 0x1f, 0xf2, 0xc7, 0x8b, 0xe2, 0xa5, 0x40, 0x84,
+	0xe9, 0x7b, 0x2a, 0xa1, 0x9a, 0x85, 0x78, 0x53, 0xbd, 0x6f, 0xf3, 0xe7, 0x4f, 0x97, 0x21, 0x54,
+	0xbf, 0x6f, 0xf3, 0xea, 0xa4, 0x45, 0xec, 0x6d, 0xbe, 0xf7, 0xd0, 0xe3, 0xf2, 0xc7, 0x30, 0xa1,
+	0x63, 0xa4, 0x9b, 0xc4, 0xc6, 0xf9, 0x31, 0x91, 0x87, 0xa2, 0x06, 0x0e, 0xaa, 0x91, 0x83, 0xea,
+	0x4e, 0xe4, 0xe0, 0xfa, 0xd8, 0xe1, 0x9f, 0x45, 0xa9, 0x1a, 0x33, 0x6e, 0x17, 0xbe, 0x7d, 0x52,
+	0x4c, 0xfd, 0xf3, 0xa4, 0x98, 0xfa, 0xe6, 0xef, 0x9f, 0xaf, 0xbd, 0xec, 0x4a, 0x29, 0x0f, 0x73,
+	0xbd, 0xe6, 0x55, 0x31, 0x73, 0xa8, 0xcd, 0x70, 0xe9, 0x28, 0x0d, 0x53, 0x15, 0x66, 0x88, 0x9b,
+	0x3b, 0xf4, 0xc1, 0xd6, 0x7f, 0xe5, 0xea, 0x26, 0xe4, 0x5a, 0xc8, 0x24, 0x7a, 0x8f, 0x4c, 0x3a,
+	0x49, 0x26, 0xa6, 0x44, 0x32, 0x1b, 0x30, 0x2d, 0xa6, 0x40, 0xaf, 0x85, 0x3d, 0xca, 0x0c, 0xd7,
+	0xa3, 0xa9, 0x80, 0xb5, 0x16, 0x74, 0x6a, 0x03, 0xa6, 0x83, 0x89, 0x8a, 0x54, 0xc6, 0x86, 0x54,
+	0x09, 0x58, 0x81, 0x4a, 0xa2, 0xc9, 0x73, 0x30, 0xdb, 0xed, 0x64, 0x6c, 0xf1, 0x2f, 0x69, 0x98,
+	0x89, 0xdd, 0x7f, 0x64, 0xb3, 0x73, 0x31, 0xbc, 0x1b, 0xf0, 0xa6, 0x3f, 0xbc, 0x23, 0x0e, 0xee,
+	0xb8, 0x45, 0xec, 0xff, 0x7f, 0x6a, 0x09, 0xe4, 0x4f, 0xfb, 0x16, 0x99, 0x2a, 0x57, 0xe0, 0x62,
+	0x83, 0x5a, 0x8e, 0x89, 0xfd, 0x95, 0x53, 0xf3, 0x77, 0x4b, 0x5e, 0x4a, 0x4c, 0x60, 0xc2, 0xaf,
+	0x51, 0x24, 0x71, 0xa1, 0x43, 0xf6, 0xc3, 0xa5, 0x1f, 0x25, 0xb8, 0x58, 0x61, 0xc6, 0x23, 0x47,
+	0x47, 0x1c, 0x6f, 0x21, 0x17, 0x59, 0x4c, 0xbe, 0x09, 0x93, 0xc8, 0xe3, 0x4d, 0xea, 0x12, 0x7e,
+	0x90, 0xd8, 0x9a, 0x0e, 0x54, 0xbe, 0x0b, 0xe3, 0x8e, 0x50, 0x08, 0x7b, 0x52, 0x52, 0xfb, 0x2f,
+	0x6c, 0x35, 0x38, 0x2b, 0x6a, 0x4e, 0xc0, 0xbb, 0x3d, 0xd7, 0x6d, 0x4c, 0x47, 0xb9, 0xb4, 0x00,
+	0xf3, 0xa7, 0x92, 0x8c, 0x87, 0xec, 0xa7, 0x34, 0x5c, 0xf6, 0xa7, 0xaf, 0xd1, 0xc4, 0xba, 0x67,
+	0xe2, 0xdd, 0x26, 0xe1, 0xd8, 0x24, 0x8c, 0x57, 0x29, 0x17, 0x5b, 0x79, 0xe4, 0x6a, 0x2c, 0x98,
+	0xdb, 0x8f, 0xc4, 0xb0, 0x5e, 0x8b, 0x9f, 0x50, 0xbf, 0xba, 0xcc, 0x62, 0xb6, 0xbc, 0x32, 0xa8,
+	0xba, 0xdd, 0x0e, 0xf3, 0xb3, 0x88, 0x18, 0xd6, 0x7a, 0x69, 0xff, 0x15, 0x31, 0x26, 0x17, 0x21,
+	0xcb, 0x38, 0x72, 0x79, 0x0d, 0x3b, 0xb4, 0xd1, 0x14, 0xb3, 0x99, 0xa9, 0x82, 0xb8, 0xb5, 0xe9,
+	0xdf, 0x91, 0x97, 0x20, 0xc7, 0x5d, 0x64, 0x33, 0x22, 0x1a, 0x2f, 0x50, 0x4c, 0xcc, 0x5e, 0xa6,
+	0x3a, 0xd3, 0x09, 0x08, 0x6c, 0x7f, 0x23, 0xdf, 0x83, 0xab, 0x83, 0xcc, 0x8a, 0x5d, 0x7d, 0x9c,
+	0x81, 0xd9, 0x2e, 0xe0, 0xeb, 0x2d, 0x99, 0xb8, 0x25, 0xe5, 0x4f, 0x60, 0x82, 0xd8, 0x1c, 0xbb,
+	0x2d, 0x64, 0xe6, 0xdf, 0x08, 0x05, 0x4e, 0x3f, 0x91, 0x1b, 0xe1, 0xa7, 0x42, 0xf0, 0x40, 0x7e,
+	0x2f, 0xb6, 0x42, 0x44, 0x4a, 0xdc, 0x0a, 0x1c, 0x2e, 0xbf, 0xaa, 0x25, 0xf1, 0x66, 0xd8, 0x81,
+	0xb7, 0x6c, 0xdc, 0xe6, 0x35, 0xdc, 0xc6, 0x0d, 0x6f, 0xb4, 0xed, 0x90, 0xf3, 0x05, 0x36, 0x23,
+	0xbe, 0x58, 0x10, 0xbf, 0x49, 0xa0, 0x54, 0x98, 0x71, 0x0f, 0xd9, 0x0d, 0x6c, 0xc6, 0x87, 0x46,
+	0x59, 0x9c, 0xaf, 0x79, 0x48, 0xb4, 0xf0, 0x2a, 0x94, 0xfa, 0xd7, 0x12, 0x0f, 0x3f, 0x85, 0xb7,
+	0x2b, 0xcc, 0xa8, 0x62, 0x07, 0x11, 0x77, 0xcb, 0xa5, 0xed, 0x83, 0x5d, 0xc2, 0x9b, 0xba, 0x8b,
+	0xf6, 0xa3, 0x6c, 0x47, 0x5c, 0x29, 0x7d, 0x9f, 0xca, 0xf7, 0xe1, 0xdd, 0x81, 0x07, 0x46, 0x99,
+	0x95, 0x0f, 0x27, 0x20, 0x53, 0x61, 0x86, 0x6c, 0x41, 0xb6, 0xfb, 0x83, 0xf0, 0xda, 0xa0, 0x55,
+	0xd4, 0xfb, 0xfd, 0xa3, 0x94, 0x87, 0xc7, 0xc6, 0x93, 0xc5, 0x60, 0xba, 0xf7, 0x25, 0x7e, 0x7d,
+	0x28, 0x91, 0x10, 0xad, 0x7c, 0x70, 0x16, 0x74, 0x7c, 0xa8, 0x01, 0x93, 0x9d, 0xb5, 0xb3, 0x98,
+	0x20, 0x11, 0x23, 0x95, 0x95, 0x61, 0x91, 0xf1, 0x41, 0x0e, 0x4c, 0xf5, 0xbc, 0xfe, 0x96, 0x12,
+	0x14, 0xba, 0xc1, 0xca, 0x8d, 0x33, 0x80, 0xe3, 0x13, 0xbf, 0x93, 0x60, 0xa1, 0xff, 0x0b, 0xeb,
+	0x56, 0x52, 0x05, 0xfd, 0x98, 0xca, 0xdd, 0x51, 0x99, 0x71, 0x66, 0x5f, 0x43, 0xee, 0xe5, 0x9d,
+	0xbf, 0x32, 0xa4, 0x6c, 0xa7, 0x09, 0xb7, 0xce, 0xca, 0x88, 0x13, 0x78, 0x2c, 0xc1, 0x7c, 0xbf,
+	0x5d, 0x73, 0x33, 0x41, 0xb5, 0x0f, 0x4f, 0xb9, 0x33, 0x1a, 0x2f, 0xce, 0xe9, 0x07, 0x09, 0x94,
+	0x01, 0xdb, 0xe0, 0xa3, 0x04, 0xf9, 0xfe, 0x54, 0x65, 0x6d, 0x64, 0x6a, 0x94, 0xdc, 0xfa, 0xe7,
+	0x47, 0xc7, 0x05, 0xe9, 0xd9, 0x71, 0x41, 0xfa, 0xeb, 0xb8, 0x20, 0x1d, 0x9e, 0x14, 0x52, 0xcf,
+	0x4e, 0x0a, 0xa9, 0xdf, 0x4f, 0x0a, 0xa9, 0x2f, 0xee, 0x18, 0x84, 0x37, 0xbd, 0xba, 0xda, 0xa0,
+	0x96, 0xe6, 0x60, 0x97, 0x11, 0xc6, 0xb1, 0xdd, 0xc0, 0x0f, 0x6d, 0xac, 0x05, 0xa7, 0x2e, 0xdb,
+	0x88, 0x93, 0x16, 0xd6, 0x5a, 0x65, 0xad, 0xdd, 0xf3, 0xdf, 0x28, 0x3f, 0x70, 0x30, 0xab, 0x8f,
+	0x8b, 0x57, 0xc5, 0x8d, 0x7f, 0x07, 0x00, 0x31, 0xe7, 0x20, 0x1b, 0x9c, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -439,6 +818,17 @@ type MsgClient interface {
 	StakeToLP(ctx context.Context, in *MsgStakeToLP, opts ...grpc.CallOption) (*MsgStakeToLPResponse, error)
 	// UpdateParams defines a method to update the module params.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ScheduleWhitelistRotation defines a method to schedule a whitelist change
+	// for a future epoch.
+	ScheduleWhitelistRotation(ctx context.Context, in *MsgScheduleWhitelistRotation, opts ...grpc.CallOption) (*MsgScheduleWhitelistRotationResponse, error)
+	// ScheduleStakeToLP defines a method to register a recurring StakeToLP
+	// executed by the module through an authz grant.
+	ScheduleStakeToLP(ctx context.Context, in *MsgScheduleStakeToLP, opts ...grpc.CallOption) (*MsgScheduleStakeToLPResponse, error)
+	// CancelStakeToLPSchedule defines a method to remove a recurring StakeToLP.
+	CancelStakeToLPSchedule(ctx context.Context, in *MsgCancelStakeToLPSchedule, opts ...grpc.CallOption) (*MsgCancelStakeToLPScheduleResponse, error)
+	// RepairProxyWithdrawAddress defines a method to restore the proxy account
+	// as its own distribution withdraw address.
+	RepairProxyWithdrawAddress(ctx context.Context, in *MsgRepairProxyWithdrawAddress, opts ...grpc.CallOption) (*MsgRepairProxyWithdrawAddressResponse, error)
 }
 
 type msgClient struct {
@@ -485,6 +875,42 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ScheduleWhitelistRotation(ctx context.Context, in *MsgScheduleWhitelistRotation, opts ...grpc.CallOption) (*MsgScheduleWhitelistRotationResponse, error) {
+	out := new(MsgScheduleWhitelistRotationResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstake.v1beta1.Msg/ScheduleWhitelistRotation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleStakeToLP(ctx context.Context, in *MsgScheduleStakeToLP, opts ...grpc.CallOption) (*MsgScheduleStakeToLPResponse, error) {
+	out := new(MsgScheduleStakeToLPResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstake.v1beta1.Msg/ScheduleStakeToLP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelStakeToLPSchedule(ctx context.Context, in *MsgCancelStakeToLPSchedule, opts ...grpc.CallOption) (*MsgCancelStakeToLPScheduleResponse, error) {
+	out := new(MsgCancelStakeToLPScheduleResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstake.v1beta1.Msg/CancelStakeToLPSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RepairProxyWithdrawAddress(ctx context.Context, in *MsgRepairProxyWithdrawAddress, opts ...grpc.CallOption) (*MsgRepairProxyWithdrawAddressResponse, error) {
+	out := new(MsgRepairProxyWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstake.v1beta1.Msg/RepairProxyWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// LiquidStake defines a method for performing a delegation of coins
@@ -498,6 +924,17 @@ type MsgServer interface {
 	StakeToLP(context.Context, *MsgStakeToLP) (*MsgStakeToLPResponse, error)
 	// UpdateParams defines a method to update the module params.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ScheduleWhitelistRotation defines a method to schedule a whitelist change
+	// for a future epoch.
+	ScheduleWhitelistRotation(context.Context, *MsgScheduleWhitelistRotation) (*MsgScheduleWhitelistRotationResponse, error)
+	// ScheduleStakeToLP defines a method to register a recurring StakeToLP
+	// executed by the module through an authz grant.
+	ScheduleStakeToLP(context.Context, *MsgScheduleStakeToLP) (*MsgScheduleStakeToLPResponse, error)
+	// CancelStakeToLPSchedule defines a method to remove a recurring StakeToLP.
+	CancelStakeToLPSchedule(context.Context, *MsgCancelStakeToLPSchedule) (*MsgCancelStakeToLPScheduleResponse, error)
+	// RepairProxyWithdrawAddress defines a method to restore the proxy account
+	// as its own distribution withdraw address.
+	RepairProxyWithdrawAddress(context.Context, *MsgRepairProxyWithdrawAddress) (*MsgRepairProxyWithdrawAddressResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -516,6 +953,18 @@ func (*UnimplementedMsgServer) StakeToLP(ctx context.Context, req *MsgStakeToLP)
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleWhitelistRotation(ctx context.Context, req *MsgScheduleWhitelistRotation) (*MsgScheduleWhitelistRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleWhitelistRotation not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleStakeToLP(ctx context.Context, req *MsgScheduleStakeToLP) (*MsgScheduleStakeToLPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleStakeToLP not implemented")
+}
+func (*UnimplementedMsgServer) CancelStakeToLPSchedule(ctx context.Context, req *MsgCancelStakeToLPSchedule) (*MsgCancelStakeToLPScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelStakeToLPSchedule not implemented")
+}
+func (*UnimplementedMsgServer) RepairProxyWithdrawAddress(ctx context.Context, req *MsgRepairProxyWithdrawAddress) (*MsgRepairProxyWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepairProxyWithdrawAddress not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -593,39 +1042,127 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pstake.liquidstake.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "LiquidStake",
-			Handler:    _Msg_LiquidStake_Handler,
-		},
-		{
-			MethodName: "LiquidUnstake",
-			Handler:    _Msg_LiquidUnstake_Handler,
-		},
-		{
-			MethodName: "StakeToLP",
-			Handler:    _Msg_StakeToLP_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pstake/liquidstake/v1beta1/tx.proto",
+func _Msg_ScheduleWhitelistRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleWhitelistRotation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleWhitelistRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstake.v1beta1.Msg/ScheduleWhitelistRotation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleWhitelistRotation(ctx, req.(*MsgScheduleWhitelistRotation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgLiquidStake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ScheduleStakeToLP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleStakeToLP)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleStakeToLP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstake.v1beta1.Msg/ScheduleStakeToLP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleStakeToLP(ctx, req.(*MsgScheduleStakeToLP))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelStakeToLPSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelStakeToLPSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelStakeToLPSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstake.v1beta1.Msg/CancelStakeToLPSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelStakeToLPSchedule(ctx, req.(*MsgCancelStakeToLPSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RepairProxyWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRepairProxyWithdrawAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RepairProxyWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstake.v1beta1.Msg/RepairProxyWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RepairProxyWithdrawAddress(ctx, req.(*MsgRepairProxyWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pstake.liquidstake.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LiquidStake",
+			Handler:    _Msg_LiquidStake_Handler,
+		},
+		{
+			MethodName: "LiquidUnstake",
+			Handler:    _Msg_LiquidUnstake_Handler,
+		},
+		{
+			MethodName: "StakeToLP",
+			Handler:    _Msg_StakeToLP_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "ScheduleWhitelistRotation",
+			Handler:    _Msg_ScheduleWhitelistRotation_Handler,
+		},
+		{
+			MethodName: "ScheduleStakeToLP",
+			Handler:    _Msg_ScheduleStakeToLP_Handler,
+		},
+		{
+			MethodName: "CancelStakeToLPSchedule",
+			Handler:    _Msg_CancelStakeToLPSchedule_Handler,
+		},
+		{
+			MethodName: "RepairProxyWithdrawAddress",
+			Handler:    _Msg_RepairProxyWithdrawAddress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pstake/liquidstake/v1beta1/tx.proto",
+}
+
+func (m *MsgLiquidStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
 func (m *MsgLiquidStake) MarshalTo(dAtA []byte) (int, error) {
@@ -638,6 +1175,26 @@ func (m *MsgLiquidStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Deadline != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.MinStkOut.Size()
+		i -= size
+		if _, err := m.MinStkOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -781,6 +1338,26 @@ func (m *MsgLiquidUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Deadline != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTx(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.MinOut.Size()
+		i -= size
+		if _, err := m.MinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -821,12 +1398,12 @@ func (m *MsgLiquidUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err5 != nil {
-		return 0, err5
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n5
-	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i -= n7
+	i = encodeVarintTx(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -895,92 +1472,390 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgScheduleWhitelistRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgLiquidStake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgScheduleWhitelistRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleWhitelistRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.TransitionEpochs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TransitionEpochs))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if m.StartEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.WhitelistedValidators) > 0 {
+		for iNdEx := len(m.WhitelistedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WhitelistedValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgLiquidStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgScheduleWhitelistRotationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleWhitelistRotationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleWhitelistRotationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgStakeToLP) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgScheduleStakeToLP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleStakeToLP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleStakeToLP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval):])
+	if err9 != nil {
+		return 0, err9
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i -= n9
+	i = encodeVarintTx(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.LiquidAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.StakedAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.LiquidAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.StakedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgStakeToLPResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgScheduleStakeToLPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgLiquidUnstake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgScheduleStakeToLPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleStakeToLPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextExecutionTime):])
+	if err12 != nil {
+		return 0, err12
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i -= n12
+	i = encodeVarintTx(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgLiquidUnstakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *MsgCancelStakeToLPSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelStakeToLPSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelStakeToLPSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelStakeToLPScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelStakeToLPScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelStakeToLPScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepairProxyWithdrawAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepairProxyWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepairProxyWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepairProxyWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepairProxyWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepairProxyWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgLiquidStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinStkOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLiquidStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStakeToLP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.StakedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LiquidAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgStakeToLPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLiquidUnstake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLiquidUnstakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
 	n += 1 + l + sovTx(uint64(l))
 	return n
@@ -996,27 +1871,1041 @@ func (m *MsgUpdateParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleWhitelistRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.WhitelistedValidators) > 0 {
+		for _, e := range m.WhitelistedValidators {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovTx(uint64(m.StartEpoch))
+	}
+	if m.TransitionEpochs != 0 {
+		n += 1 + sovTx(uint64(m.TransitionEpochs))
+	}
+	return n
+}
+
+func (m *MsgScheduleWhitelistRotationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleStakeToLP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.StakedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LiquidAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgScheduleStakeToLPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextExecutionTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelStakeToLPSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelStakeToLPScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRepairProxyWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRepairProxyWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStkOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinStkOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakeToLP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeToLP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeToLP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakeToLPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeToLPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeToLPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidUnstake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleWhitelistRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1039,15 +2928,15 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleWhitelistRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleWhitelistRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1075,11 +2964,11 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedValidators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1106,10 +2995,49 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WhitelistedValidators = append(m.WhitelistedValidators, WhitelistedValidator{})
+			if err := m.WhitelistedValidators[len(m.WhitelistedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransitionEpochs", wireType)
+			}
+			m.TransitionEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransitionEpochs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1131,7 +3059,7 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleWhitelistRotationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1154,10 +3082,10 @@ func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleWhitelistRotationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleWhitelistRotationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1181,7 +3109,7 @@ func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStakeToLP) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleStakeToLP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1204,10 +3132,10 @@ func (m *MsgStakeToLP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakeToLP: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleStakeToLP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakeToLP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleStakeToLP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1340,6 +3268,39 @@ func (m *MsgStakeToLP) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1361,7 +3322,7 @@ func (m *MsgStakeToLP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStakeToLPResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleStakeToLPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1384,12 +3345,45 @@ func (m *MsgStakeToLPResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakeToLPResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleStakeToLPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakeToLPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleStakeToLPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1411,7 +3405,7 @@ func (m *MsgStakeToLPResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelStakeToLPSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1434,10 +3428,10 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidUnstake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelStakeToLPSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelStakeToLPSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1474,9 +3468,9 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1486,24 +3480,23 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1526,7 +3519,7 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelStakeToLPScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1549,45 +3542,12 @@ func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelStakeToLPScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelStakeToLPScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1609,7 +3569,7 @@ func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRepairProxyWithdrawAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1632,10 +3592,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRepairProxyWithdrawAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRepairProxyWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1670,39 +3630,6 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1724,7 +3651,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRepairProxyWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1747,10 +3674,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRepairProxyWithdrawAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRepairProxyWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
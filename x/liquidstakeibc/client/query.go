@@ -39,8 +39,32 @@ func NewQueryCmd() *cobra.Command {
 		QueryDepositAccountBalanceCmd(),
 		QueryExchangeRateCmd(),
 		QueryUnbondingCmd(),
+		QueryUnbondingsByEpochRangeCmd(),
 		QueryRedelegationsCmd(),
 		QueryRedelegationTxCmd(),
+		QueryDepositReceiptsCmd(),
+		QueryDepositReceiptCmd(),
+		QueryDepositShortfallsCmd(),
+		QueryValidatorExitsCmd(),
+		QueryChannelMigrationCmd(),
+		QueryPendingMintsCmd(),
+		QueryDelegatorPendingMintsCmd(),
+		QueryModuleAccountsCmd(),
+		QueryRelayLatencyCmd(),
+		QueryDepositCapacityCmd(),
+		QueryCValueHistoryCmd(),
+		QueryPendingProposalsCmd(),
+		QueryValidatorWeightsCmd(),
+		QueryUnbondingCapacityCmd(),
+		QueryPendingParamChangesCmd(),
+		QueryICATxRetriesCmd(),
+		QueryValidatorDrainsCmd(),
+		QueryFeeReportCmd(),
+		QueryRebateProgramCmd(),
+		QueryWorkflowFailuresCmd(),
+		QueryValidatorScoresCmd(),
+		QueryDepositsByStateCmd(),
+		QueryEpochFundingsCmd(),
 	)
 
 	return cmd
@@ -291,6 +315,52 @@ func QueryUnbondingCmd() *cobra.Command {
 	return cmd
 }
 
+func QueryUnbondingsByEpochRangeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbondings-range [chain-id] [start-epoch] [end-epoch]",
+		Short: "Query the unbonding records of a host chain within an epoch range with their totals per state",
+		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query unbonding records by epoch range: $ %s query liquidstakeibc unbondings-range [chain-id] [start-epoch] [end-epoch]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startEpoch, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			endEpoch, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.UnbondingsByEpochRange(
+				cmd.Context(),
+				&types.QueryUnbondingsByEpochRangeRequest{ChainId: args[0], StartEpoch: startEpoch, EndEpoch: endEpoch},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // QueryUserUnbondingsCmd returns all user unbondings.
 func QueryUserUnbondingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -558,3 +628,844 @@ func QueryRedelegationTxCmd() *cobra.Command {
 
 	return cmd
 }
+
+// QueryDepositReceiptsCmd returns all the deposit receipts of a delegator.
+func QueryDepositReceiptsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-receipts [delegator-address]",
+		Short: "Query all the deposit receipts of a delegator",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query all the deposit receipts of a delegator: $ %s query liquidstakeibc deposit-receipts [delegator-address]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DepositReceipts(
+				context.Background(),
+				&types.QueryDepositReceiptsRequest{
+					Address:    args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryDepositReceiptCmd returns a deposit receipt by id.
+func QueryDepositReceiptCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-receipt [id]",
+		Short: "Query a deposit receipt by id",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query a deposit receipt: $ %s query liquidstakeibc deposit-receipt [id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DepositReceipt(
+				context.Background(),
+				&types.QueryDepositReceiptRequest{Id: id},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryDepositShortfallsCmd returns the deposit shortfalls of a host chain.
+func QueryDepositShortfallsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-shortfalls [chain-id]",
+		Short: "Query deposit shortfall records for a host chain",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query all deposit shortfalls: $ %s query liquidstakeibc deposit-shortfalls [chain-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DepositShortfalls(cmd.Context(), &types.QueryDepositShortfallsRequest{ChainId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryValidatorExitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-exits [chain-id]",
+		Short: "Query the pending validator exits of a host chain",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the queued total validator unbondings: $ %s query liquidstakeibc validator-exits [chain-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ValidatorExits(cmd.Context(), &types.QueryValidatorExitsRequest{ChainId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryChannelMigrationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel-migration [chain-id]",
+		Short: "Query the transfer channel migration of a host chain",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the channel migration status: $ %s query liquidstakeibc channel-migration [chain-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ChannelMigration(cmd.Context(), &types.QueryChannelMigrationRequest{ChainId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryPendingMintsCmd returns the stk tokens of a host chain waiting for their deposit delegation.
+func QueryPendingMintsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-mints [chain-id]",
+		Short: "Query the pending mints of a host chain",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the stk tokens held back until their deposit is delegated: $ %s query liquidstakeibc pending-mints [chain-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingMints(cmd.Context(), &types.QueryPendingMintsRequest{ChainId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryDelegatorPendingMintsCmd returns the stk tokens of a delegator waiting for their deposit delegation.
+func QueryDelegatorPendingMintsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegator-pending-mints [delegator-address]",
+		Short: "Query the pending mints of a delegator",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the pending mints of a delegator: $ %s query liquidstakeibc delegator-pending-mints [delegator-address]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorPendingMints(
+				cmd.Context(),
+				&types.QueryDelegatorPendingMintsRequest{DelegatorAddress: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryModuleAccountsCmd returns the addresses and balances of the liquid staking module accounts.
+func QueryModuleAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-accounts",
+		Short: "Query the addresses and balances of the liquid staking module accounts",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the liquid staking module accounts: $ %s query liquidstakeibc module-accounts`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ModuleAccounts(cmd.Context(), &types.QueryModuleAccountsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryRelayLatencyCmd returns the observed relay latency and packet timeout of an ibc connection.
+func QueryRelayLatencyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relay-latency [connection-id]",
+		Short: "Query the observed relay latency and packet timeout of an ibc connection",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the relay latency of a connection: $ %s query liquidstakeibc relay-latency connection-0`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RelayLatency(cmd.Context(), &types.QueryRelayLatencyRequest{ConnectionId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryDepositCapacityCmd returns the deposit cap of a host chain and the amount that can still be liquid staked.
+func QueryDepositCapacityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-capacity [chain-id]",
+		Short: "Query the deposit cap of a host chain and the amount that can still be liquid staked",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the deposit capacity of a host chain: $ %s query liquidstakeibc deposit-capacity cosmoshub-4`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DepositCapacity(cmd.Context(), &types.QueryDepositCapacityRequest{ChainId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryCValueHistoryCmd returns the c values set by governance for a host chain.
+func QueryCValueHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "c-value-history [chain-id]",
+		Short: "Query the c values set by governance for a host chain",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the c value history of a host chain: $ %s query liquidstakeibc c-value-history cosmoshub-4`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CValueHistory(cmd.Context(), &types.QueryCValueHistoryRequest{ChainId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryPendingProposalsCmd returns the pending governance proposals executing liquidstakeibc messages.
+func QueryPendingProposalsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-proposals [chain-id]",
+		Short: "Query the pending governance proposals executing liquidstakeibc messages",
+		Args:  cobra.MaximumNArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the pending proposals, optionally of a host chain: $ %s query liquidstakeibc pending-proposals cosmoshub-4`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			request := &types.QueryPendingProposalsRequest{}
+			if len(args) > 0 {
+				request.ChainId = args[0]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingProposals(cmd.Context(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryValidatorWeightsCmd returns the stored and normalized validator weights of a host chain.
+func QueryValidatorWeightsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-weights [chain-id]",
+		Short: "Query the validator weights of a host chain and their normalized values",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the validator weights of a host chain: $ %s query liquidstakeibc validator-weights cosmoshub-4`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ValidatorWeights(cmd.Context(), &types.QueryValidatorWeightsRequest{ChainId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryUnbondingCapacityCmd returns how many host tokens could be unbonded in the next unbonding epochs of a host chain.
+func QueryUnbondingCapacityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-capacity [chain-id] [epochs]",
+		Short: "Query how many host tokens could be unbonded in the next unbonding epochs of a host chain",
+		Args:  cobra.RangeArgs(1, 2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the unbonding capacity of the next 4 unbonding epochs: $ %s query liquidstakeibc unbonding-capacity cosmoshub-4 4`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var epochs uint64
+			if len(args) > 1 {
+				epochs, err = strconv.ParseUint(args[1], 10, 32)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.UnbondingCapacity(
+				cmd.Context(),
+				&types.QueryUnbondingCapacityRequest{ChainId: args[0], Epochs: uint32(epochs)},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryPendingParamChangesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-param-changes [chain-id]",
+		Short: "Query the staged param changes of a host chain",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the host chain updates waiting for the param change delay: $ %s query liquidstakeibc pending-param-changes [chain-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingParamChanges(
+				cmd.Context(),
+				&types.QueryPendingParamChangesRequest{ChainId: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryICATxRetriesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ica-tx-retries [chain-id]",
+		Short: "Query the ica txs of a host chain waiting to be submitted again",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the queued ica tx retries: $ %s query liquidstakeibc ica-tx-retries [chain-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ICATxRetries(cmd.Context(), &types.QueryICATxRetriesRequest{ChainId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryValidatorDrainsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-drains [chain-id]",
+		Short: "Query the zero weight validators of a host chain being drained",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the validator drains: $ %s query liquidstakeibc validator-drains [chain-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ValidatorDrains(cmd.Context(), &types.QueryValidatorDrainsRequest{ChainId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryFeeReportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-report [chain-id]",
+		Short: "Query the protocol fees collected on a host chain",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the fee report: $ %s query liquidstakeibc fee-report [chain-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeReport(cmd.Context(), &types.QueryFeeReportRequest{ChainId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryRebateProgramCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebate-program [chain-id]",
+		Short: "Query the commission rebate program of a host chain",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the rebate program: $ %s query liquidstakeibc rebate-program [chain-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RebateProgram(cmd.Context(), &types.QueryRebateProgramRequest{ChainId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryWorkflowFailuresCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "workflow-failures",
+		Short: "Query the host chains whose last run of a workflow failed",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the failed host chain workflows: $ %s query liquidstakeibc workflow-failures`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.WorkflowFailures(cmd.Context(), &types.QueryWorkflowFailuresRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryValidatorScoresCmd returns the performance scores of the validators of a host chain.
+func QueryValidatorScoresCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-scores [chain-id]",
+		Short: "Query the performance scores of the validators of a host chain",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the validator scores: $ %s query liquidstakeibc validator-scores [chain-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ValidatorScores(cmd.Context(), &types.QueryValidatorScoresRequest{ChainId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryDepositsByStateCmd returns the deposits in a state, optionally of a single host chain.
+func QueryDepositsByStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposits-by-state [state] [chain-id]",
+		Short: "Query the deposit records in a state, across host chains or of a single one",
+		Args:  cobra.RangeArgs(1, 2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the deposits in a state: $ %s query liquidstakeibc deposits-by-state DEPOSIT_SENT [chain-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			state, ok := types.Deposit_DepositState_value[strings.ToUpper(args[0])]
+			if !ok {
+				return fmt.Errorf("invalid deposit state %s", args[0])
+			}
+
+			chainID := ""
+			if len(args) > 1 {
+				chainID = args[1]
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DepositsByState(
+				cmd.Context(),
+				&types.QueryDepositsByStateRequest{
+					State:      types.Deposit_DepositState(state),
+					ChainId:    chainID,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryEpochFundingsCmd returns the funding of the unbonding epochs of a host chain.
+func QueryEpochFundingsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-fundings [chain-id]",
+		Short: "Query the funding of the unbonding epochs of a host chain",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the unbonding transfers that funded the unbonding epochs of a host chain and whether their claims are released: $ %s query liquidstakeibc epoch-fundings cosmoshub-4`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EpochFundings(cmd.Context(), &types.QueryEpochFundingsRequest{ChainId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -35,12 +35,42 @@ func NewTxCmd() *cobra.Command {
 		NewLiquidStakeCmdLSM(),
 		NewLiquidUnstakeCmd(),
 		NewRedeemCmd(),
+		NewTransferUnbondingCmd(),
+		NewCancelUnbondingCmd(),
+		NewSetAutoClaimCmd(),
+		NewClaimCmd(),
+		NewRetryTransferCmd(),
 		NewUpdateParamsCmd(),
+		NewCancelValidatorExitCmd(),
+		NewCancelParamChangeCmd(),
+		NewMigrateHostChainChannelCmd(),
+		NewPauseHostChainCmd(),
+		NewResumeHostChainCmd(),
+		NewForceReconcileDelegationsCmd(),
+		NewSetFeeAddressCmd(),
+		NewExitValidatorCmd(),
 	)
 
 	return txCmd
 }
 
+const (
+	// FlagBootstrapValidators is the number of host chain validators a host chain is registered with
+	FlagBootstrapValidators = "bootstrap-validators"
+	// FlagSeedAmount is the amount of host chain ibc tokens a host chain is seeded with on registration
+	FlagSeedAmount = "seed-amount"
+	// FlagMinStkAmountOut is the minimum amount of stk tokens a liquid stake has to mint
+	FlagMinStkAmountOut = "min-stk-amount-out"
+	// FlagMinTokensOut is the minimum amount of host tokens a liquid unstake has to unbond
+	FlagMinTokensOut = "min-tokens-out"
+	// FlagAutoClaim opts the unbondings of a liquid unstake in to the automatic claim
+	FlagAutoClaim = "auto-claim"
+	// FlagRecipient is the address a liquid stake mints its stk tokens to
+	FlagRecipient = "recipient"
+	// FlagFlushToOldAddress sends the accrued fees to the old fee address on a fee address change
+	FlagFlushToOldAddress = "flush-to-old-address"
+)
+
 // NewRegisterHostChainCmd implements the command to register a host chain.
 func NewRegisterHostChainCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -49,7 +79,7 @@ func NewRegisterHostChainCmd() *cobra.Command {
 		Short: "Register a host chain",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(
-				`Submit a register host chain transaction: $ %s tx liquidstakeibc register-host-chain connection-0 channel-0 transfer 0.00 0.05 0.00 0.005 uatom 1 4 20`,
+				`Submit a register host chain transaction: $ %s tx liquidstakeibc register-host-chain connection-0 channel-0 transfer 0.00 0.05 0.00 0.005 uatom 1 4 20 --bootstrap-validators 20`,
 				version.AppName,
 			),
 		),
@@ -89,6 +119,22 @@ func NewRegisterHostChainCmd() *cobra.Command {
 				autocompoundFactor,
 			)
 
+			msg.BootstrapValidators, err = cmd.Flags().GetUint32(FlagBootstrapValidators)
+			if err != nil {
+				return err
+			}
+
+			seedAmount, err := cmd.Flags().GetString(FlagSeedAmount)
+			if err != nil {
+				return err
+			}
+			if seedAmount != "" {
+				msg.SeedAmount, ok = sdk.NewIntFromString(seedAmount)
+				if !ok {
+					return fmt.Errorf("unable to parse seed amount to sdk.Int")
+				}
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -97,6 +143,8 @@ func NewRegisterHostChainCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint32(FlagBootstrapValidators, 0, "bootstrap the validator set with the top bonded host chain validators")
+	cmd.Flags().String(FlagSeedAmount, "", "amount of host chain ibc tokens the authority liquid stakes on registration")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -187,10 +235,29 @@ func NewLiquidStakeCmd() *cobra.Command {
 			delegatorAddress := clientctx.GetFromAddress()
 			msg := types.NewMsgLiquidStake(amount, delegatorAddress)
 
+			minStkAmountOut, err := cmd.Flags().GetString(FlagMinStkAmountOut)
+			if err != nil {
+				return err
+			}
+			if minStkAmountOut != "" {
+				var ok bool
+				msg.MinStkAmountOut, ok = sdk.NewIntFromString(minStkAmountOut)
+				if !ok {
+					return fmt.Errorf("unable to parse min stk amount out %s", minStkAmountOut)
+				}
+			}
+
+			msg.Recipient, err = cmd.Flags().GetString(FlagRecipient)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagMinStkAmountOut, "", "fail if the liquid stake mints fewer stk tokens, after fees")
+	cmd.Flags().String(FlagRecipient, "", "mint the stk tokens to this address instead of the delegator")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -227,10 +294,16 @@ $ %s tx liquidstakeibc liquid-stake-lsm '[
 			delegatorAddress := clientctx.GetFromAddress()
 			msg := types.NewMsgLiquidStakeLSM(coins, delegatorAddress)
 
+			msg.Recipient, err = cmd.Flags().GetString(FlagRecipient)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagRecipient, "", "mint the stk tokens to this address instead of the delegator")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -261,10 +334,29 @@ func NewLiquidUnstakeCmd() *cobra.Command {
 			delegatorAddress := clientctx.GetFromAddress()
 			msg := types.NewMsgLiquidUnstake(amount, delegatorAddress)
 
+			minTokensOut, err := cmd.Flags().GetString(FlagMinTokensOut)
+			if err != nil {
+				return err
+			}
+			if minTokensOut != "" {
+				var ok bool
+				msg.MinTokensOut, ok = sdk.NewIntFromString(minTokensOut)
+				if !ok {
+					return fmt.Errorf("unable to parse min tokens out %s", minTokensOut)
+				}
+			}
+
+			msg.AutoClaim, err = cmd.Flags().GetBool(FlagAutoClaim)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagMinTokensOut, "", "fail if the liquid unstake unbonds fewer host tokens, after fees")
+	cmd.Flags().Bool(FlagAutoClaim, false, "pay the unbonded tokens out as soon as they are claimable")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -304,6 +396,190 @@ func NewRedeemCmd() *cobra.Command {
 	return cmd
 }
 
+// NewTransferUnbondingCmd implements the command to transfer a pending unbonding to another address.
+func NewTransferUnbondingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-unbonding [recipient] [chain-id] [epoch]",
+		Short: `Transfer a pending unbonding and its claim to another address`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Submit a transfer unbonding transaction: $ %s tx liquidstakeibc transfer-unbonding persistence1... cosmoshub-4 120`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			epoch, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			delegatorAddress := clientctx.GetFromAddress()
+			msg := types.NewMsgTransferUnbonding(delegatorAddress, recipient, args[1], epoch)
+
+			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCancelUnbondingCmd implements the command to cancel an unbonding that was not sent to the host chain yet.
+func NewCancelUnbondingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-unbonding [chain-id] [epoch]",
+		Short: `Cancel a pending unbonding and get the unstaked stk tokens back`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Submit a cancel unbonding transaction: $ %s tx liquidstakeibc cancel-unbonding cosmoshub-4 120`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epoch, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			delegatorAddress := clientctx.GetFromAddress()
+			msg := types.NewMsgCancelUnbonding(delegatorAddress, args[0], epoch)
+
+			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetAutoClaimCmd implements the command to opt an unbonding in or out of the automatic claim.
+func NewSetAutoClaimCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-claim [chain-id] [epoch] [true|false]",
+		Short: `Pay an unbonding out as soon as it is claimable, or wait for a claim transaction`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Submit a set auto claim transaction: $ %s tx liquidstakeibc set-auto-claim cosmoshub-4 120 true`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epoch, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			autoClaim, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return err
+			}
+
+			delegatorAddress := clientctx.GetFromAddress()
+			msg := types.NewMsgSetAutoClaim(delegatorAddress, args[0], epoch, autoClaim)
+
+			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewClaimCmd implements the command to claim a claimable or failed unbonding.
+func NewClaimCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim [chain-id] [epoch]",
+		Short: `Claim the host tokens of a matured unbonding, or the stk tokens of a failed one`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Submit a claim transaction: $ %s tx liquidstakeibc claim cosmoshub-4 120`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epoch, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			delegatorAddress := clientctx.GetFromAddress()
+			msg := types.NewMsgClaim(delegatorAddress, args[0], epoch)
+
+			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRetryTransferCmd implements the command to send again the transfer of a pending deposit of a past epoch.
+func NewRetryTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retry-transfer [chain-id] [epoch]",
+		Short: `Send again the transfer of a deposit left pending by a timed out transfer`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Submit a retry transfer transaction: $ %s tx liquidstakeibc retry-transfer cosmoshub-4 120`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epoch, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRetryTransfer(clientctx.GetFromAddress(), args[0], epoch)
+
+			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewUpdateParamsCmd implements the command to update the module params.
 func NewUpdateParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -363,3 +639,257 @@ Params file contents:
 
 	return cmd
 }
+
+// NewCancelValidatorExitCmd implements the command to cancel a queued total validator unbonding.
+func NewCancelValidatorExitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-validator-exit [chain-id] [validator-address]",
+		Short: `Cancel a queued total validator unbonding`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Submit a cancel validator exit transaction: $ %s tx liquidstakeibc cancel-validator-exit cosmoshub-4 cosmosvaloper1...`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelValidatorExit(clientctx.GetFromAddress(), args[0], args[1])
+
+			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCancelParamChangeCmd implements the command to cancel a staged host chain param change.
+func NewCancelParamChangeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-param-change [chain-id] [key]",
+		Short: `Cancel a staged host chain param change`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Submit a cancel param change transaction: $ %s tx liquidstakeibc cancel-param-change cosmoshub-4 deposit_fee`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelParamChange(clientctx.GetFromAddress(), args[0], args[1])
+
+			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// FlagCancelMigration cancels the draining channel migration of a host chain
+const FlagCancelMigration = "cancel"
+
+// NewMigrateHostChainChannelCmd implements the command to move a host chain to a new transfer channel.
+func NewMigrateHostChainChannelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-host-chain-channel [chain-id] [new-channel-id]",
+		Short: `Move a host chain to a new transfer channel`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Submit a migrate host chain channel transaction: $ %s tx liquidstakeibc migrate-host-chain-channel cosmoshub-4 channel-190
+Cancel the migration in progress: $ %s tx liquidstakeibc migrate-host-chain-channel cosmoshub-4 "" --cancel`,
+				version.AppName,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cancel, err := cmd.Flags().GetBool(FlagCancelMigration)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMigrateHostChainChannel(clientctx.GetFromAddress(), args[0], args[1], cancel)
+
+			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagCancelMigration, false, "cancel the channel migration in progress")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewPauseHostChainCmd implements the command to pause a host chain as the emergency admin.
+func NewPauseHostChainCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-host-chain [chain-id] [reason]",
+		Short: `Pause a host chain`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Submit a pause host chain transaction: $ %s tx liquidstakeibc pause-host-chain cosmoshub-4 "halted host chain"`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPauseHostChain(clientctx.GetFromAddress(), args[0], args[1])
+
+			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewResumeHostChainCmd implements the command to resume a paused host chain as the emergency admin.
+func NewResumeHostChainCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-host-chain [chain-id]",
+		Short: `Resume a paused host chain`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Submit a resume host chain transaction: $ %s tx liquidstakeibc resume-host-chain cosmoshub-4`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResumeHostChain(clientctx.GetFromAddress(), args[0])
+
+			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewForceReconcileDelegationsCmd implements the command to reconcile the delegations of a host chain right away.
+func NewForceReconcileDelegationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "force-reconcile-delegations [chain-id]",
+		Short: `Query the host chain delegations to reconcile them without waiting for the next scheduled run`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Submit a force reconcile delegations transaction: $ %s tx liquidstakeibc force-reconcile-delegations cosmoshub-4`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgForceReconcileDelegations(clientctx.GetFromAddress(), args[0])
+
+			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetFeeAddressCmd implements the command to rotate the module fee address as the fee admin.
+func NewSetFeeAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fee-address [fee-address]",
+		Short: `Set the module fee address, sending the accrued fees not sent yet along with the change`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Submit a set fee address transaction: $ %s tx liquidstakeibc set-fee-address persistence1... --flush-to-old-address`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			feeAddress, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			flushToOldAddress, err := cmd.Flags().GetBool(FlagFlushToOldAddress)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetFeeAddress(clientctx.GetFromAddress(), feeAddress, flushToOldAddress)
+
+			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagFlushToOldAddress, false, "send the accrued fees to the old fee address instead of the new one")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewExitValidatorCmd implements the command to force the exit of a host chain validator.
+func NewExitValidatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exit-validator [chain-id] [validator-address]",
+		Short: `Unbond all the delegations of a validator right away and redistribute its weight`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Submit an exit validator transaction: $ %s tx liquidstakeibc exit-validator cosmoshub-4 cosmosvaloper1...`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExitValidator(clientctx.GetFromAddress(), args[0], args[1])
+
+			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,39 @@
+// Package events emits the liquidstakeibc user events in the format selected by the events version module param.
+package events
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// Emit emits the legacy string events, the typed event, or both depending on the events version. The message event
+// is not versioned and is always emitted.
+func Emit(
+	ctx sdk.Context,
+	version types.EventsVersion,
+	sender string,
+	typed proto.Message,
+	legacy ...sdk.Event,
+) error {
+	if version != types.EVENTS_VERSION_TYPED {
+		ctx.EventManager().EmitEvents(legacy)
+	}
+
+	if version != types.EVENTS_VERSION_LEGACY {
+		if err := ctx.EventManager().EmitTypedEvent(typed); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package events_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/events"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func TestEmit(t *testing.T) {
+	typed := &types.EventRedeem{
+		ChainId:      "chain-1",
+		InputAmount:  sdk.NewInt64Coin("stk/uatom", 100),
+		OutputAmount: sdk.NewInt64Coin("uatom", 99),
+		Fee:          sdk.NewInt64Coin("stk/uatom", 1),
+	}
+	legacy := sdk.NewEvent(types.EventTypeRedeem, sdk.NewAttribute(types.AttributeChainID, "chain-1"))
+
+	tests := []struct {
+		version    types.EventsVersion
+		wantLegacy bool
+		wantTyped  bool
+	}{
+		{types.EVENTS_VERSION_LEGACY, true, false},
+		{types.EVENTS_VERSION_TYPED, false, true},
+		{types.EVENTS_VERSION_BOTH, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version.String(), func(t *testing.T) {
+			ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+			require.NoError(t, events.Emit(ctx, tt.version, "sender", typed, legacy))
+
+			emitted := make(map[string]bool)
+			for _, event := range ctx.EventManager().Events() {
+				emitted[event.Type] = true
+			}
+			require.Equal(t, tt.wantLegacy, emitted[types.EventTypeRedeem])
+			require.Equal(t, tt.wantTyped, emitted["pstake.liquidstakeibc.v1beta1.EventRedeem"])
+			require.True(t, emitted[sdk.EventTypeMessage])
+		})
+	}
+}
@@ -12,7 +12,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState *types.GenesisState)
 	k.SetParams(ctx, genState.Params)
 
 	for _, hc := range genState.HostChains {
-		k.SetHostChain(ctx, hc)
+		k.ImportHostChain(ctx, hc)
 	}
 
 	for _, deposit := range genState.Deposits {
@@ -28,6 +28,12 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState *types.GenesisState)
 	for _, valUnbonding := range genState.ValidatorUnbondings {
 		k.SetValidatorUnbonding(ctx, valUnbonding)
 	}
+	for _, pendingMint := range genState.PendingMints {
+		k.SetPendingMint(ctx, pendingMint)
+	}
+	for _, vestingLock := range genState.VestingLocks {
+		k.SetVestingLock(ctx, vestingLock)
+	}
 
 	k.GetDepositModuleAccount(ctx)
 	k.GetUndelegationModuleAccount(ctx)
@@ -42,5 +48,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		Unbondings:          k.FilterUnbondings(ctx, func(u types.Unbonding) bool { return true }),         // GetAll
 		UserUnbondings:      k.FilterUserUnbondings(ctx, func(u types.UserUnbonding) bool { return true }), // GetAll
 		ValidatorUnbondings: k.FilterValidatorUnbondings(ctx, func(u types.ValidatorUnbonding) bool { return true }),
+		PendingMints:        k.FilterPendingMints(ctx, func(p types.PendingMint) bool { return true }), // GetAll
+		VestingLocks:        k.GetAllVestingLocks(ctx),
 	}
 }
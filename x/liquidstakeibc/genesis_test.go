@@ -24,16 +24,28 @@ func TestGenesis(t *testing.T) {
 			ChainId:      "chainA-1",
 			ConnectionId: "connection-1",
 			Params: &types.HostChainLSParams{
-				DepositFee:                  sdk.ZeroDec(),
-				RestakeFee:                  sdk.ZeroDec(),
-				UnstakeFee:                  sdk.ZeroDec(),
-				RedemptionFee:               sdk.ZeroDec(),
-				LsmValidatorCap:             sdk.NewDec(1),
-				LsmBondFactor:               sdk.NewDec(-1),
-				UpperCValueLimit:            upperCValueLimit,
-				LowerCValueLimit:            lowerCValueLimit,
-				RedelegationAcceptableDelta: sdk.ZeroInt(),
-				MaxEntries:                  7,
+				DepositFee:                    sdk.ZeroDec(),
+				RestakeFee:                    sdk.ZeroDec(),
+				UnstakeFee:                    sdk.ZeroDec(),
+				RedemptionFee:                 sdk.ZeroDec(),
+				LsmValidatorCap:               sdk.NewDec(1),
+				LsmBondFactor:                 sdk.NewDec(-1),
+				UpperCValueLimit:              upperCValueLimit,
+				LowerCValueLimit:              lowerCValueLimit,
+				RedelegationAcceptableDelta:   sdk.ZeroInt(),
+				MaxEntries:                    7,
+				MaxValidatorWeight:            sdk.ZeroDec(),
+				LiquidityIncentiveRate:        sdk.ZeroDec(),
+				DepositDeliveryRatio:          sdk.ZeroDec(),
+				MinCValue:                     sdk.ZeroDec(),
+				MaxCValue:                     sdk.ZeroDec(),
+				RebateMaxCommission:           sdk.ZeroDec(),
+				RebateWeightBoost:             sdk.ZeroDec(),
+				ScoreWeightMin:                sdk.ZeroDec(),
+				ScoreWeightMax:                sdk.ZeroDec(),
+				MaxRedelegationRatio:          sdk.ZeroDec(),
+				MaxDelegationShare:            sdk.ZeroDec(),
+				MinRewardWithdrawalDelegation: sdk.ZeroInt(),
 			},
 			HostDenom: "uatom",
 			ChannelId: "channel-1",
@@ -57,6 +69,7 @@ func TestGenesis(t *testing.T) {
 				DelegatedAmount: sdk.NewInt(1221),
 				ExchangeRate:    sdk.OneDec(),
 				UnbondingEpoch:  0,
+				CommissionRate:  sdk.ZeroDec(),
 			}},
 			MinimumDeposit:     sdk.OneInt(),
 			CValue:             sdk.OneDec(),
@@ -96,6 +109,15 @@ func TestGenesis(t *testing.T) {
 			Amount:           sdk.NewInt64Coin("uatom", 1000),
 			IbcSequenceId:    "",
 		}},
+		PendingMints: []*types.PendingMint{{
+			ChainId:          "chainA-1",
+			Epoch:            1,
+			DelegatorAddress: authtypes.NewModuleAddressOrBech32Address("test").String(),
+			DepositAmount:    sdk.NewInt64Coin("ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9", 100),
+			MintAmount:       sdk.NewInt64Coin("stk/uatom", 90),
+			FeeAmount:        sdk.NewInt64Coin("stk/uatom", 10),
+			State:            types.PendingMint_PENDING_MINT_CLAIMABLE,
+		}},
 	}
 
 	_, pStakeApp, ctx := helpers.CreateTestApp(t)
@@ -108,4 +130,6 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, genesisState.Params, got.Params)
 	require.Equal(t, genesisState.HostChains, got.HostChains)
 	require.Equal(t, genesisState.Deposits, got.Deposits)
+	require.Equal(t, genesisState.PendingMints, got.PendingMints)
+	require.Equal(t, sdk.NewInt(100), k.GetPendingMintAmount(ctx, "chainA-1"))
 }
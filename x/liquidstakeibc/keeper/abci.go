@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"time"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -16,9 +17,24 @@ import (
 )
 
 func (k *Keeper) BeginBlock(ctx sdk.Context) {
+	// answer the queries the localhost host chains made in the previous block
+	k.DoAnswerLocalhostQueries(ctx)
+
+	// apply the staged host chain updates whose delay has passed
+	k.ApplyPendingParamChanges(ctx)
+
+	// submit again the ica txs whose backoff has passed
+	k.ProcessICATxRetries(ctx)
+
+	// deactivate the host chains that kept failing
+	k.TripCircuitBreakers(ctx)
+
+	// release the stk tokens held for the deposited coins of vesting accounts which have vested
+	k.ReleaseVestingLocks(ctx)
+
 	// perform BeginBlocker tasks for each chain
 	for _, hc := range k.GetAllHostChains(ctx) {
-		if !hc.Active {
+		if !hc.IsActive() {
 			// don't do anything on inactive chains
 			continue
 		}
@@ -26,17 +42,29 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) {
 		// attempt to recreate closed ICA channels
 		k.DoRecreateICA(ctx, hc)
 
+		// degraded chains only get their local claims processed until all their channels are open again
+		if hc.Degraded && k.UpdateHostChainDegradedState(ctx, hc) {
+			k.DoClaim(ctx, hc)
+			k.DoClaimPendingMints(ctx, hc)
+			continue
+		}
+
 		// attempt to delegate
-		k.DoDelegate(ctx, hc)
+		if !hc.Flags.DepositsPaused {
+			k.DoDelegate(ctx, hc)
+		}
 
 		// attempt to automatically claim matured undelegations
 		k.DoClaim(ctx, hc)
 
+		// attempt to mint the stk tokens of delegated deposits
+		k.DoClaimPendingMints(ctx, hc)
+
 		// attempt to process any matured unbondings
 		k.DoProcessMaturedUndelegations(ctx, hc)
 
 		// attempt to redeem LSM tokens
-		if hc.Flags.Lsm {
+		if hc.Flags.Lsm && !hc.Flags.LsmPaused {
 			k.DoRedeemLSMTokens(ctx, hc)
 		}
 	}
@@ -58,6 +86,14 @@ func (k *Keeper) DoDelegate(ctx sdk.Context, hc *types.HostChain) {
 		totalDepositDelegation = totalDepositDelegation.Add(deposit.Amount.Amount)
 	}
 
+	// the transfer tax can differ from the expected one, never delegate more than the reconciled balance
+	if hc.Params.IsDepositTaxed() && totalDepositDelegation.GT(hc.DelegationAccount.Balance.Amount) {
+		if !hc.DelegationAccount.Balance.IsPositive() {
+			return
+		}
+		totalDepositDelegation = hc.DelegationAccount.Balance.Amount
+	}
+
 	// generate the delegation messages based on the hc total amount
 	messages, err := k.GenerateDelegateMessages(hc, totalDepositDelegation)
 	if err != nil {
@@ -91,6 +127,14 @@ func (k *Keeper) DoDelegate(ctx sdk.Context, hc *types.HostChain) {
 		deposit.State = types.Deposit_DEPOSIT_DELEGATING
 		k.SetDeposit(ctx, deposit)
 
+		k.OperationLogger(ctx, types.WorkflowDeposit, hc.ChainId, deposit.Epoch).Info(
+			"Delegating deposit.",
+			"sequence",
+			sequenceID,
+			"amount",
+			deposit.Amount.String(),
+		)
+
 		// emit the delegation event for every deposit
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
@@ -99,6 +143,7 @@ func (k *Keeper) DoDelegate(ctx sdk.Context, hc *types.HostChain) {
 				sdk.NewAttribute(types.AttributeEpoch, strconv.FormatInt(deposit.Epoch, 10)),
 				sdk.NewAttribute(types.AttributeDelegatedAmount, sdk.NewCoin(hc.HostDenom, deposit.Amount.Amount).String()),
 				sdk.NewAttribute(types.AttributeIBCSequenceID, sequenceID),
+				types.NewCorrelationAttribute(types.WorkflowDeposit, hc.ChainId, deposit.Epoch),
 			),
 		)
 	}
@@ -128,17 +173,25 @@ func (k *Keeper) DoClaim(ctx sdk.Context, hc *types.HostChain) {
 		},
 	)
 
+	// funded epochs are only paid once every earlier epoch got its unbonding transfer
+	holdEpoch, held := k.GetClaimHoldEpoch(ctx, hc.ChainId)
+
+	heldUnbondings := 0
 	for _, unbonding := range claimableUnbondings {
 		epochNumber := unbonding.EpochNumber
-		userUnbondings := k.FilterUserUnbondings(
-			ctx,
-			func(u types.UserUnbonding) bool {
-				return u.ChainId == hc.ChainId && u.EpochNumber == epochNumber
-			},
-		)
+		if unbonding.State == types.Unbonding_UNBONDING_CLAIMABLE && held && epochNumber > holdEpoch {
+			heldUnbondings++
+			continue
+		}
+
+		for _, userUnbonding := range k.GetUserUnbondingsForEpoch(ctx, hc.ChainId, epochNumber) {
+			// the claimable unbondings not opted in to the automatic claim wait for a MsgClaim, failed ones are
+			// always refunded
+			if unbonding.State == types.Unbonding_UNBONDING_CLAIMABLE && !userUnbonding.AutoClaim {
+				continue
+			}
 
-		for _, userUnbonding := range userUnbondings {
-			address, err := sdk.AccAddressFromBech32(userUnbonding.Address)
+			claimAmount, err := k.ClaimUserUnbonding(ctx, hc, unbonding, userUnbonding)
 			if err != nil {
 				k.Logger(ctx).Error(
 					"could not send unbonded tokens from module account to delegator",
@@ -153,83 +206,108 @@ func (k *Keeper) DoClaim(ctx sdk.Context, hc *types.HostChain) {
 						types.EventFailedClaimUnbondings,
 						sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
 						sdk.NewAttribute(types.AttributeEpoch, strconv.FormatInt(epochNumber, 10)),
+						sdk.NewAttribute(types.AttributeClaimAmount, claimAmount.String()),
 						sdk.NewAttribute(types.AttributeClaimAddress, userUnbonding.Address),
 						sdk.NewAttribute(types.AttributeClaimStatus, unbonding.State.String()),
 					),
 				)
-
-				continue
-			}
-
-			var claimableCoins sdk.Coins
-			var eventAmount sdk.Coin // used for claim events
-			switch unbonding.State {
-			case types.Unbonding_UNBONDING_CLAIMABLE:
-				claimableCoins = sdk.NewCoins(sdk.NewCoin(hc.IBCDenom(), userUnbonding.UnbondAmount.Amount))
-				eventAmount = sdk.NewCoin(hc.HostDenom, userUnbonding.UnbondAmount.Amount)
-				unbonding.UnbondAmount = unbonding.UnbondAmount.Sub(userUnbonding.UnbondAmount)
-			case types.Unbonding_UNBONDING_FAILED:
-				claimableCoins = sdk.NewCoins(sdk.NewCoin(hc.MintDenom(), userUnbonding.StkAmount.Amount))
-				eventAmount = sdk.NewCoin(hc.MintDenom(), userUnbonding.StkAmount.Amount)
-				unbonding.BurnAmount = unbonding.BurnAmount.Sub(userUnbonding.StkAmount)
 			}
+		}
+	}
 
-			// send coin to the delegator address from the undelegation module account
-			if err = k.bankKeeper.SendCoinsFromModuleToAccount(
-				ctx,
-				types.UndelegationModuleAccount,
-				address,
-				claimableCoins,
-			); err != nil {
-				k.Logger(ctx).Error(
-					"could not send unbonded tokens from module account to delegator",
-					"host_chain",
-					hc.ChainId,
-					"epoch",
-					userUnbonding.EpochNumber,
-				)
+	if heldUnbondings > 0 {
+		k.Logger(ctx).Info(
+			"Claims held behind an unfunded unbonding.",
+			"host_chain",
+			hc.ChainId,
+			"epoch",
+			holdEpoch,
+			"held_unbondings",
+			heldUnbondings,
+		)
 
-				ctx.EventManager().EmitEvent(
-					sdk.NewEvent(
-						types.EventFailedClaimUnbondings,
-						sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
-						sdk.NewAttribute(types.AttributeEpoch, strconv.FormatInt(epochNumber, 10)),
-						sdk.NewAttribute(types.AttributeClaimAmount, eventAmount.String()),
-						sdk.NewAttribute(types.AttributeClaimAddress, userUnbonding.Address),
-						sdk.NewAttribute(types.AttributeClaimStatus, unbonding.State.String()),
-					),
-				)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeClaimsHeld,
+				sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+				sdk.NewAttribute(types.AttributeClaimHoldEpoch, strconv.FormatInt(holdEpoch, 10)),
+				sdk.NewAttribute(types.AttributeHeldUnbondings, strconv.Itoa(heldUnbondings)),
+			),
+		)
+	}
+}
 
-				continue
-			}
+// ClaimUserUnbonding pays a user unbonding out to its address, the host tokens of a claimable unbonding or the stk
+// tokens of a failed one, and returns the amount paid. The unbonding is deleted once all its user unbondings are paid.
+func (k *Keeper) ClaimUserUnbonding(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	unbonding *types.Unbonding,
+	userUnbonding *types.UserUnbonding,
+) (sdk.Coin, error) {
+	var claimableCoin, claimAmount sdk.Coin
+	switch unbonding.State {
+	case types.Unbonding_UNBONDING_CLAIMABLE:
+		claimableCoin = sdk.NewCoin(hc.IBCDenom(), userUnbonding.UnbondAmount.Amount)
+		claimAmount = sdk.NewCoin(hc.HostDenom, userUnbonding.UnbondAmount.Amount)
+	case types.Unbonding_UNBONDING_FAILED:
+		claimableCoin = sdk.NewCoin(hc.MintDenom(), userUnbonding.StkAmount.Amount)
+		claimAmount = claimableCoin
+	default:
+		return sdk.Coin{}, errorsmod.Wrapf(
+			types.ErrUnbondingNotClaimable,
+			"unbonding for chain %s and epoch %d is %s",
+			hc.ChainId,
+			unbonding.EpochNumber,
+			unbonding.State,
+		)
+	}
 
-			// update the unbonding remaining amount and delete it if it reaches zero
-			if unbonding.UnbondAmount.IsZero() || unbonding.BurnAmount.IsZero() {
-				k.DeleteUnbonding(ctx, unbonding)
-			} else {
-				k.SetUnbonding(ctx, unbonding)
-			}
+	address, err := sdk.AccAddressFromBech32(userUnbonding.Address)
+	if err != nil {
+		return claimAmount, err
+	}
 
-			k.DeleteUserUnbonding(ctx, userUnbonding)
+	// send coin to the delegator address from the undelegation module account
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		types.UndelegationModuleAccount,
+		address,
+		sdk.NewCoins(claimableCoin),
+	); err != nil {
+		return claimAmount, err
+	}
 
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(
-					types.EventTypeClaimedUnbondings,
-					sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
-					sdk.NewAttribute(types.AttributeEpoch, strconv.FormatInt(epochNumber, 10)),
-					sdk.NewAttribute(types.AttributeClaimAmount, eventAmount.String()),
-					sdk.NewAttribute(types.AttributeClaimAddress, userUnbonding.Address),
-				),
-			)
-		}
+	// update the unbonding remaining amount and delete it if it reaches zero
+	if unbonding.State == types.Unbonding_UNBONDING_CLAIMABLE {
+		unbonding.UnbondAmount = unbonding.UnbondAmount.Sub(userUnbonding.UnbondAmount)
+	} else {
+		unbonding.BurnAmount = unbonding.BurnAmount.Sub(userUnbonding.StkAmount)
+	}
+	if unbonding.UnbondAmount.IsZero() || unbonding.BurnAmount.IsZero() {
+		k.DeleteUnbonding(ctx, unbonding)
+		k.DeleteEpochFunding(ctx, hc.ChainId, unbonding.EpochNumber)
+	} else {
+		k.SetUnbonding(ctx, unbonding)
 	}
+
+	k.DeleteUserUnbonding(ctx, userUnbonding)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaimedUnbondings,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeEpoch, strconv.FormatInt(unbonding.EpochNumber, 10)),
+			sdk.NewAttribute(types.AttributeClaimAmount, claimAmount.String()),
+			sdk.NewAttribute(types.AttributeClaimAddress, userUnbonding.Address),
+		),
+	)
+
+	return claimAmount, nil
 }
 
 func (k *Keeper) DoRecreateICA(ctx sdk.Context, hc *types.HostChain) {
-	// return early if any of the accounts is currently being recreated
-	if (hc.DelegationAccount == nil || hc.RewardsAccount == nil) ||
-		(hc.DelegationAccount.ChannelState == types.ICAAccount_ICA_CHANNEL_CREATING ||
-			hc.RewardsAccount.ChannelState == types.ICAAccount_ICA_CHANNEL_CREATING) {
+	if hc.DelegationAccount == nil || hc.RewardsAccount == nil {
 		return
 	}
 
@@ -242,7 +320,9 @@ func (k *Keeper) DoRecreateICA(ctx sdk.Context, hc *types.HostChain) {
 			k.Logger(ctx).Info("Recreating delegate ICA.", "chain", hc.ChainId)
 
 			hc.DelegationAccount.ChannelState = types.ICAAccount_ICA_CHANNEL_CREATING
-			k.SetHostChain(ctx, hc)
+			if err := k.SetHostChain(ctx, hc); err != nil {
+				k.Logger(ctx).Error("Could not store the delegate ICA state.", "chain", hc.ChainId, "err", err.Error())
+			}
 		}
 	}
 
@@ -255,7 +335,9 @@ func (k *Keeper) DoRecreateICA(ctx sdk.Context, hc *types.HostChain) {
 			k.Logger(ctx).Info("Recreating rewards ICA.", "chain", hc.ChainId)
 
 			hc.RewardsAccount.ChannelState = types.ICAAccount_ICA_CHANNEL_CREATING
-			k.SetHostChain(ctx, hc)
+			if err := k.SetHostChain(ctx, hc); err != nil {
+				k.Logger(ctx).Error("Could not store the rewards ICA state.", "chain", hc.ChainId, "err", err.Error())
+			}
 		}
 	}
 }
@@ -271,11 +353,17 @@ func (k *Keeper) DoProcessMaturedUndelegations(ctx sdk.Context, hc *types.HostCh
 		},
 	)
 
-	for _, unbonding := range unbondings {
+	// bring all the matured unbondings back in a single transfer, which is split across epochs once received
+	if len(unbondings) > 0 {
+		totalUnbondAmount := sdk.NewCoin(hc.HostDenom, sdk.ZeroInt())
+		for _, unbonding := range unbondings {
+			totalUnbondAmount = totalUnbondAmount.AddAmount(unbonding.UnbondAmount.Amount)
+		}
+
 		sequenceID, err := k.SendICATransfer(
 			ctx,
 			hc,
-			unbonding.UnbondAmount,
+			totalUnbondAmount,
 			hc.DelegationAccount.Address,
 			authtypes.NewModuleAddress(types.UndelegationModuleAccount).String(),
 			hc.DelegationAccount.Owner,
@@ -288,13 +376,14 @@ func (k *Keeper) DoProcessMaturedUndelegations(ctx sdk.Context, hc *types.HostCh
 				"error",
 				err.Error(),
 			)
-			continue
+		} else {
+			// update the unbonding sequence ids and states
+			for _, unbonding := range unbondings {
+				unbonding.IbcSequenceId = sequenceID
+				unbonding.State = types.Unbonding_UNBONDING_MATURED
+				k.SetUnbonding(ctx, unbonding)
+			}
 		}
-
-		// update the unbonding sequence id and state
-		unbonding.IbcSequenceId = sequenceID
-		unbonding.State = types.Unbonding_UNBONDING_MATURED
-		k.SetUnbonding(ctx, unbonding)
 	}
 
 	// get all the validator unbondings that are matured
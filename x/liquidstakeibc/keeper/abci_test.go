@@ -7,6 +7,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
 )
@@ -25,6 +26,7 @@ func (suite *IntegrationTestSuite) TestKeeper_BeginBlockCode() {
 		Address:      delAddr,
 		StkAmount:    sdk.NewCoin(hc.MintDenom(), sdk.NewInt(1000000)),
 		UnbondAmount: sdk.NewCoin(hc.HostDenom, sdk.NewInt(1000000)),
+		AutoClaim:    true,
 	})
 	k.SetUserUnbonding(ctx, &types.UserUnbonding{
 		ChainId:      hc.ChainId,
@@ -32,6 +34,7 @@ func (suite *IntegrationTestSuite) TestKeeper_BeginBlockCode() {
 		Address:      delAddr,
 		StkAmount:    sdk.NewCoin(hc.MintDenom(), sdk.NewInt(1000000)),
 		UnbondAmount: sdk.NewCoin(hc.HostDenom, sdk.NewInt(1000000)),
+		AutoClaim:    true,
 	})
 	k.SetUserUnbonding(ctx, &types.UserUnbonding{
 		ChainId:      hc.ChainId,
@@ -39,6 +42,7 @@ func (suite *IntegrationTestSuite) TestKeeper_BeginBlockCode() {
 		Address:      delAddr,
 		StkAmount:    sdk.NewCoin(hc.MintDenom(), sdk.NewInt(1000000)),
 		UnbondAmount: sdk.NewCoin(hc.HostDenom, sdk.NewInt(1000000)),
+		AutoClaim:    true,
 	})
 	k.SetUserUnbonding(ctx, &types.UserUnbonding{
 		ChainId:      hc.ChainId,
@@ -46,6 +50,7 @@ func (suite *IntegrationTestSuite) TestKeeper_BeginBlockCode() {
 		Address:      delAddr,
 		StkAmount:    sdk.NewCoin(hc.MintDenom(), sdk.NewInt(1000000)),
 		UnbondAmount: sdk.NewCoin(hc.HostDenom, sdk.NewInt(1000000)),
+		AutoClaim:    true,
 	})
 	k.SetUnbonding(ctx, &types.Unbonding{
 		ChainId:       hc.ChainId,
@@ -156,3 +161,38 @@ func (suite *IntegrationTestSuite) TestKeeper_BeginBlockCode() {
 	suite.Require().True(found)
 	suite.Require().NotEqual("", valubd.IbcSequenceId)
 }
+
+func (suite *IntegrationTestSuite) TestRecreateClosedICA() {
+	ctx, _ := suite.ctx.CacheContext()
+	k := suite.app.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	portID := k.GetPortID(hc.DelegationAccount.Owner)
+	channelID := suite.delegationPathAB.EndpointA.ChannelID
+
+	// the account is flagged while the channel is being closed
+	suite.Require().NoError(k.OnChanCloseConfirm(ctx, portID, channelID))
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(types.ICAAccount_ICA_CHANNEL_CLOSED, hc.DelegationAccount.ChannelState)
+	suite.Require().Equal(types.ICAAccount_ICA_CHANNEL_CREATED, hc.RewardsAccount.ChannelState)
+
+	channel, found := suite.app.IBCKeeper.ChannelKeeper.GetChannel(ctx, portID, channelID)
+	suite.Require().Equal(true, found)
+	channel.State = channeltypes.CLOSED
+	suite.app.IBCKeeper.ChannelKeeper.SetChannel(ctx, portID, channelID, channel)
+
+	// in the next block the account is registered again with the same owner
+	channelSequence := suite.app.IBCKeeper.ChannelKeeper.GetNextChannelSequence(ctx)
+	k.DoRecreateICA(ctx, hc)
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(types.ICAAccount_ICA_CHANNEL_CREATING, hc.DelegationAccount.ChannelState)
+
+	channel, found = suite.app.IBCKeeper.ChannelKeeper.GetChannel(
+		ctx,
+		portID,
+		channeltypes.FormatChannelIdentifier(channelSequence),
+	)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(channeltypes.INIT, channel.State)
+}
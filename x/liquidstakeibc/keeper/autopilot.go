@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// AutopilotLiquidStake liquid stakes the tokens of an incoming host chain transfer whose memo asks for it, as if the
+// transfer receiver had sent a MsgLiquidStake minting the stk tokens to the memo receiver. When the memo asks for a
+// forward, the minted stk tokens are then transferred on from the memo receiver. The tokens stay with the transfer
+// receiver when the liquid stake or the forward fails.
+func (k *Keeper) AutopilotLiquidStake(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data ibctransfertypes.FungibleTokenPacketData,
+	hc *types.HostChain,
+) error {
+	autopilot, err := types.ParseAutopilotMemo(data.Memo)
+	if err != nil || autopilot == nil {
+		return err
+	}
+
+	// only the transfer channel of the host chain credits its ibc denom
+	if packet.DestinationPort != hc.PortId || packet.DestinationChannel != hc.ChannelId {
+		return errorsmod.Wrapf(
+			types.ErrInvalidAutopilotTransfer,
+			"transfer received on %s/%s, expected the host chain channel %s/%s",
+			packet.DestinationPort,
+			packet.DestinationChannel,
+			hc.PortId,
+			hc.ChannelId,
+		)
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrParsingAmount, "could not parse transfer amount %s", data.Amount)
+	}
+
+	delegator, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidAutopilotTransfer, "invalid transfer receiver %s: %s", data.Receiver, err)
+	}
+
+	msg := types.NewMsgLiquidStake(sdk.NewCoin(hc.IBCDenom(), amount), delegator)
+	msg.Recipient = autopilot.Receiver
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// delayed mints are only minted after the delegation, there is nothing to forward in the same transfer
+	if autopilot.Forward != nil && hc.Flags.DelayedMint {
+		return errorsmod.Wrapf(
+			types.ErrInvalidAutopilotTransfer,
+			"host chain %s mints its stk tokens once delegated, they can't be forwarded",
+			hc.ChainId,
+		)
+	}
+
+	// the liquid stake and the forward are written together, nothing is kept when either of them fails
+	cacheCtx, write := ctx.CacheContext()
+
+	recipient := sdk.MustAccAddressFromBech32(autopilot.Receiver)
+	balance := k.bankKeeper.GetBalance(cacheCtx, recipient, hc.MintDenom())
+
+	if _, err = (msgServer{Keeper: *k}).LiquidStake(cacheCtx, msg); err != nil {
+		return err
+	}
+
+	cacheCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutopilotLiquidStake,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeDelegatorAddress, delegator.String()),
+			sdk.NewAttribute(types.AttributeRecipientAddress, autopilot.Receiver),
+			sdk.NewAttribute(types.AttributeInputAmount, msg.Amount.String()),
+		),
+	)
+
+	if autopilot.Forward != nil {
+		minted := k.bankKeeper.GetBalance(cacheCtx, recipient, hc.MintDenom()).Sub(balance)
+		if err = k.autopilotForward(cacheCtx, hc, recipient, minted, autopilot.Forward); err != nil {
+			return err
+		}
+	}
+
+	write()
+	return nil
+}
+
+// autopilotForward transfers the stk tokens minted by an autopilot liquid stake to the forward receiver
+func (k *Keeper) autopilotForward(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	sender sdk.AccAddress,
+	amount sdk.Coin,
+	forward *types.AutopilotForward,
+) error {
+	channel, found := k.ibcKeeper.ChannelKeeper.GetChannel(ctx, ibctransfertypes.PortID, forward.Channel)
+	if !found || len(channel.ConnectionHops) == 0 {
+		return errorsmod.Wrapf(
+			types.ErrInvalidAutopilotTransfer,
+			"forward channel %s/%s not found",
+			ibctransfertypes.PortID,
+			forward.Channel,
+		)
+	}
+
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano() + k.GetIBCTimeout(ctx, channel.ConnectionHops[0]).Nanoseconds())
+	msg := ibctransfertypes.NewMsgTransfer(
+		ibctransfertypes.PortID,
+		forward.Channel,
+		amount,
+		sender.String(),
+		forward.Receiver,
+		clienttypes.ZeroHeight(),
+		timeoutTimestamp,
+		"",
+	)
+
+	handler := k.msgRouter.Handler(msg)
+	res, err := handler(ctx, msg)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidAutopilotTransfer, "could not forward %s: %s", amount, err)
+	}
+	ctx.EventManager().EmitEvents(res.GetEvents())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutopilotForward,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeRecipientAddress, sender.String()),
+			sdk.NewAttribute(types.AttributeForwardChannel, forward.Channel),
+			sdk.NewAttribute(types.AttributeForwardReceiver, forward.Receiver),
+			sdk.NewAttribute(types.AttributeOutputAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestAutopilotLiquidStake() {
+	pstakeApp := suite.app
+	k := pstakeApp.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	k.SetDeposit(ctx, &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  sdk.NewCoin(hc.IBCDenom(), sdk.ZeroInt()),
+		Epoch:   k.GetEpochNumber(ctx, types.DelegationEpoch),
+		State:   types.Deposit_DEPOSIT_PENDING,
+	})
+
+	receiver := authtypes.NewModuleAddress("autopilot_receiver")
+	recipient := authtypes.NewModuleAddress("autopilot_recipient")
+	amount := sdk.NewInt(1000)
+
+	// the transfer module credits the receiver before the hook runs
+	receivePacket := func(destChannel, memo string) channeltypes.Packet {
+		suite.Require().NoError(testutil.FundAccount(
+			pstakeApp.BankKeeper,
+			ctx,
+			receiver,
+			sdk.NewCoins(sdk.NewCoin(hc.IBCDenom(), amount)),
+		))
+		data := ibctransfertypes.NewFungibleTokenPacketData(
+			hc.HostDenom,
+			amount.String(),
+			"cosmos1sender",
+			receiver.String(),
+			memo,
+		)
+		return channeltypes.NewPacket(
+			data.GetBytes(), 1, ibctransfertypes.PortID, "channel-100", hc.PortId, destChannel, suite.chainA.GetTimeoutHeight(), 0,
+		)
+	}
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+
+	// memos which are not for the module leave the tokens with the receiver
+	for _, memo := range []string{"", "not a json", `{"wasm":{}}`} {
+		err := k.OnRecvIBCTransferPacket(ctx, receivePacket(hc.ChannelId, memo), nil, ack)
+		suite.Require().NoError(err)
+	}
+	suite.Require().Equal(amount.MulRaw(3), pstakeApp.BankKeeper.GetBalance(ctx, receiver, hc.IBCDenom()).Amount)
+	suite.Require().True(pstakeApp.BankKeeper.GetBalance(ctx, recipient, hc.MintDenom()).IsZero())
+
+	memo := `{"liquidstake":{"receiver":"` + recipient.String() + `"}}`
+
+	// transfers from other channels are not liquid staked
+	err := k.OnRecvIBCTransferPacket(ctx, receivePacket("channel-100", memo), nil, ack)
+	suite.Require().ErrorIs(err, types.ErrInvalidAutopilotTransfer)
+
+	err = k.OnRecvIBCTransferPacket(ctx, receivePacket(hc.ChannelId, `{"liquidstake":{"receiver":"invalid"}}`), nil, ack)
+	suite.Require().Error(err)
+
+	balance := pstakeApp.BankKeeper.GetBalance(ctx, receiver, hc.IBCDenom())
+	err = k.OnRecvIBCTransferPacket(ctx, receivePacket(hc.ChannelId, memo), nil, ack)
+	suite.Require().NoError(err)
+
+	// the transferred tokens are deposited and the stk tokens minted to the memo receiver
+	mintAmount := sdk.NewDecFromInt(amount).Mul(hc.CValue).TruncateInt()
+	fee := hc.Params.DepositFee.MulInt(mintAmount).TruncateInt()
+	suite.Require().Equal(balance, pstakeApp.BankKeeper.GetBalance(ctx, receiver, hc.IBCDenom()))
+	suite.Require().Equal(mintAmount.Sub(fee), pstakeApp.BankKeeper.GetBalance(ctx, recipient, hc.MintDenom()).Amount)
+	deposit, found := k.GetDepositForChainAndEpoch(ctx, hc.ChainId, k.GetEpochNumber(ctx, types.DelegationEpoch))
+	suite.Require().True(found)
+	suite.Require().Equal(amount, deposit.Amount.Amount)
+
+	// the autopilot deposit is recorded in a receipt for the transfer receiver
+	receipts := k.GetDepositReceiptsForDelegator(ctx, receiver.String())
+	suite.Require().Len(receipts, 1)
+	suite.Require().Equal(sdk.NewCoin(hc.HostDenom, amount), receipts[0].Amount)
+	suite.Require().Equal(sdk.NewCoin(hc.MintDenom(), mintAmount.Sub(fee)), receipts[0].MintedAmount)
+}
+
+func (suite *IntegrationTestSuite) TestAutopilotLiquidStakeForward() {
+	pstakeApp := suite.app
+	k := pstakeApp.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	k.SetDeposit(ctx, &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  sdk.NewCoin(hc.IBCDenom(), sdk.ZeroInt()),
+		Epoch:   k.GetEpochNumber(ctx, types.DelegationEpoch),
+		State:   types.Deposit_DEPOSIT_PENDING,
+	})
+
+	receiver := authtypes.NewModuleAddress("autopilot_receiver")
+	recipient := authtypes.NewModuleAddress("autopilot_recipient")
+	amount := sdk.NewInt(1000)
+
+	receivePacket := func(memo string) channeltypes.Packet {
+		suite.Require().NoError(testutil.FundAccount(
+			pstakeApp.BankKeeper,
+			ctx,
+			receiver,
+			sdk.NewCoins(sdk.NewCoin(hc.IBCDenom(), amount)),
+		))
+		data := ibctransfertypes.NewFungibleTokenPacketData(
+			hc.HostDenom,
+			amount.String(),
+			"cosmos1sender",
+			receiver.String(),
+			memo,
+		)
+		return channeltypes.NewPacket(
+			data.GetBytes(), 1, ibctransfertypes.PortID, "channel-100", hc.PortId, hc.ChannelId, suite.chainA.GetTimeoutHeight(), 0,
+		)
+	}
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	forwardMemo := func(channel string) string {
+		return `{"liquidstake":{"receiver":"` + recipient.String() +
+			`","forward":{"channel":"` + channel + `","receiver":"cosmos1forward"}}}`
+	}
+
+	// a forward over a channel which doesn't exist fails the whole liquid stake, once the deposit was sent
+	packet := receivePacket(forwardMemo("channel-99"))
+	balance := pstakeApp.BankKeeper.GetBalance(ctx, receiver, hc.IBCDenom())
+	deposit, found := k.GetDepositForChainAndEpoch(ctx, hc.ChainId, k.GetEpochNumber(ctx, types.DelegationEpoch))
+	suite.Require().True(found)
+	err := k.OnRecvIBCTransferPacket(ctx, packet, nil, ack)
+	suite.Require().ErrorIs(err, types.ErrInvalidAutopilotTransfer)
+	suite.Require().Equal(balance, pstakeApp.BankKeeper.GetBalance(ctx, receiver, hc.IBCDenom()))
+	suite.Require().True(pstakeApp.BankKeeper.GetBalance(ctx, recipient, hc.MintDenom()).IsZero())
+	failedDeposit, found := k.GetDepositForChainAndEpoch(ctx, hc.ChainId, k.GetEpochNumber(ctx, types.DelegationEpoch))
+	suite.Require().True(found)
+	suite.Require().Equal(deposit.Amount, failedDeposit.Amount)
+
+	// stk tokens minted once delegated can't be forwarded
+	delayedCtx, _ := ctx.CacheContext()
+	delayed := *hc
+	delayed.Flags = &types.HostChainFlags{Lsm: hc.Flags.Lsm, DelayedMint: true}
+	suite.Require().NoError(k.SetHostChain(delayedCtx, &delayed))
+	err = k.OnRecvIBCTransferPacket(delayedCtx, receivePacket(forwardMemo(hc.ChannelId)), nil, ack)
+	suite.Require().ErrorIs(err, types.ErrInvalidAutopilotTransfer)
+
+	escrow := ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, hc.ChannelId)
+	err = k.OnRecvIBCTransferPacket(ctx, receivePacket(forwardMemo(hc.ChannelId)), nil, ack)
+	suite.Require().NoError(err)
+
+	// the minted stk tokens leave the memo receiver through the forward channel
+	mintAmount := sdk.NewDecFromInt(amount).Mul(hc.CValue).TruncateInt()
+	fee := hc.Params.DepositFee.MulInt(mintAmount).TruncateInt()
+	suite.Require().True(pstakeApp.BankKeeper.GetBalance(ctx, recipient, hc.MintDenom()).IsZero())
+	suite.Require().Equal(mintAmount.Sub(fee), pstakeApp.BankKeeper.GetBalance(ctx, escrow, hc.MintDenom()).Amount)
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// AddCValueRecord appends a manual c value update to the c value history of its host chain
+func (k *Keeper) AddCValueRecord(ctx sdk.Context, record *types.CValueRecord) {
+	index := uint64(len(k.GetCValueRecords(ctx, record.ChainId)))
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CValueHistoryKey)
+	bytes := k.cdc.MustMarshal(record)
+	store.Set(types.GetCValueRecordStoreKey(record.ChainId, index), bytes)
+}
+
+// GetCValueRecords returns the c value history of a host chain, oldest first
+func (k *Keeper) GetCValueRecords(ctx sdk.Context, chainID string) []*types.CValueRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CValueHistoryKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(chainID))
+	defer iterator.Close()
+
+	records := make([]*types.CValueRecord, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.CValueRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		// chain ids sharing a prefix share the iteration range
+		if record.ChainId == chainID {
+			records = append(records, &record)
+		}
+	}
+
+	return records
+}
@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestSetCValue() {
+	pstakeApp, ctx := suite.app, suite.ctx
+	k := pstakeApp.LiquidStakeIBCKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	previousCValue := hc.CValue
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName)
+	cValue := sdk.MustNewDecFromStr("0.97")
+
+	// the admin can't override the c value, only governance can
+	admin := sdk.MustAccAddressFromBech32(k.GetParams(ctx).AdminAddress)
+	_, err := msgServer.SetCValue(ctx, types.NewMsgSetCValue(admin, hc.ChainId, cValue, "justification", 1))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.SetCValue(ctx, types.NewMsgSetCValue(gov, "unknown-chain", cValue, "justification", 1))
+	suite.Require().ErrorIs(err, types.ErrInvalidHostChain)
+
+	outOfLimits := hc.Params.UpperCValueLimit.Add(sdk.OneDec())
+	_, err = msgServer.SetCValue(ctx, types.NewMsgSetCValue(gov, hc.ChainId, outOfLimits, "justification", 1))
+	suite.Require().ErrorIs(err, types.ErrCValueOutOfLimits)
+
+	_, err = msgServer.SetCValue(ctx, types.NewMsgSetCValue(gov, hc.ChainId, cValue, "slashing not accounted", 3))
+	suite.Require().NoError(err)
+
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(cValue, hc.CValue)
+	suite.Require().Equal(previousCValue, hc.LastCValue)
+	suite.Require().Equal(ctx.BlockHeight(), hc.CValueUpdateHeight)
+
+	res, err := k.CValueHistory(sdk.WrapSDKContext(ctx), &types.QueryCValueHistoryRequest{ChainId: hc.ChainId})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Records, 1)
+	record := res.Records[0]
+	suite.Require().Equal(cValue, record.CValue)
+	suite.Require().Equal(previousCValue, record.PreviousCValue)
+	suite.Require().Equal(gov.String(), record.Authority)
+	suite.Require().Equal(uint64(3), record.ProposalId)
+	suite.Require().Equal("slashing not accounted", record.Justification)
+
+	// records are kept in order and don't leak across host chains
+	_, err = msgServer.SetCValue(ctx, types.NewMsgSetCValue(gov, hc.ChainId, sdk.OneDec(), "revert", 4))
+	suite.Require().NoError(err)
+	records := k.GetCValueRecords(ctx, hc.ChainId)
+	suite.Require().Len(records, 2)
+	suite.Require().Equal(uint64(4), records[1].ProposalId)
+	suite.Require().Equal(cValue, records[1].PreviousCValue)
+	suite.Require().Empty(k.GetCValueRecords(ctx, suite.chainC.ChainID))
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	liquidstakeibctypes "github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// ExecuteContractCallback notifies the configured callback contract, if any, about a received transfer.
+// The contract is executed in a cached context with at most CallbackGasLimit gas, and failures, panics included, are
+// only logged and emitted as events, so a misbehaving contract can never block the processing of incoming transfers.
+func (k *Keeper) ExecuteContractCallback(ctx sdk.Context, callback liquidstakeibctypes.ContractCallbackMsg) {
+	contractAddress := k.GetParams(ctx).CallbackContractAddress
+	if contractAddress == "" {
+		return
+	}
+
+	err := k.executeContractCallback(ctx, contractAddress, callback)
+	if err != nil {
+		k.Logger(ctx).Error(
+			"Failed to execute contract callback.",
+			"contract",
+			contractAddress,
+			"error",
+			err,
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				liquidstakeibctypes.EventFailedContractCallback,
+				sdk.NewAttribute(liquidstakeibctypes.AttributeCallbackContractAddress, contractAddress),
+				sdk.NewAttribute(liquidstakeibctypes.AttributeCallbackError, err.Error()),
+			),
+		)
+	}
+}
+
+func (k *Keeper) executeContractCallback(
+	ctx sdk.Context,
+	contractAddress string,
+	callback liquidstakeibctypes.ContractCallbackMsg,
+) (err error) {
+	if k.contractKeeper == nil {
+		return errorsmod.Wrapf(liquidstakeibctypes.ErrNoContractKeeper, "callback contract %s", contractAddress)
+	}
+
+	contract, err := sdk.AccAddressFromBech32(contractAddress)
+	if err != nil {
+		return err
+	}
+
+	callbackBytes, err := callback.Bytes()
+	if err != nil {
+		return err
+	}
+	caller := k.GetDepositModuleAccount(ctx).GetAddress()
+
+	// the contract runs under its own gas meter, so running out of gas fails the callback and not the caller
+	gasLimit := liquidstakeibctypes.CallbackGasLimit
+	if remaining := ctx.GasMeter().GasRemaining(); remaining < gasLimit {
+		gasLimit = remaining
+	}
+	cms := ctx.MultiStore().CacheMultiStore()
+	cachedCtx := ctx.WithMultiStore(cms).
+		WithEventManager(sdk.NewEventManager()).
+		WithGasMeter(sdk.NewGasMeter(gasLimit))
+
+	defer func() {
+		ctx.GasMeter().ConsumeGas(cachedCtx.GasMeter().GasConsumedToLimit(), "contract callback")
+		if r := recover(); r != nil {
+			err = fmt.Errorf("contract callback panicked: %v", r)
+		}
+	}()
+
+	if _, err = k.contractKeeper.Execute(cachedCtx, contract, caller, callbackBytes, sdk.NewCoins()); err != nil {
+		return err
+	}
+
+	// the contract writes and events are only kept when it succeeds
+	cms.Write()
+	ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+type mockContractKeeper struct {
+	execute func(ctx sdk.Context) error
+	caller  sdk.AccAddress
+	msg     []byte
+}
+
+func (m *mockContractKeeper) Execute(
+	ctx sdk.Context,
+	_, caller sdk.AccAddress,
+	msg []byte,
+	_ sdk.Coins,
+) ([]byte, error) {
+	m.caller = caller
+	m.msg = msg
+	return nil, m.execute(ctx)
+}
+
+func (suite *IntegrationTestSuite) TestExecuteContractCallback() {
+	pstakeApp := suite.app
+	k := pstakeApp.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+
+	contract := authtypes.NewModuleAddress("callback_contract")
+	callback := types.NewUnbondingReceivedCallback(suite.chainB.ChainID, sdk.NewInt64Coin("uatom", 1000), 4)
+	callbackBytes, err := callback.Bytes()
+	suite.Require().NoError(err)
+
+	storeKey := pstakeApp.GetKey(types.StoreKey)
+	written := []byte("callback_written")
+	failed := func(ctx sdk.Context) bool {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventFailedContractCallback {
+				return true
+			}
+		}
+		return false
+	}
+
+	// a callback contract can't be set nor executed without a contract keeper
+	k.SetContractKeeper(nil)
+	params := k.GetParams(ctx)
+	params.CallbackContractAddress = contract.String()
+	_, err = keeper.NewMsgServerImpl(k).UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: params.AdminAddress,
+		Params:    params,
+	})
+	suite.Require().ErrorIs(err, types.ErrNoContractKeeper)
+
+	k.SetParams(ctx, params)
+	noKeeperCtx := ctx.WithEventManager(sdk.NewEventManager())
+	k.ExecuteContractCallback(noKeeperCtx, callback)
+	suite.Require().True(failed(noKeeperCtx))
+
+	contractKeeper := &mockContractKeeper{}
+	k.SetContractKeeper(contractKeeper)
+
+	// the contract is executed by the deposit module account and its writes and events are kept
+	contractEvent := func(ctx sdk.Context) bool {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == "wasm" {
+				return true
+			}
+		}
+		return false
+	}
+	contractKeeper.execute = func(ctx sdk.Context) error {
+		ctx.KVStore(storeKey).Set(written, []byte{0x01})
+		ctx.EventManager().EmitEvent(sdk.NewEvent("wasm"))
+		return nil
+	}
+	successCtx, _ := ctx.CacheContext()
+	successCtx = successCtx.WithEventManager(sdk.NewEventManager())
+	k.ExecuteContractCallback(successCtx, callback)
+	suite.Require().False(failed(successCtx))
+	suite.Require().True(successCtx.KVStore(storeKey).Has(written))
+	suite.Require().True(contractEvent(successCtx))
+	suite.Require().Equal(k.GetDepositModuleAccount(ctx).GetAddress(), contractKeeper.caller)
+	suite.Require().Equal(callbackBytes, contractKeeper.msg)
+
+	// failing contracts leave no writes behind
+	contractKeeper.execute = func(ctx sdk.Context) error {
+		ctx.KVStore(storeKey).Set(written, []byte{0x01})
+		ctx.EventManager().EmitEvent(sdk.NewEvent("wasm"))
+		return types.ErrInvalidMessages
+	}
+	errorCtx, _ := ctx.CacheContext()
+	errorCtx = errorCtx.WithEventManager(sdk.NewEventManager())
+	k.ExecuteContractCallback(errorCtx, callback)
+	suite.Require().True(failed(errorCtx))
+	suite.Require().False(errorCtx.KVStore(storeKey).Has(written))
+	suite.Require().False(contractEvent(errorCtx))
+
+	// panics are recovered
+	contractKeeper.execute = func(ctx sdk.Context) error {
+		ctx.KVStore(storeKey).Set(written, []byte{0x01})
+		panic("contract panic")
+	}
+	panicCtx, _ := ctx.CacheContext()
+	suite.Require().NotPanics(func() { k.ExecuteContractCallback(panicCtx, callback) })
+	suite.Require().True(failed(panicCtx))
+	suite.Require().False(panicCtx.KVStore(storeKey).Has(written))
+
+	// the contract runs out of gas at the callback limit, which is charged to the caller
+	contractKeeper.execute = func(ctx sdk.Context) error {
+		ctx.GasMeter().ConsumeGas(2*types.CallbackGasLimit, "contract")
+		return nil
+	}
+	gasCtx, _ := ctx.CacheContext()
+	gasCtx = gasCtx.WithGasMeter(sdk.NewGasMeter(10 * types.CallbackGasLimit))
+	gasBefore := gasCtx.GasMeter().GasConsumed()
+	suite.Require().NotPanics(func() { k.ExecuteContractCallback(gasCtx, callback) })
+	suite.Require().True(failed(gasCtx))
+	suite.Require().Less(gasCtx.GasMeter().GasConsumed()-gasBefore, 2*types.CallbackGasLimit)
+	suite.Require().GreaterOrEqual(gasCtx.GasMeter().GasConsumed()-gasBefore, types.CallbackGasLimit)
+
+	// the contract never gets more gas than the caller has left
+	lowGasCtx, _ := ctx.CacheContext()
+	lowGasCtx = lowGasCtx.WithGasMeter(sdk.NewGasMeter(types.CallbackGasLimit))
+	suite.Require().NotPanics(func() { k.ExecuteContractCallback(lowGasCtx, callback) })
+	suite.Require().True(failed(lowGasCtx))
+	suite.Require().True(lowGasCtx.GasMeter().IsPastLimit() || lowGasCtx.GasMeter().IsOutOfGas())
+}
+
+func (suite *IntegrationTestSuite) TestAppContractCallback() {
+	pstakeApp := suite.app
+	k := pstakeApp.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+
+	// the app executes the callback contract through the wasm keeper, so it can be set by the admin
+	params := k.GetParams(ctx)
+	params.CallbackContractAddress = authtypes.NewModuleAddress("callback_contract").String()
+	_, err := keeper.NewMsgServerImpl(k).UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: params.AdminAddress,
+		Params:    params,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params.CallbackContractAddress, k.GetParams(ctx).CallbackContractAddress)
+
+	// no contract is stored at the address, so the wasm keeper fails the callback without failing the caller
+	callbackCtx := ctx.WithEventManager(sdk.NewEventManager())
+	callback := types.NewUnbondingReceivedCallback(suite.chainB.ChainID, sdk.NewInt64Coin("uatom", 1000), 4)
+	suite.Require().NotPanics(func() { k.ExecuteContractCallback(callbackCtx, callback) })
+
+	var callbackErr string
+	for _, event := range callbackCtx.EventManager().Events() {
+		if event.Type != types.EventFailedContractCallback {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if attribute.Key == types.AttributeCallbackError {
+				callbackErr = attribute.Value
+			}
+		}
+	}
+	suite.Require().Contains(callbackErr, "no such contract")
+}
@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// SetChannelMigration stores the latest transfer channel migration of a host chain
+func (k *Keeper) SetChannelMigration(ctx sdk.Context, migration *types.ChannelMigration) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelMigrationKey)
+	bytes := k.cdc.MustMarshal(migration)
+	store.Set([]byte(migration.ChainId), bytes)
+}
+
+// GetChannelMigration returns the latest transfer channel migration of a host chain
+func (k *Keeper) GetChannelMigration(ctx sdk.Context, chainID string) (*types.ChannelMigration, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelMigrationKey)
+	bytes := store.Get([]byte(chainID))
+	if len(bytes) == 0 {
+		return &types.ChannelMigration{}, false
+	}
+
+	var migration types.ChannelMigration
+	k.cdc.MustUnmarshal(bytes, &migration)
+	return &migration, true
+}
+
+// GetAllChannelMigrations returns the latest transfer channel migration of every host chain that had one
+func (k *Keeper) GetAllChannelMigrations(ctx sdk.Context) []*types.ChannelMigration {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelMigrationKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	migrations := make([]*types.ChannelMigration, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		migration := types.ChannelMigration{}
+		k.cdc.MustUnmarshal(iterator.Value(), &migration)
+		migrations = append(migrations, &migration)
+	}
+
+	return migrations
+}
+
+// IsChannelMigrationDraining returns true if the transfer channel of a host chain is being migrated
+func (k *Keeper) IsChannelMigrationDraining(ctx sdk.Context, chainID string) bool {
+	migration, found := k.GetChannelMigration(ctx, chainID)
+	return found && migration.State == types.ChannelMigration_MIGRATION_DRAINING
+}
+
+// ValidateChannelMigration checks that a host chain can be moved to a new transfer channel
+func (k *Keeper) ValidateChannelMigration(ctx sdk.Context, hc *types.HostChain, newChannelID string) error {
+	if hc.IsLocalhost() {
+		return errorsmod.Wrapf(types.ErrLocalhostNotSupported, "host chain %s has no transfer channel", hc.ChainId)
+	}
+
+	if k.IsChannelMigrationDraining(ctx, hc.ChainId) {
+		return errorsmod.Wrapf(types.ErrChannelMigrationActive, "host chain %s is already being migrated", hc.ChainId)
+	}
+
+	if newChannelID == hc.ChannelId {
+		return errorsmod.Wrapf(types.ErrInvalidChannelMigration, "host chain already uses channel %s", newChannelID)
+	}
+
+	return k.validateMigrationChannel(ctx, hc, newChannelID)
+}
+
+// validateMigrationChannel checks that a transfer channel is open and reaches the host chain
+func (k *Keeper) validateMigrationChannel(ctx sdk.Context, hc *types.HostChain, newChannelID string) error {
+	channel, found := k.ibcKeeper.ChannelKeeper.GetChannel(ctx, hc.PortId, newChannelID)
+	if !found || channel.State != channeltypes.OPEN {
+		return errorsmod.Wrapf(
+			types.ErrChannelNotOpen,
+			"transfer channel %s on port %s is not open",
+			newChannelID,
+			hc.PortId,
+		)
+	}
+
+	// the new channel needs to reach the same host chain
+	if len(channel.ConnectionHops) == 0 || channel.ConnectionHops[0] != hc.ConnectionId {
+		return errorsmod.Wrapf(
+			types.ErrInvalidChannelMigration,
+			"channel %s is not built on the host chain connection %s",
+			newChannelID,
+			hc.ConnectionId,
+		)
+	}
+
+	return nil
+}
+
+// ChannelMigrationBlockers returns the transfers over the old channel of a draining migration that still need to
+// settle before the host chain can be switched to the new channel
+func (k *Keeper) ChannelMigrationBlockers(ctx sdk.Context, migration *types.ChannelMigration) []string {
+	blockers := make([]string, 0)
+
+	commitments := k.ibcKeeper.ChannelKeeper.GetAllPacketCommitmentsAtChannel(
+		ctx,
+		ibctransfertypes.PortID,
+		migration.OldChannelId,
+	)
+	if len(commitments) > 0 {
+		blockers = append(blockers, fmt.Sprintf("%d packets in flight on channel %s", len(commitments), migration.OldChannelId))
+	}
+
+	for _, deposit := range k.GetDepositsForHostChain(ctx, migration.ChainId) {
+		switch {
+		case deposit.State == types.Deposit_DEPOSIT_SENT:
+			blockers = append(blockers, fmt.Sprintf("deposit for epoch %d is being sent", deposit.Epoch))
+		case deposit.State == types.Deposit_DEPOSIT_PENDING && deposit.Amount.IsPositive():
+			blockers = append(blockers, fmt.Sprintf("deposit for epoch %d has not been sent", deposit.Epoch))
+		case deposit.State == types.Deposit_DEPOSIT_FAILED:
+			blockers = append(blockers, fmt.Sprintf("deposit for epoch %d failed to be sent", deposit.Epoch))
+		}
+	}
+
+	lsmDeposits := k.FilterLSMDeposits(
+		ctx,
+		func(d types.LSMDeposit) bool {
+			return d.ChainId == migration.ChainId &&
+				(d.State == types.LSMDeposit_DEPOSIT_PENDING || d.State == types.LSMDeposit_DEPOSIT_SENT)
+		},
+	)
+	if len(lsmDeposits) > 0 {
+		blockers = append(blockers, fmt.Sprintf("%d lsm deposits have not been received", len(lsmDeposits)))
+	}
+
+	// matured unbondings are transferred, and claimable ones paid, in the old ibc denom
+	unbondings := k.FilterUnbondings(
+		ctx,
+		func(u types.Unbonding) bool {
+			return u.ChainId == migration.ChainId &&
+				(u.State == types.Unbonding_UNBONDING_MATURED || u.State == types.Unbonding_UNBONDING_CLAIMABLE)
+		},
+	)
+	for _, unbonding := range unbondings {
+		blockers = append(
+			blockers,
+			fmt.Sprintf("unbonding for epoch %d is %s", unbonding.EpochNumber, unbonding.State.String()),
+		)
+	}
+
+	return blockers
+}
+
+// ProcessChannelMigrations switches the draining host chain migrations whose old channel has settled
+func (k *Keeper) ProcessChannelMigrations(ctx sdk.Context, epoch int64) {
+	for _, migration := range k.GetAllChannelMigrations(ctx) {
+		if migration.State != types.ChannelMigration_MIGRATION_DRAINING {
+			continue
+		}
+
+		hc, found := k.GetHostChain(ctx, migration.ChainId)
+		if !found {
+			continue
+		}
+
+		if blockers := k.ChannelMigrationBlockers(ctx, migration); len(blockers) > 0 {
+			k.Logger(ctx).Info(
+				"Host chain channel migration is draining.",
+				"host_chain",
+				hc.ChainId,
+				"blockers",
+				len(blockers),
+			)
+			continue
+		}
+
+		// the new channel may have been closed while the old one was draining
+		if err := k.validateMigrationChannel(ctx, hc, migration.NewChannelId); err != nil {
+			k.Logger(ctx).Error(
+				"Could not switch the host chain channel.",
+				"host_chain",
+				hc.ChainId,
+				"err",
+				err.Error(),
+			)
+			continue
+		}
+
+		hc.ChannelId = migration.NewChannelId
+		if err := k.SetHostChain(ctx, hc); err != nil {
+			k.Logger(ctx).Error(
+				"Could not switch the host chain channel.",
+				"host_chain",
+				hc.ChainId,
+				"err",
+				err.Error(),
+			)
+			continue
+		}
+
+		// the remaining pending deposits are empty, they only need to track the new denom
+		for _, deposit := range k.GetDepositsForHostChain(ctx, hc.ChainId) {
+			if deposit.State == types.Deposit_DEPOSIT_PENDING && deposit.Amount.Denom == migration.OldIbcDenom {
+				deposit.Amount.Denom = hc.IBCDenom()
+				k.SetDeposit(ctx, deposit)
+			}
+		}
+
+		migration.NewIbcDenom = hc.IBCDenom()
+		migration.CompletedEpoch = epoch
+		migration.State = types.ChannelMigration_MIGRATION_COMPLETED
+		k.SetChannelMigration(ctx, migration)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeChannelMigrationCompleted,
+				sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+				sdk.NewAttribute(types.AttributeOldChannelID, migration.OldChannelId),
+				sdk.NewAttribute(types.AttributeNewChannelID, migration.NewChannelId),
+				sdk.NewAttribute(types.AttributeOldIBCDenom, migration.OldIbcDenom),
+				sdk.NewAttribute(types.AttributeNewIBCDenom, migration.NewIbcDenom),
+				sdk.NewAttribute(types.AttributeEpoch, strconv.FormatInt(epoch, 10)),
+			),
+		)
+	}
+}
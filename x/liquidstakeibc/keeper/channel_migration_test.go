@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestChannelMigration() {
+	// open a second transfer channel over the host chain connection
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	path.EndpointA.ClientID = suite.transferPathAB.EndpointA.ClientID
+	path.EndpointB.ClientID = suite.transferPathAB.EndpointB.ClientID
+	path.EndpointA.ConnectionID = suite.transferPathAB.EndpointA.ConnectionID
+	path.EndpointB.ConnectionID = suite.transferPathAB.EndpointB.ConnectionID
+	suite.coordinator.CreateChannels(path)
+
+	ctx := suite.chainA.GetContext()
+	k := suite.app.LiquidStakeIBCKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+	oldChannelID, oldDenom := hc.ChannelId, hc.IBCDenom()
+	admin := k.GetParams(ctx).AdminAddress
+
+	// the channel of another connection does not reach the host chain
+	_, err := msgServer.MigrateHostChainChannel(ctx, types.NewMsgMigrateHostChainChannel(
+		sdk.MustAccAddressFromBech32(admin), hc.ChainId, suite.transferPathAC.EndpointA.ChannelID, false,
+	))
+	suite.Require().ErrorIs(err, types.ErrInvalidChannelMigration)
+
+	_, err = msgServer.MigrateHostChainChannel(ctx, types.NewMsgMigrateHostChainChannel(
+		sdk.MustAccAddressFromBech32(admin), hc.ChainId, path.EndpointA.ChannelID, false,
+	))
+	suite.Require().NoError(err)
+
+	// a single migration can be in progress and it holds back new deposits
+	_, err = msgServer.MigrateHostChainChannel(ctx, types.NewMsgMigrateHostChainChannel(
+		sdk.MustAccAddressFromBech32(admin), hc.ChainId, path.EndpointA.ChannelID, false,
+	))
+	suite.Require().ErrorIs(err, types.ErrChannelMigrationActive)
+	_, err = msgServer.LiquidStake(ctx, &types.MsgLiquidStake{
+		DelegatorAddress: suite.chainA.SenderAccount.GetAddress().String(),
+		Amount:           sdk.NewCoin(oldDenom, MinDeposit),
+	})
+	suite.Require().ErrorIs(err, types.ErrChannelMigrationActive)
+
+	// the pending deposit keeps the host chain on the old channel
+	k.SetDeposit(ctx, &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  sdk.NewCoin(oldDenom, sdk.NewInt(1000)),
+		Epoch:   10,
+		State:   types.Deposit_DEPOSIT_PENDING,
+	})
+
+	res, err := k.ChannelMigration(ctx, &types.QueryChannelMigrationRequest{ChainId: hc.ChainId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ChannelMigration_MIGRATION_DRAINING, res.Migration.State)
+	suite.Require().NotEmpty(res.Blockers)
+
+	k.ProcessChannelMigrations(ctx, 10)
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(oldChannelID, hc.ChannelId)
+
+	// once the deposits settled the host chain moves to the new channel and denom
+	for _, d := range k.GetDepositsForHostChain(ctx, hc.ChainId) {
+		d.State = types.Deposit_DEPOSIT_PENDING
+		d.Amount = sdk.NewCoin(oldDenom, sdk.ZeroInt())
+		k.SetDeposit(ctx, d)
+	}
+	for _, u := range k.FilterUnbondings(ctx, func(u types.Unbonding) bool { return u.ChainId == hc.ChainId }) {
+		k.DeleteUnbonding(ctx, u)
+	}
+	for _, d := range k.FilterLSMDeposits(ctx, func(d types.LSMDeposit) bool { return d.ChainId == hc.ChainId }) {
+		k.DeleteLSMDeposit(ctx, d)
+	}
+
+	k.ProcessChannelMigrations(ctx, 11)
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(path.EndpointA.ChannelID, hc.ChannelId)
+	newDenom := ibctransfertypes.ParseDenomTrace(
+		ibctransfertypes.GetPrefixedDenom(ibctransfertypes.PortID, path.EndpointA.ChannelID, HostDenom),
+	).IBCDenom()
+	suite.Require().Equal(newDenom, hc.IBCDenom())
+
+	for _, d := range k.GetDepositsForHostChain(ctx, hc.ChainId) {
+		suite.Require().Equal(newDenom, d.Amount.Denom)
+	}
+
+	migration, found := k.GetChannelMigration(ctx, hc.ChainId)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(types.ChannelMigration_MIGRATION_COMPLETED, migration.State)
+	suite.Require().Equal(oldDenom, migration.OldIbcDenom)
+	suite.Require().Equal(newDenom, migration.NewIbcDenom)
+	suite.Require().Equal(int64(11), migration.CompletedEpoch)
+
+	// a completed migration can't be cancelled
+	_, err = msgServer.MigrateHostChainChannel(ctx, types.NewMsgMigrateHostChainChannel(
+		sdk.MustAccAddressFromBech32(admin), hc.ChainId, "", true,
+	))
+	suite.Require().ErrorIs(err, types.ErrInvalidChannelMigration)
+}
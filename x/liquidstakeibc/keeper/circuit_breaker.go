@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (k *Keeper) SetHostChainFailures(ctx sdk.Context, failures *types.HostChainFailures) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HostChainFailuresKey)
+	bytes := k.cdc.MustMarshal(failures)
+	store.Set([]byte(failures.ChainId), bytes)
+}
+
+func (k *Keeper) GetHostChainFailures(ctx sdk.Context, chainID string) (*types.HostChainFailures, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HostChainFailuresKey)
+	bytes := store.Get([]byte(chainID))
+	if len(bytes) == 0 {
+		return &types.HostChainFailures{ChainId: chainID}, false
+	}
+
+	var failures types.HostChainFailures
+	k.cdc.MustUnmarshal(bytes, &failures)
+	return &failures, true
+}
+
+func (k *Keeper) GetAllHostChainFailures(ctx sdk.Context) []*types.HostChainFailures {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HostChainFailuresKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	allFailures := make([]*types.HostChainFailures, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		failures := types.HostChainFailures{}
+		k.cdc.MustUnmarshal(iterator.Value(), &failures)
+		allFailures = append(allFailures, &failures)
+	}
+
+	return allFailures
+}
+
+// ResetHostChainFailures clears the failures of a host chain after a successful ica or icq interaction
+func (k *Keeper) ResetHostChainFailures(ctx sdk.Context, chainID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HostChainFailuresKey)
+	store.Delete([]byte(chainID))
+}
+
+// RecordHostChainFailure counts a failed ica or icq interaction with a host chain. The failures are only checked
+// against the circuit breaker threshold at the beginning of the next block, so the host chain records the caller
+// holds are never overwritten.
+func (k *Keeper) RecordHostChainFailure(ctx sdk.Context, chainID string, failure error) {
+	if _, found := k.GetHostChain(ctx, chainID); !found {
+		return
+	}
+
+	failures, _ := k.GetHostChainFailures(ctx, chainID)
+	failures.ConsecutiveFailures++
+	failures.LastError = failure.Error()
+	failures.LastFailureHeight = ctx.BlockHeight()
+	k.SetHostChainFailures(ctx, failures)
+}
+
+// TripCircuitBreakers deactivates the host chains whose consecutive failures reached the circuit breaker threshold,
+// pausing their workflows until they are activated again
+func (k *Keeper) TripCircuitBreakers(ctx sdk.Context) {
+	threshold := k.GetParams(ctx).CircuitBreakerThreshold
+	if threshold == 0 {
+		return
+	}
+
+	for _, failures := range k.GetAllHostChainFailures(ctx) {
+		if failures.ConsecutiveFailures < threshold {
+			continue
+		}
+
+		hc, found := k.GetHostChain(ctx, failures.ChainId)
+		if !found || !hc.Active {
+			continue
+		}
+
+		hc.Active = false
+		k.setHostChain(ctx, hc)
+
+		k.Logger(ctx).Error(
+			"Host chain deactivated by the circuit breaker.",
+			"host_chain",
+			hc.ChainId,
+			"consecutive_failures",
+			failures.ConsecutiveFailures,
+			"last_error",
+			failures.LastError,
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCircuitBreakerTripped,
+				sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+				sdk.NewAttribute(types.AttributeConsecutiveFailures, strconv.FormatUint(failures.ConsecutiveFailures, 10)),
+				sdk.NewAttribute(types.AttributeKeyAckError, failures.LastError),
+			),
+		)
+	}
+}
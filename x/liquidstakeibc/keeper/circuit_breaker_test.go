@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestCircuitBreaker() {
+	ctx, _ := suite.ctx.CacheContext()
+	k := suite.app.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	params := k.GetParams(ctx)
+	params.CircuitBreakerThreshold = 2
+	k.SetParams(ctx, params)
+
+	// an ica tx without an open channel is a failure of the host chain
+	messages := []proto.Message{&banktypes.MsgSend{}}
+	_, err := k.GenerateAndExecuteICATx(ctx, hc.ConnectionId, "unknown-owner", messages)
+	suite.Require().Error(err)
+
+	failures, found := k.GetHostChainFailures(ctx, hc.ChainId)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(uint64(1), failures.ConsecutiveFailures)
+	suite.Require().Equal(err.Error(), failures.LastError)
+
+	// a success in between starts the count over
+	k.ResetHostChainFailures(ctx, hc.ChainId)
+	_, found = k.GetHostChainFailures(ctx, hc.ChainId)
+	suite.Require().Equal(false, found)
+
+	// below the threshold the chain is left alone
+	k.RecordHostChainFailure(ctx, hc.ChainId, errors.New("icq error"))
+	k.TripCircuitBreakers(ctx)
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(true, hc.Active)
+
+	k.RecordHostChainFailure(ctx, hc.ChainId, errors.New("icq error"))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.TripCircuitBreakers(ctx)
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(false, hc.Active)
+
+	tripped := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeCircuitBreakerTripped {
+			tripped = true
+		}
+	}
+	suite.Require().Equal(true, tripped)
+
+	// activating the chain again clears its failures
+	err = k.ApplyHostChainUpdates(ctx, hc, []*types.KVUpdate{{Key: types.KeyActive, Value: "true"}})
+	suite.Require().NoError(err)
+	_, found = k.GetHostChainFailures(ctx, hc.ChainId)
+	suite.Require().Equal(false, found)
+}
+
+func (suite *IntegrationTestSuite) TestCircuitBreakerDisabled() {
+	ctx, _ := suite.ctx.CacheContext()
+	k := suite.app.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	params := k.GetParams(ctx)
+	params.CircuitBreakerThreshold = 0
+	k.SetParams(ctx, params)
+
+	for i := 0; i < 10; i++ {
+		k.RecordHostChainFailure(ctx, hc.ChainId, errors.New("ica error"))
+	}
+	k.TripCircuitBreakers(ctx)
+
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(true, hc.Active)
+}
@@ -46,6 +46,12 @@ func (k *Keeper) GenerateDelegateMessages(hc *types.HostChain, depositAmount mat
 	// subtract the delegations from non-delegable validators to get the effective total delegated amount
 	effectiveTotalDelegatedAmount := hc.GetHostChainTotalDelegations().Sub(nonDelegableDelegations)
 
+	// validators qualifying for the commission rebate program are delegated to with boosted weights
+	delegableValidators = hc.RebateBoostedValidators(delegableValidators)
+
+	// no validator is delegated to above the max delegation share of the host chain
+	delegableValidators = hc.CapDelegationShares(delegableValidators)
+
 	return k.generateMessages(hc, delegableValidators, effectiveTotalDelegatedAmount, depositAmount, false)
 }
 
@@ -570,3 +570,38 @@ func (suite *IntegrationTestSuite) TestGenerateUndelegateMessages() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestGenerateDelegateMessagesMaxDelegationShare() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	weights := []string{"0.7", "0.1", "0.1", "0.1"}
+	validators := make([]*types.Validator, 0, len(weights))
+	for i, weight := range weights {
+		validators = append(validators, &types.Validator{
+			OperatorAddress: hc.Validators[i].OperatorAddress,
+			Weight:          decFromStr(weight),
+			DelegatedAmount: sdk.ZeroInt(),
+			Status:          stakingtypes.BondStatusBonded,
+			Delegable:       true,
+		})
+	}
+	hc.Validators = validators
+	hc.Params.MaxDelegationShare = decFromStr("0.3")
+
+	messages, err := suite.app.LiquidStakeIBCKeeper.GenerateDelegateMessages(hc, sdk.NewInt(100))
+	suite.Require().NoError(err)
+
+	// the heavy validator is held at the cap and its excess spills to the rest
+	expected := map[string]int64{
+		validators[0].OperatorAddress: 30,
+		validators[1].OperatorAddress: 23,
+		validators[2].OperatorAddress: 23,
+		validators[3].OperatorAddress: 24,
+	}
+	suite.Require().Len(messages, len(expected))
+	for _, message := range messages {
+		msgDelegate := message.(*stakingtypes.MsgDelegate)
+		suite.Require().Equal(expected[msgDelegate.ValidatorAddress], msgDelegate.Amount.Amount.Int64())
+	}
+}
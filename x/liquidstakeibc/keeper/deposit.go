@@ -1,8 +1,6 @@
 package keeper
 
 import (
-	"strconv"
-
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -51,6 +49,16 @@ func (k *Keeper) RevertDepositsState(ctx sdk.Context, deposits []*liquidstakeibc
 	}
 }
 
+// FailDepositsState moves deposits whose transfer timed out or failed to failed, so the deposit workflow sends them
+// again while they have retries left
+func (k *Keeper) FailDepositsState(ctx sdk.Context, deposits []*liquidstakeibctypes.Deposit) {
+	for _, deposit := range deposits {
+		deposit.IbcSequenceId = ""
+		deposit.State = liquidstakeibctypes.Deposit_DEPOSIT_FAILED
+		k.SetDeposit(ctx, deposit)
+	}
+}
+
 func (k *Keeper) GetAllDeposits(ctx sdk.Context) []*liquidstakeibctypes.Deposit {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositKey)
 	iterator := sdk.KVStorePrefixIterator(store, nil)
@@ -66,9 +74,8 @@ func (k *Keeper) GetAllDeposits(ctx sdk.Context) []*liquidstakeibctypes.Deposit
 	return deposits
 }
 
-func (k *Keeper) GetTransactionSequenceID(channelID string, sequence uint64) string {
-	sequenceStr := strconv.FormatUint(sequence, 10)
-	return channelID + "-sequence-" + sequenceStr
+func (k *Keeper) GetTransactionSequenceID(portID, channelID string, sequence uint64) string {
+	return liquidstakeibctypes.GetTransactionSequenceID(portID, channelID, sequence)
 }
 
 func (k *Keeper) AdjustDepositsForRedemption(
@@ -178,6 +185,24 @@ func (k *Keeper) GetPendingDepositsBeforeEpoch(ctx sdk.Context, epoch int64) []*
 	return deposits
 }
 
+func (k *Keeper) GetFailedDeposits(ctx sdk.Context) []*liquidstakeibctypes.Deposit {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	deposits := make([]*liquidstakeibctypes.Deposit, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		deposit := &liquidstakeibctypes.Deposit{}
+		k.cdc.MustUnmarshal(iterator.Value(), deposit)
+
+		if deposit.State == liquidstakeibctypes.Deposit_DEPOSIT_FAILED {
+			deposits = append(deposits, deposit)
+		}
+	}
+
+	return deposits
+}
+
 func (k *Keeper) GetRedeemableDepositsForHostChain(
 	ctx sdk.Context,
 	hc *liquidstakeibctypes.HostChain,
@@ -253,7 +278,8 @@ func (k *Keeper) GetDepositAmountOnPersistence(ctx sdk.Context, chainID string)
 
 		if deposit.ChainId == chainID &&
 			(deposit.State == liquidstakeibctypes.Deposit_DEPOSIT_PENDING ||
-				deposit.State == liquidstakeibctypes.Deposit_DEPOSIT_SENT) {
+				deposit.State == liquidstakeibctypes.Deposit_DEPOSIT_SENT ||
+				deposit.State == liquidstakeibctypes.Deposit_DEPOSIT_FAILED) {
 			amount = amount.Add(deposit.Amount.Amount)
 		}
 	}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// GetHostChainLiquidStakedAmount returns the host tokens liquid staked on a host chain, counted the same way as for
+// the c value
+func (k *Keeper) GetHostChainLiquidStakedAmount(ctx sdk.Context, hc *types.HostChain) math.Int {
+	return k.GetLSMDepositAmountUntokenized(ctx, hc.ChainId).
+		Add(hc.GetHostChainTotalDelegations()).
+		Add(k.GetDepositAmountOnPersistence(ctx, hc.ChainId)).
+		Add(k.GetDepositAmountOnHostChain(ctx, hc.ChainId)).
+		Add(k.GetAllValidatorUnbondedAmount(ctx, hc))
+}
+
+// GetDepositCapacity returns the host tokens that can still be liquid staked on a capped host chain
+func (k *Keeper) GetDepositCapacity(ctx sdk.Context, hc *types.HostChain) (math.Int, bool) {
+	if !hc.Params.HasDepositCap() {
+		return sdk.ZeroInt(), false
+	}
+
+	remaining := hc.Params.MaxDepositAmount.Sub(k.GetHostChainLiquidStakedAmount(ctx, hc))
+	if remaining.IsNegative() {
+		return sdk.ZeroInt(), true
+	}
+
+	return remaining, true
+}
+
+// ValidateDepositCap checks that liquid staking an amount keeps the host chain under its deposit cap
+func (k *Keeper) ValidateDepositCap(ctx sdk.Context, hc *types.HostChain, amount math.Int) error {
+	remaining, capped := k.GetDepositCapacity(ctx, hc)
+	if capped && amount.GT(remaining) {
+		return errorsmod.Wrapf(
+			types.ErrDepositCapExceeded,
+			"host chain %s can only take %s more, got %s",
+			hc.ChainId,
+			remaining,
+			amount,
+		)
+	}
+
+	return nil
+}
+
+// GetTotalStkSupply returns the stk supply of all the host chains, including the stk tokens owed to delegators but
+// not minted yet
+func (k *Keeper) GetTotalStkSupply(ctx sdk.Context) math.Int {
+	supply := sdk.ZeroInt()
+	for _, hc := range k.GetAllHostChains(ctx) {
+		supply = supply.
+			Add(k.bankKeeper.GetSupply(ctx, hc.MintDenom()).Amount).
+			Add(k.GetPendingMintAmount(ctx, hc.ChainId))
+	}
+
+	return supply
+}
+
+// ValidateStkSupplyCap checks that minting an amount of stk tokens keeps the protocol under its stk supply cap
+func (k *Keeper) ValidateStkSupplyCap(ctx sdk.Context, mintAmount math.Int) error {
+	params := k.GetParams(ctx)
+	if !params.HasStkSupplyCap() {
+		return nil
+	}
+
+	supply := k.GetTotalStkSupply(ctx)
+	if supply.Add(mintAmount).GT(params.MaxStkSupply) {
+		return errorsmod.Wrapf(
+			types.ErrStkSupplyCapExceeded,
+			"minting %s over the current stk supply %s exceeds the cap %s",
+			mintAmount,
+			supply,
+			params.MaxStkSupply,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestDepositCap() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx := suite.ctx
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	// an uncapped host chain has no remaining capacity to report
+	_, capped := k.GetDepositCapacity(ctx, hc)
+	suite.Require().False(capped)
+
+	liquidStaked := k.GetHostChainLiquidStakedAmount(ctx, hc)
+	hc.Params.MaxDepositAmount = liquidStaked.Add(MinDeposit.MulRaw(3))
+	k.SetHostChain(ctx, hc)
+
+	res, err := k.DepositCapacity(sdk.WrapSDKContext(ctx), &types.QueryDepositCapacityRequest{ChainId: hc.ChainId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(hc.Params.MaxDepositAmount, res.MaxDepositAmount)
+	suite.Require().Equal(liquidStaked, res.LiquidStakedAmount)
+	suite.Require().Equal(MinDeposit.MulRaw(3), res.RemainingAmount)
+
+	k.SetDeposit(ctx, &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  sdk.NewCoin(hc.IBCDenom(), sdk.ZeroInt()),
+		Epoch:   suite.app.EpochsKeeper.GetEpochInfo(ctx, types.DelegationEpoch).CurrentEpoch,
+		State:   types.Deposit_DEPOSIT_PENDING,
+	})
+
+	delegator := suite.chainA.SenderAccount.GetAddress().String()
+	_, err = msgServer.LiquidStake(ctx, &types.MsgLiquidStake{
+		DelegatorAddress: delegator,
+		Amount:           sdk.NewCoin(hc.IBCDenom(), MinDeposit.MulRaw(2)),
+	})
+	suite.Require().NoError(err)
+
+	// the deposit counts towards the cap
+	remaining, capped := k.GetDepositCapacity(ctx, hc)
+	suite.Require().True(capped)
+	suite.Require().Equal(MinDeposit, remaining)
+
+	_, err = msgServer.LiquidStake(ctx, &types.MsgLiquidStake{
+		DelegatorAddress: delegator,
+		Amount:           sdk.NewCoin(hc.IBCDenom(), MinDeposit.MulRaw(2)),
+	})
+	suite.Require().ErrorIs(err, types.ErrDepositCapExceeded)
+
+	_, err = msgServer.LiquidStake(ctx, &types.MsgLiquidStake{
+		DelegatorAddress: delegator,
+		Amount:           sdk.NewCoin(hc.IBCDenom(), MinDeposit),
+	})
+	suite.Require().NoError(err)
+
+	remaining, _ = k.GetDepositCapacity(ctx, hc)
+	suite.Require().True(remaining.IsZero())
+}
+
+func (suite *IntegrationTestSuite) TestStkSupplyCap() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx := suite.ctx
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	// the default params don't cap the stk supply
+	suite.Require().NoError(k.ValidateStkSupplyCap(ctx, MinDeposit.MulRaw(1000)))
+
+	k.SetDeposit(ctx, &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  sdk.NewCoin(hc.IBCDenom(), sdk.ZeroInt()),
+		Epoch:   suite.app.EpochsKeeper.GetEpochInfo(ctx, types.DelegationEpoch).CurrentEpoch,
+		State:   types.Deposit_DEPOSIT_PENDING,
+	})
+
+	supply := k.GetTotalStkSupply(ctx)
+	mintAmount := sdk.NewDecFromInt(MinDeposit.MulRaw(2)).Mul(hc.CValue).TruncateInt()
+	params := k.GetParams(ctx)
+	params.MaxStkSupply = supply.Add(mintAmount)
+	k.SetParams(ctx, params)
+
+	delegator := suite.chainA.SenderAccount.GetAddress().String()
+	_, err := msgServer.LiquidStake(ctx, &types.MsgLiquidStake{
+		DelegatorAddress: delegator,
+		Amount:           sdk.NewCoin(hc.IBCDenom(), MinDeposit.MulRaw(2)),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params.MaxStkSupply, k.GetTotalStkSupply(ctx))
+
+	// the cap is reached, any further mint is rejected
+	_, err = msgServer.LiquidStake(ctx, &types.MsgLiquidStake{
+		DelegatorAddress: delegator,
+		Amount:           sdk.NewCoin(hc.IBCDenom(), MinDeposit),
+	})
+	suite.Require().ErrorIs(err, types.ErrStkSupplyCapExceeded)
+
+	// stk tokens pending to be minted count towards the cap
+	params.MaxStkSupply = params.MaxStkSupply.Add(mintAmount)
+	k.SetParams(ctx, params)
+	k.AddPendingMint(
+		ctx,
+		hc,
+		delegator,
+		1,
+		sdk.NewCoin(hc.HostDenom, MinDeposit.MulRaw(2)),
+		sdk.NewCoin(hc.MintDenom(), mintAmount),
+		sdk.NewCoin(hc.MintDenom(), sdk.ZeroInt()),
+	)
+	suite.Require().ErrorIs(k.ValidateStkSupplyCap(ctx, sdk.OneInt()), types.ErrStkSupplyCapExceeded)
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	liquidstakeibctypes "github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (k *Keeper) SetDepositReceipt(ctx sdk.Context, receipt *liquidstakeibctypes.DepositReceipt) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositReceiptKey)
+	bytes := k.cdc.MustMarshal(receipt)
+	store.Set(liquidstakeibctypes.GetDepositReceiptStoreKey(receipt.Id), bytes)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositReceiptDelegatorIndexKey)
+	indexStore.Set(liquidstakeibctypes.GetDepositReceiptDelegatorIndexKey(receipt.DelegatorAddress, receipt.Id), []byte{})
+}
+
+func (k *Keeper) GetDepositReceipt(ctx sdk.Context, id uint64) (*liquidstakeibctypes.DepositReceipt, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositReceiptKey)
+	bytes := store.Get(liquidstakeibctypes.GetDepositReceiptStoreKey(id))
+	if len(bytes) == 0 {
+		return &liquidstakeibctypes.DepositReceipt{}, false
+	}
+
+	var receipt liquidstakeibctypes.DepositReceipt
+	k.cdc.MustUnmarshal(bytes, &receipt)
+	return &receipt, true
+}
+
+func (k *Keeper) DeleteDepositReceipt(ctx sdk.Context, receipt *liquidstakeibctypes.DepositReceipt) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositReceiptKey)
+	store.Delete(liquidstakeibctypes.GetDepositReceiptStoreKey(receipt.Id))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositReceiptDelegatorIndexKey)
+	indexStore.Delete(liquidstakeibctypes.GetDepositReceiptDelegatorIndexKey(receipt.DelegatorAddress, receipt.Id))
+}
+
+func (k *Keeper) GetAllDepositReceipts(ctx sdk.Context) []*liquidstakeibctypes.DepositReceipt {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositReceiptKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	receipts := make([]*liquidstakeibctypes.DepositReceipt, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		receipt := liquidstakeibctypes.DepositReceipt{}
+		k.cdc.MustUnmarshal(iterator.Value(), &receipt)
+		receipts = append(receipts, &receipt)
+	}
+
+	return receipts
+}
+
+// GetDepositReceiptsForDelegator returns the receipts of a delegator through the delegator index, ordered by id
+func (k *Keeper) GetDepositReceiptsForDelegator(
+	ctx sdk.Context,
+	delegatorAddress string,
+) []*liquidstakeibctypes.DepositReceipt {
+	indexPrefix := liquidstakeibctypes.GetDepositReceiptDelegatorIndexPrefix(delegatorAddress)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositReceiptDelegatorIndexKey)
+	iterator := sdk.KVStorePrefixIterator(indexStore, indexPrefix)
+	defer iterator.Close()
+
+	receipts := make([]*liquidstakeibctypes.DepositReceipt, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Key()[len(indexPrefix):])
+		if receipt, found := k.GetDepositReceipt(ctx, id); found {
+			receipts = append(receipts, receipt)
+		}
+	}
+
+	return receipts
+}
+
+// GetNextDepositReceiptID returns the id for a new deposit receipt and increments the stored counter.
+func (k *Keeper) GetNextDepositReceiptID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	var id uint64
+	if bytes := store.Get(liquidstakeibctypes.DepositReceiptIDKey); len(bytes) != 0 {
+		id = sdk.BigEndianToUint64(bytes)
+	}
+	store.Set(liquidstakeibctypes.DepositReceiptIDKey, sdk.Uint64ToBigEndian(id+1))
+
+	return id
+}
+
+// CreateDepositReceipt stores a new receipt for a liquid stake, as long as receipts are enabled, and emits its event.
+// Returns the created receipt and whether it was stored.
+func (k *Keeper) CreateDepositReceipt(
+	ctx sdk.Context,
+	hc *liquidstakeibctypes.HostChain,
+	delegatorAddress string,
+	amount sdk.Coin,
+	mintedAmount sdk.Coin,
+) (*liquidstakeibctypes.DepositReceipt, bool) {
+	if k.GetParams(ctx).DepositReceiptRetention == 0 {
+		return nil, false
+	}
+
+	receipt := &liquidstakeibctypes.DepositReceipt{
+		Id:               k.GetNextDepositReceiptID(ctx),
+		DelegatorAddress: delegatorAddress,
+		ChainId:          hc.ChainId,
+		Amount:           amount,
+		MintedAmount:     mintedAmount,
+		CValue:           hc.CValue,
+		Epoch:            k.GetEpochNumber(ctx, liquidstakeibctypes.DelegationEpoch),
+		CreatedAt:        ctx.BlockTime(),
+	}
+	k.SetDepositReceipt(ctx, receipt)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			liquidstakeibctypes.EventTypeDepositReceipt,
+			sdk.NewAttribute(liquidstakeibctypes.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(liquidstakeibctypes.AttributeDelegatorAddress, delegatorAddress),
+			sdk.NewAttribute(liquidstakeibctypes.AttributeDepositReceiptID, strconv.FormatUint(receipt.Id, 10)),
+			liquidstakeibctypes.NewCorrelationAttribute(liquidstakeibctypes.WorkflowDeposit, hc.ChainId, receipt.Epoch),
+		),
+	)
+
+	return receipt, true
+}
+
+// PruneDepositReceipts deletes all the receipts older than the configured retention period.
+func (k *Keeper) PruneDepositReceipts(ctx sdk.Context) {
+	cutoff := ctx.BlockTime().Add(-k.GetParams(ctx).DepositReceiptRetention)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositReceiptKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	// receipts are stored by increasing id, so they are also sorted by creation time
+	var expired []*liquidstakeibctypes.DepositReceipt
+	for ; iterator.Valid(); iterator.Next() {
+		receipt := liquidstakeibctypes.DepositReceipt{}
+		k.cdc.MustUnmarshal(iterator.Value(), &receipt)
+
+		if !receipt.CreatedAt.Before(cutoff) {
+			break
+		}
+
+		expired = append(expired, &receipt)
+	}
+
+	for _, receipt := range expired {
+		k.DeleteDepositReceipt(ctx, receipt)
+	}
+}
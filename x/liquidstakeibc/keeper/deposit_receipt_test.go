@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestGetSetDepositReceipt() {
+	suite.app.LiquidStakeIBCKeeper.SetDepositReceipt(suite.ctx, &types.DepositReceipt{Id: 7, ChainId: suite.chainB.ChainID})
+
+	receipt, found := suite.app.LiquidStakeIBCKeeper.GetDepositReceipt(suite.ctx, 7)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(suite.chainB.ChainID, receipt.ChainId)
+
+	_, found = suite.app.LiquidStakeIBCKeeper.GetDepositReceipt(suite.ctx, 8)
+	suite.Require().Equal(false, found)
+
+	suite.app.LiquidStakeIBCKeeper.DeleteDepositReceipt(suite.ctx, receipt)
+	suite.Require().Equal(0, len(suite.app.LiquidStakeIBCKeeper.GetAllDepositReceipts(suite.ctx)))
+}
+
+func (suite *IntegrationTestSuite) TestCreateDepositReceipt() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	delegator := suite.chainA.SenderAccount.GetAddress().String()
+	amount := sdk.NewInt64Coin(hc.HostDenom, 1000)
+	minted := sdk.NewInt64Coin(hc.MintDenom(), 990)
+
+	first, created := suite.app.LiquidStakeIBCKeeper.CreateDepositReceipt(suite.ctx, hc, delegator, amount, minted)
+	suite.Require().Equal(true, created)
+	second, created := suite.app.LiquidStakeIBCKeeper.CreateDepositReceipt(suite.ctx, hc, delegator, amount, minted)
+	suite.Require().Equal(true, created)
+
+	suite.Require().Equal(first.Id+1, second.Id)
+	suite.Require().Equal(hc.CValue, first.CValue)
+	suite.Require().Equal(amount, first.Amount)
+	suite.Require().Equal(minted, first.MintedAmount)
+
+	// receipts are not created when the retention is zero
+	params := suite.app.LiquidStakeIBCKeeper.GetParams(suite.ctx)
+	params.DepositReceiptRetention = 0
+	suite.app.LiquidStakeIBCKeeper.SetParams(suite.ctx, params)
+
+	_, created = suite.app.LiquidStakeIBCKeeper.CreateDepositReceipt(suite.ctx, hc, delegator, amount, minted)
+	suite.Require().Equal(false, created)
+}
+
+func (suite *IntegrationTestSuite) TestPruneDepositReceipts() {
+	params := suite.app.LiquidStakeIBCKeeper.GetParams(suite.ctx)
+	params.DepositReceiptRetention = time.Hour
+	suite.app.LiquidStakeIBCKeeper.SetParams(suite.ctx, params)
+
+	suite.app.LiquidStakeIBCKeeper.SetDepositReceipt(
+		suite.ctx,
+		&types.DepositReceipt{Id: 1, CreatedAt: suite.ctx.BlockTime().Add(-2 * time.Hour)},
+	)
+	suite.app.LiquidStakeIBCKeeper.SetDepositReceipt(
+		suite.ctx,
+		&types.DepositReceipt{Id: 2, CreatedAt: suite.ctx.BlockTime().Add(-time.Minute)},
+	)
+
+	suite.app.LiquidStakeIBCKeeper.PruneDepositReceipts(suite.ctx)
+
+	receipts := suite.app.LiquidStakeIBCKeeper.GetAllDepositReceipts(suite.ctx)
+	suite.Require().Equal(1, len(receipts))
+	suite.Require().Equal(uint64(2), receipts[0].Id)
+}
+
+func (suite *IntegrationTestSuite) TestDepositReceiptsDelegatorIndex() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+
+	delegator := authtypes.NewModuleAddress("receipt_delegator").String()
+	other := authtypes.NewModuleAddress("receipt_other").String()
+	for id := uint64(1); id <= 5; id++ {
+		owner := delegator
+		if id%2 == 0 {
+			owner = other
+		}
+		k.SetDepositReceipt(ctx, &types.DepositReceipt{Id: id, DelegatorAddress: owner, CreatedAt: ctx.BlockTime()})
+	}
+
+	receipts := k.GetDepositReceiptsForDelegator(ctx, delegator)
+	suite.Require().Len(receipts, 3)
+	suite.Require().Equal([]uint64{1, 3, 5}, []uint64{receipts[0].Id, receipts[1].Id, receipts[2].Id})
+
+	// the query only pages through the delegator receipts
+	res, err := k.DepositReceipts(ctx, &types.QueryDepositReceiptsRequest{
+		Address:    delegator,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.DepositReceipts, 2)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+	suite.Require().Equal(uint64(3), res.DepositReceipts[1].Id)
+
+	res, err = k.DepositReceipts(ctx, &types.QueryDepositReceiptsRequest{
+		Address:    delegator,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.DepositReceipts, 1)
+	suite.Require().Equal(uint64(5), res.DepositReceipts[0].Id)
+
+	// deleted and pruned receipts leave the index
+	k.DeleteDepositReceipt(ctx, receipts[0])
+	suite.Require().Len(k.GetDepositReceiptsForDelegator(ctx, delegator), 2)
+
+	params := k.GetParams(ctx)
+	params.DepositReceiptRetention = time.Hour
+	k.SetParams(ctx, params)
+	k.PruneDepositReceipts(ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour)))
+	suite.Require().Len(k.GetDepositReceiptsForDelegator(ctx, delegator), 0)
+	suite.Require().Len(k.GetDepositReceiptsForDelegator(ctx, other), 0)
+}
+
+func (suite *IntegrationTestSuite) TestMigrateDepositReceipts() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+
+	// upgraded chains have no receipt retention, no claim hold epochs and receipts stored without an index
+	params := k.GetParams(ctx)
+	params.DepositReceiptRetention = 0
+	params.ClaimHoldEpochs = 0
+	k.SetParams(ctx, params)
+
+	delegator := authtypes.NewModuleAddress("receipt_delegator").String()
+	receipt := &types.DepositReceipt{Id: 1, DelegatorAddress: delegator, CreatedAt: ctx.BlockTime()}
+	k.SetDepositReceipt(ctx, receipt)
+	ctx.KVStore(suite.app.GetKey(types.StoreKey)).Delete(
+		append(types.DepositReceiptDelegatorIndexKey, types.GetDepositReceiptDelegatorIndexKey(delegator, 1)...),
+	)
+	suite.Require().Len(k.GetDepositReceiptsForDelegator(ctx, delegator), 0)
+
+	suite.Require().NoError(keeper.NewMigrator(k).Migrate5to6(ctx))
+
+	suite.Require().Equal(types.DefaultDepositReceiptRetention, k.GetParams(ctx).DepositReceiptRetention)
+	suite.Require().Equal(types.DefaultClaimHoldEpochs, k.GetParams(ctx).ClaimHoldEpochs)
+	suite.Require().Len(k.GetDepositReceiptsForDelegator(ctx, delegator), 1)
+}
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	liquidstakeibctypes "github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (k *Keeper) SetDepositShortfall(ctx sdk.Context, shortfall *liquidstakeibctypes.DepositShortfall) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositShortfallKey)
+	bytes := k.cdc.MustMarshal(shortfall)
+	store.Set(liquidstakeibctypes.GetDepositShortfallStoreKey(shortfall.ChainId, shortfall.Epoch), bytes)
+}
+
+func (k *Keeper) GetDepositShortfall(
+	ctx sdk.Context,
+	chainID string,
+	epoch int64,
+) (*liquidstakeibctypes.DepositShortfall, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositShortfallKey)
+	bytes := store.Get(liquidstakeibctypes.GetDepositShortfallStoreKey(chainID, epoch))
+	if len(bytes) == 0 {
+		return &liquidstakeibctypes.DepositShortfall{}, false
+	}
+
+	var shortfall liquidstakeibctypes.DepositShortfall
+	k.cdc.MustUnmarshal(bytes, &shortfall)
+	return &shortfall, true
+}
+
+func (k *Keeper) GetAllDepositShortfalls(ctx sdk.Context) []*liquidstakeibctypes.DepositShortfall {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositShortfallKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	shortfalls := make([]*liquidstakeibctypes.DepositShortfall, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		shortfall := liquidstakeibctypes.DepositShortfall{}
+		k.cdc.MustUnmarshal(iterator.Value(), &shortfall)
+		shortfalls = append(shortfalls, &shortfall)
+	}
+
+	return shortfalls
+}
+
+func (k *Keeper) GetDepositShortfallsForHostChain(
+	ctx sdk.Context,
+	chainID string,
+) []*liquidstakeibctypes.DepositShortfall {
+	shortfalls := make([]*liquidstakeibctypes.DepositShortfall, 0)
+	for _, shortfall := range k.GetAllDepositShortfalls(ctx) {
+		if shortfall.ChainId == chainID {
+			shortfalls = append(shortfalls, shortfall)
+		}
+	}
+
+	return shortfalls
+}
+
+// GetDepositAvailableBalance returns the part of the deposit module account balance that backs the deposit. The balance
+// also holds the other pending and failed deposits of the host chain, the ones of the current epoch included, so these are
+// left out and a missing amount is only ever attributed to the deposit being checked.
+func (k *Keeper) GetDepositAvailableBalance(ctx sdk.Context, deposit *liquidstakeibctypes.Deposit) sdk.Coin {
+	available := k.bankKeeper.GetBalance(ctx, k.GetDepositModuleAccount(ctx).GetAddress(), deposit.Amount.Denom)
+	for _, other := range k.GetDepositsForHostChain(ctx, deposit.ChainId) {
+		if other.Epoch == deposit.Epoch || other.Amount.Denom != deposit.Amount.Denom {
+			continue
+		}
+		if other.State != liquidstakeibctypes.Deposit_DEPOSIT_PENDING &&
+			other.State != liquidstakeibctypes.Deposit_DEPOSIT_FAILED {
+			continue
+		}
+
+		if available.IsLT(other.Amount) {
+			return sdk.NewCoin(deposit.Amount.Denom, sdk.ZeroInt())
+		}
+		available = available.Sub(other.Amount)
+	}
+
+	return available
+}
+
+// SplitDepositShortfall reduces the deposit to the amount available in the deposit module account
+// and records the missing part as a shortfall for the deposit epoch.
+func (k *Keeper) SplitDepositShortfall(
+	ctx sdk.Context,
+	deposit *liquidstakeibctypes.Deposit,
+	available sdk.Coin,
+) *liquidstakeibctypes.DepositShortfall {
+	missing := deposit.Amount.Sub(available)
+
+	shortfall, found := k.GetDepositShortfall(ctx, deposit.ChainId, deposit.Epoch)
+	if found {
+		shortfall.Amount = shortfall.Amount.Add(missing)
+	} else {
+		shortfall = &liquidstakeibctypes.DepositShortfall{
+			ChainId: deposit.ChainId,
+			Epoch:   deposit.Epoch,
+			Amount:  missing,
+		}
+	}
+	k.SetDepositShortfall(ctx, shortfall)
+
+	deposit.Amount = available
+	k.SetDeposit(ctx, deposit)
+
+	k.Logger(ctx).Error(
+		"Deposit module account balance is lower than the deposit amount.",
+		"host_chain",
+		deposit.ChainId,
+		"epoch",
+		deposit.Epoch,
+		"shortfall",
+		missing,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			liquidstakeibctypes.EventTypeDepositShortfall,
+			sdk.NewAttribute(liquidstakeibctypes.AttributeChainID, deposit.ChainId),
+			sdk.NewAttribute(liquidstakeibctypes.AttributeEpoch, strconv.FormatInt(deposit.Epoch, 10)),
+			sdk.NewAttribute(liquidstakeibctypes.AttributeTotalEpochDepositAmount, available.String()),
+			sdk.NewAttribute(liquidstakeibctypes.AttributeDepositShortfallAmount, missing.String()),
+		),
+	)
+
+	return shortfall
+}
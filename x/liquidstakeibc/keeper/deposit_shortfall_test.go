@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestSplitDepositShortfall() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	deposit := &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  sdk.NewInt64Coin(hc.IBCDenom(), 1000),
+		Epoch:   5,
+		State:   types.Deposit_DEPOSIT_PENDING,
+	}
+	suite.app.LiquidStakeIBCKeeper.SetDeposit(suite.ctx, deposit)
+
+	suite.app.LiquidStakeIBCKeeper.SplitDepositShortfall(suite.ctx, deposit, sdk.NewInt64Coin(hc.IBCDenom(), 600))
+
+	stored, found := suite.app.LiquidStakeIBCKeeper.GetDepositForChainAndEpoch(suite.ctx, hc.ChainId, 5)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.IBCDenom(), 600), stored.Amount)
+
+	shortfall, found := suite.app.LiquidStakeIBCKeeper.GetDepositShortfall(suite.ctx, hc.ChainId, 5)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.IBCDenom(), 400), shortfall.Amount)
+
+	// a second shortfall for the same epoch accumulates on the existing record
+	suite.app.LiquidStakeIBCKeeper.SplitDepositShortfall(suite.ctx, stored, sdk.NewInt64Coin(hc.IBCDenom(), 500))
+
+	shortfall, found = suite.app.LiquidStakeIBCKeeper.GetDepositShortfall(suite.ctx, hc.ChainId, 5)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.IBCDenom(), 500), shortfall.Amount)
+	suite.Require().Equal(1, len(suite.app.LiquidStakeIBCKeeper.GetDepositShortfallsForHostChain(suite.ctx, hc.ChainId)))
+}
+
+func (suite *IntegrationTestSuite) TestDepositWorkflowShortfall() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	for _, deposit := range suite.app.LiquidStakeIBCKeeper.GetAllDeposits(suite.ctx) {
+		suite.app.LiquidStakeIBCKeeper.DeleteDeposit(suite.ctx, deposit)
+	}
+
+	// the deposit module account holds no tokens, so nothing can be sent
+	deposit := &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  sdk.NewInt64Coin(hc.IBCDenom(), 1000),
+		Epoch:   1,
+		State:   types.Deposit_DEPOSIT_PENDING,
+	}
+	suite.app.LiquidStakeIBCKeeper.SetDeposit(suite.ctx, deposit)
+
+	suite.app.LiquidStakeIBCKeeper.DepositWorkflow(suite.ctx, 1)
+
+	stored, found := suite.app.LiquidStakeIBCKeeper.GetDepositForChainAndEpoch(suite.ctx, hc.ChainId, 1)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(true, stored.Amount.IsZero())
+	suite.Require().Equal(types.Deposit_DEPOSIT_PENDING, stored.State)
+
+	shortfall, found := suite.app.LiquidStakeIBCKeeper.GetDepositShortfall(suite.ctx, hc.ChainId, 1)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.IBCDenom(), 1000), shortfall.Amount)
+}
+
+func (suite *IntegrationTestSuite) TestDepositShortfallOtherDeposits() {
+	k := suite.app.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+	ctx, _ := suite.ctx.CacheContext()
+
+	for _, deposit := range k.GetAllDeposits(ctx) {
+		k.DeleteDeposit(ctx, deposit)
+	}
+
+	// the deposit module account holds the current epoch deposit, but only part of the past epoch one
+	balance := sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), 1000))
+	suite.Require().NoError(suite.app.MintKeeper.MintCoins(ctx, balance))
+	suite.Require().NoError(
+		suite.app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.DepositModuleAccount, balance),
+	)
+	past := &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  sdk.NewInt64Coin(hc.IBCDenom(), 1000),
+		Epoch:   1,
+		State:   types.Deposit_DEPOSIT_PENDING,
+	}
+	current := &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  sdk.NewInt64Coin(hc.IBCDenom(), 800),
+		Epoch:   2,
+		State:   types.Deposit_DEPOSIT_PENDING,
+	}
+	k.SetDeposit(ctx, past)
+	k.SetDeposit(ctx, current)
+
+	suite.Require().Equal(sdk.NewInt64Coin(hc.IBCDenom(), 200), k.GetDepositAvailableBalance(ctx, past))
+	suite.Require().True(k.GetDepositAvailableBalance(ctx, current).IsZero())
+
+	// the missing amount is recorded against the past epoch, the current epoch deposit keeps its funds
+	k.DepositWorkflow(ctx, 1)
+
+	stored, found := k.GetDepositForChainAndEpoch(ctx, hc.ChainId, 1)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.IBCDenom(), 200), stored.Amount)
+
+	shortfall, found := k.GetDepositShortfall(ctx, hc.ChainId, 1)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.IBCDenom(), 800), shortfall.Amount)
+
+	_, found = k.GetDepositShortfall(ctx, hc.ChainId, 2)
+	suite.Require().Equal(false, found)
+	stored, found = k.GetDepositForChainAndEpoch(ctx, hc.ChainId, 2)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.IBCDenom(), 800), stored.Amount)
+	suite.Require().Equal(
+		sdk.NewInt64Coin(hc.IBCDenom(), 800),
+		suite.app.BankKeeper.GetBalance(ctx, k.GetDepositModuleAccount(ctx).GetAddress(), hc.IBCDenom()),
+	)
+}
@@ -3,6 +3,9 @@ package keeper_test
 import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
 )
@@ -75,9 +78,9 @@ func (suite *IntegrationTestSuite) TestRevertDepositState() {
 }
 
 func (suite *IntegrationTestSuite) TestTransactionSequenceID() {
-	sequenceID := suite.app.LiquidStakeIBCKeeper.GetTransactionSequenceID("channel-0", 1)
+	sequenceID := suite.app.LiquidStakeIBCKeeper.GetTransactionSequenceID("transfer", "channel-0", 1)
 
-	suite.Require().Equal("channel-0-sequence-1", sequenceID)
+	suite.Require().Equal("transfer/channel-0-sequence-1", sequenceID)
 }
 
 func (suite *IntegrationTestSuite) TestAdjustDepositsForRedemption() {
@@ -559,3 +562,191 @@ func (suite *IntegrationTestSuite) TestGetDepositAmountOnHostChain() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestDepositDeliveryRatio() {
+	k := suite.app.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	hc.Params.DepositDeliveryRatio = sdk.MustNewDecFromStr("0.98")
+	k.SetHostChain(suite.ctx, hc)
+	balance := hc.DelegationAccount.Balance
+
+	packet := channeltypes.Packet{
+		Sequence:      1,
+		SourcePort:    hc.PortId,
+		SourceChannel: hc.ChannelId,
+		Data: ibctransfertypes.NewFungibleTokenPacketData(
+			hc.IBCDenom(),
+			"1000",
+			authtypes.NewModuleAddress(types.DepositModuleAccount).String(),
+			hc.DelegationAccount.Address,
+			"",
+		).GetBytes(),
+	}
+	k.SetDeposit(suite.ctx, &types.Deposit{
+		ChainId:       hc.ChainId,
+		Amount:        sdk.NewInt64Coin(hc.IBCDenom(), 1000),
+		Epoch:         1,
+		State:         types.Deposit_DEPOSIT_SENT,
+		IbcSequenceId: k.GetTransactionSequenceID(packet.SourcePort, packet.SourceChannel, packet.Sequence),
+	})
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	suite.Require().NoError(
+		k.OnAcknowledgementIBCTransferPacket(suite.ctx, packet, ack.Acknowledgement(), nil, nil),
+	)
+
+	// only the expected delivered amount is credited and left for delegation
+	hc, _ = k.GetHostChain(suite.ctx, hc.ChainId)
+	suite.Require().Equal(balance.AddAmount(sdk.NewInt(980)), hc.DelegationAccount.Balance)
+	deposit, found := k.GetDepositForChainAndEpoch(suite.ctx, hc.ChainId, 1)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(types.Deposit_DEPOSIT_RECEIVED, deposit.State)
+	suite.Require().Equal(sdk.NewInt(980), deposit.Amount.Amount)
+}
+
+func (suite *IntegrationTestSuite) TestDepositTransferErrorAck() {
+	ctx, _ := suite.ctx.CacheContext()
+	k := suite.app.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+	balance := hc.DelegationAccount.Balance
+
+	packet := channeltypes.Packet{
+		Sequence:      7,
+		SourcePort:    hc.PortId,
+		SourceChannel: hc.ChannelId,
+		Data: ibctransfertypes.NewFungibleTokenPacketData(
+			hc.IBCDenom(),
+			"1000",
+			authtypes.NewModuleAddress(types.DepositModuleAccount).String(),
+			hc.DelegationAccount.Address,
+			"",
+		).GetBytes(),
+	}
+	sequenceID := k.GetTransactionSequenceID(packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	k.SetDeposit(ctx, &types.Deposit{
+		ChainId:       hc.ChainId,
+		Amount:        sdk.NewInt64Coin(hc.IBCDenom(), 1000),
+		Epoch:         1,
+		State:         types.Deposit_DEPOSIT_SENT,
+		IbcSequenceId: sequenceID,
+	})
+	k.SetLSMDeposit(ctx, &types.LSMDeposit{
+		ChainId:          hc.ChainId,
+		Amount:           sdk.OneInt(),
+		Shares:           sdk.OneDec(),
+		Denom:            "cosmosvaloper1/1",
+		IbcDenom:         "ibc/lsm",
+		DelegatorAddress: "persistence1delegator",
+		State:            types.LSMDeposit_DEPOSIT_SENT,
+		IbcSequenceId:    sequenceID,
+	})
+
+	ack := channeltypes.NewErrorAcknowledgement(ibctransfertypes.ErrReceiveDisabled)
+	suite.Require().NoError(
+		k.OnAcknowledgementIBCTransferPacket(ctx, packet, ack.Acknowledgement(), nil, nil),
+	)
+
+	// the deposit fails and the lsm deposit goes back to pending, the delegation account is not credited
+	deposit, found := k.GetDepositForChainAndEpoch(ctx, hc.ChainId, 1)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(types.Deposit_DEPOSIT_FAILED, deposit.State)
+	suite.Require().Equal("", deposit.IbcSequenceId)
+	lsmDeposit, found := k.GetLSMDeposit(ctx, hc.ChainId, "persistence1delegator", "cosmosvaloper1/1")
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(types.LSMDeposit_DEPOSIT_PENDING, lsmDeposit.State)
+	suite.Require().Equal("", lsmDeposit.IbcSequenceId)
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(balance, hc.DelegationAccount.Balance)
+
+	failed := make(map[string]bool)
+	for _, event := range ctx.EventManager().Events() {
+		for _, attr := range event.Attributes {
+			if attr.Key == types.AttributeKeyAckError {
+				suite.Require().Equal(ack.GetError(), attr.Value)
+				failed[event.Type] = true
+			}
+		}
+	}
+	suite.Require().True(failed[types.EventStakingDepositTransferFailed])
+	suite.Require().True(failed[types.EventLSMDepositTransferFailed])
+
+	// a repeated ack does not move the reverted deposits any further
+	suite.Require().NoError(
+		k.OnAcknowledgementIBCTransferPacket(ctx, packet, ack.Acknowledgement(), nil, nil),
+	)
+	deposit, _ = k.GetDepositForChainAndEpoch(ctx, hc.ChainId, 1)
+	suite.Require().Equal(types.Deposit_DEPOSIT_FAILED, deposit.State)
+}
+
+func (suite *IntegrationTestSuite) TestRetryFailedDeposits() {
+	pstakeApp := suite.app
+	ctx, _ := suite.ctx.CacheContext()
+	k := pstakeApp.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	params := k.GetParams(ctx)
+	params.MaxDepositRetries = 1
+	k.SetParams(ctx, params)
+
+	amount := sdk.NewInt64Coin(hc.IBCDenom(), 1000)
+	suite.Require().NoError(pstakeApp.BankKeeper.SendCoinsFromAccountToModule(
+		ctx,
+		suite.chainA.SenderAccount.GetAddress(),
+		types.DepositModuleAccount,
+		sdk.NewCoins(amount),
+	))
+	k.SetDeposit(ctx, &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  amount,
+		Epoch:   1,
+		State:   types.Deposit_DEPOSIT_FAILED,
+	})
+	suite.Require().Equal(amount.Amount, k.GetDepositAmountOnPersistence(ctx, hc.ChainId))
+
+	// the failed deposit is sent again and the retry is counted
+	k.RetryFailedDeposits(ctx)
+	deposit, found := k.GetDepositForChainAndEpoch(ctx, hc.ChainId, 1)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(types.Deposit_DEPOSIT_SENT, deposit.State)
+	suite.Require().Equal(uint64(1), deposit.Retries)
+	suite.Require().NotEmpty(deposit.IbcSequenceId)
+
+	// the retried transfer times out, the deposit has no retries left
+	channelID, sequence, err := k.ParseTransactionSequenceID(deposit.IbcSequenceId)
+	suite.Require().NoError(err)
+	packet := channeltypes.Packet{
+		Sequence:      sequence,
+		SourcePort:    ibctransfertypes.PortID,
+		SourceChannel: channelID,
+		Data: ibctransfertypes.NewFungibleTokenPacketData(
+			hc.IBCDenom(),
+			amount.Amount.String(),
+			authtypes.NewModuleAddress(types.DepositModuleAccount).String(),
+			hc.DelegationAccount.Address,
+			"",
+		).GetBytes(),
+	}
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(k.OnTimeoutIBCTransferPacket(ctx, packet, nil, nil))
+	deposit, _ = k.GetDepositForChainAndEpoch(ctx, hc.ChainId, 1)
+	suite.Require().Equal(types.Deposit_DEPOSIT_FAILED, deposit.State)
+	suite.Require().Equal("", deposit.IbcSequenceId)
+
+	exhausted := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeDepositRetriesExhausted {
+			exhausted = true
+		}
+	}
+	suite.Require().True(exhausted)
+
+	// it is left for a manual retry
+	k.RetryFailedDeposits(ctx)
+	deposit, _ = k.GetDepositForChainAndEpoch(ctx, hc.ChainId, 1)
+	suite.Require().Equal(types.Deposit_DEPOSIT_FAILED, deposit.State)
+	suite.Require().Equal(uint64(1), deposit.Retries)
+}
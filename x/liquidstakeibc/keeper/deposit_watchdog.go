@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	liquidstakeibctypes "github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// DepositWatchdog flags the deposits that have been sent or received for more delegation epochs than allowed,
+// which happens when the relayers are down or an acknowledgement was lost. Past the revert limit, sent deposits
+// whose transfer was refunded are moved back to pending, so they are sent again.
+func (k *Keeper) DepositWatchdog(ctx sdk.Context, epoch int64) {
+	params := k.GetParams(ctx)
+	if params.DepositAlertEpochs == 0 && params.DepositRevertEpochs == 0 {
+		return
+	}
+
+	for _, deposit := range k.GetAllDeposits(ctx) {
+		if deposit.State != liquidstakeibctypes.Deposit_DEPOSIT_SENT &&
+			deposit.State != liquidstakeibctypes.Deposit_DEPOSIT_RECEIVED {
+			continue
+		}
+
+		age := epoch - deposit.Epoch
+		if params.DepositRevertEpochs > 0 && age >= int64(params.DepositRevertEpochs) &&
+			deposit.State == liquidstakeibctypes.Deposit_DEPOSIT_SENT {
+			sequenceID := deposit.IbcSequenceId
+			if k.RevertStuckDeposit(ctx, deposit) {
+				k.Logger(ctx).Info(
+					"Reverted stuck deposit.",
+					"host_chain",
+					deposit.ChainId,
+					"epoch",
+					deposit.Epoch,
+					"sequence-id",
+					sequenceID,
+				)
+
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						liquidstakeibctypes.EventTypeDepositReverted,
+						sdk.NewAttribute(liquidstakeibctypes.AttributeChainID, deposit.ChainId),
+						sdk.NewAttribute(liquidstakeibctypes.AttributeEpoch, strconv.FormatInt(deposit.Epoch, 10)),
+						sdk.NewAttribute(liquidstakeibctypes.AttributeIBCSequenceID, sequenceID),
+						sdk.NewAttribute(liquidstakeibctypes.AttributeDepositAgeEpochs, strconv.FormatInt(age, 10)),
+					),
+				)
+				continue
+			}
+		}
+
+		if params.DepositAlertEpochs > 0 && age >= int64(params.DepositAlertEpochs) {
+			k.Logger(ctx).Error(
+				"Deposit is stuck.",
+				"host_chain",
+				deposit.ChainId,
+				"epoch",
+				deposit.Epoch,
+				"state",
+				deposit.State.String(),
+				"sequence-id",
+				deposit.IbcSequenceId,
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					liquidstakeibctypes.EventTypeDepositStuck,
+					sdk.NewAttribute(liquidstakeibctypes.AttributeChainID, deposit.ChainId),
+					sdk.NewAttribute(liquidstakeibctypes.AttributeEpoch, strconv.FormatInt(deposit.Epoch, 10)),
+					sdk.NewAttribute(liquidstakeibctypes.AttributeDepositState, deposit.State.String()),
+					sdk.NewAttribute(liquidstakeibctypes.AttributeIBCSequenceID, deposit.IbcSequenceId),
+					sdk.NewAttribute(liquidstakeibctypes.AttributeDepositAgeEpochs, strconv.FormatInt(age, 10)),
+				),
+			)
+		}
+	}
+}
+
+// RevertStuckDeposit moves a sent deposit back to pending once its transfer is no longer in flight and its amount
+// has been refunded to the deposit module account. It returns whether the deposit was reverted.
+func (k *Keeper) RevertStuckDeposit(ctx sdk.Context, deposit *liquidstakeibctypes.Deposit) bool {
+	// the transfer can still be acknowledged or timed out while its commitment exists
+	channelID, sequence, err := k.ParseTransactionSequenceID(deposit.IbcSequenceId)
+	if err == nil &&
+		k.ibcKeeper.ChannelKeeper.HasPacketCommitment(ctx, ibctransfertypes.PortID, channelID, sequence) {
+		return false
+	}
+
+	// the pending and failed deposits already account for part of the deposit module account balance
+	if k.GetDepositAvailableBalance(ctx, deposit).IsLT(deposit.Amount) {
+		return false
+	}
+
+	k.RevertDepositsState(ctx, []*liquidstakeibctypes.Deposit{deposit})
+	return true
+}
+
+// ParseTransactionSequenceID returns the channel and packet sequence of a transaction sequence id. Ids namespaced
+// by their port and ids stored before the port was part of them are both accepted.
+func (k *Keeper) ParseTransactionSequenceID(sequenceID string) (string, uint64, error) {
+	channelID, sequenceStr, found := strings.Cut(sequenceID, "-sequence-")
+	if !found {
+		return "", 0, fmt.Errorf("invalid transaction sequence id %s", sequenceID)
+	}
+	if _, namespacedChannelID, namespaced := strings.Cut(channelID, "/"); namespaced {
+		channelID = namespacedChannelID
+	}
+
+	sequence, err := strconv.ParseUint(sequenceStr, 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid transaction sequence id %s: %w", sequenceID, err)
+	}
+
+	return channelID, sequence, nil
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestDepositWatchdog() {
+	k := suite.app.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	for _, deposit := range k.GetAllDeposits(suite.ctx) {
+		k.DeleteDeposit(suite.ctx, deposit)
+	}
+
+	params := k.GetParams(suite.ctx)
+	params.DepositAlertEpochs = 2
+	params.DepositRevertEpochs = 3
+	k.SetParams(suite.ctx, params)
+
+	k.SetDeposit(suite.ctx, &types.Deposit{
+		ChainId:       hc.ChainId,
+		Amount:        sdk.NewInt64Coin(hc.IBCDenom(), 1000),
+		Epoch:         1,
+		State:         types.Deposit_DEPOSIT_SENT,
+		IbcSequenceId: "channel-0-sequence-999",
+	})
+	k.SetDeposit(suite.ctx, &types.Deposit{
+		ChainId:       hc.ChainId,
+		Amount:        sdk.NewInt64Coin(hc.IBCDenom(), 1000),
+		Epoch:         2,
+		State:         types.Deposit_DEPOSIT_RECEIVED,
+		IbcSequenceId: "channel-0-sequence-998",
+	})
+
+	countEvents := func(ctx sdk.Context, eventType string) int {
+		count := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == eventType {
+				count++
+			}
+		}
+		return count
+	}
+
+	// before the alert limit nothing is flagged
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	k.DepositWatchdog(ctx, 2)
+	suite.Require().Equal(0, countEvents(ctx, types.EventTypeDepositStuck))
+
+	// the sent deposit is flagged, but stays sent
+	ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	k.DepositWatchdog(ctx, 3)
+	suite.Require().Equal(1, countEvents(ctx, types.EventTypeDepositStuck))
+	deposit, _ := k.GetDepositForChainAndEpoch(ctx, hc.ChainId, 1)
+	suite.Require().Equal(types.Deposit_DEPOSIT_SENT, deposit.State)
+
+	// the transfer was not refunded to the deposit module account, so the deposit is not reverted
+	ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	k.DepositWatchdog(ctx, 4)
+	suite.Require().Equal(2, countEvents(ctx, types.EventTypeDepositStuck))
+	suite.Require().Equal(0, countEvents(ctx, types.EventTypeDepositReverted))
+	deposit, _ = k.GetDepositForChainAndEpoch(ctx, hc.ChainId, 1)
+	suite.Require().Equal(types.Deposit_DEPOSIT_SENT, deposit.State)
+
+	refund := sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), 1000))
+	suite.Require().NoError(suite.app.MintKeeper.MintCoins(suite.ctx, refund))
+	suite.Require().NoError(
+		suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, minttypes.ModuleName, types.DepositModuleAccount, refund),
+	)
+
+	// the transfer is still in flight while its packet commitment exists
+	inFlightCtx, _ := suite.ctx.CacheContext()
+	suite.app.IBCKeeper.ChannelKeeper.SetPacketCommitment(inFlightCtx, ibctransfertypes.PortID, "channel-0", 999, []byte("commitment"))
+	k.DepositWatchdog(inFlightCtx, 4)
+	suite.Require().Equal(0, countEvents(inFlightCtx, types.EventTypeDepositReverted))
+
+	// once refunded, the sent deposit is moved back to pending and the received one is only flagged
+	ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	k.DepositWatchdog(ctx, 4)
+	suite.Require().Equal(1, countEvents(ctx, types.EventTypeDepositReverted))
+	suite.Require().Equal(1, countEvents(ctx, types.EventTypeDepositStuck))
+
+	deposit, _ = k.GetDepositForChainAndEpoch(ctx, hc.ChainId, 1)
+	suite.Require().Equal(types.Deposit_DEPOSIT_PENDING, deposit.State)
+	suite.Require().Equal("", deposit.IbcSequenceId)
+	deposit, _ = k.GetDepositForChainAndEpoch(ctx, hc.ChainId, 2)
+	suite.Require().Equal(types.Deposit_DEPOSIT_RECEIVED, deposit.State)
+}
+
+func (suite *IntegrationTestSuite) TestParseTransactionSequenceID() {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	channelID, sequence, err := k.ParseTransactionSequenceID("channel-12-sequence-34")
+	suite.Require().NoError(err)
+	suite.Require().Equal("channel-12", channelID)
+	suite.Require().Equal(uint64(34), sequence)
+
+	channelID, sequence, err = k.ParseTransactionSequenceID("transfer/channel-12-sequence-35")
+	suite.Require().NoError(err)
+	suite.Require().Equal("channel-12", channelID)
+	suite.Require().Equal(uint64(35), sequence)
+
+	_, _, err = k.ParseTransactionSequenceID("channel-12")
+	suite.Require().Error(err)
+	_, _, err = k.ParseTransactionSequenceID("channel-12-sequence-abc")
+	suite.Require().Error(err)
+}
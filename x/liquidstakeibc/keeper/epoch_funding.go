@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// SetEpochFunding sets the funding of an unbonding epoch in the store
+func (k *Keeper) SetEpochFunding(ctx sdk.Context, funding *types.EpochFunding) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochFundingKey)
+	bytes := k.cdc.MustMarshal(funding)
+	store.Set(types.GetEpochFundingStoreKey(funding.ChainId, funding.EpochNumber), bytes)
+}
+
+// GetEpochFunding returns the funding of an unbonding epoch, not found until the epoch gets its first transfer
+func (k *Keeper) GetEpochFunding(ctx sdk.Context, chainID string, epochNumber int64) (*types.EpochFunding, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochFundingKey)
+	bz := store.Get(types.GetEpochFundingStoreKey(chainID, epochNumber))
+	if bz == nil {
+		return nil, false
+	}
+
+	var funding types.EpochFunding
+	k.cdc.MustUnmarshal(bz, &funding)
+	return &funding, true
+}
+
+// DeleteEpochFunding removes the funding of an unbonding epoch from the store
+func (k *Keeper) DeleteEpochFunding(ctx sdk.Context, chainID string, epochNumber int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochFundingKey)
+	store.Delete(types.GetEpochFundingStoreKey(chainID, epochNumber))
+}
+
+// GetEpochFundingsForHostChain returns the fundings of the unbonding epochs of a host chain, oldest epoch first
+func (k *Keeper) GetEpochFundingsForHostChain(ctx sdk.Context, chainID string) []*types.EpochFunding {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochFundingKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetEpochFundingChainPrefix(chainID))
+	defer iterator.Close()
+
+	fundings := make([]*types.EpochFunding, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		funding := types.EpochFunding{}
+		k.cdc.MustUnmarshal(iterator.Value(), &funding)
+		fundings = append(fundings, &funding)
+	}
+
+	return fundings
+}
+
+// AddEpochFunding records the part of an unbonding transfer allocated to an unbonding epoch
+func (k *Keeper) AddEpochFunding(
+	ctx sdk.Context,
+	chainID string,
+	epochNumber int64,
+	sequenceID string,
+	amount sdk.Coin,
+) {
+	funding, found := k.GetEpochFunding(ctx, chainID, epochNumber)
+	if !found {
+		funding = &types.EpochFunding{
+			ChainId:      chainID,
+			EpochNumber:  epochNumber,
+			FundedAmount: sdk.NewCoin(amount.Denom, sdk.ZeroInt()),
+		}
+	}
+
+	funding.FundedAmount = funding.FundedAmount.Add(amount)
+	funding.Transfers = append(funding.Transfers, types.EpochFundingTransfer{
+		SequenceId: sequenceID,
+		Amount:     amount,
+		Height:     ctx.BlockHeight(),
+	})
+	k.SetEpochFunding(ctx, funding)
+}
+
+// GetClaimHoldEpoch returns the oldest unbonding epoch of a host chain still waiting for its unbonding transfer. The
+// claims of the later epochs are held until it is funded, so that the claims are paid in epoch order even when the
+// transfers arrive out of order. An unbonding matured for more than the claim hold epochs no longer holds them, so a
+// transfer that never arrives can't block the later claims forever.
+func (k *Keeper) GetClaimHoldEpoch(ctx sdk.Context, chainID string) (int64, bool) {
+	holdEpochs := k.GetParams(ctx).ClaimHoldEpochs
+	holdDuration := time.Duration(holdEpochs) * k.epochsKeeper.GetEpochInfo(ctx, types.UndelegationEpoch).Duration
+
+	waiting := k.FilterUnbondings(ctx, func(u types.Unbonding) bool {
+		return u.ChainId == chainID &&
+			u.State == types.Unbonding_UNBONDING_MATURED &&
+			(holdEpochs == 0 || ctx.BlockTime().Before(u.MatureTime.Add(holdDuration)))
+	})
+	if len(waiting) == 0 {
+		return 0, false
+	}
+
+	holdEpoch := waiting[0].EpochNumber
+	for _, unbonding := range waiting[1:] {
+		if unbonding.EpochNumber < holdEpoch {
+			holdEpoch = unbonding.EpochNumber
+		}
+	}
+
+	return holdEpoch, true
+}
@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"strconv"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestEpochFundingClaimOrder() {
+	pstakeApp := suite.app
+	k := pstakeApp.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	delegator := authtypes.NewModuleAddress("claimer")
+
+	for _, epoch := range []int64{4, 8} {
+		k.SetUnbonding(ctx, &types.Unbonding{
+			ChainId:       hc.ChainId,
+			EpochNumber:   epoch,
+			BurnAmount:    sdk.NewInt64Coin(hc.MintDenom(), 1000),
+			UnbondAmount:  sdk.NewInt64Coin(hc.HostDenom, 1000),
+			MatureTime:    ctx.BlockTime(),
+			IbcSequenceId: "channel-0-sequence-" + strconv.FormatInt(epoch, 10),
+			State:         types.Unbonding_UNBONDING_MATURED,
+		})
+		k.SetUserUnbonding(ctx, &types.UserUnbonding{
+			ChainId:      hc.ChainId,
+			EpochNumber:  epoch,
+			Address:      delegator.String(),
+			StkAmount:    sdk.NewInt64Coin(hc.MintDenom(), 1000),
+			UnbondAmount: sdk.NewInt64Coin(hc.HostDenom, 1000),
+			AutoClaim:    true,
+		})
+	}
+	funds := sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), 2000))
+	suite.Require().NoError(pstakeApp.MintKeeper.MintCoins(ctx, funds))
+	suite.Require().NoError(pstakeApp.BankKeeper.SendCoinsFromModuleToModule(
+		ctx,
+		minttypes.ModuleName,
+		types.UndelegationModuleAccount,
+		funds,
+	))
+
+	receive := func(epoch int64) {
+		suite.Require().NoError(k.ReceiveHostChainTransfer(
+			ctx,
+			hc,
+			hc.DelegationAccount.Address,
+			k.GetUndelegationModuleAccount(ctx).GetAddress().String(),
+			sdk.NewInt(1000),
+			"channel-0-sequence-"+strconv.FormatInt(epoch, 10),
+		))
+	}
+	fundings := func() []*types.EpochFundingStatus {
+		res, err := k.EpochFundings(ctx, &types.QueryEpochFundingsRequest{ChainId: hc.ChainId})
+		suite.Require().NoError(err)
+		return res.Fundings
+	}
+
+	// the transfer of the later epoch arrives first, its claims wait for the earlier epoch
+	receive(8)
+	funding, found := k.GetEpochFunding(ctx, hc.ChainId, 8)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.HostDenom, 1000), funding.FundedAmount)
+	suite.Require().Equal("channel-0-sequence-8", funding.Transfers[0].SequenceId)
+
+	k.DoClaim(ctx, hc)
+	_, found = k.GetUserUnbonding(ctx, hc.ChainId, delegator.String(), 8)
+	suite.Require().True(found)
+	suite.Require().True(pstakeApp.BankKeeper.GetAllBalances(ctx, delegator).IsZero())
+
+	statuses := fundings()
+	suite.Require().Len(statuses, 2)
+	suite.Require().Equal(int64(4), statuses[0].EpochNumber)
+	suite.Require().Equal(types.Unbonding_UNBONDING_MATURED, statuses[0].State)
+	suite.Require().Nil(statuses[0].Funding)
+	suite.Require().False(statuses[0].Released)
+	suite.Require().Equal(types.Unbonding_UNBONDING_CLAIMABLE, statuses[1].State)
+	suite.Require().Equal(funding, statuses[1].Funding)
+	suite.Require().False(statuses[1].Released)
+
+	// once the earlier epoch is funded both are claimed
+	receive(4)
+	suite.Require().True(fundings()[1].Released)
+	k.DoClaim(ctx, hc)
+	for _, epoch := range []int64{4, 8} {
+		_, found = k.GetUserUnbonding(ctx, hc.ChainId, delegator.String(), epoch)
+		suite.Require().False(found)
+		_, found = k.GetEpochFunding(ctx, hc.ChainId, epoch)
+		suite.Require().False(found)
+	}
+	suite.Require().Equal(funds, pstakeApp.BankKeeper.GetAllBalances(ctx, delegator))
+	suite.Require().Empty(fundings())
+
+	_, err := k.EpochFundings(ctx, &types.QueryEpochFundingsRequest{ChainId: "not-a-chain"})
+	suite.Require().Error(err)
+}
+
+func (suite *IntegrationTestSuite) TestEpochFundingClaimHoldEpochs() {
+	pstakeApp := suite.app
+	k := pstakeApp.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	delegator := authtypes.NewModuleAddress("claimer")
+
+	// the transfer of the epoch 4 never arrives, the epoch 8 is funded
+	for epoch, state := range map[int64]types.Unbonding_UnbondingState{
+		4: types.Unbonding_UNBONDING_MATURED,
+		8: types.Unbonding_UNBONDING_CLAIMABLE,
+	} {
+		k.SetUnbonding(ctx, &types.Unbonding{
+			ChainId:      hc.ChainId,
+			EpochNumber:  epoch,
+			MatureTime:   ctx.BlockTime(),
+			BurnAmount:   sdk.NewInt64Coin(hc.MintDenom(), 1000),
+			UnbondAmount: sdk.NewInt64Coin(hc.HostDenom, 1000),
+			State:        state,
+		})
+	}
+	k.SetUserUnbonding(ctx, &types.UserUnbonding{
+		ChainId:      hc.ChainId,
+		EpochNumber:  8,
+		Address:      delegator.String(),
+		StkAmount:    sdk.NewInt64Coin(hc.MintDenom(), 1000),
+		UnbondAmount: sdk.NewInt64Coin(hc.HostDenom, 1000),
+	})
+	funds := sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), 1000))
+	suite.Require().NoError(pstakeApp.MintKeeper.MintCoins(ctx, funds))
+	suite.Require().NoError(pstakeApp.BankKeeper.SendCoinsFromModuleToModule(
+		ctx,
+		minttypes.ModuleName,
+		types.UndelegationModuleAccount,
+		funds,
+	))
+
+	// the claims are held, and reported as held, while the epoch 4 matured for less than the claim hold epochs
+	holdEpochs := k.GetParams(ctx).ClaimHoldEpochs
+	suite.Require().Equal(types.DefaultClaimHoldEpochs, holdEpochs)
+	epochDuration := pstakeApp.EpochsKeeper.GetEpochInfo(ctx, types.UndelegationEpoch).Duration
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(holdEpochs)*epochDuration - time.Second))
+
+	holdEpoch, held := k.GetClaimHoldEpoch(ctx, hc.ChainId)
+	suite.Require().True(held)
+	suite.Require().Equal(int64(4), holdEpoch)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.DoClaim(ctx, hc)
+	var heldEvent sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeClaimsHeld {
+			heldEvent = event
+		}
+	}
+	suite.Require().Equal(types.EventTypeClaimsHeld, heldEvent.Type)
+	suite.Require().Contains(heldEvent.Attributes, abci.EventAttribute{Key: types.AttributeClaimHoldEpoch, Value: "4"})
+	suite.Require().Contains(heldEvent.Attributes, abci.EventAttribute{Key: types.AttributeHeldUnbondings, Value: "1"})
+
+	_, err := msgServer.Claim(ctx, types.NewMsgClaim(delegator, hc.ChainId, 8))
+	suite.Require().ErrorIs(err, types.ErrUnbondingNotClaimable)
+
+	// past the claim hold epochs the epoch 4 no longer holds the later claims
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	_, held = k.GetClaimHoldEpoch(ctx, hc.ChainId)
+	suite.Require().False(held)
+
+	_, err = msgServer.Claim(ctx, types.NewMsgClaim(delegator, hc.ChainId, 8))
+	suite.Require().NoError(err)
+	suite.Require().Equal(funds, pstakeApp.BankKeeper.GetAllBalances(ctx, delegator))
+
+	// zero holds the claims until the transfer is received
+	params := k.GetParams(ctx)
+	params.ClaimHoldEpochs = 0
+	k.SetParams(ctx, params)
+	holdEpoch, held = k.GetClaimHoldEpoch(ctx, hc.ChainId)
+	suite.Require().True(held)
+	suite.Require().Equal(int64(4), holdEpoch)
+}
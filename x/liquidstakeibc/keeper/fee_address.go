@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// FlushPendingFees sends the protocol fees owed to the module fee address that are not sent yet to the current module
+// fee address: the deposit fees of the claimable pending mints and the liquidity incentives of the host chains without
+// an incentive address. Host chains with a fee address of their own are left out. Returns the fees the fee address
+// received.
+func (k *Keeper) FlushPendingFees(ctx sdk.Context) (sdk.Coins, error) {
+	feeAddress := k.GetParams(ctx).FeeAddress
+	recipient, err := sdk.AccAddressFromBech32(feeAddress)
+	if err != nil {
+		return nil, err
+	}
+	balance := k.bankKeeper.GetAllBalances(ctx, recipient)
+
+	for _, hc := range k.GetAllHostChains(ctx) {
+		if k.GetHostChainFeeAddress(ctx, hc) != feeAddress {
+			continue
+		}
+
+		// the fee is minted ahead of the claim, it already counts towards the stk supply as part of the pending mint
+		claimablePendingMints := k.FilterPendingMints(
+			ctx,
+			func(p types.PendingMint) bool {
+				return p.ChainId == hc.ChainId &&
+					p.State == types.PendingMint_PENDING_MINT_CLAIMABLE &&
+					p.FeeAmount.IsPositive()
+			},
+		)
+		for _, pendingMint := range claimablePendingMints {
+			fee := sdk.NewCoins(pendingMint.FeeAmount)
+			if err = k.bankKeeper.MintCoins(ctx, types.ModuleName, fee); err != nil {
+				return nil, err
+			}
+			if err = k.SendHostChainProtocolFee(ctx, hc, fee, types.ModuleName); err != nil {
+				return nil, err
+			}
+			k.AddToFeeReport(ctx, hc.ChainId, types.KeyDepositFee, pendingMint.FeeAmount)
+
+			pendingMint.FeeAmount = sdk.NewCoin(pendingMint.FeeAmount.Denom, sdk.ZeroInt())
+			k.SetPendingMint(ctx, pendingMint)
+		}
+
+		if hc.Params.LiquidityIncentiveAddress != "" {
+			continue
+		}
+		incentive, found := k.GetLiquidityIncentive(ctx, hc.ChainId)
+		if !found || incentive.Amount.IsZero() {
+			continue
+		}
+		err = k.bankKeeper.SendCoins(
+			ctx,
+			k.accountKeeper.GetModuleAccount(ctx, types.ModuleName).GetAddress(),
+			recipient,
+			incentive.Amount,
+		)
+		if err != nil {
+			return nil, err
+		}
+		k.DeleteLiquidityIncentive(ctx, hc.ChainId)
+	}
+
+	flushed := k.bankKeeper.GetAllBalances(ctx, recipient).Sub(balance...)
+	if !flushed.IsZero() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePendingFeesFlushed,
+				sdk.NewAttribute(types.AttributeFeeAddress, feeAddress),
+				sdk.NewAttribute(types.AttributeFlushedFees, flushed.String()),
+			),
+		)
+	}
+
+	return flushed, nil
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestSetFeeAddress() {
+	pstakeApp := suite.app
+	k := pstakeApp.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	suite.Require().Equal("", hc.Params.LiquidityIncentiveAddress)
+
+	feeAdmin := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	oldFeeAddress, err := sdk.AccAddressFromBech32(k.GetParams(ctx).FeeAddress)
+	suite.Require().NoError(err)
+	newFeeAddress := authtypes.NewModuleAddress("new_fee_address")
+
+	// a deposit fee waiting on the pending mint claim and a liquidity incentive streamed to the fee address
+	delegator := suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().String()
+	k.AddPendingMint(
+		ctx,
+		hc,
+		delegator,
+		1,
+		sdk.NewCoin(hc.HostDenom, sdk.NewInt(1000)),
+		sdk.NewCoin(hc.MintDenom(), sdk.NewInt(900)),
+		sdk.NewCoin(hc.MintDenom(), sdk.NewInt(100)),
+	)
+	k.SetPendingMintsClaimable(ctx, hc.ChainId, 1)
+	pendingMintAmount := k.GetPendingMintAmount(ctx, hc.ChainId)
+
+	incentive := sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), 50))
+	suite.Require().NoError(pstakeApp.MintKeeper.MintCoins(ctx, incentive))
+	suite.Require().NoError(
+		pstakeApp.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, incentive),
+	)
+	k.SetLiquidityIncentive(ctx, &types.LiquidityIncentive{ChainId: hc.ChainId, Amount: incentive})
+
+	// only governance can rotate the fee address while there is no fee admin
+	_, err = msgServer.SetFeeAddress(ctx, types.NewMsgSetFeeAddress(feeAdmin, newFeeAddress, true))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	params := k.GetParams(ctx)
+	params.FeeAdminAddress = feeAdmin.String()
+	k.SetParams(ctx, params)
+
+	oldBalance := pstakeApp.BankKeeper.GetAllBalances(ctx, oldFeeAddress)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := msgServer.SetFeeAddress(ctx, types.NewMsgSetFeeAddress(feeAdmin, newFeeAddress, true))
+	suite.Require().NoError(err)
+
+	// the accrued fees go to the old address, the pending mint keeps its stk amount owed to the delegator
+	flushed := sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), 100)).Add(incentive...)
+	suite.Require().Equal(flushed, res.FlushedFees)
+	suite.Require().Equal(oldBalance.Add(flushed...), pstakeApp.BankKeeper.GetAllBalances(ctx, oldFeeAddress))
+	suite.Require().Equal(newFeeAddress.String(), k.GetParams(ctx).FeeAddress)
+	suite.Require().Equal(pendingMintAmount.SubRaw(100), k.GetPendingMintAmount(ctx, hc.ChainId))
+	pendingMint, found := k.GetPendingMint(ctx, hc.ChainId, delegator, 1)
+	suite.Require().True(found)
+	suite.Require().True(pendingMint.FeeAmount.IsZero())
+	suite.Require().Equal(sdk.NewInt(900), pendingMint.MintAmount.Amount)
+	_, found = k.GetLiquidityIncentive(ctx, hc.ChainId)
+	suite.Require().False(found)
+
+	emitted := map[string]bool{}
+	for _, event := range ctx.EventManager().Events() {
+		emitted[event.Type] = true
+	}
+	suite.Require().True(emitted[types.EventTypeFeeAddressSet])
+	suite.Require().True(emitted[types.EventTypePendingFeesFlushed])
+
+	// without the flag the accrued fees follow the new address
+	k.AddPendingMint(
+		ctx,
+		hc,
+		delegator,
+		2,
+		sdk.NewCoin(hc.HostDenom, sdk.NewInt(300)),
+		sdk.NewCoin(hc.MintDenom(), sdk.NewInt(270)),
+		sdk.NewCoin(hc.MintDenom(), sdk.NewInt(30)),
+	)
+	k.SetPendingMintsClaimable(ctx, hc.ChainId, 2)
+
+	rotatedFeeAddress := authtypes.NewModuleAddress("rotated_fee_address")
+	res, err = msgServer.SetFeeAddress(ctx, types.NewMsgSetFeeAddress(feeAdmin, rotatedFeeAddress, false))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), 30)), res.FlushedFees)
+	suite.Require().Equal(res.FlushedFees, pstakeApp.BankKeeper.GetAllBalances(ctx, rotatedFeeAddress))
+	suite.Require().True(pstakeApp.BankKeeper.GetAllBalances(ctx, newFeeAddress).IsZero())
+}
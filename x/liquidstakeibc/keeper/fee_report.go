@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// SetFeeReport stores the protocol fees collected on a host chain
+func (k *Keeper) SetFeeReport(ctx sdk.Context, report *types.FeeReport) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeeReportKey)
+	bytes := k.cdc.MustMarshal(report)
+	store.Set([]byte(report.ChainId), bytes)
+}
+
+// GetFeeReport returns the protocol fees collected on a host chain
+func (k *Keeper) GetFeeReport(ctx sdk.Context, chainID string) (*types.FeeReport, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeeReportKey)
+	bytes := store.Get([]byte(chainID))
+	if len(bytes) == 0 {
+		return &types.FeeReport{
+			ChainId:        chainID,
+			DepositFees:    sdk.NewCoins(),
+			RestakeFees:    sdk.NewCoins(),
+			UnstakeFees:    sdk.NewCoins(),
+			RedemptionFees: sdk.NewCoins(),
+		}, false
+	}
+
+	var report types.FeeReport
+	k.cdc.MustUnmarshal(bytes, &report)
+	return &report, true
+}
+
+// AddToFeeReport adds a protocol fee to the fees collected on a host chain, in the denomination it was charged in
+func (k *Keeper) AddToFeeReport(ctx sdk.Context, chainID string, feeType string, fee sdk.Coin) {
+	if !fee.IsPositive() {
+		return
+	}
+
+	report, _ := k.GetFeeReport(ctx, chainID)
+	report.AddFee(feeType, fee)
+	k.SetFeeReport(ctx, report)
+}
+
+// FeeDenomInHost returns true if the fee type is collected in host tokens
+func (k *Keeper) FeeDenomInHost(ctx sdk.Context, feeType string) bool {
+	denominations := k.GetParams(ctx).FeeDenominations
+	switch feeType {
+	case types.KeyDepositFee:
+		return denominations.DepositFee.InHostDenom(false)
+	case types.KeyRestakeFee:
+		return denominations.RestakeFee.InHostDenom(true)
+	case types.KeyUnstakeFee:
+		return denominations.UnstakeFee.InHostDenom(false)
+	case types.KeyRedemptionFee:
+		return denominations.RedemptionFee.InHostDenom(false)
+	default:
+		return false
+	}
+}
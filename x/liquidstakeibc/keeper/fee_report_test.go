@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestHostDenomFees() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	for _, validator := range hc.Validators {
+		validator.DelegatedAmount = MinDeposit.MulRaw(1000)
+	}
+	k.SetHostChain(ctx, hc)
+
+	params := k.GetParams(ctx)
+	params.FeeDenominations = types.FeeDenominations{
+		DepositFee:    types.FEE_DENOMINATION_HOST,
+		UnstakeFee:    types.FEE_DENOMINATION_HOST,
+		RedemptionFee: types.FEE_DENOMINATION_HOST,
+	}
+	k.SetParams(ctx, params)
+
+	epoch := k.GetEpochNumber(ctx, types.DelegationEpoch)
+	k.SetDeposit(ctx, &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  sdk.NewCoin(hc.IBCDenom(), sdk.ZeroInt()),
+		Epoch:   epoch,
+		State:   types.Deposit_DEPOSIT_PENDING,
+	})
+
+	// the deposit fee is taken out of the deposit, the delegator receives the stk tokens of the rest
+	delegator := suite.chainA.SenderAccount.GetAddress()
+	amount := MinDeposit.MulRaw(1000)
+	suite.Require().NoError(
+		testutil.FundAccount(suite.app.BankKeeper, ctx, delegator, sdk.NewCoins(sdk.NewCoin(hc.IBCDenom(), amount))),
+	)
+	stkBalance := suite.app.BankKeeper.GetBalance(ctx, delegator, hc.MintDenom())
+	stakeRes, err := msgServer.LiquidStake(ctx, types.NewMsgLiquidStake(sdk.NewCoin(hc.IBCDenom(), amount), delegator))
+	suite.Require().NoError(err)
+
+	depositFee := hc.Params.DepositFee.MulInt(amount).TruncateInt()
+	suite.Require().Equal(types.NewFeesCharged(types.KeyDepositFee, sdk.NewCoin(hc.HostDenom, depositFee)), stakeRes.Fees)
+	suite.Require().Equal(hc.CValue, stakeRes.CValue)
+	deposit, _ := k.GetDepositForChainAndEpoch(ctx, hc.ChainId, epoch)
+	suite.Require().Equal(amount.Sub(depositFee), deposit.Amount.Amount)
+	suite.Require().Equal(
+		stkBalance.Amount.Add(sdk.NewDecFromInt(amount.Sub(depositFee)).Mul(hc.CValue).TruncateInt()),
+		suite.app.BankKeeper.GetBalance(ctx, delegator, hc.MintDenom()).Amount,
+	)
+
+	// the redemption fee is taken out of the redeemed tokens
+	feeAddress, err := sdk.AccAddressFromBech32(k.GetHostChainFeeAddress(ctx, hc))
+	suite.Require().NoError(err)
+	feeBalance := suite.app.BankKeeper.GetBalance(ctx, feeAddress, hc.IBCDenom())
+
+	redeemAmount := sdk.NewCoin(hc.MintDenom(), amount.QuoRaw(2))
+	redeemRes, err := msgServer.Redeem(ctx, types.NewMsgRedeem(redeemAmount, delegator))
+	suite.Require().NoError(err)
+
+	redeemToken := sdk.NewDecFromInt(redeemAmount.Amount).Quo(hc.CValue).TruncateInt()
+	redemptionFee := hc.Params.RedemptionFee.MulInt(redeemToken).TruncateInt()
+	suite.Require().Equal(types.NewFeesCharged(types.KeyRedemptionFee, sdk.NewCoin(hc.HostDenom, redemptionFee)), redeemRes.Fees)
+	suite.Require().Equal(hc.CValue, redeemRes.CValue)
+	suite.Require().Equal(
+		feeBalance.Amount.Add(redemptionFee),
+		suite.app.BankKeeper.GetBalance(ctx, feeAddress, hc.IBCDenom()).Amount,
+	)
+
+	// the unstake fee is unbonded for the fee address
+	stkAmount := sdk.NewCoin(hc.MintDenom(), amount)
+	suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, ctx, delegator, sdk.NewCoins(stkAmount)))
+	unstakeRes, err := msgServer.LiquidUnstake(ctx, types.NewMsgLiquidUnstake(stkAmount, delegator))
+	suite.Require().NoError(err)
+
+	unstakeFee := hc.Params.UnstakeFee.MulInt(stkAmount.Amount).TruncateInt()
+	unbondingEpoch := hc.CurrentUnbondingEpoch(k.GetEpochNumber(ctx, types.UndelegationEpoch))
+	feeUnbonding, found := k.GetUserUnbonding(ctx, hc.ChainId, feeAddress.String(), unbondingEpoch)
+	suite.Require().True(found)
+	suite.Require().Equal(unstakeFee, feeUnbonding.StkAmount.Amount)
+	suite.Require().True(feeUnbonding.AutoClaim)
+	unbonding, found := k.GetUserUnbonding(ctx, hc.ChainId, delegator.String(), unbondingEpoch)
+	suite.Require().True(found)
+	suite.Require().Equal(stkAmount.Amount.Sub(unstakeFee), unbonding.StkAmount.Amount)
+	suite.Require().False(unbonding.AutoClaim)
+	suite.Require().Equal(
+		types.NewFeesCharged(types.KeyUnstakeFee, sdk.NewCoin(hc.HostDenom, feeUnbonding.UnbondAmount.Amount)),
+		unstakeRes.Fees,
+	)
+	suite.Require().Equal(hc.CValue, unstakeRes.CValue)
+
+	// every fee is reported in host tokens
+	res, err := k.FeeReport(sdk.WrapSDKContext(ctx), &types.QueryFeeReportRequest{ChainId: hc.ChainId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(hc.IBCDenom(), depositFee)), res.Report.DepositFees)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(hc.IBCDenom(), redemptionFee)), res.Report.RedemptionFees)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(hc.IBCDenom(), feeUnbonding.UnbondAmount.Amount)), res.Report.UnstakeFees)
+	suite.Require().True(res.Report.RestakeFees.Empty())
+}
+
+func (suite *IntegrationTestSuite) TestStkDenomFees() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	k.SetDeposit(ctx, &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  sdk.NewCoin(hc.IBCDenom(), sdk.ZeroInt()),
+		Epoch:   k.GetEpochNumber(ctx, types.DelegationEpoch),
+		State:   types.Deposit_DEPOSIT_PENDING,
+	})
+
+	// by default the deposit fee is minted in stk tokens
+	delegator := suite.chainA.SenderAccount.GetAddress()
+	amount := MinDeposit.MulRaw(1000)
+	suite.Require().NoError(
+		testutil.FundAccount(suite.app.BankKeeper, ctx, delegator, sdk.NewCoins(sdk.NewCoin(hc.IBCDenom(), amount))),
+	)
+	res, err := msgServer.LiquidStake(ctx, types.NewMsgLiquidStake(sdk.NewCoin(hc.IBCDenom(), amount), delegator))
+	suite.Require().NoError(err)
+
+	mintAmount := sdk.NewDecFromInt(amount).Mul(hc.CValue).TruncateInt()
+	depositFee := hc.Params.DepositFee.MulInt(mintAmount).TruncateInt()
+	suite.Require().Equal(types.NewFeesCharged(types.KeyDepositFee, sdk.NewCoin(hc.MintDenom(), depositFee)), res.Fees)
+	suite.Require().Equal(hc.CValue, res.CValue)
+	report, found := k.GetFeeReport(ctx, hc.ChainId)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(hc.MintDenom(), depositFee)), report.DepositFees)
+
+	_, err = k.FeeReport(sdk.WrapSDKContext(ctx), &types.QueryFeeReportRequest{ChainId: "unknown"})
+	suite.Require().Error(err)
+}
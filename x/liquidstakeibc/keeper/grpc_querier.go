@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +12,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	liquidstaketypes "github.com/persistenceOne/pstake-native/v2/x/liquidstake/types"
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
 )
 
@@ -76,6 +78,94 @@ func (k *Keeper) Deposits(
 	return &types.QueryDepositsResponse{Deposits: k.GetDepositsForHostChain(ctx, hc.ChainId)}, nil
 }
 
+func (k *Keeper) DepositsByState(
+	goCtx context.Context,
+	request *types.QueryDepositsByStateRequest,
+) (*types.QueryDepositsByStateResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if _, ok := types.Deposit_DepositState_name[int32(request.State)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid deposit state %d", request.State)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if request.ChainId != "" {
+		if _, found := k.GetHostChain(ctx, request.ChainId); !found {
+			return nil, status.Errorf(codes.NotFound, "host chain %s not found", request.ChainId)
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	depositStore := prefix.NewStore(store, types.DepositKey)
+
+	var deposits []*types.Deposit
+	pageRes, err := query.FilteredPaginate(
+		depositStore,
+		request.Pagination,
+		func(key, value []byte, accumulate bool) (bool, error) {
+			var deposit types.Deposit
+			if err := k.cdc.Unmarshal(value, &deposit); err != nil {
+				return false, err
+			}
+
+			if deposit.State != request.State || (request.ChainId != "" && deposit.ChainId != request.ChainId) {
+				return false, nil
+			}
+
+			if accumulate {
+				deposits = append(deposits, &deposit)
+			}
+
+			return true, nil
+		})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDepositsByStateResponse{Deposits: deposits, Pagination: pageRes}, nil
+}
+
+func (k *Keeper) EpochFundings(
+	goCtx context.Context,
+	request *types.QueryEpochFundingsRequest,
+) (*types.QueryEpochFundingsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetHostChain(ctx, request.ChainId); !found {
+		return nil, status.Errorf(codes.NotFound, "host chain %s not found", request.ChainId)
+	}
+
+	unbondings := k.FilterUnbondings(ctx, func(u types.Unbonding) bool {
+		return u.ChainId == request.ChainId &&
+			(u.State == types.Unbonding_UNBONDING_MATURED || u.State == types.Unbonding_UNBONDING_CLAIMABLE)
+	})
+	sort.SliceStable(unbondings, func(i, j int) bool {
+		return unbondings[i].EpochNumber < unbondings[j].EpochNumber
+	})
+
+	holdEpoch, held := k.GetClaimHoldEpoch(ctx, request.ChainId)
+	fundings := make([]*types.EpochFundingStatus, 0, len(unbondings))
+	for _, unbonding := range unbondings {
+		funding, _ := k.GetEpochFunding(ctx, request.ChainId, unbonding.EpochNumber)
+		fundings = append(fundings, &types.EpochFundingStatus{
+			EpochNumber:  unbonding.EpochNumber,
+			State:        unbonding.State,
+			UnbondAmount: unbonding.UnbondAmount,
+			Funding:      funding,
+			Released: unbonding.State == types.Unbonding_UNBONDING_CLAIMABLE &&
+				(!held || unbonding.EpochNumber < holdEpoch),
+		})
+	}
+
+	return &types.QueryEpochFundingsResponse{Fundings: fundings}, nil
+}
+
 func (k *Keeper) LSMDeposits(
 	goCtx context.Context,
 	request *types.QueryLSMDepositsRequest,
@@ -169,12 +259,7 @@ func (k *Keeper) UserUnbondings(
 		return nil, sdkerrors.ErrKeyNotFound
 	}
 
-	userUnbondings := k.FilterUserUnbondings(
-		ctx,
-		func(u types.UserUnbonding) bool {
-			return u.Address == address.String()
-		},
-	)
+	userUnbondings := k.GetUserUnbondingsForDelegator(ctx, address.String())
 
 	return &types.QueryUserUnbondingsResponse{UserUnbondings: userUnbondings}, nil
 }
@@ -192,29 +277,29 @@ func (k *Keeper) HostChainUserUnbondings(
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// the epoch index of the host chain is paginated, so the pages are ordered by epoch and delegator
 	store := ctx.KVStore(k.storeKey)
-	userUnbondingStore := prefix.NewStore(store, types.UserUnbondingKey)
+	indexStore := prefix.NewStore(
+		prefix.NewStore(store, types.UserUnbondingEpochIndexKey),
+		types.GetUserUnbondingChainIndexPrefix(request.ChainId),
+	)
 
 	var userUnbondings []*types.UserUnbonding
-	pageRes, err := query.FilteredPaginate(
-		userUnbondingStore,
+	pageRes, err := query.Paginate(
+		indexStore,
 		request.Pagination,
-		func(key, value []byte, accumulate bool) (bool, error) {
-			if accumulate {
-				var uu types.UserUnbonding
-				if err := k.cdc.Unmarshal(value, &uu); err != nil {
-					return false, err
-				}
-
-				if uu.ChainId == request.ChainId {
-					userUnbondings = append(userUnbondings, &uu)
-					return true, nil
-				}
+		func(key, _ []byte) error {
+			if len(key) < 8 {
+				return status.Errorf(codes.Internal, "invalid user unbonding index key %X", key)
+			}
 
-				return false, nil
+			epochNumber := int64(sdk.BigEndianToUint64(key[:8]))
+			userUnbonding, found := k.GetUserUnbonding(ctx, request.ChainId, string(key[8:]), epochNumber)
+			if found {
+				userUnbondings = append(userUnbondings, userUnbonding)
 			}
 
-			return true, nil
+			return nil
 		})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -320,3 +405,583 @@ func (k *Keeper) RedelegationTx(goCtx context.Context, request *types.QueryRedel
 	})
 	return &types.QueryRedelegationTxResponse{RedelegationTx: redelTxs}, nil
 }
+
+func (k *Keeper) DepositReceipts(
+	goCtx context.Context,
+	request *types.QueryDepositReceiptsRequest,
+) (*types.QueryDepositReceiptsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if request.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	address, err := sdk.AccAddressFromBech32(request.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// only the delegator index entries are paginated, so the pages are ordered by receipt id
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(
+		prefix.NewStore(store, types.DepositReceiptDelegatorIndexKey),
+		types.GetDepositReceiptDelegatorIndexPrefix(address.String()),
+	)
+
+	var receipts []*types.DepositReceipt
+	pageRes, err := query.Paginate(
+		indexStore,
+		request.Pagination,
+		func(key, _ []byte) error {
+			if len(key) != 8 {
+				return status.Errorf(codes.Internal, "invalid deposit receipt index key %X", key)
+			}
+
+			receipt, found := k.GetDepositReceipt(ctx, sdk.BigEndianToUint64(key))
+			if found {
+				receipts = append(receipts, receipt)
+			}
+
+			return nil
+		})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDepositReceiptsResponse{DepositReceipts: receipts, Pagination: pageRes}, nil
+}
+
+func (k *Keeper) DepositReceipt(
+	goCtx context.Context,
+	request *types.QueryDepositReceiptRequest,
+) (*types.QueryDepositReceiptResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	receipt, found := k.GetDepositReceipt(ctx, request.Id)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryDepositReceiptResponse{DepositReceipt: *receipt}, nil
+}
+
+func (k *Keeper) DepositShortfalls(
+	goCtx context.Context,
+	request *types.QueryDepositShortfallsRequest,
+) (*types.QueryDepositShortfallsResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryDepositShortfallsResponse{Shortfalls: k.GetDepositShortfallsForHostChain(ctx, hc.ChainId)}, nil
+}
+
+func (k *Keeper) ValidatorExits(
+	goCtx context.Context,
+	request *types.QueryValidatorExitsRequest,
+) (*types.QueryValidatorExitsResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryValidatorExitsResponse{Exits: k.GetValidatorExitsForHostChain(ctx, hc.ChainId)}, nil
+}
+
+func (k *Keeper) PendingParamChanges(
+	goCtx context.Context,
+	request *types.QueryPendingParamChangesRequest,
+) (*types.QueryPendingParamChangesResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryPendingParamChangesResponse{Changes: k.GetPendingParamChangesForHostChain(ctx, hc.ChainId)}, nil
+}
+
+func (k *Keeper) ICATxRetries(
+	goCtx context.Context,
+	request *types.QueryICATxRetriesRequest,
+) (*types.QueryICATxRetriesResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryICATxRetriesResponse{Retries: k.GetICATxRetriesForHostChain(ctx, hc.ChainId)}, nil
+}
+
+func (k *Keeper) ValidatorDrains(
+	goCtx context.Context,
+	request *types.QueryValidatorDrainsRequest,
+) (*types.QueryValidatorDrainsResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryValidatorDrainsResponse{Drains: k.GetValidatorDrainsForHostChain(ctx, hc.ChainId)}, nil
+}
+
+func (k *Keeper) FeeReport(
+	goCtx context.Context,
+	request *types.QueryFeeReportRequest,
+) (*types.QueryFeeReportResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	report, _ := k.GetFeeReport(ctx, hc.ChainId)
+	return &types.QueryFeeReportResponse{Report: report}, nil
+}
+
+func (k *Keeper) UnbondingsByEpochRange(
+	goCtx context.Context,
+	request *types.QueryUnbondingsByEpochRangeRequest,
+) (*types.QueryUnbondingsByEpochRangeResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if request.StartEpoch > request.EndEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"start epoch %d cannot be after end epoch %d",
+			request.StartEpoch,
+			request.EndEpoch,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	unbondings := k.FilterUnbondings(
+		ctx,
+		func(u types.Unbonding) bool {
+			return u.ChainId == hc.ChainId && u.EpochNumber >= request.StartEpoch && u.EpochNumber <= request.EndEpoch
+		},
+	)
+
+	// one total per state, in the order of the unbonding lifecycle
+	totals := make([]types.UnbondingStateTotal, len(types.Unbonding_UnbondingState_name))
+	for i := range totals {
+		totals[i] = types.UnbondingStateTotal{
+			State:        types.Unbonding_UnbondingState(i),
+			BurnAmount:   sdk.NewCoin(hc.MintDenom(), sdk.ZeroInt()),
+			UnbondAmount: sdk.NewCoin(hc.HostDenom, sdk.ZeroInt()),
+		}
+	}
+	for _, unbonding := range unbondings {
+		total := &totals[unbonding.State]
+		total.Count++
+		total.BurnAmount.Amount = total.BurnAmount.Amount.Add(unbonding.BurnAmount.Amount)
+		total.UnbondAmount.Amount = total.UnbondAmount.Amount.Add(unbonding.UnbondAmount.Amount)
+	}
+
+	return &types.QueryUnbondingsByEpochRangeResponse{Unbondings: unbondings, Totals: totals}, nil
+}
+
+func (k *Keeper) ChannelMigration(
+	goCtx context.Context,
+	request *types.QueryChannelMigrationRequest,
+) (*types.QueryChannelMigrationResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	migration, found := k.GetChannelMigration(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	blockers := make([]string, 0)
+	if migration.State == types.ChannelMigration_MIGRATION_DRAINING {
+		blockers = k.ChannelMigrationBlockers(ctx, migration)
+	}
+
+	return &types.QueryChannelMigrationResponse{Migration: *migration, Blockers: blockers}, nil
+}
+
+func (k *Keeper) PendingMints(
+	goCtx context.Context,
+	request *types.QueryPendingMintsRequest,
+) (*types.QueryPendingMintsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	pendingMints := k.FilterPendingMints(
+		ctx,
+		func(p types.PendingMint) bool {
+			return p.ChainId == hc.ChainId
+		},
+	)
+
+	return &types.QueryPendingMintsResponse{PendingMints: pendingMints}, nil
+}
+
+func (k *Keeper) DelegatorPendingMints(
+	goCtx context.Context,
+	request *types.QueryDelegatorPendingMintsRequest,
+) (*types.QueryDelegatorPendingMintsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if request.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(request.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	pendingMints := k.FilterPendingMints(
+		ctx,
+		func(p types.PendingMint) bool {
+			return p.DelegatorAddress == address.String()
+		},
+	)
+
+	return &types.QueryDelegatorPendingMintsResponse{PendingMints: pendingMints}, nil
+}
+
+func (k *Keeper) ModuleAccounts(
+	goCtx context.Context,
+	request *types.QueryModuleAccountsRequest,
+) (*types.QueryModuleAccountsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	moduleAccounts := []struct {
+		name    string
+		address sdk.AccAddress
+	}{
+		{types.ModuleName, authtypes.NewModuleAddress(types.ModuleName)},
+		{types.DepositModuleAccount, authtypes.NewModuleAddress(types.DepositModuleAccount)},
+		{types.UndelegationModuleAccount, authtypes.NewModuleAddress(types.UndelegationModuleAccount)},
+		{liquidstaketypes.ModuleName, authtypes.NewModuleAddress(liquidstaketypes.ModuleName)},
+		{liquidstaketypes.ModuleName + "-LiquidStakeProxyAcc", liquidstaketypes.LiquidStakeProxyAcc},
+	}
+
+	accounts := make([]types.ModuleAccountBalance, 0, len(moduleAccounts))
+	for _, account := range moduleAccounts {
+		accounts = append(accounts, types.ModuleAccountBalance{
+			Name:     account.name,
+			Address:  account.address.String(),
+			Balances: k.bankKeeper.GetAllBalances(ctx, account.address),
+		})
+	}
+
+	return &types.QueryModuleAccountsResponse{Accounts: accounts}, nil
+}
+
+func (k *Keeper) RelayLatency(
+	goCtx context.Context,
+	request *types.QueryRelayLatencyRequest,
+) (*types.QueryRelayLatencyResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	latency, _ := k.GetRelayLatency(ctx, request.ConnectionId)
+
+	return &types.QueryRelayLatencyResponse{
+		Latency: *latency,
+		Timeout: k.GetIBCTimeout(ctx, request.ConnectionId),
+	}, nil
+}
+
+func (k *Keeper) DepositCapacity(
+	goCtx context.Context,
+	request *types.QueryDepositCapacityRequest,
+) (*types.QueryDepositCapacityResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	maxDepositAmount := sdk.ZeroInt()
+	if hc.Params.HasDepositCap() {
+		maxDepositAmount = hc.Params.MaxDepositAmount
+	}
+	remaining, _ := k.GetDepositCapacity(ctx, hc)
+
+	return &types.QueryDepositCapacityResponse{
+		MaxDepositAmount:   maxDepositAmount,
+		LiquidStakedAmount: k.GetHostChainLiquidStakedAmount(ctx, hc),
+		RemainingAmount:    remaining,
+	}, nil
+}
+
+func (k *Keeper) CValueHistory(
+	goCtx context.Context,
+	request *types.QueryCValueHistoryRequest,
+) (*types.QueryCValueHistoryResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryCValueHistoryResponse{Records: k.GetCValueRecords(ctx, request.ChainId)}, nil
+}
+
+func (k *Keeper) PendingProposals(
+	goCtx context.Context,
+	request *types.QueryPendingProposalsRequest,
+) (*types.QueryPendingProposalsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryPendingProposalsResponse{Proposals: k.GetPendingProposals(ctx, request.ChainId)}, nil
+}
+
+func (k *Keeper) ValidatorWeights(
+	goCtx context.Context,
+	request *types.QueryValidatorWeightsRequest,
+) (*types.QueryValidatorWeightsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	weights := make([]*types.ValidatorWeight, 0, len(hc.Validators))
+	for _, validator := range hc.Validators {
+		weights = append(weights, &types.ValidatorWeight{
+			OperatorAddress: validator.OperatorAddress,
+			Weight:          validator.Weight,
+		})
+	}
+	totalWeight := hc.GetTotalValidatorWeight()
+
+	// the host chain is not stored back, the normalization is only reported
+	hc.NormalizeValidatorWeights()
+	for i, validator := range hc.Validators {
+		weights[i].NormalizedWeight = validator.Weight
+	}
+
+	return &types.QueryValidatorWeightsResponse{Weights: weights, TotalWeight: totalWeight}, nil
+}
+
+func (k *Keeper) UnbondingCapacity(
+	goCtx context.Context,
+	request *types.QueryUnbondingCapacityRequest,
+) (*types.QueryUnbondingCapacityResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if request.Epochs > types.MaxUnbondingCapacityEpochs {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"can't report more than %d unbonding epochs",
+			types.MaxUnbondingCapacityEpochs,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	epochs := request.Epochs
+	if epochs == 0 {
+		epochs = types.DefaultUnbondingCapacityEpochs
+	}
+	schedule, total := k.GetUnbondingCapacity(ctx, hc, epochs)
+
+	return &types.QueryUnbondingCapacityResponse{
+		Epochs:        schedule,
+		TotalCapacity: total,
+		MaxEntries:    k.GetUnbondingMaxEntries(hc),
+	}, nil
+}
+
+func (k *Keeper) RebateProgram(
+	goCtx context.Context,
+	request *types.QueryRebateProgramRequest,
+) (*types.QueryRebateProgramResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	boostedValidators := hc.RebateBoostedValidators(hc.Validators)
+	validators := make([]types.RebateValidator, 0, len(hc.Validators))
+	for i, validator := range hc.Validators {
+		commissionRate := validator.CommissionRate
+		if commissionRate.IsNil() {
+			commissionRate = sdk.ZeroDec()
+		}
+		record, _ := k.GetRebateRecord(ctx, hc.ChainId, validator.OperatorAddress)
+
+		validators = append(validators, types.RebateValidator{
+			OperatorAddress: validator.OperatorAddress,
+			CommissionRate:  commissionRate,
+			Qualifies:       hc.QualifiesForRebate(validator),
+			Weight:          validator.Weight,
+			BoostedWeight:   boostedValidators[i].Weight,
+			DelegatedAmount: record.DelegatedAmount,
+		})
+	}
+
+	maxCommission, weightBoost := hc.Params.RebateMaxCommission, hc.Params.RebateWeightBoost
+	if maxCommission.IsNil() {
+		maxCommission = sdk.ZeroDec()
+	}
+	if weightBoost.IsNil() {
+		weightBoost = sdk.ZeroDec()
+	}
+
+	return &types.QueryRebateProgramResponse{
+		Active:        hc.IsRebateProgramActive(),
+		MaxCommission: maxCommission,
+		WeightBoost:   weightBoost,
+		Validators:    validators,
+	}, nil
+}
+
+func (k *Keeper) ValidatorScores(
+	goCtx context.Context,
+	request *types.QueryValidatorScoresRequest,
+) (*types.QueryValidatorScoresResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	var signedBlocksWindow int64
+	if hc.RiskParams != nil {
+		signedBlocksWindow = hc.RiskParams.SignedBlocksWindow
+	}
+
+	validators := make([]types.ScoredValidator, 0, len(hc.Validators))
+	for _, validator := range hc.Validators {
+		commissionRate := validator.CommissionRate
+		if commissionRate.IsNil() {
+			commissionRate = sdk.ZeroDec()
+		}
+		tokens := validator.Tokens
+		if tokens.IsNil() {
+			tokens = sdk.ZeroInt()
+		}
+
+		validators = append(validators, types.ScoredValidator{
+			OperatorAddress:     validator.OperatorAddress,
+			Score:               validator.Score,
+			MissedBlocksCounter: validator.MissedBlocksCounter,
+			SignedBlocksWindow:  signedBlocksWindow,
+			CommissionRate:      commissionRate,
+			Tokens:              tokens,
+		})
+	}
+
+	return &types.QueryValidatorScoresResponse{Validators: validators}, nil
+}
+
+func (k *Keeper) WorkflowFailures(
+	goCtx context.Context,
+	request *types.QueryWorkflowFailuresRequest,
+) (*types.QueryWorkflowFailuresResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryWorkflowFailuresResponse{Failures: k.GetAllWorkflowFailures(ctx)}, nil
+}
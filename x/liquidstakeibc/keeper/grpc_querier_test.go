@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -12,6 +13,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
 )
 
@@ -37,8 +39,17 @@ func (suite *IntegrationTestSuite) TestQueryParams() {
 			req:  &types.QueryParamsRequest{},
 			resp: &types.QueryParamsResponse{
 				Params: types.Params{
-					AdminAddress: "persistence1gztc3y3k52hjds5nqvl7h9jvfnc33spz47zcjy",
-					FeeAddress:   "persistence1gztc3y3k52hjds5nqvl7h9jvfnc33spz47zcjy",
+					AdminAddress:             "persistence1gztc3y3k52hjds5nqvl7h9jvfnc33spz47zcjy",
+					FeeAddress:               "persistence1gztc3y3k52hjds5nqvl7h9jvfnc33spz47zcjy",
+					DepositReceiptRetention:  types.DefaultDepositReceiptRetention,
+					DepositAlertEpochs:       types.DefaultDepositAlertEpochs,
+					MinIbcTimeout:            types.DefaultMinIBCTimeout,
+					MaxIbcTimeout:            types.DefaultMaxIBCTimeout,
+					MaxStkSupply:             sdktypes.ZeroInt(),
+					MaxDepositRetries:        types.DefaultMaxDepositRetries,
+					MaxIcaTxRetries:          types.DefaultMaxICATxRetries,
+					DelegationDriftTolerance: types.DefaultDelegationDriftTolerance,
+					ClaimHoldEpochs:          types.DefaultClaimHoldEpochs,
 				},
 			},
 		},
@@ -170,6 +181,74 @@ func (suite *IntegrationTestSuite) TestQueryDeposits() {
 	}
 }
 
+func (suite *IntegrationTestSuite) TestQueryDepositsByState() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+	for _, deposit := range k.GetAllDeposits(ctx) {
+		k.DeleteDeposit(ctx, deposit)
+	}
+
+	sent := make([]*types.Deposit, 0)
+	chainBSent := make([]*types.Deposit, 0)
+	for i := 0; i < MultipleTestSize; i += 1 {
+		chainID := suite.chainA.ChainID
+		if i%2 == 0 {
+			chainID = suite.chainB.ChainID
+		}
+		state := types.Deposit_DEPOSIT_PENDING
+		if i%3 != 0 {
+			state = types.Deposit_DEPOSIT_SENT
+		}
+
+		deposit := &types.Deposit{ChainId: chainID, Epoch: int64(i), State: state}
+		k.SetDeposit(ctx, deposit)
+		if state == types.Deposit_DEPOSIT_SENT {
+			sent = append(sent, deposit)
+			if chainID == suite.chainB.ChainID {
+				chainBSent = append(chainBSent, deposit)
+			}
+		}
+	}
+
+	// the deposits in the state are listed across host chains, page by page
+	listed := make([]*types.Deposit, 0)
+	var next []byte
+	for {
+		resp, err := k.DepositsByState(ctx, &types.QueryDepositsByStateRequest{
+			State:      types.Deposit_DEPOSIT_SENT,
+			Pagination: &query.PageRequest{Key: next, Limit: 2},
+		})
+		suite.Require().NoError(err)
+		suite.Require().LessOrEqual(len(resp.Deposits), 2)
+		listed = append(listed, resp.Deposits...)
+		if next = resp.Pagination.NextKey; next == nil {
+			break
+		}
+	}
+	suite.Require().ElementsMatch(sent, listed)
+
+	// or of a single host chain
+	resp, err := k.DepositsByState(ctx, &types.QueryDepositsByStateRequest{
+		State:      types.Deposit_DEPOSIT_SENT,
+		ChainId:    suite.chainB.ChainID,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(chainBSent, resp.Deposits)
+	suite.Require().Equal(uint64(len(chainBSent)), resp.Pagination.Total)
+
+	resp, err = k.DepositsByState(ctx, &types.QueryDepositsByStateRequest{State: types.Deposit_DEPOSIT_RECEIVED})
+	suite.Require().NoError(err)
+	suite.Require().Empty(resp.Deposits)
+
+	_, err = k.DepositsByState(ctx, &types.QueryDepositsByStateRequest{ChainId: "chain-1"})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+	_, err = k.DepositsByState(ctx, &types.QueryDepositsByStateRequest{State: types.Deposit_DepositState(100)})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+	_, err = k.DepositsByState(ctx, nil)
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
 func (suite *IntegrationTestSuite) TestQueryLSMDeposits() {
 	deposits := make([]*types.LSMDeposit, 0)
 	for i := 0; i < MultipleTestSize; i += 1 {
@@ -260,6 +339,61 @@ func (suite *IntegrationTestSuite) TestQueryUnbondings() {
 	}
 }
 
+func (suite *IntegrationTestSuite) TestQueryUnbondingsByEpochRange() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	states := []types.Unbonding_UnbondingState{
+		types.Unbonding_UNBONDING_INITIATED,
+		types.Unbonding_UNBONDING_CLAIMABLE,
+		types.Unbonding_UNBONDING_CLAIMABLE,
+		types.Unbonding_UNBONDING_FAILED,
+	}
+	unbondings := make([]*types.Unbonding, 0)
+	for i, state := range states {
+		unbonding := &types.Unbonding{
+			ChainId:      hc.ChainId,
+			EpochNumber:  int64(1000 + i),
+			BurnAmount:   sdktypes.NewInt64Coin(hc.MintDenom(), 100),
+			UnbondAmount: sdktypes.NewInt64Coin(hc.HostDenom, 110),
+			State:        state,
+		}
+		suite.app.LiquidStakeIBCKeeper.SetUnbonding(suite.ctx, unbonding)
+		unbondings = append(unbondings, unbonding)
+	}
+
+	resp, err := suite.app.LiquidStakeIBCKeeper.UnbondingsByEpochRange(
+		suite.ctx,
+		&types.QueryUnbondingsByEpochRangeRequest{ChainId: hc.ChainId, StartEpoch: 1001, EndEpoch: 1003},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(unbondings[1:], resp.Unbondings)
+	suite.Require().Len(resp.Totals, len(types.Unbonding_UnbondingState_name))
+
+	claimable := resp.Totals[types.Unbonding_UNBONDING_CLAIMABLE]
+	suite.Require().Equal(uint64(2), claimable.Count)
+	suite.Require().Equal(sdktypes.NewInt64Coin(hc.MintDenom(), 200), claimable.BurnAmount)
+	suite.Require().Equal(sdktypes.NewInt64Coin(hc.HostDenom, 220), claimable.UnbondAmount)
+	suite.Require().Equal(uint64(1), resp.Totals[types.Unbonding_UNBONDING_FAILED].Count)
+	suite.Require().Equal(uint64(0), resp.Totals[types.Unbonding_UNBONDING_INITIATED].Count)
+	suite.Require().Equal(sdktypes.NewInt64Coin(hc.HostDenom, 0), resp.Totals[types.Unbonding_UNBONDING_INITIATED].UnbondAmount)
+
+	_, err = suite.app.LiquidStakeIBCKeeper.UnbondingsByEpochRange(
+		suite.ctx,
+		&types.QueryUnbondingsByEpochRangeRequest{ChainId: hc.ChainId, StartEpoch: 1003, EndEpoch: 1001},
+	)
+	suite.Require().Error(err)
+
+	_, err = suite.app.LiquidStakeIBCKeeper.UnbondingsByEpochRange(
+		suite.ctx,
+		&types.QueryUnbondingsByEpochRangeRequest{ChainId: "chain-1", StartEpoch: 1001, EndEpoch: 1003},
+	)
+	suite.Require().ErrorIs(err, sdkerrors.ErrKeyNotFound)
+
+	_, err = suite.app.LiquidStakeIBCKeeper.UnbondingsByEpochRange(suite.ctx, nil)
+	suite.Require().Error(err)
+}
+
 func (suite *IntegrationTestSuite) TestQueryUserUnbondings() {
 	userUnbondings := make([]*types.UserUnbonding, 0)
 	for i := 0; i < MultipleTestSize; i += 1 {
@@ -618,3 +752,154 @@ func (suite *IntegrationTestSuite) TestQueryRedelegationTx() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestQueryModuleAccounts() {
+	ctx, _ := suite.ctx.CacheContext()
+	deposit := sdktypes.NewCoins(sdktypes.NewInt64Coin("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", 1000))
+	err := testutil.FundAccount(suite.app.BankKeeper, ctx, authtypes.NewModuleAddress(types.DepositModuleAccount), deposit)
+	suite.Require().NoError(err)
+
+	resp, err := suite.app.LiquidStakeIBCKeeper.ModuleAccounts(ctx, &types.QueryModuleAccountsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Accounts, 5)
+
+	for _, account := range resp.Accounts {
+		if account.Name == types.DepositModuleAccount {
+			suite.Require().Equal(authtypes.NewModuleAddress(types.DepositModuleAccount).String(), account.Address)
+			suite.Require().Equal(
+				suite.app.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.DepositModuleAccount)),
+				account.Balances,
+			)
+			suite.Require().True(account.Balances.IsAllGTE(deposit))
+		}
+	}
+
+	_, err = suite.app.LiquidStakeIBCKeeper.ModuleAccounts(ctx, nil)
+	suite.Require().Equal(status.Error(codes.InvalidArgument, "empty request"), err)
+}
+
+func (suite *IntegrationTestSuite) TestQueryValidatorWeights() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	// truncated weights no longer add up to one, as stored before the host chain writes were validated
+	drifted := sdktypes.OneDec().QuoTruncate(sdktypes.NewDec(int64(len(hc.Validators)) + 2))
+	for _, validator := range hc.Validators {
+		validator.Weight = drifted
+	}
+	k.ImportHostChain(ctx, hc)
+
+	resp, err := k.ValidatorWeights(ctx, &types.QueryValidatorWeightsRequest{ChainId: hc.ChainId})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Weights, len(hc.Validators))
+	suite.Require().Equal(drifted.MulInt64(int64(len(hc.Validators))), resp.TotalWeight)
+
+	normalizedTotal := sdktypes.ZeroDec()
+	for _, weight := range resp.Weights {
+		suite.Require().Equal(drifted, weight.Weight)
+		normalizedTotal = normalizedTotal.Add(weight.NormalizedWeight)
+	}
+	suite.Require().Equal(sdktypes.OneDec(), normalizedTotal)
+
+	// the query doesn't store the normalized weights
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(drifted.MulInt64(int64(len(hc.Validators))), hc.GetTotalValidatorWeight())
+
+	// a weight update normalizes the whole validator set
+	_, err = keeper.NewMsgServerImpl(k).UpdateHostChain(ctx, types.NewMsgUpdateHostChain(
+		hc.ChainId,
+		k.GetParams(ctx).AdminAddress,
+		[]*types.KVUpdate{{
+			Key:   types.KeyValidatorWeight,
+			Value: hc.Validators[0].OperatorAddress + "," + drifted.MulInt64(2).String(),
+		}},
+	))
+	suite.Require().NoError(err)
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(sdktypes.OneDec(), hc.GetTotalValidatorWeight())
+	suite.Require().True(hc.Validators[0].Weight.GT(hc.Validators[1].Weight))
+
+	_, err = k.ValidatorWeights(ctx, &types.QueryValidatorWeightsRequest{ChainId: "chain-1"})
+	suite.Require().Equal(sdkerrors.ErrKeyNotFound, err)
+	_, err = k.ValidatorWeights(ctx, nil)
+	suite.Require().Equal(status.Error(codes.InvalidArgument, "empty request"), err)
+}
+
+func (suite *IntegrationTestSuite) TestQueryUnbondingCapacity() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	suite.Require().Greater(len(hc.Validators), 1)
+
+	hc.Params.MaxEntries = 2
+	hc.RiskParams = &types.HostChainRiskParams{
+		UnbondingPeriod:         100 * 24 * time.Hour,
+		SlashFractionDoubleSign: sdktypes.ZeroDec(),
+		SlashFractionDowntime:   sdktypes.ZeroDec(),
+	}
+	for _, validator := range hc.Validators {
+		validator.DelegatedAmount = sdktypes.NewInt(1000)
+	}
+	k.SetHostChain(ctx, hc)
+
+	// an undelegation in flight holds an entry of every validator, an exit one more of the first validator
+	k.SetUnbonding(ctx, &types.Unbonding{
+		ChainId:      hc.ChainId,
+		EpochNumber:  1,
+		BurnAmount:   sdktypes.NewInt64Coin(hc.MintDenom(), 10),
+		UnbondAmount: sdktypes.NewInt64Coin(hc.HostDenom, 10),
+		State:        types.Unbonding_UNBONDING_INITIATED,
+	})
+	k.SetValidatorUnbonding(ctx, &types.ValidatorUnbonding{
+		ChainId:          hc.ChainId,
+		EpochNumber:      1,
+		ValidatorAddress: hc.Validators[0].OperatorAddress,
+		Amount:           sdktypes.NewInt64Coin(hc.HostDenom, 10),
+	})
+
+	nextEpoch := hc.CurrentUnbondingEpoch(k.GetEpochNumber(ctx, types.UndelegationEpoch))
+	k.SetUnbonding(ctx, &types.Unbonding{
+		ChainId:      hc.ChainId,
+		EpochNumber:  nextEpoch,
+		BurnAmount:   sdktypes.NewInt64Coin(hc.MintDenom(), 500),
+		UnbondAmount: sdktypes.NewInt64Coin(hc.HostDenom, 500),
+		State:        types.Unbonding_UNBONDING_PENDING,
+	})
+
+	resp, err := k.UnbondingCapacity(ctx, &types.QueryUnbondingCapacityRequest{ChainId: hc.ChainId})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Epochs, int(types.DefaultUnbondingCapacityEpochs))
+	suite.Require().Equal(uint32(2), resp.MaxEntries)
+
+	// the first validator has no entry left, the others use their last one in the next unbonding epoch
+	others := int64(len(hc.Validators) - 1)
+	first := resp.Epochs[0]
+	suite.Require().Equal(nextEpoch, first.EpochNumber)
+	suite.Require().Equal(uint32(others), first.AvailableValidators)
+	suite.Require().Equal(sdktypes.NewInt(500), first.QueuedAmount)
+	suite.Require().Equal(sdktypes.NewInt(1000*others-500), first.Capacity)
+	for i, epoch := range resp.Epochs[1:] {
+		suite.Require().Equal(nextEpoch+int64(i+1)*hc.UnbondingFactor, epoch.EpochNumber)
+		suite.Require().True(epoch.Time.After(first.Time))
+		suite.Require().Zero(epoch.AvailableValidators)
+		suite.Require().True(epoch.Capacity.IsZero())
+	}
+	suite.Require().Equal(sdktypes.NewInt(1000*others-500), resp.TotalCapacity)
+
+	_, err = k.UnbondingCapacity(ctx, &types.QueryUnbondingCapacityRequest{
+		ChainId: hc.ChainId,
+		Epochs:  types.MaxUnbondingCapacityEpochs + 1,
+	})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = k.UnbondingCapacity(ctx, &types.QueryUnbondingCapacityRequest{ChainId: "chain-1"})
+	suite.Require().ErrorIs(err, sdkerrors.ErrKeyNotFound)
+
+	_, err = k.UnbondingCapacity(ctx, nil)
+	suite.Require().Error(err)
+}
@@ -4,16 +4,15 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
-	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
-	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/cometbft/cometbft/libs/log"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/gogoproto/proto"
 	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
@@ -87,24 +86,53 @@ func (k *Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epoch
 	// create a batch of user deposits for the new deposit epoch
 	if epochIdentifier == liquidstakeibctypes.DelegationEpoch {
 		k.CreateDeposits(ctx, epochNumber)
+
+		// remove the deposit receipts past their retention period
+		k.PruneDepositReceipts(ctx)
 	}
 
 	// update the c value for each registered host chain
 	if epochIdentifier == liquidstakeibctypes.CValueEpoch {
 		k.UpdateCValues(ctx)
+
+		// slashes are applied to the c value once the delegations reported by the host chains are verified
+		k.QueryHostChainsDelegations(ctx)
 	}
 
 	return nil
 }
 
 func (k *Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	// make sure the host chain channels are open before the workflows dispatch any packets
+	if epochIdentifier == liquidstakeibctypes.DelegationEpoch ||
+		epochIdentifier == liquidstakeibctypes.UndelegationEpoch ||
+		epochIdentifier == liquidstakeibctypes.RewardsEpochIdentifier ||
+		epochIdentifier == liquidstakeibctypes.RedelegationEpochIdentifer {
+		for _, hc := range k.GetAllHostChains(ctx) {
+			if hc.IsActive() {
+				k.UpdateHostChainDegradedState(ctx, hc)
+			}
+		}
+	}
+
 	if epochIdentifier == liquidstakeibctypes.DelegationEpoch {
+		// reverted deposits are sent again by the deposit workflow
+		k.DepositWatchdog(ctx, epochNumber)
+
 		k.DepositWorkflow(ctx, epochNumber)
 
-		k.LSMWorkflow(ctx)
+		k.LSMWorkflow(ctx, epochNumber)
+
+		// switch the migrating host chains once the workflows stopped using their old channel
+		k.ProcessChannelMigrations(ctx, epochNumber)
+
+		k.StreamLiquidityIncentives(ctx)
 	}
 
 	if epochIdentifier == liquidstakeibctypes.UndelegationEpoch {
+		// keep the host chains unbonding periods and slash fractions up to date
+		k.RefreshHostChainsRiskParams(ctx)
+
 		// attempt to fully undelegate any validators that have been more than
 		// UnbondingStateEpochLimit epochs in UNBONDING state
 		k.ValidatorUndelegationWorkflow(ctx, epochNumber)
@@ -113,11 +141,25 @@ func (k *Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNum
 	}
 
 	if epochIdentifier == liquidstakeibctypes.RewardsEpochIdentifier {
+		// the rewards are only withdrawn to the rewards account if it is the withdraw address
+		k.VerifyHostChainsWithdrawAddresses(ctx)
+
 		k.RewardsWorkflow(ctx, epochNumber)
+
+		k.ScoreValidatorsWorkflow(ctx, epochNumber)
 	}
 
 	if epochIdentifier == liquidstakeibctypes.RedelegationEpochIdentifer {
+		k.ScoreWeightsWorkflow(ctx, epochNumber)
+
 		k.RebalanceWorkflow(ctx, epochNumber)
+
+		k.DrainZeroWeightValidatorsWorkflow(ctx, epochNumber)
+	}
+
+	if epochIdentifier == liquidstakeibctypes.ReconciliationEpoch {
+		// the delegations changed by the workflows above are left alone until their changes are acknowledged
+		k.ReconcileDelegationsWorkflow(ctx, epochNumber)
 	}
 
 	return nil
@@ -157,35 +199,93 @@ func (k *Keeper) OnRecvIBCTransferPacket(
 		return nil
 	}
 
+	// the transfer memo asks for the tokens to be liquid staked
+	if data.Memo != "" {
+		return k.AutopilotLiquidStake(ctx, packet, data, hc)
+	}
+
+	transferAmount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return errorsmod.Wrapf(
+			liquidstakeibctypes.ErrParsingAmount,
+			"could not parse transfer amount %s",
+			data.Amount,
+		)
+	}
+
+	return k.ReceiveHostChainTransfer(
+		ctx,
+		hc,
+		data.GetSender(),
+		data.GetReceiver(),
+		transferAmount,
+		k.GetTransactionSequenceID(packet.DestinationPort, packet.DestinationChannel, packet.Sequence),
+	)
+}
+
+// ReceiveHostChainTransfer settles the host tokens the interchain accounts of a host chain sent to the module
+// accounts: matured undelegations, total validator undelegations and auto-compounded rewards. The sequence id is the
+// id of the transfer, or of the ICA transaction for the bank sends of localhost host chains.
+func (k *Keeper) ReceiveHostChainTransfer(
+	ctx sdk.Context,
+	hc *liquidstakeibctypes.HostChain,
+	sender string,
+	receiver string,
+	transferAmount math.Int,
+	sequenceID string,
+) error {
 	// the transfer is part of the undelegation process
-	if data.GetSender() == hc.DelegationAccount.Address &&
-		data.GetReceiver() == k.GetUndelegationModuleAccount(ctx).GetAddress().String() &&
-		data.Memo == "" {
+	if sender == hc.DelegationAccount.Address &&
+		receiver == k.GetUndelegationModuleAccount(ctx).GetAddress().String() {
 		k.Logger(ctx).Info(
-			"Received unbonding IBC transfer.",
+			"Received unbonding transfer.",
 			"host chain",
 			hc.ChainId,
-			"sequence",
-			packet.Sequence,
-			"port",
-			packet.DestinationPort,
-			"channel",
-			packet.DestinationChannel,
+			"sequence-id",
+			sequenceID,
 		)
 
-		// get all the unbondings for that ibc sequence id
+		// get the matured unbondings the transfer was sent for
 		unbondings := k.FilterUnbondings(
 			ctx,
 			func(u liquidstakeibctypes.Unbonding) bool {
-				return u.ChainId == hc.ChainId && u.State == liquidstakeibctypes.Unbonding_UNBONDING_MATURED
+				return u.ChainId == hc.ChainId &&
+					u.State == liquidstakeibctypes.Unbonding_UNBONDING_MATURED &&
+					u.IbcSequenceId == sequenceID
 			},
 		)
 
-		// update the unbonding states
+		// the transfer can arrive before the ICA ack that tags the unbondings with its sequence
+		exclusive := len(unbondings) > 0
+		if !exclusive {
+			unbondings = k.FilterUnbondings(
+				ctx,
+				func(u liquidstakeibctypes.Unbonding) bool {
+					return u.ChainId == hc.ChainId && u.State == liquidstakeibctypes.Unbonding_UNBONDING_MATURED
+				},
+			)
+		}
+
+		// split the transfer across the unbondings it covers
+		unbondings, remaining := k.AllocateUnbondingTransfer(ctx, unbondings, transferAmount, exclusive)
+		if remaining.IsPositive() {
+			k.Logger(ctx).Info(
+				"Unbonding transfer was not fully allocated.",
+				"host chain",
+				hc.ChainId,
+				"remaining",
+				remaining,
+			)
+		}
+
 		for _, unbonding := range unbondings {
-			unbonding.IbcSequenceId = ""
-			unbonding.State = liquidstakeibctypes.Unbonding_UNBONDING_CLAIMABLE
-			k.SetUnbonding(ctx, unbonding)
+			k.AddEpochFunding(
+				ctx,
+				hc.ChainId,
+				unbonding.EpochNumber,
+				sequenceID,
+				sdk.NewCoin(hc.HostDenom, unbonding.UnbondAmount.Amount),
+			)
 
 			// emit event for the received transfer
 			ctx.EventManager().EmitEvent(
@@ -196,23 +296,28 @@ func (k *Keeper) OnRecvIBCTransferPacket(
 					sdk.NewAttribute(liquidstakeibctypes.AttributeUnbondingMaturedAmount, sdk.NewCoin(hc.HostDenom, unbonding.UnbondAmount.Amount).String()),
 				),
 			)
+
+			// notify the callback contract about the received unbonding
+			k.ExecuteContractCallback(
+				ctx,
+				liquidstakeibctypes.NewUnbondingReceivedCallback(
+					hc.ChainId,
+					sdk.NewCoin(hc.HostDenom, unbonding.UnbondAmount.Amount),
+					unbonding.EpochNumber,
+				),
+			)
 		}
 	}
 
 	// the transfer is part of a total validator unbonding
-	if data.GetSender() == hc.DelegationAccount.Address &&
-		data.GetReceiver() == k.GetDepositModuleAccount(ctx).GetAddress().String() &&
-		data.Memo == "" {
+	if sender == hc.DelegationAccount.Address &&
+		receiver == k.GetDepositModuleAccount(ctx).GetAddress().String() {
 		k.Logger(ctx).Info(
-			"Received total validator unbonding IBC transfer.",
+			"Received total validator unbonding transfer.",
 			"host chain",
 			hc.ChainId,
-			"sequence",
-			packet.Sequence,
-			"port",
-			packet.DestinationPort,
-			"channel",
-			packet.DestinationChannel,
+			"sequence-id",
+			sequenceID,
 		)
 
 		// add the unbonded amount to the deposit record for that chain/epoch
@@ -227,15 +332,6 @@ func (k *Keeper) OnRecvIBCTransferPacket(
 			)
 		}
 
-		transferAmount, ok := sdk.NewIntFromString(data.Amount)
-		if !ok {
-			return errorsmod.Wrapf(
-				liquidstakeibctypes.ErrParsingAmount,
-				"could not parse transfer amount %s",
-				data.Amount,
-			)
-		}
-
 		deposit.Amount.Amount = deposit.Amount.Amount.Add(transferAmount)
 		k.SetDeposit(ctx, deposit)
 
@@ -249,49 +345,65 @@ func (k *Keeper) OnRecvIBCTransferPacket(
 	}
 
 	// the transfer is part of the autocompounding process
-	if data.GetSender() == hc.RewardsAccount.Address &&
-		data.GetReceiver() == k.GetDepositModuleAccount(ctx).GetAddress().String() &&
-		data.Memo == "" {
+	if sender == hc.RewardsAccount.Address &&
+		receiver == k.GetDepositModuleAccount(ctx).GetAddress().String() {
 		k.Logger(ctx).Info(
-			"Received autocompounding IBC transfer.",
+			"Received autocompounding transfer.",
 			"host chain",
 			hc.ChainId,
-			"sequence",
-			packet.Sequence,
-			"port",
-			packet.DestinationPort,
-			"channel",
-			packet.DestinationChannel,
+			"sequence-id",
+			sequenceID,
 		)
 
-		// parse the transfer amount
-		transferAmount, ok := sdk.NewIntFromString(data.Amount)
-		if !ok {
-			return errorsmod.Wrapf(
-				liquidstakeibctypes.ErrParsingAmount,
-				"could not parse transfer amount %s",
-				data.Amount,
-			)
-		}
-
 		// calculate protocol fee
 		feeAmount := hc.Params.RestakeFee.MulInt(transferAmount)
 		fee, _ := sdk.NewDecCoinFromDec(hc.IBCDenom(), feeAmount).TruncateDecimal()
+		eventFee := sdk.NewCoin(hc.HostDenom, fee.Amount)
+		restakeAmount := transferAmount.Sub(fee.Amount)
 
-		// send the protocol fee
-		err := k.SendProtocolFee(
-			ctx,
-			sdk.NewCoins(fee),
-			liquidstakeibctypes.DepositModuleAccount,
-			k.GetParams(ctx).FeeAddress,
-		)
-		if err != nil {
-			return errorsmod.Wrapf(
-				liquidstakeibctypes.ErrFailedDeposit,
-				"failed to send restake fee to module fee address %s: %s",
-				k.GetParams(ctx).FeeAddress,
-				err.Error(),
+		feeAddress := k.GetHostChainFeeAddress(ctx, hc)
+		if k.FeeDenomInHost(ctx, liquidstakeibctypes.KeyRestakeFee) {
+			// send the protocol fee
+			err := k.SendHostChainProtocolFee(
+				ctx,
+				hc,
+				sdk.NewCoins(fee),
+				liquidstakeibctypes.DepositModuleAccount,
 			)
+			if err != nil {
+				return errorsmod.Wrapf(
+					liquidstakeibctypes.ErrFailedDeposit,
+					"failed to send restake fee to module fee address %s: %s",
+					feeAddress,
+					err.Error(),
+				)
+			}
+			k.AddToFeeReport(ctx, hc.ChainId, liquidstakeibctypes.KeyRestakeFee, fee)
+		} else {
+			// the rewards are restaked whole, and the fee is minted as the stk tokens its host tokens are worth
+			stkFee, _ := sdk.NewDecCoinFromDec(hc.MintDenom(), sdk.NewDecFromInt(fee.Amount).Mul(hc.CValue)).TruncateDecimal()
+			if stkFee.IsPositive() {
+				if err := k.bankKeeper.MintCoins(ctx, liquidstakeibctypes.ModuleName, sdk.NewCoins(stkFee)); err != nil {
+					return errorsmod.Wrapf(
+						liquidstakeibctypes.ErrMintFailed,
+						"failed to mint restake fee: %s",
+						err.Error(),
+					)
+				}
+
+				err := k.SendHostChainProtocolFee(ctx, hc, sdk.NewCoins(stkFee), liquidstakeibctypes.ModuleName)
+				if err != nil {
+					return errorsmod.Wrapf(
+						liquidstakeibctypes.ErrFailedDeposit,
+						"failed to send restake fee to module fee address %s: %s",
+						feeAddress,
+						err.Error(),
+					)
+				}
+				k.AddToFeeReport(ctx, hc.ChainId, liquidstakeibctypes.KeyRestakeFee, stkFee)
+			}
+			eventFee = stkFee
+			restakeAmount = transferAmount
 		}
 
 		// add the deposit amount to the deposit record for that chain/epoch
@@ -307,11 +419,14 @@ func (k *Keeper) OnRecvIBCTransferPacket(
 		}
 
 		// update the deposit
-		deposit.Amount.Amount = deposit.Amount.Amount.Add(transferAmount.Sub(feeAmount.TruncateInt()))
+		deposit.Amount.Amount = deposit.Amount.Amount.Add(restakeAmount)
 		k.SetDeposit(ctx, deposit)
 
-		// update the c value for the auto compounding chain
-		k.UpdateCValue(ctx, hc)
+		// update the c value for the auto compounding chain, chains committing their c value per epoch keep the one
+		// of the current epoch so the rewards can't be front-run by liquid staking right before they arrive
+		if !hc.Flags.EpochCValue {
+			k.UpdateCValue(ctx, hc)
+		}
 
 		// emit autocompound received event
 		ctx.EventManager().EmitEvent(
@@ -319,7 +434,17 @@ func (k *Keeper) OnRecvIBCTransferPacket(
 				liquidstakeibctypes.EventAutocompoundRewardsReceived,
 				sdk.NewAttribute(liquidstakeibctypes.AttributeChainID, hc.ChainId),
 				sdk.NewAttribute(liquidstakeibctypes.AttributeAutocompoundTransfer, sdk.NewCoin(hc.HostDenom, transferAmount).String()),
-				sdk.NewAttribute(liquidstakeibctypes.AttributePstakeAutocompoundFee, sdk.NewCoin(hc.HostDenom, feeAmount.TruncateInt()).String()),
+				sdk.NewAttribute(liquidstakeibctypes.AttributePstakeAutocompoundFee, eventFee.String()),
+			),
+		)
+
+		// notify the callback contract about the received rewards
+		k.ExecuteContractCallback(
+			ctx,
+			liquidstakeibctypes.NewAutocompoundReceivedCallback(
+				hc.ChainId,
+				sdk.NewCoin(hc.HostDenom, transferAmount),
+				currentEpoch,
 			),
 		)
 	}
@@ -343,15 +468,41 @@ func (k *Keeper) OnAcknowledgementIBCTransferPacket(
 	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
 		return err
 	}
-	if !ack.Success() {
-		return channeltypes.ErrInvalidAcknowledgement
-	}
+	k.RecordPacketRelayed(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
 
 	var data ibctransfertypes.FungibleTokenPacketData
 	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
 		return err
 	}
 
+	// the transfer module refunds the sender of a failed transfer, so the deposits can be sent again
+	if !ack.Success() {
+		if err := k.revertDepositTransfer(
+			ctx,
+			packet,
+			data,
+			liquidstakeibctypes.EventStakingDepositTransferFailed,
+			liquidstakeibctypes.EventLSMDepositTransferFailed,
+			sdk.NewAttribute(liquidstakeibctypes.AttributeKeyAckError, ack.GetError()),
+		); err != nil {
+			return err
+		}
+
+		k.Logger(ctx).Error(
+			"Deposit transfer failed.",
+			"sequence",
+			packet.Sequence,
+			"port",
+			packet.SourcePort,
+			"channel",
+			packet.SourceChannel,
+			"error",
+			ack.GetError(),
+		)
+
+		return nil
+	}
+
 	transferAmount, ok := sdk.NewIntFromString(data.Amount)
 	if !ok {
 		return fmt.Errorf("could not parse ibc transfer amount %s", data.Amount)
@@ -360,37 +511,48 @@ func (k *Keeper) OnAcknowledgementIBCTransferPacket(
 	// if the sender is the deposit module account, mark the corresponding deposits as received and update the balance
 	if data.GetSender() == authtypes.NewModuleAddress(liquidstakeibctypes.DepositModuleAccount).String() {
 		// process liquid stake deposits
-		deposits := k.GetDepositsWithSequenceID(ctx, k.GetTransactionSequenceID(packet.SourceChannel, packet.Sequence))
+		deposits := k.GetDepositsWithSequenceID(ctx, k.GetTransactionSequenceID(packet.SourcePort, packet.SourceChannel, packet.Sequence))
 		for _, deposit := range deposits {
-			// update the deposit state
-			deposit.IbcSequenceId = ""
-			deposit.State = liquidstakeibctypes.Deposit_DEPOSIT_RECEIVED
-			k.SetDeposit(ctx, deposit)
+			// an ack can only settle a deposit that is waiting on its transfer
+			if deposit.State != liquidstakeibctypes.Deposit_DEPOSIT_SENT {
+				continue
+			}
 
 			hc, found := k.GetHostChain(ctx, deposit.ChainId)
 			if !found {
 				return fmt.Errorf("host chain with id %s is not registered", deposit.ChainId)
 			}
 
+			// host denoms taxed on transfer only deliver part of the deposit to the delegation account
+			deposit.Amount.Amount = hc.Params.GetExpectedDeliveredAmount(deposit.Amount.Amount)
+
+			// update the deposit state
+			deposit.IbcSequenceId = ""
+			deposit.State = liquidstakeibctypes.Deposit_DEPOSIT_RECEIVED
+			k.SetDeposit(ctx, deposit)
+
 			hc.DelegationAccount.Balance = hc.DelegationAccount.Balance.Add(
 				sdk.Coin{
 					Denom:  hc.DelegationAccount.Balance.Denom,
-					Amount: transferAmount,
+					Amount: hc.Params.GetExpectedDeliveredAmount(transferAmount),
 				},
 			)
 
-			k.SetHostChain(ctx, hc)
+			if err := k.SetHostChain(ctx, hc); err != nil {
+				return err
+			}
 
-			k.Logger(ctx).Info(
+			// the expected amount is an estimate, reconcile it with the actual delegation account balance
+			if hc.Params.IsDepositTaxed() {
+				if err := k.QueryDelegationHostChainAccountBalance(ctx, hc); err != nil {
+					return err
+				}
+			}
+
+			k.OperationLogger(ctx, liquidstakeibctypes.WorkflowDeposit, hc.ChainId, deposit.Epoch).Info(
 				"Got delegation deposit received ACK.",
-				"host chain",
-				hc.ChainId,
 				"sequence",
-				packet.Sequence,
-				"port",
-				packet.SourceChannel,
-				"channel",
-				packet.SourceChannel,
+				k.GetTransactionSequenceID(packet.SourcePort, packet.SourceChannel, packet.Sequence),
 			)
 
 			// emit events for the deposits received
@@ -398,13 +560,14 @@ func (k *Keeper) OnAcknowledgementIBCTransferPacket(
 				sdk.NewEvent(
 					liquidstakeibctypes.EventStakingDepositTransferReceived,
 					sdk.NewAttribute(liquidstakeibctypes.AttributeChainID, hc.ChainId),
-					sdk.NewAttribute(liquidstakeibctypes.AttributeIBCSequenceID, k.GetTransactionSequenceID(packet.SourceChannel, packet.Sequence)),
+					sdk.NewAttribute(liquidstakeibctypes.AttributeIBCSequenceID, k.GetTransactionSequenceID(packet.SourcePort, packet.SourceChannel, packet.Sequence)),
+					liquidstakeibctypes.NewCorrelationAttribute(liquidstakeibctypes.WorkflowDeposit, hc.ChainId, deposit.Epoch),
 				),
 			)
 		}
 
 		// mark tokenized LSM token delegations as received and add the IBC sequence
-		lsmDeposits := k.GetLSMDepositsFromIbcSequenceID(ctx, k.GetTransactionSequenceID(packet.SourceChannel, packet.Sequence))
+		lsmDeposits := k.GetLSMDepositsFromIbcSequenceID(ctx, k.GetTransactionSequenceID(packet.SourcePort, packet.SourceChannel, packet.Sequence))
 		k.UpdateLSMDepositsStateAndSequence(ctx, lsmDeposits, liquidstakeibctypes.LSMDeposit_DEPOSIT_RECEIVED, "")
 
 		// emit events for the lsm deposits received
@@ -418,7 +581,7 @@ func (k *Keeper) OnAcknowledgementIBCTransferPacket(
 				sdk.NewEvent(
 					liquidstakeibctypes.EventLSMDepositTransferReceived,
 					sdk.NewAttribute(liquidstakeibctypes.AttributeChainID, hc.ChainId),
-					sdk.NewAttribute(liquidstakeibctypes.AttributeIBCSequenceID, k.GetTransactionSequenceID(packet.SourceChannel, packet.Sequence)),
+					sdk.NewAttribute(liquidstakeibctypes.AttributeIBCSequenceID, k.GetTransactionSequenceID(packet.SourcePort, packet.SourceChannel, packet.Sequence)),
 				),
 			)
 		}
@@ -436,53 +599,21 @@ func (k *Keeper) OnTimeoutIBCTransferPacket(
 	if transferTimeoutErr != nil {
 		return transferTimeoutErr
 	}
+	k.RecordPacketRelayed(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
 
 	var data ibctransfertypes.FungibleTokenPacketData
 	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
 		return err
 	}
 
-	// just take action when the transfer has been, send from the deposit module account
-	if data.GetSender() == authtypes.NewModuleAddress(liquidstakeibctypes.DepositModuleAccount).String() {
-		// revert the state of the deposits that timed out
-		deposits := k.GetDepositsWithSequenceID(ctx, k.GetTransactionSequenceID(packet.SourceChannel, packet.Sequence))
-		k.RevertDepositsState(ctx, deposits)
-
-		// emit events for the deposits that timed out
-		for _, deposit := range deposits {
-			hc, found := k.GetHostChain(ctx, deposit.ChainId)
-			if !found {
-				return fmt.Errorf("host chain with id %s is not registered", deposit.ChainId)
-			}
-
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(
-					liquidstakeibctypes.EventStakingDepositTransferTimeout,
-					sdk.NewAttribute(liquidstakeibctypes.AttributeChainID, hc.ChainId),
-					sdk.NewAttribute(liquidstakeibctypes.AttributeIBCSequenceID, k.GetTransactionSequenceID(packet.SourceChannel, packet.Sequence)),
-				),
-			)
-		}
-
-		// revert the state of the LSM deposits that timed out
-		lsmDeposits := k.GetLSMDepositsFromIbcSequenceID(ctx, k.GetTransactionSequenceID(packet.SourceChannel, packet.Sequence))
-		k.RevertLSMDepositsState(ctx, lsmDeposits)
-
-		// emit events for the lsm deposits that timed out
-		for _, lsmDeposit := range lsmDeposits {
-			hc, found := k.GetHostChain(ctx, lsmDeposit.ChainId)
-			if !found {
-				return fmt.Errorf("host chain with id %s is not registered", lsmDeposit.ChainId)
-			}
-
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(
-					liquidstakeibctypes.EventLSMDepositTransferTimeout,
-					sdk.NewAttribute(liquidstakeibctypes.AttributeChainID, hc.ChainId),
-					sdk.NewAttribute(liquidstakeibctypes.AttributeIBCSequenceID, k.GetTransactionSequenceID(packet.SourceChannel, packet.Sequence)),
-				),
-			)
-		}
+	if err := k.revertDepositTransfer(
+		ctx,
+		packet,
+		data,
+		liquidstakeibctypes.EventStakingDepositTransferTimeout,
+		liquidstakeibctypes.EventLSMDepositTransferTimeout,
+	); err != nil {
+		return err
 	}
 
 	k.Logger(ctx).Info(
@@ -498,450 +629,663 @@ func (k *Keeper) OnTimeoutIBCTransferPacket(
 	return nil
 }
 
-// Workflows
+// revertDepositTransfer moves the deposits sent in a transfer that did not reach the host chain to failed and the LSM
+// deposits back to pending, emitting the given events for each of them. Transfers not sent from the deposit module
+// account are ignored.
+func (k *Keeper) revertDepositTransfer(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data ibctransfertypes.FungibleTokenPacketData,
+	depositEvent string,
+	lsmDepositEvent string,
+	attributes ...sdk.Attribute,
+) error {
+	if data.GetSender() != authtypes.NewModuleAddress(liquidstakeibctypes.DepositModuleAccount).String() {
+		return nil
+	}
 
-func (k *Keeper) DepositWorkflow(ctx sdk.Context, epoch int64) {
-	k.Logger(ctx).Info("Running deposit workflow.", "epoch", epoch)
+	sequenceID := k.GetTransactionSequenceID(packet.SourcePort, packet.SourceChannel, packet.Sequence)
 
-	deposits := k.GetPendingDepositsBeforeEpoch(ctx, epoch)
+	// only the deposits still waiting on the transfer are reverted
+	deposits := make([]*liquidstakeibctypes.Deposit, 0)
+	for _, deposit := range k.GetDepositsWithSequenceID(ctx, sequenceID) {
+		if deposit.State == liquidstakeibctypes.Deposit_DEPOSIT_SENT {
+			deposits = append(deposits, deposit)
+		}
+	}
+	k.FailDepositsState(ctx, deposits)
+
+	maxRetries := k.GetParams(ctx).MaxDepositRetries
 	for _, deposit := range deposits {
 		hc, found := k.GetHostChain(ctx, deposit.ChainId)
 		if !found {
-			// we can't error out here as all the deposits need to be executed
-			continue
+			return fmt.Errorf("host chain with id %s is not registered", deposit.ChainId)
 		}
 
-		// check if the deposit amount is larger than 0
-		if deposit.Amount.Amount.LTE(sdk.NewInt(0)) {
-			// delete empty deposits to save on storage
-			if deposit.Epoch < epoch {
-				k.DeleteDeposit(ctx, deposit)
-			}
+		logger := k.OperationLogger(ctx, liquidstakeibctypes.WorkflowDeposit, hc.ChainId, deposit.Epoch)
+		logger.Info("Deposit transfer reverted.", "sequence", sequenceID, "event", depositEvent)
 
-			continue
-		}
+		// the deposit workflow won't send it again, it has to be retried with MsgRetryTransfer
+		if deposit.Retries >= maxRetries {
+			logger.Error("Deposit ran out of retries.", "retries", deposit.Retries)
 
-		// don't do anything if the chain is not active
-		if !hc.Active {
-			continue
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					liquidstakeibctypes.EventTypeDepositRetriesExhausted,
+					sdk.NewAttribute(liquidstakeibctypes.AttributeChainID, hc.ChainId),
+					sdk.NewAttribute(liquidstakeibctypes.AttributeEpoch, strconv.FormatInt(deposit.Epoch, 10)),
+					sdk.NewAttribute(liquidstakeibctypes.AttributeDepositRetries, strconv.FormatUint(deposit.Retries, 10)),
+					liquidstakeibctypes.NewCorrelationAttribute(liquidstakeibctypes.WorkflowDeposit, hc.ChainId, deposit.Epoch),
+				),
+			)
 		}
 
-		timeoutTimestamp := uint64(ctx.BlockTime().UnixNano() + (liquidstakeibctypes.IBCTimeoutTimestamp).Nanoseconds())
-		msg := ibctransfertypes.NewMsgTransfer(
-			ibctransfertypes.PortID,
-			hc.ChannelId,
-			deposit.Amount,
-			authtypes.NewModuleAddress(liquidstakeibctypes.DepositModuleAccount).String(),
-			hc.DelegationAccount.Address,
-			clienttypes.ZeroHeight(),
-			timeoutTimestamp,
-			"",
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				depositEvent,
+				append([]sdk.Attribute{
+					sdk.NewAttribute(liquidstakeibctypes.AttributeChainID, hc.ChainId),
+					sdk.NewAttribute(liquidstakeibctypes.AttributeIBCSequenceID, sequenceID),
+					liquidstakeibctypes.NewCorrelationAttribute(liquidstakeibctypes.WorkflowDeposit, hc.ChainId, deposit.Epoch),
+				}, attributes...)...,
+			),
 		)
+	}
 
-		handler := k.msgRouter.Handler(msg)
-		res, err := handler(ctx, msg)
-		if err != nil {
-			k.Logger(ctx).Error(fmt.Sprintf("could not send transfer msg via MsgServiceRouter, error: %s", err))
-			// we can't error out here as all the deposits need to be executed
-			continue
+	lsmDeposits := make([]*liquidstakeibctypes.LSMDeposit, 0)
+	for _, lsmDeposit := range k.GetLSMDepositsFromIbcSequenceID(ctx, sequenceID) {
+		if lsmDeposit.State == liquidstakeibctypes.LSMDeposit_DEPOSIT_SENT {
+			lsmDeposits = append(lsmDeposits, lsmDeposit)
 		}
-		ctx.EventManager().EmitEvents(res.GetEvents())
+	}
+	k.RevertLSMDepositsState(ctx, lsmDeposits)
 
-		var msgTransferResponse ibctransfertypes.MsgTransferResponse
-		if err = k.cdc.Unmarshal(res.MsgResponses[0].Value, &msgTransferResponse); err != nil {
-			// we can't error out here as all the deposits need to be executed
-			continue
+	for _, lsmDeposit := range lsmDeposits {
+		hc, found := k.GetHostChain(ctx, lsmDeposit.ChainId)
+		if !found {
+			return fmt.Errorf("host chain with id %s is not registered", lsmDeposit.ChainId)
 		}
 
-		deposit.State = liquidstakeibctypes.Deposit_DEPOSIT_SENT
-		deposit.IbcSequenceId = k.GetTransactionSequenceID(hc.ChannelId, msgTransferResponse.Sequence)
-		k.SetDeposit(ctx, deposit)
-
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
-				liquidstakeibctypes.EventTypeDelegationWorkflow,
-				sdk.NewAttribute(liquidstakeibctypes.AttributeChainID, hc.ChainId),
-				sdk.NewAttribute(liquidstakeibctypes.AttributeEpoch, strconv.FormatInt(deposit.Epoch, 10)),
-				sdk.NewAttribute(liquidstakeibctypes.AttributeTotalEpochDepositAmount, sdk.NewCoin(hc.HostDenom, deposit.Amount.Amount).String()),
-				sdk.NewAttribute(liquidstakeibctypes.AttributeIBCSequenceID, deposit.IbcSequenceId),
+				lsmDepositEvent,
+				append([]sdk.Attribute{
+					sdk.NewAttribute(liquidstakeibctypes.AttributeChainID, hc.ChainId),
+					sdk.NewAttribute(liquidstakeibctypes.AttributeIBCSequenceID, sequenceID),
+				}, attributes...)...,
 			),
 		)
 	}
+
+	return nil
 }
 
-func (k *Keeper) UndelegationWorkflow(ctx sdk.Context, epoch int64) {
-	k.Logger(ctx).Info("Running undelegation workflow.", "epoch", epoch)
+// Workflows
 
-	for _, hc := range k.GetAllHostChains(ctx) {
-		// don't do anything if the chain is not active
-		if !hc.Active {
-			continue
-		}
+func (k *Keeper) DepositWorkflow(ctx sdk.Context, epoch int64) {
+	k.Logger(ctx).Info("Running deposit workflow.", "epoch", epoch)
 
-		// not an unbonding epoch for the host chain, continue
-		if !liquidstakeibctypes.IsUnbondingEpoch(hc.UnbondingFactor, epoch) {
-			continue
-		}
+	k.ClearWorkflowFailures(ctx, liquidstakeibctypes.WorkflowDeposit)
 
-		// retrieve the unbonding for the current epoch
-		unbonding, found := k.GetUnbonding(
-			ctx,
-			hc.ChainId,
-			liquidstakeibctypes.CurrentUnbondingEpoch(hc.UnbondingFactor, epoch),
-		)
-		if !found {
-			// nothing to unbond for this epoch
-			continue
-		}
+	deposits := k.GetPendingDepositsBeforeEpoch(ctx, epoch)
+	for _, deposit := range deposits {
+		k.RunHostChainWorkflow(ctx, liquidstakeibctypes.WorkflowDeposit, deposit.ChainId, epoch, func(ctx sdk.Context) error {
+			hc, found := k.GetHostChain(ctx, deposit.ChainId)
+			if !found {
+				// we can't error out here as all the deposits need to be executed
+				return nil
+			}
 
-		// check if there is anything to unbond
-		if !unbonding.UnbondAmount.Amount.GT(sdk.ZeroInt()) {
-			k.Logger(ctx).Info(
-				"No tokens to unbond.",
-				"host_chain",
-				hc.ChainId,
-				"epoch",
-				epoch,
-			)
-			continue
-		}
+			// check if the deposit amount is larger than 0
+			if deposit.Amount.Amount.LTE(sdk.NewInt(0)) {
+				// delete empty deposits to save on storage
+				if deposit.Epoch < epoch {
+					k.DeleteDeposit(ctx, deposit)
+				}
 
-		// generate the undelegation messages based on the total unbonding amount for the epoch
-		messages, err := k.GenerateUndelegateMessages(hc, unbonding.UnbondAmount.Amount)
-		if err != nil {
-			k.Logger(ctx).Error(
-				"could not generate undelegate messages",
-				"host_chain",
-				hc.ChainId,
-			)
+				return nil
+			}
 
-			// mark the unbonding as failed
-			unbonding.State = liquidstakeibctypes.Unbonding_UNBONDING_FAILED
-			k.SetUnbonding(ctx, unbonding)
+			// don't do anything if the chain is not active, its channels are not open or its deposits are paused
+			if !hc.IsActive() || hc.Degraded || hc.Flags.DepositsPaused {
+				return nil
+			}
 
-			// emit an event for the undelegation confirmation
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(
-					liquidstakeibctypes.EventUnsuccessfulUndelegationInitiation,
-					sdk.NewAttribute(liquidstakeibctypes.AttributeChainID, hc.ChainId),
-					sdk.NewAttribute(liquidstakeibctypes.AttributeEpoch, strconv.FormatInt(epoch, 10)),
-				),
-			)
+			// we can't error out here as all the deposits need to be executed
+			if err := k.SendDeposit(ctx, hc, deposit); err != nil {
+				k.OperationLogger(ctx, liquidstakeibctypes.WorkflowDeposit, hc.ChainId, deposit.Epoch).Error(
+					"could not send deposit transfer",
+					"err",
+					err.Error(),
+				)
+			}
+
+			return nil
+		})
+	}
 
+	k.RetryFailedDeposits(ctx)
+}
+
+// RetryFailedDeposits sends the failed deposits again, as long as they have not been retried max deposit retries
+// times already
+func (k *Keeper) RetryFailedDeposits(ctx sdk.Context) {
+	maxRetries := k.GetParams(ctx).MaxDepositRetries
+	for _, deposit := range k.GetFailedDeposits(ctx) {
+		if deposit.Retries >= maxRetries {
 			continue
 		}
 
-		// execute the ICA transactions
-		sequenceID, err := k.GenerateAndExecuteICATx(
-			ctx,
-			hc.ConnectionId,
-			hc.DelegationAccount.Owner,
-			messages,
-		)
-		if err != nil {
-			k.Logger(ctx).Error(
-				"could not send ICA undelegate txs",
-				"host_chain",
-				hc.ChainId,
-			)
+		k.RunHostChainWorkflow(ctx, liquidstakeibctypes.WorkflowDeposit, deposit.ChainId, deposit.Epoch, func(ctx sdk.Context) error {
+			hc, found := k.GetHostChain(ctx, deposit.ChainId)
+			if !found || !hc.IsActive() || hc.Degraded || hc.Flags.DepositsPaused {
+				return nil
+			}
 
-			// mark the unbonding as failed
-			unbonding.State = liquidstakeibctypes.Unbonding_UNBONDING_FAILED
-			k.SetUnbonding(ctx, unbonding)
+			if err := k.SendDeposit(ctx, hc, deposit); err != nil {
+				k.OperationLogger(ctx, liquidstakeibctypes.WorkflowDeposit, hc.ChainId, deposit.Epoch).Error(
+					"could not retry deposit transfer",
+					"err",
+					err.Error(),
+				)
+				return nil
+			}
+
+			// nothing was sent if the deposit module account holds none of the deposit
+			if deposit.State == liquidstakeibctypes.Deposit_DEPOSIT_FAILED {
+				return nil
+			}
+
+			deposit.Retries++
+			k.SetDeposit(ctx, deposit)
 
-			// emit an event for the undelegation confirmation
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
-					liquidstakeibctypes.EventUnsuccessfulUndelegationInitiation,
+					liquidstakeibctypes.EventTypeDepositRetry,
 					sdk.NewAttribute(liquidstakeibctypes.AttributeChainID, hc.ChainId),
-					sdk.NewAttribute(liquidstakeibctypes.AttributeEpoch, strconv.FormatInt(epoch, 10)),
+					sdk.NewAttribute(liquidstakeibctypes.AttributeEpoch, strconv.FormatInt(deposit.Epoch, 10)),
+					sdk.NewAttribute(liquidstakeibctypes.AttributeDepositRetries, strconv.FormatUint(deposit.Retries, 10)),
+					sdk.NewAttribute(liquidstakeibctypes.AttributeIBCSequenceID, deposit.IbcSequenceId),
+					liquidstakeibctypes.NewCorrelationAttribute(liquidstakeibctypes.WorkflowDeposit, hc.ChainId, deposit.Epoch),
 				),
 			)
 
-			continue
+			return nil
+		})
+	}
+}
+
+// SendDeposit sends a pending or failed deposit to the delegation account of its host chain, up to what the deposit module
+// account holds. Localhost host chains get it with a bank send, the others with a transfer.
+func (k *Keeper) SendDeposit(ctx sdk.Context, hc *liquidstakeibctypes.HostChain, deposit *liquidstakeibctypes.Deposit) error {
+	// only send what the deposit module account holds for this deposit, the rest is recorded as its epoch shortfall
+	available := k.GetDepositAvailableBalance(ctx, deposit)
+	if available.IsLT(deposit.Amount) {
+		k.SplitDepositShortfall(ctx, deposit, available)
+		if deposit.Amount.IsZero() {
+			return nil
 		}
+	}
+
+	// localhost host chains get their deposits with a bank send
+	if hc.IsLocalhost() {
+		return k.SendLocalhostDeposit(ctx, hc, deposit)
+	}
+
+	if err := k.ValidateNextSequenceID(ctx, ibctransfertypes.PortID, hc.ChannelId); err != nil {
+		return err
+	}
+
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano() + k.GetIBCTimeout(ctx, hc.ConnectionId).Nanoseconds())
+	msg := ibctransfertypes.NewMsgTransfer(
+		ibctransfertypes.PortID,
+		hc.ChannelId,
+		deposit.Amount,
+		authtypes.NewModuleAddress(liquidstakeibctypes.DepositModuleAccount).String(),
+		hc.DelegationAccount.Address,
+		clienttypes.ZeroHeight(),
+		timeoutTimestamp,
+		"",
+	)
+
+	handler := k.msgRouter.Handler(msg)
+	res, err := handler(ctx, msg)
+	if err != nil {
+		return fmt.Errorf("could not send transfer msg via MsgServiceRouter, error: %w", err)
+	}
+	ctx.EventManager().EmitEvents(res.GetEvents())
+
+	var msgTransferResponse ibctransfertypes.MsgTransferResponse
+	if err = k.cdc.Unmarshal(res.MsgResponses[0].Value, &msgTransferResponse); err != nil {
+		return err
+	}
 
-		// update the unbonding ibc sequence id and state
-		unbonding.IbcSequenceId = sequenceID
-		unbonding.State = liquidstakeibctypes.Unbonding_UNBONDING_INITIATED
+	deposit.State = liquidstakeibctypes.Deposit_DEPOSIT_SENT
+	deposit.IbcSequenceId = k.GetTransactionSequenceID(ibctransfertypes.PortID, hc.ChannelId, msgTransferResponse.Sequence)
+	k.SetDeposit(ctx, deposit)
+	k.SetPacketSendTime(ctx, deposit.IbcSequenceId, ctx.BlockTime())
+
+	k.OperationLogger(ctx, liquidstakeibctypes.WorkflowDeposit, hc.ChainId, deposit.Epoch).Info(
+		"Sent deposit transfer.",
+		"sequence",
+		deposit.IbcSequenceId,
+		"amount",
+		deposit.Amount.String(),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			liquidstakeibctypes.EventTypeDelegationWorkflow,
+			sdk.NewAttribute(liquidstakeibctypes.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(liquidstakeibctypes.AttributeEpoch, strconv.FormatInt(deposit.Epoch, 10)),
+			sdk.NewAttribute(liquidstakeibctypes.AttributeTotalEpochDepositAmount, sdk.NewCoin(hc.HostDenom, deposit.Amount.Amount).String()),
+			sdk.NewAttribute(liquidstakeibctypes.AttributeIBCSequenceID, deposit.IbcSequenceId),
+			liquidstakeibctypes.NewCorrelationAttribute(liquidstakeibctypes.WorkflowDeposit, hc.ChainId, deposit.Epoch),
+		),
+	)
+
+	return nil
+}
+
+func (k *Keeper) UndelegationWorkflow(ctx sdk.Context, epoch int64) {
+	k.Logger(ctx).Info("Running undelegation workflow.", "epoch", epoch)
+	k.ClearWorkflowFailures(ctx, liquidstakeibctypes.WorkflowUndelegation)
+
+	for _, hc := range k.GetAllHostChains(ctx) {
+		k.RunHostChainWorkflow(ctx, liquidstakeibctypes.WorkflowUndelegation, hc.ChainId, epoch, func(ctx sdk.Context) error {
+			// don't do anything if the chain is not active
+			if !hc.IsActive() {
+				return nil
+			}
+
+			// not an unbonding epoch for the host chain, continue
+			if !hc.IsUnbondingEpoch(epoch) {
+				return nil
+			}
+
+			logger := k.OperationLogger(ctx, liquidstakeibctypes.WorkflowUndelegation, hc.ChainId, epoch)
+
+			// the undelegations can't be sent if the host chain channels are not open or its undelegations are paused,
+			// they stay pending and are sent by the first unbonding epoch after the host chain recovers
+			if hc.Degraded || hc.Flags.UndelegationsPaused {
+				logger.Info("Deferred undelegation, host chain is degraded or its undelegations are paused.")
+				return nil
+			}
+
+			// send the unbonding of the current epoch along with the ones deferred from earlier epochs
+			unbondingEpoch := hc.CurrentUnbondingEpoch(epoch)
+			unbondings := k.FilterUnbondings(ctx, func(u liquidstakeibctypes.Unbonding) bool {
+				return u.ChainId == hc.ChainId && u.EpochNumber <= unbondingEpoch &&
+					u.State == liquidstakeibctypes.Unbonding_UNBONDING_PENDING
+			})
+			sort.Slice(unbondings, func(i, j int) bool { return unbondings[i].EpochNumber < unbondings[j].EpochNumber })
+
+			for _, unbonding := range unbondings {
+				// check if there is anything to unbond
+				if !unbonding.UnbondAmount.Amount.GT(sdk.ZeroInt()) {
+					logger.Info("No tokens to unbond.", "unbonding_epoch", unbonding.EpochNumber)
+					continue
+				}
+
+				k.initiateUndelegation(ctx, hc, epoch, unbonding, logger)
+			}
+
+			return nil
+		})
+	}
+}
+
+// initiateUndelegation sends the undelegations of an epoch unbonding through the host chain delegation ICA
+func (k *Keeper) initiateUndelegation(
+	ctx sdk.Context,
+	hc *liquidstakeibctypes.HostChain,
+	epoch int64,
+	unbonding *liquidstakeibctypes.Unbonding,
+	logger log.Logger,
+) {
+	// generate the undelegation messages based on the total unbonding amount for the epoch
+	messages, err := k.GenerateUndelegateMessages(hc, unbonding.UnbondAmount.Amount)
+	if err != nil {
+		logger.Error("could not generate undelegate messages", "err", err.Error())
+
+		// mark the unbonding as failed
+		unbonding.State = liquidstakeibctypes.Unbonding_UNBONDING_FAILED
 		k.SetUnbonding(ctx, unbonding)
 
-		// emit the unbonding event
-		encMsgs, err := json.Marshal(&messages)
-		if err != nil {
-			encMsgs = make([]byte, 0)
+		// emit an event for the undelegation confirmation
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				liquidstakeibctypes.EventUnsuccessfulUndelegationInitiation,
+				sdk.NewAttribute(liquidstakeibctypes.AttributeChainID, hc.ChainId),
+				sdk.NewAttribute(liquidstakeibctypes.AttributeEpoch, strconv.FormatInt(epoch, 10)),
+				liquidstakeibctypes.NewCorrelationAttribute(liquidstakeibctypes.WorkflowUndelegation, hc.ChainId, epoch),
+			),
+		)
+
+		return
+	}
+
+	// execute the ICA transactions
+	sequenceID, err := k.GenerateAndExecuteICATx(
+		ctx,
+		hc.ConnectionId,
+		hc.DelegationAccount.Owner,
+		messages,
+	)
+	if err != nil {
+		logger.Error("could not send ICA undelegate txs", "err", err.Error())
+
+		// the undelegation is sent again once its backoff has passed
+		if k.QueueICATxRetry(
+			ctx,
+			hc,
+			liquidstakeibctypes.ICATxRetry_RETRY_UNDELEGATION,
+			unbonding.EpochNumber,
+			messages,
+			err,
+		) {
+			unbonding.State = liquidstakeibctypes.Unbonding_UNBONDING_RETRYING
+			k.SetUnbonding(ctx, unbonding)
+			return
 		}
 
+		// mark the unbonding as failed
+		unbonding.State = liquidstakeibctypes.Unbonding_UNBONDING_FAILED
+		k.SetUnbonding(ctx, unbonding)
+
+		// emit an event for the undelegation confirmation
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
-				liquidstakeibctypes.EventTypeUndelegationWorkflow,
+				liquidstakeibctypes.EventUnsuccessfulUndelegationInitiation,
 				sdk.NewAttribute(liquidstakeibctypes.AttributeChainID, hc.ChainId),
 				sdk.NewAttribute(liquidstakeibctypes.AttributeEpoch, strconv.FormatInt(epoch, 10)),
-				sdk.NewAttribute(liquidstakeibctypes.AttributeTotalEpochUnbondingAmount, sdk.NewCoin(hc.HostDenom, unbonding.UnbondAmount.Amount).String()),
-				sdk.NewAttribute(liquidstakeibctypes.AttributeTotalEpochBurnAmount, sdk.NewCoin(hc.HostDenom, unbonding.BurnAmount.Amount).String()),
-				sdk.NewAttribute(liquidstakeibctypes.AttributeICAMessages, base64.StdEncoding.EncodeToString(encMsgs)),
-				sdk.NewAttribute(liquidstakeibctypes.AttributeIBCSequenceID, sequenceID),
+				liquidstakeibctypes.NewCorrelationAttribute(liquidstakeibctypes.WorkflowUndelegation, hc.ChainId, epoch),
 			),
 		)
+
+		return
 	}
+
+	// update the unbonding ibc sequence id and state
+	unbonding.IbcSequenceId = sequenceID
+	unbonding.State = liquidstakeibctypes.Unbonding_UNBONDING_INITIATED
+	k.SetUnbonding(ctx, unbonding)
+	logger.Info("Sent undelegation.", "sequence", sequenceID, "amount", unbonding.UnbondAmount.String())
+
+	// emit the unbonding event
+	encMsgs, err := json.Marshal(&messages)
+	if err != nil {
+		encMsgs = make([]byte, 0)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			liquidstakeibctypes.EventTypeUndelegationWorkflow,
+			sdk.NewAttribute(liquidstakeibctypes.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(liquidstakeibctypes.AttributeEpoch, strconv.FormatInt(epoch, 10)),
+			sdk.NewAttribute(liquidstakeibctypes.AttributeTotalEpochUnbondingAmount, sdk.NewCoin(hc.HostDenom, unbonding.UnbondAmount.Amount).String()),
+			sdk.NewAttribute(liquidstakeibctypes.AttributeTotalEpochBurnAmount, sdk.NewCoin(hc.HostDenom, unbonding.BurnAmount.Amount).String()),
+			sdk.NewAttribute(liquidstakeibctypes.AttributeICAMessages, base64.StdEncoding.EncodeToString(encMsgs)),
+			sdk.NewAttribute(liquidstakeibctypes.AttributeIBCSequenceID, sequenceID),
+			liquidstakeibctypes.NewCorrelationAttribute(liquidstakeibctypes.WorkflowUndelegation, hc.ChainId, epoch),
+		),
+	)
 }
 
 func (k *Keeper) ValidatorUndelegationWorkflow(ctx sdk.Context, epoch int64) {
 	k.Logger(ctx).Info("Running validator undelegation workflow.", "epoch", epoch)
+	k.ClearWorkflowFailures(ctx, liquidstakeibctypes.WorkflowValidatorUndelegation)
 
 	for _, hc := range k.GetAllHostChains(ctx) {
-		// don't do anything if the chain is not active
-		if !hc.Active {
-			continue
-		}
+		k.RunHostChainWorkflow(ctx, liquidstakeibctypes.WorkflowValidatorUndelegation, hc.ChainId, epoch, func(ctx sdk.Context) error {
+			// don't do anything if the chain is not active or its channels are not open
+			if !hc.IsActive() || hc.Degraded {
+				return nil
+			}
 
-		// not an unbonding epoch for the host chain, continue
-		if !liquidstakeibctypes.IsUnbondingEpoch(hc.UnbondingFactor, epoch) {
-			continue
-		}
+			// the exits are held back until the undelegations resume
+			if hc.Flags.UndelegationsPaused {
+				return nil
+			}
+
+			// not an unbonding epoch for the host chain, continue
+			if !hc.IsUnbondingEpoch(epoch) {
+				return nil
+			}
 
-		for _, validator := range hc.Validators {
-			// check if there are validators that need to be unbonded
-			if validator.UnbondingEpoch > 0 &&
-				validator.UnbondingEpoch+liquidstakeibctypes.UnbondingStateEpochLimit <= epoch {
-
-				// unbond all delegated tokens from the validator
-				validatorUnbonding := &liquidstakeibctypes.ValidatorUnbonding{
-					ChainId:          hc.ChainId,
-					EpochNumber:      epoch,
-					MatureTime:       time.Time{},
-					ValidatorAddress: validator.OperatorAddress,
-					Amount:           sdk.NewCoin(hc.HostDenom, validator.DelegatedAmount),
+			for _, validator := range hc.Validators {
+				// check if there are validators that need to be unbonded, jailed validators are queued right away
+				_, queued := k.GetValidatorExit(ctx, hc.ChainId, validator.OperatorAddress)
+				if queued || (validator.UnbondingEpoch > 0 &&
+					validator.UnbondingEpoch+liquidstakeibctypes.UnbondingStateEpochLimit <= epoch) {
+
+					// the exit waits in the queue until its delay window is over, so it can still be cancelled
+					exit := k.QueueValidatorExit(ctx, hc, validator, epoch)
+					if epoch < exit.ExecuteEpoch {
+						continue
+					}
+
+					if err := k.ExecuteValidatorExit(ctx, hc, validator, epoch); err != nil {
+						k.Logger(ctx).Error(
+							"could not send ICA undelegate txs",
+							"host_chain",
+							hc.ChainId,
+						)
+						// the remaining exits of the host chain are sent on its next unbonding epoch
+						return nil
+					}
 				}
+			}
+
+			return nil
+		})
+	}
+}
+
+func (k *Keeper) RewardsWorkflow(ctx sdk.Context, epoch int64) {
+	k.Logger(ctx).Info("Running rewards workflow.", "epoch", epoch)
+	k.ClearWorkflowFailures(ctx, liquidstakeibctypes.WorkflowRewards)
 
-				// create the MsgUndelegate
-				message := &stakingtypes.MsgUndelegate{
-					DelegatorAddress: hc.DelegationAccount.Address,
-					ValidatorAddress: validatorUnbonding.ValidatorAddress,
-					Amount:           validatorUnbonding.Amount,
+	for _, hc := range k.GetAllHostChains(ctx) {
+		k.RunHostChainWorkflow(ctx, liquidstakeibctypes.WorkflowRewards, hc.ChainId, epoch, func(ctx sdk.Context) error {
+			// don't do anything if the chain is not active, its channels are not open or its rewards are paused
+			if !hc.IsActive() || hc.Degraded || hc.Flags.RewardsPaused {
+				return nil
+			}
+
+			logger := k.OperationLogger(ctx, liquidstakeibctypes.WorkflowRewards, hc.ChainId, epoch)
+
+			// generate the messages
+			messages := make([]proto.Message, 0)
+			for _, validator := range hc.Validators {
+				// skip dust delegations, their rewards are not worth the withdrawal overhead
+				if hc.IsRewardWithdrawable(validator) {
+					message := &distributiontypes.MsgWithdrawDelegatorReward{
+						DelegatorAddress: hc.DelegationAccount.Address,
+						ValidatorAddress: validator.OperatorAddress,
+					}
+					messages = append(messages, message)
 				}
+			}
 
-				// execute the ICA transaction
+			if len(messages) > 0 {
+				// execute the ICA transactions
 				sequenceID, err := k.GenerateAndExecuteICATx(
 					ctx,
 					hc.ConnectionId,
 					hc.DelegationAccount.Owner,
-					[]proto.Message{message},
+					messages,
 				)
 				if err != nil {
-					k.Logger(ctx).Error(
-						"could not send ICA undelegate txs",
-						"host_chain",
-						hc.ChainId,
-					)
-					return
+					logger.Error("Could not send ICA withdraw delegator reward txs", "err", err.Error())
+					k.QueueICATxRetry(ctx, hc, liquidstakeibctypes.ICATxRetry_RETRY_REWARDS_WITHDRAWAL, epoch, messages, err)
+					return nil
 				}
 
-				// update the unbonding sequence id
-				validatorUnbonding.IbcSequenceId = sequenceID
-				k.SetValidatorUnbonding(ctx, validatorUnbonding)
-
-				// redistribute the unbonding validator weight among all the other validators with weight
-				k.RedistributeValidatorWeight(ctx, hc, validator)
-
-				telemetry.IncrCounter(float32(1), hc.ChainId, "validator_unbondings")
+				logger.Info("Sent rewards withdrawal.", "sequence", sequenceID)
 
-				k.Logger(ctx).Info(
-					"Started total validator unbonding.",
-					"host_chain",
-					hc.ChainId,
-					"validator",
-					validatorUnbonding.ValidatorAddress,
-					"amount",
-					validatorUnbonding.Amount,
-					"epoch",
-					epoch,
-				)
+				// emit the rewards event
+				encMsgs, err := json.Marshal(&messages)
+				if err != nil {
+					encMsgs = make([]byte, 0)
+				}
 
-				// emit the validator unbonding event
 				ctx.EventManager().EmitEvent(
 					sdk.NewEvent(
-						liquidstakeibctypes.EventTypeValidatorUndelegationWorkflow,
+						liquidstakeibctypes.EventTypeRewardsWorkflow,
 						sdk.NewAttribute(liquidstakeibctypes.AttributeChainID, hc.ChainId),
 						sdk.NewAttribute(liquidstakeibctypes.AttributeEpoch, strconv.FormatInt(epoch, 10)),
-						sdk.NewAttribute(liquidstakeibctypes.AttributeValidatorAddress, validatorUnbonding.ValidatorAddress),
-						sdk.NewAttribute(liquidstakeibctypes.AttributeValidatorUnbondingAmount, sdk.NewCoin(hc.HostDenom, validatorUnbonding.Amount.Amount).String()),
-						sdk.NewAttribute(liquidstakeibctypes.AttributeIBCSequenceID, sequenceID),
+						sdk.NewAttribute(liquidstakeibctypes.AttributeICAMessages, base64.StdEncoding.EncodeToString(encMsgs)),
+						liquidstakeibctypes.NewCorrelationAttribute(liquidstakeibctypes.WorkflowRewards, hc.ChainId, epoch),
 					),
 				)
 			}
-		}
+
+			if hc.RewardsAccount != nil &&
+				hc.RewardsAccount.ChannelState == liquidstakeibctypes.ICAAccount_ICA_CHANNEL_CREATED {
+				if hc.RewardParams != nil {
+					if err := k.QueryNonCompoundableRewardsHostChainAccountBalance(ctx, hc); err != nil {
+						logger.Error("Could not send non-compoundable rewards account balance ICQ", "err", err.Error())
+					}
+				}
+				if err := k.QueryRewardsHostChainAccountBalance(ctx, hc); err != nil {
+					logger.Error("Could not send rewards account balance ICQ", "err", err.Error())
+					return nil
+				}
+			}
+
+			return nil
+		})
 	}
 }
 
-func (k *Keeper) RewardsWorkflow(ctx sdk.Context, epoch int64) {
-	k.Logger(ctx).Info("Running rewards workflow.", "epoch", epoch)
+func (k *Keeper) LSMWorkflow(ctx sdk.Context, epoch int64) {
+	k.ClearWorkflowFailures(ctx, liquidstakeibctypes.WorkflowLSM)
 
 	for _, hc := range k.GetAllHostChains(ctx) {
-		// don't do anything if the chain is not active
-		if !hc.Active {
-			continue
-		}
-
-		// generate the messages
-		messages := make([]proto.Message, 0)
-		for _, validator := range hc.Validators {
-			if validator.DelegatedAmount.GT(sdk.ZeroInt()) {
-				message := &distributiontypes.MsgWithdrawDelegatorReward{
-					DelegatorAddress: hc.DelegationAccount.Address,
-					ValidatorAddress: validator.OperatorAddress,
-				}
-				messages = append(messages, message)
+		k.RunHostChainWorkflow(ctx, liquidstakeibctypes.WorkflowLSM, hc.ChainId, epoch, func(ctx sdk.Context) error {
+			if !hc.IsActive() || hc.Degraded || !hc.Flags.Lsm || hc.Flags.LsmPaused {
+				// don't do anything on inactive, non-LSM or LSM paused chains
+				return nil
 			}
-		}
 
-		if len(messages) > 0 {
-			// execute the ICA transactions
-			_, err := k.GenerateAndExecuteICATx(
-				ctx,
-				hc.ConnectionId,
-				hc.DelegationAccount.Owner,
-				messages,
-			)
-			if err != nil {
-				k.Logger(ctx).Error(
-					"Could not send ICA withdraw delegator reward txs",
-					"host_chain",
-					hc.ChainId,
-				)
-				continue
-			}
-
-			// emit the rewards event
-			encMsgs, err := json.Marshal(&messages)
-			if err != nil {
-				encMsgs = make([]byte, 0)
-			}
+			// attempt to transfer all available LSM deposits
+			totalLSMDepositsSharesAmount := math.LegacyZeroDec()
+			for _, deposit := range k.GetTransferableLSMDeposits(ctx, hc.ChainId) {
 
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(
-					liquidstakeibctypes.EventTypeRewardsWorkflow,
-					sdk.NewAttribute(liquidstakeibctypes.AttributeChainID, hc.ChainId),
-					sdk.NewAttribute(liquidstakeibctypes.AttributeEpoch, strconv.FormatInt(epoch, 10)),
-					sdk.NewAttribute(liquidstakeibctypes.AttributeICAMessages, base64.StdEncoding.EncodeToString(encMsgs)),
-				),
-			)
-		}
-
-		if hc.RewardsAccount != nil &&
-			hc.RewardsAccount.ChannelState == liquidstakeibctypes.ICAAccount_ICA_CHANNEL_CREATED {
-			if hc.RewardParams != nil {
-				if err := k.QueryNonCompoundableRewardsHostChainAccountBalance(ctx, hc); err != nil {
-					k.Logger(ctx).Error(
-						"Could not send non-compoundable rewards account balance ICQ",
-						"host_chain",
-						hc.ChainId,
-					)
+				if err := k.ValidateNextSequenceID(ctx, ibctransfertypes.PortID, hc.ChannelId); err != nil {
+					k.Logger(ctx).Error("could not send lsm deposit transfer", "host_chain", hc.ChainId, "err", err.Error())
+					break
 				}
-			}
-			if err := k.QueryRewardsHostChainAccountBalance(ctx, hc); err != nil {
-				k.Logger(ctx).Error(
-					"Could not send rewards account balance ICQ",
-					"host_chain",
-					hc.ChainId,
+
+				timeoutTimestamp := uint64(ctx.BlockTime().UnixNano() + k.GetIBCTimeout(ctx, hc.ConnectionId).Nanoseconds())
+
+				// craft the IBC message
+				msg := ibctransfertypes.NewMsgTransfer(
+					ibctransfertypes.PortID,
+					hc.ChannelId,
+					sdk.NewCoin(deposit.IbcDenom, deposit.Shares.TruncateInt()),
+					authtypes.NewModuleAddress(liquidstakeibctypes.DepositModuleAccount).String(),
+					hc.DelegationAccount.Address,
+					clienttypes.ZeroHeight(),
+					timeoutTimestamp,
+					"",
 				)
-				continue
-			}
-		}
-	}
-}
 
-func (k *Keeper) LSMWorkflow(ctx sdk.Context) {
-	for _, hc := range k.GetAllHostChains(ctx) {
-		if !hc.Active || !hc.Flags.Lsm {
-			// don't do anything on inactive or non-LSM chains
-			continue
-		}
+				// send the message
+				handler := k.msgRouter.Handler(msg)
+				res, err := handler(ctx, msg)
+				if err != nil {
+					k.Logger(ctx).Error(fmt.Sprintf("could not send transfer msg via MsgServiceRouter, error: %s", err))
+					// we can't error out here as all the deposits need to be executed
+					continue
+				}
+				ctx.EventManager().EmitEvents(res.GetEvents())
 
-		// attempt to transfer all available LSM deposits
-		totalLSMDepositsSharesAmount := math.LegacyZeroDec()
-		for _, deposit := range k.GetTransferableLSMDeposits(ctx, hc.ChainId) {
-
-			timeoutTimestamp := uint64(ctx.BlockTime().UnixNano() + (liquidstakeibctypes.IBCTimeoutTimestamp).Nanoseconds())
-
-			// craft the IBC message
-			msg := ibctransfertypes.NewMsgTransfer(
-				ibctransfertypes.PortID,
-				hc.ChannelId,
-				sdk.NewCoin(deposit.IbcDenom, deposit.Shares.TruncateInt()),
-				authtypes.NewModuleAddress(liquidstakeibctypes.DepositModuleAccount).String(),
-				hc.DelegationAccount.Address,
-				clienttypes.ZeroHeight(),
-				timeoutTimestamp,
-				"",
-			)
+				var msgTransferResponse ibctransfertypes.MsgTransferResponse
+				if err = k.cdc.Unmarshal(res.MsgResponses[0].Value, &msgTransferResponse); err != nil {
+					// we can't error out here as all the deposits need to be executed
+					continue
+				}
 
-			// send the message
-			handler := k.msgRouter.Handler(msg)
-			res, err := handler(ctx, msg)
-			if err != nil {
-				k.Logger(ctx).Error(fmt.Sprintf("could not send transfer msg via MsgServiceRouter, error: %s", err))
-				// we can't error out here as all the deposits need to be executed
-				continue
-			}
-			ctx.EventManager().EmitEvents(res.GetEvents())
+				// update the deposit state and add the IBC sequence id
+				sequenceID := k.GetTransactionSequenceID(ibctransfertypes.PortID, hc.ChannelId, msgTransferResponse.Sequence)
+				k.UpdateLSMDepositsStateAndSequence(
+					ctx,
+					[]*liquidstakeibctypes.LSMDeposit{deposit},
+					liquidstakeibctypes.LSMDeposit_DEPOSIT_SENT,
+					sequenceID,
+				)
+				k.SetPacketSendTime(ctx, sequenceID, ctx.BlockTime())
 
-			var msgTransferResponse ibctransfertypes.MsgTransferResponse
-			if err = k.cdc.Unmarshal(res.MsgResponses[0].Value, &msgTransferResponse); err != nil {
-				// we can't error out here as all the deposits need to be executed
-				continue
+				totalLSMDepositsSharesAmount = totalLSMDepositsSharesAmount.Add(deposit.Shares)
 			}
 
-			// update the deposit state and add the IBC sequence id
-			k.UpdateLSMDepositsStateAndSequence(
-				ctx,
-				[]*liquidstakeibctypes.LSMDeposit{deposit},
-				liquidstakeibctypes.LSMDeposit_DEPOSIT_SENT,
-				k.GetTransactionSequenceID(hc.ChannelId, msgTransferResponse.Sequence),
+			// emit the validator unbonding event
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					liquidstakeibctypes.EventTypeLSMWorkflow,
+					sdk.NewAttribute(liquidstakeibctypes.AttributeChainID, hc.ChainId),
+					sdk.NewAttribute(liquidstakeibctypes.AttributeLSMDepositsSharesAmount, totalLSMDepositsSharesAmount.String()),
+				),
 			)
 
-			totalLSMDepositsSharesAmount = totalLSMDepositsSharesAmount.Add(deposit.Shares)
-		}
-
-		// emit the validator unbonding event
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				liquidstakeibctypes.EventTypeLSMWorkflow,
-				sdk.NewAttribute(liquidstakeibctypes.AttributeChainID, hc.ChainId),
-				sdk.NewAttribute(liquidstakeibctypes.AttributeLSMDepositsSharesAmount, totalLSMDepositsSharesAmount.String()),
-			),
-		)
+			return nil
+		})
 	}
 }
 
 // RebalanceWorkflow tries to make redelegate transactions to host-chain to balance the delegations as per the weights.
 func (k Keeper) RebalanceWorkflow(ctx sdk.Context, epoch int64) {
 	k.Logger(ctx).Info("Running redelegation workflow.", "epoch", epoch)
+	k.ClearWorkflowFailures(ctx, liquidstakeibctypes.WorkflowRebalance)
 
 	hcs := k.GetAllHostChains(ctx)
 	for _, hc := range hcs {
-		// skip unbonding epoch, as we do not want to redelegate tokens that might be going through unbond txn in same epoch.
-		// nothing bad will happen even if we do as long as unbonding txns are triggered before redelegations.
-		if liquidstakeibctypes.IsUnbondingEpoch(hc.UnbondingFactor, epoch) {
-			k.Logger(ctx).Info("redelegation epoch co-incides with unbonding epoch, skipping it for", "chainID", hc.ChainId)
-			continue
-		}
-		msgs := k.GenerateRedelegateMsgs(ctx, *hc)
-		if len(msgs) == 0 {
-			k.Logger(ctx).Info("no msgs to redelegate for", "chainID", hc.ChainId)
-		}
-		// send one msg per ica
-		for _, msg := range msgs {
-			ibcSeq, err := k.GenerateAndExecuteICATx(ctx, hc.ConnectionId, hc.DelegationAccount.Owner, []proto.Message{msg})
-			if err != nil {
-				k.Logger(ctx).Error("Failed to submit ica redelegate txns with", "err:", err)
-				continue
+		k.RunHostChainWorkflow(ctx, liquidstakeibctypes.WorkflowRebalance, hc.ChainId, epoch, func(ctx sdk.Context) error {
+			// redelegations can't be sent if the host chain channels are not open or its redelegations are paused
+			if hc.Degraded || hc.Flags.RedelegationsPaused {
+				return nil
 			}
-			k.SetRedelegationTx(ctx, &liquidstakeibctypes.RedelegateTx{
-				ChainId:       hc.ChainId,
-				IbcSequenceId: ibcSeq,
-				State:         liquidstakeibctypes.RedelegateTx_REDELEGATE_SENT,
-			})
-		}
+
+			logger := k.OperationLogger(ctx, liquidstakeibctypes.WorkflowRebalance, hc.ChainId, epoch)
+
+			// skip unbonding epoch, as we do not want to redelegate tokens that might be going through unbond txn in same epoch.
+			// nothing bad will happen even if we do as long as unbonding txns are triggered before redelegations.
+			if hc.IsUnbondingEpoch(epoch) {
+				logger.Info("redelegation epoch co-incides with unbonding epoch, skipping it")
+				return nil
+			}
+			k.UpdateWeightTransition(ctx, hc, epoch)
+			msgs := k.GenerateRedelegateMsgs(ctx, *hc)
+			if len(msgs) == 0 {
+				logger.Info("no msgs to redelegate")
+			}
+			k.AdvanceWeightTransition(ctx, hc.ChainId)
+			// send one msg per ica
+			for _, msg := range msgs {
+				ibcSeq, err := k.GenerateAndExecuteICATx(ctx, hc.ConnectionId, hc.DelegationAccount.Owner, []proto.Message{msg})
+				if err != nil {
+					logger.Error("Failed to submit ica redelegate txns", "err", err.Error())
+					k.QueueICATxRetry(
+						ctx,
+						hc,
+						liquidstakeibctypes.ICATxRetry_RETRY_REDELEGATION,
+						epoch,
+						[]proto.Message{msg},
+						err,
+					)
+					continue
+				}
+				k.SetRedelegationTx(ctx, &liquidstakeibctypes.RedelegateTx{
+					ChainId:       hc.ChainId,
+					IbcSequenceId: ibcSeq,
+					State:         liquidstakeibctypes.RedelegateTx_REDELEGATE_SENT,
+				})
+				logger.Info("Sent redelegation.", "sequence", ibcSeq)
+			}
+
+			return nil
+		})
 	}
 }
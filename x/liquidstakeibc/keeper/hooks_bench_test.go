@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/app"
+	testhelpers "github.com/persistenceOne/pstake-native/v2/app/helpers"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+const (
+	benchmarkEpoch      int64 = 100
+	benchmarkValidators       = 20
+)
+
+// epochHookGasBaselines is the gas an epoch hook run used on each benchmark state when it was last measured. Gas is
+// deterministic, so the benchmarks fail when a change makes a hook use more than epochHookGasTolerance percent above
+// its baseline. Lower the baselines when a change makes the hooks cheaper.
+var epochHookGasBaselines = map[string]uint64{
+	types.DelegationEpoch + "/chains=10/records=10":  39_341_696,
+	types.DelegationEpoch + "/chains=10/records=100": 45_679_937,
+	types.DelegationEpoch + "/chains=50/records=10":  196_483_096,
+	types.DelegationEpoch + "/chains=50/records=100": 226_060_545,
+	types.CValueEpoch + "/chains=10/records=10":      3_588_026,
+	types.CValueEpoch + "/chains=10/records=100":     10_954_286,
+	types.CValueEpoch + "/chains=50/records=10":      34_503_466,
+	types.CValueEpoch + "/chains=50/records=100":     208_636_366,
+}
+
+const epochHookGasTolerance = 10
+
+// setupEpochBenchmark returns an app with numChains registered host chains, each with numRecords deposits, unbondings
+// and user unbondings spread over the past epochs. The host chains have no ibc channels, so the workflows go through
+// their bookkeeping on a degraded chain without dispatching any packets.
+func setupEpochBenchmark(b *testing.B, numChains, numRecords int) (*app.PstakeApp, sdk.Context) {
+	b.Helper()
+
+	pstakeApp := testhelpers.Setup(b, false, 5)
+	ctx := pstakeApp.BaseApp.NewContext(false, tmproto.Header{}).
+		WithBlockHeight(100).
+		WithBlockTime(testhelpers.ParseTime("2022-03-01T00:00:00Z"))
+	k := pstakeApp.LiquidStakeIBCKeeper
+
+	weight := sdk.OneDec().QuoInt64(benchmarkValidators)
+	for i := 0; i < numChains; i++ {
+		chainID := fmt.Sprintf("chain-%d", i)
+		hostDenom := fmt.Sprintf("uhost%d", i)
+
+		validators := make([]*types.Validator, 0, benchmarkValidators)
+		for j := 0; j < benchmarkValidators; j++ {
+			validators = append(validators, &types.Validator{
+				OperatorAddress: authtypes.NewModuleAddressOrBech32Address(fmt.Sprintf("%s-val-%d", chainID, j)).String(),
+				Status:          stakingtypes.BondStatusBonded,
+				Weight:          weight,
+				DelegatedAmount: sdk.NewInt(1_000_000_000),
+				ExchangeRate:    sdk.OneDec(),
+				Delegable:       true,
+			})
+		}
+
+		hc := &types.HostChain{
+			ChainId:      chainID,
+			ConnectionId: fmt.Sprintf("connection-%d", i),
+			Params: &types.HostChainLSParams{
+				DepositFee:                  sdk.ZeroDec(),
+				RestakeFee:                  sdk.ZeroDec(),
+				UnstakeFee:                  sdk.ZeroDec(),
+				RedemptionFee:               sdk.ZeroDec(),
+				LsmValidatorCap:             sdk.OneDec(),
+				LsmBondFactor:               sdk.NewDec(-1),
+				UpperCValueLimit:            sdk.MustNewDecFromStr("1.1"),
+				LowerCValueLimit:            sdk.MustNewDecFromStr("0.9"),
+				RedelegationAcceptableDelta: sdk.ZeroInt(),
+			},
+			HostDenom: hostDenom,
+			ChannelId: fmt.Sprintf("channel-%d", i),
+			PortId:    "transfer",
+			DelegationAccount: &types.ICAAccount{
+				Address:      authtypes.NewModuleAddress(chainID + "-delegation").String(),
+				Balance:      sdk.NewCoin(hostDenom, sdk.ZeroInt()),
+				Owner:        types.DefaultDelegateAccountPortOwner(chainID),
+				ChannelState: types.ICAAccount_ICA_CHANNEL_CREATED,
+			},
+			RewardsAccount: &types.ICAAccount{
+				Address:      authtypes.NewModuleAddress(chainID + "-rewards").String(),
+				Balance:      sdk.NewCoin(hostDenom, sdk.ZeroInt()),
+				Owner:        types.DefaultRewardsAccountPortOwner(chainID),
+				ChannelState: types.ICAAccount_ICA_CHANNEL_CREATED,
+			},
+			Validators:         validators,
+			MinimumDeposit:     sdk.OneInt(),
+			CValue:             sdk.OneDec(),
+			LastCValue:         sdk.OneDec(),
+			UnbondingFactor:    4,
+			AutoCompoundFactor: sdk.OneDec(),
+			Active:             true,
+			Flags:              &types.HostChainFlags{},
+		}
+		require.NoError(b, k.SetHostChain(ctx, hc))
+
+		depositStates := []types.Deposit_DepositState{
+			types.Deposit_DEPOSIT_PENDING,
+			types.Deposit_DEPOSIT_SENT,
+			types.Deposit_DEPOSIT_RECEIVED,
+			types.Deposit_DEPOSIT_FAILED,
+		}
+		unbondingStates := []types.Unbonding_UnbondingState{
+			types.Unbonding_UNBONDING_PENDING,
+			types.Unbonding_UNBONDING_MATURING,
+			types.Unbonding_UNBONDING_CLAIMABLE,
+		}
+		for j := 0; j < numRecords; j++ {
+			epoch := benchmarkEpoch - int64(j)
+			k.SetDeposit(ctx, &types.Deposit{
+				ChainId:       chainID,
+				Amount:        sdk.NewCoin(hc.IBCDenom(), sdk.NewInt(1_000_000)),
+				Epoch:         epoch,
+				State:         depositStates[j%len(depositStates)],
+				IbcSequenceId: fmt.Sprintf("transfer/%s/%d", hc.ChannelId, j),
+			})
+			k.SetUnbonding(ctx, &types.Unbonding{
+				ChainId:      chainID,
+				EpochNumber:  epoch,
+				MatureTime:   ctx.BlockTime().Add(time.Duration(j) * time.Hour),
+				BurnAmount:   sdk.NewCoin(hc.MintDenom(), sdk.NewInt(1_000_000)),
+				UnbondAmount: sdk.NewCoin(hostDenom, sdk.NewInt(1_000_000)),
+				State:        unbondingStates[j%len(unbondingStates)],
+			})
+			k.SetUserUnbonding(ctx, &types.UserUnbonding{
+				ChainId:      chainID,
+				EpochNumber:  epoch,
+				Address:      authtypes.NewModuleAddress(fmt.Sprintf("%s-user-%d", chainID, j)).String(),
+				StkAmount:    sdk.NewCoin(hc.MintDenom(), sdk.NewInt(1_000_000)),
+				UnbondAmount: sdk.NewCoin(hostDenom, sdk.NewInt(1_000_000)),
+			})
+		}
+	}
+
+	return pstakeApp, ctx
+}
+
+// benchmarkEpochHook runs the before and after hooks of an epoch on a fresh copy of the state every iteration and
+// reports the gas used per run and per stored record, failing on a gas regression
+func benchmarkEpochHook(b *testing.B, epochIdentifier string) {
+	for _, numChains := range []int{10, 50} {
+		for _, numRecords := range []int{10, 100} {
+			name := fmt.Sprintf("chains=%d/records=%d", numChains, numRecords)
+			b.Run(name, func(b *testing.B) {
+				pstakeApp, ctx := setupEpochBenchmark(b, numChains, numRecords)
+				k := pstakeApp.LiquidStakeIBCKeeper
+
+				gasConsumed := uint64(0)
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					cachedCtx, _ := ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).CacheContext()
+					require.NoError(b, k.BeforeEpochStart(cachedCtx, epochIdentifier, benchmarkEpoch))
+					require.NoError(b, k.AfterEpochEnd(cachedCtx, epochIdentifier, benchmarkEpoch))
+					gasConsumed += cachedCtx.GasMeter().GasConsumed()
+				}
+				b.StopTimer()
+
+				gasPerOp := gasConsumed / uint64(b.N)
+				b.ReportMetric(float64(gasPerOp), "gas/op")
+				b.ReportMetric(float64(gasPerOp/uint64(numChains*numRecords)), "gas/record")
+
+				baseline, found := epochHookGasBaselines[epochIdentifier+"/"+name]
+				require.True(b, found, "no gas baseline for %s", name)
+				require.LessOrEqual(b, gasPerOp, baseline*(100+epochHookGasTolerance)/100, "gas regression on %s", name)
+			})
+		}
+	}
+}
+
+// BenchmarkDelegationEpochHooks covers the deposit, undelegation, rewards and redelegation workflows, which all run on
+// the same epoch
+func BenchmarkDelegationEpochHooks(b *testing.B) {
+	benchmarkEpochHook(b, types.DelegationEpoch)
+}
+
+func BenchmarkCValueEpochHooks(b *testing.B) {
+	benchmarkEpochHook(b, types.CValueEpoch)
+}
@@ -1,29 +1,72 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 	"strconv"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
 )
 
-// SetHostChain sets a host chain in the store
-func (k *Keeper) SetHostChain(ctx sdk.Context, hc *types.HostChain) {
+// SetHostChain sets a host chain in the store, recording the height and time of the update. The host chain is only
+// stored if it keeps its invariants, and storing an unchanged host chain leaves its last update as it is.
+func (k *Keeper) SetHostChain(ctx sdk.Context, hc *types.HostChain) error {
+	if err := hc.ValidateState(); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidHostChainState, "host chain %s not stored: %s", hc.ChainId, err)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HostChainKey)
-	bytes := k.cdc.MustMarshal(hc)
-	store.Set([]byte(hc.ChainId), bytes)
+	if bz := store.Get([]byte(hc.ChainId)); bz != nil {
+		var stored types.HostChain
+		k.cdc.MustUnmarshal(bz, &stored)
+
+		hc.LastUpdateHeight, hc.LastUpdateTime = stored.LastUpdateHeight, stored.LastUpdateTime
+		if bytes.Equal(k.cdc.MustMarshal(hc), bz) {
+			return nil
+		}
+	}
+
+	k.setHostChain(ctx, hc)
+	return nil
 }
 
-// SetHostChainValidator sets a validator on the target host chain
+// ImportHostChain stores a genesis host chain as it is, the genesis host chains are checked by the genesis validation
+func (k *Keeper) ImportHostChain(ctx sdk.Context, hc *types.HostChain) {
+	k.setHostChain(ctx, hc)
+}
+
+// setHostChain stores a host chain without checking its invariants, for the writes that deactivate or halt a host
+// chain and must go through whatever else is wrong with its state
+func (k *Keeper) setHostChain(ctx sdk.Context, hc *types.HostChain) {
+	hc.LastUpdateHeight = ctx.BlockHeight()
+	hc.LastUpdateTime = ctx.BlockTime()
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HostChainKey)
+	store.Set([]byte(hc.ChainId), k.cdc.MustMarshal(hc))
+}
+
+// SetHostChainValidator sets a validator on the target host chain and stores the host chain
 func (k *Keeper) SetHostChainValidator(
 	ctx sdk.Context,
 	hc *types.HostChain,
 	validator *types.Validator,
-) {
+) error {
+	setValidator(ctx, hc, validator)
+	return k.SetHostChain(ctx, hc)
+}
+
+// setValidator sets a validator on the host chain without storing it, for updates that only keep the host chain
+// invariants once all of them are applied
+func setValidator(ctx sdk.Context, hc *types.HostChain, validator *types.Validator) {
+	validator.LastUpdateHeight = ctx.BlockHeight()
+	validator.LastUpdateTime = ctx.BlockTime()
+
 	found := false
 	for i, val := range hc.Validators {
 		if validator.OperatorAddress == val.OperatorAddress {
@@ -36,8 +79,6 @@ func (k *Keeper) SetHostChainValidator(
 	if !found {
 		hc.Validators = append(hc.Validators, validator)
 	}
-
-	k.SetHostChain(ctx, hc)
 }
 
 // ProcessHostChainValidatorUpdates processes the new validator set for a host chain
@@ -56,11 +97,16 @@ func (k *Keeper) ProcessHostChainValidatorUpdates(
 		// validator transitioned into unbonding
 		if validator.Status.String() != stakingtypes.BondStatusBonded {
 			epochNumber := k.epochsKeeper.GetEpochInfo(ctx, types.UndelegationEpoch).CurrentEpoch
-			val.UnbondingEpoch = types.CurrentUnbondingEpoch(hc.UnbondingFactor, epochNumber)
+			val.UnbondingEpoch = hc.CurrentUnbondingEpoch(epochNumber)
 		}
 		// validator transitioned into bonded
 		if validator.Status.String() == stakingtypes.BondStatusBonded {
 			val.UnbondingEpoch = 0
+
+			// a recovered validator no longer needs to leave the set
+			if exit, found := k.GetValidatorExit(ctx, hc.ChainId, val.OperatorAddress); found {
+				k.DeleteValidatorExit(ctx, exit)
+			}
 		}
 
 		// emit the status update event
@@ -75,7 +121,42 @@ func (k *Keeper) ProcessHostChainValidatorUpdates(
 		)
 
 		val.Status = validator.Status.String()
-		k.SetHostChainValidator(ctx, hc, val)
+		if err := k.SetHostChainValidator(ctx, hc, val); err != nil {
+			return err
+		}
+
+		// alert if the status change left the host chain without enough bonded validators
+		k.CheckMinActiveValidators(ctx, hc)
+	}
+
+	// process jailing update, a jailed validator is taken out of the set without waiting for governance
+	if validator.Jailed != val.Jailed {
+		val.Jailed = validator.Jailed
+		if err := k.SetHostChainValidator(ctx, hc, val); err != nil {
+			return err
+		}
+
+		if val.Jailed {
+			if err := k.RemoveJailedValidator(ctx, hc, val); err != nil {
+				return err
+			}
+		}
+	}
+
+	// record the validator tokens and consensus address, used to score its performance
+	if !validator.Tokens.IsNil() && (val.Tokens.IsNil() || !validator.Tokens.Equal(val.Tokens)) {
+		val.Tokens = validator.Tokens
+		if err := k.SetHostChainValidator(ctx, hc, val); err != nil {
+			return err
+		}
+	}
+	if val.ConsensusAddress == "" {
+		if consensusAddress, err := types.ValidatorConsensusAddress(validator); err == nil {
+			val.ConsensusAddress = consensusAddress
+			if err := k.SetHostChainValidator(ctx, hc, val); err != nil {
+				return err
+			}
+		}
 	}
 
 	// process exchange rate update
@@ -108,7 +189,36 @@ func (k *Keeper) ProcessHostChainValidatorUpdates(
 		)
 
 		val.ExchangeRate = exchangeRate
-		k.SetHostChainValidator(ctx, hc, val)
+		if err := k.SetHostChainValidator(ctx, hc, val); err != nil {
+			return err
+		}
+	}
+
+	// process commission update, the reported rate decides if the validator qualifies for the rebate program
+	commissionRate := validator.Commission.CommissionRates.Rate
+	if !commissionRate.IsNil() &&
+		(val.CommissionUpdateHeight == 0 || val.CommissionRate.IsNil() || !commissionRate.Equal(val.CommissionRate)) {
+		oldCommissionRate := val.CommissionRate
+		if oldCommissionRate.IsNil() {
+			oldCommissionRate = sdk.ZeroDec()
+		}
+
+		val.CommissionRate = commissionRate
+		val.CommissionUpdateHeight = ctx.BlockHeight()
+		if err := k.SetHostChainValidator(ctx, hc, val); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeValidatorCommissionUpdate,
+				sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+				sdk.NewAttribute(types.AttributeValidatorAddress, val.OperatorAddress),
+				sdk.NewAttribute(types.AttributeKeyValidatorOldCommission, oldCommissionRate.String()),
+				sdk.NewAttribute(types.AttributeKeyValidatorNewCommission, commissionRate.String()),
+				sdk.NewAttribute(types.AttributeKeyRebateQualified, strconv.FormatBool(hc.QualifiesForRebate(val))),
+			),
+		)
 	}
 
 	// process LSM cap updates
@@ -136,7 +246,12 @@ func (k *Keeper) ProcessHostChainValidatorUpdates(
 
 		// update the validator if its delegable status has changed
 		val.Delegable = validatorHasRoomForDelegations && validatorHasEnoughBond
-		k.SetHostChainValidator(ctx, hc, val)
+
+		// track how much of the validator LSM capacity is left
+		val.LsmCapacity = hc.Params.GetLSMCapacity(validator)
+		if err := k.SetHostChainValidator(ctx, hc, val); err != nil {
+			return err
+		}
 
 		// this part of the code checks whether there is actually room to delegate on the validator.
 		// it can happen that a validator will have not reached any of the caps but the amount that
@@ -192,31 +307,17 @@ func (k *Keeper) ProcessHostChainValidatorUpdates(
 		for _, message := range messages {
 			msgDelegate := message.(*stakingtypes.MsgDelegate)
 			if validator.OperatorAddress == msgDelegate.ValidatorAddress {
-
-				// calculate the amount of shares left to reach the validator lsm cap
-				// shares * validator_lsm_cap - liquid_shares
-				capRoom := validator.DelegatorShares.Mul(hc.Params.LsmValidatorCap).Sub(validator.LiquidShares)
-
-				// if the bond factor functionality is disabled, calculate available room based only on the cap
-				if hc.Params.LsmBondFactor.Equal(sdk.NewDecFromInt(sdk.NewInt(-1))) {
-					validatorHasEnoughRoom = sdk.NewDecFromInt(msgDelegate.Amount.Amount).Quo(val.ExchangeRate).LT(capRoom)
-					continue
-				}
-
-				// calculate the amount of shares left to reach the validator bond cap
-				// bond_shares * bond_factor - liquid_shares
-				bondRoom := validator.ValidatorBondShares.Mul(hc.Params.LsmBondFactor).Sub(validator.LiquidShares)
-
-				// if there is room on both caps, the validator can accept the delegation we will send on the next
-				// delegation workflow (next block).
-				validatorHasEnoughRoom = sdk.NewDecFromInt(msgDelegate.Amount.Amount).Quo(val.ExchangeRate).LT(capRoom) &&
-					sdk.NewDecFromInt(msgDelegate.Amount.Amount).Quo(val.ExchangeRate).LT(bondRoom)
+				// the delegation we will send on the next delegation workflow (next block) needs to fit
+				// within the room left on both the validator cap and the bond factor.
+				validatorHasEnoughRoom = msgDelegate.Amount.Amount.LT(val.LsmCapacity)
 			}
 		}
 
 		// recalculate the delegable state of the validator with the new flag
 		val.Delegable = validatorHasRoomForDelegations && validatorHasEnoughBond && validatorHasEnoughRoom
-		k.SetHostChainValidator(ctx, hc, val)
+		if err := k.SetHostChainValidator(ctx, hc, val); err != nil {
+			return err
+		}
 
 		// emit the delegable status event
 		if oldDelegableFlag != val.Delegable {
@@ -234,7 +335,9 @@ func (k *Keeper) ProcessHostChainValidatorUpdates(
 	return nil
 }
 
-func (k *Keeper) RedistributeValidatorWeight(ctx sdk.Context, hc *types.HostChain, validator *types.Validator) {
+// RedistributeValidatorWeight moves the weight of a validator evenly to the other validators with weight and stores
+// the host chain
+func (k *Keeper) RedistributeValidatorWeight(ctx sdk.Context, hc *types.HostChain, validator *types.Validator) error {
 	validatorsWithWeight := make([]*types.Validator, 0)
 	for _, val := range hc.Validators {
 		if val.Weight.GT(sdk.ZeroDec()) && val.OperatorAddress != validator.OperatorAddress {
@@ -245,11 +348,157 @@ func (k *Keeper) RedistributeValidatorWeight(ctx sdk.Context, hc *types.HostChai
 	weightDiff := validator.Weight.Quo(sdk.NewDec(int64(len(validatorsWithWeight))))
 	for _, val := range validatorsWithWeight {
 		val.Weight = val.Weight.Add(weightDiff)
-		k.SetHostChainValidator(ctx, hc, val)
+		setValidator(ctx, hc, val)
 	}
 
 	validator.Weight = sdk.ZeroDec()
-	k.SetHostChainValidator(ctx, hc, validator)
+	setValidator(ctx, hc, validator)
+
+	// the evenly split weight is rounded, put the weights back to a sum of one
+	return k.NormalizeHostChainValidatorWeights(ctx, hc)
+}
+
+// RemoveJailedValidator zeroes the weight of a validator jailed on the host chain and queues the exit of its
+// delegation, which can still be cancelled during the exit delay if the validator is expected to unjail
+func (k *Keeper) RemoveJailedValidator(ctx sdk.Context, hc *types.HostChain, validator *types.Validator) error {
+	hasOtherWeightedValidators := false
+	for _, val := range hc.Validators {
+		if val.Weight.IsPositive() && val.OperatorAddress != validator.OperatorAddress {
+			hasOtherWeightedValidators = true
+			break
+		}
+	}
+
+	// the weight can only be moved if another validator can take it
+	if validator.Weight.IsPositive() && hasOtherWeightedValidators {
+		if err := k.RedistributeValidatorWeight(ctx, hc, validator); err != nil {
+			return err
+		}
+	}
+
+	if validator.DelegatedAmount.IsPositive() {
+		k.QueueValidatorExit(ctx, hc, validator, k.GetEpochNumber(ctx, types.UndelegationEpoch))
+	}
+
+	k.Logger(ctx).Info(
+		"Removed jailed validator.",
+		"host_chain",
+		hc.ChainId,
+		"validator",
+		validator.OperatorAddress,
+		"weight",
+		validator.Weight,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorJailed,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeValidatorAddress, validator.OperatorAddress),
+		),
+	)
+
+	return nil
+}
+
+// NormalizeHostChainValidatorWeights makes the validator weights of a host chain add up to one and stores the host
+// chain, so repeated weight updates don't make their sum drift through decimal rounding
+func (k *Keeper) NormalizeHostChainValidatorWeights(ctx sdk.Context, hc *types.HostChain) error {
+	hc.NormalizeValidatorWeights()
+	return k.SetHostChain(ctx, hc)
+}
+
+// ValidateHostChainChannels checks that the transfer channel and both ICA channels of a host chain are OPEN
+func (k *Keeper) ValidateHostChainChannels(ctx sdk.Context, hc *types.HostChain) error {
+	// localhost host chains have no transfer channel
+	channel, found := k.ibcKeeper.ChannelKeeper.GetChannel(ctx, hc.PortId, hc.ChannelId)
+	if !hc.IsLocalhost() && (!found || channel.State != channeltypes.OPEN) {
+		return errorsmod.Wrapf(
+			types.ErrChannelNotOpen,
+			"transfer channel %s on port %s is not open",
+			hc.ChannelId,
+			hc.PortId,
+		)
+	}
+
+	if hc.DelegationAccount == nil || !k.IsICAChannelActive(ctx, hc, k.GetPortID(hc.DelegationAccount.Owner)) {
+		return errorsmod.Wrapf(types.ErrChannelNotOpen, "delegation ica channel for chain %s is not open", hc.ChainId)
+	}
+
+	if hc.RewardsAccount == nil || !k.IsICAChannelActive(ctx, hc, k.GetPortID(hc.RewardsAccount.Owner)) {
+		return errorsmod.Wrapf(types.ErrChannelNotOpen, "rewards ica channel for chain %s is not open", hc.ChainId)
+	}
+
+	return nil
+}
+
+// UpdateHostChainDegradedState validates the host chain channels and flags the chain as degraded
+// while any of them is not open, returning the updated state
+func (k *Keeper) UpdateHostChainDegradedState(ctx sdk.Context, hc *types.HostChain) bool {
+	err := k.ValidateHostChainChannels(ctx, hc)
+
+	degraded := err != nil
+	if degraded == hc.Degraded {
+		return degraded
+	}
+
+	hc.Degraded = degraded
+	k.setHostChain(ctx, hc)
+
+	if degraded {
+		k.Logger(ctx).Error("Host chain degraded.", "host_chain", hc.ChainId, "reason", err)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeChainDegraded,
+				sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+				sdk.NewAttribute(types.AttributeDegradedReason, err.Error()),
+			),
+		)
+	} else {
+		k.Logger(ctx).Info("Host chain recovered.", "host_chain", hc.ChainId)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeChainRecovered,
+				sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			),
+		)
+	}
+
+	return degraded
+}
+
+// CheckMinActiveValidators emits an alert event if the host chain has fewer bonded validators with
+// non-zero weight than its configured minimum
+func (k *Keeper) CheckMinActiveValidators(ctx sdk.Context, hc *types.HostChain) {
+	if hc.Params == nil || hc.Params.MinActiveValidators == 0 {
+		return
+	}
+
+	activeValidators := hc.GetBondedActiveValidatorsCount()
+	if activeValidators >= hc.Params.MinActiveValidators {
+		return
+	}
+
+	k.Logger(ctx).Error(
+		"Host chain active validator set is below the minimum.",
+		"host_chain",
+		hc.ChainId,
+		"active_validators",
+		activeValidators,
+		"min_active_validators",
+		hc.Params.MinActiveValidators,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorSetBelowMinimum,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeActiveValidators, strconv.FormatUint(uint64(activeValidators), 10)),
+			sdk.NewAttribute(types.AttributeMinActiveValidators, strconv.FormatUint(uint64(hc.Params.MinActiveValidators), 10)),
+		),
+	)
 }
 
 // GetHostChain returns a host chain given its id
@@ -381,19 +630,13 @@ func (k *Keeper) UpdateHostChainValidatorWeight(
 		return err
 	}
 
-	found := false
-	for i, validator := range hc.Validators {
-		if validator.OperatorAddress == address {
-			hc.Validators[i].Weight = newWeight
-			found = true
-			break
-		}
-	}
-
+	validator, found := hc.GetValidator(address)
 	if !found {
 		return fmt.Errorf("could not find validator with address %s while updating validator weight", address)
 	}
 
-	k.SetHostChain(ctx, hc)
+	// the host chain is stored once all the weights are updated and normalized
+	validator.Weight = newWeight
+	setValidator(ctx, hc, validator)
 	return nil
 }
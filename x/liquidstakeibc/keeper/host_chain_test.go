@@ -76,6 +76,49 @@ func (suite *IntegrationTestSuite) TestSetHostChainValidator() {
 	}
 }
 
+func (suite *IntegrationTestSuite) TestSetHostChainValidated() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	lastUpdateHeight := hc.LastUpdateHeight
+
+	// storing an unchanged host chain keeps its last update
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	suite.Require().NoError(k.SetHostChain(ctx, hc))
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(lastUpdateHeight, hc.LastUpdateHeight)
+
+	hc.MinimumDeposit = hc.MinimumDeposit.AddRaw(1)
+	suite.Require().NoError(k.SetHostChain(ctx, hc))
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(ctx.BlockHeight(), hc.LastUpdateHeight)
+
+	// host chains breaking their invariants are not stored
+	invalid := []func(hc *types.HostChain){
+		func(hc *types.HostChain) { hc.Validators[0].Weight = hc.Validators[0].Weight.QuoInt64(2) },
+		func(hc *types.HostChain) { hc.Params.DepositFee = sdk.OneDec() },
+		func(hc *types.HostChain) { hc.DelegationAccount.Address = "" },
+	}
+	for _, update := range invalid {
+		hc, _ := k.GetHostChain(ctx, suite.chainB.ChainID)
+		update(hc)
+		suite.Require().ErrorIs(k.SetHostChain(ctx, hc), types.ErrInvalidHostChainState)
+
+		stored, _ := k.GetHostChain(ctx, hc.ChainId)
+		suite.Require().NotEqual(hc, stored)
+	}
+
+	// validators are set the same way
+	hc, _ = k.GetHostChain(ctx, suite.chainB.ChainID)
+	validator := *hc.Validators[0]
+	validator.Weight = sdk.ZeroDec()
+	suite.Require().ErrorIs(k.SetHostChainValidator(ctx, hc, &validator), types.ErrInvalidHostChainState)
+	hc, _ = k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(hc.Validators[0].Weight.IsPositive())
+}
+
 func (suite *IntegrationTestSuite) TestProcessHostChainValidatorUpdates() {
 	epoch := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, types.DelegationEpoch).CurrentEpoch
 	hcs := suite.app.LiquidStakeIBCKeeper.GetAllHostChains(suite.ctx)
@@ -981,7 +1024,7 @@ func (suite *IntegrationTestSuite) TestRedistributeValidatorWeight() {
 			hcValidators: []*types.Validator{
 				{
 					OperatorAddress: "valoper1",
-					Weight:          decFromStr("0.6"),
+					Weight:          decFromStr("0.5"),
 				},
 				{
 					OperatorAddress: "valoper2",
@@ -998,13 +1041,14 @@ func (suite *IntegrationTestSuite) TestRedistributeValidatorWeight() {
 			},
 			validator: &types.Validator{
 				OperatorAddress: "valoper1",
-				Weight:          decFromStr("0.6"),
+				Weight:          decFromStr("0.5"),
 			},
+			// the split weight is rounded up, the heaviest validator gives back the excess
 			expected: map[string]sdk.Dec{
 				"valoper1": decFromStr("0"),
-				"valoper2": decFromStr("0.4"),
-				"valoper3": decFromStr("0.35"),
-				"valoper4": decFromStr("0.35"),
+				"valoper2": decFromStr("0.366666666666666666"),
+				"valoper3": decFromStr("0.316666666666666667"),
+				"valoper4": decFromStr("0.316666666666666667"),
 			},
 		},
 	}
@@ -1260,3 +1304,51 @@ func (suite *IntegrationTestSuite) TestGetHostChainFromHostDenom() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestValidateHostChainChannels() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	suite.Require().NoError(suite.app.LiquidStakeIBCKeeper.ValidateHostChainChannels(suite.ctx, hc))
+
+	hc.ChannelId = "channel-100"
+	err := suite.app.LiquidStakeIBCKeeper.ValidateHostChainChannels(suite.ctx, hc)
+	suite.Require().ErrorIs(err, types.ErrChannelNotOpen)
+}
+
+func (suite *IntegrationTestSuite) TestUpdateHostChainDegradedState() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	channelID := hc.ChannelId
+	hc.ChannelId = "channel-100"
+	suite.Require().Equal(true, suite.app.LiquidStakeIBCKeeper.UpdateHostChainDegradedState(suite.ctx, hc))
+
+	hc, _ = suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, hc.Degraded)
+
+	hc.ChannelId = channelID
+	suite.Require().Equal(false, suite.app.LiquidStakeIBCKeeper.UpdateHostChainDegradedState(suite.ctx, hc))
+
+	hc, _ = suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().Equal(false, hc.Degraded)
+}
+
+func (suite *IntegrationTestSuite) TestHostChainUpdateMetadata() {
+	ctx := suite.ctx.WithBlockHeight(100).WithBlockTime(time.Unix(1700000000, 0).UTC())
+
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	suite.app.LiquidStakeIBCKeeper.SetHostChainValidator(ctx, hc, hc.Validators[0])
+	suite.app.LiquidStakeIBCKeeper.UpdateCValue(ctx, hc)
+
+	hc, found = suite.app.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(int64(100), hc.LastUpdateHeight)
+	suite.Require().Equal(ctx.BlockTime(), hc.LastUpdateTime)
+	suite.Require().Equal(int64(100), hc.CValueUpdateHeight)
+	suite.Require().Equal(ctx.BlockTime(), hc.CValueUpdateTime)
+	suite.Require().Equal(int64(100), hc.Validators[0].LastUpdateHeight)
+	suite.Require().Equal(ctx.BlockTime(), hc.Validators[0].LastUpdateTime)
+}
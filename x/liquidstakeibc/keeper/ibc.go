@@ -1,14 +1,17 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
 	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
@@ -81,7 +84,9 @@ func (k *Keeper) OnChanOpenAck(
 	}
 
 	// save the changes of the host chain
-	k.SetHostChain(ctx, hc)
+	if err := k.SetHostChain(ctx, hc); err != nil {
+		return err
+	}
 
 	// send an ICQ query to get the delegator account balance
 	if hc.DelegationAccount != nil && hc.DelegationAccount.ChannelState == types.ICAAccount_ICA_CHANNEL_CREATED {
@@ -103,6 +108,17 @@ func (k *Keeper) OnChanOpenAck(
 			)
 		}
 	}
+	// once both accounts exist, check that the rewards are withdrawn to the rewards account
+	if hc.DelegationAccount.ChannelState == types.ICAAccount_ICA_CHANNEL_CREATED &&
+		hc.RewardsAccount.ChannelState == types.ICAAccount_ICA_CHANNEL_CREATED {
+		if err := k.QueryDelegationAccountWithdrawAddress(ctx, hc); err != nil {
+			return fmt.Errorf(
+				"error querying host chain %s for the delegation account withdraw address: %v",
+				hc.ChainId,
+				err,
+			)
+		}
+	}
 
 	k.Logger(ctx).Info(
 		"Created new ICA.",
@@ -144,13 +160,16 @@ func (k *Keeper) OnAcknowledgementPacket(
 	if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &icaPacket); err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-27 packet data: %v", err)
 	}
+	k.RecordPacketRelayed(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	k.clearDelegationDecreases(ctx, icaPacket, packet.SourcePort, packet.SourceChannel, packet.Sequence)
 
 	switch resp := ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
-		err := k.handleUnsuccessfulAck(ctx, icaPacket, packet.SourceChannel, packet.Sequence)
+		err := k.handleUnsuccessfulAck(ctx, icaPacket, packet.SourcePort, packet.SourceChannel, packet.Sequence)
 		if err != nil {
 			return err
 		}
+		k.recordChannelFailure(ctx, packet.SourcePort, packet.SourceChannel, errors.New(resp.Error))
 		k.Logger(ctx).Info(fmt.Sprintln("ICS-27 tx failed with ack:", ack.String()))
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
@@ -159,10 +178,13 @@ func (k *Keeper) OnAcknowledgementPacket(
 			),
 		)
 	case *channeltypes.Acknowledgement_Result:
-		err := k.handleSuccessfulAck(ctx, ack, icaPacket, packet.SourceChannel, packet.Sequence)
+		err := k.handleSuccessfulAck(ctx, ack, icaPacket, packet.SourcePort, packet.SourceChannel, packet.Sequence)
 		if err != nil {
 			return err
 		}
+		if chainID, found := k.getChannelChainID(ctx, packet.SourcePort, packet.SourceChannel); found {
+			k.ResetHostChainFailures(ctx, chainID)
+		}
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypePacket,
@@ -186,6 +208,76 @@ func (k *Keeper) OnAcknowledgementPacket(
 	return nil
 }
 
+func (k *Keeper) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID string,
+	channelID string,
+) error {
+	k.markICAChannelClosed(ctx, portID, channelID)
+	return nil
+}
+
+// markICAChannelClosed flags the host chain account of an ICA channel that is being closed, so the account is
+// registered again with the same owner in the next block, once the channel is closed
+func (k *Keeper) markICAChannelClosed(ctx sdk.Context, portID string, channelID string) {
+	connID, _, err := k.ibcKeeper.ChannelKeeper.GetChannelConnection(ctx, portID, channelID)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to get the connection of a closed ICA channel.", "port", portID, "err", err)
+		return
+	}
+
+	_, portOwner, found := strings.Cut(portID, icatypes.ControllerPortPrefix)
+	if !found {
+		return
+	}
+
+	chainID, err := k.GetChainID(ctx, connID)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to get the chain id of a closed ICA channel.", "connection", connID, "err", err)
+		return
+	}
+
+	hc, found := k.GetHostChain(ctx, chainID)
+	if !found {
+		return
+	}
+
+	var account *types.ICAAccount
+	switch {
+	case hc.DelegationAccount != nil && portOwner == hc.DelegationAccount.Owner:
+		account = hc.DelegationAccount
+	case hc.RewardsAccount != nil && portOwner == hc.RewardsAccount.Owner:
+		account = hc.RewardsAccount
+	default:
+		return
+	}
+
+	account.ChannelState = types.ICAAccount_ICA_CHANNEL_CLOSED
+	if err := k.SetHostChain(ctx, hc); err != nil {
+		k.Logger(ctx).Error("Could not store the closed ICA channel.", "host chain", hc.ChainId, "err", err.Error())
+		return
+	}
+
+	k.Logger(ctx).Info(
+		"ICA channel closed.",
+		"host chain",
+		hc.ChainId,
+		"channel",
+		channelID,
+		"owner",
+		portOwner,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventICAChannelClosed,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeICAChannelID, channelID),
+			sdk.NewAttribute(types.AttributeICAPortOwner, portOwner),
+		),
+	)
+}
+
 func (k *Keeper) OnTimeoutPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -195,10 +287,18 @@ func (k *Keeper) OnTimeoutPacket(
 	if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &icaPacket); err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-27 tx message data: %v", err)
 	}
+	k.RecordPacketRelayed(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	k.clearDelegationDecreases(ctx, icaPacket, packet.SourcePort, packet.SourceChannel, packet.Sequence)
 
-	if err := k.handleUnsuccessfulAck(ctx, icaPacket, packet.SourceChannel, packet.Sequence); err != nil {
+	if err := k.handleUnsuccessfulAck(ctx, icaPacket, packet.SourcePort, packet.SourceChannel, packet.Sequence); err != nil {
 		return err
 	}
+	k.recordChannelFailure(
+		ctx,
+		packet.SourcePort,
+		packet.SourceChannel,
+		fmt.Errorf("ica packet %d timed out", packet.Sequence),
+	)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -207,6 +307,12 @@ func (k *Keeper) OnTimeoutPacket(
 		),
 	)
 
+	// a timeout closes an ordered channel once this callback returns
+	channel, found := k.ibcKeeper.ChannelKeeper.GetChannel(ctx, packet.SourcePort, packet.SourceChannel)
+	if found && channel.Ordering == channeltypes.ORDERED {
+		k.markICAChannelClosed(ctx, packet.SourcePort, packet.SourceChannel)
+	}
+
 	k.Logger(ctx).Info(
 		"ICA transaction timed out.",
 		"sequence",
@@ -220,9 +326,62 @@ func (k *Keeper) OnTimeoutPacket(
 	return nil
 }
 
+// getChannelChainID returns the host chain an ICA channel is connected to
+func (k *Keeper) getChannelChainID(ctx sdk.Context, portID string, channelID string) (string, bool) {
+	connID, _, err := k.ibcKeeper.ChannelKeeper.GetChannelConnection(ctx, portID, channelID)
+	if err != nil {
+		return "", false
+	}
+
+	chainID, err := k.GetChainID(ctx, connID)
+	if err != nil {
+		return "", false
+	}
+
+	return chainID, true
+}
+
+// clearDelegationDecreases removes the delegation decreases of an ICA tx once it is acknowledged or timed out
+func (k *Keeper) clearDelegationDecreases(
+	ctx sdk.Context,
+	icaPacket icatypes.InterchainAccountPacketData,
+	portID string,
+	channelID string,
+	sequence uint64,
+) {
+	chainID, found := k.getChannelChainID(ctx, portID, channelID)
+	if !found {
+		return
+	}
+
+	messages, err := icatypes.DeserializeCosmosTx(k.cdc, icaPacket.GetData())
+	if err != nil {
+		return
+	}
+	protoMessages := make([]proto.Message, 0, len(messages))
+	for _, msg := range messages {
+		protoMessages = append(protoMessages, msg)
+	}
+
+	k.DeleteDelegationDecreasesInFlight(
+		ctx,
+		chainID,
+		k.GetTransactionSequenceID(portID, channelID, sequence),
+		protoMessages,
+	)
+}
+
+// recordChannelFailure counts a failed or timed out ICA tx towards the circuit breaker of its host chain
+func (k *Keeper) recordChannelFailure(ctx sdk.Context, portID string, channelID string, failure error) {
+	if chainID, found := k.getChannelChainID(ctx, portID, channelID); found {
+		k.RecordHostChainFailure(ctx, chainID, failure)
+	}
+}
+
 func (k *Keeper) handleUnsuccessfulAck(
 	ctx sdk.Context,
 	icaPacket icatypes.InterchainAccountPacketData,
+	port string,
 	channel string,
 	sequence uint64,
 ) error {
@@ -235,7 +394,7 @@ func (k *Keeper) handleUnsuccessfulAck(
 		switch sdk.MsgTypeURL(msg) {
 		case sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}):
 			// revert all the deposits for that sequence back to the previous state
-			k.RevertDepositsState(ctx, k.GetDepositsWithSequenceID(ctx, k.GetTransactionSequenceID(channel, sequence)))
+			k.RevertDepositsState(ctx, k.GetDepositsWithSequenceID(ctx, k.GetTransactionSequenceID(port, channel, sequence)))
 
 			// parse the delegate message to emit the delegate error event
 			parsedMsg, ok := msg.(*stakingtypes.MsgDelegate)
@@ -243,7 +402,7 @@ func (k *Keeper) handleUnsuccessfulAck(
 				k.Logger(ctx).Error(
 					"Could not parse MsgDelegate while handling unsuccessful ack.",
 					"sequence-id",
-					k.GetTransactionSequenceID(channel, sequence),
+					k.GetTransactionSequenceID(port, channel, sequence),
 				)
 				continue
 			}
@@ -256,7 +415,7 @@ func (k *Keeper) handleUnsuccessfulAck(
 					"delegator-address",
 					parsedMsg.DelegatorAddress,
 					"sequence-id",
-					k.GetTransactionSequenceID(channel, sequence),
+					k.GetTransactionSequenceID(port, channel, sequence),
 				)
 				continue
 			}
@@ -269,14 +428,14 @@ func (k *Keeper) handleUnsuccessfulAck(
 					sdk.NewAttribute(types.AttributeDelegatorAddress, parsedMsg.DelegatorAddress),
 					sdk.NewAttribute(types.AttributeValidatorAddress, parsedMsg.ValidatorAddress),
 					sdk.NewAttribute(types.AttributeDelegatedAmount, sdk.NewCoin(hc.HostDenom, parsedMsg.Amount.Amount).String()),
-					sdk.NewAttribute(types.AttributeIBCSequenceID, k.GetTransactionSequenceID(channel, sequence)),
+					sdk.NewAttribute(types.AttributeIBCSequenceID, k.GetTransactionSequenceID(port, channel, sequence)),
 				),
 			)
 		case sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}):
 			// mark all the unbondings for the previous epoch as failed
-			k.FailAllUnbondingsForSequenceID(ctx, k.GetTransactionSequenceID(channel, sequence))
+			k.FailAllUnbondingsForSequenceID(ctx, k.GetTransactionSequenceID(port, channel, sequence))
 			// delete all validator unbondings so they can be picked up again
-			k.DeleteValidatorUnbondingsForSequenceID(ctx, k.GetTransactionSequenceID(channel, sequence))
+			k.DeleteValidatorUnbondingsForSequenceID(ctx, k.GetTransactionSequenceID(port, channel, sequence))
 
 			// parse the undelegate message to emit the undelegate error event
 			parsedMsg, ok := msg.(*stakingtypes.MsgUndelegate)
@@ -284,7 +443,7 @@ func (k *Keeper) handleUnsuccessfulAck(
 				k.Logger(ctx).Error(
 					"Could not parse MsgUndelegate while handling unsuccessful ack.",
 					"sequence-id",
-					k.GetTransactionSequenceID(channel, sequence),
+					k.GetTransactionSequenceID(port, channel, sequence),
 				)
 				continue
 			}
@@ -297,7 +456,7 @@ func (k *Keeper) handleUnsuccessfulAck(
 					"delegator-address",
 					parsedMsg.DelegatorAddress,
 					"sequence-id",
-					k.GetTransactionSequenceID(channel, sequence),
+					k.GetTransactionSequenceID(port, channel, sequence),
 				)
 				continue
 			}
@@ -310,14 +469,14 @@ func (k *Keeper) handleUnsuccessfulAck(
 					sdk.NewAttribute(types.AttributeDelegatorAddress, parsedMsg.DelegatorAddress),
 					sdk.NewAttribute(types.AttributeValidatorAddress, parsedMsg.ValidatorAddress),
 					sdk.NewAttribute(types.AttributeUndelegatedAmount, sdk.NewCoin(hc.HostDenom, parsedMsg.Amount.Amount).String()),
-					sdk.NewAttribute(types.AttributeIBCSequenceID, k.GetTransactionSequenceID(channel, sequence)),
+					sdk.NewAttribute(types.AttributeIBCSequenceID, k.GetTransactionSequenceID(port, channel, sequence)),
 				),
 			)
-		case sdk.MsgTypeURL(&ibctransfertypes.MsgTransfer{}):
+		case sdk.MsgTypeURL(&ibctransfertypes.MsgTransfer{}), sdk.MsgTypeURL(&banktypes.MsgSend{}):
 			unbondings := k.FilterUnbondings(
 				ctx,
 				func(u types.Unbonding) bool {
-					return u.IbcSequenceId == k.GetTransactionSequenceID(channel, sequence)
+					return u.IbcSequenceId == k.GetTransactionSequenceID(port, channel, sequence)
 				},
 			)
 			// revert unbonding state so it can be picked up again
@@ -327,7 +486,7 @@ func (k *Keeper) handleUnsuccessfulAck(
 			validatorUnbondings := k.FilterValidatorUnbondings(
 				ctx,
 				func(u types.ValidatorUnbonding) bool {
-					return u.IbcSequenceId == k.GetTransactionSequenceID(channel, sequence)
+					return u.IbcSequenceId == k.GetTransactionSequenceID(port, channel, sequence)
 				},
 			)
 
@@ -337,26 +496,31 @@ func (k *Keeper) handleUnsuccessfulAck(
 				k.SetValidatorUnbonding(ctx, validatorUnbonding)
 			}
 
-			// parse the transfer message to emit the transfer error event
-			parsedMsg, ok := msg.(*ibctransfertypes.MsgTransfer)
-			if !ok {
+			// parse the transfer message to emit the transfer error event, localhost host chains send bank sends
+			var sender string
+			switch parsedMsg := msg.(type) {
+			case *ibctransfertypes.MsgTransfer:
+				sender = parsedMsg.Sender
+			case *banktypes.MsgSend:
+				sender = parsedMsg.FromAddress
+			default:
 				k.Logger(ctx).Error(
-					"Could not parse MsgTransfer while handling unsuccessful ack.",
+					"Could not parse transfer while handling unsuccessful ack.",
 					"sequence-id",
-					k.GetTransactionSequenceID(channel, sequence),
+					k.GetTransactionSequenceID(port, channel, sequence),
 				)
 				continue
 			}
 
 			// get the host chain using the delegator address
-			hc, found := k.GetHostChainFromDelegatorAddress(ctx, parsedMsg.Sender)
+			hc, found := k.GetHostChainFromDelegatorAddress(ctx, sender)
 			if !found {
 				k.Logger(ctx).Error(
 					"Could not find host chain for ICA delegator address.",
 					"delegator-address",
-					parsedMsg.Sender,
+					sender,
 					"sequence-id",
-					k.GetTransactionSequenceID(channel, sequence),
+					k.GetTransactionSequenceID(port, channel, sequence),
 				)
 				continue
 			}
@@ -367,7 +531,7 @@ func (k *Keeper) handleUnsuccessfulAck(
 					sdk.NewEvent(
 						types.EventUnsuccessfulUndelegationTransfer,
 						sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
-						sdk.NewAttribute(types.AttributeIBCSequenceID, k.GetTransactionSequenceID(channel, sequence)),
+						sdk.NewAttribute(types.AttributeIBCSequenceID, k.GetTransactionSequenceID(port, channel, sequence)),
 					),
 				)
 			}
@@ -378,7 +542,7 @@ func (k *Keeper) handleUnsuccessfulAck(
 					sdk.NewEvent(
 						types.EventUnsuccessfulValidatorUndelegationTransfer,
 						sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
-						sdk.NewAttribute(types.AttributeIBCSequenceID, k.GetTransactionSequenceID(channel, sequence)),
+						sdk.NewAttribute(types.AttributeIBCSequenceID, k.GetTransactionSequenceID(port, channel, sequence)),
 					),
 				)
 			}
@@ -386,7 +550,7 @@ func (k *Keeper) handleUnsuccessfulAck(
 			deposits := k.FilterLSMDepositsWithLimit(
 				ctx,
 				func(d types.LSMDeposit) bool {
-					return d.IbcSequenceId == k.GetTransactionSequenceID(channel, sequence)
+					return d.IbcSequenceId == k.GetTransactionSequenceID(port, channel, sequence)
 				},
 			)
 
@@ -399,7 +563,7 @@ func (k *Keeper) handleUnsuccessfulAck(
 				k.Logger(ctx).Error(
 					"Could not parse MsgRedeemTokensForShares while handling unsuccessful ack.",
 					"sequence-id",
-					k.GetTransactionSequenceID(channel, sequence),
+					k.GetTransactionSequenceID(port, channel, sequence),
 				)
 				continue
 			}
@@ -412,7 +576,7 @@ func (k *Keeper) handleUnsuccessfulAck(
 					"delegator-address",
 					parsedMsg.DelegatorAddress,
 					"sequence-id",
-					k.GetTransactionSequenceID(channel, sequence),
+					k.GetTransactionSequenceID(port, channel, sequence),
 				)
 				continue
 			}
@@ -424,7 +588,7 @@ func (k *Keeper) handleUnsuccessfulAck(
 					sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
 					sdk.NewAttribute(types.AttributeDelegatorAddress, parsedMsg.DelegatorAddress),
 					sdk.NewAttribute(types.AttributeRedeemedAmount, sdk.NewCoin(hc.HostDenom, parsedMsg.Amount.Amount).String()),
-					sdk.NewAttribute(types.AttributeIBCSequenceID, k.GetTransactionSequenceID(channel, sequence)),
+					sdk.NewAttribute(types.AttributeIBCSequenceID, k.GetTransactionSequenceID(port, channel, sequence)),
 				),
 			)
 
@@ -446,7 +610,7 @@ func (k *Keeper) handleUnsuccessfulAck(
 				)
 			}
 			// remove redelegation tx for this sequence (if any)
-			tx, ok := k.GetRedelegationTx(ctx, hc.ChainId, k.GetTransactionSequenceID(channel, sequence))
+			tx, ok := k.GetRedelegationTx(ctx, hc.ChainId, k.GetTransactionSequenceID(port, channel, sequence))
 			if !ok {
 				k.Logger(ctx).Error("unidentified ica tx acked")
 				return nil
@@ -462,7 +626,7 @@ func (k *Keeper) handleUnsuccessfulAck(
 					sdk.NewAttribute(types.AttributeValidatorSrcAddress, parsedMsg.ValidatorSrcAddress),
 					sdk.NewAttribute(types.AttributeValidatorDstAddress, parsedMsg.ValidatorDstAddress),
 					sdk.NewAttribute(types.AttributeRedelegatedAmount, sdk.NewCoin(hc.HostDenom, parsedMsg.Amount.Amount).String()),
-					sdk.NewAttribute(types.AttributeIBCSequenceID, k.GetTransactionSequenceID(channel, sequence)),
+					sdk.NewAttribute(types.AttributeIBCSequenceID, k.GetTransactionSequenceID(port, channel, sequence)),
 				),
 			)
 		}
@@ -475,6 +639,7 @@ func (k *Keeper) handleSuccessfulAck(
 	ctx sdk.Context,
 	ack channeltypes.Acknowledgement,
 	icaPacket icatypes.InterchainAccountPacketData,
+	port string,
 	channel string,
 	sequence uint64,
 ) error {
@@ -491,7 +656,7 @@ func (k *Keeper) handleSuccessfulAck(
 	for i, msg := range messages {
 		switch sdk.MsgTypeURL(msg) {
 		case sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}):
-			if err = k.HandleDelegateResponse(ctx, msg, channel, sequence); err != nil {
+			if err = k.HandleDelegateResponse(ctx, msg, port, channel, sequence); err != nil {
 				return err
 			}
 		case sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}):
@@ -510,7 +675,7 @@ func (k *Keeper) handleSuccessfulAck(
 				)
 			}
 
-			if err = k.HandleUndelegateResponse(ctx, msg, msgResponse, channel, sequence); err != nil {
+			if err = k.HandleUndelegateResponse(ctx, msg, msgResponse, port, channel, sequence); err != nil {
 				return err
 			}
 		case sdk.MsgTypeURL(&ibctransfertypes.MsgTransfer{}):
@@ -529,7 +694,11 @@ func (k *Keeper) handleSuccessfulAck(
 				)
 			}
 
-			if err = k.HandleMsgTransfer(ctx, msg, msgResponse, channel, sequence); err != nil {
+			if err = k.HandleMsgTransfer(ctx, msg, msgResponse, port, channel, sequence); err != nil {
+				return err
+			}
+		case sdk.MsgTypeURL(&banktypes.MsgSend{}):
+			if err = k.HandleMsgSend(ctx, msg, port, channel, sequence); err != nil {
 				return err
 			}
 		case sdk.MsgTypeURL(&stakingtypes.MsgRedeemTokensForShares{}):
@@ -548,7 +717,7 @@ func (k *Keeper) handleSuccessfulAck(
 				)
 			}
 
-			if err = k.HandleMsgRedeemTokensForShares(ctx, msg, msgResponse, channel, sequence); err != nil {
+			if err = k.HandleMsgRedeemTokensForShares(ctx, msg, msgResponse, port, channel, sequence); err != nil {
 				return err
 			}
 
@@ -566,7 +735,7 @@ func (k *Keeper) handleSuccessfulAck(
 					err.Error(),
 				)
 			}
-			if err = k.HandleMsgBeginRedelegate(ctx, msg, msgResponse, channel, sequence); err != nil {
+			if err = k.HandleMsgBeginRedelegate(ctx, msg, msgResponse, port, channel, sequence); err != nil {
 				return err
 			}
 
@@ -18,6 +18,23 @@ func (k *Keeper) GenerateAndExecuteICATx(
 	connectionID string,
 	ownerID string,
 	messages []proto.Message,
+) (string, error) {
+	sequenceID, err := k.executeICATx(ctx, connectionID, ownerID, messages)
+	if err != nil {
+		// failures to send a tx count towards the circuit breaker of the host chain
+		if chainID, chainErr := k.GetChainID(ctx, connectionID); chainErr == nil {
+			k.RecordHostChainFailure(ctx, chainID, err)
+		}
+	}
+
+	return sequenceID, err
+}
+
+func (k *Keeper) executeICATx(
+	ctx sdk.Context,
+	connectionID string,
+	ownerID string,
+	messages []proto.Message,
 ) (string, error) {
 	msgData, err := icatypes.SerializeCosmosTx(k.cdc, messages)
 	if err != nil {
@@ -34,7 +51,21 @@ func (k *Keeper) GenerateAndExecuteICATx(
 		Owner:           ownerID,
 		ConnectionId:    connectionID,
 		PacketData:      icaPacketData,
-		RelativeTimeout: uint64(liquidstakeibctypes.IBCTimeoutTimestamp.Nanoseconds()),
+		RelativeTimeout: uint64(k.GetIBCTimeout(ctx, connectionID).Nanoseconds()),
+	}
+
+	channelID, found := k.icaControllerKeeper.GetOpenActiveChannel(ctx, connectionID, k.GetPortID(ownerID))
+	if !found {
+		return "", errorsmod.Wrapf(
+			liquidstakeibctypes.ErrICATxFailure,
+			"failed to get ica active channel for owner %s",
+			ownerID,
+		)
+	}
+
+	// a reopened channel restarts its sequences, the ack of this tx must not be matched to an older record
+	if err = k.ValidateNextSequenceID(ctx, k.GetPortID(ownerID), channelID); err != nil {
+		return "", err
 	}
 
 	handler := k.msgRouter.Handler(msgSendTx)
@@ -45,15 +76,6 @@ func (k *Keeper) GenerateAndExecuteICATx(
 	}
 	ctx.EventManager().EmitEvents(res.GetEvents())
 
-	channelID, found := k.icaControllerKeeper.GetOpenActiveChannel(ctx, connectionID, k.GetPortID(ownerID))
-	if !found {
-		return "", errorsmod.Wrapf(
-			liquidstakeibctypes.ErrICATxFailure,
-			"failed to get ica active channel: %v",
-			err,
-		)
-	}
-
 	// responses length should always be 1 since we are just sending one MsgSendTx at a time
 	if len(res.MsgResponses) != 1 {
 		return "", errorsmod.Wrapf(
@@ -81,5 +103,11 @@ func (k *Keeper) GenerateAndExecuteICATx(
 		),
 	)
 
-	return k.GetTransactionSequenceID(channelID, msgSendTxResponse.Sequence), nil
+	sequenceID := k.GetTransactionSequenceID(k.GetPortID(ownerID), channelID, msgSendTxResponse.Sequence)
+	k.SetPacketSendTime(ctx, sequenceID, ctx.BlockTime())
+	if chainID, err := k.GetChainID(ctx, connectionID); err == nil {
+		k.SetDelegationDecreasesInFlight(ctx, chainID, sequenceID, messages)
+	}
+
+	return sequenceID, nil
 }
@@ -1,18 +1,26 @@
 package keeper
 
 import (
+	"strconv"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
 )
 
-func (k *Keeper) HandleDelegateResponse(ctx sdk.Context, msg sdk.Msg, channel string, sequence uint64) error {
+func (k *Keeper) HandleDelegateResponse(
+	ctx sdk.Context,
+	msg sdk.Msg,
+	port string,
+	channel string,
+	sequence uint64,
+) error {
 	parsedMsg, ok := msg.(*stakingtypes.MsgDelegate)
 	if !ok {
 		return errorsmod.Wrapf(
@@ -23,9 +31,31 @@ func (k *Keeper) HandleDelegateResponse(ctx sdk.Context, msg sdk.Msg, channel st
 	}
 
 	// remove delegated deposits for this sequence (if any)
-	deposits := k.GetDepositsWithSequenceID(ctx, k.GetTransactionSequenceID(channel, sequence))
+	deposits := k.GetDepositsWithSequenceID(ctx, k.GetTransactionSequenceID(port, channel, sequence))
 	for _, deposit := range deposits {
+		// only deposits waiting on this delegation can be settled by its ack
+		if deposit.State != types.Deposit_DEPOSIT_DELEGATING {
+			continue
+		}
+
+		// the deposit is now staked, the stk tokens held back for it can be claimed
+		k.SetPendingMintsClaimable(ctx, deposit.ChainId, deposit.Epoch)
 		k.DeleteDeposit(ctx, deposit)
+
+		k.OperationLogger(ctx, types.WorkflowDeposit, deposit.ChainId, deposit.Epoch).Info(
+			"Deposit delegated.",
+			"sequence",
+			deposit.IbcSequenceId,
+		)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDepositDelegated,
+				sdk.NewAttribute(types.AttributeChainID, deposit.ChainId),
+				sdk.NewAttribute(types.AttributeEpoch, strconv.FormatInt(deposit.Epoch, 10)),
+				sdk.NewAttribute(types.AttributeIBCSequenceID, deposit.IbcSequenceId),
+				types.NewCorrelationAttribute(types.WorkflowDeposit, deposit.ChainId, deposit.Epoch),
+			),
+		)
 	}
 
 	// get the host chain of the delegation using its delegator address
@@ -53,9 +83,26 @@ func (k *Keeper) HandleDelegateResponse(ctx sdk.Context, msg sdk.Msg, channel st
 
 	// update the validator delegated amount
 	validator.DelegatedAmount = validator.DelegatedAmount.Add(parsedMsg.Amount.Amount)
-	k.SetHostChainValidator(ctx, hc, validator)
+	if err := k.SetHostChainValidator(ctx, hc, validator); err != nil {
+		return err
+	}
+	k.AddRebateDelegation(ctx, hc, validator, parsedMsg.Amount.Amount)
+
+	// the first delegation of a seeded host chain proves its pipeline works, public deposits can open
+	if hc.Seeding {
+		hc.Seeding = false
+		k.Logger(ctx).Info("Host chain seed delegated, deposits are open.", "host_chain", hc.ChainId)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeHostChainSeedDelegated,
+				sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			),
+		)
+	}
 
-	k.SetHostChain(ctx, hc)
+	if err := k.SetHostChain(ctx, hc); err != nil {
+		return err
+	}
 
 	// emit an event for the delegation confirmation
 	ctx.EventManager().EmitEvent(
@@ -65,7 +112,7 @@ func (k *Keeper) HandleDelegateResponse(ctx sdk.Context, msg sdk.Msg, channel st
 			sdk.NewAttribute(types.AttributeDelegatorAddress, parsedMsg.DelegatorAddress),
 			sdk.NewAttribute(types.AttributeValidatorAddress, parsedMsg.ValidatorAddress),
 			sdk.NewAttribute(types.AttributeDelegatedAmount, sdk.NewCoin(hc.HostDenom, parsedMsg.Amount.Amount).String()),
-			sdk.NewAttribute(types.AttributeIBCSequenceID, k.GetTransactionSequenceID(channel, sequence)),
+			sdk.NewAttribute(types.AttributeIBCSequenceID, k.GetTransactionSequenceID(port, channel, sequence)),
 		),
 	)
 
@@ -86,6 +133,7 @@ func (k *Keeper) HandleUndelegateResponse(
 	ctx sdk.Context,
 	msg sdk.Msg,
 	resp stakingtypes.MsgUndelegateResponse,
+	port string,
 	channel string,
 	sequence uint64,
 ) error {
@@ -120,12 +168,23 @@ func (k *Keeper) HandleUndelegateResponse(
 
 	// update the validator delegated amount
 	validator.DelegatedAmount = validator.DelegatedAmount.Sub(parsedMsg.Amount.Amount)
-	k.SetHostChainValidator(ctx, hc, validator)
+	if err := k.SetHostChainValidator(ctx, hc, validator); err != nil {
+		return err
+	}
+
+	// the host chain unbonding period is used when the response carries no completion time
+	matureTime := resp.CompletionTime
+	if matureTime.IsZero() {
+		matureTime, _ = k.GetUnbondingMatureTime(ctx, hc)
+	}
 
 	// update the state of all the unbondings associated with the undelegation
 	unbondings := k.FilterUnbondings(
 		ctx,
-		func(u types.Unbonding) bool { return u.IbcSequenceId == k.GetTransactionSequenceID(channel, sequence) },
+		func(u types.Unbonding) bool {
+			return u.IbcSequenceId == k.GetTransactionSequenceID(port, channel, sequence) &&
+				u.State == types.Unbonding_UNBONDING_INITIATED
+		},
 	)
 
 	for _, unbonding := range unbondings {
@@ -141,7 +200,7 @@ func (k *Keeper) HandleUndelegateResponse(
 
 		// update the mature time and the state for the undelegation
 		unbonding.IbcSequenceId = ""
-		unbonding.MatureTime = resp.CompletionTime
+		unbonding.MatureTime = matureTime
 		unbonding.State = types.Unbonding_UNBONDING_MATURING
 		k.SetUnbonding(ctx, unbonding)
 
@@ -169,14 +228,14 @@ func (k *Keeper) HandleUndelegateResponse(
 	validatorUnbondings := k.FilterValidatorUnbondings(
 		ctx,
 		func(u types.ValidatorUnbonding) bool {
-			return u.IbcSequenceId == k.GetTransactionSequenceID(channel, sequence)
+			return u.IbcSequenceId == k.GetTransactionSequenceID(port, channel, sequence)
 		},
 	)
 
 	for _, validatorUnbonding := range validatorUnbondings {
 		// update the mature time and the state for the validator undelegation
 		validatorUnbonding.IbcSequenceId = ""
-		validatorUnbonding.MatureTime = resp.CompletionTime
+		validatorUnbonding.MatureTime = matureTime
 		k.SetValidatorUnbonding(ctx, validatorUnbonding)
 
 		k.Logger(ctx).Info(
@@ -198,7 +257,7 @@ func (k *Keeper) HandleUndelegateResponse(
 			sdk.NewAttribute(types.AttributeDelegatorAddress, parsedMsg.DelegatorAddress),
 			sdk.NewAttribute(types.AttributeValidatorAddress, parsedMsg.ValidatorAddress),
 			sdk.NewAttribute(types.AttributeUndelegatedAmount, sdk.NewCoin(hc.HostDenom, parsedMsg.Amount.Amount).String()),
-			sdk.NewAttribute(types.AttributeIBCSequenceID, k.GetTransactionSequenceID(channel, sequence)),
+			sdk.NewAttribute(types.AttributeIBCSequenceID, k.GetTransactionSequenceID(port, channel, sequence)),
 		),
 	)
 
@@ -209,6 +268,7 @@ func (k *Keeper) HandleMsgTransfer(
 	ctx sdk.Context,
 	msg sdk.Msg,
 	resp ibctransfertypes.MsgTransferResponse,
+	port string,
 	channel string,
 	sequence uint64,
 ) error {
@@ -238,13 +298,13 @@ func (k *Keeper) HandleMsgTransfer(
 		unbondings := k.FilterUnbondings(
 			ctx,
 			func(u types.Unbonding) bool {
-				return u.IbcSequenceId == k.GetTransactionSequenceID(channel, sequence)
+				return u.IbcSequenceId == k.GetTransactionSequenceID(port, channel, sequence)
 			},
 		)
 
 		// update the unbonding ibc sequence id to the transfer id
 		for _, unbonding := range unbondings {
-			unbonding.IbcSequenceId = k.GetTransactionSequenceID(hc.ChannelId, resp.Sequence)
+			unbonding.IbcSequenceId = k.GetTransactionSequenceID(hc.PortId, hc.ChannelId, resp.Sequence)
 			k.SetUnbonding(ctx, unbonding)
 		}
 
@@ -253,7 +313,7 @@ func (k *Keeper) HandleMsgTransfer(
 			sdk.NewEvent(
 				types.EventSuccessfulUndelegationTransfer,
 				sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
-				sdk.NewAttribute(types.AttributeIBCSequenceID, k.GetTransactionSequenceID(hc.ChannelId, resp.Sequence)),
+				sdk.NewAttribute(types.AttributeIBCSequenceID, k.GetTransactionSequenceID(hc.PortId, hc.ChannelId, resp.Sequence)),
 			),
 		)
 	}
@@ -263,7 +323,7 @@ func (k *Keeper) HandleMsgTransfer(
 		validatorUnbondings := k.FilterValidatorUnbondings(
 			ctx,
 			func(u types.ValidatorUnbonding) bool {
-				return u.ChainId == hc.ChainId && u.IbcSequenceId == k.GetTransactionSequenceID(channel, sequence)
+				return u.ChainId == hc.ChainId && u.IbcSequenceId == k.GetTransactionSequenceID(port, channel, sequence)
 			},
 		)
 
@@ -277,7 +337,7 @@ func (k *Keeper) HandleMsgTransfer(
 			sdk.NewEvent(
 				types.EventSuccessfulValidatorUndelegationTransfer,
 				sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
-				sdk.NewAttribute(types.AttributeIBCSequenceID, k.GetTransactionSequenceID(hc.ChannelId, resp.Sequence)),
+				sdk.NewAttribute(types.AttributeIBCSequenceID, k.GetTransactionSequenceID(hc.PortId, hc.ChannelId, resp.Sequence)),
 			),
 		)
 	}
@@ -285,10 +345,86 @@ func (k *Keeper) HandleMsgTransfer(
 	return nil
 }
 
+// HandleMsgSend settles the bank sends the interchain accounts of localhost host chains make instead of transfers. The
+// tokens are already on the module accounts once the send is acknowledged, so the send is handled both as an
+// acknowledged transfer and as a received one.
+func (k *Keeper) HandleMsgSend(
+	ctx sdk.Context,
+	msg sdk.Msg,
+	port string,
+	channel string,
+	sequence uint64,
+) error {
+	parsedMsg, ok := msg.(*banktypes.MsgSend)
+	if !ok || len(parsedMsg.Amount) != 1 {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidType,
+			"unable to cast msg of type %s to a single denom MsgSend",
+			sdk.MsgTypeURL(msg),
+		)
+	}
+	amount := parsedMsg.Amount[0]
+
+	// get the host chain of the send using its host denom
+	hc, found := k.GetHostChainFromHostDenom(ctx, amount.Denom)
+	if !found || !hc.IsLocalhost() {
+		return errorsmod.Wrapf(
+			types.ErrInvalidHostChain,
+			"localhost host chain with host denom %s not registered",
+			amount.Denom,
+		)
+	}
+
+	sequenceID := k.GetTransactionSequenceID(port, channel, sequence)
+
+	if parsedMsg.FromAddress == hc.DelegationAccount.Address &&
+		parsedMsg.ToAddress == k.GetUndelegationModuleAccount(ctx).GetAddress().String() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventSuccessfulUndelegationTransfer,
+				sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+				sdk.NewAttribute(types.AttributeIBCSequenceID, sequenceID),
+			),
+		)
+	}
+
+	if parsedMsg.FromAddress == hc.DelegationAccount.Address &&
+		parsedMsg.ToAddress == k.GetDepositModuleAccount(ctx).GetAddress().String() {
+		validatorUnbondings := k.FilterValidatorUnbondings(
+			ctx,
+			func(u types.ValidatorUnbonding) bool {
+				return u.ChainId == hc.ChainId && u.IbcSequenceId == sequenceID
+			},
+		)
+		for _, validatorUnbonding := range validatorUnbondings {
+			k.DeleteValidatorUnbonding(ctx, validatorUnbonding)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventSuccessfulValidatorUndelegationTransfer,
+				sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+				sdk.NewAttribute(types.AttributeIBCSequenceID, sequenceID),
+			),
+		)
+	}
+
+	// the unbondings keep the id of the ICA transaction, there is no transfer to tag them with
+	return k.ReceiveHostChainTransfer(
+		ctx,
+		hc,
+		parsedMsg.FromAddress,
+		parsedMsg.ToAddress,
+		amount.Amount,
+		sequenceID,
+	)
+}
+
 func (k *Keeper) HandleMsgRedeemTokensForShares(
 	ctx sdk.Context,
 	msg sdk.Msg,
 	resp stakingtypes.MsgRedeemTokensForSharesResponse,
+	port string,
 	channel string,
 	sequence uint64,
 ) error {
@@ -302,7 +438,7 @@ func (k *Keeper) HandleMsgRedeemTokensForShares(
 	}
 
 	// remove LSM deposits for this sequence (if any)
-	deposits := k.GetLSMDepositsFromIbcSequenceID(ctx, k.GetTransactionSequenceID(channel, sequence))
+	deposits := k.GetLSMDepositsFromIbcSequenceID(ctx, k.GetTransactionSequenceID(port, channel, sequence))
 	for _, deposit := range deposits {
 		k.DeleteLSMDeposit(ctx, deposit)
 	}
@@ -339,9 +475,9 @@ func (k *Keeper) HandleMsgRedeemTokensForShares(
 
 	// update the validator delegated amount
 	validator.DelegatedAmount = validator.DelegatedAmount.Add(resp.Amount.Amount)
-	k.SetHostChainValidator(ctx, hc, validator)
-
-	k.SetHostChain(ctx, hc)
+	if err := k.SetHostChainValidator(ctx, hc, validator); err != nil {
+		return err
+	}
 
 	// emit an event for the redeem confirmation
 	ctx.EventManager().EmitEvent(
@@ -350,7 +486,7 @@ func (k *Keeper) HandleMsgRedeemTokensForShares(
 			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
 			sdk.NewAttribute(types.AttributeDelegatorAddress, parsedMsg.DelegatorAddress),
 			sdk.NewAttribute(types.AttributeRedeemedAmount, sdk.NewCoin(hc.HostDenom, parsedMsg.Amount.Amount).String()),
-			sdk.NewAttribute(types.AttributeIBCSequenceID, k.GetTransactionSequenceID(channel, sequence)),
+			sdk.NewAttribute(types.AttributeIBCSequenceID, k.GetTransactionSequenceID(port, channel, sequence)),
 		),
 	)
 
@@ -371,6 +507,7 @@ func (k *Keeper) HandleMsgBeginRedelegate(
 	ctx sdk.Context,
 	msg sdk.Msg,
 	resp stakingtypes.MsgBeginRedelegateResponse,
+	port string,
 	channel string,
 	sequence uint64,
 ) error {
@@ -391,7 +528,7 @@ func (k *Keeper) HandleMsgBeginRedelegate(
 		)
 	}
 	// remove redebelgation tx for this sequence (if any)
-	tx, ok := k.GetRedelegationTx(ctx, hc.ChainId, k.GetTransactionSequenceID(channel, sequence))
+	tx, ok := k.GetRedelegationTx(ctx, hc.ChainId, k.GetTransactionSequenceID(port, channel, sequence))
 	if !ok {
 		k.Logger(ctx).Error("unidentified ica tx acked")
 		return nil
@@ -410,7 +547,9 @@ func (k *Keeper) HandleMsgBeginRedelegate(
 	}
 
 	toValidator.DelegatedAmount = toValidator.DelegatedAmount.Add(parsedMsg.Amount.Amount)
-	k.SetHostChainValidator(ctx, hc, toValidator)
+	if err := k.SetHostChainValidator(ctx, hc, toValidator); err != nil {
+		return err
+	}
 
 	// remove src validator tokens
 	fromValidator, found := hc.GetValidator(parsedMsg.ValidatorSrcAddress)
@@ -423,7 +562,9 @@ func (k *Keeper) HandleMsgBeginRedelegate(
 	}
 
 	fromValidator.DelegatedAmount = fromValidator.DelegatedAmount.Sub(parsedMsg.Amount.Amount)
-	k.SetHostChainValidator(ctx, hc, fromValidator)
+	if err := k.SetHostChainValidator(ctx, hc, fromValidator); err != nil {
+		return err
+	}
 
 	// add redelegation entry.
 	k.AddRedelegationEntry(ctx, hc.ChainId, *parsedMsg, resp)
@@ -437,7 +578,7 @@ func (k *Keeper) HandleMsgBeginRedelegate(
 			sdk.NewAttribute(types.AttributeValidatorSrcAddress, parsedMsg.ValidatorSrcAddress),
 			sdk.NewAttribute(types.AttributeValidatorDstAddress, parsedMsg.ValidatorDstAddress),
 			sdk.NewAttribute(types.AttributeRedelegatedAmount, sdk.NewCoin(hc.HostDenom, parsedMsg.Amount.Amount).String()),
-			sdk.NewAttribute(types.AttributeIBCSequenceID, k.GetTransactionSequenceID(channel, sequence)),
+			sdk.NewAttribute(types.AttributeIBCSequenceID, k.GetTransactionSequenceID(port, channel, sequence)),
 		),
 	)
 	k.Logger(ctx).Info(
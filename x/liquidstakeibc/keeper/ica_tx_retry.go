@@ -0,0 +1,263 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (k *Keeper) SetICATxRetry(ctx sdk.Context, retry *types.ICATxRetry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ICATxRetryKey)
+	bytes := k.cdc.MustMarshal(retry)
+	store.Set(types.GetICATxRetryStoreKey(retry.Id), bytes)
+}
+
+func (k *Keeper) GetICATxRetry(ctx sdk.Context, id uint64) (*types.ICATxRetry, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ICATxRetryKey)
+	bytes := store.Get(types.GetICATxRetryStoreKey(id))
+	if len(bytes) == 0 {
+		return &types.ICATxRetry{}, false
+	}
+
+	var retry types.ICATxRetry
+	k.cdc.MustUnmarshal(bytes, &retry)
+	return &retry, true
+}
+
+func (k *Keeper) DeleteICATxRetry(ctx sdk.Context, retry *types.ICATxRetry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ICATxRetryKey)
+	store.Delete(types.GetICATxRetryStoreKey(retry.Id))
+}
+
+func (k *Keeper) GetAllICATxRetries(ctx sdk.Context) []*types.ICATxRetry {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ICATxRetryKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	retries := make([]*types.ICATxRetry, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		retry := types.ICATxRetry{}
+		k.cdc.MustUnmarshal(iterator.Value(), &retry)
+		retries = append(retries, &retry)
+	}
+
+	return retries
+}
+
+func (k *Keeper) GetICATxRetriesForHostChain(ctx sdk.Context, chainID string) []*types.ICATxRetry {
+	retries := make([]*types.ICATxRetry, 0)
+	for _, retry := range k.GetAllICATxRetries(ctx) {
+		if retry.ChainId == chainID {
+			retries = append(retries, retry)
+		}
+	}
+
+	return retries
+}
+
+// GetNextICATxRetryID returns the id for a new ica tx retry and increments the stored counter.
+func (k *Keeper) GetNextICATxRetryID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	var id uint64
+	if bytes := store.Get(types.ICATxRetryIDKey); len(bytes) != 0 {
+		id = sdk.BigEndianToUint64(bytes)
+	}
+	store.Set(types.ICATxRetryIDKey, sdk.Uint64ToBigEndian(id+1))
+
+	return id
+}
+
+// QueueICATxRetry queues an ica tx of the delegation account that could not be sent, so it is submitted again once
+// its backoff has passed. Returns false when the retries are disabled, leaving the failure to the caller.
+func (k *Keeper) QueueICATxRetry(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	retryType types.ICATxRetry_ICATxRetryType,
+	epoch int64,
+	messages []proto.Message,
+	submitErr error,
+) bool {
+	if k.GetParams(ctx).MaxIcaTxRetries == 0 {
+		return false
+	}
+
+	anys := make([]*codectypes.Any, 0, len(messages))
+	for _, message := range messages {
+		msgAny, err := codectypes.NewAnyWithValue(message)
+		if err != nil {
+			k.Logger(ctx).Error("could not pack ica tx message for a retry", "host_chain", hc.ChainId, "err", err)
+			return false
+		}
+		anys = append(anys, msgAny)
+	}
+
+	retry := &types.ICATxRetry{
+		Id:        k.GetNextICATxRetryID(ctx),
+		ChainId:   hc.ChainId,
+		Type:      retryType,
+		Epoch:     epoch,
+		Messages:  anys,
+		Attempts:  1,
+		LastError: submitErr.Error(),
+	}
+	retry.NextAttemptHeight = ctx.BlockHeight() + retry.Backoff()
+	k.SetICATxRetry(ctx, retry)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeICATxRetryQueued,
+			sdk.NewAttribute(types.AttributeChainID, retry.ChainId),
+			sdk.NewAttribute(types.AttributeICATxRetryID, strconv.FormatUint(retry.Id, 10)),
+			sdk.NewAttribute(types.AttributeICATxRetryType, retry.Type.String()),
+			sdk.NewAttribute(types.AttributeEpoch, strconv.FormatInt(retry.Epoch, 10)),
+			sdk.NewAttribute(types.AttributeICATxRetryNextHeight, strconv.FormatInt(retry.NextAttemptHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyAckError, retry.LastError),
+		),
+	)
+
+	return true
+}
+
+// ProcessICATxRetries submits again the queued ica txs whose backoff has passed. A tx that fails again waits twice
+// as long for its next attempt, until it runs out of retries.
+func (k *Keeper) ProcessICATxRetries(ctx sdk.Context) {
+	maxRetries := k.GetParams(ctx).MaxIcaTxRetries
+
+	for _, retry := range k.GetAllICATxRetries(ctx) {
+		if retry.NextAttemptHeight > ctx.BlockHeight() {
+			continue
+		}
+
+		hc, found := k.GetHostChain(ctx, retry.ChainId)
+		if !found {
+			k.DeleteICATxRetry(ctx, retry)
+			continue
+		}
+
+		sequenceID, err := k.submitICATxRetry(ctx, hc, retry)
+		if err == nil {
+			k.DeleteICATxRetry(ctx, retry)
+			k.onICATxRetrySent(ctx, hc, retry, sequenceID)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeICATxRetry,
+					sdk.NewAttribute(types.AttributeChainID, retry.ChainId),
+					sdk.NewAttribute(types.AttributeICATxRetryID, strconv.FormatUint(retry.Id, 10)),
+					sdk.NewAttribute(types.AttributeICATxRetryType, retry.Type.String()),
+					sdk.NewAttribute(types.AttributeEpoch, strconv.FormatInt(retry.Epoch, 10)),
+					sdk.NewAttribute(types.AttributeICATxRetryAttempts, strconv.FormatUint(retry.Attempts, 10)),
+					sdk.NewAttribute(types.AttributeIBCSequenceID, sequenceID),
+				),
+			)
+			continue
+		}
+
+		k.Logger(ctx).Error(
+			"Could not submit ica tx retry.",
+			"host_chain",
+			retry.ChainId,
+			"type",
+			retry.Type.String(),
+			"attempts",
+			retry.Attempts,
+			"err",
+			err.Error(),
+		)
+
+		retry.Attempts++
+		retry.LastError = err.Error()
+		if retry.Attempts > maxRetries {
+			k.DeleteICATxRetry(ctx, retry)
+			k.onICATxRetriesExhausted(ctx, hc, retry)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeICATxRetriesExhausted,
+					sdk.NewAttribute(types.AttributeChainID, retry.ChainId),
+					sdk.NewAttribute(types.AttributeICATxRetryID, strconv.FormatUint(retry.Id, 10)),
+					sdk.NewAttribute(types.AttributeICATxRetryType, retry.Type.String()),
+					sdk.NewAttribute(types.AttributeEpoch, strconv.FormatInt(retry.Epoch, 10)),
+					sdk.NewAttribute(types.AttributeICATxRetryAttempts, strconv.FormatUint(retry.Attempts, 10)),
+					sdk.NewAttribute(types.AttributeKeyAckError, retry.LastError),
+				),
+			)
+			continue
+		}
+
+		retry.NextAttemptHeight = ctx.BlockHeight() + retry.Backoff()
+		k.SetICATxRetry(ctx, retry)
+	}
+}
+
+// submitICATxRetry sends the messages of a queued ica tx through the host chain delegation account
+func (k *Keeper) submitICATxRetry(ctx sdk.Context, hc *types.HostChain, retry *types.ICATxRetry) (string, error) {
+	if !hc.IsActive() {
+		return "", errorsmod.Wrapf(types.ErrHostChainInactive, "host chain %s is not active", hc.ChainId)
+	}
+
+	messages := make([]proto.Message, 0, len(retry.Messages))
+	for _, msgAny := range retry.Messages {
+		var message sdk.Msg
+		if err := k.cdc.UnpackAny(msgAny, &message); err != nil {
+			return "", err
+		}
+		messages = append(messages, message)
+	}
+
+	return k.GenerateAndExecuteICATx(ctx, hc.ConnectionId, hc.DelegationAccount.Owner, messages)
+}
+
+// onICATxRetrySent tracks a retried ica tx the same way its workflow tracks the txs it sends
+func (k *Keeper) onICATxRetrySent(ctx sdk.Context, hc *types.HostChain, retry *types.ICATxRetry, sequenceID string) {
+	switch retry.Type {
+	case types.ICATxRetry_RETRY_UNDELEGATION:
+		unbonding, found := k.GetUnbonding(ctx, hc.ChainId, retry.Epoch)
+		if !found {
+			return
+		}
+
+		unbonding.IbcSequenceId = sequenceID
+		unbonding.State = types.Unbonding_UNBONDING_INITIATED
+		k.SetUnbonding(ctx, unbonding)
+	case types.ICATxRetry_RETRY_REDELEGATION:
+		k.SetRedelegationTx(ctx, &types.RedelegateTx{
+			ChainId:       hc.ChainId,
+			IbcSequenceId: sequenceID,
+			State:         types.RedelegateTx_REDELEGATE_SENT,
+		})
+	case types.ICATxRetry_RETRY_REWARDS_WITHDRAWAL:
+		// the withdrawn rewards are picked up by the rewards account balance query
+	}
+}
+
+// onICATxRetriesExhausted gives up on an ica tx, failing the records waiting for it
+func (k *Keeper) onICATxRetriesExhausted(ctx sdk.Context, hc *types.HostChain, retry *types.ICATxRetry) {
+	if retry.Type != types.ICATxRetry_RETRY_UNDELEGATION {
+		return
+	}
+
+	unbonding, found := k.GetUnbonding(ctx, hc.ChainId, retry.Epoch)
+	if !found {
+		return
+	}
+
+	// mark the unbonding as failed, so it can be claimed back
+	unbonding.State = types.Unbonding_UNBONDING_FAILED
+	k.SetUnbonding(ctx, unbonding)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventUnsuccessfulUndelegationInitiation,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeEpoch, strconv.FormatInt(retry.Epoch, 10)),
+		),
+	)
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestICATxRetry() {
+	ctx, _ := suite.ctx.CacheContext()
+	k := suite.app.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	epoch := int64(4)
+	k.SetUnbonding(ctx, &types.Unbonding{
+		ChainId:      hc.ChainId,
+		EpochNumber:  epoch,
+		BurnAmount:   sdk.NewInt64Coin(hc.MintDenom(), 1000),
+		UnbondAmount: sdk.NewInt64Coin(hc.HostDenom, 1000),
+		State:        types.Unbonding_UNBONDING_RETRYING,
+	})
+
+	// keep the chain inactive so the first attempts fail
+	hc.Active = false
+	k.SetHostChain(ctx, hc)
+
+	messages := []proto.Message{&stakingtypes.MsgUndelegate{
+		DelegatorAddress: hc.DelegationAccount.Address,
+		ValidatorAddress: hc.Validators[0].OperatorAddress,
+		Amount:           sdk.NewInt64Coin(hc.HostDenom, 1000),
+	}}
+	queued := k.QueueICATxRetry(ctx, hc, types.ICATxRetry_RETRY_UNDELEGATION, epoch, messages, errors.New("ica error"))
+	suite.Require().Equal(true, queued)
+
+	retries := k.GetICATxRetriesForHostChain(ctx, hc.ChainId)
+	suite.Require().Len(retries, 1)
+	retry := retries[0]
+	suite.Require().Equal(uint64(1), retry.Attempts)
+	suite.Require().Equal(ctx.BlockHeight()+types.ICATxRetryBackoffBlocks, retry.NextAttemptHeight)
+
+	res, err := k.ICATxRetries(sdk.WrapSDKContext(ctx), &types.QueryICATxRetriesRequest{ChainId: hc.ChainId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(retries, res.Retries)
+
+	// nothing is submitted before the backoff has passed
+	k.ProcessICATxRetries(ctx.WithBlockHeight(retry.NextAttemptHeight - 1))
+	retry, _ = k.GetICATxRetry(ctx, retry.Id)
+	suite.Require().Equal(uint64(1), retry.Attempts)
+
+	// a failed attempt doubles the backoff
+	height := retry.NextAttemptHeight
+	k.ProcessICATxRetries(ctx.WithBlockHeight(height))
+	retry, _ = k.GetICATxRetry(ctx, retry.Id)
+	suite.Require().Equal(uint64(2), retry.Attempts)
+	suite.Require().Equal(height+types.ICATxRetryBackoffBlocks*2, retry.NextAttemptHeight)
+
+	// once the tx goes through the unbonding is tracked as initiated
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	hc.Active = true
+	k.SetHostChain(ctx, hc)
+
+	k.ProcessICATxRetries(ctx.WithBlockHeight(retry.NextAttemptHeight))
+	_, found = k.GetICATxRetry(ctx, retry.Id)
+	suite.Require().Equal(false, found)
+
+	unbonding, found := k.GetUnbonding(ctx, hc.ChainId, epoch)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(types.Unbonding_UNBONDING_INITIATED, unbonding.State)
+	suite.Require().NotEmpty(unbonding.IbcSequenceId)
+}
+
+func (suite *IntegrationTestSuite) TestICATxRetriesExhausted() {
+	ctx, _ := suite.ctx.CacheContext()
+	k := suite.app.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	params := k.GetParams(ctx)
+	params.MaxIcaTxRetries = 1
+	k.SetParams(ctx, params)
+
+	epoch := int64(4)
+	k.SetUnbonding(ctx, &types.Unbonding{
+		ChainId:      hc.ChainId,
+		EpochNumber:  epoch,
+		BurnAmount:   sdk.NewInt64Coin(hc.MintDenom(), 1000),
+		UnbondAmount: sdk.NewInt64Coin(hc.HostDenom, 1000),
+		State:        types.Unbonding_UNBONDING_RETRYING,
+	})
+
+	hc.Active = false
+	k.SetHostChain(ctx, hc)
+
+	queued := k.QueueICATxRetry(ctx, hc, types.ICATxRetry_RETRY_UNDELEGATION, epoch, nil, errors.New("ica error"))
+	suite.Require().Equal(true, queued)
+
+	// the unbonding fails once the retries run out
+	k.ProcessICATxRetries(ctx.WithBlockHeight(ctx.BlockHeight() + types.ICATxRetryBackoffBlocks))
+	suite.Require().Len(k.GetICATxRetriesForHostChain(ctx, hc.ChainId), 0)
+
+	unbonding, found := k.GetUnbonding(ctx, hc.ChainId, epoch)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(types.Unbonding_UNBONDING_FAILED, unbonding.State)
+
+	// without retries the failure is left to the workflow
+	params.MaxIcaTxRetries = 0
+	k.SetParams(ctx, params)
+	queued = k.QueueICATxRetry(ctx, hc, types.ICATxRetry_RETRY_UNDELEGATION, epoch, nil, errors.New("ica error"))
+	suite.Require().Equal(false, queued)
+}
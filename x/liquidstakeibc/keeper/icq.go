@@ -7,6 +7,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/gogoproto/proto"
 	icqtypes "github.com/persistenceOne/persistence-sdk/v2/x/interchainquery/types"
@@ -20,6 +21,13 @@ const (
 	RewardAccountBalances                = "reward-balances"
 	NonCompoundableRewardAccountBalances = "non-compoundable-reward-balances"
 	DelegationAccountBalances            = "delegation-balances"
+	BootstrapValidators                  = "bootstrap-validators"
+	StakingParams                        = "staking-params"
+	SlashingParams                       = "slashing-params"
+	WithdrawAddress                      = "withdraw-address"
+	DelegatorDelegations                 = "delegator-delegations"
+	ReconcileDelegation                  = "reconcile-delegation"
+	SigningInfo                          = "signing-info"
 )
 
 type CallbackFn func(Keeper, sdk.Context, []byte, icqtypes.Query) error
@@ -41,7 +49,13 @@ func (c Callbacks) AddCallback(id string, fn interface{}) icqtypes.QueryCallback
 }
 
 func (c Callbacks) Call(ctx sdk.Context, id string, args []byte, query icqtypes.Query) error {
-	return c.callbacks[id](c.k, ctx, args, query)
+	if err := c.callbacks[id](c.k, ctx, args, query); err != nil {
+		return err
+	}
+
+	// an answered query shows the host chain is reachable again
+	c.k.ResetHostChainFailures(ctx, query.ChainId)
+	return nil
 }
 
 func (c Callbacks) Has(id string) bool {
@@ -55,7 +69,14 @@ func (c Callbacks) RegisterCallbacks() icqtypes.QueryCallbacks {
 		AddCallback(RewardAccountBalances, CallbackFn(RewardsAccountBalanceCallback)).
 		AddCallback(NonCompoundableRewardAccountBalances, CallbackFn(NonCompoundableRewardsAccountBalanceCallback)).
 		AddCallback(DelegationAccountBalances, CallbackFn(DelegationAccountBalanceCallback)).
-		AddCallback(Delegation, CallbackFn(DelegationCallback))
+		AddCallback(Delegation, CallbackFn(DelegationCallback)).
+		AddCallback(BootstrapValidators, CallbackFn(BootstrapValidatorsCallback)).
+		AddCallback(StakingParams, CallbackFn(StakingParamsCallback)).
+		AddCallback(SlashingParams, CallbackFn(SlashingParamsCallback)).
+		AddCallback(WithdrawAddress, CallbackFn(WithdrawAddressCallback)).
+		AddCallback(DelegatorDelegations, CallbackFn(DelegatorDelegationsCallback)).
+		AddCallback(ReconcileDelegation, CallbackFn(ReconcileDelegationCallback)).
+		AddCallback(SigningInfo, CallbackFn(SigningInfoCallback))
 
 	return a.(Callbacks)
 }
@@ -76,6 +97,64 @@ func ValidatorCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Qu
 	return k.ProcessHostChainValidatorUpdates(ctx, hc, validator)
 }
 
+func BootstrapValidatorsCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Query) error {
+	hc, found := k.GetHostChain(ctx, query.ChainId)
+	if !found {
+		return fmt.Errorf("host chain with id %s is not registered", query.ChainId)
+	}
+
+	size, found := k.GetValidatorBootstrap(ctx, hc.ChainId)
+	if !found {
+		return nil
+	}
+
+	var response stakingtypes.QueryValidatorsResponse
+	if err := k.cdc.Unmarshal(data, &response); err != nil {
+		return fmt.Errorf("could not unmarshall ICQ validators response: %w", err)
+	}
+
+	k.DeleteValidatorBootstrap(ctx, hc.ChainId)
+
+	return k.BootstrapHostChainValidators(ctx, hc, response.Validators, size)
+}
+
+func StakingParamsCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Query) error {
+	hc, found := k.GetHostChain(ctx, query.ChainId)
+	if !found {
+		return fmt.Errorf("host chain with id %s is not registered", query.ChainId)
+	}
+
+	var response stakingtypes.QueryParamsResponse
+	if err := k.cdc.Unmarshal(data, &response); err != nil {
+		return fmt.Errorf("could not unmarshall ICQ staking params response: %w", err)
+	}
+
+	return k.UpdateHostChainStakingParams(ctx, hc, response.Params)
+}
+
+func SlashingParamsCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Query) error {
+	hc, found := k.GetHostChain(ctx, query.ChainId)
+	if !found {
+		return fmt.Errorf("host chain with id %s is not registered", query.ChainId)
+	}
+
+	var response slashingtypes.QueryParamsResponse
+	if err := k.cdc.Unmarshal(data, &response); err != nil {
+		return fmt.Errorf("could not unmarshall ICQ slashing params response: %w", err)
+	}
+
+	return k.UpdateHostChainSlashingParams(ctx, hc, response.Params)
+}
+
+func WithdrawAddressCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Query) error {
+	hc, found := k.GetHostChain(ctx, query.ChainId)
+	if !found {
+		return fmt.Errorf("host chain with id %s is not registered", query.ChainId)
+	}
+
+	return k.VerifyHostChainWithdrawAddress(ctx, hc, data)
+}
+
 func DelegationCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Query) error {
 	hc, found := k.GetHostChain(ctx, query.ChainId)
 	if !found {
@@ -96,6 +175,12 @@ func DelegationCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Q
 		)
 	}
 
+	// an unacknowledged undelegation or redelegation would be mistaken for a slash, the periodic delegations check
+	// verifies the delegation again once it is acknowledged
+	if k.HasDelegationDecreaseInFlight(ctx, hc.ChainId, validator.OperatorAddress) {
+		return nil
+	}
+
 	existingDelegatedAmount := validator.DelegatedAmount
 	delegatedAmount := validator.ExchangeRate.Mul(delegation.Shares)
 	slashedAmount := sdk.NewDecFromInt(validator.DelegatedAmount).Sub(delegatedAmount)
@@ -109,7 +194,9 @@ func DelegationCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Q
 
 		// update the delegated amount to the slashed amount
 		validator.DelegatedAmount = delegatedAmount.TruncateInt()
-		k.SetHostChainValidator(ctx, hc, validator)
+		if err := k.SetHostChainValidator(ctx, hc, validator); err != nil {
+			return err
+		}
 
 		// update the c value for the slashed chain
 		k.UpdateCValue(ctx, hc)
@@ -124,11 +211,78 @@ func DelegationCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Q
 				sdk.NewAttribute(types.AttributeSlashedAmount, slashedAmount.String()),
 			),
 		)
+
+		k.CheckMinActiveValidators(ctx, hc)
 	}
 
 	return nil
 }
 
+func DelegatorDelegationsCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Query) error {
+	hc, found := k.GetHostChain(ctx, query.ChainId)
+	if !found {
+		return fmt.Errorf("host chain with id %s is not registered", query.ChainId)
+	}
+
+	var response stakingtypes.QueryDelegatorDelegationsResponse
+	if err := k.cdc.Unmarshal(data, &response); err != nil {
+		return fmt.Errorf("could not unmarshall ICQ delegator delegations response: %w", err)
+	}
+
+	return k.CheckDelegationsForSlashes(ctx, hc, response.DelegationResponses)
+}
+
+func ReconcileDelegationCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Query) error {
+	hc, found := k.GetHostChain(ctx, query.ChainId)
+	if !found {
+		return fmt.Errorf("host chain with id %s is not registered", query.ChainId)
+	}
+
+	validator, found := reconciledValidator(hc, query.Request)
+	if !found {
+		return fmt.Errorf("validator of the reconciled delegation for host chain %s not found", query.ChainId)
+	}
+
+	// the delegation is missing from the host chain store once it is fully undelegated
+	shares := sdk.ZeroDec()
+	if len(data) > 0 {
+		delegation, err := stakingtypes.UnmarshalDelegation(k.cdc, data)
+		if err != nil {
+			return fmt.Errorf("could not unmarshall ICQ delegation response: %w", err)
+		}
+		shares = delegation.Shares
+	}
+
+	k.ReconcileDelegation(ctx, hc, validator, shares)
+
+	return nil
+}
+
+func SigningInfoCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Query) error {
+	hc, found := k.GetHostChain(ctx, query.ChainId)
+	if !found {
+		return fmt.Errorf("host chain with id %s is not registered", query.ChainId)
+	}
+
+	validator, found := signingInfoValidator(hc, query.Request)
+	if !found {
+		return fmt.Errorf("validator of the signing info for host chain %s not found", query.ChainId)
+	}
+
+	// the signing info is missing from the host chain store until the validator is first bonded
+	var missedBlocks int64
+	if len(data) > 0 {
+		var signingInfo slashingtypes.ValidatorSigningInfo
+		if err := k.cdc.Unmarshal(data, &signingInfo); err != nil {
+			return fmt.Errorf("could not unmarshall ICQ signing info response: %w", err)
+		}
+		missedBlocks = signingInfo.MissedBlocksCounter
+	}
+
+	validator.MissedBlocksCounter = missedBlocks
+	return k.SetHostChainValidator(ctx, hc, validator)
+}
+
 func DelegationAccountBalanceCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Query) error {
 	hc, found := k.GetHostChain(ctx, query.ChainId)
 	if !found {
@@ -142,9 +296,7 @@ func DelegationAccountBalanceCallback(k Keeper, ctx sdk.Context, data []byte, qu
 
 	hc.DelegationAccount.Balance = balance
 
-	k.SetHostChain(ctx, hc)
-
-	return nil
+	return k.SetHostChain(ctx, hc)
 }
 
 func RewardsAccountBalanceCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Query) error {
@@ -159,7 +311,7 @@ func RewardsAccountBalanceCallback(k Keeper, ctx sdk.Context, data []byte, query
 	}
 
 	hc.RewardsAccount.Balance = balance
-	if !hc.RewardsAccount.Balance.IsZero() {
+	if hc.IsRewardsTransferable() {
 
 		// limit the auto-compounded rewards to the host chain autocompound factor
 		var autocompoundRewards sdk.Coin
@@ -193,9 +345,7 @@ func RewardsAccountBalanceCallback(k Keeper, ctx sdk.Context, data []byte, query
 		)
 	}
 
-	k.SetHostChain(ctx, hc)
-
-	return nil
+	return k.SetHostChain(ctx, hc)
 }
 
 func NonCompoundableRewardsAccountBalanceCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Query) error {
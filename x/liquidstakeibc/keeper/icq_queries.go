@@ -3,7 +3,10 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
@@ -20,17 +23,41 @@ func (k *Keeper) QueryHostChainValidator(
 		return err
 	}
 
-	k.icqKeeper.MakeRequest(
-		ctx,
-		hc.ConnectionId,
-		hc.ChainId,
-		types.StakingStoreQuery,
-		stakingtypes.GetValidatorKey(byteAddress),
-		sdk.NewInt(int64(-1)),
-		types.ModuleName,
-		Validator,
-		0,
-	)
+	k.makeHostChainQuery(ctx, hc, types.StakingStoreQuery, stakingtypes.GetValidatorKey(byteAddress), Validator)
+
+	return nil
+}
+
+// QueryValidatorSigningInfo sends an ICQ query to retrieve the signing info of a host chain validator
+func (k *Keeper) QueryValidatorSigningInfo(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	validator *types.Validator,
+) error {
+	_, consAddr, err := bech32.DecodeAndConvert(validator.ConsensusAddress)
+	if err != nil {
+		return err
+	}
+
+	k.makeHostChainQuery(ctx, hc, types.SlashingStoreQuery, slashingtypes.ValidatorSigningInfoKey(consAddr), SigningInfo)
+
+	return nil
+}
+
+// QueryHostChainValidators sends an ICQ query to retrieve the host chain bonded validators
+func (k *Keeper) QueryHostChainValidators(
+	ctx sdk.Context,
+	hc *types.HostChain,
+) error {
+	request, err := k.cdc.Marshal(&stakingtypes.QueryValidatorsRequest{
+		Status:     stakingtypes.BondStatusBonded,
+		Pagination: &query.PageRequest{Limit: types.BootstrapValidatorsQueryLimit},
+	})
+	if err != nil {
+		return err
+	}
+
+	k.makeHostChainQuery(ctx, hc, types.StakingValidatorsQuery, request, BootstrapValidators)
 
 	return nil
 }
@@ -40,6 +67,15 @@ func (k *Keeper) QueryValidatorDelegation(
 	ctx sdk.Context,
 	hc *types.HostChain,
 	validator *types.Validator,
+) error {
+	return k.queryValidatorDelegation(ctx, hc, validator, Delegation)
+}
+
+func (k *Keeper) queryValidatorDelegation(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	validator *types.Validator,
+	callbackID string,
 ) error {
 	_, delegatorAddr, err := bech32.DecodeAndConvert(hc.DelegationAccount.Address)
 	if err != nil {
@@ -51,21 +87,35 @@ func (k *Keeper) QueryValidatorDelegation(
 		return err
 	}
 
-	k.icqKeeper.MakeRequest(
+	k.makeHostChainQuery(
 		ctx,
-		hc.ConnectionId,
-		hc.ChainId,
+		hc,
 		types.StakingStoreQuery,
 		stakingtypes.GetDelegationKey(delegatorAddr, validatorAddr),
-		sdk.NewInt(int64(-1)),
-		types.ModuleName,
-		Delegation,
-		0,
+		callbackID,
 	)
 
 	return nil
 }
 
+// QueryDelegatorDelegations sends an ICQ query to get all the delegations of the delegation host account
+func (k *Keeper) QueryDelegatorDelegations(
+	ctx sdk.Context,
+	hc *types.HostChain,
+) error {
+	request, err := k.cdc.Marshal(&stakingtypes.QueryDelegatorDelegationsRequest{
+		DelegatorAddr: hc.DelegationAccount.Address,
+		Pagination:    &query.PageRequest{Limit: types.DelegatorDelegationsQueryLimit},
+	})
+	if err != nil {
+		return err
+	}
+
+	k.makeHostChainQuery(ctx, hc, types.StakingDelegatorDelegationsQuery, request, DelegatorDelegations)
+
+	return nil
+}
+
 // QueryDelegationHostChainAccountBalance sends an ICQ query to get the delegation host account balance
 func (k *Keeper) QueryDelegationHostChainAccountBalance(
 	ctx sdk.Context,
@@ -78,17 +128,7 @@ func (k *Keeper) QueryDelegationHostChainAccountBalance(
 
 	key := banktypes.CreatePrefixedAccountStoreKey(byteAddress, []byte(hc.HostDenom))
 
-	k.icqKeeper.MakeRequest(
-		ctx,
-		hc.ConnectionId,
-		hc.ChainId,
-		types.BankStoreQuery,
-		key,
-		sdk.NewInt(int64(-1)),
-		types.ModuleName,
-		DelegationAccountBalances,
-		0,
-	)
+	k.makeHostChainQuery(ctx, hc, types.BankStoreQuery, key, DelegationAccountBalances)
 
 	return nil
 }
@@ -105,17 +145,7 @@ func (k *Keeper) QueryRewardsHostChainAccountBalance(
 
 	key := banktypes.CreatePrefixedAccountStoreKey(byteAddress, []byte(hc.HostDenom))
 
-	k.icqKeeper.MakeRequest(
-		ctx,
-		hc.ConnectionId,
-		hc.ChainId,
-		types.BankStoreQuery,
-		key,
-		sdk.NewInt(int64(-1)),
-		types.ModuleName,
-		RewardAccountBalances,
-		0,
-	)
+	k.makeHostChainQuery(ctx, hc, types.BankStoreQuery, key, RewardAccountBalances)
 
 	return nil
 }
@@ -132,17 +162,65 @@ func (k *Keeper) QueryNonCompoundableRewardsHostChainAccountBalance(
 
 	key := banktypes.CreatePrefixedAccountStoreKey(byteAddress, []byte(hc.RewardParams.Denom))
 
-	k.icqKeeper.MakeRequest(
-		ctx,
-		hc.ConnectionId,
-		hc.ChainId,
-		types.BankStoreQuery,
-		key,
-		sdk.NewInt(int64(-1)),
-		types.ModuleName,
-		NonCompoundableRewardAccountBalances,
-		0,
-	)
+	k.makeHostChainQuery(ctx, hc, types.BankStoreQuery, key, NonCompoundableRewardAccountBalances)
 
 	return nil
 }
+
+// QueryDelegationAccountWithdrawAddress sends an ICQ query to get the distribution withdraw address of the
+// delegation host account
+func (k *Keeper) QueryDelegationAccountWithdrawAddress(
+	ctx sdk.Context,
+	hc *types.HostChain,
+) error {
+	_, byteAddress, err := bech32.DecodeAndConvert(hc.DelegationAccount.Address)
+	if err != nil {
+		return err
+	}
+
+	k.makeHostChainQuery(ctx, hc, types.DistributionStoreQuery, distributiontypes.GetDelegatorWithdrawAddrKey(byteAddress), WithdrawAddress)
+
+	return nil
+}
+
+// QueryHostChainStakingParams sends an ICQ query to retrieve the host chain staking params
+func (k *Keeper) QueryHostChainStakingParams(
+	ctx sdk.Context,
+	hc *types.HostChain,
+) error {
+	request, err := k.cdc.Marshal(&stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return err
+	}
+
+	k.makeHostChainQuery(ctx, hc, types.StakingParamsQuery, request, StakingParams)
+
+	return nil
+}
+
+// QueryHostChainSlashingParams sends an ICQ query to retrieve the host chain slashing params
+func (k *Keeper) QueryHostChainSlashingParams(
+	ctx sdk.Context,
+	hc *types.HostChain,
+) error {
+	request, err := k.cdc.Marshal(&slashingtypes.QueryParamsRequest{})
+	if err != nil {
+		return err
+	}
+
+	k.makeHostChainQuery(ctx, hc, types.SlashingParamsQuery, request, SlashingParams)
+
+	return nil
+}
+
+// QueryHostChainRiskParams sends the ICQ queries to retrieve the host chain staking and slashing params
+func (k *Keeper) QueryHostChainRiskParams(
+	ctx sdk.Context,
+	hc *types.HostChain,
+) error {
+	if err := k.QueryHostChainStakingParams(ctx, hc); err != nil {
+		return err
+	}
+
+	return k.QueryHostChainSlashingParams(ctx, hc)
+}
@@ -6,6 +6,7 @@ import (
 	icqtypes "github.com/persistenceOne/persistence-sdk/v2/x/interchainquery/types"
 
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
 )
 
 func (suite *IntegrationTestSuite) TestValidatorCallback() {
@@ -271,6 +272,49 @@ func (suite *IntegrationTestSuite) TestRewardsAccountBalanceCallback() {
 	}
 }
 
+func (suite *IntegrationTestSuite) TestRewardsAccountBalanceCallbackThreshold() {
+	pstakeApp := suite.app
+	ctx, _ := suite.ctx.CacheContext()
+	k := pstakeApp.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(found, true)
+
+	for i := range hc.Validators {
+		hc.Validators[i].DelegatedAmount = sdk.NewInt(1000000)
+	}
+	hc.Params.MinRewardsTransferAmount = sdk.NewInt(100)
+	k.SetHostChain(ctx, hc)
+
+	makeData := func(amount int64) []byte {
+		coin := sdk.NewInt64Coin(hc.HostDenom, amount)
+		return pstakeApp.AppCodec().MustMarshal(&coin)
+	}
+	rewardsTransfers := func(ctx sdk.Context) int {
+		count := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeRewardsTransfer {
+				count++
+			}
+		}
+		return count
+	}
+
+	// a balance up to the threshold stays in the rewards account
+	belowCtx := ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(
+		keeper.RewardsAccountBalanceCallback(k, belowCtx, makeData(100), icqtypes.Query{ChainId: hc.ChainId}),
+	)
+	suite.Require().Equal(0, rewardsTransfers(belowCtx))
+	hc, _ = k.GetHostChain(belowCtx, hc.ChainId)
+	suite.Require().Equal(sdk.NewInt(100), hc.RewardsAccount.Balance.Amount)
+
+	aboveCtx := ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(
+		keeper.RewardsAccountBalanceCallback(k, aboveCtx, makeData(101), icqtypes.Query{ChainId: hc.ChainId}),
+	)
+	suite.Require().Equal(1, rewardsTransfers(aboveCtx))
+}
+
 func (suite *IntegrationTestSuite) TestNonCompoundableRewardsAccountBalanceCallback() {
 	pstakeApp, ctx := suite.app, suite.ctx
 	k := pstakeApp.LiquidStakeIBCKeeper
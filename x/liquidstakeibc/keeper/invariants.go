@@ -11,6 +11,7 @@ import (
 // RegisterInvariants registers the bank module invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "cvalue-limits", CValueLimits(k))
+	ir.RegisterRoute(types.ModuleName, "validator-set", ValidatorSet(k))
 }
 
 func CValueLimits(k Keeper) sdk.Invariant {
@@ -36,3 +37,27 @@ func CValueLimits(k Keeper) sdk.Invariant {
 		), broken
 	}
 }
+
+func ValidatorSet(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		hostChains := k.GetAllHostChains(ctx)
+		strs := make([]string, 0)
+		broken := false
+		for _, hc := range hostChains {
+			if err := hc.ValidateValidatorSet(); err != nil {
+				strs = append(strs, fmt.Sprintf("chainID: %s, err: %s \n", hc.ChainId, err))
+			}
+		}
+		invariantStr := ""
+		if len(strs) != 0 {
+			broken = true
+			for _, str := range strs {
+				invariantStr += fmt.Sprintf("%s\n", str)
+			}
+		}
+		return sdk.FormatInvariant(
+			types.ModuleName, "validator-set",
+			fmt.Sprintf("validator set out of bounds: %v, values as follows \n %s ", broken, invariantStr),
+		), broken
+	}
+}
@@ -21,3 +21,17 @@ func (suite *IntegrationTestSuite) TestCValueLimits() {
 	suite.True(broken)
 	suite.Equal("liquidstakeibc: cvalue-limits invariant\ncvalue out of bounds: true, values as follows \n chainID: testchain2-1, cValue: 2.000000000000000000 \n\n \n", str)
 }
+
+func (suite *IntegrationTestSuite) TestValidatorSet() {
+	pstakeApp, ctx := suite.app, suite.ctx
+	k := pstakeApp.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(found, true)
+	_, broken := keeper.ValidatorSet(k)(ctx)
+	suite.False(broken)
+
+	hc.Params.MinActiveValidators = uint32(len(hc.Validators) + 1)
+	k.SetHostChain(ctx, hc)
+	_, broken = keeper.ValidatorSet(k)(ctx)
+	suite.True(broken)
+}
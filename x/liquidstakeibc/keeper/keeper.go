@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -10,6 +11,8 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/gogoproto/proto"
@@ -35,6 +38,8 @@ type Keeper struct {
 	ibcKeeper           *ibckeeper.Keeper
 	ibcTransferKeeper   types.IBCTransferKeeper
 	icqKeeper           types.ICQKeeper
+	govKeeper           types.GovKeeper
+	distributionKeeper  types.DistributionKeeper
 
 	paramSpace paramtypes.Subspace
 
@@ -42,6 +47,10 @@ type Keeper struct {
 
 	hooks types.LiquidStakeIBCHooks
 
+	localhostQuerier types.LocalhostQuerier
+
+	contractKeeper types.ContractKeeper
+
 	authority string
 }
 
@@ -56,6 +65,8 @@ func NewKeeper(
 	ibcKeeper *ibckeeper.Keeper,
 	ibcTransferKeeper types.IBCTransferKeeper,
 	icqKeeper types.ICQKeeper,
+	govKeeper types.GovKeeper,
+	distributionKeeper types.DistributionKeeper,
 
 	paramSpace paramtypes.Subspace,
 
@@ -72,6 +83,8 @@ func NewKeeper(
 		ibcKeeper:           ibcKeeper,
 		ibcTransferKeeper:   ibcTransferKeeper,
 		icqKeeper:           icqKeeper,
+		govKeeper:           govKeeper,
+		distributionKeeper:  distributionKeeper,
 		storeKey:            storeKey,
 		paramSpace:          paramSpace,
 		msgRouter:           msgRouter,
@@ -85,6 +98,21 @@ func (k *Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// OperationLogger returns the module logger with the correlation id, workflow, host chain and epoch of an operation
+// attached, so all its lines can be found with a single grep. Callers add the ibc sequence once it is known.
+func (k *Keeper) OperationLogger(ctx sdk.Context, workflow string, chainID string, epoch int64) log.Logger {
+	return k.Logger(ctx).With(
+		types.AttributeCorrelationID,
+		types.CorrelationID(workflow, chainID, epoch),
+		"workflow",
+		workflow,
+		"host_chain",
+		chainID,
+		"epoch",
+		epoch,
+	)
+}
+
 // GetParams gets the total set of liquidstakeibc parameters.
 func (k *Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	store := ctx.KVStore(k.storeKey)
@@ -114,6 +142,16 @@ func (k *Keeper) GetUndelegationModuleAccount(ctx sdk.Context) authtypes.ModuleA
 	return k.accountKeeper.GetModuleAccount(ctx, types.UndelegationModuleAccount)
 }
 
+// GetHostChainFeeAddress returns the address receiving the protocol fees of a host chain, which defaults to the
+// module fee address
+func (k *Keeper) GetHostChainFeeAddress(ctx sdk.Context, hc *types.HostChain) string {
+	if hc.Params != nil && hc.Params.FeeAddress != "" {
+		return hc.Params.FeeAddress
+	}
+
+	return k.GetParams(ctx).FeeAddress
+}
+
 // SendProtocolFee to the community pool
 func (k *Keeper) SendProtocolFee(ctx sdk.Context, protocolFee sdk.Coins, moduleAccount, feeAddress string) error {
 	addr, err := sdk.AccAddressFromBech32(feeAddress)
@@ -142,6 +180,29 @@ func (k *Keeper) GetClientState(ctx sdk.Context, connectionID string) (exported.
 	return clientState, nil
 }
 
+// ValidateVestingDeposit rejects deposits of coins that are still locked in a vesting account, for the deposits whose
+// stk tokens can't be held until the coins vest, see AddVestingLock. Moving them freely would let the account bypass
+// its vesting schedule.
+func (k *Keeper) ValidateVestingDeposit(ctx sdk.Context, address sdk.AccAddress, amount sdk.Coin) error {
+	vestingAccount, ok := k.accountKeeper.GetAccount(ctx, address).(vestingexported.VestingAccount)
+	if !ok {
+		return nil
+	}
+
+	spendable := k.bankKeeper.SpendableCoins(ctx, address).AmountOf(amount.Denom)
+	if amount.Amount.LTE(spendable) {
+		return nil
+	}
+
+	return errorsmod.Wrapf(
+		types.ErrLockedVestingCoins,
+		"vesting account %s can deposit up to %s, %s locked",
+		address,
+		sdk.NewCoin(amount.Denom, spendable),
+		sdk.NewCoin(amount.Denom, vestingAccount.LockedCoins(ctx.BlockTime()).AmountOf(amount.Denom)),
+	)
+}
+
 // GetChainID gets the id of the host chain given a connection id
 func (k *Keeper) GetChainID(ctx sdk.Context, connectionID string) (string, error) {
 	clientState, err := k.GetClientState(ctx, connectionID)
@@ -212,6 +273,28 @@ func (k *Keeper) SendICATransfer(
 	receiver string,
 	portOwner string,
 ) (string, error) {
+	// the interchain accounts of localhost host chains live on this chain, a bank send is enough
+	if hc.IsLocalhost() {
+		sequenceID, err := k.GenerateAndExecuteICATx(
+			ctx,
+			hc.ConnectionId,
+			portOwner,
+			[]proto.Message{&banktypes.MsgSend{
+				FromAddress: sender,
+				ToAddress:   receiver,
+				Amount:      sdk.NewCoins(amount),
+			}},
+		)
+		if err != nil {
+			return "", fmt.Errorf(
+				"could not send ICA bank send for host chain %s",
+				hc.ChainId,
+			)
+		}
+
+		return sequenceID, nil
+	}
+
 	channel, found := k.ibcKeeper.ChannelKeeper.GetChannel(ctx, hc.PortId, hc.ChannelId)
 	if !found {
 		return "", fmt.Errorf(
@@ -220,7 +303,7 @@ func (k *Keeper) SendICATransfer(
 		)
 	}
 
-	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano() + (types.IBCTimeoutTimestamp).Nanoseconds())
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano() + k.GetIBCTimeout(ctx, hc.ConnectionId).Nanoseconds())
 
 	// prepare the msg transfer to bring the undelegation back
 	msgTransfer := ibctransfertypes.NewMsgTransfer(
@@ -252,16 +335,21 @@ func (k *Keeper) SendICATransfer(
 }
 
 func (k *Keeper) UpdateCValues(ctx sdk.Context) {
+	k.ClearWorkflowFailures(ctx, types.WorkflowCValue)
+
 	hostChains := k.GetAllHostChains(ctx)
 
 	for _, hc := range hostChains {
-		k.UpdateCValue(ctx, hc)
+		k.RunHostChainWorkflow(ctx, types.WorkflowCValue, hc.ChainId, 0, func(ctx sdk.Context) error {
+			k.UpdateCValue(ctx, hc)
+			return nil
+		})
 	}
 }
 
 func (k *Keeper) UpdateCValue(ctx sdk.Context, hc *types.HostChain) {
-	// total stk tokens minted
-	mintedAmount := k.bankKeeper.GetSupply(ctx, hc.MintDenom()).Amount
+	// total stk tokens minted, including the ones held back until their deposit is delegated
+	mintedAmount := k.bankKeeper.GetSupply(ctx, hc.MintDenom()).Amount.Add(k.GetPendingMintAmount(ctx, hc.ChainId))
 
 	// total tokenized staked amount
 	tokenizedStakedAmount := k.GetLSMDepositAmountUntokenized(ctx, hc.ChainId)
@@ -305,7 +393,12 @@ func (k *Keeper) UpdateCValue(ctx sdk.Context, hc *types.HostChain) {
 
 	hc.LastCValue = hc.CValue
 	hc.CValue = cValue
-	k.SetHostChain(ctx, hc)
+	hc.CValueUpdateHeight = ctx.BlockHeight()
+	hc.CValueUpdateTime = ctx.BlockTime()
+	if err := k.SetHostChain(ctx, hc); err != nil {
+		k.Logger(ctx).Error("Could not store the host chain c value.", "host_chain", hc.ChainId, "err", err.Error())
+		return
+	}
 
 	if err := k.Hooks().PostCValueUpdate(ctx, hc.MintDenom(), hc.HostDenom, hc.CValue); err != nil {
 		k.Logger(ctx).Error("PostCValueUpdate hook failed with ", "err:", err)
@@ -326,10 +419,28 @@ func (k *Keeper) UpdateCValue(ctx sdk.Context, hc *types.HostChain) {
 		),
 	)
 
+	// a c value out of the safety bounds of the host chain halts its workflows until they are resumed
+	if !hc.CValueWithinBounds() {
+		k.HaltHostChainWorkflows(ctx, hc, &types.EventCValueHalt{
+			ChainId:             hc.ChainId,
+			CValue:              hc.CValue,
+			LastCValue:          hc.LastCValue,
+			MinCValue:           hc.Params.MinCValue,
+			MaxCValue:           hc.Params.MaxCValue,
+			MintedAmount:        sdk.NewCoin(hc.MintDenom(), mintedAmount),
+			LiquidStakedAmount:  sdk.NewCoin(hc.HostDenom, liquidStakedAmount),
+			LsmTokenizedAmount:  sdk.NewCoin(hc.HostDenom, tokenizedStakedAmount),
+			StakedAmount:        sdk.NewCoin(hc.HostDenom, stakedAmount),
+			AmountOnPersistence: sdk.NewCoin(hc.HostDenom, amountOnPersistence),
+			AmountOnHostChain:   sdk.NewCoin(hc.HostDenom, amountOnHostChain),
+			UnbondingAmount:     sdk.NewCoin(hc.HostDenom, totalUnbondingAmount),
+		})
+	}
+
 	// if the c value is out of bounds, disable the chain
 	if !k.CValueWithinLimits(hc) {
 		hc.Active = false
-		k.SetHostChain(ctx, hc)
+		k.setHostChain(ctx, hc)
 
 		k.Logger(ctx).Error(fmt.Sprintf("C value out of limits !!! Disabling chain %s with c value %v.", hc.ChainId, hc.CValue))
 		ctx.EventManager().EmitEvent(
@@ -345,6 +456,32 @@ func (k *Keeper) UpdateCValue(ctx sdk.Context, hc *types.HostChain) {
 	}
 }
 
+// HaltHostChainWorkflows pauses every workflow of a host chain whose c value left its safety bounds. The other host
+// chains keep running, and the workflows stay paused until the flags are updated once the c value has been reviewed.
+func (k *Keeper) HaltHostChainWorkflows(ctx sdk.Context, hc *types.HostChain, halt *types.EventCValueHalt) {
+	hc.Flags.DepositsPaused = true
+	hc.Flags.UndelegationsPaused = true
+	hc.Flags.RewardsPaused = true
+	hc.Flags.LsmPaused = true
+	hc.Flags.RedelegationsPaused = true
+	k.setHostChain(ctx, hc)
+
+	k.Logger(ctx).Error(
+		"C value out of the safety bounds, halting the host chain workflows.",
+		"host_chain",
+		hc.ChainId,
+		"c_value",
+		hc.CValue,
+		"min_c_value",
+		hc.Params.MinCValue,
+		"max_c_value",
+		hc.Params.MaxCValue,
+	)
+	if err := ctx.EventManager().EmitTypedEvent(halt); err != nil {
+		k.Logger(ctx).Error("failed to emit c value halt event", "host_chain", hc.ChainId, "err", err)
+	}
+}
+
 func (k *Keeper) CValueWithinLimits(hc *types.HostChain) bool {
 	return hc.CValue.LT(hc.Params.UpperCValueLimit) && hc.CValue.GT(hc.Params.LowerCValueLimit)
 }
@@ -366,7 +503,10 @@ func (k *Keeper) RecalculateCValueLimits(ctx sdk.Context, hc *types.HostChain, m
 	// update the limits on the host chain
 	hc.Params.LowerCValueLimit = newLowerLimit
 	hc.Params.UpperCValueLimit = newUpperLimit
-	k.SetHostChain(ctx, hc)
+	if err := k.SetHostChain(ctx, hc); err != nil {
+		k.Logger(ctx).Error("Could not store the c value limits.", "host_chain", hc.ChainId, "err", err.Error())
+		return
+	}
 
 	k.Logger(ctx).Info(
 		fmt.Sprintf("Updated C Value limits for %s. Current C Value %s, new lower limit %s, new upper limit %s",
@@ -410,3 +550,17 @@ func (k *Keeper) SetHooks(hooks types.LiquidStakeIBCHooks) *Keeper {
 
 	return k
 }
+
+// SetLocalhostQuerier sets the querier answering the interchain queries of localhost host chains
+func (k *Keeper) SetLocalhostQuerier(querier types.LocalhostQuerier) *Keeper {
+	k.localhostQuerier = querier
+
+	return k
+}
+
+// SetContractKeeper sets the keeper executing the callback contract, no callback contract can be set without it
+func (k *Keeper) SetContractKeeper(contractKeeper types.ContractKeeper) *Keeper {
+	k.contractKeeper = contractKeeper
+
+	return k
+}
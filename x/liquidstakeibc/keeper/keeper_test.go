@@ -1,15 +1,21 @@
 package keeper_test
 
 import (
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/gogoproto/proto"
 	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
 	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 	solomachine "github.com/cosmos/ibc-go/v7/modules/light-clients/06-solomachine"
 
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
 )
 
@@ -22,12 +28,16 @@ func (suite *IntegrationTestSuite) TestGetSetParams() {
 		{
 			name: "normal params",
 			params: types.Params{
-				AdminAddress: "persistence10khgeppewe4rgfrcy809r9h00aquwxxxrk6glr",
-				FeeAddress:   "persistence1xruvjju28j0a5ud5325rfdak8f5a04h0s30mld",
+				AdminAddress:             "persistence10khgeppewe4rgfrcy809r9h00aquwxxxrk6glr",
+				FeeAddress:               "persistence1xruvjju28j0a5ud5325rfdak8f5a04h0s30mld",
+				MaxStkSupply:             sdk.ZeroInt(),
+				DelegationDriftTolerance: sdk.ZeroDec(),
 			},
 			expected: types.Params{
-				AdminAddress: "persistence10khgeppewe4rgfrcy809r9h00aquwxxxrk6glr",
-				FeeAddress:   "persistence1xruvjju28j0a5ud5325rfdak8f5a04h0s30mld",
+				AdminAddress:             "persistence10khgeppewe4rgfrcy809r9h00aquwxxxrk6glr",
+				FeeAddress:               "persistence1xruvjju28j0a5ud5325rfdak8f5a04h0s30mld",
+				MaxStkSupply:             sdk.ZeroInt(),
+				DelegationDriftTolerance: sdk.ZeroDec(),
 			},
 		},
 	}
@@ -110,6 +120,87 @@ func (suite *IntegrationTestSuite) TestSendProtocolFee() {
 	}
 }
 
+func (suite *IntegrationTestSuite) TestGetHostChainFeeAddress() {
+	pstakeApp, ctx := suite.app, suite.ctx
+	k := pstakeApp.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(found, true)
+
+	// the module fee address is used unless the host chain overrides it
+	suite.Require().Equal(k.GetParams(ctx).FeeAddress, k.GetHostChainFeeAddress(ctx, hc))
+
+	treasury := authtypes.NewModuleAddress("treasury").String()
+	_, err := keeper.NewMsgServerImpl(k).UpdateHostChain(ctx, &types.MsgUpdateHostChain{
+		Authority: k.GetParams(ctx).AdminAddress,
+		ChainId:   hc.ChainId,
+		Updates:   []*types.KVUpdate{{Key: types.KeyFeeAddress, Value: treasury}},
+	})
+	suite.Require().NoError(err)
+
+	hc, _ = k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(treasury, hc.Params.FeeAddress)
+	suite.Require().Equal(treasury, k.GetHostChainFeeAddress(ctx, hc))
+
+	hc.Params.FeeAddress = ""
+	suite.Require().Equal(k.GetParams(ctx).FeeAddress, k.GetHostChainFeeAddress(ctx, hc))
+}
+
+func (suite *IntegrationTestSuite) TestValidateVestingDeposit() {
+	pstakeApp, ctx := suite.app, suite.ctx
+	hc, found := pstakeApp.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(found, true)
+
+	vested := sdk.NewInt64Coin(hc.IBCDenom(), 400)
+	locked := sdk.NewInt64Coin(hc.IBCDenom(), 600)
+
+	address := sdk.AccAddress("vesting_account_____")
+	baseAccount := pstakeApp.AccountKeeper.NewAccountWithAddress(ctx, address).(*authtypes.BaseAccount)
+	vestingAccount := vestingtypes.NewDelayedVestingAccount(
+		baseAccount,
+		sdk.NewCoins(locked),
+		ctx.BlockTime().Add(time.Hour).Unix(),
+	)
+	pstakeApp.AccountKeeper.SetAccount(ctx, vestingAccount)
+	suite.Require().NoError(testutil.FundAccount(pstakeApp.BankKeeper, ctx, address, sdk.NewCoins(vested.Add(locked))))
+
+	tc := []struct {
+		name    string
+		address sdk.AccAddress
+		amount  sdk.Coin
+		err     error
+	}{
+		{
+			name:    "regular account",
+			address: suite.chainA.SenderAccount.GetAddress(),
+			amount:  vested.Add(locked),
+			err:     nil,
+		},
+		{
+			name:    "vested coins",
+			address: address,
+			amount:  vested,
+			err:     nil,
+		},
+		{
+			name:    "locked coins",
+			address: address,
+			amount:  vested.AddAmount(sdk.OneInt()),
+			err:     types.ErrLockedVestingCoins,
+		},
+	}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+			err := pstakeApp.LiquidStakeIBCKeeper.ValidateVestingDeposit(ctx, t.address, t.amount)
+			if t.err != nil {
+				suite.Require().ErrorIs(err, t.err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
 func (suite *IntegrationTestSuite) TestDelegateAccountPortOwner() {
 	pstakeApp, ctx := suite.app, suite.ctx
 	hc, found := pstakeApp.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.chainB.ChainID)
@@ -277,6 +368,130 @@ func (suite *IntegrationTestSuite) TestUpdateCValue() {
 	suite.Require().Equal(false, hc.Active)
 }
 
+func (suite *IntegrationTestSuite) TestCValueHalt() {
+	pstakeApp := suite.app
+	ctx, _ := suite.ctx.CacheContext()
+	k := pstakeApp.LiquidStakeIBCKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	// 1000 stk tokens backed by a 900 tokens delegation
+	stk := sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), 1000))
+	suite.Require().NoError(pstakeApp.MintKeeper.MintCoins(ctx, stk))
+	hc.Validators[0].DelegatedAmount = sdk.NewInt(900)
+	hc.Params.UpperCValueLimit = sdk.NewDec(2)
+	hc.Params.LowerCValueLimit = sdk.MustNewDecFromStr("0.5")
+	k.SetHostChainValidator(ctx, hc, hc.Validators[0])
+
+	// the bounds are per host chain and validated together
+	err := k.ApplyHostChainUpdates(ctx, hc, []*types.KVUpdate{
+		{Key: types.KeyMinCValue, Value: "1.3"},
+		{Key: types.KeyMaxCValue, Value: "1.2"},
+	})
+	suite.Require().Error(err)
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().NoError(k.ApplyHostChainUpdates(ctx, hc, []*types.KVUpdate{
+		{Key: types.KeyMinCValue, Value: "0.9"},
+		{Key: types.KeyMaxCValue, Value: "1.2"},
+	}))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.UpdateCValue(ctx, hc)
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().True(hc.CValueWithinBounds())
+	suite.Require().False(hc.Flags.DepositsPaused)
+
+	// a c value above the max halts the workflows of the host chain only
+	hc.Params.MaxCValue = sdk.MustNewDecFromStr("1.1")
+	k.SetHostChain(ctx, hc)
+	k.UpdateCValue(ctx, hc)
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().False(hc.CValueWithinBounds())
+	suite.Require().True(hc.Active)
+	suite.Require().True(hc.Flags.DepositsPaused)
+	suite.Require().True(hc.Flags.UndelegationsPaused)
+	suite.Require().True(hc.Flags.RewardsPaused)
+	suite.Require().True(hc.Flags.LsmPaused)
+	suite.Require().True(hc.Flags.RedelegationsPaused)
+
+	var halt *types.EventCValueHalt
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != proto.MessageName(&types.EventCValueHalt{}) {
+			continue
+		}
+		typed, err := sdk.ParseTypedEvent(abci.Event(event))
+		suite.Require().NoError(err)
+		halt = typed.(*types.EventCValueHalt)
+	}
+	suite.Require().NotNil(halt)
+	suite.Require().Equal(hc.ChainId, halt.ChainId)
+	suite.Require().Equal(hc.CValue, halt.CValue)
+	suite.Require().Equal(sdk.NewInt(1000), halt.MintedAmount.Amount)
+	suite.Require().Equal(sdk.NewInt(900), halt.StakedAmount.Amount)
+
+	// no stk tokens are minted or redeemed at the out of bounds c value
+	delegator := suite.chainA.SenderAccount.GetAddress()
+	_, err = msgServer.LiquidStake(ctx, types.NewMsgLiquidStake(sdk.NewInt64Coin(hc.IBCDenom(), 1000000), delegator))
+	suite.Require().ErrorIs(err, types.ErrCValueOutOfLimits)
+	_, err = msgServer.Redeem(ctx, types.NewMsgRedeem(sdk.NewInt64Coin(hc.MintDenom(), 100), delegator))
+	suite.Require().ErrorIs(err, types.ErrCValueOutOfLimits)
+}
+
+func (suite *IntegrationTestSuite) TestEpochCValue() {
+	pstakeApp := suite.app
+	ctx, _ := suite.ctx.CacheContext()
+	k := pstakeApp.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	// 1000 stk tokens backed by a 1000 tokens delegation
+	stk := sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), 1000))
+	suite.Require().NoError(pstakeApp.MintKeeper.MintCoins(ctx, stk))
+	hc.Validators[0].DelegatedAmount = sdk.NewInt(1000)
+	k.SetHostChainValidator(ctx, hc, hc.Validators[0])
+	k.CreateDeposits(ctx, k.GetEpochNumber(ctx, types.DelegationEpoch))
+	k.UpdateCValue(ctx, hc)
+	suite.Require().Equal(sdk.OneDec(), hc.CValue)
+
+	rewards := sdk.NewInt64Coin(hc.IBCDenom(), 20)
+	receiveRewards := func(ctx sdk.Context, hc *types.HostChain) {
+		suite.Require().NoError(pstakeApp.BankKeeper.SendCoinsFromAccountToModule(
+			ctx,
+			suite.chainA.SenderAccount.GetAddress(),
+			types.DepositModuleAccount,
+			sdk.NewCoins(rewards),
+		))
+		suite.Require().NoError(k.ReceiveHostChainTransfer(
+			ctx,
+			hc,
+			hc.RewardsAccount.Address,
+			k.GetDepositModuleAccount(ctx).GetAddress().String(),
+			rewards.Amount,
+			"sequence-1",
+		))
+	}
+
+	// by default the autocompounded rewards lower the c value as soon as they arrive
+	continuousCtx, _ := ctx.CacheContext()
+	receiveRewards(continuousCtx, hc)
+	updated, _ := k.GetHostChain(continuousCtx, hc.ChainId)
+	suite.Require().True(updated.CValue.LT(sdk.OneDec()))
+
+	// with the epoch c value the rewards are only accounted for at the start of the next c value epoch
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	hc.Flags.EpochCValue = true
+	k.SetHostChain(ctx, hc)
+	receiveRewards(ctx, hc)
+	updated, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(sdk.OneDec(), updated.CValue)
+
+	suite.Require().NoError(k.BeforeEpochStart(ctx, types.CValueEpoch, k.GetEpochNumber(ctx, types.CValueEpoch)+1))
+	committed, _ := k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().True(committed.CValue.LT(sdk.OneDec()))
+	suite.Require().Equal(ctx.BlockHeight(), committed.CValueUpdateHeight)
+}
+
 func (suite *IntegrationTestSuite) TestRecalculateCValueLimits() {
 	pstakeApp, ctx := suite.app, suite.ctx
 	hc, found := pstakeApp.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.chainB.ChainID)
@@ -302,3 +517,43 @@ func (suite *IntegrationTestSuite) TestRecalculateCValueLimits() {
 
 	suite.Require().Equal(expectedUpper, hc.Params.UpperCValueLimit)
 }
+
+func (suite *IntegrationTestSuite) TestDepositCorrelationID() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	epoch := k.GetEpochNumber(ctx, types.DelegationEpoch)
+	k.SetDeposit(ctx, &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  sdk.NewCoin(hc.IBCDenom(), sdk.ZeroInt()),
+		Epoch:   epoch,
+		State:   types.Deposit_DEPOSIT_PENDING,
+	})
+
+	delegator := suite.chainA.SenderAccount.GetAddress()
+	amount := sdk.NewCoin(hc.IBCDenom(), MinDeposit.MulRaw(1000))
+	suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, ctx, delegator, sdk.NewCoins(amount)))
+
+	// the liquid stake and the transfer of its deposit carry the same correlation id
+	correlationID := types.CorrelationID(types.WorkflowDeposit, hc.ChainId, epoch)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err := msgServer.LiquidStake(ctx, types.NewMsgLiquidStake(amount, delegator))
+	suite.Require().NoError(err)
+	k.DepositWorkflow(ctx, epoch)
+
+	correlated := make(map[string]bool)
+	for _, event := range ctx.EventManager().Events() {
+		for _, attribute := range event.Attributes {
+			if attribute.Key == types.AttributeCorrelationID {
+				suite.Require().Equal(correlationID, attribute.Value)
+				correlated[event.Type] = true
+			}
+		}
+	}
+	suite.Require().True(correlated[types.EventTypeLiquidStake])
+	suite.Require().True(correlated[types.EventTypeDelegationWorkflow])
+}
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// SetLiquidityIncentive stores the protocol fees accrued for the liquidity incentive address of a host chain
+func (k *Keeper) SetLiquidityIncentive(ctx sdk.Context, incentive *types.LiquidityIncentive) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LiquidityIncentiveKey)
+	bytes := k.cdc.MustMarshal(incentive)
+	store.Set([]byte(incentive.ChainId), bytes)
+}
+
+// GetLiquidityIncentive returns the protocol fees accrued for the liquidity incentive address of a host chain
+func (k *Keeper) GetLiquidityIncentive(ctx sdk.Context, chainID string) (*types.LiquidityIncentive, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LiquidityIncentiveKey)
+	bytes := store.Get([]byte(chainID))
+	if len(bytes) == 0 {
+		return &types.LiquidityIncentive{ChainId: chainID, Amount: sdk.NewCoins()}, false
+	}
+
+	var incentive types.LiquidityIncentive
+	k.cdc.MustUnmarshal(bytes, &incentive)
+	return &incentive, true
+}
+
+// GetAllLiquidityIncentives returns the protocol fees accrued for the liquidity incentive addresses of all host chains
+func (k *Keeper) GetAllLiquidityIncentives(ctx sdk.Context) []*types.LiquidityIncentive {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LiquidityIncentiveKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	incentives := make([]*types.LiquidityIncentive, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		incentive := types.LiquidityIncentive{}
+		k.cdc.MustUnmarshal(iterator.Value(), &incentive)
+		incentives = append(incentives, &incentive)
+	}
+
+	return incentives
+}
+
+// DeleteLiquidityIncentive removes the accrued liquidity incentive of a host chain
+func (k *Keeper) DeleteLiquidityIncentive(ctx sdk.Context, chainID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LiquidityIncentiveKey)
+	store.Delete([]byte(chainID))
+}
+
+// SendHostChainProtocolFee sends a protocol fee charged on a host chain to the host chain fee address. The share of
+// the fee set by the liquidity incentive rate is moved to the module account instead, where it accrues until it is
+// streamed to the liquidity incentive address.
+func (k *Keeper) SendHostChainProtocolFee(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	protocolFee sdk.Coins,
+	moduleAccount string,
+) error {
+	incentive := hc.Params.GetLiquidityIncentiveShare(protocolFee)
+	if !incentive.IsZero() {
+		if moduleAccount != types.ModuleName {
+			err := k.bankKeeper.SendCoins(
+				ctx,
+				k.accountKeeper.GetModuleAccount(ctx, moduleAccount).GetAddress(),
+				k.accountKeeper.GetModuleAccount(ctx, types.ModuleName).GetAddress(),
+				incentive,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		accrued, _ := k.GetLiquidityIncentive(ctx, hc.ChainId)
+		accrued.Amount = accrued.Amount.Add(incentive...)
+		k.SetLiquidityIncentive(ctx, accrued)
+
+		protocolFee = protocolFee.Sub(incentive...)
+	}
+
+	if protocolFee.IsZero() {
+		return nil
+	}
+
+	return k.SendProtocolFee(ctx, protocolFee, moduleAccount, k.GetHostChainFeeAddress(ctx, hc))
+}
+
+// StreamLiquidityIncentives sends the protocol fees accrued for each host chain to its liquidity incentive address.
+// Fees accrued for a host chain which no longer has a liquidity incentive address are sent to its fee address.
+func (k *Keeper) StreamLiquidityIncentives(ctx sdk.Context) {
+	for _, incentive := range k.GetAllLiquidityIncentives(ctx) {
+		hc, found := k.GetHostChain(ctx, incentive.ChainId)
+		if !found {
+			continue
+		}
+
+		recipient := hc.Params.LiquidityIncentiveAddress
+		if recipient == "" {
+			recipient = k.GetHostChainFeeAddress(ctx, hc)
+		}
+
+		recipientAddress, err := sdk.AccAddressFromBech32(recipient)
+		if err != nil {
+			k.Logger(ctx).Error(
+				"Invalid liquidity incentive address.",
+				"host_chain",
+				hc.ChainId,
+				"address",
+				recipient,
+			)
+			continue
+		}
+
+		// the incentive address can be a module account, so the blocked addresses check is skipped
+		err = k.bankKeeper.SendCoins(
+			ctx,
+			k.accountKeeper.GetModuleAccount(ctx, types.ModuleName).GetAddress(),
+			recipientAddress,
+			incentive.Amount,
+		)
+		if err != nil {
+			k.Logger(ctx).Error(
+				"Could not stream the liquidity incentive, it will be retried on the next epoch.",
+				"host_chain",
+				hc.ChainId,
+				"amount",
+				incentive.Amount.String(),
+				"err",
+				err.Error(),
+			)
+			continue
+		}
+
+		k.DeleteLiquidityIncentive(ctx, hc.ChainId)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLiquidityIncentiveStreamed,
+				sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+				sdk.NewAttribute(types.AttributeLiquidityIncentiveAddress, recipient),
+				sdk.NewAttribute(types.AttributeLiquidityIncentiveAmount, incentive.Amount.String()),
+			),
+		)
+	}
+}
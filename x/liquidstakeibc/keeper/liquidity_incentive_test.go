@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestLiquidityIncentiveStreaming() {
+	pstakeApp, ctx := suite.app, suite.ctx
+	k := pstakeApp.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	incentiveAddress := authtypes.NewModuleAddress("liquidity_incentive")
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	// a rate without an address to stream the fees to is rejected
+	_, err := msgServer.UpdateHostChain(ctx, &types.MsgUpdateHostChain{
+		Authority: k.GetParams(ctx).AdminAddress,
+		ChainId:   hc.ChainId,
+		Updates:   []*types.KVUpdate{{Key: types.KeyLiquidityIncentiveRate, Value: "0.2"}},
+	})
+	suite.Require().Error(err)
+
+	_, err = msgServer.UpdateHostChain(ctx, &types.MsgUpdateHostChain{
+		Authority: k.GetParams(ctx).AdminAddress,
+		ChainId:   hc.ChainId,
+		Updates: []*types.KVUpdate{
+			{Key: types.KeyLiquidityIncentiveRate, Value: "0.2"},
+			{Key: types.KeyLiquidityIncentiveAddress, Value: incentiveAddress.String()},
+		},
+	})
+	suite.Require().NoError(err)
+	hc, _ = k.GetHostChain(ctx, suite.chainB.ChainID)
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), 1000))
+	suite.Require().NoError(pstakeApp.MintKeeper.MintCoins(ctx, fee))
+	suite.Require().NoError(
+		pstakeApp.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.DepositModuleAccount, fee),
+	)
+
+	feeAddress, err := sdk.AccAddressFromBech32(k.GetHostChainFeeAddress(ctx, hc))
+	suite.Require().NoError(err)
+	feeBalance := pstakeApp.BankKeeper.GetBalance(ctx, feeAddress, hc.IBCDenom())
+
+	// the incentive share is set aside in the module account
+	suite.Require().NoError(k.SendHostChainProtocolFee(ctx, hc, fee, types.DepositModuleAccount))
+	suite.Require().Equal(
+		feeBalance.AddAmount(sdk.NewInt(800)),
+		pstakeApp.BankKeeper.GetBalance(ctx, feeAddress, hc.IBCDenom()),
+	)
+	incentive, found := k.GetLiquidityIncentive(ctx, hc.ChainId)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), 200)), incentive.Amount)
+
+	// fees accrue until the next delegation epoch
+	suite.Require().NoError(fundAndSendProtocolFee(suite, hc, types.ModuleName, 500))
+	incentive, _ = k.GetLiquidityIncentive(ctx, hc.ChainId)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), 300)), incentive.Amount)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.StreamLiquidityIncentives(ctx)
+	suite.Require().Equal(
+		sdk.NewInt64Coin(hc.IBCDenom(), 300),
+		pstakeApp.BankKeeper.GetBalance(ctx, incentiveAddress, hc.IBCDenom()),
+	)
+	_, found = k.GetLiquidityIncentive(ctx, hc.ChainId)
+	suite.Require().Equal(false, found)
+
+	streamed := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeLiquidityIncentiveStreamed {
+			streamed++
+		}
+	}
+	suite.Require().Equal(1, streamed)
+}
+
+// fundAndSendProtocolFee funds moduleAccount with amount host chain tokens and charges them as a protocol fee
+func fundAndSendProtocolFee(suite *IntegrationTestSuite, hc *types.HostChain, moduleAccount string, amount int64) error {
+	fee := sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), amount))
+	if err := suite.app.MintKeeper.MintCoins(suite.ctx, fee); err != nil {
+		return err
+	}
+	if moduleAccount != minttypes.ModuleName {
+		err := suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, minttypes.ModuleName, moduleAccount, fee)
+		if err != nil {
+			return err
+		}
+	}
+
+	return suite.app.LiquidStakeIBCKeeper.SendHostChainProtocolFee(suite.ctx, hc, fee, moduleAccount)
+}
@@ -0,0 +1,233 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icqkeeper "github.com/persistenceOne/persistence-sdk/v2/x/interchainquery/keeper"
+	icqtypes "github.com/persistenceOne/persistence-sdk/v2/x/interchainquery/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+var _ types.LocalhostQuerier = localhostQuerier{}
+
+// localhostQuerier answers store queries by reading the module stores and gRPC queries through the gRPC router
+type localhostQuerier struct {
+	storeKeys  map[string]*storetypes.KVStoreKey
+	grpcRouter *baseapp.GRPCQueryRouter
+}
+
+// NewLocalhostQuerier returns a querier answering the interchain queries of localhost host chains from the given
+// module stores, keyed by store name, and gRPC query router
+func NewLocalhostQuerier(
+	storeKeys map[string]*storetypes.KVStoreKey,
+	grpcRouter *baseapp.GRPCQueryRouter,
+) types.LocalhostQuerier {
+	return localhostQuerier{storeKeys: storeKeys, grpcRouter: grpcRouter}
+}
+
+func (q localhostQuerier) Query(ctx sdk.Context, queryType string, request []byte) ([]byte, error) {
+	// store queries have the store/<store name>/key form
+	if strings.HasPrefix(queryType, "store/") {
+		path := strings.Split(queryType, "/")
+		if len(path) != 3 || path[2] != "key" {
+			return nil, fmt.Errorf("unsupported store query %s", queryType)
+		}
+
+		storeKey, found := q.storeKeys[path[1]]
+		if !found {
+			return nil, fmt.Errorf("store %s not found", path[1])
+		}
+
+		return ctx.KVStore(storeKey).Get(request), nil
+	}
+
+	handler := q.grpcRouter.Route(queryType)
+	if handler == nil {
+		return nil, fmt.Errorf("no gRPC route for query %s", queryType)
+	}
+
+	res, err := handler(ctx, abci.RequestQuery{Data: request, Path: queryType})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Value, nil
+}
+
+// SendLocalhostDeposit sends a deposit of a localhost host chain to its delegation account. The bank send lands right
+// away, so the deposit is received without waiting on any acknowledgement.
+func (k *Keeper) SendLocalhostDeposit(ctx sdk.Context, hc *types.HostChain, deposit *types.Deposit) error {
+	delegationAccount, err := sdk.AccAddressFromBech32(hc.DelegationAccount.Address)
+	if err != nil {
+		return err
+	}
+
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		types.DepositModuleAccount,
+		delegationAccount,
+		sdk.NewCoins(deposit.Amount),
+	); err != nil {
+		return err
+	}
+
+	deposit.IbcSequenceId = ""
+	deposit.State = types.Deposit_DEPOSIT_RECEIVED
+	k.SetDeposit(ctx, deposit)
+
+	hc.DelegationAccount.Balance = hc.DelegationAccount.Balance.AddAmount(deposit.Amount.Amount)
+	if err := k.SetHostChain(ctx, hc); err != nil {
+		return err
+	}
+
+	k.OperationLogger(ctx, types.WorkflowDeposit, hc.ChainId, deposit.Epoch).Info(
+		"Sent localhost deposit.",
+		"amount",
+		deposit.Amount.String(),
+	)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDelegationWorkflow,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeEpoch, strconv.FormatInt(deposit.Epoch, 10)),
+			sdk.NewAttribute(types.AttributeTotalEpochDepositAmount, sdk.NewCoin(hc.HostDenom, deposit.Amount.Amount).String()),
+			sdk.NewAttribute(types.AttributeIBCSequenceID, deposit.IbcSequenceId),
+			types.NewCorrelationAttribute(types.WorkflowDeposit, hc.ChainId, deposit.Epoch),
+		),
+		sdk.NewEvent(
+			types.EventStakingDepositTransferReceived,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeIBCSequenceID, deposit.IbcSequenceId),
+			types.NewCorrelationAttribute(types.WorkflowDeposit, hc.ChainId, deposit.Epoch),
+		),
+	})
+
+	return nil
+}
+
+// makeHostChainQuery sends an interchain query to a host chain. The queries of localhost host chains are kept by the
+// module and answered from the chain state in the next begin blocker, the interchain query module can't verify their
+// proofs as the localhost client has no consensus states.
+func (k *Keeper) makeHostChainQuery(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	queryType string,
+	request []byte,
+	callbackID string,
+) {
+	if !hc.IsLocalhost() {
+		k.icqKeeper.MakeRequest(
+			ctx,
+			hc.ConnectionId,
+			hc.ChainId,
+			queryType,
+			request,
+			sdk.NewInt(int64(-1)),
+			types.ModuleName,
+			callbackID,
+			0,
+		)
+		return
+	}
+
+	k.SetLocalhostQuery(ctx, icqtypes.Query{
+		Id:           icqkeeper.GenerateQueryHash(hc.ConnectionId, hc.ChainId, queryType, request, types.ModuleName),
+		ConnectionId: hc.ConnectionId,
+		ChainId:      hc.ChainId,
+		QueryType:    queryType,
+		Request:      request,
+		Period:       sdk.NewInt(int64(-1)),
+		LastHeight:   sdk.ZeroInt(),
+		CallbackId:   callbackID,
+		LastEmission: sdk.ZeroInt(),
+	})
+}
+
+// SetLocalhostQuery stores a pending query of a localhost host chain, replacing the same query if it is pending
+func (k *Keeper) SetLocalhostQuery(ctx sdk.Context, query icqtypes.Query) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LocalhostQueryKey)
+	bytes := k.cdc.MustMarshal(&query)
+	store.Set([]byte(query.Id), bytes)
+}
+
+// DeleteLocalhostQuery removes a pending query of a localhost host chain
+func (k *Keeper) DeleteLocalhostQuery(ctx sdk.Context, id string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LocalhostQueryKey)
+	store.Delete([]byte(id))
+}
+
+// GetAllLocalhostQueries returns the pending queries of the localhost host chains
+func (k *Keeper) GetAllLocalhostQueries(ctx sdk.Context) []icqtypes.Query {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LocalhostQueryKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	queries := make([]icqtypes.Query, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var query icqtypes.Query
+		k.cdc.MustUnmarshal(iterator.Value(), &query)
+		queries = append(queries, query)
+	}
+
+	return queries
+}
+
+// DoAnswerLocalhostQueries answers the pending queries of the localhost host chains and runs their callbacks, as the
+// interchain query module does with the relayed responses of the other host chains
+func (k *Keeper) DoAnswerLocalhostQueries(ctx sdk.Context) {
+	queries := k.GetAllLocalhostQueries(ctx)
+	if len(queries) == 0 {
+		return
+	}
+
+	callbacks := k.CallbackHandler().RegisterCallbacks()
+	for _, query := range queries {
+		// the callbacks can send the same query again
+		k.DeleteLocalhostQuery(ctx, query.Id)
+
+		if k.localhostQuerier == nil {
+			k.Logger(ctx).Error("No localhost querier set, dropping query.", "query", query.Id)
+			continue
+		}
+
+		data, err := k.localhostQuerier.Query(ctx, query.QueryType, query.Request)
+		if err != nil {
+			k.Logger(ctx).Error(
+				"Could not answer localhost query.",
+				"query",
+				query.Id,
+				"type",
+				query.QueryType,
+				"err",
+				err,
+			)
+			k.RecordHostChainFailure(ctx, query.ChainId, err)
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err = callbacks.Call(cacheCtx, query.CallbackId, data, query); err != nil {
+			k.Logger(ctx).Error(
+				"Localhost query callback failed.",
+				"query",
+				query.Id,
+				"callback",
+				query.CallbackId,
+				"err",
+				err,
+			)
+			k.RecordHostChainFailure(ctx, query.ChainId, err)
+			continue
+		}
+		write()
+	}
+}
@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestLocalhostQuerier() {
+	pstakeApp := suite.app
+	ctx, _ := suite.ctx.CacheContext()
+	bondDenom := pstakeApp.StakingKeeper.BondDenom(ctx)
+
+	querier := keeper.NewLocalhostQuerier(
+		map[string]*storetypes.KVStoreKey{banktypes.StoreKey: pstakeApp.GetKey(banktypes.StoreKey)},
+		pstakeApp.GRPCQueryRouter(),
+	)
+
+	// store queries read the store of the module
+	sender := suite.chainA.SenderAccount.GetAddress()
+	data, err := querier.Query(
+		ctx,
+		types.BankStoreQuery,
+		banktypes.CreatePrefixedAccountStoreKey(sender, []byte(bondDenom)),
+	)
+	suite.Require().NoError(err)
+	balance, err := bankkeeper.UnmarshalBalanceCompat(pstakeApp.AppCodec(), data, bondDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(pstakeApp.BankKeeper.GetBalance(ctx, sender, bondDenom), balance)
+
+	// gRPC queries go through the query router
+	request, err := (&stakingtypes.QueryParamsRequest{}).Marshal()
+	suite.Require().NoError(err)
+	data, err = querier.Query(ctx, types.StakingParamsQuery, request)
+	suite.Require().NoError(err)
+	var response stakingtypes.QueryParamsResponse
+	suite.Require().NoError(response.Unmarshal(data))
+	suite.Require().Equal(pstakeApp.StakingKeeper.GetParams(ctx), response.Params)
+
+	_, err = querier.Query(ctx, types.StakingStoreQuery, []byte{0x01})
+	suite.Require().Error(err)
+	_, err = querier.Query(ctx, "store/bank/subspace", []byte{0x01})
+	suite.Require().Error(err)
+	_, err = querier.Query(ctx, "/cosmos.unknown.v1beta1.Query/Params", request)
+	suite.Require().Error(err)
+}
+
+func (suite *IntegrationTestSuite) TestRegisterLocalhostHostChain() {
+	pstakeApp := suite.app
+	ctx, _ := suite.ctx.CacheContext()
+	bondDenom := pstakeApp.StakingKeeper.BondDenom(ctx)
+	msgServer := keeper.NewMsgServerImpl(pstakeApp.LiquidStakeIBCKeeper)
+
+	msg := types.NewMsgRegisterHostChain(
+		ibcexported.LocalhostConnectionID,
+		"",
+		"",
+		"0",
+		"0",
+		"0",
+		"0",
+		bondDenom,
+		sdk.OneInt(),
+		4,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		2,
+	)
+	suite.Require().NoError(msg.ValidateBasic())
+	_, err := msgServer.RegisterHostChain(ctx, msg)
+	suite.Require().NoError(err)
+
+	hc, found := pstakeApp.LiquidStakeIBCKeeper.GetHostChain(ctx, ctx.ChainID())
+	suite.Require().True(found)
+	suite.Require().True(hc.IsLocalhost())
+	suite.Require().Equal(bondDenom, hc.IBCDenom())
+
+	// the risk params queries are answered in the next begin blocker
+	suite.Require().NotEmpty(pstakeApp.LiquidStakeIBCKeeper.GetAllLocalhostQueries(ctx))
+	pstakeApp.LiquidStakeIBCKeeper.DoAnswerLocalhostQueries(ctx)
+	suite.Require().Empty(pstakeApp.LiquidStakeIBCKeeper.GetAllLocalhostQueries(ctx))
+
+	hc, _ = pstakeApp.LiquidStakeIBCKeeper.GetHostChain(ctx, ctx.ChainID())
+	suite.Require().Equal(pstakeApp.StakingKeeper.UnbondingTime(ctx), hc.RiskParams.UnbondingPeriod)
+}
+
+func (suite *IntegrationTestSuite) TestLocalhostTransfers() {
+	pstakeApp := suite.app
+	k := pstakeApp.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+	bondDenom := pstakeApp.StakingKeeper.BondDenom(ctx)
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	hc.ChainId = ctx.ChainID()
+	hc.ConnectionId = ibcexported.LocalhostConnectionID
+	hc.HostDenom = bondDenom
+	hc.DelegationAccount.Address = authtypes.NewModuleAddress("localhost_delegation").String()
+	hc.DelegationAccount.Balance = sdk.NewCoin(hc.HostDenom, sdk.ZeroInt())
+	k.SetHostChain(ctx, hc)
+
+	epoch := k.GetEpochNumber(ctx, types.DelegationEpoch)
+	amount := sdk.NewInt64Coin(hc.HostDenom, 1000)
+	suite.Require().NoError(
+		pstakeApp.BankKeeper.SendCoinsFromAccountToModule(
+			ctx,
+			suite.chainA.SenderAccount.GetAddress(),
+			types.DepositModuleAccount,
+			sdk.NewCoins(amount),
+		),
+	)
+
+	// deposits are received as soon as they are sent
+	deposit := &types.Deposit{ChainId: hc.ChainId, Amount: amount, Epoch: epoch, State: types.Deposit_DEPOSIT_PENDING}
+	k.SetDeposit(ctx, deposit)
+	suite.Require().NoError(k.SendLocalhostDeposit(ctx, hc, deposit))
+
+	delegationAccount := sdk.MustAccAddressFromBech32(hc.DelegationAccount.Address)
+	suite.Require().Equal(amount, pstakeApp.BankKeeper.GetBalance(ctx, delegationAccount, hc.HostDenom))
+	deposit, found = k.GetDepositForChainAndEpoch(ctx, hc.ChainId, epoch)
+	suite.Require().True(found)
+	suite.Require().Equal(types.Deposit_DEPOSIT_RECEIVED, deposit.State)
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(amount, hc.DelegationAccount.Balance)
+
+	// a validator unbonding bank send settles the validator unbonding and adds its tokens to the deposit
+	sequenceID := k.GetTransactionSequenceID("icacontroller-"+hc.DelegationAccount.Owner, "channel-100", 1)
+	k.SetValidatorUnbonding(ctx, &types.ValidatorUnbonding{
+		ChainId:          hc.ChainId,
+		EpochNumber:      epoch,
+		ValidatorAddress: hc.Validators[0].OperatorAddress,
+		Amount:           amount,
+		IbcSequenceId:    sequenceID,
+	})
+	k.SetDeposit(ctx, &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  sdk.NewCoin(hc.HostDenom, sdk.ZeroInt()),
+		Epoch:   epoch,
+		State:   types.Deposit_DEPOSIT_PENDING,
+	})
+
+	send := &banktypes.MsgSend{
+		FromAddress: hc.DelegationAccount.Address,
+		ToAddress:   k.GetDepositModuleAccount(ctx).GetAddress().String(),
+		Amount:      sdk.NewCoins(amount),
+	}
+	suite.Require().NoError(k.HandleMsgSend(ctx, send, "icacontroller-"+hc.DelegationAccount.Owner, "channel-100", 1))
+	suite.Require().Empty(k.FilterValidatorUnbondings(ctx, func(u types.ValidatorUnbonding) bool {
+		return u.ChainId == hc.ChainId
+	}))
+	deposit, _ = k.GetDepositForChainAndEpoch(ctx, hc.ChainId, epoch)
+	suite.Require().Equal(amount, deposit.Amount)
+
+	// sends of other host chains are rejected
+	send.Amount = sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1000))
+	suite.Require().Error(k.HandleMsgSend(ctx, send, "icacontroller-"+hc.DelegationAccount.Owner, "channel-100", 1))
+
+	// the delegation account balance is queried from the chain itself
+	suite.Require().NoError(k.QueryDelegationHostChainAccountBalance(ctx, hc))
+	suite.Require().Len(k.GetAllLocalhostQueries(ctx), 1)
+	k.BeginBlock(ctx)
+	suite.Require().Empty(k.GetAllLocalhostQueries(ctx))
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(amount, hc.DelegationAccount.Balance)
+
+	// localhost host chains have no transfer channel to migrate
+	suite.Require().ErrorIs(k.ValidateChannelMigration(ctx, hc, "channel-0"), types.ErrLocalhostNotSupported)
+}
@@ -5,6 +5,9 @@ import (
 
 	v2 "github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/migrations/v2"
 	v3 "github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/migrations/v3"
+	v4 "github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/migrations/v4"
+	v5 "github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/migrations/v5"
+	v6 "github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/migrations/v6"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -26,3 +29,23 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v3.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate3to4 migrates from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	channelPorts := make(map[string]string)
+	for _, channel := range m.keeper.ibcKeeper.ChannelKeeper.GetAllChannels(ctx) {
+		channelPorts[channel.ChannelId] = channel.PortId
+	}
+
+	return v4.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, channelPorts)
+}
+
+// Migrate4to5 migrates from version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate5to6 migrates from version 5 to 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
@@ -14,7 +14,9 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/events"
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
 )
 
@@ -37,10 +39,14 @@ func (k msgServer) RegisterHostChain(
 ) (*types.MsgRegisterHostChainResponse, error) {
 	ctx := sdktypes.UnwrapSDKContext(goCtx)
 
-	// authority needs to be either the gov module account (for proposals)
-	// or the module admin account (for normal txs)
-	if msg.Authority != k.authority && msg.Authority != k.GetParams(ctx).AdminAddress {
-		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "tx signer is not a module authority")
+	// registering host chains is a param admin role, next to governance
+	if err := k.ValidateRole(ctx, msg.Authority, types.RoleParamAdmin); err != nil {
+		return nil, err
+	}
+
+	// the seed is a community pool spend, which only governance can make
+	if !msg.SeedAmount.IsNil() && msg.SeedAmount.IsPositive() && msg.Authority != k.authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "only governance can seed a host chain")
 	}
 
 	// get the host chain id
@@ -59,16 +65,38 @@ func (k msgServer) RegisterHostChain(
 		return nil, fmt.Errorf("host chain with host denom \"%s\" already exists", msg.HostDenom)
 	}
 
+	// localhost host chains are queried through the localhost querier instead of the interchain query module
+	if msg.ConnectionId == ibcexported.LocalhostConnectionID && k.localhostQuerier == nil {
+		return nil, errorsmod.Wrapf(types.ErrRegisterFailed, "no localhost querier set to register %s", chainID)
+	}
+
 	// build the host chain params
 	hostChainParams := &types.HostChainLSParams{
-		DepositFee:                  msg.DepositFee,
-		RestakeFee:                  msg.RestakeFee,
-		UnstakeFee:                  msg.UnstakeFee,
-		RedemptionFee:               msg.RedemptionFee,
-		RedelegationAcceptableDelta: sdktypes.ZeroInt(),
-		LsmBondFactor:               sdktypes.NewDec(-1),
-		UpperCValueLimit:            sdktypes.MustNewDecFromStr("1.01"),
-		LowerCValueLimit:            sdktypes.MustNewDecFromStr("0.99"),
+		DepositFee:                    msg.DepositFee,
+		RestakeFee:                    msg.RestakeFee,
+		UnstakeFee:                    msg.UnstakeFee,
+		RedemptionFee:                 msg.RedemptionFee,
+		RedelegationAcceptableDelta:   sdktypes.ZeroInt(),
+		LsmBondFactor:                 sdktypes.NewDec(-1),
+		UpperCValueLimit:              sdktypes.MustNewDecFromStr("1.01"),
+		LowerCValueLimit:              sdktypes.MustNewDecFromStr("0.99"),
+		MaxValidatorWeight:            sdktypes.ZeroDec(),
+		LiquidityIncentiveRate:        sdktypes.ZeroDec(),
+		DepositDeliveryRatio:          sdktypes.ZeroDec(),
+		MinCValue:                     sdktypes.ZeroDec(),
+		MaxCValue:                     sdktypes.ZeroDec(),
+		RebateMaxCommission:           sdktypes.ZeroDec(),
+		RebateWeightBoost:             sdktypes.ZeroDec(),
+		ScoreWeightMin:                sdktypes.ZeroDec(),
+		ScoreWeightMax:                sdktypes.ZeroDec(),
+		MinRewardWithdrawalDelegation: sdktypes.ZeroInt(),
+		MaxDepositAmount:              sdktypes.ZeroInt(),
+		MinRewardsTransferAmount:      sdktypes.ZeroInt(),
+		MaxDrainPerEpoch:              sdktypes.ZeroInt(),
+		MaxRedelegationAmount:         sdktypes.ZeroInt(),
+		MaxRedelegationRatio:          sdktypes.ZeroDec(),
+		MaxUnbondingAmount:            sdktypes.ZeroInt(),
+		MaxDelegationShare:            sdktypes.ZeroDec(),
 	}
 
 	hc := &types.HostChain{
@@ -97,7 +125,9 @@ func (k msgServer) RegisterHostChain(
 	}
 
 	// save the host chain
-	k.SetHostChain(ctx, hc)
+	if err := k.SetHostChain(ctx, hc); err != nil {
+		return nil, err
+	}
 
 	// register delegate ICA
 	if err = k.RegisterICAAccount(ctx, hc.ConnectionId, hc.DelegationAccount.Owner); err != nil {
@@ -129,6 +159,36 @@ func (k msgServer) RegisterHostChain(
 	}
 	k.SetDeposit(ctx, deposit)
 
+	// liquid stake the seed into the first deposit, public deposits wait for it to be delegated
+	if !msg.SeedAmount.IsNil() && msg.SeedAmount.IsPositive() {
+		if err = k.seedHostChain(ctx, hc, deposit, msg.SeedAmount); err != nil {
+			return nil, err
+		}
+	}
+
+	// fetch the host chain unbonding period and slash fractions
+	if err = k.QueryHostChainRiskParams(ctx, hc); err != nil {
+		return nil, errorsmod.Wrapf(
+			types.ErrRegisterFailed,
+			"error querying %s risk params: %s",
+			chainID,
+			err.Error(),
+		)
+	}
+
+	// query the host chain validator set to bootstrap it
+	if msg.BootstrapValidators > 0 {
+		k.SetValidatorBootstrap(ctx, hc.ChainId, msg.BootstrapValidators)
+		if err = k.QueryHostChainValidators(ctx, hc); err != nil {
+			return nil, errorsmod.Wrapf(
+				types.ErrRegisterFailed,
+				"error querying %s validators: %s",
+				chainID,
+				err.Error(),
+			)
+		}
+	}
+
 	return &types.MsgRegisterHostChainResponse{}, nil
 }
 
@@ -139,10 +199,9 @@ func (k msgServer) UpdateHostChain(
 ) (*types.MsgUpdateHostChainResponse, error) {
 	ctx := sdktypes.UnwrapSDKContext(goCtx)
 
-	// authority needs to be either the gov module account (for proposals)
-	// or the module admin account (for normal txs)
-	if msg.Authority != k.authority && msg.Authority != k.GetParams(ctx).AdminAddress {
-		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "tx signer is not a module authority")
+	// every update needs the role of its key, validator set and fee updates can be delegated to narrower admins
+	if err := k.ValidateHostChainUpdatesRoles(ctx, msg.Authority, msg.Updates); err != nil {
+		return nil, err
 	}
 
 	hc, found := k.GetHostChain(ctx, msg.ChainId)
@@ -150,158 +209,191 @@ func (k msgServer) UpdateHostChain(
 		return nil, fmt.Errorf("invalid chain id \"%s\", host chain is not registered", msg.ChainId)
 	}
 
+	// fee, c value limit and cap updates only take effect once the param change delay has passed
+	delay := k.GetParams(ctx).ParamChangeDelay
+	updates := make([]*types.KVUpdate, 0, len(msg.Updates))
 	for _, update := range msg.Updates {
+		if delay > 0 && types.IsTimelockedKey(update.Key) {
+			k.StageParamChange(ctx, hc, update, delay)
+			continue
+		}
+		updates = append(updates, update)
+	}
+
+	if err := k.ApplyHostChainUpdates(ctx, hc, updates); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateHostChainResponse{}, nil
+}
+
+// ApplyHostChainUpdates applies the updates to the host chain and stores it, once the resulting validator set and
+// params are checked
+func (k *Keeper) ApplyHostChainUpdates(ctx sdktypes.Context, hc *types.HostChain, updates []*types.KVUpdate) error {
+	weightsUpdated := false
+	for _, update := range updates {
 	updateCase:
 		switch update.Key {
 		case types.KeyAddValidator:
 			var validator types.Validator
 			err := json.Unmarshal([]byte(update.Value), &validator)
 			if err != nil {
-				return nil, fmt.Errorf("unable to unmarshal validator update string")
+				return fmt.Errorf("unable to unmarshal validator update string")
 			}
 
-			_, found = hc.GetValidator(validator.OperatorAddress)
+			_, found := hc.GetValidator(validator.OperatorAddress)
 			if found {
-				return nil, fmt.Errorf("validator %s already registered on %s", validator.OperatorAddress, hc.ChainId)
+				return fmt.Errorf("validator %s already registered on %s", validator.OperatorAddress, hc.ChainId)
 			}
 
+			// only the validator ICQ can report the commission that qualifies the validator for the rebate program
+			validator.CommissionRate = sdktypes.ZeroDec()
+			validator.CommissionUpdateHeight = 0
+
 			hc.Validators = append(hc.Validators, &validator)
-			k.SetHostChain(ctx, hc)
+			weightsUpdated = true
 		case types.KeyRemoveValidator:
 			for i, validator := range hc.Validators {
 				if validator.OperatorAddress == update.Value {
 					// remove just when there are no delegated tokens and weight is 0
 					if validator.DelegatedAmount.GT(sdktypes.ZeroInt()) || validator.Weight.GT(sdktypes.ZeroDec()) {
-						return nil, fmt.Errorf(
+						return fmt.Errorf(
 							"validator %s can't be removed, it either has weight or staked tokens",
 							validator.OperatorAddress,
 						)
 					}
 					hc.Validators = append(hc.Validators[:i], hc.Validators[i+1:]...)
-					k.SetHostChain(ctx, hc)
+					if exit, found := k.GetValidatorExit(ctx, hc.ChainId, validator.OperatorAddress); found {
+						k.DeleteValidatorExit(ctx, exit)
+					}
 					break updateCase
 				}
 			}
 
-			return nil, types.ErrValidatorNotFound
+			return types.ErrValidatorNotFound
 		case types.KeyValidatorUpdate:
-			_, found = hc.GetValidator(update.Value)
+			_, found := hc.GetValidator(update.Value)
 			if !found {
-				return nil, types.ErrValidatorNotFound
+				return types.ErrValidatorNotFound
 			}
 
 			if err := k.QueryHostChainValidator(ctx, hc, update.Value); err != nil {
-				return nil, fmt.Errorf("unable to send ICQ query for validator")
+				return fmt.Errorf("unable to send ICQ query for validator")
 			}
 		case types.KeyValidatorWeight:
 			validator, weight, valid := strings.Cut(update.Value, ",")
 			if !valid {
-				return nil, fmt.Errorf("unable to parse validator update string")
+				return fmt.Errorf("unable to parse validator update string")
 			}
 
 			if err := k.UpdateHostChainValidatorWeight(ctx, hc, validator, weight); err != nil {
-				return nil, fmt.Errorf("invalid validator weight update values: %v", err)
+				return fmt.Errorf("invalid validator weight update values: %v", err)
 			}
+			weightsUpdated = true
 		case types.KeyDepositFee:
 			fee, err := sdktypes.NewDecFromStr(update.Value)
 			if err != nil {
-				return nil, fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
 			}
 			// fee limits validated in msg.ValidateBasic()
 			hc.Params.DepositFee = fee
 		case types.KeyRestakeFee:
 			fee, err := sdktypes.NewDecFromStr(update.Value)
 			if err != nil {
-				return nil, fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
 			}
 			// fee limits validated in msg.ValidateBasic()
 			hc.Params.RestakeFee = fee
 		case types.KeyRedemptionFee:
 			fee, err := sdktypes.NewDecFromStr(update.Value)
 			if err != nil {
-				return nil, fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
 			}
 			// fee limits validated in msg.ValidateBasic()
 			hc.Params.RedemptionFee = fee
 		case types.KeyUnstakeFee:
 			fee, err := sdktypes.NewDecFromStr(update.Value)
 			if err != nil {
-				return nil, fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
 			}
 			// fee limits validated in msg.ValidateBasic()
 			hc.Params.UnstakeFee = fee
 		case types.KeyLSMValidatorCap:
 			validatorCap, err := sdktypes.NewDecFromStr(update.Value)
 			if err != nil {
-				return nil, fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
 			}
 			// cap limits validated in msg.ValidateBasic()
 			hc.Params.LsmValidatorCap = validatorCap
 		case types.KeyLSMBondFactor:
 			bondFactor, err := sdktypes.NewDecFromStr(update.Value)
 			if err != nil {
-				return nil, fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
 			}
 			// factor limits validated in msg.ValidateBasic()
 			hc.Params.LsmBondFactor = bondFactor
 		case types.KeyMaxEntries:
 			entries, err := strconv.ParseUint(update.Value, 10, 32)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			hc.Params.MaxEntries = uint32(entries)
 		case types.KeyUpperCValueLimit:
 			limit, err := sdktypes.NewDecFromStr(update.Value)
 			if err != nil {
-				return nil, fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
 			}
 
 			if limit.LTE(hc.Params.LowerCValueLimit) {
-				return nil, fmt.Errorf("upper c value limit can't be less than lower c value limit: %w", err)
+				return fmt.Errorf("upper c value limit can't be less than lower c value limit: %w", err)
 			}
 
 			hc.Params.UpperCValueLimit = limit
 		case types.KeyLowerCValueLimit:
 			limit, err := sdktypes.NewDecFromStr(update.Value)
 			if err != nil {
-				return nil, fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
 			}
 
 			if limit.GTE(hc.Params.UpperCValueLimit) {
-				return nil, fmt.Errorf("lower c value limit can't be higher than upper c value limit: %w", err)
+				return fmt.Errorf("lower c value limit can't be higher than upper c value limit: %w", err)
 			}
 
 			hc.Params.LowerCValueLimit = limit
 		case types.KeyRedelegationAcceptableDelta:
 			redelegationAcceptableDelta, ok := sdktypes.NewIntFromString(update.Value)
 			if !ok {
-				return nil, fmt.Errorf("unable to parse redeleagtion acceptable delta string %v to sdk.Int", update.Value)
+				return fmt.Errorf("unable to parse redeleagtion acceptable delta string %v to sdk.Int", update.Value)
 			}
 			hc.Params.RedelegationAcceptableDelta = redelegationAcceptableDelta
 		case types.KeyMinimumDeposit:
 			minimumDeposit, ok := sdktypes.NewIntFromString(update.Value)
 			if !ok {
-				return nil, fmt.Errorf("unable to parse string to sdk.Int")
+				return fmt.Errorf("unable to parse string to sdk.Int")
 			}
 			// min deposit limits validated in msg.ValidateBasic()
 			hc.MinimumDeposit = minimumDeposit
 		case types.KeyActive:
 			active, err := strconv.ParseBool(update.Value)
 			if err != nil {
-				return nil, fmt.Errorf("unable to parse string to bool")
+				return fmt.Errorf("unable to parse string to bool")
 			}
 
+			// a chain activated again starts over with a fresh circuit breaker
+			if active {
+				k.ResetHostChainFailures(ctx, hc.ChainId)
+			}
 			hc.Active = active
 		case types.KeySetWithdrawAddress:
 			err := k.SetWithdrawAddress(ctx, hc)
 			if err != nil {
 				k.Logger(ctx).Error("Could not set withdraw address.", "chain_id", hc.ChainId)
-				return nil, fmt.Errorf("could not set withdraw address for host chain %s", hc.ChainId)
+				return fmt.Errorf("could not set withdraw address for host chain %s", hc.ChainId)
 			}
 		case types.KeyAutocompoundFactor:
 			autocompoundFactor, err := sdktypes.NewDecFromStr(update.Value)
 			if err != nil {
-				return nil, fmt.Errorf("unable to parse string to sdk.Dec")
+				return fmt.Errorf("unable to parse string to sdk.Dec")
 			}
 			// autoCompoundFactor limits validated in msg.ValidateBasic()
 			hc.AutoCompoundFactor = k.CalculateAutocompoundLimit(autocompoundFactor)
@@ -309,36 +401,222 @@ func (k msgServer) UpdateHostChain(
 			var flags types.HostChainFlags
 			err := json.Unmarshal([]byte(update.Value), &flags)
 			if err != nil {
-				return nil, fmt.Errorf("unable to unmarshal flags update string")
+				return fmt.Errorf("unable to unmarshal flags update string")
+			}
+
+			// LSM deposits are transferred to the host chain, localhost host chains have no transfer channel
+			if flags.Lsm && hc.IsLocalhost() {
+				return errorsmod.Wrapf(types.ErrLocalhostNotSupported, "lsm can't be enabled on %s", hc.ChainId)
 			}
 
 			hc.Flags = &flags
-			k.SetHostChain(ctx, hc)
 		case types.KeyRewardParams:
 			var params types.RewardParams
 			err := json.Unmarshal([]byte(update.Value), &params)
 			if err != nil {
-				return nil, fmt.Errorf("unable to unmarshal reward params update string")
+				return fmt.Errorf("unable to unmarshal reward params update string")
 			}
 
 			hc.RewardParams = &params
-			k.SetHostChain(ctx, hc)
+		case types.KeyMinActiveValidators:
+			minActiveValidators, err := strconv.ParseUint(update.Value, 10, 32)
+			if err != nil {
+				return err
+			}
+			hc.Params.MinActiveValidators = uint32(minActiveValidators)
+		case types.KeyWeightTransitionEpochs:
+			transitionEpochs, err := strconv.ParseUint(update.Value, 10, 32)
+			if err != nil {
+				return err
+			}
+			hc.Params.WeightTransitionEpochs = uint32(transitionEpochs)
+		case types.KeyMaxValidatorWeight:
+			maxWeight, err := sdktypes.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+			// weight limits validated in msg.ValidateBasic()
+			hc.Params.MaxValidatorWeight = maxWeight
+		case types.KeyScoreWeightMin:
+			minWeight, err := sdktypes.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+			hc.Params.ScoreWeightMin = minWeight
+		case types.KeyScoreWeightMax:
+			maxWeight, err := sdktypes.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+			hc.Params.ScoreWeightMax = maxWeight
+		case types.KeyMinRewardWithdrawal:
+			minDelegation, ok := sdktypes.NewIntFromString(update.Value)
+			if !ok {
+				return fmt.Errorf("unable to parse min reward withdrawal delegation string %v to sdk.Int", update.Value)
+			}
+			hc.Params.MinRewardWithdrawalDelegation = minDelegation
+		case types.KeyFeeAddress:
+			hc.Params.FeeAddress = update.Value
+
+			ctx.EventManager().EmitEvent(
+				sdktypes.NewEvent(
+					types.EventTypeFeeAddressUpdated,
+					sdktypes.NewAttribute(types.AttributeChainID, hc.ChainId),
+					sdktypes.NewAttribute(types.AttributeFeeAddress, k.GetHostChainFeeAddress(ctx, hc)),
+				),
+			)
+		case types.KeyLiquidityIncentiveAddress:
+			hc.Params.LiquidityIncentiveAddress = update.Value
+		case types.KeyLiquidityIncentiveRate:
+			rate, err := sdktypes.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+			// rate limits validated in msg.ValidateBasic()
+			hc.Params.LiquidityIncentiveRate = rate
+		case types.KeyDepositDeliveryRatio:
+			ratio, err := sdktypes.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+			// ratio limits validated in msg.ValidateBasic()
+			hc.Params.DepositDeliveryRatio = ratio
+		case types.KeyMaxDepositAmount:
+			maxDeposit, ok := sdktypes.NewIntFromString(update.Value)
+			if !ok {
+				return fmt.Errorf("unable to parse max deposit amount string %v to sdk.Int", update.Value)
+			}
+			hc.Params.MaxDepositAmount = maxDeposit
+		case types.KeyMinRewardsTransferAmount:
+			minTransfer, ok := sdktypes.NewIntFromString(update.Value)
+			if !ok {
+				return fmt.Errorf("unable to parse min rewards transfer amount string %v to sdk.Int", update.Value)
+			}
+			hc.Params.MinRewardsTransferAmount = minTransfer
+		case types.KeyMaxDrainPerEpoch:
+			maxDrain, ok := sdktypes.NewIntFromString(update.Value)
+			if !ok {
+				return fmt.Errorf("unable to parse max drain per epoch string %v to sdk.Int", update.Value)
+			}
+			hc.Params.MaxDrainPerEpoch = maxDrain
+		case types.KeyMaxRedelegationAmount:
+			maxRedelegation, ok := sdktypes.NewIntFromString(update.Value)
+			if !ok {
+				return fmt.Errorf("unable to parse max redelegation amount string %v to sdk.Int", update.Value)
+			}
+			hc.Params.MaxRedelegationAmount = maxRedelegation
+		case types.KeyMaxRedelegationRatio:
+			ratio, err := sdktypes.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+			hc.Params.MaxRedelegationRatio = ratio
+		case types.KeyMaxUnbondingAmount:
+			maxUnbonding, ok := sdktypes.NewIntFromString(update.Value)
+			if !ok {
+				return fmt.Errorf("unable to parse max unbonding amount string %v to sdk.Int", update.Value)
+			}
+			hc.Params.MaxUnbondingAmount = maxUnbonding
+		case types.KeyMaxDelegationShare:
+			share, err := sdktypes.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+			hc.Params.MaxDelegationShare = share
+		case types.KeyMinCValue:
+			bound, err := sdktypes.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+			hc.Params.MinCValue = bound
+		case types.KeyMaxCValue:
+			bound, err := sdktypes.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+			hc.Params.MaxCValue = bound
+		case types.KeyRebateMaxCommission:
+			commission, err := sdktypes.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+			hc.Params.RebateMaxCommission = commission
+		case types.KeyRebateWeightBoost:
+			boost, err := sdktypes.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+			hc.Params.RebateWeightBoost = boost
+		case types.KeyUnbondingEpochOffset:
+			offset, err := strconv.ParseInt(update.Value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("unable to parse unbonding epoch offset string %v to int64", update.Value)
+			}
+			if offset >= hc.UnbondingFactor {
+				return fmt.Errorf(
+					"unbonding epoch offset %d must be lower than the unbonding factor %d",
+					offset,
+					hc.UnbondingFactor,
+				)
+			}
+
+			// the undelegations queued for the next unbonding epochs would never be sent once they move
+			epochNumber := k.GetEpochNumber(ctx, types.UndelegationEpoch)
+			nextUnbondingEpoch := hc.CurrentUnbondingEpoch(epochNumber)
+			pending := k.FilterUnbondings(ctx, func(u types.Unbonding) bool {
+				return u.ChainId == hc.ChainId && u.EpochNumber >= nextUnbondingEpoch &&
+					u.State == types.Unbonding_UNBONDING_PENDING
+			})
+			if offset != hc.UnbondingEpochOffset && len(pending) > 0 {
+				return fmt.Errorf(
+					"unbonding epoch offset can't be updated with undelegations pending for epoch %d",
+					pending[0].EpochNumber,
+				)
+			}
+			hc.UnbondingEpochOffset = offset
 		default:
-			return nil, fmt.Errorf("invalid or unexpected update key: %s", update.Key)
+			return fmt.Errorf("invalid or unexpected update key: %s", update.Key)
 		}
 	}
 
-	k.SetHostChain(ctx, hc)
+	// weights are set one validator at a time, they only have to add up to one once all updates are applied
+	if weightsUpdated {
+		hc.NormalizeValidatorWeights()
+	}
+
+	// the resulting validator set must respect the host chain validator params
+	if err := hc.ValidateValidatorSet(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidValidatorSet, err.Error())
+	}
+
+	// the rate and address can be updated separately, so they are checked together once all updates are applied
+	if err := hc.Params.ValidateLiquidityIncentive(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// same for the c value bounds
+	if err := hc.Params.ValidateCValueBounds(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// and the score weight bounds, which depend on the max validator weight
+	if err := hc.Params.ValidateScoreWeightBounds(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.SetHostChain(ctx, hc); err != nil {
+		return err
+	}
 
 	defer func() {
-		if hc.Active {
+		if hc.IsActive() {
 			telemetry.ModuleSetGauge(types.ModuleName, float32(1), hc.ChainId, "active")
 		} else {
 			telemetry.ModuleSetGauge(types.ModuleName, float32(0), hc.ChainId, "active")
 		}
 	}()
 
-	return &types.MsgUpdateHostChainResponse{}, nil
+	return nil
 }
 
 // LiquidStake defines a method for liquid staking tokens
@@ -358,10 +636,25 @@ func (k msgServer) LiquidStake(
 		)
 	}
 
-	if !hostChain.Active {
+	if !hostChain.IsActive() {
 		return nil, types.ErrHostChainInactive
 	}
 
+	// deposits open once the seed of the host chain has been delegated
+	if hostChain.Seeding {
+		return nil, errorsmod.Wrapf(types.ErrHostChainSeeding, "host chain %s is not open to deposits yet", hostChain.ChainId)
+	}
+
+	// stk tokens can't be minted at a c value out of the host chain safety bounds
+	if !hostChain.CValueWithinBounds() {
+		return nil, errorsmod.Wrapf(types.ErrCValueOutOfLimits, "c value %s of %s is out of its safety bounds", hostChain.CValue, hostChain.ChainId)
+	}
+
+	// deposits are held back until the transfer channel of the host chain has been switched
+	if k.IsChannelMigrationDraining(ctx, hostChain.ChainId) {
+		return nil, types.ErrChannelMigrationActive
+	}
+
 	// check for minimum deposit amount
 	if msg.Amount.Amount.LT(hostChain.MinimumDeposit) {
 		return nil, errorsmod.Wrapf(
@@ -372,27 +665,115 @@ func (k msgServer) LiquidStake(
 		)
 	}
 
+	// the deposit can't take the host chain over its cap
+	if err := k.ValidateDepositCap(ctx, hostChain, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
 	// get the delegator address from the bech32 string
 	delegatorAddress, err := sdktypes.AccAddressFromBech32(msg.DelegatorAddress)
 	if err != nil {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "error parsing delegator address: %s", err)
 	}
 
+	// the stk tokens can be minted to an address other than the delegator
+	recipientAddress, err := sdktypes.AccAddressFromBech32(msg.StkRecipient())
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "error parsing recipient address: %s", err)
+	}
+
+	// the stk tokens minted for the locked coins of a vesting account are held until the coins vest, which needs them
+	// to be minted right away to the vesting account itself
+	lockedAmount := k.GetVestingLockedAmount(ctx, delegatorAddress, msg.Amount)
+	if lockedAmount.IsPositive() && (hostChain.Flags.DelayedMint || !recipientAddress.Equals(delegatorAddress)) {
+		if err = k.ValidateVestingDeposit(ctx, delegatorAddress, msg.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	// a deposit fee collected in host tokens is taken out of the deposit before it is liquid staked
+	feeInHost := k.FeeDenomInHost(ctx, types.KeyDepositFee)
+	depositToken := msg.Amount
+	hostFee := sdktypes.NewCoin(msg.Amount.Denom, sdktypes.ZeroInt())
+	if feeInHost {
+		hostFee, _ = sdktypes.NewDecCoinFromDec(
+			msg.Amount.Denom,
+			hostChain.Params.DepositFee.MulInt(msg.Amount.Amount),
+		).TruncateDecimal()
+		depositToken = msg.Amount.Sub(hostFee)
+	}
+
 	// amount of stk tokens to be minted
 	mintDenom := hostChain.MintDenom()
-	mintAmount := sdktypes.NewDecCoinFromCoin(msg.Amount).Amount.Mul(hostChain.CValue)
+	mintAmount := sdktypes.NewDecCoinFromCoin(depositToken).Amount.Mul(hostChain.CValue)
 	mintToken, _ := sdktypes.NewDecCoinFromDec(mintDenom, mintAmount).TruncateDecimal()
 
-	// send the deposit to the deposit-module account
-	depositAmount := sdktypes.NewCoins(msg.Amount)
-	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, delegatorAddress, types.DepositModuleAccount, depositAmount)
-	if err != nil {
+	// the minted stk tokens can't take the protocol over its stk supply cap
+	if err = k.ValidateStkSupplyCap(ctx, mintToken.Amount); err != nil {
+		return nil, err
+	}
+
+	// calculate protocol fee
+	protocolFee := sdktypes.NewCoin(mintDenom, sdktypes.ZeroInt())
+	if !feeInHost {
+		protocolFeeAmount := hostChain.Params.DepositFee.MulInt(mintToken.Amount)
+		protocolFee, _ = sdktypes.NewDecCoinFromDec(mintDenom, protocolFeeAmount).TruncateDecimal()
+	}
+
+	// the c value can change between signing and execution, protect the delegator from receiving less than expected
+	if !msg.MinStkAmountOut.IsNil() && mintToken.Sub(protocolFee).Amount.LT(msg.MinStkAmountOut) {
 		return nil, errorsmod.Wrapf(
-			types.ErrFailedDeposit,
-			"failed to deposit tokens to module account %s: %s",
-			types.DepositModuleAccount,
-			err,
+			types.ErrMinStkAmountOut,
+			"expected at least %s%s, got %s",
+			msg.MinStkAmountOut,
+			mintDenom,
+			mintToken.Sub(protocolFee),
+		)
+	}
+
+	// send the deposit to the deposit-module account, the locked coins are moved as a delegation so the vesting account
+	// keeps tracking them
+	depositAmount := sdktypes.NewCoins(msg.Amount.SubAmount(lockedAmount))
+	if lockedAmount.IsPositive() {
+		err = k.bankKeeper.DelegateCoins(
+			ctx,
+			delegatorAddress,
+			k.GetDepositModuleAccount(ctx).GetAddress(),
+			sdktypes.NewCoins(sdktypes.NewCoin(msg.Amount.Denom, lockedAmount)),
 		)
+		if err != nil {
+			return nil, errorsmod.Wrapf(
+				types.ErrFailedDeposit,
+				"failed to deposit locked tokens to module account %s: %s",
+				types.DepositModuleAccount,
+				err,
+			)
+		}
+	}
+	if !depositAmount.IsZero() {
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, delegatorAddress, types.DepositModuleAccount, depositAmount)
+		if err != nil {
+			return nil, errorsmod.Wrapf(
+				types.ErrFailedDeposit,
+				"failed to deposit tokens to module account %s: %s",
+				types.DepositModuleAccount,
+				err,
+			)
+		}
+	}
+
+	// send the host token deposit fee to the fee address
+	if hostFee.IsPositive() {
+		err = k.SendHostChainProtocolFee(ctx, hostChain, sdktypes.NewCoins(hostFee), types.DepositModuleAccount)
+		if err != nil {
+			return nil, errorsmod.Wrapf(
+				types.ErrFailedDeposit,
+				"failed to send protocol fee to pStake fee address %s: %s",
+				k.GetHostChainFeeAddress(ctx, hostChain),
+				err,
+			)
+		}
+		k.AddToFeeReport(ctx, hostChain.ChainId, types.KeyDepositFee, hostFee)
 	}
 
 	// add the deposit amount to the deposit record for that chain/epoch
@@ -406,78 +787,94 @@ func (k msgServer) LiquidStake(
 			currentEpoch,
 		)
 	}
-	deposit.Amount.Amount = deposit.Amount.Amount.Add(msg.Amount.Amount)
+	deposit.Amount.Amount = deposit.Amount.Amount.Add(depositToken.Amount)
 	k.SetDeposit(ctx, deposit)
 
-	// mint stk tokens in the module account
-	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdktypes.NewCoins(mintToken))
-	if err != nil {
-		return nil, errorsmod.Wrapf(
-			types.ErrMintFailed,
-			"failed to mint coins in module %s: %s",
-			types.ModuleName, err,
+	// in delayed mint mode the stk tokens are only minted once the deposit delegation is acknowledged
+	if hostChain.Flags.DelayedMint {
+		k.AddPendingMint(
+			ctx,
+			hostChain,
+			recipientAddress.String(),
+			currentEpoch,
+			sdktypes.NewCoin(hostChain.HostDenom, depositToken.Amount),
+			mintToken.Sub(protocolFee),
+			protocolFee,
 		)
-	}
+	} else {
+		// the share of the stk tokens paid with locked coins is held, rounded up so it never covers less than them
+		lockedStk := sdktypes.NewCoin(mintDenom, sdktypes.ZeroInt())
+		if lockedAmount.IsPositive() {
+			lockedStk.Amount = sdktypes.NewDecFromInt(mintToken.Sub(protocolFee).Amount).
+				MulInt(lockedAmount).
+				QuoInt(msg.Amount.Amount).
+				Ceil().
+				TruncateInt()
+		}
 
-	// calculate protocol fee
-	protocolFeeAmount := hostChain.Params.DepositFee.MulInt(mintToken.Amount)
-	protocolFee, _ := sdktypes.NewDecCoinFromDec(mintDenom, protocolFeeAmount).TruncateDecimal()
+		if err = k.mintLiquidStakeTokens(ctx, hostChain, recipientAddress, mintToken, protocolFee, lockedStk); err != nil {
+			return nil, err
+		}
 
-	// send stk tokens to the delegator address
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(
-		ctx,
-		types.ModuleName,
-		delegatorAddress,
-		sdktypes.NewCoins(mintToken.Sub(protocolFee)),
-	)
-	if err != nil {
-		return nil, errorsmod.Wrapf(
-			types.ErrMintFailed,
-			"failed to send coins from module %s to account %s: %s",
-			types.ModuleName,
-			delegatorAddress.String(),
-			err,
-		)
+		if lockedAmount.IsPositive() {
+			k.AddVestingLock(
+				ctx,
+				hostChain,
+				delegatorAddress.String(),
+				sdktypes.NewCoin(msg.Amount.Denom, lockedAmount),
+				lockedStk,
+			)
+		}
 	}
 
-	// retrieve the module params
-	params := k.GetParams(ctx)
+	// keep a record of the deposit for the delegator
+	k.CreateDepositReceipt(
+		ctx,
+		hostChain,
+		delegatorAddress.String(),
+		sdktypes.NewCoin(hostChain.HostDenom, depositToken.Amount),
+		mintToken.Sub(protocolFee),
+	)
 
-	// send the protocol fee to the protocol pool
-	if protocolFee.IsPositive() {
-		err = k.SendProtocolFee(ctx, sdktypes.NewCoins(protocolFee), types.ModuleName, params.FeeAddress)
-		if err != nil {
-			return nil, errorsmod.Wrapf(
-				types.ErrFailedDeposit,
-				"failed to send protocol fee to pStake fee address %s: %s",
-				params.FeeAddress,
-				err,
-			)
-		}
+	inputAmount := sdktypes.NewCoin(hostChain.HostDenom, msg.Amount.Amount)
+	outputAmount := sdktypes.NewCoin(hostChain.MintDenom(), mintToken.Sub(protocolFee).Amount)
+	feeAmount := sdktypes.NewCoin(hostChain.MintDenom(), protocolFee.Amount)
+	if feeInHost {
+		feeAmount = sdktypes.NewCoin(hostChain.HostDenom, hostFee.Amount)
 	}
-	ctx.EventManager().EmitEvents(sdktypes.Events{
+	err = events.Emit(
+		ctx,
+		k.GetParams(ctx).EventsVersion,
+		msg.DelegatorAddress,
+		&types.EventLiquidStake{
+			ChainId:          hostChain.ChainId,
+			DelegatorAddress: delegatorAddress.String(),
+			InputAmount:      inputAmount,
+			OutputAmount:     outputAmount,
+			Fee:              feeAmount,
+			Recipient:        recipientAddress.String(),
+		},
 		sdktypes.NewEvent(
 			types.EventTypeLiquidStake,
 			sdktypes.NewAttribute(types.AttributeChainID, hostChain.ChainId),
 			sdktypes.NewAttribute(types.AttributeDelegatorAddress, delegatorAddress.String()),
-			sdktypes.NewAttribute(types.AttributeInputAmount,
-				sdktypes.NewCoin(hostChain.HostDenom, msg.Amount.Amount).String()),
-			sdktypes.NewAttribute(types.AttributeOutputAmount,
-				sdktypes.NewCoin(hostChain.MintDenom(), mintToken.Sub(protocolFee).Amount).String()),
-			sdktypes.NewAttribute(types.AttributePstakeDepositFee,
-				sdktypes.NewCoin(hostChain.MintDenom(), protocolFee.Amount).String()),
-		),
-		sdktypes.NewEvent(
-			sdktypes.EventTypeMessage,
-			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
-			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.DelegatorAddress),
+			sdktypes.NewAttribute(types.AttributeRecipientAddress, recipientAddress.String()),
+			sdktypes.NewAttribute(types.AttributeInputAmount, inputAmount.String()),
+			sdktypes.NewAttribute(types.AttributeOutputAmount, outputAmount.String()),
+			sdktypes.NewAttribute(types.AttributePstakeDepositFee, feeAmount.String()),
+			types.NewCorrelationAttribute(types.WorkflowDeposit, hostChain.ChainId, currentEpoch),
 		),
-	},
 	)
+	if err != nil {
+		return nil, err
+	}
 
 	telemetry.IncrCounter(float32(1), hostChain.ChainId, "liquid_stake")
 
-	return &types.MsgLiquidStakeResponse{}, nil
+	return &types.MsgLiquidStakeResponse{
+		Fees:   types.NewFeesCharged(types.KeyDepositFee, feeAmount),
+		CValue: hostChain.CValue,
+	}, nil
 }
 
 // LiquidStakeLSM defines a method for liquid staking tokens using the LSM
@@ -488,8 +885,9 @@ func (k msgServer) LiquidStakeLSM(
 	ctx := sdktypes.UnwrapSDKContext(goCtx)
 
 	for _, delegation := range msg.Delegations {
-		// parse the delegator address
+		// parse the delegator and stk token recipient addresses
 		delegator := sdktypes.MustAccAddressFromBech32(msg.DelegatorAddress)
+		recipient := sdktypes.MustAccAddressFromBech32(msg.StkRecipient())
 
 		// validate the delegation
 		hc, validator, denomTrace, err := k.validateLiquidStakeLSMDeposit(ctx, delegator, delegation)
@@ -509,6 +907,15 @@ func (k msgServer) LiquidStakeLSM(
 			)
 		}
 
+		// the pending lsm deposits of the message already count towards the host chain cap
+		if err = k.ValidateDepositCap(
+			ctx,
+			hc,
+			sdktypes.NewDecFromInt(delegation.Amount).Mul(validator.ExchangeRate).TruncateInt(),
+		); err != nil {
+			return nil, err
+		}
+
 		// create the LSM deposit
 		deposit := &types.LSMDeposit{
 			ChainId:          hc.ChainId,
@@ -540,6 +947,9 @@ func (k msgServer) LiquidStakeLSM(
 		mintDenom := hc.MintDenom()
 		mintAmount := sdktypes.NewDecFromInt(deposit.Amount).Mul(hc.CValue)
 		mintToken, _ := sdktypes.NewDecCoinFromDec(mintDenom, mintAmount).TruncateDecimal()
+		if err = k.ValidateStkSupplyCap(ctx, mintToken.Amount); err != nil {
+			return nil, err
+		}
 		err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdktypes.NewCoins(mintToken))
 		if err != nil {
 			return nil, errorsmod.Wrapf(types.ErrMintFailed, "failed to mint coins in module %s: %s", types.ModuleName, err)
@@ -561,11 +971,11 @@ func (k msgServer) LiquidStakeLSM(
 		protocolFeeAmount := hc.Params.DepositFee.MulInt(mintToken.Amount)
 		protocolFee, _ := sdktypes.NewDecCoinFromDec(mintDenom, protocolFeeAmount).TruncateDecimal()
 
-		// send stk tokens to the delegator address
+		// send stk tokens to the recipient address
 		err = k.bankKeeper.SendCoinsFromModuleToAccount(
 			ctx,
 			types.ModuleName,
-			delegator,
+			recipient,
 			sdktypes.NewCoins(mintToken.Sub(protocolFee)),
 		)
 		if err != nil {
@@ -573,43 +983,63 @@ func (k msgServer) LiquidStakeLSM(
 				types.ErrMintFailed,
 				"failed to send coins from module %s to account %s: %s",
 				types.ModuleName,
-				delegator.String(),
+				recipient.String(),
 				err,
 			)
 		}
 
 		// send the protocol fee to the protocol pool
 		if protocolFee.IsPositive() {
-			err = k.SendProtocolFee(ctx, sdktypes.NewCoins(protocolFee), types.ModuleName, k.GetParams(ctx).FeeAddress)
+			feeAddress := k.GetHostChainFeeAddress(ctx, hc)
+			err = k.SendHostChainProtocolFee(ctx, hc, sdktypes.NewCoins(protocolFee), types.ModuleName)
 			if err != nil {
 				return nil, errorsmod.Wrapf(
 					types.ErrFailedDeposit,
 					"failed to send protocol fee to pStake fee address %s: %s",
-					k.GetParams(ctx).FeeAddress,
+					feeAddress,
 					err,
 				)
 			}
+			k.AddToFeeReport(ctx, hc.ChainId, types.KeyDepositFee, protocolFee)
 		}
 
-		ctx.EventManager().EmitEvents(sdktypes.Events{
+		// keep a record of the deposit for the delegator
+		k.CreateDepositReceipt(
+			ctx,
+			hc,
+			delegator.String(),
+			sdktypes.NewCoin(hc.HostDenom, deposit.Amount),
+			mintToken.Sub(protocolFee),
+		)
+
+		inputAmount := sdktypes.NewCoin(hc.HostDenom, deposit.Amount)
+		outputAmount := sdktypes.NewCoin(hc.MintDenom(), mintToken.Sub(protocolFee).Amount)
+		feeAmount := sdktypes.NewCoin(hc.MintDenom(), protocolFee.Amount)
+		err = events.Emit(
+			ctx,
+			k.GetParams(ctx).EventsVersion,
+			msg.DelegatorAddress,
+			&types.EventLiquidStakeLSM{
+				ChainId:          hc.ChainId,
+				DelegatorAddress: delegator.String(),
+				InputAmount:      inputAmount,
+				OutputAmount:     outputAmount,
+				Fee:              feeAmount,
+				Recipient:        recipient.String(),
+			},
 			sdktypes.NewEvent(
 				types.EventTypeLiquidStakeLSM,
 				sdktypes.NewAttribute(types.AttributeChainID, hc.ChainId),
 				sdktypes.NewAttribute(types.AttributeDelegatorAddress, delegator.String()),
-				sdktypes.NewAttribute(types.AttributeInputAmount,
-					sdktypes.NewCoin(hc.HostDenom, deposit.Amount).String()),
-				sdktypes.NewAttribute(types.AttributeOutputAmount,
-					sdktypes.NewCoin(hc.MintDenom(), mintToken.Sub(protocolFee).Amount).String()),
-				sdktypes.NewAttribute(types.AttributePstakeDepositFee,
-					sdktypes.NewCoin(hc.MintDenom(), protocolFee.Amount).String()),
-			),
-			sdktypes.NewEvent(
-				sdktypes.EventTypeMessage,
-				sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
-				sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.DelegatorAddress),
+				sdktypes.NewAttribute(types.AttributeRecipientAddress, recipient.String()),
+				sdktypes.NewAttribute(types.AttributeInputAmount, inputAmount.String()),
+				sdktypes.NewAttribute(types.AttributeOutputAmount, outputAmount.String()),
+				sdktypes.NewAttribute(types.AttributePstakeDepositFee, feeAmount.String()),
 			),
-		},
 		)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &types.MsgLiquidStakeLSMResponse{}, nil
@@ -640,10 +1070,15 @@ func (k msgServer) LiquidUnstake(
 		)
 	}
 
-	if !hc.Active {
+	if !hc.IsActive() {
 		return nil, types.ErrHostChainInactive
 	}
 
+	// the unbonding could not be undelegated before the undelegations resume
+	if hc.Flags.UndelegationsPaused {
+		return nil, errorsmod.Wrapf(types.ErrWorkflowPaused, "undelegations are paused on %s", hc.ChainId)
+	}
+
 	// check for minimum unbonding amount
 	if msg.Amount.Amount.LT(hc.MinimumDeposit) {
 		return nil, errorsmod.Wrapf(
@@ -683,70 +1118,132 @@ func (k msgServer) LiquidUnstake(
 	// send the unstake fee to the module fee address and subtract it from the total to unstake
 	unstakeAmount := msg.Amount
 	feeAmount := hc.Params.UnstakeFee.MulInt(unstakeAmount.Amount).TruncateInt()
-	if feeAmount.IsPositive() {
+	feeInHost := k.FeeDenomInHost(ctx, types.KeyUnstakeFee)
+	if feeAmount.IsPositive() && !feeInHost {
 		fee := sdktypes.NewCoin(msg.Amount.Denom, feeAmount)
 
-		err = k.SendProtocolFee(
+		err = k.SendHostChainProtocolFee(
 			ctx,
+			hc,
 			sdktypes.NewCoins(fee),
 			types.UndelegationModuleAccount,
-			k.GetParams(ctx).FeeAddress)
+		)
 		if err != nil {
 			return nil, err
 		}
+		k.AddToFeeReport(ctx, hc.ChainId, types.KeyUnstakeFee, fee)
 
 		unstakeAmount = msg.Amount.Sub(fee)
 	}
 
+	// a fee collected in host tokens is unstaked too, and claimed by the fee address once the unbonding matures
+	feeUnstakeAmount := sdktypes.NewCoin(msg.Amount.Denom, sdktypes.ZeroInt())
+	if feeAmount.IsPositive() && feeInHost {
+		feeUnstakeAmount = sdktypes.NewCoin(msg.Amount.Denom, feeAmount)
+		unstakeAmount = msg.Amount.Sub(feeUnstakeAmount)
+	}
+
 	// calculate the host chain token unbond amount from the stk amount
 	decTokenAmount := sdktypes.NewDecCoinFromCoin(unstakeAmount).Amount.Mul(sdktypes.OneDec().Quo(hc.CValue))
 	unbondAmount, _ := sdktypes.NewDecCoinFromDec(hc.HostDenom, decTokenAmount).TruncateDecimal()
+	decFeeAmount := sdktypes.NewDecCoinFromCoin(feeUnstakeAmount).Amount.Mul(sdktypes.OneDec().Quo(hc.CValue))
+	feeUnbondAmount, _ := sdktypes.NewDecCoinFromDec(hc.HostDenom, decFeeAmount).TruncateDecimal()
+
+	// the stk tokens are only burnt if the c value still gives the delegator the tokens they expect
+	if !msg.MinTokensOut.IsNil() && unbondAmount.Amount.LT(msg.MinTokensOut) {
+		return nil, errorsmod.Wrapf(
+			types.ErrMinTokensOut,
+			"expected at least %s%s, got %s",
+			msg.MinTokensOut,
+			hc.HostDenom,
+			unbondAmount,
+		)
+	}
 
 	// calculate the current unbonding epoch
 	epoch := k.epochsKeeper.GetEpochInfo(ctx, types.UndelegationEpoch)
-	unbondingEpoch := types.CurrentUnbondingEpoch(hc.UnbondingFactor, epoch.CurrentEpoch)
+	unbondingEpoch := hc.CurrentUnbondingEpoch(epoch.CurrentEpoch)
 
-	// increase the unbonding value for the epoch both for the user record and the module record
-	k.IncreaseUserUnbondingAmountForEpoch(ctx, hc.ChainId, msg.DelegatorAddress, unbondingEpoch, unstakeAmount, unbondAmount)
-	k.IncreaseUndelegatingAmountForEpoch(ctx, hc.ChainId, unbondingEpoch, unstakeAmount, unbondAmount)
+	// increase the unbonding value both for the user records and the module records, the amount above the host chain
+	// unbonding cap rolls into the next unbonding epochs
+	userEpochs := k.QueueUnbonding(
+		ctx,
+		hc,
+		msg.DelegatorAddress,
+		unbondingEpoch,
+		unstakeAmount,
+		unbondAmount,
+		msg.AutoClaim,
+	)
+	lastUnbondingEpoch := userEpochs[len(userEpochs)-1]
+	if feeUnstakeAmount.IsPositive() {
+		// the fee unbondings are always paid out to the fee address as soon as they are claimable
+		feeAddress := k.GetHostChainFeeAddress(ctx, hc)
+		feeEpochs := k.QueueUnbonding(ctx, hc, feeAddress, unbondingEpoch, feeUnstakeAmount, feeUnbondAmount, true)
+		if feeEpochs[len(feeEpochs)-1] > lastUnbondingEpoch {
+			lastUnbondingEpoch = feeEpochs[len(feeEpochs)-1]
+		}
+		k.AddToFeeReport(ctx, hc.ChainId, types.KeyUnstakeFee, sdktypes.NewCoin(hc.IBCDenom(), feeUnbondAmount.Amount))
+	}
 
-	// check if the total unbonding amount for the next unbonding epoch is less than what is currently staked
-	totalUnbondingsForEpoch, _ := k.GetUnbonding(ctx, hc.ChainId, unbondingEpoch)
+	// check if the total unbonding amount up to the last unbonding epoch used is less than what is currently staked
+	totalUnbondings := sdktypes.ZeroInt()
+	for epoch := unbondingEpoch; epoch <= lastUnbondingEpoch; epoch += hc.UnbondingFactor {
+		if unbonding, found := k.GetUnbonding(ctx, hc.ChainId, epoch); found {
+			totalUnbondings = totalUnbondings.Add(unbonding.UnbondAmount.Amount)
+		}
+	}
 	totalDelegations := hc.GetHostChainTotalDelegations()
-	if totalDelegations.LTE(totalUnbondingsForEpoch.UnbondAmount.Amount) {
+	if totalDelegations.LTE(totalUnbondings) {
 		return nil, errorsmod.Wrapf(
 			types.ErrNotEnoughDelegations,
-			"delegated amount %s is less than the total undelegation %s for epoch %d",
+			"delegated amount %s is less than the total undelegation %s%s for epochs %d to %d",
 			totalDelegations,
-			totalUnbondingsForEpoch,
+			totalUnbondings,
+			hc.HostDenom,
 			unbondingEpoch,
+			lastUnbondingEpoch,
 		)
 	}
 
-	ctx.EventManager().EmitEvents(sdktypes.Events{
+	inputAmount := sdktypes.NewCoin(hc.MintDenom(), msg.Amount.Amount)
+	outputAmount := sdktypes.NewCoin(hc.HostDenom, unbondAmount.Amount)
+	unstakeFee := sdktypes.NewCoin(hc.MintDenom(), feeAmount)
+	if feeInHost {
+		unstakeFee = sdktypes.NewCoin(hc.HostDenom, feeUnbondAmount.Amount)
+	}
+	err = events.Emit(
+		ctx,
+		k.GetParams(ctx).EventsVersion,
+		msg.GetDelegatorAddress(),
+		&types.EventLiquidUnstake{
+			ChainId:          hc.ChainId,
+			DelegatorAddress: msg.GetDelegatorAddress(),
+			InputAmount:      inputAmount,
+			OutputAmount:     outputAmount,
+			Fee:              unstakeFee,
+			Epoch:            userEpochs[0],
+		},
 		sdktypes.NewEvent(
 			types.EventTypeLiquidUnstake,
 			sdktypes.NewAttribute(types.AttributeChainID, hc.ChainId),
 			sdktypes.NewAttribute(types.AttributeDelegatorAddress, msg.GetDelegatorAddress()),
-			sdktypes.NewAttribute(types.AttributeInputAmount,
-				sdktypes.NewCoin(hc.MintDenom(), msg.Amount.Amount).String()),
-			sdktypes.NewAttribute(types.AttributeOutputAmount,
-				sdktypes.NewCoin(hc.HostDenom, unbondAmount.Amount).String()),
-			sdktypes.NewAttribute(types.AttributePstakeUnstakeFee,
-				sdktypes.NewCoin(hc.MintDenom(), feeAmount).String()),
-			sdktypes.NewAttribute(types.AttributeEpoch, strconv.FormatInt(unbondingEpoch, 10)),
-		),
-		sdktypes.NewEvent(
-			sdktypes.EventTypeMessage,
-			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
-			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.GetDelegatorAddress()),
+			sdktypes.NewAttribute(types.AttributeInputAmount, inputAmount.String()),
+			sdktypes.NewAttribute(types.AttributeOutputAmount, outputAmount.String()),
+			sdktypes.NewAttribute(types.AttributePstakeUnstakeFee, unstakeFee.String()),
+			sdktypes.NewAttribute(types.AttributeEpoch, strconv.FormatInt(userEpochs[0], 10)),
 		),
-	},
 	)
+	if err != nil {
+		return nil, err
+	}
 
 	telemetry.IncrCounter(float32(1), hc.ChainId, "liquid_unstake")
 
-	return &types.MsgLiquidUnstakeResponse{}, nil
+	return &types.MsgLiquidUnstakeResponse{
+		Fees:   types.NewFeesCharged(types.KeyUnstakeFee, unstakeFee),
+		CValue: hc.CValue,
+	}, nil
 }
 
 // Redeem defines a method for instantly redeem liquid staked tokens
@@ -774,10 +1271,15 @@ func (k msgServer) Redeem(
 		)
 	}
 
-	if !hc.Active {
+	if !hc.IsActive() {
 		return nil, types.ErrHostChainInactive
 	}
 
+	// nor redeemed at it
+	if !hc.CValueWithinBounds() {
+		return nil, errorsmod.Wrapf(types.ErrCValueOutOfLimits, "c value %s of %s is out of its safety bounds", hc.CValue, hc.ChainId)
+	}
+
 	// check the msg amount denom is the host chain mint denom
 	if msg.Amount.Denom != hc.MintDenom() {
 		return nil, errorsmod.Wrapf(
@@ -810,34 +1312,47 @@ func (k msgServer) Redeem(
 		)
 	}
 
-	// calculate the instant redemption fee
-	fee, _ := sdktypes.NewDecCoinFromDec(
-		hc.MintDenom(),
-		hc.Params.RedemptionFee.MulInt(msg.Amount.Amount),
-	).TruncateDecimal()
+	// calculate the instant redemption fee, a fee collected in host tokens is taken from the redeemed tokens instead
+	feeInHost := k.FeeDenomInHost(ctx, types.KeyRedemptionFee)
+	fee := sdktypes.NewCoin(hc.MintDenom(), sdktypes.ZeroInt())
+	if !feeInHost {
+		fee, _ = sdktypes.NewDecCoinFromDec(
+			hc.MintDenom(),
+			hc.Params.RedemptionFee.MulInt(msg.Amount.Amount),
+		).TruncateDecimal()
+	}
 
 	// send the protocol fee to the module fee address
 	if fee.IsPositive() {
-		err = k.SendProtocolFee(
+		feeAddress := k.GetHostChainFeeAddress(ctx, hc)
+		err = k.SendHostChainProtocolFee(
 			ctx,
+			hc,
 			sdktypes.NewCoins(fee),
 			types.ModuleName,
-			k.GetParams(ctx).FeeAddress,
 		)
 		if err != nil {
 			return nil, errorsmod.Wrapf(
 				types.ErrFailedDeposit,
 				"failed to send instant redemption fee to module fee address %s: %s",
-				k.GetParams(ctx).FeeAddress,
+				feeAddress,
 				err.Error(),
 			)
 		}
+		k.AddToFeeReport(ctx, hc.ChainId, types.KeyRedemptionFee, fee)
 	}
 
 	// amount of tokens to be redeemed
 	stkAmount := msg.Amount.Sub(fee)
 	redeemAmount := sdktypes.NewDecCoinFromCoin(stkAmount).Amount.Quo(hc.CValue)
 	redeemToken, _ := sdktypes.NewDecCoinFromDec(hc.IBCDenom(), redeemAmount).TruncateDecimal()
+	hostFee := sdktypes.NewCoin(hc.IBCDenom(), sdktypes.ZeroInt())
+	if feeInHost {
+		hostFee, _ = sdktypes.NewDecCoinFromDec(
+			hc.IBCDenom(),
+			hc.Params.RedemptionFee.MulInt(redeemToken.Amount),
+		).TruncateDecimal()
+	}
 
 	// check if there is enough deposits to fulfill the instant redemption request
 	// subtract the redemption amount from the deposits
@@ -854,7 +1369,7 @@ func (k msgServer) Redeem(
 		ctx,
 		types.DepositModuleAccount,
 		redeemAddress,
-		sdktypes.NewCoins(redeemToken),
+		sdktypes.NewCoins(redeemToken.Sub(hostFee)),
 	)
 	if err != nil {
 		return nil, errorsmod.Wrapf(
@@ -866,7 +1381,21 @@ func (k msgServer) Redeem(
 		)
 	}
 
-	// burn the stk tokens
+	// send the host token redemption fee to the module fee address
+	if hostFee.IsPositive() {
+		err = k.SendHostChainProtocolFee(ctx, hc, sdktypes.NewCoins(hostFee), types.DepositModuleAccount)
+		if err != nil {
+			return nil, errorsmod.Wrapf(
+				types.ErrRedeemFailed,
+				"failed to send instant redemption fee to module fee address %s: %s",
+				k.GetHostChainFeeAddress(ctx, hc),
+				err.Error(),
+			)
+		}
+		k.AddToFeeReport(ctx, hc.ChainId, types.KeyRedemptionFee, hostFee)
+	}
+
+	// burn the stk tokens
 	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdktypes.NewCoins(stkAmount))
 	if err != nil {
 		return nil, errorsmod.Wrapf(
@@ -877,34 +1406,47 @@ func (k msgServer) Redeem(
 		)
 	}
 
-	ctx.EventManager().EmitEvents(sdktypes.Events{
+	inputAmount := sdktypes.NewCoin(hc.MintDenom(), msg.Amount.Amount)
+	outputAmount := sdktypes.NewCoin(hc.HostDenom, redeemToken.Sub(hostFee).Amount)
+	redeemFee := sdktypes.NewCoin(hc.MintDenom(), fee.Amount)
+	if feeInHost {
+		redeemFee = sdktypes.NewCoin(hc.HostDenom, hostFee.Amount)
+	}
+	err = events.Emit(
+		ctx,
+		k.GetParams(ctx).EventsVersion,
+		msg.DelegatorAddress,
+		&types.EventRedeem{
+			ChainId:          hc.ChainId,
+			DelegatorAddress: redeemAddress.String(),
+			InputAmount:      inputAmount,
+			OutputAmount:     outputAmount,
+			Fee:              redeemFee,
+		},
 		sdktypes.NewEvent(
 			types.EventTypeRedeem,
 			sdktypes.NewAttribute(types.AttributeChainID, hc.ChainId),
 			sdktypes.NewAttribute(types.AttributeDelegatorAddress, redeemAddress.String()),
-			sdktypes.NewAttribute(types.AttributeInputAmount,
-				sdktypes.NewCoin(hc.MintDenom(), msg.Amount.Amount).String()),
-			sdktypes.NewAttribute(types.AttributeOutputAmount,
-				sdktypes.NewCoin(hc.HostDenom, redeemToken.Amount).String()),
-			sdktypes.NewAttribute(types.AttributePstakeRedeemFee,
-				sdktypes.NewCoin(hc.MintDenom(), fee.Amount).String()),
+			sdktypes.NewAttribute(types.AttributeInputAmount, inputAmount.String()),
+			sdktypes.NewAttribute(types.AttributeOutputAmount, outputAmount.String()),
+			sdktypes.NewAttribute(types.AttributePstakeRedeemFee, redeemFee.String()),
 		),
 		sdktypes.NewEvent(
 			types.EventBurn,
 			sdktypes.NewAttribute(types.AttributeChainID, hc.ChainId),
 			sdktypes.NewAttribute(types.AttributeTotalEpochBurnAmount, sdktypes.NewCoin(hc.MintDenom(), stkAmount.Amount).String()),
 		),
-		sdktypes.NewEvent(
-			sdktypes.EventTypeMessage,
-			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
-			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.DelegatorAddress),
-		),
-	},
 	)
+	if err != nil {
+		return nil, err
+	}
 
 	telemetry.IncrCounter(float32(1), hc.ChainId, "redeem")
 
-	return &types.MsgRedeemResponse{}, nil
+	return &types.MsgRedeemResponse{
+		Fees:   types.NewFeesCharged(types.KeyRedemptionFee, redeemFee),
+		CValue: hc.CValue,
+	}, nil
 }
 
 // UpdateParams defines a method for updating the module params
@@ -916,10 +1458,33 @@ func (k msgServer) UpdateParams(
 
 	params := k.GetParams(ctx)
 
-	// authority needs to be either the gov module account (for proposals)
-	// or the module admin account (for normal txs)
-	if msg.Authority != k.authority && msg.Authority != params.AdminAddress {
-		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "tx signer is not a module authority")
+	if err := k.ValidateRole(ctx, msg.Authority, types.RoleParamAdmin); err != nil {
+		return nil, err
+	}
+
+	// a role admin can't hand out roles, that stays with governance and the admin address
+	if msg.Authority != k.authority && msg.Authority != params.AdminAddress && !params.RolesEqual(msg.Params) {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "tx signer can't update the admin roles")
+	}
+
+	// the param change delay bounds every admin, only governance can change it
+	if msg.Authority != k.authority && msg.Params.ParamChangeDelay != params.ParamChangeDelay {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "tx signer can't update the param change delay")
+	}
+
+	// the module fee address and the callback contract stay with the fee admin
+	if msg.Params.FeeAddress != params.FeeAddress || msg.Params.CallbackContractAddress != params.CallbackContractAddress {
+		if err := k.ValidateRole(ctx, msg.Authority, types.RoleFeeAdmin); err != nil {
+			return nil, errorsmod.Wrapf(err, "can't update the fee address or the callback contract")
+		}
+	}
+
+	if msg.Params.CallbackContractAddress != "" && k.contractKeeper == nil {
+		return nil, errorsmod.Wrapf(
+			types.ErrNoContractKeeper,
+			"callback contract %s can't be set",
+			msg.Params.CallbackContractAddress,
+		)
 	}
 
 	k.SetParams(ctx, msg.Params)
@@ -939,62 +1504,1077 @@ func (k msgServer) UpdateParams(
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
-func (k msgServer) validateLiquidStakeLSMDeposit(
-	ctx sdktypes.Context,
-	delegatorAddress sdktypes.AccAddress,
-	delegation sdktypes.Coin,
-) (*types.HostChain, *types.Validator, *transfertypes.DenomTrace, error) {
-	// check if the ibc denom is valid
-	if err := transfertypes.ValidateIBCDenom(delegation.Denom); err != nil {
-		return nil, nil, nil, errorsmod.Wrapf(types.ErrInvalidLSMDenom, "IBC denom %s doesn't belong to a LSM token", delegation.Denom)
+// TransferUnbonding moves a pending user unbonding, and the right to claim it, to another address
+func (k msgServer) TransferUnbonding(
+	goCtx context.Context,
+	msg *types.MsgTransferUnbonding,
+) (*types.MsgTransferUnbondingResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidHostChain, "host chain with id %s not registered", msg.ChainId)
 	}
 
-	// parse the ibc denom to extract the original LSM token denom
-	hexHash := delegation.Denom[len(types.IBCPrefix):]
-	hexBytes, err := transfertypes.ParseHexHash(hexHash)
+	userUnbonding, found := k.GetUserUnbonding(ctx, hc.ChainId, msg.DelegatorAddress, msg.EpochNumber)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrUnbondingNotFound,
+			"no unbonding for %s on chain %s and epoch %d",
+			msg.DelegatorAddress,
+			hc.ChainId,
+			msg.EpochNumber,
+		)
+	}
+
+	// move the position to the recipient, merging it with any unbonding the recipient has for the same epoch
+	k.DeleteUserUnbonding(ctx, userUnbonding)
+	k.IncreaseUserUnbondingAmountForEpoch(
+		ctx,
+		hc.ChainId,
+		msg.RecipientAddress,
+		msg.EpochNumber,
+		userUnbonding.StkAmount,
+		userUnbonding.UnbondAmount,
+	)
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.DelegatorAddress),
+		),
+		sdktypes.NewEvent(
+			types.EventTypeTransferUnbonding,
+			sdktypes.NewAttribute(types.AttributeDelegatorAddress, msg.DelegatorAddress),
+			sdktypes.NewAttribute(types.AttributeRecipientAddress, msg.RecipientAddress),
+			sdktypes.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdktypes.NewAttribute(types.AttributeEpoch, strconv.FormatInt(msg.EpochNumber, 10)),
+			sdktypes.NewAttribute(types.AttributeInputAmount, userUnbonding.StkAmount.String()),
+			sdktypes.NewAttribute(types.AttributeOutputAmount, userUnbonding.UnbondAmount.String()),
+		),
+	})
+
+	return &types.MsgTransferUnbondingResponse{}, nil
+}
+
+// CancelUnbonding drops a user unbonding whose undelegation has not been sent to the host chain yet, returning the
+// escrowed stk tokens to the delegator. The unstake fee is not refunded.
+func (k msgServer) CancelUnbonding(
+	goCtx context.Context,
+	msg *types.MsgCancelUnbonding,
+) (*types.MsgCancelUnbondingResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidHostChain, "host chain with id %s not registered", msg.ChainId)
+	}
+
+	userUnbonding, found := k.GetUserUnbonding(ctx, hc.ChainId, msg.DelegatorAddress, msg.EpochNumber)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrUnbondingNotFound,
+			"no unbonding for %s on chain %s and epoch %d",
+			msg.DelegatorAddress,
+			hc.ChainId,
+			msg.EpochNumber,
+		)
+	}
+
+	// once the undelegation is sent the stk tokens are committed to it
+	unbonding, found := k.GetUnbonding(ctx, hc.ChainId, msg.EpochNumber)
+	if !found || unbonding.State != types.Unbonding_UNBONDING_PENDING {
+		return nil, errorsmod.Wrapf(
+			types.ErrUnbondingNotCancellable,
+			"undelegation for chain %s and epoch %d was already initiated",
+			hc.ChainId,
+			msg.EpochNumber,
+		)
+	}
+
+	delegatorAddress, err := sdktypes.AccAddressFromBech32(msg.DelegatorAddress)
 	if err != nil {
-		return nil, nil, nil, errorsmod.Wrapf(err, "could not parse ibc hash from ibc hex %s", hexHash)
+		return nil, err
 	}
 
-	// get the denom trace from the parsed ibc denom hex hash
-	denomTrace, found := k.ibcTransferKeeper.GetDenomTrace(ctx, hexBytes)
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		types.UndelegationModuleAccount,
+		delegatorAddress,
+		sdktypes.NewCoins(userUnbonding.StkAmount),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	unbonding.BurnAmount = unbonding.BurnAmount.Sub(userUnbonding.StkAmount)
+	unbonding.UnbondAmount = unbonding.UnbondAmount.Sub(userUnbonding.UnbondAmount)
+	if unbonding.BurnAmount.IsZero() {
+		k.DeleteUnbonding(ctx, unbonding)
+	} else {
+		k.SetUnbonding(ctx, unbonding)
+	}
+	k.DeleteUserUnbonding(ctx, userUnbonding)
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.DelegatorAddress),
+		),
+		sdktypes.NewEvent(
+			types.EventTypeCancelUnbonding,
+			sdktypes.NewAttribute(types.AttributeDelegatorAddress, msg.DelegatorAddress),
+			sdktypes.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdktypes.NewAttribute(types.AttributeEpoch, strconv.FormatInt(msg.EpochNumber, 10)),
+			sdktypes.NewAttribute(types.AttributeOutputAmount, userUnbonding.StkAmount.String()),
+		),
+	})
+
+	return &types.MsgCancelUnbondingResponse{}, nil
+}
+
+// SetAutoClaim opts a pending user unbonding in or out of the automatic claim, which pays it out as soon as it is
+// claimable
+func (k msgServer) SetAutoClaim(
+	goCtx context.Context,
+	msg *types.MsgSetAutoClaim,
+) (*types.MsgSetAutoClaimResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, msg.ChainId)
 	if !found {
-		return nil, nil, nil, errorsmod.Wrapf(types.ErrInvalidLSMDenom, "IBC denom %s doesn't belong to a LSM token", delegation.Denom)
+		return nil, errorsmod.Wrapf(types.ErrInvalidHostChain, "host chain with id %s not registered", msg.ChainId)
 	}
 
-	// retrieve the host chain associated with the liquid stake action
-	channelID := strings.TrimPrefix(denomTrace.Path, fmt.Sprintf("%s/", transfertypes.PortID))
-	hc, found := k.GetHostChainFromChannelID(ctx, channelID)
+	userUnbonding, found := k.GetUserUnbonding(ctx, hc.ChainId, msg.DelegatorAddress, msg.EpochNumber)
 	if !found {
-		return nil, nil, nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "host chain with channel id %s not registered", channelID)
+		return nil, errorsmod.Wrapf(
+			types.ErrUnbondingNotFound,
+			"no unbonding for %s on chain %s and epoch %d",
+			msg.DelegatorAddress,
+			hc.ChainId,
+			msg.EpochNumber,
+		)
 	}
 
-	// check if the host chain is active
-	if !hc.Active {
-		return nil, nil, nil, types.ErrHostChainInactive
+	userUnbonding.AutoClaim = msg.AutoClaim
+	k.SetUserUnbonding(ctx, userUnbonding)
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.DelegatorAddress),
+		),
+		sdktypes.NewEvent(
+			types.EventTypeSetAutoClaim,
+			sdktypes.NewAttribute(types.AttributeDelegatorAddress, msg.DelegatorAddress),
+			sdktypes.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdktypes.NewAttribute(types.AttributeEpoch, strconv.FormatInt(msg.EpochNumber, 10)),
+			sdktypes.NewAttribute(types.AttributeAutoClaim, strconv.FormatBool(msg.AutoClaim)),
+		),
+	})
+
+	return &types.MsgSetAutoClaimResponse{}, nil
+}
+
+// Claim pays a claimable or failed user unbonding out to its owner
+func (k msgServer) Claim(
+	goCtx context.Context,
+	msg *types.MsgClaim,
+) (*types.MsgClaimResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidHostChain, "host chain with id %s not registered", msg.ChainId)
 	}
 
-	// check if the host chain accepts LSM delegations
-	if !hc.Flags.Lsm {
-		return nil, nil, nil, types.ErrLSMNotEnabled
+	// claims follow the claim workflow, which doesn't run on inactive host chains
+	if !hc.IsActive() {
+		return nil, errorsmod.Wrapf(types.ErrHostChainInactive, "host chain %s is not active", hc.ChainId)
 	}
 
-	// check if the validator is within the module active set
-	operatorAddress, _, _ := strings.Cut(denomTrace.BaseDenom, "/")
-	validator, found := hc.GetValidator(operatorAddress)
+	userUnbonding, found := k.GetUserUnbonding(ctx, hc.ChainId, msg.DelegatorAddress, msg.EpochNumber)
 	if !found {
-		return nil, nil, nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "validator %s is not part of the module active set for chain %s", operatorAddress, hc.ChainId)
+		return nil, errorsmod.Wrapf(
+			types.ErrUnbondingNotFound,
+			"no unbonding for %s on chain %s and epoch %d",
+			msg.DelegatorAddress,
+			hc.ChainId,
+			msg.EpochNumber,
+		)
 	}
 
-	if validator.Status != stakingtypes.BondStatusBonded {
-		return nil, nil, nil, errorsmod.Wrapf(types.ErrLSMValidatorInvalidState, "validator %s is not in the bonded state, it is in %s", operatorAddress, validator.Status)
+	unbonding, found := k.GetUnbonding(ctx, hc.ChainId, msg.EpochNumber)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrUnbondingNotClaimable,
+			"no unbonding for chain %s and epoch %d",
+			hc.ChainId,
+			msg.EpochNumber,
+		)
 	}
 
-	// check delegator has enough LSM tokens
-	delegatorBalance := k.bankKeeper.GetBalance(ctx, delegatorAddress, delegation.Denom).Amount
-	if delegatorBalance.LT(delegation.Amount) {
-		return nil, nil, nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "not enough tokenized delegation funds")
+	// funded epochs are only paid once every earlier epoch got its unbonding transfer
+	holdEpoch, held := k.GetClaimHoldEpoch(ctx, hc.ChainId)
+	if unbonding.State == types.Unbonding_UNBONDING_CLAIMABLE && held && unbonding.EpochNumber > holdEpoch {
+		return nil, errorsmod.Wrapf(
+			types.ErrUnbondingNotClaimable,
+			"unbonding for chain %s and epoch %d waits for the epoch %d to be paid",
+			hc.ChainId,
+			msg.EpochNumber,
+			holdEpoch,
+		)
 	}
 
-	return hc, validator, &denomTrace, nil
+	amount, err := k.ClaimUserUnbonding(ctx, hc, unbonding, userUnbonding)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.DelegatorAddress),
+		),
+	)
+
+	return &types.MsgClaimResponse{Amount: amount}, nil
+}
+
+// CancelValidatorExit drops a queued total validator unbonding before it is executed
+func (k msgServer) CancelValidatorExit(
+	goCtx context.Context,
+	msg *types.MsgCancelValidatorExit,
+) (*types.MsgCancelValidatorExitResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateRole(ctx, msg.Authority, types.RoleValidatorSetAdmin); err != nil {
+		return nil, err
+	}
+
+	hc, found := k.GetHostChain(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidHostChain, "host chain with id %s not registered", msg.ChainId)
+	}
+
+	exit, found := k.GetValidatorExit(ctx, hc.ChainId, msg.ValidatorAddress)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrValidatorExitNotFound,
+			"no pending exit for validator %s on chain %s",
+			msg.ValidatorAddress,
+			hc.ChainId,
+		)
+	}
+
+	k.DeleteValidatorExit(ctx, exit)
+
+	// clear the unbonding epoch so the exit is not queued again, it will be set
+	// again if the validator transitions into unbonding after bonding back
+	validator, found := hc.GetValidator(msg.ValidatorAddress)
+	if found {
+		validator.UnbondingEpoch = 0
+		if err := k.SetHostChainValidator(ctx, hc, validator); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.Authority),
+		),
+		sdktypes.NewEvent(
+			types.EventTypeValidatorExitCancelled,
+			sdktypes.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdktypes.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdktypes.NewAttribute(types.AttributeValidatorAddress, msg.ValidatorAddress),
+			sdktypes.NewAttribute(types.AttributeValidatorExitEpoch, strconv.FormatInt(exit.ExecuteEpoch, 10)),
+		),
+	})
+
+	return &types.MsgCancelValidatorExitResponse{}, nil
+}
+
+// CancelParamChange drops a staged host chain update before it takes effect
+func (k msgServer) CancelParamChange(
+	goCtx context.Context,
+	msg *types.MsgCancelParamChange,
+) (*types.MsgCancelParamChangeResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	// a staged change can be cancelled by whoever could have staged it
+	if err := k.ValidateRole(ctx, msg.Authority, types.UpdateKeyRole(msg.Key)); err != nil {
+		return nil, err
+	}
+
+	hc, found := k.GetHostChain(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidHostChain, "host chain with id %s not registered", msg.ChainId)
+	}
+
+	change, found := k.GetPendingParamChange(ctx, hc.ChainId, msg.Key)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrParamChangeNotFound,
+			"no staged change of %s on chain %s",
+			msg.Key,
+			hc.ChainId,
+		)
+	}
+
+	k.DeletePendingParamChange(ctx, change)
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.Authority),
+		),
+		sdktypes.NewEvent(
+			types.EventTypeParamChangeCancelled,
+			sdktypes.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdktypes.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdktypes.NewAttribute(types.AttributeParamChangeKey, change.Key),
+			sdktypes.NewAttribute(types.AttributeParamChangeValue, change.Value),
+		),
+	})
+
+	return &types.MsgCancelParamChangeResponse{}, nil
+}
+
+// MigrateHostChainChannel starts, or cancels, moving a host chain to a new transfer channel. The channel is switched
+// at the end of a delegation epoch once all the transfers over the old channel have settled.
+func (k msgServer) MigrateHostChainChannel(
+	goCtx context.Context,
+	msg *types.MsgMigrateHostChainChannel,
+) (*types.MsgMigrateHostChainChannelResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateRole(ctx, msg.Authority, types.RoleParamAdmin); err != nil {
+		return nil, err
+	}
+
+	hc, found := k.GetHostChain(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidHostChain, "host chain with id %s not registered", msg.ChainId)
+	}
+
+	eventType := types.EventTypeChannelMigrationStarted
+	migration, found := k.GetChannelMigration(ctx, hc.ChainId)
+	if msg.Cancel {
+		if !found || migration.State != types.ChannelMigration_MIGRATION_DRAINING {
+			return nil, errorsmod.Wrapf(
+				types.ErrInvalidChannelMigration,
+				"host chain %s has no channel migration in progress",
+				hc.ChainId,
+			)
+		}
+
+		migration.State = types.ChannelMigration_MIGRATION_CANCELLED
+		eventType = types.EventTypeChannelMigrationCancelled
+	} else {
+		if err := k.ValidateChannelMigration(ctx, hc, msg.NewChannelId); err != nil {
+			return nil, err
+		}
+
+		migration = &types.ChannelMigration{
+			ChainId:      hc.ChainId,
+			OldChannelId: hc.ChannelId,
+			NewChannelId: msg.NewChannelId,
+			OldIbcDenom:  hc.IBCDenom(),
+			StartEpoch:   k.epochsKeeper.GetEpochInfo(ctx, types.DelegationEpoch).CurrentEpoch,
+			State:        types.ChannelMigration_MIGRATION_DRAINING,
+		}
+	}
+	k.SetChannelMigration(ctx, migration)
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.Authority),
+		),
+		sdktypes.NewEvent(
+			eventType,
+			sdktypes.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdktypes.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdktypes.NewAttribute(types.AttributeOldChannelID, migration.OldChannelId),
+			sdktypes.NewAttribute(types.AttributeNewChannelID, migration.NewChannelId),
+		),
+	})
+
+	return &types.MsgMigrateHostChainChannelResponse{}, nil
+}
+
+// seedHostChain pays the seed of a newly registered host chain out of the community pool into its first deposit, and
+// funds the community pool with the stk tokens of the seed, at the initial c value and without fees
+func (k msgServer) seedHostChain(
+	ctx sdktypes.Context,
+	hc *types.HostChain,
+	deposit *types.Deposit,
+	seedAmount sdktypes.Int,
+) error {
+	seed := sdktypes.NewCoin(hc.IBCDenom(), seedAmount)
+	depositAddress := k.accountKeeper.GetModuleAccount(ctx, types.DepositModuleAccount).GetAddress()
+	if err := k.distributionKeeper.DistributeFromFeePool(ctx, sdktypes.NewCoins(seed), depositAddress); err != nil {
+		return errorsmod.Wrapf(
+			types.ErrFailedDeposit,
+			"failed to pay %s seed out of the community pool: %s",
+			hc.ChainId,
+			err,
+		)
+	}
+
+	deposit.Amount = deposit.Amount.Add(seed)
+	k.SetDeposit(ctx, deposit)
+
+	mintToken, _ := sdktypes.NewDecCoinFromDec(
+		hc.MintDenom(),
+		sdktypes.NewDecFromInt(seedAmount).Mul(hc.CValue),
+	).TruncateDecimal()
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdktypes.NewCoins(mintToken)); err != nil {
+		return errorsmod.Wrapf(types.ErrMintFailed, "failed to mint coins in module %s: %s", types.ModuleName, err)
+	}
+	moduleAddress := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName).GetAddress()
+	if err := k.distributionKeeper.FundCommunityPool(ctx, sdktypes.NewCoins(mintToken), moduleAddress); err != nil {
+		return errorsmod.Wrapf(types.ErrMintFailed, "failed to fund the community pool with %s: %s", mintToken, err)
+	}
+
+	hc.Seeding = true
+	if err := k.SetHostChain(ctx, hc); err != nil {
+		return err
+	}
+
+	k.OperationLogger(ctx, types.WorkflowDeposit, hc.ChainId, deposit.Epoch).Info(
+		"Seeded host chain.",
+		"amount",
+		seed.String(),
+	)
+	ctx.EventManager().EmitEvent(
+		sdktypes.NewEvent(
+			types.EventTypeHostChainSeeded,
+			sdktypes.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdktypes.NewAttribute(types.AttributeSeedAmount, seed.String()),
+			sdktypes.NewAttribute(types.AttributeModuleMintedAmount, mintToken.String()),
+			types.NewCorrelationAttribute(types.WorkflowDeposit, hc.ChainId, deposit.Epoch),
+		),
+	)
+
+	return nil
+}
+
+// mintLiquidStakeTokens mints the stk tokens of a deposit, sends them to the recipient and charges the protocol fee.
+// The held stk tokens are kept in the module account.
+func (k msgServer) mintLiquidStakeTokens(
+	ctx sdktypes.Context,
+	hostChain *types.HostChain,
+	recipientAddress sdktypes.AccAddress,
+	mintToken sdktypes.Coin,
+	protocolFee sdktypes.Coin,
+	heldToken sdktypes.Coin,
+) error {
+	// mint stk tokens in the module account
+	err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdktypes.NewCoins(mintToken))
+	if err != nil {
+		return errorsmod.Wrapf(
+			types.ErrMintFailed,
+			"failed to mint coins in module %s: %s",
+			types.ModuleName, err,
+		)
+	}
+
+	// send stk tokens to the recipient address
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		types.ModuleName,
+		recipientAddress,
+		sdktypes.NewCoins(mintToken.Sub(protocolFee).Sub(heldToken)),
+	)
+	if err != nil {
+		return errorsmod.Wrapf(
+			types.ErrMintFailed,
+			"failed to send coins from module %s to account %s: %s",
+			types.ModuleName,
+			recipientAddress.String(),
+			err,
+		)
+	}
+
+	// send the protocol fee to the protocol pool
+	if protocolFee.IsPositive() {
+		feeAddress := k.GetHostChainFeeAddress(ctx, hostChain)
+		err = k.SendHostChainProtocolFee(ctx, hostChain, sdktypes.NewCoins(protocolFee), types.ModuleName)
+		if err != nil {
+			return errorsmod.Wrapf(
+				types.ErrFailedDeposit,
+				"failed to send protocol fee to pStake fee address %s: %s",
+				feeAddress,
+				err,
+			)
+		}
+		k.AddToFeeReport(ctx, hostChain.ChainId, types.KeyDepositFee, protocolFee)
+	}
+
+	return nil
+}
+
+func (k msgServer) validateLiquidStakeLSMDeposit(
+	ctx sdktypes.Context,
+	delegatorAddress sdktypes.AccAddress,
+	delegation sdktypes.Coin,
+) (*types.HostChain, *types.Validator, *transfertypes.DenomTrace, error) {
+	// check if the ibc denom is valid
+	if err := transfertypes.ValidateIBCDenom(delegation.Denom); err != nil {
+		return nil, nil, nil, errorsmod.Wrapf(types.ErrInvalidLSMDenom, "IBC denom %s doesn't belong to a LSM token", delegation.Denom)
+	}
+
+	// parse the ibc denom to extract the original LSM token denom
+	hexHash := delegation.Denom[len(types.IBCPrefix):]
+	hexBytes, err := transfertypes.ParseHexHash(hexHash)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrapf(err, "could not parse ibc hash from ibc hex %s", hexHash)
+	}
+
+	// get the denom trace from the parsed ibc denom hex hash
+	denomTrace, found := k.ibcTransferKeeper.GetDenomTrace(ctx, hexBytes)
+	if !found {
+		return nil, nil, nil, errorsmod.Wrapf(types.ErrInvalidLSMDenom, "IBC denom %s doesn't belong to a LSM token", delegation.Denom)
+	}
+
+	// retrieve the host chain associated with the liquid stake action
+	channelID := strings.TrimPrefix(denomTrace.Path, fmt.Sprintf("%s/", transfertypes.PortID))
+	hc, found := k.GetHostChainFromChannelID(ctx, channelID)
+	if !found {
+		return nil, nil, nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "host chain with channel id %s not registered", channelID)
+	}
+
+	// check if the host chain is active
+	if !hc.IsActive() {
+		return nil, nil, nil, types.ErrHostChainInactive
+	}
+
+	if hc.Seeding {
+		return nil, nil, nil, errorsmod.Wrapf(types.ErrHostChainSeeding, "host chain %s is not open to deposits yet", hc.ChainId)
+	}
+
+	if k.IsChannelMigrationDraining(ctx, hc.ChainId) {
+		return nil, nil, nil, types.ErrChannelMigrationActive
+	}
+
+	// check if the host chain accepts LSM delegations
+	if !hc.Flags.Lsm {
+		return nil, nil, nil, types.ErrLSMNotEnabled
+	}
+	if hc.Flags.LsmPaused {
+		return nil, nil, nil, errorsmod.Wrapf(types.ErrWorkflowPaused, "lsm deposits are paused on %s", hc.ChainId)
+	}
+
+	// check if the validator is within the module active set
+	operatorAddress, _, _ := strings.Cut(denomTrace.BaseDenom, "/")
+	validator, found := hc.GetValidator(operatorAddress)
+	if !found {
+		return nil, nil, nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "validator %s is not part of the module active set for chain %s", operatorAddress, hc.ChainId)
+	}
+
+	if validator.Status != stakingtypes.BondStatusBonded {
+		return nil, nil, nil, errorsmod.Wrapf(types.ErrLSMValidatorInvalidState, "validator %s is not in the bonded state, it is in %s", operatorAddress, validator.Status)
+	}
+
+	// check delegator has enough LSM tokens
+	delegatorBalance := k.bankKeeper.GetBalance(ctx, delegatorAddress, delegation.Denom).Amount
+	if delegatorBalance.LT(delegation.Amount) {
+		return nil, nil, nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "not enough tokenized delegation funds")
+	}
+
+	return hc, validator, &denomTrace, nil
+}
+
+// SetCValue sets the c value of a host chain through governance, to recover from a wrong c value without migrating
+// the store. The update is recorded in the host chain c value history.
+func (k msgServer) SetCValue(
+	goCtx context.Context,
+	msg *types.MsgSetCValue,
+) (*types.MsgSetCValueResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	// only a governance proposal can override the c value
+	if msg.Authority != k.authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "tx signer is not the governance account")
+	}
+
+	hc, found := k.GetHostChain(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidHostChain, "host chain with id %s not registered", msg.ChainId)
+	}
+
+	if !msg.CValue.LT(hc.Params.UpperCValueLimit) || !msg.CValue.GT(hc.Params.LowerCValueLimit) {
+		return nil, errorsmod.Wrapf(
+			types.ErrCValueOutOfLimits,
+			"c value %s is not within the %s host chain limits (%s, %s)",
+			msg.CValue,
+			hc.ChainId,
+			hc.Params.LowerCValueLimit,
+			hc.Params.UpperCValueLimit,
+		)
+	}
+
+	// nor can it be set out of the safety bounds, which would halt the host chain on its next update
+	bounded := *hc
+	bounded.CValue = msg.CValue
+	if !bounded.CValueWithinBounds() {
+		return nil, errorsmod.Wrapf(
+			types.ErrCValueOutOfLimits,
+			"c value %s is not within the %s host chain safety bounds [%s, %s]",
+			msg.CValue,
+			hc.ChainId,
+			hc.Params.MinCValue,
+			hc.Params.MaxCValue,
+		)
+	}
+
+	previousCValue := hc.CValue
+	hc.LastCValue = hc.CValue
+	hc.CValue = msg.CValue
+	hc.CValueUpdateHeight = ctx.BlockHeight()
+	hc.CValueUpdateTime = ctx.BlockTime()
+	if err := k.SetHostChain(ctx, hc); err != nil {
+		return nil, err
+	}
+
+	k.AddCValueRecord(ctx, &types.CValueRecord{
+		ChainId:        hc.ChainId,
+		CValue:         msg.CValue,
+		PreviousCValue: previousCValue,
+		Height:         ctx.BlockHeight(),
+		Time:           ctx.BlockTime(),
+		Authority:      msg.Authority,
+		ProposalId:     msg.ProposalId,
+		Justification:  msg.Justification,
+	})
+
+	if err := k.Hooks().PostCValueUpdate(ctx, hc.MintDenom(), hc.HostDenom, hc.CValue); err != nil {
+		k.Logger(ctx).Error("PostCValueUpdate hook failed with ", "err:", err)
+	}
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.Authority),
+		),
+		sdktypes.NewEvent(
+			types.EventTypeCValueSet,
+			sdktypes.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdktypes.NewAttribute(types.AttributeOldCValue, previousCValue.String()),
+			sdktypes.NewAttribute(types.AttributeNewCValue, hc.CValue.String()),
+			sdktypes.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdktypes.NewAttribute(types.AttributeProposalID, strconv.FormatUint(msg.ProposalId, 10)),
+			sdktypes.NewAttribute(types.AttributeJustification, msg.Justification),
+		),
+	})
+
+	return &types.MsgSetCValueResponse{}, nil
+}
+
+// RetryTransfer sends again the transfer of a deposit left pending by a timed out or failed transfer, instead of
+// waiting for the next delegation epoch. Any account can retry a deposit.
+func (k msgServer) RetryTransfer(
+	goCtx context.Context,
+	msg *types.MsgRetryTransfer,
+) (*types.MsgRetryTransferResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidHostChain, "host chain with id %s not registered", msg.ChainId)
+	}
+
+	if !hc.IsActive() {
+		return nil, errorsmod.Wrapf(types.ErrHostChainInactive, "host chain %s is not active", hc.ChainId)
+	}
+
+	if hc.Degraded {
+		return nil, errorsmod.Wrapf(types.ErrChannelNotOpen, "host chain %s channels are not open", hc.ChainId)
+	}
+
+	if hc.Flags.DepositsPaused {
+		return nil, errorsmod.Wrapf(types.ErrWorkflowPaused, "deposits are paused on %s", hc.ChainId)
+	}
+
+	deposit, found := k.GetDepositForChainAndEpoch(ctx, hc.ChainId, msg.EpochNumber)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrDepositNotFound,
+			"deposit not found for chain %s and epoch %d",
+			hc.ChainId,
+			msg.EpochNumber,
+		)
+	}
+
+	// the deposit of the current epoch is still collecting liquid stakes
+	currentEpoch := k.GetEpochNumber(ctx, types.DelegationEpoch)
+	retryable := deposit.State == types.Deposit_DEPOSIT_PENDING || deposit.State == types.Deposit_DEPOSIT_FAILED
+	if !retryable || deposit.Epoch >= currentEpoch || !deposit.Amount.IsPositive() {
+		return nil, errorsmod.Wrapf(
+			types.ErrDepositNotRetryable,
+			"deposit for chain %s and epoch %d is not a pending or failed deposit of a past epoch",
+			hc.ChainId,
+			msg.EpochNumber,
+		)
+	}
+
+	if err := k.SendDeposit(ctx, hc, deposit); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrDepositNotRetryable, "could not send deposit transfer: %s", err)
+	}
+
+	// the deposit module account holds none of the deposit, it was all moved to a shortfall
+	if deposit.State == types.Deposit_DEPOSIT_PENDING || deposit.State == types.Deposit_DEPOSIT_FAILED {
+		return nil, errorsmod.Wrapf(
+			types.ErrDepositNotRetryable,
+			"deposit module account holds none of the deposit for chain %s and epoch %d",
+			hc.ChainId,
+			msg.EpochNumber,
+		)
+	}
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.Signer),
+		),
+		sdktypes.NewEvent(
+			types.EventTypeRetryTransfer,
+			sdktypes.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdktypes.NewAttribute(types.AttributeEpoch, strconv.FormatInt(deposit.Epoch, 10)),
+			sdktypes.NewAttribute(types.AttributeIBCSequenceID, deposit.IbcSequenceId),
+		),
+	})
+
+	return &types.MsgRetryTransferResponse{IbcSequenceId: deposit.IbcSequenceId}, nil
+}
+
+// PauseHostChain stops a host chain right away, without waiting on a governance proposal
+func (k msgServer) PauseHostChain(
+	goCtx context.Context,
+	msg *types.MsgPauseHostChain,
+) (*types.MsgPauseHostChainResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateRole(ctx, msg.Authority, types.RoleEmergencyAdmin); err != nil {
+		return nil, err
+	}
+
+	hc, found := k.GetHostChain(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidHostChain, "host chain with id %s not registered", msg.ChainId)
+	}
+
+	if hc.Paused {
+		return nil, errorsmod.Wrapf(types.ErrHostChainInactive, "host chain %s is already paused", hc.ChainId)
+	}
+
+	hc.Paused = true
+	k.setHostChain(ctx, hc)
+
+	k.Logger(ctx).Info(
+		"Host chain paused.",
+		"host_chain",
+		hc.ChainId,
+		"authority",
+		msg.Authority,
+		"reason",
+		msg.Reason,
+	)
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.Authority),
+		),
+		sdktypes.NewEvent(
+			types.EventTypeHostChainPaused,
+			sdktypes.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdktypes.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdktypes.NewAttribute(types.AttributePauseReason, msg.Reason),
+		),
+	})
+
+	return &types.MsgPauseHostChainResponse{}, nil
+}
+
+// ResumeHostChain lifts the pause of a host chain, with a fresh circuit breaker. A host chain deactivated by other means
+// stays inactive.
+func (k msgServer) ResumeHostChain(
+	goCtx context.Context,
+	msg *types.MsgResumeHostChain,
+) (*types.MsgResumeHostChainResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateRole(ctx, msg.Authority, types.RoleEmergencyAdmin); err != nil {
+		return nil, err
+	}
+
+	hc, found := k.GetHostChain(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidHostChain, "host chain with id %s not registered", msg.ChainId)
+	}
+
+	if !hc.Paused {
+		return nil, errorsmod.Wrapf(types.ErrHostChainActive, "host chain %s is not paused", hc.ChainId)
+	}
+
+	k.ResetHostChainFailures(ctx, hc.ChainId)
+	hc.Paused = false
+	if err := k.SetHostChain(ctx, hc); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("Host chain resumed.", "host_chain", hc.ChainId, "authority", msg.Authority)
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.Authority),
+		),
+		sdktypes.NewEvent(
+			types.EventTypeHostChainResumed,
+			sdktypes.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdktypes.NewAttribute(types.AttributeChainID, hc.ChainId),
+		),
+	})
+
+	return &types.MsgResumeHostChainResponse{}, nil
+}
+
+// ForceReconcileDelegations queries the delegations of a host chain for reconciliation without waiting for the next
+// scheduled run. Only one forced reconciliation can be pending per reconciliation epoch.
+func (k msgServer) ForceReconcileDelegations(
+	goCtx context.Context,
+	msg *types.MsgForceReconcileDelegations,
+) (*types.MsgForceReconcileDelegationsResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateRole(ctx, msg.Authority, types.RoleValidatorSetAdmin); err != nil {
+		return nil, err
+	}
+
+	hc, found := k.GetHostChain(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidHostChain, "host chain with id %s not registered", msg.ChainId)
+	}
+
+	if !hc.IsActive() || hc.DelegationAccount == nil || hc.DelegationAccount.Address == "" {
+		return nil, errorsmod.Wrapf(types.ErrHostChainInactive, "host chain %s can't reconcile its delegations", hc.ChainId)
+	}
+
+	epoch := k.GetEpochNumber(ctx, types.ReconciliationEpoch)
+	if k.HasReconciliationPending(ctx, hc, epoch) {
+		return nil, errorsmod.Wrapf(
+			types.ErrReconciliationPending,
+			"host chain %s delegations were already queried in reconciliation epoch %d",
+			hc.ChainId,
+			epoch,
+		)
+	}
+
+	if err := k.ReconcileHostChainDelegations(ctx, hc, epoch); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("Forced delegation reconciliation.", "host_chain", hc.ChainId, "authority", msg.Authority)
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.Authority),
+		),
+		sdktypes.NewEvent(
+			types.EventTypeForceReconcileDelegations,
+			sdktypes.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdktypes.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdktypes.NewAttribute(types.AttributeEpoch, strconv.FormatInt(epoch, 10)),
+		),
+	})
+
+	return &types.MsgForceReconcileDelegationsResponse{}, nil
+}
+
+// SetFeeAddress rotates the module fee address, sending the fees accrued for it but not sent yet to either the old or
+// the new address so none of them are sent after the change to the address they were not meant for
+func (k msgServer) SetFeeAddress(
+	goCtx context.Context,
+	msg *types.MsgSetFeeAddress,
+) (*types.MsgSetFeeAddressResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateRole(ctx, msg.Authority, types.RoleFeeAdmin); err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	oldFeeAddress := params.FeeAddress
+	recipient := msg.FeeAddress
+
+	var flushed sdktypes.Coins
+	var err error
+	if msg.FlushToOldAddress {
+		recipient = oldFeeAddress
+		if flushed, err = k.FlushPendingFees(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	params.FeeAddress = msg.FeeAddress
+	k.SetParams(ctx, params)
+
+	if !msg.FlushToOldAddress {
+		if flushed, err = k.FlushPendingFees(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	k.Logger(ctx).Info(
+		"Set module fee address.",
+		"old_fee_address",
+		oldFeeAddress,
+		"fee_address",
+		msg.FeeAddress,
+		"flushed_fees",
+		flushed.String(),
+	)
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.Authority),
+		),
+		sdktypes.NewEvent(
+			types.EventTypeFeeAddressSet,
+			sdktypes.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdktypes.NewAttribute(types.AttributeOldFeeAddress, oldFeeAddress),
+			sdktypes.NewAttribute(types.AttributeFeeAddress, msg.FeeAddress),
+			sdktypes.NewAttribute(types.AttributeFlushedFees, flushed.String()),
+			sdktypes.NewAttribute(types.AttributeRecipientAddress, recipient),
+		),
+	})
+
+	return &types.MsgSetFeeAddressResponse{FlushedFees: flushed}, nil
+}
+
+// ExitValidator unbonds all the tokens delegated to a validator right away, without waiting for it to reach the
+// unbonding state epoch limit or for a queued exit to go through its delay
+func (k msgServer) ExitValidator(
+	goCtx context.Context,
+	msg *types.MsgExitValidator,
+) (*types.MsgExitValidatorResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateRole(ctx, msg.Authority, types.RoleValidatorSetAdmin); err != nil {
+		return nil, err
+	}
+
+	hc, found := k.GetHostChain(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidHostChain, "host chain with id %s not registered", msg.ChainId)
+	}
+
+	if !hc.IsActive() || hc.Degraded || hc.DelegationAccount == nil || hc.DelegationAccount.Address == "" {
+		return nil, errorsmod.Wrapf(types.ErrHostChainInactive, "host chain %s can't undelegate", hc.ChainId)
+	}
+
+	if hc.Flags.UndelegationsPaused {
+		return nil, errorsmod.Wrapf(types.ErrWorkflowPaused, "undelegations are paused on %s", hc.ChainId)
+	}
+
+	validator, found := hc.GetValidator(msg.ValidatorAddress)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrValidatorNotFound,
+			"validator %s not found on chain %s",
+			msg.ValidatorAddress,
+			hc.ChainId,
+		)
+	}
+
+	if !validator.DelegatedAmount.IsPositive() {
+		return nil, errorsmod.Wrapf(
+			types.ErrValidatorNotExitable,
+			"validator %s has no delegation on chain %s",
+			validator.OperatorAddress,
+			hc.ChainId,
+		)
+	}
+
+	epoch := k.GetEpochNumber(ctx, types.UndelegationEpoch)
+	if _, found = k.GetValidatorUnbonding(ctx, hc.ChainId, validator.OperatorAddress, epoch); found {
+		return nil, errorsmod.Wrapf(
+			types.ErrValidatorNotExitable,
+			"validator %s on chain %s is already unbonding in epoch %d",
+			validator.OperatorAddress,
+			hc.ChainId,
+			epoch,
+		)
+	}
+
+	// the weight can only be moved if another validator can take it
+	hasOtherWeightedValidators := false
+	for _, val := range hc.Validators {
+		if val.Weight.IsPositive() && val.OperatorAddress != validator.OperatorAddress {
+			hasOtherWeightedValidators = true
+			break
+		}
+	}
+	if !hasOtherWeightedValidators {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidValidatorSet,
+			"no other validator on chain %s can take the weight of %s",
+			hc.ChainId,
+			validator.OperatorAddress,
+		)
+	}
+
+	unbondingAmount := sdktypes.NewCoin(hc.HostDenom, validator.DelegatedAmount)
+	if err := k.ExecuteValidatorExit(ctx, hc, validator, epoch); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info(
+		"Forced validator exit.",
+		"host_chain",
+		hc.ChainId,
+		"validator",
+		validator.OperatorAddress,
+		"authority",
+		msg.Authority,
+	)
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.Authority),
+		),
+		sdktypes.NewEvent(
+			types.EventTypeValidatorExitForced,
+			sdktypes.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdktypes.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdktypes.NewAttribute(types.AttributeValidatorAddress, validator.OperatorAddress),
+			sdktypes.NewAttribute(types.AttributeValidatorUnbondingAmount, unbondingAmount.String()),
+			sdktypes.NewAttribute(types.AttributeEpoch, strconv.FormatInt(epoch, 10)),
+		),
+	})
+
+	return &types.MsgExitValidatorResponse{UnbondingAmount: unbondingAmount}, nil
 }
@@ -4,9 +4,15 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strconv"
 	"testing"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ibctfrtypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 
@@ -42,7 +48,13 @@ func (suite *IntegrationTestSuite) Test_msgServer_LiquidStake() {
 					Amount:           sdk.NewInt64Coin(hc.IBCDenom(), 1000),
 				},
 			},
-			want:    &types.MsgLiquidStakeResponse{},
+			want: &types.MsgLiquidStakeResponse{
+				Fees: types.NewFeesCharged(
+					types.KeyDepositFee,
+					sdk.NewCoin(hc.MintDenom(), hc.Params.DepositFee.MulInt64(1000).TruncateInt()),
+				),
+				CValue: hc.CValue,
+			},
 			wantErr: false,
 		}, {
 			name: "host chain with ibc denom not found",
@@ -278,6 +290,8 @@ func (suite *IntegrationTestSuite) Test_msgServer_LiquidStakeLSM() {
 				)
 			}
 
+			receipts := len(suite.app.LiquidStakeIBCKeeper.GetDepositReceiptsForDelegator(ctx, tt.args.msg.DelegatorAddress))
+
 			got, err := k.LiquidStakeLSM(tt.args.goCtx, tt.args.msg)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("LiquidStake() error = %v, wantErr %v", err, tt.wantErr)
@@ -287,6 +301,14 @@ func (suite *IntegrationTestSuite) Test_msgServer_LiquidStakeLSM() {
 				t.Errorf("LiquidStake() got = %v, want %v", got, tt.want)
 			}
 
+			// the lsm deposits are recorded in a receipt for the delegator too
+			if err == nil {
+				got := len(suite.app.LiquidStakeIBCKeeper.GetDepositReceiptsForDelegator(ctx, tt.args.msg.DelegatorAddress))
+				if got != receipts+len(tt.args.msg.Delegations) {
+					t.Errorf("LiquidStake() receipts = %v, want %v", got, receipts+len(tt.args.msg.Delegations))
+				}
+			}
+
 			suite.UpdateChainActive(true, hc)
 			suite.UpdateChainLSMActive(true, hc)
 		})
@@ -428,6 +450,357 @@ func (suite *IntegrationTestSuite) Test_msgServer_Redeem() {
 	}
 }
 
+func (suite *IntegrationTestSuite) Test_msgServer_TransferUnbonding() {
+	pstakeapp, ctx := suite.app, suite.ctx
+	hc, found := pstakeapp.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	delegator := suite.chainA.SenderAccounts[0].SenderAccount.GetAddress().String()
+	recipient := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+
+	pstakeapp.LiquidStakeIBCKeeper.SetUserUnbonding(ctx, &types.UserUnbonding{
+		ChainId:      hc.ChainId,
+		EpochNumber:  4,
+		Address:      delegator,
+		StkAmount:    sdk.NewInt64Coin(hc.MintDenom(), 100),
+		UnbondAmount: sdk.NewInt64Coin(hc.HostDenom, 110),
+	})
+	pstakeapp.LiquidStakeIBCKeeper.SetUserUnbonding(ctx, &types.UserUnbonding{
+		ChainId:      hc.ChainId,
+		EpochNumber:  4,
+		Address:      recipient,
+		StkAmount:    sdk.NewInt64Coin(hc.MintDenom(), 50),
+		UnbondAmount: sdk.NewInt64Coin(hc.HostDenom, 55),
+	})
+
+	type args struct {
+		goCtx context.Context
+		msg   *types.MsgTransferUnbonding
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *types.MsgTransferUnbondingResponse
+		wantErr bool
+	}{
+		{
+			name: "host chain not found",
+			args: args{
+				goCtx: ctx,
+				msg:   types.NewMsgTransferUnbonding(sdk.MustAccAddressFromBech32(delegator), sdk.MustAccAddressFromBech32(recipient), "chain-1", 4),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "unbonding not found",
+			args: args{
+				goCtx: ctx,
+				msg:   types.NewMsgTransferUnbonding(sdk.MustAccAddressFromBech32(delegator), sdk.MustAccAddressFromBech32(recipient), hc.ChainId, 5),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "success",
+			args: args{
+				goCtx: ctx,
+				msg:   types.NewMsgTransferUnbonding(sdk.MustAccAddressFromBech32(delegator), sdk.MustAccAddressFromBech32(recipient), hc.ChainId, 4),
+			},
+			want:    &types.MsgTransferUnbondingResponse{},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			k := keeper.NewMsgServerImpl(pstakeapp.LiquidStakeIBCKeeper)
+
+			got, err := k.TransferUnbonding(tt.args.goCtx, tt.args.msg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TransferUnbonding() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TransferUnbonding() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	_, found = pstakeapp.LiquidStakeIBCKeeper.GetUserUnbonding(ctx, hc.ChainId, delegator, 4)
+	suite.Require().False(found)
+
+	// the transferred position is merged with the recipient's existing one
+	ub, found := pstakeapp.LiquidStakeIBCKeeper.GetUserUnbonding(ctx, hc.ChainId, recipient, 4)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.MintDenom(), 150), ub.StkAmount)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.HostDenom, 165), ub.UnbondAmount)
+}
+
+func (suite *IntegrationTestSuite) Test_msgServer_CancelUnbonding() {
+	pstakeapp := suite.app
+	ctx, _ := suite.ctx.CacheContext()
+	hc, found := pstakeapp.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	delegator := suite.chainA.SenderAccounts[0].SenderAccount.GetAddress()
+	other := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+	for _, address := range []sdk.AccAddress{delegator, other} {
+		pstakeapp.LiquidStakeIBCKeeper.IncreaseUserUnbondingAmountForEpoch(
+			ctx, hc.ChainId, address.String(), 4, sdk.NewInt64Coin(hc.MintDenom(), 100), sdk.NewInt64Coin(hc.HostDenom, 110),
+		)
+		pstakeapp.LiquidStakeIBCKeeper.IncreaseUndelegatingAmountForEpoch(
+			ctx, hc.ChainId, 4, sdk.NewInt64Coin(hc.MintDenom(), 100), sdk.NewInt64Coin(hc.HostDenom, 110),
+		)
+	}
+	suite.Require().NoError(testutil.FundModuleAccount(
+		pstakeapp.BankKeeper, ctx, types.UndelegationModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), 200)),
+	))
+	pstakeapp.LiquidStakeIBCKeeper.IncreaseUndelegatingAmountForEpoch(
+		ctx, hc.ChainId, 5, sdk.NewInt64Coin(hc.MintDenom(), 100), sdk.NewInt64Coin(hc.HostDenom, 110),
+	)
+	pstakeapp.LiquidStakeIBCKeeper.IncreaseUserUnbondingAmountForEpoch(
+		ctx, hc.ChainId, delegator.String(), 5, sdk.NewInt64Coin(hc.MintDenom(), 100), sdk.NewInt64Coin(hc.HostDenom, 110),
+	)
+	initiated, _ := pstakeapp.LiquidStakeIBCKeeper.GetUnbonding(ctx, hc.ChainId, 5)
+	initiated.State = types.Unbonding_UNBONDING_INITIATED
+	pstakeapp.LiquidStakeIBCKeeper.SetUnbonding(ctx, initiated)
+
+	msgServer := keeper.NewMsgServerImpl(pstakeapp.LiquidStakeIBCKeeper)
+
+	_, err := msgServer.CancelUnbonding(ctx, types.NewMsgCancelUnbonding(delegator, "chain-1", 4))
+	suite.Require().ErrorIs(err, types.ErrInvalidHostChain)
+	_, err = msgServer.CancelUnbonding(ctx, types.NewMsgCancelUnbonding(delegator, hc.ChainId, 6))
+	suite.Require().ErrorIs(err, types.ErrUnbondingNotFound)
+	_, err = msgServer.CancelUnbonding(ctx, types.NewMsgCancelUnbonding(delegator, hc.ChainId, 5))
+	suite.Require().ErrorIs(err, types.ErrUnbondingNotCancellable)
+
+	_, err = msgServer.CancelUnbonding(ctx, types.NewMsgCancelUnbonding(delegator, hc.ChainId, 4))
+	suite.Require().NoError(err)
+
+	// the stk tokens are back and only the other delegator is left in the epoch
+	suite.Require().Equal(int64(100), pstakeapp.BankKeeper.GetBalance(ctx, delegator, hc.MintDenom()).Amount.Int64())
+	_, found = pstakeapp.LiquidStakeIBCKeeper.GetUserUnbonding(ctx, hc.ChainId, delegator.String(), 4)
+	suite.Require().False(found)
+	unbonding, found := pstakeapp.LiquidStakeIBCKeeper.GetUnbonding(ctx, hc.ChainId, 4)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.MintDenom(), 100), unbonding.BurnAmount)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.HostDenom, 110), unbonding.UnbondAmount)
+
+	// the last cancellation removes the epoch unbonding
+	_, err = msgServer.CancelUnbonding(ctx, types.NewMsgCancelUnbonding(other, hc.ChainId, 4))
+	suite.Require().NoError(err)
+	_, found = pstakeapp.LiquidStakeIBCKeeper.GetUnbonding(ctx, hc.ChainId, 4)
+	suite.Require().False(found)
+}
+
+func (suite *IntegrationTestSuite) Test_msgServer_SetAutoClaimAndClaim() {
+	pstakeapp := suite.app
+	k := pstakeapp.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	delegator := authtypes.NewModuleAddress("claimer")
+	other := authtypes.NewModuleAddress("other_claimer")
+
+	unbond := func(address sdk.AccAddress, epoch int64) {
+		k.IncreaseUserUnbondingAmountForEpoch(
+			ctx, hc.ChainId, address.String(), epoch, sdk.NewInt64Coin(hc.MintDenom(), 100), sdk.NewInt64Coin(hc.HostDenom, 110),
+		)
+		k.IncreaseUndelegatingAmountForEpoch(
+			ctx, hc.ChainId, epoch, sdk.NewInt64Coin(hc.MintDenom(), 100), sdk.NewInt64Coin(hc.HostDenom, 110),
+		)
+	}
+	setState := func(epoch int64, state types.Unbonding_UnbondingState) {
+		unbonding, found := k.GetUnbonding(ctx, hc.ChainId, epoch)
+		suite.Require().True(found)
+		unbonding.State = state
+		unbonding.MatureTime = ctx.BlockTime()
+		k.SetUnbonding(ctx, unbonding)
+	}
+	balance := func(address sdk.AccAddress, denom string) int64 {
+		return pstakeapp.BankKeeper.GetBalance(ctx, address, denom).Amount.Int64()
+	}
+
+	// a claimable epoch for both delegators, a failed, a pending, a matured and a held claimable epoch
+	unbond(delegator, 4)
+	unbond(other, 4)
+	setState(4, types.Unbonding_UNBONDING_CLAIMABLE)
+	unbond(delegator, 2)
+	unbond(delegator, 3)
+	setState(3, types.Unbonding_UNBONDING_FAILED)
+	unbond(delegator, 6)
+	unbond(delegator, 7)
+	setState(7, types.Unbonding_UNBONDING_MATURED)
+	unbond(delegator, 8)
+	setState(8, types.Unbonding_UNBONDING_CLAIMABLE)
+	suite.Require().NoError(testutil.FundModuleAccount(
+		pstakeapp.BankKeeper,
+		ctx,
+		types.UndelegationModuleAccount,
+		sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), 330), sdk.NewInt64Coin(hc.MintDenom(), 200)),
+	))
+
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	_, err := msgServer.SetAutoClaim(ctx, types.NewMsgSetAutoClaim(delegator, "chain-1", 4, true))
+	suite.Require().ErrorIs(err, types.ErrInvalidHostChain)
+	_, err = msgServer.SetAutoClaim(ctx, types.NewMsgSetAutoClaim(delegator, hc.ChainId, 5, true))
+	suite.Require().ErrorIs(err, types.ErrUnbondingNotFound)
+
+	// the claimable unbondings not opted in are left for a claim, the failed ones are refunded anyway
+	k.DoClaim(ctx, hc)
+	suite.Require().Zero(balance(delegator, hc.IBCDenom()))
+	suite.Require().Equal(int64(100), balance(delegator, hc.MintDenom()))
+	_, found = k.GetUserUnbonding(ctx, hc.ChainId, delegator.String(), 4)
+	suite.Require().True(found)
+	_, found = k.GetUserUnbonding(ctx, hc.ChainId, delegator.String(), 3)
+	suite.Require().False(found)
+
+	// the opted in unbonding is paid by the next claim workflow
+	_, err = msgServer.SetAutoClaim(ctx, types.NewMsgSetAutoClaim(delegator, hc.ChainId, 4, true))
+	suite.Require().NoError(err)
+	userUnbonding, found := k.GetUserUnbonding(ctx, hc.ChainId, delegator.String(), 4)
+	suite.Require().True(found)
+	suite.Require().True(userUnbonding.AutoClaim)
+
+	k.DoClaim(ctx, hc)
+	suite.Require().Equal(int64(110), balance(delegator, hc.IBCDenom()))
+	_, found = k.GetUserUnbonding(ctx, hc.ChainId, delegator.String(), 4)
+	suite.Require().False(found)
+	_, found = k.GetUserUnbonding(ctx, hc.ChainId, other.String(), 4)
+	suite.Require().True(found)
+
+	// the flag can be turned off again
+	_, err = msgServer.SetAutoClaim(ctx, types.NewMsgSetAutoClaim(delegator, hc.ChainId, 6, true))
+	suite.Require().NoError(err)
+	_, err = msgServer.SetAutoClaim(ctx, types.NewMsgSetAutoClaim(delegator, hc.ChainId, 6, false))
+	suite.Require().NoError(err)
+	userUnbonding, _ = k.GetUserUnbonding(ctx, hc.ChainId, delegator.String(), 6)
+	suite.Require().False(userUnbonding.AutoClaim)
+
+	_, err = msgServer.Claim(ctx, types.NewMsgClaim(delegator, "chain-1", 4))
+	suite.Require().ErrorIs(err, types.ErrInvalidHostChain)
+	_, err = msgServer.Claim(ctx, types.NewMsgClaim(delegator, hc.ChainId, 5))
+	suite.Require().ErrorIs(err, types.ErrUnbondingNotFound)
+	_, err = msgServer.Claim(ctx, types.NewMsgClaim(delegator, hc.ChainId, 6))
+	suite.Require().ErrorIs(err, types.ErrUnbondingNotClaimable)
+	_, err = msgServer.Claim(ctx, types.NewMsgClaim(delegator, hc.ChainId, 7))
+	suite.Require().ErrorIs(err, types.ErrUnbondingNotClaimable)
+
+	// the claimable epoch after the matured one waits for its transfer
+	_, err = msgServer.Claim(ctx, types.NewMsgClaim(delegator, hc.ChainId, 8))
+	suite.Require().ErrorIs(err, types.ErrUnbondingNotClaimable)
+
+	// the last claim of an epoch removes its unbonding
+	res, err := msgServer.Claim(ctx, types.NewMsgClaim(other, hc.ChainId, 4))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.HostDenom, 110), res.Amount)
+	suite.Require().Equal(int64(110), balance(other, hc.IBCDenom()))
+	_, found = k.GetUserUnbonding(ctx, hc.ChainId, other.String(), 4)
+	suite.Require().False(found)
+	_, found = k.GetUnbonding(ctx, hc.ChainId, 4)
+	suite.Require().False(found)
+
+	// failed unbondings return the stk tokens
+	setState(2, types.Unbonding_UNBONDING_FAILED)
+	res, err = msgServer.Claim(ctx, types.NewMsgClaim(delegator, hc.ChainId, 2))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.MintDenom(), 100), res.Amount)
+	suite.Require().Equal(int64(200), balance(delegator, hc.MintDenom()))
+
+	// once the matured epoch is paid the held epoch can be claimed
+	setState(7, types.Unbonding_UNBONDING_CLAIMABLE)
+	_, err = msgServer.Claim(ctx, types.NewMsgClaim(delegator, hc.ChainId, 8))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(220), balance(delegator, hc.IBCDenom()))
+}
+
+func (suite *IntegrationTestSuite) Test_msgServer_RetryTransfer() {
+	pstakeapp := suite.app
+	ctx, _ := suite.ctx.CacheContext()
+	hc, found := pstakeapp.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	signer := suite.chainA.SenderAccount.GetAddress()
+	epoch := pstakeapp.LiquidStakeIBCKeeper.GetEpochNumber(ctx, types.DelegationEpoch)
+	amount := sdk.NewInt64Coin(hc.IBCDenom(), 1000)
+	suite.Require().NoError(
+		pstakeapp.BankKeeper.SendCoinsFromAccountToModule(ctx, signer, types.DepositModuleAccount, sdk.NewCoins(amount)),
+	)
+
+	// a deposit of the previous epoch reverted to pending by a timed out transfer
+	pstakeapp.LiquidStakeIBCKeeper.SetDeposit(ctx, &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  amount,
+		Epoch:   epoch - 1,
+		State:   types.Deposit_DEPOSIT_PENDING,
+	})
+
+	msgServer := keeper.NewMsgServerImpl(pstakeapp.LiquidStakeIBCKeeper)
+
+	_, err := msgServer.RetryTransfer(ctx, types.NewMsgRetryTransfer(signer, "chain-1", epoch-1))
+	suite.Require().ErrorIs(err, types.ErrInvalidHostChain)
+	_, err = msgServer.RetryTransfer(ctx, types.NewMsgRetryTransfer(signer, hc.ChainId, epoch+1))
+	suite.Require().ErrorIs(err, types.ErrDepositNotFound)
+
+	// the deposit of the current epoch is sent by the next delegation epoch
+	pstakeapp.LiquidStakeIBCKeeper.SetDeposit(ctx, &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  sdk.NewInt64Coin(hc.IBCDenom(), 10),
+		Epoch:   epoch,
+		State:   types.Deposit_DEPOSIT_PENDING,
+	})
+	_, err = msgServer.RetryTransfer(ctx, types.NewMsgRetryTransfer(signer, hc.ChainId, epoch))
+	suite.Require().ErrorIs(err, types.ErrDepositNotRetryable)
+
+	res, err := msgServer.RetryTransfer(ctx, types.NewMsgRetryTransfer(signer, hc.ChainId, epoch-1))
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(res.IbcSequenceId)
+
+	deposit, found := pstakeapp.LiquidStakeIBCKeeper.GetDepositForChainAndEpoch(ctx, hc.ChainId, epoch-1)
+	suite.Require().True(found)
+	suite.Require().Equal(types.Deposit_DEPOSIT_SENT, deposit.State)
+	suite.Require().Equal(res.IbcSequenceId, deposit.IbcSequenceId)
+
+	// the transfer is in flight, it can't be sent twice
+	_, err = msgServer.RetryTransfer(ctx, types.NewMsgRetryTransfer(signer, hc.ChainId, epoch-1))
+	suite.Require().ErrorIs(err, types.ErrDepositNotRetryable)
+
+	// failed deposits can be retried by hand once they run out of automatic retries
+	deposit.State = types.Deposit_DEPOSIT_FAILED
+	deposit.IbcSequenceId = ""
+	deposit.Retries = pstakeapp.LiquidStakeIBCKeeper.GetParams(ctx).MaxDepositRetries
+	pstakeapp.LiquidStakeIBCKeeper.SetDeposit(ctx, deposit)
+	suite.Require().NoError(
+		pstakeapp.BankKeeper.SendCoinsFromAccountToModule(ctx, signer, types.DepositModuleAccount, sdk.NewCoins(amount)),
+	)
+	res, err = msgServer.RetryTransfer(ctx, types.NewMsgRetryTransfer(signer, hc.ChainId, epoch-1))
+	suite.Require().NoError(err)
+	deposit, _ = pstakeapp.LiquidStakeIBCKeeper.GetDepositForChainAndEpoch(ctx, hc.ChainId, epoch-1)
+	suite.Require().Equal(types.Deposit_DEPOSIT_SENT, deposit.State)
+	suite.Require().Equal(res.IbcSequenceId, deposit.IbcSequenceId)
+
+	// paused deposits stay unsent until the deposits resume
+	pausedCtx, _ := ctx.CacheContext()
+	deposit.State = types.Deposit_DEPOSIT_FAILED
+	pstakeapp.LiquidStakeIBCKeeper.SetDeposit(pausedCtx, deposit)
+	paused := *hc
+	paused.Flags = &types.HostChainFlags{Lsm: hc.Flags.Lsm, DepositsPaused: true}
+	suite.Require().NoError(pstakeapp.LiquidStakeIBCKeeper.SetHostChain(pausedCtx, &paused))
+	_, err = msgServer.RetryTransfer(pausedCtx, types.NewMsgRetryTransfer(signer, hc.ChainId, epoch-1))
+	suite.Require().ErrorIs(err, types.ErrWorkflowPaused)
+	deposit, _ = pstakeapp.LiquidStakeIBCKeeper.GetDepositForChainAndEpoch(pausedCtx, hc.ChainId, epoch-1)
+	suite.Require().Equal(types.Deposit_DEPOSIT_FAILED, deposit.State)
+
+	// degraded host chains can't send transfers
+	hc.Degraded = true
+	suite.Require().NoError(pstakeapp.LiquidStakeIBCKeeper.SetHostChain(ctx, hc))
+	_, err = msgServer.RetryTransfer(ctx, types.NewMsgRetryTransfer(signer, hc.ChainId, epoch-1))
+	suite.Require().ErrorIs(err, types.ErrChannelNotOpen)
+}
+
 func (suite *IntegrationTestSuite) Test_msgServer_RegisterHostChain() {
 	pstakeapp, ctx := suite.app, suite.ctx
 
@@ -565,6 +938,22 @@ func (suite *IntegrationTestSuite) Test_msgServer_UpdateHostChain() {
 			want:    &types.MsgUpdateHostChainResponse{},
 			wantErr: false,
 		},
+		{
+			name: "not enough active validators",
+			args: args{
+				goCtx: ctx,
+				msg: &types.MsgUpdateHostChain{
+					Authority: suite.chainA.SenderAccount.GetAddress().String(),
+					ChainId:   hc.ChainId,
+					Updates: []*types.KVUpdate{{
+						Key:   types.KeyMinActiveValidators,
+						Value: "100",
+					}},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		suite.T().Run(tt.name, func(t *testing.T) {
@@ -625,3 +1014,508 @@ func (suite *IntegrationTestSuite) Test_msgServer_UpdateParams() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestLiquidStakeMinStkAmountOut() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx := suite.ctx
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	k.SetDeposit(ctx, &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  sdk.NewCoin(hc.IBCDenom(), sdk.ZeroInt()),
+		Epoch:   suite.app.EpochsKeeper.GetEpochInfo(ctx, types.DelegationEpoch).CurrentEpoch,
+		State:   types.Deposit_DEPOSIT_PENDING,
+	})
+
+	// the delegator receives the minted amount minus the deposit fee
+	mintAmount := sdk.NewDecFromInt(MinDeposit).Mul(hc.CValue).TruncateInt()
+	fee := hc.Params.DepositFee.MulInt(mintAmount).TruncateInt()
+	amountOut := mintAmount.Sub(fee)
+
+	msg := types.NewMsgLiquidStake(sdk.NewCoin(hc.IBCDenom(), MinDeposit), suite.chainA.SenderAccount.GetAddress())
+	msg.MinStkAmountOut = amountOut.AddRaw(1)
+	_, err := msgServer.LiquidStake(ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrMinStkAmountOut)
+
+	msg.MinStkAmountOut = amountOut
+	_, err = msgServer.LiquidStake(ctx, msg)
+	suite.Require().NoError(err)
+}
+
+func (suite *IntegrationTestSuite) TestLiquidStakeRecipient() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	k.SetDeposit(ctx, &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  sdk.NewCoin(hc.IBCDenom(), sdk.ZeroInt()),
+		Epoch:   suite.app.EpochsKeeper.GetEpochInfo(ctx, types.DelegationEpoch).CurrentEpoch,
+		State:   types.Deposit_DEPOSIT_PENDING,
+	})
+
+	delegator := suite.chainA.SenderAccount.GetAddress()
+	recipient := authtypes.NewModuleAddress("recipient")
+	delegatorStkBalance := suite.app.BankKeeper.GetBalance(ctx, delegator, hc.MintDenom())
+
+	msg := types.NewMsgLiquidStake(sdk.NewCoin(hc.IBCDenom(), MinDeposit), delegator)
+	msg.Recipient = recipient.String()
+	_, err := msgServer.LiquidStake(ctx, msg)
+	suite.Require().NoError(err)
+
+	// the delegator pays for the deposit and the recipient receives the stk tokens
+	mintAmount := sdk.NewDecFromInt(MinDeposit).Mul(hc.CValue).TruncateInt()
+	fee := hc.Params.DepositFee.MulInt(mintAmount).TruncateInt()
+	suite.Require().Equal(mintAmount.Sub(fee), suite.app.BankKeeper.GetBalance(ctx, recipient, hc.MintDenom()).Amount)
+	suite.Require().Equal(delegatorStkBalance, suite.app.BankKeeper.GetBalance(ctx, delegator, hc.MintDenom()))
+
+	// in delayed mint mode the pending mint belongs to the recipient
+	hc.Flags.DelayedMint = true
+	k.SetHostChain(ctx, hc)
+	_, err = msgServer.LiquidStake(ctx, msg)
+	suite.Require().NoError(err)
+	epoch := k.GetEpochNumber(ctx, types.DelegationEpoch)
+	_, found = k.GetPendingMint(ctx, hc.ChainId, recipient.String(), epoch)
+	suite.Require().True(found)
+	_, found = k.GetPendingMint(ctx, hc.ChainId, delegator.String(), epoch)
+	suite.Require().False(found)
+}
+
+func (suite *IntegrationTestSuite) TestLiquidUnstakeMinTokensOut() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx := suite.ctx
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	for _, validator := range hc.Validators {
+		validator.DelegatedAmount = MinDeposit.MulRaw(1000)
+	}
+	k.SetHostChain(ctx, hc)
+
+	delegator := suite.chainA.SenderAccount.GetAddress()
+	stkAmount := sdk.NewCoin(hc.MintDenom(), MinDeposit.MulRaw(10))
+	suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, ctx, delegator, sdk.NewCoins(stkAmount)))
+
+	// the delegator unbonds the stk amount minus the unstake fee at the current c value
+	unstakeAmount := stkAmount.Amount.Sub(hc.Params.UnstakeFee.MulInt(stkAmount.Amount).TruncateInt())
+	tokensOut := sdk.NewDecFromInt(unstakeAmount).Mul(sdk.OneDec().Quo(hc.CValue)).TruncateInt()
+
+	msg := types.NewMsgLiquidUnstake(stkAmount, delegator)
+	// a failed message is reverted with its transaction, which the cached context stands for
+	cachedCtx, _ := ctx.CacheContext()
+	msg.MinTokensOut = tokensOut.AddRaw(1)
+	_, err := msgServer.LiquidUnstake(cachedCtx, msg)
+	suite.Require().ErrorIs(err, types.ErrMinTokensOut)
+
+	msg.MinTokensOut = tokensOut
+	_, err = msgServer.LiquidUnstake(ctx, msg)
+	suite.Require().NoError(err)
+}
+
+func (suite *IntegrationTestSuite) TestLiquidUnstakeUnbondingCap() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	for _, validator := range hc.Validators {
+		validator.DelegatedAmount = MinDeposit.MulRaw(1000)
+	}
+	hc.CValue = sdk.OneDec()
+	hc.Params.UnstakeFee = sdk.ZeroDec()
+	hc.Params.MaxUnbondingAmount = MinDeposit.MulRaw(15)
+	k.SetHostChain(ctx, hc)
+
+	delegator := suite.chainA.SenderAccount.GetAddress()
+	stkAmount := sdk.NewCoin(hc.MintDenom(), MinDeposit.MulRaw(10))
+	suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, ctx, delegator, sdk.NewCoins(stkAmount.Add(stkAmount))))
+
+	unbondingEpoch := hc.CurrentUnbondingEpoch(k.GetEpochNumber(ctx, types.UndelegationEpoch))
+	nextUnbondingEpoch := unbondingEpoch + hc.UnbondingFactor
+	unbondAmount := func(epoch int64) math.Int {
+		userUnbonding, found := k.GetUserUnbonding(ctx, hc.ChainId, delegator.String(), epoch)
+		if !found {
+			return sdk.ZeroInt()
+		}
+		unbonding, found := k.GetUnbonding(ctx, hc.ChainId, epoch)
+		suite.Require().True(found)
+		suite.Require().Equal(unbonding.UnbondAmount, userUnbonding.UnbondAmount)
+		suite.Require().Equal(unbonding.BurnAmount, userUnbonding.StkAmount)
+		return userUnbonding.UnbondAmount.Amount
+	}
+
+	// an unbonding under the cap goes to the next unbonding epoch
+	_, err := msgServer.LiquidUnstake(ctx, types.NewMsgLiquidUnstake(stkAmount, delegator))
+	suite.Require().NoError(err)
+	suite.Require().Equal(MinDeposit.MulRaw(10), unbondAmount(unbondingEpoch))
+
+	// the part above the cap rolls into the following one, every epoch of the unbonding is opted in to the auto claim
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	msg := types.NewMsgLiquidUnstake(stkAmount, delegator)
+	msg.AutoClaim = true
+	_, err = msgServer.LiquidUnstake(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(MinDeposit.MulRaw(15), unbondAmount(unbondingEpoch))
+	suite.Require().Equal(MinDeposit.MulRaw(5), unbondAmount(nextUnbondingEpoch))
+	for _, epoch := range []int64{unbondingEpoch, nextUnbondingEpoch} {
+		userUnbonding, _ := k.GetUserUnbonding(ctx, hc.ChainId, delegator.String(), epoch)
+		suite.Require().True(userUnbonding.AutoClaim)
+	}
+
+	rolledOver := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeUnbondingRolledOver {
+			rolledOver = true
+		}
+	}
+	suite.Require().True(rolledOver)
+
+	// a full epoch has no capacity left
+	capacity, _ := k.GetUnbondingCapacity(ctx, hc, 2)
+	suite.Require().Equal(unbondingEpoch, capacity[0].EpochNumber)
+	suite.Require().Equal(sdk.ZeroInt(), capacity[0].Capacity)
+	suite.Require().Equal(MinDeposit.MulRaw(10), capacity[1].Capacity)
+
+	// the rolled over unbonding keeps the unbonding epoch offset from moving
+	_, err = msgServer.UpdateHostChain(ctx, types.NewMsgUpdateHostChain(
+		hc.ChainId,
+		k.GetParams(ctx).AdminAddress,
+		[]*types.KVUpdate{{Key: types.KeyUnbondingEpochOffset, Value: "1"}},
+	))
+	suite.Require().Error(err)
+}
+
+func (suite *IntegrationTestSuite) TestUpdateHostChainUnbondingEpochOffset() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	updateOffset := func(ctx sdk.Context, offset string) error {
+		_, err := msgServer.UpdateHostChain(ctx, types.NewMsgUpdateHostChain(
+			hc.ChainId,
+			k.GetParams(ctx).AdminAddress,
+			[]*types.KVUpdate{{Key: types.KeyUnbondingEpochOffset, Value: offset}},
+		))
+		return err
+	}
+
+	// the offset has to stay within a cycle of unbonding epochs
+	cacheCtx, _ := ctx.CacheContext()
+	suite.Require().Error(updateOffset(cacheCtx, strconv.FormatInt(hc.UnbondingFactor, 10)))
+
+	suite.Require().NoError(updateOffset(ctx, "1"))
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(int64(1), hc.UnbondingEpochOffset)
+
+	// undelegations queued for the next unbonding epoch keep the offset in place
+	epochNumber := k.GetEpochNumber(ctx, types.UndelegationEpoch)
+	k.IncreaseUndelegatingAmountForEpoch(
+		ctx,
+		hc.ChainId,
+		hc.CurrentUnbondingEpoch(epochNumber),
+		sdk.NewInt64Coin(hc.MintDenom(), 100),
+		sdk.NewInt64Coin(hc.HostDenom, 100),
+	)
+	cacheCtx, _ = ctx.CacheContext()
+	suite.Require().Error(updateOffset(cacheCtx, "0"))
+	suite.Require().NoError(updateOffset(ctx, "1"))
+}
+
+func (suite *IntegrationTestSuite) TestPausedUndelegations() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	hc.Flags.UndelegationsPaused = true
+	k.SetHostChain(ctx, hc)
+
+	// new liquid unstakes are rejected
+	delegator := suite.chainA.SenderAccount.GetAddress()
+	stkAmount := sdk.NewCoin(hc.MintDenom(), MinDeposit.MulRaw(10))
+	suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, ctx, delegator, sdk.NewCoins(stkAmount)))
+	_, err := msgServer.LiquidUnstake(ctx, types.NewMsgLiquidUnstake(stkAmount, delegator))
+	suite.Require().ErrorIs(err, types.ErrWorkflowPaused)
+
+	// the unbondings already queued stay pending while the undelegations are paused
+	epoch := hc.CurrentUnbondingEpoch(k.GetEpochNumber(ctx, types.UndelegationEpoch))
+	k.SetUnbonding(ctx, &types.Unbonding{
+		ChainId:      hc.ChainId,
+		EpochNumber:  epoch,
+		BurnAmount:   stkAmount,
+		UnbondAmount: sdk.NewCoin(hc.HostDenom, stkAmount.Amount),
+		State:        types.Unbonding_UNBONDING_PENDING,
+	})
+	k.UndelegationWorkflow(ctx, epoch)
+
+	unbonding, found := k.GetUnbonding(ctx, hc.ChainId, epoch)
+	suite.Require().True(found)
+	suite.Require().Equal(types.Unbonding_UNBONDING_PENDING, unbonding.State)
+
+	// and are sent by the first unbonding epoch after the undelegations resume
+	hc.Flags.UndelegationsPaused = false
+	for _, validator := range hc.Validators {
+		validator.DelegatedAmount = MinDeposit.MulRaw(1000)
+	}
+	k.SetHostChain(ctx, hc)
+	k.UndelegationWorkflow(ctx, epoch+hc.UnbondingFactor)
+
+	unbonding, found = k.GetUnbonding(ctx, hc.ChainId, epoch)
+	suite.Require().True(found)
+	suite.Require().Equal(types.Unbonding_UNBONDING_INITIATED, unbonding.State)
+	suite.Require().NotEmpty(unbonding.IbcSequenceId)
+}
+
+func (suite *IntegrationTestSuite) TestPausedLSMDeposits() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	hc.Flags.LsmPaused = true
+	k.SetHostChain(ctx, hc)
+
+	delegator := suite.chainA.SenderAccount.GetAddress()
+	lsmDenom := ibctfrtypes.ParseDenomTrace(
+		ibctfrtypes.GetPrefixedDenom(hc.PortId, hc.ChannelId, hc.Validators[0].OperatorAddress+"/1"),
+	).IBCDenom()
+	lsmTokens := sdk.NewCoins(sdk.NewCoin(lsmDenom, MinDeposit))
+	suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, ctx, delegator, lsmTokens))
+
+	_, err := msgServer.LiquidStakeLSM(ctx, types.NewMsgLiquidStakeLSM(lsmTokens, delegator))
+	suite.Require().ErrorIs(err, types.ErrWorkflowPaused)
+}
+
+func (suite *IntegrationTestSuite) TestPauseResumeHostChain() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	suite.Require().True(hc.Active)
+
+	emergencyAdmin := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	// only governance can pause while there is no emergency admin
+	_, err := msgServer.PauseHostChain(ctx, types.NewMsgPauseHostChain(emergencyAdmin, hc.ChainId, "host chain halted"))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	params := k.GetParams(ctx)
+	params.EmergencyAdminAddress = emergencyAdmin.String()
+	k.SetParams(ctx, params)
+
+	// the module admin is not an emergency authority
+	_, err = msgServer.PauseHostChain(
+		ctx,
+		&types.MsgPauseHostChain{Authority: params.AdminAddress, ChainId: hc.ChainId, Reason: "host chain halted"},
+	)
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.PauseHostChain(ctx, types.NewMsgPauseHostChain(emergencyAdmin, "unknown", "host chain halted"))
+	suite.Require().ErrorIs(err, types.ErrInvalidHostChain)
+
+	k.RecordHostChainFailure(ctx, hc.ChainId, fmt.Errorf("ica error"))
+	_, err = msgServer.PauseHostChain(ctx, types.NewMsgPauseHostChain(emergencyAdmin, hc.ChainId, "host chain halted"))
+	suite.Require().NoError(err)
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().True(hc.Paused)
+	suite.Require().True(hc.Active)
+	suite.Require().False(hc.IsActive())
+
+	_, err = msgServer.PauseHostChain(ctx, types.NewMsgPauseHostChain(gov, hc.ChainId, "host chain halted"))
+	suite.Require().ErrorIs(err, types.ErrHostChainInactive)
+
+	// resuming starts the circuit breaker over
+	_, err = msgServer.ResumeHostChain(ctx, types.NewMsgResumeHostChain(gov, hc.ChainId))
+	suite.Require().NoError(err)
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().False(hc.Paused)
+	suite.Require().True(hc.IsActive())
+	_, found = k.GetHostChainFailures(ctx, hc.ChainId)
+	suite.Require().False(found)
+
+	_, err = msgServer.ResumeHostChain(ctx, types.NewMsgResumeHostChain(emergencyAdmin, hc.ChainId))
+	suite.Require().ErrorIs(err, types.ErrHostChainActive)
+
+	// the pause doesn't own the active state, a host chain deactivated by governance stays inactive once resumed
+	hc.Active = false
+	suite.Require().NoError(k.SetHostChain(ctx, hc))
+	_, err = msgServer.PauseHostChain(ctx, types.NewMsgPauseHostChain(emergencyAdmin, hc.ChainId, "host chain halted"))
+	suite.Require().NoError(err)
+	_, err = msgServer.ResumeHostChain(ctx, types.NewMsgResumeHostChain(emergencyAdmin, hc.ChainId))
+	suite.Require().NoError(err)
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().False(hc.Active)
+	suite.Require().False(hc.IsActive())
+}
+
+func (suite *IntegrationTestSuite) TestForceReconcileDelegations() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	validatorSetAdmin := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	forceReconcile := func(chainID string) error {
+		_, err := msgServer.ForceReconcileDelegations(ctx, types.NewMsgForceReconcileDelegations(validatorSetAdmin, chainID))
+		return err
+	}
+
+	err := forceReconcile(hc.ChainId)
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	params := k.GetParams(ctx)
+	params.ValidatorSetAdminAddress = validatorSetAdmin.String()
+	k.SetParams(ctx, params)
+
+	err = forceReconcile("unknown")
+	suite.Require().ErrorIs(err, types.ErrInvalidHostChain)
+
+	// the delegations scheduled for reconciliation in a previous epoch don't hold the forced run back
+	k.ReconcileDelegationsWorkflow(ctx, k.GetEpochNumber(ctx, types.ReconciliationEpoch)-1)
+	err = forceReconcile(hc.ChainId)
+	suite.Require().NoError(err)
+	for _, validator := range hc.Validators {
+		reconciliation, found := k.GetDelegationReconciliation(ctx, hc.ChainId, validator.OperatorAddress)
+		suite.Require().True(found)
+		suite.Require().Equal(k.GetEpochNumber(ctx, types.ReconciliationEpoch), reconciliation.Epoch)
+	}
+
+	// a second forced run has to wait for the first one to be reconciled
+	err = forceReconcile(hc.ChainId)
+	suite.Require().ErrorIs(err, types.ErrReconciliationPending)
+
+	hc.Active = false
+	k.SetHostChain(ctx, hc)
+	err = forceReconcile(hc.ChainId)
+	suite.Require().ErrorIs(err, types.ErrHostChainInactive)
+}
+
+func (suite *IntegrationTestSuite) TestRegisterSeededHostChain() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	// the seed is paid out of the community pool
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	endpoint := suite.transferPathAC.EndpointA
+	ibcDenom := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(endpoint.ChannelConfig.PortID, endpoint.ChannelID, "uosmo"),
+	).IBCDenom()
+	seedAmount := MinDeposit.MulRaw(1000)
+	funder := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	seedCoins := sdk.NewCoins(sdk.NewCoin(ibcDenom, seedAmount))
+	suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, ctx, funder, seedCoins))
+	suite.Require().NoError(suite.app.DistrKeeper.FundCommunityPool(ctx, seedCoins, funder))
+
+	msg := &types.MsgRegisterHostChain{
+		Authority:          authority.String(),
+		ConnectionId:       endpoint.ConnectionID,
+		DepositFee:         sdk.ZeroDec(),
+		RestakeFee:         sdk.ZeroDec(),
+		UnstakeFee:         sdk.ZeroDec(),
+		RedemptionFee:      sdk.ZeroDec(),
+		ChannelId:          endpoint.ChannelID,
+		PortId:             endpoint.ChannelConfig.PortID,
+		HostDenom:          "uosmo",
+		MinimumDeposit:     sdk.OneInt(),
+		UnbondingFactor:    4,
+		AutoCompoundFactor: 2,
+		SeedAmount:         seedAmount.MulRaw(2),
+	}
+
+	// only governance can spend the community pool
+	adminMsg := *msg
+	adminMsg.Authority = funder.String()
+	params := k.GetParams(ctx)
+	params.AdminAddress = funder.String()
+	k.SetParams(ctx, params)
+	_, err := msgServer.RegisterHostChain(ctx, &adminMsg)
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	// the community pool must hold the seed
+	cachedCtx, _ := ctx.CacheContext()
+	_, err = msgServer.RegisterHostChain(cachedCtx, msg)
+	suite.Require().ErrorIs(err, types.ErrFailedDeposit)
+
+	msg.SeedAmount = seedAmount
+	_, err = msgServer.RegisterHostChain(ctx, msg)
+	suite.Require().NoError(err)
+
+	hc, found := k.GetHostChain(ctx, suite.chainC.ChainID)
+	suite.Require().True(found)
+	suite.Require().True(hc.Seeding)
+	suite.Require().Equal(ibcDenom, hc.IBCDenom())
+
+	// the seed is the first deposit, its stk tokens go to the community pool at the initial c value
+	deposit, found := k.GetDepositForChainAndEpoch(ctx, hc.ChainId, k.GetEpochNumber(ctx, types.DelegationEpoch))
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoin(ibcDenom, seedAmount), deposit.Amount)
+	communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+	suite.Require().True(communityPool.AmountOf(ibcDenom).IsZero())
+	suite.Require().Equal(sdk.NewDecFromInt(seedAmount), communityPool.AmountOf(hc.MintDenom()))
+
+	// public deposits stay closed after activation until the seed is delegated
+	hc.Active = true
+	k.SetHostChain(ctx, hc)
+	delegator := suite.chainA.SenderAccount.GetAddress()
+	suite.Require().NoError(
+		testutil.FundAccount(suite.app.BankKeeper, ctx, delegator, sdk.NewCoins(sdk.NewCoin(ibcDenom, seedAmount))),
+	)
+	_, err = msgServer.LiquidStake(ctx, types.NewMsgLiquidStake(sdk.NewCoin(ibcDenom, seedAmount), delegator))
+	suite.Require().ErrorIs(err, types.ErrHostChainSeeding)
+}
+
+func (suite *IntegrationTestSuite) TestSeedDelegated() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	hc.Seeding = true
+	hc.DelegationAccount.Balance = sdk.NewCoin(hc.HostDenom, MinDeposit)
+	k.SetHostChain(ctx, hc)
+
+	// the delegation ack of the seed opens the host chain to deposits
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err := k.HandleDelegateResponse(
+		ctx,
+		&stakingtypes.MsgDelegate{
+			DelegatorAddress: hc.DelegationAccount.Address,
+			ValidatorAddress: hc.Validators[0].OperatorAddress,
+			Amount:           sdk.NewCoin(hc.HostDenom, MinDeposit),
+		},
+		k.GetPortID(hc.DelegationAccount.Owner),
+		"channel-0",
+		1,
+	)
+	suite.Require().NoError(err)
+
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().False(hc.Seeding)
+
+	delegated := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeHostChainSeedDelegated {
+			delegated = true
+		}
+	}
+	suite.Require().True(delegated)
+}
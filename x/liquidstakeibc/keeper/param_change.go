@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// SetPendingParamChange stores a host chain update staged for the param change delay
+func (k *Keeper) SetPendingParamChange(ctx sdk.Context, change *types.PendingParamChange) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingParamChangeKey)
+	bytes := k.cdc.MustMarshal(change)
+	store.Set(types.GetPendingParamChangeStoreKey(change.ChainId, change.Key), bytes)
+}
+
+// GetPendingParamChange returns the update of a host chain key staged for the param change delay
+func (k *Keeper) GetPendingParamChange(ctx sdk.Context, chainID, key string) (*types.PendingParamChange, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingParamChangeKey)
+	bytes := store.Get(types.GetPendingParamChangeStoreKey(chainID, key))
+	if len(bytes) == 0 {
+		return &types.PendingParamChange{}, false
+	}
+
+	var change types.PendingParamChange
+	k.cdc.MustUnmarshal(bytes, &change)
+	return &change, true
+}
+
+// GetAllPendingParamChanges returns the host chain updates staged for the param change delay
+func (k *Keeper) GetAllPendingParamChanges(ctx sdk.Context) []*types.PendingParamChange {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingParamChangeKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	changes := make([]*types.PendingParamChange, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		change := types.PendingParamChange{}
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		changes = append(changes, &change)
+	}
+
+	return changes
+}
+
+// GetPendingParamChangesForHostChain returns the updates of a host chain staged for the param change delay
+func (k *Keeper) GetPendingParamChangesForHostChain(ctx sdk.Context, chainID string) []*types.PendingParamChange {
+	changes := make([]*types.PendingParamChange, 0)
+	for _, change := range k.GetAllPendingParamChanges(ctx) {
+		if change.ChainId == chainID {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes
+}
+
+// DeletePendingParamChange removes a staged host chain update
+func (k *Keeper) DeletePendingParamChange(ctx sdk.Context, change *types.PendingParamChange) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingParamChangeKey)
+	store.Delete(types.GetPendingParamChangeStoreKey(change.ChainId, change.Key))
+}
+
+// StageParamChange stages a host chain update until the delay has passed. A newer update of the same key replaces
+// the staged one and restarts the delay.
+func (k *Keeper) StageParamChange(ctx sdk.Context, hc *types.HostChain, update *types.KVUpdate, delay time.Duration) {
+	change := &types.PendingParamChange{
+		ChainId:       hc.ChainId,
+		Key:           update.Key,
+		Value:         update.Value,
+		EffectiveTime: ctx.BlockTime().Add(delay),
+	}
+	k.SetPendingParamChange(ctx, change)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeParamChangeStaged,
+			sdk.NewAttribute(types.AttributeChainID, change.ChainId),
+			sdk.NewAttribute(types.AttributeParamChangeKey, change.Key),
+			sdk.NewAttribute(types.AttributeParamChangeValue, change.Value),
+			sdk.NewAttribute(types.AttributeParamChangeEffectiveTime, change.EffectiveTime.String()),
+		),
+	)
+}
+
+// ApplyPendingParamChanges applies the staged host chain updates whose delay has passed. An update that is no
+// longer valid against the host chain it is applied to is dropped.
+func (k *Keeper) ApplyPendingParamChanges(ctx sdk.Context) {
+	for _, change := range k.GetAllPendingParamChanges(ctx) {
+		if ctx.BlockTime().Before(change.EffectiveTime) {
+			continue
+		}
+
+		k.DeletePendingParamChange(ctx, change)
+
+		err := k.applyPendingParamChange(ctx, change)
+		if err != nil {
+			k.Logger(ctx).Error(
+				"Could not apply staged host chain update.",
+				"host_chain",
+				change.ChainId,
+				"key",
+				change.Key,
+				"err",
+				err.Error(),
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeParamChangeFailed,
+					sdk.NewAttribute(types.AttributeChainID, change.ChainId),
+					sdk.NewAttribute(types.AttributeParamChangeKey, change.Key),
+					sdk.NewAttribute(types.AttributeParamChangeValue, change.Value),
+					sdk.NewAttribute(types.AttributeKeyAckError, err.Error()),
+				),
+			)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeParamChangeApplied,
+				sdk.NewAttribute(types.AttributeChainID, change.ChainId),
+				sdk.NewAttribute(types.AttributeParamChangeKey, change.Key),
+				sdk.NewAttribute(types.AttributeParamChangeValue, change.Value),
+			),
+		)
+	}
+}
+
+// applyPendingParamChange applies a staged host chain update, leaving the host chain untouched if it fails
+func (k *Keeper) applyPendingParamChange(ctx sdk.Context, change *types.PendingParamChange) error {
+	hc, found := k.GetHostChain(ctx, change.ChainId)
+	if !found {
+		return types.ErrInvalidHostChain
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.ApplyHostChainUpdates(
+		cacheCtx,
+		hc,
+		[]*types.KVUpdate{{Key: change.Key, Value: change.Value}},
+	); err != nil {
+		return err
+	}
+	write()
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestParamChangeDelay() {
+	ctx, _ := suite.ctx.CacheContext()
+	k := suite.app.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	params := k.GetParams(ctx)
+	params.ParamChangeDelay = time.Hour
+	k.SetParams(ctx, params)
+
+	// the fee update is staged while the withdrawal threshold is applied right away
+	msgServer := keeper.NewMsgServerImpl(k)
+	_, err := msgServer.UpdateHostChain(ctx, &types.MsgUpdateHostChain{
+		Authority: params.AdminAddress,
+		ChainId:   hc.ChainId,
+		Updates: []*types.KVUpdate{
+			{Key: types.KeyDepositFee, Value: "0.05"},
+			{Key: types.KeyMinRewardWithdrawal, Value: "1000"},
+		},
+	})
+	suite.Require().NoError(err)
+
+	updated, _ := k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(hc.Params.DepositFee, updated.Params.DepositFee)
+	suite.Require().Equal(sdk.NewInt(1000), updated.Params.MinRewardWithdrawalDelegation)
+
+	change, found := k.GetPendingParamChange(ctx, hc.ChainId, types.KeyDepositFee)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal("0.05", change.Value)
+	suite.Require().Equal(ctx.BlockTime().Add(time.Hour), change.EffectiveTime)
+
+	res, err := k.PendingParamChanges(sdk.WrapSDKContext(ctx), &types.QueryPendingParamChangesRequest{ChainId: hc.ChainId})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.PendingParamChange{change}, res.Changes)
+
+	// the change is not applied before the delay has passed
+	k.ApplyPendingParamChanges(ctx.WithBlockTime(change.EffectiveTime.Add(-time.Second)))
+	updated, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(hc.Params.DepositFee, updated.Params.DepositFee)
+
+	// only a module authority can cancel the change
+	_, err = msgServer.CancelParamChange(ctx, &types.MsgCancelParamChange{
+		Authority: authtypes.NewModuleAddress("not_authority").String(),
+		ChainId:   hc.ChainId,
+		Key:       types.KeyDepositFee,
+	})
+	suite.Require().Error(err)
+
+	cancelCtx, _ := ctx.CacheContext()
+	_, err = msgServer.CancelParamChange(cancelCtx, &types.MsgCancelParamChange{
+		Authority: params.AdminAddress,
+		ChainId:   hc.ChainId,
+		Key:       types.KeyDepositFee,
+	})
+	suite.Require().NoError(err)
+	_, found = k.GetPendingParamChange(cancelCtx, hc.ChainId, types.KeyDepositFee)
+	suite.Require().Equal(false, found)
+
+	_, err = msgServer.CancelParamChange(cancelCtx, &types.MsgCancelParamChange{
+		Authority: params.AdminAddress,
+		ChainId:   hc.ChainId,
+		Key:       types.KeyDepositFee,
+	})
+	suite.Require().ErrorIs(err, types.ErrParamChangeNotFound)
+
+	// once the delay has passed the change is applied and leaves the queue
+	k.ApplyPendingParamChanges(ctx.WithBlockTime(change.EffectiveTime))
+	updated, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.05"), updated.Params.DepositFee)
+	_, found = k.GetPendingParamChange(ctx, hc.ChainId, types.KeyDepositFee)
+	suite.Require().Equal(false, found)
+}
+
+func (suite *IntegrationTestSuite) TestParamChangeDelayInvalidChange() {
+	ctx, _ := suite.ctx.CacheContext()
+	k := suite.app.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	params := k.GetParams(ctx)
+	params.ParamChangeDelay = time.Hour
+	k.SetParams(ctx, params)
+
+	// a lower c value limit above the current upper limit is only rejected when it is applied
+	k.StageParamChange(
+		ctx,
+		hc,
+		&types.KVUpdate{Key: types.KeyLowerCValueLimit, Value: hc.Params.UpperCValueLimit.String()},
+		params.ParamChangeDelay,
+	)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.ParamChangeDelay)).WithEventManager(sdk.NewEventManager())
+	k.ApplyPendingParamChanges(ctx)
+
+	updated, _ := k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(hc.Params.LowerCValueLimit, updated.Params.LowerCValueLimit)
+	suite.Require().Len(k.GetPendingParamChangesForHostChain(ctx, hc.ChainId), 0)
+
+	failed := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeParamChangeFailed {
+			failed = true
+		}
+	}
+	suite.Require().Equal(true, failed)
+}
+
+func (suite *IntegrationTestSuite) TestParamChangeDelayCaps() {
+	ctx, _ := suite.ctx.CacheContext()
+	k := suite.app.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	params := k.GetParams(ctx)
+	params.ParamChangeDelay = time.Hour
+	k.SetParams(ctx, params)
+
+	// the unbonding, redelegation and delegation share caps are staged like the fees
+	msgServer := keeper.NewMsgServerImpl(k)
+	updates := []*types.KVUpdate{
+		{Key: types.KeyMaxUnbondingAmount, Value: "1000"},
+		{Key: types.KeyMaxRedelegationAmount, Value: "1000"},
+		{Key: types.KeyMaxRedelegationRatio, Value: "0.1"},
+		{Key: types.KeyMaxDelegationShare, Value: "0.5"},
+	}
+	_, err := msgServer.UpdateHostChain(ctx, &types.MsgUpdateHostChain{
+		Authority: params.AdminAddress,
+		ChainId:   hc.ChainId,
+		Updates:   updates,
+	})
+	suite.Require().NoError(err)
+	for _, update := range updates {
+		_, found = k.GetPendingParamChange(ctx, hc.ChainId, update.Key)
+		suite.Require().Equal(true, found, update.Key)
+	}
+
+	// only governance can lift the delay
+	params.ParamChangeDelay = 0
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(sdk.MustAccAddressFromBech32(params.AdminAddress), params))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(authtypes.NewModuleAddress(govtypes.ModuleName), params))
+	suite.Require().NoError(err)
+	suite.Require().Zero(k.GetParams(ctx).ParamChangeDelay)
+}
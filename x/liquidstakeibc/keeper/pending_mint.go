@@ -0,0 +1,312 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// SetPendingMint stores the stk tokens owed to a delegator for a deposit that has not been delegated yet, keeping its
+// state index and the pending mint amount of its host chain in sync
+func (k *Keeper) SetPendingMint(ctx sdk.Context, pendingMint *types.PendingMint) {
+	if stored, found := k.GetPendingMint(
+		ctx,
+		pendingMint.ChainId,
+		pendingMint.DelegatorAddress,
+		pendingMint.Epoch,
+	); found {
+		k.unindexPendingMint(ctx, stored)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingMintKey)
+	bytes := k.cdc.MustMarshal(pendingMint)
+	store.Set(
+		types.GetPendingMintStoreKey(pendingMint.ChainId, pendingMint.DelegatorAddress, pendingMint.Epoch),
+		bytes,
+	)
+	k.indexPendingMint(ctx, pendingMint)
+}
+
+// GetPendingMint returns the stk tokens owed to a delegator for the deposits of an epoch
+func (k *Keeper) GetPendingMint(
+	ctx sdk.Context,
+	chainID string,
+	delegatorAddress string,
+	epoch int64,
+) (*types.PendingMint, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingMintKey)
+	bytes := store.Get(types.GetPendingMintStoreKey(chainID, delegatorAddress, epoch))
+	if len(bytes) == 0 {
+		return &types.PendingMint{}, false
+	}
+
+	var pendingMint types.PendingMint
+	k.cdc.MustUnmarshal(bytes, &pendingMint)
+	return &pendingMint, true
+}
+
+// FilterPendingMints returns the pending mints which satisfy the filter
+func (k *Keeper) FilterPendingMints(ctx sdk.Context, filter func(p types.PendingMint) bool) []*types.PendingMint {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingMintKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	pendingMints := make([]*types.PendingMint, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		pendingMint := types.PendingMint{}
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingMint)
+		if filter(pendingMint) {
+			pendingMints = append(pendingMints, &pendingMint)
+		}
+	}
+
+	return pendingMints
+}
+
+// GetPendingMintsByState returns the pending mints of a host chain in a state, ordered by epoch
+func (k *Keeper) GetPendingMintsByState(
+	ctx sdk.Context,
+	chainID string,
+	state types.PendingMint_PendingMintState,
+) []*types.PendingMint {
+	return k.getIndexedPendingMints(ctx, chainID, types.GetPendingMintStateIndexPrefix(chainID, state))
+}
+
+// GetPendingMintsByEpoch returns the pending mints of a host chain epoch in a state
+func (k *Keeper) GetPendingMintsByEpoch(
+	ctx sdk.Context,
+	chainID string,
+	state types.PendingMint_PendingMintState,
+	epoch int64,
+) []*types.PendingMint {
+	return k.getIndexedPendingMints(ctx, chainID, types.GetPendingMintEpochIndexPrefix(chainID, state, epoch))
+}
+
+func (k *Keeper) getIndexedPendingMints(ctx sdk.Context, chainID string, indexPrefix []byte) []*types.PendingMint {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingMintStateIndexKey)
+	iterator := sdk.KVStorePrefixIterator(indexStore, indexPrefix)
+	defer iterator.Close()
+
+	// the state byte and the big endian epoch follow the length prefixed chain id
+	epochStart := len(types.GetPendingMintStateIndexPrefix(chainID, 0))
+	pendingMints := make([]*types.PendingMint, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		epoch := int64(sdk.BigEndianToUint64(key[epochStart : epochStart+8]))
+		delegatorAddress := string(key[epochStart+8:])
+
+		pendingMint, found := k.GetPendingMint(ctx, chainID, delegatorAddress, epoch)
+		if found {
+			pendingMints = append(pendingMints, pendingMint)
+		}
+	}
+
+	return pendingMints
+}
+
+// DeletePendingMint removes a pending mint
+func (k *Keeper) DeletePendingMint(ctx sdk.Context, pendingMint *types.PendingMint) {
+	stored, found := k.GetPendingMint(ctx, pendingMint.ChainId, pendingMint.DelegatorAddress, pendingMint.Epoch)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingMintKey)
+	store.Delete(types.GetPendingMintStoreKey(pendingMint.ChainId, pendingMint.DelegatorAddress, pendingMint.Epoch))
+	k.unindexPendingMint(ctx, stored)
+}
+
+// indexPendingMint adds a stored pending mint to the state index and to the pending mint amount of its host chain
+func (k *Keeper) indexPendingMint(ctx sdk.Context, pendingMint *types.PendingMint) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingMintStateIndexKey)
+	indexStore.Set(
+		types.GetPendingMintStateIndexKey(
+			pendingMint.ChainId,
+			pendingMint.State,
+			pendingMint.Epoch,
+			pendingMint.DelegatorAddress,
+		),
+		[]byte{},
+	)
+
+	k.setPendingMintAmount(
+		ctx,
+		pendingMint.ChainId,
+		k.GetPendingMintAmount(ctx, pendingMint.ChainId).
+			Add(pendingMint.MintAmount.Amount).
+			Add(pendingMint.FeeAmount.Amount),
+	)
+}
+
+// unindexPendingMint removes a stored pending mint from the state index and from the pending mint amount of its host
+// chain
+func (k *Keeper) unindexPendingMint(ctx sdk.Context, pendingMint *types.PendingMint) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingMintStateIndexKey)
+	indexStore.Delete(
+		types.GetPendingMintStateIndexKey(
+			pendingMint.ChainId,
+			pendingMint.State,
+			pendingMint.Epoch,
+			pendingMint.DelegatorAddress,
+		),
+	)
+
+	k.setPendingMintAmount(
+		ctx,
+		pendingMint.ChainId,
+		k.GetPendingMintAmount(ctx, pendingMint.ChainId).
+			Sub(pendingMint.MintAmount.Amount).
+			Sub(pendingMint.FeeAmount.Amount),
+	)
+}
+
+func (k *Keeper) setPendingMintAmount(ctx sdk.Context, chainID string, amount math.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingMintAmountKey)
+	if amount.IsZero() {
+		store.Delete([]byte(chainID))
+		return
+	}
+
+	store.Set([]byte(chainID), k.cdc.MustMarshal(&sdk.IntProto{Int: amount}))
+}
+
+// AddPendingMint records the stk tokens owed to a delegator for a deposit, adding them to the ones already owed for
+// the same epoch
+func (k *Keeper) AddPendingMint(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	delegatorAddress string,
+	epoch int64,
+	depositAmount sdk.Coin,
+	mintAmount sdk.Coin,
+	feeAmount sdk.Coin,
+) *types.PendingMint {
+	pendingMint, found := k.GetPendingMint(ctx, hc.ChainId, delegatorAddress, epoch)
+	if !found {
+		pendingMint = &types.PendingMint{
+			ChainId:          hc.ChainId,
+			Epoch:            epoch,
+			DelegatorAddress: delegatorAddress,
+			DepositAmount:    sdk.NewCoin(depositAmount.Denom, sdk.ZeroInt()),
+			MintAmount:       sdk.NewCoin(mintAmount.Denom, sdk.ZeroInt()),
+			FeeAmount:        sdk.NewCoin(feeAmount.Denom, sdk.ZeroInt()),
+			State:            types.PendingMint_PENDING_MINT_AWAITING_DELEGATION,
+		}
+	}
+
+	pendingMint.DepositAmount = pendingMint.DepositAmount.Add(depositAmount)
+	pendingMint.MintAmount = pendingMint.MintAmount.Add(mintAmount)
+	pendingMint.FeeAmount = pendingMint.FeeAmount.Add(feeAmount)
+	k.SetPendingMint(ctx, pendingMint)
+
+	return pendingMint
+}
+
+// GetPendingMintAmount returns the stk amount of a host chain which is owed to delegators but not minted yet
+func (k *Keeper) GetPendingMintAmount(ctx sdk.Context, chainID string) math.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingMintAmountKey)
+	bz := store.Get([]byte(chainID))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var amount sdk.IntProto
+	k.cdc.MustUnmarshal(bz, &amount)
+	return amount.Int
+}
+
+// SetPendingMintsClaimable marks the pending mints of a deposit as claimable once its delegation is confirmed
+func (k *Keeper) SetPendingMintsClaimable(ctx sdk.Context, chainID string, epoch int64) {
+	pendingMints := k.GetPendingMintsByEpoch(ctx, chainID, types.PendingMint_PENDING_MINT_AWAITING_DELEGATION, epoch)
+
+	for _, pendingMint := range pendingMints {
+		pendingMint.State = types.PendingMint_PENDING_MINT_CLAIMABLE
+		k.SetPendingMint(ctx, pendingMint)
+	}
+}
+
+// DoClaimPendingMints mints the stk tokens of the claimable pending mints of a host chain and sends them to their
+// delegators, charging the deposit protocol fee
+func (k *Keeper) DoClaimPendingMints(ctx sdk.Context, hc *types.HostChain) {
+	claimablePendingMints := k.GetPendingMintsByState(ctx, hc.ChainId, types.PendingMint_PENDING_MINT_CLAIMABLE)
+
+	for _, pendingMint := range claimablePendingMints {
+		// a failed claim must not leave freshly minted tokens behind
+		cachedCtx, writeCache := ctx.CacheContext()
+		if err := k.claimPendingMint(cachedCtx, hc, pendingMint); err != nil {
+			k.OperationLogger(ctx, types.WorkflowDeposit, hc.ChainId, pendingMint.Epoch).Error(
+				"could not claim pending mint",
+				"delegator",
+				pendingMint.DelegatorAddress,
+				"err",
+				err.Error(),
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventFailedClaimPendingMint,
+					sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+					sdk.NewAttribute(types.AttributeEpoch, strconv.FormatInt(pendingMint.Epoch, 10)),
+					sdk.NewAttribute(types.AttributeClaimAddress, pendingMint.DelegatorAddress),
+					types.NewCorrelationAttribute(types.WorkflowDeposit, hc.ChainId, pendingMint.Epoch),
+				),
+			)
+
+			continue
+		}
+		writeCache()
+
+		k.OperationLogger(ctx, types.WorkflowDeposit, hc.ChainId, pendingMint.Epoch).Info(
+			"Claimed pending mint.",
+			"delegator",
+			pendingMint.DelegatorAddress,
+			"amount",
+			pendingMint.MintAmount.String(),
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeClaimedPendingMint,
+				sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+				sdk.NewAttribute(types.AttributeEpoch, strconv.FormatInt(pendingMint.Epoch, 10)),
+				sdk.NewAttribute(types.AttributeClaimAmount, pendingMint.MintAmount.String()),
+				sdk.NewAttribute(types.AttributeClaimAddress, pendingMint.DelegatorAddress),
+				sdk.NewAttribute(types.AttributePstakeDepositFee, pendingMint.FeeAmount.String()),
+				types.NewCorrelationAttribute(types.WorkflowDeposit, hc.ChainId, pendingMint.Epoch),
+			),
+		)
+	}
+}
+
+func (k *Keeper) claimPendingMint(ctx sdk.Context, hc *types.HostChain, pendingMint *types.PendingMint) error {
+	address, err := sdk.AccAddressFromBech32(pendingMint.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	mintToken := pendingMint.MintAmount.Add(pendingMint.FeeAmount)
+	if err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(mintToken)); err != nil {
+		return err
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, sdk.NewCoins(pendingMint.MintAmount))
+	if err != nil {
+		return err
+	}
+
+	if pendingMint.FeeAmount.IsPositive() {
+		err = k.SendHostChainProtocolFee(ctx, hc, sdk.NewCoins(pendingMint.FeeAmount), types.ModuleName)
+		if err != nil {
+			return err
+		}
+		k.AddToFeeReport(ctx, hc.ChainId, types.KeyDepositFee, pendingMint.FeeAmount)
+	}
+
+	k.DeletePendingMint(ctx, pendingMint)
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestDelayedMint() {
+	pstakeApp, ctx := suite.app, suite.ctx
+	k := pstakeApp.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	epoch := pstakeApp.EpochsKeeper.GetEpochInfo(ctx, types.DelegationEpoch)
+	suite.Require().NoError(k.BeforeEpochStart(ctx, epoch.Identifier, epoch.CurrentEpoch))
+
+	hc.Flags.DelayedMint = true
+	hc.Params.DepositFee = sdk.MustNewDecFromStr("0.1")
+	k.SetHostChain(ctx, hc)
+
+	delegator := suite.chainA.SenderAccount.GetAddress()
+	stkBalance := pstakeApp.BankKeeper.GetBalance(ctx, delegator, hc.MintDenom())
+	supply := pstakeApp.BankKeeper.GetSupply(ctx, hc.MintDenom())
+
+	msgServer := keeper.NewMsgServerImpl(k)
+	_, err := msgServer.LiquidStake(ctx, types.NewMsgLiquidStake(sdk.NewInt64Coin(hc.IBCDenom(), 1000), delegator))
+	suite.Require().NoError(err)
+
+	// nothing is minted until the deposit is delegated, but the c value accounts for the pending mint
+	suite.Require().Equal(stkBalance, pstakeApp.BankKeeper.GetBalance(ctx, delegator, hc.MintDenom()))
+	suite.Require().Equal(supply, pstakeApp.BankKeeper.GetSupply(ctx, hc.MintDenom()))
+	suite.Require().Equal(sdk.NewInt(1000), k.GetPendingMintAmount(ctx, hc.ChainId))
+
+	res, err := k.DelegatorPendingMints(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryDelegatorPendingMintsRequest{DelegatorAddress: delegator.String()},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.PendingMints, 1)
+	pendingMint := res.PendingMints[0]
+	suite.Require().Equal(types.PendingMint_PENDING_MINT_AWAITING_DELEGATION, pendingMint.State)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.MintDenom(), 900), pendingMint.MintAmount)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.MintDenom(), 100), pendingMint.FeeAmount)
+
+	// awaiting pending mints are not claimed
+	k.DoClaimPendingMints(ctx, hc)
+	_, found = k.GetPendingMint(ctx, hc.ChainId, delegator.String(), pendingMint.Epoch)
+	suite.Require().Equal(true, found)
+
+	k.SetPendingMintsClaimable(ctx, hc.ChainId, pendingMint.Epoch)
+	k.DoClaimPendingMints(ctx, hc)
+	suite.Require().Equal(
+		stkBalance.AddAmount(sdk.NewInt(900)),
+		pstakeApp.BankKeeper.GetBalance(ctx, delegator, hc.MintDenom()),
+	)
+	suite.Require().Equal(supply.AddAmount(sdk.NewInt(1000)), pstakeApp.BankKeeper.GetSupply(ctx, hc.MintDenom()))
+	_, found = k.GetPendingMint(ctx, hc.ChainId, delegator.String(), pendingMint.Epoch)
+	suite.Require().Equal(false, found)
+	suite.Require().Equal(sdk.ZeroInt(), k.GetPendingMintAmount(ctx, hc.ChainId))
+}
+
+func (suite *IntegrationTestSuite) TestPendingMintIndex() {
+	pstakeApp, ctx := suite.app, suite.ctx
+	k := pstakeApp.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	delegator := suite.chainA.SenderAccount.GetAddress().String()
+	fee := sdk.NewInt64Coin(hc.MintDenom(), 10)
+	k.AddPendingMint(ctx, hc, delegator, 2, sdk.NewInt64Coin(hc.IBCDenom(), 100), sdk.NewInt64Coin(hc.MintDenom(), 90), fee)
+	k.AddPendingMint(ctx, hc, delegator, 1, sdk.NewInt64Coin(hc.IBCDenom(), 100), sdk.NewInt64Coin(hc.MintDenom(), 90), fee)
+	k.AddPendingMint(ctx, hc, delegator, 1, sdk.NewInt64Coin(hc.IBCDenom(), 50), sdk.NewInt64Coin(hc.MintDenom(), 50), sdk.NewInt64Coin(hc.MintDenom(), 0))
+
+	// a host chain whose id starts with the id of another one doesn't share its pending mints
+	other := *hc
+	other.ChainId = hc.ChainId + "1"
+	k.AddPendingMint(ctx, &other, delegator, 1, sdk.NewInt64Coin(hc.IBCDenom(), 100), sdk.NewInt64Coin(hc.MintDenom(), 100), fee)
+
+	suite.Require().Equal(sdk.NewInt(250), k.GetPendingMintAmount(ctx, hc.ChainId))
+	suite.Require().Equal(sdk.NewInt(110), k.GetPendingMintAmount(ctx, other.ChainId))
+
+	awaiting := k.GetPendingMintsByState(ctx, hc.ChainId, types.PendingMint_PENDING_MINT_AWAITING_DELEGATION)
+	suite.Require().Len(awaiting, 2)
+	suite.Require().Equal(int64(1), awaiting[0].Epoch)
+	suite.Require().Equal(int64(2), awaiting[1].Epoch)
+	suite.Require().Empty(k.GetPendingMintsByState(ctx, hc.ChainId, types.PendingMint_PENDING_MINT_CLAIMABLE))
+
+	// the state change moves the pending mint to the claimable index and keeps the pending mint amount
+	k.SetPendingMintsClaimable(ctx, hc.ChainId, 1)
+	claimable := k.GetPendingMintsByState(ctx, hc.ChainId, types.PendingMint_PENDING_MINT_CLAIMABLE)
+	suite.Require().Len(claimable, 1)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.MintDenom(), 140), claimable[0].MintAmount)
+	suite.Require().Len(k.GetPendingMintsByEpoch(ctx, hc.ChainId, types.PendingMint_PENDING_MINT_AWAITING_DELEGATION, 2), 1)
+	suite.Require().Empty(k.GetPendingMintsByEpoch(ctx, hc.ChainId, types.PendingMint_PENDING_MINT_AWAITING_DELEGATION, 1))
+	suite.Require().Len(k.GetPendingMintsByEpoch(ctx, other.ChainId, types.PendingMint_PENDING_MINT_AWAITING_DELEGATION, 1), 1)
+	suite.Require().Equal(sdk.NewInt(250), k.GetPendingMintAmount(ctx, hc.ChainId))
+
+	k.DeletePendingMint(ctx, claimable[0])
+	suite.Require().Empty(k.GetPendingMintsByState(ctx, hc.ChainId, types.PendingMint_PENDING_MINT_CLAIMABLE))
+	suite.Require().Equal(sdk.NewInt(100), k.GetPendingMintAmount(ctx, hc.ChainId))
+}
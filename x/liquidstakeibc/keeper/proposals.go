@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// moduleMsgPrefix is the type url prefix of the liquidstakeibc messages
+const moduleMsgPrefix = "/pstake.liquidstakeibc."
+
+// GetPendingProposals returns the proposals in deposit or voting period which execute liquidstakeibc messages. If a
+// chain id is given only the proposals with a message affecting that host chain are returned.
+func (k *Keeper) GetPendingProposals(ctx sdk.Context, chainID string) []*types.ModuleProposal {
+	proposals := make([]*types.ModuleProposal, 0)
+	collect := func(proposal govv1.Proposal) bool {
+		if moduleProposal, found := k.getModuleProposal(ctx, proposal, chainID); found {
+			proposals = append(proposals, moduleProposal)
+		}
+
+		return false
+	}
+
+	// the queues are keyed by the sortable end time, which can't go past the year 9999
+	endTime := time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)
+	k.govKeeper.IterateInactiveProposalsQueue(ctx, endTime, collect)
+	k.govKeeper.IterateActiveProposalsQueue(ctx, endTime, collect)
+
+	return proposals
+}
+
+// getModuleProposal summarizes the liquidstakeibc messages of a proposal, it returns false if the proposal has none
+// or if none of them affects the host chain filtered by
+func (k *Keeper) getModuleProposal(
+	ctx sdk.Context,
+	proposal govv1.Proposal,
+	chainID string,
+) (*types.ModuleProposal, bool) {
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		k.Logger(ctx).Error("could not unpack proposal messages", "proposal_id", proposal.Id, "err", err.Error())
+		return nil, false
+	}
+
+	messages := make([]*types.ProposalMessage, 0)
+	affectsChain := chainID == ""
+	for _, msg := range msgs {
+		typeURL := sdk.MsgTypeURL(msg)
+		if !strings.HasPrefix(typeURL, moduleMsgPrefix) {
+			continue
+		}
+
+		message := &types.ProposalMessage{TypeUrl: typeURL}
+		switch m := msg.(type) {
+		case *types.MsgRegisterHostChain:
+			// the chain id of a host chain being registered is only known through its connection
+			if msgChainID, err := k.GetChainID(ctx, m.ConnectionId); err == nil {
+				message.ChainId = msgChainID
+			}
+			message.Description = m.ProposalDescription()
+		case *types.MsgUpdateHostChain:
+			message.ChainId = m.ChainId
+			message.Description = m.ProposalDescription()
+		case *types.MsgSetCValue:
+			message.ChainId = m.ChainId
+			message.Description = m.ProposalDescription()
+		}
+
+		affectsChain = affectsChain || message.ChainId == chainID
+		messages = append(messages, message)
+	}
+
+	if len(messages) == 0 || !affectsChain {
+		return nil, false
+	}
+
+	return &types.ModuleProposal{
+		ProposalId:     proposal.Id,
+		Status:         proposal.Status,
+		Title:          proposal.Title,
+		Summary:        proposal.Summary,
+		Metadata:       proposal.Metadata,
+		DepositEndTime: proposal.DepositEndTime,
+		VotingEndTime:  proposal.VotingEndTime,
+		Messages:       messages,
+	}, true
+}
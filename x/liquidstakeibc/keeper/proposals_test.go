@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestPendingProposals() {
+	pstakeApp, ctx := suite.app, suite.ctx
+	k := pstakeApp.LiquidStakeIBCKeeper
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName)
+	proposer := suite.chainA.SenderAccount.GetAddress()
+
+	updateMsg := types.NewMsgUpdateHostChain(
+		suite.chainB.ChainID,
+		gov.String(),
+		[]*types.KVUpdate{{Key: types.KeyMinimumDeposit, Value: "100"}},
+	)
+	updateProposal, err := pstakeApp.GovKeeper.SubmitProposal(
+		ctx, []sdk.Msg{updateMsg}, "", "Update minimum deposit", "Raises the minimum deposit", proposer,
+	)
+	suite.Require().NoError(err)
+
+	// proposals without liquidstakeibc messages are not listed
+	sendMsg := banktypes.NewMsgSend(gov, proposer, sdk.NewCoins(sdk.NewInt64Coin("uxprt", 1)))
+	_, err = pstakeApp.GovKeeper.SubmitProposal(ctx, []sdk.Msg{sendMsg}, "", "Spend", "Community spend", proposer)
+	suite.Require().NoError(err)
+
+	cValueMsg := types.NewMsgSetCValue(gov, suite.chainC.ChainID, sdk.OneDec(), "reset", 0)
+	cValueProposal, err := pstakeApp.GovKeeper.SubmitProposal(
+		ctx, []sdk.Msg{cValueMsg, sendMsg}, "", "Reset c value", "Resets the c value", proposer,
+	)
+	suite.Require().NoError(err)
+	pstakeApp.GovKeeper.ActivateVotingPeriod(ctx, cValueProposal)
+
+	res, err := k.PendingProposals(sdk.WrapSDKContext(ctx), &types.QueryPendingProposalsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Proposals, 2)
+
+	res, err = k.PendingProposals(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryPendingProposalsRequest{ChainId: suite.chainB.ChainID},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Proposals, 1)
+	proposal := res.Proposals[0]
+	suite.Require().Equal(updateProposal.Id, proposal.ProposalId)
+	suite.Require().Equal(govv1.StatusDepositPeriod, proposal.Status)
+	suite.Require().Equal("Update minimum deposit", proposal.Title)
+	suite.Require().Len(proposal.Messages, 1)
+	suite.Require().Equal(sdk.MsgTypeURL(updateMsg), proposal.Messages[0].TypeUrl)
+	suite.Require().Equal(suite.chainB.ChainID, proposal.Messages[0].ChainId)
+	suite.Require().Equal(updateMsg.ProposalDescription(), proposal.Messages[0].Description)
+
+	// only the module messages of a proposal are summarized
+	res, err = k.PendingProposals(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryPendingProposalsRequest{ChainId: suite.chainC.ChainID},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Proposals, 1)
+	suite.Require().Equal(govv1.StatusVotingPeriod, res.Proposals[0].Status)
+	suite.Require().Len(res.Proposals[0].Messages, 1)
+
+	_, err = k.PendingProposals(sdk.WrapSDKContext(ctx), nil)
+	suite.Require().Error(err)
+}
@@ -27,10 +27,18 @@ func (k Keeper) GenerateRedelegateMsgs(ctx sdk.Context, hc types.HostChain) []pr
 		sum = sum.Add(validator.DelegatedAmount)
 	}
 
+	// during a weight transition, the delegations only move their share of the way to the weights in this epoch
+	steps := k.weightTransitionSteps(ctx, &hc)
+
+	// the ideal delegations follow the weights boosted by the commission rebate program, the redelegations would
+	// otherwise move the boosted delegations back, and capped to the max delegation share
 	idealDelegationList := make([]delegation, len(hc.Validators))
 	sum2 := math.ZeroInt()
-	for i, validator := range hc.Validators {
+	for i, validator := range hc.CapDelegationShares(hc.RebateBoostedValidators(hc.Validators)) {
 		idealAmt := validator.Weight.MulInt(sum).TruncateInt()
+		if steps > 1 {
+			idealAmt = validator.DelegatedAmount.Add(idealAmt.Sub(validator.DelegatedAmount).QuoRaw(steps))
+		}
 		// last element
 		if i == len(hc.Validators)-1 {
 			idealAmt = sum.Sub(sum2)
@@ -58,12 +66,19 @@ func (k Keeper) GenerateRedelegateMsgs(ctx sdk.Context, hc types.HostChain) []pr
 		}
 	}
 
+	// the delta left above the redelegation cap is redelegated in the next epochs
+	remainingCap, capped := hc.RedelegationCap()
+
 	var msgs []proto.Message
 L1:
 	for i := range revIdealList {
 		if revIdealList[i].diff.LT(AcceptableDelta) {
 			break L1
 		}
+		// the drain workflow moves the delegations of the zero weight validators at its own pace
+		if hc.IsDrainingZeroWeightValidators() && revIdealList[i].validatorDetails.Weight.IsZero() {
+			continue
+		}
 		// RedelegationExistsToValidator: This is not updated inside the loop (with newer msgs), so some ICA redelegate txns might fail, and it is ok.
 		if !k.RedelegationExistsToValidator(redelegations.Redelegations, revIdealList[i].validator) {
 			// re-sort idealDelegationAsc
@@ -84,6 +99,13 @@ L1:
 				_, numEntries := k.RedelegationFromAToB(redelegations.Redelegations, revIdealList[i].validator, idealDelegationList[j].validator)
 				if numEntries < MaxRedelegationEntries {
 					redelegationAmt := math.MinInt(revIdealList[i].diff.Abs(), idealDelegationList[j].diff.Abs())
+					if capped {
+						if !remainingCap.IsPositive() {
+							break L1
+						}
+						redelegationAmt = math.MinInt(redelegationAmt, remainingCap)
+						remainingCap = remainingCap.Sub(redelegationAmt)
+					}
 					redelegateMsg := &stakingtypes.MsgBeginRedelegate{
 						DelegatorAddress:    hc.DelegationAccount.Address,
 						ValidatorSrcAddress: revIdealList[i].validator,
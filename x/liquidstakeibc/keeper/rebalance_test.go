@@ -105,3 +105,55 @@ func (suite *IntegrationTestSuite) TestKeeper_Rebalance() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestRebalanceRedelegationCap() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	hc.Params.MaxEntries = 7
+	hc.Params.RedelegationAcceptableDelta = sdk.ZeroInt()
+	hc.Validators = []*types.Validator{{
+		OperatorAddress: "valA",
+		Status:          stakingtypes.Bonded.String(),
+		Weight:          sdk.MustNewDecFromStr("0.2"),
+		DelegatedAmount: sdk.NewInt(1000000),
+		ExchangeRate:    sdk.OneDec(),
+		Delegable:       true,
+	}, {
+		OperatorAddress: "valB",
+		Status:          stakingtypes.Bonded.String(),
+		Weight:          sdk.MustNewDecFromStr("0.4"),
+		DelegatedAmount: sdk.ZeroInt(),
+		ExchangeRate:    sdk.OneDec(),
+		Delegable:       true,
+	}, {
+		OperatorAddress: "valC",
+		Status:          stakingtypes.Bonded.String(),
+		Weight:          sdk.MustNewDecFromStr("0.4"),
+		DelegatedAmount: sdk.ZeroInt(),
+		ExchangeRate:    sdk.OneDec(),
+		Delegable:       true,
+	}}
+	redelegated := func() math.Int {
+		total := math.ZeroInt()
+		for _, msg := range k.GenerateRedelegateMsgs(ctx, *hc) {
+			total = total.Add(msg.(*stakingtypes.MsgBeginRedelegate).Amount.Amount)
+		}
+		return total
+	}
+
+	suite.Require().Equal(sdk.NewInt(800000), redelegated())
+
+	// the absolute cap splits the redelegations until it is spent
+	hc.Params.MaxRedelegationAmount = sdk.NewInt(500000)
+	suite.Require().Equal(sdk.NewInt(500000), redelegated())
+
+	// the lowest of the caps applies
+	hc.Params.MaxRedelegationRatio = sdk.MustNewDecFromStr("0.1")
+	suite.Require().Equal(sdk.NewInt(100000), redelegated())
+	hc.Params.MaxRedelegationAmount = sdk.ZeroInt()
+	hc.Params.MaxRedelegationRatio = sdk.MustNewDecFromStr("0.6")
+	suite.Require().Equal(sdk.NewInt(600000), redelegated())
+}
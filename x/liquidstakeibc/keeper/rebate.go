@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// SetRebateRecord stores the commission rebate program accounting of a host chain validator
+func (k *Keeper) SetRebateRecord(ctx sdk.Context, record *types.RebateRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RebateRecordKey)
+	bytes := k.cdc.MustMarshal(record)
+	store.Set(types.GetRebateRecordStoreKey(record.ChainId, record.ValidatorAddress), bytes)
+}
+
+// GetRebateRecord returns the commission rebate program accounting of a host chain validator
+func (k *Keeper) GetRebateRecord(ctx sdk.Context, chainID, validatorAddress string) (*types.RebateRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RebateRecordKey)
+	bytes := store.Get(types.GetRebateRecordStoreKey(chainID, validatorAddress))
+	if len(bytes) == 0 {
+		return &types.RebateRecord{
+			ChainId:          chainID,
+			ValidatorAddress: validatorAddress,
+			DelegatedAmount:  sdk.ZeroInt(),
+		}, false
+	}
+
+	var record types.RebateRecord
+	k.cdc.MustUnmarshal(bytes, &record)
+	return &record, true
+}
+
+// AddRebateDelegation adds a delegation to the rebate accounting of the validator if it qualifies for the commission
+// rebate program of the host chain
+func (k *Keeper) AddRebateDelegation(ctx sdk.Context, hc *types.HostChain, validator *types.Validator, amount math.Int) {
+	if !hc.QualifiesForRebate(validator) || !amount.IsPositive() {
+		return
+	}
+
+	record, _ := k.GetRebateRecord(ctx, hc.ChainId, validator.OperatorAddress)
+	record.DelegatedAmount = record.DelegatedAmount.Add(amount)
+	k.SetRebateRecord(ctx, record)
+}
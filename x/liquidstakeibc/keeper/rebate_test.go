@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestRebateProgram() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+	msgServer := keeper.NewMsgServerImpl(k)
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	suite.Require().GreaterOrEqual(len(hc.Validators), 2)
+
+	// the program is governed through the host chain params
+	_, err := msgServer.UpdateHostChain(ctx, types.NewMsgUpdateHostChain(hc.ChainId, gov.String(), []*types.KVUpdate{
+		{Key: types.KeyRebateMaxCommission, Value: "0.05"},
+		{Key: types.KeyRebateWeightBoost, Value: "1"},
+	}))
+	suite.Require().NoError(err)
+
+	// the validators report their commission through the validator ICQ, only the first one rebates enough
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	for i, validator := range hc.Validators {
+		commission := sdk.MustNewDecFromStr("0.1")
+		if i == 0 {
+			commission = sdk.MustNewDecFromStr("0.03")
+		}
+		suite.Require().NoError(k.ProcessHostChainValidatorUpdates(ctx, hc, stakingtypes.Validator{
+			OperatorAddress:     validator.OperatorAddress,
+			Status:              stakingtypes.Bonded,
+			Tokens:              sdk.ZeroInt(),
+			DelegatorShares:     sdk.ZeroDec(),
+			LiquidShares:        sdk.ZeroDec(),
+			ValidatorBondShares: sdk.ZeroDec(),
+			Commission:          stakingtypes.NewCommission(commission, sdk.OneDec(), sdk.ZeroDec()),
+		}))
+	}
+
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().True(hc.QualifiesForRebate(hc.Validators[0]))
+	suite.Require().False(hc.QualifiesForRebate(hc.Validators[1]))
+	suite.Require().Equal(ctx.BlockHeight(), hc.Validators[0].CommissionUpdateHeight)
+
+	commissionEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeValidatorCommissionUpdate {
+			commissionEvents++
+		}
+	}
+	suite.Require().Equal(len(hc.Validators), commissionEvents)
+
+	// only the delegations to the qualifying validator are accounted for the program
+	amount := sdk.NewInt(100)
+	hc.DelegationAccount.Balance = sdk.NewCoin(hc.HostDenom, amount.MulRaw(2))
+	k.SetHostChain(ctx, hc)
+	for _, validator := range hc.Validators[:2] {
+		suite.Require().NoError(k.HandleDelegateResponse(ctx, &stakingtypes.MsgDelegate{
+			DelegatorAddress: hc.DelegationAccount.Address,
+			ValidatorAddress: validator.OperatorAddress,
+			Amount:           sdk.NewCoin(hc.HostDenom, amount),
+		}, "", "", 0))
+	}
+
+	res, err := k.RebateProgram(sdk.WrapSDKContext(ctx), &types.QueryRebateProgramRequest{ChainId: hc.ChainId})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Active)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.05"), res.MaxCommission)
+	suite.Require().Equal(sdk.OneDec(), res.WeightBoost)
+
+	qualifying, other := res.Validators[0], res.Validators[1]
+	suite.Require().True(qualifying.Qualifies)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.03"), qualifying.CommissionRate)
+	suite.Require().Equal(amount, qualifying.DelegatedAmount)
+	suite.Require().False(other.Qualifies)
+	suite.Require().True(other.DelegatedAmount.IsZero())
+
+	// the boost moves weight towards the qualifying validator without changing the stored weights
+	if qualifying.Weight.IsPositive() {
+		suite.Require().True(qualifying.BoostedWeight.GT(qualifying.Weight))
+	}
+	suite.Require().True(other.BoostedWeight.LTE(other.Weight))
+	stored, _ := k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(qualifying.Weight, stored.Validators[0].Weight)
+
+	_, err = k.RebateProgram(sdk.WrapSDKContext(ctx), &types.QueryRebateProgramRequest{ChainId: "unknown"})
+	suite.Require().Error(err)
+}
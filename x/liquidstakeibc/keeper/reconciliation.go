@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (k *Keeper) SetDelegationReconciliation(ctx sdk.Context, reconciliation *types.DelegationReconciliation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReconciliationKey)
+	bz := k.cdc.MustMarshal(reconciliation)
+	store.Set(types.GetDelegationReconciliationStoreKey(reconciliation.ChainId, reconciliation.ValidatorAddress), bz)
+}
+
+func (k *Keeper) GetDelegationReconciliation(
+	ctx sdk.Context,
+	chainID string,
+	validatorAddress string,
+) (*types.DelegationReconciliation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReconciliationKey)
+	bz := store.Get(types.GetDelegationReconciliationStoreKey(chainID, validatorAddress))
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	var reconciliation types.DelegationReconciliation
+	k.cdc.MustUnmarshal(bz, &reconciliation)
+	return &reconciliation, true
+}
+
+func (k *Keeper) DeleteDelegationReconciliation(ctx sdk.Context, chainID string, validatorAddress string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReconciliationKey)
+	store.Delete(types.GetDelegationReconciliationStoreKey(chainID, validatorAddress))
+}
+
+// ReconcileDelegationsWorkflow queries the delegation of the delegation account to every validator of the active
+// host chains, remembering the delegation tracked for each of them when the query is sent
+func (k *Keeper) ReconcileDelegationsWorkflow(ctx sdk.Context, epoch int64) {
+	k.Logger(ctx).Info("Running delegation reconciliation workflow.", "epoch", epoch)
+	k.ClearWorkflowFailures(ctx, types.WorkflowReconciliation)
+
+	for _, hc := range k.GetAllHostChains(ctx) {
+		if !hc.IsActive() || hc.DelegationAccount == nil || hc.DelegationAccount.Address == "" {
+			continue
+		}
+
+		k.RunHostChainWorkflow(ctx, types.WorkflowReconciliation, hc.ChainId, epoch, func(ctx sdk.Context) error {
+			return k.ReconcileHostChainDelegations(ctx, hc, epoch)
+		})
+	}
+}
+
+// ReconcileHostChainDelegations queries the delegation of the delegation account to every validator of a host chain
+func (k *Keeper) ReconcileHostChainDelegations(ctx sdk.Context, hc *types.HostChain, epoch int64) error {
+	for _, validator := range hc.Validators {
+		if err := k.queryValidatorDelegation(ctx, hc, validator, ReconcileDelegation); err != nil {
+			return err
+		}
+
+		k.SetDelegationReconciliation(ctx, &types.DelegationReconciliation{
+			ChainId:          hc.ChainId,
+			ValidatorAddress: validator.OperatorAddress,
+			TrackedAmount:    validator.DelegatedAmount,
+			Epoch:            epoch,
+		})
+	}
+
+	return nil
+}
+
+// HasReconciliationPending checks if a delegation of the host chain queried during the epoch wasn't reconciled yet
+func (k *Keeper) HasReconciliationPending(ctx sdk.Context, hc *types.HostChain, epoch int64) bool {
+	for _, validator := range hc.Validators {
+		reconciliation, found := k.GetDelegationReconciliation(ctx, hc.ChainId, validator.OperatorAddress)
+		if found && reconciliation.Epoch == epoch {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasDelegationChangesInFlight checks if an undelegation or redelegation from the validator, or any delegation of the
+// host chain, was sent and not acknowledged yet, which makes the delegation reported by the host chain differ from the
+// tracked one
+func (k *Keeper) HasDelegationChangesInFlight(ctx sdk.Context, hc *types.HostChain, validatorAddress string) bool {
+	if k.HasDelegationDecreaseInFlight(ctx, hc.ChainId, validatorAddress) {
+		return true
+	}
+
+	for _, deposit := range k.GetDepositsForHostChain(ctx, hc.ChainId) {
+		if deposit.State == types.Deposit_DEPOSIT_DELEGATING {
+			return true
+		}
+	}
+
+	untokenizing := k.FilterLSMDeposits(ctx, func(d types.LSMDeposit) bool {
+		return d.ChainId == hc.ChainId && d.State == types.LSMDeposit_DEPOSIT_UNTOKENIZING
+	})
+
+	return len(untokenizing) > 0
+}
+
+// ReconcileDelegation corrects the delegation tracked for a validator to the one proven by its host chain. The
+// correction is skipped if the tracked delegation changed since the query was sent or a change is in flight, as the
+// reported delegation could then be outdated.
+func (k *Keeper) ReconcileDelegation(ctx sdk.Context, hc *types.HostChain, validator *types.Validator, shares sdk.Dec) {
+	reconciliation, found := k.GetDelegationReconciliation(ctx, hc.ChainId, validator.OperatorAddress)
+	if !found {
+		return
+	}
+	k.DeleteDelegationReconciliation(ctx, hc.ChainId, validator.OperatorAddress)
+
+	if !reconciliation.TrackedAmount.Equal(validator.DelegatedAmount) || k.HasDelegationChangesInFlight(ctx, hc, validator.OperatorAddress) {
+		k.Logger(ctx).Info(
+			"Skipping delegation reconciliation, the delegation changed since it was queried.",
+			"host_chain",
+			hc.ChainId,
+			"validator",
+			validator.OperatorAddress,
+		)
+		return
+	}
+
+	if validator.ExchangeRate.IsNil() || !validator.ExchangeRate.IsPositive() {
+		return
+	}
+
+	existingDelegatedAmount := validator.DelegatedAmount
+	delegatedAmount := validator.ExchangeRate.Mul(shares).TruncateInt()
+	drift := delegatedAmount.Sub(existingDelegatedAmount)
+	if drift.IsZero() {
+		return
+	}
+
+	validator.DelegatedAmount = delegatedAmount
+	if err := k.SetHostChainValidator(ctx, hc, validator); err != nil {
+		k.Logger(ctx).Error(
+			"Could not store the reconciled validator delegation.",
+			"host_chain",
+			hc.ChainId,
+			"validator",
+			validator.OperatorAddress,
+			"err",
+			err.Error(),
+		)
+		return
+	}
+	k.UpdateCValue(ctx, hc)
+
+	k.Logger(ctx).Info(
+		"Reconciled validator delegation.",
+		"host_chain",
+		hc.ChainId,
+		"validator",
+		validator.OperatorAddress,
+		"tracked",
+		existingDelegatedAmount,
+		"reported",
+		delegatedAmount,
+	)
+
+	// drifts within the tolerance are rounding and are corrected silently
+	tolerance := k.GetParams(ctx).DelegationDriftTolerance
+	if tolerance.IsNil() {
+		tolerance = sdk.ZeroDec()
+	}
+	if sdk.NewDecFromInt(drift.Abs()).LTE(tolerance.MulInt(existingDelegatedAmount)) {
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDelegationDrift,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeValidatorAddress, validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeExistingDelegation, existingDelegatedAmount.String()),
+			sdk.NewAttribute(types.AttributeUpdatedDelegation, delegatedAmount.String()),
+			sdk.NewAttribute(types.AttributeDelegationDrift, drift.String()),
+		),
+	)
+}
+
+// reconciledValidator returns the validator of the host chain whose delegation key was queried
+func reconciledValidator(hc *types.HostChain, request []byte) (*types.Validator, bool) {
+	_, delegatorAddr, err := bech32.DecodeAndConvert(hc.DelegationAccount.Address)
+	if err != nil {
+		return nil, false
+	}
+
+	for _, validator := range hc.Validators {
+		_, validatorAddr, err := bech32.DecodeAndConvert(validator.OperatorAddress)
+		if err != nil {
+			continue
+		}
+
+		if bytes.Equal(stakingtypes.GetDelegationKey(delegatorAddr, validatorAddr), request) {
+			return validator, true
+		}
+	}
+
+	return nil, false
+}
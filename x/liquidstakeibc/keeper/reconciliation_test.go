@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icqtypes "github.com/persistenceOne/persistence-sdk/v2/x/interchainquery/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestReconcileDelegations() {
+	pstakeApp := suite.app
+	k := pstakeApp.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+
+	params := k.GetParams(ctx)
+	params.DelegationDriftTolerance = sdk.MustNewDecFromStr("0.01")
+	k.SetParams(ctx, params)
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	suite.Require().GreaterOrEqual(len(hc.Validators), 2)
+	for i, amount := range []int64{1000, 500} {
+		hc.Validators[i].DelegatedAmount = sdk.NewInt(amount)
+		hc.Validators[i].ExchangeRate = sdk.OneDec()
+	}
+	k.SetHostChain(ctx, hc)
+
+	// the workflow queries the delegation to every validator of the host chain
+	queryFor := func(validator *types.Validator) icqtypes.Query {
+		_, delegatorAddr, err := bech32.DecodeAndConvert(hc.DelegationAccount.Address)
+		suite.Require().NoError(err)
+		_, validatorAddr, err := bech32.DecodeAndConvert(validator.OperatorAddress)
+		suite.Require().NoError(err)
+
+		for _, q := range pstakeApp.InterchainQueryKeeper.AllQueries(ctx) {
+			if q.ChainId == hc.ChainId && q.CallbackId == keeper.ReconcileDelegation &&
+				bytes.Equal(q.Request, stakingtypes.GetDelegationKey(delegatorAddr, validatorAddr)) {
+				return q
+			}
+		}
+		suite.FailNow("reconciliation query not found")
+		return icqtypes.Query{}
+	}
+	delegationData := func(validator *types.Validator, shares int64) []byte {
+		return stakingtypes.MustMarshalDelegation(pstakeApp.AppCodec(), stakingtypes.Delegation{
+			DelegatorAddress: hc.DelegationAccount.Address,
+			ValidatorAddress: validator.OperatorAddress,
+			Shares:           sdk.NewDec(shares),
+		})
+	}
+	drifts := func() int {
+		count := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeDelegationDrift {
+				count++
+			}
+		}
+		return count
+	}
+	delegatedAmount := func(i int) sdk.Int {
+		hc, _ := k.GetHostChain(ctx, hc.ChainId)
+		return hc.Validators[i].DelegatedAmount
+	}
+
+	k.ReconcileDelegationsWorkflow(ctx, 1)
+	for _, validator := range hc.Validators {
+		_, found := k.GetDelegationReconciliation(ctx, hc.ChainId, validator.OperatorAddress)
+		suite.Require().True(found)
+	}
+
+	// drifts within the tolerance are corrected without an event
+	query := queryFor(hc.Validators[0])
+	suite.Require().NoError(keeper.ReconcileDelegationCallback(k, ctx, delegationData(hc.Validators[0], 995), query))
+	suite.Require().Equal(sdk.NewInt(995), delegatedAmount(0))
+	suite.Require().Equal(0, drifts())
+
+	suite.Require().NoError(keeper.ReconcileDelegationCallback(
+		k, ctx, delegationData(hc.Validators[1], 400), queryFor(hc.Validators[1]),
+	))
+	suite.Require().Equal(sdk.NewInt(400), delegatedAmount(1))
+	suite.Require().Equal(1, drifts())
+
+	// a response is only applied once
+	suite.Require().NoError(keeper.ReconcileDelegationCallback(k, ctx, delegationData(hc.Validators[0], 900), query))
+	suite.Require().Equal(sdk.NewInt(995), delegatedAmount(0))
+
+	// a delegation tracked differently than when it was queried is left alone
+	k.ReconcileDelegationsWorkflow(ctx, 2)
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	hc.Validators[0].DelegatedAmount = sdk.NewInt(1100)
+	k.SetHostChain(ctx, hc)
+	suite.Require().NoError(keeper.ReconcileDelegationCallback(k, ctx, delegationData(hc.Validators[0], 995), query))
+	suite.Require().Equal(sdk.NewInt(1100), delegatedAmount(0))
+
+	// so is a delegation while changes are in flight
+	k.ReconcileDelegationsWorkflow(ctx, 3)
+	deposit := &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  sdk.NewInt64Coin(hc.IBCDenom(), 100),
+		Epoch:   3,
+		State:   types.Deposit_DEPOSIT_DELEGATING,
+	}
+	k.SetDeposit(ctx, deposit)
+	suite.Require().True(k.HasDelegationChangesInFlight(ctx, hc, hc.Validators[0].OperatorAddress))
+	suite.Require().NoError(keeper.ReconcileDelegationCallback(k, ctx, delegationData(hc.Validators[0], 1000), query))
+	suite.Require().Equal(sdk.NewInt(1100), delegatedAmount(0))
+
+	// a delegation missing from the host chain is fully undelegated
+	k.DeleteDeposit(ctx, deposit)
+	k.ReconcileDelegationsWorkflow(ctx, 4)
+	suite.Require().NoError(keeper.ReconcileDelegationCallback(k, ctx, nil, query))
+	suite.Require().True(delegatedAmount(0).IsZero())
+	suite.Require().Equal(2, drifts())
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// SetRelayLatency stores the observed relay latency of an ibc connection
+func (k *Keeper) SetRelayLatency(ctx sdk.Context, latency *types.RelayLatency) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RelayLatencyKey)
+	bytes := k.cdc.MustMarshal(latency)
+	store.Set([]byte(latency.ConnectionId), bytes)
+}
+
+// GetRelayLatency returns the observed relay latency of an ibc connection
+func (k *Keeper) GetRelayLatency(ctx sdk.Context, connectionID string) (*types.RelayLatency, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RelayLatencyKey)
+	bytes := store.Get([]byte(connectionID))
+	if len(bytes) == 0 {
+		return &types.RelayLatency{ConnectionId: connectionID}, false
+	}
+
+	var latency types.RelayLatency
+	k.cdc.MustUnmarshal(bytes, &latency)
+	return &latency, true
+}
+
+// SetPacketSendTime stores the time a packet sent by the module left the chain
+func (k *Keeper) SetPacketSendTime(ctx sdk.Context, sequenceID string, sendTime time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PacketSendTimeKey)
+	store.Set([]byte(sequenceID), sdk.FormatTimeBytes(sendTime))
+}
+
+// GetPacketSendTime returns the time a packet sent by the module left the chain
+func (k *Keeper) GetPacketSendTime(ctx sdk.Context, sequenceID string) (time.Time, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PacketSendTimeKey)
+	bytes := store.Get([]byte(sequenceID))
+	if len(bytes) == 0 {
+		return time.Time{}, false
+	}
+
+	sendTime, err := sdk.ParseTimeBytes(bytes)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return sendTime, true
+}
+
+// DeletePacketSendTime removes the send time of a packet
+func (k *Keeper) DeletePacketSendTime(ctx sdk.Context, sequenceID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PacketSendTimeKey)
+	store.Delete([]byte(sequenceID))
+}
+
+// RecordPacketRelayed updates the relay latency of the connection of a packet sent by the module once it is
+// acknowledged or timed out. Timed out packets count with the time they took to time out, so the timeouts of a
+// congested connection grow.
+func (k *Keeper) RecordPacketRelayed(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	sequenceID := k.GetTransactionSequenceID(portID, channelID, sequence)
+	sendTime, found := k.GetPacketSendTime(ctx, sequenceID)
+	if !found {
+		return
+	}
+	k.DeletePacketSendTime(ctx, sequenceID)
+
+	channel, found := k.ibcKeeper.ChannelKeeper.GetChannel(ctx, portID, channelID)
+	if !found || len(channel.ConnectionHops) == 0 {
+		return
+	}
+
+	sample := ctx.BlockTime().Sub(sendTime)
+	if sample < 0 {
+		sample = 0
+	}
+
+	latency, _ := k.GetRelayLatency(ctx, channel.ConnectionHops[0])
+	if latency.Samples == 0 {
+		latency.Average = sample
+	} else {
+		latency.Average += (sample - latency.Average) / types.RelayLatencyWindow
+	}
+	latency.Samples++
+	k.SetRelayLatency(ctx, latency)
+}
+
+// GetIBCTimeout returns the timeout of the packets sent over a connection. It is derived from the observed relay
+// latency of the connection and bounded by the module params.
+func (k *Keeper) GetIBCTimeout(ctx sdk.Context, connectionID string) time.Duration {
+	params := k.GetParams(ctx)
+	if params.MaxIbcTimeout == 0 {
+		return types.IBCTimeoutTimestamp
+	}
+
+	latency, found := k.GetRelayLatency(ctx, connectionID)
+	if !found || latency.Samples == 0 {
+		return params.MaxIbcTimeout
+	}
+
+	timeout := latency.Average * types.IBCTimeoutLatencyMultiplier
+	if timeout < params.MinIbcTimeout {
+		return params.MinIbcTimeout
+	}
+	if timeout > params.MaxIbcTimeout {
+		return params.MaxIbcTimeout
+	}
+
+	return timeout
+}
@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestRelayLatency() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx := suite.ctx
+	connectionID := suite.transferPathAB.EndpointA.ConnectionID
+	channelID := suite.transferPathAB.EndpointA.ChannelID
+
+	params := k.GetParams(ctx)
+	params.MinIbcTimeout = 10 * time.Minute
+	params.MaxIbcTimeout = 2 * time.Hour
+	k.SetParams(ctx, params)
+
+	// without measurements the max timeout is used
+	suite.Require().Equal(params.MaxIbcTimeout, k.GetIBCTimeout(ctx, connectionID))
+
+	relay := func(sequence uint64, latency time.Duration) {
+		sendCtx := ctx.WithBlockTime(ctx.BlockTime().Add(-latency))
+		k.SetPacketSendTime(sendCtx, k.GetTransactionSequenceID(ibctransfertypes.PortID, channelID, sequence), sendCtx.BlockTime())
+		k.RecordPacketRelayed(ctx, ibctransfertypes.PortID, channelID, sequence)
+	}
+
+	relay(1, 5*time.Minute)
+	suite.Require().Equal(20*time.Minute, k.GetIBCTimeout(ctx, connectionID))
+
+	relay(2, 15*time.Minute)
+	latency, found := k.GetRelayLatency(ctx, connectionID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(2), latency.Samples)
+	suite.Require().Equal(6*time.Minute, latency.Average)
+	suite.Require().Equal(24*time.Minute, k.GetIBCTimeout(ctx, connectionID))
+
+	// the send time is only used once
+	_, found = k.GetPacketSendTime(ctx, k.GetTransactionSequenceID(ibctransfertypes.PortID, channelID, 2))
+	suite.Require().False(found)
+	k.RecordPacketRelayed(ctx, ibctransfertypes.PortID, channelID, 2)
+	latency, _ = k.GetRelayLatency(ctx, connectionID)
+	suite.Require().Equal(uint64(2), latency.Samples)
+
+	// the timeout is bounded by the params
+	relay(3, 10*time.Hour)
+	suite.Require().Equal(params.MaxIbcTimeout, k.GetIBCTimeout(ctx, connectionID))
+
+	latency.Average = time.Minute
+	k.SetRelayLatency(ctx, latency)
+	suite.Require().Equal(params.MinIbcTimeout, k.GetIBCTimeout(ctx, connectionID))
+
+	res, err := k.RelayLatency(sdk.WrapSDKContext(ctx), &types.QueryRelayLatencyRequest{ConnectionId: connectionID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(*latency, res.Latency)
+	suite.Require().Equal(params.MinIbcTimeout, res.Timeout)
+
+	// a zero max timeout falls back to the fixed timeout
+	params.MaxIbcTimeout = 0
+	k.SetParams(ctx, params)
+	suite.Require().Equal(types.IBCTimeoutTimestamp, k.GetIBCTimeout(ctx, connectionID))
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// getOrInitRiskParams returns the risk params of a host chain, initializing them if they were never fetched
+func getOrInitRiskParams(hc *types.HostChain) *types.HostChainRiskParams {
+	if hc.RiskParams == nil {
+		hc.RiskParams = &types.HostChainRiskParams{
+			SlashFractionDoubleSign: sdk.ZeroDec(),
+			SlashFractionDowntime:   sdk.ZeroDec(),
+		}
+	}
+
+	return hc.RiskParams
+}
+
+// UpdateHostChainStakingParams stores the staking params fetched from the host chain
+func (k *Keeper) UpdateHostChainStakingParams(ctx sdk.Context, hc *types.HostChain, params stakingtypes.Params) error {
+	riskParams := getOrInitRiskParams(hc)
+	riskParams.UnbondingPeriod = params.UnbondingTime
+	riskParams.StakingUpdateHeight = ctx.BlockHeight()
+
+	return k.SetHostChain(ctx, hc)
+}
+
+// UpdateHostChainSlashingParams stores the slashing params fetched from the host chain
+func (k *Keeper) UpdateHostChainSlashingParams(ctx sdk.Context, hc *types.HostChain, params slashingtypes.Params) error {
+	riskParams := getOrInitRiskParams(hc)
+	riskParams.SlashFractionDoubleSign = params.SlashFractionDoubleSign
+	riskParams.SlashFractionDowntime = params.SlashFractionDowntime
+	riskParams.SignedBlocksWindow = params.SignedBlocksWindow
+	riskParams.SlashingUpdateHeight = ctx.BlockHeight()
+
+	return k.SetHostChain(ctx, hc)
+}
+
+// RefreshHostChainsRiskParams queries the staking and slashing params of all the active host chains
+func (k *Keeper) RefreshHostChainsRiskParams(ctx sdk.Context) {
+	for _, hc := range k.GetAllHostChains(ctx) {
+		if !hc.IsActive() {
+			continue
+		}
+
+		if err := k.QueryHostChainRiskParams(ctx, hc); err != nil {
+			k.Logger(ctx).Error(
+				"Could not send host chain risk params ICQ",
+				"host_chain",
+				hc.ChainId,
+				"err",
+				err.Error(),
+			)
+		}
+	}
+}
+
+// GetUnbondingMatureTime returns the time at which an undelegation started now will mature, using the unbonding
+// period of the host chain. It returns false if the unbonding period has not been fetched yet.
+func (k *Keeper) GetUnbondingMatureTime(ctx sdk.Context, hc *types.HostChain) (time.Time, bool) {
+	if hc.RiskParams == nil || hc.RiskParams.UnbondingPeriod <= 0 {
+		return time.Time{}, false
+	}
+
+	return ctx.BlockTime().Add(hc.RiskParams.UnbondingPeriod), true
+}
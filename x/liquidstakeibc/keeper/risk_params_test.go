@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icqtypes "github.com/persistenceOne/persistence-sdk/v2/x/interchainquery/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestHostChainRiskParams() {
+	pstakeApp, ctx := suite.app, suite.ctx
+	k := pstakeApp.LiquidStakeIBCKeeper
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	// the mature time can't be computed before the unbonding period is known
+	_, found = k.GetUnbondingMatureTime(ctx, hc)
+	suite.Require().False(found)
+
+	suite.Require().NoError(k.QueryHostChainRiskParams(ctx, hc))
+
+	queries := make(map[string]icqtypes.Query)
+	for _, q := range pstakeApp.InterchainQueryKeeper.AllQueries(ctx) {
+		if q.ChainId == hc.ChainId {
+			queries[q.CallbackId] = q
+		}
+	}
+	suite.Require().Equal(types.StakingParamsQuery, queries[keeper.StakingParams].QueryType)
+	suite.Require().Equal(types.SlashingParamsQuery, queries[keeper.SlashingParams].QueryType)
+
+	stakingParams := stakingtypes.DefaultParams()
+	stakingParams.UnbondingTime = 21 * 24 * time.Hour
+	data, err := pstakeApp.AppCodec().Marshal(&stakingtypes.QueryParamsResponse{Params: stakingParams})
+	suite.Require().NoError(err)
+	suite.Require().NoError(keeper.StakingParamsCallback(k, ctx, data, queries[keeper.StakingParams]))
+
+	slashingParams := slashingtypes.DefaultParams()
+	slashingParams.SlashFractionDoubleSign = sdk.NewDecWithPrec(5, 2)
+	slashingParams.SlashFractionDowntime = sdk.NewDecWithPrec(1, 4)
+	data, err = pstakeApp.AppCodec().Marshal(&slashingtypes.QueryParamsResponse{Params: slashingParams})
+	suite.Require().NoError(err)
+	suite.Require().NoError(keeper.SlashingParamsCallback(k, ctx, data, queries[keeper.SlashingParams]))
+
+	res, err := k.HostChain(sdk.WrapSDKContext(ctx), &types.QueryHostChainRequest{ChainId: hc.ChainId})
+	suite.Require().NoError(err)
+	riskParams := res.HostChain.RiskParams
+	suite.Require().NotNil(riskParams)
+	suite.Require().Equal(stakingParams.UnbondingTime, riskParams.UnbondingPeriod)
+	suite.Require().Equal(slashingParams.SlashFractionDoubleSign, riskParams.SlashFractionDoubleSign)
+	suite.Require().Equal(slashingParams.SlashFractionDowntime, riskParams.SlashFractionDowntime)
+	suite.Require().Equal(ctx.BlockHeight(), riskParams.StakingUpdateHeight)
+	suite.Require().Equal(ctx.BlockHeight(), riskParams.SlashingUpdateHeight)
+
+	matureTime, found := k.GetUnbondingMatureTime(ctx, &res.HostChain)
+	suite.Require().True(found)
+	suite.Require().Equal(ctx.BlockTime().Add(stakingParams.UnbondingTime), matureTime)
+
+	// a response for an unknown host chain is rejected
+	query := queries[keeper.StakingParams]
+	query.ChainId = "unknown-chain"
+	suite.Require().Error(keeper.StakingParamsCallback(k, ctx, data, query))
+}
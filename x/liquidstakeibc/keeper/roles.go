@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// HasRole returns true if the signer holds the role, the governance authority holds all of them
+func (k *Keeper) HasRole(ctx sdk.Context, signer string, role types.AdminRole) bool {
+	if signer == k.authority {
+		return true
+	}
+
+	params := k.GetParams(ctx)
+	return params.HasRole(signer, role)
+}
+
+// ValidateRole returns an error if the signer does not hold the role
+func (k *Keeper) ValidateRole(ctx sdk.Context, signer string, role types.AdminRole) error {
+	if !k.HasRole(ctx, signer, role) {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "tx signer does not hold the %s role", role)
+	}
+
+	return nil
+}
+
+// ValidateHostChainUpdatesRoles returns an error if the signer does not hold the role of every update key
+func (k *Keeper) ValidateHostChainUpdatesRoles(ctx sdk.Context, signer string, updates []*types.KVUpdate) error {
+	for _, update := range updates {
+		if err := k.ValidateRole(ctx, signer, types.UpdateKeyRole(update.Key)); err != nil {
+			return errorsmod.Wrapf(err, "can't apply %s update", update.Key)
+		}
+	}
+
+	return nil
+}
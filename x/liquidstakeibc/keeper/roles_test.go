@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestAdminRoles() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName)
+	paramAdmin := authtypes.NewModuleAddress("param_admin")
+	feeAdmin := authtypes.NewModuleAddress("fee_admin")
+	params := k.GetParams(ctx)
+	params.ParamAdminAddress = paramAdmin.String()
+	params.FeeAdminAddress = feeAdmin.String()
+	k.SetParams(ctx, params)
+
+	suite.Require().True(k.HasRole(ctx, gov.String(), types.RoleEmergencyAdmin))
+	suite.Require().True(k.HasRole(ctx, params.AdminAddress, types.RoleValidatorSetAdmin))
+	suite.Require().False(k.HasRole(ctx, feeAdmin.String(), types.RoleValidatorSetAdmin))
+
+	// the fee admin can update fees, but not the validator set
+	feeUpdate := &types.KVUpdate{Key: types.KeyRestakeFee, Value: "0.1"}
+	_, err := msgServer.UpdateHostChain(ctx, types.NewMsgUpdateHostChain(hc.ChainId, feeAdmin.String(), []*types.KVUpdate{feeUpdate}))
+	suite.Require().NoError(err)
+
+	weightUpdate := &types.KVUpdate{
+		Key:   types.KeyValidatorWeight,
+		Value: hc.Validators[0].OperatorAddress + ",1",
+	}
+	_, err = msgServer.UpdateHostChain(
+		ctx,
+		types.NewMsgUpdateHostChain(hc.ChainId, feeAdmin.String(), []*types.KVUpdate{feeUpdate, weightUpdate}),
+	)
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	// the param admin can update the module params, but not hand out roles
+	params.MaxDepositRetries++
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(paramAdmin, params))
+	suite.Require().NoError(err)
+	suite.Require().Equal(params.MaxDepositRetries, k.GetParams(ctx).MaxDepositRetries)
+
+	// the module fee address needs the fee admin role on top
+	params.FeeAddress = feeAdmin.String()
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(paramAdmin, params))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(sdk.MustAccAddressFromBech32(params.AdminAddress), params))
+	suite.Require().NoError(err)
+	suite.Require().Equal(feeAdmin.String(), k.GetParams(ctx).FeeAddress)
+
+	params.ValidatorSetAdminAddress = paramAdmin.String()
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(paramAdmin, params))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(feeAdmin, params))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(gov, params))
+	suite.Require().NoError(err)
+	suite.Require().True(k.HasRole(ctx, paramAdmin.String(), types.RoleValidatorSetAdmin))
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// IsSequenceIDInUse returns true if a deposit, unbonding or redelegation is still waiting on the packet of a
+// transaction sequence id
+func (k *Keeper) IsSequenceIDInUse(ctx sdk.Context, sequenceID string) bool {
+	if len(k.GetDepositsWithSequenceID(ctx, sequenceID)) > 0 {
+		return true
+	}
+
+	if len(k.GetLSMDepositsFromIbcSequenceID(ctx, sequenceID)) > 0 {
+		return true
+	}
+
+	// matured unbondings are later tagged with the sequence of the transfer sent by the host chain, which is not a
+	// packet sent from this chain
+	unbondings := k.FilterUnbondings(
+		ctx,
+		func(u types.Unbonding) bool {
+			return u.IbcSequenceId == sequenceID &&
+				(u.State == types.Unbonding_UNBONDING_INITIATED ||
+					(u.State == types.Unbonding_UNBONDING_MATURED &&
+						!strings.HasPrefix(u.IbcSequenceId, ibctransfertypes.PortID+"/")))
+		},
+	)
+	if len(unbondings) > 0 {
+		return true
+	}
+
+	validatorUnbondings := k.FilterValidatorUnbondings(
+		ctx,
+		func(u types.ValidatorUnbonding) bool { return u.IbcSequenceId == sequenceID },
+	)
+	if len(validatorUnbondings) > 0 {
+		return true
+	}
+
+	redelegationTxs := k.FilterRedelegationTx(
+		ctx,
+		func(tx types.RedelegateTx) bool {
+			return tx.IbcSequenceId == sequenceID && tx.State == types.RedelegateTx_REDELEGATE_SENT
+		},
+	)
+	return len(redelegationTxs) > 0
+}
+
+// ValidateNextSequenceID checks that the next packet sent over a channel gets a sequence id no record is tracking.
+// Sequences restart when a channel is reopened, so a stale record could otherwise pick up the ack of a new packet.
+func (k *Keeper) ValidateNextSequenceID(ctx sdk.Context, portID, channelID string) error {
+	sequence, found := k.ibcKeeper.ChannelKeeper.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrChannelNotOpen, "no next send sequence for channel %s on port %s", channelID, portID)
+	}
+
+	sequenceID := k.GetTransactionSequenceID(portID, channelID, sequence)
+	if k.IsSequenceIDInUse(ctx, sequenceID) {
+		return errorsmod.Wrapf(types.ErrDuplicateSequenceID, "sequence id %s is already tracked", sequenceID)
+	}
+
+	return nil
+}
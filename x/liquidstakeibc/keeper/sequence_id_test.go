@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestValidateNextSequenceID() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	suite.Require().NoError(k.ValidateNextSequenceID(ctx, ibctransfertypes.PortID, hc.ChannelId))
+
+	// a deposit still waiting on the next sequence of the channel blocks the transfer
+	sequence, found := suite.app.IBCKeeper.ChannelKeeper.GetNextSequenceSend(ctx, ibctransfertypes.PortID, hc.ChannelId)
+	suite.Require().Equal(true, found)
+	k.SetDeposit(ctx, &types.Deposit{
+		ChainId:       hc.ChainId,
+		Amount:        sdk.NewInt64Coin(hc.IBCDenom(), 1000),
+		Epoch:         100,
+		State:         types.Deposit_DEPOSIT_SENT,
+		IbcSequenceId: k.GetTransactionSequenceID(ibctransfertypes.PortID, hc.ChannelId, sequence),
+	})
+	suite.Require().ErrorIs(
+		k.ValidateNextSequenceID(ctx, ibctransfertypes.PortID, hc.ChannelId),
+		types.ErrDuplicateSequenceID,
+	)
+
+	// the same sequence over another port is a different packet
+	suite.Require().Equal(false, k.IsSequenceIDInUse(ctx, k.GetTransactionSequenceID("icacontroller", hc.ChannelId, sequence)))
+
+	_, found = suite.app.IBCKeeper.ChannelKeeper.GetNextSequenceSend(ctx, ibctransfertypes.PortID, "channel-100")
+	suite.Require().Equal(false, found)
+	suite.Require().Error(k.ValidateNextSequenceID(ctx, ibctransfertypes.PortID, "channel-100"))
+}
+
+func (suite *IntegrationTestSuite) TestMigrate3to4() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	legacyID := hc.ChannelId + "-sequence-7"
+	k.SetDeposit(ctx, &types.Deposit{
+		ChainId:       hc.ChainId,
+		Amount:        sdk.NewInt64Coin(hc.IBCDenom(), 1000),
+		Epoch:         100,
+		State:         types.Deposit_DEPOSIT_SENT,
+		IbcSequenceId: legacyID,
+	})
+	k.SetRedelegationTx(ctx, &types.RedelegateTx{
+		ChainId:       hc.ChainId,
+		IbcSequenceId: legacyID,
+		State:         types.RedelegateTx_REDELEGATE_SENT,
+	})
+	k.SetDeposit(ctx, &types.Deposit{
+		ChainId:       hc.ChainId,
+		Amount:        sdk.NewInt64Coin(hc.IBCDenom(), 1000),
+		Epoch:         101,
+		State:         types.Deposit_DEPOSIT_SENT,
+		IbcSequenceId: "channel-100-sequence-7",
+	})
+
+	suite.Require().NoError(keeper.NewMigrator(k).Migrate3to4(ctx))
+
+	namespacedID := k.GetTransactionSequenceID(ibctransfertypes.PortID, hc.ChannelId, 7)
+	deposit, _ := k.GetDepositForChainAndEpoch(ctx, hc.ChainId, 100)
+	suite.Require().Equal(namespacedID, deposit.IbcSequenceId)
+
+	_, found = k.GetRedelegationTx(ctx, hc.ChainId, legacyID)
+	suite.Require().Equal(false, found)
+	redelegationTx, found := k.GetRedelegationTx(ctx, hc.ChainId, namespacedID)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(namespacedID, redelegationTx.IbcSequenceId)
+
+	// ids of unknown channels are left untouched
+	deposit, _ = k.GetDepositForChainAndEpoch(ctx, hc.ChainId, 101)
+	suite.Require().Equal("channel-100-sequence-7", deposit.IbcSequenceId)
+}
@@ -170,15 +170,22 @@ func (suite *IntegrationTestSuite) SetupHostChainAB() {
 		LsmBondFactor:    lsmBondFactor,
 		UpperCValueLimit: upperCValueLimit,
 		LowerCValueLimit: lowerCValueLimit,
+
+		RedelegationAcceptableDelta: sdk.ZeroInt(),
 	}
 
 	validators := make([]*types.Validator, 0)
 	equalWeight := sdk.OneDec().Quo(sdk.NewDecFromInt(sdk.NewInt(int64(len(suite.chainB.Vals.Validators)))))
-	for _, validator := range suite.chainB.Vals.Validators {
+	for i, validator := range suite.chainB.Vals.Validators {
+		// the last validator takes the rounding remainder, so that the weights add up to one
+		weight := equalWeight
+		if i == len(suite.chainB.Vals.Validators)-1 {
+			weight = sdk.OneDec().Sub(equalWeight.MulInt64(int64(i)))
+		}
 		validators = append(validators, &types.Validator{
 			OperatorAddress: sdk.MustBech32ifyAddressBytes(app.Bech32PrefixValAddr, validator.Address),
 			Status:          stakingtypes.Bonded.String(),
-			Weight:          equalWeight,
+			Weight:          weight,
 			DelegatedAmount: sdk.ZeroInt(),
 			ExchangeRate:    sdk.OneDec(),
 			Delegable:       true,
@@ -213,7 +220,7 @@ func (suite *IntegrationTestSuite) SetupHostChainAB() {
 		Flags:              &types.HostChainFlags{Lsm: true},
 	}
 
-	suite.app.LiquidStakeIBCKeeper.SetHostChain(suite.chainA.GetContext(), hc)
+	suite.Require().NoError(suite.app.LiquidStakeIBCKeeper.SetHostChain(suite.chainA.GetContext(), hc))
 }
 
 func (suite *IntegrationTestSuite) SetupLSM() {
@@ -409,7 +416,8 @@ func (suite *IntegrationTestSuite) TestOneFullFlow() {
 	suite.Require().True(found)
 	suite.Require().Equal(types.Deposit_DEPOSIT_DELEGATING, deposit.State)
 
-	timeoutTimestamp := uint64(suite.chainA.GetContext().BlockTime().UnixNano()) + uint64(types.IBCTimeoutTimestamp.Nanoseconds()) - uint64(time.Second*5) // sub one b
+	ibcTimeout := k.GetIBCTimeout(suite.chainA.GetContext(), hc.ConnectionId)
+	timeoutTimestamp := uint64(suite.chainA.GetContext().BlockTime().UnixNano()) + uint64(ibcTimeout.Nanoseconds()) - uint64(time.Second*5) // sub one b
 	data, err := suite.CreateICAData(deposit.Amount.Amount, hc, 0)
 	suite.NoError(err)
 
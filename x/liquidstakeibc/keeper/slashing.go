@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// QueryHostChainsDelegations queries the delegations of the delegation account of all the active host chains, to
+// check their validators for slashes even if no validator update reported a new exchange rate
+func (k *Keeper) QueryHostChainsDelegations(ctx sdk.Context) {
+	k.ClearWorkflowFailures(ctx, types.WorkflowSlashDetection)
+
+	for _, hc := range k.GetAllHostChains(ctx) {
+		if !hc.IsActive() || hc.DelegationAccount == nil || hc.DelegationAccount.Address == "" {
+			continue
+		}
+
+		k.RunHostChainWorkflow(ctx, types.WorkflowSlashDetection, hc.ChainId, 0, func(ctx sdk.Context) error {
+			return k.QueryDelegatorDelegations(ctx, hc)
+		})
+	}
+}
+
+// HasDelegationDecreaseInFlight checks if an undelegation or redelegation from a validator of the host chain was sent
+// and not acknowledged yet. Until it is, the host chain can report a delegation below the tracked one without any
+// slash.
+func (k *Keeper) HasDelegationDecreaseInFlight(ctx sdk.Context, chainID, validatorAddress string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelegationDecreaseKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetDelegationDecreaseValidatorPrefix(chainID, validatorAddress))
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+// SetDelegationDecreasesInFlight records the validators whose delegation the messages of an ica tx decrease, until
+// the tx is acknowledged or times out
+func (k *Keeper) SetDelegationDecreasesInFlight(
+	ctx sdk.Context,
+	chainID string,
+	sequenceID string,
+	messages []proto.Message,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelegationDecreaseKey)
+	for _, validatorAddress := range getDecreasedValidators(messages) {
+		store.Set(types.GetDelegationDecreaseStoreKey(chainID, validatorAddress, sequenceID), []byte{})
+	}
+}
+
+// DeleteDelegationDecreasesInFlight removes the delegation decreases of an ica tx once it is acknowledged or times out
+func (k *Keeper) DeleteDelegationDecreasesInFlight(
+	ctx sdk.Context,
+	chainID string,
+	sequenceID string,
+	messages []proto.Message,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelegationDecreaseKey)
+	for _, validatorAddress := range getDecreasedValidators(messages) {
+		store.Delete(types.GetDelegationDecreaseStoreKey(chainID, validatorAddress, sequenceID))
+	}
+}
+
+// getDecreasedValidators returns the validators whose delegation is decreased by the messages
+func getDecreasedValidators(messages []proto.Message) []string {
+	validators := make([]string, 0)
+	for _, msg := range messages {
+		switch msg := msg.(type) {
+		case *stakingtypes.MsgUndelegate:
+			validators = append(validators, msg.ValidatorAddress)
+		case *stakingtypes.MsgBeginRedelegate:
+			validators = append(validators, msg.ValidatorSrcAddress)
+		}
+	}
+
+	return validators
+}
+
+// CheckDelegationsForSlashes compares the delegations reported by the host chain with the ones tracked for its
+// validators. The gRPC response is not proven, so the validators it reports below their tracked delegation are
+// queried again through store queries, whose callbacks update the validator exchange rate and apply the slash to the
+// delegated amount and the c value.
+func (k *Keeper) CheckDelegationsForSlashes(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	delegations stakingtypes.DelegationResponses,
+) error {
+	for _, delegation := range delegations {
+		validator, found := hc.GetValidator(delegation.Delegation.ValidatorAddress)
+		if !found {
+			continue
+		}
+
+		if !delegation.Balance.Amount.LT(validator.DelegatedAmount) {
+			continue
+		}
+
+		// the next delegations check verifies the validator once its undelegation or redelegation is acknowledged
+		if k.HasDelegationDecreaseInFlight(ctx, hc.ChainId, validator.OperatorAddress) {
+			k.Logger(ctx).Info(
+				"Skipping slash check, an undelegation or redelegation is in flight.",
+				"host_chain",
+				hc.ChainId,
+				"validator",
+				validator.OperatorAddress,
+			)
+			continue
+		}
+
+		k.Logger(ctx).Info(
+			"Validator delegation reported below the tracked one, verifying the slash.",
+			"host_chain",
+			hc.ChainId,
+			"validator",
+			validator.OperatorAddress,
+			"tracked",
+			validator.DelegatedAmount,
+			"reported",
+			delegation.Balance.Amount,
+		)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSlashSuspected,
+				sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+				sdk.NewAttribute(types.AttributeValidatorAddress, validator.OperatorAddress),
+				sdk.NewAttribute(types.AttributeExistingDelegation, validator.DelegatedAmount.String()),
+				sdk.NewAttribute(types.AttributeReportedDelegation, delegation.Balance.Amount.String()),
+			),
+		)
+
+		if err := k.QueryHostChainValidator(ctx, hc, validator.OperatorAddress); err != nil {
+			return err
+		}
+		if err := k.QueryValidatorDelegation(ctx, hc, validator); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+	icqtypes "github.com/persistenceOne/persistence-sdk/v2/x/interchainquery/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestSlashDetection() {
+	pstakeApp := suite.app
+	k := pstakeApp.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	suite.Require().GreaterOrEqual(len(hc.Validators), 2)
+	hc.Validators[0].DelegatedAmount = sdk.NewInt(1000)
+	hc.Validators[1].DelegatedAmount = sdk.NewInt(500)
+	k.SetHostChain(ctx, hc)
+
+	countQueries := func(callbackID string) int {
+		count := 0
+		for _, q := range pstakeApp.InterchainQueryKeeper.AllQueries(ctx) {
+			if q.ChainId == hc.ChainId && q.CallbackId == callbackID {
+				count++
+			}
+		}
+		return count
+	}
+	countSuspected := func() int {
+		count := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeSlashSuspected {
+				count++
+			}
+		}
+		return count
+	}
+
+	// the delegations of the delegation account are queried every c value epoch
+	k.QueryHostChainsDelegations(ctx)
+	var query icqtypes.Query
+	for _, q := range pstakeApp.InterchainQueryKeeper.AllQueries(ctx) {
+		if q.ChainId == hc.ChainId && q.CallbackId == keeper.DelegatorDelegations {
+			query = q
+		}
+	}
+	suite.Require().Equal(types.StakingDelegatorDelegationsQuery, query.QueryType)
+
+	makeData := func(balances ...int64) []byte {
+		response := stakingtypes.QueryDelegatorDelegationsResponse{}
+		for i, balance := range balances {
+			response.DelegationResponses = append(response.DelegationResponses, stakingtypes.DelegationResponse{
+				Delegation: stakingtypes.Delegation{
+					DelegatorAddress: hc.DelegationAccount.Address,
+					ValidatorAddress: hc.Validators[i].OperatorAddress,
+					Shares:           sdk.NewDecFromInt(hc.Validators[i].DelegatedAmount),
+				},
+				Balance: sdk.NewInt64Coin(hc.HostDenom, balance),
+			})
+		}
+		data, err := pstakeApp.AppCodec().Marshal(&response)
+		suite.Require().NoError(err)
+		return data
+	}
+
+	// only the validator reported below its tracked delegation is verified with store queries
+	validatorQueries, delegationQueries := countQueries(keeper.Validator), countQueries(keeper.Delegation)
+	suite.Require().NoError(keeper.DelegatorDelegationsCallback(k, ctx, makeData(900, 500), query))
+	suite.Require().Equal(1, countSuspected())
+	suite.Require().Equal(validatorQueries+1, countQueries(keeper.Validator))
+	suite.Require().Equal(delegationQueries+1, countQueries(keeper.Delegation))
+
+	// the gRPC response alone does not change the tracked delegation
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(sdk.NewInt(1000), hc.Validators[0].DelegatedAmount)
+
+	// the proven delegation applies the slash with the validator exchange rate
+	hc.Validators[0].ExchangeRate = sdk.MustNewDecFromStr("0.9")
+	k.SetHostChain(ctx, hc)
+	data := stakingtypes.MustMarshalDelegation(pstakeApp.AppCodec(), stakingtypes.Delegation{
+		DelegatorAddress: hc.DelegationAccount.Address,
+		ValidatorAddress: hc.Validators[0].OperatorAddress,
+		Shares:           sdk.NewDec(1000),
+	})
+	suite.Require().NoError(keeper.DelegationCallback(k, ctx, data, icqtypes.Query{ChainId: hc.ChainId}))
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(sdk.NewInt(900), hc.Validators[0].DelegatedAmount)
+
+	// an undelegation in flight is not mistaken for a slash, but only skips the validator it undelegates from
+	undelegation := []proto.Message{&stakingtypes.MsgUndelegate{
+		DelegatorAddress: hc.DelegationAccount.Address,
+		ValidatorAddress: hc.Validators[0].OperatorAddress,
+		Amount:           sdk.NewInt64Coin(hc.HostDenom, 100),
+	}}
+	k.SetDelegationDecreasesInFlight(ctx, hc.ChainId, "icacontroller-owner/channel-0-sequence-1", undelegation)
+	suite.Require().True(k.HasDelegationDecreaseInFlight(ctx, hc.ChainId, hc.Validators[0].OperatorAddress))
+	suite.Require().False(k.HasDelegationDecreaseInFlight(ctx, hc.ChainId, hc.Validators[1].OperatorAddress))
+	suite.Require().NoError(keeper.DelegatorDelegationsCallback(k, ctx, makeData(800, 400), query))
+	suite.Require().Equal(2, countSuspected())
+
+	data = stakingtypes.MustMarshalDelegation(pstakeApp.AppCodec(), stakingtypes.Delegation{
+		DelegatorAddress: hc.DelegationAccount.Address,
+		ValidatorAddress: hc.Validators[0].OperatorAddress,
+		Shares:           sdk.NewDec(500),
+	})
+	suite.Require().NoError(keeper.DelegationCallback(k, ctx, data, icqtypes.Query{ChainId: hc.ChainId}))
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(sdk.NewInt(900), hc.Validators[0].DelegatedAmount)
+
+	// the slash is applied once the undelegation is acknowledged
+	k.DeleteDelegationDecreasesInFlight(ctx, hc.ChainId, "icacontroller-owner/channel-0-sequence-1", undelegation)
+	suite.Require().False(k.HasDelegationDecreaseInFlight(ctx, hc.ChainId, hc.Validators[0].OperatorAddress))
+	suite.Require().NoError(keeper.DelegationCallback(k, ctx, data, icqtypes.Query{ChainId: hc.ChainId}))
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(sdk.NewInt(450), hc.Validators[0].DelegatedAmount)
+}
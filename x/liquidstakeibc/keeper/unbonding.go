@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"sort"
+	"strconv"
 	"time"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -78,6 +81,69 @@ func (k *Keeper) IncreaseUndelegatingAmountForEpoch(
 	k.SetUnbonding(ctx, unbonding)
 }
 
+// QueueUnbonding adds the unbonding of a delegator to the host chain unbondings, starting from the given unbonding
+// epoch. With a max unbonding amount set, the part above what the cap leaves for an epoch rolls into the next unbonding
+// epochs, each with its own user unbonding record. With autoClaim set, every user unbonding record the unbonding is added
+// to is opted in to the automatic claim. Returns the unbonding epochs the unbonding was added to.
+func (k *Keeper) QueueUnbonding(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	delegatorAddress string,
+	unbondingEpoch int64,
+	stkAmount sdk.Coin,
+	unbondAmount sdk.Coin,
+	autoClaim bool,
+) []int64 {
+	capped := !hc.Params.MaxUnbondingAmount.IsNil() && hc.Params.MaxUnbondingAmount.IsPositive()
+
+	epochs := make([]int64, 0, 1)
+	remainingStk, remainingUnbond := stkAmount, unbondAmount
+	for epoch := unbondingEpoch; ; epoch += hc.UnbondingFactor {
+		stkPart, unbondPart := remainingStk, remainingUnbond
+		if capped {
+			room := hc.Params.MaxUnbondingAmount
+			if unbonding, found := k.GetUnbonding(ctx, hc.ChainId, epoch); found {
+				room = room.Sub(unbonding.UnbondAmount.Amount)
+			}
+			if !room.IsPositive() {
+				continue
+			}
+
+			// the stk tokens are split in the same proportion as the host tokens
+			if room.LT(remainingUnbond.Amount) {
+				unbondPart = sdk.NewCoin(unbondAmount.Denom, room)
+				stkPart = sdk.NewCoin(stkAmount.Denom, stkAmount.Amount.Mul(room).Quo(unbondAmount.Amount))
+			}
+		}
+
+		k.IncreaseUserUnbondingAmountForEpoch(ctx, hc.ChainId, delegatorAddress, epoch, stkPart, unbondPart)
+		k.IncreaseUndelegatingAmountForEpoch(ctx, hc.ChainId, epoch, stkPart, unbondPart)
+		if autoClaim {
+			userUnbonding, _ := k.GetUserUnbonding(ctx, hc.ChainId, delegatorAddress, epoch)
+			userUnbonding.AutoClaim = true
+			k.SetUserUnbonding(ctx, userUnbonding)
+		}
+		epochs = append(epochs, epoch)
+
+		if epoch != unbondingEpoch {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeUnbondingRolledOver,
+					sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+					sdk.NewAttribute(types.AttributeDelegatorAddress, delegatorAddress),
+					sdk.NewAttribute(types.AttributeEpoch, strconv.FormatInt(epoch, 10)),
+					sdk.NewAttribute(types.AttributeOutputAmount, unbondPart.String()),
+				),
+			)
+		}
+
+		remainingStk, remainingUnbond = remainingStk.Sub(stkPart), remainingUnbond.Sub(unbondPart)
+		if !remainingUnbond.IsPositive() {
+			return epochs
+		}
+	}
+}
+
 func (k *Keeper) FailAllUnbondingsForSequenceID(ctx sdk.Context, sequenceID string) {
 	unbondings := k.FilterUnbondings(ctx, func(u types.Unbonding) bool { return u.IbcSequenceId == sequenceID })
 
@@ -93,10 +159,63 @@ func (k *Keeper) RevertUnbondingsState(ctx sdk.Context, unbondings []*types.Unbo
 		unbonding.IbcSequenceId = ""
 
 		if unbonding.State != types.Unbonding_UNBONDING_PENDING &&
-			unbonding.State != types.Unbonding_UNBONDING_FAILED {
+			unbonding.State != types.Unbonding_UNBONDING_FAILED &&
+			unbonding.State != types.Unbonding_UNBONDING_RETRYING {
 			unbonding.State--
 		}
 
 		k.SetUnbonding(ctx, unbonding)
 	}
 }
+
+// AllocateUnbondingTransfer splits the amount of a consolidated undelegation transfer across the matured unbondings
+// it covers, oldest epoch first, marking every funded unbonding as claimable. When the unbondings are known to be
+// covered by this transfer alone, a short amount is taken from the latest epochs by scaling down their user
+// unbondings, otherwise the unbondings that can't be funded are left waiting for their own transfer. It returns the
+// funded unbondings and the part of the amount that was not allocated.
+func (k *Keeper) AllocateUnbondingTransfer(
+	ctx sdk.Context,
+	unbondings []*types.Unbonding,
+	amount math.Int,
+	exclusive bool,
+) ([]*types.Unbonding, math.Int) {
+	sort.SliceStable(unbondings, func(i, j int) bool {
+		return unbondings[i].EpochNumber < unbondings[j].EpochNumber
+	})
+
+	funded := make([]*types.Unbonding, 0)
+	remaining := amount
+	for _, unbonding := range unbondings {
+		if remaining.LT(unbonding.UnbondAmount.Amount) {
+			if !exclusive {
+				continue
+			}
+			k.scaleUserUnbondings(ctx, unbonding, remaining)
+		}
+
+		remaining = remaining.Sub(unbonding.UnbondAmount.Amount)
+		unbonding.IbcSequenceId = ""
+		unbonding.State = types.Unbonding_UNBONDING_CLAIMABLE
+		k.SetUnbonding(ctx, unbonding)
+		funded = append(funded, unbonding)
+	}
+
+	return funded, remaining
+}
+
+// scaleUserUnbondings reduces the user unbondings of an unbonding pro rata so that they add up to at most amount
+func (k *Keeper) scaleUserUnbondings(ctx sdk.Context, unbonding *types.Unbonding, amount math.Int) {
+	userUnbondings := k.GetUserUnbondingsForEpoch(ctx, unbonding.ChainId, unbonding.EpochNumber)
+
+	total := sdk.ZeroInt()
+	for _, userUnbonding := range userUnbondings {
+		if unbonding.UnbondAmount.IsPositive() {
+			userUnbonding.UnbondAmount.Amount = userUnbonding.UnbondAmount.Amount.Mul(amount).Quo(unbonding.UnbondAmount.Amount)
+		}
+		total = total.Add(userUnbonding.UnbondAmount.Amount)
+		k.SetUserUnbonding(ctx, userUnbonding)
+	}
+
+	// the truncation dust is left in the undelegation module account
+	unbonding.UnbondAmount.Amount = total
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// GetUnbondingMaxEntries returns the unbonding entries a validator can hold for the delegation account, the host
+// chain staking default if the host chain doesn't set any
+func (k *Keeper) GetUnbondingMaxEntries(hc *types.HostChain) uint32 {
+	if hc.Params.MaxEntries == 0 {
+		return stakingtypes.DefaultMaxEntries
+	}
+	return hc.Params.MaxEntries
+}
+
+// GetUnbondingCapacity estimates how many host tokens could be unbonded in each of the next unbonding epochs of a
+// host chain, on top of what is already queued for them, and over all of them. A validator can only be undelegated
+// from in an epoch if it has a free unbonding entry at that time: every undelegation still in flight holds an entry of
+// each delegated validator until it matures, and every reported epoch is assumed to take one more entry from each of
+// its available validators. The capacity of an epoch is also bound by what the host chain unbonding cap leaves of it.
+func (k *Keeper) GetUnbondingCapacity(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	epochs uint32,
+) ([]*types.UnbondingCapacityEpoch, math.Int) {
+	maxEntries := k.GetUnbondingMaxEntries(hc)
+	unbondingPeriod := time.Duration(0)
+	if hc.RiskParams != nil {
+		unbondingPeriod = hc.RiskParams.UnbondingPeriod
+	}
+
+	// without a known unbonding period the entries are never expected to mature
+	matureTime := func(t time.Time) time.Time {
+		if unbondingPeriod <= 0 {
+			return time.Unix(1<<62, 0)
+		}
+		return t.Add(unbondingPeriod)
+	}
+	inFlightMatureTime := func(t time.Time) time.Time {
+		if t.IsZero() {
+			return matureTime(ctx.BlockTime())
+		}
+		return t
+	}
+
+	// undelegations sent to the host chain that still hold an entry of every delegated validator
+	inFlight := make([]time.Time, 0)
+	for _, unbonding := range k.FilterUnbondings(ctx, func(u types.Unbonding) bool {
+		return u.ChainId == hc.ChainId &&
+			(u.State == types.Unbonding_UNBONDING_INITIATED || u.State == types.Unbonding_UNBONDING_MATURING)
+	}) {
+		inFlight = append(inFlight, inFlightMatureTime(unbonding.MatureTime))
+	}
+
+	// undelegations of exiting validators only hold an entry of the validator itself
+	validatorEntries := make(map[string][]time.Time)
+	for _, vu := range k.FilterValidatorUnbondings(ctx, func(u types.ValidatorUnbonding) bool {
+		return u.ChainId == hc.ChainId
+	}) {
+		validatorEntries[vu.ValidatorAddress] = append(validatorEntries[vu.ValidatorAddress], inFlightMatureTime(vu.MatureTime))
+	}
+
+	// delegations that can be unbonded in at least one of the epochs
+	unbondable := make(map[string]bool, len(hc.Validators))
+	totalQueued := sdk.ZeroInt()
+
+	epochInfo := k.epochsKeeper.GetEpochInfo(ctx, types.UndelegationEpoch)
+	schedule := make([]*types.UnbondingCapacityEpoch, 0, epochs)
+	for epoch := epochInfo.CurrentEpoch; uint32(len(schedule)) < epochs; epoch++ {
+		if !hc.IsUnbondingEpoch(epoch) {
+			continue
+		}
+
+		// the undelegations of an epoch are sent when it ends
+		sendTime := epochInfo.CurrentEpochStartTime.Add(time.Duration(epoch-epochInfo.CurrentEpoch+1) * epochInfo.Duration)
+
+		queued := sdk.ZeroInt()
+		if unbonding, found := k.GetUnbonding(ctx, hc.ChainId, epoch); found &&
+			unbonding.State == types.Unbonding_UNBONDING_PENDING {
+			queued = unbonding.UnbondAmount.Amount
+		}
+		totalQueued = totalQueued.Add(queued)
+
+		activeInFlight := countActiveEntries(inFlight, sendTime)
+		available := uint32(0)
+		capacity := sdk.ZeroInt()
+		for _, validator := range hc.Validators {
+			if !validator.DelegatedAmount.IsPositive() {
+				continue
+			}
+
+			entries := activeInFlight + countActiveEntries(validatorEntries[validator.OperatorAddress], sendTime)
+			if entries >= int(maxEntries) {
+				continue
+			}
+
+			available++
+			capacity = capacity.Add(validator.DelegatedAmount)
+			unbondable[validator.OperatorAddress] = true
+			validatorEntries[validator.OperatorAddress] = append(
+				validatorEntries[validator.OperatorAddress],
+				matureTime(sendTime),
+			)
+		}
+
+		capacity = capacity.Sub(queued)
+		if !hc.Params.MaxUnbondingAmount.IsNil() && hc.Params.MaxUnbondingAmount.IsPositive() {
+			capacity = sdk.MinInt(capacity, hc.Params.MaxUnbondingAmount.Sub(queued))
+		}
+		if capacity.IsNegative() {
+			capacity = sdk.ZeroInt()
+		}
+
+		schedule = append(schedule, &types.UnbondingCapacityEpoch{
+			EpochNumber:         epoch,
+			Time:                sendTime,
+			AvailableValidators: available,
+			QueuedAmount:        queued,
+			Capacity:            capacity,
+		})
+	}
+
+	total := sdk.ZeroInt()
+	for _, validator := range hc.Validators {
+		if unbondable[validator.OperatorAddress] {
+			total = total.Add(validator.DelegatedAmount)
+		}
+	}
+	total = total.Sub(totalQueued)
+	if total.IsNegative() {
+		total = sdk.ZeroInt()
+	}
+
+	return schedule, total
+}
+
+// countActiveEntries returns how many of the unbonding entries maturing at the given times are still held at t
+func countActiveEntries(matureTimes []time.Time, t time.Time) int {
+	active := 0
+	for _, matureTime := range matureTimes {
+		if matureTime.After(t) {
+			active++
+		}
+	}
+	return active
+}
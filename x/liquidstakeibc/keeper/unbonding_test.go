@@ -231,3 +231,55 @@ func (suite *IntegrationTestSuite) TestRevertUnbondingsState() {
 		}
 	}
 }
+
+func (suite *IntegrationTestSuite) TestAllocateUnbondingTransfer() {
+	k := suite.app.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	newUnbondings := func() []*types.Unbonding {
+		unbondings := make([]*types.Unbonding, 0)
+		for _, epoch := range []int64{12, 4, 8} {
+			unbonding := &types.Unbonding{
+				ChainId:       hc.ChainId,
+				EpochNumber:   epoch,
+				UnbondAmount:  sdk.NewInt64Coin(hc.HostDenom, 1000),
+				IbcSequenceId: "channel-0-sequence-1",
+				State:         types.Unbonding_UNBONDING_MATURED,
+			}
+			k.SetUnbonding(suite.ctx, unbonding)
+			k.SetUserUnbonding(suite.ctx, &types.UserUnbonding{
+				ChainId:      hc.ChainId,
+				EpochNumber:  epoch,
+				Address:      suite.chainA.SenderAccount.GetAddress().String(),
+				UnbondAmount: sdk.NewInt64Coin(hc.HostDenom, 1000),
+			})
+			unbondings = append(unbondings, unbonding)
+		}
+		return unbondings
+	}
+
+	// without knowing which unbondings the transfer covers, only the fully funded ones are claimable
+	funded, remaining := k.AllocateUnbondingTransfer(suite.ctx, newUnbondings(), sdk.NewInt(2500), false)
+	suite.Require().Len(funded, 2)
+	suite.Require().Equal(int64(4), funded[0].EpochNumber)
+	suite.Require().Equal(int64(8), funded[1].EpochNumber)
+	suite.Require().Equal(sdk.NewInt(500), remaining)
+	unbonding, _ := k.GetUnbonding(suite.ctx, hc.ChainId, 12)
+	suite.Require().Equal(types.Unbonding_UNBONDING_MATURED, unbonding.State)
+
+	// a short transfer for its own unbondings is taken from the latest epoch
+	funded, remaining = k.AllocateUnbondingTransfer(suite.ctx, newUnbondings(), sdk.NewInt(2500), true)
+	suite.Require().Len(funded, 3)
+	suite.Require().True(remaining.IsZero())
+	unbonding, _ = k.GetUnbonding(suite.ctx, hc.ChainId, 12)
+	suite.Require().Equal(types.Unbonding_UNBONDING_CLAIMABLE, unbonding.State)
+	suite.Require().Equal(sdk.NewInt(500), unbonding.UnbondAmount.Amount)
+	userUnbonding, _ := k.GetUserUnbonding(
+		suite.ctx,
+		hc.ChainId,
+		suite.chainA.SenderAccount.GetAddress().String(),
+		12,
+	)
+	suite.Require().Equal(sdk.NewInt(500), userUnbonding.UnbondAmount.Amount)
+}
@@ -10,7 +10,10 @@ import (
 func (k *Keeper) SetUserUnbonding(ctx sdk.Context, ub *types.UserUnbonding) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UserUnbondingKey)
 	bytes := k.cdc.MustMarshal(ub)
-	store.Set(types.GetUserUnbondingStoreKey(ub.ChainId, ub.Address, ub.EpochNumber), bytes)
+	store.Set(types.GetUserUnbondingStoreKey(ub.Address, ub.ChainId, ub.EpochNumber), bytes)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.UserUnbondingEpochIndexKey)
+	indexStore.Set(types.GetUserUnbondingEpochIndexKey(ub.ChainId, ub.EpochNumber, ub.Address), []byte{})
 }
 
 func (k *Keeper) GetUserUnbonding(
@@ -20,7 +23,7 @@ func (k *Keeper) GetUserUnbonding(
 	epochNumber int64,
 ) (*types.UserUnbonding, bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UserUnbondingKey)
-	bz := store.Get(types.GetUserUnbondingStoreKey(chainID, delegatorAddress, epochNumber))
+	bz := store.Get(types.GetUserUnbondingStoreKey(delegatorAddress, chainID, epochNumber))
 	if bz == nil {
 		return &types.UserUnbonding{}, false
 	}
@@ -32,7 +35,57 @@ func (k *Keeper) GetUserUnbonding(
 
 func (k *Keeper) DeleteUserUnbonding(ctx sdk.Context, ub *types.UserUnbonding) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UserUnbondingKey)
-	store.Delete(types.GetUserUnbondingStoreKey(ub.ChainId, ub.Address, ub.EpochNumber))
+	store.Delete(types.GetUserUnbondingStoreKey(ub.Address, ub.ChainId, ub.EpochNumber))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.UserUnbondingEpochIndexKey)
+	indexStore.Delete(types.GetUserUnbondingEpochIndexKey(ub.ChainId, ub.EpochNumber, ub.Address))
+}
+
+// GetUserUnbondingsForDelegator returns the user unbondings of a delegator, ordered by host chain and epoch
+func (k *Keeper) GetUserUnbondingsForDelegator(ctx sdk.Context, delegatorAddress string) []*types.UserUnbonding {
+	return k.getUserUnbondingsWithPrefix(ctx, types.GetUserUnbondingDelegatorPrefix(delegatorAddress))
+}
+
+// GetUserUnbondingsForDelegatorAndChain returns the user unbondings of a delegator on a host chain, ordered by epoch
+func (k *Keeper) GetUserUnbondingsForDelegatorAndChain(
+	ctx sdk.Context,
+	delegatorAddress string,
+	chainID string,
+) []*types.UserUnbonding {
+	return k.getUserUnbondingsWithPrefix(ctx, types.GetUserUnbondingDelegatorChainPrefix(delegatorAddress, chainID))
+}
+
+// GetUserUnbondingsForEpoch returns the user unbondings of a host chain epoch through the epoch index
+func (k *Keeper) GetUserUnbondingsForEpoch(ctx sdk.Context, chainID string, epochNumber int64) []*types.UserUnbonding {
+	indexPrefix := types.GetUserUnbondingEpochIndexPrefix(chainID, epochNumber)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.UserUnbondingEpochIndexKey)
+	iterator := sdk.KVStorePrefixIterator(indexStore, indexPrefix)
+	defer iterator.Close()
+
+	userUnbondings := make([]*types.UserUnbonding, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		delegatorAddress := string(iterator.Key()[len(indexPrefix):])
+		if userUnbonding, found := k.GetUserUnbonding(ctx, chainID, delegatorAddress, epochNumber); found {
+			userUnbondings = append(userUnbondings, userUnbonding)
+		}
+	}
+
+	return userUnbondings
+}
+
+func (k *Keeper) getUserUnbondingsWithPrefix(ctx sdk.Context, keyPrefix []byte) []*types.UserUnbonding {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UserUnbondingKey)
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+
+	userUnbondings := make([]*types.UserUnbonding, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		userUnbonding := types.UserUnbonding{}
+		k.cdc.MustUnmarshal(iterator.Value(), &userUnbonding)
+		userUnbondings = append(userUnbondings, &userUnbonding)
+	}
+
+	return userUnbondings
 }
 
 func (k *Keeper) FilterUserUnbondings(ctx sdk.Context, filter func(u types.UserUnbonding) bool) []*types.UserUnbonding {
@@ -134,3 +134,41 @@ func (suite *IntegrationTestSuite) TestIncreaseUserUnbondingAmountForEpoch() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestUserUnbondingKeyRanges() {
+	k := suite.app.LiquidStakeIBCKeeper
+	otherAddress := "persistence1gztc3y3k52hjds5nqvl7h9jvfnc33spz47zcjy"
+
+	for _, ub := range []*types.UserUnbonding{
+		{ChainId: suite.chainB.ChainID, Address: TestAddress, EpochNumber: 12},
+		{ChainId: suite.chainB.ChainID, Address: TestAddress, EpochNumber: 4},
+		{ChainId: suite.chainC.ChainID, Address: TestAddress, EpochNumber: 8},
+		{ChainId: suite.chainB.ChainID, Address: otherAddress, EpochNumber: 4},
+	} {
+		k.SetUserUnbonding(suite.ctx, ub)
+	}
+
+	// the unbondings of a delegator are ordered by host chain and epoch
+	unbondings := k.GetUserUnbondingsForDelegatorAndChain(suite.ctx, TestAddress, suite.chainB.ChainID)
+	suite.Require().Equal(2, len(unbondings))
+	suite.Require().Equal(int64(4), unbondings[0].EpochNumber)
+	suite.Require().Equal(int64(12), unbondings[1].EpochNumber)
+
+	unbondings = k.GetUserUnbondingsForDelegator(suite.ctx, TestAddress)
+	suite.Require().Equal(3, len(unbondings))
+	for _, ub := range unbondings {
+		suite.Require().Equal(TestAddress, ub.Address)
+	}
+
+	// the epoch index only returns the delegators of the epoch
+	unbondings = k.GetUserUnbondingsForEpoch(suite.ctx, suite.chainB.ChainID, 4)
+	suite.Require().Equal(2, len(unbondings))
+	for _, ub := range unbondings {
+		suite.Require().Equal(suite.chainB.ChainID, ub.ChainId)
+		suite.Require().Equal(int64(4), ub.EpochNumber)
+	}
+
+	// deleting an unbonding removes it from the epoch index
+	k.DeleteUserUnbonding(suite.ctx, unbondings[0])
+	suite.Require().Equal(1, len(k.GetUserUnbondingsForEpoch(suite.ctx, suite.chainB.ChainID, 4)))
+}
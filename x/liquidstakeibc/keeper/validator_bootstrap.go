@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// SetValidatorBootstrap stores the size of the validator set a host chain is pending to be bootstrapped with
+func (k *Keeper) SetValidatorBootstrap(ctx sdk.Context, chainID string, size uint32) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorBootstrapKey)
+	store.Set([]byte(chainID), sdk.Uint64ToBigEndian(uint64(size)))
+}
+
+// GetValidatorBootstrap returns the size of the validator set a host chain is pending to be bootstrapped with
+func (k *Keeper) GetValidatorBootstrap(ctx sdk.Context, chainID string) (uint32, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorBootstrapKey)
+	bz := store.Get([]byte(chainID))
+	if bz == nil {
+		return 0, false
+	}
+
+	return uint32(sdk.BigEndianToUint64(bz)), true
+}
+
+// DeleteValidatorBootstrap removes the pending validator set bootstrap of a host chain
+func (k *Keeper) DeleteValidatorBootstrap(ctx sdk.Context, chainID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorBootstrapKey)
+	store.Delete([]byte(chainID))
+}
+
+// BootstrapHostChainValidators adds the top size bonded validators by tokens to an empty host chain validator set,
+// at equal weights. As the validator set is not proven, an ICQ is sent for each validator to verify its state.
+func (k *Keeper) BootstrapHostChainValidators(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	hostValidators []stakingtypes.Validator,
+	size uint32,
+) error {
+	// validators added in the meantime take precedence over the bootstrap
+	if len(hc.Validators) > 0 {
+		k.Logger(ctx).Info(
+			"skipping validator set bootstrap, host chain already has validators",
+			"host_chain",
+			hc.ChainId,
+		)
+		return nil
+	}
+
+	bonded := make([]stakingtypes.Validator, 0, len(hostValidators))
+	for _, validator := range hostValidators {
+		if validator.IsBonded() && !validator.IsJailed() {
+			bonded = append(bonded, validator)
+		}
+	}
+	sort.SliceStable(bonded, func(i, j int) bool {
+		if !bonded[i].Tokens.Equal(bonded[j].Tokens) {
+			return bonded[i].Tokens.GT(bonded[j].Tokens)
+		}
+		return bonded[i].OperatorAddress < bonded[j].OperatorAddress
+	})
+	if uint32(len(bonded)) > size {
+		bonded = bonded[:size]
+	}
+	if len(bonded) == 0 {
+		return nil
+	}
+
+	// the last validator takes the rounding remainder, so that the weights add up to one
+	weight := sdk.OneDec().QuoInt64(int64(len(bonded)))
+	remainder := sdk.OneDec().Sub(weight.MulInt64(int64(len(bonded) - 1)))
+	for i, validator := range bonded {
+		validatorWeight := weight
+		if i == len(bonded)-1 {
+			validatorWeight = remainder
+		}
+
+		exchangeRate := sdk.OneDec()
+		if !validator.DelegatorShares.IsZero() {
+			exchangeRate = sdk.NewDecFromInt(validator.Tokens).Quo(validator.DelegatorShares)
+		}
+
+		setValidator(ctx, hc, &types.Validator{
+			OperatorAddress:        validator.OperatorAddress,
+			Status:                 validator.Status.String(),
+			Weight:                 validatorWeight,
+			DelegatedAmount:        sdk.ZeroInt(),
+			ExchangeRate:           exchangeRate,
+			Delegable:              true,
+			LsmCapacity:            sdk.ZeroInt(),
+			CommissionRate:         validator.Commission.CommissionRates.Rate,
+			CommissionUpdateHeight: ctx.BlockHeight(),
+		})
+
+		if err := k.QueryHostChainValidator(ctx, hc, validator.OperatorAddress); err != nil {
+			return err
+		}
+	}
+
+	// the weights only add up to one once every validator is added
+	if err := k.SetHostChain(ctx, hc); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorSetBootstrapped,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeBootstrapValidators, strconv.Itoa(len(bonded))),
+		),
+	)
+
+	return nil
+}
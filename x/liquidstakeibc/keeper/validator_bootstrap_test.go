@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icqtypes "github.com/persistenceOne/persistence-sdk/v2/x/interchainquery/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestBootstrapValidatorsCallback() {
+	pstakeApp, ctx := suite.app, suite.ctx
+	k := pstakeApp.LiquidStakeIBCKeeper
+
+	msgServer := keeper.NewMsgServerImpl(k)
+	_, err := msgServer.RegisterHostChain(ctx, &types.MsgRegisterHostChain{
+		Authority:           suite.chainA.SenderAccount.GetAddress().String(),
+		ConnectionId:        suite.transferPathAC.EndpointA.ConnectionID,
+		DepositFee:          sdk.ZeroDec(),
+		RestakeFee:          sdk.ZeroDec(),
+		UnstakeFee:          sdk.ZeroDec(),
+		RedemptionFee:       sdk.ZeroDec(),
+		ChannelId:           suite.transferPathAC.EndpointA.ChannelID,
+		PortId:              suite.transferPathAC.EndpointA.ChannelConfig.PortID,
+		HostDenom:           "uosmo",
+		MinimumDeposit:      sdk.OneInt(),
+		UnbondingFactor:     4,
+		AutoCompoundFactor:  2,
+		BootstrapValidators: 3,
+	})
+	suite.Require().NoError(err)
+
+	chainID := suite.chainC.ChainID
+	size, found := k.GetValidatorBootstrap(ctx, chainID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(3), size)
+
+	var query icqtypes.Query
+	for _, q := range pstakeApp.InterchainQueryKeeper.AllQueries(ctx) {
+		if q.ChainId == chainID && q.CallbackId == keeper.BootstrapValidators {
+			query = q
+		}
+	}
+	suite.Require().Equal(types.StakingValidatorsQuery, query.QueryType)
+
+	newValidator := func(tokens int64, status stakingtypes.BondStatus, jailed bool) stakingtypes.Validator {
+		return stakingtypes.Validator{
+			OperatorAddress: sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			Status:          status,
+			Jailed:          jailed,
+			Tokens:          math.NewInt(tokens),
+			DelegatorShares: sdk.NewDec(tokens / 2),
+		}
+	}
+	hostValidators := []stakingtypes.Validator{
+		newValidator(100, stakingtypes.Bonded, false),
+		newValidator(500, stakingtypes.Bonded, false),
+		newValidator(900, stakingtypes.Unbonded, false),
+		newValidator(800, stakingtypes.Bonded, true),
+		newValidator(300, stakingtypes.Bonded, false),
+		newValidator(400, stakingtypes.Bonded, false),
+	}
+	data, err := pstakeApp.AppCodec().Marshal(&stakingtypes.QueryValidatorsResponse{Validators: hostValidators})
+	suite.Require().NoError(err)
+
+	suite.Require().Error(keeper.BootstrapValidatorsCallback(k, ctx, data, icqtypes.Query{ChainId: "invalid-1"}))
+	suite.Require().Error(keeper.BootstrapValidatorsCallback(k, ctx, []byte("invalid data"), query))
+	suite.Require().NoError(keeper.BootstrapValidatorsCallback(k, ctx, data, query))
+
+	// the top bonded, non-jailed validators are added at equal weights
+	hc, found := k.GetHostChain(ctx, chainID)
+	suite.Require().True(found)
+	suite.Require().Len(hc.Validators, 3)
+	weights := sdk.ZeroDec()
+	for i, expected := range []stakingtypes.Validator{hostValidators[1], hostValidators[5], hostValidators[4]} {
+		suite.Require().Equal(expected.OperatorAddress, hc.Validators[i].OperatorAddress)
+		suite.Require().Equal(stakingtypes.BondStatusBonded, hc.Validators[i].Status)
+		suite.Require().Equal(sdk.NewDec(2), hc.Validators[i].ExchangeRate)
+		suite.Require().True(hc.Validators[i].DelegatedAmount.IsZero())
+		weights = weights.Add(hc.Validators[i].Weight)
+	}
+	suite.Require().Equal(sdk.OneDec(), weights)
+
+	// each validator is verified through a proven store query
+	validatorQueries := 0
+	for _, q := range pstakeApp.InterchainQueryKeeper.AllQueries(ctx) {
+		if q.ChainId == chainID && q.CallbackId == keeper.Validator {
+			suite.Require().Equal(types.StakingStoreQuery, q.QueryType)
+			validatorQueries++
+		}
+	}
+	suite.Require().Equal(3, validatorQueries)
+
+	// the bootstrap only happens once
+	_, found = k.GetValidatorBootstrap(ctx, chainID)
+	suite.Require().False(found)
+	suite.Require().NoError(keeper.BootstrapValidatorsCallback(k, ctx, data, query))
+	hc, _ = k.GetHostChain(ctx, chainID)
+	suite.Require().Len(hc.Validators, 3)
+}
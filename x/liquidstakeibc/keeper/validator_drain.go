@@ -0,0 +1,250 @@
+package keeper
+
+import (
+	"sort"
+	"strconv"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// SetValidatorDrain stores the drain progress of a zero weight validator
+func (k *Keeper) SetValidatorDrain(ctx sdk.Context, drain *types.ValidatorDrain) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorDrainKey)
+	bytes := k.cdc.MustMarshal(drain)
+	store.Set(types.GetValidatorDrainStoreKey(drain.ChainId, drain.ValidatorAddress), bytes)
+}
+
+// GetValidatorDrain returns the drain progress of a zero weight validator
+func (k *Keeper) GetValidatorDrain(ctx sdk.Context, chainID, validatorAddress string) (*types.ValidatorDrain, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorDrainKey)
+	bytes := store.Get(types.GetValidatorDrainStoreKey(chainID, validatorAddress))
+	if len(bytes) == 0 {
+		return &types.ValidatorDrain{}, false
+	}
+
+	var drain types.ValidatorDrain
+	k.cdc.MustUnmarshal(bytes, &drain)
+	return &drain, true
+}
+
+// GetAllValidatorDrains returns the drains of all the host chain validators
+func (k *Keeper) GetAllValidatorDrains(ctx sdk.Context) []*types.ValidatorDrain {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorDrainKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	drains := make([]*types.ValidatorDrain, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		drain := types.ValidatorDrain{}
+		k.cdc.MustUnmarshal(iterator.Value(), &drain)
+		drains = append(drains, &drain)
+	}
+
+	return drains
+}
+
+// GetValidatorDrainsForHostChain returns the drains of the validators of a host chain
+func (k *Keeper) GetValidatorDrainsForHostChain(ctx sdk.Context, chainID string) []*types.ValidatorDrain {
+	drains := make([]*types.ValidatorDrain, 0)
+	for _, drain := range k.GetAllValidatorDrains(ctx) {
+		if drain.ChainId == chainID {
+			drains = append(drains, drain)
+		}
+	}
+
+	return drains
+}
+
+// DeleteValidatorDrain removes the drain of a validator
+func (k *Keeper) DeleteValidatorDrain(ctx sdk.Context, drain *types.ValidatorDrain) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorDrainKey)
+	store.Delete(types.GetValidatorDrainStoreKey(drain.ChainId, drain.ValidatorAddress))
+}
+
+// DrainZeroWeightValidatorsWorkflow redelegates the delegations left on zero weight validators to the validators
+// furthest below their weight, up to the max drain per epoch of each host chain.
+func (k *Keeper) DrainZeroWeightValidatorsWorkflow(ctx sdk.Context, epoch int64) {
+	k.ClearWorkflowFailures(ctx, types.WorkflowDrain)
+
+	for _, hc := range k.GetAllHostChains(ctx) {
+		k.RunHostChainWorkflow(ctx, types.WorkflowDrain, hc.ChainId, epoch, func(ctx sdk.Context) error {
+			if !hc.IsActive() || hc.Degraded || hc.Flags.RedelegationsPaused {
+				return nil
+			}
+
+			// like the rebalance, stay away from the delegations being undelegated in the same epoch
+			if hc.IsUnbondingEpoch(epoch) {
+				return nil
+			}
+
+			k.UpdateValidatorDrains(ctx, hc, epoch)
+			if !hc.IsDrainingZeroWeightValidators() {
+				return nil
+			}
+
+			for _, msg := range k.GenerateDrainRedelegateMsgs(ctx, hc) {
+				ibcSeq, err := k.GenerateAndExecuteICATx(ctx, hc.ConnectionId, hc.DelegationAccount.Owner, []proto.Message{msg})
+				if err != nil {
+					k.Logger(ctx).Error("Failed to submit ica drain redelegate txn.", "host_chain", hc.ChainId, "err", err)
+					k.QueueICATxRetry(ctx, hc, types.ICATxRetry_RETRY_REDELEGATION, epoch, []proto.Message{msg}, err)
+					continue
+				}
+				k.SetRedelegationTx(ctx, &types.RedelegateTx{
+					ChainId:       hc.ChainId,
+					IbcSequenceId: ibcSeq,
+					State:         types.RedelegateTx_REDELEGATE_SENT,
+				})
+
+				drain, found := k.GetValidatorDrain(ctx, hc.ChainId, msg.ValidatorSrcAddress)
+				if found {
+					drain.RedelegatedAmount = drain.RedelegatedAmount.Add(msg.Amount.Amount)
+					drain.LastEpoch = epoch
+					k.SetValidatorDrain(ctx, drain)
+				}
+
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeValidatorDrain,
+						sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+						sdk.NewAttribute(types.AttributeValidatorSrcAddress, msg.ValidatorSrcAddress),
+						sdk.NewAttribute(types.AttributeValidatorDstAddress, msg.ValidatorDstAddress),
+						sdk.NewAttribute(types.AttributeRedelegatedAmount, msg.Amount.String()),
+						sdk.NewAttribute(types.AttributeEpoch, strconv.FormatInt(epoch, 10)),
+						sdk.NewAttribute(types.AttributeIBCSequenceID, ibcSeq),
+					),
+				)
+			}
+
+			return nil
+		})
+	}
+}
+
+// UpdateValidatorDrains tracks the zero weight validators of a host chain that still hold a delegation, and drops
+// the drains of the validators that were emptied or got a weight back
+func (k *Keeper) UpdateValidatorDrains(ctx sdk.Context, hc *types.HostChain, epoch int64) {
+	// nothing is tracked while the drain is disabled
+	validators := make([]*types.Validator, 0)
+	if hc.IsDrainingZeroWeightValidators() {
+		validators = hc.GetDrainableValidators()
+	}
+
+	draining := make(map[string]bool)
+	for _, validator := range validators {
+		draining[validator.OperatorAddress] = true
+	}
+
+	for _, drain := range k.GetValidatorDrainsForHostChain(ctx, hc.ChainId) {
+		if draining[drain.ValidatorAddress] {
+			continue
+		}
+
+		k.DeleteValidatorDrain(ctx, drain)
+
+		validator, found := hc.GetValidator(drain.ValidatorAddress)
+		if !found || validator.DelegatedAmount.IsZero() {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeValidatorDrained,
+					sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+					sdk.NewAttribute(types.AttributeValidatorAddress, drain.ValidatorAddress),
+					sdk.NewAttribute(types.AttributeRedelegatedAmount, drain.RedelegatedAmount.String()),
+					sdk.NewAttribute(types.AttributeEpoch, strconv.FormatInt(epoch, 10)),
+				),
+			)
+		}
+	}
+
+	for _, validator := range validators {
+		drain, found := k.GetValidatorDrain(ctx, hc.ChainId, validator.OperatorAddress)
+		if !found {
+			drain = &types.ValidatorDrain{
+				ChainId:           hc.ChainId,
+				ValidatorAddress:  validator.OperatorAddress,
+				InitialAmount:     validator.DelegatedAmount,
+				RedelegatedAmount: math.ZeroInt(),
+				StartEpoch:        epoch,
+			}
+		}
+		drain.RemainingAmount = validator.DelegatedAmount
+		k.SetValidatorDrain(ctx, drain)
+	}
+}
+
+// GenerateDrainRedelegateMsgs builds the redelegations moving at most the max drain per epoch away from the zero
+// weight validators, filling first the bonded delegable validators furthest below their weight.
+func (k *Keeper) GenerateDrainRedelegateMsgs(ctx sdk.Context, hc *types.HostChain) []*stakingtypes.MsgBeginRedelegate {
+	budget := hc.Params.MaxDrainPerEpoch
+	totalDelegations := hc.GetHostChainTotalDelegations()
+
+	targets := make([]delegation, 0)
+	for _, validator := range hc.Validators {
+		if !validator.Weight.IsPositive() || !validator.Delegable ||
+			validator.Status != stakingtypes.Bonded.String() {
+			continue
+		}
+
+		// the diff of a target is the amount it is missing to reach its weight
+		missing := validator.Weight.MulInt(totalDelegations).TruncateInt().Sub(validator.DelegatedAmount)
+		if missing.IsPositive() {
+			targets = append(targets, delegation{validator: validator.OperatorAddress, diff: missing})
+		}
+	}
+	sort.SliceStable(targets, func(i, j int) bool {
+		if !targets[i].diff.Equal(targets[j].diff) {
+			return targets[i].diff.GT(targets[j].diff)
+		}
+		return targets[i].validator < targets[j].validator
+	})
+
+	redelegations, ok := k.GetRedelegations(ctx, hc.ChainId)
+	if !ok {
+		redelegations = &types.Redelegations{ChainID: hc.ChainId}
+	}
+
+	msgs := make([]*stakingtypes.MsgBeginRedelegate, 0)
+	for _, validator := range hc.GetDrainableValidators() {
+		if !budget.IsPositive() {
+			break
+		}
+
+		// tokens redelegated to the validator can't be redelegated again until the redelegation completes
+		if k.RedelegationExistsToValidator(redelegations.Redelegations, validator.OperatorAddress) {
+			continue
+		}
+
+		amount := math.MinInt(validator.DelegatedAmount, budget)
+		for i := range targets {
+			if !amount.IsPositive() {
+				break
+			}
+			if !targets[i].diff.IsPositive() {
+				continue
+			}
+
+			_, entries := k.RedelegationFromAToB(redelegations.Redelegations, validator.OperatorAddress, targets[i].validator)
+			if entries >= hc.Params.MaxEntries {
+				continue
+			}
+
+			redelegationAmt := math.MinInt(amount, targets[i].diff)
+			msgs = append(msgs, &stakingtypes.MsgBeginRedelegate{
+				DelegatorAddress:    hc.DelegationAccount.Address,
+				ValidatorSrcAddress: validator.OperatorAddress,
+				ValidatorDstAddress: targets[i].validator,
+				Amount:              sdk.NewCoin(hc.HostDenom, redelegationAmt),
+			})
+			amount = amount.Sub(redelegationAmt)
+			budget = budget.Sub(redelegationAmt)
+			targets[i].diff = targets[i].diff.Sub(redelegationAmt)
+		}
+	}
+
+	return msgs
+}
@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestDrainZeroWeightValidators() {
+	ctx, _ := suite.ctx.CacheContext()
+	k := suite.app.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	newValidator := func(address, weight string, delegation int64) *types.Validator {
+		return &types.Validator{
+			OperatorAddress: address,
+			Status:          stakingtypes.Bonded.String(),
+			Weight:          sdk.MustNewDecFromStr(weight),
+			DelegatedAmount: sdk.NewInt(delegation),
+			ExchangeRate:    sdk.OneDec(),
+			Delegable:       true,
+		}
+	}
+	hc.Validators = []*types.Validator{
+		newValidator("valA", "0", 1000),
+		newValidator("valB", "0.6", 1000),
+		newValidator("valC", "0.4", 1000),
+	}
+	hc.Params.MaxEntries = 7
+	hc.Params.RedelegationAcceptableDelta = sdk.NewInt(1000000)
+	hc.Params.MaxDrainPerEpoch = sdk.NewInt(900)
+	k.SetHostChain(ctx, hc)
+
+	// the drain fills the validator furthest below its weight first, up to the max drain per epoch
+	msgs := k.GenerateDrainRedelegateMsgs(ctx, hc)
+	suite.Require().Equal([]*stakingtypes.MsgBeginRedelegate{{
+		DelegatorAddress:    hc.DelegationAccount.Address,
+		ValidatorSrcAddress: "valA",
+		ValidatorDstAddress: "valB",
+		Amount:              sdk.NewCoin(hc.HostDenom, sdk.NewInt(800)),
+	}, {
+		DelegatorAddress:    hc.DelegationAccount.Address,
+		ValidatorSrcAddress: "valA",
+		ValidatorDstAddress: "valC",
+		Amount:              sdk.NewCoin(hc.HostDenom, sdk.NewInt(100)),
+	}}, msgs)
+
+	// the rebalance leaves the zero weight validator to the drain
+	hc.Params.RedelegationAcceptableDelta = sdk.OneInt()
+	suite.Require().Len(k.GenerateRedelegateMsgs(ctx, *hc), 0)
+
+	epoch := hc.UnbondingFactor + 1
+	k.DrainZeroWeightValidatorsWorkflow(ctx, epoch)
+
+	drain, found := k.GetValidatorDrain(ctx, hc.ChainId, "valA")
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(sdk.NewInt(1000), drain.InitialAmount)
+	suite.Require().Equal(sdk.NewInt(1000), drain.RemainingAmount)
+	suite.Require().Equal(sdk.NewInt(900), drain.RedelegatedAmount)
+	suite.Require().Equal(epoch, drain.StartEpoch)
+	suite.Require().Equal(epoch, drain.LastEpoch)
+
+	res, err := k.ValidatorDrains(sdk.WrapSDKContext(ctx), &types.QueryValidatorDrainsRequest{ChainId: hc.ChainId})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.ValidatorDrain{drain}, res.Drains)
+
+	// nothing is drained on unbonding epochs
+	k.DrainZeroWeightValidatorsWorkflow(ctx, hc.UnbondingFactor*2)
+	drain, _ = k.GetValidatorDrain(ctx, hc.ChainId, "valA")
+	suite.Require().Equal(epoch, drain.LastEpoch)
+
+	// once the validator is emptied its drain is dropped
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	hc.Validators[0].DelegatedAmount = sdk.ZeroInt()
+	k.SetHostChain(ctx, hc)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.DrainZeroWeightValidatorsWorkflow(ctx, epoch+1)
+	_, found = k.GetValidatorDrain(ctx, hc.ChainId, "valA")
+	suite.Require().Equal(false, found)
+
+	drained := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeValidatorDrained {
+			drained = true
+		}
+	}
+	suite.Require().Equal(true, drained)
+}
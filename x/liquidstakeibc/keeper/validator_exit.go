@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// SetValidatorExit stores a pending total unbonding of a host chain validator
+func (k *Keeper) SetValidatorExit(ctx sdk.Context, exit *types.ValidatorExit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorExitKey)
+	bytes := k.cdc.MustMarshal(exit)
+	store.Set(types.GetValidatorExitStoreKey(exit.ChainId, exit.ValidatorAddress), bytes)
+}
+
+// GetValidatorExit returns the pending total unbonding of a host chain validator
+func (k *Keeper) GetValidatorExit(ctx sdk.Context, chainID, validatorAddress string) (*types.ValidatorExit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorExitKey)
+	bytes := store.Get(types.GetValidatorExitStoreKey(chainID, validatorAddress))
+	if len(bytes) == 0 {
+		return &types.ValidatorExit{}, false
+	}
+
+	var exit types.ValidatorExit
+	k.cdc.MustUnmarshal(bytes, &exit)
+	return &exit, true
+}
+
+// GetAllValidatorExits returns the pending total unbondings of all host chain validators
+func (k *Keeper) GetAllValidatorExits(ctx sdk.Context) []*types.ValidatorExit {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorExitKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	exits := make([]*types.ValidatorExit, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		exit := types.ValidatorExit{}
+		k.cdc.MustUnmarshal(iterator.Value(), &exit)
+		exits = append(exits, &exit)
+	}
+
+	return exits
+}
+
+// GetValidatorExitsForHostChain returns the pending total validator unbondings of a host chain
+func (k *Keeper) GetValidatorExitsForHostChain(ctx sdk.Context, chainID string) []*types.ValidatorExit {
+	exits := make([]*types.ValidatorExit, 0)
+	for _, exit := range k.GetAllValidatorExits(ctx) {
+		if exit.ChainId == chainID {
+			exits = append(exits, exit)
+		}
+	}
+
+	return exits
+}
+
+// DeleteValidatorExit removes a pending total validator unbonding
+func (k *Keeper) DeleteValidatorExit(ctx sdk.Context, exit *types.ValidatorExit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorExitKey)
+	store.Delete(types.GetValidatorExitStoreKey(exit.ChainId, exit.ValidatorAddress))
+}
+
+// QueueValidatorExit returns the pending exit of a validator which reached the unbonding state epoch limit,
+// queueing it behind the configured exit delay if it was not queued yet.
+func (k *Keeper) QueueValidatorExit(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	validator *types.Validator,
+	epoch int64,
+) *types.ValidatorExit {
+	exit, found := k.GetValidatorExit(ctx, hc.ChainId, validator.OperatorAddress)
+	if found {
+		return exit
+	}
+
+	exit = &types.ValidatorExit{
+		ChainId:          hc.ChainId,
+		ValidatorAddress: validator.OperatorAddress,
+		QueuedEpoch:      epoch,
+		ExecuteEpoch:     epoch + int64(k.GetParams(ctx).ValidatorExitDelayEpochs),
+	}
+	k.SetValidatorExit(ctx, exit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorExitQueued,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeValidatorAddress, validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeEpoch, strconv.FormatInt(epoch, 10)),
+			sdk.NewAttribute(types.AttributeValidatorExitEpoch, strconv.FormatInt(exit.ExecuteEpoch, 10)),
+		),
+	)
+
+	return exit
+}
+
+// ExecuteValidatorExit undelegates all the tokens delegated to a validator, tracking them in a validator unbonding of
+// the epoch, removes its pending exit and redistributes its weight among the other validators with weight
+func (k *Keeper) ExecuteValidatorExit(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	validator *types.Validator,
+	epoch int64,
+) error {
+	// unbond all delegated tokens from the validator
+	validatorUnbonding := &types.ValidatorUnbonding{
+		ChainId:          hc.ChainId,
+		EpochNumber:      epoch,
+		MatureTime:       time.Time{},
+		ValidatorAddress: validator.OperatorAddress,
+		Amount:           sdk.NewCoin(hc.HostDenom, validator.DelegatedAmount),
+	}
+
+	// create the MsgUndelegate
+	message := &stakingtypes.MsgUndelegate{
+		DelegatorAddress: hc.DelegationAccount.Address,
+		ValidatorAddress: validatorUnbonding.ValidatorAddress,
+		Amount:           validatorUnbonding.Amount,
+	}
+
+	// execute the ICA transaction
+	sequenceID, err := k.GenerateAndExecuteICATx(
+		ctx,
+		hc.ConnectionId,
+		hc.DelegationAccount.Owner,
+		[]proto.Message{message},
+	)
+	if err != nil {
+		return err
+	}
+
+	// update the unbonding sequence id
+	validatorUnbonding.IbcSequenceId = sequenceID
+	k.SetValidatorUnbonding(ctx, validatorUnbonding)
+	if exit, found := k.GetValidatorExit(ctx, hc.ChainId, validator.OperatorAddress); found {
+		k.DeleteValidatorExit(ctx, exit)
+	}
+
+	// redistribute the unbonding validator weight among all the other validators with weight
+	if err := k.RedistributeValidatorWeight(ctx, hc, validator); err != nil {
+		k.Logger(ctx).Error(
+			"could not redistribute the unbonding validator weight",
+			"host_chain",
+			hc.ChainId,
+			"validator",
+			validator.OperatorAddress,
+			"err",
+			err.Error(),
+		)
+	}
+
+	telemetry.IncrCounter(float32(1), hc.ChainId, "validator_unbondings")
+
+	k.Logger(ctx).Info(
+		"Started total validator unbonding.",
+		"host_chain",
+		hc.ChainId,
+		"validator",
+		validatorUnbonding.ValidatorAddress,
+		"amount",
+		validatorUnbonding.Amount,
+		"epoch",
+		epoch,
+	)
+
+	// emit the validator unbonding event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorUndelegationWorkflow,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeEpoch, strconv.FormatInt(epoch, 10)),
+			sdk.NewAttribute(types.AttributeValidatorAddress, validatorUnbonding.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeValidatorUnbondingAmount, sdk.NewCoin(hc.HostDenom, validatorUnbonding.Amount.Amount).String()),
+			sdk.NewAttribute(types.AttributeIBCSequenceID, sequenceID),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestValidatorExitQueue() {
+	k := suite.app.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	params := k.GetParams(suite.ctx)
+	params.ValidatorExitDelayEpochs = uint64(hc.UnbondingFactor)
+	k.SetParams(suite.ctx, params)
+
+	epoch := hc.UnbondingFactor * 10
+	validator := hc.Validators[0]
+	validator.UnbondingEpoch = epoch - hc.UnbondingFactor*types.UnbondingStateEpochLimit
+	k.SetHostChainValidator(suite.ctx, hc, validator)
+
+	// the exit is queued instead of being executed
+	k.ValidatorUndelegationWorkflow(suite.ctx, epoch)
+	exit, found := k.GetValidatorExit(suite.ctx, hc.ChainId, validator.OperatorAddress)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(epoch, exit.QueuedEpoch)
+	suite.Require().Equal(epoch+hc.UnbondingFactor, exit.ExecuteEpoch)
+	_, found = k.GetValidatorUnbonding(suite.ctx, hc.ChainId, validator.OperatorAddress, epoch)
+	suite.Require().Equal(false, found)
+
+	res, err := k.ValidatorExits(sdk.WrapSDKContext(suite.ctx), &types.QueryValidatorExitsRequest{ChainId: hc.ChainId})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.ValidatorExit{exit}, res.Exits)
+
+	// only a module authority can cancel the exit
+	msgServer := keeper.NewMsgServerImpl(k)
+	_, err = msgServer.CancelValidatorExit(suite.ctx, &types.MsgCancelValidatorExit{
+		Authority:        authtypes.NewModuleAddress("not_authority").String(),
+		ChainId:          hc.ChainId,
+		ValidatorAddress: validator.OperatorAddress,
+	})
+	suite.Require().Error(err)
+
+	cacheCtx, _ := suite.ctx.CacheContext()
+	_, err = msgServer.CancelValidatorExit(cacheCtx, &types.MsgCancelValidatorExit{
+		Authority:        params.AdminAddress,
+		ChainId:          hc.ChainId,
+		ValidatorAddress: validator.OperatorAddress,
+	})
+	suite.Require().NoError(err)
+	_, found = k.GetValidatorExit(cacheCtx, hc.ChainId, validator.OperatorAddress)
+	suite.Require().Equal(false, found)
+	hc, _ = k.GetHostChain(cacheCtx, hc.ChainId)
+	cancelled, _ := hc.GetValidator(validator.OperatorAddress)
+	suite.Require().Equal(int64(0), cancelled.UnbondingEpoch)
+
+	// once the window is over the validator is unbonded and the exit leaves the queue
+	k.ValidatorUndelegationWorkflow(suite.ctx, epoch+hc.UnbondingFactor)
+	_, found = k.GetValidatorExit(suite.ctx, hc.ChainId, validator.OperatorAddress)
+	suite.Require().Equal(false, found)
+	_, found = k.GetValidatorUnbonding(suite.ctx, hc.ChainId, validator.OperatorAddress, epoch+hc.UnbondingFactor)
+	suite.Require().Equal(true, found)
+}
+
+func (suite *IntegrationTestSuite) TestJailedValidatorExit() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	suite.Require().GreaterOrEqual(len(hc.Validators), 2)
+
+	validator := hc.Validators[0]
+	validator.DelegatedAmount = sdk.NewInt(1000)
+	k.SetHostChainValidator(ctx, hc, validator)
+	suite.Require().True(validator.Weight.IsPositive())
+
+	validatorUpdate := stakingtypes.Validator{
+		OperatorAddress:     validator.OperatorAddress,
+		Jailed:              true,
+		Status:              stakingtypes.Unbonding,
+		Tokens:              sdk.ZeroInt(),
+		DelegatorShares:     sdk.ZeroDec(),
+		LiquidShares:        sdk.ZeroDec(),
+		ValidatorBondShares: sdk.ZeroDec(),
+	}
+
+	// the jailed validator loses its weight and its exit is queued without waiting for the unbonding state limit
+	suite.Require().NoError(k.ProcessHostChainValidatorUpdates(ctx, hc, validatorUpdate))
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	jailed, _ := hc.GetValidator(validator.OperatorAddress)
+	suite.Require().True(jailed.Jailed)
+	suite.Require().True(jailed.Weight.IsZero())
+
+	totalWeight := sdk.ZeroDec()
+	for _, val := range hc.Validators {
+		totalWeight = totalWeight.Add(val.Weight)
+	}
+	suite.Require().Equal(sdk.OneDec(), totalWeight)
+
+	exit, found := k.GetValidatorExit(ctx, hc.ChainId, validator.OperatorAddress)
+	suite.Require().True(found)
+	suite.Require().Equal(k.GetEpochNumber(ctx, types.UndelegationEpoch), exit.QueuedEpoch)
+
+	jailedEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeValidatorJailed {
+			jailedEvents++
+		}
+	}
+	suite.Require().Equal(1, jailedEvents)
+
+	// the following reports of the jailed validator don't queue it again
+	cacheCtx, _ := ctx.CacheContext()
+	k.DeleteValidatorExit(cacheCtx, exit)
+	suite.Require().NoError(k.ProcessHostChainValidatorUpdates(cacheCtx, hc, validatorUpdate))
+	_, found = k.GetValidatorExit(cacheCtx, hc.ChainId, validator.OperatorAddress)
+	suite.Require().False(found)
+
+	// the queued exit is executed on the first unbonding epoch after its delay
+	epoch := hc.CurrentUnbondingEpoch(exit.ExecuteEpoch)
+	k.ValidatorUndelegationWorkflow(ctx, epoch)
+	_, found = k.GetValidatorExit(ctx, hc.ChainId, validator.OperatorAddress)
+	suite.Require().False(found)
+	_, found = k.GetValidatorUnbonding(ctx, hc.ChainId, validator.OperatorAddress, epoch)
+	suite.Require().True(found)
+}
+
+func (suite *IntegrationTestSuite) TestExitValidator() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	suite.Require().GreaterOrEqual(len(hc.Validators), 2)
+
+	validator := hc.Validators[0]
+	validator.DelegatedAmount = sdk.NewInt(1000)
+	suite.Require().NoError(k.SetHostChainValidator(ctx, hc, validator))
+	suite.Require().True(validator.Weight.IsPositive())
+
+	undelegated := hc.Validators[1]
+	undelegated.DelegatedAmount = sdk.ZeroInt()
+	suite.Require().NoError(k.SetHostChainValidator(ctx, hc, undelegated))
+
+	epoch := k.GetEpochNumber(ctx, types.UndelegationEpoch)
+	k.QueueValidatorExit(ctx, hc, validator, epoch)
+
+	authority := k.GetParams(ctx).AdminAddress
+	_, err := msgServer.ExitValidator(ctx, &types.MsgExitValidator{
+		Authority:        authtypes.NewModuleAddress("not_authority").String(),
+		ChainId:          hc.ChainId,
+		ValidatorAddress: validator.OperatorAddress,
+	})
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.ExitValidator(ctx, &types.MsgExitValidator{
+		Authority:        authority,
+		ChainId:          hc.ChainId,
+		ValidatorAddress: "unknown",
+	})
+	suite.Require().ErrorIs(err, types.ErrValidatorNotFound)
+
+	_, err = msgServer.ExitValidator(ctx, &types.MsgExitValidator{
+		Authority:        authority,
+		ChainId:          hc.ChainId,
+		ValidatorAddress: undelegated.OperatorAddress,
+	})
+	suite.Require().ErrorIs(err, types.ErrValidatorNotExitable)
+
+	// the exit goes through right away, skipping the queued exit delay
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := msgServer.ExitValidator(ctx, &types.MsgExitValidator{
+		Authority:        authority,
+		ChainId:          hc.ChainId,
+		ValidatorAddress: validator.OperatorAddress,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.HostDenom, 1000), res.UnbondingAmount)
+
+	validatorUnbonding, found := k.GetValidatorUnbonding(ctx, hc.ChainId, validator.OperatorAddress, epoch)
+	suite.Require().True(found)
+	suite.Require().Equal(res.UnbondingAmount, validatorUnbonding.Amount)
+	suite.Require().NotEmpty(validatorUnbonding.IbcSequenceId)
+	_, found = k.GetValidatorExit(ctx, hc.ChainId, validator.OperatorAddress)
+	suite.Require().False(found)
+
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	exited, _ := hc.GetValidator(validator.OperatorAddress)
+	suite.Require().True(exited.Weight.IsZero())
+	totalWeight := sdk.ZeroDec()
+	for _, val := range hc.Validators {
+		totalWeight = totalWeight.Add(val.Weight)
+	}
+	suite.Require().Equal(sdk.OneDec(), totalWeight)
+
+	forced := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeValidatorExitForced {
+			forced++
+		}
+	}
+	suite.Require().Equal(1, forced)
+
+	// a validator is exited once per epoch
+	_, err = msgServer.ExitValidator(ctx, &types.MsgExitValidator{
+		Authority:        authority,
+		ChainId:          hc.ChainId,
+		ValidatorAddress: validator.OperatorAddress,
+	})
+	suite.Require().ErrorIs(err, types.ErrValidatorNotExitable)
+}
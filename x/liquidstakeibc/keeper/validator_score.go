@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"bytes"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// ScoreValidatorsWorkflow scores the validators of the active host chains with the inputs reported by ICQ, and
+// queries the inputs again for the next rewards epoch
+func (k *Keeper) ScoreValidatorsWorkflow(ctx sdk.Context, epoch int64) {
+	k.Logger(ctx).Info("Running validator scoring workflow.", "epoch", epoch)
+	k.ClearWorkflowFailures(ctx, types.WorkflowScoring)
+
+	for _, hc := range k.GetAllHostChains(ctx) {
+		if !hc.IsActive() {
+			continue
+		}
+
+		k.RunHostChainWorkflow(ctx, types.WorkflowScoring, hc.ChainId, epoch, func(ctx sdk.Context) error {
+			return k.ScoreHostChainValidators(ctx, hc, epoch)
+		})
+	}
+}
+
+// ScoreHostChainValidators stores the performance score of every validator of a host chain and queries the
+// validators and their signing info
+func (k *Keeper) ScoreHostChainValidators(ctx sdk.Context, hc *types.HostChain, epoch int64) error {
+	for _, validator := range hc.Validators {
+		validator.Score = hc.ScoreValidator(validator, epoch)
+		if err := k.SetHostChainValidator(ctx, hc, validator); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeValidatorScoreUpdate,
+				sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+				sdk.NewAttribute(types.AttributeValidatorAddress, validator.OperatorAddress),
+				sdk.NewAttribute(types.AttributeKeyValidatorScore, validator.Score.Score.String()),
+				sdk.NewAttribute(types.AttributeEpoch, strconv.FormatInt(epoch, 10)),
+			),
+		)
+
+		if err := k.QueryHostChainValidator(ctx, hc, validator.OperatorAddress); err != nil {
+			return err
+		}
+
+		// the consensus address is only known once the validator ICQ reported it
+		if validator.ConsensusAddress != "" {
+			if err := k.QueryValidatorSigningInfo(ctx, hc, validator); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// signingInfoValidator finds the host chain validator whose signing info is stored under the queried key
+func signingInfoValidator(hc *types.HostChain, request []byte) (*types.Validator, bool) {
+	for _, validator := range hc.Validators {
+		if validator.ConsensusAddress == "" {
+			continue
+		}
+
+		_, consAddr, err := bech32.DecodeAndConvert(validator.ConsensusAddress)
+		if err != nil {
+			continue
+		}
+
+		if bytes.Equal(slashingtypes.ValidatorSigningInfoKey(consAddr), request) {
+			return validator, true
+		}
+	}
+
+	return nil, false
+}
+
+// ScoreWeightsWorkflow recomputes the validator weights of the score weighted active host chains from the validator
+// scores, before the delegations are rebalanced to them
+func (k *Keeper) ScoreWeightsWorkflow(ctx sdk.Context, epoch int64) {
+	k.Logger(ctx).Info("Running score weights workflow.", "epoch", epoch)
+	k.ClearWorkflowFailures(ctx, types.WorkflowScoreWeights)
+
+	for _, hc := range k.GetAllHostChains(ctx) {
+		if !hc.IsActive() || !hc.IsScoreWeighted() {
+			continue
+		}
+
+		k.RunHostChainWorkflow(ctx, types.WorkflowScoreWeights, hc.ChainId, epoch, func(ctx sdk.Context) error {
+			return k.UpdateScoreWeights(ctx, hc, epoch)
+		})
+	}
+}
+
+// UpdateScoreWeights sets the weights of the host chain validators to the ones computed from their scores. Validators
+// with a queued exit keep their zero weight.
+func (k *Keeper) UpdateScoreWeights(ctx sdk.Context, hc *types.HostChain, epoch int64) error {
+	exiting := make(map[string]bool)
+	for _, exit := range k.GetValidatorExitsForHostChain(ctx, hc.ChainId) {
+		exiting[exit.ValidatorAddress] = true
+	}
+
+	weights, err := hc.ScoreWeights(exiting)
+	if err != nil {
+		return err
+	}
+
+	for _, validator := range hc.Validators {
+		validator.Weight = weights[validator.OperatorAddress]
+	}
+	if err := hc.ValidateValidatorSet(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidValidatorSet, err.Error())
+	}
+	if err := k.SetHostChain(ctx, hc); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScoreWeightsUpdate,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeEpoch, strconv.FormatInt(epoch, 10)),
+		),
+	)
+
+	return nil
+}
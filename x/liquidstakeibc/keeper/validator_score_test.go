@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icqtypes "github.com/persistenceOne/persistence-sdk/v2/x/interchainquery/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestScoreValidators() {
+	pstakeApp := suite.app
+	k := pstakeApp.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	k.UpdateHostChainSlashingParams(ctx, hc, slashingtypes.Params{
+		SignedBlocksWindow:      100,
+		SlashFractionDoubleSign: sdk.ZeroDec(),
+		SlashFractionDowntime:   sdk.ZeroDec(),
+	})
+
+	consAddr := sdk.ConsAddress(bytes.Repeat([]byte{1}, 20))
+	validator := hc.Validators[0]
+	validator.ConsensusAddress = consAddr.String()
+	validator.CommissionRate = sdk.MustNewDecFromStr("0.1")
+	validator.Tokens = sdk.NewInt(1000)
+	k.SetHostChainValidator(ctx, hc, validator)
+
+	scored := func() *types.Validator {
+		hc, _ := k.GetHostChain(ctx, hc.ChainId)
+		validator, _ := hc.GetValidator(validator.OperatorAddress)
+		return validator
+	}
+
+	// without a previous score the token growth is perfect
+	k.ScoreValidatorsWorkflow(ctx, 1)
+	score := scored().Score
+	suite.Require().NotNil(score)
+	suite.Require().Equal(int64(1), score.Epoch)
+	suite.Require().Equal(sdk.OneDec(), score.Uptime)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.9"), score.Commission)
+	suite.Require().Equal(sdk.OneDec(), score.DelegationGrowth)
+	suite.Require().Equal(sdk.MustNewDecFromStr("2.9").QuoInt64(3), score.Score)
+
+	// the workflow queries the signing info of the validators with a known consensus address
+	var query icqtypes.Query
+	for _, q := range pstakeApp.InterchainQueryKeeper.AllQueries(ctx) {
+		if q.ChainId == hc.ChainId && q.CallbackId == keeper.SigningInfo {
+			query = q
+		}
+	}
+	suite.Require().Equal(slashingtypes.ValidatorSigningInfoKey(consAddr), []byte(query.Request))
+
+	signingInfo := pstakeApp.AppCodec().MustMarshal(&slashingtypes.ValidatorSigningInfo{
+		Address:             consAddr.String(),
+		MissedBlocksCounter: 10,
+	})
+	suite.Require().NoError(keeper.SigningInfoCallback(k, ctx, signingInfo, query))
+	suite.Require().Equal(int64(10), scored().MissedBlocksCounter)
+
+	// the next score accounts for the missed blocks and the lost tokens
+	validator = scored()
+	validator.Tokens = sdk.NewInt(500)
+	k.SetHostChainValidator(ctx, hc, validator)
+
+	k.ScoreValidatorsWorkflow(ctx, 2)
+	score = scored().Score
+	suite.Require().Equal(int64(2), score.Epoch)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.9"), score.Uptime)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.5"), score.DelegationGrowth)
+	suite.Require().Equal(sdk.NewInt(500), score.Tokens)
+	suite.Require().Equal(sdk.MustNewDecFromStr("2.3").QuoInt64(3), score.Score)
+
+	res, err := k.ValidatorScores(sdk.WrapSDKContext(ctx), &types.QueryValidatorScoresRequest{ChainId: hc.ChainId})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Validators, len(hc.Validators))
+	suite.Require().Equal(validator.OperatorAddress, res.Validators[0].OperatorAddress)
+	suite.Require().Equal(score, res.Validators[0].Score)
+	suite.Require().Equal(int64(10), res.Validators[0].MissedBlocksCounter)
+	suite.Require().Equal(int64(100), res.Validators[0].SignedBlocksWindow)
+
+	_, err = k.ValidatorScores(sdk.WrapSDKContext(ctx), &types.QueryValidatorScoresRequest{ChainId: "not-a-chain"})
+	suite.Require().Error(err)
+}
+
+func (suite *IntegrationTestSuite) TestScoreWeights() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	suite.Require().GreaterOrEqual(len(hc.Validators), 2)
+	for i, validator := range hc.Validators {
+		validator.Status = stakingtypes.BondStatusBonded
+		validator.Score = &types.ValidatorScore{Score: sdk.NewDec(int64(i + 1)).QuoInt64(10)}
+	}
+	hc.Params.MinActiveValidators = 0
+	hc.Params.MaxValidatorWeight = sdk.ZeroDec()
+	k.SetHostChain(ctx, hc)
+	validatorWeights := func() []sdk.Dec {
+		hc, _ := k.GetHostChain(ctx, hc.ChainId)
+		weights := make([]sdk.Dec, 0, len(hc.Validators))
+		for _, validator := range hc.Validators {
+			weights = append(weights, validator.Weight)
+		}
+		return weights
+	}
+	original := validatorWeights()
+
+	// host chains without a max score weight keep their weights
+	k.ScoreWeightsWorkflow(ctx, 1)
+	suite.Require().Equal(original, validatorWeights())
+
+	hc.Params.ScoreWeightMax = sdk.OneDec()
+	k.SetHostChain(ctx, hc)
+	k.ScoreWeightsWorkflow(ctx, 2)
+
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	total := sdk.ZeroDec()
+	for i, validator := range hc.Validators {
+		suite.Require().True(validator.Weight.GT(sdk.ZeroDec()))
+		if i > 0 {
+			suite.Require().True(validator.Weight.GT(hc.Validators[i-1].Weight))
+		}
+		total = total.Add(validator.Weight)
+	}
+	suite.Require().Equal(sdk.OneDec(), total)
+
+	// validators with a queued exit get no weight
+	exiting := hc.Validators[len(hc.Validators)-1]
+	k.QueueValidatorExit(ctx, hc, exiting, 3)
+	k.ScoreWeightsWorkflow(ctx, 3)
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	validator, _ := hc.GetValidator(exiting.OperatorAddress)
+	suite.Require().Equal(sdk.ZeroDec(), validator.Weight)
+
+	// bounds the validators can't meet are recorded as a workflow failure and leave the weights alone
+	scoreWeights := validatorWeights()
+	hc.Params.ScoreWeightMax = sdk.OneDec().QuoInt64(int64(len(hc.Validators)))
+	k.SetHostChain(ctx, hc)
+	k.ScoreWeightsWorkflow(ctx, 4)
+	suite.Require().Equal(scoreWeights, validatorWeights())
+	_, found = k.GetWorkflowFailure(ctx, hc.ChainId, types.WorkflowScoreWeights)
+	suite.Require().True(found)
+}
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// SetVestingLock stores the stk tokens held for the locked coins a vesting account deposited on a host chain
+func (k *Keeper) SetVestingLock(ctx sdk.Context, vestingLock *types.VestingLock) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VestingLockKey)
+	bytes := k.cdc.MustMarshal(vestingLock)
+	store.Set(types.GetVestingLockStoreKey(vestingLock.ChainId, vestingLock.Address), bytes)
+}
+
+// GetVestingLock returns the stk tokens held for the locked coins a vesting account deposited on a host chain
+func (k *Keeper) GetVestingLock(ctx sdk.Context, chainID, address string) (*types.VestingLock, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VestingLockKey)
+	bytes := store.Get(types.GetVestingLockStoreKey(chainID, address))
+	if len(bytes) == 0 {
+		return &types.VestingLock{}, false
+	}
+
+	var vestingLock types.VestingLock
+	k.cdc.MustUnmarshal(bytes, &vestingLock)
+	return &vestingLock, true
+}
+
+// GetAllVestingLocks returns every vesting lock
+func (k *Keeper) GetAllVestingLocks(ctx sdk.Context) []*types.VestingLock {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VestingLockKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	vestingLocks := make([]*types.VestingLock, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		vestingLock := types.VestingLock{}
+		k.cdc.MustUnmarshal(iterator.Value(), &vestingLock)
+		vestingLocks = append(vestingLocks, &vestingLock)
+	}
+
+	return vestingLocks
+}
+
+// DeleteVestingLock removes a vesting lock
+func (k *Keeper) DeleteVestingLock(ctx sdk.Context, vestingLock *types.VestingLock) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VestingLockKey)
+	store.Delete(types.GetVestingLockStoreKey(vestingLock.ChainId, vestingLock.Address))
+}
+
+// GetVestingLockedAmount returns the part of a deposit which is paid with the locked coins of a vesting account, zero
+// for the other accounts
+func (k *Keeper) GetVestingLockedAmount(ctx sdk.Context, address sdk.AccAddress, amount sdk.Coin) math.Int {
+	if _, ok := k.accountKeeper.GetAccount(ctx, address).(vestingexported.VestingAccount); !ok {
+		return sdk.ZeroInt()
+	}
+
+	spendable := k.bankKeeper.SpendableCoins(ctx, address).AmountOf(amount.Denom)
+	if amount.Amount.LTE(spendable) {
+		return sdk.ZeroInt()
+	}
+
+	return amount.Amount.Sub(spendable)
+}
+
+// AddVestingLock holds the stk tokens minted for the locked coins of a deposit in the module account, on top of the
+// ones already held for the vesting account on that host chain
+func (k *Keeper) AddVestingLock(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	address string,
+	lockedAmount sdk.Coin,
+	stkAmount sdk.Coin,
+) {
+	vestingLock, found := k.GetVestingLock(ctx, hc.ChainId, address)
+	if !found {
+		vestingLock = &types.VestingLock{
+			ChainId:      hc.ChainId,
+			Address:      address,
+			LockedAmount: sdk.NewCoin(lockedAmount.Denom, sdk.ZeroInt()),
+			StkAmount:    sdk.NewCoin(stkAmount.Denom, sdk.ZeroInt()),
+		}
+	}
+
+	vestingLock.LockedAmount = vestingLock.LockedAmount.Add(lockedAmount)
+	vestingLock.StkAmount = vestingLock.StkAmount.Add(stkAmount)
+	k.SetVestingLock(ctx, vestingLock)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVestingLock,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeDelegatorAddress, address),
+			sdk.NewAttribute(types.AttributeLockedAmount, lockedAmount.String()),
+			sdk.NewAttribute(types.AttributeLockedStkAmount, stkAmount.String()),
+		),
+	)
+}
+
+// ReleaseVestingLocks sends the vesting accounts the stk tokens held for their deposited coins which have vested
+// since. The coins still vesting are counted as deposited first, the same way the vesting account tracks them, so the
+// stk tokens are only released once the account has fewer vesting coins than it deposited.
+func (k *Keeper) ReleaseVestingLocks(ctx sdk.Context) {
+	for _, vestingLock := range k.GetAllVestingLocks(ctx) {
+		address, err := sdk.AccAddressFromBech32(vestingLock.Address)
+		if err != nil {
+			continue
+		}
+
+		stillLocked := sdk.ZeroInt()
+		if account, ok := k.accountKeeper.GetAccount(ctx, address).(vestingexported.VestingAccount); ok {
+			vesting := account.GetVestingCoins(ctx.BlockTime()).AmountOf(vestingLock.LockedAmount.Denom)
+			stillLocked = math.MinInt(vestingLock.LockedAmount.Amount, vesting)
+		}
+		if stillLocked.Equal(vestingLock.LockedAmount.Amount) {
+			continue
+		}
+
+		// the stk tokens kept for the coins still vesting are rounded up, so no more than the vested share is released
+		stillLockedStk := sdk.NewDecFromInt(vestingLock.StkAmount.Amount).
+			MulInt(stillLocked).
+			QuoInt(vestingLock.LockedAmount.Amount).
+			Ceil().
+			TruncateInt()
+		released := sdk.NewCoin(vestingLock.StkAmount.Denom, vestingLock.StkAmount.Amount.Sub(stillLockedStk))
+		if released.IsPositive() {
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, sdk.NewCoins(released))
+			if err != nil {
+				k.Logger(ctx).Error(
+					"could not release vesting lock",
+					"chain_id",
+					vestingLock.ChainId,
+					"address",
+					vestingLock.Address,
+					"err",
+					err.Error(),
+				)
+				continue
+			}
+		}
+
+		vestingLock.LockedAmount.Amount = stillLocked
+		vestingLock.StkAmount.Amount = stillLockedStk
+		if stillLocked.IsZero() {
+			k.DeleteVestingLock(ctx, vestingLock)
+		} else {
+			k.SetVestingLock(ctx, vestingLock)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeVestingLockReleased,
+				sdk.NewAttribute(types.AttributeChainID, vestingLock.ChainId),
+				sdk.NewAttribute(types.AttributeDelegatorAddress, vestingLock.Address),
+				sdk.NewAttribute(types.AttributeReleasedStkAmount, released.String()),
+				sdk.NewAttribute(types.AttributeLockedStkAmount, vestingLock.StkAmount.String()),
+			),
+		)
+	}
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestLiquidStakeVestingLock() {
+	pstakeApp := suite.app
+	ctx, _ := suite.ctx.CacheContext()
+	k := pstakeApp.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	epoch := pstakeApp.EpochsKeeper.GetEpochInfo(ctx, types.DelegationEpoch)
+	suite.Require().NoError(k.BeforeEpochStart(ctx, epoch.Identifier, epoch.CurrentEpoch))
+
+	hc.Params.DepositFee = sdk.MustNewDecFromStr("0.1")
+	k.SetHostChain(ctx, hc)
+
+	locked := sdk.NewInt64Coin(hc.IBCDenom(), 1000)
+	vestingStart := ctx.BlockTime()
+	vestingEnd := vestingStart.Add(time.Hour)
+
+	address := sdk.AccAddress("vesting_account_____")
+	baseAccount := pstakeApp.AccountKeeper.NewAccountWithAddress(ctx, address).(*authtypes.BaseAccount)
+	vestingAccount := vestingtypes.NewContinuousVestingAccount(
+		baseAccount,
+		sdk.NewCoins(locked),
+		vestingStart.Unix(),
+		vestingEnd.Unix(),
+	)
+	pstakeApp.AccountKeeper.SetAccount(ctx, vestingAccount)
+	suite.Require().NoError(testutil.FundAccount(pstakeApp.BankKeeper, ctx, address, sdk.NewCoins(locked)))
+
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	// the stk tokens of locked coins can't be minted to another recipient
+	msg := types.NewMsgLiquidStake(locked, address)
+	msg.Recipient = suite.chainA.SenderAccount.GetAddress().String()
+	failedCtx, _ := ctx.CacheContext()
+	_, err := msgServer.LiquidStake(failedCtx, msg)
+	suite.Require().ErrorIs(err, types.ErrLockedVestingCoins)
+
+	// the locked coins are deposited and their stk tokens are held
+	_, err = msgServer.LiquidStake(ctx, types.NewMsgLiquidStake(locked, address))
+	suite.Require().NoError(err)
+
+	mintAmount := sdk.NewDecFromInt(locked.Amount).Mul(hc.CValue).TruncateInt()
+	stkAmount := mintAmount.Sub(hc.Params.DepositFee.MulInt(mintAmount).TruncateInt())
+	suite.Require().True(pstakeApp.BankKeeper.GetBalance(ctx, address, hc.IBCDenom()).IsZero())
+	suite.Require().True(pstakeApp.BankKeeper.GetBalance(ctx, address, hc.MintDenom()).IsZero())
+
+	vestingLock, found := k.GetVestingLock(ctx, hc.ChainId, address.String())
+	suite.Require().True(found)
+	suite.Require().Equal(locked, vestingLock.LockedAmount)
+	suite.Require().Equal(sdk.NewCoin(hc.MintDenom(), stkAmount), vestingLock.StkAmount)
+	suite.Require().Equal([]*types.VestingLock{vestingLock}, k.GetAllVestingLocks(ctx))
+
+	// the stk tokens of the vested half are released
+	ctx = ctx.WithBlockTime(vestingStart.Add(30 * time.Minute))
+	k.ReleaseVestingLocks(ctx)
+	released := pstakeApp.BankKeeper.GetBalance(ctx, address, hc.MintDenom()).Amount
+	suite.Require().Equal(stkAmount.QuoRaw(2), released)
+
+	vestingLock, found = k.GetVestingLock(ctx, hc.ChainId, address.String())
+	suite.Require().True(found)
+	suite.Require().Equal(locked.Amount.QuoRaw(2), vestingLock.LockedAmount.Amount)
+	suite.Require().Equal(stkAmount.Sub(released), vestingLock.StkAmount.Amount)
+
+	// everything is released once vested
+	ctx = ctx.WithBlockTime(vestingEnd)
+	k.ReleaseVestingLocks(ctx)
+	suite.Require().Equal(stkAmount, pstakeApp.BankKeeper.GetBalance(ctx, address, hc.MintDenom()).Amount)
+	_, found = k.GetVestingLock(ctx, hc.ChainId, address.String())
+	suite.Require().False(found)
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// SetWeightTransition stores the transition of the host chain delegations to new validator weights
+func (k *Keeper) SetWeightTransition(ctx sdk.Context, transition *types.WeightTransition) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WeightTransitionKey)
+	bytes := k.cdc.MustMarshal(transition)
+	store.Set([]byte(transition.ChainId), bytes)
+}
+
+// GetWeightTransition returns the transition of the host chain delegations to new validator weights
+func (k *Keeper) GetWeightTransition(ctx sdk.Context, chainID string) (*types.WeightTransition, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WeightTransitionKey)
+	bytes := store.Get([]byte(chainID))
+	if len(bytes) == 0 {
+		return &types.WeightTransition{}, false
+	}
+
+	var transition types.WeightTransition
+	k.cdc.MustUnmarshal(bytes, &transition)
+	return &transition, true
+}
+
+// DeleteWeightTransition removes the weight transition of a host chain
+func (k *Keeper) DeleteWeightTransition(ctx sdk.Context, chainID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WeightTransitionKey)
+	store.Delete([]byte(chainID))
+}
+
+// UpdateWeightTransition starts a new transition of the host chain delegations when its validator weights no longer
+// match the targets of the current one
+func (k *Keeper) UpdateWeightTransition(ctx sdk.Context, hc *types.HostChain, epoch int64) {
+	if !hc.IsTransitioningWeights() {
+		k.DeleteWeightTransition(ctx, hc.ChainId)
+		return
+	}
+
+	transition, found := k.GetWeightTransition(ctx, hc.ChainId)
+	if found && transition.TargetsMatch(hc.Validators) {
+		return
+	}
+
+	transition = types.NewWeightTransition(hc, epoch)
+	k.SetWeightTransition(ctx, transition)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWeightTransitionStarted,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeEpoch, strconv.FormatInt(epoch, 10)),
+			sdk.NewAttribute(types.AttributeTransitionEpochs, strconv.FormatInt(transition.RemainingEpochs, 10)),
+		),
+	)
+}
+
+// AdvanceWeightTransition moves the weight transition of a host chain to its next redelegation epoch. The last epoch
+// of a transition is kept, so the delegations follow the target weights in full until the weights change again.
+func (k *Keeper) AdvanceWeightTransition(ctx sdk.Context, chainID string) {
+	transition, found := k.GetWeightTransition(ctx, chainID)
+	if !found || transition.RemainingEpochs <= 1 {
+		return
+	}
+
+	transition.RemainingEpochs--
+	k.SetWeightTransition(ctx, transition)
+}
+
+// weightTransitionSteps returns the number of redelegation epochs the delegations of a host chain have left to reach
+// its validator weights, one if they can reach them in the current epoch
+func (k *Keeper) weightTransitionSteps(ctx sdk.Context, hc *types.HostChain) int64 {
+	if !hc.IsTransitioningWeights() {
+		return 1
+	}
+
+	transition, found := k.GetWeightTransition(ctx, hc.ChainId)
+	if !found || !transition.TargetsMatch(hc.Validators) || transition.RemainingEpochs <= 1 {
+		return 1
+	}
+
+	return transition.RemainingEpochs
+}
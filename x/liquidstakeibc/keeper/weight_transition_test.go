@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestWeightTransition() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	hc.Params.MaxEntries = 7
+	hc.Params.RedelegationAcceptableDelta = sdk.ZeroInt()
+	hc.Params.MinActiveValidators = 0
+	hc.Params.WeightTransitionEpochs = 4
+	hc.Validators = []*types.Validator{{
+		OperatorAddress: "valA",
+		Status:          stakingtypes.Bonded.String(),
+		Weight:          sdk.MustNewDecFromStr("0.5"),
+		DelegatedAmount: sdk.NewInt(1000000),
+		ExchangeRate:    sdk.OneDec(),
+		Delegable:       true,
+	}, {
+		OperatorAddress: "valB",
+		Status:          stakingtypes.Bonded.String(),
+		Weight:          sdk.MustNewDecFromStr("0.5"),
+		DelegatedAmount: sdk.ZeroInt(),
+		ExchangeRate:    sdk.OneDec(),
+		Delegable:       true,
+	}}
+	k.SetHostChain(ctx, hc)
+
+	redelegated := func() sdk.Int {
+		msgs := k.GenerateRedelegateMsgs(ctx, *hc)
+		suite.Require().Len(msgs, 1)
+		return msgs[0].(*stakingtypes.MsgBeginRedelegate).Amount.Amount
+	}
+	moveDelegation := func(amount sdk.Int) {
+		hc.Validators[0].DelegatedAmount = hc.Validators[0].DelegatedAmount.Sub(amount)
+		hc.Validators[1].DelegatedAmount = hc.Validators[1].DelegatedAmount.Add(amount)
+		k.SetHostChain(ctx, hc)
+	}
+
+	// without a transition the full delta is redelegated
+	suite.Require().Equal(sdk.NewInt(500000), redelegated())
+
+	// the first epoch of a transition moves a quarter of the delta
+	k.UpdateWeightTransition(ctx, hc, 1)
+	transition, found := k.GetWeightTransition(ctx, hc.ChainId)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(4), transition.RemainingEpochs)
+	suite.Require().Equal(sdk.NewInt(125000), redelegated())
+	k.AdvanceWeightTransition(ctx, hc.ChainId)
+
+	// the next epochs move the same share of the original delta, the last one what is left of it
+	moveDelegation(sdk.NewInt(125000))
+	for epoch := int64(2); epoch <= 4; epoch++ {
+		k.UpdateWeightTransition(ctx, hc, epoch)
+		amount := redelegated()
+		suite.Require().Equal(sdk.NewInt(125000), amount)
+		k.AdvanceWeightTransition(ctx, hc.ChainId)
+		moveDelegation(amount)
+	}
+	transition, _ = k.GetWeightTransition(ctx, hc.ChainId)
+	suite.Require().Equal(int64(1), transition.RemainingEpochs)
+	suite.Require().Equal(sdk.NewInt(500000), hc.Validators[1].DelegatedAmount)
+
+	// a weight change starts a new transition
+	hc.Validators[0].Weight = sdk.MustNewDecFromStr("0.9")
+	hc.Validators[1].Weight = sdk.MustNewDecFromStr("0.1")
+	k.SetHostChain(ctx, hc)
+	k.UpdateWeightTransition(ctx, hc, 5)
+	transition, _ = k.GetWeightTransition(ctx, hc.ChainId)
+	suite.Require().Equal(int64(5), transition.StartEpoch)
+	suite.Require().Equal(int64(4), transition.RemainingEpochs)
+	suite.Require().Equal(sdk.NewInt(100000), redelegated())
+
+	// disabling the transitions removes it and redelegates the full delta again
+	hc.Params.WeightTransitionEpochs = 0
+	k.SetHostChain(ctx, hc)
+	k.UpdateWeightTransition(ctx, hc, 6)
+	_, found = k.GetWeightTransition(ctx, hc.ChainId)
+	suite.Require().False(found)
+	suite.Require().Equal(sdk.NewInt(400000), redelegated())
+}
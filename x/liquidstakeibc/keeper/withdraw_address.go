@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// VerifyHostChainWithdrawAddress checks the distribution withdraw address of the host chain delegation account,
+// as returned by ICQ, against the rewards account. On a mismatch the host chain is flagged and a new
+// MsgSetWithdrawAddress is sent, as the rewards would otherwise stay in the delegation account.
+func (k *Keeper) VerifyHostChainWithdrawAddress(ctx sdk.Context, hc *types.HostChain, withdrawAddressBytes []byte) error {
+	hrp, delegatorAddressBytes, err := bech32.DecodeAndConvert(hc.DelegationAccount.Address)
+	if err != nil {
+		return err
+	}
+
+	// an account without a withdraw address withdraws its rewards to itself
+	if len(withdrawAddressBytes) == 0 {
+		withdrawAddressBytes = delegatorAddressBytes
+	}
+	withdrawAddress, err := bech32.ConvertAndEncode(hrp, withdrawAddressBytes)
+	if err != nil {
+		return err
+	}
+
+	// nothing to do for a host chain which already withdraws to its rewards account
+	mismatch := withdrawAddress != hc.RewardsAccount.Address
+	if !mismatch && !hc.WithdrawAddressMismatch {
+		return nil
+	}
+
+	hc.WithdrawAddressMismatch = mismatch
+	if err := k.SetHostChain(ctx, hc); err != nil {
+		return err
+	}
+
+	if !mismatch {
+		k.Logger(ctx).Info("Host chain withdraw address verified.", "host_chain", hc.ChainId)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeWithdrawAddressVerified,
+				sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+				sdk.NewAttribute(types.AttributeWithdrawAddress, withdrawAddress),
+			),
+		)
+		return nil
+	}
+
+	k.Logger(ctx).Error(
+		"Host chain withdraw address is not the rewards account.",
+		"host_chain",
+		hc.ChainId,
+		"withdraw_address",
+		withdrawAddress,
+		"rewards_address",
+		hc.RewardsAccount.Address,
+	)
+
+	// the withdraw address can only be set through an open delegation account channel
+	reset := false
+	if hc.DelegationAccount.ChannelState == types.ICAAccount_ICA_CHANNEL_CREATED && !hc.Degraded {
+		if err = k.SetWithdrawAddress(ctx, hc); err != nil {
+			k.Logger(ctx).Error("Could not set withdraw address.", "host_chain", hc.ChainId, "err", err)
+		} else {
+			reset = true
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawAddressMismatch,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeWithdrawAddress, withdrawAddress),
+			sdk.NewAttribute(types.AttributeExpectedWithdrawAddress, hc.RewardsAccount.Address),
+			sdk.NewAttribute(types.AttributeWithdrawAddressReset, strconv.FormatBool(reset)),
+		),
+	)
+
+	return nil
+}
+
+// VerifyHostChainsWithdrawAddresses queries the withdraw address of the delegation account of every active host chain
+// whose interchain accounts are set up
+func (k *Keeper) VerifyHostChainsWithdrawAddresses(ctx sdk.Context) {
+	for _, hc := range k.GetAllHostChains(ctx) {
+		if !hc.IsActive() || hc.DelegationAccount == nil || hc.RewardsAccount == nil ||
+			hc.DelegationAccount.Address == "" || hc.RewardsAccount.Address == "" {
+			continue
+		}
+
+		if err := k.QueryDelegationAccountWithdrawAddress(ctx, hc); err != nil {
+			k.Logger(ctx).Error(
+				"Could not send host chain withdraw address ICQ",
+				"host_chain",
+				hc.ChainId,
+				"err",
+				err.Error(),
+			)
+		}
+	}
+}
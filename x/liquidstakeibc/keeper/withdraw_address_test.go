@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestVerifyHostChainWithdrawAddress() {
+	pstakeApp := suite.app
+	k := pstakeApp.LiquidStakeIBCKeeper
+	ctx, _ := suite.ctx.CacheContext()
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	_, rewardsAddressBytes, err := bech32.DecodeAndConvert(hc.RewardsAccount.Address)
+	suite.Require().NoError(err)
+
+	hasEvent := func(ctx sdk.Context, eventType string) bool {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == eventType {
+				return true
+			}
+		}
+		return false
+	}
+
+	// a host chain withdrawing to its rewards account is left untouched
+	suite.Require().NoError(k.VerifyHostChainWithdrawAddress(ctx, hc, rewardsAddressBytes))
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().False(hc.WithdrawAddressMismatch)
+
+	// without a withdraw address the rewards stay in the delegation account
+	mismatchCtx := ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(k.VerifyHostChainWithdrawAddress(mismatchCtx, hc, nil))
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().True(hc.WithdrawAddressMismatch)
+	suite.Require().True(hasEvent(mismatchCtx, types.EventTypeWithdrawAddressMismatch))
+
+	// the flag is cleared once the withdraw address is the rewards account again
+	verifiedCtx := ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(k.VerifyHostChainWithdrawAddress(verifiedCtx, hc, rewardsAddressBytes))
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().False(hc.WithdrawAddressMismatch)
+	suite.Require().True(hasEvent(verifiedCtx, types.EventTypeWithdrawAddressVerified))
+
+	// the withdraw address is queried for every active host chain
+	suite.Require().NotPanics(func() { k.VerifyHostChainsWithdrawAddresses(ctx) })
+}
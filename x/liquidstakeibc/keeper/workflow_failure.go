@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (k *Keeper) SetWorkflowFailure(ctx sdk.Context, failure *types.WorkflowFailure) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WorkflowFailureKey)
+	bytes := k.cdc.MustMarshal(failure)
+	store.Set(types.GetWorkflowFailureStoreKey(failure.ChainId, failure.Workflow), bytes)
+}
+
+func (k *Keeper) GetWorkflowFailure(ctx sdk.Context, chainID, workflow string) (*types.WorkflowFailure, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WorkflowFailureKey)
+	bytes := store.Get(types.GetWorkflowFailureStoreKey(chainID, workflow))
+	if len(bytes) == 0 {
+		return nil, false
+	}
+
+	var failure types.WorkflowFailure
+	k.cdc.MustUnmarshal(bytes, &failure)
+	return &failure, true
+}
+
+func (k *Keeper) GetAllWorkflowFailures(ctx sdk.Context) []*types.WorkflowFailure {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WorkflowFailureKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	failures := make([]*types.WorkflowFailure, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		failure := types.WorkflowFailure{}
+		k.cdc.MustUnmarshal(iterator.Value(), &failure)
+		failures = append(failures, &failure)
+	}
+
+	return failures
+}
+
+// ClearWorkflowFailures drops the failures recorded by the previous run of a workflow, so only the host chains that
+// fail the current run are reported
+func (k *Keeper) ClearWorkflowFailures(ctx sdk.Context, workflow string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WorkflowFailureKey)
+	for _, failure := range k.GetAllWorkflowFailures(ctx) {
+		if failure.Workflow == workflow {
+			store.Delete(types.GetWorkflowFailureStoreKey(failure.ChainId, failure.Workflow))
+		}
+	}
+}
+
+// RunHostChainWorkflow runs the part of a workflow that processes a single host chain in a cached context. If it
+// returns an error or panics, only the changes made for that host chain are discarded and the failure is recorded,
+// so the workflow carries on with the other host chains.
+func (k *Keeper) RunHostChainWorkflow(
+	ctx sdk.Context,
+	workflow string,
+	chainID string,
+	epoch int64,
+	run func(ctx sdk.Context) error,
+) bool {
+	cacheCtx, write := ctx.CacheContext()
+
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				// running out of gas must still abort the whole execution
+				if _, ok := r.(storetypes.ErrorOutOfGas); ok {
+					panic(r)
+				}
+				err = fmt.Errorf("panic: %v", r)
+			}
+		}()
+
+		return run(cacheCtx)
+	}()
+	if err != nil {
+		k.OperationLogger(ctx, workflow, chainID, epoch).Error(
+			"Host chain workflow failed, its changes were reverted.",
+			"err",
+			err.Error(),
+		)
+
+		k.SetWorkflowFailure(ctx, &types.WorkflowFailure{
+			ChainId:  chainID,
+			Workflow: workflow,
+			Epoch:    epoch,
+			Height:   ctx.BlockHeight(),
+			Error:    err.Error(),
+		})
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeWorkflowFailure,
+				sdk.NewAttribute(types.AttributeChainID, chainID),
+				sdk.NewAttribute(types.AttributeWorkflow, workflow),
+				sdk.NewAttribute(types.AttributeEpoch, strconv.FormatInt(epoch, 10)),
+				sdk.NewAttribute(types.AttributeWorkflowError, err.Error()),
+			),
+		)
+
+		return false
+	}
+
+	write()
+	return true
+}
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestRunHostChainWorkflow() {
+	ctx, _ := suite.ctx.CacheContext()
+	k := suite.app.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	cValue := hc.CValue
+
+	// a panic only reverts the changes made for its host chain
+	ok := k.RunHostChainWorkflow(ctx, types.WorkflowCValue, hc.ChainId, 0, func(ctx sdk.Context) error {
+		hc.CValue = sdk.NewDec(2)
+		k.SetHostChain(ctx, hc)
+		panic("unexpected state")
+	})
+	suite.Require().False(ok)
+
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(cValue, hc.CValue)
+
+	failure, found := k.GetWorkflowFailure(ctx, hc.ChainId, types.WorkflowCValue)
+	suite.Require().True(found)
+	suite.Require().Equal(ctx.BlockHeight(), failure.Height)
+	suite.Require().Contains(failure.Error, "unexpected state")
+
+	failed := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeWorkflowFailure {
+			failed = true
+		}
+	}
+	suite.Require().True(failed)
+
+	// the other host chains carry on
+	ok = k.RunHostChainWorkflow(ctx, types.WorkflowCValue, "other-chain", 0, func(ctx sdk.Context) error {
+		return k.SetHostChain(ctx, &types.HostChain{ChainId: "other-chain", CValue: sdk.OneDec(), Params: hc.Params})
+	})
+	suite.Require().True(ok)
+	_, found = k.GetHostChain(ctx, "other-chain")
+	suite.Require().True(found)
+	_, found = k.GetWorkflowFailure(ctx, "other-chain", types.WorkflowCValue)
+	suite.Require().False(found)
+
+	// errors are recorded like panics
+	ok = k.RunHostChainWorkflow(ctx, types.WorkflowRewards, hc.ChainId, 3, func(ctx sdk.Context) error {
+		return errors.New("rewards error")
+	})
+	suite.Require().False(ok)
+
+	res, err := k.WorkflowFailures(sdk.WrapSDKContext(ctx), &types.QueryWorkflowFailuresRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Failures, 2)
+
+	// the next run of a workflow only reports its own failures
+	k.ClearWorkflowFailures(ctx, types.WorkflowCValue)
+	res, err = k.WorkflowFailures(sdk.WrapSDKContext(ctx), &types.QueryWorkflowFailuresRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Failures, 1)
+	suite.Require().Equal(types.WorkflowRewards, res.Failures[0].Workflow)
+	suite.Require().Equal(int64(3), res.Failures[0].Epoch)
+	suite.Require().Equal("rewards error", res.Failures[0].Error)
+
+	// running out of gas still aborts the whole execution
+	gasCtx := ctx.WithGasMeter(sdk.NewGasMeter(10))
+	suite.Require().Panics(func() {
+		k.RunHostChainWorkflow(gasCtx, types.WorkflowCValue, hc.ChainId, 0, func(ctx sdk.Context) error {
+			ctx.GasMeter().ConsumeGas(100, "workflow")
+			return nil
+		})
+	})
+}
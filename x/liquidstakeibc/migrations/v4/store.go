@@ -0,0 +1,117 @@
+package v4
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// MigrateStore performs in-place store migrations to namespace the ibc sequence ids by port.
+// The migration includes:
+//
+// - Prefix the sequence ids of deposits, lsm deposits, unbondings and validator unbondings with their channel port.
+// - Move redelegation txs to store keys built from their namespaced sequence ids.
+//
+// channelPorts maps every channel id of the chain to its port.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	channelPorts map[string]string,
+) error {
+	store := ctx.KVStore(storeKey)
+
+	depositStore := prefix.NewStore(store, types.DepositKey)
+	for key, value := range getAll(depositStore) {
+		deposit := types.Deposit{}
+		cdc.MustUnmarshal(value, &deposit)
+		if id, ok := namespaceSequenceID(deposit.IbcSequenceId, channelPorts); ok {
+			deposit.IbcSequenceId = id
+			depositStore.Set([]byte(key), cdc.MustMarshal(&deposit))
+		}
+	}
+
+	lsmDepositStore := prefix.NewStore(store, types.LSMDepositKey)
+	for key, value := range getAll(lsmDepositStore) {
+		deposit := types.LSMDeposit{}
+		cdc.MustUnmarshal(value, &deposit)
+		if id, ok := namespaceSequenceID(deposit.IbcSequenceId, channelPorts); ok {
+			deposit.IbcSequenceId = id
+			lsmDepositStore.Set([]byte(key), cdc.MustMarshal(&deposit))
+		}
+	}
+
+	unbondingStore := prefix.NewStore(store, types.UnbondingKey)
+	for key, value := range getAll(unbondingStore) {
+		unbonding := types.Unbonding{}
+		cdc.MustUnmarshal(value, &unbonding)
+		if id, ok := namespaceSequenceID(unbonding.IbcSequenceId, channelPorts); ok {
+			unbonding.IbcSequenceId = id
+			unbondingStore.Set([]byte(key), cdc.MustMarshal(&unbonding))
+		}
+	}
+
+	validatorUnbondingStore := prefix.NewStore(store, types.ValidatorUnbondingKey)
+	for key, value := range getAll(validatorUnbondingStore) {
+		validatorUnbonding := types.ValidatorUnbonding{}
+		cdc.MustUnmarshal(value, &validatorUnbonding)
+		if id, ok := namespaceSequenceID(validatorUnbonding.IbcSequenceId, channelPorts); ok {
+			validatorUnbonding.IbcSequenceId = id
+			validatorUnbondingStore.Set([]byte(key), cdc.MustMarshal(&validatorUnbonding))
+		}
+	}
+
+	// the sequence id is part of the redelegation tx store key
+	redelegationTxStore := prefix.NewStore(store, types.RedelegationTxKey)
+	for key, value := range getAll(redelegationTxStore) {
+		redelegationTx := types.RedelegateTx{}
+		cdc.MustUnmarshal(value, &redelegationTx)
+		if id, ok := namespaceSequenceID(redelegationTx.IbcSequenceId, channelPorts); ok {
+			redelegationTx.IbcSequenceId = id
+			redelegationTxStore.Delete([]byte(key))
+			redelegationTxStore.Set(
+				types.GetRedelegationTxStoreKey(redelegationTx.ChainId, redelegationTx.IbcSequenceId),
+				cdc.MustMarshal(&redelegationTx),
+			)
+		}
+	}
+
+	return nil
+}
+
+// namespaceSequenceID returns the sequence id prefixed with the port of its channel, if it was stored without one
+func namespaceSequenceID(sequenceID string, channelPorts map[string]string) (string, bool) {
+	if sequenceID == "" || strings.Contains(sequenceID, "/") {
+		return "", false
+	}
+
+	channelID, _, found := strings.Cut(sequenceID, "-sequence-")
+	if !found {
+		return "", false
+	}
+
+	portID, found := channelPorts[channelID]
+	if !found {
+		return "", false
+	}
+
+	return portID + "/" + sequenceID, true
+}
+
+// getAll returns every entry of a store, so it can be rewritten once the iteration is over
+func getAll(store prefix.Store) map[string][]byte {
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	entries := make(map[string][]byte)
+	for ; iterator.Valid(); iterator.Next() {
+		entries[string(iterator.Key())] = iterator.Value()
+	}
+
+	return entries
+}
@@ -0,0 +1,55 @@
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// MigrateStore performs in-place store migrations to key the user unbondings by delegator.
+// The migration includes:
+//
+// - Move the user unbondings from chain | delegator | epoch keys to delegator | chain | epoch keys.
+// - Index every user unbonding by chain | epoch | delegator.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	userUnbondingStore := prefix.NewStore(store, types.UserUnbondingKey)
+	indexStore := prefix.NewStore(store, types.UserUnbondingEpochIndexKey)
+
+	// the old keys are removed before writing the new ones, so the two layouts never overlap
+	entries := getAll(userUnbondingStore)
+	for key := range entries {
+		userUnbondingStore.Delete([]byte(key))
+	}
+
+	for _, value := range entries {
+		userUnbonding := types.UserUnbonding{}
+		cdc.MustUnmarshal(value, &userUnbonding)
+		userUnbondingStore.Set(
+			types.GetUserUnbondingStoreKey(userUnbonding.Address, userUnbonding.ChainId, userUnbonding.EpochNumber),
+			value,
+		)
+		indexStore.Set(
+			types.GetUserUnbondingEpochIndexKey(userUnbonding.ChainId, userUnbonding.EpochNumber, userUnbonding.Address),
+			[]byte{},
+		)
+	}
+
+	return nil
+}
+
+// getAll returns every entry of a store, so it can be rewritten once the iteration is over
+func getAll(store prefix.Store) map[string][]byte {
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	entries := make(map[string][]byte)
+	for ; iterator.Valid(); iterator.Next() {
+		entries[string(iterator.Key())] = iterator.Value()
+	}
+
+	return entries
+}
@@ -0,0 +1,96 @@
+package v6
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// MigrateStore performs in-place store migrations to bring the stored host chains within their invariants.
+// The migration includes:
+//
+// - Normalize the validator weights of every host chain, which drifted from one with the evenly split weights of the
+// redistributions.
+// - Validate every stored host chain, so none is left that the host chain writes would reject.
+// - Opt every pending user unbonding in to the automatic claim, so the existing unbondings keep being paid out as soon
+// as they are claimable.
+// - Enable the deposit receipts with the default retention, the stored params have none, and index the existing
+// receipts by delegator.
+// - Bound the time a matured unbonding waiting for its transfer holds the claims of the later epochs with the default
+// claim hold epochs.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	hostChainStore := prefix.NewStore(store, types.HostChainKey)
+	for _, entry := range getAll(hostChainStore) {
+		hc := types.HostChain{}
+		cdc.MustUnmarshal(entry.value, &hc)
+
+		for _, validator := range hc.Validators {
+			if validator.Weight.IsNil() {
+				validator.Weight = sdk.ZeroDec()
+			}
+		}
+		hc.NormalizeValidatorWeights()
+
+		if err := hc.ValidateState(); err != nil {
+			return fmt.Errorf("host chain %s can't be migrated: %w", hc.ChainId, err)
+		}
+		hostChainStore.Set(entry.key, cdc.MustMarshal(&hc))
+	}
+
+	userUnbondingStore := prefix.NewStore(store, types.UserUnbondingKey)
+	for _, entry := range getAll(userUnbondingStore) {
+		userUnbonding := types.UserUnbonding{}
+		cdc.MustUnmarshal(entry.value, &userUnbonding)
+
+		userUnbonding.AutoClaim = true
+		userUnbondingStore.Set(entry.key, cdc.MustMarshal(&userUnbonding))
+	}
+
+	if bz := store.Get(types.ParamsKey); bz != nil {
+		params := types.Params{}
+		cdc.MustUnmarshal(bz, &params)
+
+		if params.DepositReceiptRetention == 0 {
+			params.DepositReceiptRetention = types.DefaultDepositReceiptRetention
+		}
+		if params.ClaimHoldEpochs == 0 {
+			params.ClaimHoldEpochs = types.DefaultClaimHoldEpochs
+		}
+		store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+	}
+
+	receiptIndexStore := prefix.NewStore(store, types.DepositReceiptDelegatorIndexKey)
+	for _, entry := range getAll(prefix.NewStore(store, types.DepositReceiptKey)) {
+		receipt := types.DepositReceipt{}
+		cdc.MustUnmarshal(entry.value, &receipt)
+
+		receiptIndexStore.Set(types.GetDepositReceiptDelegatorIndexKey(receipt.DelegatorAddress, receipt.Id), []byte{})
+	}
+
+	return nil
+}
+
+type storeEntry struct {
+	key   []byte
+	value []byte
+}
+
+// getAll returns every entry of a store in key order, so it can be rewritten once the iteration is over
+func getAll(store prefix.Store) []storeEntry {
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	entries := make([]storeEntry, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		entries = append(entries, storeEntry{key: iterator.Key(), value: iterator.Value()})
+	}
+
+	return entries
+}
@@ -131,10 +131,22 @@ func (a AppModule) RegisterServices(configurator module.Configurator) {
 	if err != nil {
 		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
 	}
+	err = configurator.RegisterMigration(types.ModuleName, 3, keeper.NewMigrator(a.keeper).Migrate3to4)
+	if err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", types.ModuleName, err))
+	}
+	err = configurator.RegisterMigration(types.ModuleName, 4, keeper.NewMigrator(a.keeper).Migrate4to5)
+	if err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", types.ModuleName, err))
+	}
+	err = configurator.RegisterMigration(types.ModuleName, 5, keeper.NewMigrator(a.keeper).Migrate5to6)
+	if err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 5 to 6: %v", types.ModuleName, err))
+	}
 }
 
 func (a AppModule) ConsensusVersion() uint64 {
-	return 3
+	return 6
 }
 
 // TODO simulations
@@ -111,8 +111,8 @@ func (m IBCModule) OnChanCloseInit(_ sdk.Context, _, _ string) error {
 	return nil
 }
 
-func (m IBCModule) OnChanCloseConfirm(_ sdk.Context, _, _ string) error {
-	return nil
+func (m IBCModule) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return m.keeper.OnChanCloseConfirm(ctx, portID, channelID)
 }
 
 func (m IBCModule) OnRecvPacket(_ sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
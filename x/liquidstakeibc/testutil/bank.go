@@ -0,0 +1,192 @@
+package testutil
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+var (
+	balancesPrefix = []byte{0x01}
+	supplyPrefix   = []byte{0x02}
+)
+
+var _ types.BankKeeper = (*BankKeeper)(nil)
+
+// BankKeeper is a minimal bank keeper keeping balances and supplies in its own store, so state changes made on a
+// cached context are discarded together with it. Module accounts are addressed by their module name.
+type BankKeeper struct {
+	storeKey storetypes.StoreKey
+}
+
+// NewBankKeeper returns a bank keeper storing its state under the given store key
+func NewBankKeeper(storeKey storetypes.StoreKey) *BankKeeper {
+	return &BankKeeper{storeKey: storeKey}
+}
+
+// FundAccount mints coins straight into an account
+func (k *BankKeeper) FundAccount(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) {
+	for _, coin := range amt {
+		k.setSupply(ctx, k.GetSupply(ctx, coin.Denom).Add(coin))
+		k.setBalance(ctx, addr, k.GetBalance(ctx, addr, coin.Denom).Add(coin))
+	}
+}
+
+func (k *BankKeeper) MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error {
+	if !amt.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	k.FundAccount(ctx, authtypes.NewModuleAddress(name), amt)
+	return nil
+}
+
+func (k *BankKeeper) BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error {
+	if !amt.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	if err := k.subBalance(ctx, authtypes.NewModuleAddress(name), amt); err != nil {
+		return err
+	}
+	for _, coin := range amt {
+		k.setSupply(ctx, k.GetSupply(ctx, coin.Denom).Sub(coin))
+	}
+
+	return nil
+}
+
+func (k *BankKeeper) GetSupply(ctx sdk.Context, denom string) sdk.Coin {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), supplyPrefix)
+	return sdk.NewCoin(denom, unmarshalAmount(store.Get([]byte(denom))))
+}
+
+func (k *BankKeeper) GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), balancesPrefix)
+	return sdk.NewCoin(denom, unmarshalAmount(store.Get(balanceStoreKey(addr, denom))))
+}
+
+func (k *BankKeeper) GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(balancesPrefix, address.MustLengthPrefix(addr)...))
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	balances := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		balances = balances.Add(sdk.NewCoin(string(iterator.Key()), unmarshalAmount(iterator.Value())))
+	}
+
+	return balances
+}
+
+// SpendableCoins returns every balance of the account, this keeper doesn't know about vesting
+func (k *BankKeeper) SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	return k.GetAllBalances(ctx, addr)
+}
+
+func (k *BankKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if !amt.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	if err := k.subBalance(ctx, fromAddr, amt); err != nil {
+		return err
+	}
+	for _, coin := range amt {
+		k.setBalance(ctx, toAddr, k.GetBalance(ctx, toAddr, coin.Denom).Add(coin))
+	}
+
+	return nil
+}
+
+func (k *BankKeeper) SendCoinsFromAccountToModule(
+	ctx sdk.Context,
+	senderAddr sdk.AccAddress,
+	recipientModule string,
+	amt sdk.Coins,
+) error {
+	return k.SendCoins(ctx, senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (k *BankKeeper) SendCoinsFromModuleToAccount(
+	ctx sdk.Context,
+	senderModule string,
+	recipientAddr sdk.AccAddress,
+	amt sdk.Coins,
+) error {
+	return k.SendCoins(ctx, authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+// DelegateCoins moves coins like SendCoins, this keeper doesn't track vesting delegations
+func (k *BankKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.SendCoins(ctx, delegatorAddr, moduleAccAddr, amt)
+}
+
+func (k *BankKeeper) subBalance(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	for _, coin := range amt {
+		balance := k.GetBalance(ctx, addr, coin.Denom)
+		if balance.IsLT(coin) {
+			return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", balance, coin)
+		}
+	}
+	for _, coin := range amt {
+		k.setBalance(ctx, addr, k.GetBalance(ctx, addr, coin.Denom).Sub(coin))
+	}
+
+	return nil
+}
+
+func (k *BankKeeper) setBalance(ctx sdk.Context, addr sdk.AccAddress, balance sdk.Coin) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), balancesPrefix)
+	if balance.IsZero() {
+		store.Delete(balanceStoreKey(addr, balance.Denom))
+		return
+	}
+
+	store.Set(balanceStoreKey(addr, balance.Denom), marshalAmount(balance.Amount))
+}
+
+func (k *BankKeeper) setSupply(ctx sdk.Context, supply sdk.Coin) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), supplyPrefix)
+	if supply.IsZero() {
+		store.Delete([]byte(supply.Denom))
+		return
+	}
+
+	store.Set([]byte(supply.Denom), marshalAmount(supply.Amount))
+}
+
+func balanceStoreKey(addr sdk.AccAddress, denom string) []byte {
+	return append(address.MustLengthPrefix(addr), []byte(denom)...)
+}
+
+func marshalAmount(amount math.Int) []byte {
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("could not marshal amount %s: %w", amount, err))
+	}
+
+	return bz
+}
+
+func unmarshalAmount(bz []byte) math.Int {
+	if len(bz) == 0 {
+		return math.ZeroInt()
+	}
+
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(fmt.Errorf("could not unmarshal amount: %w", err))
+	}
+
+	return amount
+}
@@ -0,0 +1,43 @@
+package testutil
+
+import (
+	"github.com/stretchr/testify/require"
+)
+
+// AdvanceEpoch moves the context past the end of the current epoch with the given identifier and runs the keeper
+// epoch hooks in the order the epochs module does: AfterEpochEnd for the ending epoch, then BeforeEpochStart for the
+// new one. It returns the number of the new epoch.
+func (f *Fixture) AdvanceEpoch(identifier string) int64 {
+	f.t.Helper()
+
+	info := f.EpochsKeeper.GetEpochInfo(f.Ctx, identifier)
+	require.NotEmpty(f.t, info.Identifier, "epoch %s not found", identifier)
+
+	epochEnd := info.CurrentEpochStartTime.Add(info.Duration)
+	if f.Ctx.BlockTime().After(epochEnd) {
+		f.NextBlock(0)
+	} else {
+		f.NextBlock(epochEnd.Sub(f.Ctx.BlockTime()) + 1)
+	}
+
+	require.NoError(f.t, f.Keeper.AfterEpochEnd(f.Ctx, identifier, info.CurrentEpoch))
+
+	info.CurrentEpoch++
+	info.CurrentEpochStartTime = epochEnd
+	info.CurrentEpochStartHeight = f.Ctx.BlockHeight()
+	f.EpochsKeeper.SetEpochInfo(info)
+
+	require.NoError(f.t, f.Keeper.BeforeEpochStart(f.Ctx, identifier, info.CurrentEpoch))
+
+	return info.CurrentEpoch
+}
+
+// AdvanceEpochs advances the epoch with the given identifier n times and returns the number of the last one
+func (f *Fixture) AdvanceEpochs(identifier string, n int) int64 {
+	epoch := f.EpochsKeeper.GetEpochInfo(f.Ctx, identifier).CurrentEpoch
+	for i := 0; i < n; i++ {
+		epoch = f.AdvanceEpoch(identifier)
+	}
+
+	return epoch
+}
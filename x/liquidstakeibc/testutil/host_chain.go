@@ -0,0 +1,184 @@
+package testutil
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+const (
+	// HostAccountPrefix is the bech32 prefix of the host chain accounts built by the factories
+	HostAccountPrefix = "cosmos"
+	// HostValidatorPrefix is the bech32 prefix of the host chain validators built by the factories
+	HostValidatorPrefix = "cosmosvaloper"
+	// HostUnbondingTime is the unbonding period of the host chain light clients
+	HostUnbondingTime = 21 * 24 * time.Hour
+)
+
+// HostAddress returns a deterministic host chain account address derived from a seed
+func HostAddress(seed string) string {
+	return sdk.MustBech32ifyAddressBytes(HostAccountPrefix, tmhash.SumTruncated([]byte(seed)))
+}
+
+// NewValidators returns n bonded and delegable validators of a host chain sharing the weight equally, the last one
+// taking the rounding remainder
+func NewValidators(chainID string, n int) []*types.Validator {
+	validators := make([]*types.Validator, 0, n)
+	weight := sdk.OneDec().QuoInt64(int64(n))
+	for i := 0; i < n; i++ {
+		validatorWeight := weight
+		if i == n-1 {
+			validatorWeight = sdk.OneDec().Sub(weight.MulInt64(int64(n - 1)))
+		}
+
+		validators = append(validators, &types.Validator{
+			OperatorAddress: sdk.MustBech32ifyAddressBytes(
+				HostValidatorPrefix,
+				tmhash.SumTruncated([]byte(fmt.Sprintf("%s/validator-%d", chainID, i))),
+			),
+			Status:          stakingtypes.Bonded.String(),
+			Weight:          validatorWeight,
+			DelegatedAmount: sdk.ZeroInt(),
+			ExchangeRate:    sdk.OneDec(),
+			Delegable:       true,
+			LsmCapacity:     sdk.ZeroInt(),
+		})
+	}
+
+	return validators
+}
+
+// NewHostChain returns an active host chain with the params a MsgRegisterHostChain registration sets and the given
+// validators. Its connection, channel and interchain accounts are only filled in once it is added to a fixture.
+func NewHostChain(chainID, hostDenom string, validators []*types.Validator) *types.HostChain {
+	return &types.HostChain{
+		ChainId: chainID,
+		PortId:  ibctransfertypes.PortID,
+		Params: &types.HostChainLSParams{
+			DepositFee:                    sdk.MustNewDecFromStr("0.01"),
+			RestakeFee:                    sdk.MustNewDecFromStr("0.02"),
+			UnstakeFee:                    sdk.MustNewDecFromStr("0.03"),
+			RedemptionFee:                 sdk.MustNewDecFromStr("0.03"),
+			LsmValidatorCap:               sdk.ZeroDec(),
+			RedelegationAcceptableDelta:   sdk.ZeroInt(),
+			LsmBondFactor:                 sdk.NewDec(-1),
+			UpperCValueLimit:              sdk.MustNewDecFromStr("1.01"),
+			LowerCValueLimit:              sdk.MustNewDecFromStr("0.99"),
+			MaxValidatorWeight:            sdk.ZeroDec(),
+			LiquidityIncentiveRate:        sdk.ZeroDec(),
+			DepositDeliveryRatio:          sdk.ZeroDec(),
+			MinCValue:                     sdk.ZeroDec(),
+			MaxCValue:                     sdk.ZeroDec(),
+			RebateMaxCommission:           sdk.ZeroDec(),
+			RebateWeightBoost:             sdk.ZeroDec(),
+			ScoreWeightMin:                sdk.ZeroDec(),
+			ScoreWeightMax:                sdk.ZeroDec(),
+			MinRewardWithdrawalDelegation: sdk.ZeroInt(),
+			MaxDepositAmount:              sdk.ZeroInt(),
+			MinRewardsTransferAmount:      sdk.ZeroInt(),
+			MaxDrainPerEpoch:              sdk.ZeroInt(),
+			MaxRedelegationAmount:         sdk.ZeroInt(),
+			MaxRedelegationRatio:          sdk.ZeroDec(),
+			MaxUnbondingAmount:            sdk.ZeroInt(),
+			MaxDelegationShare:            sdk.ZeroDec(),
+		},
+		HostDenom:      hostDenom,
+		MinimumDeposit: sdk.NewInt(5),
+		CValue:         sdk.OneDec(),
+		LastCValue:     sdk.OneDec(),
+		DelegationAccount: &types.ICAAccount{
+			Owner:   types.DefaultDelegateAccountPortOwner(chainID),
+			Balance: sdk.NewCoin(hostDenom, sdk.ZeroInt()),
+		},
+		RewardsAccount: &types.ICAAccount{
+			Owner:   types.DefaultRewardsAccountPortOwner(chainID),
+			Balance: sdk.NewCoin(hostDenom, sdk.ZeroInt()),
+		},
+		Validators:         validators,
+		UnbondingFactor:    4,
+		AutoCompoundFactor: sdk.ZeroDec(),
+		Active:             true,
+		Flags:              &types.HostChainFlags{},
+	}
+}
+
+// AddHostChain connects the fixture to the host chain through a tendermint light client, opens its transfer channel
+// and interchain accounts and stores it together with the deposit of the current epoch, as its registration and
+// channel handshakes would. The host chain is updated with the ids and addresses it was given.
+func (f *Fixture) AddHostChain(hc *types.HostChain) *types.HostChain {
+	f.t.Helper()
+
+	clientID := f.IBCKeeper.ClientKeeper.GenerateClientIdentifier(f.Ctx, ibcexported.Tendermint)
+	f.IBCKeeper.ClientKeeper.SetClientState(f.Ctx, clientID, ibctm.NewClientState(
+		hc.ChainId,
+		ibctm.DefaultTrustLevel,
+		HostUnbondingTime*2/3,
+		HostUnbondingTime,
+		10*time.Second,
+		clienttypes.NewHeight(1, 1),
+		commitmenttypes.GetSDKSpecs(),
+		[]string{"upgrade", "upgradedIBCState"},
+	))
+
+	hc.ConnectionId = f.IBCKeeper.ConnectionKeeper.GenerateConnectionIdentifier(f.Ctx)
+	f.IBCKeeper.ConnectionKeeper.SetConnection(f.Ctx, hc.ConnectionId, connectiontypes.NewConnectionEnd(
+		connectiontypes.OPEN,
+		clientID,
+		connectiontypes.NewCounterparty(clientID, hc.ConnectionId, commitmenttypes.NewMerklePrefix([]byte(ibcexported.StoreKey))),
+		connectiontypes.ExportedVersionsToProto(connectiontypes.GetCompatibleVersions()),
+		0,
+	))
+
+	hc.PortId = ibctransfertypes.PortID
+	hc.ChannelId = openChannel(
+		f.Ctx,
+		f.IBCKeeper,
+		hc.PortId,
+		channeltypes.UNORDERED,
+		hc.ConnectionId,
+		ibctransfertypes.PortID,
+	)
+	f.TransferKeeper.SetDenomTrace(
+		ibctransfertypes.ParseDenomTrace(ibctransfertypes.GetPrefixedDenom(hc.PortId, hc.ChannelId, hc.HostDenom)),
+	)
+
+	for _, account := range []*types.ICAAccount{hc.DelegationAccount, hc.RewardsAccount} {
+		account.Address = HostAddress(hc.ChainId + "/" + account.Owner)
+		account.ChannelState = types.ICAAccount_ICA_CHANNEL_CREATED
+		f.ICAControllerKeeper.OpenInterchainAccount(f.Ctx, hc.ConnectionId, account.Owner, account.Address)
+	}
+
+	require.NoError(f.t, f.Keeper.SetHostChain(f.Ctx, hc))
+	f.Keeper.SetDeposit(f.Ctx, &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  sdk.NewCoin(hc.IBCDenom(), sdk.ZeroInt()),
+		Epoch:   f.EpochsKeeper.GetEpochInfo(f.Ctx, types.DelegationEpoch).CurrentEpoch,
+		State:   types.Deposit_DEPOSIT_PENDING,
+	})
+
+	return hc
+}
+
+// AcknowledgeTransfer delivers the acknowledgement of a transfer to the keeper, as the transfer middleware would once
+// the host chain received it. A failed acknowledgement carries the given error.
+func (f *Fixture) AcknowledgeTransfer(transfer Transfer, ackErr error) error {
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	if ackErr != nil {
+		ack = channeltypes.NewErrorAcknowledgement(ackErr)
+	}
+
+	return f.Keeper.OnAcknowledgementIBCTransferPacket(f.Ctx, transfer.Packet(), ack.Acknowledgement(), nil, nil)
+}
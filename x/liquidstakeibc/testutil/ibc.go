@@ -0,0 +1,305 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// ICATx is an interchain account transaction sent through the ICA controller msg server
+type ICATx struct {
+	ConnectionID string
+	Owner        string
+	ChannelID    string
+	Sequence     uint64
+	Msgs         []sdk.Msg
+}
+
+var _ types.ICAControllerKeeper = (*ICAControllerKeeper)(nil)
+
+// ICAControllerKeeper registers interchain accounts without a handshake and records the transactions sent through
+// them. Accounts only become usable once they are opened with OpenInterchainAccount.
+type ICAControllerKeeper struct {
+	cdc       codec.BinaryCodec
+	ibcKeeper *ibckeeper.Keeper
+
+	addresses map[string]string
+	channels  map[string]string
+
+	// Registrations holds the port of every account registration request
+	Registrations []string
+	// Txs holds every transaction sent, in order
+	Txs []ICATx
+}
+
+// NewICAControllerKeeper returns an ICA controller keeper opening its channels on the given IBC keeper
+func NewICAControllerKeeper(cdc codec.BinaryCodec, ibcKeeper *ibckeeper.Keeper) *ICAControllerKeeper {
+	return &ICAControllerKeeper{
+		cdc:       cdc,
+		ibcKeeper: ibcKeeper,
+		addresses: make(map[string]string),
+		channels:  make(map[string]string),
+	}
+}
+
+func (k *ICAControllerKeeper) RegisterInterchainAccount(_ sdk.Context, connectionID, owner, _ string) error {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return err
+	}
+
+	k.Registrations = append(k.Registrations, icaKey(connectionID, portID))
+	return nil
+}
+
+// OpenInterchainAccount completes the registration of an interchain account, opening an ordered channel for it
+func (k *ICAControllerKeeper) OpenInterchainAccount(ctx sdk.Context, connectionID, owner, address string) string {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		panic(err)
+	}
+
+	channelID := openChannel(ctx, k.ibcKeeper, portID, channeltypes.ORDERED, connectionID, icatypes.HostPortID)
+	k.addresses[icaKey(connectionID, portID)] = address
+	k.channels[icaKey(connectionID, portID)] = channelID
+
+	return channelID
+}
+
+// CloseInterchainAccount closes the channel of an interchain account
+func (k *ICAControllerKeeper) CloseInterchainAccount(ctx sdk.Context, connectionID, owner string) {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		panic(err)
+	}
+
+	channelID, found := k.channels[icaKey(connectionID, portID)]
+	if !found {
+		return
+	}
+	channel, _ := k.ibcKeeper.ChannelKeeper.GetChannel(ctx, portID, channelID)
+	channel.State = channeltypes.CLOSED
+	k.ibcKeeper.ChannelKeeper.SetChannel(ctx, portID, channelID, channel)
+}
+
+func (k *ICAControllerKeeper) GetInterchainAccountAddress(_ sdk.Context, connectionID, portID string) (string, bool) {
+	address, found := k.addresses[icaKey(connectionID, portID)]
+	return address, found
+}
+
+func (k *ICAControllerKeeper) GetOpenActiveChannel(ctx sdk.Context, connectionID, portID string) (string, bool) {
+	channelID, found := k.channels[icaKey(connectionID, portID)]
+	if !found {
+		return "", false
+	}
+
+	channel, found := k.ibcKeeper.ChannelKeeper.GetChannel(ctx, portID, channelID)
+	if !found || channel.State != channeltypes.OPEN {
+		return "", false
+	}
+
+	return channelID, true
+}
+
+// MsgServer returns the ICA controller msg server the keeper sends its transactions through
+func (k *ICAControllerKeeper) MsgServer() icacontrollertypes.MsgServer {
+	return icaControllerMsgServer{k}
+}
+
+type icaControllerMsgServer struct {
+	*ICAControllerKeeper
+}
+
+func (s icaControllerMsgServer) RegisterInterchainAccount(
+	goCtx context.Context,
+	msg *icacontrollertypes.MsgRegisterInterchainAccount,
+) (*icacontrollertypes.MsgRegisterInterchainAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := s.ICAControllerKeeper.RegisterInterchainAccount(ctx, msg.ConnectionId, msg.Owner, msg.Version); err != nil {
+		return nil, err
+	}
+
+	return &icacontrollertypes.MsgRegisterInterchainAccountResponse{}, nil
+}
+
+func (s icaControllerMsgServer) SendTx(
+	goCtx context.Context,
+	msg *icacontrollertypes.MsgSendTx,
+) (*icacontrollertypes.MsgSendTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	channelID, found := s.GetOpenActiveChannel(ctx, msg.ConnectionId, portID)
+	if !found {
+		return nil, errorsmod.Wrapf(icatypes.ErrActiveChannelNotFound, "no active channel for port %s", portID)
+	}
+
+	msgs, err := icatypes.DeserializeCosmosTx(s.cdc, msg.PacketData.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	sequence := nextSequenceSend(ctx, s.ibcKeeper, portID, channelID)
+	s.Txs = append(s.Txs, ICATx{
+		ConnectionID: msg.ConnectionId,
+		Owner:        msg.Owner,
+		ChannelID:    channelID,
+		Sequence:     sequence,
+		Msgs:         msgs,
+	})
+
+	return &icacontrollertypes.MsgSendTxResponse{Sequence: sequence}, nil
+}
+
+// Transfer is an ibc transfer sent through the transfer msg server
+type Transfer struct {
+	Msg      ibctransfertypes.MsgTransfer
+	Sequence uint64
+}
+
+var _ types.IBCTransferKeeper = (*TransferKeeper)(nil)
+
+// TransferKeeper escrows the tokens of the transfers sent through its msg server and records them, without sending
+// any packet. It resolves the denom traces registered through SetDenomTrace.
+type TransferKeeper struct {
+	bankKeeper *BankKeeper
+	ibcKeeper  *ibckeeper.Keeper
+
+	denomTraces map[string]ibctransfertypes.DenomTrace
+
+	// Transfers holds every transfer sent, in order
+	Transfers []Transfer
+}
+
+// NewTransferKeeper returns a transfer keeper escrowing tokens on the given bank keeper
+func NewTransferKeeper(bankKeeper *BankKeeper, ibcKeeper *ibckeeper.Keeper) *TransferKeeper {
+	return &TransferKeeper{
+		bankKeeper:  bankKeeper,
+		ibcKeeper:   ibcKeeper,
+		denomTraces: make(map[string]ibctransfertypes.DenomTrace),
+	}
+}
+
+// SetDenomTrace registers a denom trace so it can be resolved from its hash
+func (k *TransferKeeper) SetDenomTrace(denomTrace ibctransfertypes.DenomTrace) {
+	k.denomTraces[denomTrace.Hash().String()] = denomTrace
+}
+
+func (k *TransferKeeper) GetDenomTrace(_ sdk.Context, denomTraceHash tmbytes.HexBytes) (ibctransfertypes.DenomTrace, bool) {
+	denomTrace, found := k.denomTraces[denomTraceHash.String()]
+	return denomTrace, found
+}
+
+func (k *TransferKeeper) Transfer(
+	goCtx context.Context,
+	msg *ibctransfertypes.MsgTransfer,
+) (*ibctransfertypes.MsgTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	channel, found := k.ibcKeeper.ChannelKeeper.GetChannel(ctx, msg.SourcePort, msg.SourceChannel)
+	if !found || channel.State != channeltypes.OPEN {
+		return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port %s, channel %s", msg.SourcePort, msg.SourceChannel)
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	escrow := ibctransfertypes.GetEscrowAddress(msg.SourcePort, msg.SourceChannel)
+	if err = k.bankKeeper.SendCoins(ctx, sender, escrow, sdk.NewCoins(msg.Token)); err != nil {
+		return nil, err
+	}
+
+	sequence := nextSequenceSend(ctx, k.ibcKeeper, msg.SourcePort, msg.SourceChannel)
+	k.Transfers = append(k.Transfers, Transfer{Msg: *msg, Sequence: sequence})
+
+	return &ibctransfertypes.MsgTransferResponse{Sequence: sequence}, nil
+}
+
+// Packet returns the packet the transfer would have been sent in
+func (t Transfer) Packet() channeltypes.Packet {
+	data := ibctransfertypes.NewFungibleTokenPacketData(
+		t.Msg.Token.Denom,
+		t.Msg.Token.Amount.String(),
+		t.Msg.Sender,
+		t.Msg.Receiver,
+		t.Msg.Memo,
+	)
+
+	return channeltypes.NewPacket(
+		data.GetBytes(),
+		t.Sequence,
+		t.Msg.SourcePort,
+		t.Msg.SourceChannel,
+		t.Msg.SourcePort,
+		t.Msg.SourceChannel,
+		t.Msg.TimeoutHeight,
+		t.Msg.TimeoutTimestamp,
+	)
+}
+
+// openChannel stores an open channel over a connection and returns its id
+func openChannel(
+	ctx sdk.Context,
+	ibcKeeper *ibckeeper.Keeper,
+	portID string,
+	order channeltypes.Order,
+	connectionID string,
+	counterpartyPortID string,
+) string {
+	channelID := ibcKeeper.ChannelKeeper.GenerateChannelIdentifier(ctx)
+	ibcKeeper.ChannelKeeper.SetChannel(ctx, portID, channelID, channeltypes.NewChannel(
+		channeltypes.OPEN,
+		order,
+		channeltypes.NewCounterparty(counterpartyPortID, channelID),
+		[]string{connectionID},
+		"",
+	))
+	ibcKeeper.ChannelKeeper.SetNextSequenceSend(ctx, portID, channelID, 1)
+
+	return channelID
+}
+
+// nextSequenceSend returns the sequence of the next packet sent over a channel and moves it forward
+func nextSequenceSend(ctx sdk.Context, ibcKeeper *ibckeeper.Keeper, portID, channelID string) uint64 {
+	sequence, found := ibcKeeper.ChannelKeeper.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		sequence = 1
+	}
+	ibcKeeper.ChannelKeeper.SetNextSequenceSend(ctx, portID, channelID, sequence+1)
+
+	return sequence
+}
+
+func icaKey(connectionID, portID string) string {
+	return connectionID + "/" + portID
+}
+
+// stakingKeeper is the minimal staking keeper the IBC client keeper needs to be built
+type stakingKeeper struct {
+	unbondingTime time.Duration
+}
+
+func (stakingKeeper) GetHistoricalInfo(sdk.Context, int64) (stakingtypes.HistoricalInfo, bool) {
+	return stakingtypes.HistoricalInfo{}, false
+}
+
+func (k stakingKeeper) UnbondingTime(sdk.Context) time.Duration {
+	return k.unbondingTime
+}
@@ -0,0 +1,218 @@
+// Package testutil builds a liquidstakeibc keeper on top of in-memory stores and lightweight bank, ICA, ICQ,
+// transfer, epochs and gov keepers, so chains embedding the module can write their own keeper tests without wiring
+// a full app. The ICA and transfer keepers record what the module sends instead of relaying packets, acks and
+// query responses are delivered by calling the keeper hooks and callbacks directly.
+package testutil
+
+import (
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ica "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts"
+	icacontrollertypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
+	"github.com/cosmos/ibc-go/v7/modules/apps/transfer"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	ibc "github.com/cosmos/ibc-go/v7/modules/core"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
+	ibctypes "github.com/cosmos/ibc-go/v7/modules/core/types"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+const (
+	// ChainID is the chain id of the controller chain the fixture runs on
+	ChainID = "pstake-testutil"
+	// bankStoreKey is the store key of the fixture bank keeper
+	bankStoreKey = "testutil_bank"
+)
+
+// Fixture is a liquidstakeibc keeper with its dependencies and the context it runs on
+type Fixture struct {
+	t testing.TB
+
+	Ctx       sdk.Context
+	Cdc       codec.Codec
+	Keeper    *keeper.Keeper
+	MsgServer types.MsgServer
+	// Admin is the module admin address set in the params
+	Admin sdk.AccAddress
+	// Authority is the gov module address the keeper accepts proposals from
+	Authority string
+
+	AccountKeeper       *AccountKeeper
+	BankKeeper          *BankKeeper
+	ICAControllerKeeper *ICAControllerKeeper
+	ICQKeeper           *ICQKeeper
+	EpochsKeeper        *EpochsKeeper
+	TransferKeeper      *TransferKeeper
+	GovKeeper           *GovKeeper
+	DistributionKeeper  *DistributionKeeper
+	IBCKeeper           *ibckeeper.Keeper
+}
+
+// NewFixture builds a liquidstakeibc keeper with the default params and every epoch at number 1
+func NewFixture(t testing.TB) *Fixture {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(
+		auth.AppModuleBasic{},
+		bank.AppModuleBasic{},
+		staking.AppModuleBasic{},
+		distribution.AppModuleBasic{},
+		ibc.AppModuleBasic{},
+		ibctm.AppModuleBasic{},
+		transfer.AppModuleBasic{},
+		ica.AppModuleBasic{},
+		liquidstakeibc.AppModuleBasic{},
+	)
+
+	keys := sdk.NewKVStoreKeys(
+		types.StoreKey,
+		bankStoreKey,
+		ibcexported.StoreKey,
+		upgradetypes.StoreKey,
+		capabilitytypes.StoreKey,
+		paramstypes.StoreKey,
+	)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
+
+	cms := store.NewCommitMultiStore(dbm.NewMemDB())
+	for _, key := range keys {
+		cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	for _, key := range tkeys {
+		cms.MountStoreWithDB(key, storetypes.StoreTypeTransient, nil)
+	}
+	for _, key := range memKeys {
+		cms.MountStoreWithDB(key, storetypes.StoreTypeMemory, nil)
+	}
+	require.NoError(t, cms.LoadLatestVersion())
+
+	startTime := time.Now().UTC().Truncate(time.Second)
+	ctx := sdk.NewContext(cms, tmproto.Header{ChainID: ChainID, Height: 1, Time: startTime}, false, log.NewNopLogger())
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	capabilityKeeper := capabilitykeeper.NewKeeper(
+		encCfg.Codec,
+		keys[capabilitytypes.StoreKey],
+		memKeys[capabilitytypes.MemStoreKey],
+	)
+	ibcKeeper := ibckeeper.NewKeeper(
+		encCfg.Codec,
+		keys[ibcexported.StoreKey],
+		paramstypes.NewSubspace(
+			encCfg.Codec,
+			encCfg.Amino,
+			keys[paramstypes.StoreKey],
+			tkeys[paramstypes.TStoreKey],
+			ibcexported.ModuleName,
+		),
+		stakingKeeper{unbondingTime: 21 * 24 * time.Hour},
+		upgradekeeper.NewKeeper(nil, keys[upgradetypes.StoreKey], encCfg.Codec, t.TempDir(), nil, authority),
+		capabilityKeeper.ScopeToModule(ibcexported.ModuleName),
+	)
+	ibc.InitGenesis(ctx, *ibcKeeper, ibctypes.DefaultGenesisState())
+
+	f := &Fixture{
+		t:                   t,
+		Ctx:                 ctx,
+		Cdc:                 encCfg.Codec,
+		Authority:           authority,
+		AccountKeeper:       NewAccountKeeper(),
+		BankKeeper:          NewBankKeeper(keys[bankStoreKey]),
+		ICAControllerKeeper: NewICAControllerKeeper(encCfg.Codec, ibcKeeper),
+		ICQKeeper:           NewICQKeeper(),
+		EpochsKeeper:        NewEpochsKeeper(startTime),
+		GovKeeper:           NewGovKeeper(),
+		IBCKeeper:           ibcKeeper,
+	}
+	f.TransferKeeper = NewTransferKeeper(f.BankKeeper, ibcKeeper)
+	f.DistributionKeeper = NewDistributionKeeper(f.BankKeeper)
+
+	msgRouter := baseapp.NewMsgServiceRouter()
+	msgRouter.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	icacontrollertypes.RegisterMsgServer(msgRouter, f.ICAControllerKeeper.MsgServer())
+	ibctransfertypes.RegisterMsgServer(msgRouter, f.TransferKeeper)
+
+	k := keeper.NewKeeper(
+		encCfg.Codec,
+		keys[types.StoreKey],
+		f.AccountKeeper,
+		f.BankKeeper,
+		f.EpochsKeeper,
+		f.ICAControllerKeeper,
+		ibcKeeper,
+		f.TransferKeeper,
+		f.ICQKeeper,
+		f.GovKeeper,
+		f.DistributionKeeper,
+		paramstypes.NewSubspace(
+			encCfg.Codec,
+			encCfg.Amino,
+			keys[paramstypes.StoreKey],
+			tkeys[paramstypes.TStoreKey],
+			types.ModuleName,
+		),
+		msgRouter,
+		authority,
+	)
+	f.Keeper = &k
+	f.MsgServer = keeper.NewMsgServerImpl(k)
+
+	f.Admin = authtypes.NewModuleAddress("testutil_admin")
+	params := types.DefaultParams()
+	params.AdminAddress = f.Admin.String()
+	f.Keeper.SetParams(ctx, params)
+
+	return f
+}
+
+// NextBlock moves the context to the next block, the given duration later
+func (f *Fixture) NextBlock(duration time.Duration) {
+	f.Ctx = f.Ctx.
+		WithBlockHeight(f.Ctx.BlockHeight() + 1).
+		WithBlockTime(f.Ctx.BlockTime().Add(duration)).
+		WithEventManager(sdk.NewEventManager())
+}
+
+// BeginBlock moves the context to the next block, the given duration later, and runs the module begin blocker
+func (f *Fixture) BeginBlock(duration time.Duration) {
+	f.NextBlock(duration)
+	f.Keeper.BeginBlock(f.Ctx)
+}
+
+// FundAccount mints coins into an account
+func (f *Fixture) FundAccount(addr sdk.AccAddress, amt sdk.Coins) {
+	f.BankKeeper.FundAccount(f.Ctx, addr, amt)
+}
+
+// FundModuleAccount mints coins into a module account
+func (f *Fixture) FundModuleAccount(moduleName string, amt sdk.Coins) {
+	f.BankKeeper.FundAccount(f.Ctx, authtypes.NewModuleAddress(moduleName), amt)
+}
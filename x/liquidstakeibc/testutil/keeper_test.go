@@ -0,0 +1,68 @@
+package testutil_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/testutil"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func TestFixtureDepositFlow(t *testing.T) {
+	f := testutil.NewFixture(t)
+	hc := f.AddHostChain(testutil.NewHostChain("hub-1", "uatom", testutil.NewValidators("hub-1", 3)))
+
+	chainID, err := f.Keeper.GetChainID(f.Ctx, hc.ConnectionId)
+	require.NoError(t, err)
+	require.Equal(t, hc.ChainId, chainID)
+	require.NoError(t, f.Keeper.ValidateHostChainChannels(f.Ctx, hc))
+
+	delegator := authtypes.NewModuleAddress("delegator")
+	f.FundAccount(delegator, sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), 1000)))
+	_, err = f.MsgServer.LiquidStake(f.Ctx, types.NewMsgLiquidStake(sdk.NewInt64Coin(hc.IBCDenom(), 1000), delegator))
+	require.NoError(t, err)
+	require.Equal(t, int64(990), f.BankKeeper.GetBalance(f.Ctx, delegator, hc.MintDenom()).Amount.Int64())
+
+	epoch := f.EpochsKeeper.GetEpochInfo(f.Ctx, types.DelegationEpoch).CurrentEpoch
+	require.Equal(t, epoch+1, f.AdvanceEpoch(types.DelegationEpoch))
+
+	// the deposit of the previous epoch is sent to the delegation account
+	require.Len(t, f.TransferKeeper.Transfers, 1)
+	transfer := f.TransferKeeper.Transfers[0]
+	require.Equal(t, hc.DelegationAccount.Address, transfer.Msg.Receiver)
+	require.Equal(t, int64(1000), transfer.Msg.Token.Amount.Int64())
+	escrow := ibctransfertypes.GetEscrowAddress(hc.PortId, hc.ChannelId)
+	require.Equal(t, int64(1000), f.BankKeeper.GetBalance(f.Ctx, escrow, hc.IBCDenom()).Amount.Int64())
+
+	deposits := f.Keeper.GetDepositsForHostChain(f.Ctx, hc.ChainId)
+	sent := 0
+	for _, deposit := range deposits {
+		if deposit.State == types.Deposit_DEPOSIT_SENT {
+			sent++
+			require.Equal(t, f.Keeper.GetTransactionSequenceID(hc.PortId, hc.ChannelId, transfer.Sequence), deposit.IbcSequenceId)
+		}
+	}
+	require.Equal(t, 1, sent)
+
+	// once the transfer is acknowledged the deposit is delegated through the delegation account
+	require.NoError(t, f.AcknowledgeTransfer(transfer, nil))
+	require.Len(t, f.Keeper.GetDelegableDepositsForChain(f.Ctx, hc.ChainId), 1)
+
+	f.BeginBlock(time.Second)
+	require.Len(t, f.ICAControllerKeeper.Txs, 1)
+	tx := f.ICAControllerKeeper.Txs[0]
+	require.Equal(t, hc.DelegationAccount.Owner, tx.Owner)
+	require.Len(t, tx.Msgs, 3)
+	for _, msg := range tx.Msgs {
+		delegate, ok := msg.(*stakingtypes.MsgDelegate)
+		require.True(t, ok)
+		require.Equal(t, hc.DelegationAccount.Address, delegate.DelegatorAddress)
+	}
+	require.Len(t, f.Keeper.GetDelegatingDepositsForChain(f.Ctx, hc.ChainId), 1)
+}
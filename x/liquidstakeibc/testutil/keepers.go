@@ -0,0 +1,223 @@
+package testutil
+
+import (
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	epochstypes "github.com/persistenceOne/persistence-sdk/v2/x/epochs/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+var _ types.AccountKeeper = (*AccountKeeper)(nil)
+
+// AccountKeeper returns module accounts addressed by their module name and the accounts set through SetAccount
+type AccountKeeper struct {
+	accounts map[string]authtypes.AccountI
+}
+
+// NewAccountKeeper returns an empty account keeper
+func NewAccountKeeper() *AccountKeeper {
+	return &AccountKeeper{accounts: make(map[string]authtypes.AccountI)}
+}
+
+// SetAccount stores an account, such as a vesting account
+func (k *AccountKeeper) SetAccount(account authtypes.AccountI) {
+	k.accounts[account.GetAddress().String()] = account
+}
+
+func (k *AccountKeeper) GetAccount(_ sdk.Context, addr sdk.AccAddress) authtypes.AccountI {
+	account, found := k.accounts[addr.String()]
+	if !found {
+		return nil
+	}
+
+	return account
+}
+
+func (k *AccountKeeper) GetModuleAccount(_ sdk.Context, moduleName string) authtypes.ModuleAccountI {
+	return authtypes.NewEmptyModuleAccount(moduleName, authtypes.Minter, authtypes.Burner)
+}
+
+// ICQRequest is an interchain query requested through the ICQ keeper
+type ICQRequest struct {
+	ConnectionID string
+	ChainID      string
+	QueryType    string
+	Request      []byte
+	Period       math.Int
+	Module       string
+	CallbackID   string
+	TTL          uint64
+}
+
+var _ types.ICQKeeper = (*ICQKeeper)(nil)
+
+// ICQKeeper records the interchain queries requested, their responses are delivered by calling the keeper
+// callbacks directly
+type ICQKeeper struct {
+	// Requests holds every query requested, in order
+	Requests []ICQRequest
+}
+
+// NewICQKeeper returns an ICQ keeper without requests
+func NewICQKeeper() *ICQKeeper {
+	return &ICQKeeper{}
+}
+
+func (k *ICQKeeper) MakeRequest(
+	_ sdk.Context,
+	connectionID, chainID, queryType string,
+	request []byte,
+	period math.Int,
+	module, callbackID string,
+	ttl uint64,
+) {
+	k.Requests = append(k.Requests, ICQRequest{
+		ConnectionID: connectionID,
+		ChainID:      chainID,
+		QueryType:    queryType,
+		Request:      request,
+		Period:       period,
+		Module:       module,
+		CallbackID:   callbackID,
+		TTL:          ttl,
+	})
+}
+
+// RequestsFor returns the requests made for a callback id of a host chain
+func (k *ICQKeeper) RequestsFor(chainID, callbackID string) []ICQRequest {
+	requests := make([]ICQRequest, 0)
+	for _, request := range k.Requests {
+		if request.ChainID == chainID && request.CallbackID == callbackID {
+			requests = append(requests, request)
+		}
+	}
+
+	return requests
+}
+
+var _ types.EpochsKeeper = (*EpochsKeeper)(nil)
+
+// EpochsKeeper holds the epochs the module relies on, they only move when advanced by the fixture
+type EpochsKeeper struct {
+	epochs map[string]epochstypes.EpochInfo
+}
+
+// NewEpochsKeeper returns an epochs keeper with the day and hour epochs the module runs on started at epoch 1
+func NewEpochsKeeper(startTime time.Time) *EpochsKeeper {
+	k := &EpochsKeeper{epochs: make(map[string]epochstypes.EpochInfo)}
+	for identifier, duration := range map[string]time.Duration{
+		types.DelegationEpoch: 24 * time.Hour,
+		types.CValueEpoch:     time.Hour,
+	} {
+		k.SetEpochInfo(epochstypes.EpochInfo{
+			Identifier:            identifier,
+			StartTime:             startTime,
+			Duration:              duration,
+			CurrentEpoch:          1,
+			CurrentEpochStartTime: startTime,
+			EpochCountingStarted:  true,
+		})
+	}
+
+	return k
+}
+
+// SetEpochInfo stores an epoch
+func (k *EpochsKeeper) SetEpochInfo(info epochstypes.EpochInfo) {
+	k.epochs[info.Identifier] = info
+}
+
+func (k *EpochsKeeper) GetEpochInfo(_ sdk.Context, identifier string) epochstypes.EpochInfo {
+	return k.epochs[identifier]
+}
+
+var _ types.GovKeeper = (*GovKeeper)(nil)
+
+// GovKeeper holds the proposals set through SetProposal in its deposit or voting period queue
+type GovKeeper struct {
+	proposals map[uint64]govv1.Proposal
+}
+
+// NewGovKeeper returns a gov keeper without proposals
+func NewGovKeeper() *GovKeeper {
+	return &GovKeeper{proposals: make(map[uint64]govv1.Proposal)}
+}
+
+// SetProposal stores a proposal
+func (k *GovKeeper) SetProposal(proposal govv1.Proposal) {
+	k.proposals[proposal.Id] = proposal
+}
+
+func (k *GovKeeper) IterateActiveProposalsQueue(
+	_ sdk.Context,
+	endTime time.Time,
+	cb func(proposal govv1.Proposal) (stop bool),
+) {
+	k.iterateProposals(govv1.StatusVotingPeriod, endTime, cb)
+}
+
+func (k *GovKeeper) IterateInactiveProposalsQueue(
+	_ sdk.Context,
+	endTime time.Time,
+	cb func(proposal govv1.Proposal) (stop bool),
+) {
+	k.iterateProposals(govv1.StatusDepositPeriod, endTime, cb)
+}
+
+func (k *GovKeeper) iterateProposals(
+	status govv1.ProposalStatus,
+	endTime time.Time,
+	cb func(proposal govv1.Proposal) (stop bool),
+) {
+	ids := make([]uint64, 0, len(k.proposals))
+	for id := range k.proposals {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for _, id := range ids {
+		proposal := k.proposals[id]
+		if proposal.Status != status {
+			continue
+		}
+
+		queueTime := proposal.DepositEndTime
+		if status == govv1.StatusVotingPeriod {
+			queueTime = proposal.VotingEndTime
+		}
+		if queueTime != nil && queueTime.After(endTime) {
+			continue
+		}
+
+		if cb(proposal) {
+			return
+		}
+	}
+}
+
+var _ types.DistributionKeeper = (*DistributionKeeper)(nil)
+
+// DistributionKeeper keeps the community pool as the bank balance of the distribution module account
+type DistributionKeeper struct {
+	bankKeeper *BankKeeper
+}
+
+// NewDistributionKeeper returns a distribution keeper moving the community pool funds through the given bank keeper
+func NewDistributionKeeper(bankKeeper *BankKeeper) *DistributionKeeper {
+	return &DistributionKeeper{bankKeeper: bankKeeper}
+}
+
+func (k *DistributionKeeper) DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error {
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, distrtypes.ModuleName, receiveAddr, amount)
+}
+
+func (k *DistributionKeeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, distrtypes.ModuleName, amount)
+}
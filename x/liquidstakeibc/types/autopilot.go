@@ -0,0 +1,55 @@
+package types
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// AutopilotLiquidStake asks for the tokens of an incoming transfer to be liquid staked,
+// minting the stk tokens to the receiver.
+type AutopilotLiquidStake struct {
+	Receiver string `json:"receiver"`
+	// Forward optionally sends the minted stk tokens on to another chain
+	Forward *AutopilotForward `json:"forward,omitempty"`
+}
+
+// AutopilotForward asks for the minted stk tokens to be transferred from the liquid stake receiver
+// to an account on another chain, over a transfer channel of this chain.
+type AutopilotForward struct {
+	Channel  string `json:"channel"`
+	Receiver string `json:"receiver"`
+}
+
+// AutopilotMemo is the transfer memo the module acts on. Memos without any of its fields
+// are left to the other modules.
+type AutopilotMemo struct {
+	LiquidStake *AutopilotLiquidStake `json:"liquidstake,omitempty"`
+}
+
+// ParseAutopilotMemo returns the liquid stake a transfer memo asks for, nil if it doesn't ask for one.
+func ParseAutopilotMemo(memo string) (*AutopilotLiquidStake, error) {
+	var autopilot AutopilotMemo
+	// memos which are not for the module are left as they are
+	if json.Unmarshal([]byte(memo), &autopilot) != nil || autopilot.LiquidStake == nil {
+		return nil, nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(autopilot.LiquidStake.Receiver); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid autopilot receiver %s: %s", autopilot.LiquidStake.Receiver, err)
+	}
+
+	if forward := autopilot.LiquidStake.Forward; forward != nil {
+		if err := host.ChannelIdentifierValidator(forward.Channel); err != nil {
+			return nil, errorsmod.Wrapf(ErrInvalidAutopilotTransfer, "invalid autopilot forward channel %s: %s", forward.Channel, err)
+		}
+		if forward.Receiver == "" {
+			return nil, errorsmod.Wrap(ErrInvalidAutopilotTransfer, "autopilot forward receiver can't be empty")
+		}
+	}
+
+	return autopilot.LiquidStake, nil
+}
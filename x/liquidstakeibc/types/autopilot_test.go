@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func TestParseAutopilotMemo(t *testing.T) {
+	tests := []struct {
+		name     string
+		memo     string
+		receiver string
+		wantErr  bool
+	}{
+		{name: "empty memo", memo: ""},
+		{name: "plain text memo", memo: "gift"},
+		{name: "memo for another module", memo: `{"wasm":{"contract":"persistence1"}}`},
+		{
+			name:     "liquid stake",
+			memo:     `{"liquidstake":{"receiver":"` + addr1.String() + `"}}`,
+			receiver: addr1.String(),
+		},
+		{name: "invalid receiver", memo: `{"liquidstake":{"receiver":"persistence1"}}`, wantErr: true},
+		{name: "missing receiver", memo: `{"liquidstake":{}}`, wantErr: true},
+		{
+			name:     "liquid stake and forward",
+			memo:     `{"liquidstake":{"receiver":"` + addr1.String() + `","forward":{"channel":"channel-1","receiver":"osmo1receiver"}}}`,
+			receiver: addr1.String(),
+		},
+		{
+			name:    "invalid forward channel",
+			memo:    `{"liquidstake":{"receiver":"` + addr1.String() + `","forward":{"channel":"","receiver":"osmo1receiver"}}}`,
+			wantErr: true,
+		},
+		{
+			name:    "missing forward receiver",
+			memo:    `{"liquidstake":{"receiver":"` + addr1.String() + `","forward":{"channel":"channel-1"}}}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			autopilot, err := types.ParseAutopilotMemo(tt.memo)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tt.receiver == "" {
+				require.Nil(t, autopilot)
+				return
+			}
+			require.Equal(t, tt.receiver, autopilot.Receiver)
+		})
+	}
+}
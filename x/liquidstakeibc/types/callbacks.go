@@ -0,0 +1,52 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CallbackGasLimit is the gas a callback contract execution can consume, out of the gas left to the caller
+const CallbackGasLimit uint64 = 1_000_000
+
+// TransferCallback holds the information passed to the callback contract
+// when an expected transfer from a host chain is received.
+type TransferCallback struct {
+	ChainID string `json:"chain_id"`
+	Denom   string `json:"denom"`
+	Amount  string `json:"amount"`
+	Epoch   int64  `json:"epoch"`
+}
+
+// ContractCallbackMsg is the execute message sent to the callback contract.
+// Only one of the fields is set for every callback.
+type ContractCallbackMsg struct {
+	AutocompoundReceived *TransferCallback `json:"autocompound_received,omitempty"`
+	UnbondingReceived    *TransferCallback `json:"unbonding_received,omitempty"`
+}
+
+func NewAutocompoundReceivedCallback(chainID string, amount sdk.Coin, epoch int64) ContractCallbackMsg {
+	return ContractCallbackMsg{
+		AutocompoundReceived: &TransferCallback{
+			ChainID: chainID,
+			Denom:   amount.Denom,
+			Amount:  amount.Amount.String(),
+			Epoch:   epoch,
+		},
+	}
+}
+
+func NewUnbondingReceivedCallback(chainID string, amount sdk.Coin, epoch int64) ContractCallbackMsg {
+	return ContractCallbackMsg{
+		UnbondingReceived: &TransferCallback{
+			ChainID: chainID,
+			Denom:   amount.Denom,
+			Amount:  amount.Amount.String(),
+			Epoch:   epoch,
+		},
+	}
+}
+
+func (m ContractCallbackMsg) Bytes() ([]byte, error) {
+	return json.Marshal(m)
+}
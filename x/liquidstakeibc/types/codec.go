@@ -19,6 +19,20 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgLiquidUnstake{}, "pstake/MsgLiquidUnstake")
 	legacy.RegisterAminoMsg(cdc, &MsgRedeem{}, "pstake/MsgRedeem")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "pstake/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgTransferUnbonding{}, "pstake/MsgTransferUnbonding")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelValidatorExit{}, "pstake/MsgCancelValidatorExit")
+	legacy.RegisterAminoMsg(cdc, &MsgMigrateHostChainChannel{}, "pstake/MsgMigrateHostChainChannel")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelUnbonding{}, "pstake/MsgCancelUnbonding")
+	legacy.RegisterAminoMsg(cdc, &MsgSetCValue{}, "pstake/MsgSetCValue")
+	legacy.RegisterAminoMsg(cdc, &MsgRetryTransfer{}, "pstake/MsgRetryTransfer")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelParamChange{}, "pstake/MsgCancelParamChange")
+	legacy.RegisterAminoMsg(cdc, &MsgPauseHostChain{}, "pstake/MsgPauseHostChain")
+	legacy.RegisterAminoMsg(cdc, &MsgResumeHostChain{}, "pstake/MsgResumeHostChain")
+	legacy.RegisterAminoMsg(cdc, &MsgForceReconcileDelegations{}, "pstake/MsgForceReconcileDelegations")
+	legacy.RegisterAminoMsg(cdc, &MsgSetFeeAddress{}, "pstake/MsgSetFeeAddress")
+	legacy.RegisterAminoMsg(cdc, &MsgExitValidator{}, "pstake/MsgExitValidator")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAutoClaim{}, "pstake/MsgSetAutoClaim")
+	legacy.RegisterAminoMsg(cdc, &MsgClaim{}, "pstake/MsgClaim")
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -30,6 +44,20 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgLiquidUnstake{},
 		&MsgRedeem{},
 		&MsgUpdateParams{},
+		&MsgTransferUnbonding{},
+		&MsgCancelValidatorExit{},
+		&MsgMigrateHostChainChannel{},
+		&MsgCancelUnbonding{},
+		&MsgSetCValue{},
+		&MsgRetryTransfer{},
+		&MsgCancelParamChange{},
+		&MsgPauseHostChain{},
+		&MsgResumeHostChain{},
+		&MsgForceReconcileDelegations{},
+		&MsgSetFeeAddress{},
+		&MsgExitValidator{},
+		&MsgSetAutoClaim{},
+		&MsgClaim{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -26,4 +26,30 @@ var (
 	ErrLSMDepositProcessing     = errorsmod.Register(ModuleName, 2020, "already processing LSM deposit")
 	ErrLSMValidatorInvalidState = errorsmod.Register(ModuleName, 2021, "validator invalid state")
 	ErrInsufficientDeposits     = errorsmod.Register(ModuleName, 2022, "insufficient deposits")
+	ErrChannelNotOpen           = errorsmod.Register(ModuleName, 2023, "ibc channel is not open")
+	ErrInvalidValidatorSet      = errorsmod.Register(ModuleName, 2024, "invalid validator set")
+	ErrUnbondingNotFound        = errorsmod.Register(ModuleName, 2025, "unbonding not found")
+	ErrLockedVestingCoins       = errorsmod.Register(ModuleName, 2026, "vesting account coins are still locked")
+	ErrValidatorExitNotFound    = errorsmod.Register(ModuleName, 2027, "validator exit not found")
+	ErrChannelMigrationActive   = errorsmod.Register(ModuleName, 2028, "host chain channel migration in progress")
+	ErrInvalidChannelMigration  = errorsmod.Register(ModuleName, 2029, "invalid host chain channel migration")
+	ErrDuplicateSequenceID      = errorsmod.Register(ModuleName, 2030, "duplicate ibc sequence id")
+	ErrUnbondingNotCancellable  = errorsmod.Register(ModuleName, 2031, "unbonding can't be cancelled")
+	ErrDepositCapExceeded       = errorsmod.Register(ModuleName, 2032, "host chain deposit cap exceeded")
+	ErrCValueOutOfLimits        = errorsmod.Register(ModuleName, 2033, "c value out of the host chain limits")
+	ErrStkSupplyCapExceeded     = errorsmod.Register(ModuleName, 2034, "protocol stk supply cap exceeded")
+	ErrMinStkAmountOut          = errorsmod.Register(ModuleName, 2035, "stk amount out less than the minimum")
+	ErrMinTokensOut             = errorsmod.Register(ModuleName, 2036, "unbond amount less than the minimum")
+	ErrInvalidAutopilotTransfer = errorsmod.Register(ModuleName, 2037, "invalid autopilot transfer")
+	ErrLocalhostNotSupported    = errorsmod.Register(ModuleName, 2038, "not supported on localhost host chains")
+	ErrDepositNotRetryable      = errorsmod.Register(ModuleName, 2039, "deposit transfer can't be retried")
+	ErrParamChangeNotFound      = errorsmod.Register(ModuleName, 2040, "pending param change not found")
+	ErrWorkflowPaused           = errorsmod.Register(ModuleName, 2041, "host chain workflow paused")
+	ErrHostChainActive          = errorsmod.Register(ModuleName, 2042, "host chain is already active")
+	ErrHostChainSeeding         = errorsmod.Register(ModuleName, 2043, "host chain seed delegation pending")
+	ErrReconciliationPending    = errorsmod.Register(ModuleName, 2044, "delegation reconciliation pending")
+	ErrInvalidHostChainState    = errorsmod.Register(ModuleName, 2045, "invalid host chain state")
+	ErrValidatorNotExitable     = errorsmod.Register(ModuleName, 2046, "validator can't be exited")
+	ErrNoContractKeeper         = errorsmod.Register(ModuleName, 2047, "no contract keeper to execute the callback contract")
+	ErrUnbondingNotClaimable    = errorsmod.Register(ModuleName, 2048, "unbonding can't be claimed yet")
 )
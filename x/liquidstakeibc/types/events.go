@@ -3,25 +3,83 @@ package types
 const (
 	EventTypeLiquidStake                           = "liquid_stake"
 	EventTypeLiquidStakeLSM                        = "liquid_stake_lsm"
+	EventTypeAutopilotLiquidStake                  = "autopilot_liquid_stake"
+	EventTypeAutopilotForward                      = "autopilot_forward"
 	EventTypeLiquidUnstake                         = "liquid_unstake"
 	EventTypeRedeem                                = "redeem"
+	EventTypeTransferUnbonding                     = "transfer_unbonding"
+	EventTypeCancelUnbonding                       = "cancel_unbonding"
+	EventTypeSetAutoClaim                          = "set_auto_claim"
+	EventTypeRetryTransfer                         = "retry_transfer"
+	EventTypeCValueSet                             = "c_value_set"
+	EventTypeDepositReceipt                        = "deposit_receipt"
+	EventTypeVestingLock                           = "vesting_lock"
+	EventTypeVestingLockReleased                   = "vesting_lock_released"
 	EventTypePacket                                = "ics27_packet"
 	EventTypeTimeout                               = "timeout"
 	EventTypeSlashing                              = "validator_slash"
+	EventTypeSlashSuspected                        = "validator_slash_suspected"
 	EventTypeUpdateParams                          = "update_params"
+	EventTypeFeeAddressUpdated                     = "fee_address_updated"
+	EventTypeFeeAddressSet                         = "fee_address_set"
+	EventTypePendingFeesFlushed                    = "pending_fees_flushed"
+	EventTypeLiquidityIncentiveStreamed            = "liquidity_incentive_streamed"
 	EventTypeChainDisabled                         = "chain_disabled"
+	EventTypeChainDegraded                         = "chain_degraded"
+	EventTypeChainRecovered                        = "chain_recovered"
+	EventTypeWithdrawAddressMismatch               = "withdraw_address_mismatch"
+	EventTypeWithdrawAddressVerified               = "withdraw_address_verified"
+	EventTypeChannelMigrationStarted               = "channel_migration_started"
+	EventTypeChannelMigrationCompleted             = "channel_migration_completed"
+	EventTypeChannelMigrationCancelled             = "channel_migration_cancelled"
 	EventTypeCValueLimitsUpdated                   = "c_value_limits"
 	EventTypeValidatorStatusUpdate                 = "validator_status_update"
 	EventTypeValidatorExchangeRateUpdate           = "validator_exchange_rate_update"
+	EventTypeValidatorCommissionUpdate             = "validator_commission_update"
 	EventTypeValidatorDelegableStateUpdate         = "validator_delegable_state_update"
+	EventTypeValidatorSetBelowMinimum              = "validator_set_below_minimum"
+	EventTypeValidatorSetBootstrapped              = "validator_set_bootstrapped"
 	EventTypeDoDelegation                          = "send_delegation"
 	EventTypeDoDelegationDeposit                   = "send_individual_delegation"
 	EventTypeClaimedUnbondings                     = "claimed_unbondings"
+	EventTypeClaimsHeld                            = "claims_held"
+	EventTypeClaimedPendingMint                    = "claimed_pending_mint"
 	EventTypeRedeemTokensForShares                 = "redeem_lsm_tokens_shares"
 	EventTypeCValueUpdate                          = "c_value_update"
 	EventTypeDelegationWorkflow                    = "delegation_workflow"
+	EventTypeDepositShortfall                      = "deposit_shortfall"
+	EventTypeDepositStuck                          = "deposit_stuck"
+	EventTypeDepositReverted                       = "deposit_reverted"
+	EventTypeDepositRetry                          = "deposit_retry"
+	EventTypeDepositRetriesExhausted               = "deposit_retries_exhausted"
+	EventTypeDepositDelegated                      = "deposit_delegated"
 	EventTypeUndelegationWorkflow                  = "undelegation_workflow"
 	EventTypeValidatorUndelegationWorkflow         = "validator_undelegation_workflow"
+	EventTypeValidatorExitQueued                   = "validator_exit_queued"
+	EventTypeValidatorJailed                       = "validator_jailed"
+	EventTypeValidatorScoreUpdate                  = "validator_score_update"
+	EventTypeScoreWeightsUpdate                    = "score_weights_update"
+	EventTypeWeightTransitionStarted               = "weight_transition_started"
+	EventTypeUnbondingRolledOver                   = "unbonding_rolled_over"
+	EventTypeValidatorExitCancelled                = "validator_exit_cancelled"
+	EventTypeValidatorExitForced                   = "validator_exit_forced"
+	EventTypeParamChangeStaged                     = "param_change_staged"
+	EventTypeParamChangeApplied                    = "param_change_applied"
+	EventTypeParamChangeFailed                     = "param_change_failed"
+	EventTypeParamChangeCancelled                  = "param_change_cancelled"
+	EventTypeHostChainPaused                       = "host_chain_paused"
+	EventTypeHostChainResumed                      = "host_chain_resumed"
+	EventTypeHostChainSeeded                       = "host_chain_seeded"
+	EventTypeHostChainSeedDelegated                = "host_chain_seed_delegated"
+	EventTypeICATxRetryQueued                      = "ica_tx_retry_queued"
+	EventTypeICATxRetry                            = "ica_tx_retry"
+	EventTypeICATxRetriesExhausted                 = "ica_tx_retries_exhausted"
+	EventTypeValidatorDrain                        = "validator_drain"
+	EventTypeValidatorDrained                      = "validator_drained"
+	EventTypeCircuitBreakerTripped                 = "circuit_breaker_tripped"
+	EventTypeWorkflowFailure                       = "workflow_failure"
+	EventTypeDelegationDrift                       = "delegation_drift"
+	EventTypeForceReconcileDelegations             = "force_reconcile_delegations"
 	EventTypeRewardsWorkflow                       = "rewards_workflow"
 	EventTypeLSMWorkflow                           = "lsm_workflow"
 	EventTypeRewardsTransfer                       = "rewards_transfer"
@@ -32,7 +90,10 @@ const (
 	EventStakingDepositTransferTimeout             = "staking_deposit_timeout"
 	EventLSMDepositTransferReceived                = "lsm_deposit_received"
 	EventLSMDepositTransferTimeout                 = "lsm_deposit_timeout"
+	EventStakingDepositTransferFailed              = "staking_deposit_failed"
+	EventLSMDepositTransferFailed                  = "lsm_deposit_failed"
 	EventICAChannelCreated                         = "ica_channel_created"
+	EventICAChannelClosed                          = "ica_channel_closed"
 	EventSuccessfulDelegation                      = "successful_delegation"
 	EventSuccessfulUndelegation                    = "successful_undelegation"
 	EventBurn                                      = "stk-burn"
@@ -48,6 +109,8 @@ const (
 	EventUnsuccessfulLSMRedeem                     = "unsuccessful_lsm_redeem"
 	EventUnsuccessfulRedelegate                    = "unsuccessful_redelegate"
 	EventFailedClaimUnbondings                     = "failed_claim_unbondings"
+	EventFailedClaimPendingMint                    = "failed_claim_pending_mint"
+	EventFailedContractCallback                    = "failed_contract_callback"
 
 	AttributeInputAmount                     = "input_amount"
 	AttributeOutputAmount                    = "output_amount"
@@ -59,6 +122,8 @@ const (
 	AttributeChainID                         = "chain_id"
 	AttributeNewCValue                       = "new_c_value"
 	AttributeOldCValue                       = "old_c_value"
+	AttributeProposalID                      = "proposal_id"
+	AttributeJustification                   = "justification"
 	AttributeLowerLimit                      = "lower_limit"
 	AttributeUpperLimit                      = "upper_limit"
 	AttributeEpoch                           = "epoch_number"
@@ -66,6 +131,7 @@ const (
 	AttributeExistingDelegation              = "existing_delegation"
 	AttributeUpdatedDelegation               = "updated_delegation"
 	AttributeSlashedAmount                   = "slashed_amount"
+	AttributeReportedDelegation              = "reported_delegation"
 	AttributeKeyAuthority                    = "authority"
 	AttributeKeyUpdatedParams                = "updated_params"
 	AttributeKeyAck                          = "acknowledgement"
@@ -75,7 +141,11 @@ const (
 	AttributeKeyValidatorOldStatus           = "validator_old_status"
 	AttributeKeyValidatorNewExchangeRate     = "validator_new_exchange_rate"
 	AttributeKeyValidatorOldExchangeRate     = "validator_old_exchange_rate"
+	AttributeKeyValidatorNewCommission       = "validator_new_commission"
+	AttributeKeyValidatorOldCommission       = "validator_old_commission"
+	AttributeKeyRebateQualified              = "rebate_qualified"
 	AttributeKeyValidatorDelegable           = "validator_delegable"
+	AttributeKeyValidatorScore               = "validator_score"
 	AttributeTotalDelegatedAmount            = "total_delegated_amount"
 	AttributeIBCSequenceID                   = "ibc_sequence_id"
 	AttributeICAMessages                     = "ica_messages"
@@ -107,6 +177,54 @@ const (
 	AttributeRedelegatedAmount               = "redelegated_amount"
 	AttributeValidatorSrcAddress             = "redelegation_validator_src-address"
 	AttributeValidatorDstAddress             = "redelegation_validator_dst-address"
+	AttributeCallbackContractAddress         = "callback_contract_address"
+	AttributeCallbackError                   = "callback_error"
+	AttributeDepositReceiptID                = "deposit_receipt_id"
+	AttributeDegradedReason                  = "degraded_reason"
+	AttributeDepositShortfallAmount          = "deposit_shortfall_amount"
+	AttributeDepositState                    = "deposit_state"
+	AttributeDepositAgeEpochs                = "deposit_age_epochs"
+	AttributeDepositRetries                  = "deposit_retries"
+	AttributeActiveValidators                = "active_validators"
+	AttributeMinActiveValidators             = "min_active_validators"
+	AttributeBootstrapValidators             = "bootstrap_validators"
+	AttributeRecipientAddress                = "recipient_address"
+	AttributeFeeAddress                      = "fee_address"
+	AttributeOldFeeAddress                   = "old_fee_address"
+	AttributeFlushedFees                     = "flushed_fees"
+	AttributeWithdrawAddress                 = "withdraw_address"
+	AttributeExpectedWithdrawAddress         = "expected_withdraw_address"
+	AttributeWithdrawAddressReset            = "withdraw_address_reset"
+	AttributeForwardChannel                  = "forward_channel"
+	AttributeForwardReceiver                 = "forward_receiver"
+	AttributeLiquidityIncentiveAddress       = "liquidity_incentive_address"
+	AttributeLiquidityIncentiveAmount        = "liquidity_incentive_amount"
+	AttributeValidatorExitEpoch              = "validator_exit_epoch"
+	AttributeOldChannelID                    = "old_channel_id"
+	AttributeNewChannelID                    = "new_channel_id"
+	AttributeOldIBCDenom                     = "old_ibc_denom"
+	AttributeNewIBCDenom                     = "new_ibc_denom"
+	AttributeParamChangeKey                  = "param_key"
+	AttributeParamChangeValue                = "param_value"
+	AttributeParamChangeEffectiveTime        = "effective_time"
+	AttributeICATxRetryID                    = "retry_id"
+	AttributeICATxRetryType                  = "retry_type"
+	AttributeICATxRetryAttempts              = "retry_attempts"
+	AttributeICATxRetryNextHeight            = "next_attempt_height"
+	AttributeConsecutiveFailures             = "consecutive_failures"
+	AttributeCorrelationID                   = "correlation_id"
+	AttributePauseReason                     = "pause_reason"
+	AttributeSeedAmount                      = "seed_amount"
+	AttributeWorkflow                        = "workflow"
+	AttributeWorkflowError                   = "workflow_error"
+	AttributeDelegationDrift                 = "delegation_drift"
+	AttributeTransitionEpochs                = "transition_epochs"
+	AttributeAutoClaim                       = "auto_claim"
+	AttributeClaimHoldEpoch                  = "claim_hold_epoch"
+	AttributeHeldUnbondings                  = "held_unbondings"
+	AttributeLockedAmount                    = "locked_amount"
+	AttributeLockedStkAmount                 = "locked_stk_amount"
+	AttributeReleasedStkAmount               = "released_stk_amount"
 
 	AttributeValueCategory = ModuleName
 )
@@ -1,11 +1,14 @@
 package types
 
 import (
+	"time"
+
 	"cosmossdk.io/math"
 	tmbytes "github.com/cometbft/cometbft/libs/bytes"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	persistencetypes "github.com/persistenceOne/persistence-sdk/v2/x/epochs/types"
 )
@@ -20,9 +23,12 @@ type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 }
 
 type ScopedKeeper interface {
@@ -39,6 +45,16 @@ type ICQKeeper interface {
 	MakeRequest(ctx sdk.Context, connectionID, chainID, queryType string, request []byte, period math.Int, module, callbackID string, ttl uint64)
 }
 
+// LocalhostQuerier answers the interchain queries of localhost host chains from the state of the chain itself
+type LocalhostQuerier interface {
+	Query(ctx sdk.Context, queryType string, request []byte) ([]byte, error)
+}
+
+// ContractKeeper executes the callback contract notified about the received host chain transfers
+type ContractKeeper interface {
+	Execute(ctx sdk.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error)
+}
+
 type EpochsKeeper interface {
 	GetEpochInfo(ctx sdk.Context, identifier string) persistencetypes.EpochInfo
 }
@@ -46,3 +62,14 @@ type EpochsKeeper interface {
 type IBCTransferKeeper interface {
 	GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (transfertypes.DenomTrace, bool)
 }
+
+// DistributionKeeper pays the seed of a host chain out of the community pool and takes back its stk tokens
+type DistributionKeeper interface {
+	DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+type GovKeeper interface {
+	IterateActiveProposalsQueue(ctx sdk.Context, endTime time.Time, cb func(proposal govv1.Proposal) (stop bool))
+	IterateInactiveProposalsQueue(ctx sdk.Context, endTime time.Time, cb func(proposal govv1.Proposal) (stop bool))
+}
@@ -108,6 +108,48 @@ func (gs *GenesisState) Validate() error {
 			return err
 		}
 	}
+	for _, pendingMint := range gs.PendingMints {
+		hc, ok := hostChainMap[pendingMint.ChainId]
+		if !ok {
+			return fmt.Errorf("pending mint for chain %s doesn't have a valid chain id", pendingMint.ChainId)
+		}
+		if hc.MintDenom() != pendingMint.MintAmount.Denom {
+			return fmt.Errorf(
+				"pending mint for chain %s doesn't have the correct mint amount denom: %s, should be %s",
+				hc.ChainId,
+				pendingMint.MintAmount.Denom,
+				hc.MintDenom(),
+			)
+		}
+		if err := pendingMint.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, vestingLock := range gs.VestingLocks {
+		hc, ok := hostChainMap[vestingLock.ChainId]
+		if !ok {
+			return fmt.Errorf("vesting lock for chain %s doesn't have a valid chain id", vestingLock.ChainId)
+		}
+		if hc.MintDenom() != vestingLock.StkAmount.Denom {
+			return fmt.Errorf(
+				"vesting lock for chain %s doesn't have the correct stk amount denom: %s, should be %s",
+				hc.ChainId,
+				vestingLock.StkAmount.Denom,
+				hc.MintDenom(),
+			)
+		}
+		if hc.IBCDenom() != vestingLock.LockedAmount.Denom {
+			return fmt.Errorf(
+				"vesting lock for chain %s doesn't have the correct locked amount denom: %s, should be %s",
+				hc.ChainId,
+				vestingLock.LockedAmount.Denom,
+				hc.IBCDenom(),
+			)
+		}
+		if err := vestingLock.Validate(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -121,5 +163,7 @@ func DefaultGenesisState() *GenesisState {
 		Unbondings:          []*Unbonding{},
 		UserUnbondings:      []*UserUnbonding{},
 		ValidatorUnbondings: []*ValidatorUnbonding{},
+		PendingMints:        []*PendingMint{},
+		VestingLocks:        []*VestingLock{},
 	}
 }
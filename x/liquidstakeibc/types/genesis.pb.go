@@ -36,6 +36,10 @@ type GenesisState struct {
 	UserUnbondings []*UserUnbonding `protobuf:"bytes,5,rep,name=user_unbondings,json=userUnbondings,proto3" json:"user_unbondings,omitempty"`
 	// validator unbondings
 	ValidatorUnbondings []*ValidatorUnbonding `protobuf:"bytes,6,rep,name=validator_unbondings,json=validatorUnbondings,proto3" json:"validator_unbondings,omitempty"`
+	// stk tokens owed to delegators for deposits not delegated yet
+	PendingMints []*PendingMint `protobuf:"bytes,7,rep,name=pending_mints,json=pendingMints,proto3" json:"pending_mints,omitempty"`
+	// stk tokens held until the deposited coins of vesting accounts vest
+	VestingLocks []*VestingLock `protobuf:"bytes,8,rep,name=vesting_locks,json=vestingLocks,proto3" json:"vesting_locks,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -113,6 +117,20 @@ func (m *GenesisState) GetValidatorUnbondings() []*ValidatorUnbonding {
 	return nil
 }
 
+func (m *GenesisState) GetPendingMints() []*PendingMint {
+	if m != nil {
+		return m.PendingMints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVestingLocks() []*VestingLock {
+	if m != nil {
+		return m.VestingLocks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "pstake.liquidstakeibc.v1beta1.GenesisState")
 }
@@ -122,31 +140,34 @@ func init() {
 }
 
 var fileDescriptor_1d650226665335af = []byte{
-	// 373 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4d, 0x4b, 0xe3, 0x40,
-	0x18, 0xc7, 0x93, 0x6d, 0xb7, 0x2c, 0xd3, 0x65, 0x17, 0xb2, 0x3d, 0x84, 0xc2, 0xc6, 0x22, 0x28,
-	0xc5, 0x97, 0x0c, 0x8d, 0x9f, 0xc0, 0x56, 0xb0, 0x9e, 0x94, 0x48, 0x3d, 0xe8, 0xa1, 0x4c, 0x92,
-	0x21, 0x19, 0x6c, 0x67, 0x62, 0x9e, 0x49, 0xd0, 0x6f, 0xe1, 0xc7, 0xea, 0xb1, 0x47, 0x4f, 0x22,
-	0xed, 0xc9, 0x6f, 0x21, 0x9d, 0xb4, 0xf6, 0x45, 0x68, 0xbc, 0x3d, 0x33, 0xfc, 0x7f, 0xbf, 0xff,
-	0x73, 0x78, 0xd0, 0x61, 0x0c, 0x92, 0xdc, 0x53, 0x3c, 0x60, 0x0f, 0x29, 0x0b, 0xd4, 0xcc, 0x3c,
-	0x1f, 0x67, 0x2d, 0x8f, 0x4a, 0xd2, 0xc2, 0x21, 0xe5, 0x14, 0x18, 0xd8, 0x71, 0x22, 0xa4, 0x30,
-	0xfe, 0xe7, 0x61, 0x7b, 0x3d, 0x6c, 0xcf, 0xc3, 0xf5, 0x5a, 0x28, 0x42, 0xa1, 0x92, 0x78, 0x36,
-	0xe5, 0x50, 0xfd, 0x60, 0x7b, 0x43, 0x4c, 0x12, 0x32, 0x9c, 0x17, 0xd4, 0x9d, 0xed, 0xd9, 0x8d,
-	0x5e, 0xc5, 0xec, 0xbe, 0x97, 0xd0, 0xef, 0xf3, 0x7c, 0xcd, 0x6b, 0x49, 0x24, 0x35, 0x3a, 0xa8,
-	0x92, 0x4b, 0x4d, 0xbd, 0xa1, 0x37, 0xab, 0xce, 0x9e, 0xbd, 0x75, 0x6d, 0xfb, 0x4a, 0x85, 0xdb,
-	0xe5, 0xd1, 0xeb, 0x8e, 0xe6, 0xce, 0x51, 0xe3, 0x02, 0x55, 0x23, 0x01, 0xb2, 0xef, 0x47, 0x84,
-	0x71, 0x30, 0x7f, 0x34, 0x4a, 0xcd, 0xaa, 0xd3, 0x2c, 0x30, 0x75, 0x05, 0xc8, 0xce, 0x0c, 0x70,
-	0x51, 0xb4, 0x18, 0xc1, 0x68, 0xa3, 0x5f, 0x01, 0x8d, 0x05, 0x30, 0x09, 0x66, 0x49, 0x79, 0xf6,
-	0x0b, 0x3c, 0x67, 0x79, 0xdc, 0xfd, 0xe4, 0x8c, 0x2e, 0x42, 0x29, 0xf7, 0x04, 0x0f, 0x18, 0x0f,
-	0xc1, 0x2c, 0x7f, 0x6b, 0x9b, 0xde, 0x02, 0x70, 0x57, 0x58, 0xa3, 0x87, 0xfe, 0xa6, 0x40, 0x93,
-	0xfe, 0x8a, 0xee, 0xa7, 0xd2, 0x1d, 0x15, 0xe9, 0x80, 0x26, 0x4b, 0xe5, 0x9f, 0x74, 0xf5, 0x09,
-	0x46, 0x80, 0x6a, 0x19, 0x19, 0xb0, 0x80, 0x48, 0xb1, 0xe6, 0xae, 0x28, 0x77, 0xab, 0xc0, 0x7d,
-	0xb3, 0x40, 0x97, 0x05, 0xff, 0xb2, 0x2f, 0x7f, 0xd0, 0xbe, 0x1b, 0x4d, 0x2c, 0x7d, 0x3c, 0xb1,
-	0xf4, 0xb7, 0x89, 0xa5, 0x3f, 0x4f, 0x2d, 0x6d, 0x3c, 0xb5, 0xb4, 0x97, 0xa9, 0xa5, 0xdd, 0x9e,
-	0x86, 0x4c, 0x46, 0xa9, 0x67, 0xfb, 0x62, 0x88, 0x63, 0x9a, 0x00, 0x03, 0x49, 0xb9, 0x4f, 0x2f,
-	0x39, 0xc5, 0x79, 0xf5, 0x31, 0x27, 0x92, 0x65, 0x14, 0x67, 0x0e, 0x7e, 0xdc, 0xbc, 0x2f, 0xf9,
-	0x14, 0x53, 0xf0, 0x2a, 0xea, 0x9e, 0x4e, 0x3e, 0x02, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x9d, 0xaf,
-	0x0f, 0x13, 0x03, 0x00, 0x00,
+	// 422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x1b, 0x77, 0xb7, 0x2e, 0xd3, 0xaa, 0x10, 0xf7, 0x10, 0x0a, 0xc6, 0x45, 0x50, 0xca,
+	0xaa, 0x19, 0x5a, 0x3f, 0x81, 0x5d, 0xc1, 0x15, 0x94, 0x95, 0xc8, 0xee, 0x41, 0x0f, 0x65, 0x92,
+	0x3c, 0xd2, 0xa1, 0xed, 0xcc, 0x98, 0xf7, 0x12, 0xf4, 0x33, 0x78, 0xf1, 0x63, 0xed, 0x71, 0x8f,
+	0x9e, 0x44, 0xda, 0x2f, 0x22, 0x9d, 0x69, 0x6d, 0x77, 0x85, 0xa6, 0xb7, 0xf7, 0xc2, 0xff, 0xf7,
+	0xfb, 0x3f, 0x48, 0xc2, 0x9e, 0x1b, 0x24, 0x31, 0x06, 0x3e, 0x91, 0x5f, 0x4b, 0x99, 0xd9, 0x59,
+	0x26, 0x29, 0xaf, 0x7a, 0x09, 0x90, 0xe8, 0xf1, 0x1c, 0x14, 0xa0, 0xc4, 0xc8, 0x14, 0x9a, 0xb4,
+	0xff, 0xc8, 0x85, 0xa3, 0x9b, 0xe1, 0x68, 0x19, 0xee, 0x1c, 0xe5, 0x3a, 0xd7, 0x36, 0xc9, 0x17,
+	0x93, 0x83, 0x3a, 0x27, 0xdb, 0x1b, 0x8c, 0x28, 0xc4, 0x74, 0x59, 0xd0, 0xe9, 0x6f, 0xcf, 0xde,
+	0xea, 0xb5, 0xcc, 0x93, 0x1f, 0x07, 0xac, 0xfd, 0xd6, 0x9d, 0xf9, 0x89, 0x04, 0x81, 0x7f, 0xca,
+	0x9a, 0x4e, 0x1a, 0x78, 0xc7, 0x5e, 0xb7, 0xd5, 0x7f, 0x1a, 0x6d, 0x3d, 0x3b, 0xfa, 0x68, 0xc3,
+	0x83, 0xfd, 0xab, 0xdf, 0x8f, 0x1b, 0xf1, 0x12, 0xf5, 0xdf, 0xb1, 0xd6, 0x48, 0x23, 0x0d, 0xd3,
+	0x91, 0x90, 0x0a, 0x83, 0x3b, 0xc7, 0x7b, 0xdd, 0x56, 0xbf, 0x5b, 0x63, 0x3a, 0xd3, 0x48, 0xa7,
+	0x0b, 0x20, 0x66, 0xa3, 0xd5, 0x88, 0xfe, 0x80, 0x1d, 0x66, 0x60, 0x34, 0x4a, 0xc2, 0x60, 0xcf,
+	0x7a, 0x9e, 0xd5, 0x78, 0xde, 0xb8, 0x78, 0xfc, 0x8f, 0xf3, 0xcf, 0x18, 0x2b, 0x55, 0xa2, 0x55,
+	0x26, 0x55, 0x8e, 0xc1, 0xfe, 0x4e, 0xd7, 0x5c, 0xac, 0x80, 0x78, 0x83, 0xf5, 0x2f, 0xd8, 0x83,
+	0x12, 0xa1, 0x18, 0x6e, 0xe8, 0x0e, 0xac, 0xee, 0x45, 0x9d, 0x0e, 0xa1, 0x58, 0x2b, 0xef, 0x97,
+	0x9b, 0x2b, 0xfa, 0x19, 0x3b, 0xaa, 0xc4, 0x44, 0x66, 0x82, 0xf4, 0x0d, 0x77, 0xd3, 0xba, 0x7b,
+	0x35, 0xee, 0xcb, 0x15, 0xba, 0x2e, 0x78, 0x58, 0xfd, 0xf7, 0x0c, 0xfd, 0x73, 0x76, 0xcf, 0x80,
+	0x9d, 0x87, 0x53, 0xa9, 0x08, 0x83, 0xbb, 0x56, 0x7f, 0x52, 0xf7, 0x86, 0x1d, 0xf3, 0x41, 0x2a,
+	0x8a, 0xdb, 0x66, 0xbd, 0x58, 0x61, 0x05, 0x48, 0x0b, 0xe1, 0x44, 0xa7, 0x63, 0x0c, 0x0e, 0x77,
+	0x12, 0x5e, 0x3a, 0xe6, 0xbd, 0x4e, 0xc7, 0x71, 0xbb, 0x5a, 0x2f, 0x38, 0xf8, 0x72, 0x35, 0x0b,
+	0xbd, 0xeb, 0x59, 0xe8, 0xfd, 0x99, 0x85, 0xde, 0xcf, 0x79, 0xd8, 0xb8, 0x9e, 0x87, 0x8d, 0x5f,
+	0xf3, 0xb0, 0xf1, 0xf9, 0x75, 0x2e, 0x69, 0x54, 0x26, 0x51, 0xaa, 0xa7, 0xdc, 0x40, 0x81, 0x12,
+	0x09, 0x54, 0x0a, 0xe7, 0x0a, 0xb8, 0x2b, 0x7b, 0xa9, 0x04, 0xc9, 0x0a, 0x78, 0xd5, 0xe7, 0xdf,
+	0x6e, 0xff, 0x01, 0xf4, 0xdd, 0x00, 0x26, 0x4d, 0xfb, 0xc5, 0xbf, 0xfa, 0x3b, 0x00, 0xf2, 0xc2,
+	0x5c, 0x57, 0xb5, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -169,6 +190,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VestingLocks) > 0 {
+		for iNdEx := len(m.VestingLocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PendingMints) > 0 {
+		for iNdEx := len(m.PendingMints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingMints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.ValidatorUnbondings) > 0 {
 		for iNdEx := len(m.ValidatorUnbondings) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -301,6 +350,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PendingMints) > 0 {
+		for _, e := range m.PendingMints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VestingLocks) > 0 {
+		for _, e := range m.VestingLocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -542,6 +603,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingMints = append(m.PendingMints, &PendingMint{})
+			if err := m.PendingMints[len(m.PendingMints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingLocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingLocks = append(m.VestingLocks, &VestingLock{})
+			if err := m.VestingLocks[len(m.VestingLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
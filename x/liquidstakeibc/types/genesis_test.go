@@ -74,7 +74,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					ChainId:       "chainA-1",
 					Amount:        sdk.NewInt64Coin("ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9", 100),
 					Epoch:         0,
-					State:         4,
+					State:         5,
 					IbcSequenceId: "",
 				})
 				return genesis
@@ -150,7 +150,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					BurnAmount:    sdk.NewInt64Coin("stk/uatom", 0),
 					UnbondAmount:  sdk.NewInt64Coin("uatom", 0),
 					IbcSequenceId: "",
-					State:         6,
+					State:         7,
 				})
 				return genesis
 			},
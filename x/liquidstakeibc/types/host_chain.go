@@ -1,15 +1,36 @@
 package types
 
 import (
+	"fmt"
+	"sort"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ibctfrtypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
+// IBCDenom returns the denom the host tokens have on the controller chain, the host denom itself for localhost host
+// chains as their tokens never leave the chain
 func (hc *HostChain) IBCDenom() string {
+	if hc.IsLocalhost() {
+		return hc.HostDenom
+	}
+
 	return ibctfrtypes.ParseDenomTrace(ibctfrtypes.GetPrefixedDenom(hc.PortId, hc.ChannelId, hc.HostDenom)).IBCDenom()
 }
 
+// IsLocalhost returns whether the host chain is the controller chain itself, registered over the localhost connection
+func (hc *HostChain) IsLocalhost() bool {
+	return hc.ConnectionId == ibcexported.LocalhostConnectionID
+}
+
+// IsActive returns whether the host chain is active and not paused by the emergency admin
+func (hc *HostChain) IsActive() bool {
+	return hc.Active && !hc.Paused
+}
+
 func (hc *HostChain) MintDenom() string {
 	return HostDenomToMintDenom(hc.HostDenom)
 }
@@ -32,3 +53,331 @@ func (hc *HostChain) GetHostChainTotalDelegations() math.Int {
 
 	return totalDelegations
 }
+
+// IsUnbondingEpoch checks if the host chain undelegates at the end of an undelegation epoch. Unbonding epochs are
+// the multiples of the unbonding factor, shifted by the host chain unbonding epoch offset.
+func (hc *HostChain) IsUnbondingEpoch(epochNumber int64) bool {
+	return hc.unbondingEpochRemainder(epochNumber) == 0
+}
+
+// CurrentUnbondingEpoch returns the unbonding epoch an undelegation requested in the given epoch is sent in, the next
+// unbonding epoch of the host chain
+func (hc *HostChain) CurrentUnbondingEpoch(epochNumber int64) int64 {
+	remainder := hc.unbondingEpochRemainder(epochNumber)
+	if remainder == 0 {
+		return epochNumber
+	}
+	return epochNumber + hc.UnbondingFactor - remainder
+}
+
+// unbondingEpochRemainder returns how many epochs past its last unbonding epoch the host chain is, the offset can
+// put the first unbonding epoch after the epochs of the first cycle
+func (hc *HostChain) unbondingEpochRemainder(epochNumber int64) int64 {
+	return ((epochNumber-hc.UnbondingEpochOffset)%hc.UnbondingFactor + hc.UnbondingFactor) % hc.UnbondingFactor
+}
+
+// GetLSMCapacity returns the host token amount that can still be liquid staked on a validator before it reaches
+// either the LSM validator cap or the validator bond limit of the host chain
+func (params *HostChainLSParams) GetLSMCapacity(validator stakingtypes.Validator) math.Int {
+	if validator.DelegatorShares.IsZero() {
+		return sdk.ZeroInt()
+	}
+
+	// shares * validator_lsm_cap - liquid_shares
+	room := validator.DelegatorShares.Mul(params.LsmValidatorCap).Sub(validator.LiquidShares)
+
+	// bond_shares * bond_factor - liquid_shares, only if the bond factor is enabled
+	if !params.LsmBondFactor.Equal(sdk.NewDec(-1)) {
+		room = sdk.MinDec(room, validator.ValidatorBondShares.Mul(params.LsmBondFactor).Sub(validator.LiquidShares))
+	}
+
+	if !room.IsPositive() {
+		return sdk.ZeroInt()
+	}
+
+	return validator.TokensFromShares(room).TruncateInt()
+}
+
+// IsRewardWithdrawable checks if a validator delegation is large enough to withdraw its rewards
+func (hc *HostChain) IsRewardWithdrawable(validator *Validator) bool {
+	if !validator.DelegatedAmount.IsPositive() {
+		return false
+	}
+
+	if hc.Params == nil || hc.Params.MinRewardWithdrawalDelegation.IsNil() {
+		return true
+	}
+
+	return validator.DelegatedAmount.GTE(hc.Params.MinRewardWithdrawalDelegation)
+}
+
+// IsRewardsTransferable checks if the rewards account balance is above the amount worth transferring
+func (hc *HostChain) IsRewardsTransferable() bool {
+	if !hc.RewardsAccount.Balance.IsPositive() {
+		return false
+	}
+
+	if hc.Params == nil || hc.Params.MinRewardsTransferAmount.IsNil() {
+		return true
+	}
+
+	return hc.RewardsAccount.Balance.Amount.GT(hc.Params.MinRewardsTransferAmount)
+}
+
+// CValueWithinBounds checks the c value is within the min and max c value safety bounds of the host chain, an unset
+// bound is not checked
+func (hc *HostChain) CValueWithinBounds() bool {
+	if hc.Params == nil {
+		return true
+	}
+	if !hc.Params.MinCValue.IsNil() && hc.Params.MinCValue.IsPositive() && hc.CValue.LT(hc.Params.MinCValue) {
+		return false
+	}
+	if !hc.Params.MaxCValue.IsNil() && hc.Params.MaxCValue.IsPositive() && hc.CValue.GT(hc.Params.MaxCValue) {
+		return false
+	}
+
+	return true
+}
+
+// IsRebateProgramActive checks if the validators of the host chain can qualify for the commission rebate program
+func (hc *HostChain) IsRebateProgramActive() bool {
+	return hc.Params != nil && !hc.Params.RebateWeightBoost.IsNil() && hc.Params.RebateWeightBoost.IsPositive() &&
+		!hc.Params.RebateMaxCommission.IsNil()
+}
+
+// QualifiesForRebate checks if the commission rate reported for the validator by ICQ is at most the rebate program
+// max commission. A validator whose commission was never reported does not qualify.
+func (hc *HostChain) QualifiesForRebate(validator *Validator) bool {
+	if !hc.IsRebateProgramActive() || validator.CommissionUpdateHeight == 0 || validator.CommissionRate.IsNil() {
+		return false
+	}
+
+	return validator.CommissionRate.LTE(hc.Params.RebateMaxCommission)
+}
+
+// RebateBoostedValidators returns copies of the validators where the weights of those qualifying for the rebate
+// program are increased by the weight boost, and all the weights scaled back to the total weight of the validators.
+// The validators are returned as they are while the program is not active.
+func (hc *HostChain) RebateBoostedValidators(validators []*Validator) []*Validator {
+	if !hc.IsRebateProgramActive() {
+		return validators
+	}
+
+	total := sdk.ZeroDec()
+	boostedTotal := sdk.ZeroDec()
+	boosted := make([]*Validator, 0, len(validators))
+	for _, validator := range validators {
+		boostedValidator := *validator
+		if hc.QualifiesForRebate(validator) {
+			boostedValidator.Weight = validator.Weight.Mul(sdk.OneDec().Add(hc.Params.RebateWeightBoost))
+		}
+		total = total.Add(validator.Weight)
+		boostedTotal = boostedTotal.Add(boostedValidator.Weight)
+		boosted = append(boosted, &boostedValidator)
+	}
+
+	if !boostedTotal.IsPositive() {
+		return validators
+	}
+	for _, validator := range boosted {
+		validator.Weight = validator.Weight.Mul(total).Quo(boostedTotal)
+	}
+
+	return boosted
+}
+
+// CapDelegationShares returns copies of the validators where no weight is above the max delegation share of the
+// total weight, the excess is spilled to the validators below the cap proportionally to their weights. Validators
+// without weight are not delegated to, so the cap is raised to an even split when it can't hold the total weight.
+// The validators are returned as they are while the cap is disabled.
+func (hc *HostChain) CapDelegationShares(validators []*Validator) []*Validator {
+	if hc.Params == nil || hc.Params.MaxDelegationShare.IsNil() || !hc.Params.MaxDelegationShare.IsPositive() {
+		return validators
+	}
+
+	total := sdk.ZeroDec()
+	weighted := int64(0)
+	capped := make([]*Validator, 0, len(validators))
+	for _, validator := range validators {
+		cappedValidator := *validator
+		if validator.Weight.IsPositive() {
+			total = total.Add(validator.Weight)
+			weighted++
+		}
+		capped = append(capped, &cappedValidator)
+	}
+	if weighted == 0 {
+		return validators
+	}
+
+	maxWeight := sdk.MaxDec(hc.Params.MaxDelegationShare.Mul(total), total.QuoInt64(weighted))
+
+	// every pass caps at least one more validator, so it settles within a pass per validator
+	for range capped {
+		excess := sdk.ZeroDec()
+		belowCap := sdk.ZeroDec()
+		for _, validator := range capped {
+			switch {
+			case validator.Weight.GT(maxWeight):
+				excess = excess.Add(validator.Weight.Sub(maxWeight))
+				validator.Weight = maxWeight
+			case validator.Weight.IsPositive() && validator.Weight.LT(maxWeight):
+				belowCap = belowCap.Add(validator.Weight)
+			}
+		}
+		if !excess.IsPositive() || !belowCap.IsPositive() {
+			break
+		}
+		for _, validator := range capped {
+			if validator.Weight.IsPositive() && validator.Weight.LT(maxWeight) {
+				validator.Weight = validator.Weight.Add(excess.Mul(validator.Weight).Quo(belowCap))
+			}
+		}
+	}
+
+	return capped
+}
+
+// IsDrainingZeroWeightValidators checks if the delegations of the zero weight validators are redelegated away
+func (hc *HostChain) IsDrainingZeroWeightValidators() bool {
+	return hc.Params != nil && !hc.Params.MaxDrainPerEpoch.IsNil() && hc.Params.MaxDrainPerEpoch.IsPositive()
+}
+
+// RedelegationCap returns the most the rebalance can redelegate in a redelegation epoch, the lowest of the max
+// redelegation amount and the max redelegation ratio of the host chain delegations. False if neither is set.
+func (hc *HostChain) RedelegationCap() (math.Int, bool) {
+	if hc.Params == nil {
+		return math.ZeroInt(), false
+	}
+
+	var redelegationCap math.Int
+	capped := false
+	if !hc.Params.MaxRedelegationAmount.IsNil() && hc.Params.MaxRedelegationAmount.IsPositive() {
+		redelegationCap = hc.Params.MaxRedelegationAmount
+		capped = true
+	}
+	if !hc.Params.MaxRedelegationRatio.IsNil() && hc.Params.MaxRedelegationRatio.IsPositive() {
+		ratioCap := hc.Params.MaxRedelegationRatio.MulInt(hc.GetHostChainTotalDelegations()).TruncateInt()
+		if !capped || ratioCap.LT(redelegationCap) {
+			redelegationCap = ratioCap
+		}
+		capped = true
+	}
+
+	if !capped {
+		return math.ZeroInt(), false
+	}
+	return redelegationCap, true
+}
+
+// GetDrainableValidators returns the zero weight validators that still hold a delegation, sorted by address
+func (hc *HostChain) GetDrainableValidators() []*Validator {
+	validators := make([]*Validator, 0)
+	for _, validator := range hc.Validators {
+		if validator.Weight.IsZero() && validator.DelegatedAmount.IsPositive() {
+			validators = append(validators, validator)
+		}
+	}
+
+	sort.SliceStable(validators, func(i, j int) bool {
+		return validators[i].OperatorAddress < validators[j].OperatorAddress
+	})
+
+	return validators
+}
+
+// GetActiveValidatorsCount returns the number of validators with non-zero weight
+func (hc *HostChain) GetActiveValidatorsCount() uint32 {
+	var count uint32
+	for _, validator := range hc.Validators {
+		if validator.Weight.IsPositive() {
+			count++
+		}
+	}
+
+	return count
+}
+
+// GetBondedActiveValidatorsCount returns the number of bonded validators with non-zero weight
+func (hc *HostChain) GetBondedActiveValidatorsCount() uint32 {
+	var count uint32
+	for _, validator := range hc.Validators {
+		if validator.Weight.IsPositive() && validator.Status == stakingtypes.BondStatusBonded {
+			count++
+		}
+	}
+
+	return count
+}
+
+// ValidateValidatorSet checks the validator weights against the host chain minimum active validators
+// and maximum validator weight params
+func (hc *HostChain) ValidateValidatorSet() error {
+	if hc.Params == nil {
+		return nil
+	}
+
+	if count := hc.GetActiveValidatorsCount(); count < hc.Params.MinActiveValidators {
+		return fmt.Errorf(
+			"host chain %s has %d validators with non-zero weight, expected at least %d",
+			hc.ChainId,
+			count,
+			hc.Params.MinActiveValidators,
+		)
+	}
+
+	if hc.Params.MaxValidatorWeight.IsNil() || hc.Params.MaxValidatorWeight.IsZero() {
+		return nil
+	}
+
+	for _, validator := range hc.Validators {
+		if validator.Weight.GT(hc.Params.MaxValidatorWeight) {
+			return fmt.Errorf(
+				"host chain %s validator %s weight %s exceeds the max validator weight %s",
+				hc.ChainId,
+				validator.OperatorAddress,
+				validator.Weight,
+				hc.Params.MaxValidatorWeight,
+			)
+		}
+	}
+
+	return nil
+}
+
+// GetTotalValidatorWeight returns the sum of the host chain validator weights
+func (hc *HostChain) GetTotalValidatorWeight() sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, validator := range hc.Validators {
+		total = total.Add(validator.Weight)
+	}
+
+	return total
+}
+
+// NormalizeValidatorWeights scales the validator weights so they add up to exactly one. The rounding remainder goes
+// to the validator with the highest weight, the lowest operator address breaking ties, so every node assigns it to
+// the same validator. A validator set without weight is left untouched.
+func (hc *HostChain) NormalizeValidatorWeights() {
+	total := hc.GetTotalValidatorWeight()
+	if !total.IsPositive() || total.Equal(sdk.OneDec()) {
+		return
+	}
+
+	var remainderValidator *Validator
+	sum := sdk.ZeroDec()
+	for _, validator := range hc.Validators {
+		validator.Weight = validator.Weight.Quo(total)
+		sum = sum.Add(validator.Weight)
+
+		if remainderValidator == nil ||
+			validator.Weight.GT(remainderValidator.Weight) ||
+			(validator.Weight.Equal(remainderValidator.Weight) &&
+				validator.OperatorAddress < remainderValidator.OperatorAddress) {
+			remainderValidator = validator
+		}
+	}
+
+	remainderValidator.Weight = remainderValidator.Weight.Add(sdk.OneDec().Sub(sum))
+}
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -8,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
 
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
 )
@@ -88,6 +90,394 @@ func TestHostChain_GetValidator(t *testing.T) {
 	}
 }
 
+func TestHostChainLSParams_GetLSMCapacity(t *testing.T) {
+	tests := []struct {
+		name      string
+		params    *types.HostChainLSParams
+		validator stakingtypes.Validator
+		want      math.Int
+	}{
+		{
+			name:   "no shares",
+			params: &types.HostChainLSParams{LsmValidatorCap: sdk.MustNewDecFromStr("0.5"), LsmBondFactor: sdk.NewDec(-1)},
+			validator: stakingtypes.Validator{
+				Tokens:          sdk.ZeroInt(),
+				DelegatorShares: sdk.ZeroDec(),
+			},
+			want: sdk.ZeroInt(),
+		},
+		{
+			name:   "validator cap",
+			params: &types.HostChainLSParams{LsmValidatorCap: sdk.MustNewDecFromStr("0.5"), LsmBondFactor: sdk.NewDec(-1)},
+			validator: stakingtypes.Validator{
+				Tokens:          sdk.NewInt(200),
+				DelegatorShares: sdk.NewDec(100),
+				LiquidShares:    sdk.NewDec(20),
+			},
+			want: sdk.NewInt(60),
+		},
+		{
+			name:   "bond factor",
+			params: &types.HostChainLSParams{LsmValidatorCap: sdk.MustNewDecFromStr("0.5"), LsmBondFactor: sdk.NewDec(5)},
+			validator: stakingtypes.Validator{
+				Tokens:              sdk.NewInt(200),
+				DelegatorShares:     sdk.NewDec(100),
+				LiquidShares:        sdk.NewDec(20),
+				ValidatorBondShares: sdk.NewDec(5),
+			},
+			want: sdk.NewInt(10),
+		},
+		{
+			name:   "over the cap",
+			params: &types.HostChainLSParams{LsmValidatorCap: sdk.MustNewDecFromStr("0.1"), LsmBondFactor: sdk.NewDec(-1)},
+			validator: stakingtypes.Validator{
+				Tokens:          sdk.NewInt(100),
+				DelegatorShares: sdk.NewDec(100),
+				LiquidShares:    sdk.NewDec(20),
+			},
+			want: sdk.ZeroInt(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.params.GetLSMCapacity(tt.validator); !got.Equal(tt.want) {
+				t.Errorf("GetLSMCapacity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHostChain_IsRewardWithdrawable(t *testing.T) {
+	tests := []struct {
+		name      string
+		params    *types.HostChainLSParams
+		delegated math.Int
+		want      bool
+	}{
+		{
+			name:      "no delegation",
+			params:    nil,
+			delegated: sdk.ZeroInt(),
+			want:      false,
+		},
+		{
+			name:      "no threshold",
+			params:    &types.HostChainLSParams{},
+			delegated: sdk.OneInt(),
+			want:      true,
+		},
+		{
+			name:      "below threshold",
+			params:    &types.HostChainLSParams{MinRewardWithdrawalDelegation: sdk.NewInt(100)},
+			delegated: sdk.NewInt(99),
+			want:      false,
+		},
+		{
+			name:      "above threshold",
+			params:    &types.HostChainLSParams{MinRewardWithdrawalDelegation: sdk.NewInt(100)},
+			delegated: sdk.NewInt(100),
+			want:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hc := validHostChain()
+			hc.Params = tt.params
+			validator := makeVal("val")
+			validator.DelegatedAmount = tt.delegated
+			if got := hc.IsRewardWithdrawable(validator); got != tt.want {
+				t.Errorf("IsRewardWithdrawable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHostChain_IsRewardsTransferable(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  *types.HostChainLSParams
+		balance math.Int
+		want    bool
+	}{
+		{
+			name:    "no balance",
+			params:  nil,
+			balance: sdk.ZeroInt(),
+			want:    false,
+		},
+		{
+			name:    "no threshold",
+			params:  &types.HostChainLSParams{},
+			balance: sdk.OneInt(),
+			want:    true,
+		},
+		{
+			name:    "at threshold",
+			params:  &types.HostChainLSParams{MinRewardsTransferAmount: sdk.NewInt(100)},
+			balance: sdk.NewInt(100),
+			want:    false,
+		},
+		{
+			name:    "above threshold",
+			params:  &types.HostChainLSParams{MinRewardsTransferAmount: sdk.NewInt(100)},
+			balance: sdk.NewInt(101),
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hc := validHostChain()
+			hc.Params = tt.params
+			hc.RewardsAccount = &types.ICAAccount{Balance: sdk.NewCoin(hc.HostDenom, tt.balance)}
+			if got := hc.IsRewardsTransferable(); got != tt.want {
+				t.Errorf("IsRewardsTransferable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHostChain_CValueWithinBounds(t *testing.T) {
+	hc := &types.HostChain{
+		CValue: sdk.MustNewDecFromStr("0.95"),
+		Params: &types.HostChainLSParams{},
+	}
+	require.True(t, hc.CValueWithinBounds())
+
+	hc.Params.MinCValue = sdk.MustNewDecFromStr("0.9")
+	hc.Params.MaxCValue = sdk.ZeroDec()
+	require.True(t, hc.CValueWithinBounds())
+
+	hc.Params.MinCValue = sdk.OneDec()
+	require.False(t, hc.CValueWithinBounds())
+
+	hc.Params.MinCValue = sdk.ZeroDec()
+	hc.Params.MaxCValue = sdk.MustNewDecFromStr("0.9")
+	require.False(t, hc.CValueWithinBounds())
+}
+
+func TestHostChain_RedelegationCap(t *testing.T) {
+	hc := &types.HostChain{
+		Params: &types.HostChainLSParams{},
+		Validators: []*types.Validator{
+			{DelegatedAmount: sdk.NewInt(600)},
+			{DelegatedAmount: sdk.NewInt(400)},
+		},
+	}
+	_, capped := hc.RedelegationCap()
+	require.False(t, capped)
+
+	hc.Params.MaxRedelegationAmount = sdk.NewInt(300)
+	redelegationCap, capped := hc.RedelegationCap()
+	require.True(t, capped)
+	require.Equal(t, sdk.NewInt(300), redelegationCap)
+
+	hc.Params.MaxRedelegationRatio = sdk.MustNewDecFromStr("0.25")
+	redelegationCap, _ = hc.RedelegationCap()
+	require.Equal(t, sdk.NewInt(250), redelegationCap)
+
+	hc.Params.MaxRedelegationAmount = sdk.ZeroInt()
+	hc.Params.MaxRedelegationRatio = sdk.MustNewDecFromStr("0.5")
+	redelegationCap, _ = hc.RedelegationCap()
+	require.Equal(t, sdk.NewInt(500), redelegationCap)
+}
+
+func TestHostChain_RebateBoostedValidators(t *testing.T) {
+	hc := &types.HostChain{
+		Params: &types.HostChainLSParams{
+			RebateMaxCommission: sdk.MustNewDecFromStr("0.05"),
+			RebateWeightBoost:   sdk.ZeroDec(),
+		},
+		Validators: []*types.Validator{
+			{
+				OperatorAddress:        "val1",
+				Weight:                 sdk.MustNewDecFromStr("0.5"),
+				CommissionRate:         sdk.MustNewDecFromStr("0.05"),
+				CommissionUpdateHeight: 1,
+			},
+			{
+				OperatorAddress:        "val2",
+				Weight:                 sdk.MustNewDecFromStr("0.5"),
+				CommissionRate:         sdk.MustNewDecFromStr("0.1"),
+				CommissionUpdateHeight: 1,
+			},
+			{
+				OperatorAddress: "val3",
+				Weight:          sdk.ZeroDec(),
+				CommissionRate:  sdk.ZeroDec(),
+			},
+		},
+	}
+
+	// without a weight boost the program is off
+	require.False(t, hc.IsRebateProgramActive())
+	require.False(t, hc.QualifiesForRebate(hc.Validators[0]))
+	require.Equal(t, hc.Validators, hc.RebateBoostedValidators(hc.Validators))
+
+	hc.Params.RebateWeightBoost = sdk.OneDec()
+	require.True(t, hc.IsRebateProgramActive())
+	require.True(t, hc.QualifiesForRebate(hc.Validators[0]))
+	require.False(t, hc.QualifiesForRebate(hc.Validators[1]))
+	// a commission never reported by ICQ does not qualify
+	require.False(t, hc.QualifiesForRebate(hc.Validators[2]))
+
+	// the boosted weights keep the total weight, the stored weights are left untouched
+	boosted := hc.RebateBoostedValidators(hc.Validators)
+	require.Equal(t, sdk.MustNewDecFromStr("0.666666666666666667"), boosted[0].Weight)
+	require.Equal(t, sdk.MustNewDecFromStr("0.333333333333333333"), boosted[1].Weight)
+	require.True(t, boosted[2].Weight.IsZero())
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), hc.Validators[0].Weight)
+}
+
+func TestHostChain_CapDelegationShares(t *testing.T) {
+	hc := &types.HostChain{
+		Params: &types.HostChainLSParams{MaxDelegationShare: sdk.ZeroDec()},
+		Validators: []*types.Validator{
+			{OperatorAddress: "val1", Weight: sdk.MustNewDecFromStr("0.6")},
+			{OperatorAddress: "val2", Weight: sdk.MustNewDecFromStr("0.3")},
+			{OperatorAddress: "val3", Weight: sdk.MustNewDecFromStr("0.1")},
+			{OperatorAddress: "val4", Weight: sdk.ZeroDec()},
+		},
+	}
+
+	// a zero share disables the cap
+	require.Equal(t, hc.Validators, hc.CapDelegationShares(hc.Validators))
+
+	// the excess of val1 spills to val2 and val3 by weight, pushing val2 over the cap in turn
+	hc.Params.MaxDelegationShare = sdk.MustNewDecFromStr("0.4")
+	capped := hc.CapDelegationShares(hc.Validators)
+	require.Equal(t, sdk.MustNewDecFromStr("0.4"), capped[0].Weight)
+	require.Equal(t, sdk.MustNewDecFromStr("0.4"), capped[1].Weight)
+	require.Equal(t, sdk.MustNewDecFromStr("0.2"), capped[2].Weight)
+	require.True(t, capped[3].Weight.IsZero())
+	require.Equal(t, sdk.MustNewDecFromStr("0.6"), hc.Validators[0].Weight)
+
+	// a cap the weighted validators can't hold falls back to an even split
+	hc.Params.MaxDelegationShare = sdk.MustNewDecFromStr("0.2")
+	capped = hc.CapDelegationShares(hc.Validators)
+	for _, validator := range capped[:3] {
+		require.Equal(t, sdk.MustNewDecFromStr("0.333333333333333333"), validator.Weight)
+	}
+	require.True(t, capped[3].Weight.IsZero())
+}
+
+func TestHostChain_ValidateValidatorSet(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  *types.HostChainLSParams
+		wantErr bool
+	}{
+		{
+			name:    "no params",
+			params:  nil,
+			wantErr: false,
+		},
+		{
+			name:    "checks disabled",
+			params:  &types.HostChainLSParams{},
+			wantErr: false,
+		},
+		{
+			name:    "enough active validators",
+			params:  &types.HostChainLSParams{MinActiveValidators: 2, MaxValidatorWeight: sdk.MustNewDecFromStr("0.5")},
+			wantErr: false,
+		},
+		{
+			name:    "not enough active validators",
+			params:  &types.HostChainLSParams{MinActiveValidators: 3},
+			wantErr: true,
+		},
+		{
+			name:    "validator over max weight",
+			params:  &types.HostChainLSParams{MaxValidatorWeight: sdk.MustNewDecFromStr("0.4")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hc := validHostChain()
+			hc.Params = tt.params
+			if err := hc.ValidateValidatorSet(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateValidatorSet() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHostChain_NormalizeValidatorWeights(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights map[string]string
+		want    map[string]string
+	}{
+		{
+			name:    "already normalized",
+			weights: map[string]string{"val1": "0.5", "val2": "0.5"},
+			want:    map[string]string{"val1": "0.5", "val2": "0.5"},
+		},
+		{
+			name:    "no weight",
+			weights: map[string]string{"val1": "0", "val2": "0"},
+			want:    map[string]string{"val1": "0", "val2": "0"},
+		},
+		{
+			name:    "scaled",
+			weights: map[string]string{"val1": "0.3", "val2": "0.1", "val3": "0"},
+			want:    map[string]string{"val1": "0.75", "val2": "0.25", "val3": "0"},
+		},
+		{
+			name: "rounding excess taken from the lowest address of the heaviest validators",
+			weights: map[string]string{
+				"val1": "0.1", "val2": "0.1", "val3": "0.1", "val4": "0.1", "val5": "0.1", "val6": "0.1",
+			},
+			want: map[string]string{
+				"val1": "0.166666666666666665",
+				"val2": "0.166666666666666667",
+				"val3": "0.166666666666666667",
+				"val4": "0.166666666666666667",
+				"val5": "0.166666666666666667",
+				"val6": "0.166666666666666667",
+			},
+		},
+		{
+			name:    "rounding shortfall given to the heaviest validator",
+			weights: map[string]string{"val1": "0.2", "val2": "0.1", "val3": "0.1"},
+			want:    map[string]string{"val1": "0.5", "val2": "0.25", "val3": "0.25"},
+		},
+		{
+			name:    "truncation drift",
+			weights: map[string]string{"val1": "0.333333333333333333", "val2": "0.333333333333333333", "val3": "0.333333333333333333"},
+			want:    map[string]string{"val1": "0.333333333333333334", "val2": "0.333333333333333333", "val3": "0.333333333333333333"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hc := validHostChain()
+			hc.Validators = nil
+			// the validators are added in reverse address order, the remainder must not depend on it
+			for i := len(tt.weights); i > 0; i-- {
+				address := fmt.Sprintf("val%d", i)
+				hc.Validators = append(hc.Validators, &types.Validator{
+					OperatorAddress: address,
+					Weight:          sdk.MustNewDecFromStr(tt.weights[address]),
+				})
+			}
+
+			hc.NormalizeValidatorWeights()
+
+			total := sdk.ZeroDec()
+			for _, validator := range hc.Validators {
+				total = total.Add(validator.Weight)
+				if want := sdk.MustNewDecFromStr(tt.want[validator.OperatorAddress]); !validator.Weight.Equal(want) {
+					t.Errorf("NormalizeValidatorWeights() %s = %v, want %v", validator.OperatorAddress, validator.Weight, want)
+				}
+			}
+			if total.IsPositive() && !total.Equal(sdk.OneDec()) {
+				t.Errorf("NormalizeValidatorWeights() total = %v, want 1", total)
+			}
+		})
+	}
+}
+
 func validHostChain() *types.HostChain {
 	return &types.HostChain{
 		ChainId:           "chain-1",
@@ -132,3 +522,39 @@ func makeVal(valoperAddr string) *types.Validator {
 		UnbondingEpoch:  0,
 	}
 }
+
+func TestHostChain_UnbondingEpoch(t *testing.T) {
+	tests := []struct {
+		offset         int64
+		epoch          int64
+		unbondingEpoch int64
+	}{
+		{offset: 0, epoch: 1, unbondingEpoch: 4},
+		{offset: 0, epoch: 4, unbondingEpoch: 4},
+		{offset: 0, epoch: 5, unbondingEpoch: 8},
+		{offset: 1, epoch: 1, unbondingEpoch: 1},
+		{offset: 1, epoch: 2, unbondingEpoch: 5},
+		{offset: 1, epoch: 4, unbondingEpoch: 5},
+		{offset: 3, epoch: 1, unbondingEpoch: 3},
+		{offset: 3, epoch: 4, unbondingEpoch: 7},
+		{offset: 3, epoch: 7, unbondingEpoch: 7},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("offset %d epoch %d", tt.offset, tt.epoch), func(t *testing.T) {
+			hc := &types.HostChain{UnbondingFactor: 4, UnbondingEpochOffset: tt.offset}
+			require.Equal(t, tt.unbondingEpoch, hc.CurrentUnbondingEpoch(tt.epoch))
+			require.Equal(t, tt.unbondingEpoch == tt.epoch, hc.IsUnbondingEpoch(tt.epoch))
+		})
+	}
+}
+
+func TestHostChain_IBCDenom(t *testing.T) {
+	hc := &types.HostChain{ConnectionId: "connection-0", PortId: "transfer", ChannelId: "channel-0", HostDenom: "uatom"}
+	require.False(t, hc.IsLocalhost())
+	require.Equal(t, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", hc.IBCDenom())
+
+	// the tokens of localhost host chains never leave the chain
+	hc.ConnectionId = "connection-localhost"
+	require.True(t, hc.IsLocalhost())
+	require.Equal(t, "uatom", hc.IBCDenom())
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 )
 
@@ -30,6 +31,7 @@ const (
 	RewardsEpochIdentifier     = "day"
 	RedelegationEpochIdentifer = "day"
 	CValueEpoch                = "hour"
+	ReconciliationEpoch        = "day"
 
 	// ICA types
 	DelegateICAType = "delegate"
@@ -37,16 +39,43 @@ const (
 
 	// ICQ query types
 	// /key is required for proof generation
-	StakingStoreQuery = "store/staking/key"
-	BankStoreQuery    = "store/bank/key"
+	StakingStoreQuery      = "store/staking/key"
+	BankStoreQuery         = "store/bank/key"
+	DistributionStoreQuery = "store/distribution/key"
+	SlashingStoreQuery     = "store/slashing/key"
+	// gRPC queries are not proven, their results need to be verified through store queries
+	StakingValidatorsQuery = "/cosmos.staking.v1beta1.Query/Validators"
+	StakingParamsQuery     = "/cosmos.staking.v1beta1.Query/Params"
+	SlashingParamsQuery    = "/cosmos.slashing.v1beta1.Query/Params"
+
+	StakingDelegatorDelegationsQuery = "/cosmos.staking.v1beta1.Query/DelegatorDelegations"
 
 	// Host chain flags
 	LSMFlag = "lsm"
 
+	// Workflow names, part of the correlation ids of their operations
+	WorkflowDeposit               = "deposit"
+	WorkflowUndelegation          = "undelegation"
+	WorkflowRewards               = "rewards"
+	WorkflowRebalance             = "rebalance"
+	WorkflowCValue                = "c_value"
+	WorkflowSlashDetection        = "slash_detection"
+	WorkflowLSM                   = "lsm"
+	WorkflowValidatorUndelegation = "validator_undelegation"
+	WorkflowDrain                 = "drain"
+	WorkflowReconciliation        = "reconciliation"
+	WorkflowScoring               = "scoring"
+	WorkflowScoreWeights          = "score_weights"
+
 	LiquidStakeDenomPrefix = "stk"
 
 	IBCTimeoutTimestamp = 120 * time.Minute
 
+	// adaptive packet timeouts are this many times the average relay latency of the connection
+	IBCTimeoutLatencyMultiplier = 4
+	// number of packets the relay latency moving average is smoothed over
+	RelayLatencyWindow = 10
+
 	ICAMessagesChunkSize = 10
 
 	IBCPrefix = transfertypes.DenomPrefix + "/"
@@ -62,6 +91,29 @@ const (
 	CValueDynamicLowerDiff int64 = 2
 
 	CValueDynamicUpperDiff int64 = 10
+
+	// DefaultUnbondingCapacityEpochs is the number of unbonding epochs the unbonding capacity is reported for by default
+	DefaultUnbondingCapacityEpochs uint32 = 4
+
+	// MaxUnbondingCapacityEpochs is the maximum number of unbonding epochs the unbonding capacity can be reported for
+	MaxUnbondingCapacityEpochs uint32 = 50
+
+	// MaxBootstrapValidators is the maximum validator set size a host chain can be bootstrapped with
+	MaxBootstrapValidators uint32 = 100
+
+	// BootstrapValidatorsQueryLimit is the maximum amount of bonded validators fetched to bootstrap a host chain
+	BootstrapValidatorsQueryLimit uint64 = 1000
+
+	// DelegatorDelegationsQueryLimit is the maximum amount of delegations of the delegation account fetched to check
+	// the host chain validators for slashes
+	DelegatorDelegationsQueryLimit uint64 = 1000
+
+	// ICATxRetryBackoffBlocks is the number of blocks a failed ica tx waits before its first retry, the wait doubles
+	// with every failed retry
+	ICATxRetryBackoffBlocks int64 = 10
+
+	// ICATxRetryMaxBackoffBlocks is the longest wait between two retries of an ica tx
+	ICATxRetryMaxBackoffBlocks int64 = 14400
 )
 
 // Consts for KV updates, update host chain
@@ -86,28 +138,134 @@ const (
 	KeyAutocompoundFactor          string = "autocompound_factor"
 	KeyFlags                       string = "flags"
 	KeyRewardParams                string = "reward_params"
+	KeyMinActiveValidators         string = "min_active_validators"
+	KeyMaxValidatorWeight          string = "max_validator_weight"
+	KeyMinRewardWithdrawal         string = "min_reward_withdrawal_delegation"
+	KeyFeeAddress                  string = "fee_address"
+	KeyLiquidityIncentiveAddress   string = "liquidity_incentive_address"
+	KeyLiquidityIncentiveRate      string = "liquidity_incentive_rate"
+	KeyDepositDeliveryRatio        string = "deposit_delivery_ratio"
+	KeyMaxDepositAmount            string = "max_deposit_amount"
+	KeyUnbondingEpochOffset        string = "unbonding_epoch_offset"
+	KeyMinRewardsTransferAmount    string = "min_rewards_transfer_amount"
+	KeyMaxDrainPerEpoch            string = "max_drain_per_epoch"
+	KeyMinCValue                   string = "min_c_value"
+	KeyMaxCValue                   string = "max_c_value"
+	KeyRebateMaxCommission         string = "rebate_max_commission"
+	KeyRebateWeightBoost           string = "rebate_weight_boost"
+	KeyScoreWeightMin              string = "score_weight_min"
+	KeyScoreWeightMax              string = "score_weight_max"
+	KeyWeightTransitionEpochs      string = "weight_transition_epochs"
+	KeyMaxRedelegationAmount       string = "max_redelegation_amount"
+	KeyMaxRedelegationRatio        string = "max_redelegation_ratio"
+	KeyMaxUnbondingAmount          string = "max_unbonding_amount"
+	KeyMaxDelegationShare          string = "max_delegation_share"
 )
 
 var (
-	HostChainKey          = []byte{0x01}
-	DepositKey            = []byte{0x02}
-	UnbondingKey          = []byte{0x03}
-	UserUnbondingKey      = []byte{0x04}
-	ValidatorUnbondingKey = []byte{0x05}
-	ParamsKey             = []byte{0x06}
-	LSMDepositKey         = []byte{0x07}
-	RedelegationsKey      = []byte{0x08}
-	RedelegationTxKey     = []byte{0x09}
+	HostChainKey             = []byte{0x01}
+	DepositKey               = []byte{0x02}
+	UnbondingKey             = []byte{0x03}
+	UserUnbondingKey         = []byte{0x04}
+	ValidatorUnbondingKey    = []byte{0x05}
+	ParamsKey                = []byte{0x06}
+	LSMDepositKey            = []byte{0x07}
+	RedelegationsKey         = []byte{0x08}
+	RedelegationTxKey        = []byte{0x09}
+	DepositReceiptKey        = []byte{0x0a}
+	DepositReceiptIDKey      = []byte{0x0b}
+	DepositShortfallKey      = []byte{0x0c}
+	ValidatorBootstrapKey    = []byte{0x0d}
+	LiquidityIncentiveKey    = []byte{0x0e}
+	ValidatorExitKey         = []byte{0x0f}
+	ChannelMigrationKey      = []byte{0x10}
+	PendingMintKey           = []byte{0x11}
+	PendingMintStateIndexKey = []byte{0x12}
+	PendingMintAmountKey     = []byte{0x13}
+	RelayLatencyKey          = []byte{0x14}
+	PacketSendTimeKey        = []byte{0x15}
+	CValueHistoryKey         = []byte{0x16}
+
+	UserUnbondingEpochIndexKey = []byte{0x17}
+	LocalhostQueryKey          = []byte{0x18}
+	PendingParamChangeKey      = []byte{0x19}
+	ICATxRetryKey              = []byte{0x1a}
+	ICATxRetryIDKey            = []byte{0x1b}
+	ValidatorDrainKey          = []byte{0x1c}
+	HostChainFailuresKey       = []byte{0x1d}
+	FeeReportKey               = []byte{0x1e}
+	RebateRecordKey            = []byte{0x1f}
+	DelegationDecreaseKey      = []byte{0x20}
+	WorkflowFailureKey         = []byte{0x21}
+	ReconciliationKey          = []byte{0x22}
+	WeightTransitionKey        = []byte{0x23}
+	EpochFundingKey            = []byte{0x24}
+
+	DepositReceiptDelegatorIndexKey = []byte{0x25}
+	VestingLockKey                  = []byte{0x26}
 )
 
 var MaxFee = sdk.MustNewDecFromStr("0.5")
 
+// timelockedKeys are the host chain updates staged for the param change delay before they are applied
+var timelockedKeys = map[string]bool{
+	KeyDepositFee:            true,
+	KeyRestakeFee:            true,
+	KeyUnstakeFee:            true,
+	KeyRedemptionFee:         true,
+	KeyUpperCValueLimit:      true,
+	KeyLowerCValueLimit:      true,
+	KeyLSMValidatorCap:       true,
+	KeyMaxDepositAmount:      true,
+	KeyMinCValue:             true,
+	KeyMaxCValue:             true,
+	KeyMaxUnbondingAmount:    true,
+	KeyMaxRedelegationAmount: true,
+	KeyMaxRedelegationRatio:  true,
+	KeyMaxDelegationShare:    true,
+}
+
+// IsTimelockedKey returns true if the host chain updates of the key are staged for the param change delay
+func IsTimelockedKey(key string) bool {
+	return timelockedKeys[key]
+}
+
 func GetUnbondingStoreKey(chainID string, epochNumber int64) []byte {
 	return append([]byte(chainID), []byte(strconv.FormatInt(epochNumber, 10))...)
 }
 
-func GetUserUnbondingStoreKey(chainID, delegatorAddress string, epochNumber int64) []byte {
-	return append([]byte(chainID), append([]byte(delegatorAddress), []byte(strconv.FormatInt(epochNumber, 10))...)...)
+// GetUserUnbondingDelegatorPrefix returns the prefix of all the user unbondings of a delegator
+func GetUserUnbondingDelegatorPrefix(delegatorAddress string) []byte {
+	return address.MustLengthPrefix([]byte(delegatorAddress))
+}
+
+// GetUserUnbondingDelegatorChainPrefix returns the prefix of the user unbondings of a delegator on a host chain
+func GetUserUnbondingDelegatorChainPrefix(delegatorAddress, chainID string) []byte {
+	return append(GetUserUnbondingDelegatorPrefix(delegatorAddress), address.MustLengthPrefix([]byte(chainID))...)
+}
+
+// GetUserUnbondingStoreKey returns the delegator | chain | epoch key of a user unbonding, the big endian epoch keeps
+// the unbondings of a delegator on a host chain ordered
+func GetUserUnbondingStoreKey(delegatorAddress, chainID string, epochNumber int64) []byte {
+	return append(
+		GetUserUnbondingDelegatorChainPrefix(delegatorAddress, chainID),
+		sdk.Uint64ToBigEndian(uint64(epochNumber))...,
+	)
+}
+
+// GetUserUnbondingChainIndexPrefix returns the prefix of the user unbonding index entries of a host chain
+func GetUserUnbondingChainIndexPrefix(chainID string) []byte {
+	return address.MustLengthPrefix([]byte(chainID))
+}
+
+// GetUserUnbondingEpochIndexPrefix returns the prefix of the user unbonding index entries of a host chain epoch
+func GetUserUnbondingEpochIndexPrefix(chainID string, epochNumber int64) []byte {
+	return append(GetUserUnbondingChainIndexPrefix(chainID), sdk.Uint64ToBigEndian(uint64(epochNumber))...)
+}
+
+// GetUserUnbondingEpochIndexKey returns the chain | epoch | delegator key indexing a user unbonding by epoch
+func GetUserUnbondingEpochIndexKey(chainID string, epochNumber int64, delegatorAddress string) []byte {
+	return append(GetUserUnbondingEpochIndexPrefix(chainID, epochNumber), []byte(delegatorAddress)...)
 }
 
 func GetValidatorUnbondingStoreKey(chainID, validatorAddress string, epochNumber int64) []byte {
@@ -129,3 +287,112 @@ func GetRedelegationsStoreKey(chainID string) []byte {
 func GetRedelegationTxStoreKey(chainID, ibcSequenceID string) []byte {
 	return append([]byte(chainID), []byte(ibcSequenceID)...)
 }
+
+func GetDepositReceiptStoreKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// GetDepositReceiptDelegatorIndexPrefix returns the prefix of the deposit receipt index entries of a delegator
+func GetDepositReceiptDelegatorIndexPrefix(delegatorAddress string) []byte {
+	return address.MustLengthPrefix([]byte(delegatorAddress))
+}
+
+// GetDepositReceiptDelegatorIndexKey returns the delegator | id key indexing a deposit receipt by delegator
+func GetDepositReceiptDelegatorIndexKey(delegatorAddress string, id uint64) []byte {
+	return append(GetDepositReceiptDelegatorIndexPrefix(delegatorAddress), GetDepositReceiptStoreKey(id)...)
+}
+
+func GetVestingLockStoreKey(chainID, address string) []byte {
+	return append([]byte(chainID), []byte(address)...)
+}
+
+func GetValidatorExitStoreKey(chainID, validatorAddress string) []byte {
+	return append([]byte(chainID), []byte(validatorAddress)...)
+}
+
+func GetPendingParamChangeStoreKey(chainID, key string) []byte {
+	return append([]byte(chainID), []byte(key)...)
+}
+
+func GetValidatorDrainStoreKey(chainID, validatorAddress string) []byte {
+	return append([]byte(chainID), []byte(validatorAddress)...)
+}
+
+func GetRebateRecordStoreKey(chainID, validatorAddress string) []byte {
+	return append([]byte(chainID), []byte(validatorAddress)...)
+}
+
+func GetWorkflowFailureStoreKey(chainID, workflow string) []byte {
+	return append([]byte(chainID), []byte(workflow)...)
+}
+
+func GetDelegationReconciliationStoreKey(chainID, validatorAddress string) []byte {
+	return append([]byte(chainID), []byte(validatorAddress)...)
+}
+
+func GetICATxRetryStoreKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+func GetDepositShortfallStoreKey(chainID string, epochNumber int64) []byte {
+	return append([]byte(chainID), []byte(strconv.FormatInt(epochNumber, 10))...)
+}
+
+func GetPendingMintStoreKey(chainID, delegatorAddress string, epochNumber int64) []byte {
+	return append([]byte(chainID), append([]byte(delegatorAddress), []byte(strconv.FormatInt(epochNumber, 10))...)...)
+}
+
+// GetPendingMintStateIndexPrefix returns the prefix of the pending mint index entries of a host chain in a state
+func GetPendingMintStateIndexPrefix(chainID string, state PendingMint_PendingMintState) []byte {
+	return append(address.MustLengthPrefix([]byte(chainID)), byte(state))
+}
+
+// GetPendingMintEpochIndexPrefix returns the prefix of the pending mint index entries of a host chain epoch in a state
+func GetPendingMintEpochIndexPrefix(chainID string, state PendingMint_PendingMintState, epochNumber int64) []byte {
+	return append(GetPendingMintStateIndexPrefix(chainID, state), sdk.Uint64ToBigEndian(uint64(epochNumber))...)
+}
+
+// GetPendingMintStateIndexKey returns the chain | state | epoch | delegator key indexing a pending mint by state
+func GetPendingMintStateIndexKey(
+	chainID string,
+	state PendingMint_PendingMintState,
+	epochNumber int64,
+	delegatorAddress string,
+) []byte {
+	return append(GetPendingMintEpochIndexPrefix(chainID, state, epochNumber), []byte(delegatorAddress)...)
+}
+
+// GetDelegationDecreaseChainPrefix returns the prefix of the delegation decreases in flight of a host chain
+func GetDelegationDecreaseChainPrefix(chainID string) []byte {
+	return address.MustLengthPrefix([]byte(chainID))
+}
+
+// GetDelegationDecreaseValidatorPrefix returns the prefix of the delegation decreases in flight of a validator
+func GetDelegationDecreaseValidatorPrefix(chainID, validatorAddress string) []byte {
+	return append(GetDelegationDecreaseChainPrefix(chainID), address.MustLengthPrefix([]byte(validatorAddress))...)
+}
+
+// GetDelegationDecreaseStoreKey returns the chain | validator | sequence id key of a delegation decrease in flight
+func GetDelegationDecreaseStoreKey(chainID, validatorAddress, sequenceID string) []byte {
+	return append(GetDelegationDecreaseValidatorPrefix(chainID, validatorAddress), []byte(sequenceID)...)
+}
+
+func GetCValueRecordStoreKey(chainID string, index uint64) []byte {
+	return append([]byte(chainID), sdk.Uint64ToBigEndian(index)...)
+}
+
+// GetEpochFundingChainPrefix returns the prefix of the epoch fundings of a host chain
+func GetEpochFundingChainPrefix(chainID string) []byte {
+	return address.MustLengthPrefix([]byte(chainID))
+}
+
+// GetEpochFundingStoreKey returns the chain | epoch key of an epoch funding, the big endian epoch keeps the fundings
+// of a host chain ordered
+func GetEpochFundingStoreKey(chainID string, epochNumber int64) []byte {
+	return append(GetEpochFundingChainPrefix(chainID), sdk.Uint64ToBigEndian(uint64(epochNumber))...)
+}
+
+// GetTransactionSequenceID namespaces a packet sequence by the port and channel it was sent over
+func GetTransactionSequenceID(portID, channelID string, sequence uint64) string {
+	return portID + "/" + channelID + "-sequence-" + strconv.FormatUint(sequence, 10)
+}
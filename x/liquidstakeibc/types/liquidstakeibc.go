@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"cosmossdk.io/math"
 	"github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
@@ -54,7 +55,8 @@ func (deposit *Deposit) Validate() error {
 	if deposit.State != Deposit_DEPOSIT_PENDING &&
 		deposit.State != Deposit_DEPOSIT_SENT &&
 		deposit.State != Deposit_DEPOSIT_RECEIVED &&
-		deposit.State != Deposit_DEPOSIT_DELEGATING {
+		deposit.State != Deposit_DEPOSIT_DELEGATING &&
+		deposit.State != Deposit_DEPOSIT_FAILED {
 		return fmt.Errorf(
 			"host chain %s deposit has an invalid state: %s",
 			deposit.ChainId,
@@ -89,13 +91,16 @@ func (hc *HostChain) Validate() error {
 	if err != nil {
 		return fmt.Errorf("hostchain connectionID invalid err: %v", err)
 	}
-	err = host.PortIdentifierValidator(hc.PortId)
-	if err != nil {
-		return err
-	}
-	err = host.ChannelIdentifierValidator(hc.ChannelId)
-	if err != nil {
-		return err
+	// localhost host chains don't need a transfer channel
+	if !hc.IsLocalhost() || hc.ChannelId != "" {
+		err = host.PortIdentifierValidator(hc.PortId)
+		if err != nil {
+			return err
+		}
+		err = host.ChannelIdentifierValidator(hc.ChannelId)
+		if err != nil {
+			return err
+		}
 	}
 	err = sdk.ValidateDenom(hc.HostDenom)
 	if err != nil {
@@ -132,9 +137,51 @@ func (hc *HostChain) Validate() error {
 	return nil
 }
 
+// ValidateState checks the invariants a host chain has to keep on every write: its params are within their bounds,
+// its validator weights add up to one once any of them is set, and its ICA accounts are in a known channel state and
+// hold an address once their channel is created.
+func (hc *HostChain) ValidateState() error {
+	if hc.Params == nil {
+		return fmt.Errorf("host chain %s has no params", hc.ChainId)
+	}
+	if err := hc.Params.Validate(); err != nil {
+		return err
+	}
+
+	// unset weights are stored as zero
+	total := sdk.ZeroDec()
+	for _, validator := range hc.Validators {
+		if validator.Weight.IsNil() {
+			continue
+		}
+		if validator.Weight.IsNegative() || validator.Weight.GT(sdk.OneDec()) {
+			return fmt.Errorf("host chain %s validator %s has invalid weight", hc.ChainId, validator.OperatorAddress)
+		}
+		total = total.Add(validator.Weight)
+	}
+	if !total.IsZero() && !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("host chain %s validator weights add up to %s, expected 0 or 1", hc.ChainId, total)
+	}
+
+	for _, account := range []*ICAAccount{hc.DelegationAccount, hc.RewardsAccount} {
+		if account == nil {
+			continue
+		}
+		if _, ok := ICAAccount_ChannelState_name[int32(account.ChannelState)]; !ok {
+			return fmt.Errorf("host chain %s ica account %s has invalid channel state", hc.ChainId, account.Owner)
+		}
+		if account.ChannelState == ICAAccount_ICA_CHANNEL_CREATED && account.Address == "" {
+			return fmt.Errorf("host chain %s ica account %s is created without an address", hc.ChainId, account.Owner)
+		}
+	}
+
+	return nil
+}
+
 func (icaAccount *ICAAccount) Validate() error {
 	if icaAccount.ChannelState != ICAAccount_ICA_CHANNEL_CREATING &&
-		icaAccount.ChannelState != ICAAccount_ICA_CHANNEL_CREATED {
+		icaAccount.ChannelState != ICAAccount_ICA_CHANNEL_CREATED &&
+		icaAccount.ChannelState != ICAAccount_ICA_CHANNEL_CLOSED {
 		return fmt.Errorf("invalid channel state")
 	}
 	portID, err := icatypes.NewControllerPortID(icaAccount.Owner)
@@ -180,12 +227,188 @@ func (params *HostChainLSParams) Validate() error {
 	if params.UnstakeFee.LT(sdk.ZeroDec()) || params.UnstakeFee.GT(MaxFee) {
 		return fmt.Errorf("host chain lsparams has invalid unstake fee, should be 0<=fee<= %s", MaxFee)
 	}
-	if params.RedelegationAcceptableDelta.LT(sdk.ZeroInt()) {
+	if params.RedelegationAcceptableDelta.IsNil() || params.RedelegationAcceptableDelta.IsNegative() {
 		return fmt.Errorf("host chain has invalid redelegation acceptable delta expected >= 0")
 	}
+	if !params.MaxValidatorWeight.IsNil() &&
+		(params.MaxValidatorWeight.IsNegative() || params.MaxValidatorWeight.GT(sdk.OneDec())) {
+		return fmt.Errorf("host chain lsparams has invalid max validator weight, should be 0<=weight<=1")
+	}
+	if !params.MinRewardWithdrawalDelegation.IsNil() && params.MinRewardWithdrawalDelegation.IsNegative() {
+		return fmt.Errorf("host chain has invalid min reward withdrawal delegation expected >= 0")
+	}
+	if params.FeeAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(params.FeeAddress); err != nil {
+			return fmt.Errorf("host chain lsparams has invalid fee address %s: %w", params.FeeAddress, err)
+		}
+	}
+	if !params.DepositDeliveryRatio.IsNil() &&
+		(params.DepositDeliveryRatio.IsNegative() || params.DepositDeliveryRatio.GT(sdk.OneDec())) {
+		return fmt.Errorf("host chain lsparams has invalid deposit delivery ratio, should be 0<=ratio<=1")
+	}
+	if !params.MaxDepositAmount.IsNil() && params.MaxDepositAmount.IsNegative() {
+		return fmt.Errorf("host chain has invalid max deposit amount expected >= 0")
+	}
+	if !params.MinRewardsTransferAmount.IsNil() && params.MinRewardsTransferAmount.IsNegative() {
+		return fmt.Errorf("host chain has invalid min rewards transfer amount expected >= 0")
+	}
+	if !params.MaxDrainPerEpoch.IsNil() && params.MaxDrainPerEpoch.IsNegative() {
+		return fmt.Errorf("host chain has invalid max drain per epoch expected >= 0")
+	}
+	if !params.MaxRedelegationAmount.IsNil() && params.MaxRedelegationAmount.IsNegative() {
+		return fmt.Errorf("host chain has invalid max redelegation amount expected >= 0")
+	}
+	if !params.MaxUnbondingAmount.IsNil() && params.MaxUnbondingAmount.IsNegative() {
+		return fmt.Errorf("host chain has invalid max unbonding amount expected >= 0")
+	}
+	if !params.MaxRedelegationRatio.IsNil() &&
+		(params.MaxRedelegationRatio.IsNegative() || params.MaxRedelegationRatio.GT(sdk.OneDec())) {
+		return fmt.Errorf("host chain lsparams has invalid max redelegation ratio, should be 0<=ratio<=1")
+	}
+	if !params.MaxDelegationShare.IsNil() &&
+		(params.MaxDelegationShare.IsNegative() || params.MaxDelegationShare.GT(sdk.OneDec())) {
+		return fmt.Errorf("host chain lsparams has invalid max delegation share, should be 0<=share<=1")
+	}
+	if !params.RebateMaxCommission.IsNil() &&
+		(params.RebateMaxCommission.IsNegative() || params.RebateMaxCommission.GT(sdk.OneDec())) {
+		return fmt.Errorf("host chain lsparams has invalid rebate max commission, should be 0<=commission<=1")
+	}
+	if !params.RebateWeightBoost.IsNil() && params.RebateWeightBoost.IsNegative() {
+		return fmt.Errorf("host chain lsparams has invalid rebate weight boost, should be >= 0")
+	}
+	if err := params.ValidateCValueBounds(); err != nil {
+		return err
+	}
+	if err := params.ValidateScoreWeightBounds(); err != nil {
+		return err
+	}
+	return params.ValidateLiquidityIncentive()
+}
+
+// ValidateScoreWeightBounds checks the score driven weight bounds are valid weights and, when the score driven weights
+// are enabled, that the minimum is not above the maximum and the maximum respects the max validator weight
+func (params *HostChainLSParams) ValidateScoreWeightBounds() error {
+	if !params.ScoreWeightMin.IsNil() && (params.ScoreWeightMin.IsNegative() || params.ScoreWeightMin.GT(sdk.OneDec())) {
+		return fmt.Errorf("host chain lsparams has invalid score weight min, should be 0<=weight<=1")
+	}
+	if !params.ScoreWeightMax.IsNil() && (params.ScoreWeightMax.IsNegative() || params.ScoreWeightMax.GT(sdk.OneDec())) {
+		return fmt.Errorf("host chain lsparams has invalid score weight max, should be 0<=weight<=1")
+	}
+	if params.ScoreWeightMax.IsNil() || params.ScoreWeightMax.IsZero() {
+		return nil
+	}
+
+	if !params.ScoreWeightMin.IsNil() && params.ScoreWeightMin.GT(params.ScoreWeightMax) {
+		return fmt.Errorf(
+			"host chain lsparams score weight min %s should not be above score weight max %s",
+			params.ScoreWeightMin,
+			params.ScoreWeightMax,
+		)
+	}
+	if !params.MaxValidatorWeight.IsNil() && params.MaxValidatorWeight.IsPositive() &&
+		params.ScoreWeightMax.GT(params.MaxValidatorWeight) {
+		return fmt.Errorf(
+			"host chain lsparams score weight max %s should not be above max validator weight %s",
+			params.ScoreWeightMax,
+			params.MaxValidatorWeight,
+		)
+	}
+
+	return nil
+}
+
+// ValidateCValueBounds checks the c value safety bounds are not negative and, when both are set, the minimum is below
+// the maximum
+func (params *HostChainLSParams) ValidateCValueBounds() error {
+	minSet := !params.MinCValue.IsNil() && !params.MinCValue.IsZero()
+	maxSet := !params.MaxCValue.IsNil() && !params.MaxCValue.IsZero()
+	if minSet && params.MinCValue.IsNegative() {
+		return fmt.Errorf("host chain lsparams has invalid min c value, should be >= 0")
+	}
+	if maxSet && params.MaxCValue.IsNegative() {
+		return fmt.Errorf("host chain lsparams has invalid max c value, should be >= 0")
+	}
+	if minSet && maxSet && params.MinCValue.GTE(params.MaxCValue) {
+		return fmt.Errorf("host chain lsparams min c value %s should be below max c value %s", params.MinCValue, params.MaxCValue)
+	}
+
+	return nil
+}
+
+// ValidateLiquidityIncentive checks that the liquidity incentive rate is a valid share and that protocol fees are
+// only set aside when there is an address to stream them to.
+func (params *HostChainLSParams) ValidateLiquidityIncentive() error {
+	if params.LiquidityIncentiveAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(params.LiquidityIncentiveAddress); err != nil {
+			return fmt.Errorf(
+				"host chain lsparams has invalid liquidity incentive address %s: %w",
+				params.LiquidityIncentiveAddress,
+				err,
+			)
+		}
+	}
+	if params.LiquidityIncentiveRate.IsNil() {
+		return nil
+	}
+	if params.LiquidityIncentiveRate.IsNegative() || params.LiquidityIncentiveRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("host chain lsparams has invalid liquidity incentive rate, should be 0<=rate<=1")
+	}
+	if params.LiquidityIncentiveRate.IsPositive() && params.LiquidityIncentiveAddress == "" {
+		return fmt.Errorf("host chain lsparams has a liquidity incentive rate but no liquidity incentive address")
+	}
 	return nil
 }
 
+// GetLiquidityIncentiveShare returns the part of the protocol fee streamed to the liquidity incentive address
+func (params *HostChainLSParams) GetLiquidityIncentiveShare(protocolFee sdk.Coins) sdk.Coins {
+	if params == nil || params.LiquidityIncentiveRate.IsNil() || !params.LiquidityIncentiveRate.IsPositive() {
+		return sdk.NewCoins()
+	}
+
+	share, _ := sdk.NewDecCoinsFromCoins(protocolFee...).MulDecTruncate(params.LiquidityIncentiveRate).TruncateDecimal()
+	return share
+}
+
+// AddFee adds a collected protocol fee to the total of its fee type
+func (r *FeeReport) AddFee(feeType string, fee sdk.Coin) {
+	if !fee.IsPositive() {
+		return
+	}
+
+	switch feeType {
+	case KeyDepositFee:
+		r.DepositFees = r.DepositFees.Add(fee)
+	case KeyRestakeFee:
+		r.RestakeFees = r.RestakeFees.Add(fee)
+	case KeyUnstakeFee:
+		r.UnstakeFees = r.UnstakeFees.Add(fee)
+	case KeyRedemptionFee:
+		r.RedemptionFees = r.RedemptionFees.Add(fee)
+	}
+}
+
+// HasDepositCap returns true if the amount liquid staked on the host chain is capped
+func (params *HostChainLSParams) HasDepositCap() bool {
+	return params != nil && !params.MaxDepositAmount.IsNil() && params.MaxDepositAmount.IsPositive()
+}
+
+// IsDepositTaxed returns true if the host denom is expected to lose part of the deposits on transfer
+func (params *HostChainLSParams) IsDepositTaxed() bool {
+	return params != nil &&
+		!params.DepositDeliveryRatio.IsNil() &&
+		params.DepositDeliveryRatio.IsPositive() &&
+		params.DepositDeliveryRatio.LT(sdk.OneDec())
+}
+
+// GetExpectedDeliveredAmount returns the part of a deposit transfer expected to reach the delegation account
+func (params *HostChainLSParams) GetExpectedDeliveredAmount(amount math.Int) math.Int {
+	if !params.IsDepositTaxed() {
+		return amount
+	}
+
+	return sdk.NewDecFromInt(amount).Mul(params.DepositDeliveryRatio).TruncateInt()
+}
+
 func (validator *Validator) Validate() error {
 	if validator.Status != stakingtypes.Unspecified.String() &&
 		validator.Status != stakingtypes.Unbonded.String() &&
@@ -237,7 +460,8 @@ func (u *Unbonding) Validate() error {
 		u.State != Unbonding_UNBONDING_MATURING &&
 		u.State != Unbonding_UNBONDING_MATURED &&
 		u.State != Unbonding_UNBONDING_CLAIMABLE &&
-		u.State != Unbonding_UNBONDING_FAILED {
+		u.State != Unbonding_UNBONDING_FAILED &&
+		u.State != Unbonding_UNBONDING_RETRYING {
 		return fmt.Errorf(
 			"host chain %s unbonding has an invalid state: %s",
 			u.ChainId,
@@ -266,3 +490,50 @@ func (vb *ValidatorUnbonding) Validate() error {
 	}
 	return nil
 }
+
+func (pm *PendingMint) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(pm.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if pm.DepositAmount.IsNegative() || pm.MintAmount.IsNegative() || pm.FeeAmount.IsNegative() {
+		return fmt.Errorf("pending mint %s has a negative amount", pm.String())
+	}
+	if _, ok := PendingMint_PendingMintState_name[int32(pm.State)]; !ok {
+		return fmt.Errorf("host chain %s pending mint has an invalid state: %s", pm.ChainId, pm.State)
+	}
+	return nil
+}
+
+func (vl *VestingLock) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(vl.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if !vl.LockedAmount.IsPositive() || vl.StkAmount.IsNegative() {
+		return fmt.Errorf("vesting lock %s has an invalid amount", vl.String())
+	}
+	return nil
+}
+
+// Backoff returns the blocks to wait before the ica tx is submitted again, doubling with every failed attempt
+func (r *ICATxRetry) Backoff() int64 {
+	backoff := ICATxRetryBackoffBlocks
+	for i := uint64(1); i < r.Attempts && backoff < ICATxRetryMaxBackoffBlocks; i++ {
+		backoff *= 2
+	}
+
+	if backoff > ICATxRetryMaxBackoffBlocks {
+		return ICATxRetryMaxBackoffBlocks
+	}
+	return backoff
+}
+
+// CorrelationID identifies every log line and event of a workflow operation on a host chain for an epoch. A deposit
+// keeps the same id from the transfer to the claim of its stk tokens.
+func CorrelationID(workflow string, chainID string, epoch int64) string {
+	return fmt.Sprintf("%s/%s/%d", workflow, chainID, epoch)
+}
+
+// NewCorrelationAttribute returns the event attribute carrying the correlation id of a workflow operation
+func NewCorrelationAttribute(workflow string, chainID string, epoch int64) sdk.Attribute {
+	return sdk.NewAttribute(AttributeCorrelationID, CorrelationID(workflow, chainID, epoch))
+}
@@ -6,12 +6,14 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types2 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -38,16 +40,20 @@ const (
 	ICAAccount_ICA_CHANNEL_CREATING ICAAccount_ChannelState = 0
 	// ICA is established and the account can be used
 	ICAAccount_ICA_CHANNEL_CREATED ICAAccount_ChannelState = 1
+	// ICA channel was closed and is reopened in the next block
+	ICAAccount_ICA_CHANNEL_CLOSED ICAAccount_ChannelState = 2
 )
 
 var ICAAccount_ChannelState_name = map[int32]string{
 	0: "ICA_CHANNEL_CREATING",
 	1: "ICA_CHANNEL_CREATED",
+	2: "ICA_CHANNEL_CLOSED",
 }
 
 var ICAAccount_ChannelState_value = map[string]int32{
 	"ICA_CHANNEL_CREATING": 0,
 	"ICA_CHANNEL_CREATED":  1,
+	"ICA_CHANNEL_CLOSED":   2,
 }
 
 func (x ICAAccount_ChannelState) String() string {
@@ -55,7 +61,7 @@ func (x ICAAccount_ChannelState) String() string {
 }
 
 func (ICAAccount_ChannelState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{4, 0}
+	return fileDescriptor_71a9a61e676043b6, []int{5, 0}
 }
 
 type Deposit_DepositState int32
@@ -69,6 +75,8 @@ const (
 	Deposit_DEPOSIT_RECEIVED Deposit_DepositState = 2
 	// delegation submitted for the deposit on the host chain
 	Deposit_DEPOSIT_DELEGATING Deposit_DepositState = 3
+	// deposit transfer timed out or failed, the deposit was refunded
+	Deposit_DEPOSIT_FAILED Deposit_DepositState = 4
 )
 
 var Deposit_DepositState_name = map[int32]string{
@@ -76,6 +84,7 @@ var Deposit_DepositState_name = map[int32]string{
 	1: "DEPOSIT_SENT",
 	2: "DEPOSIT_RECEIVED",
 	3: "DEPOSIT_DELEGATING",
+	4: "DEPOSIT_FAILED",
 }
 
 var Deposit_DepositState_value = map[string]int32{
@@ -83,6 +92,7 @@ var Deposit_DepositState_value = map[string]int32{
 	"DEPOSIT_SENT":       1,
 	"DEPOSIT_RECEIVED":   2,
 	"DEPOSIT_DELEGATING": 3,
+	"DEPOSIT_FAILED":     4,
 }
 
 func (x Deposit_DepositState) String() string {
@@ -90,7 +100,7 @@ func (x Deposit_DepositState) String() string {
 }
 
 func (Deposit_DepositState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{6, 0}
+	return fileDescriptor_71a9a61e676043b6, []int{8, 0}
 }
 
 type LSMDeposit_LSMDepositState int32
@@ -125,7 +135,7 @@ func (x LSMDeposit_LSMDepositState) String() string {
 }
 
 func (LSMDeposit_LSMDepositState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{7, 0}
+	return fileDescriptor_71a9a61e676043b6, []int{9, 0}
 }
 
 type Unbonding_UnbondingState int32
@@ -143,6 +153,8 @@ const (
 	Unbonding_UNBONDING_CLAIMABLE Unbonding_UnbondingState = 4
 	// unbonding has failed
 	Unbonding_UNBONDING_FAILED Unbonding_UnbondingState = 5
+	// undelegation could not be sent and waits in the ica tx retry queue
+	Unbonding_UNBONDING_RETRYING Unbonding_UnbondingState = 6
 )
 
 var Unbonding_UnbondingState_name = map[int32]string{
@@ -152,6 +164,7 @@ var Unbonding_UnbondingState_name = map[int32]string{
 	3: "UNBONDING_MATURED",
 	4: "UNBONDING_CLAIMABLE",
 	5: "UNBONDING_FAILED",
+	6: "UNBONDING_RETRYING",
 }
 
 var Unbonding_UnbondingState_value = map[string]int32{
@@ -161,6 +174,7 @@ var Unbonding_UnbondingState_value = map[string]int32{
 	"UNBONDING_MATURED":   3,
 	"UNBONDING_CLAIMABLE": 4,
 	"UNBONDING_FAILED":    5,
+	"UNBONDING_RETRYING":  6,
 }
 
 func (x Unbonding_UnbondingState) String() string {
@@ -168,7 +182,7 @@ func (x Unbonding_UnbondingState) String() string {
 }
 
 func (Unbonding_UnbondingState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{8, 0}
+	return fileDescriptor_71a9a61e676043b6, []int{10, 0}
 }
 
 type RedelegateTx_RedelegateTxState int32
@@ -195,7 +209,97 @@ func (x RedelegateTx_RedelegateTxState) String() string {
 }
 
 func (RedelegateTx_RedelegateTxState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{13, 0}
+	return fileDescriptor_71a9a61e676043b6, []int{15, 0}
+}
+
+type ICATxRetry_ICATxRetryType int32
+
+const (
+	// undelegation of the user unbondings of an epoch
+	ICATxRetry_RETRY_UNDELEGATION ICATxRetry_ICATxRetryType = 0
+	// withdrawal of the delegation rewards
+	ICATxRetry_RETRY_REWARDS_WITHDRAWAL ICATxRetry_ICATxRetryType = 1
+	// redelegation between two host chain validators
+	ICATxRetry_RETRY_REDELEGATION ICATxRetry_ICATxRetryType = 2
+)
+
+var ICATxRetry_ICATxRetryType_name = map[int32]string{
+	0: "RETRY_UNDELEGATION",
+	1: "RETRY_REWARDS_WITHDRAWAL",
+	2: "RETRY_REDELEGATION",
+}
+
+var ICATxRetry_ICATxRetryType_value = map[string]int32{
+	"RETRY_UNDELEGATION":       0,
+	"RETRY_REWARDS_WITHDRAWAL": 1,
+	"RETRY_REDELEGATION":       2,
+}
+
+func (x ICATxRetry_ICATxRetryType) String() string {
+	return proto.EnumName(ICATxRetry_ICATxRetryType_name, int32(x))
+}
+
+func (ICATxRetry_ICATxRetryType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{24, 0}
+}
+
+type ChannelMigration_ChannelMigrationState int32
+
+const (
+	// waiting for the in-flight transfers of the old channel to settle
+	ChannelMigration_MIGRATION_DRAINING ChannelMigration_ChannelMigrationState = 0
+	// the host chain uses the new channel
+	ChannelMigration_MIGRATION_COMPLETED ChannelMigration_ChannelMigrationState = 1
+	// the migration was cancelled before the channel was switched
+	ChannelMigration_MIGRATION_CANCELLED ChannelMigration_ChannelMigrationState = 2
+)
+
+var ChannelMigration_ChannelMigrationState_name = map[int32]string{
+	0: "MIGRATION_DRAINING",
+	1: "MIGRATION_COMPLETED",
+	2: "MIGRATION_CANCELLED",
+}
+
+var ChannelMigration_ChannelMigrationState_value = map[string]int32{
+	"MIGRATION_DRAINING":  0,
+	"MIGRATION_COMPLETED": 1,
+	"MIGRATION_CANCELLED": 2,
+}
+
+func (x ChannelMigration_ChannelMigrationState) String() string {
+	return proto.EnumName(ChannelMigration_ChannelMigrationState_name, int32(x))
+}
+
+func (ChannelMigration_ChannelMigrationState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{25, 0}
+}
+
+type PendingMint_PendingMintState int32
+
+const (
+	// the deposit has not been delegated on the host chain yet
+	PendingMint_PENDING_MINT_AWAITING_DELEGATION PendingMint_PendingMintState = 0
+	// the delegation of the deposit was acknowledged, the stk tokens can be
+	// claimed
+	PendingMint_PENDING_MINT_CLAIMABLE PendingMint_PendingMintState = 1
+)
+
+var PendingMint_PendingMintState_name = map[int32]string{
+	0: "PENDING_MINT_AWAITING_DELEGATION",
+	1: "PENDING_MINT_CLAIMABLE",
+}
+
+var PendingMint_PendingMintState_value = map[string]int32{
+	"PENDING_MINT_AWAITING_DELEGATION": 0,
+	"PENDING_MINT_CLAIMABLE":           1,
+}
+
+func (x PendingMint_PendingMintState) String() string {
+	return proto.EnumName(PendingMint_PendingMintState_name, int32(x))
+}
+
+func (PendingMint_PendingMintState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{26, 0}
 }
 
 type HostChain struct {
@@ -233,6 +337,32 @@ type HostChain struct {
 	Flags *HostChainFlags `protobuf:"bytes,16,opt,name=flags,proto3" json:"flags,omitempty"`
 	// non-compoundable chain reward params
 	RewardParams *RewardParams `protobuf:"bytes,17,opt,name=reward_params,json=rewardParams,proto3" json:"reward_params,omitempty"`
+	// whether any of the host chain ibc channels was found not open on the
+	// last validation
+	Degraded bool `protobuf:"varint,18,opt,name=degraded,proto3" json:"degraded,omitempty"`
+	// block height of the last host chain update
+	LastUpdateHeight int64 `protobuf:"varint,19,opt,name=last_update_height,json=lastUpdateHeight,proto3" json:"last_update_height,omitempty"`
+	// block time of the last host chain update
+	LastUpdateTime time.Time `protobuf:"bytes,20,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time"`
+	// block height of the last c value update
+	CValueUpdateHeight int64 `protobuf:"varint,21,opt,name=c_value_update_height,json=cValueUpdateHeight,proto3" json:"c_value_update_height,omitempty"`
+	// block time of the last c value update
+	CValueUpdateTime time.Time `protobuf:"bytes,22,opt,name=c_value_update_time,json=cValueUpdateTime,proto3,stdtime" json:"c_value_update_time"`
+	// host chain staking and slashing params, fetched through ICQ
+	RiskParams *HostChainRiskParams `protobuf:"bytes,23,opt,name=risk_params,json=riskParams,proto3" json:"risk_params,omitempty"`
+	// undelegation epochs the unbonding epochs of the host chain are shifted by,
+	// lower than the unbonding factor
+	UnbondingEpochOffset int64 `protobuf:"varint,24,opt,name=unbonding_epoch_offset,json=unbondingEpochOffset,proto3" json:"unbonding_epoch_offset,omitempty"`
+	// whether the distribution withdraw address of the delegation account was
+	// found not to be the rewards account on the last verification
+	WithdrawAddressMismatch bool `protobuf:"varint,25,opt,name=withdraw_address_mismatch,json=withdrawAddressMismatch,proto3" json:"withdraw_address_mismatch,omitempty"`
+	// whether the host chain was paused by the emergency admin, a paused host
+	// chain behaves as an inactive one until it is resumed, whatever its active
+	// state
+	Paused bool `protobuf:"varint,26,opt,name=paused,proto3" json:"paused,omitempty"`
+	// whether the seed delegation made on registration is still waiting to be
+	// delegated, public deposits stay closed until it is
+	Seeding bool `protobuf:"varint,27,opt,name=seeding,proto3" json:"seeding,omitempty"`
 }
 
 func (m *HostChain) Reset()         { *m = HostChain{} }
@@ -359,8 +489,98 @@ func (m *HostChain) GetRewardParams() *RewardParams {
 	return nil
 }
 
+func (m *HostChain) GetDegraded() bool {
+	if m != nil {
+		return m.Degraded
+	}
+	return false
+}
+
+func (m *HostChain) GetLastUpdateHeight() int64 {
+	if m != nil {
+		return m.LastUpdateHeight
+	}
+	return 0
+}
+
+func (m *HostChain) GetLastUpdateTime() time.Time {
+	if m != nil {
+		return m.LastUpdateTime
+	}
+	return time.Time{}
+}
+
+func (m *HostChain) GetCValueUpdateHeight() int64 {
+	if m != nil {
+		return m.CValueUpdateHeight
+	}
+	return 0
+}
+
+func (m *HostChain) GetCValueUpdateTime() time.Time {
+	if m != nil {
+		return m.CValueUpdateTime
+	}
+	return time.Time{}
+}
+
+func (m *HostChain) GetRiskParams() *HostChainRiskParams {
+	if m != nil {
+		return m.RiskParams
+	}
+	return nil
+}
+
+func (m *HostChain) GetUnbondingEpochOffset() int64 {
+	if m != nil {
+		return m.UnbondingEpochOffset
+	}
+	return 0
+}
+
+func (m *HostChain) GetWithdrawAddressMismatch() bool {
+	if m != nil {
+		return m.WithdrawAddressMismatch
+	}
+	return false
+}
+
+func (m *HostChain) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *HostChain) GetSeeding() bool {
+	if m != nil {
+		return m.Seeding
+	}
+	return false
+}
+
 type HostChainFlags struct {
 	Lsm bool `protobuf:"varint,1,opt,name=lsm,proto3" json:"lsm,omitempty"`
+	// stk tokens are only minted once the delegation of the deposit has been
+	// acknowledged by the host chain
+	DelayedMint bool `protobuf:"varint,2,opt,name=delayed_mint,json=delayedMint,proto3" json:"delayed_mint,omitempty"`
+	// the c value is only computed at the start of each c value epoch, every
+	// mint and burn within the epoch uses it and the autocompounded rewards
+	// received during the epoch are accounted for at the next one
+	EpochCValue bool `protobuf:"varint,3,opt,name=epoch_c_value,json=epochCValue,proto3" json:"epoch_c_value,omitempty"`
+	// deposits are neither transferred to nor delegated on the host chain, new
+	// liquid stakes keep being accepted and wait for the flow to resume
+	DepositsPaused bool `protobuf:"varint,4,opt,name=deposits_paused,json=depositsPaused,proto3" json:"deposits_paused,omitempty"`
+	// liquid unstakes are rejected, the unbondings already queued and the
+	// validator exits are held back until the undelegations resume
+	UndelegationsPaused bool `protobuf:"varint,5,opt,name=undelegations_paused,json=undelegationsPaused,proto3" json:"undelegations_paused,omitempty"`
+	// rewards are neither withdrawn nor autocompounded
+	RewardsPaused bool `protobuf:"varint,6,opt,name=rewards_paused,json=rewardsPaused,proto3" json:"rewards_paused,omitempty"`
+	// lsm deposits are rejected and the lsm tokens held are neither transferred
+	// nor redeemed
+	LsmPaused bool `protobuf:"varint,7,opt,name=lsm_paused,json=lsmPaused,proto3" json:"lsm_paused,omitempty"`
+	// no rebalancing or zero weight validator drain redelegations are sent
+	RedelegationsPaused bool `protobuf:"varint,8,opt,name=redelegations_paused,json=redelegationsPaused,proto3" json:"redelegations_paused,omitempty"`
 }
 
 func (m *HostChainFlags) Reset()         { *m = HostChainFlags{} }
@@ -403,6 +623,55 @@ func (m *HostChainFlags) GetLsm() bool {
 	return false
 }
 
+func (m *HostChainFlags) GetDelayedMint() bool {
+	if m != nil {
+		return m.DelayedMint
+	}
+	return false
+}
+
+func (m *HostChainFlags) GetEpochCValue() bool {
+	if m != nil {
+		return m.EpochCValue
+	}
+	return false
+}
+
+func (m *HostChainFlags) GetDepositsPaused() bool {
+	if m != nil {
+		return m.DepositsPaused
+	}
+	return false
+}
+
+func (m *HostChainFlags) GetUndelegationsPaused() bool {
+	if m != nil {
+		return m.UndelegationsPaused
+	}
+	return false
+}
+
+func (m *HostChainFlags) GetRewardsPaused() bool {
+	if m != nil {
+		return m.RewardsPaused
+	}
+	return false
+}
+
+func (m *HostChainFlags) GetLsmPaused() bool {
+	if m != nil {
+		return m.LsmPaused
+	}
+	return false
+}
+
+func (m *HostChainFlags) GetRedelegationsPaused() bool {
+	if m != nil {
+		return m.RedelegationsPaused
+	}
+	return false
+}
+
 type RewardParams struct {
 	// rewards denom on the host chain
 	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -457,6 +726,82 @@ func (m *RewardParams) GetDestination() string {
 	return ""
 }
 
+type HostChainRiskParams struct {
+	// unbonding period of the host chain staking module
+	UnbondingPeriod time.Duration `protobuf:"bytes,1,opt,name=unbonding_period,json=unbondingPeriod,proto3,stdduration" json:"unbonding_period"`
+	// fraction of the stake slashed for double signing
+	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign"`
+	// fraction of the stake slashed for downtime
+	SlashFractionDowntime github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime"`
+	// block height of the last staking params update
+	StakingUpdateHeight int64 `protobuf:"varint,4,opt,name=staking_update_height,json=stakingUpdateHeight,proto3" json:"staking_update_height,omitempty"`
+	// block height of the last slashing params update
+	SlashingUpdateHeight int64 `protobuf:"varint,5,opt,name=slashing_update_height,json=slashingUpdateHeight,proto3" json:"slashing_update_height,omitempty"`
+	// number of blocks the validator liveness of the host chain is tracked over
+	SignedBlocksWindow int64 `protobuf:"varint,6,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+}
+
+func (m *HostChainRiskParams) Reset()         { *m = HostChainRiskParams{} }
+func (m *HostChainRiskParams) String() string { return proto.CompactTextString(m) }
+func (*HostChainRiskParams) ProtoMessage()    {}
+func (*HostChainRiskParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{3}
+}
+func (m *HostChainRiskParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostChainRiskParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostChainRiskParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostChainRiskParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostChainRiskParams.Merge(m, src)
+}
+func (m *HostChainRiskParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostChainRiskParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostChainRiskParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostChainRiskParams proto.InternalMessageInfo
+
+func (m *HostChainRiskParams) GetUnbondingPeriod() time.Duration {
+	if m != nil {
+		return m.UnbondingPeriod
+	}
+	return 0
+}
+
+func (m *HostChainRiskParams) GetStakingUpdateHeight() int64 {
+	if m != nil {
+		return m.StakingUpdateHeight
+	}
+	return 0
+}
+
+func (m *HostChainRiskParams) GetSlashingUpdateHeight() int64 {
+	if m != nil {
+		return m.SlashingUpdateHeight
+	}
+	return 0
+}
+
+func (m *HostChainRiskParams) GetSignedBlocksWindow() int64 {
+	if m != nil {
+		return m.SignedBlocksWindow
+	}
+	return 0
+}
+
 type HostChainLSParams struct {
 	DepositFee    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=deposit_fee,json=depositFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"deposit_fee"`
 	RestakeFee    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=restake_fee,json=restakeFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"restake_fee"`
@@ -474,13 +819,75 @@ type HostChainLSParams struct {
 	RedelegationAcceptableDelta github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=redelegation_acceptable_delta,json=redelegationAcceptableDelta,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"redelegation_acceptable_delta"`
 	UpperCValueLimit            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=upper_c_value_limit,json=upperCValueLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"upper_c_value_limit"`
 	LowerCValueLimit            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=lower_c_value_limit,json=lowerCValueLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"lower_c_value_limit"`
+	// minimum number of validators with non-zero weight, zero disables the check
+	MinActiveValidators uint32 `protobuf:"varint,12,opt,name=min_active_validators,json=minActiveValidators,proto3" json:"min_active_validators,omitempty"`
+	// maximum weight a single validator can hold, zero disables the check
+	MaxValidatorWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=max_validator_weight,json=maxValidatorWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_validator_weight"`
+	// minimum delegation to a validator for its rewards to be withdrawn
+	MinRewardWithdrawalDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,14,opt,name=min_reward_withdrawal_delegation,json=minRewardWithdrawalDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_reward_withdrawal_delegation"`
+	// address receiving the protocol fees of the host chain, the module fee
+	// address is used when empty
+	FeeAddress string `protobuf:"bytes,15,opt,name=fee_address,json=feeAddress,proto3" json:"fee_address,omitempty"`
+	// address of the liquidity incentive module or contract the share of the
+	// protocol fees is streamed to every delegation epoch
+	LiquidityIncentiveAddress string `protobuf:"bytes,16,opt,name=liquidity_incentive_address,json=liquidityIncentiveAddress,proto3" json:"liquidity_incentive_address,omitempty"`
+	// share of the protocol fees streamed to the liquidity incentive address,
+	// zero disables the streaming
+	LiquidityIncentiveRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=liquidity_incentive_rate,json=liquidityIncentiveRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_incentive_rate"`
+	// share of the deposited tokens expected to reach the delegation account for
+	// host denoms taxed on transfer, zero means the full amount is delivered
+	DepositDeliveryRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=deposit_delivery_ratio,json=depositDeliveryRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"deposit_delivery_ratio"`
+	// maximum amount of host tokens liquid staked on the host chain, zero
+	// disables the cap
+	MaxDepositAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,19,opt,name=max_deposit_amount,json=maxDepositAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_deposit_amount"`
+	// rewards account balance the rewards are transferred to the deposit module
+	// account above, zero transfers any balance
+	MinRewardsTransferAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,20,opt,name=min_rewards_transfer_amount,json=minRewardsTransferAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_rewards_transfer_amount"`
+	// maximum amount of host tokens redelegated away from the zero weight
+	// validators every redelegation epoch, zero disables the drain
+	MaxDrainPerEpoch github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,21,opt,name=max_drain_per_epoch,json=maxDrainPerEpoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_drain_per_epoch"`
+	// lowest c value the host chain can reach before its workflows are halted,
+	// zero disables the bound
+	MinCValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,22,opt,name=min_c_value,json=minCValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_c_value"`
+	// highest c value the host chain can reach before its workflows are halted,
+	// zero disables the bound
+	MaxCValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,23,opt,name=max_c_value,json=maxCValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_c_value"`
+	// highest commission rate of a validator for it to qualify for the
+	// commission rebate program
+	RebateMaxCommission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,24,opt,name=rebate_max_commission,json=rebateMaxCommission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rebate_max_commission"`
+	// weight boost of the validators qualifying for the commission rebate
+	// program, zero disables the program
+	RebateWeightBoost github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,25,opt,name=rebate_weight_boost,json=rebateWeightBoost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rebate_weight_boost"`
+	// lowest weight of a bonded validator when the validator weights follow
+	// the validator scores
+	ScoreWeightMin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,26,opt,name=score_weight_min,json=scoreWeightMin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"score_weight_min"`
+	// highest weight of a validator when the validator weights follow the
+	// validator scores, zero disables the score driven weights
+	ScoreWeightMax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,27,opt,name=score_weight_max,json=scoreWeightMax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"score_weight_max"`
+	// number of redelegation epochs the delegations take to follow a change of
+	// the validator weights, zero or one moves them in a single epoch
+	WeightTransitionEpochs uint32 `protobuf:"varint,28,opt,name=weight_transition_epochs,json=weightTransitionEpochs,proto3" json:"weight_transition_epochs,omitempty"`
+	// maximum amount of host tokens the rebalance redelegates every
+	// redelegation epoch, zero disables the cap
+	MaxRedelegationAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,29,opt,name=max_redelegation_amount,json=maxRedelegationAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_redelegation_amount"`
+	// maximum share of the host chain delegations the rebalance redelegates
+	// every redelegation epoch, zero disables the cap
+	MaxRedelegationRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,30,opt,name=max_redelegation_ratio,json=maxRedelegationRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_redelegation_ratio"`
+	// maximum amount of host tokens undelegated in an unbonding epoch, the
+	// unbondings above it roll into the next unbonding epochs, zero disables the
+	// cap
+	MaxUnbondingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,31,opt,name=max_unbonding_amount,json=maxUnbondingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_unbonding_amount"`
+	// maximum share of the host chain delegations a single validator is
+	// delegated to, the excess spills to the other validators, zero disables the
+	// cap
+	MaxDelegationShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,32,opt,name=max_delegation_share,json=maxDelegationShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_delegation_share"`
 }
 
 func (m *HostChainLSParams) Reset()         { *m = HostChainLSParams{} }
 func (m *HostChainLSParams) String() string { return proto.CompactTextString(m) }
 func (*HostChainLSParams) ProtoMessage()    {}
 func (*HostChainLSParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{3}
+	return fileDescriptor_71a9a61e676043b6, []int{4}
 }
 func (m *HostChainLSParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -516,6 +923,34 @@ func (m *HostChainLSParams) GetMaxEntries() uint32 {
 	return 0
 }
 
+func (m *HostChainLSParams) GetMinActiveValidators() uint32 {
+	if m != nil {
+		return m.MinActiveValidators
+	}
+	return 0
+}
+
+func (m *HostChainLSParams) GetFeeAddress() string {
+	if m != nil {
+		return m.FeeAddress
+	}
+	return ""
+}
+
+func (m *HostChainLSParams) GetLiquidityIncentiveAddress() string {
+	if m != nil {
+		return m.LiquidityIncentiveAddress
+	}
+	return ""
+}
+
+func (m *HostChainLSParams) GetWeightTransitionEpochs() uint32 {
+	if m != nil {
+		return m.WeightTransitionEpochs
+	}
+	return 0
+}
+
 type ICAAccount struct {
 	// address of the ica on the controller chain
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -530,7 +965,7 @@ func (m *ICAAccount) Reset()         { *m = ICAAccount{} }
 func (m *ICAAccount) String() string { return proto.CompactTextString(m) }
 func (*ICAAccount) ProtoMessage()    {}
 func (*ICAAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{4}
+	return fileDescriptor_71a9a61e676043b6, []int{5}
 }
 func (m *ICAAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -604,13 +1039,36 @@ type Validator struct {
 	// whether the validator can accept delegations or not, default true for
 	// non-lsm chains
 	Delegable bool `protobuf:"varint,7,opt,name=delegable,proto3" json:"delegable,omitempty"`
+	// block height of the last validator update
+	LastUpdateHeight int64 `protobuf:"varint,8,opt,name=last_update_height,json=lastUpdateHeight,proto3" json:"last_update_height,omitempty"`
+	// block time of the last validator update
+	LastUpdateTime time.Time `protobuf:"bytes,9,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time"`
+	// host token amount that can still be liquid staked on the validator before
+	// reaching the LSM validator cap or validator bond limit, only for lsm chains
+	LsmCapacity github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=lsm_capacity,json=lsmCapacity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"lsm_capacity"`
+	// commission rate of the validator on the host chain
+	CommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate"`
+	// block height the commission rate was last reported by the validator ICQ,
+	// zero if it was never reported
+	CommissionUpdateHeight int64 `protobuf:"varint,12,opt,name=commission_update_height,json=commissionUpdateHeight,proto3" json:"commission_update_height,omitempty"`
+	// whether the validator was reported jailed by the validator ICQ
+	Jailed bool `protobuf:"varint,13,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// bech32 consensus address of the validator on the host chain
+	ConsensusAddress string `protobuf:"bytes,14,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// blocks missed by the validator in the current signed blocks window, as
+	// reported by the signing info ICQ
+	MissedBlocksCounter int64 `protobuf:"varint,15,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// total bonded tokens of the validator on the host chain
+	Tokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,16,opt,name=tokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokens"`
+	// performance score of the validator, nil until it is first scored
+	Score *ValidatorScore `protobuf:"bytes,17,opt,name=score,proto3" json:"score,omitempty"`
 }
 
 func (m *Validator) Reset()         { *m = Validator{} }
 func (m *Validator) String() string { return proto.CompactTextString(m) }
 func (*Validator) ProtoMessage()    {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{5}
+	return fileDescriptor_71a9a61e676043b6, []int{6}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -667,84 +1125,198 @@ func (m *Validator) GetDelegable() bool {
 	return false
 }
 
-type Deposit struct {
-	// deposit target chain
-	ChainId string     `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Amount  types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
-	// epoch number of the deposit
-	Epoch int64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	// state
-	State Deposit_DepositState `protobuf:"varint,4,opt,name=state,proto3,enum=pstake.liquidstakeibc.v1beta1.Deposit_DepositState" json:"state,omitempty"`
-	// sequence id of the ibc transaction
-	IbcSequenceId string `protobuf:"bytes,5,opt,name=ibc_sequence_id,json=ibcSequenceId,proto3" json:"ibc_sequence_id,omitempty"`
-}
-
-func (m *Deposit) Reset()         { *m = Deposit{} }
-func (m *Deposit) String() string { return proto.CompactTextString(m) }
-func (*Deposit) ProtoMessage()    {}
-func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{6}
-}
-func (m *Deposit) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *Deposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Deposit.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *Validator) GetLastUpdateHeight() int64 {
+	if m != nil {
+		return m.LastUpdateHeight
 	}
+	return 0
 }
-func (m *Deposit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Deposit.Merge(m, src)
-}
-func (m *Deposit) XXX_Size() int {
-	return m.Size()
-}
-func (m *Deposit) XXX_DiscardUnknown() {
-	xxx_messageInfo_Deposit.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Deposit proto.InternalMessageInfo
 
-func (m *Deposit) GetChainId() string {
+func (m *Validator) GetLastUpdateTime() time.Time {
 	if m != nil {
-		return m.ChainId
+		return m.LastUpdateTime
 	}
-	return ""
+	return time.Time{}
 }
 
-func (m *Deposit) GetAmount() types.Coin {
+func (m *Validator) GetCommissionUpdateHeight() int64 {
 	if m != nil {
-		return m.Amount
+		return m.CommissionUpdateHeight
 	}
-	return types.Coin{}
+	return 0
 }
 
-func (m *Deposit) GetEpoch() int64 {
+func (m *Validator) GetJailed() bool {
 	if m != nil {
-		return m.Epoch
+		return m.Jailed
 	}
-	return 0
+	return false
 }
 
-func (m *Deposit) GetState() Deposit_DepositState {
+func (m *Validator) GetConsensusAddress() string {
 	if m != nil {
-		return m.State
+		return m.ConsensusAddress
 	}
-	return Deposit_DEPOSIT_PENDING
+	return ""
 }
 
-func (m *Deposit) GetIbcSequenceId() string {
+func (m *Validator) GetMissedBlocksCounter() int64 {
 	if m != nil {
-		return m.IbcSequenceId
+		return m.MissedBlocksCounter
 	}
-	return ""
+	return 0
+}
+
+func (m *Validator) GetScore() *ValidatorScore {
+	if m != nil {
+		return m.Score
+	}
+	return nil
+}
+
+type ValidatorScore struct {
+	// average of the uptime, commission and delegation growth scores
+	Score github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=score,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"score"`
+	// fraction of the signed blocks window the validator did not miss
+	Uptime github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=uptime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"uptime"`
+	// fraction of the rewards the validator does not keep as commission
+	Commission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=commission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission"`
+	// ratio of the validator tokens to its tokens at the previous scoring,
+	// capped at one
+	DelegationGrowth github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=delegation_growth,json=delegationGrowth,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"delegation_growth"`
+	// validator tokens the score was computed with
+	Tokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=tokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokens"`
+	// rewards epoch the score was computed in
+	Epoch int64 `protobuf:"varint,6,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (m *ValidatorScore) Reset()         { *m = ValidatorScore{} }
+func (m *ValidatorScore) String() string { return proto.CompactTextString(m) }
+func (*ValidatorScore) ProtoMessage()    {}
+func (*ValidatorScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{7}
+}
+func (m *ValidatorScore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorScore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorScore.Merge(m, src)
+}
+func (m *ValidatorScore) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorScore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorScore proto.InternalMessageInfo
+
+func (m *ValidatorScore) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+type Deposit struct {
+	// deposit target chain
+	ChainId string     `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Amount  types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// epoch number of the deposit
+	Epoch int64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// state
+	State Deposit_DepositState `protobuf:"varint,4,opt,name=state,proto3,enum=pstake.liquidstakeibc.v1beta1.Deposit_DepositState" json:"state,omitempty"`
+	// sequence id of the ibc transaction
+	IbcSequenceId string `protobuf:"bytes,5,opt,name=ibc_sequence_id,json=ibcSequenceId,proto3" json:"ibc_sequence_id,omitempty"`
+	// times the deposit was sent again by the deposit workflow after failing
+	Retries uint64 `protobuf:"varint,6,opt,name=retries,proto3" json:"retries,omitempty"`
+}
+
+func (m *Deposit) Reset()         { *m = Deposit{} }
+func (m *Deposit) String() string { return proto.CompactTextString(m) }
+func (*Deposit) ProtoMessage()    {}
+func (*Deposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{8}
+}
+func (m *Deposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Deposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Deposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Deposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Deposit.Merge(m, src)
+}
+func (m *Deposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *Deposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_Deposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Deposit proto.InternalMessageInfo
+
+func (m *Deposit) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *Deposit) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *Deposit) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *Deposit) GetState() Deposit_DepositState {
+	if m != nil {
+		return m.State
+	}
+	return Deposit_DEPOSIT_PENDING
+}
+
+func (m *Deposit) GetIbcSequenceId() string {
+	if m != nil {
+		return m.IbcSequenceId
+	}
+	return ""
+}
+
+func (m *Deposit) GetRetries() uint64 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
 }
 
 type LSMDeposit struct {
@@ -772,7 +1344,7 @@ func (m *LSMDeposit) Reset()         { *m = LSMDeposit{} }
 func (m *LSMDeposit) String() string { return proto.CompactTextString(m) }
 func (*LSMDeposit) ProtoMessage()    {}
 func (*LSMDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{7}
+	return fileDescriptor_71a9a61e676043b6, []int{9}
 }
 func (m *LSMDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -864,7 +1436,7 @@ func (m *Unbonding) Reset()         { *m = Unbonding{} }
 func (m *Unbonding) String() string { return proto.CompactTextString(m) }
 func (*Unbonding) ProtoMessage()    {}
 func (*Unbonding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{8}
+	return fileDescriptor_71a9a61e676043b6, []int{10}
 }
 func (m *Unbonding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -953,13 +1525,16 @@ type UserUnbonding struct {
 	StkAmount types.Coin `protobuf:"bytes,4,opt,name=stk_amount,json=stkAmount,proto3" json:"stk_amount"`
 	// host token amount that is being unbonded
 	UnbondAmount types.Coin `protobuf:"bytes,5,opt,name=unbond_amount,json=unbondAmount,proto3" json:"unbond_amount"`
+	// whether the unbonding is paid out to the address as soon as it is
+	// claimable, otherwise it waits for a MsgClaim
+	AutoClaim bool `protobuf:"varint,6,opt,name=auto_claim,json=autoClaim,proto3" json:"auto_claim,omitempty"`
 }
 
 func (m *UserUnbonding) Reset()         { *m = UserUnbonding{} }
 func (m *UserUnbonding) String() string { return proto.CompactTextString(m) }
 func (*UserUnbonding) ProtoMessage()    {}
 func (*UserUnbonding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{9}
+	return fileDescriptor_71a9a61e676043b6, []int{11}
 }
 func (m *UserUnbonding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1023,6 +1598,13 @@ func (m *UserUnbonding) GetUnbondAmount() types.Coin {
 	return types.Coin{}
 }
 
+func (m *UserUnbonding) GetAutoClaim() bool {
+	if m != nil {
+		return m.AutoClaim
+	}
+	return false
+}
+
 type ValidatorUnbonding struct {
 	// unbonding target chain
 	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
@@ -1042,7 +1624,7 @@ func (m *ValidatorUnbonding) Reset()         { *m = ValidatorUnbonding{} }
 func (m *ValidatorUnbonding) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUnbonding) ProtoMessage()    {}
 func (*ValidatorUnbonding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{10}
+	return fileDescriptor_71a9a61e676043b6, []int{12}
 }
 func (m *ValidatorUnbonding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1122,7 +1704,7 @@ func (m *KVUpdate) Reset()         { *m = KVUpdate{} }
 func (m *KVUpdate) String() string { return proto.CompactTextString(m) }
 func (*KVUpdate) ProtoMessage()    {}
 func (*KVUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{11}
+	return fileDescriptor_71a9a61e676043b6, []int{13}
 }
 func (m *KVUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1174,7 +1756,7 @@ func (m *Redelegations) Reset()         { *m = Redelegations{} }
 func (m *Redelegations) String() string { return proto.CompactTextString(m) }
 func (*Redelegations) ProtoMessage()    {}
 func (*Redelegations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{12}
+	return fileDescriptor_71a9a61e676043b6, []int{14}
 }
 func (m *Redelegations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1229,7 +1811,7 @@ func (m *RedelegateTx) Reset()         { *m = RedelegateTx{} }
 func (m *RedelegateTx) String() string { return proto.CompactTextString(m) }
 func (*RedelegateTx) ProtoMessage()    {}
 func (*RedelegateTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{13}
+	return fileDescriptor_71a9a61e676043b6, []int{15}
 }
 func (m *RedelegateTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1279,1526 +1861,11117 @@ func (m *RedelegateTx) GetState() RedelegateTx_RedelegateTxState {
 	return RedelegateTx_REDELEGATE_SENT
 }
 
-func init() {
-	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.ICAAccount_ChannelState", ICAAccount_ChannelState_name, ICAAccount_ChannelState_value)
-	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.Deposit_DepositState", Deposit_DepositState_name, Deposit_DepositState_value)
-	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.LSMDeposit_LSMDepositState", LSMDeposit_LSMDepositState_name, LSMDeposit_LSMDepositState_value)
-	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.Unbonding_UnbondingState", Unbonding_UnbondingState_name, Unbonding_UnbondingState_value)
-	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.RedelegateTx_RedelegateTxState", RedelegateTx_RedelegateTxState_name, RedelegateTx_RedelegateTxState_value)
-	proto.RegisterType((*HostChain)(nil), "pstake.liquidstakeibc.v1beta1.HostChain")
-	proto.RegisterType((*HostChainFlags)(nil), "pstake.liquidstakeibc.v1beta1.HostChainFlags")
-	proto.RegisterType((*RewardParams)(nil), "pstake.liquidstakeibc.v1beta1.RewardParams")
-	proto.RegisterType((*HostChainLSParams)(nil), "pstake.liquidstakeibc.v1beta1.HostChainLSParams")
-	proto.RegisterType((*ICAAccount)(nil), "pstake.liquidstakeibc.v1beta1.ICAAccount")
-	proto.RegisterType((*Validator)(nil), "pstake.liquidstakeibc.v1beta1.Validator")
-	proto.RegisterType((*Deposit)(nil), "pstake.liquidstakeibc.v1beta1.Deposit")
-	proto.RegisterType((*LSMDeposit)(nil), "pstake.liquidstakeibc.v1beta1.LSMDeposit")
-	proto.RegisterType((*Unbonding)(nil), "pstake.liquidstakeibc.v1beta1.Unbonding")
-	proto.RegisterType((*UserUnbonding)(nil), "pstake.liquidstakeibc.v1beta1.UserUnbonding")
-	proto.RegisterType((*ValidatorUnbonding)(nil), "pstake.liquidstakeibc.v1beta1.ValidatorUnbonding")
-	proto.RegisterType((*KVUpdate)(nil), "pstake.liquidstakeibc.v1beta1.KVUpdate")
-	proto.RegisterType((*Redelegations)(nil), "pstake.liquidstakeibc.v1beta1.Redelegations")
-	proto.RegisterType((*RedelegateTx)(nil), "pstake.liquidstakeibc.v1beta1.RedelegateTx")
+type DepositReceipt struct {
+	// unique identifier of the receipt
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// address which made the deposit
+	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// deposit target chain
+	ChainId string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// host token amount that was deposited
+	Amount types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// stk token amount received by the delegator, after fees
+	MintedAmount types.Coin `protobuf:"bytes,5,opt,name=minted_amount,json=mintedAmount,proto3" json:"minted_amount"`
+	// c value of the host chain at the time of the deposit
+	CValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=c_value,json=cValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"c_value"`
+	// delegation epoch in which the deposit was made
+	Epoch int64 `protobuf:"varint,7,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// time of the deposit
+	CreatedAt time.Time `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
 }
 
-func init() {
-	proto.RegisterFile("pstake/liquidstakeibc/v1beta1/liquidstakeibc.proto", fileDescriptor_71a9a61e676043b6)
+func (m *DepositReceipt) Reset()         { *m = DepositReceipt{} }
+func (m *DepositReceipt) String() string { return proto.CompactTextString(m) }
+func (*DepositReceipt) ProtoMessage()    {}
+func (*DepositReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{16}
+}
+func (m *DepositReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositReceipt.Merge(m, src)
+}
+func (m *DepositReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositReceipt.DiscardUnknown(m)
 }
 
-var fileDescriptor_71a9a61e676043b6 = []byte{
-	// 1832 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4b, 0x6f, 0xe3, 0xd6,
-	0x15, 0xb6, 0x1e, 0xd6, 0xe3, 0x48, 0xb2, 0xe8, 0x3b, 0x6e, 0x46, 0x33, 0xe9, 0xd8, 0x2e, 0x1b,
-	0x24, 0x0e, 0x02, 0x4b, 0x8d, 0x02, 0x34, 0x68, 0xd0, 0x06, 0x95, 0x25, 0x4e, 0x86, 0x1d, 0x8f,
-	0x3c, 0xa0, 0xe5, 0x41, 0x91, 0xa0, 0x65, 0x29, 0xf2, 0x8e, 0x44, 0x98, 0xaf, 0xf0, 0x52, 0xb6,
-	0xb3, 0xeb, 0xae, 0xdb, 0xac, 0x8a, 0xae, 0x8a, 0xae, 0xbb, 0xea, 0x22, 0x7f, 0xa0, 0xbb, 0x00,
-	0xdd, 0xa4, 0xb3, 0x2a, 0x8a, 0x22, 0x53, 0xcc, 0x00, 0xfd, 0x1d, 0xc5, 0x7d, 0xf0, 0x21, 0xdb,
-	0x1d, 0x69, 0x6a, 0x2d, 0xb2, 0x22, 0xef, 0x39, 0x3c, 0xdf, 0xbd, 0x3c, 0xfc, 0xce, 0x77, 0xcf,
-	0x25, 0x74, 0x03, 0x12, 0x19, 0xa7, 0xb8, 0xe3, 0xd8, 0x9f, 0xcf, 0x6c, 0x8b, 0xdd, 0xdb, 0x63,
-	0xb3, 0x73, 0xf6, 0xfe, 0x18, 0x47, 0xc6, 0xfb, 0x97, 0xcc, 0xed, 0x20, 0xf4, 0x23, 0x1f, 0xdd,
-	0xe3, 0x31, 0xed, 0x4b, 0x4e, 0x11, 0x73, 0x77, 0x6b, 0xe2, 0x4f, 0x7c, 0xf6, 0x64, 0x87, 0xde,
-	0xf1, 0xa0, 0xbb, 0x77, 0x4c, 0x9f, 0xb8, 0x3e, 0xd1, 0xb9, 0x83, 0x0f, 0x84, 0x6b, 0x9b, 0x8f,
-	0x3a, 0x63, 0x83, 0xe0, 0x64, 0x66, 0xd3, 0xb7, 0x3d, 0xe1, 0xdf, 0x99, 0xf8, 0xfe, 0xc4, 0xc1,
-	0x1d, 0x36, 0x1a, 0xcf, 0x9e, 0x76, 0x22, 0xdb, 0xc5, 0x24, 0x32, 0xdc, 0x40, 0x3c, 0xf0, 0x96,
-	0x00, 0xa0, 0x4b, 0xb1, 0xbd, 0x49, 0x82, 0x21, 0xc6, 0xfc, 0x29, 0xf9, 0x59, 0x05, 0xaa, 0x0f,
-	0x7c, 0x12, 0xf5, 0xa7, 0x86, 0xed, 0xa1, 0x3b, 0x50, 0x31, 0xe9, 0x8d, 0x6e, 0x5b, 0xad, 0xdc,
-	0x6e, 0x6e, 0xaf, 0xaa, 0x95, 0xd9, 0x58, 0xb5, 0xd0, 0x0f, 0xa1, 0x61, 0xfa, 0x9e, 0x87, 0xcd,
-	0xc8, 0xf6, 0x99, 0x3f, 0xcf, 0xfc, 0xf5, 0xd4, 0xa8, 0x5a, 0xe8, 0x01, 0x94, 0x02, 0x23, 0x34,
-	0x5c, 0xd2, 0x2a, 0xec, 0xe6, 0xf6, 0x6a, 0xdd, 0x1f, 0xb5, 0x5f, 0x99, 0x95, 0x76, 0x32, 0xf3,
-	0xe1, 0xf1, 0x63, 0x16, 0xa7, 0x89, 0x78, 0x74, 0x0f, 0x60, 0xea, 0x93, 0x48, 0xb7, 0xb0, 0xe7,
-	0xbb, 0xad, 0x22, 0x9b, 0xab, 0x4a, 0x2d, 0x03, 0x6a, 0xa0, 0x6e, 0x73, 0x6a, 0x78, 0x1e, 0x76,
-	0xe8, 0x52, 0xd6, 0xb9, 0x5b, 0x58, 0x54, 0x0b, 0xdd, 0x86, 0x72, 0xe0, 0x87, 0x11, 0xf5, 0x95,
-	0x98, 0xaf, 0x44, 0x87, 0xaa, 0x85, 0x7e, 0x09, 0xc8, 0xc2, 0x0e, 0x9e, 0x18, 0xec, 0x2d, 0x0c,
-	0xd3, 0xf4, 0x67, 0x5e, 0xd4, 0x2a, 0xb3, 0xc5, 0xbe, 0xbb, 0x60, 0xb1, 0x6a, 0xbf, 0xd7, 0xe3,
-	0x01, 0xda, 0x66, 0x0a, 0x22, 0x4c, 0x48, 0x83, 0x66, 0x88, 0xcf, 0x8d, 0xd0, 0x22, 0x09, 0x6c,
-	0xe5, 0x75, 0x61, 0x37, 0x04, 0x42, 0x8c, 0xf9, 0x00, 0xe0, 0xcc, 0x70, 0x6c, 0xcb, 0x88, 0xfc,
-	0x90, 0xb4, 0xaa, 0xbb, 0x85, 0xbd, 0x5a, 0x77, 0x6f, 0x01, 0xdc, 0x93, 0x38, 0x40, 0xcb, 0xc4,
-	0x22, 0x0c, 0x4d, 0xd7, 0xf6, 0x6c, 0x77, 0xe6, 0xea, 0x16, 0x0e, 0x7c, 0x62, 0x47, 0x2d, 0xa0,
-	0x89, 0x39, 0xf8, 0xe9, 0xd7, 0xdf, 0xee, 0xac, 0xfd, 0xf3, 0xdb, 0x9d, 0xb7, 0x27, 0x76, 0x34,
-	0x9d, 0x8d, 0xdb, 0xa6, 0xef, 0x0a, 0x1e, 0x8a, 0xcb, 0x3e, 0xb1, 0x4e, 0x3b, 0xd1, 0x17, 0x01,
-	0x26, 0x6d, 0xd5, 0x8b, 0x9e, 0x7d, 0xb5, 0x0f, 0x82, 0xa6, 0x2a, 0x5d, 0xb0, 0x00, 0x1d, 0x70,
-	0x4c, 0x74, 0x02, 0x65, 0x53, 0x3f, 0x33, 0x9c, 0x19, 0x6e, 0xd5, 0x5e, 0x1b, 0x7e, 0x80, 0xcd,
-	0x0c, 0xfc, 0x00, 0x9b, 0x5a, 0xc9, 0x7c, 0x42, 0xb1, 0xd0, 0xaf, 0xa1, 0xee, 0x18, 0x24, 0xd2,
-	0x63, 0xec, 0xfa, 0x0a, 0xb0, 0x81, 0x22, 0xf6, 0x39, 0xfe, 0xbb, 0x20, 0xcd, 0xbc, 0xb1, 0xef,
-	0x59, 0xb6, 0x37, 0xd1, 0x9f, 0x1a, 0x66, 0xe4, 0x87, 0xad, 0xc6, 0x6e, 0x6e, 0xaf, 0xa0, 0x35,
-	0x13, 0xfb, 0x7d, 0x66, 0x46, 0x6f, 0x40, 0xc9, 0x30, 0x23, 0xfb, 0x0c, 0xb7, 0x36, 0x76, 0x73,
-	0x7b, 0x15, 0x4d, 0x8c, 0x90, 0x07, 0x5b, 0xc6, 0x2c, 0xf2, 0x75, 0xd3, 0x77, 0x03, 0x7f, 0xe6,
-	0x59, 0x31, 0x4c, 0x73, 0x05, 0x4b, 0x45, 0x14, 0xb9, 0x2f, 0x80, 0xc5, 0x3a, 0xfa, 0xb0, 0xfe,
-	0xd4, 0x31, 0x26, 0xa4, 0x25, 0x31, 0x92, 0xed, 0x2f, 0x5b, 0x68, 0xf7, 0x69, 0x90, 0xc6, 0x63,
-	0xd1, 0x63, 0x68, 0x70, 0xc6, 0xe9, 0xa2, 0x6a, 0x37, 0x19, 0xd8, 0x7b, 0x0b, 0xc0, 0x34, 0x16,
-	0x23, 0x0a, 0xb6, 0x1e, 0x66, 0x46, 0x1f, 0x15, 0xff, 0xf0, 0xa7, 0x9d, 0x9c, 0x2c, 0xc3, 0xc6,
-	0xfc, 0x84, 0x48, 0x82, 0x82, 0x43, 0x5c, 0xa6, 0x29, 0x15, 0x8d, 0xde, 0xca, 0xbf, 0x81, 0x7a,
-	0x16, 0x07, 0x6d, 0xc1, 0x3a, 0xaf, 0x75, 0xae, 0x3b, 0x7c, 0x80, 0x3e, 0x82, 0x9a, 0x85, 0x49,
-	0x64, 0x7b, 0xac, 0xd6, 0xb8, 0xe6, 0x1c, 0xb4, 0x9e, 0x7d, 0xb5, 0xbf, 0x25, 0xf2, 0xd3, 0xb3,
-	0xac, 0x10, 0x13, 0x72, 0x1c, 0x85, 0xb6, 0x37, 0xd1, 0xb2, 0x0f, 0xcb, 0x2f, 0xcb, 0xb0, 0x79,
-	0x45, 0x60, 0xd0, 0xaf, 0x28, 0x22, 0x63, 0xab, 0xfe, 0x14, 0x63, 0x3e, 0xdb, 0x4d, 0xa9, 0x24,
-	0x00, 0xef, 0x63, 0x4c, 0xe1, 0x43, 0xcc, 0x32, 0xc6, 0xe0, 0xf3, 0xab, 0x80, 0x17, 0x80, 0x02,
-	0x7e, 0xe6, 0xa5, 0xf0, 0x85, 0x55, 0xc0, 0x0b, 0x40, 0x0a, 0x6f, 0xc2, 0x46, 0x88, 0x2d, 0xec,
-	0x06, 0x4c, 0x1e, 0xe9, 0x0c, 0xc5, 0x15, 0xcc, 0xd0, 0x48, 0x31, 0xe9, 0x24, 0x53, 0xd8, 0x74,
-	0x88, 0xab, 0x27, 0xea, 0xa4, 0x9b, 0x46, 0xc0, 0x65, 0xfa, 0x86, 0xf3, 0x34, 0x1d, 0xe2, 0x26,
-	0xf2, 0xd7, 0x37, 0x02, 0x64, 0x01, 0x35, 0xe9, 0xb4, 0x82, 0xe3, 0x7a, 0x2c, 0xaf, 0xe2, 0x7d,
-	0x1c, 0xe2, 0x1e, 0xf8, 0x49, 0x29, 0xee, 0x40, 0xcd, 0x35, 0x2e, 0x74, 0xec, 0x45, 0xa1, 0x8d,
-	0x09, 0x53, 0xfd, 0x86, 0x06, 0xae, 0x71, 0xa1, 0x70, 0x0b, 0xfa, 0x6d, 0x0e, 0xee, 0xd1, 0x14,
-	0x64, 0xf7, 0x1d, 0x1c, 0x44, 0xc6, 0xd8, 0xc1, 0xba, 0x85, 0x9d, 0xc8, 0x68, 0x55, 0x57, 0xa0,
-	0xc5, 0x6f, 0x66, 0xa7, 0xe8, 0x25, 0x33, 0x0c, 0xe8, 0x04, 0xe8, 0x14, 0x6e, 0xcd, 0x82, 0x00,
-	0x87, 0xb1, 0x84, 0xea, 0x8e, 0xed, 0xfe, 0x5f, 0x7b, 0xc0, 0xd5, 0x6c, 0x48, 0x0c, 0x98, 0x2b,
-	0xe9, 0x21, 0x45, 0xa5, 0x93, 0x39, 0xfe, 0xf9, 0x95, 0xc9, 0x56, 0xb1, 0x23, 0x48, 0x0c, 0x38,
-	0x33, 0x99, 0xfc, 0xaf, 0x3c, 0x40, 0xba, 0x85, 0xa2, 0x2e, 0x94, 0x0d, 0x2e, 0x09, 0xa2, 0xb4,
-	0xff, 0xb7, 0x58, 0xc4, 0x0f, 0x22, 0x0b, 0xca, 0x63, 0xc3, 0x31, 0x3c, 0x93, 0xd7, 0x6b, 0xad,
-	0x7b, 0xa7, 0x2d, 0x02, 0x68, 0xf3, 0x95, 0xc8, 0x5e, 0xdf, 0xb7, 0xbd, 0x83, 0x0e, 0x5d, 0xfe,
-	0x9f, 0x9f, 0xef, 0xbc, 0xb3, 0xc4, 0xf2, 0x69, 0x80, 0x16, 0x43, 0x53, 0x81, 0xf3, 0xcf, 0x3d,
-	0x1c, 0xf2, 0xa2, 0xd5, 0xf8, 0x00, 0x7d, 0x06, 0x8d, 0xb8, 0x91, 0x21, 0x91, 0x11, 0xf1, 0x82,
-	0xdb, 0xe8, 0xfe, 0x78, 0xe9, 0xa6, 0xa1, 0xdd, 0xe7, 0xe1, 0xc7, 0x34, 0x5a, 0xab, 0x9b, 0x99,
-	0x91, 0xdc, 0x83, 0x7a, 0xd6, 0x8b, 0x5a, 0xb0, 0xa5, 0xf6, 0x7b, 0x7a, 0xff, 0x41, 0x6f, 0x38,
-	0x54, 0x0e, 0xf5, 0xbe, 0xa6, 0xf4, 0x46, 0xea, 0xf0, 0x13, 0x69, 0x0d, 0xdd, 0x86, 0x5b, 0x57,
-	0x3c, 0xca, 0x40, 0xca, 0xc9, 0x7f, 0x2f, 0x40, 0x35, 0xa9, 0x29, 0xd4, 0x07, 0xc9, 0x0f, 0x70,
-	0xc8, 0xaa, 0x76, 0xd9, 0x34, 0x37, 0xe3, 0x08, 0x61, 0xa6, 0x5b, 0x28, 0x7d, 0xd5, 0x19, 0x11,
-	0x2d, 0xa4, 0x18, 0xa1, 0x11, 0x94, 0xce, 0xb1, 0x3d, 0x99, 0x46, 0x2b, 0x91, 0x35, 0x81, 0x85,
-	0x26, 0x20, 0x89, 0xb2, 0xc0, 0x96, 0x6e, 0xb8, 0xac, 0x31, 0x2b, 0xae, 0xa0, 0xdc, 0x9a, 0x09,
-	0x6a, 0x8f, 0x81, 0x22, 0x03, 0x1a, 0xf8, 0x82, 0xa6, 0x7f, 0x82, 0xf5, 0x90, 0x7e, 0xc9, 0xf5,
-	0x15, 0xbc, 0x45, 0x3d, 0x86, 0xd4, 0xe8, 0xf7, 0x7b, 0x07, 0xd2, 0x7e, 0x44, 0xc7, 0x81, 0x6f,
-	0x4e, 0x99, 0x6e, 0x16, 0xb4, 0x8d, 0xc4, 0xac, 0x50, 0x2b, 0xfa, 0x3e, 0x54, 0xf9, 0xf2, 0xc6,
-	0x0e, 0x66, 0x92, 0x57, 0xd1, 0x52, 0x83, 0xfc, 0xb7, 0x3c, 0x94, 0xe3, 0x8e, 0xed, 0x15, 0x1d,
-	0xff, 0x87, 0x50, 0x12, 0xf9, 0x5a, 0x58, 0x15, 0x45, 0xfa, 0x92, 0x9a, 0x78, 0x9c, 0x32, 0x9d,
-	0x2f, 0xae, 0xc0, 0x16, 0xc7, 0x07, 0x48, 0x85, 0xf5, 0x2c, 0xc3, 0x3f, 0x58, 0xc0, 0x70, 0xb1,
-	0xc0, 0xf8, 0xca, 0xe9, 0xcd, 0x11, 0xd0, 0xdb, 0xd0, 0xb4, 0xc7, 0xa6, 0x4e, 0xf0, 0xe7, 0x33,
-	0xec, 0x99, 0x38, 0x3d, 0x02, 0x34, 0xec, 0xb1, 0x79, 0x2c, 0xac, 0xaa, 0x25, 0x9b, 0x50, 0xcf,
-	0x86, 0xa3, 0x5b, 0xd0, 0x1c, 0x28, 0x8f, 0x8f, 0x8e, 0xd5, 0x91, 0xfe, 0x58, 0x19, 0x0e, 0x38,
-	0xf5, 0x25, 0xa8, 0xc7, 0xc6, 0x63, 0x65, 0x38, 0x92, 0x72, 0x68, 0x0b, 0xa4, 0xd8, 0xa2, 0x29,
-	0x7d, 0x45, 0x7d, 0xa2, 0x0c, 0xa4, 0x3c, 0x7a, 0x03, 0x50, 0x6c, 0x1d, 0x28, 0x87, 0xca, 0x27,
-	0xbc, 0x74, 0x0a, 0xf2, 0xef, 0x8b, 0x00, 0x87, 0xc7, 0x8f, 0x96, 0x48, 0xe8, 0x68, 0x2e, 0xa1,
-	0x37, 0x25, 0x60, 0x9c, 0xed, 0x11, 0x94, 0xc8, 0xd4, 0x08, 0x31, 0x59, 0x4d, 0xd9, 0x70, 0xac,
-	0xb4, 0x1d, 0x2b, 0x66, 0xdb, 0xb1, 0x37, 0xa1, 0x4a, 0x13, 0xcf, 0x3d, 0x3c, 0xe5, 0x15, 0x7b,
-	0x6c, 0xf2, 0x33, 0xd9, 0x7b, 0x10, 0x1f, 0x8b, 0x32, 0xea, 0xc0, 0x8f, 0x5f, 0x52, 0xe2, 0x88,
-	0x45, 0xe0, 0x28, 0x66, 0x43, 0x99, 0xb1, 0xe1, 0x27, 0x0b, 0xd8, 0x90, 0x26, 0x38, 0x73, 0xbb,
-	0x88, 0x13, 0x95, 0xeb, 0x38, 0x31, 0x85, 0xe6, 0x25, 0x84, 0x9b, 0xd1, 0xa2, 0x05, 0x5b, 0xb1,
-	0xf5, 0x64, 0x38, 0x3a, 0x7a, 0xa8, 0x0c, 0xd5, 0x4f, 0x39, 0x31, 0xfe, 0x52, 0x84, 0xea, 0x49,
-	0x5c, 0x97, 0xaf, 0xe2, 0xc5, 0x0f, 0xa0, 0xce, 0x4a, 0x44, 0xf7, 0x66, 0xee, 0x18, 0x87, 0x8c,
-	0x1d, 0x05, 0xad, 0xc6, 0x6c, 0x43, 0x66, 0x42, 0x0a, 0xed, 0x31, 0xa2, 0x59, 0x88, 0x75, 0x7a,
-	0xcc, 0x17, 0xa7, 0xeb, 0xbb, 0x6d, 0xfe, 0x0f, 0xa0, 0x1d, 0xff, 0x03, 0x68, 0x8f, 0xe2, 0x7f,
-	0x00, 0x07, 0x15, 0xca, 0x82, 0x2f, 0x9f, 0xef, 0xe4, 0x68, 0x27, 0x42, 0x03, 0xa9, 0x0b, 0xfd,
-	0x1c, 0x6a, 0xe3, 0x59, 0xe8, 0x65, 0x75, 0x70, 0x89, 0xba, 0x06, 0x1a, 0x23, 0x54, 0x6e, 0x00,
-	0x0d, 0xae, 0x35, 0x31, 0xc6, 0xfa, 0x72, 0x18, 0x75, 0x1e, 0x25, 0x50, 0xae, 0xf9, 0x58, 0xa5,
-	0x6b, 0x3e, 0x16, 0x7a, 0x34, 0xcf, 0x92, 0x0f, 0x17, 0xb0, 0x24, 0xc9, 0x76, 0x7a, 0x97, 0xe5,
-	0x88, 0xfc, 0xc7, 0x1c, 0x6c, 0xcc, 0x7b, 0xd0, 0xf7, 0x60, 0xf3, 0x64, 0x78, 0x70, 0xc4, 0xbe,
-	0x7a, 0xe6, 0xeb, 0xdf, 0x86, 0x5b, 0xa9, 0x59, 0x1d, 0xaa, 0x23, 0x95, 0xef, 0x87, 0x54, 0x05,
-	0x52, 0xc7, 0xa3, 0xde, 0xe8, 0x44, 0xa3, 0x01, 0xf9, 0x79, 0x1c, 0x66, 0x57, 0x06, 0x52, 0x61,
-	0x1e, 0xa7, 0x7f, 0xd8, 0x53, 0x1f, 0xf5, 0x0e, 0x0e, 0x15, 0xa9, 0x48, 0xc9, 0x94, 0x3a, 0xee,
-	0xf7, 0xd4, 0x43, 0x65, 0x20, 0xad, 0xcb, 0xbf, 0xcb, 0x43, 0xe3, 0x84, 0xe0, 0x70, 0x55, 0xb4,
-	0xc9, 0x74, 0x43, 0x85, 0x65, 0xbb, 0xa1, 0x8f, 0x01, 0x48, 0x74, 0xfa, 0x9a, 0x14, 0xa9, 0x92,
-	0xe8, 0x74, 0x95, 0x0c, 0x91, 0xff, 0x9a, 0x07, 0x94, 0xf4, 0x1d, 0xdf, 0xb1, 0x2a, 0x52, 0x60,
-	0x33, 0x3d, 0xbc, 0xc4, 0xf9, 0x2d, 0x2e, 0xc8, 0xaf, 0x94, 0x84, 0xc4, 0x12, 0x98, 0xee, 0xaf,
-	0xeb, 0xaf, 0xb7, 0xbf, 0x2e, 0x59, 0x3d, 0x72, 0x17, 0x2a, 0x0f, 0x9f, 0x9c, 0x04, 0x16, 0xe5,
-	0xb9, 0x04, 0x85, 0x53, 0xfc, 0x85, 0xc8, 0x19, 0xbd, 0xa5, 0x0a, 0xcf, 0xff, 0xa6, 0xf0, 0x2e,
-	0x8c, 0x0f, 0xe4, 0x73, 0x68, 0x68, 0x99, 0x73, 0x04, 0x41, 0x77, 0xa1, 0x2a, 0x32, 0xae, 0x5f,
-	0x4a, 0xf9, 0x00, 0xfd, 0x02, 0x1a, 0xd9, 0x43, 0x07, 0x6d, 0xe8, 0x0a, 0x7b, 0xb5, 0xee, 0x5b,
-	0xf1, 0x8b, 0xc4, 0xbf, 0x1a, 0xd3, 0x1f, 0x07, 0xe9, 0xc3, 0xda, 0x7c, 0xa8, 0xfc, 0x9f, 0x1c,
-	0xd4, 0x13, 0x3f, 0x1e, 0x5d, 0xbc, 0xea, 0x53, 0x5f, 0x93, 0x80, 0xfc, 0x75, 0xf2, 0x71, 0x1c,
-	0xcb, 0x47, 0x81, 0xc9, 0xc7, 0xcf, 0x16, 0xfe, 0xd7, 0x48, 0xa7, 0x9f, 0x1b, 0xcc, 0x89, 0xc8,
-	0xc7, 0xb0, 0x79, 0xc5, 0x47, 0xb7, 0x10, 0x4d, 0x11, 0x6d, 0x81, 0xc2, 0x37, 0x8c, 0x35, 0x5a,
-	0xe3, 0x19, 0x63, 0xaf, 0xff, 0x90, 0x2a, 0xc8, 0xc1, 0x67, 0x5f, 0xbf, 0xd8, 0xce, 0x7d, 0xf3,
-	0x62, 0x3b, 0xf7, 0xef, 0x17, 0xdb, 0xb9, 0x2f, 0x5f, 0x6e, 0xaf, 0x7d, 0xf3, 0x72, 0x7b, 0xed,
-	0x1f, 0x2f, 0xb7, 0xd7, 0x3e, 0xed, 0x65, 0x76, 0xec, 0x00, 0x87, 0xc4, 0x26, 0x11, 0x7d, 0x91,
-	0x23, 0x0f, 0x77, 0xf8, 0xc2, 0xf7, 0x3d, 0x23, 0xb2, 0xcf, 0x70, 0xe7, 0xac, 0xdb, 0xb9, 0xb8,
-	0xfc, 0x73, 0x9a, 0x6d, 0xe8, 0xe3, 0x12, 0x23, 0xf1, 0x07, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0xfd, 0xb7, 0xf7, 0x54, 0xc2, 0x16, 0x00, 0x00,
+var xxx_messageInfo_DepositReceipt proto.InternalMessageInfo
+
+func (m *DepositReceipt) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *HostChain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DepositReceipt) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *HostChain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DepositReceipt) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (m *HostChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RewardParams != nil {
-		{
-			size, err := m.RewardParams.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+func (m *DepositReceipt) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	if m.Flags != nil {
-		{
-			size, err := m.Flags.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+	return types.Coin{}
+}
+
+func (m *DepositReceipt) GetMintedAmount() types.Coin {
+	if m != nil {
+		return m.MintedAmount
 	}
-	{
-		size := m.AutoCompoundFactor.Size()
-		i -= size
-		if _, err := m.AutoCompoundFactor.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	return types.Coin{}
+}
+
+func (m *DepositReceipt) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
 	}
-	i--
-	dAtA[i] = 0x7a
-	if m.Active {
-		i--
-		if m.Active {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x70
-	}
-	if m.UnbondingFactor != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.UnbondingFactor))
-		i--
-		dAtA[i] = 0x68
-	}
-	{
-		size := m.LastCValue.Size()
-		i -= size
-		if _, err := m.LastCValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *DepositReceipt) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	i--
-	dAtA[i] = 0x62
-	{
-		size := m.CValue.Size()
-		i -= size
-		if _, err := m.CValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return time.Time{}
+}
+
+type DepositShortfall struct {
+	// deposit target chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// epoch number of the deposit that could not be fully sent
+	Epoch int64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// amount missing from the deposit module account when the deposit was sent
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *DepositShortfall) Reset()         { *m = DepositShortfall{} }
+func (m *DepositShortfall) String() string { return proto.CompactTextString(m) }
+func (*DepositShortfall) ProtoMessage()    {}
+func (*DepositShortfall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{17}
+}
+func (m *DepositShortfall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositShortfall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositShortfall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.MinimumDeposit.Size()
-		i -= size
-		if _, err := m.MinimumDeposit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+}
+func (m *DepositShortfall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositShortfall.Merge(m, src)
+}
+func (m *DepositShortfall) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositShortfall) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositShortfall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositShortfall proto.InternalMessageInfo
+
+func (m *DepositShortfall) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	i--
-	dAtA[i] = 0x52
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
+	return ""
+}
+
+func (m *DepositShortfall) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
 	}
-	if m.RewardsAccount != nil {
-		{
-			size, err := m.RewardsAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+	return 0
+}
+
+func (m *DepositShortfall) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	if m.DelegationAccount != nil {
-		{
-			size, err := m.DelegationAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	return types.Coin{}
+}
+
+type LiquidityIncentive struct {
+	// host chain the incentive is accrued for
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// protocol fees accrued since the last stream to the liquidity incentive
+	// address
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *LiquidityIncentive) Reset()         { *m = LiquidityIncentive{} }
+func (m *LiquidityIncentive) String() string { return proto.CompactTextString(m) }
+func (*LiquidityIncentive) ProtoMessage()    {}
+func (*LiquidityIncentive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{18}
+}
+func (m *LiquidityIncentive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityIncentive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityIncentive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0x32
+		return b[:n], nil
 	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *LiquidityIncentive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityIncentive.Merge(m, src)
+}
+func (m *LiquidityIncentive) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityIncentive) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityIncentive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityIncentive proto.InternalMessageInfo
+
+func (m *LiquidityIncentive) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if len(m.HostDenom) > 0 {
-		i -= len(m.HostDenom)
-		copy(dAtA[i:], m.HostDenom)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.HostDenom)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *LiquidityIncentive) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	return nil
+}
+
+type FeeReport struct {
+	// host chain the fees were collected on
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// deposit fees collected, in stk and host tokens
+	DepositFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=deposit_fees,json=depositFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit_fees"`
+	// restake fees collected, in stk and host tokens
+	RestakeFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=restake_fees,json=restakeFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"restake_fees"`
+	// unstake fees collected, in stk and host tokens
+	UnstakeFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=unstake_fees,json=unstakeFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unstake_fees"`
+	// redemption fees collected, in stk and host tokens
+	RedemptionFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=redemption_fees,json=redemptionFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"redemption_fees"`
+}
+
+func (m *FeeReport) Reset()         { *m = FeeReport{} }
+func (m *FeeReport) String() string { return proto.CompactTextString(m) }
+func (*FeeReport) ProtoMessage()    {}
+func (*FeeReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{19}
+}
+func (m *FeeReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *FeeReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeReport.Merge(m, src)
+}
+func (m *FeeReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeReport proto.InternalMessageInfo
+
+func (m *FeeReport) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *HostChainFlags) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FeeReport) GetDepositFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DepositFees
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *HostChainFlags) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FeeReport) GetRestakeFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RestakeFees
+	}
+	return nil
 }
 
-func (m *HostChainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Lsm {
-		i--
-		if m.Lsm {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (m *FeeReport) GetUnstakeFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UnstakeFees
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *RewardParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FeeReport) GetRedemptionFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RedemptionFees
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RewardParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ValidatorExit struct {
+	// host chain of the exiting validator
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// operator address of the exiting validator
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// undelegation epoch the exit was queued in
+	QueuedEpoch int64 `protobuf:"varint,3,opt,name=queued_epoch,json=queuedEpoch,proto3" json:"queued_epoch,omitempty"`
+	// undelegation epoch from which the total unbonding can be executed
+	ExecuteEpoch int64 `protobuf:"varint,4,opt,name=execute_epoch,json=executeEpoch,proto3" json:"execute_epoch,omitempty"`
 }
 
-func (m *RewardParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Destination) > 0 {
-		i -= len(m.Destination)
-		copy(dAtA[i:], m.Destination)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Destination)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *ValidatorExit) Reset()         { *m = ValidatorExit{} }
+func (m *ValidatorExit) String() string { return proto.CompactTextString(m) }
+func (*ValidatorExit) ProtoMessage()    {}
+func (*ValidatorExit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{20}
+}
+func (m *ValidatorExit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorExit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorExit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ValidatorExit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorExit.Merge(m, src)
+}
+func (m *ValidatorExit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorExit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorExit.DiscardUnknown(m)
 }
 
-func (m *HostChainLSParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorExit proto.InternalMessageInfo
+
+func (m *ValidatorExit) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *HostChainLSParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorExit) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
 }
 
-func (m *HostChainLSParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.LowerCValueLimit.Size()
-		i -= size
-		if _, err := m.LowerCValueLimit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.UpperCValueLimit.Size()
-		i -= size
-		if _, err := m.UpperCValueLimit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.RedelegationAcceptableDelta.Size()
-		i -= size
-		if _, err := m.RedelegationAcceptableDelta.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	if m.MaxEntries != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.MaxEntries))
-		i--
-		dAtA[i] = 0x40
-	}
-	{
-		size := m.LsmBondFactor.Size()
-		i -= size
-		if _, err := m.LsmBondFactor.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+func (m *ValidatorExit) GetQueuedEpoch() int64 {
+	if m != nil {
+		return m.QueuedEpoch
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.LsmValidatorCap.Size()
-		i -= size
-		if _, err := m.LsmValidatorCap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *ValidatorExit) GetExecuteEpoch() int64 {
+	if m != nil {
+		return m.ExecuteEpoch
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.RedemptionFee.Size()
-		i -= size
-		if _, err := m.RedemptionFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+type PendingParamChange struct {
+	// host chain the update is staged for
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// key of the host chain update
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// value of the host chain update
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// block time from which the update is applied
+	EffectiveTime time.Time `protobuf:"bytes,4,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
+}
+
+func (m *PendingParamChange) Reset()         { *m = PendingParamChange{} }
+func (m *PendingParamChange) String() string { return proto.CompactTextString(m) }
+func (*PendingParamChange) ProtoMessage()    {}
+func (*PendingParamChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{21}
+}
+func (m *PendingParamChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingParamChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingParamChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.UnstakeFee.Size()
-		i -= size
-		if _, err := m.UnstakeFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+}
+func (m *PendingParamChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingParamChange.Merge(m, src)
+}
+func (m *PendingParamChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingParamChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingParamChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingParamChange proto.InternalMessageInfo
+
+func (m *PendingParamChange) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.RestakeFee.Size()
-		i -= size
-		if _, err := m.RestakeFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *PendingParamChange) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.DepositFee.Size()
-		i -= size
-		if _, err := m.DepositFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *PendingParamChange) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ICAAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PendingParamChange) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *ICAAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type HostChainFailures struct {
+	// host chain the failures happened on
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// failed ica and icq interactions since the last successful one
+	ConsecutiveFailures uint64 `protobuf:"varint,2,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// error of the last failure
+	LastError string `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// block height of the last failure
+	LastFailureHeight int64 `protobuf:"varint,4,opt,name=last_failure_height,json=lastFailureHeight,proto3" json:"last_failure_height,omitempty"`
 }
 
-func (m *ICAAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChannelState != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.ChannelState))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+func (m *HostChainFailures) Reset()         { *m = HostChainFailures{} }
+func (m *HostChainFailures) String() string { return proto.CompactTextString(m) }
+func (*HostChainFailures) ProtoMessage()    {}
+func (*HostChainFailures) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{22}
+}
+func (m *HostChainFailures) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostChainFailures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostChainFailures.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *HostChainFailures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostChainFailures.Merge(m, src)
+}
+func (m *HostChainFailures) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostChainFailures) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostChainFailures.DiscardUnknown(m)
 }
 
-func (m *Validator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_HostChainFailures proto.InternalMessageInfo
+
+func (m *HostChainFailures) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *HostChainFailures) GetConsecutiveFailures() uint64 {
+	if m != nil {
+		return m.ConsecutiveFailures
+	}
+	return 0
 }
 
-func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Delegable {
-		i--
-		if m.Delegable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.UnbondingEpoch != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.UnbondingEpoch))
-		i--
-		dAtA[i] = 0x30
+func (m *HostChainFailures) GetLastError() string {
+	if m != nil {
+		return m.LastError
 	}
-	{
-		size := m.ExchangeRate.Size()
-		i -= size
-		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *HostChainFailures) GetLastFailureHeight() int64 {
+	if m != nil {
+		return m.LastFailureHeight
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.DelegatedAmount.Size()
-		i -= size
-		if _, err := m.DelegatedAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+type ValidatorDrain struct {
+	// host chain of the drained validator
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// operator address of the drained validator
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// delegation to the validator when the drain started
+	InitialAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=initial_amount,json=initialAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_amount"`
+	// delegation left on the validator at the last drain epoch
+	RemainingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=remaining_amount,json=remainingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining_amount"`
+	// amount sent in redelegations away from the validator
+	RedelegatedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=redelegated_amount,json=redelegatedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"redelegated_amount"`
+	// redelegation epoch the drain started in
+	StartEpoch int64 `protobuf:"varint,6,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// last redelegation epoch the drain sent redelegations in
+	LastEpoch int64 `protobuf:"varint,7,opt,name=last_epoch,json=lastEpoch,proto3" json:"last_epoch,omitempty"`
+}
+
+func (m *ValidatorDrain) Reset()         { *m = ValidatorDrain{} }
+func (m *ValidatorDrain) String() string { return proto.CompactTextString(m) }
+func (*ValidatorDrain) ProtoMessage()    {}
+func (*ValidatorDrain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{23}
+}
+func (m *ValidatorDrain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorDrain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorDrain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Weight.Size()
-		i -= size
-		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+}
+func (m *ValidatorDrain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorDrain.Merge(m, src)
+}
+func (m *ValidatorDrain) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorDrain) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorDrain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorDrain proto.InternalMessageInfo
+
+func (m *ValidatorDrain) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *ValidatorDrain) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ValidatorDrain) GetStartEpoch() int64 {
+	if m != nil {
+		return m.StartEpoch
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Deposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorDrain) GetLastEpoch() int64 {
+	if m != nil {
+		return m.LastEpoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ICATxRetry struct {
+	// unique identifier of the retry
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// host chain the ica tx is sent to
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// workflow the ica tx was sent by
+	Type ICATxRetry_ICATxRetryType `protobuf:"varint,3,opt,name=type,proto3,enum=pstake.liquidstakeibc.v1beta1.ICATxRetry_ICATxRetryType" json:"type,omitempty"`
+	// epoch of the workflow the ica tx was sent in
+	Epoch int64 `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// messages of the ica tx
+	Messages []*types2.Any `protobuf:"bytes,5,rep,name=messages,proto3" json:"messages,omitempty"`
+	// failed submissions of the ica tx
+	Attempts uint64 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// block height from which the ica tx is submitted again
+	NextAttemptHeight int64 `protobuf:"varint,7,opt,name=next_attempt_height,json=nextAttemptHeight,proto3" json:"next_attempt_height,omitempty"`
+	// error of the last failed submission
+	LastError string `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
 }
 
-func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IbcSequenceId) > 0 {
-		i -= len(m.IbcSequenceId)
-		copy(dAtA[i:], m.IbcSequenceId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.State != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Epoch != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *ICATxRetry) Reset()         { *m = ICATxRetry{} }
+func (m *ICATxRetry) String() string { return proto.CompactTextString(m) }
+func (*ICATxRetry) ProtoMessage()    {}
+func (*ICATxRetry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{24}
+}
+func (m *ICATxRetry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ICATxRetry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ICATxRetry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ICATxRetry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ICATxRetry.Merge(m, src)
+}
+func (m *ICATxRetry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ICATxRetry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ICATxRetry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ICATxRetry proto.InternalMessageInfo
+
+func (m *ICATxRetry) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *LSMDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ICATxRetry) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *LSMDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ICATxRetry) GetType() ICATxRetry_ICATxRetryType {
+	if m != nil {
+		return m.Type
+	}
+	return ICATxRetry_RETRY_UNDELEGATION
 }
 
-func (m *LSMDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IbcSequenceId) > 0 {
-		i -= len(m.IbcSequenceId)
-		copy(dAtA[i:], m.IbcSequenceId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
-		i--
-		dAtA[i] = 0x42
+func (m *ICATxRetry) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
 	}
-	if m.State != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x38
+	return 0
+}
+
+func (m *ICATxRetry) GetMessages() []*types2.Any {
+	if m != nil {
+		return m.Messages
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0x32
+	return nil
+}
+
+func (m *ICATxRetry) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
 	}
-	if len(m.IbcDenom) > 0 {
-		i -= len(m.IbcDenom)
-		copy(dAtA[i:], m.IbcDenom)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcDenom)))
-		i--
-		dAtA[i] = 0x2a
+	return 0
+}
+
+func (m *ICATxRetry) GetNextAttemptHeight() int64 {
+	if m != nil {
+		return m.NextAttemptHeight
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *ICATxRetry) GetLastError() string {
+	if m != nil {
+		return m.LastError
 	}
-	{
-		size := m.Shares.Size()
-		i -= size
-		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return ""
+}
+
+type ChannelMigration struct {
+	// host chain being migrated
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// transfer channel used before the migration
+	OldChannelId string `protobuf:"bytes,2,opt,name=old_channel_id,json=oldChannelId,proto3" json:"old_channel_id,omitempty"`
+	// transfer channel used after the migration
+	NewChannelId string `protobuf:"bytes,3,opt,name=new_channel_id,json=newChannelId,proto3" json:"new_channel_id,omitempty"`
+	// ibc denom of the host token over the old channel
+	OldIbcDenom string `protobuf:"bytes,4,opt,name=old_ibc_denom,json=oldIbcDenom,proto3" json:"old_ibc_denom,omitempty"`
+	// ibc denom of the host token over the new channel
+	NewIbcDenom string `protobuf:"bytes,5,opt,name=new_ibc_denom,json=newIbcDenom,proto3" json:"new_ibc_denom,omitempty"`
+	// delegation epoch the migration was started in
+	StartEpoch int64 `protobuf:"varint,6,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// delegation epoch the channel was switched in
+	CompletedEpoch int64 `protobuf:"varint,7,opt,name=completed_epoch,json=completedEpoch,proto3" json:"completed_epoch,omitempty"`
+	// state of the migration
+	State ChannelMigration_ChannelMigrationState `protobuf:"varint,8,opt,name=state,proto3,enum=pstake.liquidstakeibc.v1beta1.ChannelMigration_ChannelMigrationState" json:"state,omitempty"`
+}
+
+func (m *ChannelMigration) Reset()         { *m = ChannelMigration{} }
+func (m *ChannelMigration) String() string { return proto.CompactTextString(m) }
+func (*ChannelMigration) ProtoMessage()    {}
+func (*ChannelMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{25}
+}
+func (m *ChannelMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+}
+func (m *ChannelMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelMigration.Merge(m, src)
+}
+func (m *ChannelMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelMigration proto.InternalMessageInfo
+
+func (m *ChannelMigration) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ChannelMigration) GetOldChannelId() string {
+	if m != nil {
+		return m.OldChannelId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Unbonding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ChannelMigration) GetNewChannelId() string {
+	if m != nil {
+		return m.NewChannelId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Unbonding) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ChannelMigration) GetOldIbcDenom() string {
+	if m != nil {
+		return m.OldIbcDenom
+	}
+	return ""
 }
 
-func (m *Unbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.State != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x38
+func (m *ChannelMigration) GetNewIbcDenom() string {
+	if m != nil {
+		return m.NewIbcDenom
 	}
-	if len(m.IbcSequenceId) > 0 {
-		i -= len(m.IbcSequenceId)
-		copy(dAtA[i:], m.IbcSequenceId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *ChannelMigration) GetStartEpoch() int64 {
+	if m != nil {
+		return m.StartEpoch
 	}
-	{
-		size, err := m.UnbondAmount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *ChannelMigration) GetCompletedEpoch() int64 {
+	if m != nil {
+		return m.CompletedEpoch
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.BurnAmount.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+func (m *ChannelMigration) GetState() ChannelMigration_ChannelMigrationState {
+	if m != nil {
+		return m.State
+	}
+	return ChannelMigration_MIGRATION_DRAINING
+}
+
+type PendingMint struct {
+	// deposit target chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// delegation epoch in which the deposit was made
+	Epoch int64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// address which made the deposit
+	DelegatorAddress string `protobuf:"bytes,3,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// host token amount that was deposited
+	DepositAmount types.Coin `protobuf:"bytes,4,opt,name=deposit_amount,json=depositAmount,proto3" json:"deposit_amount"`
+	// stk token amount to be minted for the delegator, after fees
+	MintAmount types.Coin `protobuf:"bytes,5,opt,name=mint_amount,json=mintAmount,proto3" json:"mint_amount"`
+	// stk token amount to be minted as protocol fee
+	FeeAmount types.Coin `protobuf:"bytes,6,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount"`
+	// state of the pending mint
+	State PendingMint_PendingMintState `protobuf:"varint,7,opt,name=state,proto3,enum=pstake.liquidstakeibc.v1beta1.PendingMint_PendingMintState" json:"state,omitempty"`
+}
+
+func (m *PendingMint) Reset()         { *m = PendingMint{} }
+func (m *PendingMint) String() string { return proto.CompactTextString(m) }
+func (*PendingMint) ProtoMessage()    {}
+func (*PendingMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{26}
+}
+func (m *PendingMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.MatureTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MatureTime):])
-	if err10 != nil {
-		return 0, err10
+}
+func (m *PendingMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingMint.Merge(m, src)
+}
+func (m *PendingMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingMint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingMint proto.InternalMessageInfo
+
+func (m *PendingMint) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	i -= n10
-	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n10))
-	i--
-	dAtA[i] = 0x1a
-	if m.EpochNumber != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *PendingMint) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *PendingMint) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *UserUnbonding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PendingMint) GetDepositAmount() types.Coin {
+	if m != nil {
+		return m.DepositAmount
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *UserUnbonding) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PendingMint) GetMintAmount() types.Coin {
+	if m != nil {
+		return m.MintAmount
+	}
+	return types.Coin{}
 }
 
-func (m *UserUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.UnbondAmount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+func (m *PendingMint) GetFeeAmount() types.Coin {
+	if m != nil {
+		return m.FeeAmount
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.StkAmount.MarshalToSizedBuffer(dAtA[:i])
+	return types.Coin{}
+}
+
+func (m *PendingMint) GetState() PendingMint_PendingMintState {
+	if m != nil {
+		return m.State
+	}
+	return PendingMint_PENDING_MINT_AWAITING_DELEGATION
+}
+
+// VestingLock holds the stk tokens minted for the locked coins a vesting
+// account deposited, until they vest
+type VestingLock struct {
+	// deposit target chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// vesting account which made the deposits
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// deposited ibc host token amount which has not vested yet
+	LockedAmount types.Coin `protobuf:"bytes,3,opt,name=locked_amount,json=lockedAmount,proto3" json:"locked_amount"`
+	// stk token amount held for the locked amount
+	StkAmount types.Coin `protobuf:"bytes,4,opt,name=stk_amount,json=stkAmount,proto3" json:"stk_amount"`
+}
+
+func (m *VestingLock) Reset()         { *m = VestingLock{} }
+func (m *VestingLock) String() string { return proto.CompactTextString(m) }
+func (*VestingLock) ProtoMessage()    {}
+func (*VestingLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{27}
+}
+func (m *VestingLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *VestingLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingLock.Merge(m, src)
+}
+func (m *VestingLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingLock proto.InternalMessageInfo
+
+func (m *VestingLock) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if m.EpochNumber != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *VestingLock) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *VestingLock) GetLockedAmount() types.Coin {
+	if m != nil {
+		return m.LockedAmount
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *ValidatorUnbonding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VestingLock) GetStkAmount() types.Coin {
+	if m != nil {
+		return m.StkAmount
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *ValidatorUnbonding) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RelayLatency struct {
+	// ibc connection id
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// moving average of the time between sending a packet and receiving its
+	// acknowledgement or timeout
+	Average time.Duration `protobuf:"bytes,2,opt,name=average,proto3,stdduration" json:"average"`
+	// number of packets measured
+	Samples uint64 `protobuf:"varint,3,opt,name=samples,proto3" json:"samples,omitempty"`
 }
 
-func (m *ValidatorUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IbcSequenceId) > 0 {
-		i -= len(m.IbcSequenceId)
-		copy(dAtA[i:], m.IbcSequenceId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *RelayLatency) Reset()         { *m = RelayLatency{} }
+func (m *RelayLatency) String() string { return proto.CompactTextString(m) }
+func (*RelayLatency) ProtoMessage()    {}
+func (*RelayLatency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{28}
+}
+func (m *RelayLatency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayLatency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayLatency.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *RelayLatency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayLatency.Merge(m, src)
+}
+func (m *RelayLatency) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayLatency) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayLatency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayLatency proto.InternalMessageInfo
+
+func (m *RelayLatency) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
 	}
-	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.MatureTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MatureTime):])
-	if err14 != nil {
-		return 0, err14
+	return ""
+}
+
+func (m *RelayLatency) GetAverage() time.Duration {
+	if m != nil {
+		return m.Average
 	}
-	i -= n14
-	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n14))
-	i--
-	dAtA[i] = 0x1a
-	if m.EpochNumber != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *RelayLatency) GetSamples() uint64 {
+	if m != nil {
+		return m.Samples
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+type CValueRecord struct {
+	// host chain id
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// c value set
+	CValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=c_value,json=cValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"c_value"`
+	// c value before the update
+	PreviousCValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=previous_c_value,json=previousCValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"previous_c_value"`
+	// block height of the update
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// block time of the update
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// account that set the c value
+	Authority string `protobuf:"bytes,6,opt,name=authority,proto3" json:"authority,omitempty"`
+	// governance proposal that set the c value
+	ProposalId uint64 `protobuf:"varint,7,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// reason given for the update
+	Justification string `protobuf:"bytes,8,opt,name=justification,proto3" json:"justification,omitempty"`
+}
+
+func (m *CValueRecord) Reset()         { *m = CValueRecord{} }
+func (m *CValueRecord) String() string { return proto.CompactTextString(m) }
+func (*CValueRecord) ProtoMessage()    {}
+func (*CValueRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{29}
+}
+func (m *CValueRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CValueRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CValueRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CValueRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CValueRecord.Merge(m, src)
+}
+func (m *CValueRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *CValueRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CValueRecord.DiscardUnknown(m)
 }
 
-func (m *KVUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CValueRecord proto.InternalMessageInfo
+
+func (m *CValueRecord) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *KVUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CValueRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *KVUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+func (m *CValueRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	return time.Time{}
+}
+
+func (m *CValueRecord) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Redelegations) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CValueRecord) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Redelegations) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CValueRecord) GetJustification() string {
+	if m != nil {
+		return m.Justification
+	}
+	return ""
 }
 
-func (m *Redelegations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Redelegations) > 0 {
-		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+type RebateRecord struct {
+	// host chain of the validator
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// operator address of the validator
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// host tokens delegated to the validator while it qualified for the
+	// commission rebate program
+	DelegatedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=delegated_amount,json=delegatedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegated_amount"`
+}
+
+func (m *RebateRecord) Reset()         { *m = RebateRecord{} }
+func (m *RebateRecord) String() string { return proto.CompactTextString(m) }
+func (*RebateRecord) ProtoMessage()    {}
+func (*RebateRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{30}
+}
+func (m *RebateRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RebateRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RebateRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *RebateRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebateRecord.Merge(m, src)
+}
+func (m *RebateRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RebateRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebateRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebateRecord proto.InternalMessageInfo
+
+func (m *RebateRecord) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *RedelegateTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RebateRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RedelegateTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type WorkflowFailure struct {
+	// host chain the workflow failed for
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// workflow that failed
+	Workflow string `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	// epoch of the failed run, zero for the workflows not tied to an epoch
+	// number
+	Epoch int64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// block height of the failed run
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// error returned or panic recovered by the run
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *RedelegateTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.State != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.IbcSequenceId) > 0 {
-		i -= len(m.IbcSequenceId)
-		copy(dAtA[i:], m.IbcSequenceId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
-		i--
-		dAtA[i] = 0x12
+func (m *WorkflowFailure) Reset()         { *m = WorkflowFailure{} }
+func (m *WorkflowFailure) String() string { return proto.CompactTextString(m) }
+func (*WorkflowFailure) ProtoMessage()    {}
+func (*WorkflowFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{31}
+}
+func (m *WorkflowFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *WorkflowFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowFailure.Merge(m, src)
+}
+func (m *WorkflowFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowFailure proto.InternalMessageInfo
+
+func (m *WorkflowFailure) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintLiquidstakeibc(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLiquidstakeibc(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *WorkflowFailure) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *HostChain) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *WorkflowFailure) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
-	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
-	}
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
-	}
-	l = len(m.HostDenom)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
-	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
-	}
-	if m.DelegationAccount != nil {
-		l = m.DelegationAccount.Size()
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return 0
+}
+
+func (m *WorkflowFailure) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if m.RewardsAccount != nil {
-		l = m.RewardsAccount.Size()
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return 0
+}
+
+func (m *WorkflowFailure) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return ""
+}
+
+type DelegationReconciliation struct {
+	// host chain of the validator
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// operator address of the validator
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// delegation tracked for the validator when its delegation was queried
+	TrackedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=tracked_amount,json=trackedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tracked_amount"`
+	// epoch the delegation was queried on
+	Epoch int64 `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (m *DelegationReconciliation) Reset()         { *m = DelegationReconciliation{} }
+func (m *DelegationReconciliation) String() string { return proto.CompactTextString(m) }
+func (*DelegationReconciliation) ProtoMessage()    {}
+func (*DelegationReconciliation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{32}
+}
+func (m *DelegationReconciliation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationReconciliation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationReconciliation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = m.MinimumDeposit.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.CValue.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.LastCValue.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	if m.UnbondingFactor != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.UnbondingFactor))
-	}
-	if m.Active {
-		n += 2
+}
+func (m *DelegationReconciliation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationReconciliation.Merge(m, src)
+}
+func (m *DelegationReconciliation) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationReconciliation) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationReconciliation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationReconciliation proto.InternalMessageInfo
+
+func (m *DelegationReconciliation) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	l = m.AutoCompoundFactor.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	if m.Flags != nil {
-		l = m.Flags.Size()
-		n += 2 + l + sovLiquidstakeibc(uint64(l))
+	return ""
+}
+
+func (m *DelegationReconciliation) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	if m.RewardParams != nil {
-		l = m.RewardParams.Size()
-		n += 2 + l + sovLiquidstakeibc(uint64(l))
+	return ""
+}
+
+func (m *DelegationReconciliation) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return n
+	return 0
 }
 
-func (m *HostChainFlags) Size() (n int) {
-	if m == nil {
-		return 0
+type WeightTransition struct {
+	// host chain whose delegations are moving to new validator weights
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// validator weights the delegations are moving to
+	Targets []WeightTransitionTarget `protobuf:"bytes,2,rep,name=targets,proto3" json:"targets"`
+	// redelegation epoch the transition started in
+	StartEpoch int64 `protobuf:"varint,3,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// redelegation epochs left for the delegations to reach the target weights
+	RemainingEpochs int64 `protobuf:"varint,4,opt,name=remaining_epochs,json=remainingEpochs,proto3" json:"remaining_epochs,omitempty"`
+}
+
+func (m *WeightTransition) Reset()         { *m = WeightTransition{} }
+func (m *WeightTransition) String() string { return proto.CompactTextString(m) }
+func (*WeightTransition) ProtoMessage()    {}
+func (*WeightTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{33}
+}
+func (m *WeightTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightTransition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Lsm {
-		n += 2
+}
+func (m *WeightTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightTransition.Merge(m, src)
+}
+func (m *WeightTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightTransition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightTransition proto.InternalMessageInfo
+
+func (m *WeightTransition) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return n
+	return ""
 }
 
-func (m *RewardParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WeightTransition) GetTargets() []WeightTransitionTarget {
+	if m != nil {
+		return m.Targets
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return nil
+}
+
+func (m *WeightTransition) GetStartEpoch() int64 {
+	if m != nil {
+		return m.StartEpoch
 	}
-	l = len(m.Destination)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return 0
+}
+
+func (m *WeightTransition) GetRemainingEpochs() int64 {
+	if m != nil {
+		return m.RemainingEpochs
 	}
-	return n
+	return 0
 }
 
-func (m *HostChainLSParams) Size() (n int) {
-	if m == nil {
-		return 0
+type WeightTransitionTarget struct {
+	// operator address of the validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// weight the validator delegation is moving to
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *WeightTransitionTarget) Reset()         { *m = WeightTransitionTarget{} }
+func (m *WeightTransitionTarget) String() string { return proto.CompactTextString(m) }
+func (*WeightTransitionTarget) ProtoMessage()    {}
+func (*WeightTransitionTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{34}
+}
+func (m *WeightTransitionTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightTransitionTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightTransitionTarget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.DepositFee.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.RestakeFee.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.UnstakeFee.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.RedemptionFee.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.LsmValidatorCap.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.LsmBondFactor.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	if m.MaxEntries != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.MaxEntries))
+}
+func (m *WeightTransitionTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightTransitionTarget.Merge(m, src)
+}
+func (m *WeightTransitionTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightTransitionTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightTransitionTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightTransitionTarget proto.InternalMessageInfo
+
+func (m *WeightTransitionTarget) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	l = m.RedelegationAcceptableDelta.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.UpperCValueLimit.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.LowerCValueLimit.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	return n
+	return ""
 }
 
-func (m *ICAAccount) Size() (n int) {
-	if m == nil {
-		return 0
+type EpochFunding struct {
+	// host chain of the unbonding epoch
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// unbonding epoch the transfers funded
+	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// host tokens received for the epoch
+	FundedAmount types.Coin `protobuf:"bytes,3,opt,name=funded_amount,json=fundedAmount,proto3" json:"funded_amount"`
+	// unbonding transfers that funded the epoch, in the order they were received
+	Transfers []EpochFundingTransfer `protobuf:"bytes,4,rep,name=transfers,proto3" json:"transfers"`
+}
+
+func (m *EpochFunding) Reset()         { *m = EpochFunding{} }
+func (m *EpochFunding) String() string { return proto.CompactTextString(m) }
+func (*EpochFunding) ProtoMessage()    {}
+func (*EpochFunding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{35}
+}
+func (m *EpochFunding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochFunding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochFunding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+}
+func (m *EpochFunding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochFunding.Merge(m, src)
+}
+func (m *EpochFunding) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochFunding) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochFunding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochFunding proto.InternalMessageInfo
+
+func (m *EpochFunding) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	l = m.Balance.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return ""
+}
+
+func (m *EpochFunding) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
 	}
-	if m.ChannelState != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.ChannelState))
+	return 0
+}
+
+func (m *EpochFunding) GetFundedAmount() types.Coin {
+	if m != nil {
+		return m.FundedAmount
 	}
-	return n
+	return types.Coin{}
 }
 
-func (m *Validator) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EpochFunding) GetTransfers() []EpochFundingTransfer {
+	if m != nil {
+		return m.Transfers
 	}
-	var l int
-	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
-	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
-	}
-	l = m.Weight.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.DelegatedAmount.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.ExchangeRate.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	if m.UnbondingEpoch != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.UnbondingEpoch))
-	}
-	if m.Delegable {
-		n += 2
-	}
-	return n
+	return nil
 }
 
-func (m *Deposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+type EpochFundingTransfer struct {
+	// sequence id of the unbonding transfer
+	SequenceId string `protobuf:"bytes,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
+	// part of the transfer allocated to the epoch
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// block height the transfer was received at
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *EpochFundingTransfer) Reset()         { *m = EpochFundingTransfer{} }
+func (m *EpochFundingTransfer) String() string { return proto.CompactTextString(m) }
+func (*EpochFundingTransfer) ProtoMessage()    {}
+func (*EpochFundingTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{36}
+}
+func (m *EpochFundingTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochFundingTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochFundingTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	if m.Epoch != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.Epoch))
+}
+func (m *EpochFundingTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochFundingTransfer.Merge(m, src)
+}
+func (m *EpochFundingTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochFundingTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochFundingTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochFundingTransfer proto.InternalMessageInfo
+
+func (m *EpochFundingTransfer) GetSequenceId() string {
+	if m != nil {
+		return m.SequenceId
 	}
-	if m.State != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	return ""
+}
+
+func (m *EpochFundingTransfer) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	l = len(m.IbcSequenceId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return types.Coin{}
+}
+
+func (m *EpochFundingTransfer) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return n
+	return 0
 }
 
-func (m *LSMDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.ICAAccount_ChannelState", ICAAccount_ChannelState_name, ICAAccount_ChannelState_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.Deposit_DepositState", Deposit_DepositState_name, Deposit_DepositState_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.LSMDeposit_LSMDepositState", LSMDeposit_LSMDepositState_name, LSMDeposit_LSMDepositState_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.Unbonding_UnbondingState", Unbonding_UnbondingState_name, Unbonding_UnbondingState_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.RedelegateTx_RedelegateTxState", RedelegateTx_RedelegateTxState_name, RedelegateTx_RedelegateTxState_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.ICATxRetry_ICATxRetryType", ICATxRetry_ICATxRetryType_name, ICATxRetry_ICATxRetryType_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.ChannelMigration_ChannelMigrationState", ChannelMigration_ChannelMigrationState_name, ChannelMigration_ChannelMigrationState_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.PendingMint_PendingMintState", PendingMint_PendingMintState_name, PendingMint_PendingMintState_value)
+	proto.RegisterType((*HostChain)(nil), "pstake.liquidstakeibc.v1beta1.HostChain")
+	proto.RegisterType((*HostChainFlags)(nil), "pstake.liquidstakeibc.v1beta1.HostChainFlags")
+	proto.RegisterType((*RewardParams)(nil), "pstake.liquidstakeibc.v1beta1.RewardParams")
+	proto.RegisterType((*HostChainRiskParams)(nil), "pstake.liquidstakeibc.v1beta1.HostChainRiskParams")
+	proto.RegisterType((*HostChainLSParams)(nil), "pstake.liquidstakeibc.v1beta1.HostChainLSParams")
+	proto.RegisterType((*ICAAccount)(nil), "pstake.liquidstakeibc.v1beta1.ICAAccount")
+	proto.RegisterType((*Validator)(nil), "pstake.liquidstakeibc.v1beta1.Validator")
+	proto.RegisterType((*ValidatorScore)(nil), "pstake.liquidstakeibc.v1beta1.ValidatorScore")
+	proto.RegisterType((*Deposit)(nil), "pstake.liquidstakeibc.v1beta1.Deposit")
+	proto.RegisterType((*LSMDeposit)(nil), "pstake.liquidstakeibc.v1beta1.LSMDeposit")
+	proto.RegisterType((*Unbonding)(nil), "pstake.liquidstakeibc.v1beta1.Unbonding")
+	proto.RegisterType((*UserUnbonding)(nil), "pstake.liquidstakeibc.v1beta1.UserUnbonding")
+	proto.RegisterType((*ValidatorUnbonding)(nil), "pstake.liquidstakeibc.v1beta1.ValidatorUnbonding")
+	proto.RegisterType((*KVUpdate)(nil), "pstake.liquidstakeibc.v1beta1.KVUpdate")
+	proto.RegisterType((*Redelegations)(nil), "pstake.liquidstakeibc.v1beta1.Redelegations")
+	proto.RegisterType((*RedelegateTx)(nil), "pstake.liquidstakeibc.v1beta1.RedelegateTx")
+	proto.RegisterType((*DepositReceipt)(nil), "pstake.liquidstakeibc.v1beta1.DepositReceipt")
+	proto.RegisterType((*DepositShortfall)(nil), "pstake.liquidstakeibc.v1beta1.DepositShortfall")
+	proto.RegisterType((*LiquidityIncentive)(nil), "pstake.liquidstakeibc.v1beta1.LiquidityIncentive")
+	proto.RegisterType((*FeeReport)(nil), "pstake.liquidstakeibc.v1beta1.FeeReport")
+	proto.RegisterType((*ValidatorExit)(nil), "pstake.liquidstakeibc.v1beta1.ValidatorExit")
+	proto.RegisterType((*PendingParamChange)(nil), "pstake.liquidstakeibc.v1beta1.PendingParamChange")
+	proto.RegisterType((*HostChainFailures)(nil), "pstake.liquidstakeibc.v1beta1.HostChainFailures")
+	proto.RegisterType((*ValidatorDrain)(nil), "pstake.liquidstakeibc.v1beta1.ValidatorDrain")
+	proto.RegisterType((*ICATxRetry)(nil), "pstake.liquidstakeibc.v1beta1.ICATxRetry")
+	proto.RegisterType((*ChannelMigration)(nil), "pstake.liquidstakeibc.v1beta1.ChannelMigration")
+	proto.RegisterType((*PendingMint)(nil), "pstake.liquidstakeibc.v1beta1.PendingMint")
+	proto.RegisterType((*VestingLock)(nil), "pstake.liquidstakeibc.v1beta1.VestingLock")
+	proto.RegisterType((*RelayLatency)(nil), "pstake.liquidstakeibc.v1beta1.RelayLatency")
+	proto.RegisterType((*CValueRecord)(nil), "pstake.liquidstakeibc.v1beta1.CValueRecord")
+	proto.RegisterType((*RebateRecord)(nil), "pstake.liquidstakeibc.v1beta1.RebateRecord")
+	proto.RegisterType((*WorkflowFailure)(nil), "pstake.liquidstakeibc.v1beta1.WorkflowFailure")
+	proto.RegisterType((*DelegationReconciliation)(nil), "pstake.liquidstakeibc.v1beta1.DelegationReconciliation")
+	proto.RegisterType((*WeightTransition)(nil), "pstake.liquidstakeibc.v1beta1.WeightTransition")
+	proto.RegisterType((*WeightTransitionTarget)(nil), "pstake.liquidstakeibc.v1beta1.WeightTransitionTarget")
+	proto.RegisterType((*EpochFunding)(nil), "pstake.liquidstakeibc.v1beta1.EpochFunding")
+	proto.RegisterType((*EpochFundingTransfer)(nil), "pstake.liquidstakeibc.v1beta1.EpochFundingTransfer")
+}
+
+func init() {
+	proto.RegisterFile("pstake/liquidstakeibc/v1beta1/liquidstakeibc.proto", fileDescriptor_71a9a61e676043b6)
+}
+
+var fileDescriptor_71a9a61e676043b6 = []byte{
+	// 4312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7b, 0x4b, 0x6c, 0x24, 0x49,
+	0x5a, 0x7f, 0xd7, 0xc3, 0x8f, 0xfa, 0xea, 0xe1, 0x72, 0xf8, 0xd1, 0xd9, 0xdd, 0xd3, 0x8f, 0xc9,
+	0xed, 0xff, 0x4e, 0x8f, 0xe6, 0xdf, 0xf6, 0xb4, 0x77, 0xd9, 0x9d, 0x1d, 0xd8, 0xd1, 0x96, 0xab,
+	0xaa, 0xa7, 0x8b, 0xb1, 0xdd, 0x4d, 0xba, 0xba, 0xbd, 0xbb, 0x33, 0x6c, 0x12, 0x95, 0x19, 0x55,
+	0xce, 0x71, 0x3e, 0x6a, 0x32, 0xb3, 0xda, 0xb6, 0xe0, 0xc0, 0x05, 0x89, 0x03, 0x87, 0x3d, 0x20,
+	0x34, 0x12, 0x07, 0x38, 0x20, 0x0e, 0x9c, 0x90, 0x58, 0x21, 0x71, 0x01, 0x71, 0x1b, 0x89, 0xcb,
+	0x68, 0x91, 0x10, 0x42, 0x62, 0x17, 0xcd, 0x08, 0x2e, 0x80, 0x90, 0x10, 0x07, 0xb8, 0xa1, 0x78,
+	0xe5, 0xa3, 0xca, 0xed, 0xaa, 0x5a, 0xe7, 0x4a, 0x9c, 0x5c, 0x11, 0x5f, 0xc4, 0xef, 0x8b, 0x8c,
+	0xf8, 0x5e, 0xf1, 0xc5, 0x67, 0xd8, 0x19, 0x06, 0x21, 0x3e, 0x21, 0xdb, 0xb6, 0xf5, 0xc9, 0xc8,
+	0x32, 0xd9, 0x6f, 0xab, 0x67, 0x6c, 0xbf, 0x7c, 0xd4, 0x23, 0x21, 0x7e, 0x34, 0xd6, 0xbd, 0x35,
+	0xf4, 0xbd, 0xd0, 0x43, 0xb7, 0xf9, 0x9c, 0xad, 0x31, 0xa2, 0x98, 0x73, 0x73, 0x7d, 0xe0, 0x0d,
+	0x3c, 0x36, 0x72, 0x9b, 0xfe, 0xe2, 0x93, 0x6e, 0xde, 0x30, 0xbc, 0xc0, 0xf1, 0x02, 0x9d, 0x13,
+	0x78, 0x43, 0x90, 0xee, 0xf0, 0xd6, 0x76, 0x0f, 0x07, 0x24, 0xe2, 0x6c, 0x78, 0x96, 0x2b, 0xe8,
+	0x77, 0x07, 0x9e, 0x37, 0xb0, 0xc9, 0x36, 0x6b, 0xf5, 0x46, 0xfd, 0xed, 0xd0, 0x72, 0x48, 0x10,
+	0x62, 0x67, 0x28, 0x01, 0xc6, 0x07, 0x98, 0x23, 0x1f, 0x87, 0x96, 0x27, 0x01, 0x6e, 0x8c, 0xd3,
+	0xb1, 0x7b, 0x2e, 0x48, 0xf7, 0x05, 0x6f, 0xfa, 0x15, 0x96, 0x3b, 0x88, 0xd8, 0x8b, 0x36, 0x1f,
+	0xa5, 0xfe, 0x7b, 0x05, 0x4a, 0x4f, 0xbc, 0x20, 0x6c, 0x1e, 0x63, 0xcb, 0x45, 0x37, 0x60, 0xd9,
+	0xa0, 0x3f, 0x74, 0xcb, 0x54, 0x72, 0xf7, 0x72, 0x0f, 0x4a, 0xda, 0x12, 0x6b, 0x77, 0x4c, 0xf4,
+	0x15, 0xa8, 0x1a, 0x9e, 0xeb, 0x12, 0x83, 0x72, 0xa7, 0xf4, 0x3c, 0xa3, 0x57, 0xe2, 0xce, 0x8e,
+	0x89, 0x9e, 0xc0, 0xe2, 0x10, 0xfb, 0xd8, 0x09, 0x94, 0xc2, 0xbd, 0xdc, 0x83, 0xf2, 0xce, 0xdb,
+	0x5b, 0x97, 0x6e, 0xe8, 0x56, 0xc4, 0x79, 0xef, 0xf0, 0x19, 0x9b, 0xa7, 0x89, 0xf9, 0xe8, 0x36,
+	0xc0, 0xb1, 0x17, 0x84, 0xba, 0x49, 0x5c, 0xcf, 0x51, 0x8a, 0x8c, 0x57, 0x89, 0xf6, 0xb4, 0x68,
+	0x07, 0x25, 0x1b, 0xc7, 0xd8, 0x75, 0x89, 0x4d, 0x97, 0xb2, 0xc0, 0xc9, 0xa2, 0xa7, 0x63, 0xa2,
+	0xeb, 0xb0, 0x34, 0xf4, 0xfc, 0x90, 0xd2, 0x16, 0x19, 0x6d, 0x91, 0x36, 0x3b, 0x26, 0xfa, 0x2e,
+	0x20, 0x93, 0xd8, 0x64, 0xc0, 0xf6, 0x50, 0xc7, 0x86, 0xe1, 0x8d, 0xdc, 0x50, 0x59, 0x62, 0x8b,
+	0x7d, 0x73, 0xca, 0x62, 0x3b, 0xcd, 0x46, 0x83, 0x4f, 0xd0, 0x56, 0x63, 0x10, 0xd1, 0x85, 0x34,
+	0x58, 0xf1, 0xc9, 0x29, 0xf6, 0xcd, 0x20, 0x82, 0x5d, 0x9e, 0x17, 0xb6, 0x26, 0x10, 0x24, 0xe6,
+	0x13, 0x80, 0x97, 0xd8, 0xb6, 0x4c, 0x1c, 0x7a, 0x7e, 0xa0, 0x94, 0xee, 0x15, 0x1e, 0x94, 0x77,
+	0x1e, 0x4c, 0x81, 0x7b, 0x21, 0x27, 0x68, 0x89, 0xb9, 0x88, 0xc0, 0x8a, 0x63, 0xb9, 0x96, 0x33,
+	0x72, 0x74, 0x93, 0x0c, 0xbd, 0xc0, 0x0a, 0x15, 0xa0, 0x1b, 0xb3, 0xfb, 0x4b, 0x9f, 0xfd, 0xe4,
+	0xee, 0xb5, 0x7f, 0xf8, 0xc9, 0xdd, 0xaf, 0x0e, 0xac, 0xf0, 0x78, 0xd4, 0xdb, 0x32, 0x3c, 0x47,
+	0x88, 0xb0, 0xf8, 0xf3, 0x30, 0x30, 0x4f, 0xb6, 0xc3, 0xf3, 0x21, 0x09, 0xb6, 0x3a, 0x6e, 0xf8,
+	0xe3, 0x1f, 0x3d, 0x04, 0xde, 0x4f, 0x5b, 0x5a, 0x4d, 0x80, 0xb6, 0x38, 0x26, 0x7a, 0x0e, 0x4b,
+	0x86, 0xfe, 0x12, 0xdb, 0x23, 0xa2, 0x94, 0xe7, 0x86, 0x6f, 0x11, 0x23, 0x01, 0xdf, 0x22, 0x86,
+	0xb6, 0x68, 0xbc, 0xa0, 0x58, 0xe8, 0x07, 0x50, 0xb1, 0x71, 0x10, 0xea, 0x12, 0xbb, 0x92, 0x01,
+	0x36, 0x50, 0xc4, 0x26, 0xc7, 0x7f, 0x13, 0xea, 0x23, 0xb7, 0xe7, 0xb9, 0xa6, 0xe5, 0x0e, 0xf4,
+	0x3e, 0x36, 0x42, 0xcf, 0x57, 0xaa, 0xf7, 0x72, 0x0f, 0x0a, 0xda, 0x4a, 0xd4, 0xff, 0x98, 0x75,
+	0xa3, 0x4d, 0x58, 0xc4, 0x46, 0x68, 0xbd, 0x24, 0x4a, 0xed, 0x5e, 0xee, 0xc1, 0xb2, 0x26, 0x5a,
+	0xc8, 0x85, 0x75, 0x3c, 0x0a, 0x3d, 0xdd, 0xf0, 0x9c, 0xa1, 0x37, 0x72, 0x4d, 0x09, 0xb3, 0x92,
+	0xc1, 0x52, 0x11, 0x45, 0x6e, 0x0a, 0x60, 0xb1, 0x8e, 0x26, 0x2c, 0xf4, 0x6d, 0x3c, 0x08, 0x94,
+	0x3a, 0x13, 0xb2, 0x87, 0xb3, 0x2a, 0xda, 0x63, 0x3a, 0x49, 0xe3, 0x73, 0xd1, 0x33, 0xa8, 0x72,
+	0x89, 0xd3, 0x85, 0xd6, 0xae, 0x32, 0xb0, 0xb7, 0xa6, 0x80, 0x69, 0x6c, 0x8e, 0x50, 0xd8, 0x8a,
+	0x9f, 0x68, 0xa1, 0x9b, 0xb0, 0x6c, 0x92, 0x81, 0x8f, 0x4d, 0x62, 0x2a, 0x88, 0x6d, 0x50, 0xd4,
+	0x46, 0xff, 0x1f, 0x10, 0x3b, 0xc5, 0xd1, 0xd0, 0xc4, 0x21, 0xd1, 0x8f, 0x89, 0x35, 0x38, 0x0e,
+	0x95, 0x35, 0xb6, 0xcf, 0x75, 0x4a, 0x79, 0xce, 0x08, 0x4f, 0x58, 0x3f, 0x3a, 0x80, 0x7a, 0x72,
+	0x34, 0x35, 0x8c, 0xca, 0x3a, 0x5b, 0xde, 0xcd, 0x2d, 0x6e, 0xf4, 0xb6, 0xa4, 0xd1, 0xdb, 0xea,
+	0x4a, 0xab, 0xb9, 0xbb, 0x4c, 0x37, 0xfa, 0x87, 0x3f, 0xbd, 0x9b, 0xd3, 0x6a, 0x31, 0x22, 0x25,
+	0xa3, 0x47, 0xb0, 0x21, 0xc4, 0x67, 0x6c, 0x01, 0x1b, 0x6c, 0x01, 0x88, 0x8b, 0x5a, 0x6a, 0x09,
+	0x87, 0xb0, 0x36, 0x36, 0x85, 0xad, 0x62, 0x73, 0x8e, 0x55, 0xd4, 0x93, 0xb0, 0x6c, 0x1d, 0x87,
+	0x50, 0xf6, 0xad, 0xe0, 0x44, 0xee, 0xf8, 0x75, 0x06, 0xb6, 0x33, 0xeb, 0xf1, 0x69, 0x56, 0x70,
+	0x22, 0x36, 0x1e, 0xfc, 0xe8, 0x37, 0xfa, 0x3a, 0x6c, 0xc6, 0x02, 0x4c, 0x86, 0x9e, 0x71, 0xac,
+	0x7b, 0xfd, 0x7e, 0x40, 0x42, 0x45, 0x61, 0x5f, 0xb7, 0x1e, 0x51, 0xdb, 0x94, 0xf8, 0x94, 0xd1,
+	0xd0, 0xbb, 0x70, 0xe3, 0xd4, 0x0a, 0x8f, 0x4d, 0x1f, 0x9f, 0xea, 0xd8, 0x34, 0x7d, 0x12, 0x04,
+	0xba, 0x63, 0x05, 0x0e, 0x0e, 0x8d, 0x63, 0xe5, 0x06, 0x3b, 0xbd, 0xeb, 0x72, 0x40, 0x83, 0xd3,
+	0xf7, 0x05, 0x99, 0xea, 0xc1, 0x10, 0x8f, 0x02, 0x62, 0x2a, 0x37, 0xb9, 0x1e, 0xf0, 0x16, 0x52,
+	0x60, 0x29, 0x20, 0x84, 0x72, 0x52, 0x6e, 0x31, 0x82, 0x6c, 0xbe, 0x5b, 0xfc, 0xf4, 0x0f, 0xef,
+	0xe6, 0xd4, 0xbf, 0xcc, 0x43, 0x2d, 0x2d, 0x8c, 0xa8, 0x0e, 0x05, 0x3b, 0x70, 0x98, 0xbf, 0x59,
+	0xd6, 0xe8, 0x4f, 0xf4, 0x3a, 0x54, 0x4c, 0x62, 0xe3, 0x73, 0x62, 0xea, 0x8e, 0xe5, 0x86, 0xcc,
+	0xd5, 0x2c, 0x6b, 0x65, 0xd1, 0xb7, 0x6f, 0xb9, 0x21, 0x52, 0xa1, 0xca, 0xbf, 0x53, 0xda, 0x84,
+	0x02, 0x1f, 0xc3, 0x3a, 0x85, 0x5a, 0xbf, 0x01, 0x2b, 0xc2, 0xd8, 0x05, 0xba, 0x58, 0x6c, 0x91,
+	0x8d, 0xaa, 0xc9, 0xee, 0x67, 0x7c, 0xd1, 0x8f, 0x60, 0x7d, 0xe4, 0xc6, 0x26, 0x3d, 0x1a, 0xbd,
+	0xc0, 0x46, 0xaf, 0xa5, 0x68, 0x62, 0xca, 0xff, 0x03, 0x69, 0xac, 0xe5, 0xe0, 0x45, 0x36, 0x58,
+	0x28, 0x94, 0x1c, 0x76, 0x1b, 0xc0, 0x0e, 0x1c, 0x39, 0x64, 0x89, 0x0d, 0x29, 0xd9, 0x81, 0x13,
+	0x33, 0xf6, 0xc9, 0x05, 0x8c, 0x97, 0x39, 0xe3, 0x14, 0x8d, 0x4f, 0x51, 0x7f, 0x0d, 0x2a, 0x49,
+	0xfd, 0x43, 0xeb, 0xb0, 0xc0, 0x7d, 0x24, 0xf7, 0xd7, 0xbc, 0x81, 0xde, 0x85, 0xb2, 0x49, 0x82,
+	0xd0, 0x72, 0xd9, 0x5c, 0xee, 0xab, 0x77, 0x95, 0x1f, 0xff, 0xe8, 0xe1, 0xba, 0xb0, 0x2b, 0xe2,
+	0x3c, 0x0f, 0x43, 0xdf, 0x72, 0x07, 0x5a, 0x72, 0xb0, 0xfa, 0x9f, 0x05, 0x58, 0xbb, 0x40, 0xe0,
+	0xa8, 0x46, 0xc6, 0x42, 0x36, 0x24, 0xbe, 0xe5, 0xf1, 0x20, 0xa1, 0xbc, 0x73, 0x63, 0x42, 0x17,
+	0x5a, 0x22, 0x4c, 0xe1, 0xaa, 0xf0, 0x29, 0x55, 0x85, 0xd8, 0x94, 0x3e, 0x63, 0x73, 0xd1, 0x39,
+	0xdc, 0x0c, 0x6c, 0x1c, 0x1c, 0xeb, 0x7d, 0x1f, 0xf3, 0xa8, 0xc2, 0xf4, 0x46, 0x3d, 0x9b, 0xe8,
+	0x81, 0x35, 0x90, 0x4b, 0xbe, 0x9a, 0xe1, 0xbc, 0xce, 0xf0, 0x1f, 0x0b, 0xf8, 0x16, 0x43, 0x3f,
+	0xb4, 0x06, 0x2e, 0x0a, 0xe1, 0xfa, 0x04, 0xeb, 0x53, 0x97, 0x69, 0x77, 0x21, 0x03, 0xbe, 0x1b,
+	0x63, 0x7c, 0x39, 0x34, 0xda, 0x81, 0x0d, 0x11, 0x7c, 0x8d, 0x99, 0xa0, 0x22, 0x53, 0xd2, 0x35,
+	0x41, 0x4c, 0xd9, 0xa0, 0xaf, 0xc3, 0x26, 0x03, 0x9b, 0x9c, 0xb4, 0xc0, 0x35, 0x5b, 0x52, 0x53,
+	0xb3, 0xde, 0x86, 0x75, 0xba, 0x89, 0xc4, 0xd4, 0x7b, 0xb6, 0x67, 0x9c, 0x04, 0xfa, 0xa9, 0xe5,
+	0x9a, 0xde, 0x29, 0x93, 0xd1, 0x82, 0x86, 0x38, 0x6d, 0x97, 0x91, 0x8e, 0x18, 0x45, 0xfd, 0x3b,
+	0x05, 0x56, 0x27, 0xa2, 0x31, 0xf4, 0xab, 0x50, 0x16, 0xaa, 0xa2, 0xf7, 0x09, 0x51, 0x72, 0x19,
+	0xec, 0x0d, 0x08, 0xc0, 0xc7, 0x84, 0x50, 0x78, 0x9f, 0x30, 0x63, 0xc7, 0xe0, 0xb3, 0x38, 0x72,
+	0x10, 0x80, 0x02, 0x7e, 0xe4, 0xc6, 0xf0, 0x59, 0x9c, 0x2c, 0x8c, 0xdc, 0x08, 0xde, 0xa0, 0x26,
+	0xc0, 0x24, 0xce, 0x90, 0x09, 0x10, 0xe5, 0x50, 0xcc, 0x80, 0x43, 0x35, 0xc6, 0xa4, 0x4c, 0x8e,
+	0x61, 0x95, 0x1a, 0x90, 0x28, 0x94, 0xd3, 0x0d, 0x3c, 0x54, 0x16, 0x33, 0xe0, 0xb3, 0x62, 0x07,
+	0x4e, 0x14, 0x2b, 0x36, 0xf1, 0x10, 0x99, 0x40, 0xbb, 0xf4, 0x9e, 0x17, 0x07, 0x2f, 0x4b, 0x59,
+	0x7c, 0x8f, 0x1d, 0x38, 0xbb, 0x5e, 0x14, 0xb7, 0xdc, 0x85, 0xb2, 0x83, 0xcf, 0x74, 0xe2, 0x86,
+	0xbe, 0x45, 0x02, 0x66, 0xe8, 0xaa, 0x1a, 0x38, 0xf8, 0xac, 0xcd, 0x7b, 0xd0, 0x6f, 0xe6, 0xe0,
+	0x76, 0xd2, 0xee, 0xd1, 0x68, 0x9a, 0x0c, 0x43, 0x4c, 0x0d, 0x83, 0x49, 0xec, 0x10, 0x2b, 0xa5,
+	0x0c, 0x02, 0xd7, 0x5b, 0x49, 0x16, 0x8d, 0x88, 0x43, 0x8b, 0x32, 0x40, 0x27, 0xb0, 0x36, 0x1a,
+	0x0e, 0x89, 0x2f, 0x7d, 0x8b, 0x6e, 0x5b, 0xce, 0xcf, 0x14, 0x30, 0x4f, 0xee, 0x46, 0x9d, 0x01,
+	0x73, 0xff, 0xb4, 0x47, 0x51, 0x29, 0x33, 0xdb, 0x3b, 0x9d, 0x60, 0x96, 0x45, 0xf8, 0x5c, 0x67,
+	0xc0, 0x49, 0x66, 0x3b, 0xb0, 0xe1, 0x58, 0xae, 0xce, 0x63, 0x56, 0x3d, 0x71, 0xb7, 0xa8, 0xb0,
+	0x73, 0x58, 0x73, 0x2c, 0xb7, 0xc1, 0x68, 0x91, 0x64, 0x04, 0x34, 0xb2, 0xa5, 0x27, 0x16, 0x4b,
+	0xe0, 0x29, 0xb7, 0x3f, 0xd5, 0x2c, 0x22, 0x5b, 0x07, 0x9f, 0x45, 0xac, 0x8e, 0xb8, 0xed, 0xfa,
+	0xad, 0x1c, 0xdc, 0xa3, 0x8b, 0x14, 0x91, 0xa9, 0x0c, 0x40, 0xb0, 0xad, 0xc7, 0x27, 0xa6, 0xd4,
+	0xe6, 0x66, 0x3e, 0x29, 0x03, 0xb7, 0x1d, 0xcb, 0xe5, 0xae, 0xf4, 0x28, 0xe2, 0xd1, 0x8a, 0x58,
+	0xa0, 0x6f, 0x41, 0xb9, 0x4f, 0x88, 0x0c, 0x8c, 0x94, 0x95, 0x29, 0x2e, 0x14, 0xfa, 0x84, 0x88,
+	0x1e, 0xf4, 0x5d, 0xb8, 0xc5, 0x03, 0x39, 0x2b, 0x3c, 0xd7, 0x2d, 0xd7, 0x20, 0x2e, 0xdb, 0x6f,
+	0x09, 0x55, 0x9f, 0x02, 0x75, 0x23, 0x9a, 0xdc, 0x91, 0x73, 0x25, 0xf2, 0x4b, 0x50, 0x2e, 0x42,
+	0xf6, 0x71, 0x48, 0x94, 0xd5, 0xb9, 0xf7, 0x64, 0xf2, 0x40, 0x36, 0x27, 0x59, 0x6b, 0x38, 0x24,
+	0xc8, 0x87, 0x4d, 0xe9, 0x08, 0x4c, 0x62, 0x5b, 0x2f, 0x89, 0x7f, 0xae, 0x33, 0x0f, 0xaf, 0xa0,
+	0x0c, 0xb8, 0xae, 0x0b, 0xec, 0x96, 0x80, 0xd6, 0x28, 0x32, 0xfa, 0x18, 0xa8, 0x78, 0xc8, 0xfb,
+	0xaa, 0x8e, 0x1d, 0x76, 0xa9, 0x5e, 0xcb, 0xe0, 0xe4, 0xeb, 0x0e, 0x3e, 0x13, 0x57, 0xd6, 0x06,
+	0x43, 0x45, 0xbf, 0x0e, 0xb7, 0x62, 0x99, 0x0b, 0xf4, 0xd0, 0xc7, 0x6e, 0xd0, 0x27, 0xbe, 0x64,
+	0xba, 0x9e, 0x01, 0x53, 0x25, 0x12, 0xb7, 0xa0, 0x2b, 0xe0, 0x05, 0xf3, 0x13, 0x58, 0x63, 0x1f,
+	0xea, 0xd3, 0xcc, 0x0b, 0xb5, 0x3b, 0x2c, 0x88, 0x55, 0x36, 0x32, 0x60, 0xca, 0xbe, 0x94, 0xe2,
+	0x3e, 0x23, 0x3e, 0x0b, 0xfd, 0xd1, 0x47, 0x50, 0xa6, 0x5f, 0x2a, 0xc3, 0xe6, 0xcd, 0x0c, 0x8e,
+	0xaf, 0xe4, 0x58, 0xae, 0x08, 0xb9, 0x3f, 0xe2, 0xe6, 0x5d, 0xa2, 0x5f, 0xcf, 0x04, 0x1d, 0x9f,
+	0x09, 0xf4, 0x21, 0x6c, 0xf8, 0xa4, 0x47, 0x63, 0x20, 0xc6, 0xc4, 0x73, 0x1c, 0x2b, 0x08, 0xa8,
+	0x39, 0x50, 0x32, 0xe0, 0xb3, 0xc6, 0xa1, 0xf7, 0xf1, 0x59, 0x33, 0x02, 0x46, 0x36, 0x88, 0x6e,
+	0x61, 0xf5, 0xf4, 0x9e, 0xe7, 0x05, 0xa1, 0x72, 0x23, 0x03, 0x7e, 0xab, 0x1c, 0x98, 0x5b, 0xbd,
+	0x5d, 0x0a, 0x8b, 0xfa, 0x50, 0x0f, 0x0c, 0xcf, 0x8f, 0x98, 0x39, 0x96, 0xab, 0xdc, 0xcc, 0x80,
+	0x55, 0x8d, 0xa1, 0x72, 0x4e, 0xfb, 0x96, 0x3b, 0xc9, 0x07, 0x9f, 0x29, 0xb7, 0xb2, 0xe6, 0x83,
+	0xcf, 0xd0, 0x3b, 0xa0, 0x08, 0x0e, 0x4c, 0xa1, 0x2c, 0xe6, 0xcf, 0x99, 0x70, 0x07, 0xca, 0x6b,
+	0xcc, 0xe3, 0x6c, 0x72, 0x7a, 0x37, 0x22, 0x33, 0x21, 0x0d, 0x68, 0x80, 0x4e, 0x8f, 0x38, 0x1d,
+	0x08, 0x70, 0x5d, 0xbc, 0x9d, 0x81, 0x5a, 0x6c, 0x38, 0xf8, 0x4c, 0x4b, 0x46, 0x00, 0x5c, 0x11,
+	0x7d, 0xd8, 0x9c, 0xe0, 0xca, 0xad, 0xdc, 0x9d, 0x2c, 0xac, 0xdc, 0x18, 0x53, 0x6e, 0xe5, 0x84,
+	0x7b, 0x8d, 0x6f, 0x56, 0xe2, 0x33, 0xef, 0x66, 0xf0, 0x99, 0xd4, 0x7e, 0x3e, 0x97, 0xc0, 0xe2,
+	0x1b, 0x05, 0xbf, 0xc4, 0x17, 0x06, 0xc7, 0xd8, 0x27, 0xca, 0xbd, 0x8c, 0xdc, 0x79, 0xec, 0x41,
+	0x0f, 0x29, 0xae, 0xfa, 0x5f, 0x79, 0x80, 0x38, 0xc5, 0x89, 0x76, 0x60, 0x49, 0xba, 0xc1, 0xdc,
+	0x14, 0x37, 0x28, 0x07, 0x22, 0x13, 0x96, 0x7a, 0xd8, 0xc6, 0xae, 0xc1, 0xaf, 0x08, 0xf4, 0xbe,
+	0x29, 0x26, 0xd0, 0xbc, 0x7a, 0x94, 0x24, 0x69, 0x7a, 0x96, 0xbb, 0xbb, 0x4d, 0x3f, 0xe0, 0x4f,
+	0x7e, 0x7a, 0xf7, 0x8d, 0x19, 0x3e, 0x80, 0x4e, 0xd0, 0x24, 0x34, 0xbd, 0x48, 0x7b, 0xa7, 0x2e,
+	0xf1, 0xf9, 0x3d, 0x41, 0xe3, 0x0d, 0xf4, 0x21, 0x54, 0x65, 0xa2, 0x39, 0x08, 0x71, 0xc8, 0x63,
+	0xfc, 0xda, 0xce, 0x37, 0x66, 0x4e, 0xea, 0x6e, 0x35, 0xf9, 0xf4, 0x43, 0x3a, 0x5b, 0xab, 0x18,
+	0x89, 0x96, 0xfa, 0x3d, 0xa8, 0x24, 0xa9, 0x48, 0x81, 0xf5, 0x4e, 0xb3, 0xa1, 0x37, 0x9f, 0x34,
+	0x0e, 0x0e, 0xda, 0x7b, 0x7a, 0x53, 0x6b, 0x37, 0xba, 0x9d, 0x83, 0xf7, 0xeb, 0xd7, 0xd0, 0x75,
+	0x58, 0x9b, 0xa0, 0xb4, 0x5b, 0xf5, 0x1c, 0xda, 0x04, 0x94, 0x22, 0xec, 0x3d, 0x3d, 0x6c, 0xb7,
+	0xea, 0x79, 0xf5, 0x9f, 0x97, 0xa1, 0x14, 0x45, 0x56, 0xa8, 0x09, 0x75, 0x6f, 0x48, 0x7c, 0xfa,
+	0x5b, 0x9f, 0x75, 0xfb, 0x57, 0xe4, 0x0c, 0xd1, 0x4d, 0x53, 0x3e, 0x74, 0x0b, 0x46, 0x81, 0x48,
+	0xfd, 0x8b, 0x16, 0xea, 0xc2, 0xa2, 0x08, 0x09, 0xb3, 0xb8, 0x61, 0x09, 0x2c, 0x34, 0x80, 0xba,
+	0x90, 0x51, 0x62, 0x4a, 0x9d, 0x28, 0x66, 0xa0, 0x13, 0x2b, 0x11, 0xaa, 0x50, 0x08, 0x0c, 0x55,
+	0x72, 0x46, 0x8f, 0x65, 0x20, 0xe2, 0xa8, 0x85, 0x0c, 0xbe, 0xa2, 0x22, 0x21, 0x59, 0xf4, 0xf4,
+	0x06, 0xac, 0x8c, 0xa5, 0xe7, 0xc4, 0x4d, 0xbc, 0x96, 0xce, 0xcb, 0xa1, 0xd7, 0xa0, 0xc4, 0x97,
+	0xd7, 0xb3, 0x89, 0xcc, 0x16, 0x45, 0x1d, 0xaf, 0x48, 0xa0, 0x2e, 0xcf, 0x91, 0x40, 0x2d, 0x5d,
+	0x21, 0x81, 0xaa, 0x43, 0x85, 0xde, 0x0f, 0x0d, 0x3c, 0xc4, 0x86, 0x15, 0x9e, 0x67, 0xf2, 0x7e,
+	0x50, 0xb6, 0x03, 0xa7, 0x29, 0x00, 0xe9, 0x1b, 0x45, 0xec, 0xd2, 0xf9, 0x51, 0x64, 0x71, 0x0b,
+	0xaa, 0xc5, 0xa0, 0xec, 0x30, 0xde, 0x01, 0x25, 0xc1, 0x26, 0xbd, 0x97, 0x15, 0xb6, 0x97, 0x9b,
+	0x31, 0x3d, 0xb5, 0xa3, 0x9b, 0xb0, 0xf8, 0x31, 0xb6, 0x6c, 0x62, 0xb2, 0xbb, 0xcf, 0xb2, 0x26,
+	0x5a, 0xe8, 0x2d, 0x58, 0x35, 0x3c, 0x37, 0x20, 0x6e, 0x30, 0x0a, 0x22, 0xf5, 0x62, 0x37, 0x14,
+	0xad, 0x1e, 0x11, 0xa4, 0x16, 0xb1, 0x2b, 0x58, 0x10, 0xc4, 0xa9, 0x19, 0x66, 0x25, 0x08, 0x7f,
+	0x29, 0x28, 0x68, 0x6b, 0x9c, 0xc8, 0x73, 0x33, 0x4d, 0x4e, 0xa2, 0x1a, 0x16, 0x7a, 0x27, 0xc4,
+	0x95, 0x57, 0x87, 0xab, 0x6d, 0xba, 0xc0, 0xa2, 0x4f, 0x08, 0xcc, 0x5f, 0x2b, 0xab, 0x33, 0x3d,
+	0x21, 0x44, 0xd6, 0xe4, 0x90, 0x4e, 0xd2, 0xf8, 0x5c, 0xf5, 0x3f, 0x0a, 0x50, 0x4b, 0x53, 0x90,
+	0x26, 0x71, 0xb3, 0x48, 0x17, 0x71, 0x28, 0xba, 0x03, 0xa3, 0x21, 0x13, 0xe1, 0x2c, 0x92, 0x44,
+	0x02, 0x0b, 0x7d, 0x04, 0x90, 0x08, 0x22, 0x33, 0xc9, 0x0f, 0xc5, 0x78, 0xc8, 0x82, 0xc4, 0x33,
+	0xa1, 0x3e, 0xf0, 0xbd, 0xd3, 0xf0, 0x38, 0x93, 0x14, 0x51, 0x3d, 0x86, 0x7d, 0x9f, 0xa1, 0x26,
+	0x04, 0x64, 0x21, 0x43, 0x01, 0x59, 0x87, 0x85, 0xa4, 0xb1, 0xe2, 0x0d, 0xf5, 0x7f, 0xf2, 0xb0,
+	0x24, 0xdf, 0xfb, 0x2e, 0x79, 0x2f, 0xfe, 0x26, 0x2c, 0x0a, 0xab, 0x3d, 0xd5, 0x67, 0x17, 0xe9,
+	0x6a, 0x35, 0x31, 0x3c, 0xe6, 0x5a, 0x48, 0x70, 0x45, 0x1d, 0x58, 0x48, 0xfa, 0xdf, 0xaf, 0x4d,
+	0x11, 0x56, 0xb1, 0x40, 0xf9, 0x97, 0x3b, 0x5f, 0x8e, 0x80, 0xbe, 0x0a, 0x2b, 0x56, 0xcf, 0xd0,
+	0x03, 0xf2, 0xc9, 0x88, 0xb8, 0x06, 0x89, 0x1f, 0x90, 0xab, 0x56, 0xcf, 0x38, 0x14, 0xbd, 0x1d,
+	0xf6, 0x94, 0xe1, 0x13, 0x9e, 0xa6, 0xa2, 0x1b, 0x50, 0xd4, 0x64, 0x53, 0x3d, 0x85, 0x4a, 0x12,
+	0x18, 0xad, 0xc1, 0x4a, 0xab, 0xfd, 0xec, 0xe9, 0x61, 0xa7, 0xab, 0x3f, 0x6b, 0x1f, 0xb4, 0xb8,
+	0xcb, 0xae, 0x43, 0x45, 0x76, 0x1e, 0xb6, 0x0f, 0xba, 0xf5, 0x1c, 0x5a, 0x87, 0xba, 0xec, 0xd1,
+	0xda, 0xcd, 0x76, 0xe7, 0x05, 0xf5, 0xd4, 0xd4, 0x83, 0xcb, 0xde, 0x56, 0x7b, 0xaf, 0xfd, 0x3e,
+	0x77, 0xf9, 0x05, 0x84, 0xa0, 0x26, 0xfb, 0x1f, 0x37, 0x3a, 0x7b, 0xed, 0x56, 0xbd, 0xa8, 0xfe,
+	0x5e, 0x11, 0x60, 0xef, 0x70, 0x7f, 0x86, 0xed, 0xef, 0xa6, 0xb6, 0xff, 0xca, 0x12, 0x21, 0xce,
+	0xa6, 0x0b, 0x8b, 0x2c, 0x5a, 0x0c, 0xb2, 0x71, 0xf5, 0x1c, 0x2b, 0x7e, 0xc2, 0x28, 0x26, 0x9f,
+	0x30, 0x6e, 0x41, 0x89, 0x1e, 0x13, 0xa7, 0xf0, 0x03, 0x5a, 0xb6, 0x7a, 0x06, 0x7f, 0xff, 0x7f,
+	0x2b, 0xd2, 0xad, 0x44, 0x44, 0xc3, 0x9f, 0xfa, 0xeb, 0x11, 0x41, 0x9a, 0xdc, 0xa7, 0x52, 0x76,
+	0x96, 0x98, 0xec, 0x7c, 0x6b, 0x8a, 0xec, 0xc4, 0x1b, 0x9c, 0xf8, 0x39, 0x4d, 0x82, 0x96, 0x2f,
+	0x90, 0x20, 0xf5, 0x18, 0x56, 0xc6, 0x10, 0xae, 0x26, 0x2a, 0x0a, 0xac, 0xcb, 0xde, 0xe7, 0x07,
+	0xdd, 0xa7, 0x1f, 0xb4, 0x0f, 0x3a, 0xdf, 0x67, 0xc2, 0xa2, 0x7e, 0x56, 0x84, 0x52, 0x14, 0xe9,
+	0x5f, 0x26, 0x17, 0xaf, 0x43, 0x85, 0xbf, 0x9b, 0xb9, 0x23, 0xa7, 0x47, 0x7c, 0x26, 0x1d, 0x05,
+	0xf1, 0x6c, 0x76, 0xc0, 0xba, 0x50, 0x9b, 0xde, 0xe1, 0xc3, 0x91, 0x2f, 0x62, 0x86, 0xc2, 0x1c,
+	0x31, 0x03, 0xf0, 0x89, 0x94, 0x84, 0xbe, 0x03, 0xe5, 0xde, 0xc8, 0x77, 0x93, 0xb1, 0xdb, 0x0c,
+	0x56, 0x00, 0xe8, 0x1c, 0x11, 0x99, 0xb5, 0xa0, 0xca, 0xe3, 0x23, 0x89, 0xb1, 0x30, 0x1b, 0x46,
+	0x85, 0xcf, 0x12, 0x28, 0x17, 0x1c, 0xd6, 0xe2, 0x45, 0xea, 0xbe, 0x9f, 0x96, 0x92, 0x6f, 0x4e,
+	0x91, 0x92, 0x68, 0xb7, 0xe3, 0x5f, 0x49, 0x19, 0x51, 0xff, 0x3c, 0x07, 0xb5, 0x34, 0x05, 0x6d,
+	0xc0, 0xea, 0xf3, 0x83, 0xdd, 0xa7, 0xec, 0xd4, 0x13, 0xa7, 0x7f, 0x1d, 0xd6, 0xe2, 0xee, 0xce,
+	0x41, 0xa7, 0xdb, 0x89, 0x63, 0xfb, 0x98, 0xb0, 0xdf, 0xe8, 0x3e, 0xd7, 0xe8, 0x84, 0x7c, 0x1a,
+	0x87, 0xf5, 0xb7, 0x5b, 0xf5, 0x42, 0x1a, 0xa7, 0xb9, 0xd7, 0xe8, 0xec, 0x37, 0x76, 0xf7, 0xda,
+	0xf5, 0x22, 0x15, 0xa6, 0x98, 0x20, 0x6c, 0xc9, 0x42, 0x1a, 0x5d, 0x6b, 0x77, 0xb5, 0xef, 0x51,
+	0xf4, 0x45, 0xf5, 0x8f, 0xf3, 0x50, 0x7d, 0x1e, 0x10, 0x3f, 0x2b, 0x71, 0x4a, 0xdc, 0xf8, 0x0a,
+	0xb3, 0xde, 0xf8, 0xde, 0x03, 0x08, 0xc2, 0x93, 0x39, 0x45, 0xa7, 0x14, 0x84, 0x27, 0x99, 0x4a,
+	0xce, 0x6d, 0x00, 0x5e, 0xd3, 0x61, 0x63, 0xcb, 0x11, 0xef, 0xbb, 0x25, 0xda, 0xd3, 0xa4, 0x1d,
+	0xea, 0x5f, 0xe7, 0x01, 0x45, 0xa1, 0xcf, 0xff, 0x31, 0xe5, 0x6b, 0xc3, 0x6a, 0x9c, 0xb0, 0x97,
+	0xdb, 0x5f, 0x9c, 0xb2, 0xfd, 0xf5, 0x68, 0x8a, 0xe8, 0x4f, 0x38, 0xf1, 0x85, 0xf9, 0x9c, 0xf8,
+	0x8c, 0x4a, 0xa7, 0xee, 0xc0, 0xf2, 0x07, 0x2f, 0x78, 0x90, 0x4d, 0xeb, 0x00, 0x4e, 0xc8, 0xb9,
+	0xd8, 0x33, 0xfa, 0x93, 0x3a, 0x06, 0x9e, 0x47, 0xe4, 0x17, 0x4e, 0xde, 0x50, 0x4f, 0xa1, 0x9a,
+	0x4c, 0xa3, 0xd0, 0xa2, 0x93, 0x92, 0xd8, 0x71, 0x7d, 0x6c, 0xcb, 0x5b, 0xe8, 0x97, 0xa1, 0x9a,
+	0x7a, 0x45, 0x57, 0xf2, 0xac, 0x8a, 0xea, 0xbe, 0xfc, 0x10, 0x59, 0x0d, 0x17, 0xd7, 0xb6, 0xc4,
+	0x83, 0xb5, 0xf4, 0x54, 0xf5, 0x5f, 0x72, 0xf4, 0xed, 0x5d, 0xf4, 0x90, 0xee, 0xd9, 0x65, 0x47,
+	0x7d, 0xc1, 0x06, 0xe4, 0x2f, 0xb2, 0x3a, 0x87, 0xd2, 0xea, 0x14, 0x98, 0xd5, 0xf9, 0xf6, 0xd4,
+	0xd2, 0x9b, 0x98, 0x7d, 0xaa, 0x91, 0xb2, 0x3d, 0xef, 0xc1, 0xea, 0x04, 0x8d, 0x7a, 0x1e, 0xad,
+	0x2d, 0x22, 0x8c, 0x36, 0xf7, 0x33, 0xd7, 0xa8, 0x69, 0x48, 0x74, 0x36, 0x9a, 0x1f, 0x50, 0xc3,
+	0xa3, 0xfe, 0x59, 0x01, 0x6a, 0xc2, 0x6b, 0x69, 0xc4, 0x20, 0xd6, 0x30, 0x44, 0x35, 0xc8, 0x8b,
+	0x8f, 0x2c, 0x6a, 0x79, 0xcb, 0xa4, 0x02, 0x36, 0xe9, 0x80, 0xa7, 0x95, 0x19, 0x4c, 0xba, 0xe6,
+	0xe4, 0x0e, 0x16, 0x5e, 0x15, 0x40, 0x16, 0xe7, 0x93, 0xbd, 0x16, 0x54, 0x1d, 0xcb, 0x4d, 0xa4,
+	0x0d, 0x66, 0x55, 0x7e, 0x3e, 0x4b, 0x28, 0x7f, 0xa2, 0x94, 0x6d, 0x31, 0xc3, 0x52, 0xb6, 0x28,
+	0xba, 0x5d, 0x4a, 0x46, 0xb7, 0x4d, 0x00, 0xc3, 0x27, 0x3c, 0xd5, 0x21, 0xeb, 0x06, 0x67, 0x53,
+	0xfa, 0x92, 0x98, 0xd7, 0x08, 0xd5, 0xdf, 0x80, 0xba, 0x0c, 0x35, 0x8e, 0x3d, 0x3f, 0xec, 0x63,
+	0xdb, 0xbe, 0x4c, 0x42, 0xa3, 0x95, 0xe4, 0x93, 0x2b, 0x89, 0x77, 0xbd, 0x30, 0xd7, 0xae, 0xab,
+	0xbf, 0x9b, 0x03, 0xb4, 0x37, 0xf1, 0x78, 0x74, 0xd9, 0x02, 0x8c, 0x44, 0x88, 0x5a, 0xb8, 0x9c,
+	0xd5, 0xdb, 0x22, 0xab, 0xf7, 0x60, 0xc6, 0xac, 0x5e, 0x10, 0x2d, 0xeb, 0xdf, 0x0a, 0x50, 0x7a,
+	0x4c, 0x88, 0x46, 0x68, 0x01, 0xe8, 0x65, 0xab, 0x71, 0x69, 0xcd, 0x51, 0x54, 0xea, 0x10, 0xfc,
+	0x3c, 0xd6, 0x54, 0x8e, 0x4b, 0x1f, 0xe8, 0xb3, 0x6a, 0x25, 0x51, 0xfb, 0x40, 0x7d, 0x63, 0xf6,
+	0xfc, 0xe2, 0x5a, 0x08, 0xc6, 0x2f, 0x51, 0x0c, 0x41, 0x9d, 0x41, 0xf6, 0xfc, 0xe2, 0xe2, 0x08,
+	0x9a, 0xc1, 0x5f, 0x49, 0x57, 0x47, 0xd0, 0xbb, 0x69, 0xe6, 0x2c, 0x6b, 0xa9, 0x6a, 0x89, 0x40,
+	0xfd, 0x83, 0x1c, 0x54, 0x23, 0x9f, 0xdc, 0x3e, 0xbb, 0xfc, 0x8e, 0xf4, 0xd6, 0x45, 0x4e, 0x92,
+	0x5b, 0xe9, 0x49, 0x57, 0xf8, 0x3a, 0x54, 0x3e, 0x19, 0x91, 0x11, 0x31, 0xf5, 0xe4, 0xed, 0xb4,
+	0xcc, 0xfb, 0x78, 0xf6, 0xee, 0x2b, 0x34, 0x93, 0x48, 0x8c, 0x51, 0x48, 0xc4, 0x18, 0x5e, 0xd7,
+	0x53, 0x11, 0x9d, 0x6c, 0x90, 0xfa, 0x47, 0x39, 0x40, 0xcf, 0x08, 0xaf, 0x83, 0xa2, 0x45, 0x36,
+	0x4d, 0x96, 0x26, 0xbc, 0x6c, 0x99, 0xc2, 0x2f, 0xe6, 0x2f, 0xf0, 0x8b, 0x85, 0x84, 0x5f, 0x44,
+	0x1f, 0x40, 0x8d, 0xf4, 0xfb, 0x84, 0xbf, 0xed, 0xb3, 0xe8, 0xa1, 0x38, 0x87, 0x21, 0xa9, 0x46,
+	0x73, 0x29, 0x55, 0xfd, 0xd3, 0x5c, 0xa2, 0x1e, 0xe8, 0x31, 0xb6, 0xec, 0x11, 0xbd, 0xa9, 0x5d,
+	0xb2, 0xca, 0x47, 0xb0, 0xce, 0x72, 0x5d, 0xc6, 0x88, 0xf1, 0xef, 0x8b, 0x29, 0x6c, 0xd9, 0x45,
+	0x6d, 0x2d, 0x41, 0x8b, 0xd0, 0x68, 0x71, 0x1c, 0xcd, 0x50, 0x12, 0xdf, 0xf7, 0x64, 0xda, 0xbd,
+	0x44, 0x7b, 0xda, 0xb4, 0x03, 0x6d, 0xc1, 0x1a, 0x23, 0x0b, 0xa8, 0x74, 0xb1, 0xd4, 0x2a, 0x25,
+	0x09, 0x24, 0x9e, 0x9e, 0x53, 0xff, 0x36, 0x99, 0x8a, 0x62, 0x8f, 0x9e, 0x99, 0x1d, 0xbe, 0x01,
+	0x35, 0xcb, 0xb5, 0x42, 0x0b, 0xdb, 0x7a, 0xc2, 0x3a, 0x5e, 0xf5, 0x56, 0x5d, 0x15, 0x98, 0xc2,
+	0xe3, 0x0c, 0xa0, 0xee, 0x13, 0x07, 0x5b, 0x6e, 0xe2, 0x15, 0x28, 0x93, 0x8c, 0x77, 0x84, 0x1a,
+	0xbd, 0x37, 0xa3, 0x28, 0xb0, 0x49, 0x7b, 0xc9, 0xab, 0xb2, 0x5a, 0x4d, 0xe0, 0x0a, 0x66, 0x77,
+	0xa1, 0x1c, 0x84, 0xd8, 0x0f, 0x53, 0x79, 0x6f, 0x60, 0x5d, 0x5c, 0x6b, 0x22, 0x29, 0x48, 0xb8,
+	0x45, 0x2e, 0x05, 0x4c, 0x5f, 0x3e, 0x2d, 0xb0, 0xf7, 0xa3, 0xee, 0x99, 0x46, 0x42, 0xff, 0x7c,
+	0x22, 0x0e, 0x49, 0x9e, 0x70, 0x3e, 0x7d, 0xc2, 0x7b, 0x50, 0xa4, 0xeb, 0x14, 0x91, 0xd5, 0x3b,
+	0xd3, 0x5f, 0x6c, 0x04, 0x8f, 0xc4, 0xcf, 0xee, 0xf9, 0x90, 0x68, 0x0c, 0x25, 0x76, 0x97, 0xc5,
+	0xa4, 0xbb, 0x7c, 0x1b, 0x96, 0x1d, 0x12, 0x04, 0x78, 0x10, 0x99, 0xb7, 0xf5, 0x09, 0x6d, 0x6b,
+	0xb8, 0xe7, 0x5a, 0x34, 0x8a, 0x56, 0x48, 0xe3, 0x30, 0xa4, 0x36, 0x4b, 0xa6, 0x95, 0xa2, 0x36,
+	0x95, 0x78, 0x97, 0x9c, 0x85, 0xba, 0xe8, 0x90, 0x12, 0xcf, 0xf7, 0x64, 0x95, 0x92, 0x1a, 0x9c,
+	0x22, 0x12, 0xd2, 0x69, 0x05, 0x5a, 0x1e, 0x53, 0x20, 0xf5, 0x07, 0x50, 0x4b, 0x7f, 0x0a, 0xbd,
+	0xf3, 0xb1, 0x9b, 0x9e, 0xfe, 0xfc, 0x40, 0xe6, 0x9a, 0x9e, 0x1e, 0xd4, 0xaf, 0xa1, 0xd7, 0x40,
+	0xe1, 0xfd, 0x5a, 0xfb, 0xa8, 0xa1, 0xb5, 0x0e, 0xf5, 0xa3, 0x4e, 0xf7, 0x49, 0x4b, 0x6b, 0x1c,
+	0x35, 0xf6, 0xf8, 0x3d, 0x54, 0x52, 0x13, 0xb3, 0xf2, 0xea, 0xdf, 0x14, 0xa0, 0x2e, 0xde, 0xaf,
+	0xf6, 0xad, 0x01, 0x2f, 0xf8, 0xbc, 0x4c, 0xe5, 0xee, 0x43, 0xcd, 0xb3, 0x4d, 0x3d, 0xf1, 0x8f,
+	0x1b, 0xe2, 0x7f, 0x48, 0x3c, 0xdb, 0x6c, 0x46, 0xff, 0xbb, 0x71, 0x1f, 0x6a, 0x2e, 0x39, 0x4d,
+	0x8e, 0xe2, 0x96, 0xa1, 0xe2, 0x92, 0xd3, 0x78, 0x94, 0x0a, 0x55, 0x8a, 0x15, 0x67, 0x88, 0x78,
+	0xee, 0xa8, 0xec, 0xd9, 0x66, 0x47, 0x26, 0x89, 0x54, 0xa8, 0x52, 0xa4, 0xf1, 0x2c, 0x52, 0xd9,
+	0x25, 0xa7, 0xd1, 0x98, 0xa9, 0xe2, 0xf9, 0x06, 0x7b, 0x95, 0x18, 0xda, 0x24, 0x8c, 0x4c, 0x3f,
+	0x3f, 0x8f, 0x5a, 0xd4, 0xcd, 0x07, 0x7e, 0x28, 0x23, 0xf9, 0x65, 0x26, 0x6f, 0xed, 0x29, 0xf2,
+	0x36, 0xbe, 0x71, 0x13, 0x1d, 0xa9, 0x88, 0x1e, 0xc3, 0xc6, 0x85, 0x74, 0x7a, 0x36, 0xfb, 0x9d,
+	0xf7, 0x35, 0x76, 0x24, 0x7a, 0x4b, 0x6b, 0x74, 0x0e, 0xa2, 0xa4, 0x42, 0xdc, 0xdf, 0x7c, 0xba,
+	0xff, 0x6c, 0xaf, 0xcd, 0x93, 0x0a, 0x69, 0x42, 0xe3, 0xa0, 0xd9, 0xde, 0xdb, 0x63, 0x2f, 0x86,
+	0xff, 0x5d, 0x80, 0xb2, 0x70, 0x4c, 0xac, 0xc2, 0x7a, 0xee, 0xd0, 0xf1, 0xc2, 0x2b, 0x41, 0x61,
+	0xee, 0x2b, 0xc1, 0x63, 0xa8, 0x8d, 0x95, 0xfc, 0xcc, 0x18, 0xff, 0x57, 0xcd, 0x54, 0x49, 0xcf,
+	0x77, 0x58, 0xa1, 0x4b, 0x38, 0xe7, 0x25, 0x00, 0xe8, 0x1c, 0x81, 0xf0, 0x1e, 0x00, 0xab, 0x00,
+	0xe3, 0x00, 0x8b, 0x33, 0x66, 0x21, 0x68, 0x1d, 0x18, 0x9f, 0xff, 0x2b, 0xe9, 0x8c, 0xd2, 0x2f,
+	0x4e, 0x91, 0x88, 0xc4, 0xe6, 0x27, 0x7f, 0xa7, 0xe4, 0xa0, 0x0b, 0xf5, 0x71, 0x12, 0xba, 0x0f,
+	0xf7, 0x44, 0x32, 0x49, 0xdf, 0xef, 0x1c, 0x74, 0xf5, 0xc6, 0x51, 0xa3, 0x43, 0x73, 0xc8, 0x7a,
+	0x4a, 0xc5, 0x6f, 0xc2, 0x66, 0x6a, 0x54, 0x9c, 0x20, 0xca, 0xa9, 0x5f, 0xe4, 0xa0, 0xfc, 0x82,
+	0x55, 0x80, 0x0f, 0xf6, 0x3c, 0xe3, 0xe4, 0xb2, 0xa3, 0x4f, 0x64, 0x73, 0xf2, 0xb3, 0x66, 0x73,
+	0x5a, 0x50, 0xa5, 0xcf, 0x59, 0xb1, 0xab, 0x99, 0xf1, 0x6a, 0x51, 0xe1, 0xb3, 0xe2, 0xd3, 0xb8,
+	0x4a, 0x4e, 0x48, 0xfd, 0x1d, 0x76, 0x7b, 0xb7, 0xf1, 0xf9, 0x1e, 0x0e, 0x89, 0x6b, 0x9c, 0x4f,
+	0xfe, 0x47, 0x5b, 0xee, 0x82, 0xff, 0x68, 0xfb, 0x36, 0x2c, 0xe1, 0x97, 0xc4, 0xc7, 0x83, 0xb8,
+	0xf6, 0x60, 0x86, 0x5a, 0x77, 0x39, 0x87, 0xfd, 0x3b, 0x04, 0xa6, 0x66, 0x82, 0x6b, 0x42, 0x51,
+	0x93, 0x4d, 0xf5, 0x2f, 0x0a, 0x50, 0xe1, 0x65, 0x4d, 0x1a, 0x31, 0x3c, 0xdf, 0xbc, 0x6c, 0xd3,
+	0x13, 0x77, 0xd1, 0x7c, 0x86, 0x77, 0xd1, 0x3e, 0xd4, 0x87, 0x3e, 0x79, 0x69, 0x79, 0xa3, 0x20,
+	0xf5, 0x6f, 0x14, 0x57, 0x7e, 0x71, 0x95, 0xa8, 0xfc, 0xfb, 0xe8, 0xbb, 0x69, 0x2a, 0x76, 0x13,
+	0x2d, 0xf4, 0x0e, 0x14, 0x59, 0x98, 0xba, 0x30, 0x47, 0x98, 0xca, 0x66, 0xa0, 0x6f, 0x00, 0xcd,
+	0xc3, 0x1d, 0x7b, 0x3e, 0x7d, 0x88, 0x5e, 0x9c, 0x22, 0x87, 0xf1, 0x50, 0x6a, 0xed, 0x87, 0xbe,
+	0x37, 0xf4, 0x02, 0xcc, 0x1c, 0xcb, 0x12, 0x3b, 0x12, 0x90, 0x5d, 0xcc, 0xf9, 0x54, 0x3f, 0x1e,
+	0x05, 0xa1, 0xd5, 0xb7, 0x0c, 0x5e, 0x68, 0x2a, 0xf2, 0xfa, 0xa9, 0x4e, 0xf5, 0xaf, 0x98, 0x28,
+	0xf5, 0x70, 0x38, 0xc3, 0xd9, 0xcd, 0x15, 0x67, 0x5e, 0x54, 0xf4, 0x50, 0xf8, 0x39, 0x14, 0x3d,
+	0x50, 0x65, 0x58, 0x39, 0xf2, 0xfc, 0x93, 0xbe, 0xed, 0x9d, 0x8a, 0x28, 0xfa, 0xb2, 0x8f, 0xb8,
+	0x09, 0xcb, 0xa7, 0x62, 0xb4, 0x58, 0x7b, 0xd4, 0x7e, 0xc5, 0x7b, 0xdd, 0xab, 0xce, 0x9c, 0x8e,
+	0x66, 0xd1, 0x0a, 0xf7, 0xc5, 0xbc, 0xa1, 0xfe, 0x63, 0x0e, 0x94, 0xb8, 0x70, 0x88, 0x6e, 0xaa,
+	0x6b, 0x58, 0xb6, 0x35, 0x35, 0xa2, 0x98, 0x37, 0x88, 0x0f, 0x7d, 0x6c, 0x9c, 0x64, 0xbb, 0xb5,
+	0x55, 0x81, 0xd9, 0x18, 0x7b, 0xbd, 0x4c, 0x86, 0x89, 0xea, 0xe7, 0x39, 0xa8, 0x1f, 0x8d, 0x55,
+	0xba, 0x4d, 0x51, 0xf8, 0x10, 0xfb, 0x03, 0x12, 0xca, 0x3c, 0xc4, 0x2f, 0x4c, 0xf1, 0x1d, 0xe3,
+	0xe0, 0x5d, 0x36, 0x5b, 0x18, 0x41, 0x89, 0x35, 0x1e, 0xec, 0x14, 0x26, 0x82, 0x9d, 0x37, 0x93,
+	0x57, 0x10, 0x51, 0xa8, 0xc7, 0x3f, 0x24, 0xbe, 0x44, 0xb0, 0x91, 0x81, 0xfa, 0xfb, 0x39, 0xd8,
+	0xbc, 0x98, 0xeb, 0xc5, 0xa7, 0x92, 0x7b, 0xc5, 0xa9, 0xc4, 0xd5, 0x43, 0xf9, 0xec, 0xaa, 0x87,
+	0xd4, 0x7f, 0xcd, 0x41, 0x85, 0x2d, 0xf4, 0xf1, 0x28, 0x8b, 0xac, 0x7c, 0x0b, 0xaa, 0x7d, 0xfa,
+	0x5f, 0x60, 0x73, 0x7b, 0x30, 0x3e, 0x4b, 0xc8, 0xc6, 0x11, 0x94, 0x64, 0x61, 0xb1, 0xcc, 0xbf,
+	0x4c, 0x7b, 0xc7, 0x4e, 0x7e, 0x83, 0xac, 0x1a, 0x96, 0xae, 0x2d, 0xc2, 0x52, 0x7f, 0x3b, 0x07,
+	0xeb, 0x17, 0x8d, 0x64, 0x07, 0x9e, 0xc8, 0x40, 0xf3, 0x0f, 0x87, 0x20, 0x4e, 0x3f, 0xff, 0xcc,
+	0xaf, 0xf4, 0xb1, 0x7e, 0x17, 0x92, 0xfa, 0xbd, 0xfb, 0xe1, 0x67, 0x5f, 0xdc, 0xc9, 0x7d, 0xfe,
+	0xc5, 0x9d, 0xdc, 0x3f, 0x7d, 0x71, 0x27, 0xf7, 0xc3, 0x2f, 0xef, 0x5c, 0xfb, 0xfc, 0xcb, 0x3b,
+	0xd7, 0xfe, 0xfe, 0xcb, 0x3b, 0xd7, 0xbe, 0xdf, 0x48, 0x1c, 0xe8, 0x90, 0xf8, 0x81, 0x15, 0x50,
+	0x37, 0x4c, 0x9e, 0xba, 0x64, 0x9b, 0xef, 0xc1, 0x43, 0x17, 0xd3, 0xf4, 0xc0, 0xf6, 0xcb, 0x9d,
+	0xed, 0xb3, 0xf1, 0xff, 0xda, 0x67, 0xe7, 0xdd, 0x5b, 0x64, 0xae, 0xe1, 0x6b, 0xff, 0x3b, 0x00,
+	0x7c, 0x68, 0x08, 0xbd, 0xdb, 0x3f, 0x00, 0x00,
+}
+
+func (m *HostChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *HostChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.Seeding {
+		i--
+		if m.Seeding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.Shares.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
 	}
-	l = len(m.IbcDenom)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.WithdrawAddressMismatch {
+		i--
+		if m.WithdrawAddressMismatch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
 	}
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.UnbondingEpochOffset != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.UnbondingEpochOffset))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
 	}
-	if m.State != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	if m.RiskParams != nil {
+		{
+			size, err := m.RiskParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
 	}
-	l = len(m.IbcSequenceId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CValueUpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CValueUpdateTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	return n
-}
-
-func (m *Unbonding) Size() (n int) {
-	if m == nil {
-		return 0
+	i -= n2
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	if m.CValueUpdateHeight != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.CValueUpdateHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastUpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastUpdateTime):])
+	if err3 != nil {
+		return 0, err3
 	}
-	if m.EpochNumber != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.EpochNumber))
+	i -= n3
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if m.LastUpdateHeight != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.LastUpdateHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MatureTime)
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.BurnAmount.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.UnbondAmount.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = len(m.IbcSequenceId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.Degraded {
+		i--
+		if m.Degraded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.State != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	if m.RewardParams != nil {
+		{
+			size, err := m.RewardParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	return n
-}
-
-func (m *UserUnbonding) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Flags != nil {
+		{
+			size, err := m.Flags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	{
+		size := m.AutoCompoundFactor.Size()
+		i -= size
+		if _, err := m.AutoCompoundFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
 	}
-	if m.EpochNumber != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.EpochNumber))
+	i--
+	dAtA[i] = 0x7a
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.UnbondingFactor != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.UnbondingFactor))
+		i--
+		dAtA[i] = 0x68
 	}
-	l = m.StkAmount.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.UnbondAmount.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	return n
-}
-
-func (m *ValidatorUnbonding) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.LastCValue.Size()
+		i -= size
+		if _, err := m.LastCValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.CValue.Size()
+		i -= size
+		if _, err := m.CValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
 	}
-	if m.EpochNumber != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.EpochNumber))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MinimumDeposit.Size()
+		i -= size
+		if _, err := m.MinimumDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MatureTime)
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	i--
+	dAtA[i] = 0x52
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = len(m.IbcSequenceId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.RewardsAccount != nil {
+		{
+			size, err := m.RewardsAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
-}
-
-func (m *KVUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.DelegationAccount != nil {
+		{
+			size, err := m.DelegationAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
+	if len(m.HostDenom) > 0 {
+		i -= len(m.HostDenom)
+		copy(dAtA[i:], m.HostDenom)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.HostDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Redelegations) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HostChainFlags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *HostChainFlags) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostChainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.RedelegationsPaused {
+		i--
+		if m.RedelegationsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	if len(m.Redelegations) > 0 {
-		for _, e := range m.Redelegations {
-			l = e.Size()
-			n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.LsmPaused {
+		i--
+		if m.LsmPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RewardsPaused {
+		i--
+		if m.RewardsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UndelegationsPaused {
+		i--
+		if m.UndelegationsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DepositsPaused {
+		i--
+		if m.DepositsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EpochCValue {
+		i--
+		if m.EpochCValue {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DelayedMint {
+		i--
+		if m.DelayedMint {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Lsm {
+		i--
+		if m.Lsm {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostChainRiskParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostChainRiskParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostChainRiskParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SlashingUpdateHeight != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.SlashingUpdateHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StakingUpdateHeight != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.StakingUpdateHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.SlashFractionDowntime.Size()
+		i -= size
+		if _, err := m.SlashFractionDowntime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SlashFractionDoubleSign.Size()
+		i -= size
+		if _, err := m.SlashFractionDoubleSign.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HostChainLSParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostChainLSParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostChainLSParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxDelegationShare.Size()
+		i -= size
+		if _, err := m.MaxDelegationShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.MaxUnbondingAmount.Size()
+		i -= size
+		if _, err := m.MaxUnbondingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xfa
+	{
+		size := m.MaxRedelegationRatio.Size()
+		i -= size
+		if _, err := m.MaxRedelegationRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xf2
+	{
+		size := m.MaxRedelegationAmount.Size()
+		i -= size
+		if _, err := m.MaxRedelegationAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	if m.WeightTransitionEpochs != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.WeightTransitionEpochs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	{
+		size := m.ScoreWeightMax.Size()
+		i -= size
+		if _, err := m.ScoreWeightMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	{
+		size := m.ScoreWeightMin.Size()
+		i -= size
+		if _, err := m.ScoreWeightMin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	{
+		size := m.RebateWeightBoost.Size()
+		i -= size
+		if _, err := m.RebateWeightBoost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	{
+		size := m.RebateMaxCommission.Size()
+		i -= size
+		if _, err := m.RebateMaxCommission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	{
+		size := m.MaxCValue.Size()
+		i -= size
+		if _, err := m.MaxCValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	{
+		size := m.MinCValue.Size()
+		i -= size
+		if _, err := m.MinCValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	{
+		size := m.MaxDrainPerEpoch.Size()
+		i -= size
+		if _, err := m.MaxDrainPerEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	{
+		size := m.MinRewardsTransferAmount.Size()
+		i -= size
+		if _, err := m.MinRewardsTransferAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	{
+		size := m.MaxDepositAmount.Size()
+		i -= size
+		if _, err := m.MaxDepositAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size := m.DepositDeliveryRatio.Size()
+		i -= size
+		if _, err := m.DepositDeliveryRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.LiquidityIncentiveRate.Size()
+		i -= size
+		if _, err := m.LiquidityIncentiveRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if len(m.LiquidityIncentiveAddress) > 0 {
+		i -= len(m.LiquidityIncentiveAddress)
+		copy(dAtA[i:], m.LiquidityIncentiveAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.LiquidityIncentiveAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.FeeAddress) > 0 {
+		i -= len(m.FeeAddress)
+		copy(dAtA[i:], m.FeeAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.FeeAddress)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	{
+		size := m.MinRewardWithdrawalDelegation.Size()
+		i -= size
+		if _, err := m.MinRewardWithdrawalDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.MaxValidatorWeight.Size()
+		i -= size
+		if _, err := m.MaxValidatorWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.MinActiveValidators != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.MinActiveValidators))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.LowerCValueLimit.Size()
+		i -= size
+		if _, err := m.LowerCValueLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.UpperCValueLimit.Size()
+		i -= size
+		if _, err := m.UpperCValueLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.RedelegationAcceptableDelta.Size()
+		i -= size
+		if _, err := m.RedelegationAcceptableDelta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.MaxEntries != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.MaxEntries))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.LsmBondFactor.Size()
+		i -= size
+		if _, err := m.LsmBondFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.LsmValidatorCap.Size()
+		i -= size
+		if _, err := m.LsmValidatorCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.RedemptionFee.Size()
+		i -= size
+		if _, err := m.RedemptionFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.UnstakeFee.Size()
+		i -= size
+		if _, err := m.UnstakeFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RestakeFee.Size()
+		i -= size
+		if _, err := m.RestakeFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.DepositFee.Size()
+		i -= size
+		if _, err := m.DepositFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ICAAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ICAAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ICAAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChannelState != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.ChannelState))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Validator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Score != nil {
+		{
+			size, err := m.Score.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.CommissionUpdateHeight != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.CommissionUpdateHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.LsmCapacity.Size()
+		i -= size
+		if _, err := m.LsmCapacity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastUpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastUpdateTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x4a
+	if m.LastUpdateHeight != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.LastUpdateHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Delegable {
+		i--
+		if m.Delegable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.UnbondingEpoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.UnbondingEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.DelegatedAmount.Size()
+		i -= size
+		if _, err := m.DelegatedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorScore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorScore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.DelegationGrowth.Size()
+		i -= size
+		if _, err := m.DelegationGrowth.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Commission.Size()
+		i -= size
+		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Uptime.Size()
+		i -= size
+		if _, err := m.Uptime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Deposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Retries != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.IbcSequenceId) > 0 {
+		i -= len(m.IbcSequenceId)
+		copy(dAtA[i:], m.IbcSequenceId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.State != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LSMDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LSMDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LSMDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IbcSequenceId) > 0 {
+		i -= len(m.IbcSequenceId)
+		copy(dAtA[i:], m.IbcSequenceId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.State != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.IbcDenom) > 0 {
+		i -= len(m.IbcDenom)
+		copy(dAtA[i:], m.IbcDenom)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Unbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Unbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Unbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.IbcSequenceId) > 0 {
+		i -= len(m.IbcSequenceId)
+		copy(dAtA[i:], m.IbcSequenceId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.UnbondAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.BurnAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.MatureTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MatureTime):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochNumber != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoClaim {
+		i--
+		if m.AutoClaim {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.UnbondAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.StkAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IbcSequenceId) > 0 {
+		i -= len(m.IbcSequenceId)
+		copy(dAtA[i:], m.IbcSequenceId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n20, err20 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.MatureTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MatureTime):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochNumber != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KVUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KVUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KVUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Redelegations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Redelegations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Redelegations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegateTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegateTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegateTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.IbcSequenceId) > 0 {
+		i -= len(m.IbcSequenceId)
+		copy(dAtA[i:], m.IbcSequenceId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n21, err21 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x42
+	if m.Epoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.CValue.Size()
+		i -= size
+		if _, err := m.CValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.MintedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositShortfall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositShortfall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositShortfall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Epoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityIncentive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityIncentive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityIncentive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RedemptionFees) > 0 {
+		for iNdEx := len(m.RedemptionFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedemptionFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.UnstakeFees) > 0 {
+		for iNdEx := len(m.UnstakeFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnstakeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RestakeFees) > 0 {
+		for iNdEx := len(m.RestakeFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RestakeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DepositFees) > 0 {
+		for iNdEx := len(m.DepositFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorExit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorExit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorExit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecuteEpoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.ExecuteEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.QueuedEpoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.QueuedEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingParamChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingParamChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingParamChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n25, err25 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime):])
+	if err25 != nil {
+		return 0, err25
+	}
+	i -= n25
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n25))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostChainFailures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostChainFailures) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostChainFailures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastFailureHeight != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.LastFailureHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ConsecutiveFailures != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.ConsecutiveFailures))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorDrain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorDrain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorDrain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastEpoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.LastEpoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.RedelegatedAmount.Size()
+		i -= size
+		if _, err := m.RedelegatedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RemainingAmount.Size()
+		i -= size
+		if _, err := m.RemainingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InitialAmount.Size()
+		i -= size
+		if _, err := m.InitialAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ICATxRetry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ICATxRetry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ICATxRetry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.NextAttemptHeight != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.NextAttemptHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Type != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CompletedEpoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.CompletedEpoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.NewIbcDenom) > 0 {
+		i -= len(m.NewIbcDenom)
+		copy(dAtA[i:], m.NewIbcDenom)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.NewIbcDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OldIbcDenom) > 0 {
+		i -= len(m.OldIbcDenom)
+		copy(dAtA[i:], m.OldIbcDenom)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.OldIbcDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NewChannelId) > 0 {
+		i -= len(m.NewChannelId)
+		copy(dAtA[i:], m.NewChannelId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.NewChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldChannelId) > 0 {
+		i -= len(m.OldChannelId)
+		copy(dAtA[i:], m.OldChannelId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.OldChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.FeeAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.MintAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.DepositAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StkAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.LockedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayLatency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayLatency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayLatency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Samples != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Samples))
+		i--
+		dAtA[i] = 0x18
+	}
+	n31, err31 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Average, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Average):])
+	if err31 != nil {
+		return 0, err31
+	}
+	i -= n31
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n31))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CValueRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CValueRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CValueRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Justification) > 0 {
+		i -= len(m.Justification)
+		copy(dAtA[i:], m.Justification)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Justification)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n32, err32 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err32 != nil {
+		return 0, err32
+	}
+	i -= n32
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n32))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.PreviousCValue.Size()
+		i -= size
+		if _, err := m.PreviousCValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CValue.Size()
+		i -= size
+		if _, err := m.CValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RebateRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RebateRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RebateRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DelegatedAmount.Size()
+		i -= size
+		if _, err := m.DelegatedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Height != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Workflow) > 0 {
+		i -= len(m.Workflow)
+		copy(dAtA[i:], m.Workflow)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Workflow)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationReconciliation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationReconciliation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationReconciliation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.TrackedAmount.Size()
+		i -= size
+		if _, err := m.TrackedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightTransition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingEpochs != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.RemainingEpochs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Targets) > 0 {
+		for iNdEx := len(m.Targets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Targets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightTransitionTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightTransitionTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightTransitionTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochFunding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochFunding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochFunding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.FundedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochNumber != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochFundingTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochFundingTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochFundingTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.SequenceId) > 0 {
+		i -= len(m.SequenceId)
+		copy(dAtA[i:], m.SequenceId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.SequenceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiquidstakeibc(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiquidstakeibc(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HostChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.HostDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.DelegationAccount != nil {
+		l = m.DelegationAccount.Size()
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.RewardsAccount != nil {
+		l = m.RewardsAccount.Size()
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovLiquidstakeibc(uint64(l))
+		}
+	}
+	l = m.MinimumDeposit.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.CValue.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.LastCValue.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.UnbondingFactor != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.UnbondingFactor))
+	}
+	if m.Active {
+		n += 2
+	}
+	l = m.AutoCompoundFactor.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.Flags != nil {
+		l = m.Flags.Size()
+		n += 2 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.RewardParams != nil {
+		l = m.RewardParams.Size()
+		n += 2 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.Degraded {
+		n += 3
+	}
+	if m.LastUpdateHeight != 0 {
+		n += 2 + sovLiquidstakeibc(uint64(m.LastUpdateHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastUpdateTime)
+	n += 2 + l + sovLiquidstakeibc(uint64(l))
+	if m.CValueUpdateHeight != 0 {
+		n += 2 + sovLiquidstakeibc(uint64(m.CValueUpdateHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CValueUpdateTime)
+	n += 2 + l + sovLiquidstakeibc(uint64(l))
+	if m.RiskParams != nil {
+		l = m.RiskParams.Size()
+		n += 2 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.UnbondingEpochOffset != 0 {
+		n += 2 + sovLiquidstakeibc(uint64(m.UnbondingEpochOffset))
+	}
+	if m.WithdrawAddressMismatch {
+		n += 3
+	}
+	if m.Paused {
+		n += 3
+	}
+	if m.Seeding {
+		n += 3
+	}
+	return n
+}
+
+func (m *HostChainFlags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lsm {
+		n += 2
+	}
+	if m.DelayedMint {
+		n += 2
+	}
+	if m.EpochCValue {
+		n += 2
+	}
+	if m.DepositsPaused {
+		n += 2
+	}
+	if m.UndelegationsPaused {
+		n += 2
+	}
+	if m.RewardsPaused {
+		n += 2
+	}
+	if m.LsmPaused {
+		n += 2
+	}
+	if m.RedelegationsPaused {
+		n += 2
+	}
+	return n
+}
+
+func (m *RewardParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	return n
+}
+
+func (m *HostChainRiskParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod)
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.SlashFractionDoubleSign.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.SlashFractionDowntime.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.StakingUpdateHeight != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.StakingUpdateHeight))
+	}
+	if m.SlashingUpdateHeight != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.SlashingUpdateHeight))
+	}
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.SignedBlocksWindow))
+	}
+	return n
+}
+
+func (m *HostChainLSParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DepositFee.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.RestakeFee.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.UnstakeFee.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.RedemptionFee.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.LsmValidatorCap.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.LsmBondFactor.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.MaxEntries != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.MaxEntries))
+	}
+	l = m.RedelegationAcceptableDelta.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.UpperCValueLimit.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.LowerCValueLimit.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.MinActiveValidators != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.MinActiveValidators))
+	}
+	l = m.MaxValidatorWeight.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.MinRewardWithdrawalDelegation.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = len(m.FeeAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.LiquidityIncentiveAddress)
+	if l > 0 {
+		n += 2 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.LiquidityIncentiveRate.Size()
+	n += 2 + l + sovLiquidstakeibc(uint64(l))
+	l = m.DepositDeliveryRatio.Size()
+	n += 2 + l + sovLiquidstakeibc(uint64(l))
+	l = m.MaxDepositAmount.Size()
+	n += 2 + l + sovLiquidstakeibc(uint64(l))
+	l = m.MinRewardsTransferAmount.Size()
+	n += 2 + l + sovLiquidstakeibc(uint64(l))
+	l = m.MaxDrainPerEpoch.Size()
+	n += 2 + l + sovLiquidstakeibc(uint64(l))
+	l = m.MinCValue.Size()
+	n += 2 + l + sovLiquidstakeibc(uint64(l))
+	l = m.MaxCValue.Size()
+	n += 2 + l + sovLiquidstakeibc(uint64(l))
+	l = m.RebateMaxCommission.Size()
+	n += 2 + l + sovLiquidstakeibc(uint64(l))
+	l = m.RebateWeightBoost.Size()
+	n += 2 + l + sovLiquidstakeibc(uint64(l))
+	l = m.ScoreWeightMin.Size()
+	n += 2 + l + sovLiquidstakeibc(uint64(l))
+	l = m.ScoreWeightMax.Size()
+	n += 2 + l + sovLiquidstakeibc(uint64(l))
+	if m.WeightTransitionEpochs != 0 {
+		n += 2 + sovLiquidstakeibc(uint64(m.WeightTransitionEpochs))
+	}
+	l = m.MaxRedelegationAmount.Size()
+	n += 2 + l + sovLiquidstakeibc(uint64(l))
+	l = m.MaxRedelegationRatio.Size()
+	n += 2 + l + sovLiquidstakeibc(uint64(l))
+	l = m.MaxUnbondingAmount.Size()
+	n += 2 + l + sovLiquidstakeibc(uint64(l))
+	l = m.MaxDelegationShare.Size()
+	n += 2 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *ICAAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.ChannelState != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.ChannelState))
+	}
+	return n
+}
+
+func (m *Validator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.DelegatedAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.UnbondingEpoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.UnbondingEpoch))
+	}
+	if m.Delegable {
+		n += 2
+	}
+	if m.LastUpdateHeight != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.LastUpdateHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastUpdateTime)
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.LsmCapacity.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.CommissionUpdateHeight != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.CommissionUpdateHeight))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.MissedBlocksCounter))
+	}
+	l = m.Tokens.Size()
+	n += 2 + l + sovLiquidstakeibc(uint64(l))
+	if m.Score != nil {
+		l = m.Score.Size()
+		n += 2 + l + sovLiquidstakeibc(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Score.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.Uptime.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.DelegationGrowth.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.Tokens.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.Epoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *Deposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.Epoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Epoch))
+	}
+	if m.State != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	}
+	l = len(m.IbcSequenceId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.Retries != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Retries))
+	}
+	return n
+}
+
+func (m *LSMDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.Shares.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.IbcDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	}
+	l = len(m.IbcSequenceId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	return n
+}
+
+func (m *Unbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.EpochNumber))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MatureTime)
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.BurnAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.UnbondAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = len(m.IbcSequenceId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	}
+	return n
+}
+
+func (m *UserUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.EpochNumber))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.StkAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.UnbondAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.AutoClaim {
+		n += 2
+	}
+	return n
+}
+
+func (m *ValidatorUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.EpochNumber))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MatureTime)
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = len(m.IbcSequenceId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	return n
+}
+
+func (m *KVUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	return n
+}
+
+func (m *Redelegations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 1 + l + sovLiquidstakeibc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RedelegateTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.IbcSequenceId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	}
+	return n
+}
+
+func (m *DepositReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Id))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.MintedAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.CValue.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.Epoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Epoch))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *DepositShortfall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Epoch))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *LiquidityIncentive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovLiquidstakeibc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if len(m.DepositFees) > 0 {
+		for _, e := range m.DepositFees {
+			l = e.Size()
+			n += 1 + l + sovLiquidstakeibc(uint64(l))
+		}
+	}
+	if len(m.RestakeFees) > 0 {
+		for _, e := range m.RestakeFees {
+			l = e.Size()
+			n += 1 + l + sovLiquidstakeibc(uint64(l))
+		}
+	}
+	if len(m.UnstakeFees) > 0 {
+		for _, e := range m.UnstakeFees {
+			l = e.Size()
+			n += 1 + l + sovLiquidstakeibc(uint64(l))
+		}
+	}
+	if len(m.RedemptionFees) > 0 {
+		for _, e := range m.RedemptionFees {
+			l = e.Size()
+			n += 1 + l + sovLiquidstakeibc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorExit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.QueuedEpoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.QueuedEpoch))
+	}
+	if m.ExecuteEpoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.ExecuteEpoch))
+	}
+	return n
+}
+
+func (m *PendingParamChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *HostChainFailures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.ConsecutiveFailures != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.ConsecutiveFailures))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.LastFailureHeight != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.LastFailureHeight))
+	}
+	return n
+}
+
+func (m *ValidatorDrain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.InitialAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.RemainingAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.RedelegatedAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.StartEpoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.StartEpoch))
+	}
+	if m.LastEpoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.LastEpoch))
+	}
+	return n
+}
+
+func (m *ICATxRetry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Id))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Type))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Epoch))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovLiquidstakeibc(uint64(l))
+		}
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Attempts))
+	}
+	if m.NextAttemptHeight != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.NextAttemptHeight))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	return n
+}
+
+func (m *ChannelMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.OldChannelId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.NewChannelId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.OldIbcDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.NewIbcDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.StartEpoch))
+	}
+	if m.CompletedEpoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.CompletedEpoch))
+	}
+	if m.State != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	}
+	return n
+}
+
+func (m *PendingMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Epoch))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.DepositAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.MintAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.FeeAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.State != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	}
+	return n
+}
+
+func (m *VestingLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.LockedAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.StkAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *RelayLatency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Average)
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.Samples != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Samples))
+	}
+	return n
+}
+
+func (m *CValueRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.CValue.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.PreviousCValue.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.ProposalId))
+	}
+	l = len(m.Justification)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	return n
+}
+
+func (m *RebateRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.DelegatedAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *WorkflowFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.Workflow)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Epoch))
+	}
+	if m.Height != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Height))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegationReconciliation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.TrackedAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.Epoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *WeightTransition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if len(m.Targets) > 0 {
+		for _, e := range m.Targets {
+			l = e.Size()
+			n += 1 + l + sovLiquidstakeibc(uint64(l))
+		}
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.StartEpoch))
+	}
+	if m.RemainingEpochs != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.RemainingEpochs))
+	}
+	return n
+}
+
+func (m *WeightTransitionTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *EpochFunding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.EpochNumber))
+	}
+	l = m.FundedAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovLiquidstakeibc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochFundingTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SequenceId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Height))
+	}
+	return n
+}
+
+func sovLiquidstakeibc(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLiquidstakeibc(x uint64) (n int) {
+	return sovLiquidstakeibc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HostChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &HostChainLSParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DelegationAccount == nil {
+				m.DelegationAccount = &ICAAccount{}
+			}
+			if err := m.DelegationAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RewardsAccount == nil {
+				m.RewardsAccount = &ICAAccount{}
+			}
+			if err := m.RewardsAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinimumDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastCValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingFactor", wireType)
+			}
+			m.UnbondingFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingFactor |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutoCompoundFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flags == nil {
+				m.Flags = &HostChainFlags{}
+			}
+			if err := m.Flags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RewardParams == nil {
+				m.RewardParams = &RewardParams{}
+			}
+			if err := m.RewardParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Degraded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Degraded = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateHeight", wireType)
+			}
+			m.LastUpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdateHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CValueUpdateHeight", wireType)
+			}
+			m.CValueUpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CValueUpdateHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CValueUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CValueUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RiskParams == nil {
+				m.RiskParams = &HostChainRiskParams{}
+			}
+			if err := m.RiskParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEpochOffset", wireType)
+			}
+			m.UnbondingEpochOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingEpochOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddressMismatch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithdrawAddressMismatch = bool(v != 0)
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seeding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Seeding = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostChainFlags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostChainFlags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostChainFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lsm", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lsm = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedMint", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DelayedMint = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochCValue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EpochCValue = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DepositsPaused = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UndelegationsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UndelegationsPaused = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RewardsPaused = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LsmPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LsmPaused = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RedelegationsPaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostChainRiskParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostChainRiskParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostChainRiskParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingUpdateHeight", wireType)
+			}
+			m.StakingUpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingUpdateHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingUpdateHeight", wireType)
+			}
+			m.SlashingUpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashingUpdateHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostChainLSParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostChainLSParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakeFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RestakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnstakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LsmValidatorCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LsmValidatorCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LsmBondFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LsmBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			}
+			m.MaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationAcceptableDelta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedelegationAcceptableDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperCValueLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpperCValueLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerCValueLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LowerCValueLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinActiveValidators", wireType)
+			}
+			m.MinActiveValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinActiveValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValidatorWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRewardWithdrawalDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinRewardWithdrawalDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityIncentiveAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityIncentiveAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityIncentiveRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityIncentiveRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositDeliveryRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositDeliveryRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxDepositAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRewardsTransferAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinRewardsTransferAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDrainPerEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxDrainPerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebateMaxCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RebateMaxCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebateWeightBoost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RebateWeightBoost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreWeightMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScoreWeightMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreWeightMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScoreWeightMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightTransitionEpochs", wireType)
+			}
+			m.WeightTransitionEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeightTransitionEpochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedelegationAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRedelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedelegationRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRedelegationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnbondingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxUnbondingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegationShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxDelegationShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ICAAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ICAAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ICAAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelState", wireType)
+			}
+			m.ChannelState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelState |= ICAAccount_ChannelState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEpoch", wireType)
+			}
+			m.UnbondingEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delegable = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateHeight", wireType)
+			}
+			m.LastUpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdateHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LsmCapacity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LsmCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionUpdateHeight", wireType)
+			}
+			m.CommissionUpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommissionUpdateHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Score == nil {
+				m.Score = &ValidatorScore{}
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorScore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorScore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorScore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Uptime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationGrowth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegationGrowth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= Deposit_DepositState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LSMDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LSMDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LSMDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= LSMDeposit_LSMDepositState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Unbonding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Unbonding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Unbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatureTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.MatureTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnbondAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= Unbonding_UnbondingState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserUnbonding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserUnbonding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StkAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StkAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnbondAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoClaim", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoClaim = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorUnbonding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorUnbonding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatureTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.MatureTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KVUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KVUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KVUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Redelegations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Redelegations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Redelegations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redelegations = append(m.Redelegations, &types1.Redelegation{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegateTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegateTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegateTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= RedelegateTx_RedelegateTxState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *RedelegateTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
-	}
-	l = len(m.IbcSequenceId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
-	}
-	if m.State != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovLiquidstakeibc(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozLiquidstakeibc(x uint64) (n int) {
-	return sovLiquidstakeibc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *HostChain) Unmarshal(dAtA []byte) error {
+func (m *DepositShortfall) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2821,10 +12994,10 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostChain: wiretype end group for non-group")
+			return fmt.Errorf("proto: DepositShortfall: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostChain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DepositShortfall: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2860,10 +13033,10 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var stringLen uint64
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -2873,27 +13046,14 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Epoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2920,16 +13080,63 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &HostChainLSParams{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityIncentive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityIncentive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityIncentive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2954,14 +13161,98 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2989,13 +13280,13 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositFees", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3005,27 +13296,29 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.DepositFees = append(m.DepositFees, types.Coin{})
+			if err := m.DepositFees[len(m.DepositFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakeFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3052,16 +13345,14 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DelegationAccount == nil {
-				m.DelegationAccount = &ICAAccount{}
-			}
-			if err := m.DelegationAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RestakeFees = append(m.RestakeFees, types.Coin{})
+			if err := m.RestakeFees[len(m.RestakeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3088,16 +13379,14 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RewardsAccount == nil {
-				m.RewardsAccount = &ICAAccount{}
-			}
-			if err := m.RewardsAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnstakeFees = append(m.UnstakeFees, types.Coin{})
+			if err := m.UnstakeFees[len(m.UnstakeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3124,14 +13413,64 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RedemptionFees = append(m.RedemptionFees, types.Coin{})
+			if err := m.RedemptionFees[len(m.RedemptionFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorExit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorExit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorExit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3159,13 +13498,11 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinimumDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3193,15 +13530,13 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCValue", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedEpoch", wireType)
 			}
-			var stringLen uint64
+			m.QueuedEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3211,31 +13546,16 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.QueuedEpoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LastCValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteEpoch", wireType)
 			}
-			m.UnbondingFactor = 0
+			m.ExecuteEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3245,16 +13565,66 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingFactor |= int64(b&0x7F) << shift
+				m.ExecuteEpoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingParamChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingParamChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingParamChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3264,15 +13634,27 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Active = bool(v != 0)
-		case 15:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3300,15 +13682,13 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AutoCompoundFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3318,31 +13698,27 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flags == nil {
-				m.Flags = &HostChainFlags{}
-			}
-			if err := m.Flags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3369,10 +13745,7 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RewardParams == nil {
-				m.RewardParams = &RewardParams{}
-			}
-			if err := m.RewardParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3397,7 +13770,7 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostChainFlags) Unmarshal(dAtA []byte) error {
+func (m *HostChainFailures) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3420,17 +13793,49 @@ func (m *HostChainFlags) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostChainFlags: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostChainFailures: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostChainFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostChainFailures: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lsm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
 			}
-			var v int
+			m.ConsecutiveFailures = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3440,12 +13845,62 @@ func (m *HostChainFlags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ConsecutiveFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailureHeight", wireType)
+			}
+			m.LastFailureHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFailureHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Lsm = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
@@ -3467,7 +13922,7 @@ func (m *HostChainFlags) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardParams) Unmarshal(dAtA []byte) error {
+func (m *ValidatorDrain) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3490,15 +13945,15 @@ func (m *RewardParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorDrain: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorDrain: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3526,11 +13981,11 @@ func (m *RewardParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3558,8 +14013,148 @@ func (m *RewardParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Destination = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegatedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedelegatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpoch", wireType)
+			}
+			m.LastEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
@@ -3581,7 +14176,7 @@ func (m *RewardParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
+func (m *ICATxRetry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3604,17 +14199,17 @@ func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostChainLSParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: ICATxRetry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostChainLSParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ICATxRetry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositFee", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3624,29 +14219,14 @@ func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DepositFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestakeFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3674,15 +14254,13 @@ func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RestakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeFee", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var stringLen uint64
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3692,31 +14270,35 @@ func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Type |= ICATxRetry_ICATxRetryType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			if err := m.UnstakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3726,31 +14308,31 @@ func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RedemptionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Messages = append(m.Messages, &types2.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LsmValidatorCap", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
 			}
-			var stringLen uint64
+			m.Attempts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3760,29 +14342,33 @@ func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Attempts |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAttemptHeight", wireType)
 			}
-			if err := m.LsmValidatorCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NextAttemptHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextAttemptHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LsmBondFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3810,15 +14396,63 @@ func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LsmBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
 			}
-			m.MaxEntries = 0
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3828,14 +14462,27 @@ func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxEntries |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationAcceptableDelta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3863,13 +14510,11 @@ func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RedelegationAcceptableDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OldChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpperCValueLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3897,13 +14542,11 @@ func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UpperCValueLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowerCValueLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldIbcDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3931,63 +14574,11 @@ func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LowerCValueLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OldIbcDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ICAAccount) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLiquidstakeibc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ICAAccount: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ICAAccount: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewIbcDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4015,13 +14606,13 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.NewIbcDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
 			}
-			var msglen int
+			m.StartEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -4031,30 +14622,16 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StartEpoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				}
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedEpoch", wireType)
 			}
-			var stringLen uint64
+			m.CompletedEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -4064,29 +14641,16 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CompletedEpoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.ChannelState = 0
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -4096,7 +14660,7 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChannelState |= ICAAccount_ChannelState(b&0x7F) << shift
+				m.State |= ChannelMigration_ChannelMigrationState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4122,7 +14686,7 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *PendingMint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4145,15 +14709,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingMint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingMint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4181,13 +14745,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var stringLen uint64
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -4197,27 +14761,14 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Epoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4245,15 +14796,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -4263,31 +14812,30 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DepositAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -4297,31 +14845,30 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MintAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEpoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAmount", wireType)
 			}
-			m.UnbondingEpoch = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -4331,16 +14878,30 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingEpoch |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var v int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -4350,12 +14911,11 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.State |= PendingMint_PendingMintState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Delegable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
@@ -4377,7 +14937,7 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Deposit) Unmarshal(dAtA []byte) error {
+func (m *VestingLock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4400,10 +14960,10 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: VestingLock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VestingLock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4440,7 +15000,39 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4467,15 +15059,15 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LockedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StkAmount", wireType)
 			}
-			m.Epoch = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -4485,16 +15077,112 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StkAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayLatency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayLatency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayLatency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Average", wireType)
 			}
-			m.State = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -4504,16 +15192,30 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= Deposit_DepositState(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
 			}
-			var stringLen uint64
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Average, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			m.Samples = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -4523,24 +15225,11 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Samples |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
@@ -4562,7 +15251,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LSMDeposit) Unmarshal(dAtA []byte) error {
+func (m *CValueRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4585,10 +15274,10 @@ func (m *LSMDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LSMDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: CValueRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LSMDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CValueRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4625,7 +15314,7 @@ func (m *LSMDeposit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4653,13 +15342,13 @@ func (m *LSMDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousCValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4687,15 +15376,15 @@ func (m *LSMDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PreviousCValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -4705,29 +15394,16 @@ func (m *LSMDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -4737,27 +15413,28 @@ func (m *LSMDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4785,13 +15462,13 @@ func (m *LSMDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			m.State = 0
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -4801,14 +15478,14 @@ func (m *LSMDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= LSMDeposit_LSMDepositState(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Justification", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4836,7 +15513,7 @@ func (m *LSMDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
+			m.Justification = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4859,7 +15536,7 @@ func (m *LSMDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Unbonding) Unmarshal(dAtA []byte) error {
+func (m *RebateRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4882,10 +15559,10 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Unbonding: wiretype end group for non-group")
+			return fmt.Errorf("proto: RebateRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Unbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RebateRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4921,10 +15598,10 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.EpochNumber = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -4934,16 +15611,29 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MatureTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -4953,30 +15643,81 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.MatureTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DelegatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -4986,30 +15727,29 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -5019,30 +15759,29 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnbondAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Workflow = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var stringLen uint64
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -5052,29 +15791,35 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Epoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			m.State = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -5084,11 +15829,24 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= Unbonding_UnbondingState(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
@@ -5110,7 +15868,7 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserUnbonding) Unmarshal(dAtA []byte) error {
+func (m *DelegationReconciliation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5133,10 +15891,10 @@ func (m *UserUnbonding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserUnbonding: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationReconciliation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationReconciliation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5172,27 +15930,8 @@ func (m *UserUnbonding) Unmarshal(dAtA []byte) error {
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
-			}
-			m.EpochNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidstakeibc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochNumber |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5220,13 +15959,13 @@ func (m *UserUnbonding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StkAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackedAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -5236,30 +15975,31 @@ func (m *UserUnbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StkAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TrackedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondAmount", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var msglen int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -5269,25 +16009,11 @@ func (m *UserUnbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Epoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.UnbondAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
@@ -5309,7 +16035,7 @@ func (m *UserUnbonding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorUnbonding) Unmarshal(dAtA []byte) error {
+func (m *WeightTransition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5332,10 +16058,10 @@ func (m *ValidatorUnbonding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorUnbonding: wiretype end group for non-group")
+			return fmt.Errorf("proto: WeightTransition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WeightTransition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5371,27 +16097,8 @@ func (m *ValidatorUnbonding) Unmarshal(dAtA []byte) error {
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
-			}
-			m.EpochNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidstakeibc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochNumber |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MatureTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5418,47 +16125,16 @@ func (m *ValidatorUnbonding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.MatureTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Targets = append(m.Targets, WeightTransitionTarget{})
+			if err := m.Targets[len(m.Targets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidstakeibc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
 			}
-			var msglen int
+			m.StartEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -5468,30 +16144,16 @@ func (m *ValidatorUnbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StartEpoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingEpochs", wireType)
 			}
-			var stringLen uint64
+			m.RemainingEpochs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -5501,24 +16163,11 @@ func (m *ValidatorUnbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RemainingEpochs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
@@ -5540,7 +16189,7 @@ func (m *ValidatorUnbonding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KVUpdate) Unmarshal(dAtA []byte) error {
+func (m *WeightTransitionTarget) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5563,15 +16212,15 @@ func (m *KVUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KVUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: WeightTransitionTarget: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KVUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WeightTransitionTarget: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5599,11 +16248,11 @@ func (m *KVUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5631,7 +16280,9 @@ func (m *KVUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5654,7 +16305,7 @@ func (m *KVUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Redelegations) Unmarshal(dAtA []byte) error {
+func (m *EpochFunding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5677,15 +16328,15 @@ func (m *Redelegations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Redelegations: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochFunding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Redelegations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochFunding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5713,11 +16364,30 @@ func (m *Redelegations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundedAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5744,8 +16414,41 @@ func (m *Redelegations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Redelegations = append(m.Redelegations, &types1.Redelegation{})
-			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FundedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transfers = append(m.Transfers, EpochFundingTransfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5770,7 +16473,7 @@ func (m *Redelegations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegateTx) Unmarshal(dAtA []byte) error {
+func (m *EpochFundingTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5793,15 +16496,15 @@ func (m *RedelegateTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegateTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochFundingTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegateTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochFundingTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5829,13 +16532,13 @@ func (m *RedelegateTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.SequenceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -5845,29 +16548,30 @@ func (m *RedelegateTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.State = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -5877,7 +16581,7 @@ func (m *RedelegateTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= RedelegateTx_RedelegateTxState(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
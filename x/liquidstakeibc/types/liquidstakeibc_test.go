@@ -736,6 +736,16 @@ func TestHostChainLSParams_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "nil redelegation delta",
+			fields: fields{
+				DepositFee:    sdk.ZeroDec(),
+				RestakeFee:    sdk.ZeroDec(),
+				UnstakeFee:    sdk.ZeroDec(),
+				RedemptionFee: sdk.ZeroDec(),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -753,6 +763,159 @@ func TestHostChainLSParams_Validate(t *testing.T) {
 	}
 }
 
+func TestHostChainLSParams_ValidateLiquidityIncentive(t *testing.T) {
+	incentiveAddress := authtypes.NewModuleAddress("incentive").String()
+	tests := []struct {
+		name    string
+		address string
+		rate    sdk.Dec
+		wantErr bool
+	}{
+		{name: "unset", address: "", rate: sdk.Dec{}, wantErr: false},
+		{name: "disabled", address: "", rate: sdk.ZeroDec(), wantErr: false},
+		{name: "valid", address: incentiveAddress, rate: sdk.MustNewDecFromStr("0.2"), wantErr: false},
+		{name: "invalid address", address: "invalid", rate: sdk.ZeroDec(), wantErr: true},
+		{name: "negative rate", address: incentiveAddress, rate: sdk.MustNewDecFromStr("-0.1"), wantErr: true},
+		{name: "rate above one", address: incentiveAddress, rate: sdk.MustNewDecFromStr("1.1"), wantErr: true},
+		{name: "rate without address", address: "", rate: sdk.MustNewDecFromStr("0.2"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := &types.HostChainLSParams{
+				LiquidityIncentiveAddress: tt.address,
+				LiquidityIncentiveRate:    tt.rate,
+			}
+			if err := params.ValidateLiquidityIncentive(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateLiquidityIncentive() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHostChainLSParams_ValidateCValueBounds(t *testing.T) {
+	tests := []struct {
+		name     string
+		min, max sdk.Dec
+		wantErr  bool
+	}{
+		{name: "unset", min: sdk.Dec{}, max: sdk.Dec{}, wantErr: false},
+		{name: "disabled", min: sdk.ZeroDec(), max: sdk.ZeroDec(), wantErr: false},
+		{name: "only min", min: sdk.MustNewDecFromStr("0.9"), max: sdk.ZeroDec(), wantErr: false},
+		{name: "only max", min: sdk.ZeroDec(), max: sdk.MustNewDecFromStr("1.1"), wantErr: false},
+		{name: "valid", min: sdk.MustNewDecFromStr("0.9"), max: sdk.MustNewDecFromStr("1.1"), wantErr: false},
+		{name: "negative min", min: sdk.MustNewDecFromStr("-0.9"), max: sdk.ZeroDec(), wantErr: true},
+		{name: "min above max", min: sdk.MustNewDecFromStr("1.1"), max: sdk.MustNewDecFromStr("0.9"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := &types.HostChainLSParams{MinCValue: tt.min, MaxCValue: tt.max}
+			if err := params.ValidateCValueBounds(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCValueBounds() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHostChain_ValidateState(t *testing.T) {
+	validHostChain := func() *types.HostChain {
+		return &types.HostChain{
+			ChainId: "chain-1",
+			Params: &types.HostChainLSParams{
+				DepositFee:                  sdk.ZeroDec(),
+				RestakeFee:                  sdk.ZeroDec(),
+				UnstakeFee:                  sdk.ZeroDec(),
+				RedemptionFee:               sdk.ZeroDec(),
+				RedelegationAcceptableDelta: sdk.ZeroInt(),
+			},
+			Validators: []*types.Validator{
+				{OperatorAddress: "val1", Weight: sdk.MustNewDecFromStr("0.6")},
+				{OperatorAddress: "val2", Weight: sdk.MustNewDecFromStr("0.4")},
+			},
+			DelegationAccount: &types.ICAAccount{
+				Owner:        "chain-1.delegate",
+				Address:      "cosmos1mykw6u6dq4z7qhw9aztpk5yp8j8y5n0c6usg9faqepw83y2u4nzq2qxaxc",
+				ChannelState: types.ICAAccount_ICA_CHANNEL_CREATED,
+			},
+			RewardsAccount: &types.ICAAccount{
+				Owner:        "chain-1.rewards",
+				ChannelState: types.ICAAccount_ICA_CHANNEL_CREATING,
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		update  func(hc *types.HostChain)
+		wantErr bool
+	}{
+		{name: "valid", update: func(hc *types.HostChain) {}, wantErr: false},
+		{name: "no weights", update: func(hc *types.HostChain) {
+			hc.Validators[0].Weight = sdk.ZeroDec()
+			hc.Validators[1].Weight = sdk.Dec{}
+		}, wantErr: false},
+		{name: "no params", update: func(hc *types.HostChain) { hc.Params = nil }, wantErr: true},
+		{name: "fee out of bounds", update: func(hc *types.HostChain) { hc.Params.RestakeFee = sdk.OneDec() }, wantErr: true},
+		{name: "weights below one", update: func(hc *types.HostChain) {
+			hc.Validators[1].Weight = sdk.MustNewDecFromStr("0.3")
+		}, wantErr: true},
+		{name: "negative weight", update: func(hc *types.HostChain) {
+			hc.Validators[0].Weight = sdk.MustNewDecFromStr("1.4")
+			hc.Validators[1].Weight = sdk.MustNewDecFromStr("-0.4")
+		}, wantErr: true},
+		{name: "created account without address", update: func(hc *types.HostChain) {
+			hc.RewardsAccount.ChannelState = types.ICAAccount_ICA_CHANNEL_CREATED
+		}, wantErr: true},
+		{name: "unknown channel state", update: func(hc *types.HostChain) {
+			hc.RewardsAccount.ChannelState = 10
+		}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hc := validHostChain()
+			tt.update(hc)
+			if err := hc.ValidateState(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateState() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHostChainLSParams_GetLiquidityIncentiveShare(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stk/uatom", 1005), sdk.NewInt64Coin("uatom", 3))
+
+	params := &types.HostChainLSParams{}
+	require.True(t, params.GetLiquidityIncentiveShare(fee).IsZero())
+
+	params.LiquidityIncentiveRate = sdk.MustNewDecFromStr("0.1")
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stk/uatom", 100)), params.GetLiquidityIncentiveShare(fee))
+
+	params.LiquidityIncentiveRate = sdk.OneDec()
+	require.Equal(t, fee, params.GetLiquidityIncentiveShare(fee))
+}
+
+func TestHostChainLSParams_GetExpectedDeliveredAmount(t *testing.T) {
+	tests := []struct {
+		name   string
+		ratio  sdk.Dec
+		taxed  bool
+		amount math.Int
+		want   math.Int
+	}{
+		{name: "unset", ratio: sdk.Dec{}, taxed: false, amount: sdk.NewInt(1000), want: sdk.NewInt(1000)},
+		{name: "disabled", ratio: sdk.ZeroDec(), taxed: false, amount: sdk.NewInt(1000), want: sdk.NewInt(1000)},
+		{name: "untaxed", ratio: sdk.OneDec(), taxed: false, amount: sdk.NewInt(1000), want: sdk.NewInt(1000)},
+		{name: "taxed", ratio: sdk.MustNewDecFromStr("0.98"), taxed: true, amount: sdk.NewInt(1000), want: sdk.NewInt(980)},
+		{name: "truncated", ratio: sdk.MustNewDecFromStr("0.98"), taxed: true, amount: sdk.NewInt(999), want: sdk.NewInt(979)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := &types.HostChainLSParams{DepositDeliveryRatio: tt.ratio}
+			require.Equal(t, tt.taxed, params.IsDepositTaxed())
+			require.Equal(t, tt.want, params.GetExpectedDeliveredAmount(tt.amount))
+		})
+	}
+}
+
 func TestValidator_Validate(t *testing.T) {
 	type fields struct {
 		OperatorAddress string
@@ -994,3 +1157,35 @@ func TestRewardParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestICATxRetry_Backoff(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempts uint64
+		want     int64
+	}{
+		{"first attempt", 1, types.ICATxRetryBackoffBlocks},
+		{"second attempt", 2, types.ICATxRetryBackoffBlocks * 2},
+		{"third attempt", 3, types.ICATxRetryBackoffBlocks * 4},
+		{"capped", 64, types.ICATxRetryMaxBackoffBlocks},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			retry := &types.ICATxRetry{Attempts: tt.attempts}
+			require.Equal(t, tt.want, retry.Backoff())
+		})
+	}
+}
+
+func TestCorrelationID(t *testing.T) {
+	require.Equal(t, "deposit/chain-1/5", types.CorrelationID(types.WorkflowDeposit, "chain-1", 5))
+	require.NotEqual(
+		t,
+		types.CorrelationID(types.WorkflowDeposit, "chain-1", 5),
+		types.CorrelationID(types.WorkflowUndelegation, "chain-1", 5),
+	)
+
+	attribute := types.NewCorrelationAttribute(types.WorkflowRewards, "chain-1", 7)
+	require.Equal(t, types.AttributeCorrelationID, attribute.Key)
+	require.Equal(t, "rewards/chain-1/7", attribute.Value)
+}
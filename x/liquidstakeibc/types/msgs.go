@@ -14,16 +14,31 @@ import (
 	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
 const (
-	MsgTypeRegisterHostChain string = "msg_register_host_chain"
-	MsgTypeUpdateHostChain   string = "msg_update_host_chain"
-	MsgTypeLiquidStake       string = "msg_liquid_stake"
-	MsgTypeLiquidStakeLSM    string = "msg_liquid_stake_lsm"
-	MsgTypeLiquidUnstake     string = "msg_liquid_unstake"
-	MsgTypeRedeem            string = "msg_redeem"
-	MsgTypeUpdateParams      string = "msg_update_params"
+	MsgTypeRegisterHostChain       string = "msg_register_host_chain"
+	MsgTypeUpdateHostChain         string = "msg_update_host_chain"
+	MsgTypeLiquidStake             string = "msg_liquid_stake"
+	MsgTypeLiquidStakeLSM          string = "msg_liquid_stake_lsm"
+	MsgTypeLiquidUnstake           string = "msg_liquid_unstake"
+	MsgTypeRedeem                  string = "msg_redeem"
+	MsgTypeUpdateParams            string = "msg_update_params"
+	MsgTypeTransferUnbonding       string = "msg_transfer_unbonding"
+	MsgTypeCancelValidatorExit     string = "msg_cancel_validator_exit"
+	MsgTypeMigrateHostChainChannel string = "msg_migrate_host_chain_channel"
+	MsgTypeCancelUnbonding         string = "msg_cancel_unbonding"
+	MsgTypeSetCValue               string = "msg_set_c_value"
+	MsgTypeRetryTransfer           string = "msg_retry_transfer"
+	MsgTypeCancelParamChange       string = "msg_cancel_param_change"
+	MsgTypePauseHostChain          string = "msg_pause_host_chain"
+	MsgTypeResumeHostChain         string = "msg_resume_host_chain"
+	MsgTypeForceReconcile          string = "msg_force_reconcile_delegations"
+	MsgTypeSetFeeAddress           string = "msg_set_fee_address"
+	MsgTypeExitValidator           string = "msg_exit_validator"
+	MsgTypeSetAutoClaim            string = "msg_set_auto_claim"
+	MsgTypeClaim                   string = "msg_claim"
 )
 
 var (
@@ -33,6 +48,20 @@ var (
 	_ sdk.Msg = &MsgLiquidUnstake{}
 	_ sdk.Msg = &MsgRedeem{}
 	_ sdk.Msg = &MsgLiquidStakeLSM{}
+	_ sdk.Msg = &MsgTransferUnbonding{}
+	_ sdk.Msg = &MsgCancelValidatorExit{}
+	_ sdk.Msg = &MsgMigrateHostChainChannel{}
+	_ sdk.Msg = &MsgCancelUnbonding{}
+	_ sdk.Msg = &MsgSetCValue{}
+	_ sdk.Msg = &MsgRetryTransfer{}
+	_ sdk.Msg = &MsgCancelParamChange{}
+	_ sdk.Msg = &MsgPauseHostChain{}
+	_ sdk.Msg = &MsgResumeHostChain{}
+	_ sdk.Msg = &MsgForceReconcileDelegations{}
+	_ sdk.Msg = &MsgSetFeeAddress{}
+	_ sdk.Msg = &MsgExitValidator{}
+	_ sdk.Msg = &MsgSetAutoClaim{}
+	_ sdk.Msg = &MsgClaim{}
 )
 
 func NewMsgRegisterHostChain(
@@ -123,8 +152,12 @@ func (m *MsgRegisterHostChain) ValidateBasic() error {
 		)
 	}
 
-	// validate channel id
-	if valid := strings.HasPrefix(m.ChannelId, channeltypes.ChannelPrefix); !valid {
+	// validate channel id, localhost host chains get their deposits with bank sends and don't need a transfer channel
+	if m.ConnectionId == ibcexported.LocalhostConnectionID && m.ChannelId == "" {
+		if m.PortId != "" {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "port id set without a channel id")
+		}
+	} else if valid := strings.HasPrefix(m.ChannelId, channeltypes.ChannelPrefix); !valid {
 		return errorsmod.Wrapf(
 			sdkerrors.ErrInvalidRequest,
 			fmt.Sprintf("invalid channel id: %s, must begin with '%s'", m.ChannelId, channeltypes.ChannelPrefix),
@@ -163,6 +196,13 @@ func (m *MsgRegisterHostChain) ValidateBasic() error {
 		)
 	}
 
+	// the seed is optional, but can't be negative
+	if !m.SeedAmount.IsNil() && m.SeedAmount.IsNegative() {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"seed amount should be greater or equal than zero",
+		)
+	}
+
 	// minimum deposit must be at least one
 	if m.MinimumDeposit.LTE(sdk.ZeroInt()) {
 		return sdkerrors.ErrInvalidRequest.Wrapf(
@@ -184,6 +224,14 @@ func (m *MsgRegisterHostChain) ValidateBasic() error {
 		)
 	}
 
+	// the bootstrapped validator set is bounded
+	if m.BootstrapValidators > MaxBootstrapValidators {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"bootstrap validators should be less or equal than %d",
+			MaxBootstrapValidators,
+		)
+	}
+
 	return nil
 }
 
@@ -351,7 +399,7 @@ func (m *MsgUpdateHostChain) ValidateBasic() error {
 		case KeyMinimumDeposit:
 			minimumDeposit, ok := sdk.NewIntFromString(update.Value)
 			if !ok {
-				return sdkerrors.ErrInvalidRequest.Wrapf("")
+				return sdkerrors.ErrInvalidRequest.Wrapf("unable to parse minimum deposit string %v to sdk.Int", update.Value)
 			}
 
 			if minimumDeposit.LTE(sdk.ZeroInt()) {
@@ -391,6 +439,156 @@ func (m *MsgUpdateHostChain) ValidateBasic() error {
 			if err := sdk.ValidateDenom(params.Denom); err != nil {
 				return fmt.Errorf("invalid rewards denom: %s", err.Error())
 			}
+		case KeyMinActiveValidators, KeyWeightTransitionEpochs:
+			_, err := strconv.ParseUint(update.Value, 10, 32)
+			if err != nil {
+				return err
+			}
+		case KeyMaxValidatorWeight:
+			weight, err := sdk.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+
+			if weight.IsNegative() || weight.GT(sdk.OneDec()) {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid max validator weight value should be 0 <= weight <= 1")
+			}
+		case KeyMinRewardWithdrawal:
+			minDelegation, ok := sdk.NewIntFromString(update.Value)
+			if !ok {
+				return fmt.Errorf("unable to parse min reward withdrawal delegation string %v to sdk.Int", update.Value)
+			}
+			if minDelegation.IsNegative() {
+				return fmt.Errorf("min reward withdrawal delegation cannot be negative, found %v", minDelegation.String())
+			}
+		case KeyFeeAddress:
+			// an empty fee address falls back to the module fee address
+			if update.Value != "" {
+				if _, err := sdk.AccAddressFromBech32(update.Value); err != nil {
+					return fmt.Errorf("invalid fee address %s: %w", update.Value, err)
+				}
+			}
+		case KeyLiquidityIncentiveAddress:
+			if update.Value != "" {
+				if _, err := sdk.AccAddressFromBech32(update.Value); err != nil {
+					return fmt.Errorf("invalid liquidity incentive address %s: %w", update.Value, err)
+				}
+			}
+		case KeyLiquidityIncentiveRate:
+			rate, err := sdk.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+
+			if rate.IsNegative() || rate.GT(sdk.OneDec()) {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid liquidity incentive rate value should be 0 <= rate <= 1")
+			}
+		case KeyDepositDeliveryRatio:
+			ratio, err := sdk.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+
+			if ratio.IsNegative() || ratio.GT(sdk.OneDec()) {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid deposit delivery ratio value should be 0 <= ratio <= 1")
+			}
+		case KeyMaxDepositAmount:
+			maxDeposit, ok := sdk.NewIntFromString(update.Value)
+			if !ok {
+				return fmt.Errorf("unable to parse max deposit amount string %v to sdk.Int", update.Value)
+			}
+			if maxDeposit.IsNegative() {
+				return fmt.Errorf("max deposit amount cannot be negative, found %v", maxDeposit.String())
+			}
+		case KeyMinRewardsTransferAmount:
+			minTransfer, ok := sdk.NewIntFromString(update.Value)
+			if !ok {
+				return fmt.Errorf("unable to parse min rewards transfer amount string %v to sdk.Int", update.Value)
+			}
+			if minTransfer.IsNegative() {
+				return fmt.Errorf("min rewards transfer amount cannot be negative, found %v", minTransfer.String())
+			}
+		case KeyMaxDrainPerEpoch:
+			maxDrain, ok := sdk.NewIntFromString(update.Value)
+			if !ok {
+				return fmt.Errorf("unable to parse max drain per epoch string %v to sdk.Int", update.Value)
+			}
+			if maxDrain.IsNegative() {
+				return fmt.Errorf("max drain per epoch cannot be negative, found %v", maxDrain.String())
+			}
+		case KeyMaxRedelegationAmount:
+			maxRedelegation, ok := sdk.NewIntFromString(update.Value)
+			if !ok {
+				return fmt.Errorf("unable to parse max redelegation amount string %v to sdk.Int", update.Value)
+			}
+			if maxRedelegation.IsNegative() {
+				return fmt.Errorf("max redelegation amount cannot be negative, found %v", maxRedelegation.String())
+			}
+		case KeyMaxRedelegationRatio:
+			ratio, err := sdk.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+			if ratio.IsNegative() || ratio.GT(sdk.OneDec()) {
+				return fmt.Errorf("max redelegation ratio should be 0 <= ratio <= 1, found %v", ratio.String())
+			}
+		case KeyMaxUnbondingAmount:
+			maxUnbonding, ok := sdk.NewIntFromString(update.Value)
+			if !ok {
+				return fmt.Errorf("unable to parse max unbonding amount string %v to sdk.Int", update.Value)
+			}
+			if maxUnbonding.IsNegative() {
+				return fmt.Errorf("max unbonding amount cannot be negative, found %v", maxUnbonding.String())
+			}
+		case KeyMaxDelegationShare:
+			share, err := sdk.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+			if share.IsNegative() || share.GT(sdk.OneDec()) {
+				return fmt.Errorf("max delegation share should be 0 <= share <= 1, found %v", share.String())
+			}
+		case KeyRebateMaxCommission:
+			commission, err := sdk.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+
+			if commission.IsNegative() || commission.GT(sdk.OneDec()) {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid rebate max commission value should be 0 <= commission <= 1")
+			}
+		case KeyRebateWeightBoost:
+			boost, err := sdk.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+			if boost.IsNegative() {
+				return fmt.Errorf("rebate weight boost cannot be negative, found %v", boost.String())
+			}
+		case KeyScoreWeightMin, KeyScoreWeightMax:
+			weight, err := sdk.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+			if weight.IsNegative() || weight.GT(sdk.OneDec()) {
+				return fmt.Errorf("%s should be 0 <= weight <= 1, found %v", update.Key, weight.String())
+			}
+		case KeyMinCValue, KeyMaxCValue:
+			bound, err := sdk.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+			if bound.IsNegative() {
+				return fmt.Errorf("%s cannot be negative, found %v", update.Key, bound.String())
+			}
+		case KeyUnbondingEpochOffset:
+			offset, err := strconv.ParseInt(update.Value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("unable to parse unbonding epoch offset string %v to int64", update.Value)
+			}
+			if offset < 0 {
+				return fmt.Errorf("unbonding epoch offset cannot be negative, found %v", offset)
+			}
 		default:
 			return fmt.Errorf("invalid or unexpected update key: %s", update.Key)
 		}
@@ -443,9 +641,27 @@ func (m *MsgLiquidStake) ValidateBasic() error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
 	}
 
+	if !m.MinStkAmountOut.IsNil() && m.MinStkAmountOut.IsNegative() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "min stk amount out cannot be negative: %s", m.MinStkAmountOut)
+	}
+
+	if m.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, m.Recipient)
+		}
+	}
+
 	return ibctransfertypes.ValidateIBCDenom(m.Amount.Denom)
 }
 
+// StkRecipient returns the address the stk tokens are minted to, the delegator address unless a recipient is set
+func (m *MsgLiquidStake) StkRecipient() string {
+	if m.Recipient != "" {
+		return m.Recipient
+	}
+	return m.DelegatorAddress
+}
+
 func NewMsgLiquidStakeLSM(delegations sdk.Coins, address sdk.AccAddress) *MsgLiquidStakeLSM {
 	return &MsgLiquidStakeLSM{
 		DelegatorAddress: address.String(),
@@ -495,9 +711,23 @@ func (m *MsgLiquidStakeLSM) ValidateBasic() error {
 		}
 	}
 
+	if m.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, m.Recipient)
+		}
+	}
+
 	return nil
 }
 
+// StkRecipient returns the address the stk tokens are minted to, the delegator address unless a recipient is set
+func (m *MsgLiquidStakeLSM) StkRecipient() string {
+	if m.Recipient != "" {
+		return m.Recipient
+	}
+	return m.DelegatorAddress
+}
+
 func NewMsgLiquidUnstake(amount sdk.Coin, address sdk.AccAddress) *MsgLiquidUnstake {
 	return &MsgLiquidUnstake{
 		DelegatorAddress: address.String(),
@@ -546,6 +776,10 @@ func (m *MsgLiquidUnstake) ValidateBasic() error {
 		return sdkerrors.ErrInvalidCoins.Wrapf("invalid denom, required stk/{host-denom} got %s", m.Amount.Denom)
 	}
 
+	if !m.MinTokensOut.IsNil() && m.MinTokensOut.IsNegative() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "min tokens out cannot be negative: %s", m.MinTokensOut)
+	}
+
 	return nil
 }
 
@@ -640,3 +874,709 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 	}
 	return nil
 }
+
+func NewMsgTransferUnbonding(
+	delegatorAddress sdk.AccAddress,
+	recipientAddress sdk.AccAddress,
+	chainID string,
+	epochNumber int64,
+) *MsgTransferUnbonding {
+	return &MsgTransferUnbonding{
+		DelegatorAddress: delegatorAddress.String(),
+		RecipientAddress: recipientAddress.String(),
+		ChainId:          chainID,
+		EpochNumber:      epochNumber,
+	}
+}
+
+func (m *MsgTransferUnbonding) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgTransferUnbonding) Type() string {
+	return MsgTypeTransferUnbonding
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgTransferUnbonding) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgTransferUnbonding) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// ValidateBasic performs stateless checks
+func (m *MsgTransferUnbonding) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.DelegatorAddress); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, m.DelegatorAddress)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.RecipientAddress); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, m.RecipientAddress)
+	}
+
+	if m.DelegatorAddress == m.RecipientAddress {
+		return sdkerrors.ErrInvalidRequest.Wrapf("recipient address must be different from the delegator address")
+	}
+
+	if strings.TrimSpace(m.ChainId) == "" {
+		return sdkerrors.ErrInvalidRequest.Wrapf("chain id must be non-empty")
+	}
+
+	if m.EpochNumber < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("epoch number must be non-negative, got %d", m.EpochNumber)
+	}
+
+	return nil
+}
+
+func NewMsgCancelValidatorExit(
+	authority sdk.AccAddress,
+	chainID string,
+	validatorAddress string,
+) *MsgCancelValidatorExit {
+	return &MsgCancelValidatorExit{
+		Authority:        authority.String(),
+		ChainId:          chainID,
+		ValidatorAddress: validatorAddress,
+	}
+}
+
+func (m *MsgCancelValidatorExit) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgCancelValidatorExit) Type() string {
+	return MsgTypeCancelValidatorExit
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgCancelValidatorExit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgCancelValidatorExit) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs stateless checks
+func (m *MsgCancelValidatorExit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address %q: %v", m.Authority, err)
+	}
+
+	if strings.TrimSpace(m.ChainId) == "" {
+		return sdkerrors.ErrInvalidRequest.Wrapf("chain id must be non-empty")
+	}
+
+	if _, _, err := bech32.DecodeAndConvert(m.ValidatorAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address %q: %v", m.ValidatorAddress, err)
+	}
+
+	return nil
+}
+
+func NewMsgMigrateHostChainChannel(
+	authority sdk.AccAddress,
+	chainID string,
+	newChannelID string,
+	cancel bool,
+) *MsgMigrateHostChainChannel {
+	return &MsgMigrateHostChainChannel{
+		Authority:    authority.String(),
+		ChainId:      chainID,
+		NewChannelId: newChannelID,
+		Cancel:       cancel,
+	}
+}
+
+func (m *MsgMigrateHostChainChannel) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgMigrateHostChainChannel) Type() string {
+	return MsgTypeMigrateHostChainChannel
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgMigrateHostChainChannel) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgMigrateHostChainChannel) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs stateless checks
+func (m *MsgMigrateHostChainChannel) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address %q: %v", m.Authority, err)
+	}
+
+	if strings.TrimSpace(m.ChainId) == "" {
+		return sdkerrors.ErrInvalidRequest.Wrapf("chain id must be non-empty")
+	}
+
+	// the channel is not needed to cancel a migration
+	if m.Cancel {
+		return nil
+	}
+
+	if !channeltypes.IsValidChannelID(m.NewChannelId) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid channel id %q", m.NewChannelId)
+	}
+
+	return nil
+}
+
+func NewMsgCancelUnbonding(delegatorAddress sdk.AccAddress, chainID string, epochNumber int64) *MsgCancelUnbonding {
+	return &MsgCancelUnbonding{
+		DelegatorAddress: delegatorAddress.String(),
+		ChainId:          chainID,
+		EpochNumber:      epochNumber,
+	}
+}
+
+func (m *MsgCancelUnbonding) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgCancelUnbonding) Type() string {
+	return MsgTypeCancelUnbonding
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgCancelUnbonding) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgCancelUnbonding) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// ValidateBasic performs stateless checks
+func (m *MsgCancelUnbonding) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.DelegatorAddress); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, m.DelegatorAddress)
+	}
+
+	if strings.TrimSpace(m.ChainId) == "" {
+		return sdkerrors.ErrInvalidRequest.Wrapf("chain id must be non-empty")
+	}
+
+	if m.EpochNumber < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("epoch number must be non-negative, got %d", m.EpochNumber)
+	}
+
+	return nil
+}
+
+func NewMsgSetCValue(
+	authority sdk.AccAddress,
+	chainID string,
+	cValue sdk.Dec,
+	justification string,
+	proposalID uint64,
+) *MsgSetCValue {
+	return &MsgSetCValue{
+		Authority:     authority.String(),
+		ChainId:       chainID,
+		CValue:        cValue,
+		Justification: justification,
+		ProposalId:    proposalID,
+	}
+}
+
+func (m *MsgSetCValue) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgSetCValue) Type() string {
+	return MsgTypeSetCValue
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgSetCValue) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgSetCValue) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs stateless checks
+func (m *MsgSetCValue) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address %q: %v", m.Authority, err)
+	}
+
+	if strings.TrimSpace(m.ChainId) == "" {
+		return sdkerrors.ErrInvalidRequest.Wrapf("chain id must be non-empty")
+	}
+
+	if m.CValue.IsNil() || !m.CValue.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("c value must be positive")
+	}
+
+	if strings.TrimSpace(m.Justification) == "" {
+		return sdkerrors.ErrInvalidRequest.Wrapf("justification must be non-empty")
+	}
+
+	return nil
+}
+
+func NewMsgRetryTransfer(signer sdk.AccAddress, chainID string, epochNumber int64) *MsgRetryTransfer {
+	return &MsgRetryTransfer{
+		Signer:      signer.String(),
+		ChainId:     chainID,
+		EpochNumber: epochNumber,
+	}
+}
+
+func (m *MsgRetryTransfer) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgRetryTransfer) Type() string {
+	return MsgTypeRetryTransfer
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgRetryTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgRetryTransfer) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// ValidateBasic performs stateless checks
+func (m *MsgRetryTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+
+	if strings.TrimSpace(m.ChainId) == "" {
+		return sdkerrors.ErrInvalidRequest.Wrapf("chain id must be non-empty")
+	}
+
+	if m.EpochNumber < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("epoch number must be non-negative, got %d", m.EpochNumber)
+	}
+
+	return nil
+}
+
+func NewMsgCancelParamChange(authority sdk.AccAddress, chainID, key string) *MsgCancelParamChange {
+	return &MsgCancelParamChange{
+		Authority: authority.String(),
+		ChainId:   chainID,
+		Key:       key,
+	}
+}
+
+func (m *MsgCancelParamChange) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgCancelParamChange) Type() string {
+	return MsgTypeCancelParamChange
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgCancelParamChange) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgCancelParamChange) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs stateless checks
+func (m *MsgCancelParamChange) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address %q: %v", m.Authority, err)
+	}
+
+	if strings.TrimSpace(m.ChainId) == "" {
+		return sdkerrors.ErrInvalidRequest.Wrapf("chain id must be non-empty")
+	}
+
+	if !IsTimelockedKey(m.Key) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s updates are not staged", m.Key)
+	}
+
+	return nil
+}
+
+func NewMsgPauseHostChain(authority sdk.AccAddress, chainID, reason string) *MsgPauseHostChain {
+	return &MsgPauseHostChain{
+		Authority: authority.String(),
+		ChainId:   chainID,
+		Reason:    reason,
+	}
+}
+
+func (m *MsgPauseHostChain) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgPauseHostChain) Type() string {
+	return MsgTypePauseHostChain
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgPauseHostChain) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgPauseHostChain) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs stateless checks
+func (m *MsgPauseHostChain) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address %q: %v", m.Authority, err)
+	}
+
+	if strings.TrimSpace(m.ChainId) == "" {
+		return sdkerrors.ErrInvalidRequest.Wrapf("chain id must be non-empty")
+	}
+
+	if strings.TrimSpace(m.Reason) == "" {
+		return sdkerrors.ErrInvalidRequest.Wrapf("pause reason must be non-empty")
+	}
+
+	return nil
+}
+
+func NewMsgResumeHostChain(authority sdk.AccAddress, chainID string) *MsgResumeHostChain {
+	return &MsgResumeHostChain{
+		Authority: authority.String(),
+		ChainId:   chainID,
+	}
+}
+
+func (m *MsgResumeHostChain) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgResumeHostChain) Type() string {
+	return MsgTypeResumeHostChain
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgResumeHostChain) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgResumeHostChain) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs stateless checks
+func (m *MsgResumeHostChain) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address %q: %v", m.Authority, err)
+	}
+
+	if strings.TrimSpace(m.ChainId) == "" {
+		return sdkerrors.ErrInvalidRequest.Wrapf("chain id must be non-empty")
+	}
+
+	return nil
+}
+
+func NewMsgForceReconcileDelegations(authority sdk.AccAddress, chainID string) *MsgForceReconcileDelegations {
+	return &MsgForceReconcileDelegations{
+		Authority: authority.String(),
+		ChainId:   chainID,
+	}
+}
+
+func (m *MsgForceReconcileDelegations) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgForceReconcileDelegations) Type() string {
+	return MsgTypeForceReconcile
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgForceReconcileDelegations) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgForceReconcileDelegations) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs stateless checks
+func (m *MsgForceReconcileDelegations) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address %q: %v", m.Authority, err)
+	}
+
+	if strings.TrimSpace(m.ChainId) == "" {
+		return sdkerrors.ErrInvalidRequest.Wrapf("chain id must be non-empty")
+	}
+
+	return nil
+}
+
+func NewMsgSetFeeAddress(authority, feeAddress sdk.AccAddress, flushToOldAddress bool) *MsgSetFeeAddress {
+	return &MsgSetFeeAddress{
+		Authority:         authority.String(),
+		FeeAddress:        feeAddress.String(),
+		FlushToOldAddress: flushToOldAddress,
+	}
+}
+
+func (m *MsgSetFeeAddress) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgSetFeeAddress) Type() string {
+	return MsgTypeSetFeeAddress
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgSetFeeAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgSetFeeAddress) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs stateless checks
+func (m *MsgSetFeeAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address %q: %v", m.Authority, err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.FeeAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid fee address %q: %v", m.FeeAddress, err)
+	}
+
+	return nil
+}
+
+func NewMsgExitValidator(authority sdk.AccAddress, chainID, validatorAddress string) *MsgExitValidator {
+	return &MsgExitValidator{
+		Authority:        authority.String(),
+		ChainId:          chainID,
+		ValidatorAddress: validatorAddress,
+	}
+}
+
+func (m *MsgExitValidator) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgExitValidator) Type() string {
+	return MsgTypeExitValidator
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgExitValidator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgExitValidator) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs stateless checks
+func (m *MsgExitValidator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address %q: %v", m.Authority, err)
+	}
+
+	if strings.TrimSpace(m.ChainId) == "" {
+		return sdkerrors.ErrInvalidRequest.Wrapf("chain id must be non-empty")
+	}
+
+	if _, _, err := bech32.DecodeAndConvert(m.ValidatorAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address %q: %v", m.ValidatorAddress, err)
+	}
+
+	return nil
+}
+
+func NewMsgSetAutoClaim(
+	delegatorAddress sdk.AccAddress,
+	chainID string,
+	epochNumber int64,
+	autoClaim bool,
+) *MsgSetAutoClaim {
+	return &MsgSetAutoClaim{
+		DelegatorAddress: delegatorAddress.String(),
+		ChainId:          chainID,
+		EpochNumber:      epochNumber,
+		AutoClaim:        autoClaim,
+	}
+}
+
+func (m *MsgSetAutoClaim) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgSetAutoClaim) Type() string {
+	return MsgTypeSetAutoClaim
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgSetAutoClaim) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgSetAutoClaim) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// ValidateBasic performs stateless checks
+func (m *MsgSetAutoClaim) ValidateBasic() error {
+	return validateUnbondingPosition(m.DelegatorAddress, m.ChainId, m.EpochNumber)
+}
+
+func NewMsgClaim(delegatorAddress sdk.AccAddress, chainID string, epochNumber int64) *MsgClaim {
+	return &MsgClaim{
+		DelegatorAddress: delegatorAddress.String(),
+		ChainId:          chainID,
+		EpochNumber:      epochNumber,
+	}
+}
+
+func (m *MsgClaim) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgClaim) Type() string {
+	return MsgTypeClaim
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgClaim) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgClaim) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// ValidateBasic performs stateless checks
+func (m *MsgClaim) ValidateBasic() error {
+	return validateUnbondingPosition(m.DelegatorAddress, m.ChainId, m.EpochNumber)
+}
+
+// validateUnbondingPosition checks the delegator, chain and epoch identifying a user unbonding
+func validateUnbondingPosition(delegatorAddress, chainID string, epochNumber int64) error {
+	if _, err := sdk.AccAddressFromBech32(delegatorAddress); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, delegatorAddress)
+	}
+
+	if strings.TrimSpace(chainID) == "" {
+		return sdkerrors.ErrInvalidRequest.Wrapf("chain id must be non-empty")
+	}
+
+	if epochNumber < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("epoch number must be non-negative, got %d", epochNumber)
+	}
+
+	return nil
+}
+
+// NewFeesCharged returns the fee receipt of a message, a fee that wasn't charged is left out.
+func NewFeesCharged(feeType string, amount sdk.Coin) []FeeCharged {
+	if !amount.IsPositive() {
+		return []FeeCharged{}
+	}
+	return []FeeCharged{{FeeType: feeType, Amount: amount}}
+}
@@ -48,6 +48,12 @@ type MsgRegisterHostChain struct {
 	MinimumDeposit     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=minimum_deposit,json=minimumDeposit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minimum_deposit"`
 	UnbondingFactor    int64                                  `protobuf:"varint,11,opt,name=unbonding_factor,json=unbondingFactor,proto3" json:"unbonding_factor,omitempty"`
 	AutoCompoundFactor int64                                  `protobuf:"varint,12,opt,name=auto_compound_factor,json=autoCompoundFactor,proto3" json:"auto_compound_factor,omitempty"`
+	// number of top bonded host chain validators to bootstrap the validator set
+	// with, at equal weights, through an ICQ. zero disables the bootstrap.
+	BootstrapValidators uint32 `protobuf:"varint,13,opt,name=bootstrap_validators,json=bootstrapValidators,proto3" json:"bootstrap_validators,omitempty"`
+	// amount of host chain ibc tokens the authority liquid stakes on
+	// registration, staked before public deposits open. zero skips the seed.
+	SeedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,14,opt,name=seed_amount,json=seedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"seed_amount"`
 }
 
 func (m *MsgRegisterHostChain) Reset()         { *m = MsgRegisterHostChain{} }
@@ -198,6 +204,11 @@ var xxx_messageInfo_MsgUpdateHostChainResponse proto.InternalMessageInfo
 type MsgLiquidStake struct {
 	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 	Amount           types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// minimum amount of stk tokens to receive, the message fails if the c value
+	// mints fewer. zero disables the check.
+	MinStkAmountOut github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=min_stk_amount_out,json=minStkAmountOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_stk_amount_out"`
+	// address the stk tokens are minted to, the delegator address when empty.
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
 func (m *MsgLiquidStake) Reset()         { *m = MsgLiquidStake{} }
@@ -247,14 +258,80 @@ func (m *MsgLiquidStake) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgLiquidStake) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// FeeCharged is a fee taken by a liquid staking message.
+type FeeCharged struct {
+	// type of the fee, the host chain param it was computed with
+	FeeType string `protobuf:"bytes,1,opt,name=fee_type,json=feeType,proto3" json:"fee_type,omitempty"`
+	// amount charged, in stk or host tokens depending on the fee denom
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *FeeCharged) Reset()         { *m = FeeCharged{} }
+func (m *FeeCharged) String() string { return proto.CompactTextString(m) }
+func (*FeeCharged) ProtoMessage()    {}
+func (*FeeCharged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{5}
+}
+func (m *FeeCharged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeCharged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeCharged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeCharged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeCharged.Merge(m, src)
+}
+func (m *FeeCharged) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeCharged) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeCharged.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeCharged proto.InternalMessageInfo
+
+func (m *FeeCharged) GetFeeType() string {
+	if m != nil {
+		return m.FeeType
+	}
+	return ""
+}
+
+func (m *FeeCharged) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
 type MsgLiquidStakeResponse struct {
+	// fees charged by the message, empty when no fee applies.
+	Fees []FeeCharged `protobuf:"bytes,1,rep,name=fees,proto3" json:"fees"`
+	// c value of the host chain the stk tokens were minted at.
+	CValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=c_value,json=cValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"c_value"`
 }
 
 func (m *MsgLiquidStakeResponse) Reset()         { *m = MsgLiquidStakeResponse{} }
 func (m *MsgLiquidStakeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLiquidStakeResponse) ProtoMessage()    {}
 func (*MsgLiquidStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dce3cdc829e5c7d3, []int{5}
+	return fileDescriptor_dce3cdc829e5c7d3, []int{6}
 }
 func (m *MsgLiquidStakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -283,16 +360,25 @@ func (m *MsgLiquidStakeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLiquidStakeResponse proto.InternalMessageInfo
 
+func (m *MsgLiquidStakeResponse) GetFees() []FeeCharged {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
 type MsgLiquidStakeLSM struct {
 	DelegatorAddress string                                   `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 	Delegations      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=delegations,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"delegations"`
+	// address the stk tokens are minted to, the delegator address when empty.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
 func (m *MsgLiquidStakeLSM) Reset()         { *m = MsgLiquidStakeLSM{} }
 func (m *MsgLiquidStakeLSM) String() string { return proto.CompactTextString(m) }
 func (*MsgLiquidStakeLSM) ProtoMessage()    {}
 func (*MsgLiquidStakeLSM) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dce3cdc829e5c7d3, []int{6}
+	return fileDescriptor_dce3cdc829e5c7d3, []int{7}
 }
 func (m *MsgLiquidStakeLSM) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -335,6 +421,13 @@ func (m *MsgLiquidStakeLSM) GetDelegations() github_com_cosmos_cosmos_sdk_types.
 	return nil
 }
 
+func (m *MsgLiquidStakeLSM) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
 type MsgLiquidStakeLSMResponse struct {
 }
 
@@ -342,7 +435,7 @@ func (m *MsgLiquidStakeLSMResponse) Reset()         { *m = MsgLiquidStakeLSMResp
 func (m *MsgLiquidStakeLSMResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLiquidStakeLSMResponse) ProtoMessage()    {}
 func (*MsgLiquidStakeLSMResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dce3cdc829e5c7d3, []int{7}
+	return fileDescriptor_dce3cdc829e5c7d3, []int{8}
 }
 func (m *MsgLiquidStakeLSMResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -374,13 +467,19 @@ var xxx_messageInfo_MsgLiquidStakeLSMResponse proto.InternalMessageInfo
 type MsgLiquidUnstake struct {
 	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 	Amount           types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// minimum amount of host tokens to unbond, the message fails if the c value
+	// gives fewer. zero disables the check.
+	MinTokensOut github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=min_tokens_out,json=minTokensOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_tokens_out"`
+	// whether the unbondings are paid out to the delegator as soon as they are
+	// claimable, otherwise they wait for a MsgClaim.
+	AutoClaim bool `protobuf:"varint,4,opt,name=auto_claim,json=autoClaim,proto3" json:"auto_claim,omitempty"`
 }
 
 func (m *MsgLiquidUnstake) Reset()         { *m = MsgLiquidUnstake{} }
 func (m *MsgLiquidUnstake) String() string { return proto.CompactTextString(m) }
 func (*MsgLiquidUnstake) ProtoMessage()    {}
 func (*MsgLiquidUnstake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dce3cdc829e5c7d3, []int{8}
+	return fileDescriptor_dce3cdc829e5c7d3, []int{9}
 }
 func (m *MsgLiquidUnstake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -423,14 +522,25 @@ func (m *MsgLiquidUnstake) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgLiquidUnstake) GetAutoClaim() bool {
+	if m != nil {
+		return m.AutoClaim
+	}
+	return false
+}
+
 type MsgLiquidUnstakeResponse struct {
+	// fees charged by the message, empty when no fee applies.
+	Fees []FeeCharged `protobuf:"bytes,1,rep,name=fees,proto3" json:"fees"`
+	// c value of the host chain the unbond amount was computed at.
+	CValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=c_value,json=cValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"c_value"`
 }
 
 func (m *MsgLiquidUnstakeResponse) Reset()         { *m = MsgLiquidUnstakeResponse{} }
 func (m *MsgLiquidUnstakeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLiquidUnstakeResponse) ProtoMessage()    {}
 func (*MsgLiquidUnstakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dce3cdc829e5c7d3, []int{9}
+	return fileDescriptor_dce3cdc829e5c7d3, []int{10}
 }
 func (m *MsgLiquidUnstakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,6 +569,13 @@ func (m *MsgLiquidUnstakeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLiquidUnstakeResponse proto.InternalMessageInfo
 
+func (m *MsgLiquidUnstakeResponse) GetFees() []FeeCharged {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
 type MsgRedeem struct {
 	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 	Amount           types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
@@ -468,7 +585,7 @@ func (m *MsgRedeem) Reset()         { *m = MsgRedeem{} }
 func (m *MsgRedeem) String() string { return proto.CompactTextString(m) }
 func (*MsgRedeem) ProtoMessage()    {}
 func (*MsgRedeem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dce3cdc829e5c7d3, []int{10}
+	return fileDescriptor_dce3cdc829e5c7d3, []int{11}
 }
 func (m *MsgRedeem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -512,13 +629,17 @@ func (m *MsgRedeem) GetAmount() types.Coin {
 }
 
 type MsgRedeemResponse struct {
+	// fees charged by the message, empty when no fee applies.
+	Fees []FeeCharged `protobuf:"bytes,1,rep,name=fees,proto3" json:"fees"`
+	// c value of the host chain the stk tokens were redeemed at.
+	CValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=c_value,json=cValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"c_value"`
 }
 
 func (m *MsgRedeemResponse) Reset()         { *m = MsgRedeemResponse{} }
 func (m *MsgRedeemResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRedeemResponse) ProtoMessage()    {}
 func (*MsgRedeemResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dce3cdc829e5c7d3, []int{11}
+	return fileDescriptor_dce3cdc829e5c7d3, []int{12}
 }
 func (m *MsgRedeemResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -547,6 +668,13 @@ func (m *MsgRedeemResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRedeemResponse proto.InternalMessageInfo
 
+func (m *MsgRedeemResponse) GetFees() []FeeCharged {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
 type MsgUpdateParams struct {
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
@@ -556,7 +684,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dce3cdc829e5c7d3, []int{12}
+	return fileDescriptor_dce3cdc829e5c7d3, []int{13}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -592,7 +720,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dce3cdc829e5c7d3, []int{13}
+	return fileDescriptor_dce3cdc829e5c7d3, []int{14}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -621,1161 +749,7955 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgRegisterHostChain)(nil), "pstake.liquidstakeibc.v1beta1.MsgRegisterHostChain")
-	proto.RegisterType((*MsgRegisterHostChainResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgRegisterHostChainResponse")
-	proto.RegisterType((*MsgUpdateHostChain)(nil), "pstake.liquidstakeibc.v1beta1.MsgUpdateHostChain")
-	proto.RegisterType((*MsgUpdateHostChainResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgUpdateHostChainResponse")
-	proto.RegisterType((*MsgLiquidStake)(nil), "pstake.liquidstakeibc.v1beta1.MsgLiquidStake")
-	proto.RegisterType((*MsgLiquidStakeResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgLiquidStakeResponse")
-	proto.RegisterType((*MsgLiquidStakeLSM)(nil), "pstake.liquidstakeibc.v1beta1.MsgLiquidStakeLSM")
-	proto.RegisterType((*MsgLiquidStakeLSMResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgLiquidStakeLSMResponse")
-	proto.RegisterType((*MsgLiquidUnstake)(nil), "pstake.liquidstakeibc.v1beta1.MsgLiquidUnstake")
-	proto.RegisterType((*MsgLiquidUnstakeResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgLiquidUnstakeResponse")
-	proto.RegisterType((*MsgRedeem)(nil), "pstake.liquidstakeibc.v1beta1.MsgRedeem")
-	proto.RegisterType((*MsgRedeemResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgRedeemResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "pstake.liquidstakeibc.v1beta1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgUpdateParamsResponse")
+type MsgTransferUnbonding struct {
+	// current owner of the unbonding position
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// address receiving the unbonding position and its claim rights
+	RecipientAddress string `protobuf:"bytes,2,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	// unbonding target chain
+	ChainId string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// epoch when the unbonding started
+	EpochNumber int64 `protobuf:"varint,4,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("pstake/liquidstakeibc/v1beta1/msgs.proto", fileDescriptor_dce3cdc829e5c7d3)
+func (m *MsgTransferUnbonding) Reset()         { *m = MsgTransferUnbonding{} }
+func (m *MsgTransferUnbonding) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferUnbonding) ProtoMessage()    {}
+func (*MsgTransferUnbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{15}
 }
-
-var fileDescriptor_dce3cdc829e5c7d3 = []byte{
-	// 1142 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0x5e, 0x27, 0xed, 0x26, 0x3b, 0xf9, 0x6d, 0x42, 0xe3, 0x75, 0xd3, 0x4d, 0x64, 0x54, 0xba,
-	0x84, 0xae, 0x9d, 0x6c, 0xda, 0x14, 0x02, 0x97, 0x26, 0xa1, 0xca, 0x8a, 0xac, 0x40, 0x8e, 0xca,
-	0x01, 0x84, 0x56, 0x5e, 0x7b, 0xea, 0x58, 0x8d, 0x67, 0x8c, 0x67, 0x1c, 0xd1, 0x13, 0x52, 0x25,
-	0x24, 0xc4, 0x09, 0xa9, 0xff, 0x40, 0x6f, 0x20, 0x0e, 0x10, 0x89, 0x1e, 0x38, 0x73, 0x40, 0x39,
-	0x56, 0xe5, 0x82, 0x38, 0x14, 0x94, 0x20, 0x85, 0xbf, 0x02, 0xa1, 0x19, 0xcf, 0x7a, 0x37, 0x4e,
-	0xf6, 0x57, 0x89, 0xd4, 0x4b, 0xbb, 0x7e, 0xef, 0x7d, 0xdf, 0x7c, 0xdf, 0x9b, 0x99, 0x67, 0x07,
-	0x14, 0x03, 0x42, 0xad, 0xfb, 0xd0, 0xd8, 0xf5, 0x3e, 0x8b, 0x3c, 0x87, 0xff, 0xf6, 0xea, 0xb6,
-	0xb1, 0xb7, 0x54, 0x87, 0xd4, 0x5a, 0x32, 0x7c, 0xe2, 0x12, 0x3d, 0x08, 0x31, 0xc5, 0xf2, 0x95,
-	0xb8, 0x52, 0x3f, 0x59, 0xa9, 0x8b, 0x4a, 0x75, 0xd6, 0xc5, 0xd8, 0xdd, 0x85, 0x86, 0x15, 0x78,
-	0x86, 0x85, 0x10, 0xa6, 0x16, 0xf5, 0x30, 0x12, 0x60, 0x35, 0x6f, 0x63, 0xe2, 0x63, 0x52, 0xe3,
-	0x4f, 0x46, 0xfc, 0x20, 0x52, 0xd3, 0x2e, 0x76, 0x71, 0x1c, 0x67, 0xbf, 0x44, 0x74, 0x26, 0xae,
-	0x61, 0x02, 0x8c, 0x3d, 0xae, 0x43, 0x24, 0x0a, 0x22, 0x51, 0xb7, 0x08, 0x4c, 0x64, 0xda, 0xd8,
-	0x43, 0x22, 0x3f, 0x65, 0xf9, 0x1e, 0xc2, 0x06, 0xff, 0x57, 0x84, 0xca, 0x9d, 0x3d, 0xa6, 0x0c,
-	0xc5, 0x98, 0x85, 0xce, 0x98, 0xc0, 0x0a, 0x2d, 0x5f, 0x38, 0xd0, 0x0e, 0xb2, 0x60, 0xba, 0x4a,
-	0x5c, 0x13, 0xba, 0x1e, 0xa1, 0x30, 0xdc, 0xc4, 0x84, 0xae, 0xef, 0x58, 0x1e, 0x92, 0x57, 0x40,
-	0xce, 0x8a, 0xe8, 0x0e, 0x0e, 0x3d, 0xfa, 0x40, 0x91, 0xe6, 0xa5, 0x62, 0x6e, 0x4d, 0x79, 0xf6,
-	0xa4, 0x34, 0x2d, 0xfc, 0xdf, 0x76, 0x9c, 0x10, 0x12, 0xb2, 0x4d, 0x43, 0x0f, 0xb9, 0x66, 0xb3,
-	0x54, 0x7e, 0x0d, 0x8c, 0xd9, 0x18, 0x21, 0x68, 0xb3, 0x16, 0xd6, 0x3c, 0x47, 0x19, 0x60, 0x58,
-	0x73, 0xb4, 0x19, 0xac, 0x38, 0xf2, 0xa7, 0x60, 0xc4, 0x81, 0x01, 0x26, 0x1e, 0xad, 0xdd, 0x83,
-	0x50, 0x19, 0xe4, 0xf4, 0xef, 0x1e, 0x3c, 0x9f, 0xcb, 0xfc, 0xf1, 0x7c, 0xee, 0x75, 0xd7, 0xa3,
-	0x3b, 0x51, 0x5d, 0xb7, 0xb1, 0x2f, 0xba, 0x2d, 0xfe, 0x2b, 0x11, 0xe7, 0xbe, 0x41, 0x1f, 0x04,
-	0x90, 0xe8, 0x1b, 0xd0, 0x7e, 0xf6, 0xa4, 0x04, 0x84, 0x98, 0x0d, 0x68, 0x9b, 0x40, 0x10, 0xde,
-	0x81, 0x90, 0xd1, 0x87, 0x90, 0xfb, 0xe6, 0xf4, 0x17, 0xce, 0x83, 0x5e, 0x10, 0x0a, 0xfa, 0x08,
-	0x35, 0xe9, 0x2f, 0x9e, 0x07, 0xbd, 0x20, 0x64, 0xf4, 0x36, 0x18, 0x0f, 0xa1, 0x03, 0xfd, 0x80,
-	0x77, 0x90, 0xad, 0x90, 0x3d, 0x87, 0x15, 0xc6, 0x9a, 0x9c, 0x6c, 0x91, 0x2b, 0x00, 0xd8, 0x3b,
-	0x16, 0x42, 0x70, 0x97, 0xed, 0xd1, 0x10, 0xdf, 0xa3, 0x9c, 0x88, 0x54, 0x1c, 0x79, 0x06, 0x0c,
-	0x05, 0x38, 0xa4, 0x2c, 0x37, 0xcc, 0x73, 0x59, 0xf6, 0x58, 0x71, 0x18, 0x6e, 0x07, 0x13, 0x5a,
-	0x73, 0x20, 0xc2, 0xbe, 0x92, 0x8b, 0x71, 0x2c, 0xb2, 0xc1, 0x02, 0x32, 0x04, 0x13, 0xbe, 0x87,
-	0x3c, 0x3f, 0xf2, 0x6b, 0x62, 0x3f, 0x14, 0xd0, 0xb7, 0xf8, 0x0a, 0xa2, 0x2d, 0xe2, 0x2b, 0x88,
-	0x9a, 0xe3, 0x82, 0x74, 0x23, 0xe6, 0x94, 0xdf, 0x00, 0x93, 0x11, 0xaa, 0x63, 0xe4, 0x78, 0xc8,
-	0xad, 0xdd, 0xb3, 0x6c, 0x8a, 0x43, 0x65, 0x64, 0x5e, 0x2a, 0x0e, 0x9a, 0x13, 0x49, 0xfc, 0x0e,
-	0x0f, 0xcb, 0x8b, 0x60, 0xda, 0x8a, 0x28, 0xae, 0xd9, 0xd8, 0x0f, 0x70, 0x84, 0x9c, 0x46, 0xf9,
-	0x28, 0x2f, 0x97, 0x59, 0x6e, 0x5d, 0xa4, 0x62, 0xc4, 0xea, 0xca, 0x57, 0x8f, 0xe7, 0x32, 0xff,
-	0x3c, 0x9e, 0xcb, 0x3c, 0x3c, 0xde, 0x5f, 0x68, 0x9e, 0xec, 0xaf, 0x8f, 0xf7, 0x17, 0x2e, 0x8b,
-	0x9b, 0x75, 0xd6, 0x8d, 0xd1, 0x0a, 0x60, 0xf6, 0xac, 0xb8, 0x09, 0x49, 0x80, 0x11, 0x81, 0xda,
-	0xb1, 0x04, 0xe4, 0x2a, 0x71, 0xef, 0x06, 0x8e, 0x45, 0xe1, 0xff, 0xbf, 0x68, 0x79, 0x30, 0x6c,
-	0x33, 0x82, 0xe6, 0x1d, 0x1b, 0xe2, 0xcf, 0x15, 0x47, 0xde, 0x04, 0x43, 0x11, 0x5f, 0x85, 0x28,
-	0x83, 0xf3, 0x83, 0xc5, 0x91, 0xf2, 0x35, 0xbd, 0xe3, 0x00, 0xd4, 0xdf, 0xff, 0x28, 0x56, 0xb5,
-	0x76, 0xf1, 0xbb, 0xe3, 0xfd, 0x05, 0xc9, 0x6c, 0xc0, 0x57, 0x6f, 0xb4, 0xef, 0x45, 0xbe, 0xd9,
-	0x8b, 0x94, 0x25, 0x6d, 0x16, 0xa8, 0xa7, 0xa3, 0x49, 0x1f, 0x7e, 0x91, 0xc0, 0x78, 0x95, 0xb8,
-	0x5b, 0x5c, 0xca, 0x36, 0xe3, 0x90, 0xdf, 0x03, 0x53, 0x0e, 0xdc, 0x85, 0xae, 0x45, 0x71, 0x58,
-	0xb3, 0x62, 0xc7, 0x5d, 0x7b, 0x31, 0x99, 0x40, 0x44, 0x5c, 0xbe, 0x05, 0xb2, 0x96, 0x8f, 0x23,
-	0x44, 0x79, 0x43, 0x46, 0xca, 0x79, 0x5d, 0x00, 0xd9, 0xc0, 0x4d, 0xcc, 0xae, 0x63, 0x0f, 0xad,
-	0x5d, 0x60, 0xe7, 0xd1, 0x14, 0xe5, 0xab, 0x8b, 0xcc, 0xde, 0x69, 0x09, 0xcc, 0xe6, 0xab, 0x4d,
-	0x9b, 0x2d, 0x8a, 0x35, 0x05, 0x5c, 0x3a, 0x19, 0x49, 0xec, 0xfd, 0x2b, 0x81, 0xa9, 0x93, 0xa9,
-	0xad, 0xed, 0xea, 0x79, 0x39, 0xf4, 0xd9, 0xe0, 0xe4, 0x31, 0xf6, 0x82, 0x52, 0x06, 0xf8, 0xee,
-	0x76, 0xb0, 0xb9, 0xc8, 0x6c, 0x7e, 0xff, 0xe7, 0x5c, 0xb1, 0x87, 0x6b, 0xc7, 0x00, 0xc4, 0x6c,
-	0xe5, 0x5f, 0x5d, 0x6e, 0xdf, 0x17, 0xe5, 0xcc, 0xbe, 0x6c, 0x6d, 0x57, 0xb5, 0xcb, 0x20, 0x7f,
-	0x2a, 0x98, 0x74, 0xe7, 0x57, 0x09, 0x4c, 0x26, 0xd9, 0xbb, 0xf1, 0xd0, 0x7b, 0xe9, 0xdb, 0x5f,
-	0x6e, 0x6f, 0x73, 0x26, 0x6d, 0x53, 0x68, 0xd6, 0x54, 0xa0, 0xa4, 0x63, 0x89, 0xc9, 0x9f, 0x25,
-	0x90, 0xe3, 0xa3, 0xc0, 0x81, 0xd0, 0x7f, 0xe9, 0xee, 0xde, 0x6c, 0xef, 0x6e, 0xb2, 0x75, 0x9e,
-	0x31, 0xb1, 0xda, 0x2b, 0xfc, 0xf0, 0xc6, 0x0f, 0xad, 0x9b, 0x36, 0x91, 0x5c, 0xe8, 0x0f, 0xf9,
-	0xe7, 0xc3, 0x0b, 0x8f, 0xad, 0x4d, 0x90, 0x8d, 0x3f, 0x40, 0x84, 0x8d, 0xab, 0x5d, 0x46, 0x53,
-	0xbc, 0xdc, 0x5a, 0x8e, 0x59, 0x8a, 0x87, 0x93, 0xc0, 0xaf, 0x2e, 0xb5, 0x9f, 0x4d, 0x97, 0xd2,
-	0xb3, 0x29, 0x66, 0xd1, 0xf2, 0x60, 0x26, 0x15, 0x6a, 0x78, 0x2c, 0xff, 0x30, 0x0c, 0x06, 0xab,
-	0xc4, 0x95, 0xbf, 0x94, 0xc0, 0xd4, 0xe9, 0xaf, 0xa1, 0xe5, 0x2e, 0x2a, 0xcf, 0x1a, 0xfc, 0xea,
-	0x3b, 0x2f, 0x00, 0x6a, 0xe8, 0x91, 0xbf, 0x00, 0x13, 0xe9, 0x37, 0xc5, 0x52, 0x77, 0xbe, 0x14,
-	0x44, 0x7d, 0xbb, 0x6f, 0x48, 0x22, 0xe0, 0x5b, 0x09, 0x8c, 0xb4, 0xce, 0xe8, 0x52, 0x77, 0xaa,
-	0x96, 0x72, 0xf5, 0x66, 0x5f, 0xe5, 0xc9, 0x51, 0x2b, 0x3f, 0xfc, 0xed, 0xef, 0x47, 0x03, 0xd7,
-	0xb5, 0x05, 0xa3, 0xf3, 0x47, 0x6c, 0xab, 0xb2, 0x9f, 0x24, 0x30, 0x9e, 0x1a, 0xb7, 0x8b, 0x7d,
-	0xad, 0xbe, 0xb5, 0x5d, 0x55, 0xdf, 0xea, 0x17, 0x91, 0x48, 0xbe, 0xc9, 0x25, 0x1b, 0x5a, 0xa9,
-	0x77, 0xc9, 0x4c, 0xe2, 0x8f, 0x12, 0x18, 0x3b, 0x39, 0x06, 0x8d, 0x5e, 0x25, 0x08, 0x80, 0x7a,
-	0xab, 0x4f, 0x40, 0x22, 0xf9, 0x06, 0x97, 0xac, 0x6b, 0xd7, 0x7b, 0x92, 0xdc, 0xd0, 0xf7, 0x48,
-	0x02, 0x59, 0x31, 0xd3, 0x8a, 0xbd, 0x1c, 0x6d, 0x56, 0xa9, 0x2e, 0xf6, 0x5a, 0x99, 0x88, 0x2b,
-	0x71, 0x71, 0xd7, 0xb4, 0xab, 0x5d, 0xc4, 0x09, 0x29, 0x7b, 0x60, 0xf4, 0xc4, 0x60, 0xd2, 0x7b,
-	0x3d, 0xf2, 0x71, 0xbd, 0xba, 0xd2, 0x5f, 0x7d, 0x43, 0xe6, 0xda, 0x27, 0x07, 0x87, 0x05, 0xe9,
-	0xe9, 0x61, 0x41, 0xfa, 0xeb, 0xb0, 0x20, 0x7d, 0x73, 0x54, 0xc8, 0x3c, 0x3d, 0x2a, 0x64, 0x7e,
-	0x3f, 0x2a, 0x64, 0x3e, 0xbe, 0xdd, 0xf2, 0xb2, 0x0d, 0x60, 0x48, 0xd8, 0x0d, 0x47, 0x36, 0xfc,
-	0x00, 0x41, 0xe1, 0xa8, 0x84, 0x2c, 0xea, 0xed, 0x41, 0x63, 0xaf, 0x6c, 0x7c, 0x9e, 0x76, 0xc7,
-	0xdf, 0xc5, 0xf5, 0x2c, 0xff, 0xeb, 0x6c, 0xf9, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7a, 0xd9,
-	0x72, 0x74, 0xe3, 0x0e, 0x00, 0x00,
+func (m *MsgTransferUnbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	RegisterHostChain(ctx context.Context, in *MsgRegisterHostChain, opts ...grpc.CallOption) (*MsgRegisterHostChainResponse, error)
-	UpdateHostChain(ctx context.Context, in *MsgUpdateHostChain, opts ...grpc.CallOption) (*MsgUpdateHostChainResponse, error)
-	LiquidStake(ctx context.Context, in *MsgLiquidStake, opts ...grpc.CallOption) (*MsgLiquidStakeResponse, error)
-	LiquidStakeLSM(ctx context.Context, in *MsgLiquidStakeLSM, opts ...grpc.CallOption) (*MsgLiquidStakeLSMResponse, error)
-	LiquidUnstake(ctx context.Context, in *MsgLiquidUnstake, opts ...grpc.CallOption) (*MsgLiquidUnstakeResponse, error)
-	Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.CallOption) (*MsgRedeemResponse, error)
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgTransferUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferUnbonding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgTransferUnbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferUnbonding.Merge(m, src)
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgTransferUnbonding) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) RegisterHostChain(ctx context.Context, in *MsgRegisterHostChain, opts ...grpc.CallOption) (*MsgRegisterHostChainResponse, error) {
-	out := new(MsgRegisterHostChainResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/RegisterHostChain", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgTransferUnbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferUnbonding.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateHostChain(ctx context.Context, in *MsgUpdateHostChain, opts ...grpc.CallOption) (*MsgUpdateHostChainResponse, error) {
-	out := new(MsgUpdateHostChainResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/UpdateHostChain", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTransferUnbonding proto.InternalMessageInfo
+
+func (m *MsgTransferUnbonding) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) LiquidStake(ctx context.Context, in *MsgLiquidStake, opts ...grpc.CallOption) (*MsgLiquidStakeResponse, error) {
-	out := new(MsgLiquidStakeResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/LiquidStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferUnbonding) GetRecipientAddress() string {
+	if m != nil {
+		return m.RecipientAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) LiquidStakeLSM(ctx context.Context, in *MsgLiquidStakeLSM, opts ...grpc.CallOption) (*MsgLiquidStakeLSMResponse, error) {
-	out := new(MsgLiquidStakeLSMResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/LiquidStakeLSM", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferUnbonding) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) LiquidUnstake(ctx context.Context, in *MsgLiquidUnstake, opts ...grpc.CallOption) (*MsgLiquidUnstakeResponse, error) {
-	out := new(MsgLiquidUnstakeResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/LiquidUnstake", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferUnbonding) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.CallOption) (*MsgRedeemResponse, error) {
-	out := new(MsgRedeemResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/Redeem", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgTransferUnbondingResponse struct {
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferUnbondingResponse) Reset()         { *m = MsgTransferUnbondingResponse{} }
+func (m *MsgTransferUnbondingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferUnbondingResponse) ProtoMessage()    {}
+func (*MsgTransferUnbondingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{16}
+}
+func (m *MsgTransferUnbondingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferUnbondingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferUnbondingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	RegisterHostChain(context.Context, *MsgRegisterHostChain) (*MsgRegisterHostChainResponse, error)
-	UpdateHostChain(context.Context, *MsgUpdateHostChain) (*MsgUpdateHostChainResponse, error)
-	LiquidStake(context.Context, *MsgLiquidStake) (*MsgLiquidStakeResponse, error)
-	LiquidStakeLSM(context.Context, *MsgLiquidStakeLSM) (*MsgLiquidStakeLSMResponse, error)
-	LiquidUnstake(context.Context, *MsgLiquidUnstake) (*MsgLiquidUnstakeResponse, error)
-	Redeem(context.Context, *MsgRedeem) (*MsgRedeemResponse, error)
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgTransferUnbondingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferUnbondingResponse.Merge(m, src)
 }
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgTransferUnbondingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferUnbondingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferUnbondingResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedMsgServer) RegisterHostChain(ctx context.Context, req *MsgRegisterHostChain) (*MsgRegisterHostChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterHostChain not implemented")
+var xxx_messageInfo_MsgTransferUnbondingResponse proto.InternalMessageInfo
+
+type MsgCancelValidatorExit struct {
+	// authority is the address of the governance account or the module admin
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// host chain of the exiting validator
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// operator address of the exiting validator
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
-func (*UnimplementedMsgServer) UpdateHostChain(ctx context.Context, req *MsgUpdateHostChain) (*MsgUpdateHostChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateHostChain not implemented")
+
+func (m *MsgCancelValidatorExit) Reset()         { *m = MsgCancelValidatorExit{} }
+func (m *MsgCancelValidatorExit) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelValidatorExit) ProtoMessage()    {}
+func (*MsgCancelValidatorExit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{17}
 }
-func (*UnimplementedMsgServer) LiquidStake(ctx context.Context, req *MsgLiquidStake) (*MsgLiquidStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidStake not implemented")
+func (m *MsgCancelValidatorExit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) LiquidStakeLSM(ctx context.Context, req *MsgLiquidStakeLSM) (*MsgLiquidStakeLSMResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidStakeLSM not implemented")
+func (m *MsgCancelValidatorExit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelValidatorExit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) LiquidUnstake(ctx context.Context, req *MsgLiquidUnstake) (*MsgLiquidUnstakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidUnstake not implemented")
+func (m *MsgCancelValidatorExit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelValidatorExit.Merge(m, src)
 }
-func (*UnimplementedMsgServer) Redeem(ctx context.Context, req *MsgRedeem) (*MsgRedeemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Redeem not implemented")
+func (m *MsgCancelValidatorExit) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgCancelValidatorExit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelValidatorExit.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgCancelValidatorExit proto.InternalMessageInfo
+
+func (m *MsgCancelValidatorExit) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func _Msg_RegisterHostChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterHostChain)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgCancelValidatorExit) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterHostChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/RegisterHostChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterHostChain(ctx, req.(*MsgRegisterHostChain))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateHostChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateHostChain)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateHostChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/UpdateHostChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateHostChain(ctx, req.(*MsgUpdateHostChain))
+func (m *MsgCancelValidatorExit) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_LiquidStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLiquidStake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).LiquidStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/LiquidStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LiquidStake(ctx, req.(*MsgLiquidStake))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgCancelValidatorExitResponse struct {
 }
 
-func _Msg_LiquidStakeLSM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLiquidStakeLSM)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).LiquidStakeLSM(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/LiquidStakeLSM",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LiquidStakeLSM(ctx, req.(*MsgLiquidStakeLSM))
+func (m *MsgCancelValidatorExitResponse) Reset()         { *m = MsgCancelValidatorExitResponse{} }
+func (m *MsgCancelValidatorExitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelValidatorExitResponse) ProtoMessage()    {}
+func (*MsgCancelValidatorExitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{18}
+}
+func (m *MsgCancelValidatorExitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelValidatorExitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelValidatorExitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCancelValidatorExitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelValidatorExitResponse.Merge(m, src)
+}
+func (m *MsgCancelValidatorExitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelValidatorExitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelValidatorExitResponse.DiscardUnknown(m)
 }
 
-func _Msg_LiquidUnstake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLiquidUnstake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).LiquidUnstake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/LiquidUnstake",
+var xxx_messageInfo_MsgCancelValidatorExitResponse proto.InternalMessageInfo
+
+type MsgMigrateHostChainChannel struct {
+	// authority is the address of the governance account or the module admin
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// host chain to migrate
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// transfer channel the host chain is switched to
+	NewChannelId string `protobuf:"bytes,3,opt,name=new_channel_id,json=newChannelId,proto3" json:"new_channel_id,omitempty"`
+	// cancels the draining migration of the host chain instead of starting one
+	Cancel bool `protobuf:"varint,4,opt,name=cancel,proto3" json:"cancel,omitempty"`
+}
+
+func (m *MsgMigrateHostChainChannel) Reset()         { *m = MsgMigrateHostChainChannel{} }
+func (m *MsgMigrateHostChainChannel) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateHostChainChannel) ProtoMessage()    {}
+func (*MsgMigrateHostChainChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{19}
+}
+func (m *MsgMigrateHostChainChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateHostChainChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateHostChainChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LiquidUnstake(ctx, req.(*MsgLiquidUnstake))
+}
+func (m *MsgMigrateHostChainChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateHostChainChannel.Merge(m, src)
+}
+func (m *MsgMigrateHostChainChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateHostChainChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateHostChainChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateHostChainChannel proto.InternalMessageInfo
+
+func (m *MsgMigrateHostChainChannel) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Redeem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRedeem)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgMigrateHostChainChannel) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).Redeem(ctx, in)
+	return ""
+}
+
+func (m *MsgMigrateHostChainChannel) GetNewChannelId() string {
+	if m != nil {
+		return m.NewChannelId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/Redeem",
+	return ""
+}
+
+func (m *MsgMigrateHostChainChannel) GetCancel() bool {
+	if m != nil {
+		return m.Cancel
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Redeem(ctx, req.(*MsgRedeem))
+	return false
+}
+
+type MsgMigrateHostChainChannelResponse struct {
+}
+
+func (m *MsgMigrateHostChainChannelResponse) Reset()         { *m = MsgMigrateHostChainChannelResponse{} }
+func (m *MsgMigrateHostChainChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateHostChainChannelResponse) ProtoMessage()    {}
+func (*MsgMigrateHostChainChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{20}
+}
+func (m *MsgMigrateHostChainChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateHostChainChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateHostChainChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgMigrateHostChainChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateHostChainChannelResponse.Merge(m, src)
+}
+func (m *MsgMigrateHostChainChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateHostChainChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateHostChainChannelResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgMigrateHostChainChannelResponse proto.InternalMessageInfo
+
+type MsgCancelUnbonding struct {
+	// owner of the unbonding position
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// unbonding target chain
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// epoch when the unbonding started
+	EpochNumber int64 `protobuf:"varint,3,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+}
+
+func (m *MsgCancelUnbonding) Reset()         { *m = MsgCancelUnbonding{} }
+func (m *MsgCancelUnbonding) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUnbonding) ProtoMessage()    {}
+func (*MsgCancelUnbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{21}
+}
+func (m *MsgCancelUnbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUnbonding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+}
+func (m *MsgCancelUnbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUnbonding.Merge(m, src)
+}
+func (m *MsgCancelUnbonding) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUnbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUnbonding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUnbonding proto.InternalMessageInfo
+
+func (m *MsgCancelUnbonding) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/UpdateParams",
+	return ""
+}
+
+func (m *MsgCancelUnbonding) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	return ""
+}
+
+func (m *MsgCancelUnbonding) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pstake.liquidstakeibc.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterHostChain",
-			Handler:    _Msg_RegisterHostChain_Handler,
-		},
-		{
-			MethodName: "UpdateHostChain",
-			Handler:    _Msg_UpdateHostChain_Handler,
-		},
-		{
-			MethodName: "LiquidStake",
-			Handler:    _Msg_LiquidStake_Handler,
-		},
-		{
-			MethodName: "LiquidStakeLSM",
-			Handler:    _Msg_LiquidStakeLSM_Handler,
-		},
-		{
-			MethodName: "LiquidUnstake",
-			Handler:    _Msg_LiquidUnstake_Handler,
-		},
-		{
-			MethodName: "Redeem",
-			Handler:    _Msg_Redeem_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pstake/liquidstakeibc/v1beta1/msgs.proto",
+type MsgCancelUnbondingResponse struct {
 }
 
-func (m *MsgRegisterHostChain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelUnbondingResponse) Reset()         { *m = MsgCancelUnbondingResponse{} }
+func (m *MsgCancelUnbondingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUnbondingResponse) ProtoMessage()    {}
+func (*MsgCancelUnbondingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{22}
+}
+func (m *MsgCancelUnbondingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUnbondingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUnbondingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRegisterHostChain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelUnbondingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUnbondingResponse.Merge(m, src)
+}
+func (m *MsgCancelUnbondingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUnbondingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUnbondingResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterHostChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AutoCompoundFactor != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.AutoCompoundFactor))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.UnbondingFactor != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.UnbondingFactor))
-		i--
-		dAtA[i] = 0x58
-	}
-	{
-		size := m.MinimumDeposit.Size()
-		i -= size
-		if _, err := m.MinimumDeposit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	if len(m.HostDenom) > 0 {
-		i -= len(m.HostDenom)
-		copy(dAtA[i:], m.HostDenom)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.HostDenom)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	{
-		size := m.RedemptionFee.Size()
-		i -= size
-		if _, err := m.RedemptionFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.UnstakeFee.Size()
-		i -= size
-		if _, err := m.UnstakeFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.RestakeFee.Size()
-		i -= size
-		if _, err := m.RestakeFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.DepositFee.Size()
-		i -= size
-		if _, err := m.DepositFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+var xxx_messageInfo_MsgCancelUnbondingResponse proto.InternalMessageInfo
+
+type MsgSetCValue struct {
+	// authority is the address of the governance account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// host chain to set the c value for
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// new c value, it needs to be within the host chain c value limits
+	CValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=c_value,json=cValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"c_value"`
+	// reason for the manual update, recorded in the c value history
+	Justification string `protobuf:"bytes,4,opt,name=justification,proto3" json:"justification,omitempty"`
+	// governance proposal executing the message
+	ProposalId uint64 `protobuf:"varint,5,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgSetCValue) Reset()         { *m = MsgSetCValue{} }
+func (m *MsgSetCValue) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCValue) ProtoMessage()    {}
+func (*MsgSetCValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{23}
+}
+func (m *MsgSetCValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetCValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCValue.Merge(m, src)
+}
+func (m *MsgSetCValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCValue.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterHostChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetCValue proto.InternalMessageInfo
+
+func (m *MsgSetCValue) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRegisterHostChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetCValue) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (m *MsgRegisterHostChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetCValue) GetJustification() string {
+	if m != nil {
+		return m.Justification
+	}
+	return ""
 }
 
-func (m *MsgUpdateHostChain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetCValue) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateHostChain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetCValueResponse struct {
 }
 
-func (m *MsgUpdateHostChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Updates) > 0 {
-		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMsgs(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgSetCValueResponse) Reset()         { *m = MsgSetCValueResponse{} }
+func (m *MsgSetCValueResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCValueResponse) ProtoMessage()    {}
+func (*MsgSetCValueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{24}
+}
+func (m *MsgSetCValueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCValueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCValueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetCValueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCValueResponse.Merge(m, src)
+}
+func (m *MsgSetCValueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCValueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCValueResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateHostChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetCValueResponse proto.InternalMessageInfo
+
+type MsgRetryTransfer struct {
+	// any account can retry a deposit transfer
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// host chain of the deposit
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// epoch of the pending deposit to send again
+	EpochNumber int64 `protobuf:"varint,3,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+}
+
+func (m *MsgRetryTransfer) Reset()         { *m = MsgRetryTransfer{} }
+func (m *MsgRetryTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryTransfer) ProtoMessage()    {}
+func (*MsgRetryTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{25}
+}
+func (m *MsgRetryTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRetryTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryTransfer.Merge(m, src)
+}
+func (m *MsgRetryTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryTransfer.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateHostChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRetryTransfer proto.InternalMessageInfo
+
+func (m *MsgRetryTransfer) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func (m *MsgUpdateHostChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRetryTransfer) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (m *MsgLiquidStake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRetryTransfer) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgLiquidStake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRetryTransferResponse struct {
+	// sequence id of the new deposit transfer, empty for localhost host chains
+	IbcSequenceId string `protobuf:"bytes,1,opt,name=ibc_sequence_id,json=ibcSequenceId,proto3" json:"ibc_sequence_id,omitempty"`
 }
 
-func (m *MsgLiquidStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+func (m *MsgRetryTransferResponse) Reset()         { *m = MsgRetryTransferResponse{} }
+func (m *MsgRetryTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryTransferResponse) ProtoMessage()    {}
+func (*MsgRetryTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{26}
+}
+func (m *MsgRetryTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgLiquidStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRetryTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryTransferResponse.Merge(m, src)
 }
-
-func (m *MsgLiquidStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRetryTransferResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgLiquidStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRetryTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryTransferResponse.DiscardUnknown(m)
 }
 
-func (m *MsgLiquidStakeLSM) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRetryTransferResponse proto.InternalMessageInfo
+
+func (m *MsgRetryTransferResponse) GetIbcSequenceId() string {
+	if m != nil {
+		return m.IbcSequenceId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgLiquidStakeLSM) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgCancelParamChange struct {
+	// authority is the address of the governance account or the module admin
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// host chain of the staged update
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// key of the staged host chain update
+	Key string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (m *MsgLiquidStakeLSM) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Delegations) > 0 {
-		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMsgs(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgCancelParamChange) Reset()         { *m = MsgCancelParamChange{} }
+func (m *MsgCancelParamChange) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelParamChange) ProtoMessage()    {}
+func (*MsgCancelParamChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{27}
+}
+func (m *MsgCancelParamChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelParamChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelParamChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgLiquidStakeLSMResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCancelParamChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelParamChange.Merge(m, src)
+}
+func (m *MsgCancelParamChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelParamChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelParamChange.DiscardUnknown(m)
 }
 
-func (m *MsgLiquidStakeLSMResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelParamChange proto.InternalMessageInfo
+
+func (m *MsgCancelParamChange) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgLiquidStakeLSMResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCancelParamChange) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (m *MsgLiquidUnstake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelParamChange) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgLiquidUnstake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgCancelParamChangeResponse struct {
 }
 
-func (m *MsgLiquidUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgCancelParamChangeResponse) Reset()         { *m = MsgCancelParamChangeResponse{} }
+func (m *MsgCancelParamChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelParamChangeResponse) ProtoMessage()    {}
+func (*MsgCancelParamChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{28}
+}
+func (m *MsgCancelParamChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelParamChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelParamChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgLiquidUnstakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCancelParamChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelParamChangeResponse.Merge(m, src)
 }
-
-func (m *MsgLiquidUnstakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelParamChangeResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgLiquidUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCancelParamChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelParamChangeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRedeem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgCancelParamChangeResponse proto.InternalMessageInfo
 
-func (m *MsgRedeem) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgPauseHostChain struct {
+	// authority is the address of the governance account or the emergency admin
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// host chain to pause
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// reason for the pause, recorded in the pause event
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *MsgRedeem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgPauseHostChain) Reset()         { *m = MsgPauseHostChain{} }
+func (m *MsgPauseHostChain) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseHostChain) ProtoMessage()    {}
+func (*MsgPauseHostChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{29}
+}
+func (m *MsgPauseHostChain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseHostChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseHostChain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgPauseHostChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseHostChain.Merge(m, src)
+}
+func (m *MsgPauseHostChain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseHostChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseHostChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseHostChain proto.InternalMessageInfo
+
+func (m *MsgPauseHostChain) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRedeemResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPauseHostChain) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRedeemResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgPauseHostChain) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *MsgRedeemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgPauseHostChainResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPauseHostChainResponse) Reset()         { *m = MsgPauseHostChainResponse{} }
+func (m *MsgPauseHostChainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseHostChainResponse) ProtoMessage()    {}
+func (*MsgPauseHostChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{30}
+}
+func (m *MsgPauseHostChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseHostChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseHostChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgPauseHostChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseHostChainResponse.Merge(m, src)
+}
+func (m *MsgPauseHostChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseHostChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseHostChainResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgPauseHostChainResponse proto.InternalMessageInfo
+
+type MsgResumeHostChain struct {
+	// authority is the address of the governance account or the emergency admin
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// host chain to resume
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgResumeHostChain) Reset()         { *m = MsgResumeHostChain{} }
+func (m *MsgResumeHostChain) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeHostChain) ProtoMessage()    {}
+func (*MsgResumeHostChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{31}
+}
+func (m *MsgResumeHostChain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeHostChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeHostChain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgResumeHostChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeHostChain.Merge(m, src)
+}
+func (m *MsgResumeHostChain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeHostChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeHostChain.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgResumeHostChain proto.InternalMessageInfo
+
+func (m *MsgResumeHostChain) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgResumeHostChain) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgResumeHostChainResponse struct {
 }
 
-func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsgs(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgResumeHostChainResponse) Reset()         { *m = MsgResumeHostChainResponse{} }
+func (m *MsgResumeHostChainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeHostChainResponse) ProtoMessage()    {}
+func (*MsgResumeHostChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{32}
 }
-func (m *MsgRegisterHostChain) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = m.DepositFee.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = m.RestakeFee.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = m.UnstakeFee.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = m.RedemptionFee.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.HostDenom)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = m.MinimumDeposit.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	if m.UnbondingFactor != 0 {
-		n += 1 + sovMsgs(uint64(m.UnbondingFactor))
-	}
-	if m.AutoCompoundFactor != 0 {
-		n += 1 + sovMsgs(uint64(m.AutoCompoundFactor))
+func (m *MsgResumeHostChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeHostChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeHostChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgResumeHostChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeHostChainResponse.Merge(m, src)
+}
+func (m *MsgResumeHostChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeHostChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeHostChainResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterHostChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgResumeHostChainResponse proto.InternalMessageInfo
+
+type MsgForceReconcileDelegations struct {
+	// authority is the address of the governance account or the validator set
+	// admin
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// host chain whose delegations are reconciled
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *MsgUpdateHostChain) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	if len(m.Updates) > 0 {
-		for _, e := range m.Updates {
-			l = e.Size()
-			n += 1 + l + sovMsgs(uint64(l))
+func (m *MsgForceReconcileDelegations) Reset()         { *m = MsgForceReconcileDelegations{} }
+func (m *MsgForceReconcileDelegations) String() string { return proto.CompactTextString(m) }
+func (*MsgForceReconcileDelegations) ProtoMessage()    {}
+func (*MsgForceReconcileDelegations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{33}
+}
+func (m *MsgForceReconcileDelegations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceReconcileDelegations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceReconcileDelegations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgForceReconcileDelegations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceReconcileDelegations.Merge(m, src)
+}
+func (m *MsgForceReconcileDelegations) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceReconcileDelegations) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceReconcileDelegations.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateHostChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgForceReconcileDelegations proto.InternalMessageInfo
+
+func (m *MsgForceReconcileDelegations) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgLiquidStake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+func (m *MsgForceReconcileDelegations) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	return n
+	return ""
 }
 
-func (m *MsgLiquidStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type MsgForceReconcileDelegationsResponse struct {
 }
 
-func (m *MsgLiquidStakeLSM) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	if len(m.Delegations) > 0 {
-		for _, e := range m.Delegations {
-			l = e.Size()
-			n += 1 + l + sovMsgs(uint64(l))
+func (m *MsgForceReconcileDelegationsResponse) Reset()         { *m = MsgForceReconcileDelegationsResponse{} }
+func (m *MsgForceReconcileDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgForceReconcileDelegationsResponse) ProtoMessage()    {}
+func (*MsgForceReconcileDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{34}
+}
+func (m *MsgForceReconcileDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceReconcileDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceReconcileDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgForceReconcileDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceReconcileDelegationsResponse.Merge(m, src)
+}
+func (m *MsgForceReconcileDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceReconcileDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceReconcileDelegationsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgLiquidStakeLSMResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgForceReconcileDelegationsResponse proto.InternalMessageInfo
+
+type MsgSetFeeAddress struct {
+	// authority is the address of the governance account or the fee admin
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// new module fee address
+	FeeAddress string `protobuf:"bytes,2,opt,name=fee_address,json=feeAddress,proto3" json:"fee_address,omitempty"`
+	// send the accrued fees not sent yet to the old fee address instead of the
+	// new one
+	FlushToOldAddress bool `protobuf:"varint,3,opt,name=flush_to_old_address,json=flushToOldAddress,proto3" json:"flush_to_old_address,omitempty"`
 }
 
-func (m *MsgLiquidUnstake) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetFeeAddress) Reset()         { *m = MsgSetFeeAddress{} }
+func (m *MsgSetFeeAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeAddress) ProtoMessage()    {}
+func (*MsgSetFeeAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{35}
+}
+func (m *MsgSetFeeAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+}
+func (m *MsgSetFeeAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeAddress.Merge(m, src)
+}
+func (m *MsgSetFeeAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeAddress proto.InternalMessageInfo
+
+func (m *MsgSetFeeAddress) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	return n
+	return ""
 }
 
-func (m *MsgLiquidUnstakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetFeeAddress) GetFeeAddress() string {
+	if m != nil {
+		return m.FeeAddress
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgRedeem) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetFeeAddress) GetFlushToOldAddress() bool {
+	if m != nil {
+		return m.FlushToOldAddress
 	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return false
+}
+
+type MsgSetFeeAddressResponse struct {
+	// accrued fees sent along with the fee address change
+	FlushedFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=flushed_fees,json=flushedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"flushed_fees"`
+}
+
+func (m *MsgSetFeeAddressResponse) Reset()         { *m = MsgSetFeeAddressResponse{} }
+func (m *MsgSetFeeAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeAddressResponse) ProtoMessage()    {}
+func (*MsgSetFeeAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{36}
+}
+func (m *MsgSetFeeAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeAddressResponse.Merge(m, src)
+}
+func (m *MsgSetFeeAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeAddressResponse proto.InternalMessageInfo
+
+func (m *MsgSetFeeAddressResponse) GetFlushedFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FlushedFees
+	}
+	return nil
+}
+
+type MsgExitValidator struct {
+	// authority is the address of the governance account or the validator set
+	// admin
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// host chain of the validator
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// operator address of the validator to exit
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *MsgExitValidator) Reset()         { *m = MsgExitValidator{} }
+func (m *MsgExitValidator) String() string { return proto.CompactTextString(m) }
+func (*MsgExitValidator) ProtoMessage()    {}
+func (*MsgExitValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{37}
+}
+func (m *MsgExitValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExitValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExitValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExitValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExitValidator.Merge(m, src)
+}
+func (m *MsgExitValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExitValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExitValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExitValidator proto.InternalMessageInfo
+
+func (m *MsgExitValidator) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgExitValidator) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *MsgExitValidator) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+type MsgExitValidatorResponse struct {
+	// amount undelegated from the validator
+	UnbondingAmount types.Coin `protobuf:"bytes,1,opt,name=unbonding_amount,json=unbondingAmount,proto3" json:"unbonding_amount"`
+}
+
+func (m *MsgExitValidatorResponse) Reset()         { *m = MsgExitValidatorResponse{} }
+func (m *MsgExitValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExitValidatorResponse) ProtoMessage()    {}
+func (*MsgExitValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{38}
+}
+func (m *MsgExitValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExitValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExitValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExitValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExitValidatorResponse.Merge(m, src)
+}
+func (m *MsgExitValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExitValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExitValidatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExitValidatorResponse proto.InternalMessageInfo
+
+func (m *MsgExitValidatorResponse) GetUnbondingAmount() types.Coin {
+	if m != nil {
+		return m.UnbondingAmount
+	}
+	return types.Coin{}
+}
+
+type MsgSetAutoClaim struct {
+	// owner of the unbonding position
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// unbonding target chain
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// epoch when the unbonding started
+	EpochNumber int64 `protobuf:"varint,3,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// whether the unbonding is paid out as soon as it is claimable
+	AutoClaim bool `protobuf:"varint,4,opt,name=auto_claim,json=autoClaim,proto3" json:"auto_claim,omitempty"`
+}
+
+func (m *MsgSetAutoClaim) Reset()         { *m = MsgSetAutoClaim{} }
+func (m *MsgSetAutoClaim) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoClaim) ProtoMessage()    {}
+func (*MsgSetAutoClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{39}
+}
+func (m *MsgSetAutoClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoClaim.Merge(m, src)
+}
+func (m *MsgSetAutoClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoClaim proto.InternalMessageInfo
+
+func (m *MsgSetAutoClaim) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *MsgSetAutoClaim) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *MsgSetAutoClaim) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *MsgSetAutoClaim) GetAutoClaim() bool {
+	if m != nil {
+		return m.AutoClaim
+	}
+	return false
+}
+
+type MsgSetAutoClaimResponse struct {
+}
+
+func (m *MsgSetAutoClaimResponse) Reset()         { *m = MsgSetAutoClaimResponse{} }
+func (m *MsgSetAutoClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoClaimResponse) ProtoMessage()    {}
+func (*MsgSetAutoClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{40}
+}
+func (m *MsgSetAutoClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoClaimResponse.Merge(m, src)
+}
+func (m *MsgSetAutoClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoClaimResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoClaimResponse proto.InternalMessageInfo
+
+type MsgClaim struct {
+	// owner of the unbonding position
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// unbonding target chain
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// epoch when the unbonding started
+	EpochNumber int64 `protobuf:"varint,3,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+}
+
+func (m *MsgClaim) Reset()         { *m = MsgClaim{} }
+func (m *MsgClaim) String() string { return proto.CompactTextString(m) }
+func (*MsgClaim) ProtoMessage()    {}
+func (*MsgClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{41}
+}
+func (m *MsgClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaim.Merge(m, src)
+}
+func (m *MsgClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaim proto.InternalMessageInfo
+
+func (m *MsgClaim) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *MsgClaim) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *MsgClaim) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+type MsgClaimResponse struct {
+	// amount paid out, host tokens for a matured unbonding or the stk tokens of
+	// a failed one
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgClaimResponse) Reset()         { *m = MsgClaimResponse{} }
+func (m *MsgClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimResponse) ProtoMessage()    {}
+func (*MsgClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{42}
+}
+func (m *MsgClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimResponse.Merge(m, src)
+}
+func (m *MsgClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimResponse proto.InternalMessageInfo
+
+func (m *MsgClaimResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*MsgRegisterHostChain)(nil), "pstake.liquidstakeibc.v1beta1.MsgRegisterHostChain")
+	proto.RegisterType((*MsgRegisterHostChainResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgRegisterHostChainResponse")
+	proto.RegisterType((*MsgUpdateHostChain)(nil), "pstake.liquidstakeibc.v1beta1.MsgUpdateHostChain")
+	proto.RegisterType((*MsgUpdateHostChainResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgUpdateHostChainResponse")
+	proto.RegisterType((*MsgLiquidStake)(nil), "pstake.liquidstakeibc.v1beta1.MsgLiquidStake")
+	proto.RegisterType((*FeeCharged)(nil), "pstake.liquidstakeibc.v1beta1.FeeCharged")
+	proto.RegisterType((*MsgLiquidStakeResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgLiquidStakeResponse")
+	proto.RegisterType((*MsgLiquidStakeLSM)(nil), "pstake.liquidstakeibc.v1beta1.MsgLiquidStakeLSM")
+	proto.RegisterType((*MsgLiquidStakeLSMResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgLiquidStakeLSMResponse")
+	proto.RegisterType((*MsgLiquidUnstake)(nil), "pstake.liquidstakeibc.v1beta1.MsgLiquidUnstake")
+	proto.RegisterType((*MsgLiquidUnstakeResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgLiquidUnstakeResponse")
+	proto.RegisterType((*MsgRedeem)(nil), "pstake.liquidstakeibc.v1beta1.MsgRedeem")
+	proto.RegisterType((*MsgRedeemResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgRedeemResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "pstake.liquidstakeibc.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgTransferUnbonding)(nil), "pstake.liquidstakeibc.v1beta1.MsgTransferUnbonding")
+	proto.RegisterType((*MsgTransferUnbondingResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgTransferUnbondingResponse")
+	proto.RegisterType((*MsgCancelValidatorExit)(nil), "pstake.liquidstakeibc.v1beta1.MsgCancelValidatorExit")
+	proto.RegisterType((*MsgCancelValidatorExitResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgCancelValidatorExitResponse")
+	proto.RegisterType((*MsgMigrateHostChainChannel)(nil), "pstake.liquidstakeibc.v1beta1.MsgMigrateHostChainChannel")
+	proto.RegisterType((*MsgMigrateHostChainChannelResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgMigrateHostChainChannelResponse")
+	proto.RegisterType((*MsgCancelUnbonding)(nil), "pstake.liquidstakeibc.v1beta1.MsgCancelUnbonding")
+	proto.RegisterType((*MsgCancelUnbondingResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgCancelUnbondingResponse")
+	proto.RegisterType((*MsgSetCValue)(nil), "pstake.liquidstakeibc.v1beta1.MsgSetCValue")
+	proto.RegisterType((*MsgSetCValueResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgSetCValueResponse")
+	proto.RegisterType((*MsgRetryTransfer)(nil), "pstake.liquidstakeibc.v1beta1.MsgRetryTransfer")
+	proto.RegisterType((*MsgRetryTransferResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgRetryTransferResponse")
+	proto.RegisterType((*MsgCancelParamChange)(nil), "pstake.liquidstakeibc.v1beta1.MsgCancelParamChange")
+	proto.RegisterType((*MsgCancelParamChangeResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgCancelParamChangeResponse")
+	proto.RegisterType((*MsgPauseHostChain)(nil), "pstake.liquidstakeibc.v1beta1.MsgPauseHostChain")
+	proto.RegisterType((*MsgPauseHostChainResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgPauseHostChainResponse")
+	proto.RegisterType((*MsgResumeHostChain)(nil), "pstake.liquidstakeibc.v1beta1.MsgResumeHostChain")
+	proto.RegisterType((*MsgResumeHostChainResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgResumeHostChainResponse")
+	proto.RegisterType((*MsgForceReconcileDelegations)(nil), "pstake.liquidstakeibc.v1beta1.MsgForceReconcileDelegations")
+	proto.RegisterType((*MsgForceReconcileDelegationsResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgForceReconcileDelegationsResponse")
+	proto.RegisterType((*MsgSetFeeAddress)(nil), "pstake.liquidstakeibc.v1beta1.MsgSetFeeAddress")
+	proto.RegisterType((*MsgSetFeeAddressResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgSetFeeAddressResponse")
+	proto.RegisterType((*MsgExitValidator)(nil), "pstake.liquidstakeibc.v1beta1.MsgExitValidator")
+	proto.RegisterType((*MsgExitValidatorResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgExitValidatorResponse")
+	proto.RegisterType((*MsgSetAutoClaim)(nil), "pstake.liquidstakeibc.v1beta1.MsgSetAutoClaim")
+	proto.RegisterType((*MsgSetAutoClaimResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgSetAutoClaimResponse")
+	proto.RegisterType((*MsgClaim)(nil), "pstake.liquidstakeibc.v1beta1.MsgClaim")
+	proto.RegisterType((*MsgClaimResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgClaimResponse")
+}
+
+func init() {
+	proto.RegisterFile("pstake/liquidstakeibc/v1beta1/msgs.proto", fileDescriptor_dce3cdc829e5c7d3)
+}
+
+var fileDescriptor_dce3cdc829e5c7d3 = []byte{
+	// 2345 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xdf, 0x6f, 0x1b, 0xc7,
+	0xf1, 0xd7, 0x89, 0xb6, 0x64, 0x8d, 0x7e, 0x9f, 0xf5, 0x95, 0xa8, 0x8b, 0x2d, 0x29, 0x17, 0xc7,
+	0x56, 0x64, 0x8b, 0xd4, 0x0f, 0x47, 0xb6, 0xe9, 0x6f, 0x1f, 0x2c, 0xda, 0x82, 0xd5, 0x58, 0x75,
+	0x40, 0xd9, 0x7e, 0x68, 0x51, 0xb0, 0xc7, 0xbb, 0x15, 0x75, 0x15, 0xb9, 0xcb, 0xdc, 0xde, 0x29,
+	0x11, 0x50, 0xa0, 0x40, 0x80, 0x02, 0x45, 0xfa, 0xd0, 0x02, 0x41, 0x51, 0xa0, 0x40, 0x81, 0xbc,
+	0xa5, 0x6d, 0x1e, 0x62, 0xa0, 0x46, 0x5a, 0xa0, 0x40, 0xfb, 0x56, 0xa4, 0x7d, 0xa9, 0x91, 0xbe,
+	0x14, 0x79, 0x48, 0x0b, 0xbb, 0x85, 0xf3, 0x67, 0x14, 0xbb, 0xb7, 0xdc, 0xfb, 0x41, 0x91, 0x3c,
+	0xca, 0x14, 0x9c, 0xbc, 0x24, 0xbc, 0xd9, 0x9d, 0xd9, 0xcf, 0xcc, 0xce, 0xcc, 0xce, 0x8c, 0x0c,
+	0xf3, 0x35, 0xea, 0x1a, 0x7b, 0x28, 0x5b, 0xb1, 0xdf, 0xf2, 0x6c, 0x8b, 0xff, 0xb6, 0x4b, 0x66,
+	0x76, 0x7f, 0xb9, 0x84, 0x5c, 0x63, 0x39, 0x5b, 0xa5, 0x65, 0x9a, 0xa9, 0x39, 0xc4, 0x25, 0xea,
+	0x59, 0x7f, 0x67, 0x26, 0xba, 0x33, 0x23, 0x76, 0x6a, 0x67, 0xca, 0x84, 0x94, 0x2b, 0x28, 0x6b,
+	0xd4, 0xec, 0xac, 0x81, 0x31, 0x71, 0x0d, 0xd7, 0x26, 0x58, 0x30, 0x6b, 0xd3, 0x26, 0xa1, 0x55,
+	0x42, 0x8b, 0xfc, 0x2b, 0xeb, 0x7f, 0x88, 0xa5, 0x89, 0x32, 0x29, 0x13, 0x9f, 0xce, 0x7e, 0x09,
+	0xea, 0x94, 0xbf, 0x87, 0x01, 0xc8, 0xee, 0x73, 0x1c, 0x62, 0x61, 0x46, 0x2c, 0x94, 0x0c, 0x8a,
+	0x24, 0x4c, 0x93, 0xd8, 0x58, 0xac, 0x8f, 0x1b, 0x55, 0x1b, 0x93, 0x2c, 0xff, 0xaf, 0x20, 0xad,
+	0xb4, 0xd6, 0x31, 0xa6, 0x90, 0xcf, 0xb3, 0xd0, 0x9a, 0xa7, 0x66, 0x38, 0x46, 0x55, 0x68, 0xa0,
+	0x7f, 0xde, 0x0f, 0x13, 0x5b, 0xb4, 0x5c, 0x40, 0x65, 0x9b, 0xba, 0xc8, 0xb9, 0x4d, 0xa8, 0x9b,
+	0xdf, 0x35, 0x6c, 0xac, 0xae, 0xc1, 0x80, 0xe1, 0xb9, 0xbb, 0xc4, 0xb1, 0xdd, 0x83, 0xb4, 0x32,
+	0xa7, 0xcc, 0x0f, 0xac, 0xa7, 0x3f, 0x7b, 0xb4, 0x38, 0x21, 0xf4, 0xbf, 0x61, 0x59, 0x0e, 0xa2,
+	0x74, 0xdb, 0x75, 0x6c, 0x5c, 0x2e, 0x04, 0x5b, 0xd5, 0x57, 0x60, 0xd8, 0x24, 0x18, 0x23, 0x93,
+	0x99, 0xb0, 0x68, 0x5b, 0xe9, 0x5e, 0xc6, 0x5b, 0x18, 0x0a, 0x88, 0x9b, 0x96, 0xfa, 0x5d, 0x18,
+	0xb4, 0x50, 0x8d, 0x50, 0xdb, 0x2d, 0xee, 0x20, 0x94, 0x4e, 0x71, 0xf1, 0xff, 0xff, 0xe9, 0x17,
+	0xb3, 0x3d, 0x9f, 0x7f, 0x31, 0x7b, 0xbe, 0x6c, 0xbb, 0xbb, 0x5e, 0x29, 0x63, 0x92, 0xaa, 0xb0,
+	0xb6, 0xf8, 0xdf, 0x22, 0xb5, 0xf6, 0xb2, 0xee, 0x41, 0x0d, 0xd1, 0xcc, 0x4d, 0x64, 0x7e, 0xf6,
+	0x68, 0x11, 0x04, 0x98, 0x9b, 0xc8, 0x2c, 0x80, 0x10, 0xb8, 0x81, 0x10, 0x13, 0xef, 0x20, 0xae,
+	0x37, 0x17, 0x7f, 0xa2, 0x1b, 0xe2, 0x85, 0x40, 0x21, 0xde, 0xc3, 0x81, 0xf8, 0x93, 0xdd, 0x10,
+	0xef, 0x61, 0x29, 0xde, 0x84, 0x11, 0x07, 0x59, 0xa8, 0x5a, 0xe3, 0x16, 0x64, 0x27, 0xf4, 0x75,
+	0xe1, 0x84, 0xe1, 0x40, 0x26, 0x3b, 0xe4, 0x2c, 0x80, 0xb9, 0x6b, 0x60, 0x8c, 0x2a, 0xec, 0x8e,
+	0xfa, 0xf9, 0x1d, 0x0d, 0x08, 0xca, 0xa6, 0xa5, 0x4e, 0x41, 0x7f, 0x8d, 0x38, 0x2e, 0x5b, 0x3b,
+	0xc5, 0xd7, 0xfa, 0xd8, 0xe7, 0xa6, 0xc5, 0xf8, 0x76, 0x09, 0x75, 0x8b, 0x16, 0xc2, 0xa4, 0x9a,
+	0x1e, 0xf0, 0xf9, 0x18, 0xe5, 0x26, 0x23, 0xa8, 0x08, 0x46, 0xab, 0x36, 0xb6, 0xab, 0x5e, 0xb5,
+	0x28, 0xee, 0x23, 0x0d, 0x1d, 0x83, 0xdf, 0xc4, 0x6e, 0x08, 0xfc, 0x26, 0x76, 0x0b, 0x23, 0x42,
+	0xe8, 0x4d, 0x5f, 0xa6, 0xfa, 0x1a, 0x8c, 0x79, 0xb8, 0x44, 0xb0, 0x65, 0xe3, 0x72, 0x71, 0xc7,
+	0x30, 0x5d, 0xe2, 0xa4, 0x07, 0xe7, 0x94, 0xf9, 0x54, 0x61, 0x54, 0xd2, 0x37, 0x38, 0x59, 0x5d,
+	0x82, 0x09, 0xc3, 0x73, 0x49, 0xd1, 0x24, 0xd5, 0x1a, 0xf1, 0xb0, 0x55, 0xdf, 0x3e, 0xc4, 0xb7,
+	0xab, 0x6c, 0x2d, 0x2f, 0x96, 0x04, 0xc7, 0x32, 0x4c, 0x94, 0x08, 0x71, 0xa9, 0xeb, 0x18, 0xb5,
+	0xe2, 0xbe, 0x51, 0xb1, 0x2d, 0xc3, 0x25, 0x0e, 0x4d, 0x0f, 0xcf, 0x29, 0xf3, 0xc3, 0x85, 0xd3,
+	0x72, 0xed, 0x81, 0x5c, 0x62, 0x1e, 0x41, 0x11, 0xb2, 0x8a, 0x46, 0x95, 0x78, 0xd8, 0x4d, 0x8f,
+	0x74, 0x41, 0x65, 0x60, 0x02, 0x6f, 0x70, 0x79, 0xb9, 0xb5, 0x1f, 0x7f, 0x30, 0xdb, 0xf3, 0xe5,
+	0x07, 0xb3, 0x3d, 0xef, 0x3e, 0x7b, 0xb8, 0x10, 0xc4, 0xda, 0x7b, 0xcf, 0x1e, 0x2e, 0xbc, 0x24,
+	0x62, 0xfd, 0xb0, 0x18, 0xd6, 0x67, 0xe0, 0xcc, 0x61, 0xf4, 0x02, 0xa2, 0x35, 0x82, 0x29, 0xd2,
+	0x9f, 0x29, 0xa0, 0x6e, 0xd1, 0xf2, 0xfd, 0x9a, 0x65, 0xb8, 0xe8, 0xf9, 0x43, 0x7f, 0x1a, 0x4e,
+	0x99, 0x4c, 0x40, 0x10, 0xf5, 0xfd, 0xfc, 0x7b, 0xd3, 0x52, 0x6f, 0x43, 0xbf, 0xc7, 0x4f, 0xa1,
+	0xe9, 0xd4, 0x5c, 0x6a, 0x7e, 0x70, 0xe5, 0x42, 0xa6, 0x65, 0x4a, 0xce, 0xbc, 0xf1, 0xc0, 0x47,
+	0xb5, 0x7e, 0xf2, 0xd7, 0xcf, 0x1e, 0x2e, 0x28, 0x85, 0x3a, 0x7b, 0xee, 0x72, 0x73, 0x5b, 0x4c,
+	0x07, 0xb6, 0x88, 0xa9, 0xa4, 0x9f, 0x01, 0xad, 0x91, 0x2a, 0xed, 0xf0, 0xdf, 0x5e, 0x18, 0xd9,
+	0xa2, 0xe5, 0x3b, 0x1c, 0xca, 0x36, 0x93, 0xa1, 0xde, 0x82, 0x71, 0x0b, 0x55, 0x50, 0x99, 0xdd,
+	0x6f, 0xd1, 0xf0, 0x35, 0x6e, 0x6b, 0x8b, 0x31, 0xc9, 0x22, 0xe8, 0xea, 0x15, 0xe8, 0x13, 0x3e,
+	0xc1, 0x0c, 0x32, 0xb8, 0x32, 0x9d, 0x11, 0x8c, 0xec, 0x09, 0x90, 0xca, 0xe6, 0x89, 0x8d, 0xd7,
+	0x4f, 0x30, 0x77, 0x29, 0x88, 0xed, 0xaa, 0x0d, 0x6a, 0xd5, 0xc6, 0x45, 0xea, 0xee, 0x09, 0xa7,
+	0x2a, 0x12, 0xcf, 0x4d, 0xa7, 0xba, 0xe0, 0x58, 0x2c, 0x40, 0xb7, 0xdd, 0x3d, 0xdf, 0xb5, 0xee,
+	0x7a, 0x2e, 0xbb, 0x6e, 0x07, 0x99, 0x76, 0xcd, 0x46, 0xd8, 0x4d, 0x9f, 0x68, 0xa3, 0x62, 0xb0,
+	0x35, 0xb7, 0xc4, 0x6e, 0xa0, 0xd1, 0x4a, 0xec, 0x26, 0xfe, 0x2f, 0xb8, 0x89, 0x90, 0x51, 0xf5,
+	0xef, 0x01, 0x6c, 0x20, 0x94, 0xdf, 0x35, 0x9c, 0x32, 0xb2, 0x98, 0xbb, 0xec, 0x20, 0x54, 0x64,
+	0x38, 0x7d, 0xcb, 0x16, 0xfa, 0x77, 0x10, 0xba, 0x77, 0x50, 0x43, 0x47, 0x36, 0x9b, 0xfe, 0x48,
+	0x81, 0xc9, 0xe8, 0xa1, 0xf5, 0x4b, 0x56, 0xf3, 0x70, 0x62, 0x07, 0x21, 0x76, 0x89, 0xcc, 0xff,
+	0x5e, 0x6b, 0xe3, 0x7f, 0x01, 0x4e, 0x71, 0x02, 0x67, 0x56, 0xef, 0x43, 0xbf, 0xc9, 0x72, 0x82,
+	0x87, 0xd2, 0xbd, 0x1d, 0xdf, 0x45, 0x63, 0x52, 0xee, 0x33, 0x1f, 0x30, 0x59, 0xfa, 0x27, 0xbd,
+	0x30, 0x1e, 0x85, 0x7d, 0x67, 0x7b, 0xab, 0x5b, 0x3e, 0x58, 0x85, 0x41, 0x41, 0xb3, 0x09, 0xa6,
+	0xe9, 0xde, 0xb9, 0x54, 0x6b, 0x8b, 0x2e, 0x31, 0x95, 0x7e, 0xfb, 0xaf, 0xd9, 0xf9, 0x04, 0x2a,
+	0x31, 0x06, 0x5a, 0x08, 0xcb, 0x8f, 0xba, 0x53, 0x2a, 0xb9, 0x3b, 0xad, 0x36, 0x77, 0xa7, 0xf4,
+	0xa1, 0xee, 0x74, 0x67, 0x7b, 0x4b, 0x7f, 0x09, 0xa6, 0x1b, 0x88, 0x32, 0xac, 0xff, 0xda, 0x0b,
+	0x63, 0x72, 0xf5, 0xbe, 0xff, 0xc0, 0xbe, 0xf0, 0xc0, 0x2e, 0x01, 0x7b, 0xcc, 0x8a, 0x2e, 0xd9,
+	0x43, 0x98, 0x76, 0x2d, 0xa8, 0x87, 0xaa, 0x36, 0xbe, 0xc7, 0x45, 0xb2, 0x88, 0x3e, 0x0b, 0xe0,
+	0xbf, 0x79, 0x15, 0xc3, 0xae, 0xf2, 0x90, 0x3e, 0xc5, 0xf3, 0x34, 0xc9, 0x33, 0x42, 0x6e, 0xa5,
+	0xb9, 0xa5, 0xa7, 0xe2, 0x96, 0x16, 0x66, 0xd3, 0x3f, 0x51, 0x20, 0x1d, 0x27, 0x7e, 0x2d, 0x42,
+	0xeb, 0x0f, 0x0a, 0x0c, 0xf0, 0x47, 0xd0, 0x42, 0xa8, 0xfa, 0xa2, 0x6f, 0x3f, 0x77, 0xb1, 0xb9,
+	0xe9, 0xc7, 0xc2, 0x2f, 0x39, 0x03, 0xab, 0x7f, 0xac, 0xc0, 0xb8, 0xfc, 0xfa, 0x5a, 0x18, 0xfb,
+	0x2f, 0x0a, 0x8c, 0xca, 0x77, 0xf6, 0x4d, 0xde, 0x67, 0x1c, 0xb9, 0x9a, 0xb8, 0x0d, 0x7d, 0x7e,
+	0xa7, 0x22, 0x6c, 0xfc, 0x6a, 0x1b, 0x4d, 0xfd, 0xe3, 0xd6, 0x07, 0x98, 0x22, 0x7e, 0xcd, 0x20,
+	0xf8, 0x73, 0xcb, 0xcd, 0x4b, 0x86, 0xc9, 0x78, 0xc9, 0xe0, 0x4b, 0xd1, 0xa7, 0x61, 0x2a, 0x46,
+	0x92, 0x59, 0xe5, 0x97, 0xbd, 0xbc, 0x63, 0xba, 0xe7, 0x18, 0x98, 0xee, 0x20, 0xe7, 0x7e, 0xbd,
+	0xde, 0xec, 0x96, 0x6f, 0xdd, 0x82, 0x71, 0x99, 0x14, 0xa5, 0x98, 0xde, 0x76, 0x62, 0x24, 0x4b,
+	0x5d, 0x4c, 0xb8, 0x18, 0x4b, 0x45, 0x8b, 0xb1, 0x97, 0x61, 0x08, 0xd5, 0x88, 0xb9, 0x5b, 0xc4,
+	0x5e, 0xb5, 0x84, 0x1c, 0x9e, 0x20, 0x52, 0x85, 0x41, 0x4e, 0xfb, 0x16, 0x27, 0xe5, 0xd6, 0x9a,
+	0xfb, 0x69, 0xa8, 0xe2, 0x6c, 0xb0, 0x81, 0xa8, 0x38, 0x1b, 0xe8, 0xd2, 0x78, 0x7f, 0xf3, 0xdf,
+	0xe7, 0xbc, 0x81, 0x4d, 0x54, 0x91, 0x05, 0xf4, 0xad, 0x77, 0x6c, 0xf7, 0x38, 0xaa, 0xce, 0x8b,
+	0x30, 0x2e, 0xeb, 0x77, 0x69, 0x4a, 0xdf, 0x18, 0x63, 0x72, 0x41, 0x08, 0xf6, 0xcb, 0x99, 0xa8,
+	0x77, 0x9c, 0x0d, 0x54, 0x3d, 0x04, 0xb1, 0x3e, 0x07, 0x33, 0x87, 0xaf, 0x48, 0x75, 0x9f, 0x2a,
+	0xbc, 0xee, 0xdc, 0xb2, 0xcb, 0x4e, 0xb8, 0xf0, 0xcc, 0xfb, 0x7d, 0xd6, 0x71, 0xa8, 0x7c, 0x0e,
+	0x46, 0x30, 0x7a, 0xbb, 0x18, 0xea, 0xed, 0x7c, 0x7d, 0x87, 0x30, 0x7a, 0x3b, 0x2f, 0xdb, 0xbb,
+	0x49, 0xe8, 0x33, 0x39, 0x6c, 0xf1, 0x38, 0x88, 0xaf, 0xdc, 0xe5, 0x46, 0x1b, 0xbc, 0x1c, 0xd8,
+	0xa0, 0x89, 0x1a, 0xfa, 0x39, 0xd0, 0x9b, 0xaf, 0x4a, 0x5b, 0xfc, 0xdd, 0x6f, 0x36, 0x7c, 0x73,
+	0x75, 0x3d, 0x6a, 0x5a, 0x98, 0x24, 0xee, 0xee, 0xa9, 0x46, 0x77, 0xbf, 0xdc, 0xdc, 0xdd, 0xa7,
+	0xe3, 0x3e, 0x10, 0x38, 0xbb, 0xdf, 0x54, 0xc4, 0xa8, 0x52, 0xdf, 0x0f, 0x7b, 0x61, 0x68, 0x8b,
+	0x96, 0xb7, 0x91, 0x9b, 0xe7, 0xc9, 0xf1, 0x38, 0x6e, 0x3b, 0x94, 0xc6, 0x53, 0xdd, 0x4b, 0xe3,
+	0xea, 0x39, 0x18, 0xfe, 0xbe, 0x47, 0x5d, 0x7b, 0xc7, 0x36, 0x79, 0x51, 0xe7, 0x77, 0x05, 0x85,
+	0x28, 0x51, 0x9d, 0x85, 0xc1, 0x9a, 0x43, 0x6a, 0x84, 0x1a, 0xdc, 0xcf, 0xd8, 0x18, 0xe4, 0x44,
+	0x01, 0xea, 0xa4, 0x4d, 0x2b, 0x77, 0xbe, 0xd1, 0x9b, 0x4e, 0x07, 0xd6, 0x94, 0x86, 0xd1, 0x27,
+	0x61, 0x22, 0xfc, 0x2d, 0x2d, 0xf8, 0x91, 0xc2, 0xeb, 0xb7, 0x02, 0x72, 0x9d, 0x83, 0x7a, 0x4a,
+	0x51, 0x97, 0xa0, 0x8f, 0xda, 0x65, 0x8c, 0x9c, 0xb6, 0x26, 0x14, 0xfb, 0x9e, 0xd3, 0x35, 0x2e,
+	0x30, 0x25, 0x84, 0xa8, 0x58, 0x85, 0x14, 0x01, 0xa6, 0xaf, 0x43, 0x3a, 0x4e, 0x93, 0x6f, 0xf6,
+	0x79, 0x18, 0xb5, 0x4b, 0x66, 0x91, 0xa2, 0xb7, 0x3c, 0x84, 0x4d, 0xc4, 0x90, 0xf8, 0x1d, 0xcf,
+	0xb0, 0x5d, 0x32, 0xb7, 0x05, 0x75, 0xd3, 0x62, 0x1a, 0x4f, 0x48, 0x97, 0xe2, 0xef, 0x0e, 0x8b,
+	0xa2, 0xf2, 0xb1, 0xf8, 0xce, 0x18, 0xa4, 0xf6, 0xd0, 0x81, 0x48, 0x0f, 0xec, 0x67, 0x2e, 0xd3,
+	0x72, 0xbc, 0xd0, 0x00, 0x4a, 0x24, 0xfb, 0x06, 0x7a, 0xf8, 0xfe, 0x58, 0xfd, 0xf2, 0xa6, 0xe1,
+	0xd1, 0xe3, 0x9d, 0x2e, 0x4c, 0x42, 0x9f, 0x83, 0x0c, 0x4a, 0xb0, 0xd0, 0x46, 0x7c, 0xf9, 0xd5,
+	0x56, 0x54, 0xa1, 0x50, 0x2b, 0x11, 0xc5, 0x25, 0x5a, 0x89, 0x28, 0x51, 0xaa, 0xf2, 0x73, 0x3f,
+	0x79, 0x15, 0x10, 0xf5, 0xaa, 0xc7, 0xaa, 0x4b, 0xee, 0x52, 0xcb, 0xb9, 0x46, 0x0c, 0x80, 0x48,
+	0x41, 0x31, 0xaa, 0x44, 0xfd, 0x1b, 0x85, 0xdf, 0xd0, 0x06, 0x71, 0x4c, 0x54, 0x40, 0x26, 0xc1,
+	0xa6, 0x5d, 0x41, 0x37, 0xa3, 0xbd, 0x5a, 0xb7, 0xf1, 0xaf, 0x35, 0xe2, 0x7f, 0x25, 0xc0, 0xdf,
+	0x14, 0x8a, 0x7e, 0x1e, 0xce, 0xb5, 0x5a, 0x97, 0x3a, 0x3d, 0xf1, 0x93, 0xc2, 0x36, 0x62, 0x93,
+	0xde, 0x7a, 0xf6, 0x3f, 0xaa, 0x1e, 0xd7, 0x60, 0x90, 0x8d, 0x20, 0x92, 0x56, 0x59, 0xb0, 0x13,
+	0x1c, 0x99, 0x85, 0x89, 0x9d, 0x8a, 0x47, 0x77, 0x8b, 0x2e, 0x29, 0x92, 0x8a, 0x15, 0x29, 0x2f,
+	0x4e, 0x15, 0xc6, 0xf9, 0xda, 0x3d, 0x72, 0xb7, 0x62, 0xd5, 0xeb, 0x8b, 0x85, 0x46, 0xc3, 0x4c,
+	0x45, 0xb2, 0x61, 0xa0, 0x8f, 0xfe, 0x9e, 0xdf, 0x6d, 0x45, 0x88, 0x32, 0x99, 0x60, 0x18, 0xe2,
+	0xd2, 0x91, 0x55, 0x0c, 0x35, 0x02, 0xdd, 0x6d, 0xe8, 0xc5, 0x01, 0x1b, 0x08, 0x51, 0xfd, 0x4f,
+	0xbe, 0xc5, 0x59, 0x61, 0x23, 0xab, 0x9c, 0x17, 0x5e, 0xad, 0xb5, 0xb6, 0x66, 0x04, 0xab, 0xbe,
+	0x03, 0xe9, 0x38, 0x4d, 0x1a, 0xf3, 0x9b, 0xe1, 0x49, 0xb2, 0xe8, 0xe9, 0x94, 0x64, 0x3d, 0x5d,
+	0x30, 0x6a, 0xf6, 0x67, 0x69, 0xfa, 0x97, 0x7e, 0xf7, 0xb3, 0x8d, 0xdc, 0x1b, 0xf5, 0x5e, 0xfb,
+	0x2b, 0x51, 0xde, 0xb4, 0x9b, 0x07, 0x2c, 0x37, 0xaf, 0x7e, 0x26, 0x23, 0x1e, 0x2a, 0xd5, 0x12,
+	0xfd, 0x51, 0x98, 0x24, 0x03, 0xf4, 0x8f, 0x0a, 0x9c, 0x62, 0xcf, 0xc2, 0x57, 0x46, 0x7d, 0xdf,
+	0x57, 0x0e, 0xd7, 0x6f, 0x34, 0xf4, 0xbe, 0x71, 0xc5, 0xde, 0x80, 0xb1, 0xfa, 0x6f, 0xe9, 0x23,
+	0x41, 0xb7, 0xaf, 0x74, 0xd4, 0xed, 0xaf, 0x3c, 0x4e, 0x43, 0x6a, 0x8b, 0x96, 0xd5, 0x1f, 0x29,
+	0x30, 0xde, 0xf8, 0x17, 0xb6, 0xd5, 0x36, 0x0d, 0xed, 0x61, 0xa3, 0x7b, 0xed, 0xfa, 0x11, 0x98,
+	0xa4, 0x22, 0x3f, 0x84, 0xd1, 0xf8, 0xac, 0x7f, 0xb9, 0xbd, 0xbc, 0x18, 0x8b, 0x76, 0xad, 0x63,
+	0x16, 0x09, 0xe0, 0x43, 0x05, 0x06, 0xc3, 0x53, 0xf6, 0xc5, 0xf6, 0xa2, 0x42, 0xdb, 0xb5, 0xd7,
+	0x3b, 0xda, 0x2e, 0x3d, 0x72, 0xe5, 0xdd, 0x7f, 0xfc, 0xe7, 0xfd, 0xde, 0x4b, 0xfa, 0x42, 0xb6,
+	0xf5, 0x1f, 0x46, 0xc3, 0xc8, 0x7e, 0xa7, 0xc0, 0x48, 0x6c, 0x1c, 0xbb, 0xd4, 0xd1, 0xe9, 0x77,
+	0xb6, 0xb7, 0xb4, 0xab, 0x9d, 0x72, 0x48, 0xc8, 0xaf, 0x73, 0xc8, 0x59, 0x7d, 0x31, 0x39, 0x64,
+	0x06, 0xf1, 0x63, 0x05, 0x86, 0xa3, 0xe3, 0xce, 0x6c, 0x52, 0x08, 0x82, 0x41, 0xbb, 0xd2, 0x21,
+	0x83, 0x84, 0x7c, 0x99, 0x43, 0xce, 0xe8, 0x97, 0x12, 0x41, 0xae, 0xe3, 0x7b, 0x5f, 0x81, 0x3e,
+	0x31, 0x9b, 0x9b, 0x4f, 0xe2, 0xda, 0x6c, 0xa7, 0xb6, 0x94, 0x74, 0xa7, 0x04, 0xb7, 0xc8, 0xc1,
+	0x5d, 0xd0, 0x5f, 0x6d, 0x03, 0x4e, 0x40, 0xd9, 0x87, 0xa1, 0xc8, 0x0c, 0x2b, 0x93, 0xd4, 0xe5,
+	0xfd, 0xfd, 0xda, 0x5a, 0x67, 0xfb, 0x65, 0x7c, 0xfc, 0x59, 0x81, 0xf1, 0xc6, 0xc1, 0x52, 0x82,
+	0x44, 0xd1, 0xc0, 0xa4, 0x5d, 0x3f, 0x02, 0x93, 0x34, 0xd7, 0x55, 0x6e, 0xae, 0x15, 0x7d, 0xa9,
+	0x8d, 0xb9, 0x1a, 0xb1, 0xfe, 0x44, 0x81, 0xd3, 0x87, 0x4d, 0x77, 0x12, 0x84, 0xee, 0x21, 0x6c,
+	0xda, 0x37, 0x8e, 0xc4, 0x26, 0xed, 0xf9, 0x0b, 0x05, 0xa6, 0x9a, 0x0d, 0x5f, 0x12, 0xa4, 0xb1,
+	0x26, 0xac, 0xda, 0x8d, 0x23, 0xb3, 0x4a, 0x64, 0xbf, 0x57, 0x60, 0x34, 0x3e, 0x0a, 0x59, 0x4e,
+	0xaa, 0x6c, 0x70, 0xcb, 0xd7, 0x3a, 0x66, 0x91, 0x77, 0xbc, 0xc6, 0xef, 0x78, 0x49, 0xcf, 0xb4,
+	0xb9, 0xe3, 0x38, 0xca, 0x2a, 0x0c, 0x04, 0x33, 0x8d, 0x8b, 0xed, 0xcf, 0x97, 0x9b, 0xb5, 0xd5,
+	0x0e, 0x36, 0x4b, 0x43, 0xb1, 0xb7, 0xb3, 0xb1, 0x1f, 0x5e, 0x4d, 0xaa, 0x77, 0x88, 0x49, 0xbb,
+	0x7e, 0x04, 0x26, 0x89, 0x83, 0xa5, 0xd6, 0xe8, 0x24, 0x22, 0x9b, 0x24, 0x0b, 0x85, 0x18, 0xb4,
+	0x2b, 0x1d, 0x32, 0x74, 0x9c, 0x5a, 0xa3, 0xf8, 0x7e, 0x00, 0x23, 0xb1, 0xd6, 0x3b, 0x41, 0xde,
+	0x8c, 0x72, 0x68, 0x57, 0x3b, 0xe5, 0x08, 0xd7, 0x1a, 0xf1, 0x6e, 0x79, 0x39, 0x89, 0xfe, 0x11,
+	0x16, 0xed, 0x5a, 0xc7, 0x2c, 0x12, 0xc0, 0xaf, 0x14, 0x98, 0x6e, 0xde, 0xf9, 0x26, 0xf0, 0x85,
+	0xa6, 0xcc, 0x5a, 0xfe, 0x39, 0x98, 0x25, 0xbe, 0x03, 0x18, 0x8e, 0x36, 0xb1, 0xd9, 0x44, 0xe1,
+	0x11, 0x30, 0x68, 0x57, 0x3a, 0x64, 0x08, 0x1f, 0x1d, 0xed, 0xe6, 0x12, 0x1c, 0x1d, 0x61, 0xd0,
+	0xae, 0x74, 0xc8, 0x20, 0x8f, 0xfe, 0x48, 0x81, 0xa1, 0x48, 0x83, 0x94, 0x49, 0xa4, 0x84, 0xdc,
+	0xaf, 0xad, 0x75, 0xb6, 0x5f, 0xc6, 0xd0, 0x2a, 0x8f, 0xa1, 0x45, 0xfd, 0x62, 0x9b, 0x18, 0x8a,
+	0x80, 0xfb, 0xa9, 0x02, 0x27, 0xfd, 0x5f, 0x17, 0x12, 0xe4, 0x0e, 0x8e, 0x2f, 0x9b, 0x70, 0xa3,
+	0x04, 0x76, 0x89, 0x03, 0x3b, 0xaf, 0x9f, 0x6b, 0x97, 0x87, 0x19, 0xd7, 0xfa, 0x77, 0x3e, 0x7d,
+	0x32, 0xa3, 0x3c, 0x7e, 0x32, 0xa3, 0xfc, 0xfb, 0xc9, 0x8c, 0xf2, 0xb3, 0xa7, 0x33, 0x3d, 0x8f,
+	0x9f, 0xce, 0xf4, 0xfc, 0xf3, 0xe9, 0x4c, 0xcf, 0xb7, 0x6f, 0x84, 0xba, 0xfb, 0x1a, 0x72, 0xa8,
+	0x4d, 0x5d, 0x84, 0x4d, 0x74, 0x17, 0x23, 0x21, 0x78, 0x11, 0x1b, 0xae, 0xbd, 0x8f, 0xb2, 0xfb,
+	0x2b, 0xd9, 0x77, 0xe2, 0x87, 0xf0, 0xe6, 0xbf, 0xd4, 0xc7, 0xff, 0x4d, 0xe0, 0xea, 0xff, 0x06,
+	0x00, 0x7c, 0xa2, 0xc8, 0x8a, 0x59, 0x29, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	RegisterHostChain(ctx context.Context, in *MsgRegisterHostChain, opts ...grpc.CallOption) (*MsgRegisterHostChainResponse, error)
+	UpdateHostChain(ctx context.Context, in *MsgUpdateHostChain, opts ...grpc.CallOption) (*MsgUpdateHostChainResponse, error)
+	LiquidStake(ctx context.Context, in *MsgLiquidStake, opts ...grpc.CallOption) (*MsgLiquidStakeResponse, error)
+	LiquidStakeLSM(ctx context.Context, in *MsgLiquidStakeLSM, opts ...grpc.CallOption) (*MsgLiquidStakeLSMResponse, error)
+	LiquidUnstake(ctx context.Context, in *MsgLiquidUnstake, opts ...grpc.CallOption) (*MsgLiquidUnstakeResponse, error)
+	Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.CallOption) (*MsgRedeemResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	TransferUnbonding(ctx context.Context, in *MsgTransferUnbonding, opts ...grpc.CallOption) (*MsgTransferUnbondingResponse, error)
+	CancelValidatorExit(ctx context.Context, in *MsgCancelValidatorExit, opts ...grpc.CallOption) (*MsgCancelValidatorExitResponse, error)
+	MigrateHostChainChannel(ctx context.Context, in *MsgMigrateHostChainChannel, opts ...grpc.CallOption) (*MsgMigrateHostChainChannelResponse, error)
+	CancelUnbonding(ctx context.Context, in *MsgCancelUnbonding, opts ...grpc.CallOption) (*MsgCancelUnbondingResponse, error)
+	SetCValue(ctx context.Context, in *MsgSetCValue, opts ...grpc.CallOption) (*MsgSetCValueResponse, error)
+	CancelParamChange(ctx context.Context, in *MsgCancelParamChange, opts ...grpc.CallOption) (*MsgCancelParamChangeResponse, error)
+	RetryTransfer(ctx context.Context, in *MsgRetryTransfer, opts ...grpc.CallOption) (*MsgRetryTransferResponse, error)
+	PauseHostChain(ctx context.Context, in *MsgPauseHostChain, opts ...grpc.CallOption) (*MsgPauseHostChainResponse, error)
+	ResumeHostChain(ctx context.Context, in *MsgResumeHostChain, opts ...grpc.CallOption) (*MsgResumeHostChainResponse, error)
+	ForceReconcileDelegations(ctx context.Context, in *MsgForceReconcileDelegations, opts ...grpc.CallOption) (*MsgForceReconcileDelegationsResponse, error)
+	SetFeeAddress(ctx context.Context, in *MsgSetFeeAddress, opts ...grpc.CallOption) (*MsgSetFeeAddressResponse, error)
+	ExitValidator(ctx context.Context, in *MsgExitValidator, opts ...grpc.CallOption) (*MsgExitValidatorResponse, error)
+	SetAutoClaim(ctx context.Context, in *MsgSetAutoClaim, opts ...grpc.CallOption) (*MsgSetAutoClaimResponse, error)
+	Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOption) (*MsgClaimResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterHostChain(ctx context.Context, in *MsgRegisterHostChain, opts ...grpc.CallOption) (*MsgRegisterHostChainResponse, error) {
+	out := new(MsgRegisterHostChainResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/RegisterHostChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateHostChain(ctx context.Context, in *MsgUpdateHostChain, opts ...grpc.CallOption) (*MsgUpdateHostChainResponse, error) {
+	out := new(MsgUpdateHostChainResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/UpdateHostChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LiquidStake(ctx context.Context, in *MsgLiquidStake, opts ...grpc.CallOption) (*MsgLiquidStakeResponse, error) {
+	out := new(MsgLiquidStakeResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/LiquidStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LiquidStakeLSM(ctx context.Context, in *MsgLiquidStakeLSM, opts ...grpc.CallOption) (*MsgLiquidStakeLSMResponse, error) {
+	out := new(MsgLiquidStakeLSMResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/LiquidStakeLSM", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LiquidUnstake(ctx context.Context, in *MsgLiquidUnstake, opts ...grpc.CallOption) (*MsgLiquidUnstakeResponse, error) {
+	out := new(MsgLiquidUnstakeResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/LiquidUnstake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.CallOption) (*MsgRedeemResponse, error) {
+	out := new(MsgRedeemResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/Redeem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferUnbonding(ctx context.Context, in *MsgTransferUnbonding, opts ...grpc.CallOption) (*MsgTransferUnbondingResponse, error) {
+	out := new(MsgTransferUnbondingResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/TransferUnbonding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelValidatorExit(ctx context.Context, in *MsgCancelValidatorExit, opts ...grpc.CallOption) (*MsgCancelValidatorExitResponse, error) {
+	out := new(MsgCancelValidatorExitResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/CancelValidatorExit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateHostChainChannel(ctx context.Context, in *MsgMigrateHostChainChannel, opts ...grpc.CallOption) (*MsgMigrateHostChainChannelResponse, error) {
+	out := new(MsgMigrateHostChainChannelResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/MigrateHostChainChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelUnbonding(ctx context.Context, in *MsgCancelUnbonding, opts ...grpc.CallOption) (*MsgCancelUnbondingResponse, error) {
+	out := new(MsgCancelUnbondingResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/CancelUnbonding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetCValue(ctx context.Context, in *MsgSetCValue, opts ...grpc.CallOption) (*MsgSetCValueResponse, error) {
+	out := new(MsgSetCValueResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/SetCValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelParamChange(ctx context.Context, in *MsgCancelParamChange, opts ...grpc.CallOption) (*MsgCancelParamChangeResponse, error) {
+	out := new(MsgCancelParamChangeResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/CancelParamChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RetryTransfer(ctx context.Context, in *MsgRetryTransfer, opts ...grpc.CallOption) (*MsgRetryTransferResponse, error) {
+	out := new(MsgRetryTransferResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/RetryTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseHostChain(ctx context.Context, in *MsgPauseHostChain, opts ...grpc.CallOption) (*MsgPauseHostChainResponse, error) {
+	out := new(MsgPauseHostChainResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/PauseHostChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeHostChain(ctx context.Context, in *MsgResumeHostChain, opts ...grpc.CallOption) (*MsgResumeHostChainResponse, error) {
+	out := new(MsgResumeHostChainResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/ResumeHostChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForceReconcileDelegations(ctx context.Context, in *MsgForceReconcileDelegations, opts ...grpc.CallOption) (*MsgForceReconcileDelegationsResponse, error) {
+	out := new(MsgForceReconcileDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/ForceReconcileDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeeAddress(ctx context.Context, in *MsgSetFeeAddress, opts ...grpc.CallOption) (*MsgSetFeeAddressResponse, error) {
+	out := new(MsgSetFeeAddressResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/SetFeeAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExitValidator(ctx context.Context, in *MsgExitValidator, opts ...grpc.CallOption) (*MsgExitValidatorResponse, error) {
+	out := new(MsgExitValidatorResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/ExitValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoClaim(ctx context.Context, in *MsgSetAutoClaim, opts ...grpc.CallOption) (*MsgSetAutoClaimResponse, error) {
+	out := new(MsgSetAutoClaimResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/SetAutoClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOption) (*MsgClaimResponse, error) {
+	out := new(MsgClaimResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/Claim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	RegisterHostChain(context.Context, *MsgRegisterHostChain) (*MsgRegisterHostChainResponse, error)
+	UpdateHostChain(context.Context, *MsgUpdateHostChain) (*MsgUpdateHostChainResponse, error)
+	LiquidStake(context.Context, *MsgLiquidStake) (*MsgLiquidStakeResponse, error)
+	LiquidStakeLSM(context.Context, *MsgLiquidStakeLSM) (*MsgLiquidStakeLSMResponse, error)
+	LiquidUnstake(context.Context, *MsgLiquidUnstake) (*MsgLiquidUnstakeResponse, error)
+	Redeem(context.Context, *MsgRedeem) (*MsgRedeemResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	TransferUnbonding(context.Context, *MsgTransferUnbonding) (*MsgTransferUnbondingResponse, error)
+	CancelValidatorExit(context.Context, *MsgCancelValidatorExit) (*MsgCancelValidatorExitResponse, error)
+	MigrateHostChainChannel(context.Context, *MsgMigrateHostChainChannel) (*MsgMigrateHostChainChannelResponse, error)
+	CancelUnbonding(context.Context, *MsgCancelUnbonding) (*MsgCancelUnbondingResponse, error)
+	SetCValue(context.Context, *MsgSetCValue) (*MsgSetCValueResponse, error)
+	CancelParamChange(context.Context, *MsgCancelParamChange) (*MsgCancelParamChangeResponse, error)
+	RetryTransfer(context.Context, *MsgRetryTransfer) (*MsgRetryTransferResponse, error)
+	PauseHostChain(context.Context, *MsgPauseHostChain) (*MsgPauseHostChainResponse, error)
+	ResumeHostChain(context.Context, *MsgResumeHostChain) (*MsgResumeHostChainResponse, error)
+	ForceReconcileDelegations(context.Context, *MsgForceReconcileDelegations) (*MsgForceReconcileDelegationsResponse, error)
+	SetFeeAddress(context.Context, *MsgSetFeeAddress) (*MsgSetFeeAddressResponse, error)
+	ExitValidator(context.Context, *MsgExitValidator) (*MsgExitValidatorResponse, error)
+	SetAutoClaim(context.Context, *MsgSetAutoClaim) (*MsgSetAutoClaimResponse, error)
+	Claim(context.Context, *MsgClaim) (*MsgClaimResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RegisterHostChain(ctx context.Context, req *MsgRegisterHostChain) (*MsgRegisterHostChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterHostChain not implemented")
+}
+func (*UnimplementedMsgServer) UpdateHostChain(ctx context.Context, req *MsgUpdateHostChain) (*MsgUpdateHostChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHostChain not implemented")
+}
+func (*UnimplementedMsgServer) LiquidStake(ctx context.Context, req *MsgLiquidStake) (*MsgLiquidStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidStake not implemented")
+}
+func (*UnimplementedMsgServer) LiquidStakeLSM(ctx context.Context, req *MsgLiquidStakeLSM) (*MsgLiquidStakeLSMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidStakeLSM not implemented")
+}
+func (*UnimplementedMsgServer) LiquidUnstake(ctx context.Context, req *MsgLiquidUnstake) (*MsgLiquidUnstakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidUnstake not implemented")
+}
+func (*UnimplementedMsgServer) Redeem(ctx context.Context, req *MsgRedeem) (*MsgRedeemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redeem not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) TransferUnbonding(ctx context.Context, req *MsgTransferUnbonding) (*MsgTransferUnbondingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferUnbonding not implemented")
+}
+func (*UnimplementedMsgServer) CancelValidatorExit(ctx context.Context, req *MsgCancelValidatorExit) (*MsgCancelValidatorExitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelValidatorExit not implemented")
+}
+func (*UnimplementedMsgServer) MigrateHostChainChannel(ctx context.Context, req *MsgMigrateHostChainChannel) (*MsgMigrateHostChainChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateHostChainChannel not implemented")
+}
+func (*UnimplementedMsgServer) CancelUnbonding(ctx context.Context, req *MsgCancelUnbonding) (*MsgCancelUnbondingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUnbonding not implemented")
+}
+func (*UnimplementedMsgServer) SetCValue(ctx context.Context, req *MsgSetCValue) (*MsgSetCValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCValue not implemented")
+}
+func (*UnimplementedMsgServer) CancelParamChange(ctx context.Context, req *MsgCancelParamChange) (*MsgCancelParamChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelParamChange not implemented")
+}
+func (*UnimplementedMsgServer) RetryTransfer(ctx context.Context, req *MsgRetryTransfer) (*MsgRetryTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryTransfer not implemented")
+}
+func (*UnimplementedMsgServer) PauseHostChain(ctx context.Context, req *MsgPauseHostChain) (*MsgPauseHostChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseHostChain not implemented")
+}
+func (*UnimplementedMsgServer) ResumeHostChain(ctx context.Context, req *MsgResumeHostChain) (*MsgResumeHostChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeHostChain not implemented")
+}
+func (*UnimplementedMsgServer) ForceReconcileDelegations(ctx context.Context, req *MsgForceReconcileDelegations) (*MsgForceReconcileDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceReconcileDelegations not implemented")
+}
+func (*UnimplementedMsgServer) SetFeeAddress(ctx context.Context, req *MsgSetFeeAddress) (*MsgSetFeeAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeAddress not implemented")
+}
+func (*UnimplementedMsgServer) ExitValidator(ctx context.Context, req *MsgExitValidator) (*MsgExitValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExitValidator not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoClaim(ctx context.Context, req *MsgSetAutoClaim) (*MsgSetAutoClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoClaim not implemented")
+}
+func (*UnimplementedMsgServer) Claim(ctx context.Context, req *MsgClaim) (*MsgClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Claim not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RegisterHostChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterHostChain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterHostChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/RegisterHostChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterHostChain(ctx, req.(*MsgRegisterHostChain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateHostChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateHostChain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateHostChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/UpdateHostChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateHostChain(ctx, req.(*MsgUpdateHostChain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LiquidStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidStake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LiquidStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/LiquidStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiquidStake(ctx, req.(*MsgLiquidStake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LiquidStakeLSM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidStakeLSM)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LiquidStakeLSM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/LiquidStakeLSM",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiquidStakeLSM(ctx, req.(*MsgLiquidStakeLSM))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LiquidUnstake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidUnstake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LiquidUnstake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/LiquidUnstake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiquidUnstake(ctx, req.(*MsgLiquidUnstake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Redeem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Redeem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/Redeem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Redeem(ctx, req.(*MsgRedeem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferUnbonding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferUnbonding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferUnbonding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/TransferUnbonding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferUnbonding(ctx, req.(*MsgTransferUnbonding))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelValidatorExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelValidatorExit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelValidatorExit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/CancelValidatorExit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelValidatorExit(ctx, req.(*MsgCancelValidatorExit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateHostChainChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateHostChainChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateHostChainChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/MigrateHostChainChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateHostChainChannel(ctx, req.(*MsgMigrateHostChainChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelUnbonding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelUnbonding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelUnbonding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/CancelUnbonding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelUnbonding(ctx, req.(*MsgCancelUnbonding))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/SetCValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCValue(ctx, req.(*MsgSetCValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelParamChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelParamChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelParamChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/CancelParamChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelParamChange(ctx, req.(*MsgCancelParamChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetryTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/RetryTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryTransfer(ctx, req.(*MsgRetryTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseHostChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseHostChain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseHostChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/PauseHostChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseHostChain(ctx, req.(*MsgPauseHostChain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeHostChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeHostChain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeHostChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/ResumeHostChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeHostChain(ctx, req.(*MsgResumeHostChain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForceReconcileDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceReconcileDelegations)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceReconcileDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/ForceReconcileDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceReconcileDelegations(ctx, req.(*MsgForceReconcileDelegations))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/SetFeeAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeAddress(ctx, req.(*MsgSetFeeAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExitValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExitValidator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExitValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/ExitValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExitValidator(ctx, req.(*MsgExitValidator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/SetAutoClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoClaim(ctx, req.(*MsgSetAutoClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Claim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Claim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/Claim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Claim(ctx, req.(*MsgClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pstake.liquidstakeibc.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterHostChain",
+			Handler:    _Msg_RegisterHostChain_Handler,
+		},
+		{
+			MethodName: "UpdateHostChain",
+			Handler:    _Msg_UpdateHostChain_Handler,
+		},
+		{
+			MethodName: "LiquidStake",
+			Handler:    _Msg_LiquidStake_Handler,
+		},
+		{
+			MethodName: "LiquidStakeLSM",
+			Handler:    _Msg_LiquidStakeLSM_Handler,
+		},
+		{
+			MethodName: "LiquidUnstake",
+			Handler:    _Msg_LiquidUnstake_Handler,
+		},
+		{
+			MethodName: "Redeem",
+			Handler:    _Msg_Redeem_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "TransferUnbonding",
+			Handler:    _Msg_TransferUnbonding_Handler,
+		},
+		{
+			MethodName: "CancelValidatorExit",
+			Handler:    _Msg_CancelValidatorExit_Handler,
+		},
+		{
+			MethodName: "MigrateHostChainChannel",
+			Handler:    _Msg_MigrateHostChainChannel_Handler,
+		},
+		{
+			MethodName: "CancelUnbonding",
+			Handler:    _Msg_CancelUnbonding_Handler,
+		},
+		{
+			MethodName: "SetCValue",
+			Handler:    _Msg_SetCValue_Handler,
+		},
+		{
+			MethodName: "CancelParamChange",
+			Handler:    _Msg_CancelParamChange_Handler,
+		},
+		{
+			MethodName: "RetryTransfer",
+			Handler:    _Msg_RetryTransfer_Handler,
+		},
+		{
+			MethodName: "PauseHostChain",
+			Handler:    _Msg_PauseHostChain_Handler,
+		},
+		{
+			MethodName: "ResumeHostChain",
+			Handler:    _Msg_ResumeHostChain_Handler,
+		},
+		{
+			MethodName: "ForceReconcileDelegations",
+			Handler:    _Msg_ForceReconcileDelegations_Handler,
+		},
+		{
+			MethodName: "SetFeeAddress",
+			Handler:    _Msg_SetFeeAddress_Handler,
+		},
+		{
+			MethodName: "ExitValidator",
+			Handler:    _Msg_ExitValidator_Handler,
+		},
+		{
+			MethodName: "SetAutoClaim",
+			Handler:    _Msg_SetAutoClaim_Handler,
+		},
+		{
+			MethodName: "Claim",
+			Handler:    _Msg_Claim_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pstake/liquidstakeibc/v1beta1/msgs.proto",
+}
+
+func (m *MsgRegisterHostChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterHostChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterHostChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SeedAmount.Size()
+		i -= size
+		if _, err := m.SeedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.BootstrapValidators != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.BootstrapValidators))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.AutoCompoundFactor != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.AutoCompoundFactor))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.UnbondingFactor != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.UnbondingFactor))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.MinimumDeposit.Size()
+		i -= size
+		if _, err := m.MinimumDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.HostDenom) > 0 {
+		i -= len(m.HostDenom)
+		copy(dAtA[i:], m.HostDenom)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.HostDenom)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.RedemptionFee.Size()
+		i -= size
+		if _, err := m.RedemptionFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.UnstakeFee.Size()
+		i -= size
+		if _, err := m.UnstakeFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RestakeFee.Size()
+		i -= size
+		if _, err := m.RestakeFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.DepositFee.Size()
+		i -= size
+		if _, err := m.DepositFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterHostChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterHostChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterHostChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateHostChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateHostChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateHostChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateHostChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateHostChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateHostChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.MinStkAmountOut.Size()
+		i -= size
+		if _, err := m.MinStkAmountOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeCharged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeCharged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeCharged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.FeeType) > 0 {
+		i -= len(m.FeeType)
+		copy(dAtA[i:], m.FeeType)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.FeeType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CValue.Size()
+		i -= size
+		if _, err := m.CValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidStakeLSM) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidStakeLSM) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidStakeLSM) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidStakeLSMResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidStakeLSMResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidStakeLSMResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidUnstake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidUnstake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoClaim {
+		i--
+		if m.AutoClaim {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MinTokensOut.Size()
+		i -= size
+		if _, err := m.MinTokensOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidUnstakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidUnstakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CValue.Size()
+		i -= size
+		if _, err := m.CValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CValue.Size()
+		i -= size
+		if _, err := m.CValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferUnbondingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferUnbondingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferUnbondingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelValidatorExit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelValidatorExit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelValidatorExit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelValidatorExitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelValidatorExitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelValidatorExitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateHostChainChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateHostChainChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateHostChainChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cancel {
+		i--
+		if m.Cancel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewChannelId) > 0 {
+		i -= len(m.NewChannelId)
+		copy(dAtA[i:], m.NewChannelId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.NewChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateHostChainChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateHostChainChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateHostChainChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUnbondingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUnbondingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUnbondingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Justification) > 0 {
+		i -= len(m.Justification)
+		copy(dAtA[i:], m.Justification)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Justification)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.CValue.Size()
+		i -= size
+		if _, err := m.CValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCValueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCValueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IbcSequenceId) > 0 {
+		i -= len(m.IbcSequenceId)
+		copy(dAtA[i:], m.IbcSequenceId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.IbcSequenceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelParamChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelParamChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelParamChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelParamChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelParamChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelParamChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseHostChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseHostChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseHostChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseHostChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseHostChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseHostChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeHostChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeHostChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeHostChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeHostChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeHostChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeHostChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceReconcileDelegations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceReconcileDelegations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceReconcileDelegations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceReconcileDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceReconcileDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceReconcileDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FlushToOldAddress {
+		i--
+		if m.FlushToOldAddress {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FeeAddress) > 0 {
+		i -= len(m.FeeAddress)
+		copy(dAtA[i:], m.FeeAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.FeeAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FlushedFees) > 0 {
+		for iNdEx := len(m.FlushedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlushedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExitValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExitValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExitValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExitValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExitValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExitValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UnbondingAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoClaim {
+		i--
+		if m.AutoClaim {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterHostChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.DepositFee.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = m.RestakeFee.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = m.UnstakeFee.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = m.RedemptionFee.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.HostDenom)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.MinimumDeposit.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	if m.UnbondingFactor != 0 {
+		n += 1 + sovMsgs(uint64(m.UnbondingFactor))
+	}
+	if m.AutoCompoundFactor != 0 {
+		n += 1 + sovMsgs(uint64(m.AutoCompoundFactor))
+	}
+	if m.BootstrapValidators != 0 {
+		n += 1 + sovMsgs(uint64(m.BootstrapValidators))
+	}
+	l = m.SeedAmount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterHostChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateHostChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateHostChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLiquidStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = m.MinStkAmountOut.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeCharged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeeType)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgLiquidStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = m.CValue.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgLiquidStakeLSM) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLiquidStakeLSMResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLiquidUnstake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = m.MinTokensOut.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	if m.AutoClaim {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgLiquidUnstakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = m.CValue.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgRedeem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = m.CValue.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovMsgs(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *MsgTransferUnbondingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelValidatorExit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelValidatorExitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateHostChainChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.NewChannelId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Cancel {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgMigrateHostChainChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovMsgs(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *MsgCancelUnbondingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetCValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.CValue.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = len(m.Justification)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovMsgs(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgSetCValueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRetryTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovMsgs(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *MsgRetryTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IbcSequenceId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelParamChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelParamChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPauseHostChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPauseHostChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResumeHostChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResumeHostChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgForceReconcileDelegations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgForceReconcileDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFeeAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.FeeAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.FlushToOldAddress {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetFeeAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FlushedFees) > 0 {
+		for _, e := range m.FlushedFees {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExitValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExitValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UnbondingAmount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgSetAutoClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovMsgs(uint64(m.EpochNumber))
+	}
+	if m.AutoClaim {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovMsgs(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *MsgClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func sovMsgs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgs(x uint64) (n int) {
+	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterHostChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterHostChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakeFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RestakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnstakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinimumDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingFactor", wireType)
+			}
+			m.UnbondingFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingFactor |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundFactor", wireType)
+			}
+			m.AutoCompoundFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundFactor |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BootstrapValidators", wireType)
+			}
+			m.BootstrapValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BootstrapValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SeedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterHostChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterHostChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterHostChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateHostChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateHostChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateHostChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updates = append(m.Updates, &KVUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateHostChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateHostChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateHostChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStkAmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinStkAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeCharged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeCharged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeCharged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, FeeCharged{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidStakeLSM) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidStakeLSM: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidStakeLSM: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, types.Coin{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidStakeLSMResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidStakeLSMResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidStakeLSMResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidUnstake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTokensOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinTokensOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoClaim", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoClaim = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, FeeCharged{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, FeeCharged{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferUnbonding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferUnbonding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferUnbondingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferUnbondingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferUnbondingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelValidatorExit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelValidatorExit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelValidatorExit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelValidatorExitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelValidatorExitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelValidatorExitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateHostChainChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateHostChainChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateHostChainChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancel", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancel = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateHostChainChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateHostChainChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateHostChainChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelUnbonding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelUnbonding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelUnbondingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelUnbondingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelUnbondingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Justification", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Justification = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	return n
-}
 
-func (m *MsgRedeemResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+func (m *MsgSetCValueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCValueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovMsgs(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsgs(x uint64) (n int) {
-	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
+func (m *MsgRetryTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1798,15 +8720,15 @@ func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterHostChain: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRetryTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterHostChain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRetryTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1834,11 +8756,11 @@ func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1866,13 +8788,13 @@ func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositFee", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
 			}
-			var stringLen uint64
+			m.EpochNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -1882,29 +8804,64 @@ func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochNumber |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DepositFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetryTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
 			}
-			iNdEx = postIndex
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetryTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetryTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestakeFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1932,47 +8889,61 @@ func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RestakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeFee", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelParamChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnstakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelParamChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelParamChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2000,13 +8971,11 @@ func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RedemptionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2034,11 +9003,11 @@ func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2066,11 +9035,111 @@ func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelParamChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelParamChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelParamChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseHostChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseHostChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseHostChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2098,11 +9167,11 @@ func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2130,15 +9199,13 @@ func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinimumDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingFactor", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.UnbondingFactor = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -2148,30 +9215,24 @@ func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingFactor |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundFactor", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
 			}
-			m.AutoCompoundFactor = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AutoCompoundFactor |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -2193,7 +9254,7 @@ func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterHostChainResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseHostChainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2216,10 +9277,10 @@ func (m *MsgRegisterHostChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterHostChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseHostChainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterHostChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseHostChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2243,7 +9304,7 @@ func (m *MsgRegisterHostChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateHostChain) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeHostChain) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2266,10 +9327,10 @@ func (m *MsgUpdateHostChain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateHostChain: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeHostChain: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateHostChain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeHostChain: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2336,40 +9397,6 @@ func (m *MsgUpdateHostChain) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Updates = append(m.Updates, &KVUpdate{})
-			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -2391,7 +9418,7 @@ func (m *MsgUpdateHostChain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateHostChainResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeHostChainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2414,10 +9441,10 @@ func (m *MsgUpdateHostChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateHostChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeHostChainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateHostChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeHostChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2441,7 +9468,7 @@ func (m *MsgUpdateHostChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
+func (m *MsgForceReconcileDelegations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2464,15 +9491,15 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceReconcileDelegations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceReconcileDelegations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2500,13 +9527,13 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -2516,24 +9543,23 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2556,7 +9582,7 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgForceReconcileDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2579,10 +9605,10 @@ func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceReconcileDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceReconcileDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2606,7 +9632,7 @@ func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidStakeLSM) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeeAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2629,15 +9655,15 @@ func (m *MsgLiquidStakeLSM) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidStakeLSM: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeeAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidStakeLSM: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeeAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2665,13 +9691,13 @@ func (m *MsgLiquidStakeLSM) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -2681,26 +9707,44 @@ func (m *MsgLiquidStakeLSM) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegations = append(m.Delegations, types.Coin{})
-			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FeeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlushToOldAddress", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.FlushToOldAddress = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -2722,7 +9766,7 @@ func (m *MsgLiquidStakeLSM) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidStakeLSMResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeeAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2745,12 +9789,46 @@ func (m *MsgLiquidStakeLSMResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidStakeLSMResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeeAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidStakeLSMResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeeAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlushedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlushedFees = append(m.FlushedFees, types.Coin{})
+			if err := m.FlushedFees[len(m.FlushedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -2772,7 +9850,7 @@ func (m *MsgLiquidStakeLSMResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
+func (m *MsgExitValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2795,15 +9873,15 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidUnstake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExitValidator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExitValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2831,13 +9909,13 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -2847,24 +9925,55 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2887,7 +9996,7 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExitValidatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2910,12 +10019,45 @@ func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExitValidatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExitValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnbondingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -2937,7 +10079,7 @@ func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2960,10 +10102,10 @@ func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedeem: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedeem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3000,9 +10142,9 @@ func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3012,25 +10154,63 @@ func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoClaim", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoClaim = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -3052,7 +10232,7 @@ func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedeemResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3075,10 +10255,10 @@ func (m *MsgRedeemResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedeemResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedeemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3102,7 +10282,7 @@ func (m *MsgRedeemResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3125,15 +10305,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3161,13 +10341,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3177,25 +10357,43 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -3217,7 +10415,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3240,12 +10438,45 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -40,6 +40,22 @@ func TestMsgLiquidStake(t *testing.T) {
 	zeroCoinMsg := types.NewMsgLiquidStake(sdk.NewCoin(ibcDenom, sdk.ZeroInt()), addr1)
 	require.Error(t, zeroCoinMsg.ValidateBasic())
 
+	minAmountOutMsg := types.NewMsgLiquidStake(amount1, addr1)
+	minAmountOutMsg.MinStkAmountOut = sdk.NewInt(100)
+	require.NoError(t, minAmountOutMsg.ValidateBasic())
+
+	minAmountOutMsg.MinStkAmountOut = sdk.NewInt(-1)
+	require.Error(t, minAmountOutMsg.ValidateBasic())
+
+	recipientMsg := types.NewMsgLiquidStake(amount1, addr1)
+	require.Equal(t, addr1.String(), recipientMsg.StkRecipient())
+	recipientMsg.Recipient = authtypes.NewModuleAddressOrBech32Address("test2").String()
+	require.NoError(t, recipientMsg.ValidateBasic())
+	require.Equal(t, recipientMsg.Recipient, recipientMsg.StkRecipient())
+
+	recipientMsg.Recipient = "recipient"
+	require.Error(t, recipientMsg.ValidateBasic())
+
 	invalidAddrMsg := types.NewMsgLiquidStake(amount1, sdk.AccAddress("test"))
 	require.Error(t, invalidAddrMsg.ValidateBasic())
 	require.Panics(t, func() { invalidAddrMsg.GetSigners() })
@@ -69,6 +85,15 @@ func TestMsgLiquidStakeLSM(t *testing.T) {
 	zeroCoinMsg2 := types.NewMsgLiquidStakeLSM(sdk.NewCoins(sdk.NewCoin(ibcDenom, sdk.ZeroInt())), addr1)
 	require.Error(t, zeroCoinMsg2.ValidateBasic())
 
+	recipientMsg := types.NewMsgLiquidStakeLSM(sdk.NewCoins(sdk.NewCoin(ibcDenom, sdk.NewInt(10000))), addr1)
+	require.Equal(t, addr1.String(), recipientMsg.StkRecipient())
+	recipientMsg.Recipient = authtypes.NewModuleAddressOrBech32Address("test2").String()
+	require.NoError(t, recipientMsg.ValidateBasic())
+	require.Equal(t, recipientMsg.Recipient, recipientMsg.StkRecipient())
+
+	recipientMsg.Recipient = "recipient"
+	require.Error(t, recipientMsg.ValidateBasic())
+
 	invalidAddrMsg := types.NewMsgLiquidStakeLSM(sdk.NewCoins(sdk.NewCoin(ibcDenom, sdk.NewInt(10000))), sdk.AccAddress("test"))
 	require.Error(t, invalidAddrMsg.ValidateBasic())
 	require.Panics(t, func() { invalidAddrMsg.GetSigners() })
@@ -99,6 +124,13 @@ func TestMsgLiquidUnstake(t *testing.T) {
 	invalidDenomMsg := types.NewMsgLiquidUnstake(amount1, addr1)
 	require.Error(t, invalidDenomMsg.ValidateBasic())
 
+	minTokensOutMsg := types.NewMsgLiquidUnstake(stkAmount1, addr1)
+	minTokensOutMsg.MinTokensOut = sdk.NewInt(100)
+	require.NoError(t, minTokensOutMsg.ValidateBasic())
+
+	minTokensOutMsg.MinTokensOut = sdk.NewInt(-1)
+	require.Error(t, minTokensOutMsg.ValidateBasic())
+
 	invalidAddrMsg := types.NewMsgLiquidUnstake(stkAmount1, sdk.AccAddress("test"))
 	require.Error(t, invalidAddrMsg.ValidateBasic())
 	require.Panics(t, func() { invalidAddrMsg.GetSigners() })
@@ -134,6 +166,353 @@ func TestMsgRedeem(t *testing.T) {
 	require.Panics(t, func() { invalidAddrMsg.GetSigners() })
 }
 
+func TestMsgTransferUnbonding(t *testing.T) {
+	addr2 := authtypes.NewModuleAddressOrBech32Address("test2")
+
+	msgTransferUnbonding := &types.MsgTransferUnbonding{
+		DelegatorAddress: addr1.String(),
+		RecipientAddress: addr2.String(),
+		ChainId:          "chain-1",
+		EpochNumber:      10,
+	}
+	newMsgTransferUnbonding := types.NewMsgTransferUnbonding(addr1, addr2, "chain-1", 10)
+	require.Equal(t, msgTransferUnbonding, newMsgTransferUnbonding)
+	require.Equal(t, types.ModuleName, msgTransferUnbonding.Route())
+	require.Equal(t, types.MsgTypeTransferUnbonding, msgTransferUnbonding.Type())
+	require.Equal(t, addr1, msgTransferUnbonding.GetSigners()[0])
+	require.NotPanics(t, func() { msgTransferUnbonding.GetSignBytes() })
+
+	require.Equal(t, nil, msgTransferUnbonding.ValidateBasic())
+
+	sameAddrMsg := types.NewMsgTransferUnbonding(addr1, addr1, "chain-1", 10)
+	require.Error(t, sameAddrMsg.ValidateBasic())
+
+	emptyChainMsg := types.NewMsgTransferUnbonding(addr1, addr2, "", 10)
+	require.Error(t, emptyChainMsg.ValidateBasic())
+
+	negativeEpochMsg := types.NewMsgTransferUnbonding(addr1, addr2, "chain-1", -1)
+	require.Error(t, negativeEpochMsg.ValidateBasic())
+
+	invalidRecipientMsg := types.NewMsgTransferUnbonding(addr1, sdk.AccAddress("test"), "chain-1", 10)
+	require.Error(t, invalidRecipientMsg.ValidateBasic())
+
+	invalidAddrMsg := types.NewMsgTransferUnbonding(sdk.AccAddress("test"), addr2, "chain-1", 10)
+	require.Error(t, invalidAddrMsg.ValidateBasic())
+	require.Panics(t, func() { invalidAddrMsg.GetSigners() })
+}
+
+func TestMsgCancelUnbonding(t *testing.T) {
+	msgCancelUnbonding := &types.MsgCancelUnbonding{
+		DelegatorAddress: addr1.String(),
+		ChainId:          "chain-1",
+		EpochNumber:      10,
+	}
+	newMsgCancelUnbonding := types.NewMsgCancelUnbonding(addr1, "chain-1", 10)
+	require.Equal(t, msgCancelUnbonding, newMsgCancelUnbonding)
+	require.Equal(t, types.ModuleName, msgCancelUnbonding.Route())
+	require.Equal(t, types.MsgTypeCancelUnbonding, msgCancelUnbonding.Type())
+	require.Equal(t, addr1, msgCancelUnbonding.GetSigners()[0])
+	require.NotPanics(t, func() { msgCancelUnbonding.GetSignBytes() })
+
+	require.Equal(t, nil, msgCancelUnbonding.ValidateBasic())
+
+	emptyChainMsg := types.NewMsgCancelUnbonding(addr1, "", 10)
+	require.Error(t, emptyChainMsg.ValidateBasic())
+
+	negativeEpochMsg := types.NewMsgCancelUnbonding(addr1, "chain-1", -1)
+	require.Error(t, negativeEpochMsg.ValidateBasic())
+
+	invalidAddrMsg := types.NewMsgCancelUnbonding(sdk.AccAddress("test"), "chain-1", 10)
+	require.Error(t, invalidAddrMsg.ValidateBasic())
+	require.Panics(t, func() { invalidAddrMsg.GetSigners() })
+}
+
+func TestMsgSetAutoClaim(t *testing.T) {
+	msgSetAutoClaim := &types.MsgSetAutoClaim{
+		DelegatorAddress: addr1.String(),
+		ChainId:          "chain-1",
+		EpochNumber:      10,
+		AutoClaim:        true,
+	}
+	newMsgSetAutoClaim := types.NewMsgSetAutoClaim(addr1, "chain-1", 10, true)
+	require.Equal(t, msgSetAutoClaim, newMsgSetAutoClaim)
+	require.Equal(t, types.ModuleName, msgSetAutoClaim.Route())
+	require.Equal(t, types.MsgTypeSetAutoClaim, msgSetAutoClaim.Type())
+	require.Equal(t, addr1, msgSetAutoClaim.GetSigners()[0])
+	require.NotPanics(t, func() { msgSetAutoClaim.GetSignBytes() })
+
+	require.Equal(t, nil, msgSetAutoClaim.ValidateBasic())
+	require.Equal(t, nil, types.NewMsgSetAutoClaim(addr1, "chain-1", 10, false).ValidateBasic())
+
+	emptyChainMsg := types.NewMsgSetAutoClaim(addr1, "", 10, true)
+	require.Error(t, emptyChainMsg.ValidateBasic())
+
+	negativeEpochMsg := types.NewMsgSetAutoClaim(addr1, "chain-1", -1, true)
+	require.Error(t, negativeEpochMsg.ValidateBasic())
+
+	invalidAddrMsg := types.NewMsgSetAutoClaim(sdk.AccAddress("test"), "chain-1", 10, true)
+	require.Error(t, invalidAddrMsg.ValidateBasic())
+	require.Panics(t, func() { invalidAddrMsg.GetSigners() })
+}
+
+func TestMsgClaim(t *testing.T) {
+	msgClaim := &types.MsgClaim{
+		DelegatorAddress: addr1.String(),
+		ChainId:          "chain-1",
+		EpochNumber:      10,
+	}
+	newMsgClaim := types.NewMsgClaim(addr1, "chain-1", 10)
+	require.Equal(t, msgClaim, newMsgClaim)
+	require.Equal(t, types.ModuleName, msgClaim.Route())
+	require.Equal(t, types.MsgTypeClaim, msgClaim.Type())
+	require.Equal(t, addr1, msgClaim.GetSigners()[0])
+	require.NotPanics(t, func() { msgClaim.GetSignBytes() })
+
+	require.Equal(t, nil, msgClaim.ValidateBasic())
+
+	emptyChainMsg := types.NewMsgClaim(addr1, "", 10)
+	require.Error(t, emptyChainMsg.ValidateBasic())
+
+	negativeEpochMsg := types.NewMsgClaim(addr1, "chain-1", -1)
+	require.Error(t, negativeEpochMsg.ValidateBasic())
+
+	invalidAddrMsg := types.NewMsgClaim(sdk.AccAddress("test"), "chain-1", 10)
+	require.Error(t, invalidAddrMsg.ValidateBasic())
+	require.Panics(t, func() { invalidAddrMsg.GetSigners() })
+}
+
+func TestMsgRetryTransfer(t *testing.T) {
+	msgRetryTransfer := &types.MsgRetryTransfer{
+		Signer:      addr1.String(),
+		ChainId:     "chain-1",
+		EpochNumber: 10,
+	}
+	newMsgRetryTransfer := types.NewMsgRetryTransfer(addr1, "chain-1", 10)
+	require.Equal(t, msgRetryTransfer, newMsgRetryTransfer)
+	require.Equal(t, types.ModuleName, msgRetryTransfer.Route())
+	require.Equal(t, types.MsgTypeRetryTransfer, msgRetryTransfer.Type())
+	require.Equal(t, addr1, msgRetryTransfer.GetSigners()[0])
+	require.NotPanics(t, func() { msgRetryTransfer.GetSignBytes() })
+
+	require.Equal(t, nil, msgRetryTransfer.ValidateBasic())
+
+	emptyChainMsg := types.NewMsgRetryTransfer(addr1, "", 10)
+	require.Error(t, emptyChainMsg.ValidateBasic())
+
+	negativeEpochMsg := types.NewMsgRetryTransfer(addr1, "chain-1", -1)
+	require.Error(t, negativeEpochMsg.ValidateBasic())
+
+	invalidAddrMsg := *msgRetryTransfer
+	invalidAddrMsg.Signer = "test"
+	require.Error(t, invalidAddrMsg.ValidateBasic())
+	require.Panics(t, func() { invalidAddrMsg.GetSigners() })
+}
+
+func TestMsgCancelValidatorExit(t *testing.T) {
+	valAddr := sdk.ValAddress(addr1).String()
+
+	msgCancelValidatorExit := &types.MsgCancelValidatorExit{
+		Authority:        addr1.String(),
+		ChainId:          "chain-1",
+		ValidatorAddress: valAddr,
+	}
+	newMsgCancelValidatorExit := types.NewMsgCancelValidatorExit(addr1, "chain-1", valAddr)
+	require.Equal(t, msgCancelValidatorExit, newMsgCancelValidatorExit)
+	require.Equal(t, types.ModuleName, msgCancelValidatorExit.Route())
+	require.Equal(t, types.MsgTypeCancelValidatorExit, msgCancelValidatorExit.Type())
+	require.Equal(t, addr1, msgCancelValidatorExit.GetSigners()[0])
+	require.NotPanics(t, func() { msgCancelValidatorExit.GetSignBytes() })
+
+	require.Equal(t, nil, msgCancelValidatorExit.ValidateBasic())
+
+	emptyChainMsg := types.NewMsgCancelValidatorExit(addr1, "", valAddr)
+	require.Error(t, emptyChainMsg.ValidateBasic())
+
+	invalidValidatorMsg := types.NewMsgCancelValidatorExit(addr1, "chain-1", "validator")
+	require.Error(t, invalidValidatorMsg.ValidateBasic())
+
+	invalidAddrMsg := types.NewMsgCancelValidatorExit(sdk.AccAddress("test"), "chain-1", valAddr)
+	require.Error(t, invalidAddrMsg.ValidateBasic())
+	require.Panics(t, func() { invalidAddrMsg.GetSigners() })
+}
+
+func TestMsgExitValidator(t *testing.T) {
+	valAddr := sdk.ValAddress(addr1).String()
+
+	msgExitValidator := &types.MsgExitValidator{
+		Authority:        addr1.String(),
+		ChainId:          "chain-1",
+		ValidatorAddress: valAddr,
+	}
+	newMsgExitValidator := types.NewMsgExitValidator(addr1, "chain-1", valAddr)
+	require.Equal(t, msgExitValidator, newMsgExitValidator)
+	require.Equal(t, types.ModuleName, msgExitValidator.Route())
+	require.Equal(t, types.MsgTypeExitValidator, msgExitValidator.Type())
+	require.Equal(t, addr1, msgExitValidator.GetSigners()[0])
+	require.NotPanics(t, func() { msgExitValidator.GetSignBytes() })
+
+	require.Equal(t, nil, msgExitValidator.ValidateBasic())
+
+	emptyChainMsg := types.NewMsgExitValidator(addr1, "", valAddr)
+	require.Error(t, emptyChainMsg.ValidateBasic())
+
+	invalidValidatorMsg := types.NewMsgExitValidator(addr1, "chain-1", "validator")
+	require.Error(t, invalidValidatorMsg.ValidateBasic())
+
+	invalidAddrMsg := types.NewMsgExitValidator(sdk.AccAddress("test"), "chain-1", valAddr)
+	require.Error(t, invalidAddrMsg.ValidateBasic())
+	require.Panics(t, func() { invalidAddrMsg.GetSigners() })
+}
+
+func TestMsgCancelParamChange(t *testing.T) {
+	msgCancelParamChange := &types.MsgCancelParamChange{
+		Authority: addr1.String(),
+		ChainId:   "chain-1",
+		Key:       types.KeyDepositFee,
+	}
+	newMsgCancelParamChange := types.NewMsgCancelParamChange(addr1, "chain-1", types.KeyDepositFee)
+	require.Equal(t, msgCancelParamChange, newMsgCancelParamChange)
+	require.Equal(t, types.ModuleName, msgCancelParamChange.Route())
+	require.Equal(t, types.MsgTypeCancelParamChange, msgCancelParamChange.Type())
+	require.Equal(t, addr1, msgCancelParamChange.GetSigners()[0])
+	require.NotPanics(t, func() { msgCancelParamChange.GetSignBytes() })
+
+	require.Equal(t, nil, msgCancelParamChange.ValidateBasic())
+
+	emptyChainMsg := types.NewMsgCancelParamChange(addr1, "", types.KeyDepositFee)
+	require.Error(t, emptyChainMsg.ValidateBasic())
+
+	notTimelockedMsg := types.NewMsgCancelParamChange(addr1, "chain-1", types.KeyActive)
+	require.Error(t, notTimelockedMsg.ValidateBasic())
+
+	invalidAddrMsg := types.NewMsgCancelParamChange(sdk.AccAddress("test"), "chain-1", types.KeyDepositFee)
+	require.Error(t, invalidAddrMsg.ValidateBasic())
+	require.Panics(t, func() { invalidAddrMsg.GetSigners() })
+}
+
+func TestMsgPauseHostChain(t *testing.T) {
+	msgPauseHostChain := &types.MsgPauseHostChain{
+		Authority: addr1.String(),
+		ChainId:   "chain-1",
+		Reason:    "host chain halted",
+	}
+	newMsgPauseHostChain := types.NewMsgPauseHostChain(addr1, "chain-1", "host chain halted")
+	require.Equal(t, msgPauseHostChain, newMsgPauseHostChain)
+	require.Equal(t, types.ModuleName, msgPauseHostChain.Route())
+	require.Equal(t, types.MsgTypePauseHostChain, msgPauseHostChain.Type())
+	require.Equal(t, addr1, msgPauseHostChain.GetSigners()[0])
+	require.NotPanics(t, func() { msgPauseHostChain.GetSignBytes() })
+
+	require.Equal(t, nil, msgPauseHostChain.ValidateBasic())
+
+	emptyChainMsg := types.NewMsgPauseHostChain(addr1, "", "host chain halted")
+	require.Error(t, emptyChainMsg.ValidateBasic())
+
+	emptyReasonMsg := types.NewMsgPauseHostChain(addr1, "chain-1", " ")
+	require.Error(t, emptyReasonMsg.ValidateBasic())
+
+	invalidAddrMsg := types.NewMsgPauseHostChain(sdk.AccAddress("test"), "chain-1", "host chain halted")
+	require.Error(t, invalidAddrMsg.ValidateBasic())
+	require.Panics(t, func() { invalidAddrMsg.GetSigners() })
+}
+
+func TestMsgSetFeeAddress(t *testing.T) {
+	feeAddress := authtypes.NewModuleAddressOrBech32Address("fee")
+	msgSetFeeAddress := &types.MsgSetFeeAddress{
+		Authority:         addr1.String(),
+		FeeAddress:        feeAddress.String(),
+		FlushToOldAddress: true,
+	}
+	newMsgSetFeeAddress := types.NewMsgSetFeeAddress(addr1, feeAddress, true)
+	require.Equal(t, msgSetFeeAddress, newMsgSetFeeAddress)
+	require.Equal(t, types.ModuleName, msgSetFeeAddress.Route())
+	require.Equal(t, types.MsgTypeSetFeeAddress, msgSetFeeAddress.Type())
+	require.Equal(t, addr1, msgSetFeeAddress.GetSigners()[0])
+	require.NotPanics(t, func() { msgSetFeeAddress.GetSignBytes() })
+
+	require.Equal(t, nil, msgSetFeeAddress.ValidateBasic())
+
+	emptyFeeAddressMsg := &types.MsgSetFeeAddress{Authority: addr1.String()}
+	require.Error(t, emptyFeeAddressMsg.ValidateBasic())
+
+	invalidAddrMsg := types.NewMsgSetFeeAddress(sdk.AccAddress("test"), feeAddress, false)
+	require.Error(t, invalidAddrMsg.ValidateBasic())
+	require.Panics(t, func() { invalidAddrMsg.GetSigners() })
+}
+
+func TestMsgResumeHostChain(t *testing.T) {
+	msgResumeHostChain := &types.MsgResumeHostChain{
+		Authority: addr1.String(),
+		ChainId:   "chain-1",
+	}
+	newMsgResumeHostChain := types.NewMsgResumeHostChain(addr1, "chain-1")
+	require.Equal(t, msgResumeHostChain, newMsgResumeHostChain)
+	require.Equal(t, types.ModuleName, msgResumeHostChain.Route())
+	require.Equal(t, types.MsgTypeResumeHostChain, msgResumeHostChain.Type())
+	require.Equal(t, addr1, msgResumeHostChain.GetSigners()[0])
+	require.NotPanics(t, func() { msgResumeHostChain.GetSignBytes() })
+
+	require.Equal(t, nil, msgResumeHostChain.ValidateBasic())
+
+	emptyChainMsg := types.NewMsgResumeHostChain(addr1, "")
+	require.Error(t, emptyChainMsg.ValidateBasic())
+
+	invalidAddrMsg := types.NewMsgResumeHostChain(sdk.AccAddress("test"), "chain-1")
+	require.Error(t, invalidAddrMsg.ValidateBasic())
+	require.Panics(t, func() { invalidAddrMsg.GetSigners() })
+}
+
+func TestMsgForceReconcileDelegations(t *testing.T) {
+	msgForceReconcile := &types.MsgForceReconcileDelegations{
+		Authority: addr1.String(),
+		ChainId:   "chain-1",
+	}
+	newMsgForceReconcile := types.NewMsgForceReconcileDelegations(addr1, "chain-1")
+	require.Equal(t, msgForceReconcile, newMsgForceReconcile)
+	require.Equal(t, types.ModuleName, msgForceReconcile.Route())
+	require.Equal(t, types.MsgTypeForceReconcile, msgForceReconcile.Type())
+	require.Equal(t, addr1, msgForceReconcile.GetSigners()[0])
+	require.NotPanics(t, func() { msgForceReconcile.GetSignBytes() })
+
+	require.Equal(t, nil, msgForceReconcile.ValidateBasic())
+
+	emptyChainMsg := types.NewMsgForceReconcileDelegations(addr1, " ")
+	require.Error(t, emptyChainMsg.ValidateBasic())
+
+	invalidAddrMsg := types.NewMsgForceReconcileDelegations(sdk.AccAddress("test"), "chain-1")
+	require.Error(t, invalidAddrMsg.ValidateBasic())
+	require.Panics(t, func() { invalidAddrMsg.GetSigners() })
+}
+
+func TestMsgMigrateHostChainChannel(t *testing.T) {
+	msgMigrateHostChainChannel := &types.MsgMigrateHostChainChannel{
+		Authority:    addr1.String(),
+		ChainId:      "chain-1",
+		NewChannelId: "channel-5",
+	}
+	newMsgMigrateHostChainChannel := types.NewMsgMigrateHostChainChannel(addr1, "chain-1", "channel-5", false)
+	require.Equal(t, msgMigrateHostChainChannel, newMsgMigrateHostChainChannel)
+	require.Equal(t, types.ModuleName, msgMigrateHostChainChannel.Route())
+	require.Equal(t, types.MsgTypeMigrateHostChainChannel, msgMigrateHostChainChannel.Type())
+	require.Equal(t, addr1, msgMigrateHostChainChannel.GetSigners()[0])
+	require.NotPanics(t, func() { msgMigrateHostChainChannel.GetSignBytes() })
+
+	require.Equal(t, nil, msgMigrateHostChainChannel.ValidateBasic())
+
+	cancelMsg := types.NewMsgMigrateHostChainChannel(addr1, "chain-1", "", true)
+	require.Equal(t, nil, cancelMsg.ValidateBasic())
+
+	invalidChannelMsg := types.NewMsgMigrateHostChainChannel(addr1, "chain-1", "channel", false)
+	require.Error(t, invalidChannelMsg.ValidateBasic())
+
+	emptyChainMsg := types.NewMsgMigrateHostChainChannel(addr1, "", "channel-5", false)
+	require.Error(t, emptyChainMsg.ValidateBasic())
+
+	invalidAddrMsg := types.NewMsgMigrateHostChainChannel(sdk.AccAddress("test"), "chain-1", "channel-5", false)
+	require.Error(t, invalidAddrMsg.ValidateBasic())
+	require.Panics(t, func() { invalidAddrMsg.GetSigners() })
+}
+
 func TestMsgRegisterHostChain(t *testing.T) {
 	msgRegisterHostChain := &types.MsgRegisterHostChain{
 		Authority:          addr1.String(),
@@ -181,6 +560,29 @@ func TestMsgRegisterHostChain(t *testing.T) {
 	invalidMsg.ChannelId = "notchannel-0"
 	require.Error(t, invalidMsg.ValidateBasic())
 
+	// the seed amount is optional, but can't be negative
+	seededMsg := *msgRegisterHostChain
+	seededMsg.SeedAmount = sdk.ZeroInt()
+	require.NoError(t, seededMsg.ValidateBasic())
+	seededMsg.SeedAmount = sdk.NewInt(1000)
+	require.NoError(t, seededMsg.ValidateBasic())
+	seededMsg.SeedAmount = sdk.NewInt(-1)
+	require.Error(t, seededMsg.ValidateBasic())
+
+	// localhost host chains can be registered without a transfer channel
+	localhostMsg := *msgRegisterHostChain
+	localhostMsg.ChannelId = ""
+	localhostMsg.PortId = ""
+	require.NoError(t, localhostMsg.ValidateBasic())
+
+	invalidMsg = localhostMsg
+	invalidMsg.PortId = "transfer"
+	require.Error(t, invalidMsg.ValidateBasic())
+
+	invalidMsg = localhostMsg
+	invalidMsg.ConnectionId = "connection-0"
+	require.Error(t, invalidMsg.ValidateBasic())
+
 	invalidMsg = *msgRegisterHostChain
 	invalidMsg.RestakeFee = sdk.MustNewDecFromStr("-1")
 	require.Error(t, invalidMsg.ValidateBasic())
@@ -200,6 +602,14 @@ func TestMsgRegisterHostChain(t *testing.T) {
 	invalidMsg = *msgRegisterHostChain
 	invalidMsg.MinimumDeposit = sdk.ZeroInt()
 	require.Error(t, invalidMsg.ValidateBasic())
+
+	bootstrapMsg := *msgRegisterHostChain
+	bootstrapMsg.BootstrapValidators = types.MaxBootstrapValidators
+	require.NoError(t, bootstrapMsg.ValidateBasic())
+
+	invalidMsg = *msgRegisterHostChain
+	invalidMsg.BootstrapValidators = types.MaxBootstrapValidators + 1
+	require.Error(t, invalidMsg.ValidateBasic())
 }
 
 func TestMsgUpdateHostChain(t *testing.T) {
@@ -284,6 +694,82 @@ func TestMsgUpdateHostChain(t *testing.T) {
 			Key:   types.KeyAutocompoundFactor,
 			Value: "2",
 		},
+		{
+			Key:   types.KeyFeeAddress,
+			Value: addr1.String(),
+		},
+		{
+			Key:   types.KeyFeeAddress,
+			Value: "",
+		},
+		{
+			Key:   types.KeyLiquidityIncentiveAddress,
+			Value: addr1.String(),
+		},
+		{
+			Key:   types.KeyLiquidityIncentiveRate,
+			Value: "0.25",
+		},
+		{
+			Key:   types.KeyDepositDeliveryRatio,
+			Value: "0.98",
+		},
+		{
+			Key:   types.KeyMaxDepositAmount,
+			Value: "1000000000",
+		},
+		{
+			Key:   types.KeyMinRewardsTransferAmount,
+			Value: "1000000",
+		},
+		{
+			Key:   types.KeyMaxDrainPerEpoch,
+			Value: "1000000",
+		},
+		{
+			Key:   types.KeyMinCValue,
+			Value: "0.9",
+		},
+		{
+			Key:   types.KeyMaxCValue,
+			Value: "1.1",
+		},
+		{
+			Key:   types.KeyRebateMaxCommission,
+			Value: "0.05",
+		},
+		{
+			Key:   types.KeyRebateWeightBoost,
+			Value: "0.2",
+		},
+		{
+			Key:   types.KeyScoreWeightMin,
+			Value: "0.05",
+		},
+		{
+			Key:   types.KeyScoreWeightMax,
+			Value: "0.3",
+		},
+		{
+			Key:   types.KeyWeightTransitionEpochs,
+			Value: "4",
+		},
+		{
+			Key:   types.KeyMaxRedelegationAmount,
+			Value: "1000000",
+		},
+		{
+			Key:   types.KeyMaxRedelegationRatio,
+			Value: "0.05",
+		},
+		{
+			Key:   types.KeyMaxUnbondingAmount,
+			Value: "1000000",
+		},
+		{
+			Key:   types.KeyMaxDelegationShare,
+			Value: "0.2",
+		},
 	}
 	msgUpdateHostChain := &types.MsgUpdateHostChain{
 		Authority: addr1.String(),
@@ -389,6 +875,75 @@ func TestMsgUpdateHostChain(t *testing.T) {
 		}, {
 			Key:   types.KeyAutocompoundFactor,
 			Value: "InvalidDec",
+		}, {
+			Key:   types.KeyFeeAddress,
+			Value: "invalid",
+		}, {
+			Key:   types.KeyLiquidityIncentiveAddress,
+			Value: "invalid",
+		}, {
+			Key:   types.KeyLiquidityIncentiveRate,
+			Value: "1.5",
+		}, {
+			Key:   types.KeyLiquidityIncentiveRate,
+			Value: "invalidDec",
+		}, {
+			Key:   types.KeyDepositDeliveryRatio,
+			Value: "-0.1",
+		}, {
+			Key:   types.KeyDepositDeliveryRatio,
+			Value: "1.01",
+		}, {
+			Key:   types.KeyMaxDepositAmount,
+			Value: "-1",
+		}, {
+			Key:   types.KeyMaxDepositAmount,
+			Value: "invalidInt",
+		}, {
+			Key:   types.KeyMinRewardsTransferAmount,
+			Value: "-1",
+		}, {
+			Key:   types.KeyMinRewardsTransferAmount,
+			Value: "invalidInt",
+		}, {
+			Key:   types.KeyMaxDrainPerEpoch,
+			Value: "-1",
+		}, {
+			Key:   types.KeyMaxDrainPerEpoch,
+			Value: "invalidInt",
+		}, {
+			Key:   types.KeyMinCValue,
+			Value: "-0.9",
+		}, {
+			Key:   types.KeyMaxCValue,
+			Value: "invalidDec",
+		}, {
+			Key:   types.KeyRebateMaxCommission,
+			Value: "1.1",
+		}, {
+			Key:   types.KeyRebateWeightBoost,
+			Value: "-0.2",
+		}, {
+			Key:   types.KeyScoreWeightMin,
+			Value: "-0.05",
+		}, {
+			Key:   types.KeyScoreWeightMax,
+			Value: "1.3",
+		}, {
+			Key:   types.KeyWeightTransitionEpochs,
+			Value: "-1",
+		}, {
+			Key:   types.KeyMaxRedelegationAmount,
+			Value: "-1",
+		}, {
+			Key:   types.KeyMaxRedelegationRatio,
+			Value: "1.05",
+		}, {
+			Key:   types.KeyMaxUnbondingAmount,
+			Value: "-1",
+		}, {
+			Key:   types.KeyMaxDelegationShare,
+			Value: "1.2",
 		}, {
 			Key:   "InvalidKey",
 			Value: "InvalidKey",
@@ -400,6 +955,39 @@ func TestMsgUpdateHostChain(t *testing.T) {
 	}
 }
 
+func TestMsgSetCValue(t *testing.T) {
+	msgSetCValue := &types.MsgSetCValue{
+		Authority:     addr1.String(),
+		ChainId:       "chain-1",
+		CValue:        sdk.MustNewDecFromStr("0.95"),
+		Justification: "restore the c value after the slashing accounting bug",
+		ProposalId:    7,
+	}
+	newMsgSetCValue := types.NewMsgSetCValue(
+		addr1, "chain-1", sdk.MustNewDecFromStr("0.95"), "restore the c value after the slashing accounting bug", 7,
+	)
+	require.Equal(t, msgSetCValue, newMsgSetCValue)
+	require.Equal(t, types.ModuleName, msgSetCValue.Route())
+	require.Equal(t, types.MsgTypeSetCValue, msgSetCValue.Type())
+	require.Equal(t, addr1, msgSetCValue.GetSigners()[0])
+	require.NotPanics(t, func() { msgSetCValue.GetSignBytes() })
+
+	require.Equal(t, nil, msgSetCValue.ValidateBasic())
+
+	emptyChainMsg := types.NewMsgSetCValue(addr1, "", sdk.OneDec(), "justification", 7)
+	require.Error(t, emptyChainMsg.ValidateBasic())
+
+	zeroCValueMsg := types.NewMsgSetCValue(addr1, "chain-1", sdk.ZeroDec(), "justification", 7)
+	require.Error(t, zeroCValueMsg.ValidateBasic())
+
+	noJustificationMsg := types.NewMsgSetCValue(addr1, "chain-1", sdk.OneDec(), "", 7)
+	require.Error(t, noJustificationMsg.ValidateBasic())
+
+	invalidAddrMsg := types.NewMsgSetCValue(sdk.AccAddress("test"), "chain-1", sdk.OneDec(), "justification", 7)
+	require.Error(t, invalidAddrMsg.ValidateBasic())
+	require.Panics(t, func() { invalidAddrMsg.GetSigners() })
+}
+
 func TestMsgUpdateParams(t *testing.T) {
 	msgUpdateParams := &types.MsgUpdateParams{
 		Authority: addr1.String(),
@@ -425,3 +1013,9 @@ func TestMsgUpdateParams(t *testing.T) {
 	invalidParamsMsg.Params.AdminAddress = "test"
 	require.Error(t, invalidParamsMsg.ValidateBasic())
 }
+
+func TestNewFeesCharged(t *testing.T) {
+	fee := sdk.NewInt64Coin("stk/uatom", 10)
+	require.Equal(t, []types.FeeCharged{{FeeType: types.KeyDepositFee, Amount: fee}}, types.NewFeesCharged(types.KeyDepositFee, fee))
+	require.Empty(t, types.NewFeesCharged(types.KeyDepositFee, sdk.NewInt64Coin("stk/uatom", 0)))
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -8,19 +11,46 @@ import (
 var (
 	DefaultAdminAddress = authtypes.NewModuleAddress("placeholder") // will be set manually upon module initialisation
 	DefaultFeeAddress   = authtypes.NewModuleAddress("placeholder") // will be set manually upon module initialisation
+
+	DefaultDepositReceiptRetention = 90 * 24 * time.Hour
+
+	DefaultDepositAlertEpochs uint64 = 2
+
+	DefaultMaxDepositRetries uint64 = 3
+
+	DefaultMaxICATxRetries uint64 = 5
+
+	DefaultClaimHoldEpochs uint64 = 3
+
+	DefaultMinIBCTimeout = 10 * time.Minute
+	DefaultMaxIBCTimeout = IBCTimeoutTimestamp
+
+	DefaultDelegationDriftTolerance = sdktypes.MustNewDecFromStr("0.001")
 )
 
 // NewParams creates a new Params object
 func NewParams(adminAddress, feeAddress string) Params {
 	return Params{
-		AdminAddress: adminAddress,
-		FeeAddress:   feeAddress,
+		AdminAddress:             adminAddress,
+		FeeAddress:               feeAddress,
+		MaxStkSupply:             sdktypes.ZeroInt(),
+		DelegationDriftTolerance: sdktypes.ZeroDec(),
 	}
 }
 
 // DefaultParams returns the default set of parameters of the module
 func DefaultParams() Params {
-	return NewParams(DefaultAdminAddress.String(), DefaultFeeAddress.String())
+	params := NewParams(DefaultAdminAddress.String(), DefaultFeeAddress.String())
+	params.DepositReceiptRetention = DefaultDepositReceiptRetention
+	params.DepositAlertEpochs = DefaultDepositAlertEpochs
+	params.MaxDepositRetries = DefaultMaxDepositRetries
+	params.MaxIcaTxRetries = DefaultMaxICATxRetries
+	params.MinIbcTimeout = DefaultMinIBCTimeout
+	params.MaxIbcTimeout = DefaultMaxIBCTimeout
+	params.DelegationDriftTolerance = DefaultDelegationDriftTolerance
+	params.ClaimHoldEpochs = DefaultClaimHoldEpochs
+
+	return params
 }
 
 // Validate all liquidstakeibc module parameters
@@ -31,6 +61,89 @@ func (p *Params) Validate() error {
 	if _, err := sdktypes.AccAddressFromBech32(p.FeeAddress); err != nil {
 		return err
 	}
+	if p.CallbackContractAddress != "" {
+		if _, err := sdktypes.AccAddressFromBech32(p.CallbackContractAddress); err != nil {
+			return err
+		}
+	}
+	for _, roleAddress := range []string{
+		p.EmergencyAdminAddress,
+		p.ParamAdminAddress,
+		p.ValidatorSetAdminAddress,
+		p.FeeAdminAddress,
+	} {
+		if roleAddress == "" {
+			continue
+		}
+		if _, err := sdktypes.AccAddressFromBech32(roleAddress); err != nil {
+			return err
+		}
+	}
+	if p.DepositReceiptRetention < 0 {
+		return fmt.Errorf("deposit receipt retention cannot be negative: %s", p.DepositReceiptRetention)
+	}
+	if _, found := EventsVersion_name[int32(p.EventsVersion)]; !found {
+		return fmt.Errorf("unknown events version %d", p.EventsVersion)
+	}
+	if err := p.FeeDenominations.Validate(); err != nil {
+		return err
+	}
+	if p.ParamChangeDelay < 0 {
+		return fmt.Errorf("param change delay cannot be negative: %s", p.ParamChangeDelay)
+	}
+	if p.MinIbcTimeout < 0 || p.MaxIbcTimeout < 0 {
+		return fmt.Errorf("ibc timeouts cannot be negative: min %s, max %s", p.MinIbcTimeout, p.MaxIbcTimeout)
+	}
+	if p.MaxIbcTimeout != 0 && p.MinIbcTimeout > p.MaxIbcTimeout {
+		return fmt.Errorf(
+			"min ibc timeout %s cannot be greater than the max ibc timeout %s",
+			p.MinIbcTimeout,
+			p.MaxIbcTimeout,
+		)
+	}
+	if !p.MaxStkSupply.IsNil() && p.MaxStkSupply.IsNegative() {
+		return fmt.Errorf("max stk supply cannot be negative: %s", p.MaxStkSupply)
+	}
+	if !p.DelegationDriftTolerance.IsNil() &&
+		(p.DelegationDriftTolerance.IsNegative() || p.DelegationDriftTolerance.GT(sdktypes.OneDec())) {
+		return fmt.Errorf("delegation drift tolerance must be between 0 and 1: %s", p.DelegationDriftTolerance)
+	}
+	if p.DepositRevertEpochs != 0 && p.DepositRevertEpochs < p.DepositAlertEpochs {
+		return fmt.Errorf(
+			"deposit revert epochs %d cannot be lower than the deposit alert epochs %d",
+			p.DepositRevertEpochs,
+			p.DepositAlertEpochs,
+		)
+	}
 
 	return nil
 }
+
+// Validate checks every fee type has a known denomination
+func (d FeeDenominations) Validate() error {
+	for _, denomination := range []FeeDenomination{d.DepositFee, d.RestakeFee, d.UnstakeFee, d.RedemptionFee} {
+		if _, found := FeeDenomination_name[int32(denomination)]; !found {
+			return fmt.Errorf("unknown fee denomination %d", denomination)
+		}
+	}
+
+	return nil
+}
+
+// InHostDenom returns true if a fee with this denomination is collected in host tokens, hostByDefault being the
+// denomination of its fee type when it is not set
+func (d FeeDenomination) InHostDenom(hostByDefault bool) bool {
+	switch d {
+	case FEE_DENOMINATION_HOST:
+		return true
+	case FEE_DENOMINATION_STK:
+		return false
+	default:
+		return hostByDefault
+	}
+}
+
+// HasStkSupplyCap returns true if the protocol limits the stk supply minted across the host chains
+func (p *Params) HasStkSupplyCap() bool {
+	return !p.MaxStkSupply.IsNil() && p.MaxStkSupply.IsPositive()
+}
@@ -6,18 +6,23 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,10 +30,136 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type EventsVersion int32
+
+const (
+	// only the legacy string events are emitted
+	EVENTS_VERSION_LEGACY EventsVersion = 0
+	// only the typed events are emitted
+	EVENTS_VERSION_TYPED EventsVersion = 1
+	// both the legacy string events and the typed events are emitted
+	EVENTS_VERSION_BOTH EventsVersion = 2
+)
+
+var EventsVersion_name = map[int32]string{
+	0: "EVENTS_VERSION_LEGACY",
+	1: "EVENTS_VERSION_TYPED",
+	2: "EVENTS_VERSION_BOTH",
+}
+
+var EventsVersion_value = map[string]int32{
+	"EVENTS_VERSION_LEGACY": 0,
+	"EVENTS_VERSION_TYPED":  1,
+	"EVENTS_VERSION_BOTH":   2,
+}
+
+func (x EventsVersion) String() string {
+	return proto.EnumName(EventsVersion_name, int32(x))
+}
+
+func (EventsVersion) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ed8bf02c8aabc0b0, []int{0}
+}
+
+type FeeDenomination int32
+
+const (
+	// the fee keeps the denomination of its fee type: stk tokens for the
+	// deposit, unstake and redemption fees, host tokens for the restake fee
+	FEE_DENOMINATION_DEFAULT FeeDenomination = 0
+	// the fee is collected in stk tokens
+	FEE_DENOMINATION_STK FeeDenomination = 1
+	// the fee is collected in host tokens
+	FEE_DENOMINATION_HOST FeeDenomination = 2
+)
+
+var FeeDenomination_name = map[int32]string{
+	0: "FEE_DENOMINATION_DEFAULT",
+	1: "FEE_DENOMINATION_STK",
+	2: "FEE_DENOMINATION_HOST",
+}
+
+var FeeDenomination_value = map[string]int32{
+	"FEE_DENOMINATION_DEFAULT": 0,
+	"FEE_DENOMINATION_STK":     1,
+	"FEE_DENOMINATION_HOST":    2,
+}
+
+func (x FeeDenomination) String() string {
+	return proto.EnumName(FeeDenomination_name, int32(x))
+}
+
+func (FeeDenomination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ed8bf02c8aabc0b0, []int{1}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	AdminAddress string `protobuf:"bytes,1,opt,name=admin_address,json=adminAddress,proto3" json:"admin_address,omitempty"`
 	FeeAddress   string `protobuf:"bytes,2,opt,name=fee_address,json=feeAddress,proto3" json:"fee_address,omitempty"`
+	// wasm contract notified when autocompound or unbonding transfers are
+	// received from a host chain, leave empty to disable the callbacks.
+	CallbackContractAddress string `protobuf:"bytes,5,opt,name=callback_contract_address,json=callbackContractAddress,proto3" json:"callback_contract_address,omitempty"`
+	// time deposit receipts are kept in state, zero disables the receipts.
+	DepositReceiptRetention time.Duration `protobuf:"bytes,6,opt,name=deposit_receipt_retention,json=depositReceiptRetention,proto3,stdduration" json:"deposit_receipt_retention"`
+	// delegation epochs a deposit can stay sent or received before an alert is
+	// emitted, zero disables the alerts.
+	DepositAlertEpochs uint64 `protobuf:"varint,7,opt,name=deposit_alert_epochs,json=depositAlertEpochs,proto3" json:"deposit_alert_epochs,omitempty"`
+	// delegation epochs after which a sent deposit whose transfer was refunded is
+	// reverted to pending, zero disables the reverts.
+	DepositRevertEpochs uint64 `protobuf:"varint,8,opt,name=deposit_revert_epochs,json=depositRevertEpochs,proto3" json:"deposit_revert_epochs,omitempty"`
+	// undelegation epochs a total validator unbonding is queued for before it is
+	// executed, giving the authority time to cancel it. zero executes the exit on
+	// the first unbonding epoch it is queued in.
+	ValidatorExitDelayEpochs uint64 `protobuf:"varint,9,opt,name=validator_exit_delay_epochs,json=validatorExitDelayEpochs,proto3" json:"validator_exit_delay_epochs,omitempty"`
+	// events emitted by the user messages, allows indexers to move from the
+	// legacy string events to the typed events across a release.
+	EventsVersion EventsVersion `protobuf:"varint,10,opt,name=events_version,json=eventsVersion,proto3,enum=pstake.liquidstakeibc.v1beta1.EventsVersion" json:"events_version,omitempty"`
+	// lower bound of the adaptive ica and transfer packet timeouts.
+	MinIbcTimeout time.Duration `protobuf:"bytes,11,opt,name=min_ibc_timeout,json=minIbcTimeout,proto3,stdduration" json:"min_ibc_timeout"`
+	// upper bound of the adaptive ica and transfer packet timeouts, zero disables
+	// the adaptive timeouts and the fixed 120 minutes timeout is used.
+	MaxIbcTimeout time.Duration `protobuf:"bytes,12,opt,name=max_ibc_timeout,json=maxIbcTimeout,proto3,stdduration" json:"max_ibc_timeout"`
+	// maximum stk supply minted across all the host chains, including the stk
+	// tokens pending to be minted. zero disables the cap.
+	MaxStkSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,13,opt,name=max_stk_supply,json=maxStkSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_stk_supply"`
+	// times a failed deposit is sent again by the deposit workflow before it is
+	// left for a manual retry, zero disables the automatic retries.
+	MaxDepositRetries uint64 `protobuf:"varint,14,opt,name=max_deposit_retries,json=maxDepositRetries,proto3" json:"max_deposit_retries,omitempty"`
+	// time the fee, c value limit and cap updates of a host chain are staged for
+	// before they are applied, zero applies them right away.
+	ParamChangeDelay time.Duration `protobuf:"bytes,15,opt,name=param_change_delay,json=paramChangeDelay,proto3,stdduration" json:"param_change_delay"`
+	// times an undelegation, rewards withdrawal or redelegation ica tx that
+	// could not be sent is submitted again, with an exponential backoff across
+	// blocks, zero disables the retries.
+	MaxIcaTxRetries uint64 `protobuf:"varint,16,opt,name=max_ica_tx_retries,json=maxIcaTxRetries,proto3" json:"max_ica_tx_retries,omitempty"`
+	// consecutive ica and icq failures of a host chain after which the chain is
+	// deactivated, zero disables the circuit breaker.
+	CircuitBreakerThreshold uint64 `protobuf:"varint,17,opt,name=circuit_breaker_threshold,json=circuitBreakerThreshold,proto3" json:"circuit_breaker_threshold,omitempty"`
+	// denomination each protocol fee is collected in.
+	FeeDenominations FeeDenominations `protobuf:"bytes,18,opt,name=fee_denominations,json=feeDenominations,proto3" json:"fee_denominations"`
+	// address allowed to pause and resume host chains next to the governance
+	// authority, leave empty to only allow governance.
+	EmergencyAdminAddress string `protobuf:"bytes,19,opt,name=emergency_admin_address,json=emergencyAdminAddress,proto3" json:"emergency_admin_address,omitempty"`
+	// address allowed to register host chains, update their params and the
+	// module params, next to the governance authority and the admin address.
+	ParamAdminAddress string `protobuf:"bytes,20,opt,name=param_admin_address,json=paramAdminAddress,proto3" json:"param_admin_address,omitempty"`
+	// address allowed to add, remove and weight host chain validators and to
+	// cancel validator exits, next to the governance authority and the admin
+	// address.
+	ValidatorSetAdminAddress string `protobuf:"bytes,21,opt,name=validator_set_admin_address,json=validatorSetAdminAddress,proto3" json:"validator_set_admin_address,omitempty"`
+	// address allowed to update host chain fees and fee addresses, next to the
+	// governance authority and the admin address.
+	FeeAdminAddress string `protobuf:"bytes,22,opt,name=fee_admin_address,json=feeAdminAddress,proto3" json:"fee_admin_address,omitempty"`
+	// relative difference between the delegation of a validator reported by
+	// its host chain and the tracked one above which the delegation
+	// reconciliation emits a drift event. smaller differences are corrected
+	// silently.
+	DelegationDriftTolerance github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,23,opt,name=delegation_drift_tolerance,json=delegationDriftTolerance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"delegation_drift_tolerance"`
+	// undelegation epochs after its maturity an unbonding still waiting for its
+	// transfer holds the claims of the later epochs, zero holds them until the
+	// transfer is received.
+	ClaimHoldEpochs uint64 `protobuf:"varint,24,opt,name=claim_hold_epochs,json=claimHoldEpochs,proto3" json:"claim_hold_epochs,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -78,8 +209,210 @@ func (m *Params) GetFeeAddress() string {
 	return ""
 }
 
+func (m *Params) GetCallbackContractAddress() string {
+	if m != nil {
+		return m.CallbackContractAddress
+	}
+	return ""
+}
+
+func (m *Params) GetDepositReceiptRetention() time.Duration {
+	if m != nil {
+		return m.DepositReceiptRetention
+	}
+	return 0
+}
+
+func (m *Params) GetDepositAlertEpochs() uint64 {
+	if m != nil {
+		return m.DepositAlertEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetDepositRevertEpochs() uint64 {
+	if m != nil {
+		return m.DepositRevertEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetValidatorExitDelayEpochs() uint64 {
+	if m != nil {
+		return m.ValidatorExitDelayEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetEventsVersion() EventsVersion {
+	if m != nil {
+		return m.EventsVersion
+	}
+	return EVENTS_VERSION_LEGACY
+}
+
+func (m *Params) GetMinIbcTimeout() time.Duration {
+	if m != nil {
+		return m.MinIbcTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetMaxIbcTimeout() time.Duration {
+	if m != nil {
+		return m.MaxIbcTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDepositRetries() uint64 {
+	if m != nil {
+		return m.MaxDepositRetries
+	}
+	return 0
+}
+
+func (m *Params) GetParamChangeDelay() time.Duration {
+	if m != nil {
+		return m.ParamChangeDelay
+	}
+	return 0
+}
+
+func (m *Params) GetMaxIcaTxRetries() uint64 {
+	if m != nil {
+		return m.MaxIcaTxRetries
+	}
+	return 0
+}
+
+func (m *Params) GetCircuitBreakerThreshold() uint64 {
+	if m != nil {
+		return m.CircuitBreakerThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetFeeDenominations() FeeDenominations {
+	if m != nil {
+		return m.FeeDenominations
+	}
+	return FeeDenominations{}
+}
+
+func (m *Params) GetEmergencyAdminAddress() string {
+	if m != nil {
+		return m.EmergencyAdminAddress
+	}
+	return ""
+}
+
+func (m *Params) GetParamAdminAddress() string {
+	if m != nil {
+		return m.ParamAdminAddress
+	}
+	return ""
+}
+
+func (m *Params) GetValidatorSetAdminAddress() string {
+	if m != nil {
+		return m.ValidatorSetAdminAddress
+	}
+	return ""
+}
+
+func (m *Params) GetFeeAdminAddress() string {
+	if m != nil {
+		return m.FeeAdminAddress
+	}
+	return ""
+}
+
+func (m *Params) GetClaimHoldEpochs() uint64 {
+	if m != nil {
+		return m.ClaimHoldEpochs
+	}
+	return 0
+}
+
+// FeeDenominations sets the denomination of each protocol fee type.
+type FeeDenominations struct {
+	// denomination of the fee charged on liquid stakes
+	DepositFee FeeDenomination `protobuf:"varint,1,opt,name=deposit_fee,json=depositFee,proto3,enum=pstake.liquidstakeibc.v1beta1.FeeDenomination" json:"deposit_fee,omitempty"`
+	// denomination of the fee charged on the autocompounded rewards
+	RestakeFee FeeDenomination `protobuf:"varint,2,opt,name=restake_fee,json=restakeFee,proto3,enum=pstake.liquidstakeibc.v1beta1.FeeDenomination" json:"restake_fee,omitempty"`
+	// denomination of the fee charged on liquid unstakes
+	UnstakeFee FeeDenomination `protobuf:"varint,3,opt,name=unstake_fee,json=unstakeFee,proto3,enum=pstake.liquidstakeibc.v1beta1.FeeDenomination" json:"unstake_fee,omitempty"`
+	// denomination of the fee charged on instant redemptions
+	RedemptionFee FeeDenomination `protobuf:"varint,4,opt,name=redemption_fee,json=redemptionFee,proto3,enum=pstake.liquidstakeibc.v1beta1.FeeDenomination" json:"redemption_fee,omitempty"`
+}
+
+func (m *FeeDenominations) Reset()         { *m = FeeDenominations{} }
+func (m *FeeDenominations) String() string { return proto.CompactTextString(m) }
+func (*FeeDenominations) ProtoMessage()    {}
+func (*FeeDenominations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed8bf02c8aabc0b0, []int{1}
+}
+func (m *FeeDenominations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenominations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenominations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenominations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenominations.Merge(m, src)
+}
+func (m *FeeDenominations) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenominations) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenominations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenominations proto.InternalMessageInfo
+
+func (m *FeeDenominations) GetDepositFee() FeeDenomination {
+	if m != nil {
+		return m.DepositFee
+	}
+	return FEE_DENOMINATION_DEFAULT
+}
+
+func (m *FeeDenominations) GetRestakeFee() FeeDenomination {
+	if m != nil {
+		return m.RestakeFee
+	}
+	return FEE_DENOMINATION_DEFAULT
+}
+
+func (m *FeeDenominations) GetUnstakeFee() FeeDenomination {
+	if m != nil {
+		return m.UnstakeFee
+	}
+	return FEE_DENOMINATION_DEFAULT
+}
+
+func (m *FeeDenominations) GetRedemptionFee() FeeDenomination {
+	if m != nil {
+		return m.RedemptionFee
+	}
+	return FEE_DENOMINATION_DEFAULT
+}
+
 func init() {
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.EventsVersion", EventsVersion_name, EventsVersion_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.FeeDenomination", FeeDenomination_name, FeeDenomination_value)
 	proto.RegisterType((*Params)(nil), "pstake.liquidstakeibc.v1beta1.Params")
+	proto.RegisterType((*FeeDenominations)(nil), "pstake.liquidstakeibc.v1beta1.FeeDenominations")
 }
 
 func init() {
@@ -87,26 +420,74 @@ func init() {
 }
 
 var fileDescriptor_ed8bf02c8aabc0b0 = []byte{
-	// 293 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2a, 0x28, 0x2e, 0x49,
-	0xcc, 0x4e, 0xd5, 0xcf, 0xc9, 0x2c, 0x2c, 0xcd, 0x4c, 0x01, 0xb3, 0x33, 0x93, 0x92, 0xf5, 0xcb,
-	0x0c, 0x93, 0x52, 0x4b, 0x12, 0x0d, 0xf5, 0x0b, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0xf5, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0x64, 0x21, 0x6a, 0xf5, 0x50, 0xd5, 0xea, 0x41, 0xd5, 0x4a, 0x89, 0xa4,
-	0xe7, 0xa7, 0xe7, 0x83, 0x55, 0xea, 0x83, 0x58, 0x10, 0x4d, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9,
-	0xf9, 0xc5, 0xf1, 0x10, 0x09, 0x08, 0x07, 0x2a, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f,
-	0x26, 0x21, 0x42, 0x4a, 0x9b, 0x19, 0xb9, 0xd8, 0x02, 0xc0, 0x76, 0x0a, 0xd9, 0x72, 0xf1, 0x26,
-	0xa6, 0xe4, 0x66, 0xe6, 0xc5, 0x27, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x4b, 0x30, 0x2a, 0x30,
-	0x6a, 0x70, 0x3a, 0x49, 0x5c, 0xda, 0xa2, 0x2b, 0x02, 0x35, 0xc6, 0x11, 0x22, 0x13, 0x5c, 0x52,
-	0x94, 0x99, 0x97, 0x1e, 0xc4, 0x03, 0x56, 0x0e, 0x15, 0x13, 0xb2, 0xe4, 0xe2, 0x4e, 0x4b, 0x4d,
-	0x85, 0x6b, 0x66, 0x22, 0xa0, 0x99, 0x2b, 0x2d, 0x35, 0x15, 0x2a, 0x62, 0xa5, 0xdc, 0xf5, 0x7c,
-	0x83, 0x96, 0x1c, 0x34, 0x60, 0x2a, 0xd0, 0x83, 0x06, 0xe2, 0x3c, 0x2f, 0x16, 0x0e, 0x66, 0x01,
-	0x16, 0x2f, 0x16, 0x0e, 0x16, 0x01, 0x56, 0xa7, 0xe8, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92,
-	0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c,
-	0x96, 0x63, 0x88, 0x72, 0x4c, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x2f,
-	0x48, 0x2d, 0x2a, 0xce, 0x2c, 0x2e, 0x49, 0xcd, 0x4b, 0x4e, 0xf5, 0xcf, 0x4b, 0xd5, 0x87, 0x98,
-	0xaf, 0x9b, 0x97, 0x58, 0x92, 0x59, 0x96, 0xaa, 0x5f, 0x66, 0x84, 0x69, 0x53, 0x49, 0x65, 0x41,
-	0x6a, 0x71, 0x12, 0x1b, 0x38, 0x64, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x47, 0x82,
-	0xbe, 0xaa, 0x01, 0x00, 0x00,
+	// 1066 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x4f, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xc7, 0xed, 0xd4, 0xcd, 0x2f, 0x9d, 0xc4, 0x8e, 0xbd, 0x49, 0x7e, 0x5e, 0x07, 0x70, 0xa2,
+	0x22, 0xa1, 0x28, 0x90, 0x35, 0x0d, 0x27, 0x2a, 0x7a, 0xb0, 0xe3, 0x0d, 0x49, 0x5b, 0xe2, 0xb0,
+	0xde, 0x06, 0x15, 0x0e, 0xa3, 0xd9, 0xd9, 0xc7, 0xce, 0xe0, 0xfd, 0xc7, 0xee, 0xd8, 0x72, 0x78,
+	0x05, 0x88, 0x13, 0x17, 0x24, 0xee, 0xbc, 0x01, 0x0e, 0x7d, 0x11, 0x3d, 0x56, 0x3d, 0x21, 0x84,
+	0x0a, 0x4a, 0x0e, 0xbc, 0x0d, 0x34, 0xb3, 0xbb, 0xfe, 0x17, 0x09, 0x37, 0xb9, 0xd8, 0x3b, 0xf3,
+	0x3c, 0xdf, 0xcf, 0x3c, 0xf3, 0xcc, 0xf3, 0xcc, 0xa0, 0xdd, 0x20, 0xe2, 0xa4, 0x07, 0x35, 0x87,
+	0x7d, 0xd7, 0x67, 0xb6, 0xfc, 0x66, 0x16, 0xad, 0x0d, 0x1e, 0x58, 0xc0, 0xc9, 0x83, 0x5a, 0x40,
+	0x42, 0xe2, 0x46, 0x5a, 0x10, 0xfa, 0xdc, 0x57, 0xde, 0x8b, 0x7d, 0xb5, 0x69, 0x5f, 0x2d, 0xf1,
+	0xdd, 0x5c, 0xef, 0xfa, 0x5d, 0x5f, 0x7a, 0xd6, 0xc4, 0x57, 0x2c, 0xda, 0xac, 0x50, 0x3f, 0x72,
+	0xfd, 0x08, 0xc7, 0x86, 0x78, 0x90, 0x98, 0x4a, 0xc4, 0x65, 0x9e, 0x5f, 0x93, 0xbf, 0xc9, 0x54,
+	0xb5, 0xeb, 0xfb, 0x5d, 0x07, 0x6a, 0x72, 0x64, 0xf5, 0x3b, 0x35, 0xbb, 0x1f, 0x12, 0xce, 0x7c,
+	0x2f, 0xb6, 0xdf, 0xff, 0x39, 0x8f, 0x16, 0x4f, 0x65, 0x4c, 0xca, 0x23, 0x94, 0x27, 0xb6, 0xcb,
+	0x3c, 0x4c, 0x6c, 0x3b, 0x84, 0x28, 0x52, 0xb3, 0xdb, 0xd9, 0x9d, 0x7b, 0x0d, 0xf5, 0xf5, 0x8b,
+	0xbd, 0xf5, 0x64, 0x99, 0x7a, 0x6c, 0x69, 0xf3, 0x90, 0x79, 0x5d, 0x63, 0x45, 0xba, 0x27, 0x73,
+	0xca, 0xa7, 0x68, 0xb9, 0x03, 0x30, 0x12, 0x2f, 0xcc, 0x11, 0xa3, 0x0e, 0x40, 0x2a, 0x35, 0x51,
+	0x85, 0x12, 0xc7, 0xb1, 0x08, 0xed, 0x61, 0xea, 0x7b, 0x3c, 0x24, 0x94, 0x8f, 0x40, 0x77, 0xe7,
+	0x80, 0xca, 0xa9, 0xf4, 0x20, 0x51, 0xa6, 0x54, 0x8c, 0x2a, 0x36, 0x04, 0x7e, 0xc4, 0x38, 0x0e,
+	0x81, 0x02, 0x0b, 0xc4, 0x3f, 0x07, 0x4f, 0xec, 0x5e, 0x5d, 0xdc, 0xce, 0xee, 0x2c, 0xef, 0x57,
+	0xb4, 0x38, 0x3d, 0x5a, 0x9a, 0x1e, 0xad, 0x99, 0xa4, 0xa7, 0xb1, 0xf4, 0xf2, 0xcd, 0x56, 0xe6,
+	0x97, 0xbf, 0xb6, 0xb2, 0x46, 0x39, 0xa1, 0x18, 0x31, 0xc4, 0x48, 0x19, 0xca, 0xc7, 0x68, 0x3d,
+	0x5d, 0x80, 0x38, 0x10, 0x72, 0x0c, 0x81, 0x4f, 0xcf, 0x23, 0xf5, 0x7f, 0xdb, 0xd9, 0x9d, 0x9c,
+	0xa1, 0x24, 0xb6, 0xba, 0x30, 0xe9, 0xd2, 0xa2, 0xec, 0xa3, 0x8d, 0x71, 0x48, 0x83, 0x09, 0xc9,
+	0x92, 0x94, 0xac, 0x8d, 0x56, 0x1a, 0x8c, 0x35, 0x8f, 0xd0, 0x3b, 0x03, 0xe2, 0x30, 0x9b, 0x70,
+	0x3f, 0xc4, 0x30, 0x64, 0x1c, 0xdb, 0xe0, 0x90, 0x8b, 0x54, 0x79, 0x4f, 0x2a, 0xd5, 0x91, 0x8b,
+	0x3e, 0x64, 0xbc, 0x29, 0x1c, 0x12, 0x79, 0x1b, 0x15, 0x60, 0x00, 0x1e, 0x8f, 0xf0, 0x00, 0xc2,
+	0x48, 0x6c, 0x1d, 0x6d, 0x67, 0x77, 0x0a, 0xfb, 0x1f, 0x69, 0xff, 0x59, 0x7c, 0x9a, 0x2e, 0x45,
+	0x67, 0xb1, 0xc6, 0xc8, 0xc3, 0xe4, 0x50, 0x79, 0x82, 0x56, 0x45, 0xa1, 0x30, 0x8b, 0x62, 0xce,
+	0x5c, 0xf0, 0xfb, 0x5c, 0x5d, 0x7e, 0xfb, 0x84, 0xe6, 0x5d, 0xe6, 0x1d, 0x5b, 0xd4, 0x8c, 0x95,
+	0x12, 0x46, 0x86, 0x53, 0xb0, 0x95, 0x9b, 0xc0, 0xc8, 0x70, 0x02, 0x66, 0xa1, 0x82, 0x80, 0x45,
+	0xbc, 0x87, 0xa3, 0x7e, 0x10, 0x38, 0x17, 0x6a, 0x5e, 0xd6, 0xcf, 0x67, 0x42, 0xf0, 0xc7, 0x9b,
+	0xad, 0x0f, 0xba, 0x8c, 0x9f, 0xf7, 0x2d, 0x8d, 0xfa, 0x6e, 0xd2, 0x3b, 0xc9, 0xdf, 0x5e, 0x64,
+	0xf7, 0x6a, 0xfc, 0x22, 0x80, 0x48, 0x3b, 0xf6, 0xf8, 0xeb, 0x17, 0x7b, 0x28, 0x9e, 0x17, 0x23,
+	0x63, 0xc5, 0x25, 0xc3, 0x36, 0xef, 0xb5, 0x25, 0x51, 0xd1, 0xd0, 0x9a, 0x58, 0x63, 0x7c, 0x92,
+	0x3c, 0x64, 0x10, 0xa9, 0x05, 0x79, 0x12, 0x25, 0x97, 0x0c, 0x9b, 0xe9, 0x31, 0x4a, 0x83, 0xf2,
+	0x25, 0x52, 0x64, 0xdb, 0x63, 0x7a, 0x4e, 0xbc, 0x2e, 0xc4, 0xe7, 0xa7, 0xae, 0xbe, 0xfd, 0x1e,
+	0x8b, 0x52, 0x7e, 0x20, 0xd5, 0xf2, 0x6c, 0x95, 0x0f, 0x91, 0x22, 0x73, 0x46, 0x09, 0xe6, 0xc3,
+	0x51, 0x04, 0x45, 0x19, 0x81, 0xc8, 0xe6, 0x31, 0x25, 0xe6, 0x30, 0x5d, 0xff, 0x21, 0xaa, 0x50,
+	0x16, 0xd2, 0x3e, 0xe3, 0xd8, 0x0a, 0x81, 0xf4, 0x20, 0xc4, 0xfc, 0x3c, 0x84, 0xe8, 0xdc, 0x77,
+	0x6c, 0xb5, 0x24, 0x35, 0xe5, 0xc4, 0xa1, 0x11, 0xdb, 0xcd, 0xd4, 0xac, 0x58, 0xa8, 0x24, 0xba,
+	0xda, 0x06, 0xcf, 0x77, 0x99, 0x27, 0x03, 0x8b, 0x54, 0x45, 0x86, 0x5e, 0x9b, 0x53, 0x41, 0x87,
+	0x00, 0xcd, 0x49, 0x59, 0x23, 0x27, 0x36, 0x64, 0x14, 0x3b, 0x33, 0xf3, 0xca, 0x29, 0x2a, 0x83,
+	0x0b, 0x61, 0x17, 0x3c, 0x7a, 0x81, 0xa7, 0xaf, 0xa0, 0xb5, 0x39, 0xcd, 0xbf, 0x31, 0x12, 0xd6,
+	0x27, 0xef, 0xa2, 0x23, 0xb4, 0x16, 0x67, 0x7c, 0x9a, 0xb6, 0x3e, 0x87, 0x56, 0x92, 0xa2, 0x29,
+	0xd2, 0x57, 0x93, 0xdd, 0x17, 0x01, 0x9f, 0x21, 0x6e, 0xcc, 0x21, 0x8e, 0xfb, 0xb2, 0x0d, 0x7c,
+	0x0a, 0xdc, 0x8c, 0x13, 0x3b, 0x8d, 0xfb, 0xff, 0x1c, 0xdc, 0xaa, 0xbc, 0x34, 0x27, 0x28, 0xdf,
+	0xa3, 0x4d, 0x1b, 0x1c, 0xe8, 0xca, 0x4c, 0x62, 0x3b, 0x64, 0x1d, 0x8e, 0xb9, 0xef, 0x40, 0x48,
+	0x3c, 0x0a, 0x6a, 0xf9, 0xc6, 0xa5, 0xdf, 0x04, 0x3a, 0x51, 0xfa, 0x4d, 0xa0, 0x86, 0x3a, 0xe6,
+	0x37, 0x05, 0xde, 0x4c, 0xe9, 0xca, 0x2e, 0x2a, 0x51, 0x87, 0x30, 0x17, 0x8b, 0x42, 0x49, 0xaf,
+	0x23, 0x35, 0x2e, 0x41, 0x69, 0x38, 0xf2, 0x1d, 0x3b, 0xbe, 0x85, 0x1e, 0xbe, 0xff, 0xe3, 0x3f,
+	0xbf, 0xed, 0x56, 0x93, 0xa7, 0x71, 0x38, 0xfb, 0x38, 0xc6, 0x0f, 0xd0, 0xe3, 0xdc, 0xd2, 0x9d,
+	0x62, 0xee, 0x71, 0x6e, 0x29, 0x57, 0xbc, 0x7b, 0xff, 0xcf, 0x05, 0x54, 0x9c, 0x2d, 0x20, 0xa5,
+	0x85, 0x96, 0xd3, 0xa6, 0xeb, 0x00, 0xc8, 0xf7, 0xa9, 0xb0, 0xaf, 0xdd, 0xac, 0x0c, 0x0d, 0x94,
+	0x20, 0x0e, 0x01, 0x04, 0x30, 0x04, 0xa9, 0x90, 0xc0, 0x85, 0xdb, 0x01, 0x13, 0x44, 0x02, 0xec,
+	0x7b, 0x63, 0xe0, 0x9d, 0xdb, 0x01, 0xfb, 0xde, 0x08, 0xf8, 0x0c, 0x15, 0x42, 0xb0, 0xc1, 0x0d,
+	0xe4, 0x01, 0x0b, 0x66, 0xee, 0x56, 0xcc, 0xfc, 0x98, 0x72, 0x08, 0xb0, 0x4b, 0x51, 0x7e, 0xea,
+	0x82, 0x57, 0x2a, 0x68, 0x43, 0x3f, 0xd3, 0x4f, 0xcc, 0x36, 0x3e, 0xd3, 0x8d, 0xf6, 0x71, 0xeb,
+	0x04, 0x3f, 0xd5, 0x3f, 0xaf, 0x1f, 0x3c, 0x2f, 0x66, 0x14, 0x15, 0xad, 0xcf, 0x98, 0xcc, 0xe7,
+	0xa7, 0x7a, 0xb3, 0x98, 0x55, 0xca, 0x68, 0x6d, 0xc6, 0xd2, 0x68, 0x99, 0x47, 0xc5, 0x85, 0xcd,
+	0xdc, 0x0f, 0xbf, 0x56, 0x33, 0xbb, 0xdf, 0xa2, 0xd5, 0x99, 0x30, 0x94, 0x77, 0x91, 0x7a, 0xa8,
+	0xeb, 0xb8, 0xa9, 0x9f, 0xb4, 0xbe, 0x38, 0x3e, 0xa9, 0x9b, 0x42, 0xd3, 0xd4, 0x0f, 0xeb, 0xcf,
+	0x9e, 0x9a, 0xf1, 0x4a, 0xd7, 0xac, 0x6d, 0xf3, 0x49, 0x31, 0x2b, 0xc2, 0xbb, 0x66, 0x39, 0x6a,
+	0xb5, 0xcd, 0x74, 0xad, 0xc6, 0x37, 0x2f, 0x2f, 0xab, 0xd9, 0x57, 0x97, 0xd5, 0xec, 0xdf, 0x97,
+	0xd5, 0xec, 0x4f, 0x57, 0xd5, 0xcc, 0xab, 0xab, 0x6a, 0xe6, 0xf7, 0xab, 0x6a, 0xe6, 0xeb, 0xfa,
+	0x44, 0xd9, 0x07, 0x62, 0xb7, 0x11, 0x07, 0x8f, 0x42, 0xcb, 0x83, 0x5a, 0x9c, 0xc2, 0x3d, 0x11,
+	0xdb, 0x00, 0x6a, 0x83, 0xfd, 0xeb, 0x95, 0x29, 0xbb, 0xc2, 0x5a, 0x94, 0x97, 0xf3, 0x27, 0xff,
+	0x0e, 0x00, 0x46, 0x2e, 0x8e, 0xca, 0xdc, 0x09, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -129,6 +510,161 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ClaimHoldEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ClaimHoldEpochs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	{
+		size := m.DelegationDriftTolerance.Size()
+		i -= size
+		if _, err := m.DelegationDriftTolerance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if len(m.FeeAdminAddress) > 0 {
+		i -= len(m.FeeAdminAddress)
+		copy(dAtA[i:], m.FeeAdminAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.FeeAdminAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.ValidatorSetAdminAddress) > 0 {
+		i -= len(m.ValidatorSetAdminAddress)
+		copy(dAtA[i:], m.ValidatorSetAdminAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ValidatorSetAdminAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.ParamAdminAddress) > 0 {
+		i -= len(m.ParamAdminAddress)
+		copy(dAtA[i:], m.ParamAdminAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ParamAdminAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.EmergencyAdminAddress) > 0 {
+		i -= len(m.EmergencyAdminAddress)
+		copy(dAtA[i:], m.EmergencyAdminAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.EmergencyAdminAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	{
+		size, err := m.FeeDenominations.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if m.CircuitBreakerThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CircuitBreakerThreshold))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MaxIcaTxRetries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxIcaTxRetries))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ParamChangeDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ParamChangeDelay):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x7a
+	if m.MaxDepositRetries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDepositRetries))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.MaxStkSupply.Size()
+		i -= size
+		if _, err := m.MaxStkSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxIbcTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxIbcTimeout):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x62
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinIbcTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinIbcTimeout):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintParams(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x5a
+	if m.EventsVersion != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EventsVersion))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ValidatorExitDelayEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ValidatorExitDelayEpochs))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.DepositRevertEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DepositRevertEpochs))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DepositAlertEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DepositAlertEpochs))
+		i--
+		dAtA[i] = 0x38
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DepositReceiptRetention, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DepositReceiptRetention):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x32
+	if len(m.CallbackContractAddress) > 0 {
+		i -= len(m.CallbackContractAddress)
+		copy(dAtA[i:], m.CallbackContractAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.CallbackContractAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.FeeAddress) > 0 {
 		i -= len(m.FeeAddress)
 		copy(dAtA[i:], m.FeeAddress)
@@ -146,6 +682,49 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeDenominations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenominations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenominations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RedemptionFee != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RedemptionFee))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UnstakeFee != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UnstakeFee))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RestakeFee != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RestakeFee))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DepositFee != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DepositFee))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -171,6 +750,85 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	l = len(m.CallbackContractAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DepositReceiptRetention)
+	n += 1 + l + sovParams(uint64(l))
+	if m.DepositAlertEpochs != 0 {
+		n += 1 + sovParams(uint64(m.DepositAlertEpochs))
+	}
+	if m.DepositRevertEpochs != 0 {
+		n += 1 + sovParams(uint64(m.DepositRevertEpochs))
+	}
+	if m.ValidatorExitDelayEpochs != 0 {
+		n += 1 + sovParams(uint64(m.ValidatorExitDelayEpochs))
+	}
+	if m.EventsVersion != 0 {
+		n += 1 + sovParams(uint64(m.EventsVersion))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinIbcTimeout)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxIbcTimeout)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxStkSupply.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxDepositRetries != 0 {
+		n += 1 + sovParams(uint64(m.MaxDepositRetries))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ParamChangeDelay)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxIcaTxRetries != 0 {
+		n += 2 + sovParams(uint64(m.MaxIcaTxRetries))
+	}
+	if m.CircuitBreakerThreshold != 0 {
+		n += 2 + sovParams(uint64(m.CircuitBreakerThreshold))
+	}
+	l = m.FeeDenominations.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = len(m.EmergencyAdminAddress)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = len(m.ParamAdminAddress)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = len(m.ValidatorSetAdminAddress)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = len(m.FeeAdminAddress)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = m.DelegationDriftTolerance.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.ClaimHoldEpochs != 0 {
+		n += 2 + sovParams(uint64(m.ClaimHoldEpochs))
+	}
+	return n
+}
+
+func (m *FeeDenominations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DepositFee != 0 {
+		n += 1 + sovParams(uint64(m.DepositFee))
+	}
+	if m.RestakeFee != 0 {
+		n += 1 + sovParams(uint64(m.RestakeFee))
+	}
+	if m.UnstakeFee != 0 {
+		n += 1 + sovParams(uint64(m.UnstakeFee))
+	}
+	if m.RedemptionFee != 0 {
+		n += 1 + sovParams(uint64(m.RedemptionFee))
+	}
 	return n
 }
 
@@ -273,6 +931,677 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.FeeAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositReceiptRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DepositReceiptRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAlertEpochs", wireType)
+			}
+			m.DepositAlertEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositAlertEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRevertEpochs", wireType)
+			}
+			m.DepositRevertEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositRevertEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorExitDelayEpochs", wireType)
+			}
+			m.ValidatorExitDelayEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorExitDelayEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventsVersion", wireType)
+			}
+			m.EventsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventsVersion |= EventsVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinIbcTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinIbcTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIbcTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxIbcTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStkSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxStkSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositRetries", wireType)
+			}
+			m.MaxDepositRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDepositRetries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamChangeDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ParamChangeDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIcaTxRetries", wireType)
+			}
+			m.MaxIcaTxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxIcaTxRetries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerThreshold", wireType)
+			}
+			m.CircuitBreakerThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenominations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeDenominations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyAdminAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencyAdminAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamAdminAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamAdminAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetAdminAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSetAdminAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAdminAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeAdminAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationDriftTolerance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegationDriftTolerance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHoldEpochs", wireType)
+			}
+			m.ClaimHoldEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimHoldEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenominations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenominations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenominations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositFee", wireType)
+			}
+			m.DepositFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositFee |= FeeDenomination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakeFee", wireType)
+			}
+			m.RestakeFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RestakeFee |= FeeDenomination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeFee", wireType)
+			}
+			m.UnstakeFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnstakeFee |= FeeDenomination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionFee", wireType)
+			}
+			m.RedemptionFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedemptionFee |= FeeDenomination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -10,8 +11,21 @@ import (
 
 func TestParams_Validate(t *testing.T) {
 	type fields struct {
-		AdminAddress sdk.AccAddress
-		FeeAddress   sdk.AccAddress
+		AdminAddress            sdk.AccAddress
+		FeeAddress              sdk.AccAddress
+		CallbackContractAddress string
+		EmergencyAdminAddress   string
+		ParamAdminAddress       string
+		FeeAdminAddress         string
+		DepositAlertEpochs      uint64
+		DepositRevertEpochs     uint64
+		EventsVersion           types.EventsVersion
+		FeeDenominations        types.FeeDenominations
+		MinIbcTimeout           time.Duration
+		MaxIbcTimeout           time.Duration
+		MaxStkSupply            sdk.Int
+		ParamChangeDelay        time.Duration
+		DriftTolerance          sdk.Dec
 	}
 	tests := []struct {
 		name    string
@@ -42,12 +56,214 @@ func TestParams_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid callback contract address",
+			fields: fields{
+				AdminAddress:            types.DefaultAdminAddress,
+				FeeAddress:              types.DefaultFeeAddress,
+				CallbackContractAddress: types.DefaultFeeAddress.String(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid callback contract address",
+			fields: fields{
+				AdminAddress:            types.DefaultAdminAddress,
+				FeeAddress:              types.DefaultFeeAddress,
+				CallbackContractAddress: "contract",
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid emergency admin address",
+			fields: fields{
+				AdminAddress:          types.DefaultAdminAddress,
+				FeeAddress:            types.DefaultFeeAddress,
+				EmergencyAdminAddress: types.DefaultAdminAddress.String(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid emergency admin address",
+			fields: fields{
+				AdminAddress:          types.DefaultAdminAddress,
+				FeeAddress:            types.DefaultFeeAddress,
+				EmergencyAdminAddress: "admin",
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid role admin addresses",
+			fields: fields{
+				AdminAddress:      types.DefaultAdminAddress,
+				FeeAddress:        types.DefaultFeeAddress,
+				ParamAdminAddress: types.DefaultAdminAddress.String(),
+				FeeAdminAddress:   types.DefaultFeeAddress.String(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid fee admin address",
+			fields: fields{
+				AdminAddress:    types.DefaultAdminAddress,
+				FeeAddress:      types.DefaultFeeAddress,
+				FeeAdminAddress: "fees",
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid deposit watchdog epochs",
+			fields: fields{
+				AdminAddress:        types.DefaultAdminAddress,
+				FeeAddress:          types.DefaultFeeAddress,
+				DepositAlertEpochs:  2,
+				DepositRevertEpochs: 4,
+			},
+			wantErr: false,
+		},
+		{
+			name: "deposit revert before alert",
+			fields: fields{
+				AdminAddress:        types.DefaultAdminAddress,
+				FeeAddress:          types.DefaultFeeAddress,
+				DepositAlertEpochs:  4,
+				DepositRevertEpochs: 2,
+			},
+			wantErr: true,
+		},
+		{
+			name: "typed events version",
+			fields: fields{
+				AdminAddress:  types.DefaultAdminAddress,
+				FeeAddress:    types.DefaultFeeAddress,
+				EventsVersion: types.EVENTS_VERSION_BOTH,
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown events version",
+			fields: fields{
+				AdminAddress:  types.DefaultAdminAddress,
+				FeeAddress:    types.DefaultFeeAddress,
+				EventsVersion: types.EventsVersion(3),
+			},
+			wantErr: true,
+		},
+		{
+			name: "host denom fees",
+			fields: fields{
+				AdminAddress: types.DefaultAdminAddress,
+				FeeAddress:   types.DefaultFeeAddress,
+				FeeDenominations: types.FeeDenominations{
+					DepositFee:    types.FEE_DENOMINATION_HOST,
+					RestakeFee:    types.FEE_DENOMINATION_STK,
+					RedemptionFee: types.FEE_DENOMINATION_HOST,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown fee denomination",
+			fields: fields{
+				AdminAddress:     types.DefaultAdminAddress,
+				FeeAddress:       types.DefaultFeeAddress,
+				FeeDenominations: types.FeeDenominations{UnstakeFee: types.FeeDenomination(3)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "adaptive ibc timeouts",
+			fields: fields{
+				AdminAddress:  types.DefaultAdminAddress,
+				FeeAddress:    types.DefaultFeeAddress,
+				MinIbcTimeout: 10 * time.Minute,
+				MaxIbcTimeout: time.Hour,
+			},
+			wantErr: false,
+		},
+		{
+			name: "min ibc timeout above max",
+			fields: fields{
+				AdminAddress:  types.DefaultAdminAddress,
+				FeeAddress:    types.DefaultFeeAddress,
+				MinIbcTimeout: 2 * time.Hour,
+				MaxIbcTimeout: time.Hour,
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative ibc timeout",
+			fields: fields{
+				AdminAddress:  types.DefaultAdminAddress,
+				FeeAddress:    types.DefaultFeeAddress,
+				MinIbcTimeout: -time.Minute,
+			},
+			wantErr: true,
+		},
+		{
+			name: "stk supply cap",
+			fields: fields{
+				AdminAddress: types.DefaultAdminAddress,
+				FeeAddress:   types.DefaultFeeAddress,
+				MaxStkSupply: sdk.NewInt(1000000),
+			},
+			wantErr: false,
+		},
+		{
+			name: "negative stk supply cap",
+			fields: fields{
+				AdminAddress: types.DefaultAdminAddress,
+				FeeAddress:   types.DefaultFeeAddress,
+				MaxStkSupply: sdk.NewInt(-1),
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative param change delay",
+			fields: fields{
+				AdminAddress:     types.DefaultAdminAddress,
+				FeeAddress:       types.DefaultFeeAddress,
+				ParamChangeDelay: -time.Hour,
+			},
+			wantErr: true,
+		},
+		{
+			name: "delegation drift tolerance",
+			fields: fields{
+				AdminAddress:   types.DefaultAdminAddress,
+				FeeAddress:     types.DefaultFeeAddress,
+				DriftTolerance: types.DefaultDelegationDriftTolerance,
+			},
+			wantErr: false,
+		},
+		{
+			name: "delegation drift tolerance above one",
+			fields: fields{
+				AdminAddress:   types.DefaultAdminAddress,
+				FeeAddress:     types.DefaultFeeAddress,
+				DriftTolerance: sdk.NewDec(2),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := &types.Params{
-				AdminAddress: tt.fields.AdminAddress.String(),
-				FeeAddress:   tt.fields.FeeAddress.String(),
+				AdminAddress:             tt.fields.AdminAddress.String(),
+				FeeAddress:               tt.fields.FeeAddress.String(),
+				CallbackContractAddress:  tt.fields.CallbackContractAddress,
+				EmergencyAdminAddress:    tt.fields.EmergencyAdminAddress,
+				ParamAdminAddress:        tt.fields.ParamAdminAddress,
+				FeeAdminAddress:          tt.fields.FeeAdminAddress,
+				DepositAlertEpochs:       tt.fields.DepositAlertEpochs,
+				DepositRevertEpochs:      tt.fields.DepositRevertEpochs,
+				EventsVersion:            tt.fields.EventsVersion,
+				FeeDenominations:         tt.fields.FeeDenominations,
+				MinIbcTimeout:            tt.fields.MinIbcTimeout,
+				MaxIbcTimeout:            tt.fields.MaxIbcTimeout,
+				MaxStkSupply:             tt.fields.MaxStkSupply,
+				ParamChangeDelay:         tt.fields.ParamChangeDelay,
+				DelegationDriftTolerance: tt.fields.DriftTolerance,
 			}
 			if err := p.Validate(); (err != nil) != tt.wantErr {
 				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ProposalDescription renders the host chain registration for the governance proposal summaries
+func (m *MsgRegisterHostChain) ProposalDescription() string {
+	return fmt.Sprintf(
+		"register %s host chain over %s and %s/%s, minimum deposit %s, fees: deposit %s, restake %s, unstake %s, "+
+			"redemption %s, unbonding factor %d, auto compound factor %d",
+		m.HostDenom,
+		m.ConnectionId,
+		m.PortId,
+		m.ChannelId,
+		m.MinimumDeposit,
+		m.DepositFee,
+		m.RestakeFee,
+		m.UnstakeFee,
+		m.RedemptionFee,
+		m.UnbondingFactor,
+		m.AutoCompoundFactor,
+	)
+}
+
+// ProposalDescription renders the host chain updates for the governance proposal summaries
+func (m *MsgUpdateHostChain) ProposalDescription() string {
+	updates := make([]string, 0, len(m.Updates))
+	for _, update := range m.Updates {
+		updates = append(updates, fmt.Sprintf("%s=%s", update.Key, update.Value))
+	}
+
+	return fmt.Sprintf("update %s host chain: %s", m.ChainId, strings.Join(updates, "; "))
+}
+
+// ProposalDescription renders the c value override for the governance proposal summaries
+func (m *MsgSetCValue) ProposalDescription() string {
+	return fmt.Sprintf("set %s host chain c value to %s: %s", m.ChainId, m.CValue, m.Justification)
+}
@@ -10,22 +10,28 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -1266,2034 +1272,14839 @@ func (m *QueryRedelegationTxResponse) GetRedelegationTx() []*RedelegateTx {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryHostChainRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryHostChainRequest")
-	proto.RegisterType((*QueryHostChainResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryHostChainResponse")
-	proto.RegisterType((*QueryHostChainsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryHostChainsRequest")
-	proto.RegisterType((*QueryHostChainsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryHostChainsResponse")
-	proto.RegisterType((*QueryDepositsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositsRequest")
-	proto.RegisterType((*QueryDepositsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositsResponse")
-	proto.RegisterType((*QueryLSMDepositsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryLSMDepositsRequest")
-	proto.RegisterType((*QueryLSMDepositsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryLSMDepositsResponse")
-	proto.RegisterType((*QueryUnbondingsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryUnbondingsRequest")
-	proto.RegisterType((*QueryUnbondingsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryUnbondingsResponse")
-	proto.RegisterType((*QueryUnbondingRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryUnbondingRequest")
-	proto.RegisterType((*QueryUnbondingResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryUnbondingResponse")
-	proto.RegisterType((*QueryUserUnbondingsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryUserUnbondingsRequest")
-	proto.RegisterType((*QueryUserUnbondingsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryUserUnbondingsResponse")
-	proto.RegisterType((*QueryHostChainUserUnbondingsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryHostChainUserUnbondingsRequest")
-	proto.RegisterType((*QueryHostChainUserUnbondingsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryHostChainUserUnbondingsResponse")
-	proto.RegisterType((*QueryValidatorUnbondingRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryValidatorUnbondingRequest")
-	proto.RegisterType((*QueryValidatorUnbondingResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryValidatorUnbondingResponse")
-	proto.RegisterType((*QueryDepositAccountBalanceRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositAccountBalanceRequest")
-	proto.RegisterType((*QueryDepositAccountBalanceResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositAccountBalanceResponse")
-	proto.RegisterType((*QueryExchangeRateRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryExchangeRateRequest")
-	proto.RegisterType((*QueryExchangeRateResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryExchangeRateResponse")
-	proto.RegisterType((*QueryRedelegationsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryRedelegationsRequest")
-	proto.RegisterType((*QueryRedelegationsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryRedelegationsResponse")
-	proto.RegisterType((*QueryRedelegationTxRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryRedelegationTxRequest")
-	proto.RegisterType((*QueryRedelegationTxResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryRedelegationTxResponse")
+type QueryDepositReceiptsRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("pstake/liquidstakeibc/v1beta1/query.proto", fileDescriptor_b143d1c5e28840b2)
+func (m *QueryDepositReceiptsRequest) Reset()         { *m = QueryDepositReceiptsRequest{} }
+func (m *QueryDepositReceiptsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositReceiptsRequest) ProtoMessage()    {}
+func (*QueryDepositReceiptsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{28}
 }
-
-var fileDescriptor_b143d1c5e28840b2 = []byte{
-	// 1321 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcb, 0x6f, 0xdc, 0xd4,
-	0x17, 0xc7, 0xe3, 0xbe, 0xe7, 0xf4, 0xd7, 0x54, 0xba, 0x4d, 0x7f, 0x4d, 0x0c, 0x4c, 0x8b, 0xa1,
-	0x4f, 0x9a, 0xb1, 0x32, 0x4d, 0xd3, 0x3c, 0xda, 0x90, 0xcc, 0xa4, 0x21, 0x91, 0xa8, 0x28, 0x26,
-	0x65, 0xd1, 0x2e, 0x06, 0x8f, 0x7d, 0x35, 0x63, 0x75, 0x62, 0x4f, 0xe6, 0x7a, 0xa2, 0x54, 0x51,
-	0x36, 0x6c, 0x60, 0x89, 0xc4, 0x9e, 0x7f, 0x01, 0x21, 0x21, 0x24, 0x16, 0xb0, 0x40, 0x02, 0x15,
-	0x56, 0x15, 0x6c, 0x10, 0xa0, 0x0a, 0x25, 0x20, 0xfe, 0x0d, 0x34, 0xd7, 0xc7, 0xaf, 0xb1, 0x33,
-	0xbe, 0x4e, 0xc5, 0xaa, 0xb1, 0xef, 0x3d, 0xe7, 0x7c, 0xbe, 0xc7, 0xd7, 0xc7, 0xdf, 0x29, 0x5c,
-	0x6d, 0x33, 0x57, 0x7f, 0x4c, 0xd5, 0x96, 0xb5, 0xd1, 0xb5, 0x4c, 0xfe, 0xb7, 0x55, 0x37, 0xd4,
-	0xcd, 0x89, 0x3a, 0x75, 0xf5, 0x09, 0x75, 0xa3, 0x4b, 0x3b, 0x4f, 0x4a, 0xed, 0x8e, 0xe3, 0x3a,
-	0xe4, 0x15, 0x6f, 0x6b, 0x29, 0xbe, 0xb5, 0x84, 0x5b, 0xe5, 0x91, 0x86, 0xd3, 0x70, 0xf8, 0x4e,
-	0xb5, 0xf7, 0x97, 0x17, 0x24, 0x8f, 0x19, 0x0e, 0x5b, 0x77, 0x58, 0xcd, 0x5b, 0xf0, 0x2e, 0x70,
-	0xe9, 0xe5, 0x86, 0xe3, 0x34, 0x5a, 0x54, 0xd5, 0xdb, 0x96, 0xaa, 0xdb, 0xb6, 0xe3, 0xea, 0xae,
-	0xe5, 0xd8, 0xfe, 0xea, 0x35, 0x6f, 0xaf, 0x5a, 0xd7, 0x19, 0xf5, 0x30, 0x02, 0xa8, 0xb6, 0xde,
-	0xb0, 0x6c, 0xbe, 0x19, 0xf7, 0x16, 0xa3, 0x7b, 0xfd, 0x5d, 0x86, 0x63, 0xf9, 0xeb, 0xd7, 0x06,
-	0x8b, 0x6c, 0xeb, 0x1d, 0x7d, 0xdd, 0xaf, 0x5b, 0x1e, 0xbc, 0xb7, 0x4f, 0x3c, 0x8f, 0x51, 0x46,
-	0x80, 0xbc, 0xdb, 0x23, 0xbc, 0xcf, 0x13, 0x69, 0x74, 0xa3, 0x4b, 0x99, 0xab, 0x3c, 0x84, 0x33,
-	0xb1, 0xbb, 0xac, 0xed, 0xd8, 0x8c, 0x92, 0x2a, 0x1c, 0xf3, 0x0a, 0x8e, 0x4a, 0x17, 0xa4, 0x2b,
-	0x27, 0xcb, 0x17, 0x4b, 0x03, 0xfb, 0x5a, 0xf2, 0xc2, 0x2b, 0x47, 0x9e, 0x3e, 0x3f, 0x3f, 0xa4,
-	0x61, 0xa8, 0x52, 0x86, 0xb3, 0x3c, 0xf7, 0x8a, 0xc3, 0xdc, 0x6a, 0x53, 0xb7, 0x6c, 0x2c, 0x4a,
-	0xc6, 0xe0, 0x84, 0xd1, 0xbb, 0xae, 0x59, 0x26, 0xcf, 0x5f, 0xd0, 0x8e, 0xf3, 0xeb, 0x55, 0x53,
-	0x69, 0xc0, 0xff, 0xfb, 0x63, 0x10, 0xe9, 0x1e, 0x40, 0xd3, 0x61, 0x6e, 0x8d, 0xef, 0x44, 0xac,
-	0x2b, 0x19, 0x58, 0x41, 0x16, 0x24, 0x2b, 0x34, 0xfd, 0x1b, 0xca, 0x68, 0x7f, 0xa1, 0xa0, 0x25,
-	0x26, 0x9c, 0x4b, 0xac, 0x20, 0xc3, 0x2a, 0x9c, 0x0c, 0x19, 0x7a, 0xbd, 0x39, 0x9c, 0x07, 0x42,
-	0x83, 0xa0, 0x3c, 0x53, 0x26, 0x60, 0x84, 0x57, 0x59, 0xa2, 0x6d, 0x87, 0x59, 0x2e, 0x13, 0xe8,
-	0xcd, 0x23, 0xec, 0x67, 0x18, 0x82, 0x58, 0x15, 0x38, 0x61, 0xe2, 0x3d, 0x64, 0xba, 0x94, 0xc1,
-	0x84, 0x29, 0xb4, 0x20, 0x4e, 0x99, 0x44, 0xd5, 0x6f, 0xbf, 0x77, 0x2f, 0x07, 0x92, 0x0e, 0xa3,
-	0xc9, 0x28, 0xa4, 0xba, 0x9b, 0xa0, 0xba, 0x9a, 0x41, 0x15, 0x66, 0x89, 0x80, 0xdd, 0xc0, 0x07,
-	0xf5, 0xc0, 0xae, 0x3b, 0xb6, 0x69, 0xd9, 0x0d, 0x11, 0x2e, 0x03, 0xd5, 0x44, 0x83, 0x10, 0x6b,
-	0x05, 0xa0, 0x1b, 0xdc, 0x15, 0x7c, 0x84, 0x41, 0x1a, 0x2d, 0x12, 0xab, 0xac, 0xe0, 0xf3, 0x08,
-	0x57, 0x33, 0xc1, 0xc8, 0x08, 0x1c, 0xa5, 0x6d, 0xc7, 0x68, 0x8e, 0x1e, 0xba, 0x20, 0x5d, 0x39,
-	0xac, 0x79, 0x17, 0xca, 0x07, 0xfd, 0x1a, 0x03, 0xda, 0x65, 0x28, 0x04, 0x15, 0x05, 0x0f, 0x7d,
-	0x98, 0x24, 0x0c, 0x55, 0xa6, 0x40, 0xf6, 0x2a, 0x30, 0xda, 0x49, 0x76, 0x72, 0x14, 0x8e, 0xeb,
-	0xa6, 0xd9, 0xa1, 0x8c, 0xf9, 0xbc, 0x78, 0xa9, 0xb8, 0xf0, 0x52, 0x6a, 0x1c, 0xe2, 0x3d, 0x80,
-	0xd3, 0x5d, 0x46, 0x3b, 0xb5, 0x44, 0x47, 0xaf, 0x67, 0x41, 0x46, 0xf3, 0x69, 0xc3, 0xdd, 0x58,
-	0x7a, 0xe5, 0x63, 0x09, 0x5e, 0x8b, 0xbf, 0x83, 0xe9, 0xdc, 0x03, 0x1a, 0xbd, 0x0c, 0x10, 0x8e,
-	0x60, 0xde, 0xed, 0xde, 0x5b, 0x81, 0xb3, 0xbd, 0x37, 0x83, 0x4b, 0xde, 0x67, 0x23, 0x9c, 0x60,
-	0x0d, 0x8a, 0x69, 0xb5, 0x48, 0xa4, 0xf2, 0x83, 0x04, 0xaf, 0x0f, 0x46, 0xf9, 0x4f, 0x5b, 0x41,
-	0xde, 0x4a, 0xd1, 0x71, 0x39, 0x53, 0x87, 0xc7, 0x14, 0x13, 0x32, 0x07, 0x45, 0xae, 0xe3, 0x7d,
-	0xbd, 0x65, 0x99, 0xba, 0xeb, 0x74, 0x72, 0x1c, 0x5b, 0xe5, 0x23, 0x09, 0xce, 0xef, 0x1b, 0x8d,
-	0x0d, 0x30, 0x61, 0x64, 0xd3, 0x5f, 0x4d, 0x76, 0x61, 0x22, 0xa3, 0x0b, 0x29, 0x89, 0xcf, 0x6c,
-	0x26, 0xee, 0x31, 0x65, 0x1e, 0x5e, 0x8d, 0x0e, 0xc1, 0x45, 0xc3, 0x70, 0xba, 0xb6, 0x5b, 0xd1,
-	0x5b, 0xba, 0x6d, 0x50, 0x01, 0x25, 0x35, 0x50, 0x06, 0xc5, 0xa3, 0x96, 0x19, 0x38, 0x5e, 0xf7,
-	0x6e, 0xe1, 0x4b, 0x37, 0x16, 0x6b, 0xb9, 0x0f, 0x5d, 0x75, 0x82, 0x4f, 0x8b, 0xbf, 0x5f, 0xb9,
-	0x89, 0x23, 0xf1, 0xee, 0x96, 0xd1, 0xd4, 0xed, 0x06, 0xd5, 0x74, 0x57, 0x84, 0x6b, 0x1d, 0xc6,
-	0x52, 0xc2, 0x10, 0xe7, 0x3e, 0x1c, 0xe9, 0xe8, 0xae, 0xc7, 0x52, 0xa8, 0xdc, 0xee, 0x15, 0xfc,
-	0xed, 0xf9, 0xf9, 0x4b, 0x0d, 0xcb, 0x6d, 0x76, 0xeb, 0x25, 0xc3, 0x59, 0x47, 0xd3, 0x82, 0xff,
-	0x8c, 0x33, 0xf3, 0xb1, 0xea, 0x3e, 0x69, 0x53, 0x56, 0x5a, 0xa2, 0xc6, 0xcf, 0x5f, 0x8e, 0x03,
-	0xc2, 0x2f, 0x51, 0x43, 0xe3, 0x99, 0x94, 0x29, 0x2c, 0xa7, 0x51, 0x93, 0xb6, 0x68, 0xc3, 0x73,
-	0x35, 0x02, 0x98, 0x6d, 0x9c, 0x23, 0x7d, 0x71, 0xc8, 0xa9, 0xc1, 0xa9, 0x4e, 0x74, 0x01, 0x9b,
-	0x97, 0xf5, 0x06, 0xc4, 0x93, 0xc5, 0x53, 0x28, 0xb7, 0x52, 0x2a, 0xae, 0x6d, 0x09, 0xa0, 0x32,
-	0x1c, 0x5d, 0xfd, 0x81, 0xc8, 0xba, 0x06, 0xa7, 0xa3, 0x85, 0x6a, 0xee, 0x16, 0x9e, 0xd4, 0x37,
-	0x44, 0x69, 0xe9, 0xda, 0x96, 0x36, 0xdc, 0x89, 0x65, 0x2f, 0xff, 0x7e, 0x16, 0x8e, 0xf2, 0xaa,
-	0xe4, 0x33, 0x09, 0x8e, 0x79, 0xb6, 0x88, 0x64, 0x9d, 0xfd, 0xa4, 0x2f, 0x93, 0xcb, 0x79, 0x42,
-	0x3c, 0x45, 0xca, 0xf8, 0x87, 0xbf, 0xfc, 0xf5, 0xe9, 0xa1, 0xcb, 0xe4, 0xa2, 0x2a, 0x62, 0x25,
-	0xc9, 0x57, 0x12, 0x14, 0x82, 0xa1, 0x46, 0x26, 0x45, 0x0a, 0xf6, 0x3b, 0x39, 0xf9, 0x66, 0xce,
-	0x28, 0x24, 0xbd, 0xcd, 0x49, 0xa7, 0xc8, 0x64, 0x06, 0x69, 0x68, 0xb6, 0xd4, 0x6d, 0xff, 0x31,
-	0xef, 0x90, 0xcf, 0x25, 0x80, 0xd0, 0x9c, 0x91, 0x7c, 0x0c, 0x41, 0x87, 0xa7, 0xf2, 0x86, 0x21,
-	0x7b, 0x99, 0xb3, 0x5f, 0x27, 0xd7, 0x84, 0xd9, 0x19, 0xf9, 0x42, 0x82, 0x13, 0xbe, 0x3f, 0x22,
-	0x37, 0x44, 0x0a, 0xf7, 0x79, 0x30, 0x79, 0x32, 0x5f, 0x10, 0xb2, 0xce, 0x72, 0xd6, 0x49, 0x52,
-	0xce, 0x60, 0xf5, 0xcd, 0x56, 0xb4, 0xcb, 0xdf, 0x4a, 0x70, 0x32, 0x62, 0xeb, 0x88, 0x50, 0xbf,
-	0x92, 0xee, 0x51, 0xbe, 0x95, 0x3b, 0x0e, 0xe1, 0xe7, 0x39, 0xfc, 0x34, 0x99, 0xca, 0x80, 0x6f,
-	0xb1, 0xf5, 0x5a, 0x9a, 0x80, 0xaf, 0x25, 0x80, 0xc8, 0x87, 0x54, 0xe8, 0x98, 0x24, 0x2c, 0x86,
-	0xd8, 0x31, 0x49, 0xda, 0x01, 0xe1, 0x23, 0x1e, 0x7e, 0x28, 0xa3, 0xec, 0xdf, 0x48, 0x50, 0x08,
-	0x92, 0x8a, 0xbd, 0x9b, 0xfd, 0x9f, 0x73, 0xf9, 0x66, 0xce, 0x28, 0x04, 0xaf, 0x72, 0xf0, 0x3b,
-	0x64, 0x4e, 0x14, 0x3c, 0xc2, 0xad, 0x6e, 0x73, 0x3f, 0xbb, 0x43, 0x7e, 0x94, 0x60, 0x38, 0xee,
-	0x93, 0xc8, 0x8c, 0x10, 0x4e, 0x9a, 0xcd, 0x93, 0x67, 0x0f, 0x12, 0x8a, 0x72, 0x16, 0xb8, 0x9c,
-	0x59, 0x32, 0x9d, 0x25, 0x27, 0xee, 0xdd, 0xd4, 0x6d, 0x74, 0xc0, 0x3b, 0xe4, 0x6f, 0x09, 0xce,
-	0xed, 0x63, 0xfe, 0x48, 0x25, 0xd7, 0x10, 0x49, 0x57, 0x57, 0x7d, 0xa1, 0x1c, 0x28, 0x73, 0x91,
-	0xcb, 0x9c, 0x23, 0x33, 0x79, 0x65, 0x86, 0x67, 0xee, 0x0f, 0x09, 0xce, 0x24, 0x5d, 0x18, 0x23,
-	0x77, 0x44, 0xf8, 0xf6, 0x75, 0x95, 0xf2, 0xfc, 0x41, 0xc3, 0x51, 0xd9, 0x32, 0x57, 0xb6, 0x40,
-	0xe6, 0x33, 0x94, 0xa5, 0x79, 0xcf, 0xa8, 0xbc, 0x7f, 0x24, 0x38, 0x9b, 0x6a, 0xfa, 0xc8, 0x42,
-	0x8e, 0xd9, 0x9a, 0xea, 0x37, 0xe5, 0xc5, 0x17, 0xc8, 0x80, 0x32, 0x57, 0xb9, 0xcc, 0x2a, 0x59,
-	0x14, 0x1b, 0xd5, 0x35, 0xdd, 0x4b, 0x53, 0x43, 0xdb, 0x19, 0x55, 0xfa, 0x9d, 0x04, 0xff, 0x8b,
-	0xda, 0x48, 0x22, 0x34, 0x82, 0x53, 0xfc, 0xaa, 0x3c, 0x9d, 0x3f, 0x10, 0xe5, 0xbc, 0xc9, 0xe5,
-	0xcc, 0x90, 0x5b, 0x19, 0x72, 0x28, 0x06, 0xd7, 0x7a, 0xae, 0x34, 0x2a, 0xe2, 0x7b, 0x09, 0x4e,
-	0xc5, 0x7c, 0x21, 0x11, 0x82, 0x49, 0xf3, 0xb3, 0xf2, 0xcc, 0x01, 0x22, 0x73, 0xea, 0x88, 0x79,
-	0xd6, 0xa8, 0x8e, 0x9f, 0x24, 0x18, 0x8e, 0x3b, 0x50, 0x92, 0x1b, 0x27, 0xb0, 0xbb, 0x62, 0x93,
-	0x30, 0xdd, 0xf0, 0x0a, 0x8f, 0x88, 0x3e, 0x57, 0x1c, 0x11, 0x53, 0x79, 0xf4, 0x74, 0xb7, 0x28,
-	0x3d, 0xdb, 0x2d, 0x4a, 0x7f, 0xee, 0x16, 0xa5, 0x4f, 0xf6, 0x8a, 0x43, 0xcf, 0xf6, 0x8a, 0x43,
-	0xbf, 0xee, 0x15, 0x87, 0x1e, 0x2e, 0x46, 0x7e, 0x8b, 0xb4, 0x69, 0x87, 0x59, 0xcc, 0xa5, 0xb6,
-	0x41, 0xdf, 0xb1, 0x29, 0x56, 0x1b, 0xef, 0xfd, 0x0c, 0xdd, 0xa4, 0xea, 0x66, 0x59, 0xdd, 0xea,
-	0xaf, 0xcc, 0x7f, 0xaa, 0xd4, 0x8f, 0xf1, 0xff, 0xa7, 0xbc, 0xf1, 0x6f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x56, 0x9e, 0x26, 0xdc, 0xee, 0x15, 0x00, 0x00,
+func (m *QueryDepositReceiptsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositReceiptsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositReceiptsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDepositReceiptsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositReceiptsRequest.Merge(m, src)
+}
+func (m *QueryDepositReceiptsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositReceiptsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositReceiptsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryDepositReceiptsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a HostChain by id.
-	HostChain(ctx context.Context, in *QueryHostChainRequest, opts ...grpc.CallOption) (*QueryHostChainResponse, error)
-	// Queries for all the HostChains.
-	HostChains(ctx context.Context, in *QueryHostChainsRequest, opts ...grpc.CallOption) (*QueryHostChainsResponse, error)
-	// Queries for all the deposits for a host chain.
-	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
-	// Queries for all the deposits for a host chain.
-	LSMDeposits(ctx context.Context, in *QueryLSMDepositsRequest, opts ...grpc.CallOption) (*QueryLSMDepositsResponse, error)
-	// Queries all unbondings for a host chain.
-	Unbondings(ctx context.Context, in *QueryUnbondingsRequest, opts ...grpc.CallOption) (*QueryUnbondingsResponse, error)
-	// Queries an unbonding for a host chain.
-	Unbonding(ctx context.Context, in *QueryUnbondingRequest, opts ...grpc.CallOption) (*QueryUnbondingResponse, error)
-	// Queries all unbondings for a delegator address.
-	UserUnbondings(ctx context.Context, in *QueryUserUnbondingsRequest, opts ...grpc.CallOption) (*QueryUserUnbondingsResponse, error)
-	// Queries all unbondings for a host chain.
-	HostChainUserUnbondings(ctx context.Context, in *QueryHostChainUserUnbondingsRequest, opts ...grpc.CallOption) (*QueryHostChainUserUnbondingsResponse, error)
-	// Queries all validator unbondings for a host chain.
-	ValidatorUnbondings(ctx context.Context, in *QueryValidatorUnbondingRequest, opts ...grpc.CallOption) (*QueryValidatorUnbondingResponse, error)
-	// Queries for a host chain deposit account balance.
-	DepositAccountBalance(ctx context.Context, in *QueryDepositAccountBalanceRequest, opts ...grpc.CallOption) (*QueryDepositAccountBalanceResponse, error)
-	// Queries for a host chain exchange rate between the host token and the stk
-	// token.
-	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
-	// Queries for a host chain redelegation entries on the host token delegation
-	// acct.
-	Redelegations(ctx context.Context, in *QueryRedelegationsRequest, opts ...grpc.CallOption) (*QueryRedelegationsResponse, error)
-	// Queries for a host chain redelegation-txs for the host token.
-	RedelegationTx(ctx context.Context, in *QueryRedelegationTxRequest, opts ...grpc.CallOption) (*QueryRedelegationTxResponse, error)
+func (m *QueryDepositReceiptsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryDepositReceiptsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type QueryDepositReceiptsResponse struct {
+	DepositReceipts []*DepositReceipt   `protobuf:"bytes,1,rep,name=deposit_receipts,json=depositReceipts,proto3" json:"deposit_receipts,omitempty"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDepositReceiptsResponse) Reset()         { *m = QueryDepositReceiptsResponse{} }
+func (m *QueryDepositReceiptsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositReceiptsResponse) ProtoMessage()    {}
+func (*QueryDepositReceiptsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{29}
+}
+func (m *QueryDepositReceiptsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositReceiptsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositReceiptsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDepositReceiptsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositReceiptsResponse.Merge(m, src)
+}
+func (m *QueryDepositReceiptsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositReceiptsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositReceiptsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) HostChain(ctx context.Context, in *QueryHostChainRequest, opts ...grpc.CallOption) (*QueryHostChainResponse, error) {
-	out := new(QueryHostChainResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/HostChain", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDepositReceiptsResponse proto.InternalMessageInfo
+
+func (m *QueryDepositReceiptsResponse) GetDepositReceipts() []*DepositReceipt {
+	if m != nil {
+		return m.DepositReceipts
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) HostChains(ctx context.Context, in *QueryHostChainsRequest, opts ...grpc.CallOption) (*QueryHostChainsResponse, error) {
-	out := new(QueryHostChainsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/HostChains", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDepositReceiptsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error) {
-	out := new(QueryDepositsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/Deposits", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryDepositReceiptRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (c *queryClient) LSMDeposits(ctx context.Context, in *QueryLSMDepositsRequest, opts ...grpc.CallOption) (*QueryLSMDepositsResponse, error) {
-	out := new(QueryLSMDepositsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/LSMDeposits", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDepositReceiptRequest) Reset()         { *m = QueryDepositReceiptRequest{} }
+func (m *QueryDepositReceiptRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositReceiptRequest) ProtoMessage()    {}
+func (*QueryDepositReceiptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{30}
+}
+func (m *QueryDepositReceiptRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositReceiptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositReceiptRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDepositReceiptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositReceiptRequest.Merge(m, src)
+}
+func (m *QueryDepositReceiptRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositReceiptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositReceiptRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Unbondings(ctx context.Context, in *QueryUnbondingsRequest, opts ...grpc.CallOption) (*QueryUnbondingsResponse, error) {
-	out := new(QueryUnbondingsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/Unbondings", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDepositReceiptRequest proto.InternalMessageInfo
+
+func (m *QueryDepositReceiptRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Unbonding(ctx context.Context, in *QueryUnbondingRequest, opts ...grpc.CallOption) (*QueryUnbondingResponse, error) {
-	out := new(QueryUnbondingResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/Unbonding", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryDepositReceiptResponse struct {
+	DepositReceipt DepositReceipt `protobuf:"bytes,1,opt,name=deposit_receipt,json=depositReceipt,proto3" json:"deposit_receipt"`
 }
 
-func (c *queryClient) UserUnbondings(ctx context.Context, in *QueryUserUnbondingsRequest, opts ...grpc.CallOption) (*QueryUserUnbondingsResponse, error) {
-	out := new(QueryUserUnbondingsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/UserUnbondings", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDepositReceiptResponse) Reset()         { *m = QueryDepositReceiptResponse{} }
+func (m *QueryDepositReceiptResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositReceiptResponse) ProtoMessage()    {}
+func (*QueryDepositReceiptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{31}
+}
+func (m *QueryDepositReceiptResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositReceiptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositReceiptResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDepositReceiptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositReceiptResponse.Merge(m, src)
+}
+func (m *QueryDepositReceiptResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositReceiptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositReceiptResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) HostChainUserUnbondings(ctx context.Context, in *QueryHostChainUserUnbondingsRequest, opts ...grpc.CallOption) (*QueryHostChainUserUnbondingsResponse, error) {
-	out := new(QueryHostChainUserUnbondingsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/HostChainUserUnbondings", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDepositReceiptResponse proto.InternalMessageInfo
+
+func (m *QueryDepositReceiptResponse) GetDepositReceipt() DepositReceipt {
+	if m != nil {
+		return m.DepositReceipt
 	}
-	return out, nil
+	return DepositReceipt{}
 }
 
-func (c *queryClient) ValidatorUnbondings(ctx context.Context, in *QueryValidatorUnbondingRequest, opts ...grpc.CallOption) (*QueryValidatorUnbondingResponse, error) {
-	out := new(QueryValidatorUnbondingResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/ValidatorUnbondings", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryDepositShortfallsRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (c *queryClient) DepositAccountBalance(ctx context.Context, in *QueryDepositAccountBalanceRequest, opts ...grpc.CallOption) (*QueryDepositAccountBalanceResponse, error) {
-	out := new(QueryDepositAccountBalanceResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/DepositAccountBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDepositShortfallsRequest) Reset()         { *m = QueryDepositShortfallsRequest{} }
+func (m *QueryDepositShortfallsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositShortfallsRequest) ProtoMessage()    {}
+func (*QueryDepositShortfallsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{32}
+}
+func (m *QueryDepositShortfallsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositShortfallsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositShortfallsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDepositShortfallsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositShortfallsRequest.Merge(m, src)
+}
+func (m *QueryDepositShortfallsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositShortfallsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositShortfallsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error) {
-	out := new(QueryExchangeRateResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/ExchangeRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDepositShortfallsRequest proto.InternalMessageInfo
+
+func (m *QueryDepositShortfallsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Redelegations(ctx context.Context, in *QueryRedelegationsRequest, opts ...grpc.CallOption) (*QueryRedelegationsResponse, error) {
-	out := new(QueryRedelegationsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/Redelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryDepositShortfallsResponse struct {
+	Shortfalls []*DepositShortfall `protobuf:"bytes,1,rep,name=shortfalls,proto3" json:"shortfalls,omitempty"`
 }
 
-func (c *queryClient) RedelegationTx(ctx context.Context, in *QueryRedelegationTxRequest, opts ...grpc.CallOption) (*QueryRedelegationTxResponse, error) {
-	out := new(QueryRedelegationTxResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/RedelegationTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDepositShortfallsResponse) Reset()         { *m = QueryDepositShortfallsResponse{} }
+func (m *QueryDepositShortfallsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositShortfallsResponse) ProtoMessage()    {}
+func (*QueryDepositShortfallsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{33}
+}
+func (m *QueryDepositShortfallsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositShortfallsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositShortfallsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDepositShortfallsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositShortfallsResponse.Merge(m, src)
+}
+func (m *QueryDepositShortfallsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositShortfallsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositShortfallsResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a HostChain by id.
-	HostChain(context.Context, *QueryHostChainRequest) (*QueryHostChainResponse, error)
-	// Queries for all the HostChains.
-	HostChains(context.Context, *QueryHostChainsRequest) (*QueryHostChainsResponse, error)
-	// Queries for all the deposits for a host chain.
-	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
-	// Queries for all the deposits for a host chain.
-	LSMDeposits(context.Context, *QueryLSMDepositsRequest) (*QueryLSMDepositsResponse, error)
-	// Queries all unbondings for a host chain.
-	Unbondings(context.Context, *QueryUnbondingsRequest) (*QueryUnbondingsResponse, error)
-	// Queries an unbonding for a host chain.
-	Unbonding(context.Context, *QueryUnbondingRequest) (*QueryUnbondingResponse, error)
-	// Queries all unbondings for a delegator address.
-	UserUnbondings(context.Context, *QueryUserUnbondingsRequest) (*QueryUserUnbondingsResponse, error)
-	// Queries all unbondings for a host chain.
-	HostChainUserUnbondings(context.Context, *QueryHostChainUserUnbondingsRequest) (*QueryHostChainUserUnbondingsResponse, error)
-	// Queries all validator unbondings for a host chain.
-	ValidatorUnbondings(context.Context, *QueryValidatorUnbondingRequest) (*QueryValidatorUnbondingResponse, error)
-	// Queries for a host chain deposit account balance.
-	DepositAccountBalance(context.Context, *QueryDepositAccountBalanceRequest) (*QueryDepositAccountBalanceResponse, error)
-	// Queries for a host chain exchange rate between the host token and the stk
-	// token.
-	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
-	// Queries for a host chain redelegation entries on the host token delegation
-	// acct.
-	Redelegations(context.Context, *QueryRedelegationsRequest) (*QueryRedelegationsResponse, error)
-	// Queries for a host chain redelegation-txs for the host token.
-	RedelegationTx(context.Context, *QueryRedelegationTxRequest) (*QueryRedelegationTxResponse, error)
+var xxx_messageInfo_QueryDepositShortfallsResponse proto.InternalMessageInfo
+
+func (m *QueryDepositShortfallsResponse) GetShortfalls() []*DepositShortfall {
+	if m != nil {
+		return m.Shortfalls
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryValidatorExitsRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryValidatorExitsRequest) Reset()         { *m = QueryValidatorExitsRequest{} }
+func (m *QueryValidatorExitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorExitsRequest) ProtoMessage()    {}
+func (*QueryValidatorExitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{34}
 }
-func (*UnimplementedQueryServer) HostChain(ctx context.Context, req *QueryHostChainRequest) (*QueryHostChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HostChain not implemented")
+func (m *QueryValidatorExitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) HostChains(ctx context.Context, req *QueryHostChainsRequest) (*QueryHostChainsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HostChains not implemented")
+func (m *QueryValidatorExitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorExitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDepositsRequest) (*QueryDepositsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposits not implemented")
+func (m *QueryValidatorExitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorExitsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) LSMDeposits(ctx context.Context, req *QueryLSMDepositsRequest) (*QueryLSMDepositsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LSMDeposits not implemented")
+func (m *QueryValidatorExitsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Unbondings(ctx context.Context, req *QueryUnbondingsRequest) (*QueryUnbondingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Unbondings not implemented")
+func (m *QueryValidatorExitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorExitsRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Unbonding(ctx context.Context, req *QueryUnbondingRequest) (*QueryUnbondingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Unbonding not implemented")
+
+var xxx_messageInfo_QueryValidatorExitsRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorExitsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) UserUnbondings(ctx context.Context, req *QueryUserUnbondingsRequest) (*QueryUserUnbondingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserUnbondings not implemented")
+
+type QueryValidatorExitsResponse struct {
+	Exits []*ValidatorExit `protobuf:"bytes,1,rep,name=exits,proto3" json:"exits,omitempty"`
 }
-func (*UnimplementedQueryServer) HostChainUserUnbondings(ctx context.Context, req *QueryHostChainUserUnbondingsRequest) (*QueryHostChainUserUnbondingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HostChainUserUnbondings not implemented")
+
+func (m *QueryValidatorExitsResponse) Reset()         { *m = QueryValidatorExitsResponse{} }
+func (m *QueryValidatorExitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorExitsResponse) ProtoMessage()    {}
+func (*QueryValidatorExitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{35}
 }
-func (*UnimplementedQueryServer) ValidatorUnbondings(ctx context.Context, req *QueryValidatorUnbondingRequest) (*QueryValidatorUnbondingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorUnbondings not implemented")
+func (m *QueryValidatorExitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) DepositAccountBalance(ctx context.Context, req *QueryDepositAccountBalanceRequest) (*QueryDepositAccountBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DepositAccountBalance not implemented")
+func (m *QueryValidatorExitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorExitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ExchangeRate(ctx context.Context, req *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRate not implemented")
+func (m *QueryValidatorExitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorExitsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Redelegations(ctx context.Context, req *QueryRedelegationsRequest) (*QueryRedelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Redelegations not implemented")
+func (m *QueryValidatorExitsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) RedelegationTx(ctx context.Context, req *QueryRedelegationTxRequest) (*QueryRedelegationTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RedelegationTx not implemented")
+func (m *QueryValidatorExitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorExitsResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryValidatorExitsResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryValidatorExitsResponse) GetExits() []*ValidatorExit {
+	if m != nil {
+		return m.Exits
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_HostChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHostChainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HostChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/HostChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HostChain(ctx, req.(*QueryHostChainRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryUnbondingsByEpochRangeRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// first epoch of the range, inclusive
+	StartEpoch int64 `protobuf:"varint,2,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// last epoch of the range, inclusive
+	EndEpoch int64 `protobuf:"varint,3,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
 }
 
-func _Query_HostChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHostChainsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HostChains(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/HostChains",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HostChains(ctx, req.(*QueryHostChainsRequest))
+func (m *QueryUnbondingsByEpochRangeRequest) Reset()         { *m = QueryUnbondingsByEpochRangeRequest{} }
+func (m *QueryUnbondingsByEpochRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingsByEpochRangeRequest) ProtoMessage()    {}
+func (*QueryUnbondingsByEpochRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{36}
+}
+func (m *QueryUnbondingsByEpochRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingsByEpochRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingsByEpochRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryUnbondingsByEpochRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingsByEpochRangeRequest.Merge(m, src)
+}
+func (m *QueryUnbondingsByEpochRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingsByEpochRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingsByEpochRangeRequest.DiscardUnknown(m)
 }
 
-func _Query_Deposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDepositsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Deposits(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/Deposits",
+var xxx_messageInfo_QueryUnbondingsByEpochRangeRequest proto.InternalMessageInfo
+
+func (m *QueryUnbondingsByEpochRangeRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Deposits(ctx, req.(*QueryDepositsRequest))
+	return ""
+}
+
+func (m *QueryUnbondingsByEpochRangeRequest) GetStartEpoch() int64 {
+	if m != nil {
+		return m.StartEpoch
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_LSMDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLSMDepositsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryUnbondingsByEpochRangeRequest) GetEndEpoch() int64 {
+	if m != nil {
+		return m.EndEpoch
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).LSMDeposits(ctx, in)
+	return 0
+}
+
+type QueryUnbondingsByEpochRangeResponse struct {
+	Unbondings []*Unbonding `protobuf:"bytes,1,rep,name=unbondings,proto3" json:"unbondings,omitempty"`
+	// totals of the unbondings in the range, one entry per unbonding state
+	Totals []UnbondingStateTotal `protobuf:"bytes,2,rep,name=totals,proto3" json:"totals"`
+}
+
+func (m *QueryUnbondingsByEpochRangeResponse) Reset()         { *m = QueryUnbondingsByEpochRangeResponse{} }
+func (m *QueryUnbondingsByEpochRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingsByEpochRangeResponse) ProtoMessage()    {}
+func (*QueryUnbondingsByEpochRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{37}
+}
+func (m *QueryUnbondingsByEpochRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingsByEpochRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingsByEpochRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/LSMDeposits",
+}
+func (m *QueryUnbondingsByEpochRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingsByEpochRangeResponse.Merge(m, src)
+}
+func (m *QueryUnbondingsByEpochRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingsByEpochRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingsByEpochRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondingsByEpochRangeResponse proto.InternalMessageInfo
+
+func (m *QueryUnbondingsByEpochRangeResponse) GetUnbondings() []*Unbonding {
+	if m != nil {
+		return m.Unbondings
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LSMDeposits(ctx, req.(*QueryLSMDepositsRequest))
+	return nil
+}
+
+func (m *QueryUnbondingsByEpochRangeResponse) GetTotals() []UnbondingStateTotal {
+	if m != nil {
+		return m.Totals
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Unbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUnbondingsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Unbondings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/Unbondings",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Unbondings(ctx, req.(*QueryUnbondingsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type UnbondingStateTotal struct {
+	State Unbonding_UnbondingState `protobuf:"varint,1,opt,name=state,proto3,enum=pstake.liquidstakeibc.v1beta1.Unbonding_UnbondingState" json:"state,omitempty"`
+	// number of unbondings in the state
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// stk token amount burned by the unbondings in the state
+	BurnAmount types.Coin `protobuf:"bytes,3,opt,name=burn_amount,json=burnAmount,proto3" json:"burn_amount"`
+	// host token amount unbonded by the unbondings in the state
+	UnbondAmount types.Coin `protobuf:"bytes,4,opt,name=unbond_amount,json=unbondAmount,proto3" json:"unbond_amount"`
 }
 
-func _Query_Unbonding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUnbondingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Unbonding(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/Unbonding",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Unbonding(ctx, req.(*QueryUnbondingRequest))
+func (m *UnbondingStateTotal) Reset()         { *m = UnbondingStateTotal{} }
+func (m *UnbondingStateTotal) String() string { return proto.CompactTextString(m) }
+func (*UnbondingStateTotal) ProtoMessage()    {}
+func (*UnbondingStateTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{38}
+}
+func (m *UnbondingStateTotal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingStateTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingStateTotal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *UnbondingStateTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingStateTotal.Merge(m, src)
+}
+func (m *UnbondingStateTotal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingStateTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingStateTotal.DiscardUnknown(m)
 }
 
-func _Query_UserUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUserUnbondingsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserUnbondings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/UserUnbondings",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserUnbondings(ctx, req.(*QueryUserUnbondingsRequest))
+var xxx_messageInfo_UnbondingStateTotal proto.InternalMessageInfo
+
+func (m *UnbondingStateTotal) GetState() Unbonding_UnbondingState {
+	if m != nil {
+		return m.State
 	}
-	return interceptor(ctx, in, info, handler)
+	return Unbonding_UNBONDING_PENDING
 }
 
-func _Query_HostChainUserUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHostChainUserUnbondingsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HostChainUserUnbondings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/HostChainUserUnbondings",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HostChainUserUnbondings(ctx, req.(*QueryHostChainUserUnbondingsRequest))
+func (m *UnbondingStateTotal) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ValidatorUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorUnbondingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorUnbondings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/ValidatorUnbondings",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorUnbondings(ctx, req.(*QueryValidatorUnbondingRequest))
+func (m *UnbondingStateTotal) GetBurnAmount() types.Coin {
+	if m != nil {
+		return m.BurnAmount
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_DepositAccountBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDepositAccountBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DepositAccountBalance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/DepositAccountBalance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DepositAccountBalance(ctx, req.(*QueryDepositAccountBalanceRequest))
+func (m *UnbondingStateTotal) GetUnbondAmount() types.Coin {
+	if m != nil {
+		return m.UnbondAmount
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_ExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryExchangeRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ExchangeRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/ExchangeRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ExchangeRate(ctx, req.(*QueryExchangeRateRequest))
+type QueryChannelMigrationRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryChannelMigrationRequest) Reset()         { *m = QueryChannelMigrationRequest{} }
+func (m *QueryChannelMigrationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelMigrationRequest) ProtoMessage()    {}
+func (*QueryChannelMigrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{39}
+}
+func (m *QueryChannelMigrationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelMigrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelMigrationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryChannelMigrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelMigrationRequest.Merge(m, src)
+}
+func (m *QueryChannelMigrationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelMigrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelMigrationRequest.DiscardUnknown(m)
 }
 
-func _Query_Redelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRedelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryChannelMigrationRequest proto.InternalMessageInfo
+
+func (m *QueryChannelMigrationRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Redelegations(ctx, in)
+	return ""
+}
+
+type QueryChannelMigrationResponse struct {
+	Migration ChannelMigration `protobuf:"bytes,1,opt,name=migration,proto3" json:"migration"`
+	// conditions that still prevent a draining migration from switching channels
+	Blockers []string `protobuf:"bytes,2,rep,name=blockers,proto3" json:"blockers,omitempty"`
+}
+
+func (m *QueryChannelMigrationResponse) Reset()         { *m = QueryChannelMigrationResponse{} }
+func (m *QueryChannelMigrationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelMigrationResponse) ProtoMessage()    {}
+func (*QueryChannelMigrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{40}
+}
+func (m *QueryChannelMigrationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelMigrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelMigrationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/Redelegations",
+}
+func (m *QueryChannelMigrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelMigrationResponse.Merge(m, src)
+}
+func (m *QueryChannelMigrationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelMigrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelMigrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelMigrationResponse proto.InternalMessageInfo
+
+func (m *QueryChannelMigrationResponse) GetMigration() ChannelMigration {
+	if m != nil {
+		return m.Migration
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Redelegations(ctx, req.(*QueryRedelegationsRequest))
+	return ChannelMigration{}
+}
+
+func (m *QueryChannelMigrationResponse) GetBlockers() []string {
+	if m != nil {
+		return m.Blockers
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_RedelegationTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRedelegationTxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type QueryPendingMintsRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryPendingMintsRequest) Reset()         { *m = QueryPendingMintsRequest{} }
+func (m *QueryPendingMintsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingMintsRequest) ProtoMessage()    {}
+func (*QueryPendingMintsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{41}
+}
+func (m *QueryPendingMintsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingMintsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingMintsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RedelegationTx(ctx, in)
+}
+func (m *QueryPendingMintsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingMintsRequest.Merge(m, src)
+}
+func (m *QueryPendingMintsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingMintsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingMintsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingMintsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingMintsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/RedelegationTx",
+	return ""
+}
+
+type QueryPendingMintsResponse struct {
+	PendingMints []*PendingMint `protobuf:"bytes,1,rep,name=pending_mints,json=pendingMints,proto3" json:"pending_mints,omitempty"`
+}
+
+func (m *QueryPendingMintsResponse) Reset()         { *m = QueryPendingMintsResponse{} }
+func (m *QueryPendingMintsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingMintsResponse) ProtoMessage()    {}
+func (*QueryPendingMintsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{42}
+}
+func (m *QueryPendingMintsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingMintsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingMintsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RedelegationTx(ctx, req.(*QueryRedelegationTxRequest))
+}
+func (m *QueryPendingMintsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingMintsResponse.Merge(m, src)
+}
+func (m *QueryPendingMintsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingMintsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingMintsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingMintsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingMintsResponse) GetPendingMints() []*PendingMint {
+	if m != nil {
+		return m.PendingMints
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pstake.liquidstakeibc.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "HostChain",
-			Handler:    _Query_HostChain_Handler,
-		},
-		{
-			MethodName: "HostChains",
-			Handler:    _Query_HostChains_Handler,
-		},
-		{
-			MethodName: "Deposits",
-			Handler:    _Query_Deposits_Handler,
-		},
-		{
-			MethodName: "LSMDeposits",
-			Handler:    _Query_LSMDeposits_Handler,
-		},
-		{
-			MethodName: "Unbondings",
-			Handler:    _Query_Unbondings_Handler,
-		},
-		{
-			MethodName: "Unbonding",
-			Handler:    _Query_Unbonding_Handler,
-		},
-		{
-			MethodName: "UserUnbondings",
-			Handler:    _Query_UserUnbondings_Handler,
-		},
-		{
-			MethodName: "HostChainUserUnbondings",
-			Handler:    _Query_HostChainUserUnbondings_Handler,
-		},
-		{
-			MethodName: "ValidatorUnbondings",
-			Handler:    _Query_ValidatorUnbondings_Handler,
-		},
-		{
-			MethodName: "DepositAccountBalance",
-			Handler:    _Query_DepositAccountBalance_Handler,
-		},
-		{
-			MethodName: "ExchangeRate",
-			Handler:    _Query_ExchangeRate_Handler,
-		},
-		{
-			MethodName: "Redelegations",
-			Handler:    _Query_Redelegations_Handler,
-		},
-		{
-			MethodName: "RedelegationTx",
-			Handler:    _Query_RedelegationTx_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pstake/liquidstakeibc/v1beta1/query.proto",
+type QueryDelegatorPendingMintsRequest struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDelegatorPendingMintsRequest) Reset()         { *m = QueryDelegatorPendingMintsRequest{} }
+func (m *QueryDelegatorPendingMintsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorPendingMintsRequest) ProtoMessage()    {}
+func (*QueryDelegatorPendingMintsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{43}
+}
+func (m *QueryDelegatorPendingMintsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorPendingMintsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorPendingMintsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDelegatorPendingMintsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorPendingMintsRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryDelegatorPendingMintsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorPendingMintsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorPendingMintsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegatorPendingMintsRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorPendingMintsRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryDelegatorPendingMintsResponse struct {
+	PendingMints []*PendingMint `protobuf:"bytes,1,rep,name=pending_mints,json=pendingMints,proto3" json:"pending_mints,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryDelegatorPendingMintsResponse) Reset()         { *m = QueryDelegatorPendingMintsResponse{} }
+func (m *QueryDelegatorPendingMintsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorPendingMintsResponse) ProtoMessage()    {}
+func (*QueryDelegatorPendingMintsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{44}
+}
+func (m *QueryDelegatorPendingMintsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorPendingMintsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorPendingMintsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryDelegatorPendingMintsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorPendingMintsResponse.Merge(m, src)
+}
+func (m *QueryDelegatorPendingMintsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorPendingMintsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorPendingMintsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryHostChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegatorPendingMintsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorPendingMintsResponse) GetPendingMints() []*PendingMint {
+	if m != nil {
+		return m.PendingMints
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryHostChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryModuleAccountsRequest struct {
 }
 
-func (m *QueryHostChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryModuleAccountsRequest) Reset()         { *m = QueryModuleAccountsRequest{} }
+func (m *QueryModuleAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleAccountsRequest) ProtoMessage()    {}
+func (*QueryModuleAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{45}
 }
-
-func (m *QueryHostChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryModuleAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryModuleAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleAccountsRequest.Merge(m, src)
+}
+func (m *QueryModuleAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleAccountsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryHostChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryModuleAccountsRequest proto.InternalMessageInfo
+
+type QueryModuleAccountsResponse struct {
+	Accounts []ModuleAccountBalance `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 }
 
-func (m *QueryHostChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.HostChain.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryModuleAccountsResponse) Reset()         { *m = QueryModuleAccountsResponse{} }
+func (m *QueryModuleAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleAccountsResponse) ProtoMessage()    {}
+func (*QueryModuleAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{46}
+}
+func (m *QueryModuleAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryModuleAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleAccountsResponse.Merge(m, src)
+}
+func (m *QueryModuleAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleAccountsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryHostChainsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryModuleAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryModuleAccountsResponse) GetAccounts() []ModuleAccountBalance {
+	if m != nil {
+		return m.Accounts
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryHostChainsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ModuleAccountBalance struct {
+	// name the module account address is derived from
+	Name     string                                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address  string                                   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
 }
 
-func (m *QueryHostChainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *ModuleAccountBalance) Reset()         { *m = ModuleAccountBalance{} }
+func (m *ModuleAccountBalance) String() string { return proto.CompactTextString(m) }
+func (*ModuleAccountBalance) ProtoMessage()    {}
+func (*ModuleAccountBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{47}
+}
+func (m *ModuleAccountBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleAccountBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleAccountBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleAccountBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleAccountBalance.Merge(m, src)
+}
+func (m *ModuleAccountBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleAccountBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleAccountBalance.DiscardUnknown(m)
 }
 
-func (m *QueryHostChainsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ModuleAccountBalance proto.InternalMessageInfo
+
+func (m *ModuleAccountBalance) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryHostChainsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ModuleAccountBalance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *QueryHostChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.HostChains) > 0 {
-		for iNdEx := len(m.HostChains) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HostChains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *ModuleAccountBalance) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryDepositsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type QueryRelayLatencyRequest struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
 }
 
-func (m *QueryDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRelayLatencyRequest) Reset()         { *m = QueryRelayLatencyRequest{} }
+func (m *QueryRelayLatencyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayLatencyRequest) ProtoMessage()    {}
+func (*QueryRelayLatencyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{48}
 }
-
-func (m *QueryDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryRelayLatencyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayLatencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayLatencyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRelayLatencyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayLatencyRequest.Merge(m, src)
+}
+func (m *QueryRelayLatencyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayLatencyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayLatencyRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDepositsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRelayLatencyRequest proto.InternalMessageInfo
+
+func (m *QueryRelayLatencyRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRelayLatencyResponse struct {
+	Latency RelayLatency `protobuf:"bytes,1,opt,name=latency,proto3" json:"latency"`
+	// timeout set on the packets sent over the connection
+	Timeout time.Duration `protobuf:"bytes,2,opt,name=timeout,proto3,stdduration" json:"timeout"`
 }
 
-func (m *QueryDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Deposits) > 0 {
-		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryRelayLatencyResponse) Reset()         { *m = QueryRelayLatencyResponse{} }
+func (m *QueryRelayLatencyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayLatencyResponse) ProtoMessage()    {}
+func (*QueryRelayLatencyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{49}
+}
+func (m *QueryRelayLatencyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayLatencyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayLatencyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryLSMDepositsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRelayLatencyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayLatencyResponse.Merge(m, src)
 }
-
-func (m *QueryLSMDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRelayLatencyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayLatencyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayLatencyResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLSMDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryRelayLatencyResponse proto.InternalMessageInfo
+
+func (m *QueryRelayLatencyResponse) GetLatency() RelayLatency {
+	if m != nil {
+		return m.Latency
 	}
-	return len(dAtA) - i, nil
+	return RelayLatency{}
 }
 
-func (m *QueryLSMDepositsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRelayLatencyResponse) GetTimeout() time.Duration {
+	if m != nil {
+		return m.Timeout
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryLSMDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryDepositCapacityRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryLSMDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Deposits) > 0 {
-		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDepositCapacityRequest) Reset()         { *m = QueryDepositCapacityRequest{} }
+func (m *QueryDepositCapacityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositCapacityRequest) ProtoMessage()    {}
+func (*QueryDepositCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{50}
+}
+func (m *QueryDepositCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryUnbondingsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDepositCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositCapacityRequest.Merge(m, src)
 }
-
-func (m *QueryUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDepositCapacityRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryDepositCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositCapacityRequest.DiscardUnknown(m)
 }
 
-func (m *QueryUnbondingsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDepositCapacityRequest proto.InternalMessageInfo
+
+func (m *QueryDepositCapacityRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryDepositCapacityResponse struct {
+	// deposit cap of the host chain, zero if the host chain is not capped
+	MaxDepositAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=max_deposit_amount,json=maxDepositAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_deposit_amount"`
+	// host tokens currently liquid staked on the host chain
+	LiquidStakedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=liquid_staked_amount,json=liquidStakedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"liquid_staked_amount"`
+	// host tokens that can still be liquid staked, only set if the host chain is
+	// capped
+	RemainingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=remaining_amount,json=remainingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining_amount"`
 }
 
-func (m *QueryUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Unbondings) > 0 {
-		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDepositCapacityResponse) Reset()         { *m = QueryDepositCapacityResponse{} }
+func (m *QueryDepositCapacityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositCapacityResponse) ProtoMessage()    {}
+func (*QueryDepositCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{51}
+}
+func (m *QueryDepositCapacityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositCapacityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryUnbondingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDepositCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositCapacityResponse.Merge(m, src)
 }
-
-func (m *QueryUnbondingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDepositCapacityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositCapacityResponse.DiscardUnknown(m)
 }
 
-func (m *QueryUnbondingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryDepositCapacityResponse proto.InternalMessageInfo
+
+type QueryCValueHistoryRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryCValueHistoryRequest) Reset()         { *m = QueryCValueHistoryRequest{} }
+func (m *QueryCValueHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCValueHistoryRequest) ProtoMessage()    {}
+func (*QueryCValueHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{52}
+}
+func (m *QueryCValueHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCValueHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCValueHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCValueHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCValueHistoryRequest.Merge(m, src)
+}
+func (m *QueryCValueHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCValueHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCValueHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryUnbondingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCValueHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryCValueHistoryRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryUnbondingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCValueHistoryResponse struct {
+	Records []*CValueRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
 }
 
-func (m *QueryUnbondingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Unbonding != nil {
-		{
-			size, err := m.Unbonding.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryCValueHistoryResponse) Reset()         { *m = QueryCValueHistoryResponse{} }
+func (m *QueryCValueHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCValueHistoryResponse) ProtoMessage()    {}
+func (*QueryCValueHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{53}
+}
+func (m *QueryCValueHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCValueHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCValueHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCValueHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCValueHistoryResponse.Merge(m, src)
+}
+func (m *QueryCValueHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCValueHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCValueHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryUserUnbondingsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCValueHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryCValueHistoryResponse) GetRecords() []*CValueRecord {
+	if m != nil {
+		return m.Records
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryUserUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPendingProposalsRequest struct {
+	// only return the proposals affecting this host chain, optional
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryUserUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryPendingProposalsRequest) Reset()         { *m = QueryPendingProposalsRequest{} }
+func (m *QueryPendingProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingProposalsRequest) ProtoMessage()    {}
+func (*QueryPendingProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{54}
+}
+func (m *QueryPendingProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPendingProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingProposalsRequest.Merge(m, src)
+}
+func (m *QueryPendingProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingProposalsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryUserUnbondingsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingProposalsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingProposalsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryUserUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPendingProposalsResponse struct {
+	Proposals []*ModuleProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
 }
 
-func (m *QueryUserUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UserUnbondings) > 0 {
-		for iNdEx := len(m.UserUnbondings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UserUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryPendingProposalsResponse) Reset()         { *m = QueryPendingProposalsResponse{} }
+func (m *QueryPendingProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingProposalsResponse) ProtoMessage()    {}
+func (*QueryPendingProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{55}
+}
+func (m *QueryPendingProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPendingProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingProposalsResponse.Merge(m, src)
+}
+func (m *QueryPendingProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingProposalsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryHostChainUserUnbondingsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingProposalsResponse) GetProposals() []*ModuleProposal {
+	if m != nil {
+		return m.Proposals
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryHostChainUserUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ModuleProposal is a pending governance proposal executing liquidstakeibc
+// messages, with a summary of each message for clients to render.
+type ModuleProposal struct {
+	ProposalId     uint64             `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Status         v1.ProposalStatus  `protobuf:"varint,2,opt,name=status,proto3,enum=cosmos.gov.v1.ProposalStatus" json:"status,omitempty"`
+	Title          string             `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Summary        string             `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	Metadata       string             `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	DepositEndTime *time.Time         `protobuf:"bytes,6,opt,name=deposit_end_time,json=depositEndTime,proto3,stdtime" json:"deposit_end_time,omitempty"`
+	VotingEndTime  *time.Time         `protobuf:"bytes,7,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time,omitempty"`
+	Messages       []*ProposalMessage `protobuf:"bytes,8,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
-func (m *QueryHostChainUserUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *ModuleProposal) Reset()         { *m = ModuleProposal{} }
+func (m *ModuleProposal) String() string { return proto.CompactTextString(m) }
+func (*ModuleProposal) ProtoMessage()    {}
+func (*ModuleProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{56}
+}
+func (m *ModuleProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ModuleProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleProposal.Merge(m, src)
+}
+func (m *ModuleProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleProposal proto.InternalMessageInfo
+
+func (m *ModuleProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryHostChainUserUnbondingsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ModuleProposal) GetStatus() v1.ProposalStatus {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return v1.ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
 }
 
-func (m *QueryHostChainUserUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ModuleProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
 }
 
-func (m *QueryHostChainUserUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *ModuleProposal) GetSummary() string {
+	if m != nil {
+		return m.Summary
 	}
-	if len(m.UserUnbondings) > 0 {
-		for iNdEx := len(m.UserUnbondings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UserUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryValidatorUnbondingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ModuleProposal) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryValidatorUnbondingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ModuleProposal) GetDepositEndTime() *time.Time {
+	if m != nil {
+		return m.DepositEndTime
+	}
+	return nil
 }
 
-func (m *QueryValidatorUnbondingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+func (m *ModuleProposal) GetVotingEndTime() *time.Time {
+	if m != nil {
+		return m.VotingEndTime
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryValidatorUnbondingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ModuleProposal) GetMessages() []*ProposalMessage {
+	if m != nil {
+		return m.Messages
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryValidatorUnbondingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ProposalMessage summarizes a liquidstakeibc message of a proposal.
+type ProposalMessage struct {
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// host chain affected by the message, empty for module wide messages
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// human readable description of the message
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-func (m *QueryValidatorUnbondingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorUnbondings) > 0 {
-		for iNdEx := len(m.ValidatorUnbondings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValidatorUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ProposalMessage) Reset()         { *m = ProposalMessage{} }
+func (m *ProposalMessage) String() string { return proto.CompactTextString(m) }
+func (*ProposalMessage) ProtoMessage()    {}
+func (*ProposalMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{57}
+}
+func (m *ProposalMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDepositAccountBalanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ProposalMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalMessage.Merge(m, src)
+}
+func (m *ProposalMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalMessage.DiscardUnknown(m)
 }
 
-func (m *QueryDepositAccountBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ProposalMessage proto.InternalMessageInfo
+
+func (m *ProposalMessage) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
 }
 
-func (m *QueryDepositAccountBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+func (m *ProposalMessage) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryDepositAccountBalanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProposalMessage) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDepositAccountBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryValidatorWeightsRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryDepositAccountBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryValidatorWeightsRequest) Reset()         { *m = QueryValidatorWeightsRequest{} }
+func (m *QueryValidatorWeightsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorWeightsRequest) ProtoMessage()    {}
+func (*QueryValidatorWeightsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{58}
+}
+func (m *QueryValidatorWeightsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorWeightsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorWeightsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidatorWeightsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorWeightsRequest.Merge(m, src)
+}
+func (m *QueryValidatorWeightsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorWeightsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorWeightsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryExchangeRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorWeightsRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorWeightsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryExchangeRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryValidatorWeightsResponse struct {
+	Weights []*ValidatorWeight `protobuf:"bytes,1,rep,name=weights,proto3" json:"weights,omitempty"`
+	// sum of the stored validator weights
+	TotalWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=total_weight,json=totalWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_weight"`
 }
 
-func (m *QueryExchangeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryValidatorWeightsResponse) Reset()         { *m = QueryValidatorWeightsResponse{} }
+func (m *QueryValidatorWeightsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorWeightsResponse) ProtoMessage()    {}
+func (*QueryValidatorWeightsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{59}
+}
+func (m *QueryValidatorWeightsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorWeightsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorWeightsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidatorWeightsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorWeightsResponse.Merge(m, src)
+}
+func (m *QueryValidatorWeightsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorWeightsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorWeightsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryExchangeRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorWeightsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorWeightsResponse) GetWeights() []*ValidatorWeight {
+	if m != nil {
+		return m.Weights
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryExchangeRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ValidatorWeight is the weight of a host chain validator.
+type ValidatorWeight struct {
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// weight stored for the validator
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+	// weight once all the weights are normalized to add up to one
+	NormalizedWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=normalized_weight,json=normalizedWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"normalized_weight"`
 }
 
-func (m *QueryExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Rate.Size()
-		i -= size
-		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *ValidatorWeight) Reset()         { *m = ValidatorWeight{} }
+func (m *ValidatorWeight) String() string { return proto.CompactTextString(m) }
+func (*ValidatorWeight) ProtoMessage()    {}
+func (*ValidatorWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{60}
+}
+func (m *ValidatorWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *ValidatorWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorWeight.Merge(m, src)
+}
+func (m *ValidatorWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorWeight.DiscardUnknown(m)
 }
 
-func (m *QueryRedelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorWeight proto.InternalMessageInfo
+
+func (m *ValidatorWeight) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryRedelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryUnbondingCapacityRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// number of unbonding epochs to report, defaults to 4
+	Epochs uint32 `protobuf:"varint,2,opt,name=epochs,proto3" json:"epochs,omitempty"`
 }
 
-func (m *QueryRedelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryUnbondingCapacityRequest) Reset()         { *m = QueryUnbondingCapacityRequest{} }
+func (m *QueryUnbondingCapacityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingCapacityRequest) ProtoMessage()    {}
+func (*QueryUnbondingCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{61}
+}
+func (m *QueryUnbondingCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryUnbondingCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingCapacityRequest.Merge(m, src)
+}
+func (m *QueryUnbondingCapacityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingCapacityRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRedelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUnbondingCapacityRequest proto.InternalMessageInfo
+
+func (m *QueryUnbondingCapacityRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryRedelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryUnbondingCapacityRequest) GetEpochs() uint32 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
 }
 
-func (m *QueryRedelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Redelegations != nil {
-		{
-			size, err := m.Redelegations.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type QueryUnbondingCapacityResponse struct {
+	Epochs []*UnbondingCapacityEpoch `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs,omitempty"`
+	// host tokens that could be unbonded over all the reported epochs, on top
+	// of the queued ones
+	TotalCapacity github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_capacity,json=totalCapacity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_capacity"`
+	// unbonding entries each validator can hold on the host chain
+	MaxEntries uint32 `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
 }
 
-func (m *QueryRedelegationTxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUnbondingCapacityResponse) Reset()         { *m = QueryUnbondingCapacityResponse{} }
+func (m *QueryUnbondingCapacityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingCapacityResponse) ProtoMessage()    {}
+func (*QueryUnbondingCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{62}
+}
+func (m *QueryUnbondingCapacityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingCapacityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryRedelegationTxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryUnbondingCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingCapacityResponse.Merge(m, src)
+}
+func (m *QueryUnbondingCapacityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingCapacityResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRedelegationTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryUnbondingCapacityResponse proto.InternalMessageInfo
+
+func (m *QueryUnbondingCapacityResponse) GetEpochs() []*UnbondingCapacityEpoch {
+	if m != nil {
+		return m.Epochs
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryRedelegationTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUnbondingCapacityResponse) GetMaxEntries() uint32 {
+	if m != nil {
+		return m.MaxEntries
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryRedelegationTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UnbondingCapacityEpoch is the unbonding capacity of an upcoming unbonding
+// epoch of a host chain.
+type UnbondingCapacityEpoch struct {
+	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// estimated time at which the undelegations of the epoch are sent
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// validators with a delegation and a free unbonding entry in the epoch
+	AvailableValidators uint32 `protobuf:"varint,3,opt,name=available_validators,json=availableValidators,proto3" json:"available_validators,omitempty"`
+	// host tokens already requested to be unbonded in the epoch
+	QueuedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=queued_amount,json=queuedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"queued_amount"`
+	// host tokens that could still be unbonded in the epoch
+	Capacity github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=capacity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"capacity"`
 }
 
-func (m *QueryRedelegationTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RedelegationTx) > 0 {
-		for iNdEx := len(m.RedelegationTx) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RedelegationTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *UnbondingCapacityEpoch) Reset()         { *m = UnbondingCapacityEpoch{} }
+func (m *UnbondingCapacityEpoch) String() string { return proto.CompactTextString(m) }
+func (*UnbondingCapacityEpoch) ProtoMessage()    {}
+func (*UnbondingCapacityEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{63}
+}
+func (m *UnbondingCapacityEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingCapacityEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingCapacityEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *UnbondingCapacityEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingCapacityEpoch.Merge(m, src)
+}
+func (m *UnbondingCapacityEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingCapacityEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingCapacityEpoch.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_UnbondingCapacityEpoch proto.InternalMessageInfo
+
+func (m *UnbondingCapacityEpoch) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *UnbondingCapacityEpoch) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	var l int
-	_ = l
-	return n
+	return time.Time{}
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UnbondingCapacityEpoch) GetAvailableValidators() uint32 {
+	if m != nil {
+		return m.AvailableValidators
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryHostChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryPendingParamChangesRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryHostChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPendingParamChangesRequest) Reset()         { *m = QueryPendingParamChangesRequest{} }
+func (m *QueryPendingParamChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingParamChangesRequest) ProtoMessage()    {}
+func (*QueryPendingParamChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{64}
+}
+func (m *QueryPendingParamChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingParamChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingParamChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.HostChain.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryPendingParamChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingParamChangesRequest.Merge(m, src)
+}
+func (m *QueryPendingParamChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingParamChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingParamChangesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryHostChainsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryPendingParamChangesRequest proto.InternalMessageInfo
+
+func (m *QueryPendingParamChangesRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryHostChainsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.HostChains) > 0 {
-		for _, e := range m.HostChains {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+type QueryPendingParamChangesResponse struct {
+	Changes []*PendingParamChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (m *QueryPendingParamChangesResponse) Reset()         { *m = QueryPendingParamChangesResponse{} }
+func (m *QueryPendingParamChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingParamChangesResponse) ProtoMessage()    {}
+func (*QueryPendingParamChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{65}
+}
+func (m *QueryPendingParamChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingParamChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingParamChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryPendingParamChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingParamChangesResponse.Merge(m, src)
+}
+func (m *QueryPendingParamChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingParamChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingParamChangesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDepositsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryPendingParamChangesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingParamChangesResponse) GetChanges() []*PendingParamChange {
+	if m != nil {
+		return m.Changes
 	}
-	return n
+	return nil
 }
 
-func (m *QueryDepositsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Deposits) > 0 {
-		for _, e := range m.Deposits {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+type QueryICATxRetriesRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryICATxRetriesRequest) Reset()         { *m = QueryICATxRetriesRequest{} }
+func (m *QueryICATxRetriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryICATxRetriesRequest) ProtoMessage()    {}
+func (*QueryICATxRetriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{66}
+}
+func (m *QueryICATxRetriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryICATxRetriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryICATxRetriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryICATxRetriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryICATxRetriesRequest.Merge(m, src)
+}
+func (m *QueryICATxRetriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryICATxRetriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryICATxRetriesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLSMDepositsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryICATxRetriesRequest proto.InternalMessageInfo
+
+func (m *QueryICATxRetriesRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryLSMDepositsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Deposits) > 0 {
-		for _, e := range m.Deposits {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+type QueryICATxRetriesResponse struct {
+	Retries []*ICATxRetry `protobuf:"bytes,1,rep,name=retries,proto3" json:"retries,omitempty"`
+}
+
+func (m *QueryICATxRetriesResponse) Reset()         { *m = QueryICATxRetriesResponse{} }
+func (m *QueryICATxRetriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryICATxRetriesResponse) ProtoMessage()    {}
+func (*QueryICATxRetriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{67}
+}
+func (m *QueryICATxRetriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryICATxRetriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryICATxRetriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryICATxRetriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryICATxRetriesResponse.Merge(m, src)
+}
+func (m *QueryICATxRetriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryICATxRetriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryICATxRetriesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryUnbondingsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryICATxRetriesResponse proto.InternalMessageInfo
+
+func (m *QueryICATxRetriesResponse) GetRetries() []*ICATxRetry {
+	if m != nil {
+		return m.Retries
 	}
-	return n
+	return nil
 }
 
-func (m *QueryUnbondingsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Unbondings) > 0 {
-		for _, e := range m.Unbondings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+type QueryValidatorDrainsRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryValidatorDrainsRequest) Reset()         { *m = QueryValidatorDrainsRequest{} }
+func (m *QueryValidatorDrainsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorDrainsRequest) ProtoMessage()    {}
+func (*QueryValidatorDrainsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{68}
+}
+func (m *QueryValidatorDrainsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorDrainsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorDrainsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryValidatorDrainsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorDrainsRequest.Merge(m, src)
+}
+func (m *QueryValidatorDrainsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorDrainsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorDrainsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryUnbondingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Epoch != 0 {
-		n += 1 + sovQuery(uint64(m.Epoch))
+var xxx_messageInfo_QueryValidatorDrainsRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorDrainsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryUnbondingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Unbonding != nil {
-		l = m.Unbonding.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryValidatorDrainsResponse struct {
+	Drains []*ValidatorDrain `protobuf:"bytes,1,rep,name=drains,proto3" json:"drains,omitempty"`
 }
 
-func (m *QueryUserUnbondingsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryValidatorDrainsResponse) Reset()         { *m = QueryValidatorDrainsResponse{} }
+func (m *QueryValidatorDrainsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorDrainsResponse) ProtoMessage()    {}
+func (*QueryValidatorDrainsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{69}
+}
+func (m *QueryValidatorDrainsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorDrainsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorDrainsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryValidatorDrainsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorDrainsResponse.Merge(m, src)
+}
+func (m *QueryValidatorDrainsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorDrainsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorDrainsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryUserUnbondingsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryValidatorDrainsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorDrainsResponse) GetDrains() []*ValidatorDrain {
+	if m != nil {
+		return m.Drains
 	}
-	var l int
-	_ = l
-	if len(m.UserUnbondings) > 0 {
-		for _, e := range m.UserUnbondings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryFeeReportRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryFeeReportRequest) Reset()         { *m = QueryFeeReportRequest{} }
+func (m *QueryFeeReportRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeReportRequest) ProtoMessage()    {}
+func (*QueryFeeReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{70}
+}
+func (m *QueryFeeReportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeReportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryFeeReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeReportRequest.Merge(m, src)
+}
+func (m *QueryFeeReportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeReportRequest.DiscardUnknown(m)
 }
 
-func (m *QueryHostChainUserUnbondingsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryFeeReportRequest proto.InternalMessageInfo
+
+func (m *QueryFeeReportRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryHostChainUserUnbondingsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.UserUnbondings) > 0 {
-		for _, e := range m.UserUnbondings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+type QueryFeeReportResponse struct {
+	Report *FeeReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+}
+
+func (m *QueryFeeReportResponse) Reset()         { *m = QueryFeeReportResponse{} }
+func (m *QueryFeeReportResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeReportResponse) ProtoMessage()    {}
+func (*QueryFeeReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{71}
+}
+func (m *QueryFeeReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryFeeReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeReportResponse.Merge(m, src)
+}
+func (m *QueryFeeReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeReportResponse.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorUnbondingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryFeeReportResponse proto.InternalMessageInfo
+
+func (m *QueryFeeReportResponse) GetReport() *FeeReport {
+	if m != nil {
+		return m.Report
 	}
-	return n
+	return nil
 }
 
-func (m *QueryValidatorUnbondingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryRebateProgramRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryRebateProgramRequest) Reset()         { *m = QueryRebateProgramRequest{} }
+func (m *QueryRebateProgramRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRebateProgramRequest) ProtoMessage()    {}
+func (*QueryRebateProgramRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{72}
+}
+func (m *QueryRebateProgramRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRebateProgramRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRebateProgramRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.ValidatorUnbondings) > 0 {
-		for _, e := range m.ValidatorUnbondings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryRebateProgramRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRebateProgramRequest.Merge(m, src)
+}
+func (m *QueryRebateProgramRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRebateProgramRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRebateProgramRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRebateProgramRequest proto.InternalMessageInfo
+
+func (m *QueryRebateProgramRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+type QueryRebateProgramResponse struct {
+	// whether the program is active on the host chain
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// highest commission rate of a qualifying validator
+	MaxCommission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_commission,json=maxCommission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_commission"`
+	// weight boost of the qualifying validators
+	WeightBoost github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weight_boost,json=weightBoost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight_boost"`
+	Validators  []RebateValidator                      `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators"`
+}
+
+func (m *QueryRebateProgramResponse) Reset()         { *m = QueryRebateProgramResponse{} }
+func (m *QueryRebateProgramResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRebateProgramResponse) ProtoMessage()    {}
+func (*QueryRebateProgramResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{73}
+}
+func (m *QueryRebateProgramResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRebateProgramResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRebateProgramResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryRebateProgramResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRebateProgramResponse.Merge(m, src)
+}
+func (m *QueryRebateProgramResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRebateProgramResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRebateProgramResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDepositAccountBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryRebateProgramResponse proto.InternalMessageInfo
+
+func (m *QueryRebateProgramResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return false
+}
+
+func (m *QueryRebateProgramResponse) GetValidators() []RebateValidator {
+	if m != nil {
+		return m.Validators
 	}
-	return n
+	return nil
 }
 
-func (m *QueryDepositAccountBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// RebateValidator is the commission rebate program state of a host chain
+// validator.
+type RebateValidator struct {
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// commission rate last reported by the validator ICQ
+	CommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate"`
+	// whether the validator qualifies for the program
+	Qualifies bool `protobuf:"varint,3,opt,name=qualifies,proto3" json:"qualifies,omitempty"`
+	// weight stored for the validator
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+	// weight the validator is delegated to with the program boost applied
+	BoostedWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=boosted_weight,json=boostedWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"boosted_weight"`
+	// host tokens delegated to the validator while it qualified for the program
+	DelegatedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=delegated_amount,json=delegatedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegated_amount"`
+}
+
+func (m *RebateValidator) Reset()         { *m = RebateValidator{} }
+func (m *RebateValidator) String() string { return proto.CompactTextString(m) }
+func (*RebateValidator) ProtoMessage()    {}
+func (*RebateValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{74}
+}
+func (m *RebateValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RebateValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RebateValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Balance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *RebateValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebateValidator.Merge(m, src)
+}
+func (m *RebateValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *RebateValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebateValidator.DiscardUnknown(m)
 }
 
-func (m *QueryExchangeRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_RebateValidator proto.InternalMessageInfo
+
+func (m *RebateValidator) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *RebateValidator) GetQualifies() bool {
+	if m != nil {
+		return m.Qualifies
 	}
-	return n
+	return false
 }
 
-func (m *QueryExchangeRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryWorkflowFailuresRequest struct {
+}
+
+func (m *QueryWorkflowFailuresRequest) Reset()         { *m = QueryWorkflowFailuresRequest{} }
+func (m *QueryWorkflowFailuresRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWorkflowFailuresRequest) ProtoMessage()    {}
+func (*QueryWorkflowFailuresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{75}
+}
+func (m *QueryWorkflowFailuresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWorkflowFailuresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWorkflowFailuresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Rate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryWorkflowFailuresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWorkflowFailuresRequest.Merge(m, src)
+}
+func (m *QueryWorkflowFailuresRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWorkflowFailuresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWorkflowFailuresRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRedelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryWorkflowFailuresRequest proto.InternalMessageInfo
+
+type QueryWorkflowFailuresResponse struct {
+	Failures []*WorkflowFailure `protobuf:"bytes,1,rep,name=failures,proto3" json:"failures,omitempty"`
+}
+
+func (m *QueryWorkflowFailuresResponse) Reset()         { *m = QueryWorkflowFailuresResponse{} }
+func (m *QueryWorkflowFailuresResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWorkflowFailuresResponse) ProtoMessage()    {}
+func (*QueryWorkflowFailuresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{76}
+}
+func (m *QueryWorkflowFailuresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWorkflowFailuresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWorkflowFailuresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryWorkflowFailuresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWorkflowFailuresResponse.Merge(m, src)
+}
+func (m *QueryWorkflowFailuresResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWorkflowFailuresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWorkflowFailuresResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWorkflowFailuresResponse proto.InternalMessageInfo
+
+func (m *QueryWorkflowFailuresResponse) GetFailures() []*WorkflowFailure {
+	if m != nil {
+		return m.Failures
 	}
-	return n
+	return nil
 }
 
-func (m *QueryRedelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryValidatorScoresRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryValidatorScoresRequest) Reset()         { *m = QueryValidatorScoresRequest{} }
+func (m *QueryValidatorScoresRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorScoresRequest) ProtoMessage()    {}
+func (*QueryValidatorScoresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{77}
+}
+func (m *QueryValidatorScoresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorScoresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorScoresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Redelegations != nil {
-		l = m.Redelegations.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryValidatorScoresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorScoresRequest.Merge(m, src)
+}
+func (m *QueryValidatorScoresRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorScoresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorScoresRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorScoresRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorScoresRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+type QueryValidatorScoresResponse struct {
+	Validators []ScoredValidator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators"`
+}
+
+func (m *QueryValidatorScoresResponse) Reset()         { *m = QueryValidatorScoresResponse{} }
+func (m *QueryValidatorScoresResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorScoresResponse) ProtoMessage()    {}
+func (*QueryValidatorScoresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{78}
+}
+func (m *QueryValidatorScoresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorScoresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorScoresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorScoresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorScoresResponse.Merge(m, src)
+}
+func (m *QueryValidatorScoresResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorScoresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorScoresResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorScoresResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorScoresResponse) GetValidators() []ScoredValidator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+// ScoredValidator is the performance score of a host chain validator along
+// with the inputs it was computed from.
+type ScoredValidator struct {
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// last computed score, nil if the validator was not scored yet
+	Score *ValidatorScore `protobuf:"bytes,2,opt,name=score,proto3" json:"score,omitempty"`
+	// blocks missed by the validator in the current signed blocks window
+	MissedBlocksCounter int64 `protobuf:"varint,3,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// signed blocks window of the host chain
+	SignedBlocksWindow int64 `protobuf:"varint,4,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+	// commission rate last reported by the validator ICQ
+	CommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate"`
+	// total bonded tokens of the validator on the host chain
+	Tokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=tokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokens"`
+}
+
+func (m *ScoredValidator) Reset()         { *m = ScoredValidator{} }
+func (m *ScoredValidator) String() string { return proto.CompactTextString(m) }
+func (*ScoredValidator) ProtoMessage()    {}
+func (*ScoredValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{79}
+}
+func (m *ScoredValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScoredValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScoredValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScoredValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoredValidator.Merge(m, src)
+}
+func (m *ScoredValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScoredValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoredValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScoredValidator proto.InternalMessageInfo
+
+func (m *ScoredValidator) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *ScoredValidator) GetScore() *ValidatorScore {
+	if m != nil {
+		return m.Score
+	}
+	return nil
+}
+
+func (m *ScoredValidator) GetMissedBlocksCounter() int64 {
+	if m != nil {
+		return m.MissedBlocksCounter
+	}
+	return 0
+}
+
+func (m *ScoredValidator) GetSignedBlocksWindow() int64 {
+	if m != nil {
+		return m.SignedBlocksWindow
+	}
+	return 0
+}
+
+type QueryDepositsByStateRequest struct {
+	State Deposit_DepositState `protobuf:"varint,1,opt,name=state,proto3,enum=pstake.liquidstakeibc.v1beta1.Deposit_DepositState" json:"state,omitempty"`
+	// host chain of the deposits, all host chains if empty
+	ChainId    string             `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDepositsByStateRequest) Reset()         { *m = QueryDepositsByStateRequest{} }
+func (m *QueryDepositsByStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositsByStateRequest) ProtoMessage()    {}
+func (*QueryDepositsByStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{80}
+}
+func (m *QueryDepositsByStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositsByStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositsByStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDepositsByStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositsByStateRequest.Merge(m, src)
+}
+func (m *QueryDepositsByStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositsByStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositsByStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDepositsByStateRequest proto.InternalMessageInfo
+
+func (m *QueryDepositsByStateRequest) GetState() Deposit_DepositState {
+	if m != nil {
+		return m.State
+	}
+	return Deposit_DEPOSIT_PENDING
+}
+
+func (m *QueryDepositsByStateRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QueryDepositsByStateRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryDepositsByStateResponse struct {
+	Deposits   []*Deposit          `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDepositsByStateResponse) Reset()         { *m = QueryDepositsByStateResponse{} }
+func (m *QueryDepositsByStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositsByStateResponse) ProtoMessage()    {}
+func (*QueryDepositsByStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{81}
+}
+func (m *QueryDepositsByStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositsByStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositsByStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDepositsByStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositsByStateResponse.Merge(m, src)
+}
+func (m *QueryDepositsByStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositsByStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositsByStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDepositsByStateResponse proto.InternalMessageInfo
+
+func (m *QueryDepositsByStateResponse) GetDeposits() []*Deposit {
+	if m != nil {
+		return m.Deposits
+	}
+	return nil
+}
+
+func (m *QueryDepositsByStateResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryEpochFundingsRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryEpochFundingsRequest) Reset()         { *m = QueryEpochFundingsRequest{} }
+func (m *QueryEpochFundingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochFundingsRequest) ProtoMessage()    {}
+func (*QueryEpochFundingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{82}
+}
+func (m *QueryEpochFundingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochFundingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochFundingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochFundingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochFundingsRequest.Merge(m, src)
+}
+func (m *QueryEpochFundingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochFundingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochFundingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochFundingsRequest proto.InternalMessageInfo
+
+func (m *QueryEpochFundingsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+type QueryEpochFundingsResponse struct {
+	// oldest epoch first
+	Fundings []*EpochFundingStatus `protobuf:"bytes,1,rep,name=fundings,proto3" json:"fundings,omitempty"`
+}
+
+func (m *QueryEpochFundingsResponse) Reset()         { *m = QueryEpochFundingsResponse{} }
+func (m *QueryEpochFundingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochFundingsResponse) ProtoMessage()    {}
+func (*QueryEpochFundingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{83}
+}
+func (m *QueryEpochFundingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochFundingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochFundingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochFundingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochFundingsResponse.Merge(m, src)
+}
+func (m *QueryEpochFundingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochFundingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochFundingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochFundingsResponse proto.InternalMessageInfo
+
+func (m *QueryEpochFundingsResponse) GetFundings() []*EpochFundingStatus {
+	if m != nil {
+		return m.Fundings
+	}
+	return nil
+}
+
+type EpochFundingStatus struct {
+	// unbonding epoch
+	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// state of the unbonding of the epoch
+	State Unbonding_UnbondingState `protobuf:"varint,2,opt,name=state,proto3,enum=pstake.liquidstakeibc.v1beta1.Unbonding_UnbondingState" json:"state,omitempty"`
+	// host tokens the epoch still owes to its claimers
+	UnbondAmount types.Coin `protobuf:"bytes,3,opt,name=unbond_amount,json=unbondAmount,proto3" json:"unbond_amount"`
+	// funding received for the epoch, empty until its first transfer
+	Funding *EpochFunding `protobuf:"bytes,4,opt,name=funding,proto3" json:"funding,omitempty"`
+	// true once the claims of the epoch are paid, they are held while an
+	// earlier epoch is waiting for its transfer
+	Released bool `protobuf:"varint,5,opt,name=released,proto3" json:"released,omitempty"`
+}
+
+func (m *EpochFundingStatus) Reset()         { *m = EpochFundingStatus{} }
+func (m *EpochFundingStatus) String() string { return proto.CompactTextString(m) }
+func (*EpochFundingStatus) ProtoMessage()    {}
+func (*EpochFundingStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{84}
+}
+func (m *EpochFundingStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochFundingStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochFundingStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochFundingStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochFundingStatus.Merge(m, src)
+}
+func (m *EpochFundingStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochFundingStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochFundingStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochFundingStatus proto.InternalMessageInfo
+
+func (m *EpochFundingStatus) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EpochFundingStatus) GetState() Unbonding_UnbondingState {
+	if m != nil {
+		return m.State
+	}
+	return Unbonding_UNBONDING_PENDING
+}
+
+func (m *EpochFundingStatus) GetUnbondAmount() types.Coin {
+	if m != nil {
+		return m.UnbondAmount
+	}
+	return types.Coin{}
+}
+
+func (m *EpochFundingStatus) GetFunding() *EpochFunding {
+	if m != nil {
+		return m.Funding
+	}
+	return nil
+}
+
+func (m *EpochFundingStatus) GetReleased() bool {
+	if m != nil {
+		return m.Released
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryHostChainRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryHostChainRequest")
+	proto.RegisterType((*QueryHostChainResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryHostChainResponse")
+	proto.RegisterType((*QueryHostChainsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryHostChainsRequest")
+	proto.RegisterType((*QueryHostChainsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryHostChainsResponse")
+	proto.RegisterType((*QueryDepositsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositsRequest")
+	proto.RegisterType((*QueryDepositsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositsResponse")
+	proto.RegisterType((*QueryLSMDepositsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryLSMDepositsRequest")
+	proto.RegisterType((*QueryLSMDepositsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryLSMDepositsResponse")
+	proto.RegisterType((*QueryUnbondingsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryUnbondingsRequest")
+	proto.RegisterType((*QueryUnbondingsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryUnbondingsResponse")
+	proto.RegisterType((*QueryUnbondingRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryUnbondingRequest")
+	proto.RegisterType((*QueryUnbondingResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryUnbondingResponse")
+	proto.RegisterType((*QueryUserUnbondingsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryUserUnbondingsRequest")
+	proto.RegisterType((*QueryUserUnbondingsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryUserUnbondingsResponse")
+	proto.RegisterType((*QueryHostChainUserUnbondingsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryHostChainUserUnbondingsRequest")
+	proto.RegisterType((*QueryHostChainUserUnbondingsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryHostChainUserUnbondingsResponse")
+	proto.RegisterType((*QueryValidatorUnbondingRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryValidatorUnbondingRequest")
+	proto.RegisterType((*QueryValidatorUnbondingResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryValidatorUnbondingResponse")
+	proto.RegisterType((*QueryDepositAccountBalanceRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositAccountBalanceRequest")
+	proto.RegisterType((*QueryDepositAccountBalanceResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositAccountBalanceResponse")
+	proto.RegisterType((*QueryExchangeRateRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryExchangeRateRequest")
+	proto.RegisterType((*QueryExchangeRateResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryExchangeRateResponse")
+	proto.RegisterType((*QueryRedelegationsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryRedelegationsRequest")
+	proto.RegisterType((*QueryRedelegationsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryRedelegationsResponse")
+	proto.RegisterType((*QueryRedelegationTxRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryRedelegationTxRequest")
+	proto.RegisterType((*QueryRedelegationTxResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryRedelegationTxResponse")
+	proto.RegisterType((*QueryDepositReceiptsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositReceiptsRequest")
+	proto.RegisterType((*QueryDepositReceiptsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositReceiptsResponse")
+	proto.RegisterType((*QueryDepositReceiptRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositReceiptRequest")
+	proto.RegisterType((*QueryDepositReceiptResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositReceiptResponse")
+	proto.RegisterType((*QueryDepositShortfallsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositShortfallsRequest")
+	proto.RegisterType((*QueryDepositShortfallsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositShortfallsResponse")
+	proto.RegisterType((*QueryValidatorExitsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryValidatorExitsRequest")
+	proto.RegisterType((*QueryValidatorExitsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryValidatorExitsResponse")
+	proto.RegisterType((*QueryUnbondingsByEpochRangeRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryUnbondingsByEpochRangeRequest")
+	proto.RegisterType((*QueryUnbondingsByEpochRangeResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryUnbondingsByEpochRangeResponse")
+	proto.RegisterType((*UnbondingStateTotal)(nil), "pstake.liquidstakeibc.v1beta1.UnbondingStateTotal")
+	proto.RegisterType((*QueryChannelMigrationRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryChannelMigrationRequest")
+	proto.RegisterType((*QueryChannelMigrationResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryChannelMigrationResponse")
+	proto.RegisterType((*QueryPendingMintsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryPendingMintsRequest")
+	proto.RegisterType((*QueryPendingMintsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryPendingMintsResponse")
+	proto.RegisterType((*QueryDelegatorPendingMintsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryDelegatorPendingMintsRequest")
+	proto.RegisterType((*QueryDelegatorPendingMintsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryDelegatorPendingMintsResponse")
+	proto.RegisterType((*QueryModuleAccountsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryModuleAccountsRequest")
+	proto.RegisterType((*QueryModuleAccountsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryModuleAccountsResponse")
+	proto.RegisterType((*ModuleAccountBalance)(nil), "pstake.liquidstakeibc.v1beta1.ModuleAccountBalance")
+	proto.RegisterType((*QueryRelayLatencyRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryRelayLatencyRequest")
+	proto.RegisterType((*QueryRelayLatencyResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryRelayLatencyResponse")
+	proto.RegisterType((*QueryDepositCapacityRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositCapacityRequest")
+	proto.RegisterType((*QueryDepositCapacityResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositCapacityResponse")
+	proto.RegisterType((*QueryCValueHistoryRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryCValueHistoryRequest")
+	proto.RegisterType((*QueryCValueHistoryResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryCValueHistoryResponse")
+	proto.RegisterType((*QueryPendingProposalsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryPendingProposalsRequest")
+	proto.RegisterType((*QueryPendingProposalsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryPendingProposalsResponse")
+	proto.RegisterType((*ModuleProposal)(nil), "pstake.liquidstakeibc.v1beta1.ModuleProposal")
+	proto.RegisterType((*ProposalMessage)(nil), "pstake.liquidstakeibc.v1beta1.ProposalMessage")
+	proto.RegisterType((*QueryValidatorWeightsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryValidatorWeightsRequest")
+	proto.RegisterType((*QueryValidatorWeightsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryValidatorWeightsResponse")
+	proto.RegisterType((*ValidatorWeight)(nil), "pstake.liquidstakeibc.v1beta1.ValidatorWeight")
+	proto.RegisterType((*QueryUnbondingCapacityRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryUnbondingCapacityRequest")
+	proto.RegisterType((*QueryUnbondingCapacityResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryUnbondingCapacityResponse")
+	proto.RegisterType((*UnbondingCapacityEpoch)(nil), "pstake.liquidstakeibc.v1beta1.UnbondingCapacityEpoch")
+	proto.RegisterType((*QueryPendingParamChangesRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryPendingParamChangesRequest")
+	proto.RegisterType((*QueryPendingParamChangesResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryPendingParamChangesResponse")
+	proto.RegisterType((*QueryICATxRetriesRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryICATxRetriesRequest")
+	proto.RegisterType((*QueryICATxRetriesResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryICATxRetriesResponse")
+	proto.RegisterType((*QueryValidatorDrainsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryValidatorDrainsRequest")
+	proto.RegisterType((*QueryValidatorDrainsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryValidatorDrainsResponse")
+	proto.RegisterType((*QueryFeeReportRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryFeeReportRequest")
+	proto.RegisterType((*QueryFeeReportResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryFeeReportResponse")
+	proto.RegisterType((*QueryRebateProgramRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryRebateProgramRequest")
+	proto.RegisterType((*QueryRebateProgramResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryRebateProgramResponse")
+	proto.RegisterType((*RebateValidator)(nil), "pstake.liquidstakeibc.v1beta1.RebateValidator")
+	proto.RegisterType((*QueryWorkflowFailuresRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryWorkflowFailuresRequest")
+	proto.RegisterType((*QueryWorkflowFailuresResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryWorkflowFailuresResponse")
+	proto.RegisterType((*QueryValidatorScoresRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryValidatorScoresRequest")
+	proto.RegisterType((*QueryValidatorScoresResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryValidatorScoresResponse")
+	proto.RegisterType((*ScoredValidator)(nil), "pstake.liquidstakeibc.v1beta1.ScoredValidator")
+	proto.RegisterType((*QueryDepositsByStateRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositsByStateRequest")
+	proto.RegisterType((*QueryDepositsByStateResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositsByStateResponse")
+	proto.RegisterType((*QueryEpochFundingsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryEpochFundingsRequest")
+	proto.RegisterType((*QueryEpochFundingsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryEpochFundingsResponse")
+	proto.RegisterType((*EpochFundingStatus)(nil), "pstake.liquidstakeibc.v1beta1.EpochFundingStatus")
+}
+
+func init() {
+	proto.RegisterFile("pstake/liquidstakeibc/v1beta1/query.proto", fileDescriptor_b143d1c5e28840b2)
+}
+
+var fileDescriptor_b143d1c5e28840b2 = []byte{
+	// 3909 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0x4b, 0x6c, 0x1c, 0x47,
+	0x7a, 0x76, 0x0f, 0xdf, 0x3f, 0x9f, 0x2e, 0xd1, 0x32, 0xd9, 0x92, 0x48, 0x6d, 0x6f, 0x76, 0xd7,
+	0x2f, 0x71, 0x2c, 0xea, 0x61, 0xbe, 0xf4, 0x20, 0x29, 0x69, 0x45, 0xc5, 0xda, 0x55, 0x9a, 0xb4,
+	0xbd, 0xf0, 0x06, 0xe8, 0x6d, 0xce, 0x94, 0x86, 0x1d, 0xcd, 0x74, 0x8f, 0xba, 0x7b, 0x28, 0x32,
+	0x82, 0x10, 0x60, 0x2f, 0xc9, 0xd1, 0x40, 0x80, 0x20, 0xa7, 0x9c, 0x02, 0x04, 0x48, 0x0e, 0x8b,
+	0x00, 0x8b, 0x00, 0x39, 0x24, 0xc1, 0xe6, 0xb1, 0xde, 0x6c, 0x92, 0x85, 0xe1, 0x00, 0x41, 0x10,
+	0x04, 0x76, 0x60, 0xc5, 0xc8, 0x25, 0x87, 0x5c, 0x82, 0x9c, 0x02, 0x2c, 0xba, 0xea, 0xaf, 0xea,
+	0xc7, 0xf4, 0x70, 0xaa, 0x47, 0xe3, 0xd3, 0x4c, 0x57, 0xf5, 0xf7, 0xd5, 0xff, 0x57, 0x57, 0xfd,
+	0xf5, 0x57, 0xd5, 0x07, 0xaf, 0x37, 0x83, 0xd0, 0x7e, 0x44, 0xcb, 0x75, 0xe7, 0x71, 0xcb, 0xa9,
+	0xb2, 0xff, 0xce, 0x7e, 0xa5, 0x7c, 0x78, 0x71, 0x9f, 0x86, 0xf6, 0xc5, 0xf2, 0xe3, 0x16, 0xf5,
+	0x8f, 0x97, 0x9a, 0xbe, 0x17, 0x7a, 0xe4, 0x1c, 0x7f, 0x75, 0x29, 0xfd, 0xea, 0x12, 0xbe, 0xaa,
+	0xcf, 0xd6, 0xbc, 0x9a, 0xc7, 0xde, 0x2c, 0x47, 0xff, 0x38, 0x48, 0x9f, 0xaf, 0x78, 0x41, 0xc3,
+	0x0b, 0x2c, 0x5e, 0xc1, 0x1f, 0xb0, 0xea, 0x6c, 0xcd, 0xf3, 0x6a, 0x75, 0x5a, 0xb6, 0x9b, 0x4e,
+	0xd9, 0x76, 0x5d, 0x2f, 0xb4, 0x43, 0xc7, 0x73, 0x45, 0xed, 0x1b, 0xfc, 0xdd, 0xf2, 0xbe, 0x1d,
+	0x50, 0x6e, 0x86, 0x34, 0xaa, 0x69, 0xd7, 0x1c, 0x97, 0xbd, 0x8c, 0xef, 0x2e, 0x24, 0xdf, 0x15,
+	0x6f, 0x55, 0x3c, 0x47, 0xd6, 0x63, 0x4b, 0xec, 0x69, 0xbf, 0xf5, 0xb0, 0x5c, 0x6d, 0xf9, 0x49,
+	0xfc, 0x62, 0xb6, 0x3e, 0x74, 0x1a, 0x34, 0x08, 0xed, 0x46, 0x13, 0x5f, 0x78, 0x15, 0x1b, 0xa8,
+	0x79, 0x87, 0xe5, 0xc3, 0x8b, 0xd1, 0x8f, 0xb0, 0xf2, 0xe4, 0xee, 0x6b, 0xda, 0xbe, 0xdd, 0x10,
+	0x1e, 0x2d, 0x9f, 0xfc, 0x6e, 0xa6, 0x5b, 0x19, 0xc6, 0x98, 0x05, 0xf2, 0x6b, 0x91, 0xef, 0x0f,
+	0x18, 0x91, 0x49, 0x1f, 0xb7, 0x68, 0x10, 0x1a, 0x1f, 0xc2, 0xa9, 0x54, 0x69, 0xd0, 0xf4, 0xdc,
+	0x80, 0x92, 0x6d, 0x18, 0xe6, 0x0d, 0xce, 0x69, 0xe7, 0xb5, 0xd7, 0xc6, 0x97, 0xbf, 0xb1, 0x74,
+	0xe2, 0x17, 0x5b, 0xe2, 0xf0, 0xad, 0xc1, 0x9f, 0x7d, 0xb6, 0xf8, 0x92, 0x89, 0x50, 0x63, 0x19,
+	0x5e, 0x61, 0xdc, 0x77, 0xbd, 0x20, 0xdc, 0x3e, 0xb0, 0x1d, 0x17, 0x1b, 0x25, 0xf3, 0x30, 0x5a,
+	0x89, 0x9e, 0x2d, 0xa7, 0xca, 0xf8, 0xc7, 0xcc, 0x11, 0xf6, 0xbc, 0x53, 0x35, 0x6a, 0x70, 0x3a,
+	0x8b, 0x41, 0x93, 0xee, 0x03, 0x1c, 0x78, 0x41, 0x68, 0xb1, 0x37, 0xd1, 0xac, 0xd7, 0xba, 0x98,
+	0x25, 0x59, 0xd0, 0xb2, 0xb1, 0x03, 0x51, 0x60, 0xcc, 0x65, 0x1b, 0x92, 0x5d, 0x52, 0x85, 0x57,
+	0xdb, 0x6a, 0xd0, 0x86, 0x1d, 0x18, 0x8f, 0x6d, 0x88, 0xfa, 0x66, 0xa0, 0x88, 0x11, 0x26, 0xc8,
+	0xe6, 0x03, 0xe3, 0x22, 0xcc, 0xb2, 0x56, 0x6e, 0xd1, 0xa6, 0x17, 0x38, 0x61, 0xa0, 0xd0, 0x37,
+	0xdf, 0x87, 0x57, 0x32, 0x10, 0x34, 0x6b, 0x0b, 0x46, 0xab, 0x58, 0x86, 0x36, 0x7d, 0xb3, 0x8b,
+	0x4d, 0x48, 0x61, 0x4a, 0x9c, 0x71, 0x19, 0xbd, 0x7e, 0x77, 0xf7, 0x7e, 0x01, 0x93, 0x6c, 0x98,
+	0x6b, 0x47, 0xa1, 0x55, 0xb7, 0xdb, 0xac, 0x7a, 0xbd, 0x8b, 0x55, 0x31, 0x4b, 0xc2, 0xb0, 0x4b,
+	0xf8, 0xa1, 0xde, 0x73, 0xf7, 0x3d, 0xb7, 0xea, 0xb8, 0x35, 0x15, 0xbb, 0x2a, 0xf0, 0x6a, 0x1b,
+	0x08, 0xcd, 0xba, 0x0b, 0xd0, 0x92, 0xa5, 0x8a, 0x9f, 0x50, 0xd2, 0x98, 0x09, 0xac, 0x71, 0x17,
+	0xbf, 0x47, 0x5c, 0xdb, 0xd5, 0x30, 0x32, 0x0b, 0x43, 0xb4, 0xe9, 0x55, 0x0e, 0xe6, 0x4a, 0xe7,
+	0xb5, 0xd7, 0x06, 0x4c, 0xfe, 0x60, 0xfc, 0x20, 0xeb, 0xa3, 0xb4, 0xf6, 0x0e, 0x8c, 0xc9, 0x16,
+	0x15, 0x07, 0x7d, 0x4c, 0x12, 0x43, 0x8d, 0xab, 0xa0, 0xf3, 0x16, 0x02, 0xea, 0xb7, 0xf7, 0xe4,
+	0x1c, 0x8c, 0xd8, 0xd5, 0xaa, 0x4f, 0x83, 0x40, 0xd8, 0x8b, 0x8f, 0x46, 0x08, 0x67, 0x72, 0x71,
+	0x68, 0xde, 0x7b, 0x30, 0xdd, 0x0a, 0xa8, 0x6f, 0xb5, 0xf5, 0xe8, 0x5b, 0xdd, 0x8c, 0x4c, 0xf2,
+	0x99, 0x53, 0xad, 0x14, 0xbd, 0xf1, 0x3b, 0x1a, 0x7c, 0x3d, 0x3d, 0x07, 0xf3, 0xed, 0x3e, 0xa1,
+	0xa3, 0xef, 0x00, 0xc4, 0xc1, 0x9d, 0xf5, 0x76, 0x34, 0x2b, 0x70, 0xd5, 0x88, 0xa2, 0xfb, 0x12,
+	0x5f, 0x90, 0xe2, 0x08, 0x56, 0xa3, 0x48, 0x6b, 0x26, 0x90, 0xc6, 0x4f, 0x35, 0xf8, 0x95, 0x93,
+	0x4d, 0xf9, 0x4a, 0xbb, 0x82, 0x7c, 0x3b, 0xc7, 0x8f, 0x6f, 0x75, 0xf5, 0x83, 0xdb, 0x94, 0x72,
+	0x64, 0x1d, 0x16, 0x98, 0x1f, 0xef, 0xdb, 0x75, 0xa7, 0x6a, 0x87, 0x9e, 0x5f, 0x60, 0xd8, 0x1a,
+	0xbf, 0xad, 0xc1, 0x62, 0x47, 0x34, 0x76, 0x40, 0x15, 0x66, 0x0f, 0x45, 0x6d, 0x7b, 0x2f, 0x5c,
+	0xec, 0xd2, 0x0b, 0x39, 0xc4, 0xa7, 0x0e, 0xdb, 0xca, 0x02, 0xe3, 0x3a, 0x7c, 0x2d, 0x19, 0x04,
+	0x37, 0x2b, 0x15, 0xaf, 0xe5, 0x86, 0x5b, 0x76, 0xdd, 0x76, 0x2b, 0x54, 0xc1, 0x13, 0x0b, 0x8c,
+	0x93, 0xf0, 0xe8, 0xcb, 0x2a, 0x8c, 0xec, 0xf3, 0x22, 0x9c, 0x74, 0xf3, 0xa9, 0x2e, 0x17, 0x46,
+	0x6f, 0x7b, 0x72, 0x69, 0x11, 0xef, 0x1b, 0x57, 0x30, 0x24, 0xde, 0x3e, 0xaa, 0x1c, 0xd8, 0x6e,
+	0x8d, 0x9a, 0x76, 0xa8, 0x62, 0x57, 0x03, 0xe6, 0x73, 0x60, 0x68, 0xce, 0x03, 0x18, 0xf4, 0xed,
+	0x90, 0xdb, 0x32, 0xb6, 0xb5, 0x11, 0x35, 0xf8, 0x6f, 0x9f, 0x2d, 0x7e, 0xb3, 0xe6, 0x84, 0x07,
+	0xad, 0xfd, 0xa5, 0x8a, 0xd7, 0xc0, 0x74, 0x08, 0x7f, 0x2e, 0x04, 0xd5, 0x47, 0xe5, 0xf0, 0xb8,
+	0x49, 0x83, 0xa5, 0x5b, 0xb4, 0xf2, 0xe9, 0x8f, 0x2f, 0x00, 0x1a, 0x7f, 0x8b, 0x56, 0x4c, 0xc6,
+	0x64, 0x5c, 0xc5, 0xe6, 0x4c, 0x5a, 0xa5, 0x75, 0x5a, 0xe3, 0xf9, 0x92, 0x82, 0x99, 0x4d, 0xd0,
+	0xf3, 0x70, 0x68, 0xa7, 0x09, 0x93, 0x7e, 0xb2, 0x02, 0x3b, 0xaf, 0xdb, 0x0c, 0x48, 0x93, 0xa5,
+	0x29, 0x8c, 0x77, 0x72, 0x5a, 0xdc, 0x3b, 0x52, 0x30, 0x35, 0x80, 0x33, 0xb9, 0x40, 0xb4, 0x75,
+	0x0f, 0xa6, 0x93, 0x0d, 0x59, 0xe1, 0x11, 0x8e, 0xd4, 0x37, 0x55, 0xad, 0xa5, 0x7b, 0x47, 0xe6,
+	0x94, 0x9f, 0x62, 0x37, 0x7e, 0x0b, 0xce, 0x24, 0x87, 0x97, 0x49, 0x2b, 0xd4, 0x69, 0x86, 0xdd,
+	0x03, 0x6d, 0xdf, 0xe2, 0xd5, 0x4f, 0x34, 0x38, 0x9b, 0x6f, 0x01, 0xfa, 0xfd, 0x3d, 0x98, 0xc1,
+	0xb5, 0xd5, 0xf2, 0xb1, 0x0e, 0x1d, 0xbf, 0xa0, 0x98, 0x34, 0x70, 0x94, 0x39, 0x5d, 0x4d, 0xb7,
+	0xd0, 0xbf, 0x50, 0xf5, 0x16, 0x7e, 0xf2, 0x4c, 0x83, 0xd8, 0x87, 0x53, 0x50, 0xc2, 0x8f, 0x3d,
+	0x68, 0x96, 0x9c, 0xaa, 0xf1, 0x34, 0xb7, 0xcb, 0xa5, 0xbf, 0xbf, 0x0e, 0xd3, 0x19, 0x7f, 0x71,
+	0x54, 0x16, 0x73, 0x17, 0xa7, 0xf9, 0x54, 0xda, 0x69, 0x63, 0x0d, 0xce, 0x25, 0x1b, 0xdf, 0x3d,
+	0xf0, 0xfc, 0xf0, 0xa1, 0x5d, 0xaf, 0xab, 0xcc, 0xa5, 0xc7, 0xb0, 0xd0, 0x09, 0x8b, 0xb6, 0x7f,
+	0x17, 0x20, 0x90, 0xa5, 0xf8, 0x95, 0xca, 0x6a, 0x66, 0x4b, 0x36, 0x33, 0x41, 0x21, 0x27, 0x93,
+	0x8c, 0xb6, 0xb7, 0x8f, 0x54, 0x13, 0xbd, 0x33, 0xb9, 0x40, 0x99, 0x81, 0x0e, 0xd1, 0xa3, 0x38,
+	0xd1, 0x7b, 0x4b, 0x35, 0xd8, 0x47, 0x2c, 0x26, 0x87, 0x1a, 0xcf, 0x30, 0x32, 0xc7, 0xc1, 0x7e,
+	0xeb, 0xf8, 0x76, 0x94, 0x1e, 0x99, 0x2c, 0x1e, 0x76, 0x5f, 0xf2, 0x17, 0x61, 0x3c, 0x08, 0x6d,
+	0x3f, 0xb4, 0x92, 0x19, 0x16, 0xb0, 0x22, 0xc6, 0x43, 0xce, 0xc0, 0x18, 0x75, 0xab, 0x58, 0x3d,
+	0xc0, 0xaa, 0x47, 0xa9, 0x5b, 0x65, 0x95, 0xc6, 0x4f, 0x44, 0xce, 0xd1, 0xa9, 0xfd, 0x7e, 0xe7,
+	0x8f, 0xe4, 0x01, 0x0c, 0x87, 0x5e, 0x68, 0xd7, 0x83, 0xb9, 0x12, 0x63, 0x59, 0x56, 0x65, 0xd9,
+	0x0d, 0xa3, 0xe0, 0x13, 0x41, 0xc5, 0x8e, 0x8b, 0xf3, 0x18, 0x3f, 0x2c, 0xc1, 0xa9, 0x9c, 0xb7,
+	0xc8, 0x7d, 0x18, 0x0a, 0x42, 0xb1, 0x80, 0x4c, 0x2d, 0xbf, 0xa3, 0xda, 0x50, 0xa6, 0x49, 0x93,
+	0xb3, 0x44, 0x49, 0x2c, 0x5b, 0x35, 0x59, 0x17, 0x0f, 0x9a, 0xfc, 0x81, 0xdc, 0x84, 0xf1, 0xfd,
+	0x96, 0xef, 0x5a, 0x76, 0x83, 0xd5, 0x0d, 0xa8, 0xad, 0x9b, 0x10, 0x61, 0x36, 0x19, 0x84, 0xdc,
+	0x82, 0x49, 0xde, 0x3d, 0x82, 0x63, 0x50, 0x8d, 0x63, 0x82, 0xa3, 0x38, 0x8b, 0xb1, 0x8a, 0x01,
+	0x70, 0xfb, 0xc0, 0x76, 0x5d, 0x5a, 0xbf, 0xef, 0xd4, 0xf8, 0x0e, 0x5d, 0x61, 0x94, 0x7f, 0xa4,
+	0xc1, 0xb9, 0x0e, 0x58, 0xfc, 0xfa, 0xbb, 0x30, 0xd6, 0x10, 0x85, 0x18, 0x47, 0xba, 0x4d, 0xc8,
+	0x2c, 0x97, 0xd8, 0x8b, 0x4a, 0x1e, 0xa2, 0xc3, 0xe8, 0x7e, 0xdd, 0xab, 0x3c, 0xa2, 0x3e, 0x1f,
+	0x0a, 0x63, 0xa6, 0x7c, 0x96, 0xe9, 0xc4, 0x03, 0xca, 0xbe, 0xc3, 0x7d, 0xc7, 0x55, 0x9a, 0xaf,
+	0x75, 0x98, 0xcf, 0x81, 0xc9, 0xb0, 0x32, 0xd9, 0xe4, 0xe5, 0x56, 0x23, 0xaa, 0xc0, 0x51, 0xfc,
+	0x46, 0xb7, 0x4d, 0x7e, 0xcc, 0x65, 0x4e, 0x34, 0xe3, 0x87, 0xc0, 0x78, 0x20, 0x93, 0x32, 0xb6,
+	0x14, 0x7a, 0x7e, 0x9e, 0xb5, 0x6f, 0xc2, 0xcb, 0x55, 0x51, 0x6f, 0xa5, 0x57, 0xc1, 0x19, 0x59,
+	0xb1, 0xc9, 0xcb, 0x8d, 0x96, 0x4c, 0xd3, 0x72, 0x19, 0xbf, 0x2a, 0x47, 0xce, 0x62, 0x7c, 0xbc,
+	0xef, 0x55, 0x5b, 0x75, 0x8a, 0xc9, 0xa1, 0x3c, 0x19, 0x10, 0x9b, 0xa1, 0x6c, 0xad, 0xdc, 0x01,
+	0x8c, 0xda, 0x58, 0x86, 0x86, 0x5c, 0xea, 0x62, 0x48, 0x8a, 0x08, 0x73, 0x50, 0x1c, 0x1e, 0x92,
+	0xca, 0xf8, 0x58, 0x83, 0xd9, 0xbc, 0x17, 0x09, 0x81, 0x41, 0xd7, 0x6e, 0x60, 0x56, 0x68, 0xb2,
+	0xff, 0x64, 0x39, 0x4e, 0x30, 0x4a, 0x2c, 0x59, 0x9c, 0xfb, 0xf4, 0xc7, 0x17, 0x66, 0x71, 0xfe,
+	0x60, 0xe7, 0xee, 0x86, 0x7e, 0x14, 0x8a, 0xc4, 0x8b, 0xa4, 0x06, 0xa3, 0x98, 0xbc, 0x06, 0x73,
+	0x03, 0xe7, 0x07, 0x4e, 0x9e, 0x71, 0x6f, 0x47, 0xd6, 0xfd, 0xf1, 0xe7, 0x8b, 0xaf, 0x29, 0x24,
+	0x9f, 0x11, 0x20, 0x30, 0x25, 0xb9, 0x71, 0x03, 0xc7, 0xb2, 0x49, 0xeb, 0xf6, 0xf1, 0xbb, 0x76,
+	0x48, 0xdd, 0xca, 0xb1, 0x18, 0x1d, 0x5f, 0x87, 0xc9, 0x8a, 0xe7, 0xba, 0xb4, 0xc2, 0x92, 0x31,
+	0x39, 0xa0, 0x27, 0xe2, 0xc2, 0x9d, 0xaa, 0xf1, 0x47, 0x1a, 0xcc, 0xe7, 0x30, 0x60, 0xff, 0xff,
+	0x2a, 0x8c, 0xd4, 0x79, 0x11, 0xce, 0xcc, 0xee, 0x99, 0x5c, 0xcc, 0x22, 0xd2, 0x78, 0x64, 0x20,
+	0xd7, 0x60, 0x24, 0x74, 0x1a, 0xd4, 0x6b, 0x85, 0x98, 0xc9, 0xcc, 0x2f, 0xf1, 0xa3, 0xbd, 0x25,
+	0x71, 0xb4, 0xb7, 0x74, 0x0b, 0x8f, 0xfe, 0xb6, 0x46, 0x23, 0xe8, 0xef, 0x7f, 0xbe, 0xa8, 0x99,
+	0x02, 0x63, 0xac, 0xa4, 0x93, 0x92, 0x6d, 0xbb, 0x69, 0x57, 0x9c, 0xf0, 0x58, 0x61, 0xe6, 0x3e,
+	0x2f, 0xc1, 0xd9, 0x7c, 0x28, 0xba, 0xf9, 0x1b, 0x40, 0x1a, 0xf6, 0x91, 0x25, 0x92, 0x1a, 0x0c,
+	0x95, 0xc5, 0xb7, 0x06, 0x3b, 0x6e, 0x98, 0xd8, 0x1a, 0xec, 0xb8, 0xa1, 0x39, 0xd3, 0xb0, 0x8f,
+	0xc4, 0xbe, 0x88, 0x47, 0x64, 0x17, 0x66, 0x79, 0xdf, 0x59, 0xac, 0xf3, 0x64, 0x60, 0x2e, 0xf5,
+	0xa1, 0x35, 0xc2, 0x99, 0x77, 0x19, 0x31, 0xb6, 0x57, 0x83, 0x19, 0x9f, 0x36, 0x6c, 0xc7, 0x8d,
+	0xa6, 0x74, 0x62, 0x21, 0x79, 0xd1, 0xb6, 0xa6, 0x25, 0x2b, 0x2e, 0x12, 0x62, 0xff, 0xb3, 0xfd,
+	0xbe, 0x5d, 0x6f, 0xd1, 0xbb, 0x4e, 0x10, 0x7a, 0xfe, 0xb1, 0xd2, 0xc1, 0x92, 0x9e, 0x87, 0x93,
+	0x47, 0x5e, 0x23, 0x3e, 0xad, 0x78, 0x7e, 0x35, 0x50, 0xdc, 0x4b, 0x70, 0x1a, 0x93, 0x61, 0x4c,
+	0x81, 0x95, 0x2b, 0x18, 0xc6, 0xa9, 0x07, 0xbe, 0xd7, 0xf4, 0x02, 0xbb, 0xae, 0x16, 0xf7, 0xcf,
+	0x75, 0x80, 0xca, 0x49, 0x32, 0xd6, 0x14, 0x85, 0x8a, 0x79, 0x3f, 0x0f, 0x3e, 0x82, 0xca, 0x8c,
+	0xf1, 0xc6, 0xef, 0x0d, 0xc0, 0x54, 0xba, 0x36, 0x4a, 0xc2, 0x44, 0xbd, 0x25, 0xd3, 0x74, 0x10,
+	0x45, 0x3b, 0x55, 0x72, 0x05, 0x86, 0x83, 0xd0, 0x0e, 0x5b, 0x3c, 0x40, 0x4d, 0x2d, 0x9f, 0x13,
+	0xb1, 0x26, 0x3a, 0x0a, 0x3f, 0xbc, 0xb8, 0x24, 0x98, 0x76, 0xd9, 0x4b, 0x26, 0xbe, 0x1c, 0xe5,
+	0x1c, 0xa1, 0x13, 0xd6, 0x29, 0x1f, 0x0e, 0x26, 0x7f, 0x88, 0xf6, 0x53, 0x41, 0xab, 0xd1, 0xb0,
+	0xfd, 0x63, 0x96, 0x2b, 0x8c, 0x99, 0xe2, 0x31, 0x5a, 0x53, 0x1b, 0x34, 0xb4, 0xab, 0x76, 0x68,
+	0xcf, 0x0d, 0xb1, 0x2a, 0xf9, 0x4c, 0xee, 0xc5, 0x5b, 0xa0, 0x28, 0x1f, 0x8c, 0xe6, 0xec, 0xdc,
+	0x30, 0x9b, 0xe4, 0x7a, 0xdb, 0x24, 0xdf, 0x13, 0xe7, 0xf7, 0x5b, 0x83, 0x1f, 0x45, 0x33, 0x5c,
+	0x6c, 0x00, 0x6e, 0xbb, 0xd5, 0xa8, 0x8a, 0xdc, 0x85, 0xe9, 0x43, 0x2f, 0x8c, 0x86, 0xab, 0xa4,
+	0x1a, 0x51, 0xa4, 0x9a, 0xe4, 0x40, 0xc1, 0x74, 0x2f, 0xb2, 0x38, 0x08, 0xec, 0x1a, 0x0d, 0xe6,
+	0x46, 0xd9, 0x87, 0x59, 0xea, 0xb6, 0x8e, 0x61, 0x57, 0xdd, 0xe7, 0x30, 0x53, 0xe2, 0x0d, 0x07,
+	0xa6, 0x33, 0x95, 0xd1, 0xa0, 0x89, 0x66, 0x87, 0xd5, 0xf2, 0xeb, 0x62, 0xd0, 0x44, 0xcf, 0xef,
+	0xf9, 0xf5, 0xd4, 0x78, 0x2a, 0xa5, 0x73, 0xea, 0xf3, 0x30, 0x5e, 0xa5, 0x41, 0xc5, 0x77, 0x9a,
+	0x2c, 0xe3, 0xe1, 0x9d, 0x9f, 0x2c, 0x92, 0x83, 0x55, 0xe6, 0xf4, 0x1f, 0x50, 0xa7, 0x76, 0xa0,
+	0x94, 0xa4, 0xfc, 0x5c, 0xa4, 0x5b, 0xed, 0x58, 0x99, 0x6c, 0x8f, 0x3c, 0xe1, 0x45, 0x73, 0x9a,
+	0x52, 0x97, 0x64, 0x98, 0x4c, 0x01, 0x27, 0x16, 0x4c, 0xb0, 0x24, 0xd9, 0xe2, 0x05, 0x73, 0xa5,
+	0x3e, 0x1c, 0xa5, 0x8c, 0x33, 0x46, 0xde, 0x92, 0xf1, 0xff, 0x1a, 0x4c, 0x67, 0x5a, 0x27, 0xaf,
+	0xc3, 0x8c, 0xd7, 0xa4, 0x7e, 0x4e, 0xc6, 0x33, 0x2d, 0xca, 0x71, 0x4d, 0x26, 0x7b, 0x30, 0xdc,
+	0x47, 0xcb, 0x90, 0x8b, 0x38, 0xf0, 0xb2, 0xeb, 0xf9, 0x0d, 0xbb, 0xee, 0xfc, 0x26, 0xad, 0x0a,
+	0xd7, 0x07, 0xfa, 0xd0, 0xc0, 0x4c, 0x4c, 0x8b, 0xfe, 0x9b, 0xf8, 0x2d, 0xe5, 0x96, 0x41, 0x7d,
+	0xcd, 0x23, 0xa7, 0x61, 0x98, 0x6d, 0xca, 0x78, 0x4c, 0x98, 0x34, 0xf1, 0xc9, 0xf8, 0x5f, 0x0d,
+	0x16, 0x3a, 0x91, 0xca, 0x6b, 0x21, 0x01, 0xe5, 0x03, 0xe4, 0x8a, 0xea, 0xde, 0x46, 0x30, 0xf1,
+	0x2d, 0x1e, 0x92, 0x90, 0x0a, 0x4c, 0xf1, 0x61, 0x52, 0xc1, 0xea, 0xbe, 0x2c, 0x75, 0x93, 0x8c,
+	0x53, 0xb4, 0x18, 0xc5, 0xc8, 0x68, 0x05, 0xa7, 0x6e, 0xe8, 0x3b, 0x2c, 0xe7, 0x8a, 0x7c, 0x86,
+	0x86, 0x7d, 0x74, 0x9b, 0x97, 0x18, 0x5f, 0x96, 0xe0, 0x74, 0xbe, 0xa1, 0xe4, 0x6b, 0x30, 0xc1,
+	0x4c, 0xb5, 0xdc, 0x56, 0x63, 0x9f, 0xfa, 0xac, 0x27, 0x07, 0xcc, 0x71, 0x56, 0xf6, 0x1d, 0x56,
+	0x44, 0x56, 0x60, 0x90, 0xc5, 0xa1, 0x52, 0xd7, 0x38, 0xc4, 0x12, 0x17, 0x16, 0x8b, 0x18, 0x82,
+	0x5c, 0x84, 0x59, 0xfb, 0xd0, 0x76, 0xea, 0xf6, 0x7e, 0x9d, 0x5a, 0xf2, 0xf4, 0x55, 0x58, 0x78,
+	0x4a, 0xd6, 0xc9, 0x71, 0x1e, 0x10, 0x1b, 0x26, 0x1f, 0xb7, 0x68, 0x8b, 0xa6, 0xf6, 0x6c, 0x2f,
+	0xda, 0x5f, 0x13, 0x9c, 0x12, 0x93, 0x82, 0xef, 0xc1, 0xa8, 0xfc, 0x1a, 0x43, 0x7d, 0x60, 0x97,
+	0x6c, 0xc6, 0x06, 0x2c, 0xa6, 0x56, 0xcb, 0xe8, 0xde, 0x72, 0x9b, 0x1d, 0xbf, 0xaa, 0x84, 0x2f,
+	0x0f, 0xce, 0x77, 0x46, 0xc7, 0x39, 0x29, 0x3f, 0xcf, 0x55, 0x3d, 0x07, 0x6f, 0x27, 0x33, 0x05,
+	0x83, 0xdc, 0x0b, 0xee, 0x6c, 0x6f, 0x46, 0x07, 0x99, 0x6c, 0xac, 0x28, 0xd8, 0xf9, 0x03, 0x98,
+	0xcf, 0x81, 0xc9, 0x9b, 0xde, 0x11, 0x9f, 0x17, 0x29, 0x5e, 0xd2, 0x49, 0x96, 0x63, 0x53, 0x20,
+	0x65, 0xb6, 0x2b, 0xc7, 0xc5, 0x2d, 0x3f, 0x71, 0xa3, 0x7a, 0x92, 0x6d, 0x14, 0xce, 0xe6, 0x23,
+	0x65, 0x46, 0x35, 0x5c, 0xf5, 0x13, 0x97, 0xad, 0x17, 0x54, 0xe3, 0x3f, 0xe3, 0x31, 0x11, 0x2c,
+	0xaf, 0xa2, 0xef, 0x50, 0x6a, 0xd2, 0xa6, 0xe7, 0x87, 0x0a, 0xa6, 0x7d, 0x08, 0xa7, 0xb3, 0x18,
+	0x34, 0xea, 0x26, 0x0c, 0xfb, 0xac, 0x44, 0xf1, 0x46, 0x2e, 0x66, 0x40, 0x5c, 0xe2, 0xf8, 0x7d,
+	0xdf, 0x0e, 0xa3, 0xe4, 0xa9, 0xe6, 0xdb, 0x0d, 0x05, 0x9b, 0x3e, 0x29, 0x81, 0x9e, 0x07, 0x44,
+	0xc3, 0x4e, 0xc3, 0xb0, 0x5d, 0x09, 0x9d, 0x43, 0xbe, 0x27, 0x1c, 0x35, 0xf1, 0x29, 0x8a, 0x6a,
+	0x51, 0xc0, 0xa9, 0x78, 0x8d, 0x86, 0x13, 0x04, 0xe2, 0x74, 0xf6, 0x45, 0xd7, 0x80, 0xc9, 0x86,
+	0x7d, 0xb4, 0x2d, 0x29, 0xa3, 0x15, 0x96, 0x2f, 0x30, 0xd6, 0xbe, 0xe7, 0x05, 0xfd, 0x59, 0x66,
+	0xc6, 0x39, 0xe3, 0x56, 0x44, 0x48, 0xf6, 0x00, 0x12, 0x31, 0x69, 0x50, 0x29, 0x1f, 0xe0, 0xfd,
+	0x24, 0x47, 0x85, 0x38, 0x74, 0x8a, 0x79, 0x8c, 0x2f, 0x07, 0x60, 0x3a, 0xf3, 0x56, 0x91, 0x75,
+	0x9b, 0xc2, 0x74, 0xdc, 0xad, 0x16, 0xbb, 0xa5, 0xe9, 0x47, 0xdf, 0x4e, 0xc5, 0xa4, 0xa6, 0x1d,
+	0x52, 0x72, 0x16, 0xc6, 0x1e, 0xb7, 0xec, 0xba, 0xf3, 0x50, 0x2c, 0x18, 0xa3, 0x66, 0x5c, 0x90,
+	0x48, 0x1e, 0x06, 0xfb, 0x98, 0x3c, 0x54, 0x60, 0x8a, 0x7d, 0xc9, 0x38, 0x73, 0x18, 0xea, 0xc7,
+	0xa8, 0x41, 0x4e, 0x4c, 0x91, 0x6a, 0x20, 0x0e, 0x7f, 0xe2, 0x25, 0x64, 0xb8, 0x1f, 0x3b, 0x3e,
+	0xc9, 0x8a, 0x3b, 0xbe, 0x05, 0x8c, 0x34, 0x1f, 0x78, 0xfe, 0xa3, 0x87, 0x75, 0xef, 0xc9, 0x1d,
+	0xdb, 0xa9, 0xb7, 0x7c, 0x19, 0x40, 0x8d, 0x47, 0x70, 0xae, 0x43, 0x3d, 0x4e, 0xae, 0x7b, 0x30,
+	0xfa, 0x10, 0xcb, 0x14, 0x93, 0xd1, 0x0c, 0x95, 0x29, 0xf1, 0xed, 0x01, 0x73, 0xb7, 0xe2, 0xf9,
+	0x4a, 0xc1, 0x3c, 0x84, 0xb3, 0xf9, 0x48, 0x79, 0xad, 0x95, 0x9c, 0x24, 0x6a, 0x76, 0x32, 0x8a,
+	0xea, 0x49, 0x93, 0xe4, 0x0f, 0x07, 0x60, 0x3a, 0xf3, 0x56, 0x91, 0x49, 0xb2, 0x0d, 0x43, 0x41,
+	0x84, 0xc6, 0x94, 0x44, 0x39, 0x88, 0xb3, 0x26, 0x4d, 0x8e, 0x25, 0xcb, 0xf0, 0x4a, 0x34, 0x23,
+	0x68, 0xd5, 0x62, 0x87, 0xa3, 0x81, 0xc5, 0x0e, 0xc3, 0xa8, 0x8f, 0x27, 0xf9, 0xa7, 0x78, 0xe5,
+	0x16, 0xab, 0xdb, 0xe6, 0x55, 0xe4, 0x6d, 0x98, 0x0d, 0x9c, 0x9a, 0x1b, 0x63, 0x9e, 0x38, 0x6e,
+	0xd5, 0x7b, 0xc2, 0xa6, 0xc9, 0x80, 0x49, 0x78, 0x1d, 0x87, 0x7c, 0xc0, 0x6a, 0xf2, 0xe6, 0xf3,
+	0xd0, 0x57, 0x30, 0x9f, 0xf7, 0xa2, 0xb3, 0xff, 0x47, 0xd4, 0x0d, 0xfa, 0x32, 0xd8, 0x91, 0xcb,
+	0xf8, 0x07, 0x2d, 0x7d, 0xec, 0x14, 0x6c, 0x1d, 0xf3, 0x83, 0x7b, 0x1c, 0x57, 0x3b, 0xe9, 0x7b,
+	0x80, 0x4b, 0x6a, 0x57, 0x49, 0xe2, 0x37, 0x75, 0x07, 0x70, 0xc2, 0x9e, 0x31, 0x7d, 0x95, 0x39,
+	0xd0, 0xf3, 0x55, 0xe6, 0x9f, 0x64, 0xae, 0x32, 0x63, 0x6f, 0xfa, 0xa7, 0x7b, 0xea, 0xdf, 0xa5,
+	0xa5, 0x58, 0xd2, 0x59, 0x9a, 0x7e, 0xa7, 0xa5, 0x2c, 0x55, 0x7a, 0x04, 0x7a, 0x1e, 0x4e, 0x6e,
+	0x6f, 0x46, 0x1f, 0xb6, 0x0a, 0x09, 0x29, 0x92, 0x3c, 0x78, 0x86, 0x22, 0x29, 0x8c, 0x1f, 0x95,
+	0x80, 0xb4, 0xbf, 0xa0, 0xb2, 0xa9, 0x90, 0x57, 0x48, 0xa5, 0xbe, 0x5c, 0x21, 0xb5, 0x5d, 0xf5,
+	0x0c, 0xf4, 0x70, 0xd5, 0x13, 0x9d, 0xb7, 0xa1, 0x6b, 0x78, 0x55, 0xf4, 0x66, 0x81, 0xce, 0x31,
+	0x05, 0x36, 0x3a, 0x2b, 0xf2, 0x69, 0x9d, 0xda, 0x01, 0xad, 0xb2, 0xc9, 0x3e, 0x6a, 0xca, 0xe7,
+	0xe5, 0xff, 0xde, 0x80, 0x21, 0xf6, 0x7d, 0xc8, 0x1f, 0x68, 0x30, 0xcc, 0x75, 0x8e, 0xa4, 0xdb,
+	0x37, 0x68, 0x17, 0x5a, 0xea, 0xcb, 0x45, 0x20, 0xfc, 0xe3, 0x1b, 0x17, 0x7e, 0xf8, 0xcf, 0xff,
+	0xf9, 0xbb, 0xa5, 0x6f, 0x91, 0x6f, 0x94, 0x55, 0xb4, 0xa1, 0xe4, 0xcf, 0x34, 0x18, 0x93, 0x2a,
+	0x25, 0x72, 0x59, 0xa5, 0xc1, 0xac, 0x34, 0x53, 0xbf, 0x52, 0x10, 0x85, 0x96, 0x6e, 0x30, 0x4b,
+	0xaf, 0x92, 0xcb, 0x5d, 0x2c, 0x8d, 0xd5, 0x93, 0xe5, 0xa7, 0x62, 0x42, 0x3c, 0x23, 0x3f, 0xd2,
+	0x00, 0x24, 0x67, 0x40, 0x8a, 0xd9, 0x20, 0x7b, 0xf8, 0x6a, 0x51, 0x18, 0xda, 0xbe, 0xcc, 0x6c,
+	0x7f, 0x8b, 0xbc, 0xa1, 0x6c, 0x7b, 0x40, 0xfe, 0x54, 0x83, 0x51, 0x11, 0x95, 0xc8, 0x25, 0x95,
+	0x86, 0x33, 0xa2, 0x4a, 0xfd, 0x72, 0x31, 0x10, 0xda, 0xba, 0xc6, 0x6c, 0xbd, 0x4c, 0x96, 0xbb,
+	0xd8, 0x2a, 0xc2, 0x5b, 0xb2, 0x97, 0xff, 0x52, 0x83, 0xf1, 0x84, 0x4e, 0x93, 0x28, 0xf5, 0x57,
+	0xbb, 0x1c, 0x54, 0x7f, 0xa7, 0x30, 0x0e, 0x8d, 0xbf, 0xce, 0x8c, 0x5f, 0x21, 0x57, 0xbb, 0x18,
+	0x5f, 0x0f, 0x1a, 0x56, 0x9e, 0x03, 0x7f, 0xae, 0x01, 0x24, 0x94, 0x71, 0x4a, 0xc3, 0xa4, 0x4d,
+	0x33, 0xa8, 0x5f, 0x2d, 0x0a, 0x2b, 0x38, 0xc4, 0xe3, 0x0b, 0xfe, 0xa4, 0xed, 0x7f, 0xa1, 0xc1,
+	0x98, 0x24, 0x55, 0x9b, 0x9b, 0x59, 0x7d, 0x9e, 0x7e, 0xa5, 0x20, 0x0a, 0x0d, 0xdf, 0x66, 0x86,
+	0x5f, 0x23, 0xeb, 0xaa, 0x86, 0x27, 0xec, 0x2e, 0x3f, 0x65, 0x6b, 0xc0, 0x33, 0xf2, 0xf7, 0x1a,
+	0x4c, 0xa5, 0x85, 0x8f, 0x64, 0x55, 0xc9, 0x9c, 0x3c, 0xdd, 0xa6, 0xbe, 0xd6, 0x0b, 0x14, 0xdd,
+	0xb9, 0xc9, 0xdc, 0x59, 0x23, 0x2b, 0xdd, 0xdc, 0x49, 0x8b, 0x31, 0xcb, 0x4f, 0x31, 0x47, 0x7d,
+	0x46, 0xbe, 0xd4, 0xe0, 0xd5, 0x0e, 0x6a, 0x4e, 0xb2, 0x55, 0x28, 0x88, 0xe4, 0x7b, 0xb7, 0xfd,
+	0x42, 0x1c, 0xe8, 0xe6, 0x26, 0x73, 0x73, 0x9d, 0xac, 0x16, 0x75, 0x33, 0x1e, 0x73, 0xff, 0xae,
+	0xc1, 0xa9, 0x76, 0x59, 0x65, 0x40, 0xae, 0xa9, 0xd8, 0xd7, 0x51, 0x26, 0xaa, 0x5f, 0xef, 0x15,
+	0x8e, 0x9e, 0xdd, 0x61, 0x9e, 0xdd, 0x24, 0xd7, 0xbb, 0x78, 0x96, 0x27, 0x26, 0x4d, 0xba, 0xf7,
+	0x5f, 0x1a, 0xbc, 0x92, 0xab, 0xe2, 0x24, 0x37, 0x0b, 0xc4, 0xd6, 0x5c, 0x01, 0xa9, 0xbe, 0xf9,
+	0x02, 0x0c, 0xe8, 0xe6, 0x0e, 0x73, 0x73, 0x9b, 0x6c, 0xaa, 0x85, 0x6a, 0x0b, 0xef, 0xfb, 0x2d,
+	0xbc, 0x2d, 0x4f, 0x7a, 0xfa, 0xd7, 0x1a, 0x4c, 0x24, 0x75, 0xa1, 0x44, 0x29, 0x04, 0xe7, 0x08,
+	0x50, 0xf5, 0x95, 0xe2, 0x40, 0x74, 0xe7, 0x06, 0x73, 0x67, 0x95, 0xbc, 0xd3, 0xc5, 0x1d, 0x8a,
+	0x60, 0xb6, 0x75, 0x4a, 0x3a, 0xf1, 0x77, 0x1a, 0x4c, 0xa6, 0x84, 0x9e, 0x44, 0xc9, 0x98, 0x3c,
+	0x81, 0xaa, 0xbe, 0xda, 0x03, 0xb2, 0xa0, 0x1f, 0x29, 0x11, 0x6a, 0xd2, 0x8f, 0x9f, 0x6b, 0x30,
+	0x95, 0x96, 0x94, 0x92, 0xc2, 0xe6, 0xec, 0x1d, 0x15, 0x8a, 0x84, 0xf9, 0x0a, 0x56, 0xe5, 0x10,
+	0x91, 0x91, 0xb9, 0x26, 0x9d, 0xf9, 0x27, 0x0d, 0xa6, 0x33, 0x42, 0x51, 0xb2, 0x56, 0x60, 0xec,
+	0x67, 0xf4, 0xad, 0xfa, 0x7a, 0x4f, 0xd8, 0x82, 0xfe, 0x64, 0xe5, 0xab, 0x89, 0xd0, 0xfe, 0xb7,
+	0x1a, 0x4c, 0xa5, 0xe9, 0xd5, 0x3e, 0x4e, 0xae, 0xd2, 0x54, 0x5f, 0xeb, 0x05, 0x8a, 0xce, 0xac,
+	0x33, 0x67, 0xae, 0x90, 0x4b, 0xc5, 0x9c, 0x29, 0x3f, 0x8d, 0x3e, 0xcb, 0xbf, 0x68, 0xf0, 0x72,
+	0x9b, 0x2a, 0x94, 0x6c, 0x14, 0x30, 0xa7, 0x4d, 0x88, 0xaa, 0x5f, 0xeb, 0x11, 0x8d, 0xfe, 0xdc,
+	0x62, 0xfe, 0x5c, 0x27, 0x1b, 0x8a, 0xfe, 0xc4, 0xa2, 0xd3, 0xec, 0xe4, 0x49, 0x4b, 0x48, 0xd5,
+	0xbe, 0x4f, 0xae, 0x5e, 0x55, 0x5f, 0xeb, 0x05, 0x5a, 0x70, 0xb0, 0xc5, 0xab, 0x10, 0x53, 0xa9,
+	0x26, 0x9d, 0xf9, 0x3f, 0x0d, 0x4e, 0xe7, 0x8b, 0x45, 0xc9, 0x66, 0xb1, 0x24, 0x33, 0x47, 0xe8,
+	0xaa, 0x6f, 0xbd, 0x08, 0x05, 0x3a, 0xf9, 0x3e, 0x73, 0xf2, 0x01, 0xf9, 0x4e, 0x2f, 0x39, 0x6b,
+	0xf9, 0x69, 0x42, 0x4d, 0x1b, 0x65, 0x82, 0x42, 0x3a, 0xfb, 0x8c, 0x7c, 0xaa, 0xc1, 0x4c, 0x56,
+	0xd6, 0x48, 0x94, 0xe6, 0x7e, 0x07, 0x51, 0xa6, 0xbe, 0xd1, 0x1b, 0xb8, 0x60, 0x8a, 0x5b, 0xe1,
+	0x04, 0x96, 0x94, 0x5e, 0x66, 0x57, 0xd9, 0xa4, 0xca, 0x50, 0x6d, 0x95, 0xcd, 0x51, 0x3a, 0xea,
+	0x2b, 0xc5, 0x81, 0x05, 0x57, 0xa7, 0x94, 0xea, 0x31, 0xe9, 0xc4, 0xff, 0xb0, 0xa4, 0x28, 0x47,
+	0x33, 0xa9, 0x9a, 0x14, 0x75, 0x16, 0x70, 0xea, 0x9b, 0x2f, 0xc0, 0x80, 0xfe, 0x99, 0xcc, 0xbf,
+	0x77, 0xc9, 0xbd, 0xae, 0x51, 0x04, 0x59, 0xac, 0x8c, 0xa7, 0x6d, 0x0a, 0xd2, 0x67, 0xe4, 0xaf,
+	0x34, 0x21, 0x42, 0x12, 0x8a, 0x4c, 0xb5, 0x98, 0x92, 0xab, 0xf1, 0xd4, 0xd7, 0x7a, 0x81, 0xa2,
+	0x77, 0x57, 0x99, 0x77, 0x6f, 0x93, 0xa5, 0x2e, 0xde, 0x35, 0x18, 0x5c, 0x64, 0x7c, 0x01, 0xf9,
+	0x58, 0x83, 0x89, 0xa4, 0x16, 0x51, 0x6d, 0xe4, 0xe5, 0xa8, 0x28, 0xf5, 0x95, 0xe2, 0xc0, 0x82,
+	0xf1, 0xdd, 0x8f, 0xc0, 0x16, 0xaa, 0x24, 0xcb, 0x4f, 0x53, 0x9a, 0xcd, 0x67, 0xe4, 0x17, 0x71,
+	0x3e, 0x21, 0xd5, 0x0e, 0x45, 0x56, 0xd1, 0x8c, 0x66, 0x44, 0x5f, 0xef, 0x09, 0x8b, 0x2e, 0x6d,
+	0x31, 0x97, 0x36, 0xc8, 0x9a, 0xe2, 0x92, 0x25, 0x64, 0x01, 0xc9, 0xf9, 0xf4, 0xb1, 0x06, 0x93,
+	0x29, 0xad, 0x9f, 0x5a, 0xd6, 0x9a, 0x27, 0x2b, 0xd4, 0x57, 0x7b, 0x40, 0x16, 0x5c, 0xad, 0x2a,
+	0x91, 0x6a, 0xa3, 0x45, 0xad, 0x03, 0x8e, 0xcf, 0x78, 0x32, 0x93, 0x55, 0x05, 0xaa, 0xc5, 0xec,
+	0x0e, 0x32, 0x44, 0x7d, 0xa3, 0x37, 0x30, 0xba, 0xb4, 0xc2, 0x5c, 0x5a, 0x26, 0x6f, 0x2b, 0x86,
+	0x3a, 0xa9, 0x3a, 0x64, 0xab, 0x4f, 0x56, 0x31, 0xa6, 0xe6, 0x49, 0x07, 0x8d, 0x9a, 0xbe, 0xd1,
+	0x1b, 0xb8, 0xe0, 0xea, 0x13, 0xa7, 0x12, 0x28, 0x4a, 0x4b, 0x7e, 0x9e, 0x28, 0xe5, 0x6b, 0x93,
+	0xfc, 0xa8, 0xa5, 0x7c, 0x9d, 0x14, 0x57, 0xfa, 0xb5, 0x1e, 0xd1, 0x05, 0x43, 0x82, 0xcc, 0x1e,
+	0x72, 0x67, 0xd0, 0xe7, 0x1a, 0x9c, 0xca, 0x51, 0xc8, 0x90, 0xeb, 0x45, 0x46, 0x4f, 0xbb, 0x30,
+	0x47, 0xbf, 0xd1, 0x33, 0x1e, 0xdd, 0xfb, 0x36, 0x73, 0x6f, 0x93, 0xdc, 0x50, 0x1d, 0x80, 0x11,
+	0x89, 0x85, 0x5a, 0x9c, 0xa4, 0x87, 0x7f, 0xa3, 0xc1, 0x44, 0x52, 0x5b, 0xa3, 0x16, 0xbe, 0x73,
+	0x44, 0x3c, 0xfa, 0x4a, 0x71, 0x60, 0xc1, 0x53, 0x31, 0xa7, 0x62, 0x5b, 0xe1, 0x91, 0x85, 0xc2,
+	0x9d, 0xa4, 0x17, 0xbf, 0x48, 0xea, 0x17, 0xb9, 0x0a, 0x87, 0x14, 0x4b, 0xb0, 0x53, 0xa2, 0x1f,
+	0x7d, 0xbd, 0x27, 0x6c, 0xc1, 0xd0, 0x1d, 0x4f, 0x29, 0x2e, 0xf4, 0x49, 0x3a, 0x14, 0x5d, 0x87,
+	0x48, 0xe5, 0x8d, 0xda, 0x91, 0x6b, 0x56, 0x1e, 0xa4, 0x5f, 0x29, 0x88, 0x2a, 0x78, 0x56, 0xfc,
+	0x90, 0x52, 0x8b, 0x2b, 0x82, 0x92, 0x86, 0xff, 0x94, 0x9d, 0x94, 0x24, 0xf4, 0x3d, 0xaa, 0x27,
+	0x25, 0xed, 0x5a, 0x22, 0x7d, 0xb5, 0x07, 0x64, 0xc1, 0x21, 0xe5, 0x33, 0xb4, 0xd5, 0xe4, 0xf0,
+	0xec, 0x92, 0x93, 0x95, 0x53, 0xa8, 0x05, 0xea, 0x0e, 0x22, 0x0d, 0x7d, 0xa3, 0x37, 0x70, 0xc1,
+	0x25, 0xe7, 0x09, 0x12, 0x58, 0x42, 0xaf, 0x91, 0x9e, 0x1c, 0x5c, 0x71, 0x51, 0x70, 0x72, 0xa4,
+	0x04, 0x1e, 0xfa, 0x7a, 0x4f, 0xd8, 0x9e, 0x27, 0x07, 0x13, 0x50, 0xa4, 0x26, 0xc7, 0x3f, 0xc6,
+	0x89, 0x9a, 0xb8, 0x56, 0x2f, 0x94, 0xa8, 0x65, 0x94, 0x05, 0xfa, 0x7a, 0x4f, 0xd8, 0x82, 0x23,
+	0x4d, 0x5c, 0x0a, 0x59, 0xfb, 0xc7, 0x16, 0xbb, 0x46, 0x66, 0x5b, 0xd4, 0x90, 0xf2, 0x29, 0x93,
+	0xba, 0x40, 0x57, 0x9b, 0x32, 0x79, 0x77, 0xf5, 0xfa, 0x6a, 0x0f, 0xc8, 0x82, 0x8e, 0xf0, 0xcb,
+	0x76, 0x71, 0x2b, 0x9f, 0xf8, 0x2e, 0x5b, 0xdf, 0xff, 0xd9, 0x17, 0x0b, 0xda, 0x27, 0x5f, 0x2c,
+	0x68, 0xff, 0xf1, 0xc5, 0x82, 0xf6, 0xd1, 0xf3, 0x85, 0x97, 0x3e, 0x79, 0xbe, 0xf0, 0xd2, 0xbf,
+	0x3e, 0x5f, 0x78, 0xe9, 0xc3, 0xcd, 0x84, 0x32, 0xa4, 0x49, 0xfd, 0xc0, 0x09, 0xa2, 0x14, 0x9c,
+	0x7e, 0xd7, 0xa5, 0xd8, 0xd8, 0x05, 0xd7, 0x0e, 0x9d, 0x43, 0x5a, 0x3e, 0x5c, 0x2e, 0x1f, 0x65,
+	0x1b, 0x66, 0xc2, 0x91, 0xfd, 0x61, 0x26, 0x01, 0xbe, 0xf4, 0xcb, 0x01, 0x00, 0x9f, 0xe7, 0x5f,
+	0xc0, 0xaa, 0x49, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a HostChain by id.
+	HostChain(ctx context.Context, in *QueryHostChainRequest, opts ...grpc.CallOption) (*QueryHostChainResponse, error)
+	// Queries for all the HostChains.
+	HostChains(ctx context.Context, in *QueryHostChainsRequest, opts ...grpc.CallOption) (*QueryHostChainsResponse, error)
+	// Queries for all the deposits for a host chain.
+	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
+	// Queries for all the deposits for a host chain.
+	LSMDeposits(ctx context.Context, in *QueryLSMDepositsRequest, opts ...grpc.CallOption) (*QueryLSMDepositsResponse, error)
+	// Queries all unbondings for a host chain.
+	Unbondings(ctx context.Context, in *QueryUnbondingsRequest, opts ...grpc.CallOption) (*QueryUnbondingsResponse, error)
+	// Queries an unbonding for a host chain.
+	Unbonding(ctx context.Context, in *QueryUnbondingRequest, opts ...grpc.CallOption) (*QueryUnbondingResponse, error)
+	// Queries all unbondings for a delegator address.
+	UserUnbondings(ctx context.Context, in *QueryUserUnbondingsRequest, opts ...grpc.CallOption) (*QueryUserUnbondingsResponse, error)
+	// Queries all unbondings for a host chain.
+	HostChainUserUnbondings(ctx context.Context, in *QueryHostChainUserUnbondingsRequest, opts ...grpc.CallOption) (*QueryHostChainUserUnbondingsResponse, error)
+	// Queries all validator unbondings for a host chain.
+	ValidatorUnbondings(ctx context.Context, in *QueryValidatorUnbondingRequest, opts ...grpc.CallOption) (*QueryValidatorUnbondingResponse, error)
+	// Queries for a host chain deposit account balance.
+	DepositAccountBalance(ctx context.Context, in *QueryDepositAccountBalanceRequest, opts ...grpc.CallOption) (*QueryDepositAccountBalanceResponse, error)
+	// Queries for a host chain exchange rate between the host token and the stk
+	// token.
+	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
+	// Queries for a host chain redelegation entries on the host token delegation
+	// acct.
+	Redelegations(ctx context.Context, in *QueryRedelegationsRequest, opts ...grpc.CallOption) (*QueryRedelegationsResponse, error)
+	// Queries for a host chain redelegation-txs for the host token.
+	RedelegationTx(ctx context.Context, in *QueryRedelegationTxRequest, opts ...grpc.CallOption) (*QueryRedelegationTxResponse, error)
+	// Queries all the deposit receipts of a delegator address.
+	DepositReceipts(ctx context.Context, in *QueryDepositReceiptsRequest, opts ...grpc.CallOption) (*QueryDepositReceiptsResponse, error)
+	// Queries a deposit receipt by id.
+	DepositReceipt(ctx context.Context, in *QueryDepositReceiptRequest, opts ...grpc.CallOption) (*QueryDepositReceiptResponse, error)
+	// Queries the deposit shortfalls of a host chain.
+	DepositShortfalls(ctx context.Context, in *QueryDepositShortfallsRequest, opts ...grpc.CallOption) (*QueryDepositShortfallsResponse, error)
+	// Queries the pending validator exits of a host chain.
+	ValidatorExits(ctx context.Context, in *QueryValidatorExitsRequest, opts ...grpc.CallOption) (*QueryValidatorExitsResponse, error)
+	// Queries the unbondings of a host chain within an epoch range, along with
+	// their totals per unbonding state.
+	UnbondingsByEpochRange(ctx context.Context, in *QueryUnbondingsByEpochRangeRequest, opts ...grpc.CallOption) (*QueryUnbondingsByEpochRangeResponse, error)
+	// Queries the transfer channel migration of a host chain.
+	ChannelMigration(ctx context.Context, in *QueryChannelMigrationRequest, opts ...grpc.CallOption) (*QueryChannelMigrationResponse, error)
+	// Queries the stk tokens waiting for their deposit delegation on a host
+	// chain.
+	PendingMints(ctx context.Context, in *QueryPendingMintsRequest, opts ...grpc.CallOption) (*QueryPendingMintsResponse, error)
+	// Queries the stk tokens waiting for their deposit delegation for a
+	// delegator address.
+	DelegatorPendingMints(ctx context.Context, in *QueryDelegatorPendingMintsRequest, opts ...grpc.CallOption) (*QueryDelegatorPendingMintsResponse, error)
+	// Queries the addresses and balances of the liquid staking module accounts.
+	ModuleAccounts(ctx context.Context, in *QueryModuleAccountsRequest, opts ...grpc.CallOption) (*QueryModuleAccountsResponse, error)
+	// Queries the observed relay latency and packet timeout of an ibc connection.
+	RelayLatency(ctx context.Context, in *QueryRelayLatencyRequest, opts ...grpc.CallOption) (*QueryRelayLatencyResponse, error)
+	// Queries the deposit cap of a host chain and the amount that can still be
+	// liquid staked under it.
+	DepositCapacity(ctx context.Context, in *QueryDepositCapacityRequest, opts ...grpc.CallOption) (*QueryDepositCapacityResponse, error)
+	// Queries the manual c value updates of a host chain.
+	CValueHistory(ctx context.Context, in *QueryCValueHistoryRequest, opts ...grpc.CallOption) (*QueryCValueHistoryResponse, error)
+	// Queries the governance proposals in deposit or voting period which
+	// execute liquidstakeibc messages.
+	PendingProposals(ctx context.Context, in *QueryPendingProposalsRequest, opts ...grpc.CallOption) (*QueryPendingProposalsResponse, error)
+	// Queries the validator weights of a host chain next to the same weights
+	// normalized to add up to one.
+	ValidatorWeights(ctx context.Context, in *QueryValidatorWeightsRequest, opts ...grpc.CallOption) (*QueryValidatorWeightsResponse, error)
+	// Queries how many host tokens could be unbonded in each of the next
+	// unbonding epochs of a host chain, given the validator unbonding entries
+	// limit and the undelegations still in flight.
+	UnbondingCapacity(ctx context.Context, in *QueryUnbondingCapacityRequest, opts ...grpc.CallOption) (*QueryUnbondingCapacityResponse, error)
+	// Queries the fee, c value limit and cap updates of a host chain staged
+	// until the param change delay has passed.
+	PendingParamChanges(ctx context.Context, in *QueryPendingParamChangesRequest, opts ...grpc.CallOption) (*QueryPendingParamChangesResponse, error)
+	// Queries the ica txs of a host chain waiting to be submitted again.
+	ICATxRetries(ctx context.Context, in *QueryICATxRetriesRequest, opts ...grpc.CallOption) (*QueryICATxRetriesResponse, error)
+	// Queries the zero weight validators of a host chain whose delegations are
+	// being redelegated away.
+	ValidatorDrains(ctx context.Context, in *QueryValidatorDrainsRequest, opts ...grpc.CallOption) (*QueryValidatorDrainsResponse, error)
+	// Queries the protocol fees collected on a host chain, by fee type and
+	// denomination.
+	FeeReport(ctx context.Context, in *QueryFeeReportRequest, opts ...grpc.CallOption) (*QueryFeeReportResponse, error)
+	// Queries the commission rebate program of a host chain, with the boosted
+	// weight and the rebate accounting of each validator.
+	RebateProgram(ctx context.Context, in *QueryRebateProgramRequest, opts ...grpc.CallOption) (*QueryRebateProgramResponse, error)
+	// Queries the host chains whose last run of a workflow failed.
+	WorkflowFailures(ctx context.Context, in *QueryWorkflowFailuresRequest, opts ...grpc.CallOption) (*QueryWorkflowFailuresResponse, error)
+	// Queries the performance scores of the validators of a host chain.
+	ValidatorScores(ctx context.Context, in *QueryValidatorScoresRequest, opts ...grpc.CallOption) (*QueryValidatorScoresResponse, error)
+	// Queries the deposits in a state, optionally of a single host chain.
+	DepositsByState(ctx context.Context, in *QueryDepositsByStateRequest, opts ...grpc.CallOption) (*QueryDepositsByStateResponse, error)
+	// Queries the funding of the unbonding epochs of a host chain that are
+	// waiting for or being paid out of their unbonding transfers.
+	EpochFundings(ctx context.Context, in *QueryEpochFundingsRequest, opts ...grpc.CallOption) (*QueryEpochFundingsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HostChain(ctx context.Context, in *QueryHostChainRequest, opts ...grpc.CallOption) (*QueryHostChainResponse, error) {
+	out := new(QueryHostChainResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/HostChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HostChains(ctx context.Context, in *QueryHostChainsRequest, opts ...grpc.CallOption) (*QueryHostChainsResponse, error) {
+	out := new(QueryHostChainsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/HostChains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error) {
+	out := new(QueryDepositsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/Deposits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LSMDeposits(ctx context.Context, in *QueryLSMDepositsRequest, opts ...grpc.CallOption) (*QueryLSMDepositsResponse, error) {
+	out := new(QueryLSMDepositsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/LSMDeposits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Unbondings(ctx context.Context, in *QueryUnbondingsRequest, opts ...grpc.CallOption) (*QueryUnbondingsResponse, error) {
+	out := new(QueryUnbondingsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/Unbondings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Unbonding(ctx context.Context, in *QueryUnbondingRequest, opts ...grpc.CallOption) (*QueryUnbondingResponse, error) {
+	out := new(QueryUnbondingResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/Unbonding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UserUnbondings(ctx context.Context, in *QueryUserUnbondingsRequest, opts ...grpc.CallOption) (*QueryUserUnbondingsResponse, error) {
+	out := new(QueryUserUnbondingsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/UserUnbondings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HostChainUserUnbondings(ctx context.Context, in *QueryHostChainUserUnbondingsRequest, opts ...grpc.CallOption) (*QueryHostChainUserUnbondingsResponse, error) {
+	out := new(QueryHostChainUserUnbondingsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/HostChainUserUnbondings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorUnbondings(ctx context.Context, in *QueryValidatorUnbondingRequest, opts ...grpc.CallOption) (*QueryValidatorUnbondingResponse, error) {
+	out := new(QueryValidatorUnbondingResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/ValidatorUnbondings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DepositAccountBalance(ctx context.Context, in *QueryDepositAccountBalanceRequest, opts ...grpc.CallOption) (*QueryDepositAccountBalanceResponse, error) {
+	out := new(QueryDepositAccountBalanceResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/DepositAccountBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error) {
+	out := new(QueryExchangeRateResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/ExchangeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Redelegations(ctx context.Context, in *QueryRedelegationsRequest, opts ...grpc.CallOption) (*QueryRedelegationsResponse, error) {
+	out := new(QueryRedelegationsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/Redelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RedelegationTx(ctx context.Context, in *QueryRedelegationTxRequest, opts ...grpc.CallOption) (*QueryRedelegationTxResponse, error) {
+	out := new(QueryRedelegationTxResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/RedelegationTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DepositReceipts(ctx context.Context, in *QueryDepositReceiptsRequest, opts ...grpc.CallOption) (*QueryDepositReceiptsResponse, error) {
+	out := new(QueryDepositReceiptsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/DepositReceipts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DepositReceipt(ctx context.Context, in *QueryDepositReceiptRequest, opts ...grpc.CallOption) (*QueryDepositReceiptResponse, error) {
+	out := new(QueryDepositReceiptResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/DepositReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DepositShortfalls(ctx context.Context, in *QueryDepositShortfallsRequest, opts ...grpc.CallOption) (*QueryDepositShortfallsResponse, error) {
+	out := new(QueryDepositShortfallsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/DepositShortfalls", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorExits(ctx context.Context, in *QueryValidatorExitsRequest, opts ...grpc.CallOption) (*QueryValidatorExitsResponse, error) {
+	out := new(QueryValidatorExitsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/ValidatorExits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbondingsByEpochRange(ctx context.Context, in *QueryUnbondingsByEpochRangeRequest, opts ...grpc.CallOption) (*QueryUnbondingsByEpochRangeResponse, error) {
+	out := new(QueryUnbondingsByEpochRangeResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/UnbondingsByEpochRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelMigration(ctx context.Context, in *QueryChannelMigrationRequest, opts ...grpc.CallOption) (*QueryChannelMigrationResponse, error) {
+	out := new(QueryChannelMigrationResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/ChannelMigration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingMints(ctx context.Context, in *QueryPendingMintsRequest, opts ...grpc.CallOption) (*QueryPendingMintsResponse, error) {
+	out := new(QueryPendingMintsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/PendingMints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorPendingMints(ctx context.Context, in *QueryDelegatorPendingMintsRequest, opts ...grpc.CallOption) (*QueryDelegatorPendingMintsResponse, error) {
+	out := new(QueryDelegatorPendingMintsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/DelegatorPendingMints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleAccounts(ctx context.Context, in *QueryModuleAccountsRequest, opts ...grpc.CallOption) (*QueryModuleAccountsResponse, error) {
+	out := new(QueryModuleAccountsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/ModuleAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RelayLatency(ctx context.Context, in *QueryRelayLatencyRequest, opts ...grpc.CallOption) (*QueryRelayLatencyResponse, error) {
+	out := new(QueryRelayLatencyResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/RelayLatency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DepositCapacity(ctx context.Context, in *QueryDepositCapacityRequest, opts ...grpc.CallOption) (*QueryDepositCapacityResponse, error) {
+	out := new(QueryDepositCapacityResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/DepositCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CValueHistory(ctx context.Context, in *QueryCValueHistoryRequest, opts ...grpc.CallOption) (*QueryCValueHistoryResponse, error) {
+	out := new(QueryCValueHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/CValueHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingProposals(ctx context.Context, in *QueryPendingProposalsRequest, opts ...grpc.CallOption) (*QueryPendingProposalsResponse, error) {
+	out := new(QueryPendingProposalsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/PendingProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorWeights(ctx context.Context, in *QueryValidatorWeightsRequest, opts ...grpc.CallOption) (*QueryValidatorWeightsResponse, error) {
+	out := new(QueryValidatorWeightsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/ValidatorWeights", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbondingCapacity(ctx context.Context, in *QueryUnbondingCapacityRequest, opts ...grpc.CallOption) (*QueryUnbondingCapacityResponse, error) {
+	out := new(QueryUnbondingCapacityResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/UnbondingCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingParamChanges(ctx context.Context, in *QueryPendingParamChangesRequest, opts ...grpc.CallOption) (*QueryPendingParamChangesResponse, error) {
+	out := new(QueryPendingParamChangesResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/PendingParamChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ICATxRetries(ctx context.Context, in *QueryICATxRetriesRequest, opts ...grpc.CallOption) (*QueryICATxRetriesResponse, error) {
+	out := new(QueryICATxRetriesResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/ICATxRetries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorDrains(ctx context.Context, in *QueryValidatorDrainsRequest, opts ...grpc.CallOption) (*QueryValidatorDrainsResponse, error) {
+	out := new(QueryValidatorDrainsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/ValidatorDrains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeReport(ctx context.Context, in *QueryFeeReportRequest, opts ...grpc.CallOption) (*QueryFeeReportResponse, error) {
+	out := new(QueryFeeReportResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/FeeReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RebateProgram(ctx context.Context, in *QueryRebateProgramRequest, opts ...grpc.CallOption) (*QueryRebateProgramResponse, error) {
+	out := new(QueryRebateProgramResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/RebateProgram", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WorkflowFailures(ctx context.Context, in *QueryWorkflowFailuresRequest, opts ...grpc.CallOption) (*QueryWorkflowFailuresResponse, error) {
+	out := new(QueryWorkflowFailuresResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/WorkflowFailures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorScores(ctx context.Context, in *QueryValidatorScoresRequest, opts ...grpc.CallOption) (*QueryValidatorScoresResponse, error) {
+	out := new(QueryValidatorScoresResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/ValidatorScores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DepositsByState(ctx context.Context, in *QueryDepositsByStateRequest, opts ...grpc.CallOption) (*QueryDepositsByStateResponse, error) {
+	out := new(QueryDepositsByStateResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/DepositsByState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochFundings(ctx context.Context, in *QueryEpochFundingsRequest, opts ...grpc.CallOption) (*QueryEpochFundingsResponse, error) {
+	out := new(QueryEpochFundingsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/EpochFundings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a HostChain by id.
+	HostChain(context.Context, *QueryHostChainRequest) (*QueryHostChainResponse, error)
+	// Queries for all the HostChains.
+	HostChains(context.Context, *QueryHostChainsRequest) (*QueryHostChainsResponse, error)
+	// Queries for all the deposits for a host chain.
+	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
+	// Queries for all the deposits for a host chain.
+	LSMDeposits(context.Context, *QueryLSMDepositsRequest) (*QueryLSMDepositsResponse, error)
+	// Queries all unbondings for a host chain.
+	Unbondings(context.Context, *QueryUnbondingsRequest) (*QueryUnbondingsResponse, error)
+	// Queries an unbonding for a host chain.
+	Unbonding(context.Context, *QueryUnbondingRequest) (*QueryUnbondingResponse, error)
+	// Queries all unbondings for a delegator address.
+	UserUnbondings(context.Context, *QueryUserUnbondingsRequest) (*QueryUserUnbondingsResponse, error)
+	// Queries all unbondings for a host chain.
+	HostChainUserUnbondings(context.Context, *QueryHostChainUserUnbondingsRequest) (*QueryHostChainUserUnbondingsResponse, error)
+	// Queries all validator unbondings for a host chain.
+	ValidatorUnbondings(context.Context, *QueryValidatorUnbondingRequest) (*QueryValidatorUnbondingResponse, error)
+	// Queries for a host chain deposit account balance.
+	DepositAccountBalance(context.Context, *QueryDepositAccountBalanceRequest) (*QueryDepositAccountBalanceResponse, error)
+	// Queries for a host chain exchange rate between the host token and the stk
+	// token.
+	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
+	// Queries for a host chain redelegation entries on the host token delegation
+	// acct.
+	Redelegations(context.Context, *QueryRedelegationsRequest) (*QueryRedelegationsResponse, error)
+	// Queries for a host chain redelegation-txs for the host token.
+	RedelegationTx(context.Context, *QueryRedelegationTxRequest) (*QueryRedelegationTxResponse, error)
+	// Queries all the deposit receipts of a delegator address.
+	DepositReceipts(context.Context, *QueryDepositReceiptsRequest) (*QueryDepositReceiptsResponse, error)
+	// Queries a deposit receipt by id.
+	DepositReceipt(context.Context, *QueryDepositReceiptRequest) (*QueryDepositReceiptResponse, error)
+	// Queries the deposit shortfalls of a host chain.
+	DepositShortfalls(context.Context, *QueryDepositShortfallsRequest) (*QueryDepositShortfallsResponse, error)
+	// Queries the pending validator exits of a host chain.
+	ValidatorExits(context.Context, *QueryValidatorExitsRequest) (*QueryValidatorExitsResponse, error)
+	// Queries the unbondings of a host chain within an epoch range, along with
+	// their totals per unbonding state.
+	UnbondingsByEpochRange(context.Context, *QueryUnbondingsByEpochRangeRequest) (*QueryUnbondingsByEpochRangeResponse, error)
+	// Queries the transfer channel migration of a host chain.
+	ChannelMigration(context.Context, *QueryChannelMigrationRequest) (*QueryChannelMigrationResponse, error)
+	// Queries the stk tokens waiting for their deposit delegation on a host
+	// chain.
+	PendingMints(context.Context, *QueryPendingMintsRequest) (*QueryPendingMintsResponse, error)
+	// Queries the stk tokens waiting for their deposit delegation for a
+	// delegator address.
+	DelegatorPendingMints(context.Context, *QueryDelegatorPendingMintsRequest) (*QueryDelegatorPendingMintsResponse, error)
+	// Queries the addresses and balances of the liquid staking module accounts.
+	ModuleAccounts(context.Context, *QueryModuleAccountsRequest) (*QueryModuleAccountsResponse, error)
+	// Queries the observed relay latency and packet timeout of an ibc connection.
+	RelayLatency(context.Context, *QueryRelayLatencyRequest) (*QueryRelayLatencyResponse, error)
+	// Queries the deposit cap of a host chain and the amount that can still be
+	// liquid staked under it.
+	DepositCapacity(context.Context, *QueryDepositCapacityRequest) (*QueryDepositCapacityResponse, error)
+	// Queries the manual c value updates of a host chain.
+	CValueHistory(context.Context, *QueryCValueHistoryRequest) (*QueryCValueHistoryResponse, error)
+	// Queries the governance proposals in deposit or voting period which
+	// execute liquidstakeibc messages.
+	PendingProposals(context.Context, *QueryPendingProposalsRequest) (*QueryPendingProposalsResponse, error)
+	// Queries the validator weights of a host chain next to the same weights
+	// normalized to add up to one.
+	ValidatorWeights(context.Context, *QueryValidatorWeightsRequest) (*QueryValidatorWeightsResponse, error)
+	// Queries how many host tokens could be unbonded in each of the next
+	// unbonding epochs of a host chain, given the validator unbonding entries
+	// limit and the undelegations still in flight.
+	UnbondingCapacity(context.Context, *QueryUnbondingCapacityRequest) (*QueryUnbondingCapacityResponse, error)
+	// Queries the fee, c value limit and cap updates of a host chain staged
+	// until the param change delay has passed.
+	PendingParamChanges(context.Context, *QueryPendingParamChangesRequest) (*QueryPendingParamChangesResponse, error)
+	// Queries the ica txs of a host chain waiting to be submitted again.
+	ICATxRetries(context.Context, *QueryICATxRetriesRequest) (*QueryICATxRetriesResponse, error)
+	// Queries the zero weight validators of a host chain whose delegations are
+	// being redelegated away.
+	ValidatorDrains(context.Context, *QueryValidatorDrainsRequest) (*QueryValidatorDrainsResponse, error)
+	// Queries the protocol fees collected on a host chain, by fee type and
+	// denomination.
+	FeeReport(context.Context, *QueryFeeReportRequest) (*QueryFeeReportResponse, error)
+	// Queries the commission rebate program of a host chain, with the boosted
+	// weight and the rebate accounting of each validator.
+	RebateProgram(context.Context, *QueryRebateProgramRequest) (*QueryRebateProgramResponse, error)
+	// Queries the host chains whose last run of a workflow failed.
+	WorkflowFailures(context.Context, *QueryWorkflowFailuresRequest) (*QueryWorkflowFailuresResponse, error)
+	// Queries the performance scores of the validators of a host chain.
+	ValidatorScores(context.Context, *QueryValidatorScoresRequest) (*QueryValidatorScoresResponse, error)
+	// Queries the deposits in a state, optionally of a single host chain.
+	DepositsByState(context.Context, *QueryDepositsByStateRequest) (*QueryDepositsByStateResponse, error)
+	// Queries the funding of the unbonding epochs of a host chain that are
+	// waiting for or being paid out of their unbonding transfers.
+	EpochFundings(context.Context, *QueryEpochFundingsRequest) (*QueryEpochFundingsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) HostChain(ctx context.Context, req *QueryHostChainRequest) (*QueryHostChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostChain not implemented")
+}
+func (*UnimplementedQueryServer) HostChains(ctx context.Context, req *QueryHostChainsRequest) (*QueryHostChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostChains not implemented")
+}
+func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDepositsRequest) (*QueryDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposits not implemented")
+}
+func (*UnimplementedQueryServer) LSMDeposits(ctx context.Context, req *QueryLSMDepositsRequest) (*QueryLSMDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LSMDeposits not implemented")
+}
+func (*UnimplementedQueryServer) Unbondings(ctx context.Context, req *QueryUnbondingsRequest) (*QueryUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unbondings not implemented")
+}
+func (*UnimplementedQueryServer) Unbonding(ctx context.Context, req *QueryUnbondingRequest) (*QueryUnbondingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unbonding not implemented")
+}
+func (*UnimplementedQueryServer) UserUnbondings(ctx context.Context, req *QueryUserUnbondingsRequest) (*QueryUserUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserUnbondings not implemented")
+}
+func (*UnimplementedQueryServer) HostChainUserUnbondings(ctx context.Context, req *QueryHostChainUserUnbondingsRequest) (*QueryHostChainUserUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostChainUserUnbondings not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorUnbondings(ctx context.Context, req *QueryValidatorUnbondingRequest) (*QueryValidatorUnbondingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorUnbondings not implemented")
+}
+func (*UnimplementedQueryServer) DepositAccountBalance(ctx context.Context, req *QueryDepositAccountBalanceRequest) (*QueryDepositAccountBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositAccountBalance not implemented")
+}
+func (*UnimplementedQueryServer) ExchangeRate(ctx context.Context, req *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRate not implemented")
+}
+func (*UnimplementedQueryServer) Redelegations(ctx context.Context, req *QueryRedelegationsRequest) (*QueryRedelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redelegations not implemented")
+}
+func (*UnimplementedQueryServer) RedelegationTx(ctx context.Context, req *QueryRedelegationTxRequest) (*QueryRedelegationTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegationTx not implemented")
+}
+func (*UnimplementedQueryServer) DepositReceipts(ctx context.Context, req *QueryDepositReceiptsRequest) (*QueryDepositReceiptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositReceipts not implemented")
+}
+func (*UnimplementedQueryServer) DepositReceipt(ctx context.Context, req *QueryDepositReceiptRequest) (*QueryDepositReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositReceipt not implemented")
+}
+func (*UnimplementedQueryServer) DepositShortfalls(ctx context.Context, req *QueryDepositShortfallsRequest) (*QueryDepositShortfallsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositShortfalls not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorExits(ctx context.Context, req *QueryValidatorExitsRequest) (*QueryValidatorExitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorExits not implemented")
+}
+func (*UnimplementedQueryServer) UnbondingsByEpochRange(ctx context.Context, req *QueryUnbondingsByEpochRangeRequest) (*QueryUnbondingsByEpochRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingsByEpochRange not implemented")
+}
+func (*UnimplementedQueryServer) ChannelMigration(ctx context.Context, req *QueryChannelMigrationRequest) (*QueryChannelMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelMigration not implemented")
+}
+func (*UnimplementedQueryServer) PendingMints(ctx context.Context, req *QueryPendingMintsRequest) (*QueryPendingMintsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingMints not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorPendingMints(ctx context.Context, req *QueryDelegatorPendingMintsRequest) (*QueryDelegatorPendingMintsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorPendingMints not implemented")
+}
+func (*UnimplementedQueryServer) ModuleAccounts(ctx context.Context, req *QueryModuleAccountsRequest) (*QueryModuleAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccounts not implemented")
+}
+func (*UnimplementedQueryServer) RelayLatency(ctx context.Context, req *QueryRelayLatencyRequest) (*QueryRelayLatencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayLatency not implemented")
+}
+func (*UnimplementedQueryServer) DepositCapacity(ctx context.Context, req *QueryDepositCapacityRequest) (*QueryDepositCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositCapacity not implemented")
+}
+func (*UnimplementedQueryServer) CValueHistory(ctx context.Context, req *QueryCValueHistoryRequest) (*QueryCValueHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CValueHistory not implemented")
+}
+func (*UnimplementedQueryServer) PendingProposals(ctx context.Context, req *QueryPendingProposalsRequest) (*QueryPendingProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingProposals not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorWeights(ctx context.Context, req *QueryValidatorWeightsRequest) (*QueryValidatorWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorWeights not implemented")
+}
+func (*UnimplementedQueryServer) UnbondingCapacity(ctx context.Context, req *QueryUnbondingCapacityRequest) (*QueryUnbondingCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingCapacity not implemented")
+}
+func (*UnimplementedQueryServer) PendingParamChanges(ctx context.Context, req *QueryPendingParamChangesRequest) (*QueryPendingParamChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingParamChanges not implemented")
+}
+func (*UnimplementedQueryServer) ICATxRetries(ctx context.Context, req *QueryICATxRetriesRequest) (*QueryICATxRetriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ICATxRetries not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorDrains(ctx context.Context, req *QueryValidatorDrainsRequest) (*QueryValidatorDrainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorDrains not implemented")
+}
+func (*UnimplementedQueryServer) FeeReport(ctx context.Context, req *QueryFeeReportRequest) (*QueryFeeReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeReport not implemented")
+}
+func (*UnimplementedQueryServer) RebateProgram(ctx context.Context, req *QueryRebateProgramRequest) (*QueryRebateProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebateProgram not implemented")
+}
+func (*UnimplementedQueryServer) WorkflowFailures(ctx context.Context, req *QueryWorkflowFailuresRequest) (*QueryWorkflowFailuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WorkflowFailures not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorScores(ctx context.Context, req *QueryValidatorScoresRequest) (*QueryValidatorScoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorScores not implemented")
+}
+func (*UnimplementedQueryServer) DepositsByState(ctx context.Context, req *QueryDepositsByStateRequest) (*QueryDepositsByStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositsByState not implemented")
+}
+func (*UnimplementedQueryServer) EpochFundings(ctx context.Context, req *QueryEpochFundingsRequest) (*QueryEpochFundingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochFundings not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/HostChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostChain(ctx, req.(*QueryHostChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostChainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostChains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/HostChains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostChains(ctx, req.(*QueryHostChainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Deposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Deposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/Deposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Deposits(ctx, req.(*QueryDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LSMDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLSMDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LSMDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/LSMDeposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LSMDeposits(ctx, req.(*QueryLSMDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Unbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Unbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/Unbondings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Unbondings(ctx, req.(*QueryUnbondingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Unbonding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Unbonding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/Unbonding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Unbonding(ctx, req.(*QueryUnbondingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserUnbondingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserUnbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/UserUnbondings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserUnbondings(ctx, req.(*QueryUserUnbondingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostChainUserUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostChainUserUnbondingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostChainUserUnbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/HostChainUserUnbondings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostChainUserUnbondings(ctx, req.(*QueryHostChainUserUnbondingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorUnbondingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorUnbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/ValidatorUnbondings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorUnbondings(ctx, req.(*QueryValidatorUnbondingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DepositAccountBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositAccountBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DepositAccountBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/DepositAccountBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DepositAccountBalance(ctx, req.(*QueryDepositAccountBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/ExchangeRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRate(ctx, req.(*QueryExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Redelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Redelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/Redelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Redelegations(ctx, req.(*QueryRedelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedelegationTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedelegationTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedelegationTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/RedelegationTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedelegationTx(ctx, req.(*QueryRedelegationTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DepositReceipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositReceiptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DepositReceipts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/DepositReceipts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DepositReceipts(ctx, req.(*QueryDepositReceiptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DepositReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DepositReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/DepositReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DepositReceipt(ctx, req.(*QueryDepositReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DepositShortfalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositShortfallsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DepositShortfalls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/DepositShortfalls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DepositShortfalls(ctx, req.(*QueryDepositShortfallsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorExits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorExitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorExits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/ValidatorExits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorExits(ctx, req.(*QueryValidatorExitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingsByEpochRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingsByEpochRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingsByEpochRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/UnbondingsByEpochRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingsByEpochRange(ctx, req.(*QueryUnbondingsByEpochRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/ChannelMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelMigration(ctx, req.(*QueryChannelMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingMints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingMintsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingMints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/PendingMints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingMints(ctx, req.(*QueryPendingMintsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorPendingMints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorPendingMintsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorPendingMints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/DelegatorPendingMints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorPendingMints(ctx, req.(*QueryDelegatorPendingMintsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/ModuleAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleAccounts(ctx, req.(*QueryModuleAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RelayLatency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelayLatencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RelayLatency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/RelayLatency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RelayLatency(ctx, req.(*QueryRelayLatencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DepositCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DepositCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/DepositCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DepositCapacity(ctx, req.(*QueryDepositCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CValueHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCValueHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CValueHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/CValueHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CValueHistory(ctx, req.(*QueryCValueHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/PendingProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingProposals(ctx, req.(*QueryPendingProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorWeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/ValidatorWeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorWeights(ctx, req.(*QueryValidatorWeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/UnbondingCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingCapacity(ctx, req.(*QueryUnbondingCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingParamChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingParamChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingParamChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/PendingParamChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingParamChanges(ctx, req.(*QueryPendingParamChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ICATxRetries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryICATxRetriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ICATxRetries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/ICATxRetries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ICATxRetries(ctx, req.(*QueryICATxRetriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorDrains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorDrainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorDrains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/ValidatorDrains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorDrains(ctx, req.(*QueryValidatorDrainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/FeeReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeReport(ctx, req.(*QueryFeeReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RebateProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRebateProgramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RebateProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/RebateProgram",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RebateProgram(ctx, req.(*QueryRebateProgramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WorkflowFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWorkflowFailuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WorkflowFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/WorkflowFailures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WorkflowFailures(ctx, req.(*QueryWorkflowFailuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/ValidatorScores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorScores(ctx, req.(*QueryValidatorScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DepositsByState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositsByStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DepositsByState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/DepositsByState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DepositsByState(ctx, req.(*QueryDepositsByStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochFundings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochFundingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochFundings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/EpochFundings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochFundings(ctx, req.(*QueryEpochFundingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pstake.liquidstakeibc.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "HostChain",
+			Handler:    _Query_HostChain_Handler,
+		},
+		{
+			MethodName: "HostChains",
+			Handler:    _Query_HostChains_Handler,
+		},
+		{
+			MethodName: "Deposits",
+			Handler:    _Query_Deposits_Handler,
+		},
+		{
+			MethodName: "LSMDeposits",
+			Handler:    _Query_LSMDeposits_Handler,
+		},
+		{
+			MethodName: "Unbondings",
+			Handler:    _Query_Unbondings_Handler,
+		},
+		{
+			MethodName: "Unbonding",
+			Handler:    _Query_Unbonding_Handler,
+		},
+		{
+			MethodName: "UserUnbondings",
+			Handler:    _Query_UserUnbondings_Handler,
+		},
+		{
+			MethodName: "HostChainUserUnbondings",
+			Handler:    _Query_HostChainUserUnbondings_Handler,
+		},
+		{
+			MethodName: "ValidatorUnbondings",
+			Handler:    _Query_ValidatorUnbondings_Handler,
+		},
+		{
+			MethodName: "DepositAccountBalance",
+			Handler:    _Query_DepositAccountBalance_Handler,
+		},
+		{
+			MethodName: "ExchangeRate",
+			Handler:    _Query_ExchangeRate_Handler,
+		},
+		{
+			MethodName: "Redelegations",
+			Handler:    _Query_Redelegations_Handler,
+		},
+		{
+			MethodName: "RedelegationTx",
+			Handler:    _Query_RedelegationTx_Handler,
+		},
+		{
+			MethodName: "DepositReceipts",
+			Handler:    _Query_DepositReceipts_Handler,
+		},
+		{
+			MethodName: "DepositReceipt",
+			Handler:    _Query_DepositReceipt_Handler,
+		},
+		{
+			MethodName: "DepositShortfalls",
+			Handler:    _Query_DepositShortfalls_Handler,
+		},
+		{
+			MethodName: "ValidatorExits",
+			Handler:    _Query_ValidatorExits_Handler,
+		},
+		{
+			MethodName: "UnbondingsByEpochRange",
+			Handler:    _Query_UnbondingsByEpochRange_Handler,
+		},
+		{
+			MethodName: "ChannelMigration",
+			Handler:    _Query_ChannelMigration_Handler,
+		},
+		{
+			MethodName: "PendingMints",
+			Handler:    _Query_PendingMints_Handler,
+		},
+		{
+			MethodName: "DelegatorPendingMints",
+			Handler:    _Query_DelegatorPendingMints_Handler,
+		},
+		{
+			MethodName: "ModuleAccounts",
+			Handler:    _Query_ModuleAccounts_Handler,
+		},
+		{
+			MethodName: "RelayLatency",
+			Handler:    _Query_RelayLatency_Handler,
+		},
+		{
+			MethodName: "DepositCapacity",
+			Handler:    _Query_DepositCapacity_Handler,
+		},
+		{
+			MethodName: "CValueHistory",
+			Handler:    _Query_CValueHistory_Handler,
+		},
+		{
+			MethodName: "PendingProposals",
+			Handler:    _Query_PendingProposals_Handler,
+		},
+		{
+			MethodName: "ValidatorWeights",
+			Handler:    _Query_ValidatorWeights_Handler,
+		},
+		{
+			MethodName: "UnbondingCapacity",
+			Handler:    _Query_UnbondingCapacity_Handler,
+		},
+		{
+			MethodName: "PendingParamChanges",
+			Handler:    _Query_PendingParamChanges_Handler,
+		},
+		{
+			MethodName: "ICATxRetries",
+			Handler:    _Query_ICATxRetries_Handler,
+		},
+		{
+			MethodName: "ValidatorDrains",
+			Handler:    _Query_ValidatorDrains_Handler,
+		},
+		{
+			MethodName: "FeeReport",
+			Handler:    _Query_FeeReport_Handler,
+		},
+		{
+			MethodName: "RebateProgram",
+			Handler:    _Query_RebateProgram_Handler,
+		},
+		{
+			MethodName: "WorkflowFailures",
+			Handler:    _Query_WorkflowFailures_Handler,
+		},
+		{
+			MethodName: "ValidatorScores",
+			Handler:    _Query_ValidatorScores_Handler,
+		},
+		{
+			MethodName: "DepositsByState",
+			Handler:    _Query_DepositsByState_Handler,
+		},
+		{
+			MethodName: "EpochFundings",
+			Handler:    _Query_EpochFundings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pstake/liquidstakeibc/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.HostChain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostChainsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostChainsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostChainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostChainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostChainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HostChains) > 0 {
+		for iNdEx := len(m.HostChains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostChains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLSMDepositsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLSMDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLSMDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLSMDepositsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLSMDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLSMDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Unbonding != nil {
+		{
+			size, err := m.Unbonding.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserUnbondingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserUnbondingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserUnbondings) > 0 {
+		for iNdEx := len(m.UserUnbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostChainUserUnbondingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostChainUserUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostChainUserUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostChainUserUnbondingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostChainUserUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostChainUserUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UserUnbondings) > 0 {
+		for iNdEx := len(m.UserUnbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorUnbondingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorUnbondingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorUnbondingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorUnbondingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorUnbondingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorUnbondingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorUnbondings) > 0 {
+		for iNdEx := len(m.ValidatorUnbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositAccountBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositAccountBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositAccountBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositAccountBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositAccountBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositAccountBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Redelegations != nil {
+		{
+			size, err := m.Redelegations.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedelegationTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedelegationTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedelegationTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedelegationTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedelegationTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedelegationTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RedelegationTx) > 0 {
+		for iNdEx := len(m.RedelegationTx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedelegationTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositReceiptsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositReceiptsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositReceiptsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositReceiptsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositReceiptsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositReceiptsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DepositReceipts) > 0 {
+		for iNdEx := len(m.DepositReceipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositReceipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositReceiptRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositReceiptRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositReceiptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositReceiptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositReceiptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositReceiptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DepositReceipt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositShortfallsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositShortfallsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositShortfallsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositShortfallsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositShortfallsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositShortfallsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shortfalls) > 0 {
+		for iNdEx := len(m.Shortfalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shortfalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorExitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorExitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorExitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorExitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorExitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorExitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Exits) > 0 {
+		for iNdEx := len(m.Exits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Exits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingsByEpochRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingsByEpochRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingsByEpochRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingsByEpochRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingsByEpochRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingsByEpochRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Totals) > 0 {
+		for iNdEx := len(m.Totals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Totals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnbondingStateTotal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingStateTotal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingStateTotal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UnbondAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.BurnAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.State != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelMigrationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelMigrationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelMigrationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelMigrationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelMigrationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelMigrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blockers) > 0 {
+		for iNdEx := len(m.Blockers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Blockers[iNdEx])
+			copy(dAtA[i:], m.Blockers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Blockers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Migration.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingMintsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingMintsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingMintsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingMintsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingMintsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingMintsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingMints) > 0 {
+		for iNdEx := len(m.PendingMints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingMints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorPendingMintsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorPendingMintsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorPendingMintsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorPendingMintsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorPendingMintsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorPendingMintsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingMints) > 0 {
+		for iNdEx := len(m.PendingMints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingMints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleAccountBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleAccountBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleAccountBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayLatencyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayLatencyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayLatencyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayLatencyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayLatencyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayLatencyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timeout):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintQuery(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Latency.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RemainingAmount.Size()
+		i -= size
+		if _, err := m.RemainingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LiquidStakedAmount.Size()
+		i -= size
+		if _, err := m.LiquidStakedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxDepositAmount.Size()
+		i -= size
+		if _, err := m.MaxDepositAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCValueHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCValueHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCValueHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCValueHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCValueHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCValueHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.VotingEndTime != nil {
+		n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintQuery(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.DepositEndTime != nil {
+		n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintQuery(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorWeightsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorWeightsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorWeightsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorWeightsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorWeightsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorWeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalWeight.Size()
+		i -= size
+		if _, err := m.TotalWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Weights) > 0 {
+		for iNdEx := len(m.Weights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Weights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NormalizedWeight.Size()
+		i -= size
+		if _, err := m.NormalizedWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxEntries != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxEntries))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.TotalCapacity.Size()
+		i -= size
+		if _, err := m.TotalCapacity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnbondingCapacityEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingCapacityEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingCapacityEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Capacity.Size()
+		i -= size
+		if _, err := m.Capacity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.QueuedAmount.Size()
+		i -= size
+		if _, err := m.QueuedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.AvailableValidators != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AvailableValidators))
+		i--
+		dAtA[i] = 0x18
+	}
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintQuery(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x12
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingParamChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingParamChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingParamChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingParamChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingParamChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingParamChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryICATxRetriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryICATxRetriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryICATxRetriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryICATxRetriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryICATxRetriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryICATxRetriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Retries) > 0 {
+		for iNdEx := len(m.Retries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Retries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorDrainsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorDrainsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorDrainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorDrainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorDrainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorDrainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Drains) > 0 {
+		for iNdEx := len(m.Drains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Drains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Report != nil {
+		{
+			size, err := m.Report.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRebateProgramRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRebateProgramRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRebateProgramRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRebateProgramResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRebateProgramResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRebateProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.WeightBoost.Size()
+		i -= size
+		if _, err := m.WeightBoost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxCommission.Size()
+		i -= size
+		if _, err := m.MaxCommission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RebateValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RebateValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RebateValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DelegatedAmount.Size()
+		i -= size
+		if _, err := m.DelegatedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.BoostedWeight.Size()
+		i -= size
+		if _, err := m.BoostedWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Qualifies {
+		i--
+		if m.Qualifies {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWorkflowFailuresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWorkflowFailuresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWorkflowFailuresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWorkflowFailuresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWorkflowFailuresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWorkflowFailuresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Failures) > 0 {
+		for iNdEx := len(m.Failures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Failures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorScoresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorScoresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorScoresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorScoresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorScoresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorScoresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScoredValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScoredValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScoredValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Score != nil {
+		{
+			size, err := m.Score.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositsByStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositsByStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositsByStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.State != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositsByStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositsByStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositsByStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochFundingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochFundingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochFundingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochFundingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochFundingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochFundingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fundings) > 0 {
+		for iNdEx := len(m.Fundings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fundings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochFundingStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochFundingStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochFundingStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Released {
+		i--
+		if m.Released {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Funding != nil {
+		{
+			size, err := m.Funding.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.UnbondAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.State != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHostChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHostChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HostChain.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHostChainsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryHostChainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HostChains) > 0 {
+		for _, e := range m.HostChains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLSMDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLSMDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUnbondingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUnbondingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *QueryUnbondingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Unbonding != nil {
+		l = m.Unbonding.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserUnbondingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserUnbondingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UserUnbondings) > 0 {
+		for _, e := range m.UserUnbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryHostChainUserUnbondingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHostChainUserUnbondingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UserUnbondings) > 0 {
+		for _, e := range m.UserUnbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorUnbondingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorUnbondingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorUnbondings) > 0 {
+		for _, e := range m.ValidatorUnbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDepositAccountBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositAccountBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExchangeRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExchangeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRedelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Redelegations != nil {
+		l = m.Redelegations.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedelegationTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedelegationTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RedelegationTx) > 0 {
+		for _, e := range m.RedelegationTx {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDepositReceiptsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositReceiptsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DepositReceipts) > 0 {
+		for _, e := range m.DepositReceipts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositReceiptRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryDepositReceiptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DepositReceipt.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDepositShortfallsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositShortfallsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Shortfalls) > 0 {
+		for _, e := range m.Shortfalls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorExitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorExitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Exits) > 0 {
+		for _, e := range m.Exits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUnbondingsByEpochRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.EndEpoch))
+	}
+	return n
+}
+
+func (m *QueryUnbondingsByEpochRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Totals) > 0 {
+		for _, e := range m.Totals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnbondingStateTotal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovQuery(uint64(m.State))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	l = m.BurnAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.UnbondAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryChannelMigrationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelMigrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Migration.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Blockers) > 0 {
+		for _, s := range m.Blockers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingMintsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingMintsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingMints) > 0 {
+		for _, e := range m.PendingMints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegatorPendingMintsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorPendingMintsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingMints) > 0 {
+		for _, e := range m.PendingMints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryModuleAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleAccountBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRelayLatencyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayLatencyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Latency.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timeout)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDepositCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxDepositAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LiquidStakedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCValueHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCValueHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DepositEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VotingEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProposalMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorWeightsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorWeightsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Weights) > 0 {
+		for _, e := range m.Weights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ValidatorWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NormalizedWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUnbondingCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Epochs != 0 {
+		n += 1 + sovQuery(uint64(m.Epochs))
+	}
+	return n
+}
+
+func (m *QueryUnbondingCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalCapacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MaxEntries != 0 {
+		n += 1 + sovQuery(uint64(m.MaxEntries))
+	}
+	return n
+}
+
+func (m *UnbondingCapacityEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.AvailableValidators != 0 {
+		n += 1 + sovQuery(uint64(m.AvailableValidators))
+	}
+	l = m.QueuedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Capacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingParamChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingParamChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryICATxRetriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryICATxRetriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Retries) > 0 {
+		for _, e := range m.Retries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorDrainsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorDrainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Drains) > 0 {
+		for _, e := range m.Drains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeeReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Report != nil {
+		l = m.Report.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRebateProgramRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRebateProgramResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Active {
+		n += 2
+	}
+	l = m.MaxCommission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.WeightBoost.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RebateValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Qualifies {
+		n += 2
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BoostedWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DelegatedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryWorkflowFailuresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryWorkflowFailuresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Failures) > 0 {
+		for _, e := range m.Failures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorScoresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorScoresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScoredValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Score != nil {
+		l = m.Score.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovQuery(uint64(m.MissedBlocksCounter))
+	}
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovQuery(uint64(m.SignedBlocksWindow))
+	}
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Tokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDepositsByStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovQuery(uint64(m.State))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositsByStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochFundingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochFundingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fundings) > 0 {
+		for _, e := range m.Fundings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochFundingStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	if m.State != 0 {
+		n += 1 + sovQuery(uint64(m.State))
+	}
+	l = m.UnbondAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Funding != nil {
+		l = m.Funding.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Released {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostChain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HostChain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostChainsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostChainsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostChainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostChainsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostChainsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostChains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostChains = append(m.HostChains, &HostChain{})
+			if err := m.HostChains[len(m.HostChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, &Deposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLSMDepositsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLSMDepositsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLSMDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLSMDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLSMDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLSMDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, &LSMDeposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbondings = append(m.Unbondings, &Unbonding{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Unbonding == nil {
+				m.Unbonding = &Unbonding{}
+			}
+			if err := m.Unbonding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserUnbondingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserUnbondingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserUnbondingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserUnbondingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserUnbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserUnbondings = append(m.UserUnbondings, &UserUnbonding{})
+			if err := m.UserUnbondings[len(m.UserUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostChainUserUnbondingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostChainUserUnbondingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostChainUserUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostChainUserUnbondingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostChainUserUnbondingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostChainUserUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserUnbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserUnbondings = append(m.UserUnbondings, &UserUnbonding{})
+			if err := m.UserUnbondings[len(m.UserUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorUnbondingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorUnbondingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorUnbondingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorUnbondingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorUnbondingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorUnbondingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUnbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorUnbondings = append(m.ValidatorUnbondings, &ValidatorUnbonding{})
+			if err := m.ValidatorUnbondings[len(m.ValidatorUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositAccountBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositAccountBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositAccountBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositAccountBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositAccountBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositAccountBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Redelegations == nil {
+				m.Redelegations = &Redelegations{}
+			}
+			if err := m.Redelegations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedelegationTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedelegationTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedelegationTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedelegationTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedelegationTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedelegationTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedelegationTx = append(m.RedelegationTx, &RedelegateTx{})
+			if err := m.RedelegationTx[len(m.RedelegationTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositReceiptsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositReceiptsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositReceiptsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositReceiptsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositReceiptsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositReceiptsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositReceipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositReceipts = append(m.DepositReceipts, &DepositReceipt{})
+			if err := m.DepositReceipts[len(m.DepositReceipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositReceiptRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositReceiptRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositReceiptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositReceiptResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositReceiptResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositReceiptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositReceipt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositReceipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositShortfallsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositShortfallsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositShortfallsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositShortfallsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositShortfallsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositShortfallsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shortfalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shortfalls = append(m.Shortfalls, &DepositShortfall{})
+			if err := m.Shortfalls[len(m.Shortfalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorExitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorExitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorExitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorExitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorExitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorExitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exits = append(m.Exits, &ValidatorExit{})
+			if err := m.Exits[len(m.Exits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingsByEpochRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingsByEpochRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingsByEpochRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingsByEpochRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingsByEpochRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingsByEpochRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbondings = append(m.Unbondings, &Unbonding{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Totals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Totals = append(m.Totals, UnbondingStateTotal{})
+			if err := m.Totals[len(m.Totals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingStateTotal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingStateTotal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingStateTotal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= Unbonding_UnbondingState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnbondAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelMigrationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelMigrationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelMigrationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelMigrationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelMigrationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelMigrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Migration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blockers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blockers = append(m.Blockers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingMintsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingMintsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingMintsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingMintsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingMintsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingMintsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingMints = append(m.PendingMints, &PendingMint{})
+			if err := m.PendingMints[len(m.PendingMints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorPendingMintsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorPendingMintsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorPendingMintsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorPendingMintsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorPendingMintsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorPendingMintsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingMints = append(m.PendingMints, &PendingMint{})
+			if err := m.PendingMints[len(m.PendingMints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, ModuleAccountBalance{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleAccountBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleAccountBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleAccountBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRelayLatencyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRelayLatencyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRelayLatencyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRelayLatencyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRelayLatencyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRelayLatencyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Latency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositCapacityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositCapacityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositCapacityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositCapacityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxDepositAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidStakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCValueHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCValueHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCValueHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCValueHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCValueHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCValueHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &CValueRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, &ModuleProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= v1.ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DepositEndTime == nil {
+				m.DepositEndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.DepositEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingEndTime == nil {
+				m.VotingEndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &ProposalMessage{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryRedelegationTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryRedelegationTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.RedelegationTx) > 0 {
-		for _, e := range m.RedelegationTx {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryValidatorWeightsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorWeightsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorWeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorWeightsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3316,12 +16127,80 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorWeightsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weights = append(m.Weights, &ValidatorWeight{})
+			if err := m.Weights[len(m.Weights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3343,7 +16222,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorWeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3353,30 +16232,96 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedWeight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3386,22 +16331,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NormalizedWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3426,7 +16372,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHostChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondingCapacityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3449,10 +16395,10 @@ func (m *QueryHostChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHostChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondingCapacityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHostChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondingCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3487,6 +16433,25 @@ func (m *QueryHostChainRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3508,7 +16473,7 @@ func (m *QueryHostChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHostChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondingCapacityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3531,15 +16496,15 @@ func (m *QueryHostChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHostChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondingCapacityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHostChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondingCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3566,60 +16531,64 @@ func (m *QueryHostChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HostChain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Epochs = append(m.Epochs, &UnbondingCapacityEpoch{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCapacity", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryHostChainsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.TotalCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHostChainsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHostChainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3641,7 +16610,7 @@ func (m *QueryHostChainsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHostChainsResponse) Unmarshal(dAtA []byte) error {
+func (m *UnbondingCapacityEpoch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3664,15 +16633,34 @@ func (m *QueryHostChainsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHostChainsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingCapacityEpoch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHostChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingCapacityEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostChains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3699,8 +16687,94 @@ func (m *QueryHostChainsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostChains = append(m.HostChains, &HostChain{})
-			if err := m.HostChains[len(m.HostChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableValidators", wireType)
+			}
+			m.AvailableValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AvailableValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QueuedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Capacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3725,7 +16799,7 @@ func (m *QueryHostChainsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingParamChangesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3748,10 +16822,10 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingParamChangesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingParamChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3807,7 +16881,7 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingParamChangesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3830,15 +16904,15 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingParamChangesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingParamChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3865,8 +16939,8 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposits = append(m.Deposits, &Deposit{})
-			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Changes = append(m.Changes, &PendingParamChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3891,7 +16965,7 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLSMDepositsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryICATxRetriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3914,10 +16988,10 @@ func (m *QueryLSMDepositsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLSMDepositsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryICATxRetriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLSMDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryICATxRetriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3973,7 +17047,7 @@ func (m *QueryLSMDepositsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLSMDepositsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryICATxRetriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3996,15 +17070,15 @@ func (m *QueryLSMDepositsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLSMDepositsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryICATxRetriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLSMDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryICATxRetriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4031,8 +17105,8 @@ func (m *QueryLSMDepositsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposits = append(m.Deposits, &LSMDeposit{})
-			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Retries = append(m.Retries, &ICATxRetry{})
+			if err := m.Retries[len(m.Retries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4057,7 +17131,7 @@ func (m *QueryLSMDepositsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnbondingsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorDrainsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4080,10 +17154,10 @@ func (m *QueryUnbondingsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorDrainsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorDrainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4139,7 +17213,7 @@ func (m *QueryUnbondingsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnbondingsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorDrainsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4162,15 +17236,15 @@ func (m *QueryUnbondingsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorDrainsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorDrainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Drains", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4197,8 +17271,8 @@ func (m *QueryUnbondingsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unbondings = append(m.Unbondings, &Unbonding{})
-			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Drains = append(m.Drains, &ValidatorDrain{})
+			if err := m.Drains[len(m.Drains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4223,7 +17297,7 @@ func (m *QueryUnbondingsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnbondingRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeReportRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4246,10 +17320,10 @@ func (m *QueryUnbondingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeReportRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4284,25 +17358,6 @@ func (m *QueryUnbondingRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
-			}
-			m.Epoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Epoch |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4324,7 +17379,7 @@ func (m *QueryUnbondingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnbondingResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeReportResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4347,15 +17402,15 @@ func (m *QueryUnbondingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeReportResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4382,10 +17437,10 @@ func (m *QueryUnbondingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Unbonding == nil {
-				m.Unbonding = &Unbonding{}
+			if m.Report == nil {
+				m.Report = &FeeReport{}
 			}
-			if err := m.Unbonding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Report.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4410,7 +17465,7 @@ func (m *QueryUnbondingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUserUnbondingsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRebateProgramRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4433,15 +17488,15 @@ func (m *QueryUserUnbondingsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserUnbondingsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRebateProgramRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRebateProgramRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4469,7 +17524,7 @@ func (m *QueryUserUnbondingsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4492,7 +17547,7 @@ func (m *QueryUserUnbondingsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUserUnbondingsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRebateProgramResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4515,15 +17570,103 @@ func (m *QueryUserUnbondingsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserUnbondingsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRebateProgramResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRebateProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserUnbondings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightBoost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightBoost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4550,8 +17693,8 @@ func (m *QueryUserUnbondingsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserUnbondings = append(m.UserUnbondings, &UserUnbonding{})
-			if err := m.UserUnbondings[len(m.UserUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validators = append(m.Validators, RebateValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4576,7 +17719,7 @@ func (m *QueryUserUnbondingsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHostChainUserUnbondingsRequest) Unmarshal(dAtA []byte) error {
+func (m *RebateValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4586,28 +17729,148 @@ func (m *QueryHostChainUserUnbondingsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RebateValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RebateValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Qualifies", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Qualifies = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHostChainUserUnbondingsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHostChainUserUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BoostedWeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4635,13 +17898,15 @@ func (m *QueryHostChainUserUnbondingsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if err := m.BoostedWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4651,25 +17916,23 @@ func (m *QueryHostChainUserUnbondingsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DelegatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4694,7 +17957,7 @@ func (m *QueryHostChainUserUnbondingsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHostChainUserUnbondingsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkflowFailuresRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4717,49 +17980,65 @@ func (m *QueryHostChainUserUnbondingsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHostChainUserUnbondingsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkflowFailuresRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHostChainUserUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkflowFailuresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserUnbondings", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWorkflowFailuresResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserUnbondings = append(m.UserUnbondings, &UserUnbonding{})
-			if err := m.UserUnbondings[len(m.UserUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWorkflowFailuresResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWorkflowFailuresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4786,10 +18065,8 @@ func (m *QueryHostChainUserUnbondingsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Failures = append(m.Failures, &WorkflowFailure{})
+			if err := m.Failures[len(m.Failures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4814,7 +18091,7 @@ func (m *QueryHostChainUserUnbondingsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorUnbondingRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorScoresRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4837,10 +18114,10 @@ func (m *QueryValidatorUnbondingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorUnbondingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorScoresRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorUnbondingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorScoresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4896,7 +18173,7 @@ func (m *QueryValidatorUnbondingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorUnbondingResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorScoresResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4919,15 +18196,15 @@ func (m *QueryValidatorUnbondingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorUnbondingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorScoresResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorUnbondingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorScoresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUnbondings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4954,8 +18231,8 @@ func (m *QueryValidatorUnbondingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorUnbondings = append(m.ValidatorUnbondings, &ValidatorUnbonding{})
-			if err := m.ValidatorUnbondings[len(m.ValidatorUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validators = append(m.Validators, ScoredValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4980,7 +18257,7 @@ func (m *QueryValidatorUnbondingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositAccountBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *ScoredValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5003,15 +18280,15 @@ func (m *QueryDepositAccountBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositAccountBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScoredValidator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositAccountBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScoredValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5039,63 +18316,121 @@ func (m *QueryDepositAccountBalanceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDepositAccountBalanceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if m.Score == nil {
+				m.Score = &ValidatorScore{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositAccountBalanceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositAccountBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5105,22 +18440,23 @@ func (m *QueryDepositAccountBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5145,7 +18481,7 @@ func (m *QueryDepositAccountBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositsByStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5168,13 +18504,32 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositsByStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositsByStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= Deposit_DepositState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -5206,6 +18561,42 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5227,7 +18618,7 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositsByStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5250,17 +18641,17 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositsByStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositsByStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5270,23 +18661,59 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Deposits = append(m.Deposits, &Deposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5311,7 +18738,7 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRedelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochFundingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5334,10 +18761,10 @@ func (m *QueryRedelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRedelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochFundingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRedelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochFundingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5393,7 +18820,7 @@ func (m *QueryRedelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRedelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochFundingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5416,15 +18843,15 @@ func (m *QueryRedelegationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRedelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochFundingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRedelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochFundingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fundings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5451,10 +18878,8 @@ func (m *QueryRedelegationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Redelegations == nil {
-				m.Redelegations = &Redelegations{}
-			}
-			if err := m.Redelegations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fundings = append(m.Fundings, &EpochFundingStatus{})
+			if err := m.Fundings[len(m.Fundings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5479,7 +18904,7 @@ func (m *QueryRedelegationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRedelegationTxRequest) Unmarshal(dAtA []byte) error {
+func (m *EpochFundingStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5502,17 +18927,55 @@ func (m *QueryRedelegationTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRedelegationTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochFundingStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRedelegationTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochFundingStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= Unbonding_UnbondingState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5522,77 +18985,28 @@ func (m *QueryRedelegationTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.UnbondAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRedelegationTxResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRedelegationTxResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRedelegationTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funding", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5619,11 +19033,33 @@ func (m *QueryRedelegationTxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RedelegationTx = append(m.RedelegationTx, &RedelegateTx{})
-			if err := m.RedelegationTx[len(m.RedelegationTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Funding == nil {
+				m.Funding = &EpochFunding{}
+			}
+			if err := m.Funding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Released = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
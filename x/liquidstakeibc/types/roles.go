@@ -0,0 +1,90 @@
+package types
+
+// AdminRole is a narrow permission over the module that a params address can hold next to the governance authority
+type AdminRole string
+
+const (
+	// RoleParamAdmin registers host chains and updates their params, the module params and the channel migrations
+	RoleParamAdmin AdminRole = "param_admin"
+	// RoleValidatorSetAdmin adds, removes and weights host chain validators, bounds their score driven weights, forces
+	// and cancels validator exits and forces delegation reconciliations
+	RoleValidatorSetAdmin AdminRole = "validator_set_admin"
+	// RoleEmergencyAdmin pauses and resumes host chains
+	RoleEmergencyAdmin AdminRole = "emergency_admin"
+	// RoleFeeAdmin updates the host chain fees and fee addresses, rotates the module fee address and sets the callback
+	// contract
+	RoleFeeAdmin AdminRole = "fee_admin"
+)
+
+// validatorSetKeys are the host chain updates that change the validator set or its weights
+var validatorSetKeys = map[string]bool{
+	KeyAddValidator:        true,
+	KeyRemoveValidator:     true,
+	KeyValidatorUpdate:     true,
+	KeyValidatorWeight:     true,
+	KeyMinActiveValidators: true,
+	KeyMaxValidatorWeight:  true,
+	KeyRebateMaxCommission: true,
+	KeyRebateWeightBoost:   true,
+	KeyScoreWeightMin:      true,
+	KeyScoreWeightMax:      true,
+}
+
+// feeKeys are the host chain updates that change what the protocol charges and where it is sent
+var feeKeys = map[string]bool{
+	KeyDepositFee:    true,
+	KeyRestakeFee:    true,
+	KeyUnstakeFee:    true,
+	KeyRedemptionFee: true,
+	KeyFeeAddress:    true,
+}
+
+// UpdateKeyRole returns the role allowed to apply the host chain updates of the key
+func UpdateKeyRole(key string) AdminRole {
+	switch {
+	case validatorSetKeys[key]:
+		return RoleValidatorSetAdmin
+	case feeKeys[key]:
+		return RoleFeeAdmin
+	default:
+		return RoleParamAdmin
+	}
+}
+
+// RoleAddress returns the address assigned the role in the params, empty if the role is not assigned
+func (p *Params) RoleAddress(role AdminRole) string {
+	switch role {
+	case RoleParamAdmin:
+		return p.ParamAdminAddress
+	case RoleValidatorSetAdmin:
+		return p.ValidatorSetAdminAddress
+	case RoleEmergencyAdmin:
+		return p.EmergencyAdminAddress
+	case RoleFeeAdmin:
+		return p.FeeAdminAddress
+	default:
+		return ""
+	}
+}
+
+// HasRole returns true if the address holds the role. The admin address holds every role but the emergency admin,
+// which has to be assigned explicitly.
+func (p *Params) HasRole(address string, role AdminRole) bool {
+	if address == "" {
+		return false
+	}
+	if role != RoleEmergencyAdmin && address == p.AdminAddress {
+		return true
+	}
+
+	return address == p.RoleAddress(role)
+}
+
+// RolesEqual returns true if both params assign the admin address and every role to the same addresses
+func (p *Params) RolesEqual(other Params) bool {
+	return p.AdminAddress == other.AdminAddress &&
+		p.ParamAdminAddress == other.ParamAdminAddress &&
+		p.ValidatorSetAdminAddress == other.ValidatorSetAdminAddress &&
+		p.EmergencyAdminAddress == other.EmergencyAdminAddress &&
+		p.FeeAdminAddress == other.FeeAdminAddress
+}
@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"testing"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func TestUpdateKeyRole(t *testing.T) {
+	require.Equal(t, types.RoleValidatorSetAdmin, types.UpdateKeyRole(types.KeyAddValidator))
+	require.Equal(t, types.RoleValidatorSetAdmin, types.UpdateKeyRole(types.KeyValidatorWeight))
+	require.Equal(t, types.RoleFeeAdmin, types.UpdateKeyRole(types.KeyDepositFee))
+	require.Equal(t, types.RoleFeeAdmin, types.UpdateKeyRole(types.KeyFeeAddress))
+	require.Equal(t, types.RoleParamAdmin, types.UpdateKeyRole(types.KeyActive))
+	require.Equal(t, types.RoleParamAdmin, types.UpdateKeyRole("unknown"))
+}
+
+func TestParams_HasRole(t *testing.T) {
+	admin := authtypes.NewModuleAddress("admin").String()
+	feeAdmin := authtypes.NewModuleAddress("fee_admin").String()
+	emergencyAdmin := authtypes.NewModuleAddress("emergency_admin").String()
+
+	params := types.DefaultParams()
+	params.AdminAddress = admin
+	params.FeeAdminAddress = feeAdmin
+	params.EmergencyAdminAddress = emergencyAdmin
+
+	// the admin address holds every role but the emergency admin
+	require.True(t, params.HasRole(admin, types.RoleParamAdmin))
+	require.True(t, params.HasRole(admin, types.RoleValidatorSetAdmin))
+	require.True(t, params.HasRole(admin, types.RoleFeeAdmin))
+	require.False(t, params.HasRole(admin, types.RoleEmergencyAdmin))
+
+	// role admins only hold their own role
+	require.True(t, params.HasRole(feeAdmin, types.RoleFeeAdmin))
+	require.False(t, params.HasRole(feeAdmin, types.RoleParamAdmin))
+	require.True(t, params.HasRole(emergencyAdmin, types.RoleEmergencyAdmin))
+	require.False(t, params.HasRole(emergencyAdmin, types.RoleValidatorSetAdmin))
+
+	// unassigned roles are not held by the empty address
+	require.False(t, params.HasRole("", types.RoleValidatorSetAdmin))
+
+	other := params
+	require.True(t, params.RolesEqual(other))
+	other.ValidatorSetAdminAddress = feeAdmin
+	require.False(t, params.RolesEqual(other))
+}
@@ -0,0 +1,179 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// number of components the validator score is the average of
+const validatorScoreComponents = 3
+
+// ValidatorConsensusAddress returns the bech32 consensus address of a host chain validator, using the prefix of its
+// operator address
+func ValidatorConsensusAddress(validator stakingtypes.Validator) (string, error) {
+	if validator.ConsensusPubkey == nil {
+		return "", fmt.Errorf("validator %s has no consensus pubkey", validator.OperatorAddress)
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return "", err
+	}
+
+	hrp, _, err := bech32.DecodeAndConvert(validator.OperatorAddress)
+	if err != nil {
+		return "", err
+	}
+
+	consHRP := strings.TrimSuffix(hrp, sdk.PrefixValidator+sdk.PrefixOperator) + sdk.PrefixValidator + sdk.PrefixConsensus
+	return bech32.ConvertAndEncode(consHRP, consAddr)
+}
+
+// ScoreValidator computes the performance score of a validator as the average of its uptime over the signed blocks
+// window, the fraction of the rewards it leaves to its delegators and the growth of its tokens since it was last
+// scored. Components whose inputs were not reported by ICQ yet are considered perfect.
+func (hc *HostChain) ScoreValidator(validator *Validator, epoch int64) *ValidatorScore {
+	uptime := sdk.OneDec()
+	if hc.RiskParams != nil && hc.RiskParams.SignedBlocksWindow > 0 {
+		window := hc.RiskParams.SignedBlocksWindow
+		missed := validator.MissedBlocksCounter
+		if missed > window {
+			missed = window
+		}
+		uptime = sdk.NewDec(window - missed).QuoInt64(window)
+	}
+
+	commission := sdk.OneDec()
+	if !validator.CommissionRate.IsNil() {
+		commission = sdk.OneDec().Sub(sdk.MinDec(validator.CommissionRate, sdk.OneDec()))
+	}
+
+	tokens := validator.Tokens
+	if tokens.IsNil() {
+		tokens = sdk.ZeroInt()
+	}
+
+	growth := sdk.OneDec()
+	if validator.Score != nil && !validator.Score.Tokens.IsNil() && validator.Score.Tokens.IsPositive() {
+		growth = sdk.MinDec(sdk.NewDecFromInt(tokens).QuoInt(validator.Score.Tokens), sdk.OneDec())
+	}
+
+	return &ValidatorScore{
+		Score:            uptime.Add(commission).Add(growth).QuoInt64(validatorScoreComponents),
+		Uptime:           uptime,
+		Commission:       commission,
+		DelegationGrowth: growth,
+		Tokens:           tokens,
+		Epoch:            epoch,
+	}
+}
+
+// IsScoreWeighted returns true if the weights of the host chain validators are recomputed from their scores
+func (hc *HostChain) IsScoreWeighted() bool {
+	return hc.Params != nil && !hc.Params.ScoreWeightMax.IsNil() && hc.Params.ScoreWeightMax.IsPositive()
+}
+
+// ScoreWeights computes the weights of the host chain validators from their performance scores. Bonded, non-jailed,
+// scored validators that are not excluded get a weight proportional to their score, clamped to the score weight
+// bounds, with the weight freed or taken by the clamped validators spread over the others by score. The weights sum
+// to one, every other validator gets a zero weight.
+func (hc *HostChain) ScoreWeights(excluded map[string]bool) (map[string]sdk.Dec, error) {
+	if !hc.IsScoreWeighted() {
+		return nil, fmt.Errorf("host chain %s validator weights are not score weighted", hc.ChainId)
+	}
+
+	minWeight, maxWeight := hc.Params.ScoreWeightMin, hc.Params.ScoreWeightMax
+	if minWeight.IsNil() {
+		minWeight = sdk.ZeroDec()
+	}
+
+	weights := make(map[string]sdk.Dec, len(hc.Validators))
+	free := make([]*Validator, 0, len(hc.Validators))
+	for _, validator := range hc.Validators {
+		weights[validator.OperatorAddress] = sdk.ZeroDec()
+		if validator.Status == stakingtypes.BondStatusBonded && !validator.Jailed &&
+			!excluded[validator.OperatorAddress] && validator.Score != nil && validator.Score.Score.IsPositive() {
+			free = append(free, validator)
+		}
+	}
+
+	eligible := int64(len(free))
+	if eligible == 0 {
+		return nil, fmt.Errorf("host chain %s has no scored bonded validators", hc.ChainId)
+	}
+	if maxWeight.MulInt64(eligible).LT(sdk.OneDec()) || minWeight.MulInt64(eligible).GT(sdk.OneDec()) {
+		return nil, fmt.Errorf(
+			"host chain %s score weight bounds [%s, %s] can't be met by %d validators",
+			hc.ChainId,
+			minWeight,
+			maxWeight,
+			eligible,
+		)
+	}
+
+	remaining := sdk.OneDec()
+	for len(free) > 0 {
+		totalScore := sdk.ZeroDec()
+		for _, validator := range free {
+			totalScore = totalScore.Add(validator.Score.Score)
+		}
+		for _, validator := range free {
+			weights[validator.OperatorAddress] = remaining.Mul(validator.Score.Score).Quo(totalScore)
+		}
+
+		// clamp the validators above the maximum first, the weight they give up can lift the ones below the minimum
+		bound, violates := maxWeight, func(weight sdk.Dec) bool { return weight.GT(maxWeight) }
+		if !hasViolation(free, weights, violates) {
+			bound, violates = minWeight, func(weight sdk.Dec) bool { return weight.LT(minWeight) }
+		}
+		if !hasViolation(free, weights, violates) {
+			break
+		}
+
+		unclamped := free[:0]
+		for _, validator := range free {
+			if violates(weights[validator.OperatorAddress]) {
+				weights[validator.OperatorAddress] = bound
+				remaining = remaining.Sub(bound)
+			} else {
+				unclamped = append(unclamped, validator)
+			}
+		}
+		free = unclamped
+	}
+
+	// hand the rounding dust to the free validator that has the most room for it
+	total := sdk.ZeroDec()
+	for _, weight := range weights {
+		total = total.Add(weight)
+	}
+	dust := sdk.OneDec().Sub(total)
+	if !dust.IsZero() && len(free) == 0 {
+		return nil, fmt.Errorf("host chain %s score weights sum to %s", hc.ChainId, total)
+	}
+	if !dust.IsZero() {
+		target := free[0]
+		for _, validator := range free[1:] {
+			weight, targetWeight := weights[validator.OperatorAddress], weights[target.OperatorAddress]
+			if (dust.IsPositive() && weight.LT(targetWeight)) || (dust.IsNegative() && weight.GT(targetWeight)) {
+				target = validator
+			}
+		}
+		weights[target.OperatorAddress] = weights[target.OperatorAddress].Add(dust)
+	}
+
+	return weights, nil
+}
+
+func hasViolation(validators []*Validator, weights map[string]sdk.Dec, violates func(sdk.Dec) bool) bool {
+	for _, validator := range validators {
+		if violates(weights[validator.OperatorAddress]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func TestValidatorConsensusAddress(t *testing.T) {
+	pubKey := ed25519.GenPrivKey().PubKey()
+	operator, err := bech32.ConvertAndEncode("cosmosvaloper", pubKey.Address())
+	require.NoError(t, err)
+
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(pubKey.Address()), pubKey, stakingtypes.Description{})
+	require.NoError(t, err)
+	validator.OperatorAddress = operator
+
+	consensusAddress, err := types.ValidatorConsensusAddress(validator)
+	require.NoError(t, err)
+	expected, err := bech32.ConvertAndEncode("cosmosvalcons", pubKey.Address())
+	require.NoError(t, err)
+	require.Equal(t, expected, consensusAddress)
+
+	// validators without a consensus key have no consensus address
+	_, err = types.ValidatorConsensusAddress(stakingtypes.Validator{OperatorAddress: operator})
+	require.Error(t, err)
+}
+
+func TestHostChain_ScoreValidator(t *testing.T) {
+	hc := &types.HostChain{RiskParams: &types.HostChainRiskParams{SignedBlocksWindow: 200}}
+
+	// inputs that were not reported yet do not lower the score
+	score := (&types.HostChain{}).ScoreValidator(&types.Validator{}, 1)
+	require.Equal(t, sdk.OneDec(), score.Score)
+	require.Equal(t, sdk.ZeroInt(), score.Tokens)
+
+	validator := &types.Validator{
+		MissedBlocksCounter: 50,
+		CommissionRate:      sdk.MustNewDecFromStr("0.25"),
+		Tokens:              sdk.NewInt(1200),
+		Score:               &types.ValidatorScore{Tokens: sdk.NewInt(1000)},
+	}
+	score = hc.ScoreValidator(validator, 3)
+	require.Equal(t, sdk.MustNewDecFromStr("0.75"), score.Uptime)
+	require.Equal(t, sdk.MustNewDecFromStr("0.75"), score.Commission)
+	require.Equal(t, sdk.OneDec(), score.DelegationGrowth)
+	require.Equal(t, sdk.MustNewDecFromStr("2.5").QuoInt64(3), score.Score)
+	require.Equal(t, int64(3), score.Epoch)
+
+	// missed blocks beyond the window leave no uptime
+	validator.MissedBlocksCounter = 300
+	require.Equal(t, sdk.ZeroDec(), hc.ScoreValidator(validator, 4).Uptime)
+}
+
+func TestHostChain_ScoreWeights(t *testing.T) {
+	scored := func(address string, score string) *types.Validator {
+		return &types.Validator{
+			OperatorAddress: address,
+			Status:          stakingtypes.BondStatusBonded,
+			Weight:          sdk.ZeroDec(),
+			Score:           &types.ValidatorScore{Score: sdk.MustNewDecFromStr(score)},
+		}
+	}
+	hc := &types.HostChain{
+		ChainId: "chain-1",
+		Params: &types.HostChainLSParams{
+			ScoreWeightMin: sdk.ZeroDec(),
+			ScoreWeightMax: sdk.ZeroDec(),
+		},
+		Validators: []*types.Validator{
+			scored("val1", "0.9"),
+			scored("val2", "0.6"),
+			scored("val3", "0.3"),
+			scored("val4", "0.2"),
+		},
+	}
+
+	// the score weights are disabled with a zero max
+	require.False(t, hc.IsScoreWeighted())
+	_, err := hc.ScoreWeights(nil)
+	require.Error(t, err)
+
+	// without binding bounds the weights are proportional to the scores
+	hc.Params.ScoreWeightMax = sdk.OneDec()
+	require.True(t, hc.IsScoreWeighted())
+	weights, err := hc.ScoreWeights(nil)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.45"), weights["val1"])
+	require.Equal(t, sdk.MustNewDecFromStr("0.3"), weights["val2"])
+	require.Equal(t, sdk.MustNewDecFromStr("0.15"), weights["val3"])
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), weights["val4"])
+
+	// the weight above the max is spread over the others by score, and the weight below the min taken from them
+	hc.Params.ScoreWeightMin = sdk.MustNewDecFromStr("0.15")
+	hc.Params.ScoreWeightMax = sdk.MustNewDecFromStr("0.4")
+	weights, err = hc.ScoreWeights(nil)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.4"), weights["val1"])
+	require.Equal(t, sdk.MustNewDecFromStr("0.15"), weights["val4"])
+	require.Equal(t, sdk.MustNewDecFromStr("0.3"), weights["val2"])
+	require.Equal(t, sdk.MustNewDecFromStr("0.15"), weights["val3"])
+
+	// jailed, unbonded, unscored and excluded validators get no weight
+	hc.Params.ScoreWeightMin = sdk.ZeroDec()
+	hc.Params.ScoreWeightMax = sdk.OneDec()
+	hc.Validators[1].Jailed = true
+	hc.Validators[2].Status = stakingtypes.BondStatusUnbonding
+	hc.Validators = append(hc.Validators, &types.Validator{OperatorAddress: "val5", Status: stakingtypes.BondStatusBonded})
+	weights, err = hc.ScoreWeights(map[string]bool{"val4": true})
+	require.NoError(t, err)
+	require.Equal(t, sdk.OneDec(), weights["val1"])
+	for _, address := range []string{"val2", "val3", "val4", "val5"} {
+		require.Equal(t, sdk.ZeroDec(), weights[address])
+	}
+
+	// bounds that the eligible validators can't meet are rejected
+	hc.Params.ScoreWeightMax = sdk.MustNewDecFromStr("0.5")
+	_, err = hc.ScoreWeights(map[string]bool{"val4": true})
+	require.Error(t, err)
+	weights, err = hc.ScoreWeights(nil)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), weights["val1"])
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), weights["val4"])
+
+	// uneven scores still sum to one
+	hc.Validators = []*types.Validator{scored("val1", "0.7"), scored("val2", "0.7"), scored("val3", "0.7")}
+	weights, err = hc.ScoreWeights(nil)
+	require.NoError(t, err)
+	total := sdk.ZeroDec()
+	for _, weight := range weights {
+		total = total.Add(weight)
+	}
+	require.Equal(t, sdk.OneDec(), total)
+}
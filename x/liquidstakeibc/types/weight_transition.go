@@ -0,0 +1,45 @@
+package types
+
+// IsTransitioningWeights returns true if the delegations of the host chain follow a change of the validator weights
+// over more than one redelegation epoch
+func (hc *HostChain) IsTransitioningWeights() bool {
+	return hc.Params != nil && hc.Params.WeightTransitionEpochs > 1
+}
+
+// NewWeightTransition returns a transition of the host chain delegations to the current validator weights
+func NewWeightTransition(hc *HostChain, epoch int64) *WeightTransition {
+	targets := make([]WeightTransitionTarget, 0, len(hc.Validators))
+	for _, validator := range hc.Validators {
+		targets = append(targets, WeightTransitionTarget{
+			ValidatorAddress: validator.OperatorAddress,
+			Weight:           validator.Weight,
+		})
+	}
+
+	return &WeightTransition{
+		ChainId:         hc.ChainId,
+		Targets:         targets,
+		StartEpoch:      epoch,
+		RemainingEpochs: int64(hc.Params.WeightTransitionEpochs),
+	}
+}
+
+// TargetsMatch returns true if the transition targets are the current weights of the validators
+func (t *WeightTransition) TargetsMatch(validators []*Validator) bool {
+	if len(t.Targets) != len(validators) {
+		return false
+	}
+
+	targets := make(map[string]WeightTransitionTarget, len(t.Targets))
+	for _, target := range t.Targets {
+		targets[target.ValidatorAddress] = target
+	}
+	for _, validator := range validators {
+		target, found := targets[validator.OperatorAddress]
+		if !found || target.Weight.IsNil() || validator.Weight.IsNil() || !target.Weight.Equal(validator.Weight) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,37 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func TestWeightTransition_TargetsMatch(t *testing.T) {
+	hc := &types.HostChain{
+		ChainId: "chain-1",
+		Params:  &types.HostChainLSParams{WeightTransitionEpochs: 3},
+		Validators: []*types.Validator{
+			{OperatorAddress: "val1", Weight: sdk.MustNewDecFromStr("0.6")},
+			{OperatorAddress: "val2", Weight: sdk.MustNewDecFromStr("0.4")},
+		},
+	}
+	require.True(t, hc.IsTransitioningWeights())
+
+	transition := types.NewWeightTransition(hc, 7)
+	require.Equal(t, int64(7), transition.StartEpoch)
+	require.Equal(t, int64(3), transition.RemainingEpochs)
+	require.True(t, transition.TargetsMatch(hc.Validators))
+
+	// the order of the validators does not matter
+	require.True(t, transition.TargetsMatch([]*types.Validator{hc.Validators[1], hc.Validators[0]}))
+
+	hc.Validators[0].Weight = sdk.MustNewDecFromStr("0.5")
+	require.False(t, transition.TargetsMatch(hc.Validators))
+	require.False(t, transition.TargetsMatch(hc.Validators[:1]))
+
+	hc.Params.WeightTransitionEpochs = 1
+	require.False(t, hc.IsTransitioningWeights())
+}
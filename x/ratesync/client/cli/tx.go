@@ -31,6 +31,7 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateChain())
 	cmd.AddCommand(CmdUpdateChain())
 	cmd.AddCommand(CmdDeleteChain())
+	cmd.AddCommand(CmdSetFeatureEnabled())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -183,3 +184,46 @@ func CmdDeleteChain() *cobra.Command {
 
 	return cmd
 }
+
+func CmdSetFeatureEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-feature-enabled [id] [feature-type] [enabled]",
+		Short: "Enable or disable a feature of a chain",
+		Long:  "Enable or disable a feature of a chain, feature-type is LIQUID_STAKE_IBC or LIQUID_STAKE",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			idInt, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			featureType, ok := types.FeatureType_value[args[1]]
+			if !ok {
+				return fmt.Errorf("invalid feature type %s", args[1])
+			}
+			enabled, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetFeatureEnabled(
+				clientCtx.GetFromAddress().String(),
+				idInt,
+				types.FeatureType(featureType),
+				enabled,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
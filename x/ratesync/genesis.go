@@ -1,6 +1,7 @@
 package ratesync
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/persistenceOne/pstake-native/v2/x/ratesync/keeper"
@@ -9,10 +10,22 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// a malformed host chain would otherwise only fail once its ICA is used
+	if err := genState.Validate(); err != nil {
+		panic(errorsmod.Wrap(types.ErrInvalidGenesis, err.Error()))
+	}
+
 	// Set all the chain
+	var lastID uint64
 	for _, elem := range genState.HostChains {
+		if err := k.ValidateGenesisHostChain(ctx, elem); err != nil {
+			panic(err)
+		}
 		k.SetHostChain(ctx, elem)
+		lastID = max(lastID, elem.ID)
 	}
+	// the host chains created after the import must not reuse the ID, and so the ICA port, of an imported one
+	k.SetHostChainID(ctx, lastID)
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
@@ -40,4 +40,31 @@ func TestGenesis(t *testing.T) {
 
 	require.ElementsMatch(t, genesisState.HostChains, got.HostChains)
 	// this line is used by starport scaffolding # genesis/test/assert
+
+	// host chains created after the import don't reuse an imported id
+	require.Equal(t, uint64(3), k.IncrementHostChainID(ctx))
+}
+
+func TestInitGenesisInvalid(t *testing.T) {
+	_, pStakeApp, ctx := helpers.CreateTestApp(t)
+
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+		HostChains: []types.HostChain{
+			{
+				ID:           1,
+				ChainID:      "test-1",
+				ConnectionID: "connection-99",
+				ICAAccount:   liquidstakeibctypes.ICAAccount{Balance: sdk.Coin{Amount: sdk.OneInt()}},
+			},
+		},
+	}
+
+	// the connection is not part of the imported IBC state
+	require.Panics(t, func() { ratesync.InitGenesis(ctx, *pStakeApp.RatesyncKeeper, genesisState) })
+
+	// the port owner belongs to another host chain id
+	genesisState.HostChains[0].ConnectionID = "connection-0"
+	genesisState.HostChains[0].ICAAccount.Owner = types.DefaultPortOwner(2)
+	require.Panics(t, func() { ratesync.InitGenesis(ctx, *pStakeApp.RatesyncKeeper, genesisState) })
 }
@@ -8,6 +8,9 @@ import (
 )
 
 func (k *Keeper) BeginBlock(ctx sdk.Context) {
+	// send the rate syncs queued by the epochs and c value updates, within the sync gas budget
+	k.ProcessPendingSyncs(ctx)
+
 	// perform BeginBlocker tasks for each chain
 	for _, hc := range k.GetAllHostChain(ctx) {
 		if !hc.IsActive() {
@@ -17,6 +20,9 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) {
 		// attempt to recreate closed ICA channels
 		k.DoRecreateICA(ctx, hc)
 
+		// report how old the rate last acknowledged by the host chain contracts is
+		k.SetSyncAgeGauge(ctx, hc)
+
 		// reset hc before going into next function, as it might have changed in earlier function
 		// as we do not want to re-write and omit the last write.
 	}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"encoding/binary"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -28,6 +29,78 @@ func (k Keeper) IncrementHostChainID(ctx sdk.Context) (hostChainID uint64) {
 	return hostChainID
 }
 
+// SetHostChainID sets the last host chain ID, so the next host chain gets the one after it
+func (k Keeper) SetHostChainID(ctx sdk.Context, hostChainID uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, hostChainID)
+	ctx.KVStore(k.storeKey).Set(types.HostChainIDKeyPrefix, bz)
+}
+
+// ValidateGenesisHostChain checks an imported host chain against the imported IBC state: its connection has to lead to
+// the host chain, its ICA address has to be the one registered for its port on that connection, and its transfer
+// channel has to run over the same connection
+func (k Keeper) ValidateGenesisHostChain(ctx sdk.Context, hc types.HostChain) error {
+	if hc.ConnectionID == "" {
+		return nil
+	}
+
+	chainID, err := k.GetChainID(ctx, hc.ConnectionID)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidGenesis, "host chain %d: %s", hc.ID, err)
+	}
+	if chainID != hc.ChainID {
+		return errorsmod.Wrapf(
+			types.ErrInvalidGenesis,
+			"host chain %d: connection %s leads to chain %s, expected %s",
+			hc.ID,
+			hc.ConnectionID,
+			chainID,
+			hc.ChainID,
+		)
+	}
+
+	if hc.ICAAccount.Owner != "" && hc.ICAAccount.Address != "" {
+		portID := types.MustICAPortIDFromOwner(hc.ICAAccount.Owner)
+		address, found := k.icaControllerKeeper.GetInterchainAccountAddress(ctx, hc.ConnectionID, portID)
+		if found && address != hc.ICAAccount.Address {
+			return errorsmod.Wrapf(
+				types.ErrInvalidGenesis,
+				"host chain %d: ica address %s does not match the address %s registered for port %s on connection %s",
+				hc.ID,
+				hc.ICAAccount.Address,
+				address,
+				portID,
+				hc.ConnectionID,
+			)
+		}
+	}
+
+	if hc.TransferChannelID != "" {
+		channel, found := k.ibcKeeper.ChannelKeeper.GetChannel(ctx, hc.TransferPortID, hc.TransferChannelID)
+		if !found {
+			return errorsmod.Wrapf(
+				types.ErrInvalidGenesis,
+				"host chain %d: transfer channel %s on port %s not found",
+				hc.ID,
+				hc.TransferChannelID,
+				hc.TransferPortID,
+			)
+		}
+		if len(channel.ConnectionHops) == 0 || channel.ConnectionHops[0] != hc.ConnectionID {
+			return errorsmod.Wrapf(
+				types.ErrInvalidGenesis,
+				"host chain %d: transfer channel %s runs over connection hops %v, expected %s",
+				hc.ID,
+				hc.TransferChannelID,
+				channel.ConnectionHops,
+				hc.ConnectionID,
+			)
+		}
+	}
+
+	return nil
+}
+
 // SetHostChain set a specific chain in the store from its index
 func (k Keeper) SetHostChain(ctx sdk.Context, chain types.HostChain) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HostChainKeyPrefix)
@@ -4,6 +4,7 @@ import (
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 
 	liquidstakeibctypes "github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
@@ -95,3 +96,37 @@ func (suite *IntegrationTestSuite) TestHostChainGetAll() {
 	items := createNChain(keeper, ctx, 10)
 	suite.Require().ElementsMatch(items, keeper.GetAllHostChain(ctx))
 }
+
+func (suite *IntegrationTestSuite) TestValidateGenesisHostChain() {
+	k, ctx := suite.app.RatesyncKeeper, suite.ctx
+	transferEndpoint := suite.transferPathAB.EndpointA
+	portID := types.MustICAPortIDFromOwner(types.DefaultPortOwner(1))
+	icaAddress, found := suite.app.ICAControllerKeeper.GetInterchainAccountAddress(ctx, transferEndpoint.ConnectionID, portID)
+	suite.Require().True(found)
+
+	hc := ValidHostChainInMsg(1)
+	hc.ChainID = suite.chainB.ChainID
+	hc.ConnectionID = transferEndpoint.ConnectionID
+	hc.ICAAccount.Address = icaAddress
+	hc.ICAAccount.ChannelState = liquidstakeibctypes.ICAAccount_ICA_CHANNEL_CREATED
+	hc.TransferChannelID = transferEndpoint.ChannelID
+	hc.TransferPortID = transferEndpoint.ChannelConfig.PortID
+	suite.Require().NoError(k.ValidateGenesisHostChain(ctx, hc))
+
+	// host chains without a connection are not checked against the IBC state
+	suite.Require().NoError(k.ValidateGenesisHostChain(ctx, types.HostChain{ID: 2}))
+
+	invalid := []func(hc *types.HostChain){
+		func(hc *types.HostChain) { hc.ConnectionID = "connection-99" },
+		func(hc *types.HostChain) { hc.ChainID = suite.chainC.ChainID },
+		func(hc *types.HostChain) { hc.ICAAccount.Address = authtypes.NewModuleAddress("other").String() },
+		func(hc *types.HostChain) { hc.TransferChannelID = "channel-99" },
+		func(hc *types.HostChain) { hc.TransferChannelID = suite.transferPathAC.EndpointA.ChannelID },
+	}
+	for _, malform := range invalid {
+		malformed := hc
+		malform(&malformed)
+		err := k.ValidateGenesisHostChain(ctx, malformed)
+		suite.Require().ErrorIs(err, types.ErrInvalidGenesis)
+	}
+}
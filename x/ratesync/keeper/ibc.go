@@ -134,7 +134,7 @@ func (k *Keeper) OnAcknowledgementPacket(
 	}
 	switch resp := ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
-		err := k.handleUnsuccessfulAck(ctx, icaPacket, packet, icaMemo)
+		err := k.handleUnsuccessfulAck(ctx, icaPacket, packet, icaMemo, resp.Error)
 		if err != nil {
 			return err
 		}
@@ -202,7 +202,7 @@ func (k *Keeper) OnTimeoutPacket(
 	if hc.ID != icaMemo.HostChainID {
 		return errorsmod.Wrapf(types.ErrInvalid, "host chain ID should match ID in memo")
 	}
-	if err := k.handleUnsuccessfulAck(ctx, icaPacket, packet, icaMemo); err != nil {
+	if err := k.handleUnsuccessfulAck(ctx, icaPacket, packet, icaMemo, "ica tx timed out"); err != nil {
 		return err
 	}
 
@@ -230,6 +230,7 @@ func (k *Keeper) handleUnsuccessfulAck(
 	ctx sdk.Context,
 	icaPacket icatypes.InterchainAccountPacketData,
 	packet channeltypes.Packet, icaMemo types.ICAMemo,
+	reason string,
 ) error {
 	messages, err := icatypes.DeserializeCosmosTx(k.cdc, icaPacket.GetData())
 	if err != nil {
@@ -282,6 +283,7 @@ func (k *Keeper) handleUnsuccessfulAck(
 				continue
 			}
 			// Do nothing, relay next epoch
+			k.RecordSyncFailed(ctx, hc.ID, icaMemo.FeatureType, reason)
 
 			// emit an event for the execution confirmation
 			ctx.EventManager().EmitEvent(
@@ -370,7 +372,7 @@ func (k *Keeper) handleSuccessfulAck(
 				)
 			}
 
-			if err = k.HandleExecuteContractResponse(ctx, parsedMsg, msgResponse); err != nil {
+			if err = k.HandleExecuteContractResponse(ctx, parsedMsg, msgResponse, icaMemo); err != nil {
 				return err
 			}
 		}
@@ -415,7 +417,8 @@ func (k Keeper) HandleInstantiateContractResponse(ctx sdk.Context,
 func (k Keeper) HandleExecuteContractResponse(ctx sdk.Context,
 	msg *wasmtypes.MsgExecuteContract,
 	resp wasmtypes.MsgExecuteContractResponse,
+	icaMemo types.ICAMemo,
 ) error {
-	// cool do nothing
+	k.RecordSyncAcked(ctx, icaMemo.HostChainID, icaMemo.FeatureType, msg.Msg)
 	return nil
 }
@@ -106,10 +106,12 @@ func (k *Keeper) ExecuteLiquidStakeRateTx(ctx sdk.Context, feature types.LiquidS
 		if err != nil {
 			return err
 		}
-		_, err = k.GenerateAndExecuteICATx(ctx, connectionID, icaAccount.Owner, []proto.Message{msg}, string(memoBz))
+		res, err := k.GenerateAndExecuteICATx(ctx, connectionID, icaAccount.Owner, []proto.Message{msg}, string(memoBz))
 		if err != nil {
+			k.RecordSyncFailed(ctx, hostchainId, feature.FeatureType, err.Error())
 			return err
 		}
+		k.RecordSyncSent(ctx, hostchainId, feature.FeatureType, mintDenom, cValue, res.Sequence)
 	}
 	return nil
 }
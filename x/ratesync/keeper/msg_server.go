@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -272,6 +273,8 @@ func (k msgServer) DeleteHostChain(goCtx context.Context, msg *types.MsgDeleteHo
 		ctx,
 		msg.ID,
 	)
+	k.RemoveSyncStatus(ctx, msg.ID)
+	k.RemovePendingSyncs(ctx, msg.ID)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -286,6 +289,52 @@ func (k msgServer) DeleteHostChain(goCtx context.Context, msg *types.MsgDeleteHo
 	return &types.MsgDeleteHostChainResponse{}, nil
 }
 
+// SetFeatureEnabled turns a single feature of a host chain on or off, so an integration can be paused without a full
+// host chain update
+func (k msgServer) SetFeatureEnabled(goCtx context.Context, msg *types.MsgSetFeatureEnabled) (*types.MsgSetFeatureEnabledResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	// Checks if the msg creator is the same as the current owner
+	if msg.Authority != k.authority && msg.Authority != params.Admin {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrorInvalidSigner, "tx signer is not a module authority")
+	}
+
+	hc, isFound := k.GetHostChain(ctx, msg.ID)
+	if !isFound {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "id not set, hostchain does not exist")
+	}
+
+	feature, err := hc.Features.GetFeature(msg.FeatureType)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalid, err.Error())
+	}
+	if feature.Enabled == msg.Enabled {
+		return nil, errorsmod.Wrapf(types.ErrInvalid, "feature %s already has enabled set to %v", msg.FeatureType, msg.Enabled)
+	}
+
+	// a feature can only be turned on once its contract is instantiated
+	feature.Enabled = msg.Enabled
+	if err := feature.ValdidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalid, err.Error())
+	}
+
+	k.SetHostChain(ctx, hc)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetFeatureEnabled,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainID),
+			sdk.NewAttribute(types.AttributeID, fmt.Sprintf("%v", hc.ID)),
+			sdk.NewAttribute(types.AttributeFeatureType, msg.FeatureType.String()),
+			sdk.NewAttribute(types.AttributeEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+	})
+
+	return &types.MsgSetFeatureEnabledResponse{}, nil
+}
+
 // UpdateParams defines a method for updating the module params
 func (k msgServer) UpdateParams(
 	goCtx context.Context,
@@ -219,3 +219,64 @@ func (suite *IntegrationTestSuite) TestChainMsgServerUpdateParams() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestChainMsgServerSetFeatureEnabled() {
+	k, ctx := suite.app.RatesyncKeeper, suite.ctx
+	hc := createNChain(k, ctx, 2)[1]
+	hc.Features.LiquidStake.Instantiation = types.InstantiationState_INSTANTIATION_COMPLETED
+	hc.Features.LiquidStake.CodeID = 1
+	hc.Features.LiquidStake.ContractAddress = GovAddress.String()
+	k.SetHostChain(ctx, hc)
+
+	tests := []struct {
+		desc    string
+		request *types.MsgSetFeatureEnabled
+		err     error
+	}{
+		{
+			desc:    "Unauthorized",
+			request: types.NewMsgSetFeatureEnabled("B", hc.ID, types.FeatureType_LIQUID_STAKE, true),
+			err:     sdkerrors.ErrorInvalidSigner,
+		},
+		{
+			desc:    "KeyNotFound",
+			request: types.NewMsgSetFeatureEnabled(GovAddress.String(), 10, types.FeatureType_LIQUID_STAKE, true),
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Enabled",
+			request: types.NewMsgSetFeatureEnabled(GovAddress.String(), hc.ID, types.FeatureType_LIQUID_STAKE, true),
+		},
+		{
+			desc:    "AlreadyEnabled",
+			request: types.NewMsgSetFeatureEnabled(GovAddress.String(), hc.ID, types.FeatureType_LIQUID_STAKE, true),
+			err:     types.ErrInvalid,
+		},
+		{
+			desc:    "NotInstantiated",
+			request: types.NewMsgSetFeatureEnabled(GovAddress.String(), hc.ID, types.FeatureType_LIQUID_STAKE_IBC, true),
+			err:     types.ErrInvalid,
+		},
+		{
+			desc:    "Disabled",
+			request: types.NewMsgSetFeatureEnabled(GovAddress.String(), hc.ID, types.FeatureType_LIQUID_STAKE, false),
+		},
+	}
+	for _, tc := range tests {
+		suite.T().Run(tc.desc, func(t *testing.T) {
+			srv := keeper.NewMsgServerImpl(*k)
+			wctx := sdk.WrapSDKContext(ctx)
+
+			_, err := srv.SetFeatureEnabled(wctx, tc.request)
+			if tc.err != nil {
+				suite.Require().ErrorIs(err, tc.err)
+			} else {
+				suite.Require().NoError(err)
+				updated, found := k.GetHostChain(ctx, tc.request.ID)
+				suite.Require().True(found)
+				suite.Require().Equal(tc.request.Enabled, updated.Features.LiquidStake.Enabled)
+				suite.Require().False(updated.Features.LiquidStakeIBC.Enabled)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/ratesync/types"
+)
+
+// SetPendingSync queues a rate sync, replacing the rate queued for the same host chain feature and minted denom
+func (k Keeper) SetPendingSync(ctx sdk.Context, sync types.PendingSync) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSyncKeyPrefix)
+	b := k.cdc.MustMarshal(&sync)
+	store.Set(types.PendingSyncKey(sync.ID, sync.FeatureType, sync.MintDenom), b)
+}
+
+// GetAllPendingSyncs returns the queued rate syncs, ordered by host chain
+func (k Keeper) GetAllPendingSyncs(ctx sdk.Context) (list []types.PendingSync) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSyncKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PendingSync
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemovePendingSync removes a queued rate sync
+func (k Keeper) RemovePendingSync(ctx sdk.Context, sync types.PendingSync) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSyncKeyPrefix)
+	store.Delete(types.PendingSyncKey(sync.ID, sync.FeatureType, sync.MintDenom))
+}
+
+// RemovePendingSyncs removes the rate syncs queued for a host chain
+func (k Keeper) RemovePendingSyncs(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSyncKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.HostChainKey(id))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// QueueRateSync syncs a rate to a host chain feature contract, right away if there is no sync gas budget, or else
+// through the queue processed within the budget of every block
+func (k Keeper) QueueRateSync(
+	ctx sdk.Context,
+	hc types.HostChain,
+	feature types.LiquidStake,
+	mintDenom, hostDenom string,
+	cValue sdk.Dec,
+) error {
+	if k.GetParams(ctx).SyncGasBudget == 0 {
+		return k.ExecuteLiquidStakeRateTx(ctx, feature, mintDenom, hostDenom, cValue, hc.ID, hc.ConnectionID, hc.ICAAccount)
+	}
+	if !feature.AllowsDenom(mintDenom) {
+		return nil
+	}
+
+	k.SetPendingSync(ctx, types.PendingSync{
+		ID:           hc.ID,
+		FeatureType:  feature.FeatureType,
+		MintDenom:    mintDenom,
+		HostDenom:    hostDenom,
+		CValue:       cValue,
+		QueuedHeight: ctx.BlockHeight(),
+	})
+	return nil
+}
+
+// ProcessPendingSyncs sends the queued rate syncs until the sync gas budget of the block is spent, at least one per
+// block. Each block continues after the last sync sent by the previous one, so every host chain gets its turn.
+func (k Keeper) ProcessPendingSyncs(ctx sdk.Context) {
+	syncs := k.GetAllPendingSyncs(ctx)
+	if len(syncs) == 0 {
+		return
+	}
+
+	// round-robin from the sync after the last one sent
+	store := ctx.KVStore(k.storeKey)
+	cursor := store.Get(types.SyncCursorKey)
+	start := 0
+	for i, sync := range syncs {
+		if bytes.Compare(types.PendingSyncKey(sync.ID, sync.FeatureType, sync.MintDenom), cursor) > 0 {
+			start = i
+			break
+		}
+	}
+	syncs = append(syncs[start:], syncs[:start]...)
+
+	budget := k.GetParams(ctx).SyncGasBudget
+	gasStart := ctx.GasMeter().GasConsumed()
+	sent := 0
+	for _, sync := range syncs {
+		if sent > 0 && budget > 0 && ctx.GasMeter().GasConsumed()-gasStart >= budget {
+			break
+		}
+		sent++
+
+		k.RemovePendingSync(ctx, sync)
+		store.Set(types.SyncCursorKey, types.PendingSyncKey(sync.ID, sync.FeatureType, sync.MintDenom))
+
+		hc, found := k.GetHostChain(ctx, sync.ID)
+		if !found {
+			continue
+		}
+		feature, err := hc.Features.GetFeature(sync.FeatureType)
+		if err != nil || !feature.Enabled {
+			continue
+		}
+
+		err = k.ExecuteLiquidStakeRateTx(ctx, *feature, sync.MintDenom, sync.HostDenom, sync.CValue, hc.ID, hc.ConnectionID, hc.ICAAccount)
+		if err != nil {
+			k.Logger(ctx).Error("cannot ExecuteLiquidStakeRateTx for queued rate sync",
+				"id", hc.ID,
+				"mint-denom", sync.MintDenom,
+				"err:", err)
+		}
+	}
+
+	telemetry.ModuleSetGauge(types.ModuleName, float32(len(syncs)-sent), "pending_syncs")
+}
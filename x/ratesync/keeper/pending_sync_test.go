@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/ratesync/types"
+)
+
+func (suite *IntegrationTestSuite) TestProcessPendingSyncs() {
+	k := suite.app.RatesyncKeeper
+	ctx, _ := suite.ctx.CacheContext()
+	hcs := createNChain(k, ctx, 3)
+	for i := range hcs {
+		hcs[i].Features.LiquidStake.Enabled = true
+		hcs[i].Features.LiquidStake.Denoms = []string{types.LiquidStakeAllowAllDenoms}
+		k.SetHostChain(ctx, hcs[i])
+	}
+	params := k.GetParams(ctx)
+	params.SyncGasBudget = 1
+	k.SetParams(ctx, params)
+
+	attempted := func() int {
+		count := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeRateSyncSent || event.Type == types.EventTypeRateSyncFailed {
+				count++
+			}
+		}
+		return count
+	}
+	queue := func(hc types.HostChain, cValue sdk.Dec) {
+		suite.Require().NoError(k.QueueRateSync(ctx, hc, hc.Features.LiquidStake, "stk/uxprt", "uxprt", cValue))
+	}
+
+	// with a budget the syncs are queued instead of sent, a newer rate replacing the queued one
+	for _, hc := range hcs {
+		queue(hc, sdk.OneDec())
+	}
+	queue(hcs[0], sdk.MustNewDecFromStr("1.1"))
+	suite.Require().Equal(0, attempted())
+	syncs := k.GetAllPendingSyncs(ctx)
+	suite.Require().Len(syncs, 3)
+	suite.Require().Equal(sdk.MustNewDecFromStr("1.1"), syncs[0].CValue)
+
+	// the budget is spent by the first sync of the block
+	k.ProcessPendingSyncs(ctx)
+	suite.Require().Equal(1, attempted())
+	suite.Require().Len(k.GetAllPendingSyncs(ctx), 2)
+
+	// the next block carries on with the next host chain, even if the first one queued a new rate
+	queue(hcs[0], sdk.OneDec())
+	k.ProcessPendingSyncs(ctx)
+	syncs = k.GetAllPendingSyncs(ctx)
+	suite.Require().Len(syncs, 2)
+	suite.Require().Equal(hcs[0].ID, syncs[0].ID)
+	suite.Require().Equal(hcs[2].ID, syncs[1].ID)
+
+	// syncs of removed host chains are dropped
+	k.RemovePendingSyncs(ctx, hcs[2].ID)
+	suite.Require().Len(k.GetAllPendingSyncs(ctx), 1)
+
+	// without a budget the queue is drained and new rates are sent right away
+	params.SyncGasBudget = 0
+	k.SetParams(ctx, params)
+	// the test host chains have no ica channel, so the syncs fail to be sent
+	suite.Require().Error(k.QueueRateSync(ctx, hcs[1], hcs[1].Features.LiquidStake, "stk/uxprt", "uxprt", sdk.OneDec()))
+	suite.Require().Equal(3, attempted())
+	k.ProcessPendingSyncs(ctx)
+	suite.Require().Equal(4, attempted())
+	suite.Require().Empty(k.GetAllPendingSyncs(ctx))
+}
@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/ratesync/types"
+)
+
+// SetSyncStatus sets the sync status of a host chain in the store
+func (k Keeper) SetSyncStatus(ctx sdk.Context, status types.SyncStatus) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SyncStatusKeyPrefix)
+	b := k.cdc.MustMarshal(&status)
+	store.Set(types.HostChainKey(status.ID), b)
+}
+
+// GetSyncStatus returns the sync status of a host chain, not found until a sync of the host chain is acknowledged
+func (k Keeper) GetSyncStatus(ctx sdk.Context, id uint64) (val types.SyncStatus, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SyncStatusKeyPrefix)
+
+	b := store.Get(types.HostChainKey(id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveSyncStatus removes the sync status of a host chain from the store
+func (k Keeper) RemoveSyncStatus(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SyncStatusKeyPrefix)
+	store.Delete(types.HostChainKey(id))
+}
+
+// RecordSyncSent counts a rate sent to a host chain contract
+func (k Keeper) RecordSyncSent(
+	ctx sdk.Context,
+	id uint64,
+	featureType types.FeatureType,
+	mintDenom string,
+	cValue sdk.Dec,
+	sequence uint64,
+) {
+	hc, found := k.GetHostChain(ctx, id)
+	if !found {
+		return
+	}
+
+	telemetry.IncrCounter(float32(1), types.ModuleName, hc.ChainID, strconv.FormatUint(hc.ID, 10), "syncs_sent")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRateSyncSent,
+			sdk.NewAttribute(types.AttributeID, strconv.FormatUint(hc.ID, 10)),
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainID),
+			sdk.NewAttribute(types.AttributeFeatureType, featureType.String()),
+			sdk.NewAttribute(types.AttributeStkDenom, mintDenom),
+			sdk.NewAttribute(types.AttributeCValue, cValue.String()),
+			sdk.NewAttribute(channeltypes.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+		),
+	)
+}
+
+// RecordSyncFailed counts a rate that could not be sent to or was rejected by a host chain contract
+func (k Keeper) RecordSyncFailed(ctx sdk.Context, id uint64, featureType types.FeatureType, reason string) {
+	hc, found := k.GetHostChain(ctx, id)
+	if !found {
+		return
+	}
+
+	telemetry.IncrCounter(float32(1), types.ModuleName, hc.ChainID, strconv.FormatUint(hc.ID, 10), "syncs_failed")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRateSyncFailed,
+			sdk.NewAttribute(types.AttributeID, strconv.FormatUint(hc.ID, 10)),
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainID),
+			sdk.NewAttribute(types.AttributeFeatureType, featureType.String()),
+			sdk.NewAttribute(types.AttributeError, reason),
+		),
+	)
+}
+
+// RecordSyncAcked counts a rate acknowledged by a host chain contract, and moves the last sync time of the host chain
+// forward to the time the rate was taken at. Acknowledgements of older rates don't move it back.
+func (k Keeper) RecordSyncAcked(ctx sdk.Context, id uint64, featureType types.FeatureType, contractMsg []byte) {
+	hc, found := k.GetHostChain(ctx, id)
+	if !found {
+		return
+	}
+
+	var rate types.ExecuteLiquidStakeRate
+	if err := json.Unmarshal(contractMsg, &rate); err != nil || rate.LiquidStakeRate.StkDenom == "" {
+		// not a rate sync
+		return
+	}
+	syncTime := time.Unix(rate.LiquidStakeRate.ControllerChainTime, 0).UTC()
+
+	telemetry.IncrCounter(float32(1), types.ModuleName, hc.ChainID, strconv.FormatUint(hc.ID, 10), "syncs_acked")
+
+	status, found := k.GetSyncStatus(ctx, hc.ID)
+	if !found || syncTime.After(status.LastSyncTime) {
+		k.SetSyncStatus(ctx, types.SyncStatus{
+			ID:             hc.ID,
+			LastSyncTime:   syncTime,
+			LastSyncHeight: ctx.BlockHeight(),
+		})
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRateSyncAcked,
+			sdk.NewAttribute(types.AttributeID, strconv.FormatUint(hc.ID, 10)),
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainID),
+			sdk.NewAttribute(types.AttributeFeatureType, featureType.String()),
+			sdk.NewAttribute(types.AttributeStkDenom, rate.LiquidStakeRate.StkDenom),
+			sdk.NewAttribute(types.AttributeCValue, rate.LiquidStakeRate.CValue.String()),
+			sdk.NewAttribute(types.AttributeSyncTime, strconv.FormatInt(rate.LiquidStakeRate.ControllerChainTime, 10)),
+		),
+	)
+}
+
+// SetSyncAgeGauge reports the seconds since the rate last acknowledged by the host chain contracts was taken
+func (k Keeper) SetSyncAgeGauge(ctx sdk.Context, hc types.HostChain) {
+	status, found := k.GetSyncStatus(ctx, hc.ID)
+	if !found {
+		return
+	}
+
+	age := ctx.BlockTime().Sub(status.LastSyncTime).Seconds()
+	telemetry.ModuleSetGauge(types.ModuleName, float32(age), hc.ChainID, strconv.FormatUint(hc.ID, 10), "last_sync_age")
+}
@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/ratesync/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/ratesync/types"
+)
+
+func (suite *IntegrationTestSuite) TestSyncStatus() {
+	k := suite.app.RatesyncKeeper
+	ctx, _ := suite.ctx.CacheContext()
+	_ = createNChain(k, ctx, 2)
+	hc, _ := k.GetHostChain(ctx, 1)
+
+	syncPacket := func(syncTime int64) channeltypes.Packet {
+		msg, memo, err := keeper.GenerateExecuteLiquidStakeRateTxMsg(syncTime, hc.Features.LiquidStake,
+			"stk/uatom", "uatom", sdk.OneDec(), hc.ID, hc.ICAAccount)
+		suite.Require().NoError(err)
+		msgData, err := icatypes.SerializeCosmosTx(suite.app.AppCodec(), []proto.Message{msg})
+		suite.Require().NoError(err)
+		databz, err := suite.app.AppCodec().MarshalJSON(&icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: msgData,
+			Memo: string(memo),
+		})
+		suite.Require().NoError(err)
+		return channeltypes.Packet{
+			SourcePort: types.MustICAPortIDFromOwner(hc.ICAAccount.Owner),
+			Data:       databz,
+		}
+	}
+	successAck := func() []byte {
+		msgResult, err := codectypes.NewAnyWithValue(&wasmtypes.MsgExecuteContractResponse{Data: []byte{}})
+		suite.Require().NoError(err)
+		resultbz, err := suite.app.AppCodec().Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{msgResult}})
+		suite.Require().NoError(err)
+		ack := channeltypes.NewResultAcknowledgement(resultbz)
+		ackbz, err := suite.app.AppCodec().MarshalJSON(&ack)
+		suite.Require().NoError(err)
+		return ackbz
+	}
+	countEvents := func(eventType string) int {
+		count := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == eventType {
+				count++
+			}
+		}
+		return count
+	}
+	relayer := authtypes.NewModuleAddress("test")
+
+	_, found := k.GetSyncStatus(ctx, hc.ID)
+	suite.Require().False(found)
+
+	// an acknowledged sync records the time its rate was taken at
+	syncTime := ctx.BlockTime().Add(-time.Hour).Unix()
+	suite.Require().NoError(k.OnAcknowledgementPacket(ctx, syncPacket(syncTime), successAck(), relayer))
+	status, found := k.GetSyncStatus(ctx, hc.ID)
+	suite.Require().True(found)
+	suite.Require().Equal(time.Unix(syncTime, 0).UTC(), status.LastSyncTime)
+	suite.Require().Equal(ctx.BlockHeight(), status.LastSyncHeight)
+	suite.Require().Equal(1, countEvents(types.EventTypeRateSyncAcked))
+	k.SetSyncAgeGauge(ctx, hc)
+
+	// the acknowledgement of an older rate is counted without moving the last sync time back
+	suite.Require().NoError(k.OnAcknowledgementPacket(ctx, syncPacket(syncTime-60), successAck(), relayer))
+	status, _ = k.GetSyncStatus(ctx, hc.ID)
+	suite.Require().Equal(time.Unix(syncTime, 0).UTC(), status.LastSyncTime)
+	suite.Require().Equal(2, countEvents(types.EventTypeRateSyncAcked))
+
+	// failed and timed out syncs leave the status alone
+	errorAck := channeltypes.NewErrorAcknowledgement(types.ErrICATxFailure)
+	errorAckbz, err := suite.app.AppCodec().MarshalJSON(&errorAck)
+	suite.Require().NoError(err)
+	suite.Require().NoError(k.OnAcknowledgementPacket(ctx, syncPacket(syncTime+60), errorAckbz, relayer))
+	suite.Require().NoError(k.OnTimeoutPacket(ctx, syncPacket(syncTime+60), relayer))
+	suite.Require().Equal(2, countEvents(types.EventTypeRateSyncFailed))
+	status, _ = k.GetSyncStatus(ctx, hc.ID)
+	suite.Require().Equal(time.Unix(syncTime, 0).UTC(), status.LastSyncTime)
+
+	k.RemoveSyncStatus(ctx, hc.ID)
+	_, found = k.GetSyncStatus(ctx, hc.ID)
+	suite.Require().False(found)
+}
@@ -12,6 +12,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateHostChain{}, "pstake/ratesync/MsgCreateHostChain", nil)
 	cdc.RegisterConcrete(&MsgUpdateHostChain{}, "pstake/ratesync/MsgUpdateHostChain", nil)
 	cdc.RegisterConcrete(&MsgDeleteHostChain{}, "pstake/ratesync/MsgDeleteHostChain", nil)
+	cdc.RegisterConcrete(&MsgSetFeatureEnabled{}, "pstake/ratesync/MsgSetFeatureEnabled", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -21,6 +22,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreateHostChain{},
 		&MsgUpdateHostChain{},
 		&MsgDeleteHostChain{},
+		&MsgSetFeatureEnabled{},
 	)
 
 	// this line is used by starport scaffolding # 3
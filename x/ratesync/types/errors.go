@@ -12,4 +12,5 @@ var (
 	ErrInvalid          = errorsmod.Register(ModuleName, 3002, "Invalid data")
 	ErrICATxFailure     = errorsmod.Register(ModuleName, 3003, "ica transaction failed")
 	ErrInvalidResponses = errorsmod.Register(ModuleName, 3004, "not enough message responses")
+	ErrInvalidGenesis   = errorsmod.Register(ModuleName, 3005, "invalid genesis state")
 )
@@ -7,10 +7,14 @@ const (
 	EventTypeCreateHostChain                 = "create_host_chain"
 	EventTypeUpdateHostChain                 = "update_host_chain"
 	EventTypeDeleteHostChain                 = "delete_host_chain"
+	EventTypeSetFeatureEnabled               = "set_feature_enabled"
 	EventTypeCValueUpdate                    = "c_value_update"
 	EventTypeUnsuccessfulInstantiateContract = "unsuccessful_instantiate_contract"
 	EventTypeUnsuccessfulExecuteContract     = "unsuccessful_execute_contract"
 	EventICAChannelCreated                   = "ica_channel_created"
+	EventTypeRateSyncSent                    = "rate_sync_sent"
+	EventTypeRateSyncAcked                   = "rate_sync_acked"
+	EventTypeRateSyncFailed                  = "rate_sync_failed"
 
 	AttributeID               = "id"
 	AttributeChainID          = "chain_id"
@@ -29,6 +33,12 @@ const (
 	AttributeICAChannelID     = "ica_channel_id"
 	AttributeICAAddress       = "ica_address"
 	AttributeSender           = "msg_sender"
+	AttributeFeatureType      = "feature_type"
+	AttributeEnabled          = "enabled"
+	AttributeStkDenom         = "stk_denom"
+	AttributeCValue           = "c_value"
+	AttributeSyncTime         = "sync_time"
+	AttributeError            = "error"
 
 	AttributeValueCategory = ModuleName
 )
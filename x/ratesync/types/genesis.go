@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 )
 
 // DefaultIndex is the default global index
@@ -20,6 +22,8 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	// Check for duplicated index in chain
 	chainIndexMap := make(map[string]struct{})
+	// Check for ICA port owners used by more than one chain
+	portOwners := make(map[string]uint64)
 
 	for _, elem := range gs.HostChains {
 		index := string(HostChainKey(elem.ID))
@@ -27,8 +31,68 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("duplicated index for chain")
 		}
 		chainIndexMap[index] = struct{}{}
+
+		if owner := elem.ICAAccount.Owner; owner != "" {
+			if id, ok := portOwners[owner]; ok {
+				return fmt.Errorf("host chains %d and %d share the ica port owner %s", id, elem.ID, owner)
+			}
+			portOwners[owner] = elem.ID
+		}
+
+		if err := elem.ValidateGenesis(); err != nil {
+			return fmt.Errorf("invalid host chain %d: %w", elem.ID, err)
+		}
 	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
 }
+
+// ValidateGenesis checks the ICA port, connection, transfer channel and contract instantiation states of an imported
+// host chain are consistent with each other. Unlike ValidateBasic, it allows the unset fields of a host chain whose ICA
+// was never registered.
+func (hc HostChain) ValidateGenesis() error {
+	if hc.ICAAccount.Owner != "" && hc.ICAAccount.Owner != DefaultPortOwner(hc.ID) {
+		return fmt.Errorf(
+			"ica port owner %s does not match the host chain id, expected %s",
+			hc.ICAAccount.Owner,
+			DefaultPortOwner(hc.ID),
+		)
+	}
+
+	if hc.ConnectionID != "" {
+		if err := host.ConnectionIdentifierValidator(hc.ConnectionID); err != nil {
+			return fmt.Errorf("invalid connection id %s: %w", hc.ConnectionID, err)
+		}
+	} else if hc.ICAAccount.Owner != "" || hc.ICAAccount.Address != "" {
+		return fmt.Errorf("ica account %s registered without a connection id", hc.ICAAccount.Owner)
+	}
+
+	if (hc.TransferChannelID == "") != (hc.TransferPortID == "") {
+		return fmt.Errorf(
+			"transfer channel id %q and transfer port id %q should be set together",
+			hc.TransferChannelID,
+			hc.TransferPortID,
+		)
+	}
+	if hc.TransferChannelID != "" {
+		if err := host.ChannelIdentifierValidator(hc.TransferChannelID); err != nil {
+			return fmt.Errorf("invalid transfer channel id %s: %w", hc.TransferChannelID, err)
+		}
+		if err := host.PortIdentifierValidator(hc.TransferPortID); err != nil {
+			return fmt.Errorf("invalid transfer port id %s: %w", hc.TransferPortID, err)
+		}
+	}
+
+	for _, feature := range []LiquidStake{hc.Features.LiquidStakeIBC, hc.Features.LiquidStake} {
+		if err := feature.ValdidateBasic(); err != nil {
+			return fmt.Errorf("invalid %s feature: %w", feature.FeatureType, err)
+		}
+		// contracts are instantiated by the ica, so their instantiation can't start before it is registered
+		if feature.Instantiation != InstantiationState_INSTANTIATION_NOT_INITIATED && hc.ICAAccount.Address == "" {
+			return fmt.Errorf("%s feature instantiation %s without an ica account", feature.FeatureType, feature.Instantiation)
+		}
+	}
+
+	return nil
+}
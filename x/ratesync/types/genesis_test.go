@@ -3,11 +3,42 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
 
+	liquidstakeibctypes "github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
 	"github.com/persistenceOne/pstake-native/v2/x/ratesync/types"
 )
 
+// genesisHostChain returns a host chain with a registered ICA and an instantiated liquid stake contract
+func genesisHostChain(id uint64, malform func(hc *types.HostChain)) types.HostChain {
+	hc := types.HostChain{
+		ID:           id,
+		ChainID:      "test-1",
+		ConnectionID: "connection-0",
+		ICAAccount: liquidstakeibctypes.ICAAccount{
+			Address:      authtypes.NewModuleAddress("ica").String(),
+			Balance:      sdk.Coin{Amount: sdk.ZeroInt()},
+			Owner:        types.DefaultPortOwner(id),
+			ChannelState: liquidstakeibctypes.ICAAccount_ICA_CHANNEL_CREATED,
+		},
+		Features: types.Feature{
+			LiquidStakeIBC: types.LiquidStake{FeatureType: types.FeatureType_LIQUID_STAKE_IBC},
+			LiquidStake: types.LiquidStake{
+				FeatureType:     types.FeatureType_LIQUID_STAKE,
+				CodeID:          1,
+				Instantiation:   types.InstantiationState_INSTANTIATION_COMPLETED,
+				ContractAddress: authtypes.NewModuleAddress("contract").String(),
+			},
+		},
+		TransferChannelID: "channel-0",
+		TransferPortID:    "transfer",
+	}
+	malform(&hc)
+	return hc
+}
+
 func TestGenesisState_Validate(t *testing.T) {
 	tests := []struct {
 		desc     string
@@ -19,6 +50,17 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: types.DefaultGenesis(),
 			valid:    true,
 		},
+		{
+			desc: "host chains with registered icas",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				HostChains: []types.HostChain{
+					genesisHostChain(1, func(hc *types.HostChain) {}),
+					genesisHostChain(2, func(hc *types.HostChain) {}),
+				},
+			},
+			valid: true,
+		},
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
@@ -50,6 +92,70 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "shared ica port owner",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				HostChains: []types.HostChain{
+					genesisHostChain(1, func(hc *types.HostChain) {}),
+					genesisHostChain(2, func(hc *types.HostChain) { hc.ICAAccount.Owner = types.DefaultPortOwner(1) }),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "ica port owner of another id",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				HostChains: []types.HostChain{
+					genesisHostChain(2, func(hc *types.HostChain) { hc.ICAAccount.Owner = types.DefaultPortOwner(3) }),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "ica without connection",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				HostChains: []types.HostChain{
+					genesisHostChain(1, func(hc *types.HostChain) { hc.ConnectionID = "" }),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "transfer channel without port",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				HostChains: []types.HostChain{
+					genesisHostChain(1, func(hc *types.HostChain) { hc.TransferPortID = "" }),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "instantiation without ica account",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				HostChains: []types.HostChain{
+					genesisHostChain(1, func(hc *types.HostChain) {
+						hc.ICAAccount.Address = ""
+						hc.ICAAccount.ChannelState = liquidstakeibctypes.ICAAccount_ICA_CHANNEL_CREATING
+					}),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "completed instantiation without contract",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				HostChains: []types.HostChain{
+					genesisHostChain(1, func(hc *types.HostChain) { hc.Features.LiquidStake.ContractAddress = "" }),
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
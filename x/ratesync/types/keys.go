@@ -28,6 +28,9 @@ const (
 var (
 	HostChainIDKeyPrefix = []byte{0x01}
 	HostChainKeyPrefix   = []byte{0x02}
+	SyncStatusKeyPrefix  = []byte{0x03}
+	PendingSyncKeyPrefix = []byte{0x04}
+	SyncCursorKey        = []byte{0x05}
 	ParamsKeyPrefix      = []byte{0x00}
 )
 
@@ -39,3 +42,10 @@ func HostChainKey(
 	binary.BigEndian.PutUint64(bz, id)
 	return bz
 }
+
+// PendingSyncKey returns the store key of a rate sync queued for a host chain feature and minted denom, ordered by
+// host chain
+func PendingSyncKey(id uint64, featureType FeatureType, mintDenom string) []byte {
+	key := append(HostChainKey(id), byte(featureType))
+	return append(key, []byte(mintDenom)...)
+}
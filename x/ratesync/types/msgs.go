@@ -10,9 +10,10 @@ import (
 
 const TypeMsgUpdateParams = "msg_update_params"
 const (
-	TypeMsgCreateHostChain = "create_host_chain"
-	TypeMsgUpdateHostChain = "update_host_chain"
-	TypeMsgDeleteHostChain = "delete_host_chain"
+	TypeMsgCreateHostChain   = "create_host_chain"
+	TypeMsgUpdateHostChain   = "update_host_chain"
+	TypeMsgDeleteHostChain   = "delete_host_chain"
+	TypeMsgSetFeatureEnabled = "set_feature_enabled"
 )
 
 var _ sdk.Msg = &MsgUpdateParams{}
@@ -203,3 +204,55 @@ func (msg *MsgDeleteHostChain) ValidateBasic() error {
 
 	return nil
 }
+
+var _ sdk.Msg = &MsgSetFeatureEnabled{}
+
+func NewMsgSetFeatureEnabled(
+	authority string,
+	id uint64,
+	featureType FeatureType,
+	enabled bool,
+) *MsgSetFeatureEnabled {
+	return &MsgSetFeatureEnabled{
+		Authority:   authority,
+		ID:          id,
+		FeatureType: featureType,
+		Enabled:     enabled,
+	}
+}
+
+func (msg *MsgSetFeatureEnabled) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetFeatureEnabled) Type() string {
+	return TypeMsgSetFeatureEnabled
+}
+
+func (msg *MsgSetFeatureEnabled) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetFeatureEnabled) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetFeatureEnabled) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if msg.ID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "hostchain ID for set feature enabled msg should not be 0")
+	}
+	if _, ok := FeatureType_name[int32(msg.FeatureType)]; !ok {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid feature type %v", msg.FeatureType)
+	}
+
+	return nil
+}
@@ -191,3 +191,55 @@ func TestMsgDeleteHostChain_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetFeatureEnabled_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetFeatureEnabled
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetFeatureEnabled{
+				Authority: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero id",
+			msg: MsgSetFeatureEnabled{
+				Authority: authtypes.NewModuleAddress("addr1").String(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid feature type",
+			msg: MsgSetFeatureEnabled{
+				Authority:   authtypes.NewModuleAddress("addr1").String(),
+				ID:          1,
+				FeatureType: 5,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgSetFeatureEnabled{
+				Authority:   authtypes.NewModuleAddress("addr1").String(),
+				ID:          1,
+				FeatureType: FeatureType_LIQUID_STAKE,
+				Enabled:     true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.msg.Type(), TypeMsgSetFeatureEnabled)
+			require.Equal(t, tt.msg.Route(), RouterKey)
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.msg.GetSigners()[0], sdk.MustAccAddressFromBech32(tt.msg.Authority))
+			require.NotNil(t, tt.msg.GetSignBytes())
+		})
+	}
+}
@@ -27,6 +27,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the module.
 type Params struct {
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// gas a block can spend sending queued rate syncs before the rest are left
+	// to the next blocks, zero sends every rate sync right away
+	SyncGasBudget uint64 `protobuf:"varint,2,opt,name=sync_gas_budget,json=syncGasBudget,proto3" json:"sync_gas_budget,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -68,6 +71,13 @@ func (m *Params) GetAdmin() string {
 	return ""
 }
 
+func (m *Params) GetSyncGasBudget() uint64 {
+	if m != nil {
+		return m.SyncGasBudget
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "pstake.ratesync.v1beta1.Params")
 }
@@ -77,22 +87,24 @@ func init() {
 }
 
 var fileDescriptor_874e04d586361014 = []byte{
-	// 234 bytes of a gzipped FileDescriptorProto
+	// 264 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0x28, 0x2e, 0x49,
 	0xcc, 0x4e, 0xd5, 0x2f, 0x4a, 0x2c, 0x49, 0x2d, 0xae, 0xcc, 0x4b, 0xd6, 0x2f, 0x33, 0x4c, 0x4a,
 	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
 	0x17, 0x12, 0x87, 0xa8, 0xd2, 0x83, 0xa9, 0xd2, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
 	0x07, 0xab, 0xd1, 0x07, 0xb1, 0x20, 0xca, 0xa5, 0x24, 0x93, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xe3,
-	0x21, 0x12, 0x10, 0x0e, 0x44, 0x4a, 0xc9, 0x8e, 0x8b, 0x2d, 0x00, 0x6c, 0xb2, 0x90, 0x1e, 0x17,
+	0x21, 0x12, 0x10, 0x0e, 0x44, 0x4a, 0x29, 0x8d, 0x8b, 0x2d, 0x00, 0x6c, 0xb2, 0x90, 0x1e, 0x17,
 	0x6b, 0x62, 0x4a, 0x6e, 0x66, 0x9e, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xc4, 0xa5, 0x2d,
 	0xba, 0x22, 0x50, 0xa5, 0x8e, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0xc1, 0x25, 0x45, 0x99, 0x79,
-	0xe9, 0x41, 0x10, 0x65, 0x56, 0x2c, 0x33, 0x16, 0xc8, 0x33, 0x38, 0x85, 0x9e, 0x78, 0x24, 0xc7,
-	0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c,
-	0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x75, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72,
-	0x7e, 0xae, 0x7e, 0x41, 0x6a, 0x51, 0x71, 0x66, 0x71, 0x49, 0x6a, 0x5e, 0x72, 0xaa, 0x7f, 0x5e,
-	0xaa, 0x3e, 0xc4, 0xf5, 0xba, 0x79, 0x89, 0x25, 0x99, 0x65, 0xa9, 0xfa, 0x65, 0x46, 0xfa, 0x15,
-	0x08, 0xff, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x5d, 0x67, 0x0c, 0x08, 0x00, 0x00,
-	0xff, 0xff, 0x60, 0xd5, 0xf3, 0x96, 0x0f, 0x01, 0x00, 0x00,
+	0xe9, 0x41, 0x10, 0x65, 0x42, 0x6a, 0x5c, 0xfc, 0x20, 0xab, 0xe3, 0xd3, 0x13, 0x8b, 0xe3, 0x93,
+	0x4a, 0x53, 0xd2, 0x53, 0x4b, 0x24, 0x98, 0x14, 0x18, 0x35, 0x58, 0x82, 0x78, 0x41, 0xc2, 0xee,
+	0x89, 0xc5, 0x4e, 0x60, 0x41, 0x2b, 0x96, 0x19, 0x0b, 0xe4, 0x19, 0x9c, 0x42, 0x4f, 0x3c, 0x92,
+	0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c,
+	0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x3a, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f,
+	0x39, 0x3f, 0x57, 0xbf, 0x20, 0xb5, 0xa8, 0x38, 0xb3, 0xb8, 0x24, 0x35, 0x2f, 0x39, 0xd5, 0x3f,
+	0x2f, 0x55, 0x1f, 0xe2, 0x4b, 0xdd, 0xbc, 0xc4, 0x92, 0xcc, 0xb2, 0x54, 0xfd, 0x32, 0x23, 0xfd,
+	0x0a, 0x44, 0xb8, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x7d, 0x61, 0x0c, 0x18, 0x00,
+	0x51, 0x3b, 0xf8, 0x9f, 0x37, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -115,6 +127,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SyncGasBudget != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SyncGasBudget))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -146,6 +163,9 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.SyncGasBudget != 0 {
+		n += 1 + sovParams(uint64(m.SyncGasBudget))
+	}
 	return n
 }
 
@@ -216,6 +236,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncGasBudget", wireType)
+			}
+			m.SyncGasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SyncGasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
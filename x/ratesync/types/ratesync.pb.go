@@ -5,19 +5,25 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types "github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -239,7 +245,8 @@ type LiquidStake struct {
 	// address of instantiated contract.
 	ContractAddress string `protobuf:"bytes,4,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	// allow * as default for all denoms in case of lsibc, or default bond denom
-	// in case of ls.
+	// in case of ls. denoms ending in * allow every denom with their prefix, like
+	// stk/* or stk/ibc/*.
 	Denoms  []string `protobuf:"bytes,5,rep,name=denoms,proto3" json:"denoms,omitempty"`
 	Enabled bool     `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
@@ -372,6 +379,154 @@ func (m *ICAMemo) GetHostChainID() uint64 {
 	return 0
 }
 
+// SyncStatus tracks the last rate a host chain contract acknowledged.
+type SyncStatus struct {
+	// id of the host chain
+	ID uint64 `protobuf:"varint,1,opt,name=i_d,json=iD,proto3" json:"i_d,omitempty"`
+	// controller chain time the last acknowledged rate was taken at
+	LastSyncTime time.Time `protobuf:"bytes,2,opt,name=last_sync_time,json=lastSyncTime,proto3,stdtime" json:"last_sync_time"`
+	// controller chain height the last successful sync was acknowledged at
+	LastSyncHeight int64 `protobuf:"varint,3,opt,name=last_sync_height,json=lastSyncHeight,proto3" json:"last_sync_height,omitempty"`
+}
+
+func (m *SyncStatus) Reset()         { *m = SyncStatus{} }
+func (m *SyncStatus) String() string { return proto.CompactTextString(m) }
+func (*SyncStatus) ProtoMessage()    {}
+func (*SyncStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_429540018f2469ab, []int{4}
+}
+func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncStatus.Merge(m, src)
+}
+func (m *SyncStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
+
+func (m *SyncStatus) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *SyncStatus) GetLastSyncTime() time.Time {
+	if m != nil {
+		return m.LastSyncTime
+	}
+	return time.Time{}
+}
+
+func (m *SyncStatus) GetLastSyncHeight() int64 {
+	if m != nil {
+		return m.LastSyncHeight
+	}
+	return 0
+}
+
+// PendingSync is a rate sync waiting for the sync gas budget of a block.
+type PendingSync struct {
+	// id of the host chain
+	ID uint64 `protobuf:"varint,1,opt,name=i_d,json=iD,proto3" json:"i_d,omitempty"`
+	// feature of the host chain the rate is synced to
+	FeatureType FeatureType `protobuf:"varint,2,opt,name=feature_type,json=featureType,proto3,enum=pstake.ratesync.v1beta1.FeatureType" json:"feature_type,omitempty"`
+	// denom minted for the rate
+	MintDenom string `protobuf:"bytes,3,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
+	// host denom of the minted denom
+	HostDenom string `protobuf:"bytes,4,opt,name=host_denom,json=hostDenom,proto3" json:"host_denom,omitempty"`
+	// rate to sync
+	CValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=c_value,json=cValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"c_value"`
+	// controller chain height the rate was queued at
+	QueuedHeight int64 `protobuf:"varint,6,opt,name=queued_height,json=queuedHeight,proto3" json:"queued_height,omitempty"`
+}
+
+func (m *PendingSync) Reset()         { *m = PendingSync{} }
+func (m *PendingSync) String() string { return proto.CompactTextString(m) }
+func (*PendingSync) ProtoMessage()    {}
+func (*PendingSync) Descriptor() ([]byte, []int) {
+	return fileDescriptor_429540018f2469ab, []int{5}
+}
+func (m *PendingSync) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSync) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSync.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingSync) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSync.Merge(m, src)
+}
+func (m *PendingSync) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSync) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSync.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSync proto.InternalMessageInfo
+
+func (m *PendingSync) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *PendingSync) GetFeatureType() FeatureType {
+	if m != nil {
+		return m.FeatureType
+	}
+	return FeatureType_LIQUID_STAKE_IBC
+}
+
+func (m *PendingSync) GetMintDenom() string {
+	if m != nil {
+		return m.MintDenom
+	}
+	return ""
+}
+
+func (m *PendingSync) GetHostDenom() string {
+	if m != nil {
+		return m.HostDenom
+	}
+	return ""
+}
+
+func (m *PendingSync) GetQueuedHeight() int64 {
+	if m != nil {
+		return m.QueuedHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("pstake.ratesync.v1beta1.InstantiationState", InstantiationState_name, InstantiationState_value)
 	proto.RegisterEnum("pstake.ratesync.v1beta1.FeatureType", FeatureType_name, FeatureType_value)
@@ -379,6 +534,8 @@ func init() {
 	proto.RegisterType((*Feature)(nil), "pstake.ratesync.v1beta1.Feature")
 	proto.RegisterType((*LiquidStake)(nil), "pstake.ratesync.v1beta1.LiquidStake")
 	proto.RegisterType((*ICAMemo)(nil), "pstake.ratesync.v1beta1.ICAMemo")
+	proto.RegisterType((*SyncStatus)(nil), "pstake.ratesync.v1beta1.SyncStatus")
+	proto.RegisterType((*PendingSync)(nil), "pstake.ratesync.v1beta1.PendingSync")
 }
 
 func init() {
@@ -386,51 +543,64 @@ func init() {
 }
 
 var fileDescriptor_429540018f2469ab = []byte{
-	// 692 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x41, 0x6f, 0x12, 0x41,
-	0x14, 0x66, 0x29, 0x42, 0x19, 0x28, 0xa5, 0x93, 0xc6, 0x92, 0x36, 0xa1, 0x04, 0x1b, 0x43, 0x6b,
-	0x64, 0x53, 0x8c, 0x27, 0x4f, 0xb0, 0x5b, 0xeb, 0xc4, 0x16, 0xda, 0x85, 0xc6, 0x44, 0x0f, 0x93,
-	0x61, 0x98, 0x96, 0x89, 0x30, 0x83, 0xbb, 0x43, 0xb5, 0xff, 0xc2, 0x9f, 0xe0, 0xd1, 0xa3, 0x67,
-	0x7f, 0x41, 0x8f, 0x3d, 0x7a, 0x30, 0xc6, 0xb4, 0x07, 0xff, 0x86, 0xd9, 0xd9, 0x05, 0x16, 0x9b,
-	0x7a, 0x30, 0x5e, 0xc8, 0xbe, 0xef, 0xfb, 0xde, 0x37, 0xef, 0x3d, 0xde, 0x0c, 0x78, 0x38, 0xf2,
-	0x14, 0x79, 0xcb, 0x4c, 0x97, 0x28, 0xe6, 0x5d, 0x08, 0x6a, 0x9e, 0xef, 0x76, 0x99, 0x22, 0xbb,
-	0x53, 0xa0, 0x3a, 0x72, 0xa5, 0x92, 0x70, 0x2d, 0xd0, 0x55, 0xa7, 0x70, 0xa8, 0x5b, 0x5f, 0x3d,
-	0x93, 0x67, 0x52, 0x6b, 0x4c, 0xff, 0x2b, 0x90, 0xaf, 0xd7, 0x42, 0xdb, 0x01, 0x7f, 0x37, 0xe6,
-	0x3d, 0xfd, 0xcd, 0xbb, 0x33, 0xf3, 0x79, 0x38, 0xcc, 0x59, 0x21, 0x43, 0x2e, 0xa4, 0xa9, 0x7f,
-	0x03, 0xa8, 0xfc, 0x3d, 0x0e, 0xd2, 0x2f, 0xa4, 0xa7, 0xac, 0x3e, 0xe1, 0x02, 0x2e, 0x83, 0x05,
-	0x8e, 0x7b, 0x05, 0xa3, 0x64, 0x54, 0x12, 0x4e, 0x9c, 0xdb, 0x70, 0x1d, 0xa4, 0xa9, 0xcf, 0x60,
-	0x1f, 0x8e, 0x97, 0x8c, 0x4a, 0xda, 0x49, 0x69, 0x00, 0xd9, 0x70, 0x0b, 0xe4, 0xa8, 0x14, 0x82,
-	0x51, 0xc5, 0x65, 0x20, 0x58, 0xd0, 0x82, 0xec, 0x0c, 0x45, 0x36, 0x7c, 0x05, 0x96, 0x38, 0xa6,
-	0x98, 0x60, 0x42, 0xa9, 0x1c, 0x0b, 0x55, 0x48, 0x94, 0x8c, 0x4a, 0xa6, 0xb6, 0x5d, 0x0d, 0xdb,
-	0xfd, 0xa3, 0xd0, 0xb0, 0xfe, 0x2a, 0xb2, 0xea, 0xf5, 0x20, 0xa1, 0x91, 0xbe, 0xfc, 0xb1, 0x19,
-	0xfb, 0xfc, 0xeb, 0xcb, 0x8e, 0xe1, 0x00, 0x3e, 0x85, 0xe1, 0x3e, 0x58, 0x3c, 0x65, 0x44, 0x8d,
-	0x5d, 0xe6, 0x15, 0xee, 0x69, 0xcf, 0x52, 0xf5, 0x8e, 0x11, 0x56, 0x9f, 0x07, 0xc2, 0xa8, 0xd5,
-	0x34, 0x19, 0x9a, 0x60, 0x55, 0xb9, 0x44, 0x78, 0xa7, 0xcc, 0xc5, 0xb4, 0x4f, 0x84, 0x60, 0x03,
-	0xdd, 0x4d, 0x52, 0x77, 0xb3, 0x32, 0xe1, 0xac, 0x80, 0x42, 0x36, 0xdc, 0x06, 0x53, 0x10, 0x8f,
-	0xa4, 0xab, 0xb4, 0x3a, 0xa5, 0xd5, 0xb9, 0x09, 0x71, 0x24, 0x5d, 0x85, 0xec, 0xf2, 0x57, 0x03,
-	0xa4, 0xc2, 0xc3, 0xe1, 0x1b, 0x00, 0x83, 0x66, 0xb1, 0xae, 0x12, 0x73, 0xdc, 0xc5, 0x54, 0xcf,
-	0x3a, 0x53, 0xdb, 0xba, 0xb3, 0xf4, 0x03, 0x9d, 0xd2, 0xf6, 0xc9, 0x68, 0xf9, 0xb9, 0xc1, 0x0c,
-	0x47, 0x0d, 0x0b, 0x3a, 0x20, 0x1b, 0x35, 0xd7, 0xff, 0xd5, 0x3f, 0xd8, 0x66, 0x22, 0xb6, 0xe5,
-	0x4f, 0x71, 0x90, 0x89, 0xe8, 0xe0, 0x3e, 0xc8, 0x86, 0x43, 0xc3, 0xea, 0x62, 0xc4, 0x74, 0xe9,
-	0xb9, 0xbf, 0x9c, 0x11, 0x36, 0xde, 0xb9, 0x18, 0x31, 0x27, 0x73, 0x3a, 0x0b, 0x60, 0x01, 0x2c,
-	0x52, 0xd9, 0x63, 0xd3, 0xa5, 0x4a, 0x38, 0x49, 0x3f, 0x46, 0x36, 0x3c, 0x06, 0x4b, 0x5c, 0x78,
-	0x8a, 0x08, 0xc5, 0x89, 0xbf, 0x40, 0x7a, 0xa5, 0x72, 0xb5, 0x47, 0x77, 0x9e, 0x81, 0xa2, 0xea,
-	0xb6, 0x22, 0x8a, 0x39, 0xf3, 0x0e, 0x70, 0x1b, 0xe4, 0xa9, 0x14, 0xca, 0x25, 0x54, 0x61, 0xd2,
-	0xeb, 0xb9, 0xcc, 0xf3, 0xf4, 0x0e, 0xa6, 0x9d, 0xe5, 0x09, 0x5e, 0x0f, 0x60, 0x78, 0x1f, 0x24,
-	0x7b, 0x4c, 0xc8, 0xa1, 0xbf, 0x50, 0x0b, 0x95, 0xb4, 0x13, 0x46, 0xb0, 0x00, 0x52, 0x4c, 0x90,
-	0xee, 0x80, 0x05, 0x4b, 0xb1, 0xe8, 0x4c, 0xc2, 0xf2, 0x7b, 0x90, 0x42, 0x56, 0xfd, 0x90, 0x0d,
-	0xe5, 0xff, 0x9b, 0xce, 0x03, 0x90, 0xeb, 0x4b, 0x4f, 0xe1, 0xf9, 0x8b, 0x97, 0x70, 0x32, 0xfd,
-	0xc9, 0x3d, 0x45, 0xf6, 0x8e, 0x04, 0xf0, 0x76, 0xeb, 0x70, 0x13, 0x6c, 0xa0, 0x66, 0xbb, 0x53,
-	0x6f, 0x76, 0x50, 0xbd, 0x83, 0x5a, 0x4d, 0xdc, 0x6c, 0x75, 0x30, 0x6a, 0x22, 0x3f, 0xdc, 0xb3,
-	0xf3, 0x31, 0xb8, 0x01, 0xd6, 0xe6, 0x05, 0x33, 0xd2, 0xb8, 0x4d, 0x5a, 0xad, 0xc3, 0xa3, 0x83,
-	0x3d, 0x9f, 0x8c, 0xef, 0x3c, 0x05, 0x99, 0x48, 0xc5, 0x70, 0x15, 0xe4, 0x0f, 0xd0, 0xf1, 0x09,
-	0xb2, 0x71, 0xbb, 0x53, 0x7f, 0xb9, 0x87, 0x51, 0xc3, 0xca, 0xc7, 0x60, 0x1e, 0x64, 0xa3, 0x68,
-	0xde, 0x68, 0x9c, 0x5c, 0x5e, 0x17, 0x8d, 0xab, 0xeb, 0xa2, 0xf1, 0xf3, 0xba, 0x68, 0x7c, 0xbc,
-	0x29, 0xc6, 0xae, 0x6e, 0x8a, 0xb1, 0x6f, 0x37, 0xc5, 0xd8, 0xeb, 0x67, 0x67, 0x5c, 0xf5, 0xc7,
-	0xdd, 0x2a, 0x95, 0x43, 0x73, 0xc4, 0x5c, 0x8f, 0x7b, 0x8a, 0x09, 0xca, 0x5a, 0x82, 0x99, 0xc1,
-	0xc8, 0x1e, 0x0b, 0xa2, 0xf8, 0x39, 0x33, 0xcf, 0x6b, 0xe6, 0x87, 0xd9, 0xeb, 0xe9, 0xcf, 0xd6,
-	0xeb, 0x26, 0xf5, 0xeb, 0xf5, 0xe4, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x05, 0x21, 0xe8, 0x15,
-	0x5d, 0x05, 0x00, 0x00,
+	// 912 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x3a, 0xc1, 0x8e, 0x9f, 0x1d, 0xd7, 0x1d, 0x45, 0xd4, 0xa4, 0xc2, 0x8e, 0xdc, 0xaa,
+	0x72, 0x82, 0xb2, 0xab, 0x1a, 0x71, 0x82, 0x8b, 0xed, 0x0d, 0xed, 0x42, 0xea, 0xa4, 0x6b, 0x07,
+	0x24, 0x38, 0x8c, 0xc6, 0xe3, 0x89, 0x3d, 0xaa, 0x3d, 0xe3, 0xee, 0x8e, 0x03, 0xf9, 0x16, 0x95,
+	0xf8, 0x02, 0x70, 0xe3, 0xc8, 0x81, 0x13, 0x9f, 0xa0, 0xc7, 0x8a, 0x13, 0x42, 0xa8, 0xa0, 0xe4,
+	0xc0, 0xd7, 0x40, 0x33, 0xb3, 0xfe, 0x93, 0x46, 0xe1, 0x50, 0xf5, 0x92, 0xec, 0xfb, 0xfd, 0x7e,
+	0xf3, 0xf6, 0xbd, 0xdf, 0xbc, 0x7d, 0x86, 0x07, 0xd3, 0x58, 0x91, 0x67, 0xcc, 0x8b, 0x88, 0x62,
+	0xf1, 0xb9, 0xa0, 0xde, 0xd9, 0xc3, 0x3e, 0x53, 0xe4, 0xe1, 0x02, 0x70, 0xa7, 0x91, 0x54, 0x12,
+	0xdd, 0xb1, 0x3a, 0x77, 0x01, 0x27, 0xba, 0xed, 0xad, 0xa1, 0x1c, 0x4a, 0xa3, 0xf1, 0xf4, 0x93,
+	0x95, 0x6f, 0x37, 0x92, 0xb4, 0x63, 0xfe, 0x7c, 0xc6, 0x07, 0xe6, 0x99, 0xf7, 0x97, 0xc9, 0xaf,
+	0xc2, 0xc9, 0x99, 0xdb, 0x64, 0xc2, 0x85, 0xf4, 0xcc, 0xdf, 0x04, 0xaa, 0x0e, 0xa5, 0x1c, 0x8e,
+	0x99, 0x67, 0xa2, 0xfe, 0xec, 0xd4, 0x53, 0x7c, 0xc2, 0x62, 0x45, 0x26, 0xd3, 0x44, 0xf0, 0x01,
+	0x95, 0xf1, 0x44, 0xc6, 0xd8, 0x16, 0x60, 0x03, 0x4b, 0xd5, 0xfe, 0x4a, 0x43, 0xee, 0xb1, 0x8c,
+	0x55, 0x7b, 0x44, 0xb8, 0x40, 0xb7, 0x60, 0x8d, 0xe3, 0x41, 0xd9, 0xd9, 0x71, 0xea, 0xeb, 0x61,
+	0x9a, 0xfb, 0x68, 0x1b, 0x72, 0x54, 0x33, 0x58, 0xc3, 0xe9, 0x1d, 0xa7, 0x9e, 0x0b, 0xb3, 0x06,
+	0x08, 0x7c, 0x74, 0x1f, 0x8a, 0x54, 0x0a, 0xc1, 0xa8, 0xe2, 0xd2, 0x0a, 0xd6, 0x8c, 0xa0, 0xb0,
+	0x44, 0x03, 0x1f, 0x7d, 0x0d, 0x9b, 0x1c, 0x53, 0x4c, 0x30, 0xa1, 0x54, 0xce, 0x84, 0x2a, 0xaf,
+	0xef, 0x38, 0xf5, 0x7c, 0x63, 0xd7, 0x4d, 0xac, 0x7a, 0xa3, 0xc9, 0xa4, 0x77, 0x37, 0x68, 0x37,
+	0x9b, 0xf6, 0x40, 0x2b, 0xf7, 0xf2, 0x75, 0x35, 0xf5, 0xf3, 0xbf, 0xbf, 0xec, 0x39, 0x21, 0xf0,
+	0x05, 0x8c, 0x1e, 0xc1, 0xc6, 0x29, 0x23, 0x6a, 0x16, 0xb1, 0xb8, 0xfc, 0x9e, 0xc9, 0xb9, 0xe3,
+	0xde, 0x60, 0xbf, 0xfb, 0xb9, 0x15, 0xae, 0xa6, 0x5a, 0x1c, 0x46, 0x1e, 0x6c, 0xa9, 0x88, 0x88,
+	0xf8, 0x94, 0x45, 0x98, 0x8e, 0x88, 0x10, 0x6c, 0x6c, 0xba, 0xc9, 0x98, 0x6e, 0x6e, 0xcf, 0xb9,
+	0xb6, 0xa5, 0x02, 0x1f, 0xed, 0xc2, 0x02, 0xc4, 0x53, 0x19, 0x29, 0xa3, 0xce, 0x1a, 0x75, 0x71,
+	0x4e, 0x1c, 0xcb, 0x48, 0x05, 0x7e, 0xed, 0x37, 0x07, 0xb2, 0xc9, 0xcb, 0xd1, 0xb7, 0x80, 0x6c,
+	0xb3, 0xd8, 0x54, 0x89, 0x39, 0xee, 0x63, 0x6a, 0xbc, 0xce, 0x37, 0xee, 0xdf, 0x58, 0xfa, 0xa1,
+	0x39, 0xd2, 0xd5, 0xe4, 0x6a, 0xf9, 0xc5, 0xf1, 0x12, 0x0f, 0x5a, 0x6d, 0x14, 0x42, 0x61, 0x35,
+	0x79, 0x39, 0xfd, 0x76, 0x69, 0xf3, 0x2b, 0x69, 0x6b, 0x3f, 0xa6, 0x21, 0xbf, 0xa2, 0x43, 0x8f,
+	0xa0, 0x90, 0x98, 0x86, 0xd5, 0xf9, 0x94, 0x99, 0xd2, 0x8b, 0xff, 0xf3, 0x8e, 0xa4, 0xf1, 0xde,
+	0xf9, 0x94, 0x85, 0xf9, 0xd3, 0x65, 0x80, 0xca, 0xb0, 0x41, 0xe5, 0x80, 0x2d, 0x86, 0x6a, 0x3d,
+	0xcc, 0xe8, 0x38, 0xf0, 0xd1, 0x53, 0xd8, 0xe4, 0x22, 0x56, 0x44, 0x28, 0x4e, 0xf4, 0x00, 0x99,
+	0x91, 0x2a, 0x36, 0x3e, 0xba, 0xf1, 0x1d, 0xc1, 0xaa, 0xba, 0xab, 0x88, 0x62, 0xe1, 0xd5, 0x0c,
+	0x68, 0x17, 0x4a, 0x54, 0x0a, 0x15, 0x11, 0xaa, 0x30, 0x19, 0x0c, 0x22, 0x16, 0xc7, 0x66, 0x06,
+	0x73, 0xe1, 0xad, 0x39, 0xde, 0xb4, 0x30, 0x7a, 0x1f, 0x32, 0x03, 0x26, 0xe4, 0x44, 0x0f, 0xd4,
+	0x5a, 0x3d, 0x17, 0x26, 0x11, 0x2a, 0x43, 0x96, 0x09, 0xd2, 0x1f, 0x33, 0x3b, 0x14, 0x1b, 0xe1,
+	0x3c, 0xac, 0x7d, 0x07, 0xd9, 0xa0, 0xdd, 0x7c, 0xc2, 0x26, 0xf2, 0xdd, 0xb9, 0x73, 0x0f, 0x8a,
+	0x23, 0x19, 0x2b, 0x7c, 0xf5, 0xc3, 0x5b, 0x0f, 0xf3, 0xa3, 0xf9, 0x77, 0x1a, 0xf8, 0xb5, 0x1f,
+	0x1c, 0x80, 0xee, 0xb9, 0xa0, 0xba, 0xe5, 0x59, 0x7c, 0xfd, 0xc3, 0xfd, 0x02, 0x8a, 0x63, 0x12,
+	0x2b, 0xac, 0x5f, 0x89, 0xf5, 0x3e, 0x48, 0x26, 0x62, 0xdb, 0xb5, 0xcb, 0xc2, 0x9d, 0x2f, 0x0b,
+	0xb7, 0x37, 0x5f, 0x16, 0xad, 0x0d, 0x3d, 0x07, 0x2f, 0xfe, 0xae, 0x3a, 0x61, 0x41, 0x9f, 0xd5,
+	0xe9, 0x35, 0x89, 0xea, 0x50, 0x5a, 0xe6, 0x1a, 0x31, 0x3e, 0x1c, 0x29, 0x73, 0x2f, 0x6b, 0x61,
+	0x71, 0xae, 0x7b, 0x6c, 0xd0, 0xda, 0x4f, 0x69, 0xc8, 0x1f, 0x33, 0x31, 0xe0, 0x62, 0xa8, 0xd1,
+	0xeb, 0x65, 0xbd, 0x69, 0x52, 0xfa, 0x6d, 0x4d, 0xfa, 0x10, 0x60, 0xc2, 0x85, 0xc2, 0xe6, 0x86,
+	0x92, 0xc5, 0x93, 0xd3, 0x88, 0xaf, 0x01, 0x4d, 0x1b, 0x0f, 0x2d, 0x6d, 0xaf, 0x3b, 0xa7, 0x11,
+	0x4b, 0x9f, 0x40, 0x96, 0xe2, 0x33, 0x32, 0x9e, 0x31, 0xb3, 0x3a, 0x72, 0xad, 0xcf, 0x74, 0xeb,
+	0x7f, 0xbe, 0xae, 0x3e, 0x18, 0x72, 0x35, 0x9a, 0xf5, 0x5d, 0x2a, 0x27, 0xc9, 0x9e, 0x4c, 0xfe,
+	0xed, 0xc7, 0x83, 0x67, 0x9e, 0x2e, 0x39, 0x76, 0x7d, 0x46, 0x7f, 0xff, 0x75, 0x1f, 0x2c, 0xae,
+	0xa3, 0x30, 0x43, 0xbf, 0xd2, 0xb9, 0xd0, 0x3d, 0xd8, 0x7c, 0x3e, 0x63, 0x33, 0x36, 0x98, 0xbb,
+	0x94, 0x31, 0x2e, 0x15, 0x2c, 0x68, 0x3d, 0xda, 0x93, 0x80, 0xae, 0x0f, 0x2d, 0xaa, 0xc2, 0xdd,
+	0xa0, 0xd3, 0xed, 0x35, 0x3b, 0xbd, 0xa0, 0xd9, 0x0b, 0x8e, 0x3a, 0xb8, 0x73, 0xd4, 0xc3, 0x41,
+	0x27, 0xd0, 0xe1, 0x81, 0x5f, 0x4a, 0xa1, 0xbb, 0x70, 0xe7, 0xaa, 0x60, 0x49, 0x3a, 0xd7, 0xc9,
+	0xf6, 0xd1, 0x93, 0xe3, 0xc3, 0x03, 0x4d, 0xa6, 0xf7, 0x3e, 0x81, 0xfc, 0x8a, 0x8d, 0x68, 0x0b,
+	0x4a, 0x87, 0xc1, 0xd3, 0x93, 0xc0, 0xc7, 0xdd, 0x5e, 0xf3, 0xcb, 0x03, 0x1c, 0xb4, 0xda, 0xa5,
+	0x14, 0x2a, 0x41, 0x61, 0x15, 0x2d, 0x39, 0xad, 0x93, 0x97, 0x17, 0x15, 0xe7, 0xd5, 0x45, 0xc5,
+	0xf9, 0xe7, 0xa2, 0xe2, 0xbc, 0xb8, 0xac, 0xa4, 0x5e, 0x5d, 0x56, 0x52, 0x7f, 0x5c, 0x56, 0x52,
+	0xdf, 0x7c, 0xba, 0x62, 0xd2, 0x94, 0x45, 0x31, 0x8f, 0x15, 0x13, 0x94, 0x1d, 0x09, 0xe6, 0xd9,
+	0x7b, 0xdc, 0x17, 0x44, 0xf1, 0x33, 0xe6, 0x9d, 0x35, 0xbc, 0xef, 0x97, 0xbf, 0x99, 0xc6, 0xbd,
+	0x7e, 0xc6, 0x0c, 0xde, 0xc7, 0xff, 0x0d, 0x00, 0xc8, 0xcd, 0x8a, 0x59, 0x53, 0x07, 0x00, 0x00,
 }
 
 func (m *HostChain) Marshal() (dAtA []byte, err error) {
@@ -649,6 +819,109 @@ func (m *ICAMemo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastSyncHeight != 0 {
+		i = encodeVarintRatesync(dAtA, i, uint64(m.LastSyncHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastSyncTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastSyncTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintRatesync(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if m.ID != 0 {
+		i = encodeVarintRatesync(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingSync) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSync) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSync) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QueuedHeight != 0 {
+		i = encodeVarintRatesync(dAtA, i, uint64(m.QueuedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.CValue.Size()
+		i -= size
+		if _, err := m.CValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRatesync(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.HostDenom) > 0 {
+		i -= len(m.HostDenom)
+		copy(dAtA[i:], m.HostDenom)
+		i = encodeVarintRatesync(dAtA, i, uint64(len(m.HostDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MintDenom) > 0 {
+		i -= len(m.MintDenom)
+		copy(dAtA[i:], m.MintDenom)
+		i = encodeVarintRatesync(dAtA, i, uint64(len(m.MintDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FeatureType != 0 {
+		i = encodeVarintRatesync(dAtA, i, uint64(m.FeatureType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintRatesync(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRatesync(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRatesync(v)
 	base := offset
@@ -751,6 +1024,51 @@ func (m *ICAMemo) Size() (n int) {
 	return n
 }
 
+func (m *SyncStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovRatesync(uint64(m.ID))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastSyncTime)
+	n += 1 + l + sovRatesync(uint64(l))
+	if m.LastSyncHeight != 0 {
+		n += 1 + sovRatesync(uint64(m.LastSyncHeight))
+	}
+	return n
+}
+
+func (m *PendingSync) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovRatesync(uint64(m.ID))
+	}
+	if m.FeatureType != 0 {
+		n += 1 + sovRatesync(uint64(m.FeatureType))
+	}
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovRatesync(uint64(l))
+	}
+	l = len(m.HostDenom)
+	if l > 0 {
+		n += 1 + l + sovRatesync(uint64(l))
+	}
+	l = m.CValue.Size()
+	n += 1 + l + sovRatesync(uint64(l))
+	if m.QueuedHeight != 0 {
+		n += 1 + sovRatesync(uint64(m.QueuedHeight))
+	}
+	return n
+}
+
 func sovRatesync(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1415,6 +1733,332 @@ func (m *ICAMemo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SyncStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatesync
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatesync
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSyncTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatesync
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRatesync
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatesync
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastSyncTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSyncHeight", wireType)
+			}
+			m.LastSyncHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatesync
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSyncHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatesync(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRatesync
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingSync) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatesync
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingSync: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingSync: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatesync
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeatureType", wireType)
+			}
+			m.FeatureType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatesync
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeatureType |= FeatureType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatesync
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatesync
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatesync
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatesync
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatesync
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatesync
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatesync
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatesync
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatesync
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedHeight", wireType)
+			}
+			m.QueuedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatesync
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatesync(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRatesync
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRatesync(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -392,6 +392,112 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetFeatureEnabled turns a single feature of a host chain on or off,
+// without resubmitting the whole host chain.
+type MsgSetFeatureEnabled struct {
+	Authority   string      `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ID          uint64      `protobuf:"varint,2,opt,name=i_d,json=iD,proto3" json:"i_d,omitempty"`
+	FeatureType FeatureType `protobuf:"varint,3,opt,name=feature_type,json=featureType,proto3,enum=pstake.ratesync.v1beta1.FeatureType" json:"feature_type,omitempty"`
+	Enabled     bool        `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetFeatureEnabled) Reset()         { *m = MsgSetFeatureEnabled{} }
+func (m *MsgSetFeatureEnabled) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeatureEnabled) ProtoMessage()    {}
+func (*MsgSetFeatureEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6173f0b1d1f1f64e, []int{8}
+}
+func (m *MsgSetFeatureEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeatureEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeatureEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeatureEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeatureEnabled.Merge(m, src)
+}
+func (m *MsgSetFeatureEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeatureEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeatureEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeatureEnabled proto.InternalMessageInfo
+
+func (m *MsgSetFeatureEnabled) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetFeatureEnabled) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *MsgSetFeatureEnabled) GetFeatureType() FeatureType {
+	if m != nil {
+		return m.FeatureType
+	}
+	return FeatureType_LIQUID_STAKE_IBC
+}
+
+func (m *MsgSetFeatureEnabled) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type MsgSetFeatureEnabledResponse struct {
+}
+
+func (m *MsgSetFeatureEnabledResponse) Reset()         { *m = MsgSetFeatureEnabledResponse{} }
+func (m *MsgSetFeatureEnabledResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeatureEnabledResponse) ProtoMessage()    {}
+func (*MsgSetFeatureEnabledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6173f0b1d1f1f64e, []int{9}
+}
+func (m *MsgSetFeatureEnabledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeatureEnabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeatureEnabledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeatureEnabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeatureEnabledResponse.Merge(m, src)
+}
+func (m *MsgSetFeatureEnabledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeatureEnabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeatureEnabledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeatureEnabledResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateHostChain)(nil), "pstake.ratesync.v1beta1.MsgCreateHostChain")
 	proto.RegisterType((*MsgCreateHostChainResponse)(nil), "pstake.ratesync.v1beta1.MsgCreateHostChainResponse")
@@ -401,47 +507,55 @@ func init() {
 	proto.RegisterType((*MsgDeleteHostChainResponse)(nil), "pstake.ratesync.v1beta1.MsgDeleteHostChainResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "pstake.ratesync.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "pstake.ratesync.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetFeatureEnabled)(nil), "pstake.ratesync.v1beta1.MsgSetFeatureEnabled")
+	proto.RegisterType((*MsgSetFeatureEnabledResponse)(nil), "pstake.ratesync.v1beta1.MsgSetFeatureEnabledResponse")
 }
 
 func init() { proto.RegisterFile("pstake/ratesync/v1beta1/tx.proto", fileDescriptor_6173f0b1d1f1f64e) }
 
 var fileDescriptor_6173f0b1d1f1f64e = []byte{
-	// 551 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0x31, 0x8f, 0x12, 0x41,
-	0x14, 0x66, 0xc1, 0x5c, 0xc2, 0x68, 0x42, 0xdc, 0x5c, 0x02, 0x47, 0xcc, 0x42, 0x36, 0xc6, 0x10,
-	0x0c, 0x3b, 0xc2, 0x45, 0x8b, 0xb3, 0x92, 0xbb, 0xc2, 0xc2, 0x8b, 0x06, 0x73, 0x8d, 0x0d, 0x19,
-	0x96, 0xc9, 0xee, 0xa8, 0xbb, 0xb3, 0xd9, 0x37, 0x47, 0x8e, 0xd6, 0xd2, 0xca, 0xc2, 0x1f, 0x61,
-	0x49, 0xe1, 0x1f, 0xb0, 0xbb, 0x12, 0xad, 0xac, 0x8c, 0x81, 0x82, 0xbf, 0x61, 0xd8, 0x99, 0x5d,
-	0x74, 0xc8, 0xe2, 0x69, 0x6c, 0x6c, 0x80, 0x79, 0xf3, 0xcd, 0xfb, 0xbe, 0xf7, 0xde, 0xf7, 0x40,
-	0xcd, 0x08, 0x04, 0x79, 0x45, 0x71, 0x4c, 0x04, 0x85, 0x69, 0xe8, 0xe2, 0x49, 0x77, 0x44, 0x05,
-	0xe9, 0x62, 0x71, 0xe1, 0x44, 0x31, 0x17, 0xdc, 0xac, 0x4a, 0x84, 0x93, 0x22, 0x1c, 0x85, 0xa8,
-	0x57, 0x5d, 0x0e, 0x01, 0x07, 0x1c, 0x80, 0x87, 0x27, 0xdd, 0xf5, 0x97, 0x7c, 0x51, 0xb7, 0xd4,
-	0xc5, 0x88, 0x00, 0xcd, 0xf2, 0xb9, 0x9c, 0x85, 0xea, 0xfe, 0x26, 0x09, 0x58, 0xc8, 0x71, 0xf2,
-	0xa9, 0x42, 0x07, 0xf2, 0xc9, 0x30, 0x39, 0x61, 0x79, 0x50, 0x57, 0xb7, 0xf3, 0x14, 0x46, 0x24,
-	0x26, 0x41, 0x8a, 0xba, 0x93, 0x87, 0xca, 0x64, 0x4b, 0xdc, 0xbe, 0xc7, 0x3d, 0x2e, 0x59, 0xd6,
-	0xbf, 0x64, 0xd4, 0xfe, 0x6c, 0x20, 0xf3, 0x14, 0xbc, 0xe3, 0x98, 0x12, 0x41, 0x1f, 0x73, 0x10,
-	0xc7, 0x3e, 0x61, 0xa1, 0xf9, 0x00, 0x95, 0xc9, 0xb9, 0xf0, 0x79, 0xcc, 0xc4, 0xb4, 0x66, 0x34,
-	0x8d, 0x56, 0xb9, 0x5f, 0xfb, 0xf2, 0xb1, 0xb3, 0xaf, 0xf4, 0x3d, 0x1a, 0x8f, 0x63, 0x0a, 0xf0,
-	0x5c, 0xc4, 0x2c, 0xf4, 0x06, 0x1b, 0xa8, 0xf9, 0x04, 0x21, 0x9f, 0x83, 0x18, 0xba, 0xeb, 0x2c,
-	0xb5, 0x62, 0xd3, 0x68, 0x5d, 0xef, 0xd9, 0x4e, 0x4e, 0x1f, 0x9d, 0x8c, 0xaf, 0x5f, 0xbe, 0xfc,
-	0xd6, 0x28, 0x7c, 0x58, 0xcd, 0xda, 0xc6, 0xa0, 0xec, 0xa7, 0xd1, 0xa3, 0xfb, 0x6f, 0x56, 0xb3,
-	0xf6, 0x26, 0xfb, 0xdb, 0xd5, 0xac, 0x6d, 0xeb, 0xd5, 0x6e, 0x8b, 0xb7, 0x3b, 0xa8, 0xbe, 0x1d,
-	0x1d, 0x50, 0x88, 0x78, 0x08, 0xd4, 0xac, 0xa0, 0x12, 0x1b, 0x8e, 0x93, 0xa2, 0xae, 0x0d, 0x8a,
-	0xec, 0x24, 0x6d, 0xc1, 0x59, 0x34, 0xfe, 0x7f, 0x5b, 0xa0, 0x89, 0xb7, 0x6f, 0x25, 0x2d, 0xd0,
-	0xa2, 0x69, 0x0b, 0xec, 0xf7, 0xb2, 0xe2, 0x13, 0xfa, 0x9a, 0xfe, 0x8b, 0x8a, 0x55, 0x47, 0x8b,
-	0x69, 0x47, 0xaf, 0x2a, 0x5a, 0xe3, 0x57, 0xa2, 0xb5, 0x68, 0x26, 0xfa, 0x93, 0x81, 0x2a, 0x59,
-	0x4d, 0xcf, 0x92, 0x0d, 0xf8, 0x6b, 0xc5, 0x7d, 0xb4, 0x27, 0x77, 0x48, 0xcd, 0xa7, 0x91, 0x3b,
-	0x1f, 0x49, 0xf4, 0xf3, 0x70, 0xd4, 0xcb, 0xa3, 0xde, 0x76, 0x91, 0x8d, 0xdc, 0xc9, 0xc8, 0x34,
-	0xf6, 0x01, 0xaa, 0x6a, 0xa1, 0xb4, 0xbc, 0xde, 0xbc, 0x84, 0x4a, 0xa7, 0xe0, 0x99, 0x80, 0x2a,
-	0xfa, 0x32, 0xde, 0xcd, 0x55, 0xb7, 0x6d, 0xf3, 0xfa, 0xe1, 0x1f, 0x80, 0xb3, 0x9d, 0x00, 0x54,
-	0xd1, 0xed, 0xbf, 0x93, 0x54, 0x03, 0xef, 0x26, 0xcd, 0x71, 0xe1, 0x9a, 0x54, 0x77, 0xe0, 0x4e,
-	0x52, 0x0d, 0xbc, 0x9b, 0x34, 0xc7, 0x45, 0xe6, 0x4b, 0x74, 0xe3, 0x17, 0x07, 0xb5, 0x7e, 0xaf,
-	0x5c, 0x22, 0xeb, 0xf7, 0xae, 0x8a, 0x4c, 0xb9, 0xfa, 0x67, 0x97, 0x0b, 0xcb, 0x98, 0x2f, 0x2c,
-	0xe3, 0xfb, 0xc2, 0x32, 0xde, 0x2d, 0xad, 0xc2, 0x7c, 0x69, 0x15, 0xbe, 0x2e, 0xad, 0xc2, 0x8b,
-	0x87, 0x1e, 0x13, 0xfe, 0xf9, 0xc8, 0x71, 0x79, 0x80, 0x23, 0x1a, 0x03, 0x03, 0x41, 0x43, 0x97,
-	0x3e, 0x0d, 0x29, 0x96, 0x24, 0x9d, 0x90, 0x08, 0x36, 0xa1, 0x78, 0xd2, 0xc3, 0x17, 0x1b, 0x3b,
-	0x89, 0x69, 0x44, 0x61, 0xb4, 0x97, 0xfc, 0x73, 0x1f, 0xfe, 0x08, 0x00, 0x00, 0xff, 0xff, 0xbf,
-	0x6c, 0xf7, 0xb8, 0xc1, 0x06, 0x00, 0x00,
+	// 653 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xbf, 0x6f, 0xda, 0x40,
+	0x14, 0xe6, 0x12, 0x94, 0x96, 0x4b, 0x54, 0x14, 0x0b, 0x09, 0xc7, 0x8a, 0x0c, 0xb2, 0x50, 0x85,
+	0xa8, 0xb0, 0x0b, 0x28, 0xad, 0x44, 0xa7, 0x92, 0xf4, 0xc7, 0x50, 0xd4, 0xca, 0x69, 0x96, 0x2e,
+	0xe8, 0x30, 0x17, 0xe3, 0x36, 0xf8, 0x2c, 0xdf, 0x81, 0xc2, 0xda, 0xb1, 0x53, 0x87, 0xfe, 0x11,
+	0x1d, 0x19, 0xfa, 0x0f, 0x74, 0x8b, 0xd4, 0x25, 0xed, 0xd4, 0xa9, 0xaa, 0x60, 0xe0, 0x8f, 0xe8,
+	0x52, 0xc1, 0xd9, 0x26, 0xb5, 0x63, 0x1a, 0xaa, 0x2c, 0x5d, 0x80, 0x7b, 0xf7, 0xdd, 0xfb, 0xbe,
+	0xef, 0xbd, 0x7b, 0x07, 0xcc, 0x3b, 0x94, 0xa1, 0x37, 0x58, 0x73, 0x11, 0xc3, 0x74, 0x68, 0x1b,
+	0xda, 0xa0, 0xd2, 0xc6, 0x0c, 0x55, 0x34, 0x76, 0xaa, 0x3a, 0x2e, 0x61, 0x44, 0xc8, 0x72, 0x84,
+	0xea, 0x23, 0x54, 0x0f, 0x21, 0x65, 0x0d, 0x42, 0x7b, 0x84, 0x6a, 0x3d, 0x6a, 0x6a, 0x83, 0xca,
+	0xec, 0x8b, 0x9f, 0x90, 0x64, 0x6f, 0xa3, 0x8d, 0x28, 0x0e, 0xf2, 0x19, 0xc4, 0xb2, 0xbd, 0xfd,
+	0x6d, 0xd4, 0xb3, 0x6c, 0xa2, 0xcd, 0x3f, 0xbd, 0xd0, 0x0e, 0x3f, 0xd2, 0x9a, 0xaf, 0x34, 0xbe,
+	0xf0, 0xb6, 0x0a, 0x71, 0x0a, 0x1d, 0xe4, 0xa2, 0x9e, 0x8f, 0xba, 0x1d, 0x87, 0x0a, 0x64, 0x73,
+	0x5c, 0xc6, 0x24, 0x26, 0xe1, 0x2c, 0xb3, 0x5f, 0x3c, 0xaa, 0x7c, 0x05, 0x50, 0x68, 0x52, 0x73,
+	0xdf, 0xc5, 0x88, 0xe1, 0xa7, 0x84, 0xb2, 0xfd, 0x2e, 0xb2, 0x6c, 0xe1, 0x1e, 0x4c, 0xa1, 0x3e,
+	0xeb, 0x12, 0xd7, 0x62, 0x43, 0x11, 0xe4, 0x41, 0x31, 0xd5, 0x10, 0xbf, 0x7d, 0x2a, 0x67, 0x3c,
+	0x7d, 0x0f, 0x3b, 0x1d, 0x17, 0x53, 0x7a, 0xc8, 0x5c, 0xcb, 0x36, 0xf5, 0x05, 0x54, 0x78, 0x06,
+	0x61, 0x97, 0x50, 0xd6, 0x32, 0x66, 0x59, 0xc4, 0xb5, 0x3c, 0x28, 0x6e, 0x56, 0x15, 0x35, 0xa6,
+	0x8e, 0x6a, 0xc0, 0xd7, 0x48, 0x9d, 0xfd, 0xc8, 0x25, 0x3e, 0x4e, 0x47, 0x25, 0xa0, 0xa7, 0xba,
+	0x7e, 0xb4, 0xbe, 0xf7, 0x76, 0x3a, 0x2a, 0x2d, 0xb2, 0xbf, 0x9b, 0x8e, 0x4a, 0x4a, 0xd8, 0x6d,
+	0x54, 0xbc, 0x52, 0x86, 0x52, 0x34, 0xaa, 0x63, 0xea, 0x10, 0x9b, 0x62, 0x21, 0x0d, 0xd7, 0xad,
+	0x56, 0x67, 0x6e, 0x2a, 0xa9, 0xaf, 0x59, 0x07, 0x7e, 0x09, 0x8e, 0x9c, 0xce, 0xff, 0x5b, 0x82,
+	0x90, 0x78, 0x65, 0x17, 0x4a, 0xd1, 0xa8, 0x5f, 0x02, 0xe5, 0x03, 0x77, 0x7c, 0x80, 0x4f, 0xf0,
+	0x75, 0x38, 0xf6, 0x2a, 0xba, 0xe6, 0x57, 0xf4, 0xaa, 0xa2, 0x43, 0xfc, 0x9e, 0xe8, 0x50, 0x34,
+	0x10, 0xfd, 0x19, 0xc0, 0x74, 0xe0, 0xe9, 0xc5, 0x7c, 0x02, 0xfe, 0x59, 0x71, 0x03, 0x6e, 0xf0,
+	0x19, 0xf2, 0xfa, 0x93, 0x8b, 0xed, 0x0f, 0x27, 0xba, 0xd8, 0x1c, 0xef, 0x64, 0xbd, 0x1a, 0x35,
+	0x99, 0x8b, 0xed, 0x0c, 0x4f, 0xa3, 0xec, 0xc0, 0x6c, 0x28, 0x14, 0xd8, 0xfb, 0x05, 0x60, 0xa6,
+	0x49, 0xcd, 0x43, 0xcc, 0x1e, 0x63, 0xc4, 0xfa, 0x2e, 0x7e, 0x64, 0xa3, 0xf6, 0x09, 0xee, 0x5c,
+	0x5b, 0x57, 0x84, 0x27, 0x70, 0xeb, 0x98, 0xa7, 0x6e, 0xb1, 0xa1, 0x83, 0xc5, 0xf5, 0x3c, 0x28,
+	0xde, 0xaa, 0x16, 0x62, 0xad, 0x7b, 0x3a, 0x5e, 0x0e, 0x1d, 0xac, 0x6f, 0x1e, 0x2f, 0x16, 0x82,
+	0x08, 0x6f, 0x60, 0x2e, 0x4e, 0x4c, 0xe6, 0x41, 0xf1, 0xa6, 0xee, 0x2f, 0xeb, 0xf7, 0xa3, 0x35,
+	0x29, 0x5c, 0x52, 0x93, 0x88, 0x49, 0x45, 0x86, 0xbb, 0x97, 0xc5, 0xfd, 0xea, 0x54, 0xbf, 0x24,
+	0xe1, 0x7a, 0x93, 0x9a, 0x02, 0x85, 0xe9, 0xf0, 0x53, 0x75, 0x27, 0xd6, 0x40, 0xf4, 0x11, 0x90,
+	0x6a, 0x2b, 0x80, 0x83, 0x17, 0x83, 0xc2, 0x74, 0xf8, 0x71, 0x58, 0x4a, 0x1a, 0x02, 0x4b, 0xb5,
+	0x15, 0xc0, 0x17, 0x49, 0xc3, 0xf3, 0xb9, 0x94, 0x34, 0x04, 0x96, 0x6a, 0x2b, 0x80, 0x03, 0xd2,
+	0xd7, 0x70, 0xeb, 0x8f, 0xf9, 0x2a, 0xfe, 0x5d, 0x39, 0x47, 0x4a, 0x77, 0xaf, 0x8a, 0x0c, 0xb8,
+	0x86, 0x70, 0x3b, 0x7a, 0xd9, 0xcb, 0xcb, 0xd2, 0x44, 0xe0, 0xd2, 0xde, 0x4a, 0x70, 0x9f, 0xba,
+	0x71, 0x74, 0x36, 0x96, 0xc1, 0xf9, 0x58, 0x06, 0x3f, 0xc7, 0x32, 0x78, 0x3f, 0x91, 0x13, 0xe7,
+	0x13, 0x39, 0xf1, 0x7d, 0x22, 0x27, 0x5e, 0x3d, 0x30, 0x2d, 0xd6, 0xed, 0xb7, 0x55, 0x83, 0xf4,
+	0x34, 0x07, 0xbb, 0xd4, 0xa2, 0x0c, 0xdb, 0x06, 0x7e, 0x6e, 0x63, 0x8d, 0x33, 0x95, 0x6d, 0xc4,
+	0xac, 0x01, 0xd6, 0x06, 0x55, 0xed, 0x74, 0x71, 0xa7, 0x67, 0xf3, 0x44, 0xdb, 0x1b, 0xf3, 0xbf,
+	0xd4, 0xda, 0xef, 0x01, 0x00, 0x8f, 0xb0, 0x44, 0x29, 0x5a, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -460,6 +574,7 @@ type MsgClient interface {
 	UpdateHostChain(ctx context.Context, in *MsgUpdateHostChain, opts ...grpc.CallOption) (*MsgUpdateHostChainResponse, error)
 	DeleteHostChain(ctx context.Context, in *MsgDeleteHostChain, opts ...grpc.CallOption) (*MsgDeleteHostChainResponse, error)
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	SetFeatureEnabled(ctx context.Context, in *MsgSetFeatureEnabled, opts ...grpc.CallOption) (*MsgSetFeatureEnabledResponse, error)
 }
 
 type msgClient struct {
@@ -506,12 +621,22 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetFeatureEnabled(ctx context.Context, in *MsgSetFeatureEnabled, opts ...grpc.CallOption) (*MsgSetFeatureEnabledResponse, error) {
+	out := new(MsgSetFeatureEnabledResponse)
+	err := c.cc.Invoke(ctx, "/pstake.ratesync.v1beta1.Msg/SetFeatureEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateHostChain(context.Context, *MsgCreateHostChain) (*MsgCreateHostChainResponse, error)
 	UpdateHostChain(context.Context, *MsgUpdateHostChain) (*MsgUpdateHostChainResponse, error)
 	DeleteHostChain(context.Context, *MsgDeleteHostChain) (*MsgDeleteHostChainResponse, error)
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	SetFeatureEnabled(context.Context, *MsgSetFeatureEnabled) (*MsgSetFeatureEnabledResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -530,6 +655,9 @@ func (*UnimplementedMsgServer) DeleteHostChain(ctx context.Context, req *MsgDele
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetFeatureEnabled(ctx context.Context, req *MsgSetFeatureEnabled) (*MsgSetFeatureEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeatureEnabled not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -607,6 +735,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetFeatureEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeatureEnabled)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeatureEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.ratesync.v1beta1.Msg/SetFeatureEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeatureEnabled(ctx, req.(*MsgSetFeatureEnabled))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pstake.ratesync.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -627,6 +773,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetFeatureEnabled",
+			Handler:    _Msg_SetFeatureEnabled_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pstake/ratesync/v1beta1/tx.proto",
@@ -884,6 +1034,79 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetFeatureEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeatureEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeatureEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FeatureType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FeatureType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeatureEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeatureEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeatureEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -995,6 +1218,37 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetFeatureEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	if m.FeatureType != 0 {
+		n += 1 + sovTx(uint64(m.FeatureType))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetFeatureEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1666,6 +1920,196 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetFeatureEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeatureEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeatureEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeatureType", wireType)
+			}
+			m.FeatureType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeatureType |= FeatureType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFeatureEnabledResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeatureEnabledResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeatureEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
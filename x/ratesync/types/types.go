@@ -82,6 +82,18 @@ func (hc HostChain) IsActive() bool {
 	return false
 }
 
+// GetFeature returns the feature of the given type, to be updated in place
+func (f *Feature) GetFeature(featureType FeatureType) (*LiquidStake, error) {
+	switch featureType {
+	case FeatureType_LIQUID_STAKE_IBC:
+		return &f.LiquidStakeIBC, nil
+	case FeatureType_LIQUID_STAKE:
+		return &f.LiquidStake, nil
+	default:
+		return nil, fmt.Errorf("invalid feature type %s", featureType)
+	}
+}
+
 func (f Feature) ValdidateBasic() error {
 	if f.LiquidStakeIBC.FeatureType != FeatureType_LIQUID_STAKE_IBC {
 		return fmt.Errorf("invalid feature type expected %s, got %s", FeatureType_LIQUID_STAKE_IBC, f.LiquidStakeIBC.FeatureType)
@@ -151,11 +163,21 @@ func (lsConfig LiquidStake) AllowsAllDenoms() bool {
 	return false
 }
 
+// AllowsDenom checks the denom against the allowed denoms, where a denom ending in "*" allows every denom it prefixes
 func (lsConfig LiquidStake) AllowsDenom(denom string) bool {
 	if lsConfig.AllowsAllDenoms() {
 		return true
 	}
-	return slices.Contains(lsConfig.Denoms, denom)
+	for _, allowed := range lsConfig.Denoms {
+		prefix, isPattern := strings.CutSuffix(allowed, LiquidStakeAllowAllDenoms)
+		if isPattern && prefix != "" && strings.HasPrefix(denom, prefix) {
+			return true
+		}
+		if allowed == denom {
+			return true
+		}
+	}
+	return false
 }
 
 func (lsConfig LiquidStake) Equals(l2 LiquidStake) bool {
@@ -224,6 +246,10 @@ func ValidateLiquidStakeDenoms(denoms []string) error {
 		if !liquidstakeibctypes.IsLiquidStakingDenom(denom) {
 			return fmt.Errorf("invalid denom, expected a liquidstaking denom got %s", denom)
 		}
+		// a wildcard is only allowed at the end of a denom, to match the denoms with its prefix
+		if i := strings.Index(denom, LiquidStakeAllowAllDenoms); i != -1 && i != len(denom)-1 {
+			return fmt.Errorf("invalid denom pattern %s, wildcard only allowed as the last character", denom)
+		}
 	}
 	return nil
 }
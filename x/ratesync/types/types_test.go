@@ -113,6 +113,23 @@ func TestTypes(t *testing.T) {
 	lsfeature.Denoms = []string{"*", "stk/uxprt"}
 	require.Equal(t, false, lsfeature.AllowsAllDenoms())
 	require.Equal(t, true, lsfeature.AllowsDenom("stk/uxprt"))
+	require.Equal(t, false, lsfeature.AllowsDenom("stk/uatom"))
+
+	lsfeature = ValidHostChainInMsg(0).Features.LiquidStake
+	lsfeature.Denoms = []string{"stk/*"}
+	require.NoError(t, lsfeature.ValdidateBasic())
+	require.Equal(t, false, lsfeature.AllowsAllDenoms())
+	require.Equal(t, true, lsfeature.AllowsDenom("stk/uxprt"))
+	require.Equal(t, false, lsfeature.AllowsDenom("uxprt"))
+	lsfeature.Denoms = []string{"stk/ibc/*", "stk/uxprt"}
+	require.NoError(t, lsfeature.ValdidateBasic())
+	require.Equal(t, true, lsfeature.AllowsDenom("stk/ibc/ABC"))
+	require.Equal(t, true, lsfeature.AllowsDenom("stk/uxprt"))
+	require.Equal(t, false, lsfeature.AllowsDenom("stk/uatom"))
+	lsfeature.Denoms = []string{"stk/*/uatom"}
+	require.Error(t, lsfeature.ValdidateBasic())
+	lsfeature.Denoms = []string{"ibc/*"}
+	require.Error(t, lsfeature.ValdidateBasic())
 
 	lsfeature = ValidHostChainInMsg(0).Features.LiquidStake
 	lsfeature2 := ValidHostChainInMsg(0).Features.LiquidStake